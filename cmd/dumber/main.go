@@ -30,11 +30,16 @@ import (
 	infrafavicon "github.com/bnema/dumber/internal/infrastructure/favicon"
 	"github.com/bnema/dumber/internal/infrastructure/filesystem"
 	"github.com/bnema/dumber/internal/infrastructure/idle"
+	"github.com/bnema/dumber/internal/infrastructure/navtree"
 	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite"
+	"github.com/bnema/dumber/internal/infrastructure/requestmock"
 	"github.com/bnema/dumber/internal/infrastructure/runtimeprofile"
 	"github.com/bnema/dumber/internal/infrastructure/snapshot"
 	"github.com/bnema/dumber/internal/infrastructure/textinput"
+	"github.com/bnema/dumber/internal/infrastructure/tts"
 	"github.com/bnema/dumber/internal/infrastructure/updater"
+	"github.com/bnema/dumber/internal/infrastructure/userscript"
+	"github.com/bnema/dumber/internal/infrastructure/windowstate"
 	"github.com/bnema/dumber/internal/infrastructure/xdg"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/dumber/internal/ui"
@@ -59,6 +64,9 @@ var initialURL string
 // restoreSessionID holds the session ID to restore on startup.
 var restoreSessionID string
 
+// restorePinnedOnly limits the startup restore to pinned tabs (session.restore_mode = "pinned").
+var restorePinnedOnly bool
+
 // browserLaunchRelay is shared for early browse handoff and in-app browser launches.
 var browserLaunchRelay port.BrowserLaunchRelay
 
@@ -232,6 +240,7 @@ func runGUI(cfg *config.Config, timing startupTiming) int {
 		configureBrowserLaunchRelay(cfg)
 	}
 	timer.Mark("config")
+	timer.SetBudgets(startupBudgets(cfg))
 
 	ctx := initStartupContext(cfg)
 	activateCEFStartupTraceForGUI(cfg, timing, logging.FromContext(ctx), infracef.ActivateStartupTrace)
@@ -239,6 +248,12 @@ func runGUI(cfg *config.Config, timing startupTiming) int {
 	timer.Mark("logger")
 	bootstrapLog := logging.FromContext(ctx)
 
+	safeMode := bootstrap.CheckCrashLoop(cfg, time.Now(), bootstrapLog)
+	if safeMode {
+		bootstrap.ApplySafeMode(cfg)
+		bootstrapLog.Warn().Msg("repeated crashes detected, starting in safe mode")
+	}
+
 	initResult, err := runParallelInitPhase(ctx, cfg)
 	if err != nil {
 		return 1
@@ -279,9 +294,13 @@ func runGUI(cfg *config.Config, timing startupTiming) int {
 
 	idleInhibitor := idle.NewPortalInhibitor(ctx)
 	defer closeIdleInhibitor(idleInhibitor)
+	applyIdleQuietHours(ctx, cfg, idleInhibitor)
+	urlSharer := desktop.NewPortalSharer()
+	defer func() { _ = urlSharer.Close() }()
+	useCases.shareURL = usecase.NewShareURLUseCase(urlSharer, useCases.copyURL)
 	timer.Mark("use_cases")
 
-	app, err := buildAndConfigureApp(ctx, cfg, initResult, engine, repos, useCases, idleInhibitor, browserSession)
+	app, err := buildAndConfigureApp(ctx, cfg, initResult, engine, repos, useCases, idleInhibitor, browserSession, safeMode)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to create application")
 		return 1
@@ -301,6 +320,15 @@ func runGUI(cfg *config.Config, timing startupTiming) int {
 	return app.Run(ctx, os.Args)
 }
 
+// startupBudgets converts debug.startup_budgets from milliseconds.
+func startupBudgets(cfg *config.Config) map[string]time.Duration {
+	budgets := make(map[string]time.Duration, len(cfg.Debug.StartupBudgets))
+	for phase, ms := range cfg.Debug.StartupBudgets {
+		budgets[phase] = time.Duration(ms) * time.Millisecond
+	}
+	return budgets
+}
+
 func runStandaloneOmnibox() int {
 	maybeReexecStandaloneOmniboxWithLayerShell()
 
@@ -475,6 +503,17 @@ func closeIdleInhibitor(inhibitor port.IdleInhibitor) {
 	}
 }
 
+// applyIdleQuietHours applies the configured quiet hours and keeps them in
+// sync with config reloads.
+func applyIdleQuietHours(ctx context.Context, cfg *config.Config, inhibitor *idle.PortalInhibitor) {
+	inhibitor.SetQuietHours(ctx, cfg.Idle.QuietHours)
+	if manager := config.GetManager(); manager != nil {
+		manager.OnConfigChange(func(newCfg *config.Config) {
+			inhibitor.SetQuietHours(ctx, newCfg.Idle.QuietHours)
+		})
+	}
+}
+
 func buildAndConfigureApp(
 	ctx context.Context,
 	cfg *config.Config,
@@ -484,6 +523,7 @@ func buildAndConfigureApp(
 	useCases *useCases,
 	idleInhibitor port.IdleInhibitor,
 	browserSession *bootstrap.BrowserSession,
+	safeMode bool,
 ) (*ui.App, error) {
 	uiDeps, err := buildUIDependencies(
 		ctx, cfg, initResult.RuntimeProfile, initResult.ThemeManager,
@@ -494,7 +534,8 @@ func buildAndConfigureApp(
 	if err != nil {
 		return nil, err
 	}
-	configureDeferredInit(uiDeps, cfg, browserSession)
+	uiDeps.SafeMode = safeMode
+	configureDeferredInit(uiDeps, cfg, browserSession, useCases.favicon)
 	return ui.New(uiDeps)
 }
 
@@ -570,6 +611,7 @@ func configureDeferredInit(
 	uiDeps *ui.Dependencies,
 	cfg *config.Config,
 	session *bootstrap.BrowserSession,
+	favicons *infrafavicon.Service,
 ) {
 	if uiDeps == nil {
 		return
@@ -584,8 +626,9 @@ func configureDeferredInit(
 		bgCtx := logging.WithContext(context.Background(), *logger)
 		go func() {
 			result := bootstrap.RunDeferredInit(bootstrap.DeferredInitInput{
-				Ctx:    bgCtx,
-				Config: cfg,
+				Ctx:      bgCtx,
+				Config:   cfg,
+				Favicons: favicons,
 			})
 			logDeferredInitResults(bgCtx, result)
 		}()
@@ -649,6 +692,16 @@ func logDeferredInitResults(ctx context.Context, result bootstrap.DeferredInitRe
 	if result.MediaErr != nil {
 		log.Warn().Err(result.MediaErr).Msg("media check failed")
 	}
+	if result.FaviconErr != nil {
+		log.Warn().Err(result.FaviconErr).Msg("favicon cache eviction failed")
+	}
+	if result.FaviconGC.EvictedEntries > 0 {
+		log.Info().
+			Int("evicted", result.FaviconGC.EvictedEntries).
+			Int64("freed_bytes", result.FaviconGC.FreedBytes).
+			Int("remaining", result.FaviconGC.Remaining.Entries).
+			Msg("favicon cache trimmed")
+	}
 	log.Debug().Dur("duration", result.Duration).Msg("deferred init complete")
 }
 
@@ -662,8 +715,10 @@ func handleAutoRestore(
 		return
 	}
 	log := logging.FromContext(ctx)
+	pinnedOnly := cfg.Session.RestoreMode == config.SessionRestorePinned
 	out, err := uc.lastRestorable.Execute(ctx, usecase.GetLastRestorableSessionInput{
 		ExcludeSessionID: currentSessionID,
+		PinnedOnly:       pinnedOnly,
 	})
 	if err != nil {
 		log.Warn().Err(err).Msg("auto-restore: failed to find restorable session")
@@ -671,8 +726,10 @@ func handleAutoRestore(
 	}
 	if out.SessionID != "" {
 		restoreSessionID = string(out.SessionID)
+		restorePinnedOnly = pinnedOnly
 		log.Info().
 			Str("session_id", restoreSessionID).
+			Bool("pinned_only", pinnedOnly).
 			Int("tabs", len(out.State.Tabs)).
 			Msg("auto-restore: found last session")
 	}
@@ -704,9 +761,15 @@ type repositories struct {
 	tag          repository.TagRepository
 	zoom         repository.ZoomRepository
 	permission   port.PermissionRepository
+	certPin      port.CertificatePinRepository
+	siteUA       port.SiteUserAgentRepository
+	siteScheme   port.SiteColorSchemeRepository
+	filter       repository.ContentWhitelistRepository
+	cosmetic     repository.CosmeticFilterExceptionRepository
 	session      repository.SessionRepository
 	sessionState repository.SessionStateRepository
 	faviconRepo  port.FaviconRepository
+	notes        port.NoteRepository
 }
 
 func createRepositories(db *sql.DB) *repositories {
@@ -716,9 +779,15 @@ func createRepositories(db *sql.DB) *repositories {
 		tag:          sqlite.NewTagRepository(db),
 		zoom:         sqlite.NewZoomRepository(db),
 		permission:   sqlite.NewPermissionRepository(db),
+		certPin:      sqlite.NewCertificatePinRepository(db),
+		siteUA:       sqlite.NewSiteUserAgentRepository(db),
+		siteScheme:   sqlite.NewSiteColorSchemeRepository(db),
+		filter:       sqlite.NewContentWhitelistRepository(db),
+		cosmetic:     sqlite.NewCosmeticFilterExceptionRepository(db),
 		session:      sqlite.NewSessionRepository(db),
 		sessionState: sqlite.NewSessionStateRepository(db),
 		faviconRepo:  sqlite.NewFaviconRepository(db),
+		notes:        sqlite.NewNoteRepository(db),
 	}
 }
 
@@ -729,30 +798,43 @@ func createLazyRepositories(provider port.DatabaseProvider) *repositories {
 		tag:          sqlite.NewLazyTagRepository(provider),
 		zoom:         sqlite.NewLazyZoomRepository(provider),
 		permission:   sqlite.NewLazyPermissionRepository(provider),
+		certPin:      sqlite.NewLazyCertificatePinRepository(provider),
+		siteUA:       sqlite.NewLazySiteUserAgentRepository(provider),
+		siteScheme:   sqlite.NewLazySiteColorSchemeRepository(provider),
+		filter:       sqlite.NewLazyContentWhitelistRepository(provider),
+		cosmetic:     sqlite.NewLazyCosmeticFilterExceptionRepository(provider),
 		session:      sqlite.NewLazySessionRepository(provider),
 		sessionState: sqlite.NewLazySessionStateRepository(provider),
 		faviconRepo:  sqlite.NewLazyFaviconRepository(provider),
+		notes:        sqlite.NewLazyNoteRepository(provider),
 	}
 }
 
 // useCases groups application layer use case implementations.
 type useCases struct {
-	tabs            *usecase.ManageTabsUseCase
-	panes           *usecase.ManagePanesUseCase
-	history         *usecase.SearchHistoryUseCase
-	favorites       *usecase.ManageFavoritesUseCase
-	zoom            *usecase.ManageZoomUseCase
-	permission      *usecase.HandlePermissionUseCase
-	navigate        *usecase.NavigateUseCase
-	historyRecorder *usecase.HistoryRecorderUseCase
-	copyURL         *usecase.CopyURLUseCase
-	snapshot        *usecase.SnapshotSessionUseCase
-	lastRestorable  *usecase.GetLastRestorableSessionUseCase
-	checkUpdate     *usecase.CheckUpdateUseCase
-	applyUpdate     *usecase.ApplyUpdateUseCase
-	clipboard       port.Clipboard
-	favicon         *infrafavicon.Service
-	faviconUC       *usecase.FaviconUseCase
+	tabs             *usecase.ManageTabsUseCase
+	panes            *usecase.ManagePanesUseCase
+	history          *usecase.SearchHistoryUseCase
+	favorites        *usecase.ManageFavoritesUseCase
+	notes            *usecase.ManageNotesUseCase
+	zoom             *usecase.ManageZoomUseCase
+	permission       *usecase.HandlePermissionUseCase
+	certPins         *usecase.ManageCertificatePinsUseCase
+	siteUserAgents   *usecase.ManageSiteUserAgentsUseCase
+	siteColorSchemes *usecase.ManageSiteColorSchemesUseCase
+	filterExceptions *usecase.ManageFilterExceptionsUseCase
+	cosmeticFilters  *usecase.ManageFilterExceptionsUseCase
+	navigate         *usecase.NavigateUseCase
+	historyRecorder  *usecase.HistoryRecorderUseCase
+	copyURL          *usecase.CopyURLUseCase
+	shareURL         *usecase.ShareURLUseCase
+	snapshot         *usecase.SnapshotSessionUseCase
+	lastRestorable   *usecase.GetLastRestorableSessionUseCase
+	checkUpdate      *usecase.CheckUpdateUseCase
+	applyUpdate      *usecase.ApplyUpdateUseCase
+	clipboard        port.Clipboard
+	favicon          *infrafavicon.Service
+	faviconUC        *usecase.FaviconUseCase
 }
 
 func (uc *useCases) Close() {
@@ -808,22 +890,28 @@ func createUseCases(repos *repositories, cfg *config.Config) *useCases {
 	historyUC.SetHistoryMutationCoordinator(historyRecorderUC)
 
 	return &useCases{
-		tabs:            usecase.NewManageTabsUseCase(idGenerator, localPaths),
-		panes:           usecase.NewManagePanesUseCase(idGenerator, localPaths),
-		history:         historyUC,
-		favorites:       usecase.NewManageFavoritesUseCase(repos.favorite, repos.tag),
-		zoom:            usecase.NewManageZoomUseCase(repos.zoom, defaultZoom, zoomCache),
-		permission:      permissionUC,
-		navigate:        usecase.NewNavigateUseCase(defaultZoom),
-		historyRecorder: historyRecorderUC,
-		copyURL:         usecase.NewCopyURLUseCase(clipboardAdapter),
-		snapshot:        usecase.NewSnapshotSessionUseCase(repos.sessionState),
-		lastRestorable:  usecase.NewGetLastRestorableSessionUseCase(repos.session, repos.sessionState),
-		checkUpdate:     usecase.NewCheckUpdateUseCase(updateChecker, updateApplier, buildInfo),
-		applyUpdate:     usecase.NewApplyUpdateUseCase(updateDownloader, updateApplier, xdgDirs.CacheHome),
-		clipboard:       clipboardAdapter,
-		favicon:         faviconService,
-		faviconUC:       faviconUC,
+		tabs:             usecase.NewManageTabsUseCase(idGenerator, localPaths),
+		panes:            usecase.NewManagePanesUseCase(idGenerator, localPaths),
+		history:          historyUC,
+		favorites:        usecase.NewManageFavoritesUseCase(repos.favorite, repos.tag),
+		notes:            usecase.NewManageNotesUseCase(repos.notes),
+		zoom:             usecase.NewManageZoomUseCase(repos.zoom, defaultZoom, zoomCache),
+		permission:       permissionUC,
+		certPins:         usecase.NewManageCertificatePinsUseCase(repos.certPin, nil),
+		siteUserAgents:   usecase.NewManageSiteUserAgentsUseCase(repos.siteUA),
+		siteColorSchemes: usecase.NewManageSiteColorSchemesUseCase(repos.siteScheme),
+		filterExceptions: usecase.NewManageFilterExceptionsUseCase(repos.filter),
+		cosmeticFilters:  usecase.NewManageFilterExceptionsUseCase(repos.cosmetic),
+		navigate:         usecase.NewNavigateUseCase(defaultZoom),
+		historyRecorder:  historyRecorderUC,
+		copyURL:          usecase.NewCopyURLUseCase(clipboardAdapter),
+		snapshot:         usecase.NewSnapshotSessionUseCase(repos.sessionState),
+		lastRestorable:   usecase.NewGetLastRestorableSessionUseCase(repos.session, repos.sessionState),
+		checkUpdate:      usecase.NewCheckUpdateUseCase(updateChecker, updateApplier, buildInfo),
+		applyUpdate:      usecase.NewApplyUpdateUseCase(updateDownloader, updateApplier, xdgDirs.CacheHome),
+		clipboard:        clipboardAdapter,
+		favicon:          faviconService,
+		faviconUC:        faviconUC,
 	}
 }
 
@@ -897,6 +985,7 @@ func buildUIDependencies(
 		RuntimeConfig:        runtimeConfig,
 		InitialURL:           initialURL,
 		RestoreSessionID:     restoreSessionID,
+		RestorePinnedOnly:    restorePinnedOnly,
 		StartupCrashReports:  startupCrashReports,
 		Theme:                themeManager,
 		ResolveThemeUC:       resolveThemeUC,
@@ -918,11 +1007,19 @@ func buildUIDependencies(
 		PanesUC:                   uc.panes,
 		HistoryUC:                 uc.history,
 		FavoritesUC:               uc.favorites,
+		NotesUC:                   uc.notes,
 		ZoomUC:                    uc.zoom,
 		PermissionUC:              uc.permission,
+		CertificatePinUC:          uc.certPins,
+		SiteUserAgentUC:           uc.siteUserAgents,
+		SiteColorSchemeUC:         uc.siteColorSchemes,
+		FilterExceptionsUC:        uc.filterExceptions,
+		CosmeticExceptionsUC:      uc.cosmeticFilters,
+		FilterRepo:                repos.filter,
 		NavigateUC:                uc.navigate,
 		HistoryRecorderUC:         uc.historyRecorder,
 		CopyURLUC:                 uc.copyURL,
+		ShareURLUC:                uc.shareURL,
 		Clipboard:                 uc.clipboard,
 		FaviconService:            legacyFaviconService(uc),
 		FaviconResolver:           faviconResolver(uc),
@@ -932,8 +1029,12 @@ func buildUIDependencies(
 			InternalDomain:     infrafavicon.InternalDomain,
 			NormalizedIconSize: infrafavicon.NormalizedIconSize,
 			GetLogoBytes:       infrafavicon.GetLogoBytes,
+			MonogramFallback: func() bool {
+				return runtimeConfig.Current().UI.Appearance.FaviconFallback != entity.FaviconFallbackIcon
+			},
 		},
 		IdleInhibitor:    idleInhibitor,
+		SpeechPlayer:     tts.NewPlayer(),
 		SessionRepo:      repos.session,
 		SessionStateRepo: repos.sessionState,
 		CurrentSessionID: currentSessionID,
@@ -959,5 +1060,27 @@ func buildUIDependencies(
 		HandlerDeps:        *handlerDeps,
 	}
 
+	if cfg.UserScripts.Enabled {
+		scriptsDir, dirErr := config.ResolveUserScriptsDir(cfg.UserScripts.Directory)
+		if dirErr != nil {
+			logging.FromContext(ctx).Warn().Err(dirErr).Msg("user scripts disabled: cannot resolve directory")
+		} else {
+			uiDeps.UserScripts = userscript.NewUserScriptManager(scriptsDir)
+		}
+	}
+
+	if mocksFile, fileErr := config.ResolveRequestMocksFile(cfg.RequestMocks.File); fileErr == nil {
+		uiDeps.RequestMocks = requestmock.NewFile(mocksFile)
+	} else {
+		logging.FromContext(ctx).Warn().Err(fileErr).Msg("request mocks disabled: cannot resolve file")
+	}
+
+	if stateDir, dirErr := config.GetStateDir(); dirErr == nil {
+		uiDeps.WindowGeometryStore = windowstate.NewStore(stateDir)
+		uiDeps.NavigationTreeStore = navtree.NewStore(stateDir)
+	} else {
+		logging.FromContext(ctx).Warn().Err(dirErr).Msg("window geometry not remembered: cannot resolve state directory")
+	}
+
 	return uiDeps, nil
 }
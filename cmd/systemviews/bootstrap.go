@@ -12,6 +12,10 @@ type bridgeServices interface {
 	port.SystemviewHistoryService
 	port.SystemviewFavoritesService
 	port.SystemviewConfigService
+	port.SystemviewHomepageService
+	port.SystemviewConsoleService
+	port.SystemviewNavigationTreeService
+	port.SystemviewNotesService
 }
 
 func newBridgeApp(dom systemviews.DOM, locationURI string, bridge bridgeServices) *systemviews.App {
@@ -21,6 +25,10 @@ func newBridgeApp(dom systemviews.DOM, locationURI string, bridge bridgeServices
 		History:     bridge,
 		Favorites:   bridge,
 		Config:      bridgeConfigProxy{bridge: bridge, route: route},
+		Homepage:    bridge,
+		Console:     bridge,
+		NavTree:     bridge,
+		Notes:       bridge,
 		LocationURI: locationURI,
 	})
 }
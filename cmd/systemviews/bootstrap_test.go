@@ -91,6 +91,76 @@ func TestNewBridgeApp_WiresConfigService(t *testing.T) {
 	assert.True(t, bridge.calledKeybindings.Load())
 }
 
+func TestNewBridgeApp_WiresHomepageService(t *testing.T) {
+	t.Parallel()
+
+	bridge := &bridgeServiceRecorder{
+		dashboard: dto.HomepageDashboard{
+			Widgets:      []entity.HomepageWidget{entity.HomepageWidgetTopFavorites},
+			TopFavorites: []*entity.Favorite{{ID: 1, Title: "Example", URL: "https://example.com"}},
+		},
+	}
+	dom := &recordingDOM{}
+	app := newBridgeApp(dom, "dumb://homepage", bridge)
+
+	require.NoError(t, app.LoadInitial(context.Background()))
+	assert.True(t, bridge.calledHomepage.Load())
+	assert.False(t, bridge.calledHistory.Load())
+	assert.False(t, bridge.calledFavorites.Load())
+	assert.False(t, bridge.calledKeybindings.Load())
+}
+
+func TestNewBridgeApp_WiresConsoleService(t *testing.T) {
+	t.Parallel()
+
+	bridge := &bridgeServiceRecorder{
+		consoleLogs: []dto.ConsoleLog{{
+			WebViewID: 7,
+			URL:       "https://example.com",
+			Messages:  []entity.ConsoleMessage{{Level: entity.ConsoleLevelWarn, Message: "deprecated API"}},
+		}},
+	}
+	dom := &recordingDOM{}
+	app := newBridgeApp(dom, "dumb://console", bridge)
+
+	require.NoError(t, app.LoadInitial(context.Background()))
+	assert.True(t, bridge.calledConsole.Load())
+	assert.False(t, bridge.calledHistory.Load())
+	assert.False(t, bridge.calledKeybindings.Load())
+}
+
+func TestNewBridgeApp_WiresNavigationTreeService(t *testing.T) {
+	t.Parallel()
+
+	bridge := &bridgeServiceRecorder{
+		navTrees: []dto.NavigationTree{{
+			PaneID:  "pane-1",
+			Current: 1,
+			Nodes:   []entity.NavigationNode{{ID: 1, URL: "https://example.com", Visits: 1}},
+		}},
+	}
+	dom := &recordingDOM{}
+	app := newBridgeApp(dom, "dumb://navtree", bridge)
+
+	require.NoError(t, app.LoadInitial(context.Background()))
+	assert.True(t, bridge.calledNavTree.Load())
+	assert.False(t, bridge.calledHistory.Load())
+	assert.False(t, bridge.calledKeybindings.Load())
+}
+
+func TestNewBridgeApp_WiresNotesService(t *testing.T) {
+	t.Parallel()
+
+	bridge := &bridgeServiceRecorder{notes: []*entity.Note{{ID: 1, Body: "todo"}}}
+	dom := &recordingDOM{}
+	app := newBridgeApp(dom, "dumb://notes", bridge)
+
+	require.NoError(t, app.LoadInitial(context.Background()))
+	assert.True(t, bridge.calledNotes.Load())
+	assert.False(t, bridge.calledHistory.Load())
+	assert.False(t, bridge.calledKeybindings.Load())
+}
+
 type recordingDOM struct {
 	html   string
 	mounts chan string
@@ -137,12 +207,20 @@ type bridgeServiceRecorder struct {
 	calledFavorites   atomic.Bool
 	calledConfig      atomic.Bool
 	calledKeybindings atomic.Bool
+	calledHomepage    atomic.Bool
+	calledConsole     atomic.Bool
+	calledNavTree     atomic.Bool
+	calledNotes       atomic.Bool
 
 	historyEntries []*entity.HistoryEntry
 	favorites      []*entity.Favorite
 	tags           []*entity.Tag
 	currentConfig  dto.SystemviewConfigPayload
 	keybindings    port.KeybindingsConfig
+	dashboard      dto.HomepageDashboard
+	consoleLogs    []dto.ConsoleLog
+	navTrees       []dto.NavigationTree
+	notes          []*entity.Note
 }
 
 func (f *bridgeServiceRecorder) Timeline(context.Context, int, int) ([]*entity.HistoryEntry, error) {
@@ -180,6 +258,14 @@ func (*bridgeServiceRecorder) DomainStats(context.Context, int) ([]*entity.Domai
 	return nil, nil
 }
 
+func (*bridgeServiceRecorder) GroupByDomain(context.Context, int, int) ([]*entity.HistoryGroup, error) {
+	return nil, nil
+}
+
+func (*bridgeServiceRecorder) GroupByDay(context.Context, int, int) ([]*entity.HistoryGroup, error) {
+	return nil, nil
+}
+
 func (*bridgeServiceRecorder) DeleteDomain(context.Context, string) error { return nil }
 
 func (f *bridgeServiceRecorder) List(context.Context) ([]*entity.Favorite, error) {
@@ -240,3 +326,35 @@ func (*bridgeServiceRecorder) ResetKeybinding(context.Context, port.ResetKeybind
 }
 
 func (*bridgeServiceRecorder) ResetAllKeybindings(context.Context) error { return nil }
+
+func (f *bridgeServiceRecorder) Dashboard(context.Context) (dto.HomepageDashboard, error) {
+	f.calledHomepage.Store(true)
+	return f.dashboard, nil
+}
+
+func (f *bridgeServiceRecorder) ConsoleLogs(context.Context) ([]dto.ConsoleLog, error) {
+	f.calledConsole.Store(true)
+	return f.consoleLogs, nil
+}
+
+func (*bridgeServiceRecorder) ClearConsole(context.Context, uint64) error { return nil }
+
+func (f *bridgeServiceRecorder) NavigationTrees(context.Context) ([]dto.NavigationTree, error) {
+	f.calledNavTree.Store(true)
+	return f.navTrees, nil
+}
+
+func (f *bridgeServiceRecorder) Notes(context.Context) ([]*entity.Note, error) {
+	f.calledNotes.Store(true)
+	return f.notes, nil
+}
+
+func (*bridgeServiceRecorder) CreateNote(context.Context) (*entity.Note, error) {
+	return &entity.Note{}, nil
+}
+
+func (*bridgeServiceRecorder) SaveNote(context.Context, int64, string) (*entity.Note, error) {
+	return &entity.Note{}, nil
+}
+
+func (*bridgeServiceRecorder) DeleteNote(context.Context, int64) error { return nil }
@@ -46,6 +46,9 @@ type NewBrowsingContextRequest struct {
 	SourceBrowserID int32
 	SourceFrameID   string
 	SourceFrameURL  string
+	// OpenerURI is the top-level page of the opener, which per-domain popup
+	// rules are matched on.
+	OpenerURI string
 
 	TargetURI         string
 	TargetFrameName   string
@@ -80,5 +83,8 @@ type HostDecision struct {
 
 	RequestContextDisposition RequestContextDisposition
 	RequiresNativeOpener      bool
-	Reason                    string
+	// Suppressed marks a deny caused by the opener's domain rules rather
+	// than by a malformed request.
+	Suppressed bool
+	Reason     string
 }
@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// ConsoleLog is the captured console output of one WebView, as listed on
+// dumb://console. URL is the page that logged most recently; Dropped counts
+// messages evicted because the buffer was full.
+type ConsoleLog struct {
+	WebViewID  uint64                  `json:"webview_id"`
+	URL        string                  `json:"url,omitempty"`
+	Dropped    int                     `json:"dropped,omitempty"`
+	LastLogged time.Time               `json:"last_logged"`
+	Messages   []entity.ConsoleMessage `json:"messages"`
+}
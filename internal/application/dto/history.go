@@ -6,6 +6,8 @@ import "github.com/bnema/dumber/internal/domain/entity"
 type HistorySearchInput struct {
 	Query string
 	Limit int
+	// MatchMode filters and ranks the results; empty means substring.
+	MatchMode entity.OmniboxMatchMode
 }
 
 // HistorySearchOutput holds search results for history search use cases.
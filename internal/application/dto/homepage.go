@@ -0,0 +1,29 @@
+package dto
+
+import "github.com/bnema/dumber/internal/domain/entity"
+
+// HomepageDashboardSettings selects what goes into a HomepageDashboard.
+type HomepageDashboardSettings struct {
+	Homepage  entity.HomepageConfig
+	SearchURL string
+}
+
+// HomepageDashboard is the data payload behind the dumb://homepage dashboard.
+// Widgets lists the enabled widgets in display order; list data is only
+// filled for widgets that are enabled.
+type HomepageDashboard struct {
+	Widgets       []entity.HomepageWidget `json:"widgets"`
+	SearchURL     string                  `json:"search_url,omitempty"`
+	RecentHistory []*entity.HistoryEntry  `json:"recent_history,omitempty"`
+	TopFavorites  []*entity.Favorite      `json:"top_favorites,omitempty"`
+}
+
+// HasWidget reports whether widget is enabled on the dashboard.
+func (d HomepageDashboard) HasWidget(widget entity.HomepageWidget) bool {
+	for _, enabled := range d.Widgets {
+		if enabled == widget {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// NavigationTree is the navigation tree of one pane, as shown on
+// dumb://navtree. Current is the ID of the node the pane is on. Closed marks
+// panes closed since; Restored marks trees saved by an earlier session.
+type NavigationTree struct {
+	PaneID    string                  `json:"pane_id"`
+	Current   int                     `json:"current,omitempty"`
+	Closed    bool                    `json:"closed,omitempty"`
+	Restored  bool                    `json:"restored,omitempty"`
+	LastVisit time.Time               `json:"last_visit"`
+	Nodes     []entity.NavigationNode `json:"nodes"`
+}
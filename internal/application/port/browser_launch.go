@@ -3,6 +3,8 @@ package port
 import (
 	"context"
 	"io"
+
+	"github.com/bnema/dumber/internal/domain/entity"
 )
 
 // BrowserWindowOpener opens a fresh browser window.
@@ -10,6 +12,28 @@ type BrowserWindowOpener interface {
 	OpenFreshWindow(ctx context.Context, url string) error
 }
 
+// ActivePaneZoomController reads and sets the zoom of the focused pane.
+// Browser window openers may implement it to serve get_zoom/set_zoom
+// commands received over the browser launch socket.
+type ActivePaneZoomController interface {
+	GetActivePaneZoom(ctx context.Context) (float64, error)
+	// SetActivePaneZoom applies level (clamped to the valid range) and returns
+	// the zoom factor actually applied.
+	SetActivePaneZoom(ctx context.Context, level float64) (float64, error)
+}
+
+// PageSaveController saves a URL to disk from the running browser.
+// Browser window openers may implement it to serve save_page commands
+// received over the browser launch socket.
+type PageSaveController interface {
+	SavePageURL(ctx context.Context, url, path string, format entity.PageSaveFormat) error
+}
+
+// PageSaveRequester asks a running browser to save a URL to disk.
+type PageSaveRequester interface {
+	RequestSavePage(ctx context.Context, url, path string, format entity.PageSaveFormat) error
+}
+
 // BrowserLaunchRelay delivers fresh-window launch requests.
 type BrowserLaunchRelay interface {
 	// DeliverOpenFreshWindow attempts to deliver a request to open a fresh window.
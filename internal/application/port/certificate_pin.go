@@ -0,0 +1,30 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// CertificatePinRepository defines operations for certificate pin persistence.
+type CertificatePinRepository interface {
+	// Get returns the pin for host, or nil when the host is not pinned.
+	Get(ctx context.Context, host string) (*entity.CertificatePin, error)
+
+	// Set saves or replaces the pin for pin.Host.
+	Set(ctx context.Context, pin *entity.CertificatePin) error
+
+	// Delete removes the pin for host.
+	Delete(ctx context.Context, host string) error
+
+	// List returns every pin ordered by host.
+	List(ctx context.Context) ([]*entity.CertificatePin, error)
+}
+
+// CertificateProbe connects to a host and reports the public key hash of the
+// certificate it currently serves.
+type CertificateProbe interface {
+	// FetchSPKISHA256 returns the base64 SHA-256 of the leaf certificate's
+	// SubjectPublicKeyInfo. hostPort may omit the port (443 is assumed).
+	FetchSPKISHA256(ctx context.Context, hostPort string) (string, error)
+}
@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// ConsoleCapture stores page console output per WebView for dumb://console.
+type ConsoleCapture interface {
+	// Record appends msg to the buffer of the WebView that logged it.
+	Record(ctx context.Context, viewID WebViewID, msg entity.ConsoleMessage)
+	// Logs returns every captured buffer, most recently active first.
+	Logs(ctx context.Context) []dto.ConsoleLog
+	// Clear drops the buffer of one WebView, or of all WebViews when viewID is 0.
+	Clear(ctx context.Context, viewID WebViewID)
+}
@@ -15,6 +15,10 @@ const (
 	MenuActionSaveImage      MenuAction = "save_image"
 	MenuActionInspectElement MenuAction = "inspect_element"
 	MenuActionCopySelection  MenuAction = "copy_selection"
+
+	// MenuActionSearchSelection searches the selected text with the default
+	// search engine.
+	MenuActionSearchSelection MenuAction = "search_selection"
 )
 
 // MenuContext captures the state needed to build and execute a context menu.
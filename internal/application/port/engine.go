@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/domain/entity"
 )
 
@@ -69,6 +70,15 @@ type MemoryPressureApplier interface {
 	ApplyWebProcessSettings(ctx context.Context, cfg *MemoryPressureConfig) (any, error)
 }
 
+// ProxyConfig configures the proxy of the engine's network session.
+type ProxyConfig struct {
+	// URL is the proxy used for every scheme ("socks5://127.0.0.1:1080").
+	URL string
+
+	// NoProxy lists hosts, IP addresses and CIDR ranges that bypass the proxy.
+	NoProxy []string
+}
+
 // EngineOptions configures engine initialization.
 type EngineOptions struct {
 	// DataDir is the directory for persistent data (cookies, storage, etc.).
@@ -81,6 +91,10 @@ type EngineOptions struct {
 	// Empty value means runtime defaults are used.
 	CookiePolicy CookiePolicy
 
+	// Proxy routes network session traffic through a proxy.
+	// nil means the system proxy settings are used.
+	Proxy *ProxyConfig
+
 	// WebProcessMemory configures memory pressure for web processes.
 	// nil means use engine defaults.
 	WebProcessMemory *MemoryPressureConfig
@@ -183,6 +197,22 @@ type HandlerDependencies struct {
 	AutoCopyConfig            AutoCopyConfig
 	ClipboardTextOrchestrator ClipboardTextOrchestrator
 	OnClipboardCopied         func(textLen int)
+	HomepageDashboard         func() dto.HomepageDashboardSettings
+	ConsoleCapture            ConsoleCapture
+	NavigationTrees           NavigationTrees
+	Notes                     Notes
+	// OnTypeToFind receives text typed on a page outside form fields while
+	// type-to-find is enabled.
+	OnTypeToFind func(ctx context.Context, webviewID WebViewID, text string)
+	// OnFormDirty receives a page's report that it has, or no longer has,
+	// form fields edited since it loaded.
+	OnFormDirty func(ctx context.Context, webviewID WebViewID, dirty bool)
+	// OnReadingProgress receives how far a page is scrolled, in percent,
+	// for its pane's reading progress bar.
+	OnReadingProgress func(ctx context.Context, webviewID WebViewID, percent float64)
+	// OnPageLanguage receives the language of a loaded page, for the
+	// translate offer.
+	OnPageLanguage func(ctx context.Context, webviewID WebViewID, lang string)
 	HandlerDeps
 }
 
@@ -212,6 +242,24 @@ type FilterApplier interface {
 	ApplyToAll(ctx context.Context, webviews []WebView)
 }
 
+// FilterBypassApplier is an optional FilterApplier capability that turns
+// content filtering off for a single WebView and back on.
+type FilterBypassApplier interface {
+	// SetFilteringBypassed removes the content filters from webview when
+	// bypassed is true and applies them again otherwise.
+	SetFilteringBypassed(ctx context.Context, webview WebView, bypassed bool)
+}
+
+// CosmeticFilterBypassApplier is an optional FilterApplier capability that
+// keeps the network rules of a single WebView's content filters and drops the
+// rules that hide page elements, and back.
+type CosmeticFilterBypassApplier interface {
+	// SetCosmeticFilteringBypassed drops the cosmetic rules of webview when
+	// bypassed is true and restores them otherwise. A WebView whose filtering
+	// is bypassed entirely stays unfiltered either way.
+	SetCosmeticFilteringBypassed(ctx context.Context, webview WebView, bypassed bool)
+}
+
 // FaviconDatabase defines the port interface for async favicon lookups.
 // Implementations retrieve favicons from an engine-managed database and
 // deliver them via callback on the main thread.
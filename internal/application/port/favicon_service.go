@@ -6,6 +6,9 @@ import "context"
 type FaviconService interface {
 	GetCached(ctx context.Context, domain string) ([]byte, bool)
 	Get(ctx context.Context, domain string) ([]byte, error)
+	// GetOrGenerate returns the cached favicon for the page's domain, or a
+	// generated monogram image when none is cached. It never fetches remotely.
+	GetOrGenerate(ctx context.Context, pageURL string) []byte
 	DiskPathPNG(domain string) string
 	HasPNGOnDisk(domain string) bool
 	HasPNGSizedOnDisk(domain string, size int) bool
@@ -21,6 +21,8 @@ type HomepageHistory interface {
 	GetStats(ctx context.Context) (*entity.HistoryStats, error)
 	GetAnalytics(ctx context.Context) (*entity.HistoryAnalytics, error)
 	GetDomainStats(ctx context.Context, limit int) ([]*entity.DomainStat, error)
+	GroupByDomain(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error)
+	GroupByDay(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error)
 	DeleteByDomain(ctx context.Context, domain string) error
 }
 
@@ -467,6 +467,65 @@ func (_c *MockFaviconService_GetCached_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetOrGenerate provides a mock function for the type MockFaviconService
+func (_mock *MockFaviconService) GetOrGenerate(ctx context.Context, pageURL string) []byte {
+	ret := _mock.Called(ctx, pageURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrGenerate")
+	}
+
+	var r0 []byte
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = returnFunc(ctx, pageURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	return r0
+}
+
+// MockFaviconService_GetOrGenerate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrGenerate'
+type MockFaviconService_GetOrGenerate_Call struct {
+	*mock.Call
+}
+
+// GetOrGenerate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageURL string
+func (_e *MockFaviconService_Expecter) GetOrGenerate(ctx any, pageURL any) *MockFaviconService_GetOrGenerate_Call {
+	return &MockFaviconService_GetOrGenerate_Call{Call: _e.mock.On("GetOrGenerate", ctx, pageURL)}
+}
+
+func (_c *MockFaviconService_GetOrGenerate_Call) Run(run func(ctx context.Context, pageURL string)) *MockFaviconService_GetOrGenerate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFaviconService_GetOrGenerate_Call) Return(bytes []byte) *MockFaviconService_GetOrGenerate_Call {
+	_c.Call.Return(bytes)
+	return _c
+}
+
+func (_c *MockFaviconService_GetOrGenerate_Call) RunAndReturn(run func(ctx context.Context, pageURL string) []byte) *MockFaviconService_GetOrGenerate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasPNGOnDisk provides a mock function for the type MockFaviconService
 func (_mock *MockFaviconService) HasPNGOnDisk(domain string) bool {
 	ret := _mock.Called(domain)
@@ -745,6 +745,154 @@ func (_c *MockHomepageHistory_GetStats_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GroupByDay provides a mock function for the type MockHomepageHistory
+func (_mock *MockHomepageHistory) GroupByDay(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error) {
+	ret := _mock.Called(ctx, limit, perGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupByDay")
+	}
+
+	var r0 []*entity.HistoryGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*entity.HistoryGroup, error)); ok {
+		return returnFunc(ctx, limit, perGroup)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*entity.HistoryGroup); ok {
+		r0 = returnFunc(ctx, limit, perGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.HistoryGroup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, limit, perGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHomepageHistory_GroupByDay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupByDay'
+type MockHomepageHistory_GroupByDay_Call struct {
+	*mock.Call
+}
+
+// GroupByDay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - perGroup int
+func (_e *MockHomepageHistory_Expecter) GroupByDay(ctx any, limit any, perGroup any) *MockHomepageHistory_GroupByDay_Call {
+	return &MockHomepageHistory_GroupByDay_Call{Call: _e.mock.On("GroupByDay", ctx, limit, perGroup)}
+}
+
+func (_c *MockHomepageHistory_GroupByDay_Call) Run(run func(ctx context.Context, limit int, perGroup int)) *MockHomepageHistory_GroupByDay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHomepageHistory_GroupByDay_Call) Return(historyGroups []*entity.HistoryGroup, err error) *MockHomepageHistory_GroupByDay_Call {
+	_c.Call.Return(historyGroups, err)
+	return _c
+}
+
+func (_c *MockHomepageHistory_GroupByDay_Call) RunAndReturn(run func(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error)) *MockHomepageHistory_GroupByDay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupByDomain provides a mock function for the type MockHomepageHistory
+func (_mock *MockHomepageHistory) GroupByDomain(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error) {
+	ret := _mock.Called(ctx, limit, perGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupByDomain")
+	}
+
+	var r0 []*entity.HistoryGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*entity.HistoryGroup, error)); ok {
+		return returnFunc(ctx, limit, perGroup)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*entity.HistoryGroup); ok {
+		r0 = returnFunc(ctx, limit, perGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.HistoryGroup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, limit, perGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHomepageHistory_GroupByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupByDomain'
+type MockHomepageHistory_GroupByDomain_Call struct {
+	*mock.Call
+}
+
+// GroupByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - perGroup int
+func (_e *MockHomepageHistory_Expecter) GroupByDomain(ctx any, limit any, perGroup any) *MockHomepageHistory_GroupByDomain_Call {
+	return &MockHomepageHistory_GroupByDomain_Call{Call: _e.mock.On("GroupByDomain", ctx, limit, perGroup)}
+}
+
+func (_c *MockHomepageHistory_GroupByDomain_Call) Run(run func(ctx context.Context, limit int, perGroup int)) *MockHomepageHistory_GroupByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHomepageHistory_GroupByDomain_Call) Return(historyGroups []*entity.HistoryGroup, err error) *MockHomepageHistory_GroupByDomain_Call {
+	_c.Call.Return(historyGroups, err)
+	return _c
+}
+
+func (_c *MockHomepageHistory_GroupByDomain_Call) RunAndReturn(run func(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error)) *MockHomepageHistory_GroupByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function for the type MockHomepageHistory
 func (_mock *MockHomepageHistory) Search(ctx context.Context, input dto.HistorySearchInput) (*dto.HistorySearchOutput, error) {
 	ret := _mock.Called(ctx, input)
@@ -0,0 +1,21 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/dto"
+)
+
+// NavigationTrees exposes the per-pane navigation trees for dumb://navtree.
+type NavigationTrees interface {
+	// Trees returns every recorded tree, most recently active first.
+	Trees(ctx context.Context) []dto.NavigationTree
+}
+
+// NavigationTreeStore persists navigation trees across restarts.
+type NavigationTreeStore interface {
+	// Load returns the saved trees, or none when nothing was saved.
+	Load(ctx context.Context) ([]dto.NavigationTree, error)
+	// Save replaces the saved trees. Saving no trees removes the saved data.
+	Save(ctx context.Context, trees []dto.NavigationTree) error
+}
@@ -0,0 +1,34 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// NoteRepository defines operations for dumb://notes persistence.
+type NoteRepository interface {
+	// Create saves note and sets its ID.
+	Create(ctx context.Context, note *entity.Note) error
+
+	// Get returns the note with id, or nil when there is none.
+	Get(ctx context.Context, id int64) (*entity.Note, error)
+
+	// Update replaces the body and update time of an existing note. It
+	// reports false when the note does not exist.
+	Update(ctx context.Context, note *entity.Note) (bool, error)
+
+	// Delete removes the note with id.
+	Delete(ctx context.Context, id int64) error
+
+	// List returns every note, most recently updated first.
+	List(ctx context.Context) ([]*entity.Note, error)
+}
+
+// Notes exposes the dumb://notes scratchpad to its message handlers.
+type Notes interface {
+	List(ctx context.Context) ([]*entity.Note, error)
+	Create(ctx context.Context) (*entity.Note, error)
+	Save(ctx context.Context, id int64, body string) (*entity.Note, error)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// RequestMockSource loads the named sets of mock responses panes can be
+// switched to.
+type RequestMockSource interface {
+	// LoadRequestMocks reads the sets afresh, so edits apply to the next
+	// pane switched to a set. A missing file yields no sets.
+	LoadRequestMocks(ctx context.Context) ([]entity.RequestMockSet, error)
+}
@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// SiteColorSchemeRepository defines operations for per-domain color scheme
+// override persistence.
+type SiteColorSchemeRepository interface {
+	// Get returns the override stored for domain, or nil when there is none.
+	Get(ctx context.Context, domain string) (*entity.SiteColorScheme, error)
+
+	// Set saves or replaces the override for site.Domain.
+	Set(ctx context.Context, site *entity.SiteColorScheme) error
+
+	// Delete removes the override for domain.
+	Delete(ctx context.Context, domain string) error
+}
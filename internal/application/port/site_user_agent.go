@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// SiteUserAgentRepository defines operations for per-domain user agent
+// preset persistence.
+type SiteUserAgentRepository interface {
+	// Get returns the preset stored for domain, or nil when there is none.
+	Get(ctx context.Context, domain string) (*entity.SiteUserAgent, error)
+
+	// Set saves or replaces the preset for site.Domain.
+	Set(ctx context.Context, site *entity.SiteUserAgent) error
+
+	// Delete removes the preset for domain.
+	Delete(ctx context.Context, domain string) error
+}
@@ -0,0 +1,29 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/readaloud"
+)
+
+// SpeechPlayer reads text aloud through an external text-to-speech command
+// and tracks the reading so it can be paused, resumed and stopped.
+type SpeechPlayer interface {
+	// Play stops the current reading and speaks chunks in order, running
+	// argv once per chunk with the chunk on its stdin. argv is run directly,
+	// never through a shell. It returns once the reading has started.
+	Play(ctx context.Context, argv []string, chunks []string) error
+
+	// Pause suspends the reading; it reports false when nothing is playing.
+	Pause() bool
+
+	// Resume continues a paused reading; it reports false when nothing is
+	// paused.
+	Resume() bool
+
+	// Stop ends the reading, if any.
+	Stop()
+
+	// State returns where the reading stands.
+	State() readaloud.State
+}
@@ -19,6 +19,8 @@ type SystemviewHistoryService interface {
 	Stats(ctx context.Context) (*entity.HistoryStats, error)
 	Analytics(ctx context.Context) (*entity.HistoryAnalytics, error)
 	DomainStats(ctx context.Context, limit int) ([]*entity.DomainStat, error)
+	GroupByDomain(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error)
+	GroupByDay(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error)
 	DeleteDomain(ctx context.Context, domain string) error
 }
 
@@ -47,3 +49,27 @@ type SystemviewConfigService interface {
 	ResetKeybinding(ctx context.Context, req ResetKeybindingRequest) error
 	ResetAllKeybindings(ctx context.Context) error
 }
+
+// SystemviewHomepageService exposes the dashboard data for the systemviews homepage route.
+type SystemviewHomepageService interface {
+	Dashboard(ctx context.Context) (dto.HomepageDashboard, error)
+}
+
+// SystemviewConsoleService exposes captured page console output for the systemviews console route.
+type SystemviewConsoleService interface {
+	ConsoleLogs(ctx context.Context) ([]dto.ConsoleLog, error)
+	ClearConsole(ctx context.Context, webViewID uint64) error
+}
+
+// SystemviewNavigationTreeService exposes per-pane navigation trees for the systemviews navtree route.
+type SystemviewNavigationTreeService interface {
+	NavigationTrees(ctx context.Context) ([]dto.NavigationTree, error)
+}
+
+// SystemviewNotesService exposes the quick notes for the systemviews notes route.
+type SystemviewNotesService interface {
+	Notes(ctx context.Context) ([]*entity.Note, error)
+	CreateNote(ctx context.Context) (*entity.Note, error)
+	SaveNote(ctx context.Context, id int64, body string) (*entity.Note, error)
+	DeleteNote(ctx context.Context, id int64) error
+}
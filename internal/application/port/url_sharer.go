@@ -0,0 +1,21 @@
+package port
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrShareUnavailable reports that no desktop share mechanism is available.
+var ErrShareUnavailable = errors.New("share portal unavailable")
+
+// ErrShareCancelled reports that the user closed the chooser without picking
+// an application.
+var ErrShareCancelled = errors.New("share cancelled")
+
+// URLSharer hands a page off to another application chosen by the user.
+type URLSharer interface {
+	// ShareURL offers uri, titled title, to other applications. It returns
+	// once the user has picked an application, and ErrShareCancelled when
+	// they dismiss the chooser instead.
+	ShareURL(ctx context.Context, uri, title string) error
+}
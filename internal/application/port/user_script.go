@@ -0,0 +1,39 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// UserScriptSource loads user scripts from the user scripts directory and
+// watches it for changes.
+type UserScriptSource interface {
+	// Load (re)reads every script in the directory. Scripts that fail to
+	// parse are skipped; their errors are joined into the returned error.
+	Load(ctx context.Context) error
+	// Scripts returns the scripts from the last successful Load.
+	Scripts() []entity.UserScript
+	// Watch reloads the scripts whenever the directory changes and calls
+	// onChange afterwards.
+	Watch(ctx context.Context, onChange func()) error
+	// Stop stops watching. It is safe to call repeatedly.
+	Stop() error
+}
+
+// UserScriptInjector is an optional capability for ContentInjectors that can
+// run user scripts. Callers should type-assert Engine.ContentInjector().
+// Implementations evaluate the scripts' match patterns on every main-frame
+// navigation and inject only the matching ones.
+type UserScriptInjector interface {
+	SetUserScripts(ctx context.Context, scripts []entity.UserScript)
+}
+
+// PageEnvInjector is an optional capability for ContentInjectors that can
+// expose page_env values as window.__dumber_env. Callers should type-assert
+// Engine.ContentInjector(). Implementations define the object at document
+// start in the main frame of pages whose domain is allowed some values, and
+// never on any other page.
+type PageEnvInjector interface {
+	SetPageEnv(ctx context.Context, cfg entity.RuntimePageEnvConfig)
+}
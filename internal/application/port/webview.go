@@ -8,6 +8,7 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/loadretry"
 )
 
 // WebViewID uniquely identifies a WebView instance.
@@ -65,6 +66,16 @@ const (
 	WebProcessTerminationByAPI
 )
 
+// LoadFailure describes a main-frame load that failed before showing the page.
+type LoadFailure struct {
+	// URI is the page that failed to load.
+	URI string
+	// Cause is the engine error mapped to an engine-neutral reason.
+	Cause loadretry.Cause
+	// Message is the engine's error text, for logs.
+	Message string
+}
+
 // PopupRequest contains metadata about a popup window request.
 type PopupRequest struct {
 	TargetURI          string
@@ -81,6 +92,20 @@ type PopupRequest struct {
 	WindowFeatures    string
 }
 
+// ScriptDialogRequest is a JavaScript dialog (alert, confirm, prompt or
+// beforeunload) waiting for an answer.
+type ScriptDialogRequest struct {
+	Type    entity.ScriptDialogType
+	Message string
+	// DefaultText pre-fills the entry of a prompt.
+	DefaultText string
+	// URI is the page that opened the dialog.
+	URI string
+	// Respond answers the dialog and lets the page continue. Only the first
+	// call has an effect.
+	Respond func(response entity.ScriptDialogResponse)
+}
+
 // Texture represents a graphics texture returned by the engine.
 // GoPointer returns a native toolkit pointer (e.g. *gdk.Texture in GTK engines).
 // Engine implementations are responsible for the concrete type.
@@ -116,6 +141,19 @@ type WebViewCallbacks struct {
 	OnLinkHover func(uri string)
 	// OnWebProcessTerminated is called when the web process exits unexpectedly.
 	OnWebProcessTerminated func(reason WebProcessTerminationReason, reasonLabel string, uri string)
+	// OnLoadFailed is called when a main-frame load fails, TLS errors aside.
+	// It runs before the LoadFinished event of that load.
+	OnLoadFailed func(failure LoadFailure)
+	// OnCertificateCheck is called with the base64 SHA-256 of the SPKI of the
+	// certificate a main-frame https load presented (empty when none could be
+	// read), before the load is reported as committed and, on WebKit, when it
+	// fails with TLS errors. Return true to block the load; the engine then reports
+	// neither the commit nor the failure.
+	OnCertificateCheck func(uri, spkiSHA256 string) bool
+	// OnNavigationRequest is called with the URL of a main-frame navigation,
+	// redirects included, before its request is sent. Return true to cancel
+	// the navigation.
+	OnNavigationRequest func(uri string) bool
 
 	// OnPermissionRequest is called when a site requests permission (mic, camera, screen sharing).
 	// Return true to indicate the request was handled. Call allow()/deny() to respond.
@@ -124,10 +162,19 @@ type WebViewCallbacks struct {
 	// entity.PermissionMetadataKeyRequestingDomain and entity.PermissionMetadataKeyCurrentDomain must be populated.
 	OnPermissionRequest func(origin string, permTypes []string, metadata map[string]string, allow, deny func()) bool
 
+	// OnScriptDialog is called when the page opens a JavaScript dialog.
+	// Return true to handle it and answer later through request.Respond;
+	// false leaves the dialog to the engine's default handler.
+	OnScriptDialog func(request ScriptDialogRequest) bool
+
 	// OnLinkMiddleClick is called when a link is middle-clicked.
 	// Return true if handled (blocks default navigation).
 	OnLinkMiddleClick func(uri string) bool
 
+	// OnSearchSelection is called with the selected page text when the user
+	// picks "Search for Selection" in the context menu.
+	OnSearchSelection func(text string)
+
 	// OnEnterFullscreen is called when the WebView requests fullscreen mode.
 	// Return true to prevent fullscreen.
 	OnEnterFullscreen func() bool
@@ -330,6 +377,150 @@ type Printer interface {
 	PrintPage()
 }
 
+// AutoplayPolicyCapable is an optional capability for WebViews that can
+// enforce a per-page autoplay policy (resolved per domain on navigation).
+type AutoplayPolicyCapable interface {
+	ApplyAutoplayPolicy(ctx context.Context, policy entity.AutoplayPolicy)
+}
+
+// ForceDarkCapable is an optional capability for WebViews that can darken
+// pages that ignore the system color scheme.
+type ForceDarkCapable interface {
+	ApplyForceDark(ctx context.Context, style entity.ForceDarkStyle, enabled bool)
+}
+
+// ColorSchemeCapable is an optional capability for WebViews that can report
+// a light or dark color scheme to pages regardless of the system one.
+type ColorSchemeCapable interface {
+	// ApplyColorScheme switches the loaded page and the pages loaded after it
+	// to scheme; entity.ColorSchemeSystem follows the system again.
+	ApplyColorScheme(ctx context.Context, scheme entity.ColorScheme)
+}
+
+// CookiePolicyCapable is an optional capability for WebViews whose engine can
+// switch the cookie policy of their network session at runtime. The policy is
+// session-wide: it applies to every WebView sharing the session.
+type CookiePolicyCapable interface {
+	// ApplyCookiePolicy sets the session cookie policy and reports whether it
+	// differed from the policy in effect.
+	ApplyCookiePolicy(ctx context.Context, policy entity.CookiePolicy) (changed bool)
+}
+
+// SiteDataClearCapable is an optional capability for WebViews whose engine
+// can remove the stored website data of one origin from their network
+// session: cookies, storage, service workers and cache. Engines that group
+// data per site remove the whole site's data.
+type SiteDataClearCapable interface {
+	// ClearSiteData starts removing the data of origin ("https://host[:port]").
+	// It returns at once; removal finishes in the background and must be
+	// started before the WebView is destroyed.
+	ClearSiteData(ctx context.Context, origin string)
+}
+
+// UserAgentCapable is an optional capability for WebViews that can change
+// the user agent they send. An empty userAgent restores the engine default.
+type UserAgentCapable interface {
+	// ApplyUserAgent sets the user agent for the WebView's next requests and
+	// reports whether it differed from the one in effect.
+	ApplyUserAgent(ctx context.Context, userAgent string) (changed bool)
+}
+
+// FontOverrideCapable is an optional capability for WebViews that can use
+// other fonts than the configured ones. A fonts value that keeps every
+// default restores the configured fonts.
+type FontOverrideCapable interface {
+	// ApplyFontOverride sets the fonts of the loaded and next pages and
+	// reports whether they differed from the ones in effect.
+	ApplyFontOverride(ctx context.Context, fonts entity.FontOverride) (changed bool)
+}
+
+// NavigationGesturesCapable is an optional capability for WebViews whose
+// swipe back/forward gestures can be turned off.
+type NavigationGesturesCapable interface {
+	// SetNavigationGesturesEnabled turns the gestures on or off and reports
+	// whether that changed their state.
+	SetNavigationGesturesEnabled(ctx context.Context, enabled bool) (changed bool)
+}
+
+// HoveredLinkProvider is an optional capability for WebViews that track the
+// link under the pointer, as found by the engine's hit test.
+type HoveredLinkProvider interface {
+	// HoveredLinkURI returns the target of the hovered link, or "" when the
+	// pointer is not over a link.
+	HoveredLinkURI() string
+}
+
+// ExtraHeadersCapable is an optional capability for WebViews that can add
+// custom HTTP headers to their outgoing requests. An empty map clears them.
+type ExtraHeadersCapable interface {
+	SetExtraHeaders(headers map[string]string, scope entity.RequestHeaderScope)
+}
+
+// AudioMuteCapable is an optional capability for WebViews that can silence
+// their audio output without pausing playback.
+type AudioMuteCapable interface {
+	SetAudioMuted(muted bool)
+	IsAudioMuted() bool
+}
+
+// RenderingFreezeCapable is an optional capability for WebViews that can
+// pause the rendering work of a page while it is in the background. A frozen
+// page keeps its state; animation-frame callbacks held back while frozen run
+// once it thaws. It must be called on the main thread.
+type RenderingFreezeCapable interface {
+	SetRenderingFrozen(ctx context.Context, frozen bool)
+	IsRenderingFrozen() bool
+}
+
+// PageSaver is an optional capability for WebViews that can write the
+// loaded page to disk. SavePage must be called on the main thread; it returns
+// immediately and the channel receives exactly one value (nil on success)
+// once the file has been written.
+type PageSaver interface {
+	SavePage(ctx context.Context, path string, format entity.PageSaveFormat) <-chan error
+}
+
+// ScrollPositionReader is an optional capability for WebViews that can
+// measure the main frame's scroll offset. It must be called on the main
+// thread; fn runs there later with the URL of the measured document, which
+// is still the old page while a navigation is starting. fn is not called when
+// the page can't be measured.
+type ScrollPositionReader interface {
+	ReadScrollPosition(ctx context.Context, fn func(pageURL string, pos entity.ScrollPosition))
+}
+
+// ReadableTextReader is an optional capability for WebViews that can extract
+// the readable text of the main frame, as selected by
+// readaloud.ExtractScript. It must be called on the main thread; fn runs
+// there later, and is not called when the text can't be read.
+type ReadableTextReader interface {
+	ReadReadableText(ctx context.Context, fn func(text string))
+}
+
+// SelectionTextReader is an optional capability for WebViews that can read
+// the text selected in the page. It must be called on the main thread; fn runs
+// there, possibly later, with an empty string when nothing is selected, and is
+// not called when the selection can't be read.
+type SelectionTextReader interface {
+	ReadSelectionText(ctx context.Context, fn func(text string))
+}
+
+// RequestMockCapable is an optional capability for WebViews that can answer
+// page requests with canned responses. It must be called on the main thread.
+type RequestMockCapable interface {
+	// SetRequestMocks answers the fetch and XMLHttpRequest requests of the
+	// loaded page and the pages loaded after it with mocks, the first
+	// matching one winning. Empty mocks send every request to the network
+	// again.
+	SetRequestMocks(ctx context.Context, mocks []entity.RequestMock)
+}
+
+// CacheBypassLoader is an optional capability for WebViews that can load a
+// URI while skipping the HTTP cache for the main document.
+type CacheBypassLoader interface {
+	LoadURIBypassCache(ctx context.Context, uri string) error
+}
+
 // PopupLifecycleCapable is implemented by WebViews that support the full popup
 // pane lifecycle. SetOnClose composes the provided function with any existing
 // close handler so multiple callers can register close hooks without
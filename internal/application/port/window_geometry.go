@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// WindowGeometryStore persists the browser window geometry across restarts.
+type WindowGeometryStore interface {
+	// Load returns the last saved geometry. ok is false when none was saved;
+	// an unreadable or corrupt file is reported as an error.
+	Load(ctx context.Context) (geometry entity.WindowGeometry, ok bool, err error)
+	// Save replaces the saved geometry.
+	Save(ctx context.Context, geometry entity.WindowGeometry) error
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// ShouldAutofocusFirstField reports whether the first text field of the page
+// at uri is focused once it has loaded: either every page is autofocused, or
+// uri matches input.autofocus_domains.
+func ShouldAutofocusFirstField(cfg entity.RuntimeInputConfig, uri string) bool {
+	if uri == "" {
+		return false
+	}
+	if cfg.AutofocusFirstField {
+		return true
+	}
+	if len(cfg.AutofocusDomains) == 0 {
+		return false
+	}
+	return urlutil.MatchAnyDomainPattern(cfg.AutofocusDomains, uri)
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestShouldAutofocusFirstField(t *testing.T) {
+	perDomain := entity.RuntimeInputConfig{AutofocusDomains: []string{"duckduckgo.com", "*.wikipedia.org"}}
+
+	tests := []struct {
+		name string
+		cfg  entity.RuntimeInputConfig
+		uri  string
+		want bool
+	}{
+		{name: "off by default", uri: "https://example.com/"},
+		{name: "every page", cfg: entity.RuntimeInputConfig{AutofocusFirstField: true}, uri: "https://example.com/", want: true},
+		{name: "no page loaded", cfg: entity.RuntimeInputConfig{AutofocusFirstField: true}},
+		{name: "exact domain", cfg: perDomain, uri: "https://duckduckgo.com/?q=go", want: true},
+		{name: "subdomain glob", cfg: perDomain, uri: "https://en.wikipedia.org/wiki/Go", want: true},
+		{name: "other domain", cfg: perDomain, uri: "https://example.com/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ShouldAutofocusFirstField(tt.cfg, tt.uri))
+		})
+	}
+}
@@ -22,7 +22,7 @@ func (*BuildContextMenuUseCase) Build(_ context.Context, menuContext port.MenuCo
 		return nil
 	}
 
-	items := make([]port.MenuItem, 0, 9)
+	items := make([]port.MenuItem, 0, 10)
 
 	if menuContext.CanGoBack {
 		items = append(items, port.MenuItem{Action: port.MenuActionBack, Label: "Back"})
@@ -48,7 +48,10 @@ func (*BuildContextMenuUseCase) Build(_ context.Context, menuContext port.MenuCo
 	}
 
 	if menuContext.HasSelection {
-		items = append(items, port.MenuItem{Action: port.MenuActionCopySelection, Label: "Copy Selection"})
+		items = append(items,
+			port.MenuItem{Action: port.MenuActionCopySelection, Label: "Copy Selection"},
+			port.MenuItem{Action: port.MenuActionSearchSelection, Label: "Search for Selection"},
+		)
 	}
 
 	items = append(items, port.MenuItem{Action: port.MenuActionInspectElement, Label: "Inspect Element"})
@@ -32,6 +32,7 @@ func TestBuildContextMenuUseCase_Build(t *testing.T) {
 			expected: []port.MenuAction{
 				port.MenuActionReload,
 				port.MenuActionCopySelection,
+				port.MenuActionSearchSelection,
 				port.MenuActionInspectElement,
 			},
 		},
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/console"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+const (
+	// maxConsoleViews bounds how many WebViews keep a console buffer. The
+	// least recently active buffer is evicted first, so closed panes stay
+	// inspectable for a while without growing without bound.
+	maxConsoleViews = 32
+	// maxConsoleMessageRunes truncates single messages; pages occasionally
+	// log whole documents.
+	maxConsoleMessageRunes = 4000
+)
+
+type consoleView struct {
+	ring       *console.Ring
+	url        string
+	lastLogged time.Time
+}
+
+// CaptureConsoleUseCase keeps the recent console output of each WebView in a
+// ring buffer. The buffer size is read on every call so config changes apply
+// without a restart; a size of 0 disables capture.
+type CaptureConsoleUseCase struct {
+	mu       sync.Mutex
+	views    map[port.WebViewID]*consoleView
+	capacity func() int
+	now      func() time.Time
+}
+
+var _ port.ConsoleCapture = (*CaptureConsoleUseCase)(nil)
+
+// NewCaptureConsoleUseCase creates a console capture use case. capacity
+// returns the per-WebView buffer size.
+func NewCaptureConsoleUseCase(capacity func() int) *CaptureConsoleUseCase {
+	return &CaptureConsoleUseCase{
+		views:    make(map[port.WebViewID]*consoleView),
+		capacity: capacity,
+		now:      time.Now,
+	}
+}
+
+func (uc *CaptureConsoleUseCase) bufferSize() int {
+	if uc.capacity == nil {
+		return 0
+	}
+	return max(uc.capacity(), 0)
+}
+
+// Record appends msg to the buffer of viewID. Messages without a timestamp
+// are stamped with the current time.
+func (uc *CaptureConsoleUseCase) Record(_ context.Context, viewID port.WebViewID, msg entity.ConsoleMessage) {
+	size := uc.bufferSize()
+	if viewID == 0 || size == 0 {
+		return
+	}
+	if msg.Timestamp.IsZero() {
+		msg.Timestamp = uc.now()
+	}
+	msg.Message = truncateConsoleMessage(msg.Message, maxConsoleMessageRunes)
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	view, ok := uc.views[viewID]
+	if !ok {
+		uc.evictOldestLocked()
+		view = &consoleView{ring: console.NewRing(size)}
+		uc.views[viewID] = view
+	}
+	view.ring.Resize(size)
+	view.ring.Push(msg)
+	if msg.URL != "" {
+		view.url = msg.URL
+	}
+	view.lastLogged = msg.Timestamp
+}
+
+func (uc *CaptureConsoleUseCase) evictOldestLocked() {
+	if len(uc.views) < maxConsoleViews {
+		return
+	}
+	var oldestID port.WebViewID
+	var oldest time.Time
+	for id, view := range uc.views {
+		if oldestID == 0 || view.lastLogged.Before(oldest) {
+			oldestID, oldest = id, view.lastLogged
+		}
+	}
+	delete(uc.views, oldestID)
+}
+
+// Logs returns a snapshot of every non-empty buffer, most recently active first.
+func (uc *CaptureConsoleUseCase) Logs(_ context.Context) []dto.ConsoleLog {
+	size := uc.bufferSize()
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	logs := make([]dto.ConsoleLog, 0, len(uc.views))
+	for id, view := range uc.views {
+		view.ring.Resize(size)
+		if view.ring.Len() == 0 {
+			continue
+		}
+		logs = append(logs, dto.ConsoleLog{
+			WebViewID:  uint64(id),
+			URL:        view.url,
+			Dropped:    view.ring.Dropped(),
+			LastLogged: view.lastLogged,
+			Messages:   view.ring.Messages(),
+		})
+	}
+	slices.SortFunc(logs, func(a, b dto.ConsoleLog) int {
+		if c := b.LastLogged.Compare(a.LastLogged); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.WebViewID, b.WebViewID)
+	})
+	return logs
+}
+
+// Clear drops the buffer of viewID, or every buffer when viewID is 0.
+func (uc *CaptureConsoleUseCase) Clear(_ context.Context, viewID port.WebViewID) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if viewID == 0 {
+		clear(uc.views)
+		return
+	}
+	delete(uc.views, viewID)
+}
+
+func truncateConsoleMessage(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit]) + "…"
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func newTestConsoleCapture(size *int) *CaptureConsoleUseCase {
+	uc := NewCaptureConsoleUseCase(func() int { return *size })
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	var tick int
+	uc.now = func() time.Time {
+		tick++
+		return base.Add(time.Duration(tick) * time.Second)
+	}
+	return uc
+}
+
+func consoleInfo(text string) entity.ConsoleMessage {
+	return entity.ConsoleMessage{Level: entity.ConsoleLevelInfo, Message: text}
+}
+
+func TestCaptureConsole_CapsEachBuffer(t *testing.T) {
+	size := 2
+	uc := newTestConsoleCapture(&size)
+	ctx := context.Background()
+
+	uc.Record(ctx, 1, consoleInfo("a"))
+	uc.Record(ctx, 1, consoleInfo("b"))
+	uc.Record(ctx, 1, consoleInfo("c"))
+
+	logs := uc.Logs(ctx)
+	require.Len(t, logs, 1)
+	assert.Equal(t, uint64(1), logs[0].WebViewID)
+	assert.Equal(t, 1, logs[0].Dropped)
+	require.Len(t, logs[0].Messages, 2)
+	assert.Equal(t, "b", logs[0].Messages[0].Message)
+	assert.Equal(t, "c", logs[0].Messages[1].Message)
+	assert.False(t, logs[0].Messages[0].Timestamp.IsZero())
+}
+
+func TestCaptureConsole_OrdersByLastActivityAndTracksURL(t *testing.T) {
+	size := 10
+	uc := newTestConsoleCapture(&size)
+	ctx := context.Background()
+
+	uc.Record(ctx, 1, entity.ConsoleMessage{Level: entity.ConsoleLevelWarn, Message: "first", URL: "https://a.example/"})
+	uc.Record(ctx, 2, entity.ConsoleMessage{Level: entity.ConsoleLevelError, Message: "second", URL: "https://b.example/"})
+	uc.Record(ctx, 1, entity.ConsoleMessage{Level: entity.ConsoleLevelInfo, Message: "third", URL: "https://a.example/next"})
+
+	logs := uc.Logs(ctx)
+	require.Len(t, logs, 2)
+	assert.Equal(t, uint64(1), logs[0].WebViewID)
+	assert.Equal(t, "https://a.example/next", logs[0].URL)
+	assert.Equal(t, uint64(2), logs[1].WebViewID)
+}
+
+func TestCaptureConsole_ZeroSizeDisablesCapture(t *testing.T) {
+	size := 0
+	uc := newTestConsoleCapture(&size)
+	ctx := context.Background()
+
+	uc.Record(ctx, 1, consoleInfo("ignored"))
+	assert.Empty(t, uc.Logs(ctx))
+}
+
+func TestCaptureConsole_ShrinkingSizeAppliesToExistingBuffers(t *testing.T) {
+	size := 5
+	uc := newTestConsoleCapture(&size)
+	ctx := context.Background()
+	for _, s := range []string{"a", "b", "c", "d"} {
+		uc.Record(ctx, 7, consoleInfo(s))
+	}
+
+	size = 2
+	logs := uc.Logs(ctx)
+	require.Len(t, logs, 1)
+	require.Len(t, logs[0].Messages, 2)
+	assert.Equal(t, "c", logs[0].Messages[0].Message)
+	assert.Equal(t, 2, logs[0].Dropped)
+}
+
+func TestCaptureConsole_EvictsLeastRecentlyActiveView(t *testing.T) {
+	size := 1
+	uc := newTestConsoleCapture(&size)
+	ctx := context.Background()
+	for id := 1; id <= maxConsoleViews+1; id++ {
+		uc.Record(ctx, port.WebViewID(id), consoleInfo("x"))
+	}
+
+	logs := uc.Logs(ctx)
+	require.Len(t, logs, maxConsoleViews)
+	for _, log := range logs {
+		assert.NotEqual(t, uint64(1), log.WebViewID)
+	}
+}
+
+func TestCaptureConsole_Clear(t *testing.T) {
+	size := 3
+	uc := newTestConsoleCapture(&size)
+	ctx := context.Background()
+	uc.Record(ctx, 1, consoleInfo("a"))
+	uc.Record(ctx, 2, consoleInfo("b"))
+
+	uc.Clear(ctx, 1)
+	logs := uc.Logs(ctx)
+	require.Len(t, logs, 1)
+	assert.Equal(t, uint64(2), logs[0].WebViewID)
+
+	uc.Clear(ctx, 0)
+	assert.Empty(t, uc.Logs(ctx))
+}
+
+func TestCaptureConsole_TruncatesLongMessages(t *testing.T) {
+	size := 1
+	uc := newTestConsoleCapture(&size)
+	ctx := context.Background()
+
+	uc.Record(ctx, 1, consoleInfo(strings.Repeat("é", maxConsoleMessageRunes+10)))
+
+	got := uc.Logs(ctx)[0].Messages[0].Message
+	assert.Equal(t, maxConsoleMessageRunes+1, len([]rune(got)))
+	assert.True(t, strings.HasSuffix(got, "…"))
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ManageCertificatePinsUseCase records per-host certificate pins and checks
+// main-frame loads against them.
+type ManageCertificatePinsUseCase struct {
+	repo  port.CertificatePinRepository
+	probe port.CertificateProbe
+	now   func() time.Time
+
+	// pins is the in-memory copy Check reads, keyed by host, so load checks
+	// never wait on the database. It is nil until the first Refresh.
+	mu         sync.RWMutex
+	pins       map[string]*entity.CertificatePin
+	refreshing atomic.Bool
+}
+
+// NewManageCertificatePinsUseCase creates a certificate pin use case.
+// probe may be nil when only Check is needed (browser process).
+func NewManageCertificatePinsUseCase(
+	repo port.CertificatePinRepository,
+	probe port.CertificateProbe,
+) *ManageCertificatePinsUseCase {
+	return &ManageCertificatePinsUseCase{repo: repo, probe: probe, now: time.Now}
+}
+
+// Pin connects to target, captures the public key hash it currently serves
+// and stores it as the pin for its host, replacing any previous pin.
+// target may be a host, host:port, or https URL.
+func (uc *ManageCertificatePinsUseCase) Pin(ctx context.Context, target string) (*entity.CertificatePin, error) {
+	if uc.probe == nil {
+		return nil, errors.New("certificate probe not configured")
+	}
+	host, err := entity.NormalizeCertificatePinHost(target)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := uc.probe.FetchSPKISHA256(ctx, probeAddress(target, host))
+	if err != nil {
+		return nil, fmt.Errorf("fetch certificate for %s: %w", host, err)
+	}
+
+	pin := &entity.CertificatePin{Host: host, SPKISHA256: hash, CreatedAt: uc.now().Unix()}
+	if err := uc.repo.Set(ctx, pin); err != nil {
+		return nil, fmt.Errorf("save pin for %s: %w", host, err)
+	}
+	uc.mu.Lock()
+	if uc.pins != nil {
+		uc.pins[host] = pin
+	}
+	uc.mu.Unlock()
+	logging.FromContext(ctx).Info().Str("host", host).Msg("certificate pinned")
+	return pin, nil
+}
+
+// Unpin removes the pin for target's host.
+func (uc *ManageCertificatePinsUseCase) Unpin(ctx context.Context, target string) error {
+	host, err := entity.NormalizeCertificatePinHost(target)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.Delete(ctx, host); err != nil {
+		return err
+	}
+	uc.mu.Lock()
+	delete(uc.pins, host)
+	uc.mu.Unlock()
+	return nil
+}
+
+// List returns all stored pins.
+func (uc *ManageCertificatePinsUseCase) List(ctx context.Context) ([]*entity.CertificatePin, error) {
+	return uc.repo.List(ctx)
+}
+
+// Refresh replaces the in-memory pins with the stored ones. Pins are also
+// written by the CLI from another process, so the browser refreshes them
+// whenever a load starts.
+func (uc *ManageCertificatePinsUseCase) Refresh(ctx context.Context) error {
+	stored, err := uc.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("load certificate pins: %w", err)
+	}
+	pins := make(map[string]*entity.CertificatePin, len(stored))
+	for _, pin := range stored {
+		pins[pin.Host] = pin
+	}
+	uc.mu.Lock()
+	uc.pins = pins
+	uc.mu.Unlock()
+	return nil
+}
+
+// RefreshInBackground runs Refresh on its own goroutine, unless a refresh is
+// already running.
+func (uc *ManageCertificatePinsUseCase) RefreshInBackground(ctx context.Context) {
+	if !uc.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer uc.refreshing.Store(false)
+		if err := uc.Refresh(ctx); err != nil {
+			logging.FromContext(ctx).Warn().Err(err).Msg("certificate pin refresh failed")
+		}
+	}()
+}
+
+// Check returns the pin decision for a main-frame load of rawURL that
+// presented presentedHash (empty when no certificate was available). Only
+// https URLs are checked, against the pins loaded by the last Refresh; the
+// first check loads them itself when no refresh has completed yet, so loads
+// restored at startup are not let through unchecked. When the decision is a
+// mismatch the pin is returned so callers can explain the failure.
+func (uc *ManageCertificatePinsUseCase) Check(
+	ctx context.Context,
+	rawURL, presentedHash string,
+) (entity.CertificatePinDecision, *entity.CertificatePin) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || !strings.EqualFold(parsed.Scheme, "https") || parsed.Hostname() == "" {
+		return entity.CertificatePinNone, nil
+	}
+	host, err := entity.NormalizeCertificatePinHost(parsed.Hostname())
+	if err != nil {
+		return entity.CertificatePinNone, nil
+	}
+
+	uc.mu.RLock()
+	loaded := uc.pins != nil
+	pin := uc.pins[host]
+	uc.mu.RUnlock()
+	if !loaded {
+		if err := uc.Refresh(ctx); err != nil {
+			logging.FromContext(ctx).Warn().Err(err).Str("host", host).Msg("certificate pins unavailable for check")
+		}
+		uc.mu.RLock()
+		pin = uc.pins[host]
+		uc.mu.RUnlock()
+	}
+	decision := entity.EvaluateCertificatePin(pin, presentedHash)
+	if decision == entity.CertificatePinMismatch {
+		logging.FromContext(ctx).Warn().
+			Str("host", host).
+			Str("expected", pin.SPKISHA256).
+			Str("presented", presentedHash).
+			Msg("certificate pin mismatch")
+	}
+	return decision, pin
+}
+
+// probeAddress keeps an explicit port from target so pins can be captured
+// from services on non-standard ports.
+func probeAddress(target, host string) string {
+	trimmed := strings.TrimSpace(target)
+	if strings.Contains(trimmed, "://") {
+		if parsed, err := url.Parse(trimmed); err == nil && parsed.Port() != "" {
+			return parsed.Host
+		}
+		return host
+	}
+	if i := strings.IndexAny(trimmed, "/?#"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	if strings.LastIndex(trimmed, ":") > strings.LastIndex(trimmed, "]") && strings.Count(trimmed, ":") == 1 {
+		return trimmed
+	}
+	return host
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type fakeCertificatePinRepo struct {
+	pins map[string]*entity.CertificatePin
+}
+
+func newFakeCertificatePinRepo() *fakeCertificatePinRepo {
+	return &fakeCertificatePinRepo{pins: map[string]*entity.CertificatePin{}}
+}
+
+func (r *fakeCertificatePinRepo) Get(_ context.Context, host string) (*entity.CertificatePin, error) {
+	return r.pins[host], nil
+}
+
+func (r *fakeCertificatePinRepo) Set(_ context.Context, pin *entity.CertificatePin) error {
+	r.pins[pin.Host] = pin
+	return nil
+}
+
+func (r *fakeCertificatePinRepo) Delete(_ context.Context, host string) error {
+	delete(r.pins, host)
+	return nil
+}
+
+func (r *fakeCertificatePinRepo) List(context.Context) ([]*entity.CertificatePin, error) {
+	out := make([]*entity.CertificatePin, 0, len(r.pins))
+	for _, pin := range r.pins {
+		out = append(out, pin)
+	}
+	return out, nil
+}
+
+type fakeCertificateProbe struct {
+	hash    string
+	err     error
+	address string
+}
+
+func (p *fakeCertificateProbe) FetchSPKISHA256(_ context.Context, hostPort string) (string, error) {
+	p.address = hostPort
+	return p.hash, p.err
+}
+
+func TestManageCertificatePinsUseCase_PinCapturesCurrentHash(t *testing.T) {
+	repo := newFakeCertificatePinRepo()
+	probe := &fakeCertificateProbe{hash: "current="}
+	uc := NewManageCertificatePinsUseCase(repo, probe)
+	uc.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	pin, err := uc.Pin(context.Background(), "https://Example.com/login")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.CertificatePin{Host: "example.com", SPKISHA256: "current=", CreatedAt: 1700000000}, pin)
+	assert.Equal(t, "example.com", probe.address)
+	assert.Same(t, pin, repo.pins["example.com"])
+
+	_, err = uc.Pin(context.Background(), "example.com:8443")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com:8443", probe.address)
+}
+
+func TestManageCertificatePinsUseCase_PinProbeFailureStoresNothing(t *testing.T) {
+	repo := newFakeCertificatePinRepo()
+	uc := NewManageCertificatePinsUseCase(repo, &fakeCertificateProbe{err: errors.New("refused")})
+
+	_, err := uc.Pin(context.Background(), "example.com")
+	require.Error(t, err)
+	assert.Empty(t, repo.pins)
+}
+
+func TestManageCertificatePinsUseCase_Check(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeCertificatePinRepo()
+	repo.pins["bank.example"] = &entity.CertificatePin{Host: "bank.example", SPKISHA256: "good="}
+	uc := NewManageCertificatePinsUseCase(repo, nil)
+	require.NoError(t, uc.Refresh(ctx))
+
+	tests := []struct {
+		name      string
+		url       string
+		presented string
+		want      entity.CertificatePinDecision
+	}{
+		{name: "matching key", url: "https://bank.example/account", presented: "good=", want: entity.CertificatePinMatch},
+		{name: "host case and port ignored", url: "https://BANK.example:443/", presented: "good=", want: entity.CertificatePinMatch},
+		{name: "different key hard-fails", url: "https://bank.example/", presented: "evil=", want: entity.CertificatePinMismatch},
+		{name: "missing certificate hard-fails", url: "https://bank.example/", presented: "", want: entity.CertificatePinMismatch},
+		{name: "unpinned host", url: "https://other.example/", presented: "evil=", want: entity.CertificatePinNone},
+		{name: "subdomain is not covered", url: "https://www.bank.example/", presented: "evil=", want: entity.CertificatePinNone},
+		{name: "plain http is not checked", url: "http://bank.example/", presented: "", want: entity.CertificatePinNone},
+		{name: "internal pages are not checked", url: "dumb://home", presented: "", want: entity.CertificatePinNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, pin := uc.Check(ctx, tt.url, tt.presented)
+			assert.Equal(t, tt.want, got)
+			if tt.want == entity.CertificatePinMismatch {
+				require.NotNil(t, pin)
+				assert.Equal(t, "good=", pin.SPKISHA256)
+			}
+		})
+	}
+}
+
+func TestManageCertificatePinsUseCase_Unpin(t *testing.T) {
+	repo := newFakeCertificatePinRepo()
+	repo.pins["example.com"] = &entity.CertificatePin{Host: "example.com", SPKISHA256: "x="}
+	uc := NewManageCertificatePinsUseCase(repo, nil)
+
+	require.NoError(t, uc.Unpin(context.Background(), "EXAMPLE.com"))
+	assert.Empty(t, repo.pins)
+}
+
+func TestManageCertificatePinsUseCase_CheckReadsMemoryUntilRefresh(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeCertificatePinRepo()
+	uc := NewManageCertificatePinsUseCase(repo, &fakeCertificateProbe{hash: "good="})
+
+	repo.pins["bank.example"] = &entity.CertificatePin{Host: "bank.example", SPKISHA256: "good="}
+	got, _ := uc.Check(ctx, "https://bank.example/", "evil=")
+	assert.Equal(t, entity.CertificatePinMismatch, got, "the first check loads the pins instead of failing open")
+
+	repo.pins["late.example"] = &entity.CertificatePin{Host: "late.example", SPKISHA256: "good="}
+	got, _ = uc.Check(ctx, "https://late.example/", "evil=")
+	assert.Equal(t, entity.CertificatePinNone, got, "pins stored elsewhere apply after a refresh")
+
+	require.NoError(t, uc.Refresh(ctx))
+	got, _ = uc.Check(ctx, "https://late.example/", "evil=")
+	assert.Equal(t, entity.CertificatePinMismatch, got)
+
+	_, err := uc.Pin(ctx, "other.example")
+	require.NoError(t, err)
+	got, _ = uc.Check(ctx, "https://other.example/", "evil=")
+	assert.Equal(t, entity.CertificatePinMismatch, got, "Pin updates the loaded pins")
+
+	require.NoError(t, uc.Unpin(ctx, "bank.example"))
+	got, _ = uc.Check(ctx, "https://bank.example/", "evil=")
+	assert.Equal(t, entity.CertificatePinNone, got, "Unpin updates the loaded pins")
+}
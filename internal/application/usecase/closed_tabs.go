@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ErrNoClosedTabs is returned when there is no closed tab left to reopen.
+var ErrNoClosedTabs = errors.New("no closed tabs to reopen")
+
+// ClosedTabsUseCase keeps a bounded, most-recent-first history of closed
+// tabs so they can be reopened with their whole pane tree. Tabs are stored
+// as session snapshots, so a reopened tab gets fresh pane WebViews.
+type ClosedTabsUseCase struct {
+	idGenerator IDGenerator
+	depth       func() int
+
+	mu     sync.Mutex
+	closed []entity.TabSnapshot // oldest first
+}
+
+// NewClosedTabsUseCase creates a closed-tab history. depth is read on every
+// change so config reloads apply without restarting; 0 disables the history.
+func NewClosedTabsUseCase(idGenerator IDGenerator, depth func() int) *ClosedTabsUseCase {
+	return &ClosedTabsUseCase{
+		idGenerator: idGenerator,
+		depth:       depth,
+	}
+}
+
+// Remember records a tab that was just closed. Tabs with nothing worth
+// reopening (only blank or internal pages) are skipped, and the oldest
+// entries are dropped once the history is full.
+func (uc *ClosedTabsUseCase) Remember(ctx context.Context, tab *entity.Tab) {
+	if uc == nil || tab == nil || tab.Workspace == nil || tab.Workspace.Root == nil {
+		return
+	}
+	if len(tab.Workspace.ShareableURLs()) == 0 {
+		return
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	depth := uc.currentDepth()
+	if depth == 0 {
+		uc.closed = nil
+		return
+	}
+	uc.closed = append(uc.closed, entity.SnapshotTab(tab))
+	uc.trimLocked(depth)
+
+	logging.FromContext(ctx).Debug().
+		Str("tab_id", string(tab.ID)).
+		Int("panes", tab.PaneCount()).
+		Int("history", len(uc.closed)).
+		Msg("closed tab remembered")
+}
+
+// ReopenLastClosedTab removes the most recently closed tab from the history
+// and rebuilds it, pane tree included, with new IDs. The returned tab is not
+// added to any tab list.
+func (uc *ClosedTabsUseCase) ReopenLastClosedTab(ctx context.Context) (*entity.Tab, error) {
+	if uc == nil {
+		return nil, ErrNoClosedTabs
+	}
+
+	uc.mu.Lock()
+	uc.trimLocked(uc.currentDepth())
+	if len(uc.closed) == 0 {
+		uc.mu.Unlock()
+		return nil, ErrNoClosedTabs
+	}
+	last := len(uc.closed) - 1
+	snap := uc.closed[last]
+	uc.closed = uc.closed[:last]
+	uc.mu.Unlock()
+
+	tab := entity.TabFromSnapshot(snap, entity.IDGenerator(uc.idGenerator))
+	if tab == nil {
+		return nil, ErrNoClosedTabs
+	}
+
+	logging.FromContext(ctx).Info().
+		Str("tab_id", string(tab.ID)).
+		Str("name", tab.Name).
+		Int("panes", tab.PaneCount()).
+		Msg("reopening closed tab")
+	return tab, nil
+}
+
+// Count returns how many closed tabs can currently be reopened.
+func (uc *ClosedTabsUseCase) Count() int {
+	if uc == nil {
+		return 0
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return min(len(uc.closed), uc.currentDepth())
+}
+
+func (uc *ClosedTabsUseCase) currentDepth() int {
+	if uc.depth == nil {
+		return 0
+	}
+	return max(uc.depth(), 0)
+}
+
+// trimLocked drops the oldest entries beyond depth. Callers hold uc.mu.
+func (uc *ClosedTabsUseCase) trimLocked(depth int) {
+	if excess := len(uc.closed) - depth; excess > 0 {
+		uc.closed = append([]entity.TabSnapshot(nil), uc.closed[excess:]...)
+	}
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func closedTabsIDGenerator() IDGenerator {
+	n := 0
+	return func() string {
+		n++
+		return fmt.Sprintf("new-%d", n)
+	}
+}
+
+func closedTabWithURL(id entity.TabID, uri string) *entity.Tab {
+	pane := entity.NewPane(entity.PaneID(string(id) + "-pane"))
+	pane.URI = uri
+	tab := entity.NewTab(id, entity.WorkspaceID(string(id)+"-ws"), pane)
+	tab.Name = string(id)
+	return tab
+}
+
+func TestClosedTabsUseCase_ReopensLastClosedFirst(t *testing.T) {
+	ctx := context.Background()
+	uc := NewClosedTabsUseCase(closedTabsIDGenerator(), func() int { return 10 })
+
+	uc.Remember(ctx, closedTabWithURL("first", "https://one.example/"))
+	uc.Remember(ctx, closedTabWithURL("second", "https://two.example/"))
+	assert.Equal(t, 2, uc.Count())
+
+	tab, err := uc.ReopenLastClosedTab(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "second", tab.Name)
+	assert.Equal(t, "https://two.example/", tab.Workspace.Root.Pane.URI)
+
+	tab, err = uc.ReopenLastClosedTab(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "first", tab.Name)
+
+	_, err = uc.ReopenLastClosedTab(ctx)
+	assert.ErrorIs(t, err, ErrNoClosedTabs)
+}
+
+func TestClosedTabsUseCase_DepthDropsOldest(t *testing.T) {
+	ctx := context.Background()
+	depth := 2
+	uc := NewClosedTabsUseCase(closedTabsIDGenerator(), func() int { return depth })
+
+	for _, id := range []entity.TabID{"a", "b", "c"} {
+		uc.Remember(ctx, closedTabWithURL(id, "https://"+string(id)+".example/"))
+	}
+	assert.Equal(t, 2, uc.Count())
+
+	// Lowering the depth applies to the existing history.
+	depth = 1
+	tab, err := uc.ReopenLastClosedTab(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "c", tab.Name)
+	_, err = uc.ReopenLastClosedTab(ctx)
+	assert.ErrorIs(t, err, ErrNoClosedTabs)
+}
+
+func TestClosedTabsUseCase_ZeroDepthDisables(t *testing.T) {
+	ctx := context.Background()
+	uc := NewClosedTabsUseCase(closedTabsIDGenerator(), func() int { return 0 })
+
+	uc.Remember(ctx, closedTabWithURL("a", "https://a.example/"))
+
+	assert.Equal(t, 0, uc.Count())
+	_, err := uc.ReopenLastClosedTab(ctx)
+	assert.ErrorIs(t, err, ErrNoClosedTabs)
+}
+
+func TestClosedTabsUseCase_SkipsTabsWithOnlyBlankPages(t *testing.T) {
+	ctx := context.Background()
+	uc := NewClosedTabsUseCase(closedTabsIDGenerator(), func() int { return 10 })
+
+	uc.Remember(ctx, nil)
+	uc.Remember(ctx, closedTabWithURL("blank", "about:blank"))
+	uc.Remember(ctx, closedTabWithURL("home", "dumb://home"))
+
+	assert.Equal(t, 0, uc.Count())
+}
+
+func TestClosedTabsUseCase_ReconstructsPaneTree(t *testing.T) {
+	ctx := context.Background()
+	uc := NewClosedTabsUseCase(closedTabsIDGenerator(), func() int { return 10 })
+
+	// Layout: a horizontal split whose right side is a two-pane stack.
+	left := &entity.PaneNode{ID: "left", Pane: &entity.Pane{ID: "p-left", URI: "https://left.example/", Title: "Left"}}
+	stack := &entity.PaneNode{ID: "stack", IsStacked: true, ActiveStackIndex: 1}
+	stackTop := &entity.PaneNode{ID: "s1", Parent: stack, Pane: &entity.Pane{ID: "p-s1", URI: "https://s1.example/"}}
+	stackBottom := &entity.PaneNode{
+		ID: "s2", Parent: stack, Pane: &entity.Pane{ID: "p-s2", URI: "https://s2.example/", ZoomFactor: 1.25},
+	}
+	stack.Children = []*entity.PaneNode{stackTop, stackBottom}
+	root := &entity.PaneNode{
+		ID: "root", SplitDir: entity.SplitHorizontal, SplitRatio: 0.3,
+		Children: []*entity.PaneNode{left, stack},
+	}
+	left.Parent = root
+	stack.Parent = root
+	closed := &entity.Tab{
+		ID:        "tab",
+		Name:      "Work",
+		IsPinned:  true,
+		Workspace: &entity.Workspace{ID: "ws", Root: root, ActivePaneID: "p-s2"},
+	}
+
+	uc.Remember(ctx, closed)
+	tab, err := uc.ReopenLastClosedTab(ctx)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, closed.ID, tab.ID)
+	assert.Equal(t, "Work", tab.Name)
+	assert.True(t, tab.IsPinned)
+	require.NotNil(t, tab.Workspace)
+	assert.NotEqual(t, closed.Workspace.ID, tab.Workspace.ID)
+
+	gotRoot := tab.Workspace.Root
+	require.NotNil(t, gotRoot)
+	assert.Equal(t, entity.SplitHorizontal, gotRoot.SplitDir)
+	assert.InDelta(t, 0.3, gotRoot.SplitRatio, 0.001)
+	require.Len(t, gotRoot.Children, 2)
+
+	gotLeft := gotRoot.Children[0]
+	require.NotNil(t, gotLeft.Pane)
+	assert.Equal(t, "https://left.example/", gotLeft.Pane.URI)
+	assert.Equal(t, "Left", gotLeft.Pane.Title)
+	assert.NotEqual(t, entity.PaneID("p-left"), gotLeft.Pane.ID)
+	assert.Same(t, gotRoot, gotLeft.Parent)
+
+	gotStack := gotRoot.Children[1]
+	assert.True(t, gotStack.IsStacked)
+	assert.Equal(t, 1, gotStack.ActiveStackIndex)
+	require.Len(t, gotStack.Children, 2)
+	assert.Equal(t, "https://s1.example/", gotStack.Children[0].Pane.URI)
+	assert.Equal(t, "https://s2.example/", gotStack.Children[1].Pane.URI)
+	assert.InDelta(t, 1.25, gotStack.Children[1].Pane.ZoomFactor, 0.001)
+	assert.Same(t, gotStack, gotStack.Children[1].Parent)
+
+	assert.Equal(t, 3, tab.PaneCount())
+	assert.NotNil(t, gotRoot.FindPane(tab.Workspace.ActivePaneID))
+}
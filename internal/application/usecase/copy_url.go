@@ -3,31 +3,137 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"html"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/logging"
 )
 
-// CopyURLUseCase handles copying URLs to the system clipboard.
+// URLListFormat selects how a list of page URLs is rendered for the clipboard.
+type URLListFormat string
+
+const (
+	// URLListPlain renders one URL per line.
+	URLListPlain URLListFormat = "plain"
+	// URLListMarkdown renders a Markdown bullet list of "[title](url)" links.
+	URLListMarkdown URLListFormat = "markdown"
+	// URLListJSON renders a JSON array of {"url", "title"} objects.
+	URLListJSON URLListFormat = "json"
+)
+
+const (
+	// DefaultCopiedURLHistorySize is how many copied URLs are kept when no
+	// size is configured.
+	DefaultCopiedURLHistorySize = 20
+	// MaxCopiedURLHistorySize bounds clipboard.url_history_size.
+	MaxCopiedURLHistorySize = 200
+)
+
+// CopiedURL is a URL copied to the clipboard.
+type CopiedURL struct {
+	URL string
+	At  time.Time
+}
+
+// CopyURLUseCase handles copying URLs to the system clipboard and keeps a
+// bounded history of the copied URLs.
 type CopyURLUseCase struct {
 	clipboard port.Clipboard
+
+	historySize func() int
+	now         func() time.Time
+
+	mu sync.Mutex
+	// history holds the copied URLs, newest first.
+	history []CopiedURL
 }
 
 // NewCopyURLUseCase creates a new CopyURLUseCase.
 func NewCopyURLUseCase(clipboard port.Clipboard) *CopyURLUseCase {
 	return &CopyURLUseCase{
 		clipboard: clipboard,
+		now:       time.Now,
+	}
+}
+
+// SetHistorySize sets how many copied URLs are kept. size
+// (clipboard.url_history_size) is read on every copy so config reloads
+// apply without restarting; 0 keeps nothing.
+func (uc *CopyURLUseCase) SetHistorySize(size func() int) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.historySize = size
+}
+
+// History returns the copied URLs, newest first.
+func (uc *CopyURLUseCase) History() []CopiedURL {
+	if uc == nil {
+		return nil
 	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return append([]CopiedURL(nil), uc.history...)
 }
 
-// Copy copies the given URL to the clipboard.
+// ClearHistory forgets every copied URL and returns how many were kept.
+func (uc *CopyURLUseCase) ClearHistory() int {
+	if uc == nil {
+		return 0
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	n := len(uc.history)
+	uc.history = nil
+	return n
+}
+
+// record adds url to the history. Copying the newest URL again only
+// refreshes its time, so repeated copies don't crowd out older URLs.
+func (uc *CopyURLUseCase) record(url string) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	size := DefaultCopiedURLHistorySize
+	if uc.historySize != nil {
+		size = min(max(uc.historySize(), 0), MaxCopiedURLHistorySize)
+	}
+	entry := CopiedURL{URL: url, At: uc.now()}
+	switch {
+	case size == 0:
+		uc.history = nil
+		return
+	case len(uc.history) > 0 && uc.history[0].URL == url:
+		uc.history[0] = entry
+	default:
+		uc.history = append([]CopiedURL{entry}, uc.history...)
+	}
+	if len(uc.history) > size {
+		uc.history = uc.history[:size]
+	}
+}
+
+// Copy copies the given URL to the clipboard and records it in the history.
 // Returns nil on success, error on failure.
 // The caller is responsible for showing toast notifications on the UI thread.
 func (uc *CopyURLUseCase) Copy(ctx context.Context, url string) error {
+	if err := uc.write(ctx, url); err != nil {
+		return err
+	}
+	uc.record(url)
+	return nil
+}
+
+// write puts text on the clipboard without recording it.
+func (uc *CopyURLUseCase) write(ctx context.Context, text string) error {
 	log := logging.FromContext(ctx)
 
-	if url == "" {
+	if text == "" {
 		log.Debug().Msg("copy URL: empty URL")
 		return fmt.Errorf("empty URL")
 	}
@@ -37,11 +143,107 @@ func (uc *CopyURLUseCase) Copy(ctx context.Context, url string) error {
 		return fmt.Errorf("clipboard not available")
 	}
 
-	if err := uc.clipboard.WriteText(ctx, url); err != nil {
-		log.Error().Err(err).Str("url", url).Msg("copy URL: clipboard write failed")
+	if err := uc.clipboard.WriteText(ctx, text); err != nil {
+		log.Error().Err(err).Str("url", text).Msg("copy URL: clipboard write failed")
 		return fmt.Errorf("clipboard write failed: %w", err)
 	}
 
-	log.Debug().Str("url", url).Msg("URL copied to clipboard")
+	log.Debug().Str("url", text).Msg("URL copied to clipboard")
 	return nil
 }
+
+// CopyAsMarkdown copies a "[title](url)" Markdown link to the clipboard.
+// An empty title falls back to the URL.
+func (uc *CopyURLUseCase) CopyAsMarkdown(ctx context.Context, url, title string) error {
+	if url == "" {
+		return uc.Copy(ctx, url)
+	}
+	if err := uc.write(ctx, FormatMarkdownLink(url, title)); err != nil {
+		return err
+	}
+	uc.record(url)
+	return nil
+}
+
+// CopyAsHTML copies an HTML anchor for url to the clipboard.
+// An empty title falls back to the URL.
+func (uc *CopyURLUseCase) CopyAsHTML(ctx context.Context, url, title string) error {
+	if url == "" {
+		return uc.Copy(ctx, url)
+	}
+	if err := uc.write(ctx, FormatHTMLLink(url, title)); err != nil {
+		return err
+	}
+	uc.record(url)
+	return nil
+}
+
+// CopyURLList copies urls to the clipboard rendered in format. Lists are
+// not recorded in the history.
+func (uc *CopyURLUseCase) CopyURLList(ctx context.Context, urls []entity.SessionURL, format URLListFormat) error {
+	if len(urls) == 0 {
+		return fmt.Errorf("no URLs to copy")
+	}
+	text, err := FormatURLList(urls, format)
+	if err != nil {
+		return err
+	}
+	return uc.write(ctx, text)
+}
+
+// FormatURLList renders urls in format. Markdown links fall back to the URL
+// when a page has no title.
+func FormatURLList(urls []entity.SessionURL, format URLListFormat) (string, error) {
+	switch format {
+	case URLListPlain:
+		lines := make([]string, 0, len(urls))
+		for _, u := range urls {
+			lines = append(lines, u.URL)
+		}
+		return strings.Join(lines, "\n"), nil
+	case URLListMarkdown:
+		lines := make([]string, 0, len(urls))
+		for _, u := range urls {
+			lines = append(lines, "- "+FormatMarkdownLink(u.URL, u.Title))
+		}
+		return strings.Join(lines, "\n"), nil
+	case URLListJSON:
+		if urls == nil {
+			urls = []entity.SessionURL{}
+		}
+		data, err := json.MarshalIndent(urls, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("encode URL list: %w", err)
+		}
+		return string(data), nil
+	default:
+		return "", fmt.Errorf("unknown URL list format %q", format)
+	}
+}
+
+var (
+	markdownTitleEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`, `(`, `\(`, `)`, `\)`)
+	markdownURLEscaper   = strings.NewReplacer(` `, `%20`, `(`, `%28`, `)`, `%29`, `<`, `%3C`, `>`, `%3E`)
+)
+
+// FormatMarkdownLink returns "[title](url)" with the title's brackets and
+// parentheses backslash-escaped and the URL's parentheses percent-encoded,
+// so neither can terminate the link early.
+func FormatMarkdownLink(url, title string) string {
+	title = linkTitle(url, title)
+	return "[" + markdownTitleEscaper.Replace(title) + "](" + markdownURLEscaper.Replace(url) + ")"
+}
+
+// FormatHTMLLink returns an HTML anchor for url with title as its text.
+func FormatHTMLLink(url, title string) string {
+	title = linkTitle(url, title)
+	return `<a href="` + html.EscapeString(url) + `">` + html.EscapeString(title) + `</a>`
+}
+
+func linkTitle(url, title string) string {
+	title = strings.Join(strings.Fields(title), " ")
+	if title == "" {
+		return url
+	}
+	return title
+}
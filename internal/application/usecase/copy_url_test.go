@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	portmocks "github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestFormatMarkdownLink(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		title string
+		want  string
+	}{
+		{
+			name:  "plain",
+			url:   "https://example.com/",
+			title: "Example Domain",
+			want:  "[Example Domain](https://example.com/)",
+		},
+		{
+			name:  "escapes brackets and parentheses in title",
+			url:   "https://example.com/",
+			title: "Go [release] notes (1.24)",
+			want:  `[Go \[release\] notes \(1.24\)](https://example.com/)`,
+		},
+		{
+			name:  "escapes backslashes before brackets",
+			url:   "https://example.com/",
+			title: `C:\path]`,
+			want:  `[C:\\path\]](https://example.com/)`,
+		},
+		{
+			name:  "encodes parentheses and spaces in url",
+			url:   "https://en.wikipedia.org/wiki/Go_(programming language)",
+			title: "Go",
+			want:  "[Go](https://en.wikipedia.org/wiki/Go_%28programming%20language%29)",
+		},
+		{
+			name:  "empty title falls back to url",
+			url:   "https://example.com/",
+			title: "  ",
+			want:  "[https://example.com/](https://example.com/)",
+		},
+		{
+			name:  "collapses whitespace in title",
+			url:   "https://example.com/",
+			title: "Line one\n  line two",
+			want:  "[Line one line two](https://example.com/)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatMarkdownLink(tt.url, tt.title))
+		})
+	}
+}
+
+func TestFormatHTMLLink(t *testing.T) {
+	assert.Equal(t,
+		`<a href="https://example.com/?a=1&amp;b=&#34;2&#34;">Tom &amp; Jerry &lt;3</a>`,
+		FormatHTMLLink(`https://example.com/?a=1&b="2"`, "Tom & Jerry <3"),
+	)
+	assert.Equal(t,
+		`<a href="https://example.com/">https://example.com/</a>`,
+		FormatHTMLLink("https://example.com/", ""),
+	)
+}
+
+func TestCopyURLUseCase_CopyAsMarkdown(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, `[A \] B](https://example.com/)`).Return(nil).Once()
+
+	uc := NewCopyURLUseCase(clipboard)
+	require.NoError(t, uc.CopyAsMarkdown(ctx, "https://example.com/", "A ] B"))
+}
+
+func TestCopyURLUseCase_CopyAsHTML(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, `<a href="https://example.com/">Example</a>`).Return(nil).Once()
+
+	uc := NewCopyURLUseCase(clipboard)
+	require.NoError(t, uc.CopyAsHTML(ctx, "https://example.com/", "Example"))
+}
+
+func TestCopyURLUseCase_CopyAsMarkdown_EmptyURL(t *testing.T) {
+	uc := NewCopyURLUseCase(portmocks.NewMockClipboard(t))
+	assert.Error(t, uc.CopyAsMarkdown(context.Background(), "", "Title"))
+}
+
+func TestFormatURLList(t *testing.T) {
+	urls := []entity.SessionURL{
+		{URL: "https://a.test/", Title: "Page [A]"},
+		{URL: "https://b.test/x"},
+	}
+
+	tests := []struct {
+		format URLListFormat
+		want   string
+	}{
+		{format: URLListPlain, want: "https://a.test/\nhttps://b.test/x"},
+		{format: URLListMarkdown, want: "- [Page \\[A\\]](https://a.test/)\n- [https://b.test/x](https://b.test/x)"},
+		{
+			format: URLListJSON,
+			want: `[
+  {
+    "url": "https://a.test/",
+    "title": "Page [A]"
+  },
+  {
+    "url": "https://b.test/x"
+  }
+]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			got, err := FormatURLList(urls, tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := FormatURLList(urls, "csv")
+	assert.Error(t, err)
+}
+
+func TestCopyURLUseCase_CopyURLList(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, "https://a.test/\nhttps://b.test/").Return(nil).Once()
+
+	uc := NewCopyURLUseCase(clipboard)
+	require.NoError(t, uc.CopyURLList(ctx, []entity.SessionURL{{URL: "https://a.test/"}, {URL: "https://b.test/"}}, URLListPlain))
+	assert.Error(t, uc.CopyURLList(ctx, nil, URLListPlain), "nothing to copy")
+}
+
+func TestCopyURLUseCase_HistoryRecordsCopiedURLs(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, mock.Anything).Return(nil)
+
+	uc := NewCopyURLUseCase(clipboard)
+	clock := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	uc.now = func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	}
+
+	require.NoError(t, uc.Copy(ctx, "https://a.test/"))
+	require.NoError(t, uc.CopyAsMarkdown(ctx, "https://b.test/", "B"))
+	require.NoError(t, uc.CopyURLList(ctx, []entity.SessionURL{{URL: "https://c.test/"}}, URLListPlain))
+
+	assert.Equal(t, []CopiedURL{
+		{URL: "https://b.test/", At: time.Date(2026, 1, 2, 3, 4, 7, 0, time.UTC)},
+		{URL: "https://a.test/", At: time.Date(2026, 1, 2, 3, 4, 6, 0, time.UTC)},
+	}, uc.History(), "newest first, lists are not recorded")
+
+	assert.Equal(t, 2, uc.ClearHistory())
+	assert.Empty(t, uc.History())
+}
+
+func TestCopyURLUseCase_HistoryDedupsConsecutiveCopies(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, mock.Anything).Return(nil)
+
+	uc := NewCopyURLUseCase(clipboard)
+	for _, url := range []string{"https://a.test/", "https://a.test/", "https://b.test/", "https://a.test/"} {
+		require.NoError(t, uc.Copy(ctx, url))
+	}
+
+	var urls []string
+	for _, entry := range uc.History() {
+		urls = append(urls, entry.URL)
+	}
+	assert.Equal(t, []string{"https://a.test/", "https://b.test/", "https://a.test/"}, urls)
+}
+
+func TestCopyURLUseCase_HistoryIsCapped(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, mock.Anything).Return(nil)
+
+	size := 2
+	uc := NewCopyURLUseCase(clipboard)
+	uc.SetHistorySize(func() int { return size })
+	for _, url := range []string{"https://a.test/", "https://b.test/", "https://c.test/"} {
+		require.NoError(t, uc.Copy(ctx, url))
+	}
+	history := uc.History()
+	require.Len(t, history, 2)
+	assert.Equal(t, "https://c.test/", history[0].URL)
+	assert.Equal(t, "https://b.test/", history[1].URL)
+
+	size = 0
+	require.NoError(t, uc.Copy(ctx, "https://d.test/"))
+	assert.Empty(t, uc.History(), "a size of 0 keeps nothing")
+}
+
+func TestCopyURLUseCase_HistorySkipsFailedCopies(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, "https://a.test/").Return(errors.New("no display")).Once()
+
+	uc := NewCopyURLUseCase(clipboard)
+	require.Error(t, uc.Copy(ctx, "https://a.test/"))
+	assert.Empty(t, uc.History())
+}
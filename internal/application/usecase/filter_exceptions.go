@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/bnema/dumber/internal/domain/repository"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+var (
+	// ErrFilterExceptionUnsupportedURL is returned when toggling filtering on a
+	// page that is never filtered (internal pages, files, blank pages).
+	ErrFilterExceptionUnsupportedURL = errors.New("content filtering does not apply to this page")
+	// ErrFilteringDisabledByConfig is returned when toggling filtering back on
+	// for a site exempted by content_filtering.disabled_domains.
+	ErrFilteringDisabledByConfig = errors.New("content filtering is disabled for this site in the config")
+)
+
+// ManageFilterExceptionsUseCase decides which sites load without content
+// filtering. A site is exempted by a content_filtering.disabled_domains
+// pattern or by a per-site toggle persisted in the content whitelist. A second
+// instance, backed by the cosmetic filter exceptions, decides which sites load
+// without cosmetic rules.
+type ManageFilterExceptionsUseCase struct {
+	repo            repository.ContentWhitelistRepository
+	disabledDomains func() []string
+
+	mu      sync.Mutex
+	toggled map[string]bool
+	loaded  bool
+}
+
+// NewManageFilterExceptionsUseCase creates a filter exception use case.
+func NewManageFilterExceptionsUseCase(repo repository.ContentWhitelistRepository) *ManageFilterExceptionsUseCase {
+	return &ManageFilterExceptionsUseCase{repo: repo}
+}
+
+// SetDisabledDomainsProvider sets the source of the configured domain
+// patterns. The provider is read on every check so config reloads apply
+// without restarting.
+func (uc *ManageFilterExceptionsUseCase) SetDisabledDomainsProvider(fn func() []string) {
+	uc.disabledDomains = fn
+}
+
+// FilterExceptionDomain returns the site key of rawURL for filter exceptions,
+// or "" when the page is never filtered.
+func FilterExceptionDomain(rawURL string) string {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		return urlutil.DisplayDomain(parsed.Host)
+	default:
+		return ""
+	}
+}
+
+// ShouldBypassFiltering reports whether rawURL loads without content
+// filtering, given the configured domain patterns and the toggled sites.
+func ShouldBypassFiltering(disabledDomains []string, toggled map[string]bool, rawURL string) bool {
+	domain := FilterExceptionDomain(rawURL)
+	if domain == "" {
+		return false
+	}
+	return toggled[domain] || urlutil.MatchAnyDomainPattern(disabledDomains, domain)
+}
+
+// IsBypassed reports whether rawURL loads without content filtering.
+func (uc *ManageFilterExceptionsUseCase) IsBypassed(ctx context.Context, rawURL string) bool {
+	if FilterExceptionDomain(rawURL) == "" {
+		return false
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.loadLocked(ctx)
+	return ShouldBypassFiltering(uc.configuredDomains(), uc.toggled, rawURL)
+}
+
+// Toggle flips filtering for the site of rawURL and persists the choice. It
+// returns whether the site now loads without filtering and the site key.
+func (uc *ManageFilterExceptionsUseCase) Toggle(ctx context.Context, rawURL string) (bypassed bool, domain string, err error) {
+	domain = FilterExceptionDomain(rawURL)
+	if domain == "" {
+		return false, "", ErrFilterExceptionUnsupportedURL
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.loadLocked(ctx)
+
+	configured := urlutil.MatchAnyDomainPattern(uc.configuredDomains(), domain)
+	if uc.toggled[domain] {
+		if err := uc.repo.Remove(ctx, domain); err != nil {
+			return true, domain, fmt.Errorf("remove filter exception for %s: %w", domain, err)
+		}
+		delete(uc.toggled, domain)
+		return configured, domain, nil
+	}
+	if configured {
+		return true, domain, ErrFilteringDisabledByConfig
+	}
+	if err := uc.repo.Add(ctx, domain); err != nil {
+		return false, domain, fmt.Errorf("add filter exception for %s: %w", domain, err)
+	}
+	uc.toggled[domain] = true
+	return true, domain, nil
+}
+
+// loadLocked reads the toggled sites once. A failed load is retried on the
+// next call. Caller must hold uc.mu.
+func (uc *ManageFilterExceptionsUseCase) loadLocked(ctx context.Context) {
+	if uc.toggled == nil {
+		uc.toggled = make(map[string]bool)
+	}
+	if uc.loaded || uc.repo == nil {
+		return
+	}
+	domains, err := uc.repo.GetAll(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msg("failed to load content filter exceptions")
+		return
+	}
+	for _, domain := range domains {
+		uc.toggled[domain] = true
+	}
+	uc.loaded = true
+}
+
+func (uc *ManageFilterExceptionsUseCase) configuredDomains() []string {
+	if uc.disabledDomains == nil {
+		return nil
+	}
+	return uc.disabledDomains()
+}
@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	repomocks "github.com/bnema/dumber/internal/domain/repository/mocks"
+)
+
+func TestShouldBypassFiltering(t *testing.T) {
+	patterns := []string{"*.bank.example", "news.example"}
+	toggled := map[string]bool{"shop.example": true}
+
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{name: "exact pattern", url: "https://news.example/today", want: true},
+		{name: "wildcard subdomain", url: "https://login.bank.example/", want: true},
+		{name: "wildcard covers apex", url: "https://bank.example/", want: true},
+		{name: "toggled site", url: "http://shop.example/cart", want: true},
+		{name: "toggled site ignores port", url: "https://shop.example:8443/", want: true},
+		{name: "other subdomain of toggled site", url: "https://cdn.shop.example/", want: false},
+		{name: "unrelated site", url: "https://example.org/", want: false},
+		{name: "internal page", url: "dumb://homepage", want: false},
+		{name: "file", url: "file:///tmp/news.example", want: false},
+		{name: "blank", url: "about:blank", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, usecase.ShouldBypassFiltering(patterns, toggled, tt.url))
+		})
+	}
+}
+
+func TestManageFilterExceptions_TogglePersistsDomain(t *testing.T) {
+	ctx := testContext()
+	repo := repomocks.NewMockContentWhitelistRepository(t)
+	repo.EXPECT().GetAll(ctx).Return([]string{"saved.example"}, nil).Once()
+	repo.EXPECT().Add(ctx, "shop.example").Return(nil).Once()
+	repo.EXPECT().Remove(ctx, "shop.example").Return(nil).Once()
+
+	uc := usecase.NewManageFilterExceptionsUseCase(repo)
+
+	assert.True(t, uc.IsBypassed(ctx, "https://saved.example/"), "persisted sites are loaded")
+	assert.False(t, uc.IsBypassed(ctx, "https://shop.example/"))
+
+	bypassed, domain, err := uc.Toggle(ctx, "https://shop.example/cart?id=1")
+	require.NoError(t, err)
+	assert.True(t, bypassed)
+	assert.Equal(t, "shop.example", domain)
+	assert.True(t, uc.IsBypassed(ctx, "https://shop.example/other"))
+
+	bypassed, _, err = uc.Toggle(ctx, "https://shop.example/")
+	require.NoError(t, err)
+	assert.False(t, bypassed)
+	assert.False(t, uc.IsBypassed(ctx, "https://shop.example/"))
+}
+
+func TestManageFilterExceptions_ToggleConfiguredDomain(t *testing.T) {
+	ctx := testContext()
+	repo := repomocks.NewMockContentWhitelistRepository(t)
+	repo.EXPECT().GetAll(ctx).Return(nil, nil).Once()
+
+	uc := usecase.NewManageFilterExceptionsUseCase(repo)
+	uc.SetDisabledDomainsProvider(func() []string { return []string{"*.bank.example"} })
+
+	bypassed, domain, err := uc.Toggle(ctx, "https://login.bank.example/")
+	require.ErrorIs(t, err, usecase.ErrFilteringDisabledByConfig)
+	assert.True(t, bypassed)
+	assert.Equal(t, "login.bank.example", domain)
+
+	_, _, err = uc.Toggle(ctx, "dumb://homepage")
+	require.ErrorIs(t, err, usecase.ErrFilterExceptionUnsupportedURL)
+}
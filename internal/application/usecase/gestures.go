@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// NavigationGesturesEnabled reports whether swiping goes back and forward on
+// the page at uri: everywhere except on input.disable_gestures_domains.
+func NavigationGesturesEnabled(cfg entity.RuntimeInputConfig, uri string) bool {
+	if uri == "" || len(cfg.DisableGesturesDomains) == 0 {
+		return true
+	}
+	return !urlutil.MatchAnyDomainPattern(cfg.DisableGesturesDomains, uri)
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestNavigationGesturesEnabled(t *testing.T) {
+	perDomain := entity.RuntimeInputConfig{DisableGesturesDomains: []string{"excalidraw.com", "*.figma.com"}}
+
+	tests := []struct {
+		name string
+		cfg  entity.RuntimeInputConfig
+		uri  string
+		want bool
+	}{
+		{name: "on by default", uri: "https://excalidraw.com/", want: true},
+		{name: "no page loaded", cfg: perDomain, want: true},
+		{name: "exact domain", cfg: perDomain, uri: "https://excalidraw.com/#room=1"},
+		{name: "subdomain glob", cfg: perDomain, uri: "https://www.figma.com/file/abc"},
+		{name: "glob apex", cfg: perDomain, uri: "https://figma.com/"},
+		{name: "other domain", cfg: perDomain, uri: "https://example.com/", want: true},
+		{name: "internal page", cfg: perDomain, uri: "dumb://home", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NavigationGesturesEnabled(tt.cfg, tt.uri))
+		})
+	}
+}
@@ -38,6 +38,9 @@ func NewGetLastRestorableSessionUseCase(
 type GetLastRestorableSessionInput struct {
 	// ExcludeSessionID is the current session ID to exclude from results.
 	ExcludeSessionID entity.SessionID
+	// PinnedOnly restricts the restored state to pinned tabs. A last session
+	// without pinned tabs yields an empty output rather than an older session.
+	PinnedOnly bool
 }
 
 // GetLastRestorableSessionOutput contains the found restorable session, if any.
@@ -101,6 +104,16 @@ func (uc *GetLastRestorableSessionUseCase) Execute(
 			continue
 		}
 
+		if input.PinnedOnly {
+			state = state.PinnedOnly()
+			if state.CountPanes() == 0 {
+				log.Info().
+					Str("session_id", string(session.ID)).
+					Msg("auto-restore: last session has no pinned tabs")
+				return &GetLastRestorableSessionOutput{}, nil
+			}
+		}
+
 		// Found a valid restorable session
 		log.Info().
 			Str("session_id", string(session.ID)).
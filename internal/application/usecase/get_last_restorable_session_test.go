@@ -393,3 +393,90 @@ func TestGetLastRestorableSessionUseCase_SkipsFirstIfNoSnapshot(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, goodID, output.SessionID)
 }
+
+func pinnedRestoreTab(id entity.TabID, pinned bool) entity.TabSnapshot {
+	return entity.TabSnapshot{
+		ID:       id,
+		IsPinned: pinned,
+		Workspace: entity.WorkspaceSnapshot{
+			Root: &entity.PaneNodeSnapshot{
+				Pane: &entity.PaneSnapshot{ID: entity.PaneID(id + "-pane"), URI: "https://" + string(id) + ".example"},
+			},
+		},
+	}
+}
+
+func TestGetLastRestorableSessionUseCase_PinnedOnlyFiltersTabs(t *testing.T) {
+	ctx := testContext()
+
+	sessionRepo := repomocks.NewMockSessionRepository(t)
+	stateRepo := repomocks.NewMockSessionStateRepository(t)
+
+	endedAt := time.Now()
+	sessionID := entity.SessionID("20251225_120000_pins")
+	sessions := []*entity.Session{
+		{ID: sessionID, Type: entity.SessionTypeBrowser, StartedAt: time.Now().Add(-1 * time.Hour), EndedAt: &endedAt},
+	}
+	state := &entity.SessionState{
+		Version:   entity.LegacySessionStateVersion,
+		SessionID: sessionID,
+		Tabs: []entity.TabSnapshot{
+			pinnedRestoreTab("scratch", false),
+			pinnedRestoreTab("mail", true),
+			pinnedRestoreTab("notes", true),
+		},
+		SavedAt: time.Now(),
+	}
+
+	sessionRepo.EXPECT().GetRecent(ctx, 10).Return(sessions, nil)
+	stateRepo.EXPECT().GetSnapshot(ctx, sessionID).Return(state, nil)
+
+	uc := usecase.NewGetLastRestorableSessionUseCase(sessionRepo, stateRepo)
+
+	output, err := uc.Execute(ctx, usecase.GetLastRestorableSessionInput{
+		ExcludeSessionID: "current",
+		PinnedOnly:       true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, output.SessionID)
+	require.Len(t, output.State.Tabs, 2)
+	assert.Equal(t, entity.TabID("mail"), output.State.Tabs[0].ID)
+	assert.Equal(t, entity.TabID("notes"), output.State.Tabs[1].ID)
+	assert.Len(t, state.Tabs, 3, "stored snapshot must not be modified")
+}
+
+func TestGetLastRestorableSessionUseCase_PinnedOnlyWithoutPinnedTabsRestoresNothing(t *testing.T) {
+	ctx := testContext()
+
+	sessionRepo := repomocks.NewMockSessionRepository(t)
+	stateRepo := repomocks.NewMockSessionStateRepository(t)
+
+	endedAt := time.Now()
+	lastID := entity.SessionID("20251225_120000_last")
+	olderID := entity.SessionID("20251224_120000_oldr")
+	sessions := []*entity.Session{
+		{ID: lastID, Type: entity.SessionTypeBrowser, StartedAt: time.Now().Add(-1 * time.Hour), EndedAt: &endedAt},
+		{ID: olderID, Type: entity.SessionTypeBrowser, StartedAt: time.Now().Add(-24 * time.Hour), EndedAt: &endedAt},
+	}
+	lastState := &entity.SessionState{
+		Version:   entity.LegacySessionStateVersion,
+		SessionID: lastID,
+		Tabs:      []entity.TabSnapshot{pinnedRestoreTab("scratch", false)},
+		SavedAt:   time.Now(),
+	}
+
+	sessionRepo.EXPECT().GetRecent(ctx, 10).Return(sessions, nil)
+	stateRepo.EXPECT().GetSnapshot(ctx, lastID).Return(lastState, nil)
+
+	uc := usecase.NewGetLastRestorableSessionUseCase(sessionRepo, stateRepo)
+
+	output, err := uc.Execute(ctx, usecase.GetLastRestorableSessionInput{
+		ExcludeSessionID: "current",
+		PinnedOnly:       true,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.SessionID)
+	assert.Nil(t, output.State)
+}
@@ -25,11 +25,13 @@ type PermissionCallback struct {
 // - Display capture: auto-allow (XDG portal handles UI)
 // - Device enumeration: auto-allow (low risk)
 // - Mic/Camera: check stored → dialog → persist if "Always"
+// - Clipboard read: as mic/camera, with the configured policy applied on top
 type HandlePermissionUseCase struct {
-	permRepo          port.PermissionRepository
-	dialog            port.PermissionDialogPresenter
-	loggerFromContext port.LoggerFromContext
-	dialogMu          sync.RWMutex
+	permRepo            port.PermissionRepository
+	dialog              port.PermissionDialogPresenter
+	loggerFromContext   port.LoggerFromContext
+	dialogMu            sync.RWMutex
+	clipboardReadPolicy func() entity.ClipboardReadPolicy
 }
 
 // NewHandlePermissionUseCase creates a new permission handling use case.
@@ -59,6 +61,33 @@ func (uc *HandlePermissionUseCase) logger(ctx context.Context) *zerolog.Logger {
 	return log
 }
 
+// SetClipboardReadPolicyProvider sets the source of the live clipboard read
+// policy. Without one, clipboard reads are prompted.
+func (uc *HandlePermissionUseCase) SetClipboardReadPolicyProvider(fn func() entity.ClipboardReadPolicy) {
+	uc.clipboardReadPolicy = fn
+}
+
+func isClipboardPermission(permTypes []entity.PermissionType) bool {
+	return slices.Contains(permTypes, entity.PermissionTypeClipboard)
+}
+
+// resolveClipboardRead applies the clipboard read policy to the decision
+// stored for the origin. deny blocks every read; allow reads unless the
+// origin was denied; prompt uses the stored decision and asks otherwise.
+func resolveClipboardRead(policy entity.ClipboardReadPolicy, stored entity.PermissionDecision) entity.PermissionDecision {
+	switch policy {
+	case entity.ClipboardReadDeny:
+		return entity.PermissionDenied
+	case entity.ClipboardReadAllow:
+		if stored == entity.PermissionDenied {
+			return entity.PermissionDenied
+		}
+		return entity.PermissionGranted
+	default:
+		return stored
+	}
+}
+
 func isWebsiteDataAccessPermission(permTypes []entity.PermissionType) bool {
 	return slices.Contains(permTypes, entity.PermissionTypeWebsiteDataAccess)
 }
@@ -147,6 +176,14 @@ func (uc *HandlePermissionUseCase) HandlePermissionRequest(
 
 	// For mic/camera: check stored permissions first
 	decision := uc.checkStoredPermissions(ctx, origin, permTypes)
+	if isClipboardPermission(permTypes) {
+		policy := entity.ClipboardReadPrompt
+		if uc.clipboardReadPolicy != nil {
+			policy = uc.clipboardReadPolicy()
+		}
+		decision = resolveClipboardRead(policy, decision)
+		log.Debug().Str("policy", string(policy)).Str("decision", string(decision)).Msg("clipboard read policy applied")
+	}
 	switch decision {
 	case entity.PermissionGranted:
 		if isWebsiteDataAccessPermission(permTypes) {
@@ -610,3 +610,83 @@ func TestHandlePermissionUseCase_WebsiteDataAccess_UsesStoredGrant(t *testing.T)
 	assert.True(t, allowed, "should use stored granted permission without dialog")
 	dialog.AssertNotCalled(t, "ShowPermissionDialog")
 }
+
+func TestHandlePermissionUseCase_ClipboardReadPolicy(t *testing.T) {
+	const origin = "https://paste.example.com"
+	stored := func(decision entity.PermissionDecision) *entity.PermissionRecord {
+		return &entity.PermissionRecord{Origin: origin, Type: entity.PermissionTypeClipboard, Decision: decision}
+	}
+
+	tests := []struct {
+		name        string
+		policy      entity.ClipboardReadPolicy
+		stored      *entity.PermissionRecord
+		wantAllowed bool
+	}{
+		{name: "allow without stored decision", policy: entity.ClipboardReadAllow, wantAllowed: true},
+		{name: "allow keeps stored denial", policy: entity.ClipboardReadAllow, stored: stored(entity.PermissionDenied)},
+		{name: "deny without stored decision", policy: entity.ClipboardReadDeny},
+		{name: "deny overrides stored grant", policy: entity.ClipboardReadDeny, stored: stored(entity.PermissionGranted)},
+		{name: "prompt uses stored grant", policy: entity.ClipboardReadPrompt, stored: stored(entity.PermissionGranted), wantAllowed: true},
+		{name: "prompt uses stored denial", policy: entity.ClipboardReadPrompt, stored: stored(entity.PermissionDenied)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testContext()
+			permRepo := portmocks.NewMockPermissionRepository(t)
+			dialog := portmocks.NewMockPermissionDialogPresenter(t)
+
+			uc := usecase.NewHandlePermissionUseCase(permRepo, dialog, permissionLoggerFromContext)
+			uc.SetClipboardReadPolicyProvider(func() entity.ClipboardReadPolicy { return tt.policy })
+
+			permRepo.EXPECT().Get(mock.Anything, origin, entity.PermissionTypeClipboard).Return(tt.stored, nil)
+
+			allowed, denied := false, false
+			uc.HandlePermissionRequest(ctx, origin, []entity.PermissionType{
+				entity.PermissionTypeClipboard,
+			}, nil, usecase.PermissionCallback{
+				Allow: func() { allowed = true },
+				Deny:  func() { denied = true },
+			})
+
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.Equal(t, !tt.wantAllowed, denied)
+			dialog.AssertNotCalled(t, "ShowPermissionDialog")
+		})
+	}
+}
+
+func TestHandlePermissionUseCase_ClipboardReadPromptPersistsPerOrigin(t *testing.T) {
+	ctx := testContext()
+	permRepo := portmocks.NewMockPermissionRepository(t)
+	dialog := portmocks.NewMockPermissionDialogPresenter(t)
+
+	// No policy provider: clipboard reads are prompted.
+	uc := usecase.NewHandlePermissionUseCase(permRepo, dialog, permissionLoggerFromContext)
+
+	permRepo.EXPECT().Get(mock.Anything, "https://paste.example.com", entity.PermissionTypeClipboard).
+		Return(nil, nil)
+
+	dialog.EXPECT().ShowPermissionDialog(mock.Anything, "https://paste.example.com", []entity.PermissionType{
+		entity.PermissionTypeClipboard,
+	}, mock.Anything, mock.Anything).Run(func(_ context.Context, _ string, _ []entity.PermissionType, _ entity.PermissionMetadata, cb func(port.PermissionDialogResult)) {
+		cb(port.PermissionDialogResult{Allowed: true, Persistent: true})
+	})
+
+	permRepo.EXPECT().Set(mock.Anything, mock.AnythingOfType("*entity.PermissionRecord")).
+		Run(func(_ context.Context, r *entity.PermissionRecord) {
+			assert.Equal(t, "https://paste.example.com", r.Origin)
+			assert.Equal(t, entity.PermissionTypeClipboard, r.Type)
+			assert.Equal(t, entity.PermissionGranted, r.Decision)
+		}).Return(nil)
+
+	allowed := false
+	uc.HandlePermissionRequest(ctx, "https://paste.example.com", []entity.PermissionType{
+		entity.PermissionTypeClipboard,
+	}, nil, usecase.PermissionCallback{
+		Allow: func() { allowed = true },
+		Deny:  func() {},
+	})
+
+	assert.True(t, allowed)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/httpsupgrade"
+)
+
+// HTTPSUpgradeUseCase applies privacy.https_only to page loads: it upgrades
+// http:// pages to https:// and keeps, for the browser session, the hosts
+// allowed over http:// after their HTTPS load failed.
+type HTTPSUpgradeUseCase struct {
+	mode func() entity.HTTPSOnlyMode
+	now  func() time.Time
+
+	mu      sync.Mutex
+	tracker *httpsupgrade.Tracker
+}
+
+// NewHTTPSUpgradeUseCase creates an HTTPS upgrade use case. mode is read on
+// every load so config reloads apply without restarting.
+func NewHTTPSUpgradeUseCase(mode func() entity.HTTPSOnlyMode) *HTTPSUpgradeUseCase {
+	return &HTTPSUpgradeUseCase{
+		mode:    mode,
+		now:     time.Now,
+		tracker: httpsupgrade.NewTracker(),
+	}
+}
+
+func (uc *HTTPSUpgradeUseCase) currentMode() entity.HTTPSOnlyMode {
+	if uc.mode == nil {
+		return entity.HTTPSOnlyOff
+	}
+	return uc.mode()
+}
+
+// Rewrite returns the URL to load for rawURL: its https:// version when it
+// is upgraded, rawURL otherwise. It is the URL rewriter of navigations.
+func (uc *HTTPSUpgradeUseCase) Rewrite(rawURL string) string {
+	if d := uc.Navigate(rawURL); d.Action == httpsupgrade.ActionUpgrade {
+		return d.URL
+	}
+	return rawURL
+}
+
+// Navigate decides how a main-frame load of rawURL is made.
+func (uc *HTTPSUpgradeUseCase) Navigate(rawURL string) httpsupgrade.Decision {
+	if uc == nil {
+		return httpsupgrade.Decision{}
+	}
+	mode := uc.currentMode()
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.tracker.Navigate(mode, rawURL, uc.now())
+}
+
+// Failed decides what follows a failed main-frame load of rawURL.
+func (uc *HTTPSUpgradeUseCase) Failed(rawURL string) httpsupgrade.Decision {
+	if uc == nil {
+		return httpsupgrade.Decision{}
+	}
+	mode := uc.currentMode()
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.tracker.Failed(mode, rawURL, uc.now())
+}
+
+// Settled records that the main-frame load of rawURL committed or was
+// cancelled.
+func (uc *HTTPSUpgradeUseCase) Settled(rawURL string) {
+	if uc == nil {
+		return
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.tracker.Settled(rawURL)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/httpsupgrade"
+)
+
+func TestHTTPSUpgradeUseCase_FollowsLiveMode(t *testing.T) {
+	mode := entity.HTTPSOnlyOff
+	uc := NewHTTPSUpgradeUseCase(func() entity.HTTPSOnlyMode { return mode })
+	uc.now = func() time.Time { return time.Unix(1000, 0) }
+
+	assert.Equal(t, "http://example.com/", uc.Rewrite("http://example.com/"))
+
+	mode = entity.HTTPSOnlyLenient
+	assert.Equal(t, "https://example.com/", uc.Rewrite("http://example.com/"))
+
+	d := uc.Failed("https://example.com/")
+	assert.Equal(t, httpsupgrade.ActionFallback, d.Action)
+	assert.Equal(t, "http://example.com/", d.URL)
+	assert.Equal(t, "http://example.com/", uc.Rewrite("http://example.com/"),
+		"the host keeps its exception")
+	assert.Equal(t, httpsupgrade.ActionAllowInsecure, uc.Navigate("http://example.com/").Action)
+}
+
+func TestHTTPSUpgradeUseCase_SettledUpgradeDoesNotFallBack(t *testing.T) {
+	uc := NewHTTPSUpgradeUseCase(func() entity.HTTPSOnlyMode { return entity.HTTPSOnlyStrict })
+
+	assert.Equal(t, "https://example.com/", uc.Rewrite("http://example.com/"))
+	uc.Settled("https://example.com/")
+	assert.Equal(t, httpsupgrade.Decision{}, uc.Failed("https://example.com/"))
+
+	var nilUC *HTTPSUpgradeUseCase
+	assert.Equal(t, "http://example.com/", nilUC.Rewrite("http://example.com/"))
+}
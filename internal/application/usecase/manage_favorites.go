@@ -11,6 +11,7 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/autocomplete"
 	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/domain/repository"
 	domainurl "github.com/bnema/dumber/internal/domain/url"
@@ -328,8 +329,14 @@ func (uc *ManageFavoritesUseCase) GetAll(ctx context.Context) ([]*entity.Favorit
 	return favs, nil
 }
 
-// FilterForOmnibox returns favorites filtered and ranked for omnibox display.
-func (uc *ManageFavoritesUseCase) FilterForOmnibox(ctx context.Context, query string) ([]*entity.Favorite, error) {
+// FilterForOmnibox returns favorites filtered by mode and ranked for omnibox
+// display. Fuzzy matches that fit no exact, prefix or contains rank are
+// ordered by their fuzzy score.
+func (uc *ManageFavoritesUseCase) FilterForOmnibox(
+	ctx context.Context,
+	query string,
+	mode entity.OmniboxMatchMode,
+) ([]*entity.Favorite, error) {
 	favs, err := uc.GetAll(ctx)
 	if err != nil {
 		return nil, err
@@ -341,15 +348,19 @@ func (uc *ManageFavoritesUseCase) FilterForOmnibox(ctx context.Context, query st
 	}
 
 	filtered := make([]*entity.Favorite, 0, len(favs))
+	fuzzyScores := make(map[*entity.Favorite]int)
 	for _, fav := range favs {
 		if fav == nil {
 			continue
 		}
-		titleLower := strings.ToLower(fav.Title)
-		urlLower := strings.ToLower(fav.URL)
-		if !strings.Contains(titleLower, queryLower) && !strings.Contains(urlLower, queryLower) {
+		fields := append([]string{fav.Title}, autocomplete.URLMatchFields(fav.URL)...)
+		score, ok := autocomplete.BestMatchScore(mode, queryLower, fields...)
+		if !ok {
 			continue
 		}
+		if mode == entity.OmniboxMatchModeFuzzy {
+			fuzzyScores[fav] = score
+		}
 		filtered = append(filtered, fav)
 	}
 
@@ -359,6 +370,9 @@ func (uc *ManageFavoritesUseCase) FilterForOmnibox(ctx context.Context, query st
 		if leftScore != rightScore {
 			return leftScore > rightScore
 		}
+		if fuzzyScores[filtered[i]] != fuzzyScores[filtered[j]] {
+			return fuzzyScores[filtered[i]] > fuzzyScores[filtered[j]]
+		}
 		if filtered[i].Position != filtered[j].Position {
 			return filtered[i].Position < filtered[j].Position
 		}
@@ -283,7 +283,7 @@ func TestManageFavoritesUseCase_FilterForOmnibox_RanksPrefixBeforeContains(t *te
 
 	uc := usecase.NewManageFavoritesUseCase(favoriteRepo, tagRepo)
 
-	results, err := uc.FilterForOmnibox(ctx, "git")
+	results, err := uc.FilterForOmnibox(ctx, "git", entity.OmniboxMatchModeSubstring)
 	require.NoError(t, err)
 	require.Len(t, results, 3)
 	assert.Equal(t, "https://github.com/bnema/dumber", results[0].URL)
@@ -291,6 +291,44 @@ func TestManageFavoritesUseCase_FilterForOmnibox_RanksPrefixBeforeContains(t *te
 	assert.Equal(t, "https://example.com/landing", results[2].URL)
 }
 
+func TestManageFavoritesUseCase_FilterForOmnibox_MatchModes(t *testing.T) {
+	ctx := testContext()
+
+	favoriteRepo := repomocks.NewMockFavoriteRepository(t)
+	tagRepo := repomocks.NewMockTagRepository(t)
+
+	favorites := []*entity.Favorite{
+		{ID: 1, URL: "https://notes.example/gist-hub", Title: "Gist Hub notes", Position: 0},
+		{ID: 2, URL: "https://github.com/bnema/dumber", Title: "Project repo", Position: 1},
+		{ID: 3, URL: "https://example.com/github", Title: "Mirror", Position: 2},
+	}
+	favoriteRepo.EXPECT().GetAll(mock.Anything).Return(favorites, nil)
+
+	uc := usecase.NewManageFavoritesUseCase(favoriteRepo, tagRepo)
+	urls := func(mode entity.OmniboxMatchMode, query string) []string {
+		results, err := uc.FilterForOmnibox(ctx, query, mode)
+		require.NoError(t, err)
+		out := make([]string, 0, len(results))
+		for _, fav := range results {
+			out = append(out, fav.URL)
+		}
+		return out
+	}
+
+	assert.Empty(t, urls(entity.OmniboxMatchModeSubstring, "gthb"))
+	// The last two score the same fuzzy run, so favorite position decides.
+	assert.Equal(t, []string{
+		"https://github.com/bnema/dumber",
+		"https://notes.example/gist-hub",
+		"https://example.com/github",
+	}, urls(entity.OmniboxMatchModeFuzzy, "gthb"))
+	assert.Equal(t, []string{"https://github.com/bnema/dumber"}, urls(entity.OmniboxMatchModePrefix, "github"))
+	assert.Equal(t, []string{
+		"https://github.com/bnema/dumber",
+		"https://example.com/github",
+	}, urls(entity.OmniboxMatchModeSubstring, "github"))
+}
+
 func TestManageFavoritesUseCase_FilterForOmnibox_EmptyQueryKeepsRepositoryOrder(t *testing.T) {
 	ctx := testContext()
 
@@ -305,7 +343,7 @@ func TestManageFavoritesUseCase_FilterForOmnibox_EmptyQueryKeepsRepositoryOrder(
 
 	uc := usecase.NewManageFavoritesUseCase(favoriteRepo, tagRepo)
 
-	results, err := uc.FilterForOmnibox(ctx, "")
+	results, err := uc.FilterForOmnibox(ctx, "", entity.OmniboxMatchModeSubstring)
 	require.NoError(t, err)
 	require.Len(t, results, 2)
 	assert.Equal(t, "https://first.example", results[0].URL)
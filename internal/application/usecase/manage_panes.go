@@ -53,6 +53,15 @@ const (
 
 var ErrNothingToResize = errors.New("nothing to resize")
 
+// balancedSplitRatio gives both sides of a split the same space.
+const balancedSplitRatio = 0.5
+
+var (
+	ErrNothingToCollapse = errors.New("nothing to collapse")
+	ErrNothingToExpand   = errors.New("nothing to expand")
+	ErrNothingToBalance  = errors.New("nothing to balance")
+)
+
 type ConsumeOrExpelDirection string
 
 const (
@@ -1823,6 +1832,232 @@ func splitExistingNodeWithMarker(
 	return nil
 }
 
+// CollapseToStack replaces the whole pane tree with a single stack holding
+// every pane in tree order (left before right, top before bottom, stack
+// members in stack order). Pane nodes are reused, so pane identities are
+// preserved, and the active pane becomes the visible stack entry.
+// Returns ErrNothingToCollapse when the tree is already a single stack or leaf.
+func (uc *ManagePanesUseCase) CollapseToStack(ctx context.Context, ws *entity.Workspace) (*entity.PaneNode, error) {
+	if ws == nil {
+		return nil, fmt.Errorf("workspace is required")
+	}
+	if ws.Root == nil || ws.Root.IsLeaf() || ws.Root.IsStacked {
+		return nil, ErrNothingToCollapse
+	}
+
+	var leaves []*entity.PaneNode
+	ws.Root.Walk(func(node *entity.PaneNode) bool {
+		if node.Pane != nil {
+			leaves = append(leaves, node)
+			return false
+		}
+		return true
+	})
+	if len(leaves) < 2 {
+		return nil, ErrNothingToCollapse
+	}
+
+	stackNode := &entity.PaneNode{
+		ID:        uc.idGenerator(),
+		IsStacked: true,
+		Children:  leaves,
+	}
+	for i, leaf := range leaves {
+		leaf.Parent = stackNode
+		leaf.Children = nil
+		leaf.SplitDir = entity.SplitNone
+		if leaf.Pane.ID == ws.ActivePaneID {
+			stackNode.ActiveStackIndex = i
+		}
+	}
+	ws.Root = stackNode
+	ws.ActivePaneID = leaves[stackNode.ActiveStackIndex].Pane.ID
+
+	logging.FromContext(ctx).Info().
+		Str("stack_id", stackNode.ID).
+		Int("panes", len(leaves)).
+		Msg("collapsed workspace into stack")
+
+	return stackNode, nil
+}
+
+// ExpandStack re-splits the stack holding the active pane into an even grid.
+// The grid has ceil(sqrt(n)) columns; rows are filled in stack order and
+// every split divides its space evenly. The stack node is converted in place
+// so its position in the tree is kept, and the active pane stays active.
+// Returns ErrNothingToExpand when the active pane is not stacked.
+func (uc *ManagePanesUseCase) ExpandStack(ctx context.Context, ws *entity.Workspace) (*entity.PaneNode, error) {
+	if ws == nil {
+		return nil, fmt.Errorf("workspace is required")
+	}
+	active := ws.ActivePane()
+	if active == nil || active.Parent == nil || !active.Parent.IsStacked {
+		return nil, ErrNothingToExpand
+	}
+
+	stackNode := active.Parent
+	panes := append([]*entity.PaneNode(nil), stackNode.Children...)
+	if len(panes) == 1 {
+		dissolveStackIntoLeaf(stackNode)
+		return stackNode, nil
+	}
+
+	cols := int(math.Ceil(math.Sqrt(float64(len(panes)))))
+	rows := make([]*entity.PaneNode, 0, (len(panes)+cols-1)/cols)
+	for start := 0; start < len(panes); start += cols {
+		end := min(start+cols, len(panes))
+		rows = append(rows, uc.evenSplitChain(panes[start:end], entity.SplitHorizontal))
+	}
+	grid := uc.evenSplitChain(rows, entity.SplitVertical)
+
+	stackNode.IsStacked = false
+	stackNode.ActiveStackIndex = 0
+	stackNode.Pane = nil
+	stackNode.SplitDir = grid.SplitDir
+	stackNode.SplitRatio = grid.SplitRatio
+	stackNode.Children = grid.Children
+	for _, child := range stackNode.Children {
+		child.Parent = stackNode
+	}
+
+	logging.FromContext(ctx).Info().
+		Str("node_id", stackNode.ID).
+		Int("panes", len(panes)).
+		Int("columns", cols).
+		Msg("expanded stack into grid")
+
+	return stackNode, nil
+}
+
+// evenSplitChain nests nodes into binary splits along dir so that each node
+// ends up with an equal share of the space.
+func (uc *ManagePanesUseCase) evenSplitChain(nodes []*entity.PaneNode, dir entity.SplitDirection) *entity.PaneNode {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	first := nodes[0]
+	rest := uc.evenSplitChain(nodes[1:], dir)
+	splitNode := &entity.PaneNode{
+		ID:         uc.idGenerator(),
+		SplitDir:   dir,
+		SplitRatio: 1 / float64(len(nodes)),
+		Children:   []*entity.PaneNode{first, rest},
+	}
+	first.Parent = splitNode
+	rest.Parent = splitNode
+	return splitNode
+}
+
+// ReplaceWithRelatedPaneInput contains parameters for ReplaceWithRelatedPane.
+type ReplaceWithRelatedPaneInput struct {
+	Workspace    *entity.Workspace
+	PaneID       entity.PaneID // Pane to replace
+	ParentPaneID entity.PaneID // Pane whose browsing context is shared
+	NewPaneID    entity.PaneID // Optional: ID for the new pane (default: generated)
+}
+
+// ReplaceWithRelatedPane swaps a pane for a new related pane that shares
+// browsing context with ParentPaneID. The new node takes the old node's slot
+// in the tree (including its stack position) and inherits its URI and title;
+// the workspace active pane follows the replacement.
+func (uc *ManagePanesUseCase) ReplaceWithRelatedPane(
+	ctx context.Context,
+	input ReplaceWithRelatedPaneInput,
+) (*entity.PaneNode, error) {
+	ws := input.Workspace
+	paneID := input.PaneID
+	parentPaneID := input.ParentPaneID
+	if ws == nil {
+		return nil, fmt.Errorf("workspace is required")
+	}
+	oldNode := ws.FindPane(paneID)
+	if oldNode == nil || oldNode.Pane == nil {
+		return nil, fmt.Errorf("pane %s not found", paneID)
+	}
+	if parentPaneID == paneID || ws.FindPane(parentPaneID) == nil {
+		return nil, fmt.Errorf("related parent pane %s not found", parentPaneID)
+	}
+
+	newPaneID := input.NewPaneID
+	if newPaneID == "" {
+		newPaneID = entity.PaneID(uc.idGenerator())
+	}
+	newPane := entity.NewPane(newPaneID)
+	newPane.URI = oldNode.Pane.URI
+	newPane.Title = oldNode.Pane.Title
+	newPane.FaviconURL = oldNode.Pane.FaviconURL
+	newPane.ZoomFactor = oldNode.Pane.ZoomFactor
+	newPane.IsRelated = true
+	parentID := parentPaneID
+	newPane.ParentPaneID = &parentID
+
+	newNode := &entity.PaneNode{
+		ID:     string(newPaneID),
+		Pane:   newPane,
+		Parent: oldNode.Parent,
+	}
+	if oldNode.Parent == nil {
+		ws.Root = newNode
+	} else {
+		for i, child := range oldNode.Parent.Children {
+			if child == oldNode {
+				oldNode.Parent.Children[i] = newNode
+				break
+			}
+		}
+	}
+	oldNode.Parent = nil
+
+	if ws.ActivePaneID == paneID {
+		ws.ActivePaneID = newPaneID
+	}
+
+	logging.FromContext(ctx).Info().
+		Str("old_pane", string(paneID)).
+		Str("new_pane", string(newPaneID)).
+		Str("parent_pane", string(parentPaneID)).
+		Msg("pane replaced with related pane")
+
+	return newNode, nil
+}
+
+// BalanceSplits resets every split of the workspace to an even 0.5 ratio,
+// walking the whole tree so nested splits are reset as well. It returns how
+// many ratios changed, or ErrNothingToBalance when the tree has no split.
+//
+//nolint:revive // receiver required for interface consistency
+func (uc *ManagePanesUseCase) BalanceSplits(ctx context.Context, ws *entity.Workspace) (int, error) {
+	if ws == nil {
+		return 0, fmt.Errorf("workspace is required")
+	}
+	if ws.Root == nil {
+		return 0, ErrNothingToBalance
+	}
+
+	splits, changed := 0, 0
+	ws.Root.Walk(func(node *entity.PaneNode) bool {
+		if !node.IsSplit() {
+			return true
+		}
+		splits++
+		if node.SplitRatio != balancedSplitRatio {
+			node.SplitRatio = balancedSplitRatio
+			changed++
+		}
+		return true
+	})
+	if splits == 0 {
+		return 0, ErrNothingToBalance
+	}
+
+	logging.FromContext(ctx).Debug().
+		Int("splits", splits).
+		Int("changed", changed).
+		Msg("balanced split ratios")
+
+	return changed, nil
+}
+
 // GetAllPanes returns all leaf panes in a workspace.
 //
 //nolint:revive // receiver required for interface consistency
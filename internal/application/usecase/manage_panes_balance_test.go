@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestManagePanesUseCase_BalanceSplits_ResetsEveryLevel(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+
+	// a | (stack(b, c) / (d | e)), with uneven ratios at every level.
+	inner := split(entity.SplitHorizontal, leaf("d"), leaf("e"))
+	inner.SplitRatio = 0.8
+	right := split(entity.SplitVertical, stack(leaf("b"), leaf("c")), inner)
+	right.SplitRatio = 0.3
+	root := split(entity.SplitHorizontal, leaf("a"), right)
+	root.SplitRatio = 0.5
+	ws := &entity.Workspace{Root: root, ActivePaneID: "d"}
+
+	changed, err := uc.BalanceSplits(context.Background(), ws)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changed != 2 {
+		t.Fatalf("changed=%d, want 2 (the root was already even)", changed)
+	}
+	for _, node := range []*entity.PaneNode{root, right, inner} {
+		if node.SplitRatio != 0.5 {
+			t.Fatalf("split ratio=%v, want 0.5", node.SplitRatio)
+		}
+	}
+	if ws.Root != root || root.Right() != right || right.Right() != inner {
+		t.Fatalf("balancing must not restructure the tree")
+	}
+	if ws.ActivePaneID != "d" {
+		t.Fatalf("active=%s, want d", ws.ActivePaneID)
+	}
+
+	changed, err = uc.BalanceSplits(context.Background(), ws)
+	if err != nil || changed != 0 {
+		t.Fatalf("second balance: changed=%d err=%v, want 0 and nil", changed, err)
+	}
+}
+
+func TestManagePanesUseCase_BalanceSplits_NothingToBalance(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+	ctx := context.Background()
+
+	for name, ws := range map[string]*entity.Workspace{
+		"empty":  {},
+		"single": {Root: leaf("a"), ActivePaneID: "a"},
+		"stack":  {Root: stack(leaf("a"), leaf("b")), ActivePaneID: "a"},
+	} {
+		if _, err := uc.BalanceSplits(ctx, ws); !errors.Is(err, ErrNothingToBalance) {
+			t.Fatalf("%s: err=%v, want ErrNothingToBalance", name, err)
+		}
+	}
+	if _, err := uc.BalanceSplits(ctx, nil); err == nil {
+		t.Fatalf("nil workspace should fail")
+	}
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func sequentialIDs() IDGenerator {
+	n := 0
+	return func() string {
+		n++
+		return fmt.Sprintf("node-%d", n)
+	}
+}
+
+func TestManagePanesUseCase_CollapseToStack_PreservesOrderAndActive(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+	ctx := context.Background()
+
+	a, b, c, d := leaf("a"), leaf("b"), leaf("c"), leaf("d")
+	right := split(entity.SplitVertical, stack(b, c), d)
+	root := split(entity.SplitHorizontal, a, right)
+	ws := &entity.Workspace{Root: root, ActivePaneID: "c"}
+	originals := map[entity.PaneID]*entity.Pane{"a": a.Pane, "b": b.Pane, "c": c.Pane, "d": d.Pane}
+
+	stackNode, err := uc.CollapseToStack(ctx, ws)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ws.Root != stackNode || !stackNode.IsStacked || stackNode.Parent != nil {
+		t.Fatalf("root should be the new stack")
+	}
+	if got := panesInOrder(stackNode); got != "a,b,c,d" {
+		t.Fatalf("stack panes=%s, want a,b,c,d", got)
+	}
+	for _, child := range stackNode.Children {
+		if child.Parent != stackNode {
+			t.Fatalf("pane %s parent not updated", child.Pane.ID)
+		}
+		if originals[child.Pane.ID] != child.Pane {
+			t.Fatalf("pane %s identity changed", child.Pane.ID)
+		}
+	}
+	if stackNode.ActiveStackIndex != 2 {
+		t.Fatalf("active index=%d, want 2", stackNode.ActiveStackIndex)
+	}
+	if ws.ActivePaneID != "c" {
+		t.Fatalf("active=%s, want c", ws.ActivePaneID)
+	}
+}
+
+func TestManagePanesUseCase_CollapseToStack_NothingToCollapse(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+	ctx := context.Background()
+
+	single := &entity.Workspace{Root: leaf("a"), ActivePaneID: "a"}
+	if _, err := uc.CollapseToStack(ctx, single); !errors.Is(err, ErrNothingToCollapse) {
+		t.Fatalf("single pane err=%v, want ErrNothingToCollapse", err)
+	}
+
+	stacked := &entity.Workspace{Root: stack(leaf("a"), leaf("b")), ActivePaneID: "a"}
+	if _, err := uc.CollapseToStack(ctx, stacked); !errors.Is(err, ErrNothingToCollapse) {
+		t.Fatalf("stacked root err=%v, want ErrNothingToCollapse", err)
+	}
+}
+
+func TestManagePanesUseCase_ExpandStack_BuildsEvenGrid(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+	ctx := context.Background()
+
+	a, b, c, d, e := leaf("a"), leaf("b"), leaf("c"), leaf("d"), leaf("e")
+	stackNode := stack(a, b, c, d, e)
+	stackNode.ActiveStackIndex = 3
+	ws := &entity.Workspace{Root: stackNode, ActivePaneID: "d"}
+
+	node, err := uc.ExpandStack(ctx, ws)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if node != stackNode || ws.Root != stackNode {
+		t.Fatalf("stack node should be converted in place")
+	}
+	if stackNode.IsStacked || stackNode.SplitDir != entity.SplitVertical {
+		t.Fatalf("root should be a vertical split of rows")
+	}
+	if stackNode.SplitRatio != 0.5 {
+		t.Fatalf("row ratio=%v, want 0.5", stackNode.SplitRatio)
+	}
+
+	// 5 panes -> 3 columns: [a b c] over [d e].
+	visible := ws.Root.VisiblePanes()
+	var order string
+	for i, node := range visible {
+		if i > 0 {
+			order += ","
+		}
+		order += string(node.Pane.ID)
+	}
+	if order != "a,b,c,d,e" {
+		t.Fatalf("grid order=%s, want a,b,c,d,e", order)
+	}
+
+	top := stackNode.Left()
+	if top.SplitDir != entity.SplitHorizontal || top.SplitRatio != 1.0/3 {
+		t.Fatalf("top row dir=%v ratio=%v, want horizontal 1/3", top.SplitDir, top.SplitRatio)
+	}
+	if top.Left() != a || a.Parent != top {
+		t.Fatalf("pane a should be reused as first cell")
+	}
+	bottom := stackNode.Right()
+	if bottom.SplitDir != entity.SplitHorizontal || bottom.Left() != d || bottom.Right() != e {
+		t.Fatalf("bottom row should split d and e")
+	}
+	if ws.ActivePaneID != "d" || ws.ActivePane() != d {
+		t.Fatalf("active=%s, want d", ws.ActivePaneID)
+	}
+}
+
+func TestManagePanesUseCase_ExpandStack_KeepsPositionInTree(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+	ctx := context.Background()
+
+	x := leaf("x")
+	stackNode := stack(leaf("a"), leaf("b"))
+	root := split(entity.SplitHorizontal, x, stackNode)
+	ws := &entity.Workspace{Root: root, ActivePaneID: "b"}
+
+	if _, err := uc.ExpandStack(ctx, ws); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if root.Right() != stackNode || stackNode.Parent != root {
+		t.Fatalf("expanded node should keep its parent slot")
+	}
+	if !stackNode.IsSplit() || stackNode.SplitDir != entity.SplitHorizontal {
+		t.Fatalf("two panes should expand into a horizontal split")
+	}
+	if ws.ActivePaneID != "b" {
+		t.Fatalf("active=%s, want b", ws.ActivePaneID)
+	}
+}
+
+func TestManagePanesUseCase_ExpandStack_NothingToExpand(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+	ws := &entity.Workspace{Root: split(entity.SplitHorizontal, leaf("a"), leaf("b")), ActivePaneID: "a"}
+
+	if _, err := uc.ExpandStack(context.Background(), ws); !errors.Is(err, ErrNothingToExpand) {
+		t.Fatalf("err=%v, want ErrNothingToExpand", err)
+	}
+}
+
+func TestManagePanesUseCase_CollapseThenExpand_RoundTrip(t *testing.T) {
+	uc := NewManagePanesUseCase(sequentialIDs(), nil)
+	ctx := context.Background()
+
+	root := split(entity.SplitHorizontal, leaf("a"), split(entity.SplitVertical, leaf("b"), leaf("c")))
+	ws := &entity.Workspace{Root: root, ActivePaneID: "b"}
+
+	if _, err := uc.CollapseToStack(ctx, ws); err != nil {
+		t.Fatalf("collapse: %v", err)
+	}
+	if _, err := uc.ExpandStack(ctx, ws); err != nil {
+		t.Fatalf("expand: %v", err)
+	}
+	if ws.PaneCount() != 3 {
+		t.Fatalf("pane count=%d, want 3", ws.PaneCount())
+	}
+	if ws.ActivePaneID != "b" || ws.ActivePane() == nil {
+		t.Fatalf("active pane lost after round trip")
+	}
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestManagePanesUseCase_ReplaceWithRelatedPane_ReplacesInPlace(t *testing.T) {
+	uc := NewManagePanesUseCase(func() string { return "generated" }, nil)
+	ctx := context.Background()
+
+	parent := leaf("parent")
+	oauth := leaf("oauth")
+	oauth.Pane.URI = "https://accounts.example.com/login"
+	oauth.Pane.Title = "Sign in"
+	stackNode := stack(leaf("other"), oauth)
+	stackNode.ActiveStackIndex = 1
+	root := split(entity.SplitHorizontal, parent, stackNode)
+	ws := &entity.Workspace{Root: root, ActivePaneID: "oauth"}
+
+	newNode, err := uc.ReplaceWithRelatedPane(ctx, ReplaceWithRelatedPaneInput{
+		Workspace:    ws,
+		PaneID:       "oauth",
+		ParentPaneID: "parent",
+		NewPaneID:    "related",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stackNode.Children[1] != newNode || newNode.Parent != stackNode {
+		t.Fatalf("new node should take the old node's stack slot")
+	}
+	if oauth.Parent != nil {
+		t.Fatalf("old node should be detached")
+	}
+	if ws.FindPane("oauth") != nil {
+		t.Fatalf("old pane should no longer be in the tree")
+	}
+	pane := newNode.Pane
+	if pane.ID != "related" || !pane.IsRelated {
+		t.Fatalf("pane=%+v, want related pane", pane)
+	}
+	if pane.ParentPaneID == nil || *pane.ParentPaneID != "parent" {
+		t.Fatalf("parent pane=%v, want parent", pane.ParentPaneID)
+	}
+	if pane.URI != "https://accounts.example.com/login" || pane.Title != "Sign in" {
+		t.Fatalf("uri=%q title=%q, want inherited values", pane.URI, pane.Title)
+	}
+	if ws.ActivePaneID != "related" {
+		t.Fatalf("active=%s, want related", ws.ActivePaneID)
+	}
+}
+
+func TestManagePanesUseCase_ReplaceWithRelatedPane_RootAndGeneratedID(t *testing.T) {
+	uc := NewManagePanesUseCase(func() string { return "generated" }, nil)
+	ctx := context.Background()
+
+	left := leaf("left")
+	right := leaf("right")
+	ws := &entity.Workspace{Root: split(entity.SplitVertical, left, right), ActivePaneID: "left"}
+
+	newNode, err := uc.ReplaceWithRelatedPane(ctx, ReplaceWithRelatedPaneInput{
+		Workspace:    ws,
+		PaneID:       "right",
+		ParentPaneID: "left",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if newNode.Pane.ID != "generated" || ws.Root.Right() != newNode {
+		t.Fatalf("new node should use the generated id and replace right")
+	}
+	if ws.ActivePaneID != "left" {
+		t.Fatalf("active=%s, want left unchanged", ws.ActivePaneID)
+	}
+}
+
+func TestManagePanesUseCase_ReplaceWithRelatedPane_RejectsInvalidParent(t *testing.T) {
+	uc := NewManagePanesUseCase(func() string { return "generated" }, nil)
+	ctx := context.Background()
+	ws := &entity.Workspace{Root: split(entity.SplitVertical, leaf("a"), leaf("b")), ActivePaneID: "a"}
+
+	for _, parent := range []entity.PaneID{"a", "missing"} {
+		_, err := uc.ReplaceWithRelatedPane(ctx, ReplaceWithRelatedPaneInput{
+			Workspace:    ws,
+			PaneID:       "a",
+			ParentPaneID: parent,
+		})
+		if err == nil {
+			t.Fatalf("parent %q: expected error", parent)
+		}
+	}
+	if ws.FindPane("a") == nil {
+		t.Fatalf("tree should be unchanged on error")
+	}
+}
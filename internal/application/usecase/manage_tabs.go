@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/entity"
@@ -122,6 +123,96 @@ func (uc *ManageTabsUseCase) Close(ctx context.Context, tabs *entity.TabList, ta
 	return false, nil
 }
 
+// CloseToTheRight closes the tabs after tabID, keeping pinned ones. When the
+// active tab is closed, tabID becomes active. Returns the closed tabs in
+// their former order.
+func (uc *ManageTabsUseCase) CloseToTheRight(
+	ctx context.Context, tabs *entity.TabList, tabID entity.TabID,
+) ([]*entity.Tab, error) {
+	return uc.closeMany(ctx, tabs, tabID, "close tabs to the right", tabsToTheRight)
+}
+
+// CloseOthers closes every tab except tabID, keeping pinned ones. When the
+// active tab is closed, tabID becomes active. Returns the closed tabs in
+// their former order.
+func (uc *ManageTabsUseCase) CloseOthers(
+	ctx context.Context, tabs *entity.TabList, tabID entity.TabID,
+) ([]*entity.Tab, error) {
+	return uc.closeMany(ctx, tabs, tabID, "close other tabs", otherTabs)
+}
+
+// tabsToTheRight selects the unpinned tabs after tabID.
+func tabsToTheRight(all []*entity.Tab, tabID entity.TabID) []*entity.Tab {
+	var selected []*entity.Tab
+	found := false
+	for _, tab := range all {
+		if tab == nil {
+			continue
+		}
+		if tab.ID == tabID {
+			found = true
+			continue
+		}
+		if found && !tab.IsPinned {
+			selected = append(selected, tab)
+		}
+	}
+	return selected
+}
+
+// otherTabs selects the unpinned tabs other than tabID.
+func otherTabs(all []*entity.Tab, tabID entity.TabID) []*entity.Tab {
+	var selected []*entity.Tab
+	for _, tab := range all {
+		if tab != nil && tab.ID != tabID && !tab.IsPinned {
+			selected = append(selected, tab)
+		}
+	}
+	return selected
+}
+
+func (uc *ManageTabsUseCase) closeMany(
+	ctx context.Context,
+	tabs *entity.TabList,
+	tabID entity.TabID,
+	op string,
+	selectTabs func([]*entity.Tab, entity.TabID) []*entity.Tab,
+) ([]*entity.Tab, error) {
+	ctx = logging.WithTabID(ctx, string(tabID))
+	log := logging.FromContext(ctx)
+	if uc == nil {
+		return nil, fmt.Errorf("manage tabs use case is nil")
+	}
+	if tabs == nil {
+		return nil, fmt.Errorf("tab list is required")
+	}
+	if tabs.Find(tabID) == nil {
+		return nil, fmt.Errorf("tab not found: %s", tabID)
+	}
+
+	closed := selectTabs(append([]*entity.Tab(nil), tabs.Tabs...), tabID)
+	activeID, previousID := tabs.ActiveTabID, tabs.PreviousActiveTabID
+	for _, tab := range closed {
+		tabs.Remove(tab.ID)
+	}
+	if tabs.Find(activeID) == nil {
+		tabs.ActiveTabID = tabID
+	}
+	// Remove moves the active tab through neighbours that may close next;
+	// only a surviving, distinct previous tab is kept for switch_last_tab.
+	if previousID == tabs.ActiveTabID || tabs.Find(previousID) == nil {
+		previousID = ""
+	}
+	tabs.PreviousActiveTabID = previousID
+
+	log.Info().
+		Int("closed", len(closed)).
+		Int("remaining", tabs.Count()).
+		Msg(op)
+
+	return closed, nil
+}
+
 // Switch changes the active tab.
 func (uc *ManageTabsUseCase) Switch(ctx context.Context, tabs *entity.TabList, tabID entity.TabID) error {
 	log := logging.FromContext(ctx)
@@ -140,6 +231,9 @@ func (uc *ManageTabsUseCase) Switch(ctx context.Context, tabs *entity.TabList, t
 	}
 
 	oldActive := tabs.ActiveTabID
+	if oldActive != tabID {
+		rememberActivePane(tabs.Find(oldActive))
+	}
 	tabs.SetActive(tabID) // Uses SetActive to track previous tab
 
 	log.Info().
@@ -150,6 +244,22 @@ func (uc *ManageTabsUseCase) Switch(ctx context.Context, tabs *entity.TabList, t
 	return nil
 }
 
+// ForgetPane drops the last-active record of a closed pane.
+func (*ManageTabsUseCase) ForgetPane(tabs *entity.TabList, paneID entity.PaneID) {
+	if tabs == nil {
+		return
+	}
+	tabs.ForgetPane(paneID)
+}
+
+// rememberActivePane records the pane in use as the tab is left.
+func rememberActivePane(tab *entity.Tab) {
+	if tab == nil || tab.Workspace == nil || tab.Workspace.ActivePaneID == "" {
+		return
+	}
+	tab.LastActivePaneID = tab.Workspace.ActivePaneID
+}
+
 // Move repositions a tab within the tab bar.
 func (uc *ManageTabsUseCase) Move(ctx context.Context, tabs *entity.TabList, tabID entity.TabID, newPosition int) error {
 	log := logging.FromContext(ctx)
@@ -325,6 +435,127 @@ func (uc *ManageTabsUseCase) Pin(ctx context.Context, tabs *entity.TabList, tabI
 	return nil
 }
 
+// CreateTabGroupInput contains parameters for creating a tab group.
+type CreateTabGroupInput struct {
+	TabList *entity.TabList
+	Name    string               // Optional; defaults to "Group N"
+	Color   entity.TabGroupColor // Optional; defaults to the first color no group uses
+	TabIDs  []entity.TabID       // Tabs moved into the group, at least one
+}
+
+// CreateGroup creates a tab group holding the given tabs, taking them out of
+// the groups they were in.
+func (uc *ManageTabsUseCase) CreateGroup(ctx context.Context, input CreateTabGroupInput) (*entity.TabGroup, error) {
+	log := logging.FromContext(ctx)
+	if uc == nil {
+		return nil, fmt.Errorf("manage tabs use case is nil")
+	}
+	tabs := input.TabList
+	if tabs == nil {
+		return nil, fmt.Errorf("tab list is required")
+	}
+	if len(input.TabIDs) == 0 {
+		return nil, fmt.Errorf("tab group needs at least one tab")
+	}
+	for _, tabID := range input.TabIDs {
+		if tabs.Find(tabID) == nil {
+			return nil, fmt.Errorf("tab not found: %s", tabID)
+		}
+	}
+	color := input.Color
+	if color == "" {
+		color = nextTabGroupColor(tabs.Groups)
+	} else if !color.Valid() {
+		return nil, fmt.Errorf("unknown tab group color: %s", color)
+	}
+	name := input.Name
+	if name == "" {
+		name = fmt.Sprintf("Group %d", len(tabs.Groups)+1)
+	}
+
+	for _, tabID := range input.TabIDs {
+		tabs.AssignGroup(tabID, "")
+	}
+	group := &entity.TabGroup{
+		ID:     entity.TabGroupID(uc.idGenerator()),
+		Name:   name,
+		Color:  color,
+		TabIDs: slices.Clone(input.TabIDs),
+	}
+	tabs.AddGroup(group)
+
+	log.Info().
+		Str("group_id", string(group.ID)).
+		Str("name", group.Name).
+		Str("color", string(group.Color)).
+		Int("tabs", len(group.TabIDs)).
+		Msg("tab group created")
+
+	return group, nil
+}
+
+// nextTabGroupColor returns the first color no group uses, cycling through
+// the palette once every color is taken.
+func nextTabGroupColor(groups []*entity.TabGroup) entity.TabGroupColor {
+	for _, color := range entity.TabGroupColors {
+		if !slices.ContainsFunc(groups, func(group *entity.TabGroup) bool { return group.Color == color }) {
+			return color
+		}
+	}
+	return entity.TabGroupColors[len(groups)%len(entity.TabGroupColors)]
+}
+
+// AssignToGroup moves a tab into an existing group. An empty groupID removes
+// the tab from its group; a group left without tabs is removed.
+func (uc *ManageTabsUseCase) AssignToGroup(
+	ctx context.Context, tabs *entity.TabList, tabID entity.TabID, groupID entity.TabGroupID,
+) error {
+	log := logging.FromContext(ctx)
+	if uc == nil {
+		return fmt.Errorf("manage tabs use case is nil")
+	}
+	if tabs == nil {
+		return fmt.Errorf("tab list is required")
+	}
+	if tabs.Find(tabID) == nil {
+		return fmt.Errorf("tab not found: %s", tabID)
+	}
+	if !tabs.AssignGroup(tabID, groupID) {
+		return fmt.Errorf("tab group not found: %s", groupID)
+	}
+
+	log.Info().
+		Str("tab_id", string(tabID)).
+		Str("group_id", string(groupID)).
+		Msg("tab group assigned")
+
+	return nil
+}
+
+// SetGroupCollapsed collapses or expands a tab group. A collapsed group only
+// shows its active tab, or its first tab while another tab is active.
+func (uc *ManageTabsUseCase) SetGroupCollapsed(
+	ctx context.Context, tabs *entity.TabList, groupID entity.TabGroupID, collapsed bool,
+) error {
+	log := logging.FromContext(ctx)
+	if uc == nil {
+		return fmt.Errorf("manage tabs use case is nil")
+	}
+	if tabs == nil {
+		return fmt.Errorf("tab list is required")
+	}
+	if !tabs.SetGroupCollapsed(groupID, collapsed) {
+		return fmt.Errorf("tab group not found: %s", groupID)
+	}
+
+	log.Info().
+		Str("group_id", string(groupID)).
+		Bool("collapsed", collapsed).
+		Msg("tab group collapse state changed")
+
+	return nil
+}
+
 // CreateTabWithPaneInput contains parameters for creating a tab with an existing pane.
 type CreateTabWithPaneInput struct {
 	TabList    *entity.TabList
@@ -380,3 +611,88 @@ func (uc *ManageTabsUseCase) CreateWithPane(ctx context.Context, input CreateTab
 
 	return &CreateTabOutput{Tab: tab}, nil
 }
+
+// maxFreshIDAttempts bounds how often Merge asks the generator for an ID
+// that is not already used by the tab list.
+const maxFreshIDAttempts = 16
+
+// Merge appends the tabs of a saved session to tabs, after the existing ones.
+// Every restored tab, workspace, pane and pane node gets a new ID that is not
+// already used in tabs. The active tab is left alone. Returns the appended
+// tabs in order.
+func (uc *ManageTabsUseCase) Merge(
+	ctx context.Context, tabs *entity.TabList, state *entity.SessionState,
+) ([]*entity.Tab, error) {
+	log := logging.FromContext(ctx)
+	if uc == nil {
+		return nil, fmt.Errorf("manage tabs use case is nil")
+	}
+	if tabs == nil {
+		return nil, fmt.Errorf("tab list is required")
+	}
+	if state == nil {
+		return nil, fmt.Errorf("session state is required")
+	}
+
+	restored := entity.TabListFromSnapshot(state, uc.freshIDGenerator(tabListIDs(tabs)))
+	for _, tab := range restored.Tabs {
+		tabs.Add(tab)
+	}
+	for _, group := range restored.Groups {
+		tabs.AddGroup(group)
+	}
+
+	log.Info().
+		Str("session_id", string(state.SessionID)).
+		Int("merged", len(restored.Tabs)).
+		Int("total", tabs.Count()).
+		Msg("session merged into tab list")
+
+	return restored.Tabs, nil
+}
+
+// freshIDGenerator wraps the use case generator so it skips the IDs in taken,
+// recording each ID it hands out.
+func (uc *ManageTabsUseCase) freshIDGenerator(taken map[string]struct{}) entity.IDGenerator {
+	return func() string {
+		id := uc.idGenerator()
+		for attempt := 1; attempt < maxFreshIDAttempts; attempt++ {
+			if _, used := taken[id]; !used {
+				break
+			}
+			id = uc.idGenerator()
+		}
+		taken[id] = struct{}{}
+		return id
+	}
+}
+
+// tabListIDs returns the IDs of the tabs, tab groups, workspaces, pane nodes
+// and panes in tabs.
+func tabListIDs(tabs *entity.TabList) map[string]struct{} {
+	ids := make(map[string]struct{})
+	for _, group := range tabs.Groups {
+		ids[string(group.ID)] = struct{}{}
+	}
+	for _, tab := range tabs.Tabs {
+		if tab == nil {
+			continue
+		}
+		ids[string(tab.ID)] = struct{}{}
+		if tab.Workspace == nil {
+			continue
+		}
+		ids[string(tab.Workspace.ID)] = struct{}{}
+		if tab.Workspace.Root == nil {
+			continue
+		}
+		tab.Workspace.Root.Walk(func(node *entity.PaneNode) bool {
+			ids[node.ID] = struct{}{}
+			if node.Pane != nil {
+				ids[string(node.Pane.ID)] = struct{}{}
+			}
+			return true
+		})
+	}
+	return ids
+}
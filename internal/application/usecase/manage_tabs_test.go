@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManageTabsSwitch_RemembersActivePaneOfLeftTab(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+
+	tabA := newStackedTab(entity.NewPane("pA"), entity.NewPane("pB"))
+	tabA.Workspace.ActivePaneID = "pB"
+	tabB := entity.NewTab("tB", "wB", entity.NewPane("pC"))
+
+	tabs := entity.NewTabList()
+	tabs.Add(tabA)
+	tabs.Add(tabB)
+	tabs.SetActive(tabA.ID)
+
+	require.NoError(t, uc.Switch(ctx, tabs, tabB.ID))
+	require.Equal(t, entity.PaneID("pB"), tabA.LastActivePaneID)
+
+	// The workspace may move its active pane while the tab is in the background.
+	tabA.Workspace.ActivePaneID = "pA"
+	require.Equal(t, entity.PaneID("pB"), tabA.PaneToFocus())
+
+	require.NoError(t, uc.Switch(ctx, tabs, tabA.ID))
+	require.Equal(t, entity.PaneID("pC"), tabB.LastActivePaneID)
+}
+
+func TestManageTabsForgetPane_FallsBackToActivePane(t *testing.T) {
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+
+	tab := newStackedTab(entity.NewPane("pA"), entity.NewPane("pB"))
+	tab.LastActivePaneID = "pB"
+	tabs := entity.NewTabList()
+	tabs.Add(tab)
+
+	uc.ForgetPane(tabs, "pB")
+	require.Empty(t, tab.LastActivePaneID)
+	require.Equal(t, entity.PaneID("pA"), tab.PaneToFocus())
+
+	uc.ForgetPane(nil, "pA")
+}
+
+func TestTabPaneToFocus_IgnoresPaneNoLongerInWorkspace(t *testing.T) {
+	tab := newStackedTab(entity.NewPane("pA"))
+	tab.LastActivePaneID = "gone"
+
+	require.Equal(t, entity.PaneID("pA"), tab.PaneToFocus())
+}
+
+func newTestTabList(pinned map[entity.TabID]bool, ids ...entity.TabID) *entity.TabList {
+	tabs := entity.NewTabList()
+	for _, id := range ids {
+		tab := entity.NewTab(id, entity.WorkspaceID("w"+id), entity.NewPane(entity.PaneID("p"+id)))
+		tab.IsPinned = pinned[id]
+		tabs.Add(tab)
+	}
+	return tabs
+}
+
+func tabIDs(tabs []*entity.Tab) []entity.TabID {
+	ids := make([]entity.TabID, 0, len(tabs))
+	for _, tab := range tabs {
+		ids = append(ids, tab.ID)
+	}
+	return ids
+}
+
+func TestTabsToCloseSelection(t *testing.T) {
+	all := newTestTabList(map[entity.TabID]bool{"a": true, "d": true}, "a", "b", "c", "d", "e").Tabs
+
+	tests := []struct {
+		name       string
+		selectTabs func([]*entity.Tab, entity.TabID) []*entity.Tab
+		tabID      entity.TabID
+		want       []entity.TabID
+	}{
+		{name: "right of middle skips pinned", selectTabs: tabsToTheRight, tabID: "b", want: []entity.TabID{"c", "e"}},
+		{name: "right of pinned first tab", selectTabs: tabsToTheRight, tabID: "a", want: []entity.TabID{"b", "c", "e"}},
+		{name: "right of last tab", selectTabs: tabsToTheRight, tabID: "e", want: []entity.TabID{}},
+		{name: "right of unknown tab", selectTabs: tabsToTheRight, tabID: "zz", want: []entity.TabID{}},
+		{name: "others skip pinned", selectTabs: otherTabs, tabID: "c", want: []entity.TabID{"b", "e"}},
+		{name: "others of pinned tab", selectTabs: otherTabs, tabID: "d", want: []entity.TabID{"b", "c", "e"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tabIDs(tt.selectTabs(all, tt.tabID)))
+		})
+	}
+}
+
+func TestManageTabsCloseToTheRight_ActivatesAnchorWhenActiveCloses(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	tabs := newTestTabList(map[entity.TabID]bool{"d": true}, "a", "b", "c", "d", "e")
+	tabs.SetActive("a")
+	tabs.SetActive("c")
+
+	closed, err := uc.CloseToTheRight(ctx, tabs, "b")
+	require.NoError(t, err)
+	require.Equal(t, []entity.TabID{"c", "e"}, tabIDs(closed))
+	require.Equal(t, []entity.TabID{"a", "b", "d"}, tabIDs(tabs.Tabs))
+	require.Equal(t, entity.TabID("b"), tabs.ActiveTabID)
+	require.Equal(t, entity.TabID("a"), tabs.PreviousActiveTabID)
+	for i, tab := range tabs.Tabs {
+		require.Equal(t, i, tab.Position)
+	}
+}
+
+func TestManageTabsCloseOthers_KeepsSurvivingActiveTab(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	tabs := newTestTabList(map[entity.TabID]bool{"a": true}, "a", "b", "c")
+	tabs.SetActive("c")
+	tabs.SetActive("a")
+
+	closed, err := uc.CloseOthers(ctx, tabs, "b")
+	require.NoError(t, err)
+	require.Equal(t, []entity.TabID{"c"}, tabIDs(closed))
+	require.Equal(t, []entity.TabID{"a", "b"}, tabIDs(tabs.Tabs))
+	require.Equal(t, entity.TabID("a"), tabs.ActiveTabID)
+	require.Empty(t, tabs.PreviousActiveTabID, "closed previous tab must be forgotten")
+}
+
+func TestManageTabsCloseOthers_UnknownTab(t *testing.T) {
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	tabs := newTestTabList(nil, "a", "b")
+
+	closed, err := uc.CloseOthers(context.Background(), tabs, "zz")
+	require.Error(t, err)
+	require.Empty(t, closed)
+	require.Equal(t, 2, tabs.Count())
+}
+
+func TestManageTabsMerge_AppendsSessionTabsWithFreshIDs(t *testing.T) {
+	ctx := context.Background()
+
+	// The generator restarts at id1, so it hands out IDs the current tabs
+	// already use; Merge must skip them.
+	current := entity.NewTabList()
+	current.Add(entity.NewTab("id1", "id2", entity.NewPane("id3")))
+	current.Add(entity.NewTab("id4", "id5", entity.NewPane("id6")))
+	current.SetActive("id4")
+
+	saved := entity.NewTabList()
+	saved.Add(entity.NewTab("id1", "id2", entity.NewPane("id3")))
+	pinned := newStackedTab(entity.NewPane("pA"), entity.NewPane("pB"))
+	pinned.IsPinned = true
+	saved.Add(pinned)
+	saved.Tabs[0].Workspace.Root.Pane.URI = "https://example.com/"
+	state := entity.SnapshotFromTabList("saved", saved)
+
+	existingIDs := tabListIDs(current)
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	merged, err := uc.Merge(ctx, current, state)
+	require.NoError(t, err)
+	require.Len(t, merged, 2)
+
+	require.Equal(t, 4, current.Count())
+	require.Equal(t, entity.TabID("id1"), current.Tabs[0].ID)
+	require.Equal(t, entity.TabID("id4"), current.Tabs[1].ID)
+	require.Same(t, merged[0], current.Tabs[2])
+	require.Same(t, merged[1], current.Tabs[3])
+	require.Equal(t, entity.TabID("id4"), current.ActiveTabID, "merging keeps the active tab")
+	for i, tab := range current.Tabs {
+		require.Equal(t, i, tab.Position)
+	}
+
+	require.Equal(t, "https://example.com/", merged[0].Workspace.Root.Pane.URI)
+	require.True(t, merged[1].IsPinned)
+	require.Equal(t, 2, merged[1].PaneCount())
+
+	seen := make(map[string]bool)
+	for _, tab := range merged {
+		ids := []string{string(tab.ID), string(tab.Workspace.ID)}
+		tab.Workspace.Root.Walk(func(node *entity.PaneNode) bool {
+			ids = append(ids, node.ID)
+			if node.Pane != nil {
+				ids = append(ids, string(node.Pane.ID))
+			}
+			return true
+		})
+		for _, id := range ids {
+			require.NotEmpty(t, id)
+			require.NotContains(t, existingIDs, id, "merged id collides with the current tabs")
+			require.False(t, seen[id], "merged id %q is used twice", id)
+			seen[id] = true
+		}
+	}
+}
+
+func TestManageTabsMerge_RequiresState(t *testing.T) {
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	_, err := uc.Merge(context.Background(), entity.NewTabList(), nil)
+	require.Error(t, err)
+}
+
+func TestManageTabsCreateGroup_DefaultsNameAndUnusedColor(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	tabs := newTestTabList(nil, "a", "b", "c")
+
+	first, err := uc.CreateGroup(ctx, CreateTabGroupInput{TabList: tabs, TabIDs: []entity.TabID{"a", "b"}})
+	require.NoError(t, err)
+	require.Equal(t, "Group 1", first.Name)
+	require.Equal(t, entity.TabGroupColorBlue, first.Color)
+	require.Equal(t, []entity.TabID{"a", "b"}, first.TabIDs)
+
+	second, err := uc.CreateGroup(ctx, CreateTabGroupInput{
+		TabList: tabs, Name: "dumber", TabIDs: []entity.TabID{"b", "c"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "dumber", second.Name)
+	require.Equal(t, entity.TabGroupColorRed, second.Color, "new groups pick a color no group uses")
+	require.NotEqual(t, first.ID, second.ID)
+	require.Equal(t, []entity.TabID{"a"}, first.TabIDs, "tabs leave their previous group")
+	require.Same(t, second, tabs.GroupOf("b"))
+}
+
+func TestManageTabsCreateGroup_RejectsBadInput(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	tabs := newTestTabList(nil, "a")
+
+	_, err := uc.CreateGroup(ctx, CreateTabGroupInput{TabIDs: []entity.TabID{"a"}})
+	require.Error(t, err)
+	_, err = uc.CreateGroup(ctx, CreateTabGroupInput{TabList: tabs})
+	require.Error(t, err)
+	_, err = uc.CreateGroup(ctx, CreateTabGroupInput{TabList: tabs, TabIDs: []entity.TabID{"a", "missing"}})
+	require.Error(t, err)
+	_, err = uc.CreateGroup(ctx, CreateTabGroupInput{TabList: tabs, Color: "mauve", TabIDs: []entity.TabID{"a"}})
+	require.Error(t, err)
+	require.Empty(t, tabs.Groups, "a rejected group leaves the tabs alone")
+}
+
+func TestNextTabGroupColor_CyclesOnceEveryColorIsUsed(t *testing.T) {
+	var groups []*entity.TabGroup
+	for _, color := range entity.TabGroupColors {
+		require.Equal(t, color, nextTabGroupColor(groups))
+		groups = append(groups, &entity.TabGroup{Color: color})
+	}
+	require.Equal(t, entity.TabGroupColors[0], nextTabGroupColor(groups))
+}
+
+func TestManageTabsAssignToGroupAndCollapse(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	tabs := newTestTabList(nil, "a", "b", "c")
+	group, err := uc.CreateGroup(ctx, CreateTabGroupInput{TabList: tabs, TabIDs: []entity.TabID{"a"}})
+	require.NoError(t, err)
+
+	require.NoError(t, uc.AssignToGroup(ctx, tabs, "c", group.ID))
+	require.Equal(t, []entity.TabID{"a", "c"}, group.TabIDs)
+	require.Error(t, uc.AssignToGroup(ctx, tabs, "c", "missing"))
+	require.Error(t, uc.AssignToGroup(ctx, tabs, "missing", group.ID))
+	require.Error(t, uc.AssignToGroup(ctx, nil, "c", group.ID))
+
+	tabs.SetActive("b")
+	require.NoError(t, uc.SetGroupCollapsed(ctx, tabs, group.ID, true))
+	require.False(t, tabs.IsTabHidden("a"))
+	require.True(t, tabs.IsTabHidden("c"))
+	require.Error(t, uc.SetGroupCollapsed(ctx, tabs, "missing", true))
+
+	require.NoError(t, uc.AssignToGroup(ctx, tabs, "c", ""))
+	require.NoError(t, uc.AssignToGroup(ctx, tabs, "a", ""))
+	require.Empty(t, tabs.Groups, "ungrouping the last tab removes the group")
+}
+
+func TestManageTabsMerge_KeepsTabGroups(t *testing.T) {
+	ctx := context.Background()
+	current := newTestTabList(nil, "x")
+
+	saved := newTestTabList(nil, "s1", "s2")
+	require.True(t, saved.AddGroup(&entity.TabGroup{
+		ID: "g", Name: "Work", Color: entity.TabGroupColorPurple, TabIDs: []entity.TabID{"s2"},
+	}))
+	state := entity.SnapshotFromTabList("saved", saved)
+
+	uc := NewManageTabsUseCase(newTestIDGen(), nil)
+	merged, err := uc.Merge(ctx, current, state)
+	require.NoError(t, err)
+	require.Len(t, merged, 2)
+
+	require.Len(t, current.Groups, 1)
+	group := current.Groups[0]
+	require.Equal(t, "Work", group.Name)
+	require.Equal(t, []entity.TabID{merged[1].ID}, group.TabIDs)
+}
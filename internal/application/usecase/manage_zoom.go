@@ -6,19 +6,27 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/domain/repository"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
 	"github.com/bnema/dumber/internal/logging"
 )
 
 // ManageZoomUseCase handles per-domain zoom level operations.
 // It uses an LRU cache to avoid database queries on every navigation.
+// Zoom levels of session-only domains are held in memory and never saved.
 type ManageZoomUseCase struct {
 	zoomRepo    repository.ZoomRepository
 	defaultZoom float64
 	cache       port.Cache[string, *entity.ZoomLevel]
+	stepConfig  func() entity.RuntimeZoomConfig
+
+	sessionMu    sync.Mutex
+	sessionZooms map[string]*entity.ZoomLevel
 }
 
 // NewManageZoomUseCase creates a new zoom management use case.
@@ -33,10 +41,53 @@ func NewManageZoomUseCase(
 		defaultZoom = entity.ZoomDefault
 	}
 	return &ManageZoomUseCase{
-		zoomRepo:    zoomRepo,
-		defaultZoom: defaultZoom,
-		cache:       cache,
+		zoomRepo:     zoomRepo,
+		defaultZoom:  defaultZoom,
+		cache:        cache,
+		sessionZooms: make(map[string]*entity.ZoomLevel),
+	}
+}
+
+// SetStepConfigProvider sets the source of the zoom step factor, its
+// per-domain overrides, the per-domain zoom presets and the session-only
+// domains. The provider is read
+// on every lookup so config reloads apply without restarting.
+func (uc *ManageZoomUseCase) SetStepConfigProvider(fn func() entity.RuntimeZoomConfig) {
+	uc.stepConfig = fn
+}
+
+// StepFactor returns the zoom step factor that applies to domain.
+func (uc *ManageZoomUseCase) StepFactor(domain string) float64 {
+	if uc.stepConfig == nil {
+		return entity.ZoomStepFactor
 	}
+	cfg := uc.stepConfig()
+	return ResolveZoomStepFactor(cfg.StepFactor, cfg.DomainOverrides, domain)
+}
+
+// ResolveZoomStepFactor returns the step factor that applies to domain.
+// The most specific matching override wins; otherwise defaultStep applies.
+// Out-of-range factors resolve to entity.ZoomStepFactor.
+func ResolveZoomStepFactor(defaultStep float64, overrides []entity.ZoomDomainOverride, domain string) float64 {
+	step := defaultStep
+	if len(overrides) > 0 {
+		patterns := make([]string, 0, len(overrides))
+		for _, override := range overrides {
+			patterns = append(patterns, override.Domain)
+		}
+		if pattern, ok := urlutil.BestDomainPatternMatch(patterns, domain); ok {
+			for _, override := range overrides {
+				if override.Domain == pattern {
+					step = override.StepFactor
+					break
+				}
+			}
+		}
+	}
+	if !entity.ValidZoomStepFactor(step) {
+		return entity.ZoomStepFactor
+	}
+	return step
 }
 
 // DefaultZoom returns the configured default zoom level.
@@ -44,15 +95,83 @@ func (uc *ManageZoomUseCase) DefaultZoom() float64 {
 	return uc.defaultZoom
 }
 
+// DefaultZoomFor returns the zoom level domain opens at when it has no saved
+// zoom: its preset if one matches, otherwise the configured default.
+func (uc *ManageZoomUseCase) DefaultZoomFor(domain string) float64 {
+	if uc.stepConfig == nil {
+		return uc.defaultZoom
+	}
+	return ResolveZoomPreset(uc.defaultZoom, uc.stepConfig().Presets, domain)
+}
+
+// ResolveZoomPreset returns the preset zoom level for domain.
+// The most specific matching preset wins; otherwise, or when the matching
+// factor is out of range, defaultZoom applies.
+func ResolveZoomPreset(defaultZoom float64, presets []entity.ZoomPreset, domain string) float64 {
+	if len(presets) == 0 {
+		return defaultZoom
+	}
+	patterns := make([]string, 0, len(presets))
+	for _, preset := range presets {
+		patterns = append(patterns, preset.Domain)
+	}
+	pattern, ok := urlutil.BestDomainPatternMatch(patterns, domain)
+	if !ok {
+		return defaultZoom
+	}
+	for _, preset := range presets {
+		if preset.Domain != pattern {
+			continue
+		}
+		if preset.Factor < entity.ZoomMin || preset.Factor > entity.ZoomMax {
+			return defaultZoom
+		}
+		return preset.Factor
+	}
+	return defaultZoom
+}
+
+// ShouldPersistZoom reports whether the zoom level of domain is saved.
+// Domains matching a session-only pattern keep their zoom in memory only;
+// file:// keys never match and are always saved.
+func ShouldPersistZoom(sessionOnlyDomains []string, domain string) bool {
+	return !urlutil.MatchAnyDomainPattern(sessionOnlyDomains, domain)
+}
+
+// persists reports whether the zoom level of domain is saved under the
+// current config.
+func (uc *ManageZoomUseCase) persists(domain string) bool {
+	if uc.stepConfig == nil {
+		return true
+	}
+	return ShouldPersistZoom(uc.stepConfig().SessionOnlyDomains, domain)
+}
+
 // GetZoom retrieves the zoom level for a domain.
-// Returns the configured default zoom level if none is set.
+// A saved zoom level always wins; otherwise the matching preset or the
+// configured default zoom level is returned. Session-only domains only see
+// zoom levels set since launch.
 // Uses LRU cache to avoid database queries on repeat visits.
 func (uc *ManageZoomUseCase) GetZoom(ctx context.Context, domain string) (*entity.ZoomLevel, error) {
 	log := logging.FromContext(ctx)
 
+	if !uc.persists(domain) {
+		uc.sessionMu.Lock()
+		zoom, ok := uc.sessionZooms[domain]
+		uc.sessionMu.Unlock()
+		if !ok {
+			zoom = uc.fallbackZoom(domain)
+		}
+		return zoom, nil
+	}
+
 	// Check cache first (fast path - no I/O)
 	if uc.cache != nil {
 		if cached, ok := uc.cache.Get(domain); ok {
+			if cached.UpdatedAt.IsZero() {
+				// Cached miss: re-resolve so edited presets apply.
+				return uc.fallbackZoom(domain), nil
+			}
 			log.Debug().Str("domain", domain).Float64("zoom", cached.ZoomFactor).Msg("zoom level from cache")
 			return cached, nil
 		}
@@ -66,7 +185,7 @@ func (uc *ManageZoomUseCase) GetZoom(ctx context.Context, domain string) (*entit
 	}
 
 	if zoom == nil {
-		zoom = entity.NewZoomLevel(domain, uc.defaultZoom)
+		zoom = uc.fallbackZoom(domain)
 		log.Debug().Str("domain", domain).Float64("zoom", zoom.ZoomFactor).Msg("using default zoom")
 	}
 
@@ -78,22 +197,25 @@ func (uc *ManageZoomUseCase) GetZoom(ctx context.Context, domain string) (*entit
 	return zoom, nil
 }
 
+// fallbackZoom returns the unsaved zoom level of a domain with no stored
+// zoom. Its zero UpdatedAt marks it as a cached miss.
+func (uc *ManageZoomUseCase) fallbackZoom(domain string) *entity.ZoomLevel {
+	zoom := entity.NewZoomLevel(domain, uc.DefaultZoomFor(domain))
+	zoom.UpdatedAt = time.Time{}
+	return zoom
+}
+
 // SetZoom saves a zoom level for a domain.
 func (uc *ManageZoomUseCase) SetZoom(ctx context.Context, domain string, factor float64) error {
 	log := logging.FromContext(ctx)
 	log.Debug().Str("domain", domain).Float64("factor", factor).Msg("setting zoom level")
 
 	zoom := entity.NewZoomLevel(domain, factor)
-	if err := uc.zoomRepo.Set(ctx, zoom); err != nil {
+	if err := uc.save(ctx, zoom); err != nil {
 		return fmt.Errorf("failed to set zoom level: %w", err)
 	}
 
-	// Update cache
-	if uc.cache != nil {
-		uc.cache.Set(domain, zoom)
-	}
-
-	log.Info().Str("domain", domain).Float64("factor", zoom.ZoomFactor).Msg("zoom level saved")
+	log.Info().Str("domain", domain).Float64("factor", zoom.ZoomFactor).Msg("zoom level set")
 	return nil
 }
 
@@ -102,6 +224,10 @@ func (uc *ManageZoomUseCase) ResetZoom(ctx context.Context, domain string) error
 	log := logging.FromContext(ctx)
 	log.Debug().Str("domain", domain).Msg("resetting zoom level")
 
+	uc.sessionMu.Lock()
+	delete(uc.sessionZooms, domain)
+	uc.sessionMu.Unlock()
+
 	if err := uc.zoomRepo.Delete(ctx, domain); err != nil {
 		return fmt.Errorf("failed to reset zoom level: %w", err)
 	}
@@ -115,12 +241,12 @@ func (uc *ManageZoomUseCase) ResetZoom(ctx context.Context, domain string) error
 	return nil
 }
 
-// ZoomIn increases the zoom level by one step (0.1).
+// ZoomIn multiplies the zoom level by the step factor for domain.
 func (uc *ManageZoomUseCase) ZoomIn(ctx context.Context, domain string, current float64) (*entity.ZoomLevel, error) {
 	log := logging.FromContext(ctx)
 
 	zoom := entity.NewZoomLevel(domain, current)
-	zoom.ZoomIn()
+	zoom.ZoomInBy(uc.StepFactor(domain))
 
 	log.Debug().
 		Str("domain", domain).
@@ -128,24 +254,19 @@ func (uc *ManageZoomUseCase) ZoomIn(ctx context.Context, domain string, current
 		Float64("to", zoom.ZoomFactor).
 		Msg("zooming in")
 
-	if err := uc.zoomRepo.Set(ctx, zoom); err != nil {
+	if err := uc.save(ctx, zoom); err != nil {
 		return nil, fmt.Errorf("failed to save zoom level: %w", err)
 	}
 
-	// Update cache
-	if uc.cache != nil {
-		uc.cache.Set(domain, zoom)
-	}
-
 	return zoom, nil
 }
 
-// ZoomOut decreases the zoom level by one step (0.1).
+// ZoomOut divides the zoom level by the step factor for domain.
 func (uc *ManageZoomUseCase) ZoomOut(ctx context.Context, domain string, current float64) (*entity.ZoomLevel, error) {
 	log := logging.FromContext(ctx)
 
 	zoom := entity.NewZoomLevel(domain, current)
-	zoom.ZoomOut()
+	zoom.ZoomOutBy(uc.StepFactor(domain))
 
 	log.Debug().
 		Str("domain", domain).
@@ -153,16 +274,33 @@ func (uc *ManageZoomUseCase) ZoomOut(ctx context.Context, domain string, current
 		Float64("to", zoom.ZoomFactor).
 		Msg("zooming out")
 
-	if err := uc.zoomRepo.Set(ctx, zoom); err != nil {
+	if err := uc.save(ctx, zoom); err != nil {
 		return nil, fmt.Errorf("failed to save zoom level: %w", err)
 	}
 
+	return zoom, nil
+}
+
+// save stores zoom: in memory for session-only domains, otherwise in the
+// repository and the cache.
+func (uc *ManageZoomUseCase) save(ctx context.Context, zoom *entity.ZoomLevel) error {
+	if !uc.persists(zoom.Domain) {
+		uc.sessionMu.Lock()
+		uc.sessionZooms[zoom.Domain] = zoom
+		uc.sessionMu.Unlock()
+		logging.FromContext(ctx).Debug().Str("domain", zoom.Domain).Msg("session-only zoom, not saved")
+		return nil
+	}
+
+	if err := uc.zoomRepo.Set(ctx, zoom); err != nil {
+		return err
+	}
+
 	// Update cache
 	if uc.cache != nil {
-		uc.cache.Set(domain, zoom)
+		uc.cache.Set(zoom.Domain, zoom)
 	}
-
-	return zoom, nil
+	return nil
 }
 
 // ApplyToWebView loads the saved zoom level and applies it to a webview.
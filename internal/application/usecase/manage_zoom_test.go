@@ -1,6 +1,14 @@
 package usecase
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	repomocks "github.com/bnema/dumber/internal/domain/repository/mocks"
+)
 
 func TestExtractZoomKey(t *testing.T) {
 	tests := []struct {
@@ -44,3 +52,219 @@ func TestExtractZoomKey(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveZoomStepFactor(t *testing.T) {
+	overrides := []entity.ZoomDomainOverride{
+		{Domain: "*.example.com", StepFactor: 1.25},
+		{Domain: "maps.example.com", StepFactor: 1.05},
+		{Domain: "broken.test", StepFactor: 0.5},
+	}
+
+	tests := []struct {
+		name        string
+		defaultStep float64
+		domain      string
+		want        float64
+	}{
+		{name: "no match uses default", defaultStep: 1.2, domain: "other.org", want: 1.2},
+		{name: "glob override", defaultStep: 1.2, domain: "docs.example.com", want: 1.25},
+		{name: "most specific override wins", defaultStep: 1.2, domain: "maps.example.com", want: 1.05},
+		{name: "host with port", defaultStep: 1.2, domain: "maps.example.com:8443", want: 1.05},
+		{name: "invalid override falls back", defaultStep: 1.2, domain: "broken.test", want: entity.ZoomStepFactor},
+		{name: "invalid default falls back", defaultStep: 0, domain: "other.org", want: entity.ZoomStepFactor},
+		{name: "file key uses default", defaultStep: 1.3, domain: "file:///tmp/demo.html", want: 1.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResolveZoomStepFactor(tt.defaultStep, overrides, tt.domain)
+			if got != tt.want {
+				t.Fatalf("ResolveZoomStepFactor(%v, _, %q) = %v, want %v", tt.defaultStep, tt.domain, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManageZoomUseCase_StepFactorReadsProvider(t *testing.T) {
+	uc := NewManageZoomUseCase(nil, 1.0, nil)
+	if got := uc.StepFactor("example.com"); got != entity.ZoomStepFactor {
+		t.Fatalf("StepFactor without provider = %v, want %v", got, entity.ZoomStepFactor)
+	}
+
+	cfg := entity.RuntimeZoomConfig{StepFactor: 1.5}
+	uc.SetStepConfigProvider(func() entity.RuntimeZoomConfig { return cfg })
+	if got := uc.StepFactor("example.com"); got != 1.5 {
+		t.Fatalf("StepFactor = %v, want 1.5", got)
+	}
+
+	cfg.DomainOverrides = []entity.ZoomDomainOverride{{Domain: "example.com", StepFactor: 1.1}}
+	if got := uc.StepFactor("example.com"); got != 1.1 {
+		t.Fatalf("StepFactor after reload = %v, want 1.1", got)
+	}
+}
+
+func TestResolveZoomPreset(t *testing.T) {
+	presets := []entity.ZoomPreset{
+		{Domain: "*.example.com", Factor: 1.25},
+		{Domain: "maps.example.com", Factor: 0.9},
+		{Domain: "broken.test", Factor: 9},
+	}
+
+	tests := []struct {
+		name   string
+		domain string
+		want   float64
+	}{
+		{name: "no match uses default", domain: "other.org", want: 1.1},
+		{name: "glob preset", domain: "docs.example.com", want: 1.25},
+		{name: "glob matches apex domain", domain: "example.com", want: 1.25},
+		{name: "most specific preset wins", domain: "maps.example.com", want: 0.9},
+		{name: "www is ignored", domain: "www.maps.example.com", want: 0.9},
+		{name: "out of range preset falls back", domain: "broken.test", want: 1.1},
+		{name: "file key uses default", domain: "file:///tmp/demo.html", want: 1.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResolveZoomPreset(1.1, presets, tt.domain)
+			if got != tt.want {
+				t.Fatalf("ResolveZoomPreset(1.1, _, %q) = %v, want %v", tt.domain, got, tt.want)
+			}
+		})
+	}
+}
+
+// mapZoomCache is a minimal port.Cache for zoom levels.
+type mapZoomCache map[string]*entity.ZoomLevel
+
+func (c mapZoomCache) Get(key string) (*entity.ZoomLevel, bool) {
+	v, ok := c[key]
+	return v, ok
+}
+func (c mapZoomCache) Set(key string, value *entity.ZoomLevel) { c[key] = value }
+func (c mapZoomCache) Remove(key string)                       { delete(c, key) }
+func (c mapZoomCache) Len() int                                { return len(c) }
+
+func TestManageZoomUseCase_GetZoomPrecedence(t *testing.T) {
+	ctx := context.Background()
+	repo := repomocks.NewMockZoomRepository(t)
+	repo.EXPECT().Get(ctx, "stored.example.com").Return(entity.NewZoomLevel("stored.example.com", 1.5), nil).Once()
+	repo.EXPECT().Get(ctx, "preset.example.com").Return(nil, nil).Once()
+	repo.EXPECT().Get(ctx, "other.org").Return(nil, nil).Once()
+
+	uc := NewManageZoomUseCase(repo, 1.1, mapZoomCache{})
+	cfg := entity.RuntimeZoomConfig{Presets: []entity.ZoomPreset{{Domain: "*.example.com", Factor: 0.8}}}
+	uc.SetStepConfigProvider(func() entity.RuntimeZoomConfig { return cfg })
+
+	tests := []struct {
+		name   string
+		domain string
+		want   float64
+	}{
+		{name: "stored zoom overrides preset", domain: "stored.example.com", want: 1.5},
+		{name: "preset applies without stored zoom", domain: "preset.example.com", want: 0.8},
+		{name: "default applies without preset", domain: "other.org", want: 1.1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zoom, err := uc.GetZoom(ctx, tt.domain)
+			if err != nil {
+				t.Fatalf("GetZoom(%q) error = %v", tt.domain, err)
+			}
+			if zoom.ZoomFactor != tt.want {
+				t.Fatalf("GetZoom(%q) = %v, want %v", tt.domain, zoom.ZoomFactor, tt.want)
+			}
+		})
+	}
+
+	// Cached lookups skip the repository but still see edited presets.
+	cfg.Presets = []entity.ZoomPreset{{Domain: "*.example.com", Factor: 2}}
+	if zoom, _ := uc.GetZoom(ctx, "preset.example.com"); zoom.ZoomFactor != 2 {
+		t.Fatalf("GetZoom after preset reload = %v, want 2", zoom.ZoomFactor)
+	}
+	if zoom, _ := uc.GetZoom(ctx, "stored.example.com"); zoom.ZoomFactor != 1.5 {
+		t.Fatalf("cached stored zoom = %v, want 1.5", zoom.ZoomFactor)
+	}
+	if got := uc.DefaultZoomFor("stored.example.com"); got != 2 {
+		t.Fatalf("DefaultZoomFor = %v, want 2", got)
+	}
+}
+
+func TestShouldPersistZoom(t *testing.T) {
+	sessionOnly := []string{"*.slides.example", "kiosk.test", "localhost:3000"}
+
+	tests := []struct {
+		name   string
+		domain string
+		want   bool
+	}{
+		{name: "unlisted domain persists", domain: "example.com", want: true},
+		{name: "exact domain is session-only", domain: "kiosk.test", want: false},
+		{name: "glob subdomain is session-only", domain: "deck.slides.example", want: false},
+		{name: "glob apex is session-only", domain: "slides.example", want: false},
+		{name: "www is ignored", domain: "www.kiosk.test", want: false},
+		{name: "lookalike persists", domain: "notkiosk.test", want: true},
+		{name: "pattern port must match", domain: "localhost:3000", want: false},
+		{name: "other port persists", domain: "localhost:8080", want: true},
+		{name: "file key persists", domain: "file:///tmp/kiosk.test", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShouldPersistZoom(sessionOnly, tt.domain); got != tt.want {
+				t.Fatalf("ShouldPersistZoom(_, %q) = %v, want %v", tt.domain, got, tt.want)
+			}
+		})
+	}
+
+	if !ShouldPersistZoom(nil, "kiosk.test") {
+		t.Fatal("ShouldPersistZoom without session-only domains = false, want true")
+	}
+}
+
+func TestManageZoomUseCase_SessionOnlyDomainsSkipRepository(t *testing.T) {
+	ctx := context.Background()
+	repo := repomocks.NewMockZoomRepository(t)
+	repo.EXPECT().Set(ctx, mock.MatchedBy(func(z *entity.ZoomLevel) bool {
+		return z.Domain == "saved.example"
+	})).Return(nil).Once()
+	repo.EXPECT().Delete(ctx, "deck.slides.example").Return(nil).Once()
+
+	uc := NewManageZoomUseCase(repo, 1.0, mapZoomCache{})
+	cfg := entity.RuntimeZoomConfig{
+		SessionOnlyDomains: []string{"*.slides.example"},
+		Presets:            []entity.ZoomPreset{{Domain: "*.slides.example", Factor: 1.25}},
+	}
+	uc.SetStepConfigProvider(func() entity.RuntimeZoomConfig { return cfg })
+
+	if err := uc.SetZoom(ctx, "saved.example", 1.5); err != nil {
+		t.Fatalf("SetZoom(saved.example) error = %v", err)
+	}
+
+	zoom, err := uc.ZoomIn(ctx, "deck.slides.example", 1.25)
+	if err != nil {
+		t.Fatalf("ZoomIn(deck.slides.example) error = %v", err)
+	}
+	got, err := uc.GetZoom(ctx, "deck.slides.example")
+	if err != nil {
+		t.Fatalf("GetZoom(deck.slides.example) error = %v", err)
+	}
+	if got.ZoomFactor != zoom.ZoomFactor {
+		t.Fatalf("GetZoom after ZoomIn = %v, want session zoom %v", got.ZoomFactor, zoom.ZoomFactor)
+	}
+
+	// A fresh launch has no session zoom and opens at the preset.
+	relaunched := NewManageZoomUseCase(repo, 1.0, mapZoomCache{})
+	relaunched.SetStepConfigProvider(func() entity.RuntimeZoomConfig { return cfg })
+	if got, _ := relaunched.GetZoom(ctx, "deck.slides.example"); got.ZoomFactor != 1.25 {
+		t.Fatalf("GetZoom after relaunch = %v, want preset 1.25", got.ZoomFactor)
+	}
+
+	if err := uc.ResetZoom(ctx, "deck.slides.example"); err != nil {
+		t.Fatalf("ResetZoom error = %v", err)
+	}
+	if got, _ := uc.GetZoom(ctx, "deck.slides.example"); got.ZoomFactor != 1.25 {
+		t.Fatalf("GetZoom after reset = %v, want preset 1.25", got.ZoomFactor)
+	}
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/url"
+	"strings"
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
 	"github.com/bnema/dumber/internal/logging"
 )
 
 // NavigateUseCase handles URL navigation with zoom application.
 type NavigateUseCase struct {
-	defaultZoom float64
+	defaultZoom        float64
+	alwaysFreshDomains func() []string
+	rewriteURL         func(rawURL string) string
 }
 
 // NewNavigateUseCase creates a new navigation use case.
@@ -25,6 +30,37 @@ func NewNavigateUseCase(defaultZoom float64) *NavigateUseCase {
 	return &NavigateUseCase{defaultZoom: defaultZoom}
 }
 
+// SetURLRewriter sets the rewrite applied to URLs before they load, such as
+// the HTTPS-only upgrade.
+func (uc *NavigateUseCase) SetURLRewriter(fn func(rawURL string) string) {
+	uc.rewriteURL = fn
+}
+
+// SetAlwaysFreshDomainsProvider sets the source of domain patterns whose
+// pages must bypass the HTTP cache. The provider is read on every navigation
+// so config reloads apply without restarting.
+func (uc *NavigateUseCase) SetAlwaysFreshDomainsProvider(fn func() []string) {
+	uc.alwaysFreshDomains = fn
+}
+
+// ShouldBypassCache reports whether rawURL must be loaded from the network.
+// Only http(s) URLs are considered; internal and file URLs never bypass.
+func ShouldBypassCache(alwaysFreshDomains []string, rawURL string) bool {
+	if len(alwaysFreshDomains) == 0 {
+		return false
+	}
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+	default:
+		return false
+	}
+	return urlutil.MatchAnyDomainPattern(alwaysFreshDomains, parsed.Host)
+}
+
 // NavigateInput contains parameters for navigation.
 type NavigateInput struct {
 	URL     string
@@ -48,7 +84,7 @@ func (uc *NavigateUseCase) Execute(ctx context.Context, input NavigateInput) (*N
 
 	// Navigate to URL (zoom will be applied on LoadCommitted to avoid shift)
 	// History is recorded on LoadCommitted when URI is guaranteed correct
-	if err := input.WebView.LoadURI(ctx, input.URL); err != nil {
+	if err := uc.load(ctx, input.WebView, input.URL); err != nil {
 		return nil, fmt.Errorf("failed to load URL: %w", err)
 	}
 
@@ -61,6 +97,34 @@ func (uc *NavigateUseCase) Execute(ctx context.Context, input NavigateInput) (*N
 	}, nil
 }
 
+// load starts the navigation, skipping the HTTP cache for always-fresh domains
+// when the WebView loads through port.CacheBypassLoader. Engines that apply
+// the list to every main-frame request themselves, such as CEF, do not.
+func (uc *NavigateUseCase) load(ctx context.Context, webview port.WebView, rawURL string) error {
+	if uc.rewriteURL != nil {
+		if rewritten := uc.rewriteURL(rawURL); rewritten != rawURL {
+			logging.FromContext(ctx).Debug().
+				Str("url", logging.RedactURL(rawURL)).
+				Str("rewritten", logging.RedactURL(rewritten)).
+				Msg("navigation URL rewritten")
+			rawURL = rewritten
+		}
+	}
+	var alwaysFresh []string
+	if uc.alwaysFreshDomains != nil {
+		alwaysFresh = uc.alwaysFreshDomains()
+	}
+	if ShouldBypassCache(alwaysFresh, rawURL) {
+		if loader, ok := webview.(port.CacheBypassLoader); ok {
+			logging.FromContext(ctx).Debug().
+				Str("url", logging.RedactURL(rawURL)).
+				Msg("always-fresh domain, bypassing cache")
+			return loader.LoadURIBypassCache(ctx, rawURL)
+		}
+	}
+	return webview.LoadURI(ctx, rawURL)
+}
+
 // Reload reloads the current page.
 func (uc *NavigateUseCase) Reload(ctx context.Context, webview port.WebView, bypassCache bool) error {
 	log := logging.FromContext(ctx).With().Float64("default_zoom", uc.defaultZoom).Logger()
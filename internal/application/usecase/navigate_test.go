@@ -104,3 +104,88 @@ func TestNavigateUseCase_ExecuteReturnsLoadError(t *testing.T) {
 	require.ErrorIs(t, err, loadErr)
 	require.Contains(t, err.Error(), "failed to load URL")
 }
+
+type fakeBypassWebView struct {
+	fakeWebView
+	bypassLoaded string
+}
+
+func (f *fakeBypassWebView) LoadURIBypassCache(_ context.Context, uri string) error {
+	f.bypassLoaded = uri
+	return nil
+}
+
+func TestShouldBypassCache(t *testing.T) {
+	domains := []string{"localhost:3000", "*.dev.example", "staging.example.com"}
+
+	tests := []struct {
+		name    string
+		domains []string
+		url     string
+		want    bool
+	}{
+		{name: "no domains configured", domains: nil, url: "https://staging.example.com", want: false},
+		{name: "exact host", domains: domains, url: "https://staging.example.com/app", want: true},
+		{name: "www prefix matches exact host", domains: domains, url: "https://www.staging.example.com/", want: true},
+		{name: "wildcard subdomain", domains: domains, url: "http://api.dev.example/v1", want: true},
+		{name: "wildcard apex", domains: domains, url: "https://dev.example", want: true},
+		{name: "host with port", domains: domains, url: "http://localhost:3000/", want: true},
+		{name: "other port", domains: domains, url: "http://localhost:8080/", want: false},
+		{name: "unrelated host", domains: domains, url: "https://example.com", want: false},
+		{name: "sibling of exact host", domains: domains, url: "https://prod.example.com", want: false},
+		{name: "internal scheme", domains: []string{"*"}, url: "dumb://history", want: false},
+		{name: "file scheme", domains: []string{"*"}, url: "file:///tmp/index.html", want: false},
+		{name: "unparseable", domains: domains, url: "://bad", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ShouldBypassCache(tt.domains, tt.url))
+		})
+	}
+}
+
+func TestNavigateUseCase_ExecuteBypassesCacheForAlwaysFreshDomains(t *testing.T) {
+	ctx := context.Background()
+	uc := NewNavigateUseCase(entity.ZoomDefault)
+	uc.SetAlwaysFreshDomainsProvider(func() []string { return []string{"*.dev.example"} })
+
+	fresh := &fakeBypassWebView{}
+	_, err := uc.Execute(ctx, NavigateInput{URL: "https://app.dev.example/", PaneID: "pane-1", WebView: fresh})
+	require.NoError(t, err)
+	require.Equal(t, "https://app.dev.example/", fresh.bypassLoaded)
+	require.Empty(t, fresh.loaded)
+
+	cached := &fakeBypassWebView{}
+	_, err = uc.Execute(ctx, NavigateInput{URL: "https://example.com/", PaneID: "pane-1", WebView: cached})
+	require.NoError(t, err)
+	require.Empty(t, cached.bypassLoaded)
+	require.Equal(t, "https://example.com/", cached.loaded)
+}
+
+func TestNavigateUseCase_ExecuteFallsBackWithoutBypassCapability(t *testing.T) {
+	ctx := context.Background()
+	uc := NewNavigateUseCase(entity.ZoomDefault)
+	uc.SetAlwaysFreshDomainsProvider(func() []string { return []string{"*.dev.example"} })
+
+	wv := &fakeWebView{}
+	_, err := uc.Execute(ctx, NavigateInput{URL: "https://app.dev.example/", PaneID: "pane-1", WebView: wv})
+	require.NoError(t, err)
+	require.Equal(t, "https://app.dev.example/", wv.loaded)
+}
+
+func TestNavigateUseCase_ExecuteLoadsRewrittenURL(t *testing.T) {
+	ctx := context.Background()
+	uc := NewNavigateUseCase(entity.ZoomDefault)
+	upgrade := NewHTTPSUpgradeUseCase(func() entity.HTTPSOnlyMode { return entity.HTTPSOnlyLenient })
+	uc.SetURLRewriter(upgrade.Rewrite)
+
+	wv := &fakeWebView{}
+	_, err := uc.Execute(ctx, NavigateInput{URL: "http://example.com/page", PaneID: "pane-1", WebView: wv})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/page", wv.loaded)
+
+	_, err = uc.Execute(ctx, NavigateInput{URL: "http://localhost:8080/", PaneID: "pane-1", WebView: wv})
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080/", wv.loaded)
+}
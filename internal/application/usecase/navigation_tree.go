@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/navgraph"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+const (
+	// maxNavigationTreePanes bounds how many panes keep a navigation tree,
+	// restored ones included. The least recently active tree is evicted
+	// first, so closed panes stay inspectable for a while.
+	maxNavigationTreePanes = 32
+	// maxNavigationTreeNodes bounds the nodes of a single tree.
+	maxNavigationTreeNodes = 500
+)
+
+type paneNavigationTree struct {
+	graph     *navgraph.Graph
+	closed    bool
+	lastVisit time.Time
+}
+
+// NavigationTreeUseCase records the navigations of each pane as a branching
+// tree for dumb://navtree. Trees live in memory; when persist returns true
+// they are also saved to the store on Save and restored by Load.
+type NavigationTreeUseCase struct {
+	store   port.NavigationTreeStore
+	persist func() bool
+	now     func() time.Time
+
+	mu       sync.Mutex
+	panes    map[entity.PaneID]*paneNavigationTree
+	restored []dto.NavigationTree
+}
+
+var _ port.NavigationTrees = (*NavigationTreeUseCase)(nil)
+
+// NewNavigationTreeUseCase creates a navigation tree recorder. store may be
+// nil to keep trees in memory only; persist is read on every Load and Save
+// so config changes apply without a restart.
+func NewNavigationTreeUseCase(store port.NavigationTreeStore, persist func() bool) *NavigationTreeUseCase {
+	return &NavigationTreeUseCase{
+		store:   store,
+		persist: persist,
+		now:     time.Now,
+		panes:   make(map[entity.PaneID]*paneNavigationTree),
+	}
+}
+
+func (uc *NavigationTreeUseCase) persistEnabled() bool {
+	return uc.store != nil && uc.persist != nil && uc.persist()
+}
+
+// Record adds a committed navigation of paneID to its tree. Blank and
+// internal pages are not recorded.
+func (uc *NavigationTreeUseCase) Record(_ context.Context, paneID entity.PaneID, url string) {
+	if uc == nil || paneID == "" || entity.IsEphemeralSessionURI(url) {
+		return
+	}
+	now := uc.now()
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	tree, ok := uc.panes[paneID]
+	if !ok {
+		uc.evictOldestLocked()
+		tree = &paneNavigationTree{graph: navgraph.NewGraph(maxNavigationTreeNodes)}
+		uc.panes[paneID] = tree
+	}
+	tree.graph.Visit(url, now)
+	tree.lastVisit = now
+}
+
+// UpdateTitle sets the title of the page url in the tree of paneID.
+func (uc *NavigationTreeUseCase) UpdateTitle(_ context.Context, paneID entity.PaneID, url, title string) {
+	if uc == nil || title == "" {
+		return
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if tree, ok := uc.panes[paneID]; ok {
+		tree.graph.SetTitle(url, title)
+	}
+}
+
+// PaneClosed marks the tree of paneID as belonging to a closed pane. The
+// tree stays listed until it is evicted.
+func (uc *NavigationTreeUseCase) PaneClosed(paneID entity.PaneID) {
+	if uc == nil {
+		return
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if tree, ok := uc.panes[paneID]; ok {
+		tree.closed = true
+	}
+}
+
+// evictOldestLocked makes room for one more tree, dropping restored trees
+// before live ones. Callers hold uc.mu.
+func (uc *NavigationTreeUseCase) evictOldestLocked() {
+	if len(uc.panes)+len(uc.restored) < maxNavigationTreePanes {
+		return
+	}
+	if n := len(uc.restored); n > 0 {
+		uc.restored = uc.restored[:n-1] // oldest last
+		return
+	}
+	var oldestID entity.PaneID
+	var oldest time.Time
+	for id, tree := range uc.panes {
+		if oldestID == "" || tree.lastVisit.Before(oldest) {
+			oldestID, oldest = id, tree.lastVisit
+		}
+	}
+	delete(uc.panes, oldestID)
+}
+
+// Trees returns a snapshot of every tree: live panes most recently active
+// first, then the trees restored from an earlier session.
+func (uc *NavigationTreeUseCase) Trees(_ context.Context) []dto.NavigationTree {
+	if uc == nil {
+		return nil
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	trees := make([]dto.NavigationTree, 0, len(uc.panes)+len(uc.restored))
+	for id, tree := range uc.panes {
+		trees = append(trees, dto.NavigationTree{
+			PaneID:    string(id),
+			Current:   tree.graph.Current(),
+			Closed:    tree.closed,
+			LastVisit: tree.lastVisit,
+			Nodes:     tree.graph.Nodes(),
+		})
+	}
+	slices.SortFunc(trees, func(a, b dto.NavigationTree) int {
+		if c := b.LastVisit.Compare(a.LastVisit); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.PaneID, b.PaneID)
+	})
+	return append(trees, uc.restored...)
+}
+
+// Load restores the trees saved by an earlier session when persistence is
+// enabled. They are listed after the live trees and never grow.
+func (uc *NavigationTreeUseCase) Load(ctx context.Context) error {
+	if uc == nil || !uc.persistEnabled() {
+		return nil
+	}
+	trees, err := uc.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("load navigation trees: %w", err)
+	}
+	restored := make([]dto.NavigationTree, 0, min(len(trees), maxNavigationTreePanes))
+	for _, tree := range trees {
+		if len(tree.Nodes) == 0 || len(restored) == maxNavigationTreePanes {
+			continue
+		}
+		tree.Restored = true
+		tree.Closed = false
+		restored = append(restored, tree)
+	}
+
+	uc.mu.Lock()
+	uc.restored = restored
+	uc.mu.Unlock()
+
+	logging.FromContext(ctx).Debug().Int("trees", len(restored)).Msg("navigation trees restored")
+	return nil
+}
+
+// Save writes every tree to the store when persistence is enabled, and
+// removes saved trees when it is not.
+func (uc *NavigationTreeUseCase) Save(ctx context.Context) error {
+	if uc == nil || uc.store == nil {
+		return nil
+	}
+	var trees []dto.NavigationTree
+	if uc.persistEnabled() {
+		trees = uc.Trees(ctx)
+	}
+	if err := uc.store.Save(ctx, trees); err != nil {
+		return fmt.Errorf("save navigation trees: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type memoryNavigationTreeStore struct {
+	trees []dto.NavigationTree
+	saves int
+}
+
+func (s *memoryNavigationTreeStore) Load(context.Context) ([]dto.NavigationTree, error) {
+	return s.trees, nil
+}
+
+func (s *memoryNavigationTreeStore) Save(_ context.Context, trees []dto.NavigationTree) error {
+	s.trees = trees
+	s.saves++
+	return nil
+}
+
+func newTestNavigationTrees(store *memoryNavigationTreeStore, persist *bool) *NavigationTreeUseCase {
+	var uc *NavigationTreeUseCase
+	if store == nil {
+		uc = NewNavigationTreeUseCase(nil, nil)
+	} else {
+		uc = NewNavigationTreeUseCase(store, func() bool { return *persist })
+	}
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	var tick int
+	uc.now = func() time.Time {
+		tick++
+		return base.Add(time.Duration(tick) * time.Second)
+	}
+	return uc
+}
+
+func nodeParents(tree dto.NavigationTree) map[string]string {
+	urls := make(map[int]string)
+	for _, n := range tree.Nodes {
+		urls[n.ID] = n.URL
+	}
+	out := make(map[string]string)
+	for _, n := range tree.Nodes {
+		out[n.URL] = urls[n.ParentID]
+	}
+	return out
+}
+
+func TestNavigationTree_BackThenNewNavigationBranches(t *testing.T) {
+	uc := newTestNavigationTrees(nil, nil)
+	ctx := context.Background()
+
+	for _, u := range []string{"https://a.example/", "https://b.example/", "https://a.example/", "https://c.example/"} {
+		uc.Record(ctx, "pane-1", u)
+	}
+	uc.UpdateTitle(ctx, "pane-1", "https://c.example/", "See")
+
+	trees := uc.Trees(ctx)
+	require.Len(t, trees, 1)
+	assert.Equal(t, "pane-1", trees[0].PaneID)
+	assert.Equal(t, map[string]string{
+		"https://a.example/": "",
+		"https://b.example/": "https://a.example/",
+		"https://c.example/": "https://a.example/",
+	}, nodeParents(trees[0]))
+	require.Len(t, trees[0].Nodes, 3)
+	assert.Equal(t, trees[0].Nodes[2].ID, trees[0].Current)
+	assert.Equal(t, "See", trees[0].Nodes[2].Title)
+}
+
+func TestNavigationTree_SkipsInternalPagesAndOrdersByActivity(t *testing.T) {
+	uc := newTestNavigationTrees(nil, nil)
+	ctx := context.Background()
+
+	uc.Record(ctx, "pane-1", "https://a.example/")
+	uc.Record(ctx, "pane-2", "dumb://navtree")
+	uc.Record(ctx, "pane-2", "about:blank")
+	uc.Record(ctx, "pane-3", "https://b.example/")
+	uc.Record(ctx, "pane-1", "https://c.example/")
+	uc.PaneClosed("pane-3")
+
+	trees := uc.Trees(ctx)
+	require.Len(t, trees, 2)
+	assert.Equal(t, "pane-1", trees[0].PaneID)
+	assert.Equal(t, "pane-3", trees[1].PaneID)
+	assert.True(t, trees[1].Closed)
+}
+
+func TestNavigationTree_EvictsLeastRecentlyActivePane(t *testing.T) {
+	uc := newTestNavigationTrees(nil, nil)
+	ctx := context.Background()
+	for i := 1; i <= maxNavigationTreePanes+1; i++ {
+		uc.Record(ctx, entity.PaneID(fmt.Sprintf("pane-%d", i)), "https://example.com/")
+	}
+
+	trees := uc.Trees(ctx)
+	require.Len(t, trees, maxNavigationTreePanes)
+	for _, tree := range trees {
+		assert.NotEqual(t, "pane-1", tree.PaneID)
+	}
+}
+
+func TestNavigationTree_PersistsOnlyWhenEnabled(t *testing.T) {
+	ctx := context.Background()
+	store := &memoryNavigationTreeStore{}
+	persist := true
+
+	uc := newTestNavigationTrees(store, &persist)
+	uc.Record(ctx, "pane-1", "https://a.example/")
+	uc.Record(ctx, "pane-1", "https://b.example/")
+	require.NoError(t, uc.Save(ctx))
+	require.Len(t, store.trees, 1)
+
+	next := newTestNavigationTrees(store, &persist)
+	require.NoError(t, next.Load(ctx))
+	next.Record(ctx, "pane-9", "https://c.example/")
+	trees := next.Trees(ctx)
+	require.Len(t, trees, 2)
+	assert.Equal(t, "pane-9", trees[0].PaneID)
+	assert.False(t, trees[0].Restored)
+	assert.True(t, trees[1].Restored)
+	assert.Len(t, trees[1].Nodes, 2)
+
+	// Disabling persistence drops saved trees and skips loading them.
+	persist = false
+	require.NoError(t, next.Save(ctx))
+	assert.Empty(t, store.trees)
+
+	store.trees = trees
+	disabled := newTestNavigationTrees(store, &persist)
+	require.NoError(t, disabled.Load(ctx))
+	assert.Empty(t, disabled.Trees(ctx))
+}
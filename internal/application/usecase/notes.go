@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// ManageNotesUseCase keeps the notes of the dumb://notes scratchpad.
+type ManageNotesUseCase struct {
+	repo port.NoteRepository
+	now  func() time.Time
+}
+
+var _ port.Notes = (*ManageNotesUseCase)(nil)
+
+// NewManageNotesUseCase creates a notes use case.
+func NewManageNotesUseCase(repo port.NoteRepository) *ManageNotesUseCase {
+	return &ManageNotesUseCase{repo: repo, now: time.Now}
+}
+
+// List returns every note, most recently edited first.
+func (uc *ManageNotesUseCase) List(ctx context.Context) ([]*entity.Note, error) {
+	notes, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list notes: %w", err)
+	}
+	return notes, nil
+}
+
+// Create adds an empty note.
+func (uc *ManageNotesUseCase) Create(ctx context.Context) (*entity.Note, error) {
+	now := uc.now()
+	note := &entity.Note{CreatedAt: now, UpdatedAt: now}
+	if err := uc.repo.Create(ctx, note); err != nil {
+		return nil, fmt.Errorf("create note: %w", err)
+	}
+	return note, nil
+}
+
+// Save replaces the body of note id. Saving an unchanged body keeps the
+// note's update time, so a late autosave does not reorder the list.
+func (uc *ManageNotesUseCase) Save(ctx context.Context, id int64, body string) (*entity.Note, error) {
+	if len(body) > entity.MaxNoteBytes {
+		return nil, fmt.Errorf("note is larger than %d bytes", entity.MaxNoteBytes)
+	}
+	note, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("load note %d: %w", id, err)
+	}
+	if note == nil {
+		return nil, fmt.Errorf("note %d not found", id)
+	}
+	if note.Body == body {
+		return note, nil
+	}
+	note.Body = body
+	note.UpdatedAt = uc.now()
+	found, err := uc.repo.Update(ctx, note)
+	if err != nil {
+		return nil, fmt.Errorf("save note %d: %w", id, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("note %d not found", id)
+	}
+	return note, nil
+}
+
+// Delete removes note id.
+func (uc *ManageNotesUseCase) Delete(ctx context.Context, id int64) error {
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete note %d: %w", id, err)
+	}
+	return nil
+}
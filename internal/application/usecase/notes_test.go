@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type fakeNoteRepo struct {
+	notes  map[int64]entity.Note
+	nextID int64
+}
+
+func newFakeNoteRepo() *fakeNoteRepo {
+	return &fakeNoteRepo{notes: map[int64]entity.Note{}}
+}
+
+func (r *fakeNoteRepo) Create(_ context.Context, note *entity.Note) error {
+	r.nextID++
+	note.ID = r.nextID
+	r.notes[note.ID] = *note
+	return nil
+}
+
+func (r *fakeNoteRepo) Get(_ context.Context, id int64) (*entity.Note, error) {
+	note, ok := r.notes[id]
+	if !ok {
+		return nil, nil
+	}
+	return &note, nil
+}
+
+func (r *fakeNoteRepo) Update(_ context.Context, note *entity.Note) (bool, error) {
+	if _, ok := r.notes[note.ID]; !ok {
+		return false, nil
+	}
+	r.notes[note.ID] = *note
+	return true, nil
+}
+
+func (r *fakeNoteRepo) Delete(_ context.Context, id int64) error {
+	delete(r.notes, id)
+	return nil
+}
+
+func (r *fakeNoteRepo) List(context.Context) ([]*entity.Note, error) {
+	notes := make([]*entity.Note, 0, len(r.notes))
+	for _, note := range r.notes {
+		notes = append(notes, &note)
+	}
+	return notes, nil
+}
+
+func TestManageNotes_SaveUpdatesBodyAndTime(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeNoteRepo()
+	uc := NewManageNotesUseCase(repo)
+	now := time.Unix(1700000000, 0)
+	uc.now = func() time.Time { return now }
+
+	note, err := uc.Create(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, note.Body)
+
+	now = now.Add(time.Minute)
+	saved, err := uc.Save(ctx, note.ID, "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", repo.notes[note.ID].Body)
+	assert.True(t, saved.UpdatedAt.Equal(now))
+
+	now = now.Add(time.Minute)
+	_, err = uc.Save(ctx, note.ID, "hello")
+	require.NoError(t, err)
+	assert.True(t, repo.notes[note.ID].UpdatedAt.Equal(now.Add(-time.Minute)), "an unchanged body keeps its time")
+}
+
+func TestManageNotes_SaveRejectsMissingAndOversizedNotes(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageNotesUseCase(newFakeNoteRepo())
+
+	_, err := uc.Save(ctx, 42, "lost")
+	require.ErrorContains(t, err, "not found")
+
+	note, err := uc.Create(ctx)
+	require.NoError(t, err)
+	_, err = uc.Save(ctx, note.ID, strings.Repeat("x", entity.MaxNoteBytes+1))
+	require.ErrorContains(t, err, "larger than")
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/notification"
+)
+
+// NotificationsUseCase keeps the recent toasts for the notification center.
+type NotificationsUseCase struct {
+	capacity func() int
+	now      func() time.Time
+
+	mu  sync.Mutex
+	log *notification.Log
+}
+
+// NewNotificationsUseCase creates a notifications use case. capacity
+// (notifications.history_size) is read on every notification so config
+// reloads apply without restarting; 0 keeps nothing.
+func NewNotificationsUseCase(capacity func() int) *NotificationsUseCase {
+	uc := &NotificationsUseCase{
+		capacity: capacity,
+		now:      time.Now,
+	}
+	uc.log = notification.NewLog(uc.currentCapacity())
+	return uc
+}
+
+func (uc *NotificationsUseCase) currentCapacity() int {
+	if uc.capacity == nil {
+		return notification.DefaultCapacity
+	}
+	return min(max(uc.capacity(), 0), notification.MaxCapacity)
+}
+
+// Record adds a toast to the notification history. Empty messages are
+// ignored.
+func (uc *NotificationsUseCase) Record(message string, level notification.Level, paneID entity.PaneID) {
+	if uc == nil || strings.TrimSpace(message) == "" {
+		return
+	}
+	capacity := uc.currentCapacity()
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.log.Resize(capacity)
+	uc.log.Add(notification.Entry{Message: message, Level: level, At: uc.now(), PaneID: paneID})
+}
+
+// Recent returns the kept notifications, newest first.
+func (uc *NotificationsUseCase) Recent() []notification.Entry {
+	if uc == nil {
+		return nil
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.log.Entries()
+}
+
+// DismissAll clears the notification history and returns how many
+// notifications it held.
+func (uc *NotificationsUseCase) DismissAll() int {
+	if uc == nil {
+		return 0
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.log.Clear()
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/notification"
+)
+
+func TestNotificationsUseCase_RecordsNewestFirst(t *testing.T) {
+	uc := NewNotificationsUseCase(func() int { return 2 })
+	at := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	uc.now = func() time.Time { return at }
+
+	uc.Record("Saved", notification.LevelSuccess, "pane-1")
+	uc.Record("  ", notification.LevelInfo, "pane-1")
+	uc.Record("Offline", notification.LevelWarning, "")
+	uc.Record("Failed", notification.LevelError, "pane-2")
+
+	recent := uc.Recent()
+	require.Len(t, recent, 2, "the ring buffer drops the oldest toast")
+	assert.Equal(t, notification.Entry{Message: "Failed", Level: notification.LevelError, At: at, PaneID: "pane-2"}, recent[0])
+	assert.Equal(t, "Offline", recent[1].Message)
+}
+
+func TestNotificationsUseCase_FollowsCapacity(t *testing.T) {
+	capacity := 3
+	uc := NewNotificationsUseCase(func() int { return capacity })
+	for _, msg := range []string{"a", "b", "c"} {
+		uc.Record(msg, notification.LevelInfo, "")
+	}
+
+	capacity = 1
+	uc.Record("d", notification.LevelInfo, "")
+	require.Len(t, uc.Recent(), 1)
+	assert.Equal(t, "d", uc.Recent()[0].Message)
+
+	capacity = 0
+	uc.Record("e", notification.LevelInfo, "")
+	assert.Empty(t, uc.Recent(), "a history size of 0 keeps nothing")
+}
+
+func TestNotificationsUseCase_DismissAll(t *testing.T) {
+	uc := NewNotificationsUseCase(func() int { return 5 })
+	uc.Record("a", notification.LevelInfo, "")
+	uc.Record("b", notification.LevelInfo, "")
+
+	assert.Equal(t, 2, uc.DismissAll())
+	assert.Empty(t, uc.Recent())
+	assert.Equal(t, 0, uc.DismissAll())
+
+	var nilUC *NotificationsUseCase
+	nilUC.Record("a", notification.LevelInfo, "")
+	assert.Empty(t, nilUC.Recent())
+	assert.Equal(t, 0, nilUC.DismissAll())
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// omniboxFavoriteBonus lifts favorites above history entries of similar rank
+// without letting a poorly matching favorite bury the best history hits.
+const omniboxFavoriteBonus = 0.5
+
+// OmniboxResult is a single row of the unified history+favorites omnibox list.
+type OmniboxResult struct {
+	URL        string
+	Title      string
+	IsFavorite bool
+}
+
+type omniboxMergeRow struct {
+	result OmniboxResult
+	score  float64
+	order  int
+}
+
+// MergeOmniboxResults interleaves ranked history results with ranked favorites.
+//
+// Each source keeps its own ordering: an entry's base score is its normalized
+// rank within the source (1 for the first entry, approaching 0 for the last).
+// URLs present in both sources are merged into a single favorite row that keeps
+// the better of the two ranks. Favorites receive a fixed score bonus.
+// A non-positive limit returns every merged result.
+func MergeOmniboxResults(history []OmniboxResult, favorites []*entity.Favorite, limit int) []OmniboxResult {
+	rows := make([]*omniboxMergeRow, 0, len(history)+len(favorites))
+	byKey := make(map[string]*omniboxMergeRow, len(history)+len(favorites))
+
+	add := func(result OmniboxResult, score float64) {
+		key := omniboxDedupKey(result.URL)
+		if key == "" {
+			return
+		}
+		if existing, ok := byKey[key]; ok {
+			existing.score = max(existing.score, score)
+			if result.IsFavorite {
+				existing.result.IsFavorite = true
+				if result.Title != "" {
+					existing.result.Title = result.Title
+				}
+			}
+			return
+		}
+		row := &omniboxMergeRow{result: result, score: score, order: len(rows)}
+		rows = append(rows, row)
+		byKey[key] = row
+	}
+
+	for i, h := range history {
+		add(h, omniboxRankScore(i, len(history)))
+	}
+	for i, fav := range favorites {
+		if fav == nil {
+			continue
+		}
+		add(OmniboxResult{URL: fav.URL, Title: fav.Title, IsFavorite: true}, omniboxRankScore(i, len(favorites)))
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		left, right := rows[i].rankedScore(), rows[j].rankedScore()
+		if left != right {
+			return left > right
+		}
+		return rows[i].order < rows[j].order
+	})
+
+	if limit > 0 && len(rows) > limit {
+		rows = rows[:limit]
+	}
+	out := make([]OmniboxResult, len(rows))
+	for i, row := range rows {
+		out[i] = row.result
+	}
+	return out
+}
+
+func (r *omniboxMergeRow) rankedScore() float64 {
+	if r.result.IsFavorite {
+		return r.score + omniboxFavoriteBonus
+	}
+	return r.score
+}
+
+func omniboxRankScore(index, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(total-index) / float64(total)
+}
+
+func omniboxDedupKey(rawURL string) string {
+	return strings.TrimSuffix(strings.TrimSpace(rawURL), "/")
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func omniboxResultURLs(results []OmniboxResult) []string {
+	urls := make([]string, len(results))
+	for i, r := range results {
+		urls[i] = r.URL
+	}
+	return urls
+}
+
+func TestMergeOmniboxResults_InterleavesWithFavoriteBonus(t *testing.T) {
+	history := []OmniboxResult{
+		{URL: "https://h1.test", Title: "H1"},
+		{URL: "https://h2.test", Title: "H2"},
+		{URL: "https://h3.test", Title: "H3"},
+		{URL: "https://h4.test", Title: "H4"},
+	}
+	favorites := []*entity.Favorite{
+		{URL: "https://f1.test", Title: "F1"},
+		{URL: "https://f2.test", Title: "F2"},
+	}
+
+	got := MergeOmniboxResults(history, favorites, 0)
+
+	// f1=1.5, f2=1.0, h1=1.0, h2=0.75, ... ties keep history first.
+	assert.Equal(t, []string{
+		"https://f1.test",
+		"https://h1.test",
+		"https://f2.test",
+		"https://h2.test",
+		"https://h3.test",
+		"https://h4.test",
+	}, omniboxResultURLs(got))
+	assert.True(t, got[0].IsFavorite)
+	assert.False(t, got[1].IsFavorite)
+}
+
+func TestMergeOmniboxResults_DedupsByURLAndMarksFavorite(t *testing.T) {
+	history := []OmniboxResult{
+		{URL: "https://a.test/", Title: "A from history"},
+		{URL: "https://b.test", Title: "B"},
+	}
+	favorites := []*entity.Favorite{
+		nil,
+		{URL: "https://a.test", Title: "A favorite"},
+	}
+
+	got := MergeOmniboxResults(history, favorites, 0)
+
+	assert.Len(t, got, 2)
+	assert.Equal(t, "https://a.test/", got[0].URL)
+	assert.Equal(t, "A favorite", got[0].Title)
+	assert.True(t, got[0].IsFavorite)
+	assert.Equal(t, "https://b.test", got[1].URL)
+}
+
+func TestMergeOmniboxResults_HistoryFavoriteKeepsBonus(t *testing.T) {
+	history := []OmniboxResult{
+		{URL: "https://top.test"},
+		{URL: "https://starred.test", IsFavorite: true},
+		{URL: "https://last.test"},
+	}
+
+	got := MergeOmniboxResults(history, nil, 0)
+
+	assert.Equal(t, []string{"https://starred.test", "https://top.test", "https://last.test"}, omniboxResultURLs(got))
+}
+
+func TestMergeOmniboxResults_AppliesLimitAfterRanking(t *testing.T) {
+	history := []OmniboxResult{{URL: "https://h1.test"}, {URL: "https://h2.test"}}
+	favorites := []*entity.Favorite{{URL: "https://f1.test"}}
+
+	got := MergeOmniboxResults(history, favorites, 2)
+
+	assert.Equal(t, []string{"https://f1.test", "https://h1.test"}, omniboxResultURLs(got))
+}
+
+func TestMergeOmniboxResults_SkipsEmptyURLs(t *testing.T) {
+	got := MergeOmniboxResults([]OmniboxResult{{URL: " "}}, []*entity.Favorite{{URL: ""}}, 0)
+
+	assert.Empty(t, got)
+}
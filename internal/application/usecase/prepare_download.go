@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"net/url"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/download"
@@ -20,15 +23,24 @@ var _ port.DownloadPreparer = (*PrepareDownloadUseCase)(nil)
 
 // PrepareDownloadUseCase orchestrates filename resolution for downloads.
 // It combines multiple sources (suggested name, response headers, URI) to
-// determine the best filename, then sanitizes it to prevent path traversal.
+// determine the best filename, sanitizes it to prevent path traversal, and
+// applies the configured filename template.
 type PrepareDownloadUseCase struct {
-	fs port.FileSystem
+	fs               port.FileSystem
+	templateProvider func() string
+	now              func() time.Time
 }
 
 // NewPrepareDownloadUseCase creates a new PrepareDownloadUseCase.
 // If fs is nil, filename deduplication is disabled.
 func NewPrepareDownloadUseCase(fs port.FileSystem) *PrepareDownloadUseCase {
-	return &PrepareDownloadUseCase{fs: fs}
+	return &PrepareDownloadUseCase{fs: fs, now: time.Now}
+}
+
+// SetFilenameTemplateProvider sets the source of the filename template, read
+// each time a download starts. Without one, the suggested name is kept.
+func (u *PrepareDownloadUseCase) SetFilenameTemplateProvider(provider func() string) {
+	u.templateProvider = provider
 }
 
 // Execute resolves the download filename and destination path.
@@ -46,6 +58,11 @@ func (u *PrepareDownloadUseCase) Execute(ctx context.Context, input PrepareDownl
 
 	// Sanitize filename and add extension if needed
 	safeName := download.SanitizeFilenameWithExtension(resolvedName, mimeType)
+	safeName = download.ExpandFilenameTemplate(u.filenameTemplate(), download.TemplateVars{
+		Name: safeName,
+		Host: downloadHost(input.Response),
+		Time: u.now(),
+	})
 
 	// Make filename unique if file already exists
 	if u.fs != nil {
@@ -75,6 +92,28 @@ func (u *PrepareDownloadUseCase) Execute(ctx context.Context, input PrepareDownl
 	}
 }
 
+func (u *PrepareDownloadUseCase) filenameTemplate() string {
+	if u.templateProvider == nil {
+		return download.DefaultFilenameTemplate
+	}
+	if tmpl := u.templateProvider(); tmpl != "" {
+		return tmpl
+	}
+	return download.DefaultFilenameTemplate
+}
+
+// downloadHost returns the host the download is served from, without "www.".
+func downloadHost(response port.DownloadResponse) string {
+	if response == nil {
+		return ""
+	}
+	parsed, err := url.Parse(response.GetUri())
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
 // resolveSuggestedFilename determines the best filename from available sources.
 func (*PrepareDownloadUseCase) resolveSuggestedFilename(name string, response port.DownloadResponse) string {
 	// Priority 1: Use provided suggested filename
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -271,3 +272,48 @@ func TestPrepareDownloadUseCase_Deduplication(t *testing.T) {
 		assert.Equal(t, "/tmp/downloads/document.pdf", result.DestinationPath)
 	})
 }
+
+func TestPrepareDownloadUseCase_FilenameTemplate(t *testing.T) {
+	ctx := context.Background()
+	started := time.Date(2024, time.March, 9, 14, 5, 7, 0, time.Local)
+
+	t.Run("template applied with host from response", func(t *testing.T) {
+		uc := NewPrepareDownloadUseCase(nil)
+		uc.now = func() time.Time { return started }
+		uc.SetFilenameTemplateProvider(func() string { return "{date}-{host}-{name}" })
+
+		result := uc.Execute(ctx, PrepareDownloadInput{
+			Response:    &mockDownloadResponse{suggestedFilename: "report.pdf", uri: "https://www.Example.com/files/report.pdf"},
+			DownloadDir: "/tmp/downloads",
+		})
+		assert.Equal(t, "2024-03-09-example.com-report.pdf", result.Filename)
+		assert.Equal(t, "/tmp/downloads/2024-03-09-example.com-report.pdf", result.DestinationPath)
+	})
+
+	t.Run("templated name gets collision suffix", func(t *testing.T) {
+		fs := &mockFileSystem{
+			existingFiles: map[string]bool{
+				"/tmp/downloads/2024-03-09-example.com-report.pdf": true,
+			},
+		}
+		uc := NewPrepareDownloadUseCase(fs)
+		uc.now = func() time.Time { return started }
+		uc.SetFilenameTemplateProvider(func() string { return "{date}-{host}-{name}" })
+
+		result := uc.Execute(ctx, PrepareDownloadInput{
+			Response:    &mockDownloadResponse{suggestedFilename: "report.pdf", uri: "https://example.com/report.pdf"},
+			DownloadDir: "/tmp/downloads",
+		})
+		assert.Equal(t, "2024-03-09-example.com-report_(1).pdf", result.Filename)
+	})
+
+	t.Run("illegal characters replaced", func(t *testing.T) {
+		uc := NewPrepareDownloadUseCase(nil)
+
+		result := uc.Execute(ctx, PrepareDownloadInput{
+			SuggestedFilename: `Q3: "final" <draft>.pdf`,
+			DownloadDir:       "/tmp/downloads",
+		})
+		assert.Equal(t, "Q3_ _final_ _draft_.pdf", result.Filename)
+	})
+}
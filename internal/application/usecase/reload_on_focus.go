@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ReloadOnFocusSettleDelay is how long a pane must keep focus before it is
+// reloaded. Focusing another pane first cancels the reload, so rapid focus
+// switching never triggers a reload storm.
+const ReloadOnFocusSettleDelay = 500 * time.Millisecond
+
+// ReloadOnFocusUseCase reloads panes matching workspace.reload_on_focus_domains
+// when they regain focus after being unfocused for at least
+// workspace.reload_on_focus_after_seconds.
+type ReloadOnFocusUseCase struct {
+	config func() entity.WorkspaceConfig
+	reload func(ctx context.Context, paneID entity.PaneID)
+
+	now       func() time.Time
+	afterFunc func(d time.Duration, fn func()) (stop func() bool)
+
+	mu        sync.Mutex
+	focused   entity.PaneID
+	blurredAt map[entity.PaneID]time.Time
+	pending   func() bool
+	// generation invalidates reloads whose timer fired after being canceled.
+	generation uint64
+}
+
+// NewReloadOnFocusUseCase creates a reload-on-focus use case. config is read
+// on every focus change so config reloads apply without restarting. reload
+// runs on a timer goroutine; callers touching the UI must hop back to the
+// main thread themselves.
+func NewReloadOnFocusUseCase(
+	config func() entity.WorkspaceConfig,
+	reload func(ctx context.Context, paneID entity.PaneID),
+) *ReloadOnFocusUseCase {
+	return &ReloadOnFocusUseCase{
+		config: config,
+		reload: reload,
+		now:    time.Now,
+		afterFunc: func(d time.Duration, fn func()) func() bool {
+			return time.AfterFunc(d, fn).Stop
+		},
+		blurredAt: make(map[entity.PaneID]time.Time),
+	}
+}
+
+// PaneFocused records that paneID, currently showing uri, gained focus. The
+// previously focused pane starts its unfocused period. Repeated calls for the
+// already focused pane are ignored.
+func (uc *ReloadOnFocusUseCase) PaneFocused(ctx context.Context, paneID entity.PaneID, uri string) {
+	if uc == nil || paneID == "" {
+		return
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if paneID == uc.focused {
+		return
+	}
+
+	now := uc.now()
+	if uc.focused != "" {
+		uc.blurredAt[uc.focused] = now
+	}
+	uc.cancelPendingLocked()
+	uc.focused = paneID
+
+	blurredAt, seen := uc.blurredAt[paneID]
+	delete(uc.blurredAt, paneID)
+	// A pane focused for the first time has just loaded.
+	if !seen || uc.config == nil {
+		return
+	}
+
+	cfg := uc.config()
+	if len(cfg.ReloadOnFocusDomains) == 0 || !urlutil.MatchAnyDomainPattern(cfg.ReloadOnFocusDomains, uri) {
+		return
+	}
+	threshold := time.Duration(cfg.ReloadOnFocusAfterSeconds) * time.Second
+	if now.Sub(blurredAt) < threshold {
+		return
+	}
+
+	uc.generation++
+	generation := uc.generation
+	logging.FromContext(ctx).Debug().
+		Str("pane_id", string(paneID)).
+		Dur("unfocused_for", now.Sub(blurredAt)).
+		Msg("scheduling reload on focus")
+	// Use context.Background() since the timer fires after the caller returned.
+	uc.pending = uc.afterFunc(ReloadOnFocusSettleDelay, func() {
+		uc.fire(context.Background(), generation, paneID)
+	})
+}
+
+// PaneClosed forgets paneID and cancels its pending reload.
+func (uc *ReloadOnFocusUseCase) PaneClosed(paneID entity.PaneID) {
+	if uc == nil {
+		return
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	delete(uc.blurredAt, paneID)
+	if uc.focused == paneID {
+		uc.cancelPendingLocked()
+		uc.focused = ""
+	}
+}
+
+func (uc *ReloadOnFocusUseCase) fire(ctx context.Context, generation uint64, paneID entity.PaneID) {
+	uc.mu.Lock()
+	if generation != uc.generation || uc.pending == nil || uc.focused != paneID {
+		uc.mu.Unlock()
+		return
+	}
+	uc.pending = nil
+	uc.mu.Unlock()
+
+	if uc.reload != nil {
+		uc.reload(ctx, paneID)
+	}
+}
+
+// cancelPendingLocked must be called with uc.mu held.
+func (uc *ReloadOnFocusUseCase) cancelPendingLocked() {
+	if uc.pending == nil {
+		return
+	}
+	uc.pending()
+	uc.pending = nil
+	uc.generation++
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type fakeReloadTimer struct {
+	fn      func()
+	stopped bool
+}
+
+type reloadOnFocusHarness struct {
+	uc       *ReloadOnFocusUseCase
+	clock    time.Time
+	timers   []*fakeReloadTimer
+	reloaded []entity.PaneID
+}
+
+func newReloadOnFocusHarness(domains []string, afterSeconds int) *reloadOnFocusHarness {
+	h := &reloadOnFocusHarness{clock: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	h.uc = NewReloadOnFocusUseCase(
+		func() entity.WorkspaceConfig {
+			return entity.WorkspaceConfig{
+				ReloadOnFocusDomains:      domains,
+				ReloadOnFocusAfterSeconds: afterSeconds,
+			}
+		},
+		func(_ context.Context, paneID entity.PaneID) {
+			h.reloaded = append(h.reloaded, paneID)
+		},
+	)
+	h.uc.now = func() time.Time { return h.clock }
+	h.uc.afterFunc = func(d time.Duration, fn func()) func() bool {
+		timer := &fakeReloadTimer{fn: fn}
+		h.timers = append(h.timers, timer)
+		return func() bool {
+			wasActive := !timer.stopped
+			timer.stopped = true
+			return wasActive
+		}
+	}
+	return h
+}
+
+func (h *reloadOnFocusHarness) advance(d time.Duration) {
+	h.clock = h.clock.Add(d)
+}
+
+// fireTimers runs every timer that has not been stopped, like the runtime
+// would once the settle delay elapses.
+func (h *reloadOnFocusHarness) fireTimers() {
+	for _, timer := range h.timers {
+		if !timer.stopped {
+			timer.stopped = true
+			timer.fn()
+		}
+	}
+}
+
+const dashboardURL = "https://grafana.example.com/d/overview"
+
+func TestReloadOnFocus_ReloadsAfterThreshold(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 60)
+
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(61 * time.Second)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+
+	require.Len(t, h.timers, 1)
+	assert.Empty(t, h.reloaded, "reload waits for the settle delay")
+	h.fireTimers()
+	assert.Equal(t, []entity.PaneID{"dash"}, h.reloaded)
+}
+
+func TestReloadOnFocus_SkipsBelowThreshold(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 60)
+
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(59 * time.Second)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+
+	h.fireTimers()
+	assert.Empty(t, h.timers)
+	assert.Empty(t, h.reloaded)
+}
+
+func TestReloadOnFocus_UnfocusedPeriodRestartsOnEachBlur(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 60)
+
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(45 * time.Second)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(45 * time.Second)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+
+	h.fireTimers()
+	assert.Empty(t, h.reloaded, "neither unfocused period reached the threshold")
+}
+
+func TestReloadOnFocus_SkipsUnmatchedAndNewPanes(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 60)
+
+	// First focus of a pane never reloads: it has just loaded.
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "docs", "https://docs.test/")
+	h.advance(2 * time.Minute)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.fireTimers()
+	require.Equal(t, []entity.PaneID{"dash"}, h.reloaded)
+
+	h.advance(2 * time.Minute)
+	h.uc.PaneFocused(ctx, "docs", "https://docs.test/")
+	h.fireTimers()
+	assert.Equal(t, []entity.PaneID{"dash"}, h.reloaded)
+}
+
+func TestReloadOnFocus_DisabledWithoutDomains(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness(nil, 0)
+
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(time.Hour)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+
+	h.fireTimers()
+	assert.Empty(t, h.reloaded)
+}
+
+func TestReloadOnFocus_DebouncesRapidFocusSwitching(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 60)
+
+	h.uc.PaneFocused(ctx, "a", "https://a.example.com/")
+	h.uc.PaneFocused(ctx, "b", "https://b.example.com/")
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(2 * time.Minute)
+
+	// Flick through both dashboards before settling on an unrelated pane.
+	h.uc.PaneFocused(ctx, "a", "https://a.example.com/")
+	h.advance(100 * time.Millisecond)
+	h.uc.PaneFocused(ctx, "b", "https://b.example.com/")
+	h.advance(100 * time.Millisecond)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+
+	require.Len(t, h.timers, 2)
+	h.fireTimers()
+	assert.Empty(t, h.reloaded, "focus left each dashboard before the settle delay")
+
+	// Coming straight back does not reload either: the dashboards were only
+	// unfocused for a moment.
+	h.advance(time.Second)
+	h.uc.PaneFocused(ctx, "a", "https://a.example.com/")
+	h.fireTimers()
+	assert.Empty(t, h.reloaded)
+}
+
+func TestReloadOnFocus_StaleTimerDoesNotReload(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 0)
+
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	require.Len(t, h.timers, 1)
+	stale := h.timers[0].fn
+
+	// The timer had already fired when focus moved away.
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	stale()
+	assert.Empty(t, h.reloaded)
+}
+
+func TestReloadOnFocus_RepeatedFocusIsIgnored(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 60)
+
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(2 * time.Minute)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+
+	require.Len(t, h.timers, 1)
+	h.fireTimers()
+	assert.Equal(t, []entity.PaneID{"dash"}, h.reloaded)
+}
+
+func TestReloadOnFocus_PaneClosedCancelsPendingReload(t *testing.T) {
+	ctx := context.Background()
+	h := newReloadOnFocusHarness([]string{"*.example.com"}, 60)
+
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneFocused(ctx, "other", "https://docs.test/")
+	h.advance(2 * time.Minute)
+	h.uc.PaneFocused(ctx, "dash", dashboardURL)
+	h.uc.PaneClosed("dash")
+
+	h.fireTimers()
+	assert.Empty(t, h.reloaded)
+}
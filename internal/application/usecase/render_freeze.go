@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// RenderFreezeUseCase freezes the rendering of a pane when it loses focus,
+// while workspace.freeze_background_panes is enabled, and thaws it as soon as
+// it gains focus again.
+type RenderFreezeUseCase struct {
+	config    func() entity.WorkspaceConfig
+	setFrozen func(ctx context.Context, paneID entity.PaneID, frozen bool)
+
+	mu      sync.Mutex
+	focused entity.PaneID
+	frozen  map[entity.PaneID]struct{}
+}
+
+// NewRenderFreezeUseCase creates a render freeze use case. config is read on
+// every focus change so config reloads apply without restarting. setFrozen
+// runs synchronously on the caller's thread.
+func NewRenderFreezeUseCase(
+	config func() entity.WorkspaceConfig,
+	setFrozen func(ctx context.Context, paneID entity.PaneID, frozen bool),
+) *RenderFreezeUseCase {
+	return &RenderFreezeUseCase{
+		config:    config,
+		setFrozen: setFrozen,
+		frozen:    make(map[entity.PaneID]struct{}),
+	}
+}
+
+// PaneFocused thaws paneID and freezes the previously focused pane. With the
+// setting off, every frozen pane is thawed instead. Repeated calls for the
+// already focused pane are ignored.
+func (uc *RenderFreezeUseCase) PaneFocused(ctx context.Context, paneID entity.PaneID) {
+	if uc == nil || paneID == "" {
+		return
+	}
+
+	uc.mu.Lock()
+	if paneID == uc.focused {
+		uc.mu.Unlock()
+		return
+	}
+	previous := uc.focused
+	uc.focused = paneID
+
+	var thaw []entity.PaneID
+	if _, ok := uc.frozen[paneID]; ok {
+		delete(uc.frozen, paneID)
+		thaw = append(thaw, paneID)
+	}
+	var freeze entity.PaneID
+	if uc.enabled() {
+		if previous != "" {
+			uc.frozen[previous] = struct{}{}
+			freeze = previous
+		}
+	} else {
+		for id := range uc.frozen {
+			thaw = append(thaw, id)
+		}
+		clear(uc.frozen)
+	}
+	uc.mu.Unlock()
+
+	if uc.setFrozen == nil {
+		return
+	}
+	slices.Sort(thaw)
+	for _, id := range thaw {
+		uc.setFrozen(ctx, id, false)
+	}
+	if freeze != "" {
+		logging.FromContext(ctx).Debug().Str("pane_id", string(freeze)).Msg("freezing background pane rendering")
+		uc.setFrozen(ctx, freeze, true)
+	}
+}
+
+// PaneClosed forgets paneID.
+func (uc *RenderFreezeUseCase) PaneClosed(paneID entity.PaneID) {
+	if uc == nil {
+		return
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	delete(uc.frozen, paneID)
+	if uc.focused == paneID {
+		uc.focused = ""
+	}
+}
+
+// IsFrozen reports whether paneID is frozen.
+func (uc *RenderFreezeUseCase) IsFrozen(paneID entity.PaneID) bool {
+	if uc == nil {
+		return false
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	_, ok := uc.frozen[paneID]
+	return ok
+}
+
+// enabled must be called with uc.mu held.
+func (uc *RenderFreezeUseCase) enabled() bool {
+	return uc.config != nil && uc.config().FreezeBackgroundPanes
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type freezeCall struct {
+	paneID entity.PaneID
+	frozen bool
+}
+
+type renderFreezeHarness struct {
+	uc      *RenderFreezeUseCase
+	enabled bool
+	calls   []freezeCall
+}
+
+func newRenderFreezeHarness(enabled bool) *renderFreezeHarness {
+	h := &renderFreezeHarness{enabled: enabled}
+	h.uc = NewRenderFreezeUseCase(
+		func() entity.WorkspaceConfig {
+			return entity.WorkspaceConfig{FreezeBackgroundPanes: h.enabled}
+		},
+		func(_ context.Context, paneID entity.PaneID, frozen bool) {
+			h.calls = append(h.calls, freezeCall{paneID: paneID, frozen: frozen})
+		},
+	)
+	return h
+}
+
+func (h *renderFreezeHarness) focus(paneID entity.PaneID) []freezeCall {
+	h.calls = nil
+	h.uc.PaneFocused(context.Background(), paneID)
+	return h.calls
+}
+
+func TestRenderFreeze_FreezesPaneThatLosesFocus(t *testing.T) {
+	h := newRenderFreezeHarness(true)
+
+	assert.Empty(t, h.focus("a"), "the first focused pane has nothing to freeze")
+	assert.Equal(t, []freezeCall{{paneID: "a", frozen: true}}, h.focus("b"))
+	assert.True(t, h.uc.IsFrozen("a"))
+	assert.False(t, h.uc.IsFrozen("b"))
+}
+
+func TestRenderFreeze_FocusThawsFrozenPane(t *testing.T) {
+	h := newRenderFreezeHarness(true)
+	h.focus("a")
+	h.focus("b")
+
+	assert.Equal(t, []freezeCall{
+		{paneID: "a", frozen: false},
+		{paneID: "b", frozen: true},
+	}, h.focus("a"), "the focused pane thaws before the previous one freezes")
+	assert.False(t, h.uc.IsFrozen("a"))
+	assert.True(t, h.uc.IsFrozen("b"))
+}
+
+func TestRenderFreeze_RepeatedFocusIsIgnored(t *testing.T) {
+	h := newRenderFreezeHarness(true)
+	h.focus("a")
+
+	assert.Empty(t, h.focus("a"))
+	assert.False(t, h.uc.IsFrozen("a"))
+}
+
+func TestRenderFreeze_DisabledNeverFreezes(t *testing.T) {
+	h := newRenderFreezeHarness(false)
+	h.focus("a")
+
+	assert.Empty(t, h.focus("b"))
+	assert.False(t, h.uc.IsFrozen("a"))
+}
+
+func TestRenderFreeze_DisablingThawsEveryFrozenPane(t *testing.T) {
+	h := newRenderFreezeHarness(true)
+	h.focus("a")
+	h.focus("b")
+	h.focus("c")
+
+	h.enabled = false
+	assert.Equal(t, []freezeCall{
+		{paneID: "a", frozen: false},
+		{paneID: "b", frozen: false},
+	}, h.focus("d"))
+	assert.False(t, h.uc.IsFrozen("a"))
+	assert.False(t, h.uc.IsFrozen("b"))
+	assert.False(t, h.uc.IsFrozen("c"), "the pane losing focus is not frozen once disabled")
+}
+
+func TestRenderFreeze_ClosedPaneIsForgotten(t *testing.T) {
+	h := newRenderFreezeHarness(true)
+	h.focus("a")
+	h.focus("b")
+
+	h.uc.PaneClosed("a")
+	assert.False(t, h.uc.IsFrozen("a"))
+
+	h.uc.PaneClosed("b")
+	assert.Empty(t, h.focus("c"), "a closed focused pane is not frozen")
+
+	h.enabled = false
+	assert.Empty(t, h.focus("d"), "closed panes are never thawed")
+}
+
+func TestRenderFreeze_NilUseCaseIsSafe(t *testing.T) {
+	var uc *RenderFreezeUseCase
+	uc.PaneFocused(context.Background(), "a")
+	uc.PaneClosed("a")
+	assert.False(t, uc.IsFrozen("a"))
+}
@@ -38,6 +38,9 @@ type ResolveThemeInput struct {
 
 	// ModeColors from user config (may be nil for defaults).
 	ModeColors *entity.ThemeModeColors
+
+	// WorkspaceBackground from user config (may be nil for none).
+	WorkspaceBackground *entity.WorkspaceBackgroundConfig
 }
 
 // ResolveThemeOutput is the result of theme resolution.
@@ -102,10 +105,12 @@ func ResolveThemeInputFromConfig(
 			GtkFont:       appearance.GtkFont,
 			DefaultSize:   appearance.DefaultFontSize,
 		}
+		workspaceBackground := appearance.WorkspaceBackground
 		input.ColorScheme = appearance.ColorScheme
 		input.LightPalette = &lightPalette
 		input.DarkPalette = &darkPalette
 		input.Fonts = &fonts
+		input.WorkspaceBackground = &workspaceBackground
 	}
 
 	if styling != nil {
@@ -416,21 +421,53 @@ func buildResolvedTheme(
 		modeColors = entity.DefaultThemeModeColors()
 	}
 
+	var workspaceBackground entity.WorkspaceBackgroundConfig
+	if input.WorkspaceBackground != nil {
+		workspaceBackground, warnings = validWorkspaceBackground(*input.WorkspaceBackground, warnings)
+	}
+
 	activePalette := lightPalette
 	if input.PrefersDark {
 		activePalette = darkPalette
 	}
 
 	return entity.ResolvedTheme{
-		LightPalette:      lightPalette,
-		DarkPalette:       darkPalette,
-		ActivePalette:     activePalette,
-		PrefersDark:       input.PrefersDark,
-		ColorSchemeSource: input.ColorSchemeSource,
-		ThemeSource:       themeSource,
-		Fonts:             fonts,
-		UIScale:           uiScale,
-		ModeColors:        modeColors,
-		Warnings:          warnings,
+		LightPalette:        lightPalette,
+		DarkPalette:         darkPalette,
+		ActivePalette:       activePalette,
+		PrefersDark:         input.PrefersDark,
+		ColorSchemeSource:   input.ColorSchemeSource,
+		ThemeSource:         themeSource,
+		Fonts:               fonts,
+		UIScale:             uiScale,
+		ModeColors:          modeColors,
+		WorkspaceBackground: workspaceBackground,
+		Warnings:            warnings,
+	}
+}
+
+// validWorkspaceBackground drops an invalid color or pattern of bg with a
+// warning, keeping the rest of it.
+func validWorkspaceBackground(
+	bg entity.WorkspaceBackgroundConfig,
+	warnings []entity.ThemeWarning,
+) (entity.WorkspaceBackgroundConfig, []entity.ThemeWarning) {
+	if bg.Color != "" && !entity.IsValidHex(bg.Color) {
+		warnings = append(warnings, entity.ThemeWarning{
+			Field:   "workspace_background.color",
+			Message: "workspace background color is not a #RRGGBB color, using the theme background",
+		})
+		bg.Color = ""
+	}
+	if bg.Pattern == "" {
+		bg.Pattern = entity.WorkspaceBackgroundPatternNone
+	}
+	if !bg.Pattern.IsValid() {
+		warnings = append(warnings, entity.ThemeWarning{
+			Field:   "workspace_background.pattern",
+			Message: "unknown workspace background pattern, drawing none",
+		})
+		bg.Pattern = entity.WorkspaceBackgroundPatternNone
 	}
+	return bg, warnings
 }
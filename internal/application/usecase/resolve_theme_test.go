@@ -1194,6 +1194,54 @@ func TestResolveTheme_InvalidModeColors_FallsBackToDefaults(t *testing.T) {
 	}
 }
 
+// TestResolveTheme_WorkspaceBackground_DropsInvalidFields verifies an invalid
+// workspace background color or pattern is dropped with a warning while the
+// image is kept.
+func TestResolveTheme_WorkspaceBackground_DropsInvalidFields(t *testing.T) {
+	uc := NewResolveThemeUseCase(nil)
+
+	output, err := uc.Execute(context.Background(), ResolveThemeInput{
+		WorkspaceBackground: &entity.WorkspaceBackgroundConfig{
+			Color:   "red; background-image: none",
+			Image:   "/tmp/wallpaper.png",
+			Pattern: "zigzag",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := entity.WorkspaceBackgroundConfig{
+		Image:   "/tmp/wallpaper.png",
+		Pattern: entity.WorkspaceBackgroundPatternNone,
+	}
+	if output.Theme.WorkspaceBackground != want {
+		t.Errorf("expected workspace background %+v, got %+v", want, output.Theme.WorkspaceBackground)
+	}
+	if len(output.Theme.Warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %+v", output.Theme.Warnings)
+	}
+}
+
+// TestResolveTheme_WorkspaceBackground_KeepsValidConfig verifies a valid
+// workspace background passes through unchanged.
+func TestResolveTheme_WorkspaceBackground_KeepsValidConfig(t *testing.T) {
+	uc := NewResolveThemeUseCase(nil)
+	bg := entity.WorkspaceBackgroundConfig{Color: "#1e1e2e", Pattern: entity.WorkspaceBackgroundPatternDots}
+
+	output, err := uc.Execute(context.Background(), ResolveThemeInput{WorkspaceBackground: &bg})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Theme.WorkspaceBackground != bg {
+		t.Errorf("expected workspace background %+v, got %+v", bg, output.Theme.WorkspaceBackground)
+	}
+	if len(output.Theme.Warnings) != 0 {
+		t.Errorf("expected no warnings, got %+v", output.Theme.Warnings)
+	}
+}
+
 // ============================================================
 // UIScale defaults
 // ============================================================
@@ -1311,6 +1359,10 @@ func TestResolveThemeInputFromConfig_MapsAppearanceStylingAndPreference(t *testi
 		ColorScheme:     "prefer-dark",
 		LightPalette:    *configLightPalette(),
 		DarkPalette:     *configDarkPalette(),
+		WorkspaceBackground: entity.WorkspaceBackgroundConfig{
+			Color:   "#1e1e2e",
+			Pattern: entity.WorkspaceBackgroundPatternGrid,
+		},
 	}
 	styling := &entity.WorkspaceStylingConfig{
 		PaneModeColor:    "#111111",
@@ -1343,6 +1395,9 @@ func TestResolveThemeInputFromConfig_MapsAppearanceStylingAndPreference(t *testi
 	if input.ModeColors == nil || input.ModeColors.PaneMode != "#111111" || input.ModeColors.ResizeMode != "#444444" {
 		t.Fatalf("expected mode colors from styling, got %+v", input.ModeColors)
 	}
+	if input.WorkspaceBackground == nil || *input.WorkspaceBackground != appearance.WorkspaceBackground {
+		t.Fatalf("expected workspace background from appearance, got %+v", input.WorkspaceBackground)
+	}
 }
 
 // TestResolveThemeInputFromConfig_AllowsNilConfig verifies callers can resolve defaults
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// ShouldAutoDismissScriptDialog reports whether a JavaScript dialog opened by
+// the page at uri is answered without being shown: either every dialog is
+// auto-dismissed, or uri matches script_dialogs.auto_dismiss_domains.
+func ShouldAutoDismissScriptDialog(cfg entity.RuntimeScriptDialogsConfig, uri string) bool {
+	if cfg.AutoDismiss {
+		return true
+	}
+	if uri == "" || len(cfg.AutoDismissDomains) == 0 {
+		return false
+	}
+	return urlutil.MatchAnyDomainPattern(cfg.AutoDismissDomains, uri)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestShouldAutoDismissScriptDialog(t *testing.T) {
+	perDomain := entity.RuntimeScriptDialogsConfig{AutoDismissDomains: []string{"kiosk.example", "*.ci.test"}}
+
+	tests := []struct {
+		name string
+		cfg  entity.RuntimeScriptDialogsConfig
+		uri  string
+		want bool
+	}{
+		{name: "default shows dialogs", uri: "https://example.com/"},
+		{name: "global auto dismiss", cfg: entity.RuntimeScriptDialogsConfig{AutoDismiss: true}, uri: "https://example.com/", want: true},
+		{name: "global auto dismiss without uri", cfg: entity.RuntimeScriptDialogsConfig{AutoDismiss: true}, want: true},
+		{name: "exact domain", cfg: perDomain, uri: "https://kiosk.example/board", want: true},
+		{name: "subdomain glob", cfg: perDomain, uri: "https://runner.ci.test/job/1", want: true},
+		{name: "other domain", cfg: perDomain, uri: "https://example.com/"},
+		{name: "exact domain does not match subdomains", cfg: perDomain, uri: "https://admin.kiosk.example/"},
+		{name: "empty uri", cfg: perDomain},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ShouldAutoDismissScriptDialog(tt.cfg, tt.uri))
+		})
+	}
+}
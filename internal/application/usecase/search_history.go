@@ -9,6 +9,7 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/autocomplete"
 	"github.com/bnema/dumber/internal/domain/entity"
 	historydomain "github.com/bnema/dumber/internal/domain/history"
 	"github.com/bnema/dumber/internal/domain/repository"
@@ -31,6 +32,12 @@ const (
 	maxHistorySearchLimit     = 100
 	defaultDomainStatsLimit   = 20
 	maxDomainStatsLimit       = 100
+	defaultHistoryGroupLimit  = 30
+	maxHistoryGroupLimit      = 100
+	maxHistoryGroupEntries    = 100
+	// fuzzyHistoryCandidates is how many recent entries fuzzy search scans
+	// on top of the full-text matches, which only find word prefixes.
+	fuzzyHistoryCandidates = 500
 )
 
 // SearchHistoryUseCase handles history search and retrieval operations.
@@ -86,7 +93,9 @@ type SearchInput = dto.HistorySearchInput
 type SearchOutput = dto.HistorySearchOutput
 
 // Search performs a full-text search on history entries using SQLite FTS5.
-// Returns only entries that actually match the query terms.
+// Returns only entries that actually match the query terms. Prefix mode keeps
+// the matches whose title or address starts with the query; fuzzy mode also
+// scans recent history and ranks everything by fuzzy score.
 func (uc *SearchHistoryUseCase) Search(ctx context.Context, input SearchInput) (*SearchOutput, error) {
 	log := logging.FromContext(ctx)
 
@@ -96,8 +105,13 @@ func (uc *SearchHistoryUseCase) Search(ctx context.Context, input SearchInput) (
 
 	limit := clampPositiveLimit(input.Limit, defaultHistorySearchLimit, maxHistorySearchLimit)
 
+	ftsLimit := limit
+	if input.MatchMode == entity.OmniboxMatchModePrefix || input.MatchMode == entity.OmniboxMatchModeFuzzy {
+		ftsLimit = maxHistorySearchLimit
+	}
+
 	// Use repository's FTS5 search
-	matches, err := uc.historyRepo.Search(ctx, input.Query, limit)
+	matches, err := uc.historyRepo.Search(ctx, input.Query, ftsLimit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search history: %w", err)
 	}
@@ -107,9 +121,64 @@ func (uc *SearchHistoryUseCase) Search(ctx context.Context, input SearchInput) (
 		Int("matches", len(matches)).
 		Msg("FTS5 search completed")
 
+	switch input.MatchMode {
+	case entity.OmniboxMatchModePrefix:
+		matches = rankHistoryMatches(input.MatchMode, input.Query, matches, limit)
+	case entity.OmniboxMatchModeFuzzy:
+		recent, err := uc.historyRepo.GetRecent(ctx, fuzzyHistoryCandidates, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load fuzzy search candidates: %w", err)
+		}
+		matches = rankHistoryMatches(input.MatchMode, input.Query, appendHistoryCandidates(matches, recent), limit)
+	}
+
 	return &SearchOutput{Matches: matches}, nil
 }
 
+// appendHistoryCandidates adds the entries not already in matches, keeping
+// the full-text matches first.
+func appendHistoryCandidates(matches []entity.HistoryMatch, entries []*entity.HistoryEntry) []entity.HistoryMatch {
+	seen := make(map[string]struct{}, len(matches)+len(entries))
+	for _, match := range matches {
+		if match.Entry != nil {
+			seen[match.Entry.URL] = struct{}{}
+		}
+	}
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+		if _, ok := seen[entry.URL]; ok {
+			continue
+		}
+		seen[entry.URL] = struct{}{}
+		matches = append(matches, entity.HistoryMatch{Entry: entry})
+	}
+	return matches
+}
+
+// rankHistoryMatches keeps the candidates matching query under mode, best
+// first, capped at limit. Ties keep the candidate order.
+func rankHistoryMatches(mode entity.OmniboxMatchMode, query string, candidates []entity.HistoryMatch, limit int) []entity.HistoryMatch {
+	fields := make([][]string, len(candidates))
+	for i, candidate := range candidates {
+		if candidate.Entry == nil {
+			continue
+		}
+		fields[i] = append([]string{candidate.Entry.Title}, autocomplete.URLMatchFields(candidate.Entry.URL)...)
+	}
+
+	ranked := autocomplete.Rank(mode, query, fields)
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	out := make([]entity.HistoryMatch, 0, len(ranked))
+	for _, match := range ranked {
+		out = append(out, entity.HistoryMatch{Entry: candidates[match.Index].Entry, Score: float64(match.Score)})
+	}
+	return out
+}
+
 // GetRecent retrieves recent history entries. A zero limit means all entries;
 // negative limits retain the historical default page size. Positive limits are
 // capped to keep WebUI-originated queries bounded.
@@ -463,6 +532,31 @@ func (uc *SearchHistoryUseCase) GetDomainStats(ctx context.Context, limit int) (
 	return uc.historyRepo.GetDomainStats(ctx, limit)
 }
 
+// GroupByDomain retrieves history grouped by domain, most recently visited
+// domain first. perGroup bounds the entries attached to each group; zero
+// returns counts only.
+func (uc *SearchHistoryUseCase) GroupByDomain(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	log := logging.FromContext(ctx)
+	log.Debug().Int("limit", limit).Int("per_group", perGroup).Msg("grouping history by domain")
+
+	limit = clampPositiveLimit(limit, defaultHistoryGroupLimit, maxHistoryGroupLimit)
+	perGroup = clampOptionalLimit(perGroup, 0, maxHistoryGroupEntries)
+
+	return uc.historyRepo.GroupByDomain(ctx, limit, perGroup)
+}
+
+// GroupByDay retrieves history grouped by local calendar day, newest first.
+// perGroup bounds the entries attached to each group; zero returns counts only.
+func (uc *SearchHistoryUseCase) GroupByDay(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	log := logging.FromContext(ctx)
+	log.Debug().Int("limit", limit).Int("per_group", perGroup).Msg("grouping history by day")
+
+	limit = clampPositiveLimit(limit, defaultHistoryGroupLimit, maxHistoryGroupLimit)
+	perGroup = clampOptionalLimit(perGroup, 0, maxHistoryGroupEntries)
+
+	return uc.historyRepo.GroupByDay(ctx, limit, perGroup)
+}
+
 // clampOptionalLimit normalizes an optional limit where zero remains a valid
 // "no limit" value, negatives fall back to defaultLimit, and values above
 // maxLimit are capped. Use clampPositiveLimit instead when zero should be
@@ -109,6 +109,44 @@ func TestSearchHistoryUseCase_Search_ClampsOversizedLimit(t *testing.T) {
 	assert.Empty(t, result.Matches)
 }
 
+func TestSearchHistoryUseCase_Search_PrefixKeepsLeadingMatches(t *testing.T) {
+	ctx := testContext()
+	historyRepo := repomocks.NewMockHistoryRepository(t)
+	historyRepo.EXPECT().Search(mock.Anything, "go", 100).Return([]entity.HistoryMatch{
+		{Entry: &entity.HistoryEntry{URL: "https://example.com/", Title: "Let's go"}},
+		{Entry: &entity.HistoryEntry{URL: "https://go.dev/", Title: "The Go Programming Language"}},
+		{Entry: &entity.HistoryEntry{URL: "https://pkg.example/", Title: "Go Packages"}},
+	}, nil).Once()
+
+	uc := usecase.NewSearchHistoryUseCase(historyRepo)
+	result, err := uc.Search(ctx, usecase.SearchInput{Query: "go", Limit: 10, MatchMode: entity.OmniboxMatchModePrefix})
+
+	require.NoError(t, err)
+	require.Len(t, result.Matches, 2)
+	assert.Equal(t, "https://go.dev/", result.Matches[0].Entry.URL)
+	assert.Equal(t, "https://pkg.example/", result.Matches[1].Entry.URL)
+}
+
+func TestSearchHistoryUseCase_Search_FuzzyScansRecentHistory(t *testing.T) {
+	ctx := testContext()
+	historyRepo := repomocks.NewMockHistoryRepository(t)
+	github := &entity.HistoryEntry{URL: "https://github.com/", Title: "GitHub"}
+	historyRepo.EXPECT().Search(mock.Anything, "gthb", 100).Return([]entity.HistoryMatch{}, nil).Once()
+	historyRepo.EXPECT().GetRecent(mock.Anything, 500, 0).Return([]*entity.HistoryEntry{
+		{URL: "https://example.com/", Title: "Example"},
+		{URL: "https://notes.example/", Title: "Gist Hub notes"},
+		github,
+	}, nil).Once()
+
+	uc := usecase.NewSearchHistoryUseCase(historyRepo)
+	result, err := uc.Search(ctx, usecase.SearchInput{Query: "gthb", Limit: 1, MatchMode: entity.OmniboxMatchModeFuzzy})
+
+	require.NoError(t, err)
+	require.Len(t, result.Matches, 1)
+	assert.Same(t, github, result.Matches[0].Entry)
+	assert.Positive(t, result.Matches[0].Score)
+}
+
 func TestSearchHistoryUseCase_GetRecentWindow_InvalidDomainReturnsValidationError(t *testing.T) {
 	ctx := testContext()
 	historyRepo := repomocks.NewMockHistoryRepository(t)
@@ -315,6 +353,31 @@ func TestSearchHistoryUseCase_GetDomainStats_ClampsOversizedLimit(t *testing.T)
 	assert.Empty(t, result)
 }
 
+func TestSearchHistoryUseCase_GroupByDomain_ClampsLimits(t *testing.T) {
+	ctx := testContext()
+	historyRepo := repomocks.NewMockHistoryRepository(t)
+	historyRepo.EXPECT().GroupByDomain(mock.Anything, 100, 100).Return([]*entity.HistoryGroup{}, nil).Once()
+
+	uc := usecase.NewSearchHistoryUseCase(historyRepo)
+	result, err := uc.GroupByDomain(ctx, 10_000, 10_000)
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestSearchHistoryUseCase_GroupByDay_DefaultsInvalidLimits(t *testing.T) {
+	ctx := testContext()
+	historyRepo := repomocks.NewMockHistoryRepository(t)
+	groups := []*entity.HistoryGroup{{Key: "2026-06-20", EntryCount: 2}}
+	historyRepo.EXPECT().GroupByDay(mock.Anything, 30, 0).Return(groups, nil).Once()
+
+	uc := usecase.NewSearchHistoryUseCase(historyRepo)
+	result, err := uc.GroupByDay(ctx, 0, -1)
+
+	require.NoError(t, err)
+	assert.Equal(t, groups, result)
+}
+
 func TestSearchHistoryUseCase_Delete_PublishesDeleteChangeAfterSuccess(t *testing.T) {
 	ctx := testContext()
 	historyRepo := repomocks.NewMockHistoryRepository(t)
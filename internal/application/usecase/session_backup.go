@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/repository"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ErrInvalidBackup is returned when a session backup cannot be imported.
+var ErrInvalidBackup = errors.New("invalid session backup")
+
+// maxSessionIDAttempts bounds the search for a session ID not in use yet.
+const maxSessionIDAttempts = 16
+
+// SessionBackupUseCase exports every saved session to a portable backup and
+// imports backups back under new IDs.
+type SessionBackupUseCase struct {
+	sessionRepo repository.SessionRepository
+	stateRepo   repository.SessionStateRepository
+}
+
+// NewSessionBackupUseCase creates a new SessionBackupUseCase.
+func NewSessionBackupUseCase(
+	sessionRepo repository.SessionRepository,
+	stateRepo repository.SessionStateRepository,
+) *SessionBackupUseCase {
+	return &SessionBackupUseCase{
+		sessionRepo: sessionRepo,
+		stateRepo:   stateRepo,
+	}
+}
+
+// Export returns every saved snapshot with the metadata of its session.
+// Snapshots whose session record is gone are exported as browser sessions
+// started when the snapshot was saved.
+func (uc *SessionBackupUseCase) Export(ctx context.Context) (*entity.SessionBackup, error) {
+	states, err := uc.stateRepo.GetAllSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get session snapshots: %w", err)
+	}
+
+	backup := &entity.SessionBackup{
+		Version:    entity.SessionBackupVersion,
+		ExportedAt: time.Now().UTC(),
+		Sessions:   make([]entity.SessionBackupEntry, 0, len(states)),
+	}
+	for _, state := range states {
+		if state == nil {
+			continue
+		}
+		entry := entity.SessionBackupEntry{
+			ID:        state.SessionID,
+			Type:      entity.SessionTypeBrowser,
+			StartedAt: state.SavedAt,
+			State:     state,
+		}
+		session, err := uc.sessionRepo.FindByID(ctx, state.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("get session %s: %w", state.SessionID, err)
+		}
+		if session != nil {
+			entry.Type = session.Type
+			entry.StartedAt = session.StartedAt
+			entry.EndedAt = session.EndedAt
+		}
+		backup.Sessions = append(backup.Sessions, entry)
+	}
+
+	logging.FromContext(ctx).Debug().Int("sessions", len(backup.Sessions)).Msg("exported sessions")
+	return backup, nil
+}
+
+// Import saves every session of backup under a new session ID, with new IDs
+// for its windows, tabs and panes, and returns the new session IDs in backup
+// order. Imported sessions are stored as exited so they can be restored. The
+// whole backup is checked before anything is written.
+func (uc *SessionBackupUseCase) Import(ctx context.Context, backup *entity.SessionBackup) ([]entity.SessionID, error) {
+	if err := validateSessionBackup(backup); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	taken := make(map[entity.SessionID]struct{}, len(backup.Sessions))
+	imported := make([]entity.SessionID, 0, len(backup.Sessions))
+	for _, entry := range backup.Sessions {
+		id, err := uc.unusedSessionID(ctx, taken)
+		if err != nil {
+			return imported, err
+		}
+		taken[id] = struct{}{}
+
+		session := importedSession(id, entry, now)
+		if err := uc.sessionRepo.Save(ctx, session); err != nil {
+			return imported, fmt.Errorf("save session %s: %w", id, err)
+		}
+		if err := uc.stateRepo.SaveSnapshot(ctx, entry.State.WithNewIDs(id, sessionScopedIDs(id))); err != nil {
+			return imported, fmt.Errorf("save session snapshot %s: %w", id, err)
+		}
+		imported = append(imported, id)
+
+		logging.FromContext(ctx).Debug().
+			Str("from_session_id", string(entry.ID)).
+			Str("session_id", string(id)).
+			Msg("imported session")
+	}
+	return imported, nil
+}
+
+func validateSessionBackup(backup *entity.SessionBackup) error {
+	if backup == nil {
+		return fmt.Errorf("%w: empty backup", ErrInvalidBackup)
+	}
+	if backup.Version < 1 || backup.Version > entity.SessionBackupVersion {
+		return fmt.Errorf("%w: backup version %d, want at most %d",
+			ErrVersionMismatch, backup.Version, entity.SessionBackupVersion)
+	}
+	for i, entry := range backup.Sessions {
+		if entry.State == nil {
+			return fmt.Errorf("%w: session %d (%s) has no state", ErrInvalidBackup, i+1, entry.ID)
+		}
+		if entry.State.Version > entity.SessionStateVersion {
+			return fmt.Errorf("%w: session %s has state version %d, want at most %d",
+				ErrVersionMismatch, entry.ID, entry.State.Version, entity.SessionStateVersion)
+		}
+	}
+	return nil
+}
+
+// unusedSessionID returns a new session ID that is neither stored nor in
+// taken.
+func (uc *SessionBackupUseCase) unusedSessionID(
+	ctx context.Context,
+	taken map[entity.SessionID]struct{},
+) (entity.SessionID, error) {
+	for range maxSessionIDAttempts {
+		id := entity.SessionID(logging.GenerateSessionID())
+		if _, ok := taken[id]; ok {
+			continue
+		}
+		existing, err := uc.sessionRepo.FindByID(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("check session id %s: %w", id, err)
+		}
+		if existing == nil {
+			return id, nil
+		}
+	}
+	return "", errors.New("no free session id")
+}
+
+// importedSession builds the session record of an imported entry. Sessions
+// that were running when exported are ended at import time.
+func importedSession(id entity.SessionID, entry entity.SessionBackupEntry, now time.Time) *entity.Session {
+	session := &entity.Session{
+		ID:        id,
+		Type:      entry.Type,
+		StartedAt: entry.StartedAt,
+		EndedAt:   entry.EndedAt,
+	}
+	if session.Type != entity.SessionTypeBrowser && session.Type != entity.SessionTypeCLI {
+		session.Type = entity.SessionTypeBrowser
+	}
+	if session.StartedAt.IsZero() {
+		session.StartedAt = entry.State.SavedAt
+	}
+	if session.StartedAt.IsZero() {
+		session.StartedAt = now
+	}
+	if session.EndedAt == nil {
+		session.End(now)
+	}
+	return session
+}
+
+// sessionScopedIDs generates IDs prefixed with the session ID, so imported
+// windows, tabs and panes never share an ID with another session's.
+func sessionScopedIDs(id entity.SessionID) entity.IDGenerator {
+	n := 0
+	return func() string {
+		n++
+		return fmt.Sprintf("%s-%d", id, n)
+	}
+}
@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type fakeSessionRepo struct {
+	sessions map[entity.SessionID]entity.Session
+}
+
+func newFakeSessionRepo() *fakeSessionRepo {
+	return &fakeSessionRepo{sessions: map[entity.SessionID]entity.Session{}}
+}
+
+func (r *fakeSessionRepo) Save(_ context.Context, session *entity.Session) error {
+	r.sessions[session.ID] = *session
+	return nil
+}
+
+func (r *fakeSessionRepo) FindByID(_ context.Context, id entity.SessionID) (*entity.Session, error) {
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, nil
+	}
+	return &session, nil
+}
+
+func (*fakeSessionRepo) GetActive(context.Context) (*entity.Session, error) { return nil, nil }
+
+func (*fakeSessionRepo) GetRecent(context.Context, int) ([]*entity.Session, error) { return nil, nil }
+
+func (*fakeSessionRepo) MarkEnded(context.Context, entity.SessionID, time.Time) error { return nil }
+
+func (r *fakeSessionRepo) Delete(_ context.Context, id entity.SessionID) error {
+	delete(r.sessions, id)
+	return nil
+}
+
+func (*fakeSessionRepo) DeleteOldestExited(context.Context, int) (int64, error) { return 0, nil }
+
+func (*fakeSessionRepo) DeleteExitedBefore(context.Context, time.Time) (int64, error) { return 0, nil }
+
+// fakeSessionStateRepo stores snapshots as JSON, like the database does.
+type fakeSessionStateRepo struct {
+	snapshots map[entity.SessionID][]byte
+}
+
+func newFakeSessionStateRepo() *fakeSessionStateRepo {
+	return &fakeSessionStateRepo{snapshots: map[entity.SessionID][]byte{}}
+}
+
+func (r *fakeSessionStateRepo) SaveSnapshot(_ context.Context, state *entity.SessionState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	r.snapshots[state.SessionID] = data
+	return nil
+}
+
+func (r *fakeSessionStateRepo) GetSnapshot(_ context.Context, id entity.SessionID) (*entity.SessionState, error) {
+	data, ok := r.snapshots[id]
+	if !ok {
+		return nil, nil
+	}
+	var state entity.SessionState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (r *fakeSessionStateRepo) DeleteSnapshot(_ context.Context, id entity.SessionID) error {
+	delete(r.snapshots, id)
+	return nil
+}
+
+func (r *fakeSessionStateRepo) GetAllSnapshots(ctx context.Context) ([]*entity.SessionState, error) {
+	ids := make([]entity.SessionID, 0, len(r.snapshots))
+	for id := range r.snapshots {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	states := make([]*entity.SessionState, 0, len(ids))
+	for _, id := range ids {
+		state, err := r.GetSnapshot(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+func (*fakeSessionStateRepo) GetTotalSnapshotsSize(context.Context) (int64, error) { return 0, nil }
+
+var backupEpoch = time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC)
+
+// backupTestState is a window-scoped session: a pinned tab split between a
+// pane and a stack of two, plus a second window with a single pane.
+func backupTestState(id entity.SessionID) *entity.SessionState {
+	pane := func(paneID entity.PaneID, uri string) *entity.PaneNodeSnapshot {
+		return &entity.PaneNodeSnapshot{
+			ID:   "node-" + string(paneID),
+			Pane: &entity.PaneSnapshot{ID: paneID, URI: uri, Title: strings.ToUpper(uri), ZoomFactor: 1.25},
+		}
+	}
+	return &entity.SessionState{
+		Version:   entity.SessionStateVersion,
+		SessionID: id,
+		Windows: []entity.WindowSnapshot{
+			{
+				ID: "win-1",
+				Tabs: []entity.TabSnapshot{{
+					ID: "tab-1", Name: "work", IsPinned: true,
+					Workspace: entity.WorkspaceSnapshot{
+						ID: "ws-1",
+						Root: &entity.PaneNodeSnapshot{
+							ID: "split", SplitDir: entity.SplitVertical, SplitRatio: 0.3,
+							Children: []*entity.PaneNodeSnapshot{
+								pane("a", "https://a.example/"),
+								{
+									ID: "stack", IsStacked: true, ActiveStackIndex: 1,
+									Children: []*entity.PaneNodeSnapshot{
+										pane("b", "https://b.example/"),
+										pane("c", "https://c.example/"),
+									},
+								},
+							},
+						},
+						ActivePaneID: "c",
+					},
+				}},
+			},
+			{
+				ID: "win-2",
+				Tabs: []entity.TabSnapshot{
+					{ID: "tab-2", Name: "docs", Position: 0, Workspace: entity.WorkspaceSnapshot{
+						ID: "ws-2", Root: pane("d", "https://d.example/"), ActivePaneID: "d",
+					}},
+					{ID: "tab-3", Position: 1, Workspace: entity.WorkspaceSnapshot{
+						ID: "ws-3", Root: pane("e", "dumb://home"), ActivePaneID: "e",
+					}},
+				},
+				ActiveTabIndex: 1,
+			},
+		},
+		ActiveWindowIndex: 1,
+		SavedAt:           backupEpoch,
+	}
+}
+
+// structureOf renumbers every ID in traversal order, so two states compare
+// equal exactly when they only differ by their IDs.
+func structureOf(state *entity.SessionState) *entity.SessionState {
+	n := 0
+	return state.WithNewIDs("session", func() string {
+		n++
+		return fmt.Sprintf("id-%d", n)
+	})
+}
+
+func collectStateIDs(state *entity.SessionState) []string {
+	var ids []string
+	var walk func(node *entity.PaneNodeSnapshot)
+	walk = func(node *entity.PaneNodeSnapshot) {
+		if node == nil {
+			return
+		}
+		ids = append(ids, node.ID)
+		if node.Pane != nil {
+			ids = append(ids, string(node.Pane.ID))
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	for _, win := range state.Windows {
+		ids = append(ids, string(win.ID))
+		for _, tab := range win.Tabs {
+			ids = append(ids, string(tab.ID), string(tab.Workspace.ID))
+			walk(tab.Workspace.Root)
+		}
+	}
+	return ids
+}
+
+func TestSessionBackupUseCase_RoundTripPreservesStructure(t *testing.T) {
+	ctx := context.Background()
+	sessions, states := newFakeSessionRepo(), newFakeSessionStateRepo()
+	endedAt := backupEpoch.Add(time.Hour)
+	sessionsIn := []entity.Session{
+		{ID: "20260314_093000_aaaa", Type: entity.SessionTypeBrowser, StartedAt: backupEpoch, EndedAt: &endedAt},
+		{ID: "20260314_100000_bbbb", Type: entity.SessionTypeBrowser, StartedAt: backupEpoch.Add(30 * time.Minute)},
+	}
+	for _, session := range sessionsIn {
+		require.NoError(t, sessions.Save(ctx, &session))
+		require.NoError(t, states.SaveSnapshot(ctx, backupTestState(session.ID)))
+	}
+
+	exported, err := NewSessionBackupUseCase(sessions, states).Export(ctx)
+	require.NoError(t, err)
+	require.Len(t, exported.Sessions, 2)
+
+	data, err := json.Marshal(exported)
+	require.NoError(t, err)
+	var backup entity.SessionBackup
+	require.NoError(t, json.Unmarshal(data, &backup))
+
+	importSessions, importStates := newFakeSessionRepo(), newFakeSessionStateRepo()
+	importer := NewSessionBackupUseCase(importSessions, importStates)
+	ids, err := importer.Import(ctx, &backup)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+
+	for i, id := range ids {
+		original := sessionsIn[i]
+		assert.NotEqual(t, original.ID, id, "imported sessions get new IDs")
+
+		session, err := importSessions.FindByID(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, session)
+		assert.Equal(t, original.Type, session.Type)
+		assert.True(t, original.StartedAt.Equal(session.StartedAt))
+		assert.False(t, session.IsActive(), "imported sessions are restorable, not running")
+
+		state, err := importStates.GetSnapshot(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, state)
+		assert.Equal(t, id, state.SessionID)
+		assert.Equal(t, structureOf(backupTestState(original.ID)), structureOf(state))
+		for _, stateID := range collectStateIDs(state) {
+			assert.True(t, strings.HasPrefix(stateID, string(id)+"-"), "ID %q is scoped to its session", stateID)
+		}
+		assert.Equal(t, state.Windows[0].Tabs[0].Workspace.Root.Children[1].Children[1].Pane.ID,
+			state.Windows[0].Tabs[0].Workspace.ActivePaneID, "the active pane follows its new ID")
+	}
+	assert.True(t, importSessions.sessions[ids[0]].EndedAt.Equal(endedAt), "the end time of exited sessions is kept")
+
+	reexported, err := importer.Export(ctx)
+	require.NoError(t, err)
+	require.Len(t, reexported.Sessions, 2)
+}
+
+func TestSessionBackupUseCase_ImportTwiceDoesNotCollide(t *testing.T) {
+	ctx := context.Background()
+	backup := &entity.SessionBackup{
+		Version: entity.SessionBackupVersion,
+		Sessions: []entity.SessionBackupEntry{
+			{ID: "s1", Type: entity.SessionTypeBrowser, StartedAt: backupEpoch, State: backupTestState("s1")},
+		},
+	}
+	sessions, states := newFakeSessionRepo(), newFakeSessionStateRepo()
+	uc := NewSessionBackupUseCase(sessions, states)
+
+	first, err := uc.Import(ctx, backup)
+	require.NoError(t, err)
+	second, err := uc.Import(ctx, backup)
+	require.NoError(t, err)
+
+	require.Len(t, first, 1)
+	require.Len(t, second, 1)
+	assert.NotEqual(t, first[0], second[0])
+	assert.Len(t, sessions.sessions, 2)
+	assert.Len(t, states.snapshots, 2)
+	assert.Equal(t, entity.SessionID("s1"), backup.Sessions[0].State.SessionID, "the backup itself is not modified")
+}
+
+func TestSessionBackupUseCase_ImportRejectsBadBackupsBeforeWriting(t *testing.T) {
+	futureState := backupTestState("s2")
+	futureState.Version = entity.SessionStateVersion + 1
+	tests := []struct {
+		name    string
+		backup  *entity.SessionBackup
+		wantErr error
+	}{
+		{name: "nil", backup: nil, wantErr: ErrInvalidBackup},
+		{name: "newer format", backup: &entity.SessionBackup{Version: entity.SessionBackupVersion + 1}, wantErr: ErrVersionMismatch},
+		{name: "no version", backup: &entity.SessionBackup{}, wantErr: ErrVersionMismatch},
+		{name: "entry without state", backup: &entity.SessionBackup{
+			Version: entity.SessionBackupVersion,
+			Sessions: []entity.SessionBackupEntry{
+				{ID: "s1", State: backupTestState("s1")},
+				{ID: "s2"},
+			},
+		}, wantErr: ErrInvalidBackup},
+		{name: "newer state", backup: &entity.SessionBackup{
+			Version: entity.SessionBackupVersion,
+			Sessions: []entity.SessionBackupEntry{
+				{ID: "s1", State: backupTestState("s1")},
+				{ID: "s2", State: futureState},
+			},
+		}, wantErr: ErrVersionMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions, states := newFakeSessionRepo(), newFakeSessionStateRepo()
+
+			ids, err := NewSessionBackupUseCase(sessions, states).Import(context.Background(), tt.backup)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, ids)
+			assert.Empty(t, sessions.sessions, "nothing is written")
+			assert.Empty(t, states.snapshots)
+		})
+	}
+}
+
+func TestSessionBackupUseCase_ExportSnapshotWithoutSession(t *testing.T) {
+	ctx := context.Background()
+	states := newFakeSessionStateRepo()
+	require.NoError(t, states.SaveSnapshot(ctx, backupTestState("orphan")))
+
+	backup, err := NewSessionBackupUseCase(newFakeSessionRepo(), states).Export(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, backup.Sessions, 1)
+	entry := backup.Sessions[0]
+	assert.Equal(t, entity.SessionID("orphan"), entry.ID)
+	assert.Equal(t, entity.SessionTypeBrowser, entry.Type)
+	assert.True(t, backupEpoch.Equal(entry.StartedAt), "falls back to the snapshot time")
+	assert.Equal(t, entity.SessionBackupVersion, backup.Version)
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ShareMethod reports how a page was shared.
+type ShareMethod string
+
+const (
+	// ShareMethodPortal means the page was handed to the desktop share portal.
+	ShareMethodPortal ShareMethod = "portal"
+	// ShareMethodClipboard means the URL was copied to the clipboard instead.
+	ShareMethodClipboard ShareMethod = "clipboard"
+)
+
+// ShareURLUseCase hands the current page off to another application through
+// the desktop portal, and copies its URL to the clipboard when that fails.
+type ShareURLUseCase struct {
+	sharer  port.URLSharer
+	copyURL *CopyURLUseCase
+}
+
+// NewShareURLUseCase creates a new ShareURLUseCase. sharer may be nil, in
+// which case every share falls back to the clipboard.
+func NewShareURLUseCase(sharer port.URLSharer, copyURL *CopyURLUseCase) *ShareURLUseCase {
+	return &ShareURLUseCase{
+		sharer:  sharer,
+		copyURL: copyURL,
+	}
+}
+
+// Share offers uri to other applications and reports how it was shared.
+// Pages other applications cannot open, such as internal dumber:// pages,
+// go straight to the clipboard.
+func (uc *ShareURLUseCase) Share(ctx context.Context, uri, title string) (ShareMethod, error) {
+	log := logging.FromContext(ctx)
+
+	if uri == "" {
+		return "", fmt.Errorf("empty URL")
+	}
+
+	if uc.sharer != nil && IsShareableURL(uri) {
+		err := uc.sharer.ShareURL(ctx, uri, title)
+		if err == nil {
+			log.Debug().Str("url", uri).Msg("URL handed to share portal")
+			return ShareMethodPortal, nil
+		}
+		if !errors.Is(err, port.ErrShareUnavailable) && !errors.Is(err, port.ErrShareCancelled) {
+			log.Warn().Err(err).Str("url", uri).Msg("share portal failed, copying URL instead")
+		}
+	}
+
+	if uc.copyURL == nil {
+		return "", fmt.Errorf("clipboard not available")
+	}
+	if err := uc.copyURL.Copy(ctx, uri); err != nil {
+		return "", err
+	}
+	return ShareMethodClipboard, nil
+}
+
+// IsShareableURL reports whether rawURL can be opened by other applications.
+func IsShareableURL(rawURL string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	portmocks "github.com/bnema/dumber/internal/application/port/mocks"
+)
+
+func TestShareURLUseCase_UsesPortal(t *testing.T) {
+	ctx := context.Background()
+	sharer := portmocks.NewMockURLSharer(t)
+	sharer.EXPECT().ShareURL(ctx, "https://example.com/", "Example").Return(nil).Once()
+
+	uc := NewShareURLUseCase(sharer, NewCopyURLUseCase(portmocks.NewMockClipboard(t)))
+	method, err := uc.Share(ctx, "https://example.com/", "Example")
+
+	require.NoError(t, err)
+	assert.Equal(t, ShareMethodPortal, method)
+}
+
+func TestShareURLUseCase_FallsBackToClipboard(t *testing.T) {
+	tests := []struct {
+		name     string
+		shareErr error
+	}{
+		{name: "portal unavailable", shareErr: port.ErrShareUnavailable},
+		{name: "portal call failed", shareErr: errors.New("org.freedesktop.DBus.Error.NoReply")},
+		{name: "chooser cancelled", shareErr: port.ErrShareCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			sharer := portmocks.NewMockURLSharer(t)
+			sharer.EXPECT().ShareURL(ctx, "https://example.com/", "Example").Return(tt.shareErr).Once()
+			clipboard := portmocks.NewMockClipboard(t)
+			clipboard.EXPECT().WriteText(ctx, "https://example.com/").Return(nil).Once()
+
+			uc := NewShareURLUseCase(sharer, NewCopyURLUseCase(clipboard))
+			method, err := uc.Share(ctx, "https://example.com/", "Example")
+
+			require.NoError(t, err)
+			assert.Equal(t, ShareMethodClipboard, method)
+		})
+	}
+}
+
+func TestShareURLUseCase_WithoutSharerCopies(t *testing.T) {
+	ctx := context.Background()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, "https://example.com/").Return(nil).Once()
+
+	uc := NewShareURLUseCase(nil, NewCopyURLUseCase(clipboard))
+	method, err := uc.Share(ctx, "https://example.com/", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, ShareMethodClipboard, method)
+}
+
+func TestShareURLUseCase_InternalPagesSkipPortal(t *testing.T) {
+	ctx := context.Background()
+	// No ShareURL expectation: the mock fails the test if the portal is called.
+	sharer := portmocks.NewMockURLSharer(t)
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, "dumber://home").Return(nil).Once()
+
+	uc := NewShareURLUseCase(sharer, NewCopyURLUseCase(clipboard))
+	method, err := uc.Share(ctx, "dumber://home", "Home")
+
+	require.NoError(t, err)
+	assert.Equal(t, ShareMethodClipboard, method)
+}
+
+func TestShareURLUseCase_ReportsClipboardFailure(t *testing.T) {
+	ctx := context.Background()
+	sharer := portmocks.NewMockURLSharer(t)
+	sharer.EXPECT().ShareURL(ctx, "https://example.com/", "").Return(port.ErrShareUnavailable).Once()
+	clipboard := portmocks.NewMockClipboard(t)
+	clipboard.EXPECT().WriteText(ctx, "https://example.com/").Return(errors.New("no display")).Once()
+
+	uc := NewShareURLUseCase(sharer, NewCopyURLUseCase(clipboard))
+	_, err := uc.Share(ctx, "https://example.com/", "")
+
+	require.Error(t, err)
+}
+
+func TestShareURLUseCase_EmptyURL(t *testing.T) {
+	uc := NewShareURLUseCase(portmocks.NewMockURLSharer(t), NewCopyURLUseCase(portmocks.NewMockClipboard(t)))
+	_, err := uc.Share(context.Background(), "", "")
+	assert.Error(t, err)
+}
+
+func TestIsShareableURL(t *testing.T) {
+	assert.True(t, IsShareableURL("https://example.com/a?b=c"))
+	assert.True(t, IsShareableURL("HTTP://example.com"))
+	assert.False(t, IsShareableURL("dumber://home"))
+	assert.False(t, IsShareableURL("about:blank"))
+	assert.False(t, IsShareableURL("file:///tmp/page.html"))
+	assert.False(t, IsShareableURL("https://"))
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ManageSiteColorSchemesUseCase remembers, per domain, whether a site is
+// shown with a light or dark color scheme instead of the system one.
+type ManageSiteColorSchemesUseCase struct {
+	repo port.SiteColorSchemeRepository
+	now  func() time.Time
+}
+
+// NewManageSiteColorSchemesUseCase creates a site color scheme use case.
+func NewManageSiteColorSchemesUseCase(repo port.SiteColorSchemeRepository) *ManageSiteColorSchemesUseCase {
+	return &ManageSiteColorSchemesUseCase{repo: repo, now: time.Now}
+}
+
+// SiteColorSchemeDomain returns the domain rawURL's color scheme is stored
+// under, or "" when the page is not a web page. Sites are keyed like site
+// user agents.
+func SiteColorSchemeDomain(rawURL string) string {
+	return SiteUserAgentDomain(rawURL)
+}
+
+// Resolve returns the color scheme stored for rawURL's domain, or
+// entity.ColorSchemeSystem when there is none.
+func (uc *ManageSiteColorSchemesUseCase) Resolve(ctx context.Context, rawURL string) (entity.ColorScheme, error) {
+	domain := SiteColorSchemeDomain(rawURL)
+	if domain == "" {
+		return entity.ColorSchemeSystem, nil
+	}
+	site, err := uc.repo.Get(ctx, domain)
+	if err != nil {
+		return entity.ColorSchemeSystem, fmt.Errorf("load color scheme for %s: %w", domain, err)
+	}
+	if site == nil || !site.Scheme.IsValid() {
+		return entity.ColorSchemeSystem, nil
+	}
+	return site.Scheme, nil
+}
+
+// Set stores scheme for rawURL's domain; entity.ColorSchemeSystem forgets
+// the domain. It returns the domain the scheme applies to.
+func (uc *ManageSiteColorSchemesUseCase) Set(
+	ctx context.Context,
+	rawURL string,
+	scheme entity.ColorScheme,
+) (string, error) {
+	domain := SiteColorSchemeDomain(rawURL)
+	if domain == "" {
+		return "", fmt.Errorf("no site color scheme for %q", rawURL)
+	}
+	if !scheme.IsValid() {
+		return domain, fmt.Errorf("unknown color scheme %q", scheme)
+	}
+
+	var err error
+	if scheme == entity.ColorSchemeSystem {
+		err = uc.repo.Delete(ctx, domain)
+	} else {
+		err = uc.repo.Set(ctx, &entity.SiteColorScheme{Domain: domain, Scheme: scheme, UpdatedAt: uc.now().Unix()})
+	}
+	if err != nil {
+		return domain, fmt.Errorf("save color scheme for %s: %w", domain, err)
+	}
+
+	logging.FromContext(ctx).Info().
+		Str("domain", domain).
+		Str("scheme", string(scheme)).
+		Msg("site color scheme changed")
+	return domain, nil
+}
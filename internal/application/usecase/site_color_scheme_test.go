@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type fakeSiteColorSchemeRepo struct {
+	sites  map[string]*entity.SiteColorScheme
+	setErr error
+}
+
+func newFakeSiteColorSchemeRepo() *fakeSiteColorSchemeRepo {
+	return &fakeSiteColorSchemeRepo{sites: map[string]*entity.SiteColorScheme{}}
+}
+
+func (r *fakeSiteColorSchemeRepo) Get(_ context.Context, domain string) (*entity.SiteColorScheme, error) {
+	return r.sites[domain], nil
+}
+
+func (r *fakeSiteColorSchemeRepo) Set(_ context.Context, site *entity.SiteColorScheme) error {
+	if r.setErr != nil {
+		return r.setErr
+	}
+	r.sites[site.Domain] = site
+	return nil
+}
+
+func (r *fakeSiteColorSchemeRepo) Delete(_ context.Context, domain string) error {
+	delete(r.sites, domain)
+	return nil
+}
+
+func TestManageSiteColorSchemes_SetPersistsPerDomain(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeSiteColorSchemeRepo()
+	uc := NewManageSiteColorSchemesUseCase(repo)
+	uc.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	scheme, err := uc.Resolve(ctx, "https://example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, entity.ColorSchemeSystem, scheme)
+
+	domain, err := uc.Set(ctx, "https://www.example.com/article", entity.ColorSchemeDark)
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", domain)
+	assert.Equal(t, &entity.SiteColorScheme{
+		Domain:    "example.com",
+		Scheme:    entity.ColorSchemeDark,
+		UpdatedAt: 1700000000,
+	}, repo.sites["example.com"])
+
+	scheme, err = uc.Resolve(ctx, "https://example.com/other")
+	require.NoError(t, err)
+	assert.Equal(t, entity.ColorSchemeDark, scheme)
+	scheme, err = uc.Resolve(ctx, "https://other.example/")
+	require.NoError(t, err)
+	assert.Equal(t, entity.ColorSchemeSystem, scheme)
+
+	// Going back to the system scheme forgets the domain.
+	_, err = uc.Set(ctx, "https://example.com/", entity.ColorSchemeSystem)
+	require.NoError(t, err)
+	assert.NotContains(t, repo.sites, "example.com")
+}
+
+func TestManageSiteColorSchemes_SetErrors(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeSiteColorSchemeRepo()
+	uc := NewManageSiteColorSchemesUseCase(repo)
+
+	_, err := uc.Set(ctx, "dumb://home", entity.ColorSchemeDark)
+	require.Error(t, err)
+	_, err = uc.Set(ctx, "https://example.com/", "sepia")
+	require.Error(t, err)
+
+	repo.setErr = errors.New("disk full")
+	domain, err := uc.Set(ctx, "https://example.com/", entity.ColorSchemeLight)
+	require.Error(t, err)
+	assert.Equal(t, "example.com", domain)
+}
+
+func TestManageSiteColorSchemes_ResolveIgnoresUnknownScheme(t *testing.T) {
+	repo := newFakeSiteColorSchemeRepo()
+	repo.sites["example.com"] = &entity.SiteColorScheme{Domain: "example.com", Scheme: "sepia"}
+	uc := NewManageSiteColorSchemesUseCase(repo)
+
+	scheme, err := uc.Resolve(context.Background(), "https://example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, entity.ColorSchemeSystem, scheme)
+}
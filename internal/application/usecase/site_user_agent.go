@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+	"github.com/bnema/dumber/internal/domain/useragent"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ManageSiteUserAgentsUseCase remembers, per domain, whether a site is
+// requested with the desktop or mobile user agent preset.
+type ManageSiteUserAgentsUseCase struct {
+	repo port.SiteUserAgentRepository
+	now  func() time.Time
+}
+
+// NewManageSiteUserAgentsUseCase creates a site user agent use case.
+func NewManageSiteUserAgentsUseCase(repo port.SiteUserAgentRepository) *ManageSiteUserAgentsUseCase {
+	return &ManageSiteUserAgentsUseCase{repo: repo, now: time.Now}
+}
+
+// SiteUserAgentDomain returns the domain rawURL's preset is stored under, or
+// "" when the page is not a web page (internal pages, files, blank pages).
+func SiteUserAgentDomain(rawURL string) string {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Hostname() == "" {
+		return ""
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		return urlutil.DisplayDomain(parsed.Hostname())
+	default:
+		return ""
+	}
+}
+
+// Resolve returns the preset stored for rawURL's domain, or the default
+// preset when there is none.
+func (uc *ManageSiteUserAgentsUseCase) Resolve(ctx context.Context, rawURL string) (entity.UserAgentPreset, error) {
+	domain := SiteUserAgentDomain(rawURL)
+	if domain == "" {
+		return entity.UserAgentPresetDefault, nil
+	}
+	site, err := uc.repo.Get(ctx, domain)
+	if err != nil {
+		return entity.UserAgentPresetDefault, fmt.Errorf("load user agent for %s: %w", domain, err)
+	}
+	if site == nil || !site.Preset.IsValid() {
+		return entity.UserAgentPresetDefault, nil
+	}
+	return site.Preset, nil
+}
+
+// Toggle requests rawURL's domain with requested, or returns it to the
+// default user agent when requested is already in effect, and stores the
+// result. It returns the new preset and the domain it applies to.
+func (uc *ManageSiteUserAgentsUseCase) Toggle(
+	ctx context.Context,
+	rawURL string,
+	requested entity.UserAgentPreset,
+) (entity.UserAgentPreset, string, error) {
+	domain := SiteUserAgentDomain(rawURL)
+	if domain == "" {
+		return entity.UserAgentPresetDefault, "", fmt.Errorf("no site user agent for %q", rawURL)
+	}
+	current, err := uc.Resolve(ctx, rawURL)
+	if err != nil {
+		return entity.UserAgentPresetDefault, domain, err
+	}
+
+	preset := useragent.Toggle(current, requested)
+	if preset == entity.UserAgentPresetDefault {
+		err = uc.repo.Delete(ctx, domain)
+	} else {
+		err = uc.repo.Set(ctx, &entity.SiteUserAgent{Domain: domain, Preset: preset, UpdatedAt: uc.now().Unix()})
+	}
+	if err != nil {
+		return current, domain, fmt.Errorf("save user agent for %s: %w", domain, err)
+	}
+
+	logging.FromContext(ctx).Info().
+		Str("domain", domain).
+		Str("preset", string(preset)).
+		Msg("site user agent changed")
+	return preset, domain, nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type fakeSiteUserAgentRepo struct {
+	sites  map[string]*entity.SiteUserAgent
+	setErr error
+}
+
+func newFakeSiteUserAgentRepo() *fakeSiteUserAgentRepo {
+	return &fakeSiteUserAgentRepo{sites: map[string]*entity.SiteUserAgent{}}
+}
+
+func (r *fakeSiteUserAgentRepo) Get(_ context.Context, domain string) (*entity.SiteUserAgent, error) {
+	return r.sites[domain], nil
+}
+
+func (r *fakeSiteUserAgentRepo) Set(_ context.Context, site *entity.SiteUserAgent) error {
+	if r.setErr != nil {
+		return r.setErr
+	}
+	r.sites[site.Domain] = site
+	return nil
+}
+
+func (r *fakeSiteUserAgentRepo) Delete(_ context.Context, domain string) error {
+	delete(r.sites, domain)
+	return nil
+}
+
+func TestSiteUserAgentDomain(t *testing.T) {
+	assert.Equal(t, "example.com", SiteUserAgentDomain("https://www.example.com/path?q=1"))
+	assert.Equal(t, "news.example.com", SiteUserAgentDomain("http://news.example.com:8080/"))
+	assert.Empty(t, SiteUserAgentDomain("dumb://home"))
+	assert.Empty(t, SiteUserAgentDomain("file:///tmp/page.html"))
+	assert.Empty(t, SiteUserAgentDomain("about:blank"))
+	assert.Empty(t, SiteUserAgentDomain(""))
+}
+
+func TestManageSiteUserAgents_TogglePersistsPerDomain(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeSiteUserAgentRepo()
+	uc := NewManageSiteUserAgentsUseCase(repo)
+	uc.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	preset, err := uc.Resolve(ctx, "https://example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserAgentPresetDefault, preset)
+
+	preset, domain, err := uc.Toggle(ctx, "https://www.example.com/article", entity.UserAgentPresetMobile)
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserAgentPresetMobile, preset)
+	assert.Equal(t, "example.com", domain)
+	assert.Equal(t, &entity.SiteUserAgent{
+		Domain:    "example.com",
+		Preset:    entity.UserAgentPresetMobile,
+		UpdatedAt: 1700000000,
+	}, repo.sites["example.com"])
+
+	// Every page of the domain resolves to the stored preset; other domains don't.
+	preset, err = uc.Resolve(ctx, "https://example.com/other")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserAgentPresetMobile, preset)
+	preset, err = uc.Resolve(ctx, "https://other.example/")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserAgentPresetDefault, preset)
+
+	// Switching presets replaces the stored one.
+	preset, _, err = uc.Toggle(ctx, "https://example.com/", entity.UserAgentPresetDesktop)
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserAgentPresetDesktop, preset)
+	assert.Equal(t, entity.UserAgentPresetDesktop, repo.sites["example.com"].Preset)
+
+	// Requesting the preset in effect returns the domain to the default.
+	preset, _, err = uc.Toggle(ctx, "https://example.com/", entity.UserAgentPresetDesktop)
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserAgentPresetDefault, preset)
+	assert.NotContains(t, repo.sites, "example.com")
+}
+
+func TestManageSiteUserAgents_ToggleErrors(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeSiteUserAgentRepo()
+	uc := NewManageSiteUserAgentsUseCase(repo)
+
+	_, _, err := uc.Toggle(ctx, "dumb://home", entity.UserAgentPresetMobile)
+	require.Error(t, err)
+
+	repo.setErr = errors.New("disk full")
+	preset, domain, err := uc.Toggle(ctx, "https://example.com/", entity.UserAgentPresetMobile)
+	require.Error(t, err)
+	assert.Equal(t, entity.UserAgentPresetDefault, preset, "failed save keeps the preset in effect")
+	assert.Equal(t, "example.com", domain)
+}
+
+func TestManageSiteUserAgents_ResolveIgnoresUnknownPreset(t *testing.T) {
+	repo := newFakeSiteUserAgentRepo()
+	repo.sites["example.com"] = &entity.SiteUserAgent{Domain: "example.com", Preset: "tablet"}
+	uc := NewManageSiteUserAgentsUseCase(repo)
+
+	preset, err := uc.Resolve(context.Background(), "https://example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UserAgentPresetDefault, preset)
+}
@@ -69,3 +69,26 @@ func (uc *SnapshotSessionUseCase) Execute(ctx context.Context, input SnapshotInp
 
 	return nil
 }
+
+// ExportURLs returns the shareable pane URLs of a saved session, ordered by
+// tab and then by pane within each tab. Ephemeral panes are excluded.
+func (uc *SnapshotSessionUseCase) ExportURLs(ctx context.Context, sessionID entity.SessionID) ([]entity.SessionURL, error) {
+	if sessionID == "" {
+		return nil, fmt.Errorf("session id required")
+	}
+
+	state, err := uc.stateRepo.GetSnapshot(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("get session snapshot: %w", err)
+	}
+	if state == nil {
+		return nil, fmt.Errorf("no snapshot found for session %s", sessionID)
+	}
+
+	urls := state.ExportURLs()
+	logging.FromContext(ctx).Debug().
+		Str("session_id", string(sessionID)).
+		Int("url_count", len(urls)).
+		Msg("exported session urls")
+	return urls, nil
+}
@@ -245,6 +245,99 @@ func TestSessionState_CountPanes(t *testing.T) {
 	assert.Equal(t, 3, state.CountPanes())
 }
 
+func exportTestPaneNode(id, uri, title string) *entity.PaneNodeSnapshot {
+	return &entity.PaneNodeSnapshot{Pane: &entity.PaneSnapshot{ID: entity.PaneID(id), URI: uri, Title: title}}
+}
+
+func TestSnapshotSessionUseCase_ExportURLs_OrdersTabsThenPanes(t *testing.T) {
+	ctx := testContext()
+	sessionID := entity.SessionID("20251224_120000_export")
+
+	state := &entity.SessionState{
+		Version:   entity.SessionStateVersion,
+		SessionID: sessionID,
+		Windows: []entity.WindowSnapshot{
+			{
+				ID: "win-1",
+				Tabs: []entity.TabSnapshot{
+					{Workspace: entity.WorkspaceSnapshot{Root: exportTestPaneNode("p1", "https://one.test", "One")}},
+					{Workspace: entity.WorkspaceSnapshot{Root: &entity.PaneNodeSnapshot{
+						Children: []*entity.PaneNodeSnapshot{
+							exportTestPaneNode("p2", "https://two.test", "Two"),
+							{
+								IsStacked: true,
+								Children: []*entity.PaneNodeSnapshot{
+									exportTestPaneNode("p3", "https://three.test", "Three"),
+									exportTestPaneNode("p4", "https://four.test", ""),
+								},
+							},
+						},
+					}}},
+				},
+			},
+			{
+				ID: "win-2",
+				Tabs: []entity.TabSnapshot{
+					{Workspace: entity.WorkspaceSnapshot{Root: exportTestPaneNode("p5", "https://five.test", "Five")}},
+				},
+			},
+		},
+	}
+
+	stateRepo := repomocks.NewMockSessionStateRepository(t)
+	stateRepo.EXPECT().GetSnapshot(mock.Anything, sessionID).Return(state, nil)
+
+	urls, err := usecase.NewSnapshotSessionUseCase(stateRepo).ExportURLs(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.SessionURL{
+		{URL: "https://one.test", Title: "One"},
+		{URL: "https://two.test", Title: "Two"},
+		{URL: "https://three.test", Title: "Three"},
+		{URL: "https://four.test"},
+		{URL: "https://five.test", Title: "Five"},
+	}, urls)
+}
+
+func TestSnapshotSessionUseCase_ExportURLs_ExcludesEphemeralPanes(t *testing.T) {
+	ctx := testContext()
+	sessionID := entity.SessionID("20251224_120000_ephemeral")
+
+	state := &entity.SessionState{
+		Version:   entity.LegacySessionStateVersion,
+		SessionID: sessionID,
+		Tabs: []entity.TabSnapshot{
+			{Workspace: entity.WorkspaceSnapshot{Root: &entity.PaneNodeSnapshot{
+				Children: []*entity.PaneNodeSnapshot{
+					exportTestPaneNode("p1", "", ""),
+					exportTestPaneNode("p2", "about:blank", ""),
+					exportTestPaneNode("p3", "dumb://home", "Home"),
+					exportTestPaneNode("p4", "https://kept.test", "Kept"),
+				},
+			}}},
+		},
+	}
+
+	stateRepo := repomocks.NewMockSessionStateRepository(t)
+	stateRepo.EXPECT().GetSnapshot(mock.Anything, sessionID).Return(state, nil)
+
+	urls, err := usecase.NewSnapshotSessionUseCase(stateRepo).ExportURLs(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.SessionURL{{URL: "https://kept.test", Title: "Kept"}}, urls)
+}
+
+func TestSnapshotSessionUseCase_ExportURLs_MissingSnapshot(t *testing.T) {
+	ctx := testContext()
+
+	stateRepo := repomocks.NewMockSessionStateRepository(t)
+	stateRepo.EXPECT().GetSnapshot(mock.Anything, entity.SessionID("missing")).Return(nil, nil)
+
+	_, err := usecase.NewSnapshotSessionUseCase(stateRepo).ExportURLs(ctx, "missing")
+	require.Error(t, err)
+
+	_, err = usecase.NewSnapshotSessionUseCase(stateRepo).ExportURLs(ctx, "")
+	require.Error(t, err)
+}
+
 func TestGetRelativeTime(t *testing.T) {
 	now := time.Now()
 
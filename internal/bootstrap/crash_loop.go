@@ -0,0 +1,100 @@
+package bootstrap
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/config"
+	"github.com/rs/zerolog"
+)
+
+const crashHistoryFileName = "crash_history.json"
+
+// CheckCrashLoop records a crash of the previous run, detected through a stale
+// PID file, and reports whether the recent crashes call for safe mode. It
+// leaves the PID file in place so the session still writes its crash report.
+func CheckCrashLoop(cfg *config.Config, now time.Time, logger *zerolog.Logger) bool {
+	if cfg == nil || cfg.SafeMode.CrashThreshold < 1 {
+		return false
+	}
+	logDir := resolveLogDir(cfg)
+	if logDir == "" || !previousRunCrashed(logDir) {
+		return false
+	}
+
+	window := time.Duration(cfg.SafeMode.CrashWindowMinutes) * time.Minute
+	history := readCrashHistory(logDir)
+	history.Record(now, window)
+	if err := writeCrashHistory(logDir, history); err != nil && logger != nil {
+		logger.Warn().Err(err).Msg("failed to write crash history")
+	}
+	return history.InCrashLoop(now, cfg.SafeMode.CrashThreshold, window)
+}
+
+// ApplySafeMode turns off the features most likely to take the browser down
+// again: content filtering, user scripts and GPU rendering.
+func ApplySafeMode(cfg *config.Config) {
+	if cfg == nil {
+		return
+	}
+	cfg.ContentFiltering.Enabled = false
+	cfg.UserScripts.Enabled = false
+
+	wk := &cfg.Engine.WebKit
+	wk.GSKRenderer = config.GSKRendererCairo
+	wk.ForceCompositingMode = false
+	wk.DisableCompositingMode = true
+	wk.DisableDMABufRenderer = true
+
+	cfg.Engine.CEF.DisableGPU = true
+}
+
+// previousRunCrashed reports whether the PID file names a process that is no
+// longer running.
+func previousRunCrashed(logDir string) bool {
+	raw, err := os.ReadFile(pidFilePath(logDir))
+	if err != nil {
+		return false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+	if err != nil || pid == os.Getpid() {
+		return false
+	}
+	return !processAlive(pid)
+}
+
+func crashHistoryPath(logDir string) string {
+	return filepath.Join(logDir, crashHistoryFileName)
+}
+
+// readCrashHistory loads the crash history; a missing or corrupt file reads
+// as an empty history.
+func readCrashHistory(logDir string) entity.CrashHistory {
+	var history entity.CrashHistory
+	raw, err := os.ReadFile(crashHistoryPath(logDir))
+	if err != nil {
+		return history
+	}
+	if json.Unmarshal(raw, &history) != nil {
+		return entity.CrashHistory{}
+	}
+	return history
+}
+
+func writeCrashHistory(logDir string, history entity.CrashHistory) error {
+	raw, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(crashHistoryPath(logDir), raw, filePerm)
+}
+
+// clearCrashHistory resets the crash counter after a clean run.
+func clearCrashHistory(logDir string) {
+	_ = os.Remove(crashHistoryPath(logDir))
+}
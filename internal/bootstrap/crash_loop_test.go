@@ -0,0 +1,80 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/infrastructure/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadPID returns the PID of a process that already exited.
+func deadPID(t *testing.T) int {
+	t.Helper()
+	cmd := exec.Command("true")
+	require.NoError(t, cmd.Run())
+	return cmd.Process.Pid
+}
+
+func TestCheckCrashLoop(t *testing.T) {
+	logDir := t.TempDir()
+	cfg := config.DefaultConfig()
+	cfg.Logging.LogDir = logDir
+	cfg.SafeMode.CrashThreshold = 3
+	cfg.SafeMode.CrashWindowMinutes = 10
+
+	start := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	crashAt := func(offset time.Duration) bool {
+		pid := deadPID(t)
+		require.NoError(t, os.WriteFile(pidFilePath(logDir), fmt.Appendf(nil, "%d\n", pid), filePerm))
+		return CheckCrashLoop(cfg, start.Add(offset), nil)
+	}
+
+	assert.False(t, crashAt(0))
+	assert.False(t, crashAt(2*time.Minute))
+	assert.True(t, crashAt(4*time.Minute), "third crash within the window")
+	assert.False(t, crashAt(30*time.Minute), "older crashes left the window")
+	assert.Len(t, readCrashHistory(logDir).Crashes, 1)
+
+	clearCrashHistory(logDir)
+	assert.Empty(t, readCrashHistory(logDir).Crashes, "a clean run resets the counter")
+}
+
+func TestCheckCrashLoop_IgnoresCleanPreviousRun(t *testing.T) {
+	logDir := t.TempDir()
+	cfg := config.DefaultConfig()
+	cfg.Logging.LogDir = logDir
+	cfg.SafeMode.CrashThreshold = 1
+
+	now := time.Now()
+	assert.False(t, CheckCrashLoop(cfg, now, nil), "no PID file")
+
+	require.NoError(t, os.WriteFile(pidFilePath(logDir), fmt.Appendf(nil, "%d\n", os.Getpid()), filePerm))
+	assert.False(t, CheckCrashLoop(cfg, now, nil), "running process")
+	assert.Empty(t, readCrashHistory(logDir).Crashes)
+
+	cfg.SafeMode.CrashThreshold = 0
+	require.NoError(t, os.WriteFile(pidFilePath(logDir), fmt.Appendf(nil, "%d\n", deadPID(t)), filePerm))
+	assert.False(t, CheckCrashLoop(cfg, now, nil), "detection disabled")
+}
+
+func TestApplySafeMode(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ContentFiltering.Enabled = true
+	cfg.UserScripts.Enabled = true
+	cfg.Engine.WebKit.ForceCompositingMode = true
+
+	ApplySafeMode(cfg)
+
+	assert.False(t, cfg.ContentFiltering.Enabled)
+	assert.False(t, cfg.UserScripts.Enabled)
+	assert.Equal(t, config.GSKRendererCairo, cfg.Engine.WebKit.GSKRenderer)
+	assert.False(t, cfg.Engine.WebKit.ForceCompositingMode)
+	assert.True(t, cfg.Engine.WebKit.DisableCompositingMode)
+	assert.True(t, cfg.Engine.WebKit.DisableDMABufRenderer)
+	assert.True(t, cfg.Engine.CEF.DisableGPU)
+}
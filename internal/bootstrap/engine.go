@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
@@ -66,6 +67,7 @@ func BuildEngine(input EngineInput) (port.Engine, error) {
 		}
 		opts := port.EngineOptions{
 			CookiePolicy: port.CookiePolicy(cfg.Engine.CookiePolicy),
+			Proxy:        proxyConfigFromConfig(cfg.Network.Proxy, profile.Engine),
 		}
 		wkCfg := webkit.EngineConfigFromConfig(cfg.Engine.WebKit)
 
@@ -84,12 +86,14 @@ func BuildEngine(input EngineInput) (port.Engine, error) {
 		}
 		opts := port.EngineOptions{
 			CookiePolicy: port.CookiePolicy(cfg.Engine.CookiePolicy),
+			Proxy:        proxyConfigFromConfig(cfg.Network.Proxy, profile.Engine),
 		}
 		cefCfg := cef.RuntimeConfig{
 			CEFDir:                      cfg.Engine.CEF.CEFDir,
 			LogFile:                     cfg.Engine.CEF.LogFile,
 			LogSeverity:                 cfg.Engine.CEF.LogSeverity,
 			RenderStack:                 string(cfg.Engine.CEF.CEFRenderStack()),
+			DisableGPU:                  cfg.Engine.CEF.DisableGPU,
 			AdaptiveWindowlessFrameRate: cfg.Engine.CEF.CEFAdaptiveWindowlessFrameRate(),
 			WindowlessFrameRate:         cfg.Engine.CEF.WindowlessFrameRate,
 			WindowlessFrameRateMax:      cfg.Engine.CEF.CEFWindowlessFrameRateMax(),
@@ -106,6 +110,9 @@ func BuildEngine(input EngineInput) (port.Engine, error) {
 			EnableAudioHandler: cfg.Engine.CEF.EnableAudioHandler,
 			TraceHandlers:      cfg.Engine.CEF.TraceHandlers,
 			ApplicationScale:   cfg.DefaultUIScale,
+			RequestThrottle:    slices.Clone(cfg.Network.Throttle),
+			StripCSPDomains:    stripCSPDomains(cfg),
+			AlwaysFreshDomains: slices.Clone(cfg.Cache.AlwaysFreshDomains),
 		}
 		deps := cef.EngineDependencies{
 			RegisterHandlers:           handlers.RegisterAll,
@@ -147,3 +154,16 @@ func requireRuntimeProfile(profile runtimeprofile.Profile, engineType string) (r
 	}
 	return profile, nil
 }
+
+// proxyConfigFromConfig returns the network proxy options of the engine
+// profile, or nil when it has no proxy and the system settings apply.
+func proxyConfigFromConfig(proxy config.ProxyConfig, engine string) *port.ProxyConfig {
+	settings := proxy.ForProfile(engine)
+	if settings.URL == "" {
+		return nil
+	}
+	return &port.ProxyConfig{
+		URL:     settings.URL,
+		NoProxy: append([]string(nil), settings.NoProxy...),
+	}
+}
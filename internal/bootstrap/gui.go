@@ -17,6 +17,7 @@ import (
 	"github.com/bnema/dumber/internal/infrastructure/deps"
 	"github.com/bnema/dumber/internal/infrastructure/env"
 	"github.com/bnema/dumber/internal/infrastructure/externaltheme/noctalia"
+	"github.com/bnema/dumber/internal/infrastructure/favicon"
 	"github.com/bnema/dumber/internal/infrastructure/media"
 	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite"
 	"github.com/bnema/dumber/internal/infrastructure/runtimeprofile"
@@ -24,6 +25,9 @@ import (
 	"github.com/bnema/dumber/internal/ui/theme"
 )
 
+// bytesPerMB converts cache.favicon_max_mb to bytes.
+const bytesPerMB = 1 << 20
+
 // DatabaseResult holds database connection and cleanup function.
 type DatabaseResult struct {
 	DB      *sql.DB
@@ -47,6 +51,8 @@ type ParallelInitResult struct {
 type DeferredInitResult struct {
 	RuntimeErr error
 	MediaErr   error
+	FaviconGC  favicon.GCResult
+	FaviconErr error
 	Duration   time.Duration
 }
 
@@ -60,6 +66,8 @@ type ParallelInitInput struct {
 type DeferredInitInput struct {
 	Ctx    context.Context
 	Config *config.Config
+	// Favicons is trimmed to the configured cache limits; nil skips eviction.
+	Favicons *favicon.Service
 }
 
 // RuntimeRequirementsError contains details about missing runtime dependencies.
@@ -197,16 +205,18 @@ func LogResolvedTheme(ctx context.Context, resolved entity.ResolvedTheme) {
 }
 
 // RunDeferredInit runs deferred initialization checks off the critical path.
-// This includes runtime requirements and media checks.
+// This includes runtime requirements, media checks and favicon cache eviction.
 func RunDeferredInit(input DeferredInitInput) DeferredInitResult {
 	var (
 		runtimeErr error
 		mediaErr   error
+		faviconGC  favicon.GCResult
+		faviconErr error
 		wg         sync.WaitGroup
 	)
 
 	start := time.Now()
-	wg.Add(2)
+	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
@@ -218,15 +228,43 @@ func RunDeferredInit(input DeferredInitInput) DeferredInitResult {
 		mediaErr = CheckMediaRequirements(input.Ctx, input.Config)
 	}()
 
+	go func() {
+		defer wg.Done()
+		faviconGC, faviconErr = EvictFaviconCache(input.Ctx, input.Config, input.Favicons)
+	}()
+
 	wg.Wait()
 
 	return DeferredInitResult{
 		RuntimeErr: runtimeErr,
 		MediaErr:   mediaErr,
+		FaviconGC:  faviconGC,
+		FaviconErr: faviconErr,
 		Duration:   time.Since(start),
 	}
 }
 
+// FaviconCacheLimits converts the cache.favicon_* settings to eviction limits.
+func FaviconCacheLimits(cfg *config.Config) favicon.CacheLimits {
+	if cfg == nil {
+		return favicon.CacheLimits{}
+	}
+	return favicon.CacheLimits{
+		MaxBytes:   int64(cfg.Cache.FaviconMaxMB) * bytesPerMB,
+		MaxEntries: cfg.Cache.FaviconMaxEntries,
+	}
+}
+
+// EvictFaviconCache removes least recently used favicons until the disk cache
+// fits the configured limits. It is a no-op without limits or a service.
+func EvictFaviconCache(ctx context.Context, cfg *config.Config, svc *favicon.Service) (favicon.GCResult, error) {
+	limits := FaviconCacheLimits(cfg)
+	if svc == nil || limits.Unlimited() {
+		return favicon.GCResult{}, nil
+	}
+	return svc.GC(ctx, limits)
+}
+
 // CheckRuntimeRequirements verifies GUI runtime dependencies currently covered by runtime checks.
 // Returns error if requirements are not met; caller should log details and exit.
 // Note: When running in a Flatpak sandbox, runtime checks are skipped because
@@ -2,6 +2,7 @@ package bootstrap
 
 import (
 	"maps"
+	"slices"
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/entity"
@@ -73,13 +74,34 @@ func EngineSettingsPayloadFromConfig(cfg *config.Config) entity.EngineSettingsPa
 			DefaultFontSize:           cfg.Appearance.DefaultFontSize,
 			EnableDevTools:            cfg.Debug.EnableDevTools,
 			CaptureConsole:            cfg.Logging.CaptureConsole,
+			ConsoleBufferSize:         cfg.Debug.ConsoleBufferSize,
 			DrawCompositingIndicators: cfg.Engine.WebKit.DrawCompositingIndicators,
 			HardwareDecoding:          engineHardwareDecodingModeFromConfig(cfg.Media.HardwareDecodingMode),
 			AutoCopyOnSelection:       cfg.Clipboard.AutoCopyOnSelection,
+			AutoplayPolicy:            cfg.Media.AutoplayPolicy,
+			ScrollMultiplier:          cfg.Input.ScrollMultiplier,
+			SmoothScrolling:           cfg.Input.SmoothScrolling,
+			TypeToFind:                cfg.Input.TypeToFind,
+			TrackFormDirty:            cfg.Input.ConfirmCloseUnsavedForms,
+			ReadingProgress:           cfg.Appearance.ReadingProgress,
+			PageLanguage:              cfg.Translation.Prompt,
+			ImageDisposition:          cfg.Downloads.Images,
 		},
+		RequestThrottle:    slices.Clone(cfg.Network.Throttle),
+		StripCSPDomains:    stripCSPDomains(cfg),
+		AlwaysFreshDomains: slices.Clone(cfg.Cache.AlwaysFreshDomains),
 	}
 }
 
+// stripCSPDomains returns the domains whose CSP is stripped, or nil unless
+// debug.enable_csp_stripping arms the list.
+func stripCSPDomains(cfg *config.Config) []string {
+	if !cfg.Debug.EnableCSPStripping {
+		return nil
+	}
+	return slices.Clone(cfg.Debug.StripCSPDomains)
+}
+
 func RuntimeConfigSnapshotFromConfig(cfg *config.Config) entity.RuntimeConfigSnapshot {
 	if cfg == nil {
 		return entity.RuntimeConfigSnapshot{}
@@ -87,25 +109,114 @@ func RuntimeConfigSnapshotFromConfig(cfg *config.Config) entity.RuntimeConfigSna
 	return entity.RuntimeConfigSnapshot{
 		EngineSettings: EngineSettingsPayloadFromConfig(cfg),
 		UI: entity.RuntimeUIConfig{
-			DefaultUIScale:      cfg.DefaultUIScale,
-			SidebarWidth:        cfg.SidebarWidth,
-			Appearance:          cfg.Appearance,
-			Workspace:           cloneWorkspaceConfig(cfg.Workspace),
-			Session:             cloneSessionConfig(cfg.Session),
-			Clipboard:           entity.RuntimeClipboardConfig{AutoCopyOnSelection: cfg.Clipboard.AutoCopyOnSelection},
+			DefaultUIScale: cfg.DefaultUIScale,
+			SidebarWidth:   cfg.SidebarWidth,
+			Appearance:     cloneAppearanceConfig(cfg.Appearance),
+			Workspace:      cloneWorkspaceConfig(cfg.Workspace),
+			Session:        cloneSessionConfig(cfg.Session),
+			Clipboard: entity.RuntimeClipboardConfig{
+				AutoCopyOnSelection: cfg.Clipboard.AutoCopyOnSelection,
+				URLHistorySize:      cfg.Clipboard.URLHistorySize,
+			},
 			SearchShortcuts:     runtimeSearchShortcutsFromConfig(cfg.SearchShortcuts),
 			DefaultSearchEngine: cfg.DefaultSearchEngine,
+			MultiSearchEngines:  slices.Clone(cfg.MultiSearchEngines),
 			Omnibox: entity.RuntimeOmniboxConfig{
-				InitialBehavior:   cfg.Omnibox.InitialBehavior,
-				MostVisitedDays:   cfg.Omnibox.MostVisitedDays,
-				AutoOpenOnNewPane: cfg.Omnibox.AutoOpenOnNewPane,
+				InitialBehavior:    cfg.Omnibox.InitialBehavior,
+				MostVisitedDays:    cfg.Omnibox.MostVisitedDays,
+				AutoOpenOnNewPane:  cfg.Omnibox.AutoOpenOnNewPane,
+				MaxResults:         cfg.Omnibox.MaxResults,
+				MinQueryLength:     cfg.Omnibox.MinQueryLength,
+				OpenInNewPane:      cfg.Omnibox.OpenInNewPane,
+				OpenWithCurrentURL: cfg.Omnibox.OpenWithCurrentURL,
+				MatchMode:          cfg.Omnibox.MatchMode,
+				NextResultKeys:     slices.Clone(cfg.Omnibox.NextResultKeys),
+				PreviousResultKeys: slices.Clone(cfg.Omnibox.PreviousResultKeys),
 			},
 			Update: entity.RuntimeUpdateConfig{
 				EnableOnStartup:     cfg.Update.EnableOnStartup,
 				AutoDownload:        cfg.Update.AutoDownload,
 				NotifyOnNewSettings: cfg.Update.NotifyOnNewSettings,
 			},
-			Downloads: entity.RuntimeDownloadsConfig{Path: cfg.Downloads.Path},
+			Downloads: entity.RuntimeDownloadsConfig{
+				Path:             cfg.Downloads.Path,
+				FilenameTemplate: cfg.Downloads.FilenameTemplate,
+			},
+			Media: entity.RuntimeMediaConfig{
+				AutoplayPolicy:     cfg.Media.AutoplayPolicy,
+				AutoplayExceptions: slices.Clone(cfg.Media.AutoplayExceptions),
+				MuteOnFocusLoss:    cfg.Media.MuteOnFocusLoss,
+			},
+			Privacy: entity.RuntimePrivacyConfig{
+				CookiePolicy:            cfg.Engine.CookiePolicy,
+				CookiePolicyOverrides:   slices.Clone(cfg.Engine.CookiePolicyOverrides),
+				ClipboardReadPolicy:     cfg.Privacy.ClipboardReadPolicy,
+				ClearDataOnCloseDomains: slices.Clone(cfg.Privacy.ClearDataOnCloseDomains),
+				PanicNeutralURL:         cfg.Privacy.PanicNeutralURL,
+				PanicPIN:                cfg.Privacy.PanicPIN,
+				HTTPSOnly:               cfg.Privacy.HTTPSOnly,
+			},
+			PageEnv: clonePageEnvConfig(entity.RuntimePageEnvConfig{
+				Values:  cfg.PageEnv.Values,
+				Domains: cfg.PageEnv.Domains,
+			}),
+			Cache: entity.RuntimeCacheConfig{
+				AlwaysFreshDomains: slices.Clone(cfg.Cache.AlwaysFreshDomains),
+			},
+			Zoom: entity.RuntimeZoomConfig{
+				StepFactor:         cfg.Zoom.StepFactor,
+				DomainOverrides:    slices.Clone(cfg.Zoom.DomainOverrides),
+				Presets:            slices.Clone(cfg.Zoom.Presets),
+				SessionOnlyDomains: slices.Clone(cfg.Zoom.SessionOnlyDomains),
+			},
+			Input: entity.RuntimeInputConfig{
+				HoverFocusEnabled:        cfg.Input.HoverFocusEnabled,
+				HoverFocusDelayMs:        cfg.Input.HoverFocusDelayMs,
+				MiddleClickClosesPane:    cfg.Input.MiddleClickClosesPane,
+				AutofocusFirstField:      cfg.Input.AutofocusFirstField,
+				AutofocusDomains:         slices.Clone(cfg.Input.AutofocusDomains),
+				DisableGesturesDomains:   slices.Clone(cfg.Input.DisableGesturesDomains),
+				ConfirmCloseUnsavedForms: cfg.Input.ConfirmCloseUnsavedForms,
+			},
+			Homepage: cloneHomepageConfig(cfg.Homepage),
+			LinkStatus: entity.RuntimeLinkStatusConfig{
+				Enabled:     cfg.LinkStatus.Enabled,
+				ShowDelayMs: cfg.LinkStatus.ShowDelayMs,
+				MaxLength:   cfg.LinkStatus.MaxLength,
+			},
+			ContentFiltering: entity.RuntimeContentFilteringConfig{
+				DisabledDomains: slices.Clone(cfg.ContentFiltering.DisabledDomains),
+			},
+			Window: entity.RuntimeWindowConfig{
+				RememberGeometry: cfg.Window.RememberGeometry,
+				DefaultWidth:     cfg.Window.DefaultWidth,
+				DefaultHeight:    cfg.Window.DefaultHeight,
+			},
+			ScriptDialogs: entity.RuntimeScriptDialogsConfig{
+				AutoDismiss:        cfg.ScriptDialogs.AutoDismiss,
+				AutoDismissDomains: slices.Clone(cfg.ScriptDialogs.AutoDismissDomains),
+			},
+			History: entity.RuntimeHistoryConfig{
+				PersistNavigationTree: cfg.History.PersistNavigationTree,
+			},
+			LoadRetry: entity.RuntimeLoadRetryConfig{
+				MaxAttempts:    cfg.Network.Retry.MaxAttempts,
+				InitialDelayMs: cfg.Network.Retry.InitialDelayMs,
+				MaxDelayMs:     cfg.Network.Retry.MaxDelayMs,
+			},
+			ReadAloud: entity.RuntimeReadAloudConfig{
+				Command: cfg.ReadAloud.Command,
+			},
+			Translation: entity.RuntimeTranslationConfig{
+				Prompt:            cfg.Translation.Prompt,
+				PreferredLanguage: cfg.Translation.PreferredLanguage,
+				URLTemplate:       cfg.Translation.URLTemplate,
+				IgnoredLanguages:  slices.Clone(cfg.Translation.IgnoredLanguages),
+			},
+			Notifications: entity.RuntimeNotificationsConfig{
+				HistorySize: cfg.Notifications.HistorySize,
+			},
+			SearchSelectionInNewPane: cfg.SearchSelectionInNewPane,
 		},
 	}
 }
@@ -123,11 +234,50 @@ func runtimeSearchShortcutsFromConfig(in map[string]config.SearchShortcut) map[s
 
 func cloneRuntimeConfigSnapshot(snapshot entity.RuntimeConfigSnapshot) entity.RuntimeConfigSnapshot {
 	snapshot.UI.SearchShortcuts = cloneRuntimeSearchShortcuts(snapshot.UI.SearchShortcuts)
+	snapshot.UI.MultiSearchEngines = slices.Clone(snapshot.UI.MultiSearchEngines)
 	snapshot.UI.Workspace = cloneWorkspaceConfig(snapshot.UI.Workspace)
 	snapshot.UI.Session = cloneSessionConfig(snapshot.UI.Session)
+	snapshot.UI.Media.AutoplayExceptions = slices.Clone(snapshot.UI.Media.AutoplayExceptions)
+	snapshot.UI.Privacy.CookiePolicyOverrides = slices.Clone(snapshot.UI.Privacy.CookiePolicyOverrides)
+	snapshot.UI.Privacy.ClearDataOnCloseDomains = slices.Clone(snapshot.UI.Privacy.ClearDataOnCloseDomains)
+	snapshot.UI.PageEnv = clonePageEnvConfig(snapshot.UI.PageEnv)
+	snapshot.UI.Cache.AlwaysFreshDomains = slices.Clone(snapshot.UI.Cache.AlwaysFreshDomains)
+	snapshot.UI.Omnibox.NextResultKeys = slices.Clone(snapshot.UI.Omnibox.NextResultKeys)
+	snapshot.UI.Omnibox.PreviousResultKeys = slices.Clone(snapshot.UI.Omnibox.PreviousResultKeys)
+	snapshot.UI.ContentFiltering.DisabledDomains = slices.Clone(snapshot.UI.ContentFiltering.DisabledDomains)
+	snapshot.UI.ScriptDialogs.AutoDismissDomains = slices.Clone(snapshot.UI.ScriptDialogs.AutoDismissDomains)
+	snapshot.UI.Input.AutofocusDomains = slices.Clone(snapshot.UI.Input.AutofocusDomains)
+	snapshot.UI.Input.DisableGesturesDomains = slices.Clone(snapshot.UI.Input.DisableGesturesDomains)
+	snapshot.UI.Zoom.DomainOverrides = slices.Clone(snapshot.UI.Zoom.DomainOverrides)
+	snapshot.UI.Zoom.Presets = slices.Clone(snapshot.UI.Zoom.Presets)
+	snapshot.UI.Zoom.SessionOnlyDomains = slices.Clone(snapshot.UI.Zoom.SessionOnlyDomains)
+	snapshot.UI.Homepage = cloneHomepageConfig(snapshot.UI.Homepage)
+	snapshot.UI.Appearance = cloneAppearanceConfig(snapshot.UI.Appearance)
 	return snapshot
 }
 
+func clonePageEnvConfig(in entity.RuntimePageEnvConfig) entity.RuntimePageEnvConfig {
+	out := entity.RuntimePageEnvConfig{
+		Values:  maps.Clone(in.Values),
+		Domains: slices.Clone(in.Domains),
+	}
+	for i := range out.Domains {
+		out.Domains[i].Keys = slices.Clone(out.Domains[i].Keys)
+	}
+	return out
+}
+
+func cloneAppearanceConfig(in entity.AppearanceConfig) entity.AppearanceConfig {
+	in.ForceDarkDomains = slices.Clone(in.ForceDarkDomains)
+	in.FontOverrides = slices.Clone(in.FontOverrides)
+	return in
+}
+
+func cloneHomepageConfig(in entity.HomepageConfig) entity.HomepageConfig {
+	in.Widgets = slices.Clone(in.Widgets)
+	return in
+}
+
 func cloneRuntimeSearchShortcuts(in map[string]entity.RuntimeSearchShortcut) map[string]entity.RuntimeSearchShortcut {
 	if in == nil {
 		return nil
@@ -141,8 +291,19 @@ func cloneWorkspaceConfig(in entity.WorkspaceConfig) entity.WorkspaceConfig {
 	in.PaneMode.Actions = cloneActionBindings(in.PaneMode.Actions)
 	in.TabMode.Actions = cloneActionBindings(in.TabMode.Actions)
 	in.ResizeMode.Actions = cloneActionBindings(in.ResizeMode.Actions)
+	in.Leader.Actions = cloneActionBindings(in.Leader.Actions)
 	in.Shortcuts.Actions = cloneActionBindings(in.Shortcuts.Actions)
 	in.FloatingPane.Profiles = cloneFloatingPaneProfiles(in.FloatingPane.Profiles)
+	in.ReloadOnFocusDomains = cloneStringSlice(in.ReloadOnFocusDomains)
+	in.BrowsingContexts = cloneBrowsingContextConfig(in.BrowsingContexts)
+	in.Popups = cloneBrowsingContextConfig(in.Popups)
+	return in
+}
+
+func cloneBrowsingContextConfig(in entity.BrowsingContextConfig) entity.BrowsingContextConfig {
+	in.PopupBlockDomains = cloneStringSlice(in.PopupBlockDomains)
+	in.PopupAllowDomains = cloneStringSlice(in.PopupAllowDomains)
+	in.DomainPlacement = slices.Clone(in.DomainPlacement)
 	return in
 }
 
@@ -245,7 +245,7 @@ func TestRuntimeConfigProviderOnChangePassesNestedConfigClone(t *testing.T) {
 
 func TestEngineSettingsPayloadFromNilConfigReturnsZeroPayload(t *testing.T) {
 	got := EngineSettingsPayloadFromConfig(nil)
-	if got != (entity.EngineSettingsPayload{}) {
+	if !reflect.DeepEqual(got, entity.EngineSettingsPayload{}) {
 		t.Fatalf("payload=%#v, want zero value", got)
 	}
 }
@@ -392,7 +392,7 @@ func TestRuntimeConfigSnapshotFromConfigMapsUIRuntimeFields(t *testing.T) {
 
 	got := RuntimeConfigSnapshotFromConfig(cfg)
 
-	if got.EngineSettings != EngineSettingsPayloadFromConfig(cfg) {
+	if !reflect.DeepEqual(got.EngineSettings, EngineSettingsPayloadFromConfig(cfg)) {
 		t.Fatalf("EngineSettings=%#v, want %#v", got.EngineSettings, EngineSettingsPayloadFromConfig(cfg))
 	}
 	if got.UI.DefaultUIScale != 1.4 ||
@@ -78,12 +78,7 @@ func StartBrowserSession(
 		return nil, ctx, errors.New("session repository is nil")
 	}
 
-	logDir := cfg.Logging.LogDir
-	if logDir == "" {
-		if dir, err := config.GetLogDir(); err == nil {
-			logDir = dir
-		}
-	}
+	logDir := resolveLogDir(cfg)
 
 	sessionLoggerAdapter := infralogging.NewSessionLoggerAdapter()
 	sessionUC := usecase.NewManageSessionUseCase(sessionRepo, sessionLoggerAdapter)
@@ -172,9 +167,10 @@ func StartBrowserSession(
 		_ = persistFn(endCtx)
 		endErr := sessionUC.EndSession(endCtx, session.ID, time.Now())
 
-		// Remove PID file on clean shutdown.
+		// Remove PID file and crash history on clean shutdown.
 		if logDir != "" {
 			removePIDFile(logDir)
+			clearCrashHistory(logDir)
 		}
 		return endErr
 	}
@@ -195,6 +191,18 @@ func StartBrowserSession(
 	return bs, sessionCtx, nil
 }
 
+// resolveLogDir returns the configured log directory, falling back to the
+// default one.
+func resolveLogDir(cfg *config.Config) string {
+	if cfg.Logging.LogDir != "" {
+		return cfg.Logging.LogDir
+	}
+	if dir, err := config.GetLogDir(); err == nil {
+		return dir
+	}
+	return ""
+}
+
 // ---------------------------------------------------------------------------
 // PID file: single file {logDir}/dumber.pid
 // ---------------------------------------------------------------------------
@@ -232,17 +240,12 @@ func detectPreviousCrash(logDir string, logger *zerolog.Logger) []string {
 		return nil
 	}
 
-	// Check if the process is still running.
-	proc, procErr := os.FindProcess(pid)
-	if procErr == nil {
-		// On Unix, FindProcess always succeeds. Send signal 0 to probe existence.
-		if killErr := proc.Signal(syscall.Signal(0)); killErr == nil {
-			// Process is alive → another instance running, don't touch PID file.
-			if logger != nil {
-				logger.Warn().Int("pid", pid).Msg("previous instance still running (PID file exists)")
-			}
-			return nil
+	if processAlive(pid) {
+		// Process is alive → another instance running, don't touch PID file.
+		if logger != nil {
+			logger.Warn().Int("pid", pid).Msg("previous instance still running (PID file exists)")
 		}
+		return nil
 	}
 
 	// Previous process is dead → crash. Generate report from last log.
@@ -278,6 +281,16 @@ func detectPreviousCrash(logDir string, logger *zerolog.Logger) []string {
 	return []string{reportPath}
 }
 
+// processAlive reports whether a process with pid is still running.
+func processAlive(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	// On Unix, FindProcess always succeeds. Send signal 0 to probe existence.
+	return proc.Signal(syscall.Signal(0)) == nil
+}
+
 // findLastSessionLog returns the most recent session_*.log file in logDir.
 func findLastSessionLog(logDir string) string {
 	matches, err := filepath.Glob(filepath.Join(logDir, "session_*.log"))
@@ -7,16 +7,25 @@ import (
 	"time"
 
 	"github.com/bnema/dumber/internal/logging"
+	"github.com/rs/zerolog"
 )
 
 // StartupTimer tracks timing for cold start phases.
 // Thread-safe for use with parallel initialization.
 type StartupTimer struct {
-	start  time.Time
-	phases map[string]time.Duration
-	order  []string // Track insertion order for logging
-	last   time.Time
-	mu     sync.Mutex
+	start   time.Time
+	phases  map[string]time.Duration
+	order   []string // Track insertion order for logging
+	budgets map[string]time.Duration
+	last    time.Time
+	mu      sync.Mutex
+}
+
+// phaseOverrun is a phase that took longer than its budget.
+type phaseOverrun struct {
+	Phase    string
+	Duration time.Duration
+	Budget   time.Duration
 }
 
 // NewStartupTimer creates a new timer starting from now.
@@ -59,21 +68,33 @@ func (t *StartupTimer) Total() time.Duration {
 	return time.Since(t.start)
 }
 
-// Log outputs all timing information to the context logger.
+// SetBudgets sets the per-phase budgets checked when the timing is logged.
+// Phases without a budget are never reported as over budget.
+func (t *StartupTimer) SetBudgets(budgets map[string]time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.budgets = make(map[string]time.Duration, len(budgets))
+	for phase, budget := range budgets {
+		t.budgets[phase] = budget
+	}
+}
+
+// Log outputs all timing information to the context logger, preceded by a
+// warning for each phase over budget.
 func (t *StartupTimer) Log(ctx context.Context) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
 	log := logging.FromContext(ctx)
-	total := time.Since(t.start)
-
-	event := log.Info().Dur("total", total)
-	for _, phase := range t.order {
-		if dur, ok := t.phases[phase]; ok {
-			event = event.Dur(phase, dur)
-		}
+	for _, overrun := range phaseOverruns(t.order, t.phases, t.budgets) {
+		log.Warn().
+			Str("phase", overrun.Phase).
+			Dur("duration", overrun.Duration).
+			Dur("budget", overrun.Budget).
+			Msg("startup phase over budget")
 	}
-	event.Msg("startup timing")
+	t.logTiming(log.Info())
 }
 
 // LogDebug outputs timing as debug level (for less verbose production logs).
@@ -81,14 +102,53 @@ func (t *StartupTimer) LogDebug(ctx context.Context) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	log := logging.FromContext(ctx)
-	total := time.Since(t.start)
+	t.logTiming(logging.FromContext(ctx).Debug())
+}
 
-	event := log.Debug().Dur("total", total)
+// logTiming writes the total, every phase and finally the slowest phase.
+// Callers must hold t.mu.
+func (t *StartupTimer) logTiming(event *zerolog.Event) {
+	event = event.Dur("total", time.Since(t.start))
 	for _, phase := range t.order {
 		if dur, ok := t.phases[phase]; ok {
 			event = event.Dur(phase, dur)
 		}
 	}
+	if phase, dur, ok := slowestPhase(t.order, t.phases); ok {
+		event = event.Str("slowest", phase).Dur("slowest_duration", dur)
+	}
 	event.Msg("startup timing")
 }
+
+// phaseOverruns compares each marked phase against its budget. A phase
+// marked more than once is reported once, with its last duration.
+func phaseOverruns(order []string, phases, budgets map[string]time.Duration) []phaseOverrun {
+	var overruns []phaseOverrun
+	seen := make(map[string]bool, len(order))
+	for _, phase := range order {
+		if seen[phase] {
+			continue
+		}
+		seen[phase] = true
+		budget, ok := budgets[phase]
+		if !ok || budget <= 0 {
+			continue
+		}
+		if dur := phases[phase]; dur > budget {
+			overruns = append(overruns, phaseOverrun{Phase: phase, Duration: dur, Budget: budget})
+		}
+	}
+	return overruns
+}
+
+// slowestPhase returns the longest phase; the earliest one wins a tie.
+func slowestPhase(order []string, phases map[string]time.Duration) (slowest string, longest time.Duration, ok bool) {
+	for _, phase := range order {
+		dur, found := phases[phase]
+		if !found || (ok && dur <= longest) {
+			continue
+		}
+		slowest, longest, ok = phase, dur, true
+	}
+	return slowest, longest, ok
+}
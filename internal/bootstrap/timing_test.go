@@ -0,0 +1,65 @@
+package bootstrap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhaseOverruns_ReportsPhasesOverBudgetInMarkOrder(t *testing.T) {
+	timer := NewStartupTimer()
+	timer.MarkDuration("config", 20*time.Millisecond)
+	timer.MarkDuration("db_webkit_parallel", 180*time.Millisecond)
+	timer.MarkDuration("session", 10*time.Millisecond)
+	timer.MarkDuration("ui_deps", 400*time.Millisecond)
+	timer.SetBudgets(map[string]time.Duration{
+		"config":             20 * time.Millisecond, // exactly on budget
+		"db_webkit_parallel": 150 * time.Millisecond,
+		"ui_deps":            300 * time.Millisecond,
+		"never_marked":       time.Millisecond,
+	})
+
+	got := phaseOverruns(timer.order, timer.phases, timer.budgets)
+
+	assert.Equal(t, []phaseOverrun{
+		{Phase: "db_webkit_parallel", Duration: 180 * time.Millisecond, Budget: 150 * time.Millisecond},
+		{Phase: "ui_deps", Duration: 400 * time.Millisecond, Budget: 300 * time.Millisecond},
+	}, got)
+}
+
+func TestPhaseOverruns_NoBudgets(t *testing.T) {
+	timer := NewStartupTimer()
+	timer.MarkDuration("config", time.Second)
+
+	assert.Empty(t, phaseOverruns(timer.order, timer.phases, timer.budgets))
+}
+
+func TestPhaseOverruns_RepeatedPhaseReportedOnce(t *testing.T) {
+	timer := NewStartupTimer()
+	timer.MarkDuration("session", 50*time.Millisecond)
+	timer.MarkDuration("session", 70*time.Millisecond)
+	timer.SetBudgets(map[string]time.Duration{"session": 40 * time.Millisecond})
+
+	got := phaseOverruns(timer.order, timer.phases, timer.budgets)
+
+	assert.Equal(t, []phaseOverrun{
+		{Phase: "session", Duration: 70 * time.Millisecond, Budget: 40 * time.Millisecond},
+	}, got)
+}
+
+func TestSlowestPhase(t *testing.T) {
+	timer := NewStartupTimer()
+	_, _, ok := slowestPhase(timer.order, timer.phases)
+	assert.False(t, ok)
+
+	timer.MarkDuration("config", 30*time.Millisecond)
+	timer.MarkDuration("parallel_phase", 90*time.Millisecond)
+	timer.MarkDuration("session", 90*time.Millisecond)
+	timer.MarkDuration("logger", 5*time.Millisecond)
+
+	phase, d, ok := slowestPhase(timer.order, timer.phases)
+	assert.True(t, ok)
+	assert.Equal(t, "parallel_phase", phase, "earliest phase wins a tie")
+	assert.Equal(t, 90*time.Millisecond, d)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/bnema/dumber/internal/domain/build"
 	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/domain/repository"
+	"github.com/bnema/dumber/internal/infrastructure/certpin"
 	"github.com/bnema/dumber/internal/infrastructure/config"
 	"github.com/bnema/dumber/internal/infrastructure/externaltheme/noctalia"
 	"github.com/bnema/dumber/internal/infrastructure/favicon"
@@ -38,6 +39,9 @@ type App struct {
 	ListSessionsUC  *usecase.ListSessionsUseCase
 	RestoreUC       *usecase.RestoreSessionUseCase
 	DeleteSessionUC *usecase.DeleteSessionUseCase
+	SnapshotUC      *usecase.SnapshotSessionUseCase
+	BackupUC        *usecase.SessionBackupUseCase
+	CertPinsUC      *usecase.ManageCertificatePinsUseCase
 
 	// Services
 	FaviconService          *favicon.Service
@@ -127,6 +131,9 @@ func NewApp() (*App, error) {
 	listSessionsUC := usecase.NewListSessionsUseCase(sessionRepo, sessionStateRepo)
 	restoreUC := usecase.NewRestoreSessionUseCase(sessionStateRepo, sessionRepo)
 	deleteSessionUC := usecase.NewDeleteSessionUseCase(sessionStateRepo, sessionRepo)
+	snapshotUC := usecase.NewSnapshotSessionUseCase(sessionStateRepo)
+	backupUC := usecase.NewSessionBackupUseCase(sessionRepo, sessionStateRepo)
+	certPinsUC := usecase.NewManageCertificatePinsUseCase(sqlite.NewCertificatePinRepository(db), certpin.NewProbe())
 
 	// Create favicon service for CLI (path resolution for dmenu/fuzzel)
 	faviconCacheDir, _ := config.GetFaviconCacheDir()
@@ -143,6 +150,9 @@ func NewApp() (*App, error) {
 		ListSessionsUC:          listSessionsUC,
 		RestoreUC:               restoreUC,
 		DeleteSessionUC:         deleteSessionUC,
+		SnapshotUC:              snapshotUC,
+		BackupUC:                backupUC,
+		CertPinsUC:              certPinsUC,
 		FaviconService:          faviconService,
 		SessionSpawner:          bootstrap.NewSessionSpawner(ctx, profile),
 		LocalPaths:              localPaths,
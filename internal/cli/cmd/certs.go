@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bnema/dumber/internal/cli/styles"
+)
+
+var certsCmd = &cobra.Command{
+	Use:   "certs",
+	Short: "Manage per-host certificate pins",
+	Long: `Pin the public key a host currently serves so later loads hard-fail
+if it changes.
+
+A pinned https page whose certificate key does not match is blocked without
+a prompt. Pins are checked for main-frame loads only.`,
+}
+
+var certsPinCmd = &cobra.Command{
+	Use:   "pin <host>",
+	Short: "Pin the certificate key a host currently serves",
+	Long: `Connect to a host, verify its certificate against the system trust
+store and store the SHA-256 of its public key as the pin for that host.
+Pinning an already pinned host replaces the previous pin.`,
+	Example: `  dumber certs pin example.com
+  dumber certs pin example.com:8443`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCertsPin,
+}
+
+var certsUnpinCmd = &cobra.Command{
+	Use:   "unpin <host>",
+	Short: "Remove the certificate pin for a host",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCertsUnpin,
+}
+
+var certsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List certificate pins",
+	Args:  cobra.NoArgs,
+	RunE:  runCertsList,
+}
+
+func init() {
+	rootCmd.AddCommand(certsCmd)
+	certsCmd.AddCommand(certsPinCmd, certsUnpinCmd, certsListCmd)
+}
+
+func runCertsPin(_ *cobra.Command, args []string) error {
+	app := GetApp()
+	if app == nil {
+		return fmt.Errorf("app not initialized")
+	}
+
+	pin, err := app.CertPinsUC.Pin(app.Ctx(), args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s %s\n",
+		app.Theme.SuccessStyle.Render(styles.IconCheck),
+		pin.Host,
+		app.Theme.Subtle.Render("sha256/"+pin.SPKISHA256),
+	)
+	return nil
+}
+
+func runCertsUnpin(_ *cobra.Command, args []string) error {
+	app := GetApp()
+	if app == nil {
+		return fmt.Errorf("app not initialized")
+	}
+
+	if err := app.CertPinsUC.Unpin(app.Ctx(), args[0]); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s unpinned\n", app.Theme.SuccessStyle.Render(styles.IconCheck), args[0])
+	return nil
+}
+
+func runCertsList(_ *cobra.Command, _ []string) error {
+	app := GetApp()
+	if app == nil {
+		return fmt.Errorf("app not initialized")
+	}
+
+	pins, err := app.CertPinsUC.List(app.Ctx())
+	if err != nil {
+		return fmt.Errorf("list pins: %w", err)
+	}
+	if len(pins) == 0 {
+		fmt.Println(app.Theme.Subtle.Render("No certificate pins"))
+		return nil
+	}
+
+	for _, pin := range pins {
+		fmt.Printf("%s  %s  %s\n",
+			pin.Host,
+			pin.SPKISHA256,
+			app.Theme.Subtle.Render(time.Unix(pin.CreatedAt, 0).Format(time.DateOnly)),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bnema/dumber/internal/bootstrap"
+)
+
+var (
+	faviconGCMaxMB      int
+	faviconGCMaxEntries int
+)
+
+var faviconCmd = &cobra.Command{
+	Use:   "favicon",
+	Short: "Inspect and clean the favicon cache",
+	Long: `Inspect and clean the on-disk favicon cache.
+
+The browser trims the cache to cache.favicon_max_mb and
+cache.favicon_max_entries at startup, evicting the least recently used
+favicons first. Evicted favicons are fetched again when needed.
+
+Examples:
+  dumber favicon stats
+  dumber favicon gc
+  dumber favicon gc --max-mb 10`,
+}
+
+var faviconStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show favicon cache size and usage",
+	Args:  cobra.NoArgs,
+	RunE:  runFaviconStats,
+}
+
+var faviconGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Evict least recently used favicons over the cache limits",
+	Args:  cobra.NoArgs,
+	RunE:  runFaviconGC,
+}
+
+func init() {
+	rootCmd.AddCommand(faviconCmd)
+	faviconCmd.AddCommand(faviconStatsCmd)
+	faviconCmd.AddCommand(faviconGCCmd)
+
+	faviconGCCmd.Flags().IntVar(&faviconGCMaxMB, "max-mb", -1,
+		"maximum cache size in MB (default: cache.favicon_max_mb)")
+	faviconGCCmd.Flags().IntVar(&faviconGCMaxEntries, "max-entries", -1,
+		"maximum number of cached domains (default: cache.favicon_max_entries)")
+}
+
+func runFaviconStats(_ *cobra.Command, _ []string) error {
+	app := GetApp()
+	if app == nil || app.FaviconService == nil {
+		return fmt.Errorf("app not initialized")
+	}
+
+	stats, err := app.FaviconService.Stats()
+	if err != nil {
+		return fmt.Errorf("scan favicon cache: %w", err)
+	}
+	limits := bootstrap.FaviconCacheLimits(app.Config)
+
+	fmt.Println(app.Theme.Title.Render("Favicon cache"))
+	fmt.Println()
+	printFaviconStat(app.Theme.Subtle.Render, "Directory", stats.Dir)
+	printFaviconStat(app.Theme.Subtle.Render, "Domains", fmt.Sprintf("%d (limit: %s)",
+		stats.Entries, formatFaviconLimit(int64(limits.MaxEntries), formatCount)))
+	printFaviconStat(app.Theme.Subtle.Render, "Files", fmt.Sprint(stats.Files))
+	printFaviconStat(app.Theme.Subtle.Render, "Size", fmt.Sprintf("%s (limit: %s)",
+		formatSize(stats.Bytes), formatFaviconLimit(limits.MaxBytes, formatSize)))
+	if stats.Entries > 0 {
+		printFaviconStat(app.Theme.Subtle.Render, "Oldest use", stats.Oldest.Format("2006-01-02 15:04"))
+		printFaviconStat(app.Theme.Subtle.Render, "Newest use", stats.Newest.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+func runFaviconGC(_ *cobra.Command, _ []string) error {
+	app := GetApp()
+	if app == nil || app.FaviconService == nil {
+		return fmt.Errorf("app not initialized")
+	}
+
+	limits := bootstrap.FaviconCacheLimits(app.Config)
+	if faviconGCMaxMB >= 0 {
+		limits.MaxBytes = int64(faviconGCMaxMB) << 20 // MB to bytes
+	}
+	if faviconGCMaxEntries >= 0 {
+		limits.MaxEntries = faviconGCMaxEntries
+	}
+	if limits.Unlimited() {
+		fmt.Println(app.Theme.Subtle.Render("No favicon cache limit set; nothing to evict."))
+		return nil
+	}
+
+	result, err := app.FaviconService.GC(app.Ctx(), limits)
+	if err != nil {
+		return fmt.Errorf("evict favicons: %w", err)
+	}
+	if result.EvictedEntries == 0 {
+		fmt.Println(app.Theme.Subtle.Render("Favicon cache is within limits."))
+		return nil
+	}
+	fmt.Printf("Evicted %s favicons, freed %s (%d domains, %s left)\n",
+		app.Theme.Highlight.Render(fmt.Sprint(result.EvictedEntries)),
+		formatSize(result.FreedBytes),
+		result.Remaining.Entries,
+		formatSize(result.Remaining.Bytes))
+	return nil
+}
+
+func printFaviconStat(label func(...string) string, name, value string) {
+	fmt.Printf("  %s %s\n", label(fmt.Sprintf("%-11s", name+":")), value)
+}
+
+// formatFaviconLimit renders a cache limit, where 0 means unlimited.
+func formatFaviconLimit(limit int64, format func(int64) string) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+	return format(limit)
+}
+
+func formatCount(n int64) string { return fmt.Sprint(n) }
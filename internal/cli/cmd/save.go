@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bnema/dumber/internal/bootstrap"
+	"github.com/bnema/dumber/internal/cli/styles"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/desktop"
+)
+
+var (
+	saveOutput string
+	saveFormat string
+)
+
+var saveCmd = &cobra.Command{
+	Use:   "save <url>",
+	Short: "Save a page to disk through the running browser",
+	Long: `Load a URL in the running browser and save it to a file.
+
+Formats:
+  mhtml  Single-file MIME archive with every subresource (default)
+  html   Single HTML file with stylesheets and images inlined
+
+The format is inferred from the output extension unless --format is given.
+A matching extension is appended when the output has none.`,
+	Example: `  dumber save https://example.com -o page.mhtml
+  dumber save https://example.com -o page --format html`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSave,
+}
+
+func init() {
+	rootCmd.AddCommand(saveCmd)
+	saveCmd.Flags().StringVarP(&saveOutput, "output", "o", "", "file to write (required)")
+	saveCmd.Flags().StringVar(&saveFormat, "format", "", "save format: mhtml or html")
+	_ = saveCmd.MarkFlagRequired("output")
+}
+
+func runSave(_ *cobra.Command, args []string) error {
+	app := GetApp()
+	if app == nil {
+		return fmt.Errorf("app not initialized")
+	}
+
+	path, format, err := resolveSaveTarget(saveOutput, saveFormat)
+	if err != nil {
+		return err
+	}
+	url := app.NavigationURLNormalizer.Normalize(app.Ctx(), args[0])
+
+	profile, err := bootstrap.ResolveRuntimeProfile(app.Config)
+	if err != nil {
+		return fmt.Errorf("resolve runtime profile: %w", err)
+	}
+	requester := desktop.NewPageSaveRequester(profile.IPC)
+	if err := requester.RequestSavePage(app.Ctx(), url, path, format); err != nil {
+		if errors.Is(err, desktop.ErrBrowserNotRunning) {
+			return fmt.Errorf("%w: start dumber browse first", err)
+		}
+		return err
+	}
+
+	fmt.Printf("%s %s\n", app.Theme.SuccessStyle.Render(styles.IconCheck), path)
+	return nil
+}
+
+// resolveSaveTarget returns the absolute output path and the save format.
+// The browser process writes the file, so relative paths and ~ are resolved
+// against the caller's environment first.
+func resolveSaveTarget(output, format string) (string, entity.PageSaveFormat, error) {
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return "", "", errors.New("output path is required")
+	}
+	if output == "~" || strings.HasPrefix(output, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", "", fmt.Errorf("resolve home directory: %w", err)
+		}
+		output = filepath.Join(home, strings.TrimPrefix(output, "~"))
+	}
+
+	saveAs := entity.PageSaveFormatForPath(output)
+	if format != "" {
+		parsed, err := entity.ParsePageSaveFormat(format)
+		if err != nil {
+			return "", "", err
+		}
+		saveAs = parsed
+	}
+
+	abs, err := filepath.Abs(output)
+	if err != nil {
+		return "", "", fmt.Errorf("resolve output path: %w", err)
+	}
+	if info, statErr := os.Stat(abs); statErr == nil && info.IsDir() {
+		return "", "", fmt.Errorf("%s is a directory", abs)
+	}
+	return entity.PageSavePath(abs, saveAs), saveAs, nil
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestResolveSaveTarget(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("HOME", dir)
+
+	path, format, err := resolveSaveTarget("page.mhtml", "")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "page.mhtml"), path)
+	assert.Equal(t, entity.PageSaveFormatMHTML, format)
+
+	path, format, err = resolveSaveTarget("~/saved/page", "html")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "saved", "page.html"), path)
+	assert.Equal(t, entity.PageSaveFormatHTMLComplete, format)
+
+	path, format, err = resolveSaveTarget("page.htm", "")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "page.htm"), path)
+	assert.Equal(t, entity.PageSaveFormatHTMLComplete, format)
+
+	_, _, err = resolveSaveTarget("page", "pdf")
+	assert.Error(t, err)
+
+	_, _, err = resolveSaveTarget(" ", "")
+	assert.Error(t, err)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "existing"), 0o755))
+	_, _, err = resolveSaveTarget("existing", "")
+	assert.ErrorContains(t, err, "is a directory")
+}
@@ -259,6 +259,214 @@ func runSessionsDelete(_ *cobra.Command, args []string) error {
 	return nil
 }
 
+// sessions export-urls [id]
+var (
+	sessionsExportTitles bool
+	sessionsExportOutput string
+)
+
+var sessionsExportURLsCmd = &cobra.Command{
+	Use:   "export-urls [session-id]",
+	Short: "Export a session's open URLs",
+	Long: `Print the URLs of every pane in a session, one per line.
+
+URLs are ordered by tab, then by pane within each tab. Blank, about: and
+internal dumb:// pages are skipped. Without a session ID, the currently
+running session is exported.
+
+Example:
+  dumber sessions export-urls
+  dumber sessions export-urls abc1 --titles
+  dumber sessions export-urls -o tabs.txt`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSessionsExportURLs,
+}
+
+func init() {
+	sessionsCmd.AddCommand(sessionsExportURLsCmd)
+	sessionsExportURLsCmd.Flags().BoolVar(&sessionsExportTitles, "titles", false, "include page titles (tab-separated)")
+	sessionsExportURLsCmd.Flags().StringVarP(&sessionsExportOutput, "output", "o", "", "write to file instead of stdout")
+}
+
+func runSessionsExportURLs(_ *cobra.Command, args []string) error {
+	cliApp := GetApp()
+	if cliApp == nil {
+		return fmt.Errorf("app not initialized")
+	}
+	renderer := styles.NewSessionsCLIRenderer(cliApp.Theme)
+
+	if cliApp.SnapshotUC == nil || cliApp.ListSessionsUC == nil || cliApp.SessionUC == nil {
+		err := fmt.Errorf("session management not available")
+		fmt.Fprintln(os.Stderr, renderer.RenderError(err))
+		return wrapPrintedError(err)
+	}
+
+	var sessionID entity.SessionID
+	if len(args) == 1 {
+		sessionInfo, err := findSessionByIDOrSuffix(args[0])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, renderer.RenderError(err))
+			return wrapPrintedError(err)
+		}
+		sessionID = sessionInfo.Session.ID
+	} else {
+		active, err := cliApp.SessionUC.GetActiveSession(cliApp.Ctx())
+		if err != nil || active == nil {
+			err = fmt.Errorf("no running session - pass a session ID (see 'dumber sessions list')")
+			fmt.Fprintln(os.Stderr, renderer.RenderError(err))
+			return wrapPrintedError(err)
+		}
+		sessionID = active.ID
+	}
+
+	urls, err := cliApp.SnapshotUC.ExportURLs(cliApp.Ctx(), sessionID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("export session urls: %w", err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+
+	text := formatSessionURLs(urls, sessionsExportTitles)
+	if sessionsExportOutput == "" {
+		fmt.Print(text)
+		return nil
+	}
+	const exportFilePerm = 0o644
+	if err := os.WriteFile(sessionsExportOutput, []byte(text), exportFilePerm); err != nil {
+		wrappedErr := fmt.Errorf("write %s: %w", sessionsExportOutput, err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+	return nil
+}
+
+// formatSessionURLs renders one URL per line, optionally followed by a tab
+// and the page title.
+func formatSessionURLs(urls []entity.SessionURL, withTitles bool) string {
+	var b strings.Builder
+	for _, u := range urls {
+		b.WriteString(u.URL)
+		if withTitles {
+			b.WriteByte('\t')
+			b.WriteString(strings.Join(strings.Fields(u.Title), " "))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// sessions export <file>
+var sessionsExportCmd = &cobra.Command{
+	Use:   "export <file>",
+	Short: "Back up every saved session to a file",
+	Long: `Write every saved session, with all its windows, tabs and pane
+layouts, to a portable JSON file.
+
+Use 'dumber sessions import' to restore the file on this or another
+machine.
+
+Example:
+  dumber sessions export ~/dumber-sessions.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionsExport,
+}
+
+// sessions import <file>
+var sessionsImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import sessions from a backup file",
+	Long: `Add the sessions of a file written by 'dumber sessions export'.
+
+Imported sessions get new IDs, so importing never replaces an existing
+session and the same file can be imported twice. They show up as exited
+sessions, ready to restore.
+
+Example:
+  dumber sessions import ~/dumber-sessions.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionsImport,
+}
+
+func init() {
+	sessionsCmd.AddCommand(sessionsExportCmd)
+	sessionsCmd.AddCommand(sessionsImportCmd)
+}
+
+func runSessionsExport(_ *cobra.Command, args []string) error {
+	cliApp := GetApp()
+	if cliApp == nil {
+		return fmt.Errorf("app not initialized")
+	}
+	renderer := styles.NewSessionsCLIRenderer(cliApp.Theme)
+
+	if cliApp.BackupUC == nil {
+		err := fmt.Errorf("session management not available")
+		fmt.Fprintln(os.Stderr, renderer.RenderError(err))
+		return wrapPrintedError(err)
+	}
+
+	backup, err := cliApp.BackupUC.Export(cliApp.Ctx())
+	if err != nil {
+		wrappedErr := fmt.Errorf("export sessions: %w", err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+
+	data, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		wrappedErr := fmt.Errorf("encode sessions: %w", err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+	// The backup lists every visited page; keep it private like the database.
+	const backupFilePerm = 0o600
+	if err := os.WriteFile(args[0], append(data, '\n'), backupFilePerm); err != nil {
+		wrappedErr := fmt.Errorf("write %s: %w", args[0], err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+
+	fmt.Println(renderer.RenderExported(len(backup.Sessions), args[0]))
+	return nil
+}
+
+func runSessionsImport(_ *cobra.Command, args []string) error {
+	cliApp := GetApp()
+	if cliApp == nil {
+		return fmt.Errorf("app not initialized")
+	}
+	renderer := styles.NewSessionsCLIRenderer(cliApp.Theme)
+
+	if cliApp.BackupUC == nil {
+		err := fmt.Errorf("session management not available")
+		fmt.Fprintln(os.Stderr, renderer.RenderError(err))
+		return wrapPrintedError(err)
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		wrappedErr := fmt.Errorf("read %s: %w", args[0], err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+	var backup entity.SessionBackup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		wrappedErr := fmt.Errorf("decode %s: %w", args[0], err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+
+	ids, err := cliApp.BackupUC.Import(cliApp.Ctx(), &backup)
+	if err != nil {
+		wrappedErr := fmt.Errorf("import sessions: %w", err)
+		fmt.Fprintln(os.Stderr, renderer.RenderError(wrappedErr))
+		return wrapPrintedError(wrappedErr)
+	}
+
+	fmt.Println(renderer.RenderImported(ids))
+	return nil
+}
+
 // findSessionByIDOrSuffix finds a session by exact ID or unique suffix.
 // Users typically identify sessions by the last few characters (e.g., "dee5").
 func findSessionByIDOrSuffix(idOrSuffix string) (*entity.SessionInfo, error) {
@@ -33,6 +33,18 @@ func TestSessionCommandsReturnManagementErrorWhenSessionUseCaseMissing(t *testin
 			name: "delete session",
 			run:  func() error { return runSessionsDelete(nil, []string{"session-id"}) },
 		},
+		{
+			name: "export session urls",
+			run:  func() error { return runSessionsExportURLs(nil, nil) },
+		},
+		{
+			name: "export sessions",
+			run:  func() error { return runSessionsExport(nil, []string{"sessions.json"}) },
+		},
+		{
+			name: "import sessions",
+			run:  func() error { return runSessionsImport(nil, []string{"sessions.json"}) },
+		},
 		{
 			name: "find session helper",
 			run: func() error {
@@ -194,3 +206,17 @@ func (sessionCommandStateRepo) GetAllSnapshots(context.Context) ([]*entity.Sessi
 func (sessionCommandStateRepo) GetTotalSnapshotsSize(context.Context) (int64, error) {
 	return 0, nil
 }
+
+func TestFormatSessionURLs(t *testing.T) {
+	urls := []entity.SessionURL{
+		{URL: "https://one.test", Title: "One"},
+		{URL: "https://two.test", Title: "Two\twith  tabs\n"},
+		{URL: "https://three.test"},
+	}
+
+	require.Equal(t, "https://one.test\nhttps://two.test\nhttps://three.test\n", formatSessionURLs(urls, false))
+	require.Equal(t,
+		"https://one.test\tOne\nhttps://two.test\tTwo with tabs\nhttps://three.test\t\n",
+		formatSessionURLs(urls, true),
+	)
+}
@@ -52,6 +52,17 @@ func (r *ConfigSchemaRenderer) Render(keys []entity.ConfigKeyInfo) string {
 		"Media",
 		"Update",
 		"Downloads",
+		"Cache",
+		"Zoom",
+		"Idle",
+		"Network",
+		"Input",
+		"Homepage",
+		"Link Status",
+		"User Scripts",
+		"Safe Mode",
+		"Window",
+		"Script Dialogs",
 		"Debug",
 		"Performance",
 		"Runtime",
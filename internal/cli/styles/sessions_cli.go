@@ -85,6 +85,31 @@ func (r *SessionsCLIRenderer) RenderDeleted(sessionID entity.SessionID) string {
 	)
 }
 
+func (r *SessionsCLIRenderer) RenderExported(count int, path string) string {
+	return fmt.Sprintf("%s Exported %s to %s.",
+		r.theme.SuccessStyle.Render(IconCheck),
+		sessionCount(count),
+		r.theme.Highlight.Render(path),
+	)
+}
+
+func (r *SessionsCLIRenderer) RenderImported(ids []entity.SessionID) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s Imported %s.", r.theme.SuccessStyle.Render(IconCheck), sessionCount(len(ids)))
+	for _, id := range ids {
+		b.WriteString("\n  ")
+		b.WriteString(r.theme.Highlight.Render(string(id)))
+	}
+	return b.String()
+}
+
+func sessionCount(n int) string {
+	if n == 1 {
+		return "1 session"
+	}
+	return fmt.Sprintf("%d sessions", n)
+}
+
 func (r *SessionsCLIRenderer) RenderError(err error) string {
 	return fmt.Sprintf("%s %v", r.theme.ErrorStyle.Render(IconX), err)
 }
@@ -0,0 +1,148 @@
+package autocomplete
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// Fuzzy scoring weights. Every matched character earns fuzzyMatchScore;
+// characters that follow the previous match or start a word earn a bonus,
+// and characters skipped inside the run or before it cost a penalty.
+const (
+	fuzzyMatchScore       = 16
+	fuzzyAdjacentBonus    = 16
+	fuzzyBoundaryBonus    = 8
+	fuzzyGapPenalty       = 2
+	fuzzyLeadingPenalty   = 1
+	fuzzyMaxLeadingCharge = 16
+)
+
+// substringMaxScore bounds substring scores, which fall with the match offset.
+const substringMaxScore = 1000
+
+// Match is a candidate that matched a query, with its position in the
+// candidate list and its score (higher is better).
+type Match struct {
+	Index int
+	Score int
+}
+
+// MatchScore scores text against query under mode, ignoring case. ok is
+// false when text does not match. An empty query matches everything with a
+// zero score.
+//
+// Substring scores fall with the offset of the match, prefix matches all
+// score the same, and fuzzy matches need the query's characters in order,
+// scoring tight runs and word starts higher.
+func MatchScore(mode entity.OmniboxMatchMode, query, text string) (score int, ok bool) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return 0, true
+	}
+	text = strings.ToLower(text)
+
+	switch mode {
+	case entity.OmniboxMatchModePrefix:
+		if strings.HasPrefix(text, query) {
+			return 1, true
+		}
+		return 0, false
+	case entity.OmniboxMatchModeFuzzy:
+		return fuzzyScore([]rune(query), []rune(text))
+	default:
+		offset := strings.Index(text, query)
+		if offset < 0 {
+			return 0, false
+		}
+		return max(substringMaxScore-offset, 1), true
+	}
+}
+
+// BestMatchScore returns the best score of query across fields.
+func BestMatchScore(mode entity.OmniboxMatchMode, query string, fields ...string) (score int, ok bool) {
+	for _, field := range fields {
+		if fieldScore, fieldOK := MatchScore(mode, query, field); fieldOK && (!ok || fieldScore > score) {
+			score, ok = fieldScore, true
+		}
+	}
+	return score, ok
+}
+
+// URLMatchFields returns the texts an address is matched on: the URL itself
+// and the URL without its scheme and "www.", so "git" prefixes
+// https://www.github.com.
+func URLMatchFields(rawURL string) []string {
+	stripped := strings.TrimPrefix(StripProtocol(rawURL), "www.")
+	if stripped == rawURL {
+		return []string{rawURL}
+	}
+	return []string{rawURL, stripped}
+}
+
+// Rank returns the candidates matching query under mode, best first. Each
+// candidate is the list of fields it is matched on. Ties keep the candidate
+// order.
+func Rank(mode entity.OmniboxMatchMode, query string, candidates [][]string) []Match {
+	matches := make([]Match, 0, len(candidates))
+	for i, fields := range candidates {
+		if score, ok := BestMatchScore(mode, query, fields...); ok {
+			matches = append(matches, Match{Index: i, Score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// fuzzyScore returns the best score of query as a subsequence of text,
+// trying every start position of the first query character.
+func fuzzyScore(query, text []rune) (int, bool) {
+	best, found := 0, false
+	for start := range text {
+		if text[start] != query[0] {
+			continue
+		}
+		score, ok := fuzzyScoreFrom(query, text, start)
+		if ok && (!found || score > best) {
+			best, found = score, true
+		}
+	}
+	return best, found
+}
+
+// fuzzyScoreFrom greedily matches query in text from start.
+func fuzzyScoreFrom(query, text []rune, start int) (int, bool) {
+	score := -min(start, fuzzyMaxLeadingCharge) * fuzzyLeadingPenalty
+	prev := -1
+	pos := start
+	for _, r := range query {
+		for pos < len(text) && text[pos] != r {
+			pos++
+		}
+		if pos == len(text) {
+			return 0, false
+		}
+		score += fuzzyMatchScore
+		if prev >= 0 {
+			if pos == prev+1 {
+				score += fuzzyAdjacentBonus
+			} else {
+				score -= (pos - prev - 1) * fuzzyGapPenalty
+			}
+		}
+		if pos == 0 || !isWordRune(text[pos-1]) {
+			score += fuzzyBoundaryBonus
+		}
+		prev = pos
+		pos++
+	}
+	return score, true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,124 @@
+package autocomplete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// matchCandidates is the fixed candidate set the match modes are compared on.
+var matchCandidates = []struct {
+	title string
+	url   string
+}{
+	{title: "GitHub", url: "https://github.com/"},
+	{title: "Go Packages", url: "https://pkg.go.dev/"},
+	{title: "Gist Hub notes", url: "https://notes.example/gist-hub"},
+	{title: "The Go Programming Language", url: "https://go.dev/"},
+	{title: "Digital Garden", url: "https://www.digital.garden/"},
+	{title: "Mongo Atlas", url: "https://cloud.mongodb.com/"},
+}
+
+func rankedTitles(mode entity.OmniboxMatchMode, query string) []string {
+	candidates := make([][]string, len(matchCandidates))
+	for i, c := range matchCandidates {
+		candidates[i] = append([]string{c.title}, URLMatchFields(c.url)...)
+	}
+	var titles []string
+	for _, m := range Rank(mode, query, candidates) {
+		titles = append(titles, matchCandidates[m.Index].title)
+	}
+	return titles
+}
+
+func TestRank_ComparesModes(t *testing.T) {
+	tests := []struct {
+		query string
+		want  map[entity.OmniboxMatchMode][]string
+	}{
+		{
+			query: "go",
+			want: map[entity.OmniboxMatchMode][]string{
+				entity.OmniboxMatchModeSubstring: {"Go Packages", "The Go Programming Language", "Mongo Atlas"},
+				entity.OmniboxMatchModePrefix:    {"Go Packages", "The Go Programming Language"},
+				entity.OmniboxMatchModeFuzzy:     {"Go Packages", "The Go Programming Language", "Mongo Atlas", "GitHub", "Gist Hub notes"},
+			},
+		},
+		{
+			query: "gthb",
+			want: map[entity.OmniboxMatchMode][]string{
+				entity.OmniboxMatchModeSubstring: nil,
+				entity.OmniboxMatchModePrefix:    nil,
+				entity.OmniboxMatchModeFuzzy:     {"GitHub", "Gist Hub notes"},
+			},
+		},
+		{
+			query: "dig",
+			want: map[entity.OmniboxMatchMode][]string{
+				entity.OmniboxMatchModeSubstring: {"Digital Garden"},
+				entity.OmniboxMatchModePrefix:    {"Digital Garden"},
+				entity.OmniboxMatchModeFuzzy:     {"Digital Garden"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for mode, want := range tt.want {
+			assert.Equal(t, want, rankedTitles(mode, tt.query), "mode %s, query %q", mode, tt.query)
+		}
+	}
+}
+
+func TestRank_TiesKeepCandidateOrder(t *testing.T) {
+	candidates := [][]string{{"alpha one"}, {"beta"}, {"alpha two"}, {"alpha three"}}
+	for _, mode := range []entity.OmniboxMatchMode{
+		entity.OmniboxMatchModeSubstring,
+		entity.OmniboxMatchModePrefix,
+		entity.OmniboxMatchModeFuzzy,
+	} {
+		assert.Equal(t, []Match{{Index: 0}, {Index: 2}, {Index: 3}}, zeroScores(Rank(mode, "alpha", candidates)), "mode %s", mode)
+	}
+}
+
+func zeroScores(matches []Match) []Match {
+	for i := range matches {
+		matches[i].Score = 0
+	}
+	return matches
+}
+
+func TestMatchScore_Fuzzy(t *testing.T) {
+	_, ok := MatchScore(entity.OmniboxMatchModeFuzzy, "hbtg", "github")
+	assert.False(t, ok, "characters must appear in order")
+
+	tight, ok := MatchScore(entity.OmniboxMatchModeFuzzy, "git", "github")
+	assert.True(t, ok)
+	loose, ok := MatchScore(entity.OmniboxMatchModeFuzzy, "git", "gadget it")
+	assert.True(t, ok)
+	assert.Greater(t, tight, loose, "a tight run outranks a spread-out one")
+
+	wordStart, _ := MatchScore(entity.OmniboxMatchModeFuzzy, "hub", "git hub")
+	midWord, _ := MatchScore(entity.OmniboxMatchModeFuzzy, "hub", "githubx")
+	assert.Greater(t, wordStart, midWord, "a word start outranks a mid-word match")
+
+	score, ok := MatchScore(entity.OmniboxMatchModeFuzzy, "", "anything")
+	assert.True(t, ok)
+	assert.Zero(t, score)
+}
+
+func TestMatchScore_IgnoresCase(t *testing.T) {
+	for _, mode := range []entity.OmniboxMatchMode{
+		entity.OmniboxMatchModeSubstring,
+		entity.OmniboxMatchModePrefix,
+		entity.OmniboxMatchModeFuzzy,
+	} {
+		_, ok := MatchScore(mode, "GIT", "GitHub")
+		assert.True(t, ok, "mode %s", mode)
+	}
+}
+
+func TestURLMatchFields(t *testing.T) {
+	assert.Equal(t, []string{"https://www.github.com/x", "github.com/x"}, URLMatchFields("https://www.github.com/x"))
+	assert.Equal(t, []string{"github.com"}, URLMatchFields("github.com"))
+}
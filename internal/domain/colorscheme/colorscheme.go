@@ -0,0 +1,34 @@
+// Package colorscheme resolves the color scheme override reported to pages.
+package colorscheme
+
+import "github.com/bnema/dumber/internal/domain/entity"
+
+// Resolve returns the color scheme in effect for a page: the pane override
+// wins over the one stored for the site, which wins over the system
+// preference. Empty and unknown values count as no override.
+func Resolve(pane, site entity.ColorScheme) entity.ColorScheme {
+	if isOverride(pane) {
+		return pane
+	}
+	if isOverride(site) {
+		return site
+	}
+	return entity.ColorSchemeSystem
+}
+
+// Next returns the color scheme after current in the toggle cycle:
+// system, dark, light, then back to system.
+func Next(current entity.ColorScheme) entity.ColorScheme {
+	switch current {
+	case entity.ColorSchemeDark:
+		return entity.ColorSchemeLight
+	case entity.ColorSchemeLight:
+		return entity.ColorSchemeSystem
+	default:
+		return entity.ColorSchemeDark
+	}
+}
+
+func isOverride(scheme entity.ColorScheme) bool {
+	return scheme == entity.ColorSchemeLight || scheme == entity.ColorSchemeDark
+}
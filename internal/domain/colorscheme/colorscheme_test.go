@@ -0,0 +1,37 @@
+package colorscheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name       string
+		pane, site entity.ColorScheme
+		want       entity.ColorScheme
+	}{
+		{"nothing set follows the system", "", "", entity.ColorSchemeSystem},
+		{"explicit system follows the system", entity.ColorSchemeSystem, entity.ColorSchemeSystem, entity.ColorSchemeSystem},
+		{"site applies without pane override", "", entity.ColorSchemeDark, entity.ColorSchemeDark},
+		{"system pane defers to the site", entity.ColorSchemeSystem, entity.ColorSchemeLight, entity.ColorSchemeLight},
+		{"pane wins over the site", entity.ColorSchemeLight, entity.ColorSchemeDark, entity.ColorSchemeLight},
+		{"pane applies without site", entity.ColorSchemeDark, "", entity.ColorSchemeDark},
+		{"unknown values are ignored", "sepia", "sepia", entity.ColorSchemeSystem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Resolve(tt.pane, tt.site))
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	assert.Equal(t, entity.ColorSchemeDark, Next(entity.ColorSchemeSystem))
+	assert.Equal(t, entity.ColorSchemeLight, Next(entity.ColorSchemeDark))
+	assert.Equal(t, entity.ColorSchemeSystem, Next(entity.ColorSchemeLight))
+	assert.Equal(t, entity.ColorSchemeDark, Next(""))
+}
@@ -0,0 +1,86 @@
+// Package console holds the bounded storage for captured page console output.
+package console
+
+import "github.com/bnema/dumber/internal/domain/entity"
+
+// Ring keeps the most recent console messages up to a fixed capacity.
+// Pushing into a full ring overwrites the oldest message. A ring with zero
+// capacity stores nothing. Ring is not safe for concurrent use.
+type Ring struct {
+	buf     []entity.ConsoleMessage
+	start   int
+	size    int
+	dropped int
+}
+
+// NewRing creates a ring holding at most capacity messages.
+// Negative capacities are treated as zero.
+func NewRing(capacity int) *Ring {
+	return &Ring{buf: make([]entity.ConsoleMessage, max(capacity, 0))}
+}
+
+// Cap returns the maximum number of messages the ring holds.
+func (r *Ring) Cap() int {
+	return len(r.buf)
+}
+
+// Len returns the number of messages currently held.
+func (r *Ring) Len() int {
+	return r.size
+}
+
+// Dropped returns how many messages were overwritten or discarded since the
+// ring was created or last cleared.
+func (r *Ring) Dropped() int {
+	return r.dropped
+}
+
+// Push appends msg, evicting the oldest message when the ring is full.
+func (r *Ring) Push(msg entity.ConsoleMessage) {
+	if len(r.buf) == 0 {
+		r.dropped++
+		return
+	}
+	if r.size < len(r.buf) {
+		r.buf[(r.start+r.size)%len(r.buf)] = msg
+		r.size++
+		return
+	}
+	r.buf[r.start] = msg
+	r.start = (r.start + 1) % len(r.buf)
+	r.dropped++
+}
+
+// Messages returns a copy of the held messages, oldest first.
+func (r *Ring) Messages() []entity.ConsoleMessage {
+	out := make([]entity.ConsoleMessage, r.size)
+	for i := range out {
+		out[i] = r.buf[(r.start+i)%len(r.buf)]
+	}
+	return out
+}
+
+// Resize changes the capacity, keeping the newest messages that still fit.
+func (r *Ring) Resize(capacity int) {
+	capacity = max(capacity, 0)
+	if capacity == len(r.buf) {
+		return
+	}
+	msgs := r.Messages()
+	if len(msgs) > capacity {
+		r.dropped += len(msgs) - capacity
+		msgs = msgs[len(msgs)-capacity:]
+	}
+	r.buf = make([]entity.ConsoleMessage, capacity)
+	copy(r.buf, msgs)
+	r.start = 0
+	r.size = len(msgs)
+}
+
+// Clear removes every message and resets the dropped counter.
+func (r *Ring) Clear() {
+	clear(r.buf)
+	r.start = 0
+	r.size = 0
+	r.dropped = 0
+}
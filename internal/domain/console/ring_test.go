@@ -0,0 +1,92 @@
+package console
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func msg(text string) entity.ConsoleMessage {
+	return entity.ConsoleMessage{Level: entity.ConsoleLevelInfo, Message: text}
+}
+
+func texts(msgs []entity.ConsoleMessage) []string {
+	out := make([]string, len(msgs))
+	for i, m := range msgs {
+		out[i] = m.Message
+	}
+	return out
+}
+
+func TestRing_KeepsInsertionOrderUntilFull(t *testing.T) {
+	r := NewRing(3)
+	r.Push(msg("a"))
+	r.Push(msg("b"))
+
+	assert.Equal(t, []string{"a", "b"}, texts(r.Messages()))
+	assert.Equal(t, 2, r.Len())
+	assert.Equal(t, 3, r.Cap())
+	assert.Zero(t, r.Dropped())
+}
+
+func TestRing_OverwritesOldestWhenFull(t *testing.T) {
+	r := NewRing(3)
+	for _, s := range []string{"a", "b", "c", "d", "e"} {
+		r.Push(msg(s))
+	}
+
+	assert.Equal(t, []string{"c", "d", "e"}, texts(r.Messages()))
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, 2, r.Dropped())
+}
+
+func TestRing_MessagesReturnsCopy(t *testing.T) {
+	r := NewRing(2)
+	r.Push(msg("a"))
+
+	got := r.Messages()
+	got[0].Message = "changed"
+
+	assert.Equal(t, []string{"a"}, texts(r.Messages()))
+}
+
+func TestRing_ZeroCapacityStoresNothing(t *testing.T) {
+	r := NewRing(-5)
+	r.Push(msg("a"))
+
+	assert.Empty(t, r.Messages())
+	assert.Equal(t, 0, r.Cap())
+	assert.Equal(t, 1, r.Dropped())
+}
+
+func TestRing_ResizeKeepsNewest(t *testing.T) {
+	r := NewRing(4)
+	for _, s := range []string{"a", "b", "c", "d", "e"} {
+		r.Push(msg(s))
+	}
+
+	r.Resize(2)
+	assert.Equal(t, []string{"d", "e"}, texts(r.Messages()))
+	assert.Equal(t, 3, r.Dropped())
+
+	r.Resize(3)
+	r.Push(msg("f"))
+	assert.Equal(t, []string{"d", "e", "f"}, texts(r.Messages()))
+	r.Push(msg("g"))
+	assert.Equal(t, []string{"e", "f", "g"}, texts(r.Messages()))
+}
+
+func TestRing_ClearResetsState(t *testing.T) {
+	r := NewRing(2)
+	for _, s := range []string{"a", "b", "c"} {
+		r.Push(msg(s))
+	}
+
+	r.Clear()
+	assert.Empty(t, r.Messages())
+	assert.Zero(t, r.Dropped())
+
+	r.Push(msg("d"))
+	assert.Equal(t, []string{"d"}, texts(r.Messages()))
+}
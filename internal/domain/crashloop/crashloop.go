@@ -0,0 +1,108 @@
+// Package crashloop follows the web process crashes of each page, so a page
+// that keeps crashing its renderer is reloaded once instead of forever.
+package crashloop
+
+import (
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultWindow is how long a crash counts against its page when the Tracker
+// has no Window set.
+const DefaultWindow = 5 * time.Minute
+
+// Action is what a pane does after its web process crashed.
+type Action int
+
+const (
+	// ActionPlaceholder shows the crash page with its reload button.
+	ActionPlaceholder Action = iota
+	// ActionReload loads the page again without asking.
+	ActionReload
+)
+
+// Tracker counts the recent crashes of each page. The zero value is ready to
+// use and safe for concurrent use.
+type Tracker struct {
+	// Window is how long after its last crash a page is still considered
+	// crashing. Zero uses DefaultWindow.
+	Window time.Duration
+
+	mu      sync.Mutex
+	crashes map[string]crashRecord
+}
+
+type crashRecord struct {
+	count int
+	last  time.Time
+}
+
+// Crashed records a crash of the page at uri and returns what the pane should
+// do. With autoReload, the first crash of a page reloads it; a page that
+// crashes again within the window of its previous crash gets the placeholder,
+// so a page that crashes while loading is reloaded only once. Pages without a
+// URI always get the placeholder.
+func (t *Tracker) Crashed(uri string, now time.Time, autoReload bool) Action {
+	key := pageKey(uri)
+	if key == "" {
+		return ActionPlaceholder
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pruneLocked(now)
+	if t.crashes == nil {
+		t.crashes = make(map[string]crashRecord)
+	}
+	record := t.crashes[key]
+	record.count++
+	record.last = now
+	t.crashes[key] = record
+
+	if autoReload && record.count == 1 {
+		return ActionReload
+	}
+	return ActionPlaceholder
+}
+
+// Count returns how many times the page at uri crashed, counting back from
+// its last crash while each one falls within the window of the next.
+func (t *Tracker) Count(uri string, now time.Time) int {
+	key := pageKey(uri)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	record, ok := t.crashes[key]
+	if !ok || now.Sub(record.last) > t.window() {
+		return 0
+	}
+	return record.count
+}
+
+func (t *Tracker) pruneLocked(now time.Time) {
+	window := t.window()
+	for key, record := range t.crashes {
+		if now.Sub(record.last) > window {
+			delete(t.crashes, key)
+		}
+	}
+}
+
+func (t *Tracker) window() time.Duration {
+	if t.Window > 0 {
+		return t.Window
+	}
+	return DefaultWindow
+}
+
+// pageKey identifies a page by its URI without the fragment, since jumping to
+// an anchor does not load another page.
+func pageKey(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	return parsed.String()
+}
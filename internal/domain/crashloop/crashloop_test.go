@@ -0,0 +1,54 @@
+package crashloop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var start = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestTrackerCrashed_PlaceholderWithoutAutoReload(t *testing.T) {
+	var tracker Tracker
+
+	assert.Equal(t, ActionPlaceholder, tracker.Crashed("https://example.com/", start, false))
+	assert.Equal(t, 1, tracker.Count("https://example.com/", start))
+}
+
+func TestTrackerCrashed_ReloadsOnlyOnce(t *testing.T) {
+	var tracker Tracker
+	const uri = "https://heavy.example/app"
+
+	assert.Equal(t, ActionReload, tracker.Crashed(uri, start, true))
+	assert.Equal(t, ActionPlaceholder, tracker.Crashed(uri, start.Add(10*time.Second), true),
+		"a second crash within the window must not reload again")
+	assert.Equal(t, ActionPlaceholder, tracker.Crashed(uri, start.Add(4*time.Minute), true))
+	assert.Equal(t, 3, tracker.Count(uri, start.Add(4*time.Minute)))
+}
+
+func TestTrackerCrashed_ReloadsAgainAfterWindow(t *testing.T) {
+	tracker := Tracker{Window: time.Minute}
+	const uri = "https://heavy.example/app"
+
+	assert.Equal(t, ActionReload, tracker.Crashed(uri, start, true))
+	assert.Equal(t, ActionPlaceholder, tracker.Crashed(uri, start.Add(30*time.Second), true))
+	assert.Equal(t, 0, tracker.Count(uri, start.Add(2*time.Minute)))
+	assert.Equal(t, ActionReload, tracker.Crashed(uri, start.Add(2*time.Minute), true))
+}
+
+func TestTrackerCrashed_TracksPagesSeparately(t *testing.T) {
+	var tracker Tracker
+
+	assert.Equal(t, ActionReload, tracker.Crashed("https://a.example/", start, true))
+	assert.Equal(t, ActionReload, tracker.Crashed("https://b.example/", start, true))
+	assert.Equal(t, ActionPlaceholder, tracker.Crashed("https://a.example/#section", start, true),
+		"the fragment does not make another page")
+}
+
+func TestTrackerCrashed_EmptyURIShowsPlaceholder(t *testing.T) {
+	var tracker Tracker
+
+	assert.Equal(t, ActionPlaceholder, tracker.Crashed("", start, true))
+	assert.Equal(t, 0, tracker.Count("", start))
+}
@@ -0,0 +1,64 @@
+// Package csp decides which pages load without their Content Security Policy.
+// Stripping the policy is a development aid for userscripts and tooling that
+// a site's CSP blocks; it removes a protection against script injection, so
+// it is only ever applied to explicitly listed domains.
+package csp
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// Stripper matches page URLs against the domain patterns whose CSP is
+// removed. The nil Stripper matches nothing.
+type Stripper struct {
+	patterns []string
+}
+
+// NewStripper returns a stripper for domain patterns ("example.com",
+// "*.example.com"). Empty and duplicate patterns are skipped, and so is "*",
+// which would strip the policy of every site. It returns nil when no pattern
+// is left.
+func NewStripper(domains []string) *Stripper {
+	s := &Stripper{}
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain == "" || domain == "*" || slices.Contains(s.patterns, domain) {
+			continue
+		}
+		s.patterns = append(s.patterns, domain)
+	}
+	if len(s.patterns) == 0 {
+		return nil
+	}
+	return s
+}
+
+// Domains returns the patterns the stripper matches.
+func (s *Stripper) Domains() []string {
+	if s == nil {
+		return nil
+	}
+	return slices.Clone(s.patterns)
+}
+
+// Applies reports whether the page at rawURL loads without its CSP. Only
+// http(s) pages of a listed domain qualify.
+func (s *Stripper) Applies(rawURL string) bool {
+	if s == nil {
+		return false
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+	default:
+		return false
+	}
+	return urlutil.MatchAnyDomainPattern(s.patterns, rawURL)
+}
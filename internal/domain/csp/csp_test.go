@@ -0,0 +1,42 @@
+package csp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripperApplies_OnlyToListedDomains(t *testing.T) {
+	s := NewStripper([]string{"localhost:3000", "*.dev.example.com", "app.test"})
+
+	tests := map[string]bool{
+		"http://localhost:3000/":            true,
+		"http://localhost:4000/":            false,
+		"https://dev.example.com/login":     true,
+		"https://api.dev.example.com/":      true,
+		"https://example.com/":              false,
+		"https://app.test/page":             true,
+		"https://www.app.test/page":         true,
+		"https://evil-app.test/":            false,
+		"https://app.test.evil.example/":    false,
+		"dumb://app.test":                   false,
+		"file:///home/user/app.test/a.html": false,
+		"":                                  false,
+	}
+	for rawURL, want := range tests {
+		assert.Equal(t, want, s.Applies(rawURL), rawURL)
+	}
+}
+
+func TestNewStripper_SkipsUnusablePatterns(t *testing.T) {
+	assert.Nil(t, NewStripper(nil))
+	assert.Nil(t, NewStripper([]string{"", "  ", "*"}), "a catch-all pattern is never honored")
+
+	s := NewStripper([]string{" Example.COM ", "example.com", "*"})
+	assert.Equal(t, []string{"example.com"}, s.Domains())
+	assert.False(t, s.Applies("https://other.org/"))
+
+	var nilStripper *Stripper
+	assert.False(t, nilStripper.Applies("https://example.com/"))
+	assert.Nil(t, nilStripper.Domains())
+}
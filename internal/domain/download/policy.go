@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"path"
 	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
 )
 
 var forcedDownloadExtensions = map[string]struct{}{
@@ -42,6 +44,15 @@ func ShouldForceDownload(uri, mimeType string) bool {
 	return ShouldForceDownloadForMIMEType(mimeType) || ShouldForceDownloadForURI(uri)
 }
 
+// ShouldDownloadImage returns true when mimeType is an image and images
+// opened as a page are downloaded instead of shown.
+func ShouldDownloadImage(mimeType string, disposition entity.ImageDisposition) bool {
+	if disposition != entity.ImageDispositionDownload {
+		return false
+	}
+	return strings.HasPrefix(normalizeMIMEType(mimeType), "image/")
+}
+
 // ShouldForceDownloadForURI returns true when the URI path has a known
 // download-only extension.
 func ShouldForceDownloadForURI(uri string) bool {
@@ -59,14 +70,16 @@ func ShouldForceDownloadForMIMEType(mimeType string) bool {
 	if mimeType == "" {
 		return false
 	}
+	_, ok := forcedDownloadMIMETypes[normalizeMIMEType(mimeType)]
+	return ok
+}
 
+func normalizeMIMEType(mimeType string) string {
 	mediaType := strings.ToLower(strings.TrimSpace(mimeType))
 	if parsed, _, err := mime.ParseMediaType(mediaType); err == nil {
 		mediaType = parsed
 	}
-
-	_, ok := forcedDownloadMIMETypes[mediaType]
-	return ok
+	return mediaType
 }
 
 func uriPath(raw string) string {
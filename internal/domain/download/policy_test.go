@@ -1,6 +1,10 @@
 package download
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
 
 func TestShouldForceDownloadForURI(t *testing.T) {
 	tests := []struct {
@@ -57,3 +61,27 @@ func TestShouldForceDownload(t *testing.T) {
 		t.Fatal("did not expect regular HTML to trigger forced download")
 	}
 }
+
+func TestShouldDownloadImage(t *testing.T) {
+	tests := []struct {
+		name        string
+		mimeType    string
+		disposition entity.ImageDisposition
+		expected    bool
+	}{
+		{name: "png downloaded", mimeType: "image/png", disposition: entity.ImageDispositionDownload, expected: true},
+		{name: "svg with params", mimeType: "Image/SVG+XML; charset=utf-8", disposition: entity.ImageDispositionDownload, expected: true},
+		{name: "png inline", mimeType: "image/png", disposition: entity.ImageDispositionInline, expected: false},
+		{name: "html downloaded", mimeType: "text/html", disposition: entity.ImageDispositionDownload, expected: false},
+		{name: "unset disposition", mimeType: "image/png", disposition: "", expected: false},
+		{name: "empty", mimeType: "", disposition: entity.ImageDispositionDownload, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShouldDownloadImage(tt.mimeType, tt.disposition); got != tt.expected {
+				t.Fatalf("ShouldDownloadImage(%q, %q) = %v, want %v", tt.mimeType, tt.disposition, got, tt.expected)
+			}
+		})
+	}
+}
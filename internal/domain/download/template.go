@@ -0,0 +1,160 @@
+package download
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultFilenameTemplate keeps the name suggested by the server.
+const DefaultFilenameTemplate = "{name}"
+
+// Filename template placeholders.
+const (
+	templateVarName = "name" // full filename, extension included
+	templateVarStem = "stem" // filename without extension
+	templateVarExt  = "ext"  // extension without the leading dot
+	templateVarHost = "host" // host the download was served from
+	templateVarDate = "date" // local date, 2006-01-02
+	templateVarTime = "time" // local time, 150405
+)
+
+// templateSeparators are dropped next to a placeholder that expands to
+// nothing, so "{host}-{name}" does not leave a leading "-" behind.
+const templateSeparators = "-_. "
+
+// illegalFilenameChars are replaced in generated names. Besides the path
+// separator they cover characters rejected by FAT and NTFS, so names stay
+// valid when downloading to removable drives.
+const illegalFilenameChars = `/\:*?"<>|`
+
+// TemplateVars holds the values substituted into a filename template.
+type TemplateVars struct {
+	// Name is the sanitized filename, extension included.
+	Name string
+	// Host is the host the download was served from; may be empty.
+	Host string
+	// Time is when the download started.
+	Time time.Time
+}
+
+type templatePart struct {
+	literal  string
+	variable string
+}
+
+// ValidateFilenameTemplate checks that tmpl only uses known placeholders and
+// has balanced braces.
+func ValidateFilenameTemplate(tmpl string) error {
+	if strings.TrimSpace(tmpl) == "" {
+		return fmt.Errorf("filename template must not be empty")
+	}
+	_, err := parseTemplate(tmpl)
+	return err
+}
+
+// ExpandFilenameTemplate builds a filename from tmpl. Supported placeholders
+// are {name}, {stem}, {ext}, {host}, {date} and {time}. Placeholders that
+// expand to nothing take one neighbouring separator with them. Illegal
+// filesystem characters are replaced, and the extension of vars.Name is
+// appended when the result would otherwise lose it. An invalid template or
+// an empty result falls back to the sanitized name.
+func ExpandFilenameTemplate(tmpl string, vars TemplateVars) string {
+	name := ReplaceIllegalFilenameChars(vars.Name)
+	if name == "" {
+		name = DefaultFilename
+	}
+	parts, err := parseTemplate(tmpl)
+	if err != nil || len(parts) == 0 {
+		return name
+	}
+
+	ext := filepath.Ext(name)
+	values := map[string]string{
+		templateVarName: name,
+		templateVarStem: strings.TrimSuffix(name, ext),
+		templateVarExt:  strings.TrimPrefix(ext, "."),
+		templateVarHost: vars.Host,
+		templateVarDate: vars.Time.Format("2006-01-02"),
+		templateVarTime: vars.Time.Format("150405"),
+	}
+	if vars.Time.IsZero() {
+		values[templateVarDate] = ""
+		values[templateVarTime] = ""
+	}
+
+	var b strings.Builder
+	dropNextSeparator := false
+	for _, part := range parts {
+		if part.variable == "" {
+			literal := part.literal
+			if dropNextSeparator && literal != "" && strings.ContainsRune(templateSeparators, rune(literal[0])) {
+				literal = literal[1:]
+			}
+			dropNextSeparator = false
+			b.WriteString(literal)
+			continue
+		}
+		value := values[part.variable]
+		if value == "" {
+			dropNextSeparator = true
+			continue
+		}
+		dropNextSeparator = false
+		b.WriteString(value)
+	}
+
+	result := strings.TrimRight(b.String(), templateSeparators)
+	result = strings.TrimLeft(result, " ")
+	result = ReplaceIllegalFilenameChars(result)
+	if result == "" || result == "." || result == ".." {
+		return name
+	}
+	if ext != "" && !strings.HasSuffix(result, ext) {
+		result += ext
+	}
+	return result
+}
+
+// ReplaceIllegalFilenameChars replaces path separators, characters rejected
+// by common filesystems and control characters with "_".
+func ReplaceIllegalFilenameChars(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(illegalFilenameChars, r) {
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+func parseTemplate(tmpl string) ([]templatePart, error) {
+	var parts []templatePart
+	rest := tmpl
+	for rest != "" {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			parts = append(parts, templatePart{literal: rest})
+			break
+		}
+		if rest[open] == '}' {
+			return nil, fmt.Errorf("unexpected '}' in filename template %q", tmpl)
+		}
+		if open > 0 {
+			parts = append(parts, templatePart{literal: rest[:open]})
+		}
+		closing := strings.IndexByte(rest[open:], '}')
+		if closing < 0 {
+			return nil, fmt.Errorf("unclosed '{' in filename template %q", tmpl)
+		}
+		variable := rest[open+1 : open+closing]
+		switch variable {
+		case templateVarName, templateVarStem, templateVarExt, templateVarHost, templateVarDate, templateVarTime:
+		default:
+			return nil, fmt.Errorf("unknown placeholder {%s} in filename template (use {name}, {stem}, {ext}, {host}, {date} or {time})", variable)
+		}
+		parts = append(parts, templatePart{variable: variable})
+		rest = rest[open+closing+1:]
+	}
+	return parts, nil
+}
@@ -0,0 +1,105 @@
+package download
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandFilenameTemplate(t *testing.T) {
+	started := time.Date(2024, time.March, 9, 14, 5, 7, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		template string
+		vars     TemplateVars
+		expected string
+	}{
+		{
+			name:     "default template keeps name",
+			template: DefaultFilenameTemplate,
+			vars:     TemplateVars{Name: "report.pdf", Host: "example.com", Time: started},
+			expected: "report.pdf",
+		},
+		{
+			name:     "date host name",
+			template: "{date}-{host}-{name}",
+			vars:     TemplateVars{Name: "report.pdf", Host: "example.com", Time: started},
+			expected: "2024-03-09-example.com-report.pdf",
+		},
+		{
+			name:     "stem time ext",
+			template: "{stem}_{time}.{ext}",
+			vars:     TemplateVars{Name: "photo.jpg", Time: started},
+			expected: "photo_140507.jpg",
+		},
+		{
+			name:     "empty host drops its separator",
+			template: "{date}-{host}-{name}",
+			vars:     TemplateVars{Name: "report.pdf", Time: started},
+			expected: "2024-03-09-report.pdf",
+		},
+		{
+			name:     "leading empty placeholder",
+			template: "{host}-{name}",
+			vars:     TemplateVars{Name: "report.pdf"},
+			expected: "report.pdf",
+		},
+		{
+			name:     "extension appended when template omits it",
+			template: "{host}-{date}",
+			vars:     TemplateVars{Name: "archive.tar.gz", Host: "example.com", Time: started},
+			expected: "example.com-2024-03-09.gz",
+		},
+		{
+			name:     "illegal characters in literal and host",
+			template: "dl:{host}/{name}",
+			vars:     TemplateVars{Name: "a.txt", Host: "[::1]"},
+			expected: "dl_[__1]_a.txt",
+		},
+		{
+			name:     "invalid template falls back to name",
+			template: "{nope}-{name}",
+			vars:     TemplateVars{Name: "a.txt"},
+			expected: "a.txt",
+		},
+		{
+			name:     "empty result falls back to name",
+			template: "{host}",
+			vars:     TemplateVars{Name: "notes"},
+			expected: "notes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExpandFilenameTemplate(tt.template, tt.vars))
+		})
+	}
+}
+
+func TestReplaceIllegalFilenameChars(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "plain name.pdf", expected: "plain name.pdf"},
+		{input: `a/b\c:d*e?f"g<h>i|j.txt`, expected: "a_b_c_d_e_f_g_h_i_j.txt"},
+		{input: "tab\tnew\nline.txt", expected: "tab_new_line.txt"},
+		{input: "café.txt", expected: "café.txt"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, ReplaceIllegalFilenameChars(tt.input))
+	}
+}
+
+func TestValidateFilenameTemplate(t *testing.T) {
+	for _, tmpl := range []string{"{name}", "{date}-{host}-{name}", "{stem} ({time}).{ext}", "static.bin"} {
+		assert.NoError(t, ValidateFilenameTemplate(tmpl), tmpl)
+	}
+	for _, tmpl := range []string{"", "  ", "{unknown}", "{name", "name}", "{}"} {
+		assert.Error(t, ValidateFilenameTemplate(tmpl), tmpl)
+	}
+}
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// CertificatePin binds a host to the SHA-256 hash of the public key
+// (SubjectPublicKeyInfo) its certificate must present, in the style of HPKP
+// "pin-sha256". Pinning the key rather than the whole certificate keeps the
+// pin valid across renewals that reuse the key.
+type CertificatePin struct {
+	Host       string // Normalized host name (lowercase, no port)
+	SPKISHA256 string // Base64 (standard encoding) SHA-256 of the SubjectPublicKeyInfo
+	CreatedAt  int64  // Unix timestamp in seconds when the pin was recorded
+}
+
+// CertificatePinDecision is the outcome of checking a presented certificate
+// against the stored pin for its host.
+type CertificatePinDecision int
+
+const (
+	// CertificatePinNone means the host has no pin; normal TLS rules apply.
+	CertificatePinNone CertificatePinDecision = iota
+	// CertificatePinMatch means the presented key matches the pin.
+	CertificatePinMatch
+	// CertificatePinMismatch means the host is pinned and the presented key
+	// differs or could not be read. The load must fail without a prompt.
+	CertificatePinMismatch
+)
+
+// String returns a human-readable representation of the decision.
+func (d CertificatePinDecision) String() string {
+	switch d {
+	case CertificatePinNone:
+		return "none"
+	case CertificatePinMatch:
+		return "match"
+	case CertificatePinMismatch:
+		return "mismatch"
+	default:
+		return "unknown"
+	}
+}
+
+// EvaluateCertificatePin compares a presented SPKI hash with pin.
+// A nil pin yields CertificatePinNone. An empty presented hash on a pinned
+// host is a mismatch: a missing certificate must never bypass the pin.
+func EvaluateCertificatePin(pin *CertificatePin, presentedSPKISHA256 string) CertificatePinDecision {
+	if pin == nil || pin.SPKISHA256 == "" {
+		return CertificatePinNone
+	}
+	if presentedSPKISHA256 != "" && presentedSPKISHA256 == pin.SPKISHA256 {
+		return CertificatePinMatch
+	}
+	return CertificatePinMismatch
+}
+
+// NormalizeCertificatePinHost extracts the host a pin applies to from a bare
+// host, host:port, or https URL. The result is lowercase without port or
+// trailing dot.
+func NormalizeCertificatePinHost(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", errors.New("host is empty")
+	}
+
+	host := raw
+	if strings.Contains(raw, "://") {
+		parsed, err := url.Parse(raw)
+		if err != nil {
+			return "", err
+		}
+		host = parsed.Host
+	} else if i := strings.IndexAny(host, "/?#"); i >= 0 {
+		host = host[:i]
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.Trim(strings.ToLower(host), "[]"), ".")
+	if host == "" || strings.ContainsAny(host, " \t@") {
+		return "", errors.New("invalid host: " + raw)
+	}
+	return host, nil
+}
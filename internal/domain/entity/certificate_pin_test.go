@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateCertificatePin(t *testing.T) {
+	pin := &CertificatePin{Host: "example.com", SPKISHA256: "abc="}
+
+	assert.Equal(t, CertificatePinNone, EvaluateCertificatePin(nil, "abc="))
+	assert.Equal(t, CertificatePinNone, EvaluateCertificatePin(&CertificatePin{Host: "example.com"}, "abc="))
+	assert.Equal(t, CertificatePinMatch, EvaluateCertificatePin(pin, "abc="))
+	assert.Equal(t, CertificatePinMismatch, EvaluateCertificatePin(pin, "xyz="))
+	assert.Equal(t, CertificatePinMismatch, EvaluateCertificatePin(pin, ""), "missing certificate must not bypass the pin")
+}
+
+func TestNormalizeCertificatePinHost(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"example.com", "example.com"},
+		{"Example.COM.", "example.com"},
+		{"example.com:8443", "example.com"},
+		{"https://Sub.Example.com/path?q=1", "sub.example.com"},
+		{"https://example.com:443/", "example.com"},
+		{"example.com/path", "example.com"},
+		{"[::1]:443", "::1"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeCertificatePinHost(tt.in)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+
+	for _, bad := range []string{"", "  ", "https://", "user@host"} {
+		_, err := NormalizeCertificatePinHost(bad)
+		assert.Error(t, err, bad)
+	}
+}
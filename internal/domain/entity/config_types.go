@@ -15,8 +15,106 @@ type AppearanceConfig struct {
 	DarkPalette     ColorPalette        `mapstructure:"dark_palette" yaml:"dark_palette" toml:"dark_palette" json:"dark_palette"`
 	ColorScheme     string              `mapstructure:"color_scheme" yaml:"color_scheme" toml:"color_scheme" json:"color_scheme"`
 	ExternalTheme   ExternalThemeConfig `mapstructure:"external_theme" yaml:"external_theme" toml:"external_theme" json:"external_theme"`
+	FaviconFallback FaviconFallback     `mapstructure:"favicon_fallback" yaml:"favicon_fallback" toml:"favicon_fallback" json:"favicon_fallback"` //nolint:lll // struct tags must stay on one line
+
+	// ForceDarkDomains lists domain patterns whose pages get a dark-mode
+	// stylesheet even when they ignore the system color scheme.
+	ForceDarkDomains []string `mapstructure:"force_dark_domains" yaml:"force_dark_domains" toml:"force_dark_domains" json:"force_dark_domains"` //nolint:lll // struct tags must stay on one line
+	// ForceDarkStyle selects the stylesheet injected on forced-dark pages.
+	ForceDarkStyle ForceDarkStyle `mapstructure:"force_dark_style" yaml:"force_dark_style" toml:"force_dark_style" json:"force_dark_style"` //nolint:lll // struct tags must stay on one line
+	// WorkspaceBackground styles the empty areas of the workspace, around
+	// and between the panes.
+	WorkspaceBackground WorkspaceBackgroundConfig `mapstructure:"workspace_background" yaml:"workspace_background" toml:"workspace_background" json:"workspace_background"` //nolint:lll // struct tags must stay on one line
+	// ReadingProgress draws a thin bar at the top of each pane showing how
+	// far its page is scrolled.
+	ReadingProgress bool `mapstructure:"reading_progress" yaml:"reading_progress" toml:"reading_progress" json:"reading_progress"` //nolint:lll // struct tags must stay on one line
+	// FontOverrides replaces the fonts above on matching sites; the most
+	// specific domain wins.
+	FontOverrides []FontOverride `mapstructure:"font_overrides" yaml:"font_overrides" toml:"font_overrides" json:"font_overrides"` //nolint:lll // struct tags must stay on one line
 }
 
+// FontOverride sets the fonts of the pages of a domain pattern. Domain
+// accepts exact hosts ("docs.rs") or globs ("*.example.com"). Empty families
+// and a zero size keep the appearance defaults.
+type FontOverride struct {
+	Domain          string `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	SansFont        string `mapstructure:"sans" yaml:"sans" toml:"sans" json:"sans"`
+	SerifFont       string `mapstructure:"serif" yaml:"serif" toml:"serif" json:"serif"`
+	MonospaceFont   string `mapstructure:"mono" yaml:"mono" toml:"mono" json:"mono"`
+	DefaultFontSize int    `mapstructure:"size" yaml:"size" toml:"size" json:"size"`
+}
+
+// KeepsDefaults reports whether o changes no font.
+func (o FontOverride) KeepsDefaults() bool {
+	return o.SansFont == "" && o.SerifFont == "" && o.MonospaceFont == "" && o.DefaultFontSize == 0
+}
+
+// WorkspaceBackgroundConfig is the background of the workspace container,
+// seen in the gutters between split panes and wherever no pane is drawn.
+// The zero value keeps the theme background.
+type WorkspaceBackgroundConfig struct {
+	// Color is a #RRGGBB color; empty keeps the theme background.
+	Color string `mapstructure:"color" yaml:"color" toml:"color" json:"color"`
+	// Image is an absolute or "~/" path of an image drawn over Color,
+	// scaled to cover the workspace.
+	Image string `mapstructure:"image" yaml:"image" toml:"image" json:"image"`
+	// Pattern is drawn over Color and Image.
+	Pattern WorkspaceBackgroundPattern `mapstructure:"pattern" yaml:"pattern" toml:"pattern" json:"pattern"`
+}
+
+// IsZero reports whether b leaves the workspace background unstyled.
+func (b WorkspaceBackgroundConfig) IsZero() bool {
+	return b.Color == "" && b.Image == "" && (b.Pattern == "" || b.Pattern == WorkspaceBackgroundPatternNone)
+}
+
+// WorkspaceBackgroundPattern is a subtle pattern drawn over the workspace
+// background.
+type WorkspaceBackgroundPattern string
+
+const (
+	// WorkspaceBackgroundPatternNone draws no pattern.
+	WorkspaceBackgroundPatternNone WorkspaceBackgroundPattern = "none"
+	// WorkspaceBackgroundPatternDots draws a grid of small dots.
+	WorkspaceBackgroundPatternDots WorkspaceBackgroundPattern = "dots"
+	// WorkspaceBackgroundPatternGrid draws thin grid lines.
+	WorkspaceBackgroundPatternGrid WorkspaceBackgroundPattern = "grid"
+	// WorkspaceBackgroundPatternStripes draws diagonal stripes.
+	WorkspaceBackgroundPatternStripes WorkspaceBackgroundPattern = "stripes"
+)
+
+// IsValid reports whether p is a known workspace background pattern.
+func (p WorkspaceBackgroundPattern) IsValid() bool {
+	switch p {
+	case WorkspaceBackgroundPatternNone, WorkspaceBackgroundPatternDots,
+		WorkspaceBackgroundPatternGrid, WorkspaceBackgroundPatternStripes:
+		return true
+	default:
+		return false
+	}
+}
+
+// ForceDarkStyle selects how a page is darkened when dark mode is forced.
+type ForceDarkStyle string
+
+const (
+	// ForceDarkStyleInvert inverts the page colors and re-inverts media, so
+	// images and video keep their look. Works on any page.
+	ForceDarkStyleInvert ForceDarkStyle = "invert"
+	// ForceDarkStyleCSS applies a curated dark stylesheet and reports a dark
+	// color scheme to the page.
+	ForceDarkStyleCSS ForceDarkStyle = "css"
+)
+
+// FaviconFallback controls what is shown for sites without a favicon.
+type FaviconFallback string
+
+const (
+	// FaviconFallbackMonogram shows a letter on a color derived from the host.
+	FaviconFallbackMonogram FaviconFallback = "monogram"
+	// FaviconFallbackIcon shows the generic globe icon.
+	FaviconFallbackIcon FaviconFallback = "icon"
+)
+
 // ExternalThemeConfig controls optional external theme loading.
 type ExternalThemeConfig struct {
 	Enabled  bool   `mapstructure:"enabled" yaml:"enabled" toml:"enabled" json:"enabled"`
@@ -92,6 +190,21 @@ func (r *ResizeModeConfig) GetKeyBindings() map[string]string {
 	return keyBindingsFromActions(r.Actions)
 }
 
+// LeaderConfig holds leader key configuration. The activation shortcut starts
+// a sequence and the next key picks an action. An empty activation shortcut
+// disables the leader key.
+type LeaderConfig struct {
+	ActivationShortcut  string                   `mapstructure:"activation_shortcut" yaml:"activation_shortcut" toml:"activation_shortcut" json:"activation_shortcut"` //nolint:lll // struct tags must stay on one line
+	TimeoutMilliseconds int                      `mapstructure:"timeout_ms" yaml:"timeout_ms" toml:"timeout_ms" json:"timeout_ms"`
+	ShowHints           bool                     `mapstructure:"show_hints" yaml:"show_hints" toml:"show_hints" json:"show_hints"`
+	Actions             map[string]ActionBinding `mapstructure:"actions" yaml:"actions" toml:"actions" json:"actions"`
+}
+
+// GetKeyBindings returns a map from key string to action name.
+func (l *LeaderConfig) GetKeyBindings() map[string]string {
+	return keyBindingsFromActions(l.Actions)
+}
+
 // SessionModeConfig holds session mode shortcut configuration.
 type SessionModeConfig struct {
 	ActivationShortcut  string                   `mapstructure:"activation_shortcut" yaml:"activation_shortcut" toml:"activation_shortcut" json:"activation_shortcut"` //nolint:lll // struct tags must stay on one line
@@ -108,6 +221,16 @@ func (s *SessionModeConfig) GetKeyBindings() map[string]string {
 type SessionConfig struct {
 	AutoRestore bool `mapstructure:"auto_restore" yaml:"auto_restore" toml:"auto_restore" json:"auto_restore"`
 
+	RestoreMode SessionRestoreMode `mapstructure:"restore_mode" yaml:"restore_mode" toml:"restore_mode" json:"restore_mode"`
+
+	// RestoreStrategy decides where a session opened from the session
+	// manager goes: its own instance, or the current window's tabs.
+	RestoreStrategy SessionRestoreStrategy `mapstructure:"restore_strategy" yaml:"restore_strategy" toml:"restore_strategy" json:"restore_strategy"` //nolint:lll // struct tags must stay on one line
+
+	// LazyRestore keeps the background tabs of a restored session unloaded
+	// until they are first shown.
+	LazyRestore bool `mapstructure:"lazy_restore" yaml:"lazy_restore" toml:"lazy_restore" json:"lazy_restore"`
+
 	SnapshotIntervalMs int `mapstructure:"snapshot_interval_ms" yaml:"snapshot_interval_ms" toml:"snapshot_interval_ms" json:"snapshot_interval_ms"` //nolint:lll // struct tags must stay on one line
 
 	MaxExitedSessions int `mapstructure:"max_exited_sessions" yaml:"max_exited_sessions" toml:"max_exited_sessions" json:"max_exited_sessions"` //nolint:lll // struct tags must stay on one line
@@ -167,6 +290,52 @@ const (
 	PopupBehaviorWindowed PopupBehavior = "windowed"
 )
 
+// SessionRestoreMode controls which tabs auto-restore brings back.
+type SessionRestoreMode string
+
+const (
+	// SessionRestoreAll restores every tab of the last session.
+	SessionRestoreAll SessionRestoreMode = "all"
+	// SessionRestorePinned restores only the pinned tabs of the last session.
+	SessionRestorePinned SessionRestoreMode = "pinned"
+)
+
+// SessionRestoreStrategy controls where a session opened from the session
+// manager is restored.
+type SessionRestoreStrategy string
+
+const (
+	// SessionRestoreReplace opens the session in its own browser instance,
+	// with its tabs in place of the default ones.
+	SessionRestoreReplace SessionRestoreStrategy = "replace"
+	// SessionRestoreMerge appends the session's tabs to the current window.
+	SessionRestoreMerge SessionRestoreStrategy = "merge"
+)
+
+// StackSwipeMode controls which vertical swipes cycle the panes of a stack.
+type StackSwipeMode string
+
+const (
+	// StackSwipeTitleBar cycles the stack when the swipe starts over a title bar.
+	StackSwipeTitleBar StackSwipeMode = "title_bar"
+	// StackSwipeAlt cycles the stack on any swipe while Alt is held.
+	StackSwipeAlt StackSwipeMode = "alt"
+	// StackSwipeOff disables swipe navigation in stacks.
+	StackSwipeOff StackSwipeMode = "off"
+)
+
+// TabBarMode controls when the tab strip is shown.
+type TabBarMode string
+
+const (
+	// TabBarModeBar shows the tab strip, subject to HideTabBarWhenSingleTab.
+	TabBarModeBar TabBarMode = "bar"
+	// TabBarModeHidden never shows the tab strip.
+	TabBarModeHidden TabBarMode = "hidden"
+	// TabBarModeOverview shows the tab strip only while tab mode is active.
+	TabBarModeOverview TabBarMode = "overview"
+)
+
 // OmniboxInitialBehavior controls what the omnibox shows for empty input.
 type OmniboxInitialBehavior string
 
@@ -176,6 +345,216 @@ const (
 	OmniboxInitialBehaviorNone        OmniboxInitialBehavior = "none"
 )
 
+// OmniboxMatchMode controls how omnibox suggestions are matched against the
+// typed query.
+type OmniboxMatchMode string
+
+const (
+	// OmniboxMatchModeSubstring matches entries containing the query.
+	OmniboxMatchModeSubstring OmniboxMatchMode = "substring"
+	// OmniboxMatchModeFuzzy matches entries containing the query's characters
+	// in order, ranking closer runs higher.
+	OmniboxMatchModeFuzzy OmniboxMatchMode = "fuzzy"
+	// OmniboxMatchModePrefix matches entries starting with the query.
+	OmniboxMatchModePrefix OmniboxMatchMode = "prefix"
+)
+
+// AutoplayPolicy controls whether pages may start media playback on their own.
+type AutoplayPolicy string
+
+const (
+	// AutoplayPolicyAllow lets pages autoplay audible and muted media.
+	AutoplayPolicyAllow AutoplayPolicy = "allow"
+	// AutoplayPolicyBlock pauses all media that starts without a user gesture.
+	AutoplayPolicyBlock AutoplayPolicy = "block"
+	// AutoplayPolicyBlockAudio only lets muted media autoplay.
+	AutoplayPolicyBlockAudio AutoplayPolicy = "block-audio"
+	// AutoplayPolicyMutedVideo lets video autoplay muted and pauses audio
+	// elements that start without a user gesture.
+	AutoplayPolicyMutedVideo AutoplayPolicy = "muted-video"
+	// AutoplayPolicyBlockVideo lets audio elements autoplay and pauses video
+	// that starts without a user gesture.
+	AutoplayPolicyBlockVideo AutoplayPolicy = "block-video"
+)
+
+// IsValid reports whether p is a known autoplay policy.
+func (p AutoplayPolicy) IsValid() bool {
+	switch p {
+	case AutoplayPolicyAllow, AutoplayPolicyBlock, AutoplayPolicyBlockAudio,
+		AutoplayPolicyMutedVideo, AutoplayPolicyBlockVideo:
+		return true
+	default:
+		return false
+	}
+}
+
+// ClipboardReadPolicy decides how a page's request to read the clipboard is
+// handled.
+type ClipboardReadPolicy string
+
+const (
+	// ClipboardReadAllow lets pages read the clipboard unless the origin was
+	// denied before.
+	ClipboardReadAllow ClipboardReadPolicy = "allow"
+	// ClipboardReadDeny blocks every clipboard read.
+	ClipboardReadDeny ClipboardReadPolicy = "deny"
+	// ClipboardReadPrompt asks once per request, or uses the decision
+	// remembered for the origin.
+	ClipboardReadPrompt ClipboardReadPolicy = "prompt"
+)
+
+// IsValid reports whether p is a known clipboard read policy.
+func (p ClipboardReadPolicy) IsValid() bool {
+	switch p {
+	case ClipboardReadAllow, ClipboardReadDeny, ClipboardReadPrompt:
+		return true
+	default:
+		return false
+	}
+}
+
+// HTTPSOnlyMode controls whether http:// pages are upgraded to https://.
+type HTTPSOnlyMode string
+
+const (
+	// HTTPSOnlyOff loads http:// pages as they are.
+	HTTPSOnlyOff HTTPSOnlyMode = "off"
+	// HTTPSOnlyLenient upgrades http:// pages and falls back to http:// for a
+	// host whose HTTPS load failed, remembering it for the session.
+	HTTPSOnlyLenient HTTPSOnlyMode = "lenient"
+	// HTTPSOnlyStrict upgrades http:// pages and never falls back.
+	HTTPSOnlyStrict HTTPSOnlyMode = "strict"
+)
+
+// IsValid reports whether m is a known HTTPS-only mode.
+func (m HTTPSOnlyMode) IsValid() bool {
+	switch m {
+	case HTTPSOnlyOff, HTTPSOnlyLenient, HTTPSOnlyStrict:
+		return true
+	default:
+		return false
+	}
+}
+
+// ImageDisposition decides whether an image opened as a page is shown or
+// downloaded.
+type ImageDisposition string
+
+const (
+	// ImageDispositionInline shows the image in the pane.
+	ImageDispositionInline ImageDisposition = "inline"
+	// ImageDispositionDownload saves the image to the downloads directory.
+	ImageDispositionDownload ImageDisposition = "download"
+)
+
+// IsValid reports whether d is a known image disposition.
+func (d ImageDisposition) IsValid() bool {
+	switch d {
+	case ImageDispositionInline, ImageDispositionDownload:
+		return true
+	default:
+		return false
+	}
+}
+
+// AutoplayException overrides the autoplay policy for a domain pattern.
+// Domain accepts exact hosts ("youtube.com") or globs ("*.example.com").
+type AutoplayException struct {
+	Domain string         `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	Policy AutoplayPolicy `mapstructure:"policy" yaml:"policy" toml:"policy" json:"policy"`
+}
+
+// CookiePolicy controls which cookies the network session accepts.
+type CookiePolicy string
+
+const (
+	// CookiePolicyAlways accepts all cookies.
+	CookiePolicyAlways CookiePolicy = "always"
+	// CookiePolicyNoThirdParty blocks third-party cookies.
+	CookiePolicyNoThirdParty CookiePolicy = "no_third_party"
+	// CookiePolicyNever blocks all cookies.
+	CookiePolicyNever CookiePolicy = "never"
+)
+
+// IsValid reports whether p is a known cookie policy.
+func (p CookiePolicy) IsValid() bool {
+	switch p {
+	case CookiePolicyAlways, CookiePolicyNoThirdParty, CookiePolicyNever:
+		return true
+	default:
+		return false
+	}
+}
+
+// CookiePolicyOverride overrides the cookie policy for a domain pattern.
+// Domain accepts exact hosts ("example.com") or globs ("*.example.com").
+type CookiePolicyOverride struct {
+	Domain string       `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	Policy CookiePolicy `mapstructure:"policy" yaml:"policy" toml:"policy" json:"policy"`
+}
+
+// PageEnvRule exposes the allow-listed page_env values named by Keys to
+// pages on a domain pattern. Domain accepts exact hosts ("tools.example")
+// or globs ("*.corp.example").
+type PageEnvRule struct {
+	Domain string   `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	Keys   []string `mapstructure:"keys" yaml:"keys" toml:"keys" json:"keys"`
+}
+
+// ZoomDomainOverride overrides the zoom step factor for a domain pattern.
+// Domain accepts exact hosts ("github.com") or globs ("*.example.com").
+type ZoomDomainOverride struct {
+	Domain     string  `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	StepFactor float64 `mapstructure:"step_factor" yaml:"step_factor" toml:"step_factor" json:"step_factor"`
+}
+
+// RequestThrottleRule limits how fast requests to a domain pattern are sent.
+// Domain accepts exact hosts ("api.example.com") or globs ("*.example.com").
+// Rate is in requests per second; Burst is how many requests may go out
+// back to back before Rate applies.
+type RequestThrottleRule struct {
+	Domain string  `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	Rate   float64 `mapstructure:"rate" yaml:"rate" toml:"rate" json:"rate"`
+	Burst  int     `mapstructure:"burst" yaml:"burst" toml:"burst" json:"burst"`
+}
+
+// ZoomPreset sets the zoom level pages of a domain pattern open at until the
+// user zooms them. Domain accepts exact hosts ("github.com") or globs
+// ("*.example.com").
+type ZoomPreset struct {
+	Domain string  `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	Factor float64 `mapstructure:"factor" yaml:"factor" toml:"factor" json:"factor"`
+}
+
+// HomepageWidget names a widget shown on the dumb://homepage dashboard.
+type HomepageWidget string
+
+const (
+	// HomepageWidgetSearch renders a search box using the default search engine.
+	HomepageWidgetSearch HomepageWidget = "search"
+	// HomepageWidgetRecentHistory lists the most recently visited pages.
+	HomepageWidgetRecentHistory HomepageWidget = "recent_history"
+	// HomepageWidgetTopFavorites lists favorites, shortcut-bound ones first.
+	HomepageWidgetTopFavorites HomepageWidget = "top_favorites"
+)
+
+// IsValid reports whether w is a known homepage widget.
+func (w HomepageWidget) IsValid() bool {
+	switch w {
+	case HomepageWidgetSearch, HomepageWidgetRecentHistory, HomepageWidgetTopFavorites:
+		return true
+	default:
+		return false
+	}
+}
+
+// HomepageConfig controls which widgets the homepage dashboard shows and in which order.
+type HomepageConfig struct {
+	Widgets            []HomepageWidget `mapstructure:"widgets" yaml:"widgets" toml:"widgets" json:"widgets"`
+	RecentHistoryLimit int              `mapstructure:"recent_history_limit" yaml:"recent_history_limit" toml:"recent_history_limit" json:"recent_history_limit"` //nolint:lll // struct tags must stay on one line
+	TopFavoritesLimit  int              `mapstructure:"top_favorites_limit" yaml:"top_favorites_limit" toml:"top_favorites_limit" json:"top_favorites_limit"`     //nolint:lll // struct tags must stay on one line
+}
+
 // BrowsingContextConfig controls how browsing contexts (popups, tabs, new windows) are handled.
 // This is the canonical config type; PopupBehaviorConfig is a compatibility alias.
 type BrowsingContextConfig struct {
@@ -189,9 +568,36 @@ type BrowsingContextConfig struct {
 
 	BlankTargetBehavior string `mapstructure:"blank_target_behavior" yaml:"blank_target_behavior" toml:"blank_target_behavior" json:"blank_target_behavior"` //nolint:lll // struct tags must stay on one line
 
+	// DefaultForLinks, when set, places every non-OAuth browsing context
+	// (_blank links, middle clicks, script popups) ahead of
+	// BlankTargetBehavior and Behavior. OAuth flows keep those two.
+	DefaultForLinks PopupBehavior `mapstructure:"default_for_links" yaml:"default_for_links" toml:"default_for_links" json:"default_for_links"` //nolint:lll // struct tags must stay on one line
+
 	EnableSmartDetection bool `mapstructure:"enable_smart_detection" yaml:"enable_smart_detection" toml:"enable_smart_detection" json:"enable_smart_detection"` //nolint:lll // struct tags must stay on one line
 
 	OAuthAutoClose bool `mapstructure:"oauth_auto_close" yaml:"oauth_auto_close" toml:"oauth_auto_close" json:"oauth_auto_close"`
+
+	// PopupBlockDomains lists opener domains whose script-opened windows are
+	// suppressed. Links the user opens in a new page are still honored.
+	PopupBlockDomains []string `mapstructure:"popup_block_domains" yaml:"popup_block_domains" toml:"popup_block_domains" json:"popup_block_domains"` //nolint:lll // struct tags must stay on one line
+
+	// PopupAllowDomains lists opener domains whose windows are always allowed,
+	// even when a PopupBlockDomains pattern also matches.
+	PopupAllowDomains []string `mapstructure:"popup_allow_domains" yaml:"popup_allow_domains" toml:"popup_allow_domains" json:"popup_allow_domains"` //nolint:lll // struct tags must stay on one line
+
+	// NotifyBlockedPopups shows a toast when a popup is suppressed.
+	NotifyBlockedPopups bool `mapstructure:"notify_blocked_popups" yaml:"notify_blocked_popups" toml:"notify_blocked_popups" json:"notify_blocked_popups"` //nolint:lll // struct tags must stay on one line
+
+	// DomainPlacement sets the split direction used instead of Placement for
+	// popups opening matching domains; the most specific domain wins.
+	DomainPlacement []PopupPlacementRule `mapstructure:"domain_placement" yaml:"domain_placement" toml:"domain_placement" json:"domain_placement"` //nolint:lll // struct tags must stay on one line
+}
+
+// PopupPlacementRule places the popups opening a domain pattern. Domain
+// accepts exact hosts ("accounts.google.com") or globs ("*.okta.com").
+type PopupPlacementRule struct {
+	Domain    string `mapstructure:"domain" yaml:"domain" toml:"domain" json:"domain"`
+	Placement string `mapstructure:"placement" yaml:"placement" toml:"placement" json:"placement"`
 }
 
 // Deprecated: PopupBehaviorConfig is a compatibility alias for BrowsingContextConfig.
@@ -203,12 +609,46 @@ type WorkspaceConfig struct {
 	PaneMode     PaneModeConfig        `mapstructure:"pane_mode" yaml:"pane_mode" toml:"pane_mode" json:"pane_mode"`
 	TabMode      TabModeConfig         `mapstructure:"tab_mode" yaml:"tab_mode" toml:"tab_mode" json:"tab_mode"`
 	ResizeMode   ResizeModeConfig      `mapstructure:"resize_mode" yaml:"resize_mode" toml:"resize_mode" json:"resize_mode"`
+	Leader       LeaderConfig          `mapstructure:"leader" yaml:"leader" toml:"leader" json:"leader"`
 	Shortcuts    GlobalShortcutsConfig `mapstructure:"shortcuts" yaml:"shortcuts" toml:"shortcuts" json:"shortcuts"`
 	FloatingPane FloatingPaneConfig    `mapstructure:"floating_pane" yaml:"floating_pane" toml:"floating_pane" json:"floating_pane"`
 
-	TabBarPosition          string `mapstructure:"tab_bar_position" yaml:"tab_bar_position" toml:"tab_bar_position" json:"tab_bar_position"`
-	HideTabBarWhenSingleTab bool   `mapstructure:"hide_tab_bar_when_single_tab" yaml:"hide_tab_bar_when_single_tab" toml:"hide_tab_bar_when_single_tab" json:"hide_tab_bar_when_single_tab"` //nolint:lll // struct tags must stay on one line
-	SwitchToTabOnMove       bool   `mapstructure:"switch_to_tab_on_move" yaml:"switch_to_tab_on_move" toml:"switch_to_tab_on_move" json:"switch_to_tab_on_move"`                             //nolint:lll // struct tags must stay on one line
+	TabBarPosition          string     `mapstructure:"tab_bar_position" yaml:"tab_bar_position" toml:"tab_bar_position" json:"tab_bar_position"`
+	TabBarMode              TabBarMode `mapstructure:"tab_bar_mode" yaml:"tab_bar_mode" toml:"tab_bar_mode" json:"tab_bar_mode"`
+	HideTabBarWhenSingleTab bool       `mapstructure:"hide_tab_bar_when_single_tab" yaml:"hide_tab_bar_when_single_tab" toml:"hide_tab_bar_when_single_tab" json:"hide_tab_bar_when_single_tab"` //nolint:lll // struct tags must stay on one line
+	SwitchToTabOnMove       bool       `mapstructure:"switch_to_tab_on_move" yaml:"switch_to_tab_on_move" toml:"switch_to_tab_on_move" json:"switch_to_tab_on_move"`                             //nolint:lll // struct tags must stay on one line
+
+	// SplitInheritsURL makes a split pane open the source pane's current URL
+	// instead of NewPaneURL.
+	SplitInheritsURL bool `mapstructure:"split_inherits_url" yaml:"split_inherits_url" toml:"split_inherits_url" json:"split_inherits_url"`
+
+	// AutoBalanceOnSplit resets every split of the tab to an even ratio
+	// after each split.
+	AutoBalanceOnSplit bool `mapstructure:"auto_balance_on_split" yaml:"auto_balance_on_split" toml:"auto_balance_on_split" json:"auto_balance_on_split"` //nolint:lll // struct tags must stay on one line
+
+	// StackSwipe selects when a vertical two-finger swipe over a stack cycles
+	// its panes.
+	StackSwipe StackSwipeMode `mapstructure:"stack_swipe" yaml:"stack_swipe" toml:"stack_swipe" json:"stack_swipe"`
+
+	// ReloadOnFocusDomains lists domain patterns (e.g. "grafana.example.com",
+	// "*.example.com") whose panes reload when they regain focus after being
+	// unfocused for ReloadOnFocusAfterSeconds.
+	ReloadOnFocusDomains      []string `mapstructure:"reload_on_focus_domains" yaml:"reload_on_focus_domains" toml:"reload_on_focus_domains" json:"reload_on_focus_domains"`                         //nolint:lll // struct tags must stay on one line
+	ReloadOnFocusAfterSeconds int      `mapstructure:"reload_on_focus_after_seconds" yaml:"reload_on_focus_after_seconds" toml:"reload_on_focus_after_seconds" json:"reload_on_focus_after_seconds"` //nolint:lll // struct tags must stay on one line
+
+	// FreezeBackgroundPanes pauses animation-frame work and CSS animations
+	// of every pane but the focused one, and lowers their frame rate where
+	// the engine allows. A pane thaws as soon as it gains focus.
+	FreezeBackgroundPanes bool `mapstructure:"freeze_background_panes" yaml:"freeze_background_panes" toml:"freeze_background_panes" json:"freeze_background_panes"` //nolint:lll // struct tags must stay on one line
+
+	// ReloadCrashedPanes reloads a pane once when its web process crashes,
+	// instead of showing the crash page. A page that crashes again soon after
+	// gets the crash page.
+	ReloadCrashedPanes bool `mapstructure:"reload_crashed_panes" yaml:"reload_crashed_panes" toml:"reload_crashed_panes" json:"reload_crashed_panes"` //nolint:lll // struct tags must stay on one line
+
+	// ClosedTabHistoryDepth caps how many closed tabs can be reopened.
+	// 0 disables the history.
+	ClosedTabHistoryDepth int `mapstructure:"closed_tab_history_depth" yaml:"closed_tab_history_depth" toml:"closed_tab_history_depth" json:"closed_tab_history_depth"` //nolint:lll // struct tags must stay on one line
 
 	// BrowsingContexts is the canonical field for browsing context behavior.
 	// It replaces the legacy popups configuration.
@@ -218,9 +658,39 @@ type WorkspaceConfig struct {
 	// It is not serialized or deserialized from config files.
 	Popups BrowsingContextConfig `mapstructure:"-" yaml:"-" toml:"-" json:"-"`
 
+	// OmniboxTriggerKey is a runtime mirror of omnibox.trigger_key, synced
+	// during normalization. Empty means the default ctrl+l.
+	OmniboxTriggerKey string `mapstructure:"-" yaml:"-" toml:"-" json:"-"`
+
+	// FocusTimer sets up the countdown the toggle_focus_timer shortcut starts
+	// on a pane.
+	FocusTimer FocusTimerConfig `mapstructure:"focus_timer" yaml:"focus_timer" toml:"focus_timer" json:"focus_timer"`
+
 	Styling WorkspaceStylingConfig `mapstructure:"styling" yaml:"styling" toml:"styling" json:"styling"`
 }
 
+// FocusTimerConfig holds the per-pane focus timer: how long a pane may be
+// browsed, how early to warn before the end, and what happens then.
+type FocusTimerConfig struct {
+	DurationMinutes int              `mapstructure:"duration_minutes" yaml:"duration_minutes" toml:"duration_minutes" json:"duration_minutes"`
+	WarningSeconds  int              `mapstructure:"warning_seconds" yaml:"warning_seconds" toml:"warning_seconds" json:"warning_seconds"`
+	Action          FocusTimerAction `mapstructure:"action" yaml:"action" toml:"action" json:"action"`
+	// NavigateURL is loaded in the pane when Action is FocusTimerNavigate.
+	NavigateURL string `mapstructure:"navigate_url" yaml:"navigate_url" toml:"navigate_url" json:"navigate_url"`
+}
+
+// FocusTimerAction is what happens to a pane when its focus timer runs out.
+type FocusTimerAction string
+
+const (
+	// FocusTimerWarn only tells that the time is up.
+	FocusTimerWarn FocusTimerAction = "warn"
+	// FocusTimerClose closes the pane.
+	FocusTimerClose FocusTimerAction = "close"
+	// FocusTimerNavigate loads FocusTimerConfig.NavigateURL in the pane.
+	FocusTimerNavigate FocusTimerAction = "navigate"
+)
+
 // UpdateConfig holds auto-update behavior settings.
 type UpdateConfig struct {
 	EnableOnStartup     bool `mapstructure:"enable_on_startup" yaml:"enable_on_startup" toml:"enable_on_startup"`
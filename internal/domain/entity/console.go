@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// ConsoleLevel is the severity of a captured page console message.
+type ConsoleLevel string
+
+const (
+	ConsoleLevelDebug ConsoleLevel = "debug"
+	ConsoleLevelInfo  ConsoleLevel = "info"
+	ConsoleLevelWarn  ConsoleLevel = "warn"
+	ConsoleLevelError ConsoleLevel = "error"
+)
+
+// ParseConsoleLevel maps a console method or severity name to a level.
+// console.log is reported as info and "warning" as warn.
+func ParseConsoleLevel(s string) (ConsoleLevel, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug", "trace", "verbose":
+		return ConsoleLevelDebug, true
+	case "log", "info":
+		return ConsoleLevelInfo, true
+	case "warn", "warning":
+		return ConsoleLevelWarn, true
+	case "error", "assert":
+		return ConsoleLevelError, true
+	default:
+		return "", false
+	}
+}
+
+// ConsoleMessage is one console call captured from a page.
+type ConsoleMessage struct {
+	Level     ConsoleLevel `json:"level"`
+	Message   string       `json:"message"`
+	URL       string       `json:"url,omitempty"`
+	Source    string       `json:"source,omitempty"`
+	Line      int          `json:"line,omitempty"`
+	Timestamp time.Time    `json:"timestamp"`
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// CrashHistory holds the times at which a crash of the previous run was
+// detected. A clean shutdown clears it.
+type CrashHistory struct {
+	Crashes []time.Time `json:"crashes"`
+}
+
+// Record adds a crash detected at t and drops crashes that fell out of window.
+func (h *CrashHistory) Record(t time.Time, window time.Duration) {
+	h.Crashes = append(h.Crashes, t)
+	h.Prune(t, window)
+}
+
+// Prune drops crashes older than window before now, keeping the rest sorted
+// oldest first.
+func (h *CrashHistory) Prune(now time.Time, window time.Duration) {
+	cutoff := now.Add(-window)
+	h.Crashes = slices.DeleteFunc(h.Crashes, func(t time.Time) bool {
+		return t.Before(cutoff)
+	})
+	slices.SortFunc(h.Crashes, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+}
+
+// InCrashLoop reports whether at least threshold crashes happened within
+// window before now. A threshold below 1 or a non-positive window disables
+// detection.
+func (h CrashHistory) InCrashLoop(now time.Time, threshold int, window time.Duration) bool {
+	if threshold < 1 || window <= 0 {
+		return false
+	}
+	cutoff := now.Add(-window)
+	count := 0
+	for _, t := range h.Crashes {
+		if !t.Before(cutoff) && !t.After(now) {
+			count++
+		}
+	}
+	return count >= threshold
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCrashHistoryInCrashLoop(t *testing.T) {
+	now := at(12, 0)
+	window := 10 * time.Minute
+
+	tests := []struct {
+		name      string
+		crashes   []time.Time
+		threshold int
+		window    time.Duration
+		want      bool
+	}{
+		{name: "no crashes", threshold: 3, window: window, want: false},
+		{name: "below threshold", crashes: []time.Time{at(11, 55), at(11, 58)}, threshold: 3, window: window, want: false},
+		{name: "reaches threshold", crashes: []time.Time{at(11, 52), at(11, 55), at(11, 58)}, threshold: 3, window: window, want: true},
+		{
+			name:      "window start is included",
+			crashes:   []time.Time{at(11, 50), at(11, 55), at(12, 0)},
+			threshold: 3, window: window, want: true,
+		},
+		{
+			name:      "crash outside window does not count",
+			crashes:   []time.Time{at(11, 49), at(11, 55), at(11, 58)},
+			threshold: 3, window: window, want: false,
+		},
+		{
+			name:      "future crash does not count",
+			crashes:   []time.Time{at(11, 55), at(11, 58), at(12, 5)},
+			threshold: 3, window: window, want: false,
+		},
+		{name: "zero threshold disables", crashes: []time.Time{at(11, 58)}, threshold: 0, window: window, want: false},
+		{name: "zero window disables", crashes: []time.Time{at(12, 0)}, threshold: 1, window: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := CrashHistory{Crashes: tt.crashes}
+			if got := h.InCrashLoop(now, tt.threshold, tt.window); got != tt.want {
+				t.Errorf("InCrashLoop() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrashHistoryRecordPrunesOldCrashes(t *testing.T) {
+	window := 10 * time.Minute
+	h := CrashHistory{Crashes: []time.Time{at(11, 58), at(11, 40), at(11, 52)}}
+
+	h.Record(at(12, 0), window)
+
+	want := []time.Time{at(11, 52), at(11, 58), at(12, 0)}
+	if len(h.Crashes) != len(want) {
+		t.Fatalf("Crashes = %v, want %v", h.Crashes, want)
+	}
+	for i := range want {
+		if !h.Crashes[i].Equal(want[i]) {
+			t.Errorf("Crashes[%d] = %s, want %s", i, h.Crashes[i], want[i])
+		}
+	}
+	if !h.InCrashLoop(at(12, 0), 3, window) {
+		t.Error("three crashes within the window should be a crash loop")
+	}
+	if h.InCrashLoop(at(12, 9), 3, window) {
+		t.Error("the oldest crash left the window, so it is no longer a loop")
+	}
+}
@@ -53,6 +53,18 @@ type DomainStat struct {
 	LastVisit   time.Time `json:"last_visit"`
 }
 
+// HistoryGroup is a bucket of history entries sharing a domain or a day.
+// Entries holds the group's most recent entries, which may be fewer than
+// EntryCount.
+type HistoryGroup struct {
+	// Key is the domain, or the local day formatted as YYYY-MM-DD.
+	Key         string          `json:"key"`
+	EntryCount  int64           `json:"entry_count"`
+	VisitCount  int64           `json:"visit_count"`
+	LastVisited time.Time       `json:"last_visited"`
+	Entries     []*HistoryEntry `json:"entries"`
+}
+
 // HourlyDistribution contains visit counts by hour of day.
 type HourlyDistribution struct {
 	Hour       int   `json:"hour"`
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// NavigationNode is one page in a pane's navigation tree. Navigating to a new
+// page adds a child of the current node, so going back and then elsewhere
+// starts a new branch. IDs are unique within one tree; ParentID is 0 for the
+// root.
+type NavigationNode struct {
+	ID         int       `json:"id"`
+	ParentID   int       `json:"parent_id,omitempty"`
+	URL        string    `json:"url"`
+	Title      string    `json:"title,omitempty"`
+	Visits     int       `json:"visits"`
+	FirstVisit time.Time `json:"first_visit"`
+	LastVisit  time.Time `json:"last_visit"`
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxNoteBytes caps the size of a note body.
+const MaxNoteBytes = 1 << 20
+
+// noteTitleRunes caps the title derived from a note's first line.
+const noteTitleRunes = 80
+
+// Note is a free-form text note kept on the dumb://notes scratchpad.
+type Note struct {
+	ID        int64     `json:"id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Title returns the note's first non-blank line, shortened, or "" for an
+// empty note.
+func (n *Note) Title() string {
+	if n == nil {
+		return ""
+	}
+	for line := range strings.SplitSeq(n.Body, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if utf8.RuneCountInString(line) > noteTitleRunes {
+			runes := []rune(line)
+			return strings.TrimSpace(string(runes[:noteTitleRunes-1])) + "…"
+		}
+		return line
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// PageSaveFormat selects how a page is written to disk by "save page as".
+type PageSaveFormat string
+
+const (
+	// PageSaveFormatMHTML saves the page and its subresources as a single
+	// MIME HTML archive.
+	PageSaveFormatMHTML PageSaveFormat = "mhtml"
+	// PageSaveFormatHTMLComplete saves a single HTML document with
+	// stylesheets and images inlined where the page allows it.
+	PageSaveFormatHTMLComplete PageSaveFormat = "html"
+)
+
+// IsValid reports whether f is a known save format.
+func (f PageSaveFormat) IsValid() bool {
+	switch f {
+	case PageSaveFormatMHTML, PageSaveFormatHTMLComplete:
+		return true
+	default:
+		return false
+	}
+}
+
+// Extension returns the file extension, including the dot, for f.
+func (f PageSaveFormat) Extension() string {
+	if f == PageSaveFormatHTMLComplete {
+		return ".html"
+	}
+	return ".mhtml"
+}
+
+// ParsePageSaveFormat parses a user-supplied format name.
+// The common aliases "mht" and "htm" are accepted.
+func ParsePageSaveFormat(s string) (PageSaveFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "mhtml", "mht":
+		return PageSaveFormatMHTML, nil
+	case "html", "htm":
+		return PageSaveFormatHTMLComplete, nil
+	default:
+		return "", fmt.Errorf("unknown page save format %q (want mhtml or html)", s)
+	}
+}
+
+// PageSaveFormatForPath infers the save format from the extension of path.
+// Paths without a recognised extension default to MHTML, which preserves
+// the most of the original page.
+func PageSaveFormatForPath(path string) PageSaveFormat {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		return PageSaveFormatHTMLComplete
+	default:
+		return PageSaveFormatMHTML
+	}
+}
+
+// PageSavePath returns path with the extension for format appended when path
+// does not already end in an extension matching that format.
+func PageSavePath(path string, format PageSaveFormat) string {
+	if path == "" || strings.HasSuffix(path, string(filepath.Separator)) {
+		return path
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	switch format {
+	case PageSaveFormatHTMLComplete:
+		if ext == ".html" || ext == ".htm" {
+			return path
+		}
+	default:
+		if ext == ".mhtml" || ext == ".mht" {
+			return path
+		}
+	}
+	return path + format.Extension()
+}
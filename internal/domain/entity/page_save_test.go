@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePageSaveFormat(t *testing.T) {
+	tests := []struct {
+		in   string
+		want PageSaveFormat
+	}{
+		{"mhtml", PageSaveFormatMHTML},
+		{"MHT", PageSaveFormatMHTML},
+		{" html ", PageSaveFormatHTMLComplete},
+		{"htm", PageSaveFormatHTMLComplete},
+	}
+	for _, tt := range tests {
+		got, err := ParsePageSaveFormat(tt.in)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+		assert.True(t, got.IsValid())
+	}
+
+	_, err := ParsePageSaveFormat("pdf")
+	assert.Error(t, err)
+	assert.False(t, PageSaveFormat("pdf").IsValid())
+}
+
+func TestPageSaveFormatForPath(t *testing.T) {
+	assert.Equal(t, PageSaveFormatMHTML, PageSaveFormatForPath("page.mhtml"))
+	assert.Equal(t, PageSaveFormatMHTML, PageSaveFormatForPath("/tmp/page.MHT"))
+	assert.Equal(t, PageSaveFormatHTMLComplete, PageSaveFormatForPath("page.html"))
+	assert.Equal(t, PageSaveFormatHTMLComplete, PageSaveFormatForPath("dir/page.HTM"))
+	assert.Equal(t, PageSaveFormatMHTML, PageSaveFormatForPath("page"))
+	assert.Equal(t, PageSaveFormatMHTML, PageSaveFormatForPath("archive.tar.gz"))
+}
+
+func TestPageSavePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		format PageSaveFormat
+		want   string
+	}{
+		{"mhtml keeps extension", "/tmp/page.mhtml", PageSaveFormatMHTML, "/tmp/page.mhtml"},
+		{"mht alias kept", "/tmp/page.mht", PageSaveFormatMHTML, "/tmp/page.mht"},
+		{"mhtml appends extension", "/tmp/page", PageSaveFormatMHTML, "/tmp/page.mhtml"},
+		{"html keeps extension", "page.HTML", PageSaveFormatHTMLComplete, "page.HTML"},
+		{"html appends to mismatched extension", "page.mhtml", PageSaveFormatHTMLComplete, "page.mhtml.html"},
+		{"dotted name appends", "v1.2", PageSaveFormatMHTML, "v1.2.mhtml"},
+		{"empty path unchanged", "", PageSaveFormatMHTML, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PageSavePath(tt.path, tt.format))
+		})
+	}
+}
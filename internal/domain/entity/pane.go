@@ -190,3 +190,30 @@ func (n *PaneNode) VisibleAreaCount() int {
 		return count
 	}
 }
+
+// VisiblePanes returns the visible leaf nodes in depth-first, first-child-first
+// order (left before right, top before bottom). Stacked containers contribute
+// only their active pane. The order only depends on tree shape, so splitting a
+// pane never renumbers the panes that come before it.
+func (n *PaneNode) VisiblePanes() []*PaneNode {
+	var visible []*PaneNode
+	n.collectVisiblePanes(&visible)
+	return visible
+}
+
+func (n *PaneNode) collectVisiblePanes(visible *[]*PaneNode) {
+	switch {
+	case n.IsLeaf():
+		*visible = append(*visible, n)
+	case n.IsStacked:
+		if active := n.ActivePane(); active != nil {
+			active.collectVisiblePanes(visible)
+		}
+	default:
+		for _, child := range n.Children {
+			if child != nil {
+				child.collectVisiblePanes(visible)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// QuietHours is a daily local time range written as "HH:MM" clock times.
+// An End earlier than Start spans midnight ("22:00" to "07:00"). The range
+// includes Start and excludes End. Leaving both empty disables it.
+type QuietHours struct {
+	Start string `mapstructure:"start" yaml:"start" toml:"start" json:"start"`
+	End   string `mapstructure:"end" yaml:"end" toml:"end" json:"end"`
+}
+
+// Enabled reports whether a range is configured.
+func (q QuietHours) Enabled() bool {
+	return strings.TrimSpace(q.Start) != "" || strings.TrimSpace(q.End) != ""
+}
+
+// Validate checks that both ends parse and differ.
+func (q QuietHours) Validate() error {
+	if !q.Enabled() {
+		return nil
+	}
+	start, err := ParseClockTime(q.Start)
+	if err != nil {
+		return fmt.Errorf("start: %w", err)
+	}
+	end, err := ParseClockTime(q.End)
+	if err != nil {
+		return fmt.Errorf("end: %w", err)
+	}
+	if start == end {
+		return fmt.Errorf("start and end must differ (got %s)", q.Start)
+	}
+	return nil
+}
+
+// Contains reports whether t falls within the range, in t's location.
+// An invalid or disabled range contains nothing.
+func (q QuietHours) Contains(t time.Time) bool {
+	start, end, ok := q.bounds()
+	if !ok {
+		return false
+	}
+	now := t.Hour()*60 + t.Minute()
+	if start < end {
+		return now >= start && now < end
+	}
+	return now >= start || now < end
+}
+
+// NextBoundary returns the next time after t at which the range starts or
+// ends. It returns false when the range is disabled or invalid.
+func (q QuietHours) NextBoundary(t time.Time) (time.Time, bool) {
+	start, end, ok := q.bounds()
+	if !ok {
+		return time.Time{}, false
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	var next time.Time
+	for _, minute := range []int{start, end, start + minutesPerDay, end + minutesPerDay} {
+		candidate := midnight.Add(time.Duration(minute) * time.Minute)
+		if candidate.After(t) && (next.IsZero() || candidate.Before(next)) {
+			next = candidate
+		}
+	}
+	return next, true
+}
+
+func (q QuietHours) bounds() (start, end int, ok bool) {
+	if q.Validate() != nil || !q.Enabled() {
+		return 0, 0, false
+	}
+	start, _ = ParseClockTime(q.Start)
+	end, _ = ParseClockTime(q.End)
+	return start, end, true
+}
+
+// ParseClockTime parses an "HH:MM" 24-hour clock time into minutes since
+// midnight.
+func ParseClockTime(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	hours, minutes, found := strings.Cut(s, ":")
+	if !found || len(minutes) != 2 || hours == "" || len(hours) > 2 {
+		return 0, fmt.Errorf("invalid time %q (want HH:MM)", s)
+	}
+	h, err := strconv.Atoi(hours)
+	if err != nil || h < 0 || h > 23 {
+		return 0, fmt.Errorf("invalid hour in %q", s)
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil || m < 0 || m > 59 {
+		return 0, fmt.Errorf("invalid minute in %q", s)
+	}
+	return h*60 + m, nil
+}
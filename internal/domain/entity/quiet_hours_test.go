@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2024, time.March, 10, hour, minute, 0, 0, time.UTC)
+}
+
+func TestQuietHoursContains(t *testing.T) {
+	tests := []struct {
+		name  string
+		hours QuietHours
+		now   time.Time
+		want  bool
+	}{
+		{name: "same day inside", hours: QuietHours{Start: "13:00", End: "15:30"}, now: at(14, 0), want: true},
+		{name: "same day start is included", hours: QuietHours{Start: "13:00", End: "15:30"}, now: at(13, 0), want: true},
+		{name: "same day end is excluded", hours: QuietHours{Start: "13:00", End: "15:30"}, now: at(15, 30), want: false},
+		{name: "same day before", hours: QuietHours{Start: "13:00", End: "15:30"}, now: at(12, 59), want: false},
+		{name: "midnight span late evening", hours: QuietHours{Start: "22:00", End: "07:00"}, now: at(23, 15), want: true},
+		{name: "midnight span at midnight", hours: QuietHours{Start: "22:00", End: "07:00"}, now: at(0, 0), want: true},
+		{name: "midnight span early morning", hours: QuietHours{Start: "22:00", End: "07:00"}, now: at(6, 59), want: true},
+		{name: "midnight span end is excluded", hours: QuietHours{Start: "22:00", End: "07:00"}, now: at(7, 0), want: false},
+		{name: "midnight span daytime", hours: QuietHours{Start: "22:00", End: "07:00"}, now: at(12, 0), want: false},
+		{name: "ends at midnight", hours: QuietHours{Start: "20:00", End: "00:00"}, now: at(23, 59), want: true},
+		{name: "disabled", hours: QuietHours{}, now: at(3, 0), want: false},
+		{name: "invalid", hours: QuietHours{Start: "25:00", End: "07:00"}, now: at(3, 0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hours.Contains(tt.now); got != tt.want {
+				t.Errorf("%+v.Contains(%s) = %v, want %v", tt.hours, tt.now.Format("15:04"), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuietHoursNextBoundary(t *testing.T) {
+	hours := QuietHours{Start: "22:00", End: "07:00"}
+
+	tests := []struct {
+		now  time.Time
+		want time.Time
+	}{
+		{now: at(12, 0), want: at(22, 0)},
+		{now: at(22, 0), want: at(7, 0).AddDate(0, 0, 1)},
+		{now: at(3, 0), want: at(7, 0)},
+		{now: at(23, 30), want: at(7, 0).AddDate(0, 0, 1)},
+	}
+	for _, tt := range tests {
+		got, ok := hours.NextBoundary(tt.now)
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("NextBoundary(%s) = %s, %v; want %s", tt.now, got, ok, tt.want)
+		}
+	}
+
+	if _, ok := (QuietHours{}).NextBoundary(at(12, 0)); ok {
+		t.Error("disabled quiet hours should have no boundary")
+	}
+}
+
+func TestQuietHoursValidate(t *testing.T) {
+	valid := []QuietHours{{}, {Start: "22:00", End: "07:00"}, {Start: "9:30", End: "17:00"}}
+	for _, q := range valid {
+		if err := q.Validate(); err != nil {
+			t.Errorf("%+v.Validate() = %v", q, err)
+		}
+	}
+	invalid := []QuietHours{
+		{Start: "22:00"},
+		{Start: "22:00", End: "24:00"},
+		{Start: "22:60", End: "07:00"},
+		{Start: "10pm", End: "07:00"},
+		{Start: "07:00", End: "07:00"},
+	}
+	for _, q := range invalid {
+		if err := q.Validate(); err == nil {
+			t.Errorf("%+v.Validate() = nil, want error", q)
+		}
+	}
+}
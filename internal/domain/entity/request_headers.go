@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// RequestHeaderScope selects which frames of a pane send extra request headers.
+type RequestHeaderScope string
+
+const (
+	// RequestHeaderScopeTopFrame adds headers to requests made by the main
+	// frame only; iframes and their subresources are left untouched.
+	RequestHeaderScopeTopFrame RequestHeaderScope = "top_frame"
+	// RequestHeaderScopeAllFrames adds headers to requests from every frame.
+	RequestHeaderScopeAllFrames RequestHeaderScope = "all_frames"
+)
+
+// ExtraRequestHeaders are custom HTTP headers added to a pane's outgoing
+// requests, typically for development and testing.
+type ExtraRequestHeaders struct {
+	Headers map[string]string
+	Scope   RequestHeaderScope
+}
+
+// NewExtraRequestHeaders copies headers, dropping blank names. It returns nil
+// when nothing is left so callers can treat nil as "no extra headers".
+func NewExtraRequestHeaders(headers map[string]string, scope RequestHeaderScope) *ExtraRequestHeaders {
+	cleaned := make(map[string]string, len(headers))
+	for name, value := range headers {
+		if name = strings.TrimSpace(name); name != "" {
+			cleaned[name] = value
+		}
+	}
+	if len(cleaned) == 0 {
+		return nil
+	}
+	return &ExtraRequestHeaders{Headers: cleaned, Scope: scope}
+}
+
+// AppliesTo reports whether a request from the main frame, or from a
+// subframe when mainFrame is false, gets the headers. An unknown scope is
+// treated as top frame only.
+func (h *ExtraRequestHeaders) AppliesTo(mainFrame bool) bool {
+	if h == nil || len(h.Headers) == 0 {
+		return false
+	}
+	return mainFrame || h.Scope == RequestHeaderScopeAllFrames
+}
+
+// Apply calls set for every header, in name order, when the headers apply to
+// the requesting frame. It returns how many headers were set.
+func (h *ExtraRequestHeaders) Apply(mainFrame bool, set func(name, value string)) int {
+	if !h.AppliesTo(mainFrame) {
+		return 0
+	}
+	names := slices.Sorted(maps.Keys(h.Headers))
+	for _, name := range names {
+		set(name, h.Headers[name])
+	}
+	return len(names)
+}
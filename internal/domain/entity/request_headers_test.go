@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewExtraRequestHeaders(t *testing.T) {
+	if got := NewExtraRequestHeaders(nil, RequestHeaderScopeTopFrame); got != nil {
+		t.Fatalf("nil headers = %#v, want nil", got)
+	}
+	if got := NewExtraRequestHeaders(map[string]string{" ": "x"}, RequestHeaderScopeTopFrame); got != nil {
+		t.Fatalf("blank names only = %#v, want nil", got)
+	}
+
+	src := map[string]string{" X-Debug ": "1", "X-Env": "staging"}
+	got := NewExtraRequestHeaders(src, RequestHeaderScopeAllFrames)
+	want := map[string]string{"X-Debug": "1", "X-Env": "staging"}
+	if !reflect.DeepEqual(got.Headers, want) {
+		t.Fatalf("Headers = %v, want %v", got.Headers, want)
+	}
+	src["X-Env"] = "prod"
+	if got.Headers["X-Env"] != "staging" {
+		t.Fatal("headers must be copied, not aliased")
+	}
+}
+
+func TestExtraRequestHeadersApply(t *testing.T) {
+	headers := map[string]string{"X-B": "2", "X-A": "1"}
+
+	tests := []struct {
+		name      string
+		headers   *ExtraRequestHeaders
+		mainFrame bool
+		want      []string
+	}{
+		{name: "nil", headers: nil, mainFrame: true},
+		{
+			name:      "top frame scope, main frame",
+			headers:   NewExtraRequestHeaders(headers, RequestHeaderScopeTopFrame),
+			mainFrame: true,
+			want:      []string{"X-A: 1", "X-B: 2"},
+		},
+		{
+			name:    "top frame scope, subframe",
+			headers: NewExtraRequestHeaders(headers, RequestHeaderScopeTopFrame),
+		},
+		{
+			name:    "all frames scope, subframe",
+			headers: NewExtraRequestHeaders(headers, RequestHeaderScopeAllFrames),
+			want:    []string{"X-A: 1", "X-B: 2"},
+		},
+		{
+			name:    "unknown scope is top frame only",
+			headers: NewExtraRequestHeaders(headers, "everywhere"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			n := tt.headers.Apply(tt.mainFrame, func(name, value string) {
+				got = append(got, name+": "+value)
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("applied %v, want %v", got, tt.want)
+			}
+			if n != len(tt.want) {
+				t.Fatalf("Apply returned %d, want %d", n, len(tt.want))
+			}
+		})
+	}
+}
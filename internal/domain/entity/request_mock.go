@@ -0,0 +1,22 @@
+package entity
+
+// RequestMock answers the page requests matching URLPattern with a canned
+// response instead of sending them.
+type RequestMock struct {
+	// URLPattern is matched against the absolute request URL; "*" matches
+	// any run of characters.
+	URLPattern string
+	// Method limits the mock to one HTTP method; empty matches any.
+	Method      string
+	Status      int
+	ContentType string
+	Headers     map[string]string
+	Body        string
+}
+
+// RequestMockSet is a named list of mocks a pane can be switched to. The
+// first mock matching a request answers it.
+type RequestMockSet struct {
+	Name  string
+	Mocks []RequestMock
+}
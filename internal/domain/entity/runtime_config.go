@@ -12,15 +12,37 @@ const (
 // EngineWebContentSettingsPayload is the engine-facing runtime view of web
 // content settings that can be applied to newly-created or existing webviews.
 type EngineWebContentSettingsPayload struct {
-	SansFont                  string
-	SerifFont                 string
-	MonospaceFont             string
-	DefaultFontSize           int
-	EnableDevTools            bool
-	CaptureConsole            bool
+	SansFont        string
+	SerifFont       string
+	MonospaceFont   string
+	DefaultFontSize int
+	EnableDevTools  bool
+	CaptureConsole  bool
+	// ConsoleBufferSize is the per-pane dumb://console buffer size; 0
+	// disables capture.
+	ConsoleBufferSize         int
 	DrawCompositingIndicators bool
 	HardwareDecoding          EngineHardwareDecodingMode
 	AutoCopyOnSelection       bool
+	// AutoplayPolicy is the default autoplay policy; per-domain exceptions
+	// are resolved on navigation from RuntimeMediaConfig.
+	AutoplayPolicy AutoplayPolicy
+	// ScrollMultiplier scales wheel scroll distance; 1 leaves wheel events
+	// to the engine.
+	ScrollMultiplier float64
+	SmoothScrolling  bool
+	// TypeToFind injects the type-to-find key listener into web pages.
+	TypeToFind bool
+	// TrackFormDirty injects the edited-form tracker into web pages.
+	TrackFormDirty bool
+	// ReadingProgress injects the scroll listener behind the reading
+	// progress bar into web pages.
+	ReadingProgress bool
+	// PageLanguage injects the script reporting each page's language, for
+	// the translate offer.
+	PageLanguage bool
+	// ImageDisposition shows or downloads images opened as a page.
+	ImageDisposition ImageDisposition
 }
 
 // EngineSettingsPayload is the engine-facing boundary view of runtime config.
@@ -28,6 +50,14 @@ type EngineWebContentSettingsPayload struct {
 type EngineSettingsPayload struct {
 	DefaultUIScale float64
 	WebContent     EngineWebContentSettingsPayload
+	// RequestThrottle limits the request rate to matching domains.
+	RequestThrottle []RequestThrottleRule
+	// StripCSPDomains lists the domain patterns whose pages load without
+	// their Content Security Policy. Empty unless CSP stripping is enabled.
+	StripCSPDomains []string
+	// AlwaysFreshDomains lists the domain patterns whose pages are loaded
+	// from the network, skipping the HTTP cache.
+	AlwaysFreshDomains []string
 }
 
 // EngineSettingsUpdate carries a runtime config change to the engine.
@@ -49,13 +79,35 @@ type RuntimeUIConfig struct {
 	Clipboard           RuntimeClipboardConfig
 	SearchShortcuts     map[string]RuntimeSearchShortcut
 	DefaultSearchEngine string
+	MultiSearchEngines  []string
 	Omnibox             RuntimeOmniboxConfig
 	Update              RuntimeUpdateConfig
 	Downloads           RuntimeDownloadsConfig
+	Media               RuntimeMediaConfig
+	Privacy             RuntimePrivacyConfig
+	PageEnv             RuntimePageEnvConfig
+	Cache               RuntimeCacheConfig
+	Zoom                RuntimeZoomConfig
+	Input               RuntimeInputConfig
+	Homepage            HomepageConfig
+	LinkStatus          RuntimeLinkStatusConfig
+	Window              RuntimeWindowConfig
+	ContentFiltering    RuntimeContentFilteringConfig
+	ScriptDialogs       RuntimeScriptDialogsConfig
+	History             RuntimeHistoryConfig
+	LoadRetry           RuntimeLoadRetryConfig
+	ReadAloud           RuntimeReadAloudConfig
+	Translation         RuntimeTranslationConfig
+	Notifications       RuntimeNotificationsConfig
+
+	// SearchSelectionInNewPane opens context menu selection searches in a
+	// new pane.
+	SearchSelectionInNewPane bool
 }
 
 type RuntimeClipboardConfig struct {
 	AutoCopyOnSelection bool
+	URLHistorySize      int
 }
 
 type RuntimeSearchShortcut struct {
@@ -64,9 +116,18 @@ type RuntimeSearchShortcut struct {
 }
 
 type RuntimeOmniboxConfig struct {
-	InitialBehavior   OmniboxInitialBehavior
-	MostVisitedDays   int
-	AutoOpenOnNewPane bool
+	InitialBehavior    OmniboxInitialBehavior
+	MostVisitedDays    int
+	AutoOpenOnNewPane  bool
+	MaxResults         int
+	MinQueryLength     int
+	OpenInNewPane      bool
+	OpenWithCurrentURL bool
+	MatchMode          OmniboxMatchMode
+	// NextResultKeys and PreviousResultKeys move the result selection like
+	// the arrow keys.
+	NextResultKeys     []string
+	PreviousResultKeys []string
 }
 
 type RuntimeUpdateConfig struct {
@@ -76,5 +137,106 @@ type RuntimeUpdateConfig struct {
 }
 
 type RuntimeDownloadsConfig struct {
-	Path string
+	Path             string
+	FilenameTemplate string
+}
+
+type RuntimeInputConfig struct {
+	HoverFocusEnabled     bool
+	HoverFocusDelayMs     int
+	MiddleClickClosesPane bool
+	AutofocusFirstField   bool
+	AutofocusDomains      []string
+	// ConfirmCloseUnsavedForms asks before closing a pane with edited forms.
+	ConfirmCloseUnsavedForms bool
+	// DisableGesturesDomains turns off swipe back/forward gestures on
+	// matching domain patterns.
+	DisableGesturesDomains []string
+}
+
+// RuntimeLoadRetryConfig is the retry schedule of loads that failed on a
+// transient network error. MaxAttempts 0 disables retrying.
+type RuntimeLoadRetryConfig struct {
+	MaxAttempts    int
+	InitialDelayMs int
+	MaxDelayMs     int
+}
+
+// RuntimeReadAloudConfig holds the text-to-speech command line, unparsed.
+// An empty Command disables reading aloud.
+type RuntimeReadAloudConfig struct {
+	Command string
+}
+
+// RuntimeTranslationConfig holds the translate offer settings. An empty
+// PreferredLanguage stands for the language of the system locale.
+type RuntimeTranslationConfig struct {
+	Prompt            bool
+	PreferredLanguage string
+	URLTemplate       string
+	IgnoredLanguages  []string
+}
+
+// RuntimeNotificationsConfig holds how many recent toasts the notification
+// center keeps.
+type RuntimeNotificationsConfig struct {
+	HistorySize int
+}
+
+type RuntimeLinkStatusConfig struct {
+	Enabled     bool
+	ShowDelayMs int
+	MaxLength   int
+}
+
+type RuntimeContentFilteringConfig struct {
+	DisabledDomains []string
+}
+
+type RuntimeHistoryConfig struct {
+	// PersistNavigationTree saves per-pane navigation trees across restarts.
+	PersistNavigationTree bool
+}
+
+type RuntimeScriptDialogsConfig struct {
+	AutoDismiss        bool
+	AutoDismissDomains []string
+}
+
+type RuntimeWindowConfig struct {
+	RememberGeometry bool
+	DefaultWidth     int
+	DefaultHeight    int
+}
+
+type RuntimeCacheConfig struct {
+	AlwaysFreshDomains []string
+}
+
+type RuntimeZoomConfig struct {
+	StepFactor         float64
+	DomainOverrides    []ZoomDomainOverride
+	Presets            []ZoomPreset
+	SessionOnlyDomains []string
+}
+
+type RuntimePrivacyConfig struct {
+	CookiePolicy            CookiePolicy
+	CookiePolicyOverrides   []CookiePolicyOverride
+	ClipboardReadPolicy     ClipboardReadPolicy
+	ClearDataOnCloseDomains []string
+	PanicNeutralURL         string
+	PanicPIN                string
+	HTTPSOnly               HTTPSOnlyMode
+}
+
+type RuntimePageEnvConfig struct {
+	Values  map[string]string
+	Domains []PageEnvRule
+}
+
+type RuntimeMediaConfig struct {
+	AutoplayPolicy     AutoplayPolicy
+	AutoplayExceptions []AutoplayException
+	MuteOnFocusLoss    bool
 }
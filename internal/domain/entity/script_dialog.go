@@ -0,0 +1,33 @@
+package entity
+
+// ScriptDialogType identifies a JavaScript dialog opened by a page.
+type ScriptDialogType string
+
+const (
+	// ScriptDialogAlert is window.alert(): a message with a single OK button.
+	ScriptDialogAlert ScriptDialogType = "alert"
+	// ScriptDialogConfirm is window.confirm(): OK or Cancel.
+	ScriptDialogConfirm ScriptDialogType = "confirm"
+	// ScriptDialogPrompt is window.prompt(): a text entry with OK or Cancel.
+	ScriptDialogPrompt ScriptDialogType = "prompt"
+	// ScriptDialogBeforeUnload asks whether to leave a page with unsaved changes.
+	ScriptDialogBeforeUnload ScriptDialogType = "before_unload"
+)
+
+// ScriptDialogResponse is the user's answer to a JavaScript dialog.
+type ScriptDialogResponse struct {
+	// Confirmed is true for OK / Leave. Alerts ignore it.
+	Confirmed bool
+	// Text is the prompt's answer. It is ignored unless Confirmed is true.
+	Text string
+}
+
+// DismissResponse returns the answer an auto-dismissed dialog gives.
+// Dialogs are cancelled, except beforeunload, which lets the page go:
+// unattended setups must never get stuck on a "Leave page?" question.
+func (t ScriptDialogType) DismissResponse() ScriptDialogResponse {
+	if t == ScriptDialogBeforeUnload {
+		return ScriptDialogResponse{Confirmed: true}
+	}
+	return ScriptDialogResponse{}
+}
@@ -0,0 +1,51 @@
+package entity
+
+// ScrollPosition is a page's scroll offset in CSS pixels.
+type ScrollPosition struct {
+	X float64
+	Y float64
+}
+
+// ScrollMemory remembers where recently left pages were scrolled to, keyed by
+// URL, so going back to one can return to the same spot. Only the most
+// recently remembered pages are kept.
+type ScrollMemory struct {
+	limit     int
+	order     []string
+	positions map[string]ScrollPosition
+}
+
+// NewScrollMemory returns a memory holding at most limit pages.
+func NewScrollMemory(limit int) *ScrollMemory {
+	return &ScrollMemory{limit: max(limit, 1), positions: make(map[string]ScrollPosition)}
+}
+
+// Remember records pos for url, replacing an earlier position and evicting
+// the oldest page once the memory is full.
+func (m *ScrollMemory) Remember(url string, pos ScrollPosition) {
+	if m == nil || url == "" {
+		return
+	}
+	if _, ok := m.positions[url]; ok {
+		for i, existing := range m.order {
+			if existing == url {
+				m.order = append(m.order[:i], m.order[i+1:]...)
+				break
+			}
+		}
+	} else if len(m.order) >= m.limit {
+		delete(m.positions, m.order[0])
+		m.order = m.order[1:]
+	}
+	m.order = append(m.order, url)
+	m.positions[url] = pos
+}
+
+// Recall returns the position last remembered for url.
+func (m *ScrollMemory) Recall(url string) (ScrollPosition, bool) {
+	if m == nil {
+		return ScrollPosition{}, false
+	}
+	pos, ok := m.positions[url]
+	return pos, ok
+}
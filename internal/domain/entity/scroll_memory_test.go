@@ -0,0 +1,31 @@
+package entity
+
+import "testing"
+
+func TestScrollMemoryRememberRecall(t *testing.T) {
+	m := NewScrollMemory(2)
+	m.Remember("https://a.test/", ScrollPosition{Y: 100})
+	m.Remember("https://b.test/", ScrollPosition{Y: 200})
+	m.Remember("https://a.test/", ScrollPosition{Y: 150})
+	m.Remember("https://c.test/", ScrollPosition{Y: 300})
+
+	if _, ok := m.Recall("https://b.test/"); ok {
+		t.Fatal("b.test should be evicted as the oldest page")
+	}
+	if pos, ok := m.Recall("https://a.test/"); !ok || pos.Y != 150 {
+		t.Fatalf("a.test = %+v %v, want the latest position", pos, ok)
+	}
+	if pos, ok := m.Recall("https://c.test/"); !ok || pos.Y != 300 {
+		t.Fatalf("c.test = %+v %v", pos, ok)
+	}
+
+	m.Remember("", ScrollPosition{Y: 1})
+	if _, ok := m.Recall(""); ok {
+		t.Fatal("empty URLs are not remembered")
+	}
+
+	var nilMemory *ScrollMemory
+	if _, ok := nilMemory.Recall("https://a.test/"); ok {
+		t.Fatal("nil memory recalls nothing")
+	}
+}
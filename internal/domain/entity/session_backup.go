@@ -0,0 +1,120 @@
+package entity
+
+import "time"
+
+// SessionBackupVersion is the current format version of session backup files.
+const SessionBackupVersion = 1
+
+// SessionBackup is a portable copy of every saved session, written by
+// `dumber sessions export` and read back by `dumber sessions import`.
+type SessionBackup struct {
+	Version    int                  `json:"version"`
+	ExportedAt time.Time            `json:"exported_at"`
+	Sessions   []SessionBackupEntry `json:"sessions"`
+}
+
+// SessionBackupEntry is one session of a backup: its metadata and its last
+// snapshot.
+type SessionBackupEntry struct {
+	ID        SessionID     `json:"id"`
+	Type      SessionType   `json:"type"`
+	StartedAt time.Time     `json:"started_at"`
+	EndedAt   *time.Time    `json:"ended_at,omitempty"`
+	State     *SessionState `json:"state"`
+}
+
+// WithNewIDs returns a deep copy of the state owned by sessionID, with new
+// IDs from idGen for every window, tab, tab group, workspace, pane node and
+// pane. Active panes and group members follow their pane or tab to its new
+// ID. The receiver is not modified.
+func (s *SessionState) WithNewIDs(sessionID SessionID, idGen IDGenerator) *SessionState {
+	if s == nil {
+		return nil
+	}
+	out := *s
+	out.SessionID = sessionID
+	tabIDs := make(map[TabID]TabID)
+	out.Tabs = tabSnapshotsWithNewIDs(s.Tabs, tabIDs, idGen)
+	out.TabGroups = tabGroupSnapshotsWithNewIDs(s.TabGroups, tabIDs, idGen)
+	if s.Windows != nil {
+		out.Windows = make([]WindowSnapshot, len(s.Windows))
+		for i, win := range s.Windows {
+			tabIDs := make(map[TabID]TabID)
+			tabs := tabSnapshotsWithNewIDs(win.Tabs, tabIDs, idGen)
+			out.Windows[i] = WindowSnapshot{
+				ID:             WindowID(idGen()),
+				Tabs:           tabs,
+				ActiveTabIndex: win.ActiveTabIndex,
+				TabGroups:      tabGroupSnapshotsWithNewIDs(win.TabGroups, tabIDs, idGen),
+			}
+		}
+	}
+	return &out
+}
+
+// tabSnapshotsWithNewIDs copies tabs with new IDs, recording the new ID of
+// each tab in tabIDs.
+func tabSnapshotsWithNewIDs(tabs []TabSnapshot, tabIDs map[TabID]TabID, idGen IDGenerator) []TabSnapshot {
+	if tabs == nil {
+		return nil
+	}
+	out := make([]TabSnapshot, len(tabs))
+	for i, tab := range tabs {
+		paneIDs := make(map[PaneID]PaneID)
+		root := paneNodeSnapshotWithNewIDs(tab.Workspace.Root, paneIDs, idGen)
+		oldID := tab.ID
+		tab.ID = TabID(idGen())
+		tabIDs[oldID] = tab.ID
+		tab.Workspace = WorkspaceSnapshot{
+			ID:           WorkspaceID(idGen()),
+			Root:         root,
+			ActivePaneID: paneIDs[tab.Workspace.ActivePaneID],
+		}
+		out[i] = tab
+	}
+	return out
+}
+
+// tabGroupSnapshotsWithNewIDs copies groups with new IDs, mapping members
+// through tabIDs and dropping those that are not there.
+func tabGroupSnapshotsWithNewIDs(groups []TabGroupSnapshot, tabIDs map[TabID]TabID, idGen IDGenerator) []TabGroupSnapshot {
+	if groups == nil {
+		return nil
+	}
+	out := make([]TabGroupSnapshot, 0, len(groups))
+	for _, group := range groups {
+		members := make([]TabID, 0, len(group.TabIDs))
+		for _, id := range group.TabIDs {
+			if newID, ok := tabIDs[id]; ok {
+				members = append(members, newID)
+			}
+		}
+		group.ID = TabGroupID(idGen())
+		group.TabIDs = members
+		out = append(out, group)
+	}
+	return out
+}
+
+// paneNodeSnapshotWithNewIDs copies node with new IDs, recording the new ID
+// of each pane in paneIDs.
+func paneNodeSnapshotWithNewIDs(node *PaneNodeSnapshot, paneIDs map[PaneID]PaneID, idGen IDGenerator) *PaneNodeSnapshot {
+	if node == nil {
+		return nil
+	}
+	out := *node
+	out.ID = idGen()
+	if node.Pane != nil {
+		pane := *node.Pane
+		pane.ID = PaneID(idGen())
+		paneIDs[node.Pane.ID] = pane.ID
+		out.Pane = &pane
+	}
+	if node.Children != nil {
+		out.Children = make([]*PaneNodeSnapshot, len(node.Children))
+		for i, child := range node.Children {
+			out.Children[i] = paneNodeSnapshotWithNewIDs(child, paneIDs, idGen)
+		}
+	}
+	return &out
+}
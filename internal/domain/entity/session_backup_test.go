@@ -0,0 +1,87 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStateWithNewIDs_LegacyTabs(t *testing.T) {
+	state := &entity.SessionState{
+		Version:   entity.LegacySessionStateVersion,
+		SessionID: "old",
+		Tabs: []entity.TabSnapshot{{
+			ID:   "tab-1",
+			Name: "main",
+			Workspace: entity.WorkspaceSnapshot{
+				ID: "ws-1",
+				Root: &entity.PaneNodeSnapshot{
+					ID:       "split",
+					SplitDir: entity.SplitHorizontal,
+					Children: []*entity.PaneNodeSnapshot{
+						{ID: "n1", Pane: &entity.PaneSnapshot{ID: "p1", URI: "https://one.example/"}},
+						{ID: "n2", Pane: &entity.PaneSnapshot{ID: "p2", URI: "https://two.example/"}},
+					},
+				},
+				ActivePaneID: "p2",
+			},
+		}},
+		ActiveTabIndex: 0,
+	}
+
+	got := state.WithNewIDs("new", mockIDGenerator())
+
+	require.Len(t, got.Tabs, 1)
+	assert.Equal(t, entity.SessionID("new"), got.SessionID)
+	assert.Nil(t, got.Windows, "legacy states stay legacy")
+	tab := got.Tabs[0]
+	assert.Equal(t, "main", tab.Name)
+	root := tab.Workspace.Root
+	assert.NotEqual(t, "split", root.ID)
+	assert.Equal(t, entity.SplitHorizontal, root.SplitDir)
+	require.Len(t, root.Children, 2)
+	assert.Equal(t, "https://two.example/", root.Children[1].Pane.URI)
+	assert.Equal(t, root.Children[1].Pane.ID, tab.Workspace.ActivePaneID)
+	assert.NotEqual(t, entity.PaneID("p2"), tab.Workspace.ActivePaneID)
+
+	assert.Equal(t, entity.SessionID("old"), state.SessionID, "the receiver is not modified")
+	assert.Equal(t, entity.PaneID("p2"), state.Tabs[0].Workspace.Root.Children[1].Pane.ID)
+	assert.Equal(t, entity.PaneID("p2"), state.Tabs[0].Workspace.ActivePaneID)
+}
+
+func TestSessionStateWithNewIDs_Nil(t *testing.T) {
+	var state *entity.SessionState
+	assert.Nil(t, state.WithNewIDs("new", mockIDGenerator()))
+}
+
+func TestSessionStateWithNewIDs_RemapsTabGroups(t *testing.T) {
+	state := &entity.SessionState{
+		Version: entity.SessionStateVersion,
+		Windows: []entity.WindowSnapshot{{
+			ID: "w1",
+			Tabs: []entity.TabSnapshot{
+				pinTestTab("t1", "https://one.example/", false),
+				pinTestTab("t2", "https://two.example/", false),
+			},
+			TabGroups: []entity.TabGroupSnapshot{{
+				ID:     "g1",
+				Name:   "Work",
+				Color:  entity.TabGroupColorBlue,
+				TabIDs: []entity.TabID{"t2", "t1"},
+			}},
+		}},
+	}
+
+	got := state.WithNewIDs("new", mockIDGenerator())
+
+	require.Len(t, got.Windows, 1)
+	win := got.Windows[0]
+	require.Len(t, win.TabGroups, 1)
+	group := win.TabGroups[0]
+	assert.NotEqual(t, entity.TabGroupID("g1"), group.ID)
+	assert.Equal(t, "Work", group.Name)
+	assert.Equal(t, []entity.TabID{win.Tabs[1].ID, win.Tabs[0].ID}, group.TabIDs)
+	assert.Equal(t, []entity.TabID{"t2", "t1"}, state.Windows[0].TabGroups[0].TabIDs, "the receiver is not modified")
+}
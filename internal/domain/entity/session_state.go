@@ -1,6 +1,10 @@
 package entity
 
-import "time"
+import (
+	"slices"
+	"strings"
+	"time"
+)
 
 const LegacySessionStateVersion = 1
 
@@ -16,6 +20,8 @@ type SessionState struct {
 	SessionID      SessionID     `json:"session_id"`
 	Tabs           []TabSnapshot `json:"tabs,omitempty"`
 	ActiveTabIndex int           `json:"active_tab_index,omitempty"`
+	// TabGroups groups the legacy flat Tabs; v2 states keep groups per window.
+	TabGroups []TabGroupSnapshot `json:"tab_groups,omitempty"`
 	// v2: window-scoped sessions
 	Windows           []WindowSnapshot `json:"windows,omitempty"`
 	ActiveWindowIndex int              `json:"active_window_index,omitempty"`
@@ -31,6 +37,16 @@ type TabSnapshot struct {
 	Workspace WorkspaceSnapshot `json:"workspace"`
 }
 
+// TabGroupSnapshot captures a tab group. TabIDs are the IDs of the member
+// TabSnapshots saved alongside it.
+type TabGroupSnapshot struct {
+	ID        TabGroupID    `json:"id"`
+	Name      string        `json:"name"`
+	Color     TabGroupColor `json:"color"`
+	TabIDs    []TabID       `json:"tab_ids"`
+	Collapsed bool          `json:"collapsed,omitempty"`
+}
+
 // WorkspaceSnapshot captures the pane tree layout.
 type WorkspaceSnapshot struct {
 	ID           WorkspaceID       `json:"id"`
@@ -83,10 +99,36 @@ func SnapshotFromTabList(sessionID SessionID, tabs *TabList) *SessionState {
 		SessionID:      sessionID,
 		Tabs:           snapTabs,
 		ActiveTabIndex: activeTabIndex,
+		TabGroups:      snapshotTabGroups(tabs.Groups),
 		SavedAt:        time.Now(),
 	}
 }
 
+func snapshotTabGroups(groups []*TabGroup) []TabGroupSnapshot {
+	var snaps []TabGroupSnapshot
+	for _, group := range groups {
+		if group == nil || len(group.TabIDs) == 0 {
+			continue
+		}
+		snaps = append(snaps, TabGroupSnapshot{
+			ID:        group.ID,
+			Name:      group.Name,
+			Color:     group.Color,
+			TabIDs:    slices.Clone(group.TabIDs),
+			Collapsed: group.Collapsed,
+		})
+	}
+	return snaps
+}
+
+// SnapshotTab captures a single tab, including its full pane tree.
+func SnapshotTab(tab *Tab) TabSnapshot {
+	if tab == nil {
+		return TabSnapshot{}
+	}
+	return snapshotTab(tab)
+}
+
 func snapshotTab(tab *Tab) TabSnapshot {
 	return TabSnapshot{
 		ID:        tab.ID,
@@ -171,6 +213,128 @@ func (s *SessionState) CountPanes() int {
 	return count
 }
 
+// PinnedOnly returns a copy of the state that keeps only pinned tabs.
+// Windows left without tabs are dropped, and active indices follow the
+// previously active tab when it survives, falling back to the first tab.
+// The receiver is not modified.
+func (s *SessionState) PinnedOnly() *SessionState {
+	if s == nil {
+		return nil
+	}
+	filtered := *s
+	filtered.Tabs, filtered.ActiveTabIndex = pinnedTabSnapshots(s.Tabs, s.ActiveTabIndex)
+	filtered.TabGroups = tabGroupSnapshotsFor(s.TabGroups, filtered.Tabs)
+	if s.Windows == nil {
+		return &filtered
+	}
+
+	filtered.Windows = make([]WindowSnapshot, 0, len(s.Windows))
+	filtered.ActiveWindowIndex = 0
+	for i, win := range s.Windows {
+		tabs, activeTab := pinnedTabSnapshots(win.Tabs, win.ActiveTabIndex)
+		if len(tabs) == 0 {
+			continue
+		}
+		if i == s.ActiveWindowIndex {
+			filtered.ActiveWindowIndex = len(filtered.Windows)
+		}
+		filtered.Windows = append(filtered.Windows, WindowSnapshot{
+			ID:             win.ID,
+			Tabs:           tabs,
+			ActiveTabIndex: activeTab,
+			TabGroups:      tabGroupSnapshotsFor(win.TabGroups, tabs),
+		})
+	}
+	return &filtered
+}
+
+// tabGroupSnapshotsFor returns groups limited to the members in tabs,
+// dropping groups left empty.
+func tabGroupSnapshotsFor(groups []TabGroupSnapshot, tabs []TabSnapshot) []TabGroupSnapshot {
+	kept := make(map[TabID]struct{}, len(tabs))
+	for _, tab := range tabs {
+		kept[tab.ID] = struct{}{}
+	}
+	var out []TabGroupSnapshot
+	for _, group := range groups {
+		group.TabIDs = slices.DeleteFunc(slices.Clone(group.TabIDs), func(id TabID) bool {
+			_, ok := kept[id]
+			return !ok
+		})
+		if len(group.TabIDs) > 0 {
+			out = append(out, group)
+		}
+	}
+	return out
+}
+
+func pinnedTabSnapshots(tabs []TabSnapshot, activeIndex int) ([]TabSnapshot, int) {
+	pinned := make([]TabSnapshot, 0, len(tabs))
+	newActive := 0
+	for i, tab := range tabs {
+		if !tab.IsPinned {
+			continue
+		}
+		if i == activeIndex {
+			newActive = len(pinned)
+		}
+		tab.Position = len(pinned)
+		pinned = append(pinned, tab)
+	}
+	return pinned, newActive
+}
+
+// SessionURL is a pane URL exported from a session snapshot.
+type SessionURL struct {
+	URL   string `json:"url"`
+	Title string `json:"title,omitempty"`
+}
+
+// ExportURLs returns pane URLs in display order: windows, then tabs within a
+// window, then panes within a tab's tree (depth-first, children in order).
+// Ephemeral panes (see IsEphemeralSessionURI) are skipped.
+func (s *SessionState) ExportURLs() []SessionURL {
+	if s == nil {
+		return nil
+	}
+	tabs := s.Tabs
+	if s.Version >= SessionStateVersion {
+		tabs = nil
+		for _, w := range s.Windows {
+			tabs = append(tabs, w.Tabs...)
+		}
+	}
+
+	var urls []SessionURL
+	for _, tab := range tabs {
+		urls = appendPaneURLs(urls, tab.Workspace.Root)
+	}
+	return urls
+}
+
+func appendPaneURLs(urls []SessionURL, node *PaneNodeSnapshot) []SessionURL {
+	if node == nil {
+		return urls
+	}
+	if node.Pane != nil {
+		if IsEphemeralSessionURI(node.Pane.URI) {
+			return urls
+		}
+		return append(urls, SessionURL{URL: node.Pane.URI, Title: node.Pane.Title})
+	}
+	for _, child := range node.Children {
+		urls = appendPaneURLs(urls, child)
+	}
+	return urls
+}
+
+// IsEphemeralSessionURI reports whether a pane URI is meaningless outside the
+// local browser: blank panes, about: pages, and internal dumb:// pages.
+func IsEphemeralSessionURI(uri string) bool {
+	uri = strings.TrimSpace(uri)
+	return uri == "" || strings.HasPrefix(uri, "about:") || strings.HasPrefix(uri, "dumb://")
+}
+
 func countPanesInNode(node *PaneNodeSnapshot) int {
 	if node == nil {
 		return 0
@@ -190,8 +354,10 @@ type IDGenerator func() string
 
 // tabListFromSnapshots converts a slice of TabSnapshots to a live TabList.
 // activeIndex is the index in snapshots that should become the active tab.
-func tabListFromSnapshots(snaps []TabSnapshot, activeIndex int, idGen IDGenerator) *TabList {
+// Groups follow their member tabs to their new IDs.
+func tabListFromSnapshots(snaps []TabSnapshot, activeIndex int, groups []TabGroupSnapshot, idGen IDGenerator) *TabList {
 	tabs := NewTabList()
+	tabIDs := make(map[TabID]TabID, len(snaps))
 
 	for i, tabSnap := range snaps {
 		tab := tabFromSnapshot(&tabSnap, idGen)
@@ -200,6 +366,9 @@ func tabListFromSnapshots(snaps []TabSnapshot, activeIndex int, idGen IDGenerato
 		}
 		tabs.Tabs = append(tabs.Tabs, tab)
 		tab.Position = len(tabs.Tabs) - 1
+		if tabSnap.ID != "" {
+			tabIDs[tabSnap.ID] = tab.ID
+		}
 
 		if i == activeIndex {
 			tabs.ActiveTabID = tab.ID
@@ -211,9 +380,40 @@ func tabListFromSnapshots(snaps []TabSnapshot, activeIndex int, idGen IDGenerato
 		tabs.ActiveTabID = tabs.Tabs[0].ID
 	}
 
+	for i := range groups {
+		if group := tabGroupFromSnapshot(&groups[i], tabIDs, idGen); group != nil {
+			tabs.AddGroup(group)
+		}
+	}
+
 	return tabs
 }
 
+// tabGroupFromSnapshot rebuilds a group with a new ID, mapping its members
+// through tabIDs. Returns nil when none of its tabs were restored.
+func tabGroupFromSnapshot(snap *TabGroupSnapshot, tabIDs map[TabID]TabID, idGen IDGenerator) *TabGroup {
+	members := make([]TabID, 0, len(snap.TabIDs))
+	for _, id := range snap.TabIDs {
+		if newID, ok := tabIDs[id]; ok {
+			members = append(members, newID)
+		}
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	color := snap.Color
+	if !color.Valid() {
+		color = TabGroupColorGrey
+	}
+	return &TabGroup{
+		ID:        TabGroupID(idGen()),
+		Name:      snap.Name,
+		Color:     color,
+		TabIDs:    members,
+		Collapsed: snap.Collapsed,
+	}
+}
+
 // TabListFromSnapshot reconstructs a TabList from a SessionState snapshot.
 // Generates new IDs for all entities using the provided generator.
 // This is the inverse of SnapshotFromTabList.
@@ -223,10 +423,14 @@ func TabListFromSnapshot(state *SessionState, idGen IDGenerator) *TabList {
 	}
 	if state.Version >= SessionStateVersion {
 		tabs, activeIndex := flattenWindowTabSnapshots(state.Windows, state.ActiveWindowIndex)
-		return tabListFromSnapshots(tabs, activeIndex, idGen)
+		var groups []TabGroupSnapshot
+		for _, win := range state.Windows {
+			groups = append(groups, win.TabGroups...)
+		}
+		return tabListFromSnapshots(tabs, activeIndex, groups, idGen)
 	}
 
-	return tabListFromSnapshots(state.Tabs, state.ActiveTabIndex, idGen)
+	return tabListFromSnapshots(state.Tabs, state.ActiveTabIndex, state.TabGroups, idGen)
 }
 
 func flattenWindowTabSnapshots(windows []WindowSnapshot, activeWindowIndex int) ([]TabSnapshot, int) {
@@ -253,6 +457,13 @@ func flattenWindowTabSnapshots(windows []WindowSnapshot, activeWindowIndex int)
 	return flattened, activeTabIndex
 }
 
+// TabFromSnapshot reconstructs a single tab from snap, with new IDs for the
+// tab, its workspace and every pane. Returns nil when snap has no pane tree.
+// This is the inverse of SnapshotTab.
+func TabFromSnapshot(snap TabSnapshot, idGen IDGenerator) *Tab {
+	return tabFromSnapshot(&snap, idGen)
+}
+
 func tabFromSnapshot(snap *TabSnapshot, idGen IDGenerator) *Tab {
 	if snap == nil {
 		return nil
@@ -815,3 +815,189 @@ func TestV2SnapshotJSON_CanStillReadV1(t *testing.T) {
 	assert.Equal(t, "MyTab", state.Tabs[0].Name)
 	assert.Equal(t, 0, state.ActiveTabIndex)
 }
+
+func pinTestTab(id entity.TabID, uri string, pinned bool) entity.TabSnapshot {
+	return entity.TabSnapshot{
+		ID:       id,
+		IsPinned: pinned,
+		Workspace: entity.WorkspaceSnapshot{
+			Root: &entity.PaneNodeSnapshot{Pane: &entity.PaneSnapshot{ID: entity.PaneID(id + "-pane"), URI: uri}},
+		},
+	}
+}
+
+func TestSessionStatePinnedOnly_V2KeepsOnlyPinnedTabs(t *testing.T) {
+	state := &entity.SessionState{
+		Version: entity.SessionStateVersion,
+		Windows: []entity.WindowSnapshot{
+			{
+				ID: "w1",
+				Tabs: []entity.TabSnapshot{
+					pinTestTab("a", "https://mail.example", true),
+					pinTestTab("b", "https://scratch.example", false),
+					pinTestTab("c", "https://chat.example", true),
+				},
+				ActiveTabIndex: 2,
+			},
+			{
+				ID:             "w2",
+				Tabs:           []entity.TabSnapshot{pinTestTab("d", "https://throwaway.example", false)},
+				ActiveTabIndex: 0,
+			},
+		},
+		ActiveWindowIndex: 0,
+	}
+
+	filtered := state.PinnedOnly()
+
+	require.Len(t, filtered.Windows, 1)
+	win := filtered.Windows[0]
+	assert.Equal(t, entity.WindowID("w1"), win.ID)
+	require.Len(t, win.Tabs, 2)
+	assert.Equal(t, entity.TabID("a"), win.Tabs[0].ID)
+	assert.Equal(t, entity.TabID("c"), win.Tabs[1].ID)
+	assert.Equal(t, 1, win.Tabs[1].Position)
+	assert.Equal(t, 1, win.ActiveTabIndex)
+	assert.Equal(t, 2, filtered.CountPanes())
+
+	// The original state is untouched.
+	assert.Len(t, state.Windows, 2)
+	assert.Len(t, state.Windows[0].Tabs, 3)
+
+	restored := entity.WindowTabListsFromSnapshot(filtered, mockIDGenerator())
+	require.Len(t, restored, 1)
+	require.Len(t, restored[0].Tabs.Tabs, 2)
+	for _, tab := range restored[0].Tabs.Tabs {
+		assert.True(t, tab.IsPinned)
+	}
+}
+
+func TestSessionStatePinnedOnly_ActiveWindowDroppedFallsBackToFirst(t *testing.T) {
+	state := &entity.SessionState{
+		Version: entity.SessionStateVersion,
+		Windows: []entity.WindowSnapshot{
+			{ID: "w1", Tabs: []entity.TabSnapshot{pinTestTab("a", "https://a.example", true)}},
+			{ID: "w2", Tabs: []entity.TabSnapshot{pinTestTab("b", "https://b.example", false)}},
+		},
+		ActiveWindowIndex: 1,
+	}
+
+	filtered := state.PinnedOnly()
+
+	require.Len(t, filtered.Windows, 1)
+	assert.Equal(t, 0, filtered.ActiveWindowIndex)
+	assert.Equal(t, 0, filtered.Windows[0].ActiveTabIndex)
+}
+
+func TestSessionStatePinnedOnly_V1AndNoPinnedTabs(t *testing.T) {
+	state := &entity.SessionState{
+		Version: entity.LegacySessionStateVersion,
+		Tabs: []entity.TabSnapshot{
+			pinTestTab("a", "https://a.example", false),
+			pinTestTab("b", "https://b.example", true),
+		},
+		ActiveTabIndex: 0,
+	}
+
+	filtered := state.PinnedOnly()
+	require.Len(t, filtered.Tabs, 1)
+	assert.Equal(t, entity.TabID("b"), filtered.Tabs[0].ID)
+	assert.Equal(t, 0, filtered.ActiveTabIndex)
+
+	state.Tabs[1].IsPinned = false
+	assert.Zero(t, state.PinnedOnly().CountPanes())
+	assert.Nil(t, (*entity.SessionState)(nil).PinnedOnly())
+}
+
+func TestSnapshotTabGroups_JSONRoundTrip(t *testing.T) {
+	tabs := entity.NewTabList()
+	for _, id := range []entity.TabID{"t1", "t2", "t3"} {
+		pane := entity.NewPane(entity.PaneID("p-" + id))
+		pane.URI = "https://" + string(id) + ".example/"
+		tabs.Add(entity.NewTab(id, entity.WorkspaceID("ws-"+id), pane))
+	}
+	require.True(t, tabs.AddGroup(&entity.TabGroup{
+		ID:        "g1",
+		Name:      "dumber",
+		Color:     entity.TabGroupColorGreen,
+		TabIDs:    []entity.TabID{"t3", "t1"},
+		Collapsed: true,
+	}))
+
+	state := entity.SnapshotFromWindowTabLists("sess", []entity.WindowTabListState{
+		{WindowID: "w1", Tabs: tabs},
+	}, 0, time.Unix(123, 0))
+	require.Len(t, state.Windows, 1)
+	assert.Equal(t, []entity.TabGroupSnapshot{{
+		ID:        "g1",
+		Name:      "dumber",
+		Color:     entity.TabGroupColorGreen,
+		TabIDs:    []entity.TabID{"t3", "t1"},
+		Collapsed: true,
+	}}, state.Windows[0].TabGroups)
+
+	data, err := json.Marshal(state)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"tab_groups":[{"id":"g1","name":"dumber","color":"green","tab_ids":["t3","t1"],"collapsed":true}]`)
+
+	var decoded entity.SessionState
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	restored := entity.WindowTabListsFromSnapshot(&decoded, mockIDGenerator())
+	require.Len(t, restored, 1)
+	list := restored[0].Tabs
+	require.Len(t, list.Tabs, 3)
+	require.Len(t, list.Groups, 1)
+
+	group := list.Groups[0]
+	assert.NotEqual(t, entity.TabGroupID("g1"), group.ID, "restored groups get new IDs")
+	assert.Equal(t, "dumber", group.Name)
+	assert.Equal(t, entity.TabGroupColorGreen, group.Color)
+	assert.True(t, group.Collapsed)
+	assert.Equal(t, []entity.TabID{list.Tabs[2].ID, list.Tabs[0].ID}, group.TabIDs, "members follow their tabs to the new IDs")
+	assert.Nil(t, list.GroupOf(list.Tabs[1].ID))
+}
+
+func TestTabListFromSnapshot_TabGroupsSkipMissingTabs(t *testing.T) {
+	state := &entity.SessionState{
+		Version: entity.LegacySessionStateVersion,
+		Tabs: []entity.TabSnapshot{
+			pinTestTab("a", "https://a.example", false),
+			{ID: "no-tree"},
+		},
+		TabGroups: []entity.TabGroupSnapshot{
+			{ID: "kept", Name: "Kept", Color: "mauve", TabIDs: []entity.TabID{"gone", "a"}},
+			{ID: "empty", Name: "Empty", Color: entity.TabGroupColorRed, TabIDs: []entity.TabID{"no-tree"}},
+		},
+	}
+
+	tabs := entity.TabListFromSnapshot(state, mockIDGenerator())
+
+	require.Len(t, tabs.Tabs, 1)
+	require.Len(t, tabs.Groups, 1, "a group with no restored tab is dropped")
+	assert.Equal(t, "Kept", tabs.Groups[0].Name)
+	assert.Equal(t, []entity.TabID{tabs.Tabs[0].ID}, tabs.Groups[0].TabIDs)
+	assert.Equal(t, entity.TabGroupColorGrey, tabs.Groups[0].Color, "unknown colors fall back to grey")
+}
+
+func TestSessionStatePinnedOnly_KeepsGroupsOfPinnedTabs(t *testing.T) {
+	state := &entity.SessionState{
+		Version: entity.SessionStateVersion,
+		Windows: []entity.WindowSnapshot{{
+			ID: "w1",
+			Tabs: []entity.TabSnapshot{
+				pinTestTab("a", "https://mail.example", true),
+				pinTestTab("b", "https://scratch.example", false),
+			},
+			TabGroups: []entity.TabGroupSnapshot{
+				{ID: "mixed", TabIDs: []entity.TabID{"a", "b"}},
+				{ID: "unpinned", TabIDs: []entity.TabID{"b"}},
+			},
+		}},
+	}
+
+	filtered := state.PinnedOnly()
+
+	require.Len(t, filtered.Windows, 1)
+	assert.Equal(t, []entity.TabGroupSnapshot{{ID: "mixed", TabIDs: []entity.TabID{"a"}}}, filtered.Windows[0].TabGroups)
+	assert.Equal(t, []entity.TabID{"a", "b"}, state.Windows[0].TabGroups[0].TabIDs, "the original state is untouched")
+}
@@ -0,0 +1,30 @@
+package entity
+
+// ColorScheme is a color scheme override for pages.
+type ColorScheme string
+
+const (
+	// ColorSchemeSystem follows the system preference; it is no override.
+	ColorSchemeSystem ColorScheme = "system"
+	// ColorSchemeLight reports a light color scheme to pages.
+	ColorSchemeLight ColorScheme = "light"
+	// ColorSchemeDark reports a dark color scheme to pages.
+	ColorSchemeDark ColorScheme = "dark"
+)
+
+// IsValid reports whether s is a known color scheme.
+func (s ColorScheme) IsValid() bool {
+	switch s {
+	case ColorSchemeSystem, ColorSchemeLight, ColorSchemeDark:
+		return true
+	default:
+		return false
+	}
+}
+
+// SiteColorScheme is the color scheme remembered for a domain.
+type SiteColorScheme struct {
+	Domain    string      // Site domain, as keyed by the use case
+	Scheme    ColorScheme // Never ColorSchemeSystem; that is stored as no row
+	UpdatedAt int64       // Unix timestamp in seconds of the last change
+}
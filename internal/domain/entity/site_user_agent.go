@@ -0,0 +1,30 @@
+package entity
+
+// UserAgentPreset selects the user agent a site is requested with.
+type UserAgentPreset string
+
+const (
+	// UserAgentPresetDefault keeps the engine's own user agent.
+	UserAgentPresetDefault UserAgentPreset = ""
+	// UserAgentPresetDesktop requests the desktop version of sites.
+	UserAgentPresetDesktop UserAgentPreset = "desktop"
+	// UserAgentPresetMobile requests the mobile version of sites.
+	UserAgentPresetMobile UserAgentPreset = "mobile"
+)
+
+// IsValid reports whether p is a known preset.
+func (p UserAgentPreset) IsValid() bool {
+	switch p {
+	case UserAgentPresetDefault, UserAgentPresetDesktop, UserAgentPresetMobile:
+		return true
+	default:
+		return false
+	}
+}
+
+// SiteUserAgent is the user agent preset remembered for a domain.
+type SiteUserAgent struct {
+	Domain    string          // Site domain, as keyed by the use case
+	Preset    UserAgentPreset // Never UserAgentPresetDefault; that is stored as no row
+	UpdatedAt int64           // Unix timestamp in seconds of the last change
+}
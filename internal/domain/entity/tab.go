@@ -18,6 +18,10 @@ type Tab struct {
 	Position  int        // Position in the tab bar (0-indexed)
 	IsPinned  bool       // Pinned tabs stay at the left
 	CreatedAt time.Time
+
+	// LastActivePaneID is the pane that was focused when the tab was last
+	// left. Switching back restores focus to it while it still exists.
+	LastActivePaneID PaneID
 }
 
 // NewTab creates a new tab with an initial pane.
@@ -39,6 +43,18 @@ func (t *Tab) Title() string {
 	return fmt.Sprintf("Tab %d", t.Position+1)
 }
 
+// PaneToFocus returns the pane to focus when the tab is shown: the pane last
+// used in it when it still exists, otherwise the workspace's active pane.
+func (t *Tab) PaneToFocus() PaneID {
+	if t == nil || t.Workspace == nil {
+		return ""
+	}
+	if t.LastActivePaneID != "" && t.Workspace.FindPane(t.LastActivePaneID) != nil {
+		return t.LastActivePaneID
+	}
+	return t.Workspace.ActivePaneID
+}
+
 // PaneCount returns the number of panes in this tab's workspace.
 func (t *Tab) PaneCount() int {
 	if t.Workspace == nil {
@@ -52,6 +68,7 @@ type TabList struct {
 	Tabs                []*Tab
 	ActiveTabID         TabID
 	PreviousActiveTabID TabID // Tracks last active tab for Alt+Tab style switching
+	Groups              []*TabGroup
 
 	mu sync.RWMutex
 }
@@ -75,7 +92,8 @@ func (tl *TabList) Add(tab *Tab) {
 	}
 }
 
-// Remove removes a tab by ID and reindexes positions.
+// Remove removes a tab by ID, takes it out of its group and reindexes
+// positions.
 func (tl *TabList) Remove(id TabID) bool {
 	tl.mu.Lock()
 	defer tl.mu.Unlock()
@@ -83,6 +101,7 @@ func (tl *TabList) Remove(id TabID) bool {
 	for i, tab := range tl.Tabs {
 		if tab.ID == id {
 			tl.Tabs = append(tl.Tabs[:i], tl.Tabs[i+1:]...)
+			tl.ungroupNoLock(id)
 			// Reindex positions
 			for j := i; j < len(tl.Tabs); j++ {
 				tl.Tabs[j].Position = j
@@ -150,6 +169,22 @@ func (tl *TabList) SetActive(id TabID) {
 	tl.ActiveTabID = id
 }
 
+// ForgetPane clears any last-active record pointing at paneID, so a closed
+// pane is never restored on tab switch.
+func (tl *TabList) ForgetPane(paneID PaneID) {
+	if paneID == "" {
+		return
+	}
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	for _, tab := range tl.Tabs {
+		if tab != nil && tab.LastActivePaneID == paneID {
+			tab.LastActivePaneID = ""
+		}
+	}
+}
+
 // TabAt returns the tab at the given 0-based index.
 func (tl *TabList) TabAt(index int) *Tab {
 	tl.mu.RLock()
@@ -193,7 +228,8 @@ func (tl *TabList) Move(id TabID, newPos int) bool {
 }
 
 // Snapshot returns an isolated copy of the TabList safe for concurrent read.
-// The returned TabList has its own slice, Tab values, and workspace tree.
+// The returned TabList has its own slice, Tab values, workspace tree and
+// groups.
 func (tl *TabList) Snapshot() *TabList {
 	if tl == nil {
 		return nil
@@ -205,10 +241,15 @@ func (tl *TabList) Snapshot() *TabList {
 	for _, tab := range tl.Tabs {
 		tabs = append(tabs, cloneTab(tab))
 	}
+	var groups []*TabGroup
+	for _, group := range tl.Groups {
+		groups = append(groups, cloneTabGroup(group))
+	}
 	return &TabList{
 		Tabs:                tabs,
 		ActiveTabID:         tl.ActiveTabID,
 		PreviousActiveTabID: tl.PreviousActiveTabID,
+		Groups:              groups,
 	}
 }
 
@@ -272,9 +313,11 @@ func (tl *TabList) ReplaceFrom(other *TabList) {
 		tl.Tabs = make([]*Tab, 0)
 		tl.ActiveTabID = ""
 		tl.PreviousActiveTabID = ""
+		tl.Groups = nil
 		return
 	}
 	tl.Tabs = snapshot.Tabs
 	tl.ActiveTabID = snapshot.ActiveTabID
 	tl.PreviousActiveTabID = snapshot.PreviousActiveTabID
+	tl.Groups = snapshot.Groups
 }
@@ -0,0 +1,210 @@
+package entity
+
+import "slices"
+
+// TabGroupID uniquely identifies a tab group within a tab list.
+type TabGroupID string
+
+// TabGroupColor names the color a tab group is marked with in the tab bar.
+type TabGroupColor string
+
+// Tab group colors, in the order new groups pick them.
+const (
+	TabGroupColorBlue   TabGroupColor = "blue"
+	TabGroupColorRed    TabGroupColor = "red"
+	TabGroupColorYellow TabGroupColor = "yellow"
+	TabGroupColorGreen  TabGroupColor = "green"
+	TabGroupColorPink   TabGroupColor = "pink"
+	TabGroupColorPurple TabGroupColor = "purple"
+	TabGroupColorCyan   TabGroupColor = "cyan"
+	TabGroupColorOrange TabGroupColor = "orange"
+	TabGroupColorGrey   TabGroupColor = "grey"
+)
+
+// TabGroupColors lists every tab group color.
+var TabGroupColors = []TabGroupColor{
+	TabGroupColorBlue,
+	TabGroupColorRed,
+	TabGroupColorYellow,
+	TabGroupColorGreen,
+	TabGroupColorPink,
+	TabGroupColorPurple,
+	TabGroupColorCyan,
+	TabGroupColorOrange,
+	TabGroupColorGrey,
+}
+
+// Valid reports whether c is one of TabGroupColors.
+func (c TabGroupColor) Valid() bool {
+	return slices.Contains(TabGroupColors, c)
+}
+
+// TabGroup is a named, colored set of tabs, such as the tabs of one project.
+// A collapsed group shows a single tab in the tab bar: the active tab when it
+// belongs to the group, otherwise the group's first tab.
+type TabGroup struct {
+	ID        TabGroupID
+	Name      string
+	Color     TabGroupColor
+	TabIDs    []TabID // Member tabs, in the order they joined
+	Collapsed bool
+}
+
+// Contains reports whether tabID is a member of the group.
+func (g *TabGroup) Contains(tabID TabID) bool {
+	return g != nil && slices.Contains(g.TabIDs, tabID)
+}
+
+func cloneTabGroup(group *TabGroup) *TabGroup {
+	if group == nil {
+		return nil
+	}
+	cloned := *group
+	cloned.TabIDs = slices.Clone(group.TabIDs)
+	return &cloned
+}
+
+// AddGroup appends group to the list. Members that are not in the list or
+// already belong to another group are dropped from it. Returns false, adding
+// nothing, when no member is left.
+func (tl *TabList) AddGroup(group *TabGroup) bool {
+	if group == nil {
+		return false
+	}
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	members := make([]TabID, 0, len(group.TabIDs))
+	for _, id := range group.TabIDs {
+		if tl.findNoLock(id) != nil && tl.groupOfNoLock(id) == nil && !slices.Contains(members, id) {
+			members = append(members, id)
+		}
+	}
+	if len(members) == 0 {
+		return false
+	}
+	group.TabIDs = members
+	tl.Groups = append(tl.Groups, group)
+	return true
+}
+
+// FindGroup returns a group by ID.
+func (tl *TabList) FindGroup(id TabGroupID) *TabGroup {
+	tl.mu.RLock()
+	defer tl.mu.RUnlock()
+
+	for _, group := range tl.Groups {
+		if group.ID == id {
+			return group
+		}
+	}
+	return nil
+}
+
+// GroupOf returns the group tabID belongs to, or nil when it is ungrouped.
+func (tl *TabList) GroupOf(tabID TabID) *TabGroup {
+	tl.mu.RLock()
+	defer tl.mu.RUnlock()
+
+	return tl.groupOfNoLock(tabID)
+}
+
+func (tl *TabList) groupOfNoLock(tabID TabID) *TabGroup {
+	for _, group := range tl.Groups {
+		if group.Contains(tabID) {
+			return group
+		}
+	}
+	return nil
+}
+
+// AssignGroup moves tabID into the group groupID, taking it out of the group
+// it was in. An empty groupID only ungroups the tab. Groups left without tabs
+// are removed. Returns false when the tab or group does not exist.
+func (tl *TabList) AssignGroup(tabID TabID, groupID TabGroupID) bool {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	if tl.findNoLock(tabID) == nil {
+		return false
+	}
+	var target *TabGroup
+	if groupID != "" {
+		for _, group := range tl.Groups {
+			if group.ID == groupID {
+				target = group
+				break
+			}
+		}
+		if target == nil {
+			return false
+		}
+		if target.Contains(tabID) {
+			return true
+		}
+	}
+
+	tl.ungroupNoLock(tabID)
+	if target != nil {
+		target.TabIDs = append(target.TabIDs, tabID)
+	}
+	return true
+}
+
+// ungroupNoLock takes tabID out of its group, removing the group when it is
+// left empty.
+func (tl *TabList) ungroupNoLock(tabID TabID) {
+	group := tl.groupOfNoLock(tabID)
+	if group == nil {
+		return
+	}
+	group.TabIDs = slices.DeleteFunc(group.TabIDs, func(id TabID) bool { return id == tabID })
+	if len(group.TabIDs) == 0 {
+		tl.Groups = slices.DeleteFunc(tl.Groups, func(g *TabGroup) bool { return g == group })
+	}
+}
+
+// SetGroupCollapsed collapses or expands a group. Returns false when the
+// group does not exist.
+func (tl *TabList) SetGroupCollapsed(id TabGroupID, collapsed bool) bool {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	for _, group := range tl.Groups {
+		if group.ID == id {
+			group.Collapsed = collapsed
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveGroup removes a group, leaving its tabs ungrouped.
+func (tl *TabList) RemoveGroup(id TabGroupID) bool {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	before := len(tl.Groups)
+	tl.Groups = slices.DeleteFunc(tl.Groups, func(group *TabGroup) bool { return group.ID == id })
+	return len(tl.Groups) != before
+}
+
+// IsTabHidden reports whether tabID is folded away in a collapsed group.
+func (tl *TabList) IsTabHidden(tabID TabID) bool {
+	tl.mu.RLock()
+	defer tl.mu.RUnlock()
+
+	group := tl.groupOfNoLock(tabID)
+	if group == nil || !group.Collapsed {
+		return false
+	}
+	return tabID != tl.shownTabNoLock(group)
+}
+
+// shownTabNoLock returns the tab a collapsed group keeps in the tab bar.
+func (tl *TabList) shownTabNoLock(group *TabGroup) TabID {
+	if group.Contains(tl.ActiveTabID) {
+		return tl.ActiveTabID
+	}
+	return group.TabIDs[0]
+}
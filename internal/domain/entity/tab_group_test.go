@@ -0,0 +1,122 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGroupTestTabList(ids ...entity.TabID) *entity.TabList {
+	tabs := entity.NewTabList()
+	for _, id := range ids {
+		tabs.Add(entity.NewTab(id, entity.WorkspaceID("ws-"+id), entity.NewPane(entity.PaneID("pane-"+id))))
+	}
+	return tabs
+}
+
+func TestTabListAddGroup_DropsUnknownAndAlreadyGroupedTabs(t *testing.T) {
+	tabs := newGroupTestTabList("a", "b", "c")
+
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "g1", TabIDs: []entity.TabID{"a", "b"}}))
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "g2", TabIDs: []entity.TabID{"b", "c", "c", "missing"}}))
+	assert.False(t, tabs.AddGroup(&entity.TabGroup{ID: "g3", TabIDs: []entity.TabID{"a"}}), "every member is taken")
+	assert.False(t, tabs.AddGroup(nil))
+
+	require.Len(t, tabs.Groups, 2)
+	assert.Equal(t, []entity.TabID{"a", "b"}, tabs.FindGroup("g1").TabIDs)
+	assert.Equal(t, []entity.TabID{"c"}, tabs.FindGroup("g2").TabIDs)
+	assert.Nil(t, tabs.FindGroup("g3"))
+}
+
+func TestTabListAssignGroup_MovesTabBetweenGroups(t *testing.T) {
+	tabs := newGroupTestTabList("a", "b", "c")
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "work", TabIDs: []entity.TabID{"a", "b"}}))
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "home", TabIDs: []entity.TabID{"c"}}))
+
+	require.True(t, tabs.AssignGroup("a", "home"))
+	assert.Equal(t, []entity.TabID{"b"}, tabs.FindGroup("work").TabIDs)
+	assert.Equal(t, []entity.TabID{"c", "a"}, tabs.FindGroup("home").TabIDs)
+	assert.Equal(t, entity.TabGroupID("home"), tabs.GroupOf("a").ID)
+
+	require.True(t, tabs.AssignGroup("a", "home"), "assigning to the current group is a no-op")
+	assert.Equal(t, []entity.TabID{"c", "a"}, tabs.FindGroup("home").TabIDs)
+
+	assert.False(t, tabs.AssignGroup("a", "missing"))
+	assert.False(t, tabs.AssignGroup("missing", "home"))
+	assert.Equal(t, entity.TabGroupID("home"), tabs.GroupOf("a").ID, "a failed assignment keeps the tab in place")
+
+	require.True(t, tabs.AssignGroup("b", ""))
+	assert.Nil(t, tabs.GroupOf("b"))
+	assert.Nil(t, tabs.FindGroup("work"), "a group left empty is removed")
+}
+
+func TestTabListRemove_DropsTabFromGroup(t *testing.T) {
+	tabs := newGroupTestTabList("a", "b", "c")
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "g", TabIDs: []entity.TabID{"a", "b"}}))
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "solo", TabIDs: []entity.TabID{"c"}}))
+
+	require.True(t, tabs.Remove("a"))
+	assert.Equal(t, []entity.TabID{"b"}, tabs.FindGroup("g").TabIDs)
+
+	require.True(t, tabs.Remove("c"))
+	assert.Nil(t, tabs.FindGroup("solo"))
+}
+
+func TestTabListRemoveGroup_UngroupsTabs(t *testing.T) {
+	tabs := newGroupTestTabList("a", "b")
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "g", TabIDs: []entity.TabID{"a", "b"}}))
+
+	assert.True(t, tabs.RemoveGroup("g"))
+	assert.False(t, tabs.RemoveGroup("g"))
+	assert.Nil(t, tabs.GroupOf("a"))
+	assert.Equal(t, 2, tabs.Count())
+}
+
+func TestTabListIsTabHidden_CollapsedGroupShowsOneTab(t *testing.T) {
+	tabs := newGroupTestTabList("a", "b", "c", "d")
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "g", TabIDs: []entity.TabID{"b", "c"}}))
+	tabs.SetActive("a")
+
+	assert.False(t, tabs.IsTabHidden("c"), "expanded groups hide nothing")
+
+	require.True(t, tabs.SetGroupCollapsed("g", true))
+	assert.False(t, tabs.SetGroupCollapsed("missing", true))
+	assert.False(t, tabs.IsTabHidden("a"))
+	assert.False(t, tabs.IsTabHidden("b"), "the first tab stands for the group")
+	assert.True(t, tabs.IsTabHidden("c"))
+	assert.False(t, tabs.IsTabHidden("d"))
+
+	tabs.SetActive("c")
+	assert.True(t, tabs.IsTabHidden("b"))
+	assert.False(t, tabs.IsTabHidden("c"), "the active tab stays shown")
+}
+
+func TestTabListSnapshot_ClonesGroups(t *testing.T) {
+	tabs := newGroupTestTabList("a", "b")
+	require.True(t, tabs.AddGroup(&entity.TabGroup{ID: "g", Name: "Work", Color: entity.TabGroupColorBlue, TabIDs: []entity.TabID{"a"}}))
+
+	snapshot := tabs.Snapshot()
+	require.True(t, tabs.AssignGroup("b", "g"))
+	require.True(t, tabs.SetGroupCollapsed("g", true))
+
+	group := snapshot.FindGroup("g")
+	require.NotNil(t, group)
+	assert.Equal(t, []entity.TabID{"a"}, group.TabIDs)
+	assert.False(t, group.Collapsed)
+
+	replaced := entity.NewTabList()
+	replaced.ReplaceFrom(tabs)
+	assert.Equal(t, []entity.TabID{"a", "b"}, replaced.FindGroup("g").TabIDs)
+	replaced.ReplaceFrom(nil)
+	assert.Empty(t, replaced.Groups)
+}
+
+func TestTabGroupColorValid(t *testing.T) {
+	for _, color := range entity.TabGroupColors {
+		assert.True(t, color.Valid(), color)
+	}
+	assert.False(t, entity.TabGroupColor("mauve").Valid())
+	assert.False(t, entity.TabGroupColor("").Valid())
+}
@@ -77,7 +77,9 @@ type ResolvedTheme struct {
 	Fonts             ThemeFonts
 	UIScale           float64
 	ModeColors        ThemeModeColors
-	Warnings          []ThemeWarning
+	// WorkspaceBackground has a valid color and pattern, or none.
+	WorkspaceBackground WorkspaceBackgroundConfig
+	Warnings            []ThemeWarning
 }
 
 // hexColorRegex matches valid CSS-safe #RRGGBB hex colors (strict).
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TypeToFindKey is a key press reported by a page for type-to-find, together
+// with the element that had focus when it was typed.
+type TypeToFindKey struct {
+	// Key is the DOM KeyboardEvent.key value.
+	Key  string `json:"key"`
+	Ctrl bool   `json:"ctrl"`
+	Alt  bool   `json:"alt"`
+	Meta bool   `json:"meta"`
+	// FocusTag is the tag name of the focused element.
+	FocusTag string `json:"focusTag"`
+	// FocusType is the type attribute of a focused input element.
+	FocusType string `json:"focusType"`
+	// FocusRole is the ARIA role of the focused element.
+	FocusRole string `json:"focusRole"`
+	// FocusEditable reports contenteditable content or a document in design
+	// mode.
+	FocusEditable bool `json:"focusEditable"`
+}
+
+// typeToFindInputTypes are input types that take no typed text, so keys typed
+// while they have focus may start a search.
+var typeToFindInputTypes = map[string]bool{
+	"button":   true,
+	"checkbox": true,
+	"color":    true,
+	"file":     true,
+	"image":    true,
+	"radio":    true,
+	"range":    true,
+	"reset":    true,
+	"submit":   true,
+}
+
+// typeToFindBlockedRoles are ARIA roles of widgets that handle typed keys
+// themselves.
+var typeToFindBlockedRoles = map[string]bool{
+	"application": true,
+	"combobox":    true,
+	"grid":        true,
+	"listbox":     true,
+	"searchbox":   true,
+	"spinbutton":  true,
+	"textbox":     true,
+	"tree":        true,
+}
+
+// Text returns the character to search for, or ok=false when the key belongs
+// to the page: shortcuts with ctrl, alt or meta, keys that do not produce a
+// single visible character, and anything typed into a form field, editable
+// content or a widget that takes typed input. Space is left to the page so
+// it keeps scrolling.
+func (k TypeToFindKey) Text() (string, bool) {
+	if k.Ctrl || k.Alt || k.Meta || k.FocusEditable {
+		return "", false
+	}
+	r, size := utf8.DecodeRuneInString(k.Key)
+	if r == utf8.RuneError || size != len(k.Key) || !unicode.IsPrint(r) || unicode.IsSpace(r) {
+		return "", false
+	}
+	if !typeToFindFocusAllows(k.FocusTag, k.FocusType, k.FocusRole) {
+		return "", false
+	}
+	return k.Key, true
+}
+
+func typeToFindFocusAllows(tag, inputType, role string) bool {
+	for _, r := range strings.Fields(strings.ToLower(role)) {
+		if typeToFindBlockedRoles[r] {
+			return false
+		}
+	}
+	switch strings.ToLower(tag) {
+	case "textarea", "select", "embed", "object":
+		return false
+	case "input":
+		return typeToFindInputTypes[strings.ToLower(strings.TrimSpace(inputType))]
+	default:
+		return true
+	}
+}
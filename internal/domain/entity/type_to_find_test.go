@@ -0,0 +1,53 @@
+package entity
+
+import "testing"
+
+func TestTypeToFindKeyText(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    TypeToFindKey
+		want   string
+		wantOK bool
+	}{
+		{name: "letter on page body", key: TypeToFindKey{Key: "a", FocusTag: "BODY"}, want: "a", wantOK: true},
+		{name: "no focused element", key: TypeToFindKey{Key: "7"}, want: "7", wantOK: true},
+		{name: "punctuation", key: TypeToFindKey{Key: "/", FocusTag: "body"}, want: "/", wantOK: true},
+		{name: "non-ascii letter", key: TypeToFindKey{Key: "é", FocusTag: "body"}, want: "é", wantOK: true},
+		{name: "focused link", key: TypeToFindKey{Key: "x", FocusTag: "A"}, want: "x", wantOK: true},
+		{name: "focused button", key: TypeToFindKey{Key: "x", FocusTag: "button"}, want: "x", wantOK: true},
+		{name: "focused checkbox", key: TypeToFindKey{Key: "x", FocusTag: "INPUT", FocusType: "checkbox"}, want: "x", wantOK: true},
+		{name: "shift is allowed", key: TypeToFindKey{Key: "A", FocusTag: "body"}, want: "A", wantOK: true},
+
+		{name: "ctrl shortcut", key: TypeToFindKey{Key: "f", Ctrl: true, FocusTag: "body"}},
+		{name: "alt shortcut", key: TypeToFindKey{Key: "f", Alt: true, FocusTag: "body"}},
+		{name: "meta shortcut", key: TypeToFindKey{Key: "f", Meta: true, FocusTag: "body"}},
+		{name: "space scrolls", key: TypeToFindKey{Key: " ", FocusTag: "body"}},
+		{name: "named key", key: TypeToFindKey{Key: "Enter", FocusTag: "body"}},
+		{name: "dead key", key: TypeToFindKey{Key: "Dead", FocusTag: "body"}},
+		{name: "empty key", key: TypeToFindKey{FocusTag: "body"}},
+		{name: "control character", key: TypeToFindKey{Key: "\t", FocusTag: "body"}},
+		{name: "invalid utf-8", key: TypeToFindKey{Key: "\xff", FocusTag: "body"}},
+
+		{name: "text input", key: TypeToFindKey{Key: "a", FocusTag: "INPUT", FocusType: "text"}},
+		{name: "input without type", key: TypeToFindKey{Key: "a", FocusTag: "input"}},
+		{name: "password input", key: TypeToFindKey{Key: "a", FocusTag: "input", FocusType: "password"}},
+		{name: "search input", key: TypeToFindKey{Key: "a", FocusTag: "input", FocusType: "Search"}},
+		{name: "number input", key: TypeToFindKey{Key: "1", FocusTag: "input", FocusType: "number"}},
+		{name: "textarea", key: TypeToFindKey{Key: "a", FocusTag: "TEXTAREA"}},
+		{name: "select", key: TypeToFindKey{Key: "a", FocusTag: "select"}},
+		{name: "plugin", key: TypeToFindKey{Key: "a", FocusTag: "embed"}},
+		{name: "contenteditable", key: TypeToFindKey{Key: "a", FocusTag: "div", FocusEditable: true}},
+		{name: "textbox role", key: TypeToFindKey{Key: "a", FocusTag: "div", FocusRole: "textbox"}},
+		{name: "combobox among roles", key: TypeToFindKey{Key: "a", FocusTag: "div", FocusRole: "presentation Combobox"}},
+		{name: "application role", key: TypeToFindKey{Key: "a", FocusTag: "div", FocusRole: "application"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.key.Text()
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("Text() = %q, %v; want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package entity
+
+// UserScriptRunAt controls when a user script runs during page load.
+type UserScriptRunAt string
+
+const (
+	// UserScriptRunAtDocumentStart runs before any page script, while the
+	// document is still empty.
+	UserScriptRunAtDocumentStart UserScriptRunAt = "document-start"
+	// UserScriptRunAtDocumentEnd runs once the DOM has been parsed. This is
+	// the default when a script does not declare @run-at.
+	UserScriptRunAtDocumentEnd UserScriptRunAt = "document-end"
+)
+
+// UserScript is a Greasemonkey-style script loaded from the user scripts
+// directory. Matches and Excludes hold the raw @match/@include and @exclude
+// patterns from the metadata block.
+type UserScript struct {
+	Name     string
+	Path     string
+	Matches  []string
+	Excludes []string
+	RunAt    UserScriptRunAt
+	Source   string
+}
@@ -0,0 +1,38 @@
+package entity
+
+// Smallest window size restored from saved geometry. Anything smaller is
+// treated as corrupt and the configured default size is used instead.
+const (
+	MinWindowWidth  = 360
+	MinWindowHeight = 240
+)
+
+// WindowGeometry is the size and maximized state of a browser window, kept
+// across restarts. The size is the unmaximized size so un-maximizing a
+// restored window returns to it. GTK4 leaves window placement to the
+// compositor, so no position is kept.
+type WindowGeometry struct {
+	Width     int
+	Height    int
+	Maximized bool
+}
+
+// Valid reports whether the size is large enough to restore.
+func (g WindowGeometry) Valid() bool {
+	return g.Width >= MinWindowWidth && g.Height >= MinWindowHeight
+}
+
+// ClampTo shrinks the size to fit a maxWidth x maxHeight monitor, keeping the
+// minimum size. A non-positive bound leaves that dimension unclamped, for
+// when the monitor size is unknown.
+func (g WindowGeometry) ClampTo(maxWidth, maxHeight int) WindowGeometry {
+	if maxWidth > 0 {
+		g.Width = min(g.Width, max(maxWidth, MinWindowWidth))
+	}
+	if maxHeight > 0 {
+		g.Height = min(g.Height, max(maxHeight, MinWindowHeight))
+	}
+	g.Width = max(g.Width, MinWindowWidth)
+	g.Height = max(g.Height, MinWindowHeight)
+	return g
+}
@@ -0,0 +1,58 @@
+package entity
+
+import "testing"
+
+func TestWindowGeometryClampTo(t *testing.T) {
+	tests := []struct {
+		name                string
+		geometry            WindowGeometry
+		maxWidth, maxHeight int
+		want                WindowGeometry
+	}{
+		{
+			name:     "fits",
+			geometry: WindowGeometry{Width: 1280, Height: 800},
+			maxWidth: 1920, maxHeight: 1080,
+			want: WindowGeometry{Width: 1280, Height: 800},
+		},
+		{
+			name:     "larger than monitor",
+			geometry: WindowGeometry{Width: 3000, Height: 2000, Maximized: true},
+			maxWidth: 1920, maxHeight: 1080,
+			want: WindowGeometry{Width: 1920, Height: 1080, Maximized: true},
+		},
+		{
+			name:     "unknown monitor",
+			geometry: WindowGeometry{Width: 3000, Height: 2000},
+			want:     WindowGeometry{Width: 3000, Height: 2000},
+		},
+		{
+			name:     "below minimum",
+			geometry: WindowGeometry{Width: 100, Height: 50},
+			maxWidth: 1920, maxHeight: 1080,
+			want: WindowGeometry{Width: MinWindowWidth, Height: MinWindowHeight},
+		},
+		{
+			name:     "monitor smaller than minimum",
+			geometry: WindowGeometry{Width: 800, Height: 600},
+			maxWidth: 320, maxHeight: 200,
+			want: WindowGeometry{Width: MinWindowWidth, Height: MinWindowHeight},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.geometry.ClampTo(tt.maxWidth, tt.maxHeight); got != tt.want {
+				t.Fatalf("ClampTo(%d, %d) = %+v, want %+v", tt.maxWidth, tt.maxHeight, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindowGeometryValid(t *testing.T) {
+	if !(WindowGeometry{Width: MinWindowWidth, Height: MinWindowHeight}).Valid() {
+		t.Fatal("minimum size should be valid")
+	}
+	if (WindowGeometry{Width: 0, Height: 800}).Valid() {
+		t.Fatal("zero width should be invalid")
+	}
+}
@@ -7,9 +7,10 @@ type WindowID string
 
 // WindowSnapshot captures the state of a single browser window.
 type WindowSnapshot struct {
-	ID             WindowID      `json:"id"`
-	Tabs           []TabSnapshot `json:"tabs"`
-	ActiveTabIndex int           `json:"active_tab_index"`
+	ID             WindowID           `json:"id"`
+	Tabs           []TabSnapshot      `json:"tabs"`
+	ActiveTabIndex int                `json:"active_tab_index"`
+	TabGroups      []TabGroupSnapshot `json:"tab_groups,omitempty"`
 }
 
 // WindowTabListState pairs a window ID with its live TabList.
@@ -62,6 +63,7 @@ func windowToSnapshot(id WindowID, tabs *TabList) WindowSnapshot {
 		ID:             id,
 		Tabs:           snapTabs,
 		ActiveTabIndex: activeTabIndex,
+		TabGroups:      snapshotTabGroups(tabs.Groups),
 	}
 }
 
@@ -99,5 +101,5 @@ func tabListFromWindowSnapshot(snap *WindowSnapshot, idGen IDGenerator) *TabList
 		return NewTabList()
 	}
 
-	return tabListFromSnapshots(snap.Tabs, snap.ActiveTabIndex, idGen)
+	return tabListFromSnapshots(snap.Tabs, snap.ActiveTabIndex, snap.TabGroups, idGen)
 }
@@ -65,6 +65,20 @@ func (w *Workspace) AllPanes() []*Pane {
 	return panes
 }
 
+// ShareableURLs returns the pane URLs in tree order (depth-first, children in
+// order, every pane of a stack). Ephemeral panes (see IsEphemeralSessionURI)
+// are skipped.
+func (w *Workspace) ShareableURLs() []SessionURL {
+	var urls []SessionURL
+	for _, pane := range w.AllPanes() {
+		if IsEphemeralSessionURI(pane.URI) {
+			continue
+		}
+		urls = append(urls, SessionURL{URL: pane.URI, Title: pane.Title})
+	}
+	return urls
+}
+
 // VisibleAreaCount returns the number of visible pane areas.
 // Stacked panes count as 1 (only one visible at a time).
 func (w *Workspace) VisibleAreaCount() int {
@@ -73,3 +87,33 @@ func (w *Workspace) VisibleAreaCount() int {
 	}
 	return w.Root.VisibleAreaCount()
 }
+
+// MaxPaneNumber is the highest number assigned by NumberedPanes.
+// Panes beyond it stay unlabeled since only the 1-9 keys select them.
+const MaxPaneNumber = 9
+
+// NumberedPanes returns the visible panes that carry a jump-to-pane number.
+// The pane at index i is labeled i+1; at most MaxPaneNumber panes are returned.
+func (w *Workspace) NumberedPanes() []*PaneNode {
+	if w.Root == nil {
+		return nil
+	}
+	visible := w.Root.VisiblePanes()
+	if len(visible) > MaxPaneNumber {
+		visible = visible[:MaxPaneNumber]
+	}
+	return visible
+}
+
+// PaneByNumber returns the visible pane labeled n (1-based), or nil when no
+// pane carries that number.
+func (w *Workspace) PaneByNumber(n int) *PaneNode {
+	if n < 1 || n > MaxPaneNumber {
+		return nil
+	}
+	numbered := w.NumberedPanes()
+	if n > len(numbered) {
+		return nil
+	}
+	return numbered[n-1]
+}
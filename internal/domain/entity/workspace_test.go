@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+)
+
+func paneIDs(nodes []*PaneNode) []PaneID {
+	ids := make([]PaneID, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.Pane.ID)
+	}
+	return ids
+}
+
+func leaf(id string) *PaneNode {
+	return &PaneNode{ID: id, Pane: NewPane(PaneID(id))}
+}
+
+func split(dir SplitDirection, left, right *PaneNode) *PaneNode {
+	return &PaneNode{ID: "split-" + left.ID + "-" + right.ID, SplitDir: dir, Children: []*PaneNode{left, right}}
+}
+
+func assertPaneIDs(t *testing.T, got []*PaneNode, want ...PaneID) {
+	t.Helper()
+	ids := paneIDs(got)
+	if fmt.Sprint(ids) != fmt.Sprint(want) {
+		t.Fatalf("numbered panes = %v, want %v", ids, want)
+	}
+}
+
+func TestWorkspace_NumberedPanes_StableAcrossSplits(t *testing.T) {
+	ws := &Workspace{Root: leaf("a")}
+	assertPaneIDs(t, ws.NumberedPanes(), "a")
+
+	// Split a to the right: a keeps 1, b gets 2.
+	ws.Root = split(SplitHorizontal, leaf("a"), leaf("b"))
+	assertPaneIDs(t, ws.NumberedPanes(), "a", "b")
+
+	// Split b downward: a and b keep their numbers, c gets 3.
+	ws.Root = split(SplitHorizontal, leaf("a"), split(SplitVertical, leaf("b"), leaf("c")))
+	assertPaneIDs(t, ws.NumberedPanes(), "a", "b", "c")
+
+	// Split a downward: a keeps 1, the new pane slots in right after it.
+	ws.Root = split(SplitHorizontal,
+		split(SplitVertical, leaf("a"), leaf("d")),
+		split(SplitVertical, leaf("b"), leaf("c")),
+	)
+	assertPaneIDs(t, ws.NumberedPanes(), "a", "d", "b", "c")
+
+	// Recomputing on the same tree yields the same order.
+	assertPaneIDs(t, ws.NumberedPanes(), "a", "d", "b", "c")
+}
+
+func TestWorkspace_NumberedPanes_StackedUsesActivePane(t *testing.T) {
+	stack := &PaneNode{
+		ID:               "stack",
+		IsStacked:        true,
+		ActiveStackIndex: 1,
+		Children:         []*PaneNode{leaf("b"), leaf("c"), leaf("d")},
+	}
+	ws := &Workspace{Root: split(SplitHorizontal, leaf("a"), stack)}
+	assertPaneIDs(t, ws.NumberedPanes(), "a", "c")
+
+	stack.ActiveStackIndex = 2
+	assertPaneIDs(t, ws.NumberedPanes(), "a", "d")
+}
+
+func TestWorkspace_NumberedPanes_CapsAtNine(t *testing.T) {
+	root := leaf("p0")
+	for i := 1; i < 12; i++ {
+		root = split(SplitHorizontal, root, leaf(fmt.Sprintf("p%d", i)))
+	}
+	ws := &Workspace{Root: root}
+
+	numbered := ws.NumberedPanes()
+	if len(numbered) != MaxPaneNumber {
+		t.Fatalf("len(NumberedPanes) = %d, want %d", len(numbered), MaxPaneNumber)
+	}
+	assertPaneIDs(t, numbered, "p0", "p1", "p2", "p3", "p4", "p5", "p6", "p7", "p8")
+}
+
+func TestWorkspace_PaneByNumber(t *testing.T) {
+	ws := &Workspace{Root: split(SplitHorizontal, leaf("a"), leaf("b"))}
+
+	tests := []struct {
+		n    int
+		want PaneID
+	}{
+		{n: 1, want: "a"},
+		{n: 2, want: "b"},
+		{n: 0},
+		{n: 3},
+		{n: 10},
+	}
+	for _, tt := range tests {
+		node := ws.PaneByNumber(tt.n)
+		switch {
+		case tt.want == "" && node != nil:
+			t.Errorf("PaneByNumber(%d) = %s, want nil", tt.n, node.Pane.ID)
+		case tt.want != "" && (node == nil || node.Pane.ID != tt.want):
+			t.Errorf("PaneByNumber(%d) = %v, want %s", tt.n, node, tt.want)
+		}
+	}
+
+	empty := &Workspace{}
+	if empty.PaneByNumber(1) != nil {
+		t.Error("PaneByNumber on empty workspace should be nil")
+	}
+}
+
+func TestWorkspace_ShareableURLs(t *testing.T) {
+	page := func(id, uri, title string) *PaneNode {
+		node := leaf(id)
+		node.Pane.URI = uri
+		node.Pane.Title = title
+		return node
+	}
+	stack := &PaneNode{
+		ID:        "stack",
+		IsStacked: true,
+		Children: []*PaneNode{
+			page("c", "https://c.test/", "C"),
+			page("d", "dumb://home", "Home"),
+			page("e", "https://e.test/", ""),
+		},
+	}
+	ws := &Workspace{Root: split(SplitHorizontal,
+		split(SplitVertical, page("a", "https://a.test/", "A"), page("b", "about:blank", "")),
+		stack,
+	)}
+
+	want := []SessionURL{
+		{URL: "https://a.test/", Title: "A"},
+		{URL: "https://c.test/", Title: "C"},
+		{URL: "https://e.test/"},
+	}
+	if got := ws.ShareableURLs(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("ShareableURLs() = %v, want %v", got, want)
+	}
+	if got := (&Workspace{}).ShareableURLs(); got != nil {
+		t.Errorf("ShareableURLs() on empty workspace = %v, want nil", got)
+	}
+}
@@ -15,9 +15,20 @@ const (
 	ZoomDefault = 1.0
 	ZoomMin     = 0.25 // 25%
 	ZoomMax     = 5.0  // 500%
-	ZoomStep    = 0.1  // 10% increments
+
+	// ZoomStepFactor is the default zoom step: zooming in multiplies the
+	// factor by it and zooming out divides by it.
+	ZoomStepFactor = 1.1
+	// ZoomStepFactorMin and ZoomStepFactorMax bound configurable step factors.
+	ZoomStepFactorMin = 1.01
+	ZoomStepFactorMax = 2.0
 )
 
+// ValidZoomStepFactor reports whether step is within the supported range.
+func ValidZoomStepFactor(step float64) bool {
+	return step >= ZoomStepFactorMin && step <= ZoomStepFactorMax
+}
+
 // NewZoomLevel creates a new zoom level for a domain.
 func NewZoomLevel(domain string, factor float64) *ZoomLevel {
 	return &ZoomLevel{
@@ -33,14 +44,26 @@ func (z *ZoomLevel) SetFactor(factor float64) {
 	z.UpdatedAt = time.Now()
 }
 
-// ZoomIn increases the zoom factor by one step.
+// ZoomIn increases the zoom factor by one default step.
 func (z *ZoomLevel) ZoomIn() {
-	z.SetFactor(z.ZoomFactor + ZoomStep)
+	z.ZoomInBy(ZoomStepFactor)
 }
 
-// ZoomOut decreases the zoom factor by one step.
+// ZoomOut decreases the zoom factor by one default step.
 func (z *ZoomLevel) ZoomOut() {
-	z.SetFactor(z.ZoomFactor - ZoomStep)
+	z.ZoomOutBy(ZoomStepFactor)
+}
+
+// ZoomInBy multiplies the zoom factor by step. Out-of-range steps fall back
+// to ZoomStepFactor.
+func (z *ZoomLevel) ZoomInBy(step float64) {
+	z.SetFactor(z.ZoomFactor * normalizeZoomStep(step))
+}
+
+// ZoomOutBy divides the zoom factor by step. Out-of-range steps fall back
+// to ZoomStepFactor.
+func (z *ZoomLevel) ZoomOutBy(step float64) {
+	z.SetFactor(z.ZoomFactor / normalizeZoomStep(step))
 }
 
 // Reset restores the zoom factor to default.
@@ -58,6 +81,13 @@ func (z *ZoomLevel) Percentage() int {
 	return int(z.ZoomFactor * 100)
 }
 
+func normalizeZoomStep(step float64) float64 {
+	if ValidZoomStepFactor(step) {
+		return step
+	}
+	return ZoomStepFactor
+}
+
 // clampZoom constrains a zoom factor to the valid range.
 func clampZoom(factor float64) float64 {
 	if factor < ZoomMin {
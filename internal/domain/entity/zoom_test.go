@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestZoomLevel_StepIsMultiplicative(t *testing.T) {
+	z := NewZoomLevel("example.com", 1.0)
+	z.ZoomInBy(1.25)
+	if math.Abs(z.ZoomFactor-1.25) > 1e-9 {
+		t.Fatalf("ZoomInBy(1.25) from 1.0 = %v, want 1.25", z.ZoomFactor)
+	}
+	z.ZoomOutBy(1.25)
+	if math.Abs(z.ZoomFactor-1.0) > 1e-9 {
+		t.Fatalf("ZoomOutBy(1.25) back = %v, want 1.0", z.ZoomFactor)
+	}
+}
+
+func TestZoomLevel_InvalidStepFallsBackToDefault(t *testing.T) {
+	for _, step := range []float64{0, 1.0, -1.1, 10, math.NaN()} {
+		z := NewZoomLevel("example.com", 1.0)
+		z.ZoomInBy(step)
+		if math.Abs(z.ZoomFactor-ZoomStepFactor) > 1e-9 {
+			t.Errorf("ZoomInBy(%v) = %v, want %v", step, z.ZoomFactor, ZoomStepFactor)
+		}
+	}
+}
+
+func TestZoomLevel_RepeatedStepsStayInBounds(t *testing.T) {
+	for _, step := range []float64{ZoomStepFactorMin, ZoomStepFactor, ZoomStepFactorMax} {
+		z := NewZoomLevel("example.com", ZoomDefault)
+		for range 200 {
+			z.ZoomInBy(step)
+			if z.ZoomFactor > ZoomMax {
+				t.Fatalf("step %v: zoom %v exceeds max %v", step, z.ZoomFactor, ZoomMax)
+			}
+		}
+		if z.ZoomFactor != ZoomMax {
+			t.Errorf("step %v: zoom after repeated zoom in = %v, want %v", step, z.ZoomFactor, ZoomMax)
+		}
+
+		for range 400 {
+			z.ZoomOutBy(step)
+			if z.ZoomFactor < ZoomMin {
+				t.Fatalf("step %v: zoom %v below min %v", step, z.ZoomFactor, ZoomMin)
+			}
+		}
+		if z.ZoomFactor != ZoomMin {
+			t.Errorf("step %v: zoom after repeated zoom out = %v, want %v", step, z.ZoomFactor, ZoomMin)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package favicon
+
+import (
+	"hash/fnv"
+	"net"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// monogramPalette holds the background colors a monogram can take. All of
+// them keep enough contrast with white text.
+var monogramPalette = []string{
+	"#c0392b", "#d35400", "#b7950b", "#27ae60",
+	"#16a085", "#2980b9", "#2c3e80", "#8e44ad",
+	"#c2185b", "#6d4c41", "#546e7a", "#00838f",
+}
+
+// Monogram is the generated stand-in for a site without a favicon: a single
+// letter on a background color derived from the site.
+type Monogram struct {
+	// Site is the registrable domain the monogram was derived from.
+	Site       string
+	Letter     string
+	Background string
+}
+
+// MonogramFor derives the monogram for a URL or bare host. Subdomains of the
+// same registrable domain share a monogram. It returns false when raw has no
+// usable host.
+func MonogramFor(raw string) (Monogram, bool) {
+	key, ok := CanonicalHostKey(raw)
+	if !ok {
+		return Monogram{}, false
+	}
+	site := string(key)
+	if host, _, err := net.SplitHostPort(site); err == nil {
+		site = host
+	}
+	if !isExactHost(site) {
+		if registrable, err := publicsuffix.EffectiveTLDPlusOne(site); err == nil {
+			site = registrable
+		}
+	}
+	return Monogram{
+		Site:       site,
+		Letter:     monogramLetter(site),
+		Background: monogramColor(site),
+	}, true
+}
+
+// monogramLetter picks the first letter or digit of the site, decoding
+// punycode so internationalized domains show their own first letter.
+func monogramLetter(site string) string {
+	if decoded, err := idna.ToUnicode(site); err == nil {
+		site = decoded
+	}
+	for _, r := range site {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return strings.ToUpper(string(r))
+		}
+	}
+	return "?"
+}
+
+func monogramColor(site string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(site))
+	return monogramPalette[h.Sum32()%uint32(len(monogramPalette))]
+}
@@ -0,0 +1,64 @@
+package favicon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonogramForIsDeterministic(t *testing.T) {
+	first, ok := MonogramFor("https://example.com/path")
+	require.True(t, ok)
+	second, ok := MonogramFor("https://example.com/other?q=1")
+	require.True(t, ok)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, "example.com", first.Site)
+	assert.Equal(t, "E", first.Letter)
+	assert.Equal(t, monogramColor("example.com"), first.Background)
+	assert.Contains(t, monogramPalette, first.Background)
+}
+
+func TestMonogramForSharesSiteAcrossSubdomains(t *testing.T) {
+	mail, ok := MonogramFor("https://mail.google.com")
+	require.True(t, ok)
+	www, ok := MonogramFor("www.google.com")
+	require.True(t, ok)
+
+	assert.Equal(t, "google.com", mail.Site)
+	assert.Equal(t, mail, www)
+	assert.Equal(t, "G", mail.Letter)
+}
+
+func TestMonogramForLetters(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{raw: "https://123movies.example", want: "1"},
+		{raw: "http://127.0.0.1:8080", want: "1"},
+		{raw: "https://éclair.fr", want: "É"},
+		{raw: "localhost", want: "L"},
+	}
+	for _, tt := range tests {
+		m, ok := MonogramFor(tt.raw)
+		require.True(t, ok, tt.raw)
+		assert.Equal(t, tt.want, m.Letter, tt.raw)
+	}
+}
+
+func TestMonogramForRejectsHostlessInput(t *testing.T) {
+	for _, raw := range []string{"", "about:blank", "dumb://history", "file:///tmp/x.html"} {
+		_, ok := MonogramFor(raw)
+		assert.False(t, ok, raw)
+	}
+}
+
+func TestMonogramColorSpreadsAcrossPalette(t *testing.T) {
+	seen := map[string]bool{}
+	for _, site := range []string{"a.com", "b.com", "c.com", "d.com", "e.com", "f.com", "g.com", "h.com"} {
+		seen[monogramColor(site)] = true
+	}
+	assert.Greater(t, len(seen), 1)
+}
@@ -0,0 +1,24 @@
+package formfill
+
+import "encoding/json"
+
+// AutofocusScript returns the JavaScript that focuses the first visible text
+// field of the top-level document, skipping the fields Script would skip.
+// Password inputs are never focused. The script leaves the page alone when
+// it runs inside a frame or when the page already focused an element, and
+// returns whether it moved the focus.
+func AutofocusScript() string {
+	selector, _ := json.Marshal(Selector(false))
+
+	return `(function() {
+	if (window !== window.top) return false;
+	const active = document.activeElement;
+	if (active && active !== document.body && active !== document.documentElement) return false;
+	for (const el of document.querySelectorAll(` + string(selector) + `)) {
+		if (` + skipFieldJS + `) continue;
+		el.focus({preventScroll: true});
+		return document.activeElement === el;
+	}
+	return false;
+})();`
+}
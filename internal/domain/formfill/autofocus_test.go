@@ -0,0 +1,36 @@
+package formfill
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutofocusScript_SelectsVisibleTextFields(t *testing.T) {
+	script := AutofocusScript()
+
+	assert.Contains(t, script, "window !== window.top", "autofocus must stay in the top frame")
+	assert.Contains(t, script, "document.activeElement", "autofocus must not steal existing focus")
+	assert.Contains(t, script, "active !== document.body", "a focused body counts as nothing focused")
+	assert.Contains(t, script, "preventScroll: true", "focusing must not scroll the page")
+
+	assert.Contains(t, script, "textarea")
+	assert.Contains(t, script, `input[type=\"search\" i]`)
+	assert.Contains(t, script, `input:not([type])`)
+	assert.NotContains(t, script, "password", "password fields are never autofocused")
+	for _, skipped := range []string{"checkbox", "radio", "submit", "file", "number", "date", `\"hidden\"`} {
+		assert.NotContains(t, script, skipped)
+	}
+
+	for _, skip := range []string{"el.disabled", "el.readOnly", "getClientRects().length === 0", "'hidden'", "'collapse'"} {
+		assert.Contains(t, script, skip)
+	}
+	assert.Less(t, strings.Index(script, "el.disabled"), strings.Index(script, "el.focus("),
+		"fields are filtered before the first one is focused")
+}
+
+func TestAutofocusScript_SharesSkipRulesWithFill(t *testing.T) {
+	assert.Contains(t, AutofocusScript(), skipFieldJS)
+	assert.Contains(t, Script(Options{Value: "x"}), skipFieldJS)
+}
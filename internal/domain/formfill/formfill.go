@@ -0,0 +1,100 @@
+// Package formfill builds the scripts that act on the visible text inputs of
+// a page: the omnibox ">fill" command, which bulk-fills them for form
+// testing, and the load-time autofocus of the first one.
+package formfill
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// IndexPlaceholder is replaced by the 1-based position of each filled field,
+// so "user{n}@example.com" gives every field a distinct value.
+const IndexPlaceholder = "{n}"
+
+// passwordsFlag opts password inputs into the fill.
+const passwordsFlag = "--passwords"
+
+// ErrMissingValue is returned when the command has no value to fill.
+var ErrMissingValue = errors.New("fill needs a value, e.g. >fill test-{n}")
+
+// textInputTypes are the input types that accept free text. Other types
+// (number, date, checkbox, file, ...) would reject or misread a text value.
+var textInputTypes = []string{"text", "search", "email", "url", "tel"}
+
+// Options describes one fill.
+type Options struct {
+	// Value is written into every field, with IndexPlaceholder expanded.
+	Value string
+	// IncludePasswords also fills password inputs.
+	IncludePasswords bool
+}
+
+// ParseArgs parses the arguments of the fill command: an optional
+// "--passwords" flag followed by the value or pattern.
+func ParseArgs(args string) (Options, error) {
+	var opts Options
+	args = strings.TrimSpace(args)
+	if rest, ok := strings.CutPrefix(args, passwordsFlag); ok && (rest == "" || rest[0] == ' ') {
+		opts.IncludePasswords = true
+		args = strings.TrimSpace(rest)
+	}
+	if args == "" {
+		return Options{}, ErrMissingValue
+	}
+	opts.Value = args
+	return opts, nil
+}
+
+// InputTypes returns the input types the fill writes to.
+func InputTypes(includePasswords bool) []string {
+	types := append([]string(nil), textInputTypes...)
+	if includePasswords {
+		types = append(types, "password")
+	}
+	return types
+}
+
+// Selector returns the CSS selector matching the candidate fields: textareas,
+// inputs without a type (which default to text) and the allowed input types.
+// Hidden, disabled and read-only fields are left to the script to skip.
+func Selector(includePasswords bool) string {
+	parts := []string{"textarea", "input:not([type])"}
+	for _, inputType := range InputTypes(includePasswords) {
+		parts = append(parts, `input[type="`+inputType+`" i]`)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// skipFieldJS is the JavaScript condition, on a candidate field el, under
+// which the field is left alone: disabled, read-only or not rendered.
+const skipFieldJS = `el.disabled || el.readOnly || el.getClientRects().length === 0 ||
+			['hidden', 'collapse'].includes(window.getComputedStyle(el).visibility)`
+
+// Script returns the JavaScript that fills the fields of the top-level
+// document. Frames are left alone, and the script does nothing when run
+// inside one. Values go through the native setter and fire input and change
+// events so framework-managed forms pick them up.
+func Script(opts Options) string {
+	selector, _ := json.Marshal(Selector(opts.IncludePasswords))
+	value, _ := json.Marshal(opts.Value)
+	placeholder, _ := json.Marshal(IndexPlaceholder)
+
+	return `(function() {
+	if (window !== window.top) return 0;
+	const value = ` + string(value) + `;
+	const placeholder = ` + string(placeholder) + `;
+	let n = 0;
+	for (const el of document.querySelectorAll(` + string(selector) + `)) {
+		if (` + skipFieldJS + `) continue;
+		n++;
+		const proto = el instanceof HTMLTextAreaElement ? HTMLTextAreaElement.prototype : HTMLInputElement.prototype;
+		const setter = Object.getOwnPropertyDescriptor(proto, 'value').set;
+		setter.call(el, value.split(placeholder).join(String(n)));
+		el.dispatchEvent(new Event('input', {bubbles: true}));
+		el.dispatchEvent(new Event('change', {bubbles: true}));
+	}
+	return n;
+})();`
+}
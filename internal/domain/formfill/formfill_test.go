@@ -0,0 +1,69 @@
+package formfill
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want Options
+	}{
+		{name: "plain value", args: "hello", want: Options{Value: "hello"}},
+		{name: "value with spaces", args: "  Jane Doe  ", want: Options{Value: "Jane Doe"}},
+		{name: "pattern", args: "user{n}@example.com", want: Options{Value: "user{n}@example.com"}},
+		{name: "passwords flag", args: "--passwords s3cret", want: Options{Value: "s3cret", IncludePasswords: true}},
+		{name: "flag-like value", args: "--passwordsX", want: Options{Value: "--passwordsX"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseArgs(tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, args := range []string{"", "   ", "--passwords", "--passwords   "} {
+		_, err := ParseArgs(args)
+		assert.ErrorIs(t, err, ErrMissingValue, "args %q", args)
+	}
+}
+
+func TestInputTypes_SkipsPasswordsUnlessAsked(t *testing.T) {
+	assert.NotContains(t, InputTypes(false), "password")
+	assert.Contains(t, InputTypes(true), "password")
+
+	for _, includePasswords := range []bool{false, true} {
+		types := InputTypes(includePasswords)
+		assert.Contains(t, types, "text")
+		assert.Contains(t, types, "email")
+		for _, skipped := range []string{"hidden", "checkbox", "radio", "submit", "file", "number", "date"} {
+			assert.NotContains(t, types, skipped)
+		}
+	}
+}
+
+func TestSelector(t *testing.T) {
+	selector := Selector(false)
+	assert.Contains(t, selector, "textarea")
+	assert.Contains(t, selector, "input:not([type])")
+	assert.Contains(t, selector, `input[type="text" i]`)
+	assert.NotContains(t, selector, "password")
+
+	assert.Contains(t, Selector(true), `input[type="password" i]`)
+}
+
+func TestScript(t *testing.T) {
+	script := Script(Options{Value: `it's "quoted" </script>`})
+
+	assert.Contains(t, script, "window !== window.top", "fill must stay in the top frame")
+	assert.Contains(t, script, `"it's \"quoted\" \u003c/script\u003e"`, "value must be an escaped JSON string literal")
+	assert.Contains(t, script, `"{n}"`)
+	assert.NotContains(t, script, "password")
+
+	assert.Contains(t, Script(Options{Value: "x", IncludePasswords: true}), `password`)
+}
@@ -0,0 +1,218 @@
+// Package httpsupgrade decides how HTTPS-only mode treats a page load:
+// http:// pages are upgraded to https:// before they load, and a host whose
+// HTTPS load fails either falls back to http:// with a recorded exception
+// (lenient mode) or stays blocked (strict mode).
+package httpsupgrade
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// PendingTimeout bounds how long an upgraded load counts as in flight. A
+// plain http:// load of the host after that is upgraded again instead of
+// being taken for the server sending the upgraded load back to http://.
+const PendingTimeout = 30 * time.Second
+
+// Action is what to do with a load.
+type Action int
+
+const (
+	// ActionAllow loads the page as requested.
+	ActionAllow Action = iota
+	// ActionUpgrade loads Decision.URL, the https:// version, instead.
+	ActionUpgrade
+	// ActionAllowInsecure loads the http:// page because its host has an
+	// exception; the user should be warned.
+	ActionAllowInsecure
+	// ActionFallback loads Decision.URL, the http:// version, because HTTPS
+	// failed; an exception was recorded for the host.
+	ActionFallback
+	// ActionBlock refuses the http:// page in strict mode.
+	ActionBlock
+)
+
+// Decision is the answer to a load.
+type Decision struct {
+	Action Action
+	// URL is the page to load instead, for ActionUpgrade and ActionFallback.
+	URL string
+	// Host is the host the decision is about; empty for ActionAllow.
+	Host string
+	// Recorded is set when the decision recorded a new exception for Host.
+	Recorded bool
+}
+
+// Exception is a host allowed over http:// after its HTTPS load failed.
+type Exception struct {
+	Host  string
+	Since time.Time
+}
+
+// Upgrade returns the https:// version of an http:// URL whose host is
+// worth upgrading. Local hosts (localhost, *.localhost, *.local, loopback,
+// private and link-local addresses) and explicit ports other than 80 are
+// left alone: they rarely serve HTTPS.
+func Upgrade(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || !strings.EqualFold(u.Scheme, "http") || !upgradable(u) {
+		return "", false
+	}
+	upgraded := *u
+	upgraded.Scheme = "https"
+	upgraded.Host = hostOf(u)
+	if ip := net.ParseIP(upgraded.Host); ip != nil && ip.To4() == nil {
+		upgraded.Host = "[" + upgraded.Host + "]"
+	}
+	return upgraded.String(), true
+}
+
+// Downgrade returns the http:// version of an https:// URL.
+func Downgrade(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || !strings.EqualFold(u.Scheme, "https") || u.Hostname() == "" {
+		return "", false
+	}
+	downgraded := *u
+	downgraded.Scheme = "http"
+	if u.Port() == "443" {
+		downgraded.Host = u.Hostname()
+		if ip := net.ParseIP(downgraded.Host); ip != nil && ip.To4() == nil {
+			downgraded.Host = "[" + downgraded.Host + "]"
+		}
+	}
+	return downgraded.String(), true
+}
+
+func upgradable(u *url.URL) bool {
+	host := hostOf(u)
+	if host == "" || (u.Port() != "" && u.Port() != "80") {
+		return false
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".local") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsUnspecified()
+	}
+	return true
+}
+
+// hostOf returns the lowercase host of u without port or trailing dot.
+func hostOf(u *url.URL) string {
+	return strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+}
+
+// Tracker follows the upgraded loads and the recorded exceptions of a
+// browsing session. It is not safe for concurrent use.
+type Tracker struct {
+	// pending holds the hosts whose upgraded load has not committed yet,
+	// with when it was upgraded.
+	pending    map[string]time.Time
+	exceptions map[string]time.Time
+}
+
+// NewTracker creates a Tracker without exceptions.
+func NewTracker() *Tracker {
+	return &Tracker{
+		pending:    make(map[string]time.Time),
+		exceptions: make(map[string]time.Time),
+	}
+}
+
+// Navigate decides how a load of rawURL starting at now is made. http://
+// pages are upgraded, unless the host has an exception (lenient mode) or
+// the server just sent the upgraded load back to http://: lenient mode then
+// records an exception and allows it, strict mode blocks it.
+func (t *Tracker) Navigate(mode entity.HTTPSOnlyMode, rawURL string, now time.Time) Decision {
+	if mode != entity.HTTPSOnlyLenient && mode != entity.HTTPSOnlyStrict {
+		return Decision{}
+	}
+	upgraded, ok := Upgrade(rawURL)
+	if !ok {
+		return Decision{}
+	}
+	u, _ := url.Parse(rawURL)
+	host := hostOf(u)
+
+	if _, ok := t.exceptions[host]; ok && mode == entity.HTTPSOnlyLenient {
+		return Decision{Action: ActionAllowInsecure, Host: host}
+	}
+	if t.isPending(host, now) {
+		delete(t.pending, host)
+		if mode == entity.HTTPSOnlyStrict {
+			return Decision{Action: ActionBlock, Host: host}
+		}
+		t.exceptions[host] = now
+		return Decision{Action: ActionAllowInsecure, Host: host, Recorded: true}
+	}
+	t.pending[host] = now
+	return Decision{Action: ActionUpgrade, URL: upgraded, Host: host}
+}
+
+// Failed decides what follows a failed load of rawURL. When it was an
+// upgraded load, lenient mode records an exception and falls back to
+// http://, and strict mode blocks the page; other failures are left alone.
+func (t *Tracker) Failed(mode entity.HTTPSOnlyMode, rawURL string, now time.Time) Decision {
+	host, ok := t.pendingHost(rawURL, now)
+	if !ok {
+		return Decision{}
+	}
+	delete(t.pending, host)
+	switch mode {
+	case entity.HTTPSOnlyLenient:
+		fallback, ok := Downgrade(rawURL)
+		if !ok {
+			return Decision{}
+		}
+		t.exceptions[host] = now
+		return Decision{Action: ActionFallback, URL: fallback, Host: host, Recorded: true}
+	case entity.HTTPSOnlyStrict:
+		return Decision{Action: ActionBlock, Host: host}
+	default:
+		return Decision{}
+	}
+}
+
+// Settled ends the upgraded load of rawURL's host: it committed, or it was
+// cancelled by another navigation.
+func (t *Tracker) Settled(rawURL string) {
+	if u, err := url.Parse(rawURL); err == nil && strings.EqualFold(u.Scheme, "https") {
+		delete(t.pending, hostOf(u))
+	}
+}
+
+// Exceptions returns the recorded exceptions sorted by host.
+func (t *Tracker) Exceptions() []Exception {
+	out := make([]Exception, 0, len(t.exceptions))
+	for host, since := range t.exceptions {
+		out = append(out, Exception{Host: host, Since: since})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Host < out[j].Host })
+	return out
+}
+
+func (t *Tracker) isPending(host string, now time.Time) bool {
+	since, ok := t.pending[host]
+	if ok && now.Sub(since) > PendingTimeout {
+		delete(t.pending, host)
+		return false
+	}
+	return ok
+}
+
+// pendingHost returns the host of rawURL when it is an https:// load this
+// Tracker upgraded and is still waiting for.
+func (t *Tracker) pendingHost(rawURL string, now time.Time) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || !strings.EqualFold(u.Scheme, "https") {
+		return "", false
+	}
+	host := hostOf(u)
+	return host, t.isPending(host, now)
+}
@@ -0,0 +1,143 @@
+package httpsupgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestUpgrade(t *testing.T) {
+	tests := map[string]string{
+		"http://example.com":                 "https://example.com",
+		"http://Example.COM./path?q=1#frag":  "https://example.com/path?q=1#frag",
+		"http://example.com:80/a":            "https://example.com/a",
+		"http://user@example.com/":           "https://user@example.com/",
+		"http://93.184.216.34/":              "https://93.184.216.34/",
+		"http://[2606:2800:220:1::248]/page": "https://[2606:2800:220:1::248]/page",
+	}
+	for in, want := range tests {
+		got, ok := Upgrade(in)
+		assert.True(t, ok, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	for _, in := range []string{
+		"https://example.com/",
+		"file:///tmp/x.html",
+		"dumb://home",
+		"http://localhost:3000/",
+		"http://localhost/",
+		"http://app.localhost/",
+		"http://printer.local/",
+		"http://127.0.0.1/",
+		"http://192.168.1.1/",
+		"http://10.0.0.2/",
+		"http://[::1]/",
+		"http://169.254.1.1/",
+		"http://example.com:8080/",
+		"http://%zz",
+	} {
+		_, ok := Upgrade(in)
+		assert.False(t, ok, in)
+	}
+}
+
+func TestDowngrade(t *testing.T) {
+	got, ok := Downgrade("https://example.com:443/a?b=c")
+	assert.True(t, ok)
+	assert.Equal(t, "http://example.com/a?b=c", got)
+
+	_, ok = Downgrade("http://example.com/")
+	assert.False(t, ok)
+}
+
+func TestTracker_OffLeavesLoadsAlone(t *testing.T) {
+	tr := NewTracker()
+	now := time.Unix(1000, 0)
+	for _, mode := range []entity.HTTPSOnlyMode{entity.HTTPSOnlyOff, ""} {
+		assert.Equal(t, Decision{}, tr.Navigate(mode, "http://example.com/", now))
+		assert.Equal(t, Decision{}, tr.Failed(mode, "https://example.com/", now))
+	}
+}
+
+func TestTracker_UpgradeThenSuccess(t *testing.T) {
+	tr := NewTracker()
+	now := time.Unix(1000, 0)
+
+	d := tr.Navigate(entity.HTTPSOnlyLenient, "http://example.com/page", now)
+	assert.Equal(t, Decision{Action: ActionUpgrade, URL: "https://example.com/page", Host: "example.com"}, d)
+	assert.Equal(t, Decision{}, tr.Navigate(entity.HTTPSOnlyLenient, "https://example.com/page", now),
+		"the upgraded load itself goes ahead")
+
+	tr.Settled("https://example.com/page")
+	assert.Equal(t, Decision{}, tr.Failed(entity.HTTPSOnlyLenient, "https://example.com/later", now),
+		"a committed upgrade no longer falls back")
+	assert.Equal(t, ActionUpgrade, tr.Navigate(entity.HTTPSOnlyLenient, "http://example.com/other", now).Action,
+		"later http links are upgraded too")
+	assert.Empty(t, tr.Exceptions())
+}
+
+func TestTracker_LenientFallsBackAndRecordsException(t *testing.T) {
+	tr := NewTracker()
+	now := time.Unix(1000, 0)
+
+	tr.Navigate(entity.HTTPSOnlyLenient, "http://old.example/a?x=1", now)
+	d := tr.Failed(entity.HTTPSOnlyLenient, "https://old.example/a?x=1", now.Add(time.Second))
+	assert.Equal(t, Decision{Action: ActionFallback, URL: "http://old.example/a?x=1", Host: "old.example", Recorded: true}, d)
+	assert.Equal(t, []Exception{{Host: "old.example", Since: now.Add(time.Second)}}, tr.Exceptions())
+
+	d = tr.Navigate(entity.HTTPSOnlyLenient, "http://old.example/a?x=1", now.Add(2*time.Second))
+	assert.Equal(t, Decision{Action: ActionAllowInsecure, Host: "old.example"}, d,
+		"the fallback load and later ones are allowed with a warning")
+	assert.Equal(t, ActionUpgrade, tr.Navigate(entity.HTTPSOnlyLenient, "http://other.example/", now).Action,
+		"exceptions are per host")
+}
+
+func TestTracker_LenientDowngradeRedirect(t *testing.T) {
+	tr := NewTracker()
+	now := time.Unix(1000, 0)
+
+	tr.Navigate(entity.HTTPSOnlyLenient, "http://legacy.example/", now)
+	d := tr.Navigate(entity.HTTPSOnlyLenient, "http://legacy.example/", now.Add(time.Second))
+	assert.Equal(t, Decision{Action: ActionAllowInsecure, Host: "legacy.example", Recorded: true}, d,
+		"the server sent the upgraded load back to http")
+	assert.Len(t, tr.Exceptions(), 1)
+}
+
+func TestTracker_Strict(t *testing.T) {
+	tr := NewTracker()
+	now := time.Unix(1000, 0)
+
+	tr.Navigate(entity.HTTPSOnlyStrict, "http://old.example/", now)
+	assert.Equal(t, Decision{Action: ActionBlock, Host: "old.example"},
+		tr.Failed(entity.HTTPSOnlyStrict, "https://old.example/", now))
+	assert.Empty(t, tr.Exceptions(), "strict mode never records exceptions")
+
+	tr.Navigate(entity.HTTPSOnlyStrict, "http://legacy.example/", now)
+	assert.Equal(t, Decision{Action: ActionBlock, Host: "legacy.example"},
+		tr.Navigate(entity.HTTPSOnlyStrict, "http://legacy.example/", now), "downgrade redirects are blocked")
+
+	tr.Navigate(entity.HTTPSOnlyLenient, "http://mixed.example/", now)
+	tr.Failed(entity.HTTPSOnlyLenient, "https://mixed.example/", now)
+	assert.Equal(t, ActionUpgrade, tr.Navigate(entity.HTTPSOnlyStrict, "http://mixed.example/", now).Action,
+		"lenient exceptions don't apply in strict mode")
+}
+
+func TestTracker_PendingExpiresAndSettles(t *testing.T) {
+	tr := NewTracker()
+	now := time.Unix(1000, 0)
+
+	tr.Navigate(entity.HTTPSOnlyLenient, "http://slow.example/", now)
+	later := now.Add(PendingTimeout + time.Second)
+	assert.Equal(t, Decision{}, tr.Failed(entity.HTTPSOnlyLenient, "https://slow.example/", later),
+		"an old upgrade is no longer waited for")
+	assert.Equal(t, ActionUpgrade, tr.Navigate(entity.HTTPSOnlyLenient, "http://slow.example/", later).Action)
+
+	tr.Settled("https://slow.example/")
+	assert.Equal(t, ActionUpgrade, tr.Navigate(entity.HTTPSOnlyLenient, "http://slow.example/", later).Action,
+		"a cancelled upgrade is not taken for a downgrade")
+	assert.Empty(t, tr.Exceptions())
+}
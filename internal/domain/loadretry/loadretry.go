@@ -0,0 +1,69 @@
+// Package loadretry decides whether a failed page load is worth retrying and
+// when, so flaky connections recover without the user reloading by hand.
+package loadretry
+
+import "time"
+
+// Cause is the engine-neutral reason a page load failed.
+type Cause string
+
+const (
+	// CauseOther covers every failure not listed below. It is not retried.
+	CauseOther Cause = "other"
+	// CauseCancelled is a load stopped by the user or replaced by another one.
+	CauseCancelled Cause = "cancelled"
+	// CauseNameResolution is a DNS lookup that failed.
+	CauseNameResolution Cause = "name_resolution"
+	// CauseConnection is a connection that was refused, reset or closed.
+	CauseConnection Cause = "connection"
+	// CauseTimeout is a connection or response that took too long.
+	CauseTimeout Cause = "timeout"
+	// CauseOffline is a network that is down or unreachable.
+	CauseOffline Cause = "offline"
+	// CauseHTTPStatus is a server that answered with an error status.
+	CauseHTTPStatus Cause = "http_status"
+	// CausePolicy is a load the engine refused, such as an unknown scheme.
+	CausePolicy Cause = "policy"
+)
+
+// Transient reports whether a failure with this cause may succeed when the
+// same request is sent again. Only network-level failures qualify: a server
+// that answered, a refused scheme or a cancelled load gives the same result.
+func (c Cause) Transient() bool {
+	switch c {
+	case CauseNameResolution, CauseConnection, CauseTimeout, CauseOffline:
+		return true
+	default:
+		return false
+	}
+}
+
+// Policy is the retry schedule of failed loads.
+type Policy struct {
+	// MaxAttempts caps the retries of one page. Zero disables retrying.
+	MaxAttempts int
+	// InitialDelay is the wait before the first retry.
+	InitialDelay time.Duration
+	// MaxDelay caps the wait between two retries.
+	MaxDelay time.Duration
+}
+
+// Delay returns the wait before retry number attempt, counted from 1. The wait
+// doubles after each retry, from InitialDelay up to MaxDelay. ok is false once
+// attempt goes past MaxAttempts.
+func (p Policy) Delay(attempt int) (delay time.Duration, ok bool) {
+	if attempt < 1 || attempt > p.MaxAttempts {
+		return 0, false
+	}
+	delay = p.InitialDelay
+	for i := 1; i < attempt; i++ {
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay, true
+}
@@ -0,0 +1,53 @@
+package loadretry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCauseTransient(t *testing.T) {
+	for _, cause := range []Cause{CauseNameResolution, CauseConnection, CauseTimeout, CauseOffline} {
+		assert.True(t, cause.Transient(), cause)
+	}
+	for _, cause := range []Cause{CauseOther, CauseCancelled, CauseHTTPStatus, CausePolicy, ""} {
+		assert.False(t, cause.Transient(), cause)
+	}
+}
+
+func TestPolicyDelay(t *testing.T) {
+	p := Policy{MaxAttempts: 5, InitialDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	var schedule []time.Duration
+	for attempt := 1; ; attempt++ {
+		delay, ok := p.Delay(attempt)
+		if !ok {
+			break
+		}
+		schedule = append(schedule, delay)
+	}
+	assert.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second,
+	}, schedule)
+}
+
+func TestPolicyDelay_Bounds(t *testing.T) {
+	p := Policy{MaxAttempts: 3, InitialDelay: 500 * time.Millisecond, MaxDelay: time.Minute}
+
+	_, ok := p.Delay(0)
+	assert.False(t, ok, "attempts count from 1")
+	_, ok = p.Delay(4)
+	assert.False(t, ok, "past MaxAttempts")
+
+	delay, ok := p.Delay(3)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, delay)
+
+	_, ok = Policy{InitialDelay: time.Second, MaxDelay: time.Minute}.Delay(1)
+	assert.False(t, ok, "zero MaxAttempts disables retrying")
+
+	delay, ok = Policy{MaxAttempts: 100, InitialDelay: time.Second, MaxDelay: 30 * time.Second}.Delay(100)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, delay, "many attempts stay capped without overflowing")
+}
@@ -0,0 +1,179 @@
+// Package measure builds the "measure page" developer overlay: a page-world
+// script that shows the size of the element under the pointer and the
+// distance covered by a drag, toggled by the omnibox ">measure" command.
+package measure
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Separator joins the horizontal and vertical parts of a label.
+const Separator = " × "
+
+// Unit is appended to every measurement.
+const Unit = "px"
+
+// overlayGlobal is the window property holding the teardown of an active
+// overlay, so running the script again turns the overlay off.
+const overlayGlobal = "__dumberMeasure"
+
+// Point is a position in CSS pixels, relative to the viewport.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Bounds is an element's box in CSS pixels, relative to the viewport.
+type Bounds struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// Distance returns the straight-line distance between a and b.
+func Distance(a, b Point) float64 {
+	return math.Hypot(b.X-a.X, b.Y-a.Y)
+}
+
+// FormatDistance labels a drag from a to b with its horizontal and vertical
+// extent and its length, e.g. "120 × 50 px, 130 px". Values are rounded to
+// whole pixels.
+func FormatDistance(a, b Point) string {
+	return fmt.Sprintf("%s%s%s %s, %s %s",
+		formatPixels(math.Abs(b.X-a.X)), Separator, formatPixels(math.Abs(b.Y-a.Y)), Unit,
+		formatPixels(Distance(a, b)), Unit,
+	)
+}
+
+// FormatBounds labels an element box with its size and position, e.g.
+// "320 × 48 px at 16, 200". Values are rounded to whole pixels.
+func FormatBounds(b Bounds) string {
+	return fmt.Sprintf("%s%s%s %s at %s, %s",
+		formatPixels(b.Width), Separator, formatPixels(b.Height), Unit,
+		formatPixels(b.X), formatPixels(b.Y),
+	)
+}
+
+// formatPixels rounds v the way the script's Math.round does, half up, so
+// both sides print the same labels.
+func formatPixels(v float64) string {
+	return fmt.Sprintf("%d", int64(math.Floor(v+0.5)))
+}
+
+// ToggleScript returns the JavaScript that turns the overlay on, or off when
+// it is already showing. The overlay lives in a closed shadow root of the
+// top-level document; frames are left alone. While it is on, hovering
+// outlines the element under the pointer with its bounds, dragging measures
+// the distance between the press and the pointer, and Escape turns it off.
+// The script returns whether the overlay is now on.
+func ToggleScript() string {
+	global, _ := json.Marshal(overlayGlobal)
+	separator, _ := json.Marshal(Separator)
+	unit, _ := json.Marshal(Unit)
+
+	return `(function() {
+	if (window !== window.top) return false;
+	const key = ` + string(global) + `;
+	if (typeof window[key] === 'function') {
+		window[key]();
+		return false;
+	}
+	const sep = ` + string(separator) + `;
+	const unit = ` + string(unit) + `;
+	const px = (v) => String(Math.round(v));
+	const formatDistance = (a, b) =>
+		px(Math.abs(b.x - a.x)) + sep + px(Math.abs(b.y - a.y)) + ' ' + unit + ', ' +
+		px(Math.hypot(b.x - a.x, b.y - a.y)) + ' ' + unit;
+	const formatBounds = (r) =>
+		px(r.width) + sep + px(r.height) + ' ' + unit + ' at ' + px(r.left) + ', ' + px(r.top);
+
+	const host = document.createElement('div');
+	host.style.cssText = 'all: initial; position: fixed; inset: 0; z-index: 2147483647; pointer-events: none;';
+	const root = host.attachShadow({mode: 'closed'});
+	root.innerHTML = '<style>' +
+		'.box, .line, .label { position: fixed; pointer-events: none; box-sizing: border-box; }' +
+		'.box { outline: 1px solid #e5484d; background: rgba(229, 72, 77, 0.12); }' +
+		'.line { border: 1px dashed #0091ff; background: rgba(0, 145, 255, 0.08); }' +
+		'.label { font: 11px/1.4 monospace; color: #fff; background: rgba(0, 0, 0, 0.8); padding: 2px 6px; border-radius: 3px; white-space: nowrap; }' +
+		'[hidden] { display: none; }' +
+		'</style><div class="box" hidden></div><div class="line" hidden></div><div class="label" hidden></div>';
+	const box = root.querySelector('.box');
+	const line = root.querySelector('.line');
+	const label = root.querySelector('.label');
+	(document.body || document.documentElement).appendChild(host);
+
+	let start = null;
+	const showLabel = (text, x, y) => {
+		label.textContent = text;
+		label.hidden = false;
+		label.style.left = Math.min(x + 12, window.innerWidth - label.offsetWidth - 4) + 'px';
+		label.style.top = Math.min(y + 12, window.innerHeight - label.offsetHeight - 4) + 'px';
+	};
+	const place = (el, left, top, width, height) => {
+		el.hidden = false;
+		el.style.left = left + 'px';
+		el.style.top = top + 'px';
+		el.style.width = width + 'px';
+		el.style.height = height + 'px';
+	};
+	const onMove = (e) => {
+		const p = {x: e.clientX, y: e.clientY};
+		if (start) {
+			box.hidden = true;
+			place(line, Math.min(start.x, p.x), Math.min(start.y, p.y), Math.abs(p.x - start.x), Math.abs(p.y - start.y));
+			showLabel(formatDistance(start, p), p.x, p.y);
+			return;
+		}
+		const target = document.elementFromPoint(p.x, p.y);
+		if (!target || target === host) {
+			box.hidden = true;
+			label.hidden = true;
+			return;
+		}
+		const r = target.getBoundingClientRect();
+		place(box, r.left, r.top, r.width, r.height);
+		showLabel(formatBounds(r), p.x, p.y);
+	};
+	const onDown = (e) => {
+		if (e.button !== 0) return;
+		e.preventDefault();
+		e.stopPropagation();
+		start = {x: e.clientX, y: e.clientY};
+		line.hidden = true;
+	};
+	const onUp = (e) => {
+		if (!start) return;
+		e.preventDefault();
+		e.stopPropagation();
+		start = null;
+	};
+	const swallow = (e) => {
+		e.preventDefault();
+		e.stopPropagation();
+	};
+	const onKey = (e) => {
+		if (e.key === 'Escape') {
+			swallow(e);
+			teardown();
+		}
+	};
+	const listeners = [
+		['mousemove', onMove],
+		['mousedown', onDown],
+		['mouseup', onUp],
+		['click', swallow],
+		['keydown', onKey],
+	];
+	const teardown = () => {
+		for (const [type, fn] of listeners) window.removeEventListener(type, fn, true);
+		host.remove();
+		delete window[key];
+	};
+	for (const [type, fn] of listeners) window.addEventListener(type, fn, true);
+	Object.defineProperty(window, key, {value: teardown, configurable: true});
+	return true;
+})();`
+}
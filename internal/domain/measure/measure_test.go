@@ -0,0 +1,54 @@
+package measure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	assert.InDelta(t, 5.0, Distance(Point{X: 0, Y: 0}, Point{X: 3, Y: 4}), 1e-9)
+	assert.InDelta(t, 5.0, Distance(Point{X: 3, Y: 4}, Point{X: 0, Y: 0}), 1e-9, "distance is symmetric")
+	assert.Zero(t, Distance(Point{X: 12, Y: 7}, Point{X: 12, Y: 7}))
+	assert.InDelta(t, 130.0, Distance(Point{X: 10, Y: 20}, Point{X: 130, Y: 70}), 1e-9)
+}
+
+func TestFormatDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Point
+		want string
+	}{
+		{name: "right triangle", a: Point{X: 10, Y: 20}, b: Point{X: 130, Y: 70}, want: "120 × 50 px, 130 px"},
+		{name: "dragging up and left", a: Point{X: 130, Y: 70}, b: Point{X: 10, Y: 20}, want: "120 × 50 px, 130 px"},
+		{name: "horizontal", a: Point{X: 0, Y: 5}, b: Point{X: 42, Y: 5}, want: "42 × 0 px, 42 px"},
+		{name: "no movement", a: Point{X: 3, Y: 3}, b: Point{X: 3, Y: 3}, want: "0 × 0 px, 0 px"},
+		{name: "rounds to whole pixels", a: Point{X: 0, Y: 0}, b: Point{X: 10.5, Y: 10.4}, want: "11 × 10 px, 15 px"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatDistance(tt.a, tt.b))
+		})
+	}
+}
+
+func TestFormatBounds(t *testing.T) {
+	assert.Equal(t, "320 × 48 px at 16, 200", FormatBounds(Bounds{X: 16, Y: 200, Width: 320, Height: 48}))
+	assert.Equal(t, "101 × 0 px at 0, 8", FormatBounds(Bounds{X: 0.4, Y: 7.5, Width: 100.5, Height: 0.2}))
+	assert.Equal(t, "20 × 20 px at -2, -40", FormatBounds(Bounds{X: -2.5, Y: -40, Width: 20, Height: 20}),
+		"negative positions round half up like Math.round")
+}
+
+func TestToggleScript(t *testing.T) {
+	script := ToggleScript()
+
+	assert.Contains(t, script, "window !== window.top", "overlay must stay in the top frame")
+	assert.Contains(t, script, `const key = "__dumberMeasure"`)
+	assert.Contains(t, script, "window[key]();", "running the script again turns the overlay off")
+	assert.Contains(t, script, "attachShadow({mode: 'closed'})", "overlay styles stay out of the page")
+	assert.Contains(t, script, `const sep = " × "`, "labels use the Go separator")
+	assert.Contains(t, script, `const unit = "px"`)
+	assert.Contains(t, script, "Math.hypot(", "drag length matches Distance")
+	assert.Contains(t, script, "e.key === 'Escape'")
+	assert.Contains(t, script, "removeEventListener", "teardown removes the capture listeners")
+}
@@ -0,0 +1,91 @@
+// Package media holds engine-agnostic media playback policy rules.
+package media
+
+import (
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// ElementAutoplay is what the autoplay script does to a media element that
+// starts playing without a recent user gesture.
+type ElementAutoplay string
+
+const (
+	// ElementAutoplayAllow leaves the element alone.
+	ElementAutoplayAllow ElementAutoplay = "allow"
+	// ElementAutoplayMute lets the element play but mutes it.
+	ElementAutoplayMute ElementAutoplay = "mute"
+	// ElementAutoplayPause strips its autoplay attribute and pauses it.
+	ElementAutoplayPause ElementAutoplay = "pause"
+)
+
+// AutoplayEnforcement describes how an engine enforces an autoplay policy.
+type AutoplayEnforcement struct {
+	// RequireUserGesture maps to the engine's "media playback requires user
+	// gesture" setting.
+	RequireUserGesture bool
+	// Video and Audio are what the autoplay script does to <video> and
+	// <audio> elements. Policies that only rely on the gesture setting leave
+	// both at allow so no script runs: it would run on every page and pause
+	// late audible playback.
+	Video ElementAutoplay
+	Audio ElementAutoplay
+}
+
+// NeedsScript reports whether the autoplay script must run for e.
+func (e AutoplayEnforcement) NeedsScript() bool {
+	return e.Video != ElementAutoplayAllow || e.Audio != ElementAutoplayAllow
+}
+
+// EnforcementForAutoplayPolicy maps a policy to engine settings and script behavior.
+// Unknown policies fall back to block-audio, which matches the engine default
+// of requiring a gesture for audible playback.
+//
+// The split policies turn the gesture setting off, since it cannot tell
+// video from audio, and leave the per-element decision to the script.
+func EnforcementForAutoplayPolicy(policy entity.AutoplayPolicy) AutoplayEnforcement {
+	switch policy {
+	case entity.AutoplayPolicyAllow:
+		return AutoplayEnforcement{Video: ElementAutoplayAllow, Audio: ElementAutoplayAllow}
+	case entity.AutoplayPolicyBlock:
+		return AutoplayEnforcement{RequireUserGesture: true, Video: ElementAutoplayPause, Audio: ElementAutoplayPause}
+	case entity.AutoplayPolicyMutedVideo:
+		return AutoplayEnforcement{Video: ElementAutoplayMute, Audio: ElementAutoplayPause}
+	case entity.AutoplayPolicyBlockVideo:
+		return AutoplayEnforcement{Video: ElementAutoplayPause, Audio: ElementAutoplayAllow}
+	default:
+		return AutoplayEnforcement{RequireUserGesture: true, Video: ElementAutoplayAllow, Audio: ElementAutoplayAllow}
+	}
+}
+
+// ResolveAutoplayPolicy returns the policy that applies to rawURL.
+// The most specific matching exception wins (the first entry wins for duplicate
+// domains); otherwise defaultPolicy applies.
+// Invalid policies (default or exception) resolve to block-audio.
+func ResolveAutoplayPolicy(
+	defaultPolicy entity.AutoplayPolicy,
+	exceptions []entity.AutoplayException,
+	rawURL string,
+) entity.AutoplayPolicy {
+	if len(exceptions) > 0 {
+		patterns := make([]string, 0, len(exceptions))
+		for _, exception := range exceptions {
+			patterns = append(patterns, exception.Domain)
+		}
+		if pattern, ok := urlutil.BestDomainPatternMatch(patterns, rawURL); ok {
+			for _, exception := range exceptions {
+				if exception.Domain == pattern {
+					return normalizeAutoplayPolicy(exception.Policy)
+				}
+			}
+		}
+	}
+	return normalizeAutoplayPolicy(defaultPolicy)
+}
+
+func normalizeAutoplayPolicy(policy entity.AutoplayPolicy) entity.AutoplayPolicy {
+	if policy.IsValid() {
+		return policy
+	}
+	return entity.AutoplayPolicyBlockAudio
+}
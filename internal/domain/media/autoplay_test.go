@@ -0,0 +1,126 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestEnforcementForAutoplayPolicy(t *testing.T) {
+	const (
+		allow = ElementAutoplayAllow
+		mute  = ElementAutoplayMute
+		pause = ElementAutoplayPause
+	)
+	tests := []struct {
+		policy     entity.AutoplayPolicy
+		want       AutoplayEnforcement
+		wantScript bool
+	}{
+		{
+			policy: entity.AutoplayPolicyAllow,
+			want:   AutoplayEnforcement{Video: allow, Audio: allow},
+		},
+		{
+			policy:     entity.AutoplayPolicyBlock,
+			want:       AutoplayEnforcement{RequireUserGesture: true, Video: pause, Audio: pause},
+			wantScript: true,
+		},
+		{
+			policy: entity.AutoplayPolicyBlockAudio,
+			want:   AutoplayEnforcement{RequireUserGesture: true, Video: allow, Audio: allow},
+		},
+		{
+			policy:     entity.AutoplayPolicyMutedVideo,
+			want:       AutoplayEnforcement{Video: mute, Audio: pause},
+			wantScript: true,
+		},
+		{
+			policy:     entity.AutoplayPolicyBlockVideo,
+			want:       AutoplayEnforcement{Video: pause, Audio: allow},
+			wantScript: true,
+		},
+		{
+			policy: "bogus",
+			want:   AutoplayEnforcement{RequireUserGesture: true, Video: allow, Audio: allow},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			got := EnforcementForAutoplayPolicy(tt.policy)
+			if got != tt.want {
+				t.Fatalf("EnforcementForAutoplayPolicy(%q) = %+v, want %+v", tt.policy, got, tt.want)
+			}
+			if got.NeedsScript() != tt.wantScript {
+				t.Fatalf("EnforcementForAutoplayPolicy(%q).NeedsScript() = %v, want %v", tt.policy, got.NeedsScript(), tt.wantScript)
+			}
+		})
+	}
+}
+
+func TestEnforcementForAutoplayPolicy_BlockAudioSkipsBlockerScript(t *testing.T) {
+	// block-audio is the default policy, so the blocker script would run on
+	// every page and pause audible playback started long after a gesture.
+	got := EnforcementForAutoplayPolicy(entity.AutoplayPolicyBlockAudio)
+	if got.NeedsScript() {
+		t.Fatal("block-audio should rely on the gesture setting, not the blocker script")
+	}
+	if !got.RequireUserGesture {
+		t.Fatal("block-audio should require a gesture for audible playback")
+	}
+}
+
+func TestEnforcementForAutoplayPolicy_SplitPoliciesLeaveGestureToScript(t *testing.T) {
+	// The gesture setting blocks audible video and audio alike, so a policy
+	// treating them differently must turn it off and let the script decide.
+	for _, policy := range []entity.AutoplayPolicy{entity.AutoplayPolicyMutedVideo, entity.AutoplayPolicyBlockVideo} {
+		got := EnforcementForAutoplayPolicy(policy)
+		if got.RequireUserGesture {
+			t.Fatalf("%s should not require a gesture engine-wide", policy)
+		}
+		if got.Video == got.Audio {
+			t.Fatalf("%s should treat video and audio differently, got %+v", policy, got)
+		}
+	}
+}
+
+func TestResolveAutoplayPolicy(t *testing.T) {
+	exceptions := []entity.AutoplayException{
+		{Domain: "youtube.com", Policy: entity.AutoplayPolicyAllow},
+		{Domain: "*.example.com", Policy: entity.AutoplayPolicyBlock},
+		{Domain: "video.example.com", Policy: entity.AutoplayPolicyAllow},
+		{Domain: "broken.test", Policy: "nope"},
+		{Domain: "*.reddit.com", Policy: entity.AutoplayPolicyMutedVideo},
+	}
+
+	tests := []struct {
+		name   string
+		rawURL string
+		want   entity.AutoplayPolicy
+	}{
+		{name: "no match uses default", rawURL: "https://news.test/a", want: entity.AutoplayPolicyBlockAudio},
+		{name: "exact domain", rawURL: "https://youtube.com/watch?v=1", want: entity.AutoplayPolicyAllow},
+		{name: "www prefix ignored", rawURL: "https://www.youtube.com/", want: entity.AutoplayPolicyAllow},
+		{name: "wildcard apex", rawURL: "https://example.com/", want: entity.AutoplayPolicyBlock},
+		{name: "wildcard subdomain", rawURL: "https://cdn.example.com/x", want: entity.AutoplayPolicyBlock},
+		{name: "exact beats wildcard", rawURL: "https://video.example.com/x", want: entity.AutoplayPolicyAllow},
+		{name: "split policy", rawURL: "https://old.reddit.com/r/x", want: entity.AutoplayPolicyMutedVideo},
+		{name: "invalid exception falls back", rawURL: "https://broken.test/", want: entity.AutoplayPolicyBlockAudio},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResolveAutoplayPolicy(entity.AutoplayPolicyBlockAudio, exceptions, tt.rawURL)
+			if got != tt.want {
+				t.Fatalf("ResolveAutoplayPolicy(%q) = %q, want %q", tt.rawURL, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveAutoplayPolicy_InvalidDefault(t *testing.T) {
+	if got := ResolveAutoplayPolicy("", nil, "https://example.com"); got != entity.AutoplayPolicyBlockAudio {
+		t.Fatalf("ResolveAutoplayPolicy with empty default = %q, want block-audio", got)
+	}
+}
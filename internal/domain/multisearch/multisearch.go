@@ -0,0 +1,139 @@
+// Package multisearch builds the ">multi" omnibox command: one query searched
+// on several engines at once, each in its own pane of a grid.
+package multisearch
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// MaxEngines caps how many engines, and so panes, one search opens.
+const MaxEngines = 6
+
+// ParseArgs splits the command arguments into the engines named with leading
+// "!key" words and the query after them. No "!key" word means the configured
+// engines.
+func ParseArgs(args string) (keys []string, query string) {
+	rest := strings.TrimSpace(args)
+	for {
+		word, tail, _ := strings.Cut(rest, " ")
+		key, ok := strings.CutPrefix(word, "!")
+		if !ok || key == "" {
+			return keys, rest
+		}
+		keys = append(keys, key)
+		rest = strings.TrimSpace(tail)
+	}
+}
+
+// SearchURL fills the %s placeholder of template with query, escaped for the
+// part of the URL the placeholder sits in.
+func SearchURL(template, query string) string {
+	escaped := url.PathEscape(query)
+	if i := strings.Index(template, "?"); i >= 0 && i < strings.Index(template, "%s") {
+		escaped = url.QueryEscape(query)
+	}
+	return strings.Replace(template, "%s", escaped, 1)
+}
+
+// URLs returns the search URL of query on each engine in keys, looked up in
+// templates by bang key without regard to case. Repeated engines are opened
+// once and engines past MaxEngines are dropped.
+func URLs(keys []string, templates map[string]string, query string) ([]string, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("nothing to search")
+	}
+	seen := make(map[string]bool, len(keys))
+	urls := make([]string, 0, min(len(keys), MaxEngines))
+	for _, key := range keys {
+		canonical, template, ok := lookup(templates, key)
+		if !ok {
+			return nil, fmt.Errorf("unknown search shortcut !%s", key)
+		}
+		if seen[canonical] {
+			continue
+		}
+		seen[canonical] = true
+		if len(urls) == MaxEngines {
+			break
+		}
+		urls = append(urls, SearchURL(template, query))
+	}
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("no search engines to search with")
+	}
+	return urls, nil
+}
+
+func lookup(templates map[string]string, key string) (canonical, template string, ok bool) {
+	if template, ok := templates[key]; ok {
+		return key, template, true
+	}
+	for candidate, template := range templates {
+		if strings.EqualFold(candidate, key) {
+			return candidate, template, true
+		}
+	}
+	return "", "", false
+}
+
+// Grid returns the columns and rows of the grid holding n panes, as square
+// as possible and never taller than wide.
+func Grid(n int) (cols, rows int) {
+	if n <= 0 {
+		return 0, 0
+	}
+	cols = int(math.Ceil(math.Sqrt(float64(n))))
+	rows = (n + cols - 1) / cols
+	return cols, rows
+}
+
+// Layout returns the pane tree of a tab showing urls in a grid, filled row
+// by row. Splits share their space evenly, so every pane of a row has the
+// same width and every row the same height; a shorter last row stretches its
+// panes.
+func Layout(urls []string) *entity.PaneNodeSnapshot {
+	cols, rows := Grid(len(urls))
+	if cols == 0 {
+		return nil
+	}
+	rowNodes := make([]*entity.PaneNodeSnapshot, 0, rows)
+	for start := 0; start < len(urls); start += cols {
+		end := min(start+cols, len(urls))
+		panes := make([]*entity.PaneNodeSnapshot, 0, end-start)
+		for _, uri := range urls[start:end] {
+			panes = append(panes, &entity.PaneNodeSnapshot{
+				Pane: &entity.PaneSnapshot{URI: uri, ZoomFactor: 1.0},
+			})
+		}
+		rowNodes = append(rowNodes, evenSplit(panes, entity.SplitHorizontal))
+	}
+	return evenSplit(rowNodes, entity.SplitVertical)
+}
+
+// evenSplit nests nodes into binary splits along dir, giving each node the
+// same share of the space.
+func evenSplit(nodes []*entity.PaneNodeSnapshot, dir entity.SplitDirection) *entity.PaneNodeSnapshot {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	return &entity.PaneNodeSnapshot{
+		SplitDir:   dir,
+		SplitRatio: 1 / float64(len(nodes)),
+		Children:   []*entity.PaneNodeSnapshot{nodes[0], evenSplit(nodes[1:], dir)},
+	}
+}
+
+// Tab returns the snapshot of a tab showing urls in a grid. Its panes get
+// IDs when the tab is built with entity.TabFromSnapshot.
+func Tab(urls []string) (entity.TabSnapshot, bool) {
+	root := Layout(urls)
+	if root == nil {
+		return entity.TabSnapshot{}, false
+	}
+	return entity.TabSnapshot{Workspace: entity.WorkspaceSnapshot{Root: root}}, true
+}
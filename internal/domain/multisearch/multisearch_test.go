@@ -0,0 +1,134 @@
+package multisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+var templates = map[string]string{
+	"ddg": "https://duckduckgo.com/?q=%s",
+	"g":   "https://www.google.com/search?q=%s",
+	"gh":  "https://github.com/search?q=%s&type=code",
+	"w":   "https://en.wikipedia.org/wiki/%s",
+	"a":   "https://a.example/?q=%s",
+	"b":   "https://b.example/?q=%s",
+	"c":   "https://c.example/?q=%s",
+}
+
+func TestParseArgs(t *testing.T) {
+	keys, query := ParseArgs("  rust borrow checker ")
+	assert.Empty(t, keys)
+	assert.Equal(t, "rust borrow checker", query)
+
+	keys, query = ParseArgs("!g !w  rust !important")
+	assert.Equal(t, []string{"g", "w"}, keys)
+	assert.Equal(t, "rust !important", query)
+
+	keys, query = ParseArgs("! rust")
+	assert.Empty(t, keys)
+	assert.Equal(t, "! rust", query)
+}
+
+func TestSearchURL(t *testing.T) {
+	assert.Equal(t, "https://duckduckgo.com/?q=a+%26+b", SearchURL(templates["ddg"], "a & b"))
+	assert.Equal(t, "https://github.com/search?q=c%2B%2B+sort&type=code", SearchURL(templates["gh"], "c++ sort"))
+	assert.Equal(t, "https://en.wikipedia.org/wiki/Alan%20Turing%2F1", SearchURL(templates["w"], "Alan Turing/1"))
+	assert.Equal(t, "https://example.com/", SearchURL("https://example.com/", "ignored"))
+}
+
+func TestURLs(t *testing.T) {
+	urls, err := URLs([]string{"G", "w", "g"}, templates, "go generics")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://www.google.com/search?q=go+generics",
+		"https://en.wikipedia.org/wiki/go%20generics",
+	}, urls, "keys match without regard to case and repeats open once")
+
+	urls, err = URLs([]string{"ddg", "g", "gh", "w", "a", "b", "c"}, templates, "q")
+	require.NoError(t, err)
+	assert.Len(t, urls, MaxEngines)
+	assert.Equal(t, "https://b.example/?q=q", urls[MaxEngines-1])
+
+	_, err = URLs([]string{"g", "nope"}, templates, "q")
+	require.ErrorContains(t, err, "!nope")
+	_, err = URLs([]string{"g"}, templates, "  ")
+	require.Error(t, err)
+	_, err = URLs(nil, templates, "q")
+	require.Error(t, err)
+}
+
+func TestGrid(t *testing.T) {
+	tests := []struct{ n, cols, rows int }{
+		{0, 0, 0}, {1, 1, 1}, {2, 2, 1}, {3, 2, 2}, {4, 2, 2}, {5, 3, 2}, {6, 3, 2},
+	}
+	for _, tt := range tests {
+		cols, rows := Grid(tt.n)
+		assert.Equal(t, [2]int{tt.cols, tt.rows}, [2]int{cols, rows}, "n=%d", tt.n)
+	}
+}
+
+// leafURIs returns the URIs of the leaves under node, left to right and top
+// to bottom.
+func leafURIs(node *entity.PaneNodeSnapshot) []string {
+	if node.Pane != nil {
+		return []string{node.Pane.URI}
+	}
+	var uris []string
+	for _, child := range node.Children {
+		uris = append(uris, leafURIs(child)...)
+	}
+	return uris
+}
+
+func TestLayout_SinglePane(t *testing.T) {
+	root := Layout([]string{"u1"})
+	require.NotNil(t, root.Pane)
+	assert.Equal(t, "u1", root.Pane.URI)
+	assert.Nil(t, Layout(nil))
+}
+
+func TestLayout_FiveEnginesTwoRows(t *testing.T) {
+	urls := []string{"u1", "u2", "u3", "u4", "u5"}
+	root := Layout(urls)
+
+	// Rows stack top to bottom, the first one taking half the height.
+	require.Len(t, root.Children, 2)
+	assert.Equal(t, entity.SplitVertical, root.SplitDir)
+	assert.InDelta(t, 0.5, root.SplitRatio, 1e-9)
+	assert.Equal(t, urls, leafURIs(root))
+
+	// Three panes in the first row, each a third of the width.
+	top := root.Children[0]
+	assert.Equal(t, entity.SplitHorizontal, top.SplitDir)
+	assert.InDelta(t, 1.0/3, top.SplitRatio, 1e-9)
+	assert.Equal(t, "u1", top.Children[0].Pane.URI)
+	rest := top.Children[1]
+	assert.Equal(t, entity.SplitHorizontal, rest.SplitDir)
+	assert.InDelta(t, 0.5, rest.SplitRatio, 1e-9)
+	assert.Equal(t, []string{"u1", "u2", "u3"}, leafURIs(top))
+
+	// The last row holds the remaining two.
+	assert.Equal(t, []string{"u4", "u5"}, leafURIs(root.Children[1]))
+	assert.InDelta(t, 0.5, root.Children[1].SplitRatio, 1e-9)
+}
+
+func TestTab_BuildsLiveTabWithGrid(t *testing.T) {
+	snap, ok := Tab([]string{"u1", "u2", "u3", "u4"})
+	require.True(t, ok)
+
+	n := 0
+	tab := entity.TabFromSnapshot(snap, func() string { n++; return string(rune('a' + n)) })
+	require.NotNil(t, tab)
+	assert.Equal(t, 4, tab.PaneCount())
+	assert.Equal(t, tab.Workspace.AllPanes()[0].ID, tab.Workspace.ActivePaneID)
+	for _, pane := range tab.Workspace.AllPanes() {
+		assert.InDelta(t, 1.0, pane.ZoomFactor, 1e-9)
+	}
+
+	_, ok = Tab(nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,215 @@
+// Package navgraph builds the branching navigation tree of a pane.
+package navgraph
+
+import (
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// Graph records the pages visited in one pane as a tree. Each navigation
+// either moves along the tree (back, forward, reload) or adds a child of the
+// current page. Graph is not safe for concurrent use.
+type Graph struct {
+	nodes   []entity.NavigationNode // creation order
+	current int                     // node ID, 0 when empty
+	nextID  int
+	limit   int
+}
+
+// NewGraph creates an empty graph holding at most limit nodes.
+// A limit below 1 keeps every node.
+func NewGraph(limit int) *Graph {
+	return &Graph{limit: limit}
+}
+
+// Len returns the number of nodes.
+func (g *Graph) Len() int {
+	return len(g.nodes)
+}
+
+// Current returns the ID of the page the pane is on, or 0 when empty.
+func (g *Graph) Current() int {
+	return g.current
+}
+
+// Nodes returns a copy of the nodes, oldest first.
+func (g *Graph) Nodes() []entity.NavigationNode {
+	return append([]entity.NavigationNode(nil), g.nodes...)
+}
+
+// Visit records a committed navigation to url and returns the ID of the node
+// the pane is now on. Revisiting the current page only refreshes it. A URL
+// matching an ancestor is a move back, one matching a child or the most
+// recently visited forward path is a move forward; anything else becomes a
+// new child of the current node.
+func (g *Graph) Visit(url string, at time.Time) int {
+	if url == "" {
+		return g.current
+	}
+	cur := g.find(g.current)
+	if cur == nil {
+		return g.add(0, url, at)
+	}
+	if cur.URL == url {
+		cur.LastVisit = at
+		return g.current
+	}
+	if id := g.findAncestor(cur, url); id != 0 {
+		return g.moveTo(id, at)
+	}
+	if id := g.findChild(cur.ID, url); id != 0 {
+		return g.moveTo(id, at)
+	}
+	if id := g.findForward(cur.ID, url); id != 0 {
+		return g.moveTo(id, at)
+	}
+	return g.add(cur.ID, url, at)
+}
+
+// SetTitle sets the title of the current node when it shows url, otherwise
+// of the most recently visited node with that URL.
+func (g *Graph) SetTitle(url, title string) {
+	if cur := g.find(g.current); cur != nil && cur.URL == url {
+		cur.Title = title
+		return
+	}
+	var latest *entity.NavigationNode
+	for i := range g.nodes {
+		n := &g.nodes[i]
+		if n.URL == url && (latest == nil || n.LastVisit.After(latest.LastVisit)) {
+			latest = n
+		}
+	}
+	if latest != nil {
+		latest.Title = title
+	}
+}
+
+func (g *Graph) find(id int) *entity.NavigationNode {
+	if id == 0 {
+		return nil
+	}
+	for i := range g.nodes {
+		if g.nodes[i].ID == id {
+			return &g.nodes[i]
+		}
+	}
+	return nil
+}
+
+func (g *Graph) findAncestor(n *entity.NavigationNode, url string) int {
+	for p := g.find(n.ParentID); p != nil; p = g.find(p.ParentID) {
+		if p.URL == url {
+			return p.ID
+		}
+	}
+	return 0
+}
+
+func (g *Graph) findChild(parentID int, url string) int {
+	for _, n := range g.nodes {
+		if n.ParentID == parentID && n.URL == url {
+			return n.ID
+		}
+	}
+	return 0
+}
+
+// findForward follows the most recently visited child from parentID, the
+// path a forward navigation replays.
+func (g *Graph) findForward(parentID int, url string) int {
+	for next := g.latestChild(parentID); next != nil; next = g.latestChild(next.ID) {
+		if next.URL == url {
+			return next.ID
+		}
+	}
+	return 0
+}
+
+func (g *Graph) latestChild(parentID int) *entity.NavigationNode {
+	var latest *entity.NavigationNode
+	for i := range g.nodes {
+		n := &g.nodes[i]
+		if n.ParentID == parentID && (latest == nil || n.LastVisit.After(latest.LastVisit)) {
+			latest = n
+		}
+	}
+	return latest
+}
+
+func (g *Graph) hasChildren(id int) bool {
+	for _, n := range g.nodes {
+		if n.ParentID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Graph) moveTo(id int, at time.Time) int {
+	n := g.find(id)
+	n.Visits++
+	n.LastVisit = at
+	g.current = id
+	return id
+}
+
+func (g *Graph) add(parentID int, url string, at time.Time) int {
+	g.nextID++
+	g.nodes = append(g.nodes, entity.NavigationNode{
+		ID:         g.nextID,
+		ParentID:   parentID,
+		URL:        url,
+		Visits:     1,
+		FirstVisit: at,
+		LastVisit:  at,
+	})
+	g.current = g.nextID
+	g.prune()
+	return g.current
+}
+
+// prune drops nodes beyond the limit: first the least recently visited leaf
+// off the current path, and when the tree is a single chain, its root.
+func (g *Graph) prune() {
+	if g.limit < 1 {
+		return
+	}
+	for len(g.nodes) > g.limit {
+		onPath := make(map[int]bool)
+		for n := g.find(g.current); n != nil; n = g.find(n.ParentID) {
+			onPath[n.ID] = true
+		}
+		victim := -1
+		for i, n := range g.nodes {
+			if onPath[n.ID] || g.hasChildren(n.ID) {
+				continue
+			}
+			if victim < 0 || n.LastVisit.Before(g.nodes[victim].LastVisit) {
+				victim = i
+			}
+		}
+		if victim < 0 {
+			g.dropRoot()
+			continue
+		}
+		g.nodes = append(g.nodes[:victim], g.nodes[victim+1:]...)
+	}
+}
+
+// dropRoot removes the root of a chain, promoting its only child.
+func (g *Graph) dropRoot() {
+	for i, n := range g.nodes {
+		if n.ParentID != 0 {
+			continue
+		}
+		for j := range g.nodes {
+			if g.nodes[j].ParentID == n.ID {
+				g.nodes[j].ParentID = 0
+			}
+		}
+		g.nodes = append(g.nodes[:i], g.nodes[i+1:]...)
+		return
+	}
+}
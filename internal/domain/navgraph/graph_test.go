@@ -0,0 +1,157 @@
+package navgraph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type visitor struct {
+	g    *Graph
+	tick int
+}
+
+func (v *visitor) visit(url string) int {
+	v.tick++
+	return v.g.Visit(url, time.Date(2026, 3, 1, 12, 0, v.tick, 0, time.UTC))
+}
+
+// edges maps each URL to its parent URL ("" for the root).
+func edges(g *Graph) map[string]string {
+	byID := make(map[int]entity.NavigationNode)
+	for _, n := range g.Nodes() {
+		byID[n.ID] = n
+	}
+	out := make(map[string]string)
+	for _, n := range g.Nodes() {
+		out[n.URL] = byID[n.ParentID].URL
+	}
+	return out
+}
+
+func urlOf(g *Graph, id int) string {
+	for _, n := range g.Nodes() {
+		if n.ID == id {
+			return n.URL
+		}
+	}
+	return ""
+}
+
+func TestGraph_LinearNavigationBuildsChain(t *testing.T) {
+	v := &visitor{g: NewGraph(0)}
+	v.visit("a")
+	v.visit("b")
+	v.visit("c")
+
+	assert.Equal(t, map[string]string{"a": "", "b": "a", "c": "b"}, edges(v.g))
+	assert.Equal(t, "c", urlOf(v.g, v.g.Current()))
+}
+
+func TestGraph_BackThenNewNavigationBranches(t *testing.T) {
+	v := &visitor{g: NewGraph(0)}
+	v.visit("a")
+	v.visit("b")
+	v.visit("c")
+	v.visit("b") // back
+	v.visit("d") // new page from b
+
+	assert.Equal(t, map[string]string{"a": "", "b": "a", "c": "b", "d": "b"}, edges(v.g))
+	assert.Equal(t, "d", urlOf(v.g, v.g.Current()))
+	assert.Equal(t, 4, v.g.Len())
+}
+
+func TestGraph_BackSeveralStepsThenBranchFromAncestor(t *testing.T) {
+	v := &visitor{g: NewGraph(0)}
+	for _, u := range []string{"a", "b", "c", "a", "e"} {
+		v.visit(u)
+	}
+
+	assert.Equal(t, map[string]string{"a": "", "b": "a", "c": "b", "e": "a"}, edges(v.g))
+}
+
+func TestGraph_ForwardFollowsMostRecentBranch(t *testing.T) {
+	v := &visitor{g: NewGraph(0)}
+	for _, u := range []string{"a", "b", "c", "b", "d", "b", "a"} {
+		v.visit(u)
+	}
+	// Forward twice from a replays a → b → d, the branch visited last.
+	v.visit("b")
+	id := v.visit("d")
+
+	assert.Equal(t, "d", urlOf(v.g, id))
+	assert.Equal(t, 4, v.g.Len(), "forward navigation must not add nodes")
+
+	// Skipping ahead along the forward path also moves instead of adding.
+	v.visit("a")
+	v.visit("d")
+	assert.Equal(t, 4, v.g.Len())
+	assert.Equal(t, "d", urlOf(v.g, v.g.Current()))
+}
+
+func TestGraph_ReloadOnlyRefreshesCurrentNode(t *testing.T) {
+	v := &visitor{g: NewGraph(0)}
+	first := v.visit("a")
+	again := v.visit("a")
+
+	require.Equal(t, first, again)
+	nodes := v.g.Nodes()
+	require.Len(t, nodes, 1)
+	assert.Equal(t, 1, nodes[0].Visits)
+	assert.True(t, nodes[0].LastVisit.After(nodes[0].FirstVisit))
+}
+
+func TestGraph_RevisitCountsVisits(t *testing.T) {
+	v := &visitor{g: NewGraph(0)}
+	for _, u := range []string{"a", "b", "a", "b"} {
+		v.visit(u)
+	}
+
+	for _, n := range v.g.Nodes() {
+		assert.Equal(t, 2, n.Visits, n.URL)
+	}
+}
+
+func TestGraph_SetTitle(t *testing.T) {
+	v := &visitor{g: NewGraph(0)}
+	v.visit("a")
+	v.visit("b")
+	v.g.SetTitle("b", "Bee")
+	v.g.SetTitle("a", "Ay")
+	v.g.SetTitle("missing", "ignored")
+
+	titles := make(map[string]string)
+	for _, n := range v.g.Nodes() {
+		titles[n.URL] = n.Title
+	}
+	assert.Equal(t, map[string]string{"a": "Ay", "b": "Bee"}, titles)
+}
+
+func TestGraph_PruneDropsOldestLeafOffCurrentPath(t *testing.T) {
+	v := &visitor{g: NewGraph(3)}
+	for _, u := range []string{"a", "b", "a", "c", "a", "d"} {
+		v.visit(u)
+	}
+
+	// b is the least recently visited leaf that is not on the path to d.
+	assert.Equal(t, map[string]string{"a": "", "c": "a", "d": "a"}, edges(v.g))
+}
+
+func TestGraph_PruneChainDropsRoot(t *testing.T) {
+	v := &visitor{g: NewGraph(2)}
+	for _, u := range []string{"a", "b", "c"} {
+		v.visit(u)
+	}
+
+	assert.Equal(t, map[string]string{"b": "", "c": "b"}, edges(v.g))
+	assert.Equal(t, "c", urlOf(v.g, v.g.Current()))
+}
+
+func TestGraph_EmptyURLIsIgnored(t *testing.T) {
+	g := NewGraph(0)
+	assert.Zero(t, g.Visit("", time.Now()))
+	assert.Zero(t, g.Len())
+}
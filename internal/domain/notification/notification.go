@@ -0,0 +1,110 @@
+// Package notification keeps the recent in-app notifications, the toasts
+// shown over panes and windows, so they can be reviewed after they faded.
+package notification
+
+import (
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+const (
+	// DefaultCapacity is how many notifications are kept by default.
+	DefaultCapacity = 50
+	// MaxCapacity bounds notifications.history_size.
+	MaxCapacity = 500
+)
+
+// Level is the severity of a notification, matching the toast styles.
+type Level string
+
+const (
+	LevelInfo    Level = "info"
+	LevelSuccess Level = "success"
+	LevelWarning Level = "warning"
+	LevelError   Level = "error"
+)
+
+// Entry is one notification.
+type Entry struct {
+	Message string
+	Level   Level
+	At      time.Time
+	// PaneID is the pane the toast was shown over; empty for window toasts.
+	PaneID entity.PaneID
+}
+
+// Log is a ring buffer of the latest notifications: once full, each new
+// entry replaces the oldest. A Log with capacity 0 keeps nothing. It is not
+// safe for concurrent use.
+type Log struct {
+	entries []Entry
+	// start is the index of the oldest entry.
+	start int
+	count int
+}
+
+// NewLog creates an empty Log keeping up to capacity entries.
+func NewLog(capacity int) *Log {
+	return &Log{entries: make([]Entry, max(capacity, 0))}
+}
+
+// Capacity returns how many entries the Log keeps.
+func (l *Log) Capacity() int {
+	return len(l.entries)
+}
+
+// Len returns how many entries the Log holds.
+func (l *Log) Len() int {
+	return l.count
+}
+
+// Add appends e, dropping the oldest entry when the Log is full.
+func (l *Log) Add(e Entry) {
+	if len(l.entries) == 0 {
+		return
+	}
+	if l.count < len(l.entries) {
+		l.entries[(l.start+l.count)%len(l.entries)] = e
+		l.count++
+		return
+	}
+	l.entries[l.start] = e
+	l.start = (l.start + 1) % len(l.entries)
+}
+
+// Entries returns the entries newest first.
+func (l *Log) Entries() []Entry {
+	out := make([]Entry, l.count)
+	for i := range l.count {
+		out[i] = l.entries[(l.start+l.count-1-i)%len(l.entries)]
+	}
+	return out
+}
+
+// Clear drops every entry (dismiss all) and returns how many there were.
+func (l *Log) Clear() int {
+	n := l.count
+	clear(l.entries)
+	l.start = 0
+	l.count = 0
+	return n
+}
+
+// Resize changes the capacity, keeping the newest entries that fit.
+func (l *Log) Resize(capacity int) {
+	capacity = max(capacity, 0)
+	if capacity == len(l.entries) {
+		return
+	}
+	newest := l.Entries()
+	if len(newest) > capacity {
+		newest = newest[:capacity]
+	}
+	l.entries = make([]Entry, capacity)
+	l.start = 0
+	l.count = len(newest)
+	for i, e := range newest {
+		l.entries[len(newest)-1-i] = e
+	}
+}
@@ -0,0 +1,77 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func messages(entries []Entry) []string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.Message
+	}
+	return out
+}
+
+func TestLog_KeepsNewestFirst(t *testing.T) {
+	log := NewLog(3)
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	log.Add(Entry{Message: "one", Level: LevelInfo, At: at, PaneID: "pane-1"})
+	log.Add(Entry{Message: "two", Level: LevelError})
+
+	entries := log.Entries()
+	assert.Equal(t, []string{"two", "one"}, messages(entries))
+	assert.Equal(t, Entry{Message: "one", Level: LevelInfo, At: at, PaneID: "pane-1"}, entries[1])
+	assert.Equal(t, 2, log.Len())
+}
+
+func TestLog_RingDropsOldest(t *testing.T) {
+	log := NewLog(3)
+	for _, msg := range []string{"a", "b", "c", "d", "e"} {
+		log.Add(Entry{Message: msg})
+	}
+	assert.Equal(t, []string{"e", "d", "c"}, messages(log.Entries()))
+	assert.Equal(t, 3, log.Len())
+
+	log.Add(Entry{Message: "f"})
+	assert.Equal(t, []string{"f", "e", "d"}, messages(log.Entries()))
+}
+
+func TestLog_ZeroCapacityKeepsNothing(t *testing.T) {
+	log := NewLog(0)
+	log.Add(Entry{Message: "a"})
+	assert.Empty(t, log.Entries())
+	assert.Equal(t, 0, NewLog(-1).Capacity())
+}
+
+func TestLog_ClearDismissesAll(t *testing.T) {
+	log := NewLog(2)
+	for _, msg := range []string{"a", "b", "c"} {
+		log.Add(Entry{Message: msg})
+	}
+	assert.Equal(t, 2, log.Clear())
+	assert.Empty(t, log.Entries())
+	assert.Equal(t, 0, log.Clear())
+
+	log.Add(Entry{Message: "d"})
+	assert.Equal(t, []string{"d"}, messages(log.Entries()), "the log keeps working after a clear")
+}
+
+func TestLog_ResizeKeepsNewest(t *testing.T) {
+	log := NewLog(4)
+	for _, msg := range []string{"a", "b", "c", "d", "e"} {
+		log.Add(Entry{Message: msg})
+	}
+
+	log.Resize(2)
+	require.Equal(t, 2, log.Capacity())
+	assert.Equal(t, []string{"e", "d"}, messages(log.Entries()))
+
+	log.Resize(3)
+	log.Add(Entry{Message: "f"})
+	log.Add(Entry{Message: "g"})
+	assert.Equal(t, []string{"g", "f", "e"}, messages(log.Entries()))
+}
@@ -0,0 +1,101 @@
+// Package panickey sequences the panic key, which hides the browser at once
+// and can keep it locked behind a PIN until the user comes back.
+package panickey
+
+import (
+	"crypto/subtle"
+	"strings"
+	"sync"
+)
+
+// PIN length bounds, in digits.
+const (
+	MinPINLength = 4
+	MaxPINLength = 12
+)
+
+// Step is one action of a panic key press.
+type Step string
+
+const (
+	// StepLock covers every window with the PIN prompt.
+	StepLock Step = "lock"
+	// StepNeutralPage loads the neutral page in the active pane.
+	StepNeutralPage Step = "neutral_page"
+	// StepMinimize minimizes every browser window.
+	StepMinimize Step = "minimize"
+)
+
+// Steps returns what one press of the panic key does, in order. Minimizing
+// always happens. With a valid pin the windows are locked first, so the page
+// is covered even if a later step fails. With a neutralURL it is loaded before
+// minimizing, so window previews show it rather than the page.
+func Steps(neutralURL, pin string) []Step {
+	steps := make([]Step, 0, 3)
+	if ValidPIN(pin) {
+		steps = append(steps, StepLock)
+	}
+	if strings.TrimSpace(neutralURL) != "" {
+		steps = append(steps, StepNeutralPage)
+	}
+	return append(steps, StepMinimize)
+}
+
+// ValidPIN reports whether pin can lock the browser: MinPINLength to
+// MaxPINLength ASCII digits.
+func ValidPIN(pin string) bool {
+	if len(pin) < MinPINLength || len(pin) > MaxPINLength {
+		return false
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Gate keeps the browser locked after a panic until the PIN is entered.
+// The PIN is captured when locking, so editing the config while locked does
+// not change the PIN that unlocks. The zero value is unlocked.
+type Gate struct {
+	mu     sync.Mutex
+	locked bool
+	pin    string
+}
+
+// Lock locks the gate with pin and reports whether it is locked. An invalid
+// pin leaves the gate as it was.
+func (g *Gate) Lock(pin string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.locked && ValidPIN(pin) {
+		g.locked = true
+		g.pin = pin
+	}
+	return g.locked
+}
+
+// Locked reports whether the gate is locked.
+func (g *Gate) Locked() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.locked
+}
+
+// Unlock unlocks the gate when input matches the PIN it was locked with,
+// ignoring surrounding spaces. It reports whether the gate is now unlocked.
+func (g *Gate) Unlock(input string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.locked {
+		return true
+	}
+	input = strings.TrimSpace(input)
+	if subtle.ConstantTimeCompare([]byte(input), []byte(g.pin)) != 1 {
+		return false
+	}
+	g.locked = false
+	g.pin = ""
+	return true
+}
@@ -0,0 +1,72 @@
+package panickey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSteps(t *testing.T) {
+	tests := []struct {
+		name       string
+		neutralURL string
+		pin        string
+		want       []Step
+	}{
+		{name: "minimize only", want: []Step{StepMinimize}},
+		{
+			name:       "neutral page before minimizing",
+			neutralURL: "https://news.example",
+			want:       []Step{StepNeutralPage, StepMinimize},
+		},
+		{name: "lock first", pin: "1234", want: []Step{StepLock, StepMinimize}},
+		{
+			name:       "lock, neutral page, minimize",
+			neutralURL: "https://news.example",
+			pin:        "123456",
+			want:       []Step{StepLock, StepNeutralPage, StepMinimize},
+		},
+		{name: "invalid pin does not lock", pin: "12", want: []Step{StepMinimize}},
+		{name: "blank neutral url is ignored", neutralURL: "  ", want: []Step{StepMinimize}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Steps(tt.neutralURL, tt.pin))
+		})
+	}
+}
+
+func TestValidPIN(t *testing.T) {
+	for _, pin := range []string{"1234", "000000", "123456789012"} {
+		assert.True(t, ValidPIN(pin), pin)
+	}
+	for _, pin := range []string{"", "123", "1234567890123", "12a4", "12 34", "١٢٣٤"} {
+		assert.False(t, ValidPIN(pin), pin)
+	}
+}
+
+func TestGate(t *testing.T) {
+	var g Gate
+	assert.False(t, g.Locked(), "zero value is unlocked")
+	assert.True(t, g.Unlock("anything"), "unlocking an open gate succeeds")
+
+	assert.False(t, g.Lock(""), "no pin, no lock")
+	assert.False(t, g.Lock("12"), "invalid pin, no lock")
+	assert.False(t, g.Locked())
+
+	assert.True(t, g.Lock("2468"))
+	assert.True(t, g.Locked())
+	assert.True(t, g.Lock("1357"), "locking again keeps the first pin")
+
+	assert.False(t, g.Unlock("1357"))
+	assert.False(t, g.Unlock(""))
+	assert.False(t, g.Unlock("24680"))
+	assert.True(t, g.Locked(), "wrong pins keep the gate locked")
+
+	assert.True(t, g.Unlock(" 2468 "), "surrounding spaces are ignored")
+	assert.False(t, g.Locked())
+
+	assert.True(t, g.Lock("1357"), "a new panic locks with the current pin")
+	assert.False(t, g.Unlock("2468"))
+	assert.True(t, g.Unlock("1357"))
+}
@@ -0,0 +1,48 @@
+package privacy
+
+import (
+	"net/url"
+	"strings"
+
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// ClearDataOnCloseOrigin returns the origin whose data is cleared when a pane
+// showing rawURL closes. ok is false when rawURL is not an http(s) page or its
+// domain matches none of the patterns.
+func ClearDataOnCloseOrigin(patterns []string, rawURL string) (origin string, ok bool) {
+	if len(patterns) == 0 {
+		return "", false
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return "", false
+	}
+	if scheme := strings.ToLower(parsed.Scheme); scheme != "http" && scheme != "https" {
+		return "", false
+	}
+	if !urlutil.MatchAnyDomainPattern(patterns, rawURL) {
+		return "", false
+	}
+	origin, err = urlutil.ExtractOrigin(rawURL)
+	if err != nil {
+		return "", false
+	}
+	return origin, true
+}
+
+// SiteDataCoversOrigin reports whether a website data record named site
+// (a host or registrable domain, as engines group stored data) holds data of
+// origin. A record covers its own host and every subdomain of it.
+func SiteDataCoversOrigin(site, origin string) bool {
+	site = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(site)), ".")
+	if site == "" {
+		return false
+	}
+	parsed, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	return host == site || strings.HasSuffix(host, "."+site)
+}
@@ -0,0 +1,60 @@
+package privacy
+
+import "testing"
+
+func TestClearDataOnCloseOrigin(t *testing.T) {
+	patterns := []string{"bank.example", "*.health.example", "localhost:3000"}
+
+	tests := []struct {
+		name       string
+		patterns   []string
+		rawURL     string
+		wantOrigin string
+		wantOK     bool
+	}{
+		{name: "exact domain", patterns: patterns, rawURL: "https://bank.example/login?next=/", wantOrigin: "https://bank.example", wantOK: true},
+		{name: "case folded", patterns: patterns, rawURL: "HTTPS://Bank.Example/", wantOrigin: "https://bank.example", wantOK: true},
+		{name: "www prefix kept in origin", patterns: patterns, rawURL: "https://www.bank.example/", wantOrigin: "https://www.bank.example", wantOK: true},
+		{name: "default port dropped", patterns: patterns, rawURL: "https://bank.example:443/", wantOrigin: "https://bank.example", wantOK: true},
+		{name: "other port kept", patterns: patterns, rawURL: "http://localhost:3000/app", wantOrigin: "http://localhost:3000", wantOK: true},
+		{name: "wildcard subdomain", patterns: patterns, rawURL: "https://portal.health.example/records", wantOrigin: "https://portal.health.example", wantOK: true},
+		{name: "wildcard apex", patterns: patterns, rawURL: "https://health.example/", wantOrigin: "https://health.example", wantOK: true},
+		{name: "no match", patterns: patterns, rawURL: "https://news.example/", wantOK: false},
+		{name: "lookalike suffix", patterns: patterns, rawURL: "https://notbank.example/", wantOK: false},
+		{name: "non-http scheme", patterns: patterns, rawURL: "dumb://bank.example", wantOK: false},
+		{name: "file url", patterns: patterns, rawURL: "file:///tmp/bank.example", wantOK: false},
+		{name: "empty url", patterns: patterns, rawURL: "", wantOK: false},
+		{name: "no patterns", patterns: nil, rawURL: "https://bank.example/", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origin, ok := ClearDataOnCloseOrigin(tt.patterns, tt.rawURL)
+			if ok != tt.wantOK || origin != tt.wantOrigin {
+				t.Fatalf("ClearDataOnCloseOrigin(%q) = (%q, %t), want (%q, %t)", tt.rawURL, origin, ok, tt.wantOrigin, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSiteDataCoversOrigin(t *testing.T) {
+	tests := []struct {
+		site   string
+		origin string
+		want   bool
+	}{
+		{site: "bank.example", origin: "https://bank.example", want: true},
+		{site: "bank.example", origin: "https://login.bank.example", want: true},
+		{site: "Bank.Example", origin: "https://bank.example", want: true},
+		{site: "login.bank.example", origin: "https://bank.example", want: false},
+		{site: "bank.example", origin: "https://notbank.example", want: false},
+		{site: "localhost", origin: "http://localhost:3000", want: true},
+		{site: "", origin: "https://bank.example", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := SiteDataCoversOrigin(tt.site, tt.origin); got != tt.want {
+			t.Errorf("SiteDataCoversOrigin(%q, %q) = %t, want %t", tt.site, tt.origin, got, tt.want)
+		}
+	}
+}
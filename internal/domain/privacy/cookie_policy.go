@@ -0,0 +1,39 @@
+// Package privacy holds engine-agnostic privacy policy rules.
+package privacy
+
+import (
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// ResolveCookiePolicy returns the cookie policy that applies to rawURL.
+// The most specific matching override wins (the first entry wins for duplicate
+// domains); otherwise defaultPolicy applies.
+// Invalid policies (default or override) resolve to no_third_party.
+func ResolveCookiePolicy(
+	defaultPolicy entity.CookiePolicy,
+	overrides []entity.CookiePolicyOverride,
+	rawURL string,
+) entity.CookiePolicy {
+	if len(overrides) > 0 {
+		patterns := make([]string, 0, len(overrides))
+		for _, override := range overrides {
+			patterns = append(patterns, override.Domain)
+		}
+		if pattern, ok := urlutil.BestDomainPatternMatch(patterns, rawURL); ok {
+			for _, override := range overrides {
+				if override.Domain == pattern {
+					return normalizeCookiePolicy(override.Policy)
+				}
+			}
+		}
+	}
+	return normalizeCookiePolicy(defaultPolicy)
+}
+
+func normalizeCookiePolicy(policy entity.CookiePolicy) entity.CookiePolicy {
+	if policy.IsValid() {
+		return policy
+	}
+	return entity.CookiePolicyNoThirdParty
+}
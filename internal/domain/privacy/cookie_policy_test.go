@@ -0,0 +1,46 @@
+package privacy
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestResolveCookiePolicy(t *testing.T) {
+	overrides := []entity.CookiePolicyOverride{
+		{Domain: "bank.example", Policy: entity.CookiePolicyAlways},
+		{Domain: "*.tracker.test", Policy: entity.CookiePolicyNever},
+		{Domain: "login.tracker.test", Policy: entity.CookiePolicyNoThirdParty},
+		{Domain: "broken.test", Policy: "sometimes"},
+	}
+
+	tests := []struct {
+		name          string
+		defaultPolicy entity.CookiePolicy
+		rawURL        string
+		want          entity.CookiePolicy
+	}{
+		{name: "no match uses default", defaultPolicy: entity.CookiePolicyAlways, rawURL: "https://news.test/a", want: entity.CookiePolicyAlways},
+		{name: "exact domain", defaultPolicy: entity.CookiePolicyNoThirdParty, rawURL: "https://bank.example/login", want: entity.CookiePolicyAlways},
+		{name: "www prefix ignored", defaultPolicy: entity.CookiePolicyNoThirdParty, rawURL: "https://www.bank.example/", want: entity.CookiePolicyAlways},
+		{name: "wildcard apex", defaultPolicy: entity.CookiePolicyAlways, rawURL: "https://tracker.test/", want: entity.CookiePolicyNever},
+		{name: "wildcard subdomain", defaultPolicy: entity.CookiePolicyAlways, rawURL: "https://cdn.tracker.test/x", want: entity.CookiePolicyNever},
+		{
+			name: "exact beats wildcard", defaultPolicy: entity.CookiePolicyAlways,
+			rawURL: "https://login.tracker.test/", want: entity.CookiePolicyNoThirdParty,
+		},
+		{
+			name: "invalid override falls back", defaultPolicy: entity.CookiePolicyAlways,
+			rawURL: "https://broken.test/", want: entity.CookiePolicyNoThirdParty,
+		},
+		{name: "invalid default falls back", defaultPolicy: "", rawURL: "https://news.test/", want: entity.CookiePolicyNoThirdParty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveCookiePolicy(tt.defaultPolicy, overrides, tt.rawURL); got != tt.want {
+				t.Fatalf("ResolveCookiePolicy(%q, %q) = %q, want %q", tt.defaultPolicy, tt.rawURL, got, tt.want)
+			}
+		})
+	}
+}
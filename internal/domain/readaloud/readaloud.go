@@ -0,0 +1,253 @@
+// Package readaloud reads pages aloud through an external text-to-speech
+// command: it extracts the readable text of a page, splits it into chunks
+// the command speaks one at a time, and builds the command line from
+// read_aloud.command without involving a shell.
+package readaloud
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxChunkLen is the longest chunk, in characters, handed to the command in
+// one run. Short runs keep pausing and stopping responsive.
+const MaxChunkLen = 400
+
+// State is where the reading of a page stands.
+type State string
+
+const (
+	StateIdle    State = "idle"
+	StatePlaying State = "playing"
+	StatePaused  State = "paused"
+)
+
+// Control is a request to change the reading.
+type Control string
+
+const (
+	ControlStart  Control = "start"
+	ControlPause  Control = "pause"
+	ControlResume Control = "resume"
+	ControlStop   Control = "stop"
+)
+
+// ParseControl reads the argument of the ">read" omnibox command. An empty
+// argument returns "", meaning the control follows from the current state
+// (see Toggle).
+func ParseControl(args string) (Control, error) {
+	switch control := Control(strings.ToLower(strings.TrimSpace(args))); control {
+	case "", ControlStart, ControlPause, ControlResume, ControlStop:
+		return control, nil
+	default:
+		return "", fmt.Errorf("unknown read control %q (want pause, resume or stop)", args)
+	}
+}
+
+// Toggle returns the control of the read-aloud key in state: it starts
+// reading when idle and pauses or resumes the current reading otherwise.
+func Toggle(state State) Control {
+	switch state {
+	case StatePlaying:
+		return ControlPause
+	case StatePaused:
+		return ControlResume
+	default:
+		return ControlStart
+	}
+}
+
+// ExtractScript returns a JavaScript expression evaluating to the readable
+// text of the page: the text blocks of its single article, its main region
+// or its body, skipping navigation, forms and hidden content. Blocks are
+// separated by blank lines.
+func ExtractScript() string {
+	return extractScript
+}
+
+const extractScript = `(function() {
+	const skip = 'script, style, noscript, template, nav, aside, footer, form, button, svg, iframe, [hidden], [aria-hidden="true"]';
+	const blocks = 'h1, h2, h3, h4, h5, h6, p, li, blockquote, pre, figcaption, dt, dd';
+	const articles = document.querySelectorAll('article');
+	const root = (articles.length === 1 ? articles[0] : null) ||
+		document.querySelector('main, [role="main"]') || document.body;
+	if (!root) {
+		return '';
+	}
+	const clean = (text) => (text || '').replace(/\s+/g, ' ').trim();
+	const parts = [];
+	for (const el of root.querySelectorAll(blocks)) {
+		if (el.closest(skip) || (el.parentElement && el.parentElement.closest(blocks))) {
+			continue;
+		}
+		const style = getComputedStyle(el);
+		if (style.display === 'none' || style.visibility === 'hidden') {
+			continue;
+		}
+		const text = clean(el.innerText);
+		if (text) {
+			parts.push(text);
+		}
+	}
+	if (parts.length === 0) {
+		return clean(root.innerText);
+	}
+	return parts.join('\n\n');
+})()`
+
+// Chunks splits text into pieces of at most maxLen characters to be spoken
+// in order. Paragraphs, separated by line breaks, always start a new chunk;
+// within a paragraph whole sentences are packed together, and sentences
+// longer than maxLen are cut between words. Whitespace runs are collapsed.
+// A maxLen below 1 uses MaxChunkLen.
+func Chunks(text string, maxLen int) []string {
+	if maxLen < 1 {
+		maxLen = MaxChunkLen
+	}
+	var chunks []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		paragraph = strings.Join(strings.Fields(paragraph), " ")
+		if paragraph == "" {
+			continue
+		}
+		var (
+			current    strings.Builder
+			currentLen int
+		)
+		for _, sentence := range sentences(paragraph) {
+			for _, piece := range splitLong(sentence, maxLen) {
+				pieceLen := utf8.RuneCountInString(piece)
+				if currentLen > 0 && currentLen+1+pieceLen > maxLen {
+					chunks = append(chunks, current.String())
+					current.Reset()
+					currentLen = 0
+				}
+				if currentLen > 0 {
+					current.WriteByte(' ')
+					currentLen++
+				}
+				current.WriteString(piece)
+				currentLen += pieceLen
+			}
+		}
+		if currentLen > 0 {
+			chunks = append(chunks, current.String())
+		}
+	}
+	return chunks
+}
+
+// sentences splits a paragraph after each ".", "!", "?" or "…" followed by
+// a space. Abbreviations end a sentence too, which only costs a short pause.
+func sentences(paragraph string) []string {
+	var out []string
+	start := 0
+	runes := []rune(paragraph)
+	for i := 0; i < len(runes)-1; i++ {
+		switch runes[i] {
+		case '.', '!', '?', '…':
+			if runes[i+1] == ' ' {
+				out = append(out, string(runes[start:i+1]))
+				start = i + 2
+			}
+		}
+	}
+	if start < len(runes) {
+		out = append(out, string(runes[start:]))
+	}
+	return out
+}
+
+// splitLong cuts a sentence longer than maxLen between words, and words
+// longer than maxLen anywhere.
+func splitLong(sentence string, maxLen int) []string {
+	if utf8.RuneCountInString(sentence) <= maxLen {
+		return []string{sentence}
+	}
+	var out []string
+	var current []rune
+	for _, word := range strings.Fields(sentence) {
+		runes := []rune(word)
+		if len(current) > 0 && len(current)+1+len(runes) > maxLen {
+			out = append(out, string(current))
+			current = nil
+		}
+		for len(runes) > maxLen {
+			out = append(out, string(runes[:maxLen]))
+			runes = runes[maxLen:]
+		}
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, runes...)
+	}
+	if len(current) > 0 {
+		out = append(out, string(current))
+	}
+	return out
+}
+
+// ErrNoCommand is returned by ParseCommand for an empty command.
+var ErrNoCommand = errors.New("no text-to-speech command configured")
+
+// shellOperators are characters a shell would interpret. The command runs
+// without a shell, so they would reach the program literally; they are
+// rejected unquoted so a pipeline or redirection is not mistaken for one.
+const shellOperators = "|&;<>()$`\\"
+
+// ParseCommand splits read_aloud.command into the program and its
+// arguments. Words are separated by spaces; single or double quotes keep a
+// word with spaces together and have no escapes. Unquoted shell operators
+// and control characters are rejected: the command is run directly, never
+// through a shell, and the page text only ever reaches it on stdin.
+func ParseCommand(raw string) ([]string, error) {
+	var (
+		argv   []string
+		word   strings.Builder
+		inWord bool
+		quote  rune
+	)
+	for _, r := range raw {
+		if unicode.IsControl(r) && r != '\t' {
+			return nil, fmt.Errorf("control character %q in command", r)
+		}
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+				continue
+			}
+			word.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				argv = append(argv, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case strings.ContainsRune(shellOperators, r):
+			return nil, fmt.Errorf("unquoted %q in command: it runs without a shell, put pipelines in a script", r)
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in command", quote)
+	}
+	if inWord {
+		argv = append(argv, word.String())
+	}
+	if len(argv) == 0 {
+		return nil, ErrNoCommand
+	}
+	if argv[0] == "" || strings.HasPrefix(argv[0], "-") {
+		return nil, fmt.Errorf("command must start with a program, got %q", argv[0])
+	}
+	return argv, nil
+}
@@ -0,0 +1,122 @@
+package readaloud
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunks_PacksSentencesPerParagraph(t *testing.T) {
+	text := "Title\n\nFirst sentence. Second one!   Third?\n\n\nNew   paragraph here."
+
+	assert.Equal(t, []string{
+		"Title",
+		"First sentence. Second one! Third?",
+		"New paragraph here.",
+	}, Chunks(text, 100))
+
+	assert.Equal(t, []string{
+		"Title",
+		"First sentence.",
+		"Second one! Third?",
+		"New paragraph here.",
+	}, Chunks(text, 20))
+}
+
+func TestChunks_CutsLongSentencesBetweenWords(t *testing.T) {
+	chunks := Chunks("one two three four five six seven", 10)
+	assert.Equal(t, []string{"one two", "three four", "five six", "seven"}, chunks)
+
+	chunks = Chunks("abcdefghijklmnopqrstuvwxyz end", 10)
+	assert.Equal(t, []string{"abcdefghij", "klmnopqrst", "uvwxyz end"}, chunks)
+}
+
+func TestChunks_RespectsMaxLenInCharacters(t *testing.T) {
+	text := strings.Repeat("Élan vital, déjà vu… ", 60)
+	chunks := Chunks(text, 50)
+	require.NotEmpty(t, chunks)
+	for _, chunk := range chunks {
+		assert.LessOrEqual(t, utf8.RuneCountInString(chunk), 50, chunk)
+		assert.Equal(t, strings.TrimSpace(chunk), chunk)
+	}
+	assert.Equal(t, strings.Join(strings.Fields(text), " "), strings.Join(chunks, " "),
+		"no text is lost or reordered")
+}
+
+func TestChunks_EmptyAndDefaultLength(t *testing.T) {
+	assert.Empty(t, Chunks("", 10))
+	assert.Empty(t, Chunks(" \n\t\n ", 10))
+
+	chunks := Chunks(strings.Repeat("word ", 200), 0)
+	require.Len(t, chunks, 3)
+	assert.LessOrEqual(t, utf8.RuneCountInString(chunks[0]), MaxChunkLen)
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want []string
+	}{
+		{"espeak-ng", []string{"espeak-ng"}},
+		{"  espeak-ng  -v en-us\t-s 160 ", []string{"espeak-ng", "-v", "en-us", "-s", "160"}},
+		{`piper-say --model "/voices/en US.onnx"`, []string{"piper-say", "--model", "/voices/en US.onnx"}},
+		{`say 'a | b; $HOME'`, []string{"say", "a | b; $HOME"}},
+		{`say ""`, []string{"say", ""}},
+		{`say --voice="en gb"`, []string{"say", "--voice=en gb"}},
+	}
+	for _, tt := range tests {
+		got, err := ParseCommand(tt.raw)
+		require.NoError(t, err, tt.raw)
+		assert.Equal(t, tt.want, got, tt.raw)
+	}
+}
+
+func TestParseCommand_RejectsShellSyntax(t *testing.T) {
+	for _, raw := range []string{
+		"piper --model x | aplay",
+		"espeak-ng; rm -rf ~",
+		"espeak-ng && true",
+		"espeak-ng > /tmp/out",
+		"espeak-ng < /etc/passwd",
+		"espeak-ng $(whoami)",
+		"espeak-ng `whoami`",
+		`espeak-ng \-v`,
+		"espeak-ng\nrm -rf ~",
+		"espeak-ng \x00",
+		`espeak-ng "unterminated`,
+		"-v en",
+		`"" -v en`,
+	} {
+		_, err := ParseCommand(raw)
+		assert.Error(t, err, "%q", raw)
+	}
+
+	_, err := ParseCommand("   ")
+	assert.ErrorIs(t, err, ErrNoCommand)
+}
+
+func TestParseControl(t *testing.T) {
+	for args, want := range map[string]Control{
+		"":        "",
+		" pause ": ControlPause,
+		"Resume":  ControlResume,
+		"stop":    ControlStop,
+		"start":   ControlStart,
+	} {
+		got, err := ParseControl(args)
+		require.NoError(t, err, args)
+		assert.Equal(t, want, got, args)
+	}
+	_, err := ParseControl("louder")
+	assert.Error(t, err)
+}
+
+func TestToggle(t *testing.T) {
+	assert.Equal(t, ControlStart, Toggle(StateIdle))
+	assert.Equal(t, ControlStart, Toggle(""))
+	assert.Equal(t, ControlPause, Toggle(StatePlaying))
+	assert.Equal(t, ControlResume, Toggle(StatePaused))
+}
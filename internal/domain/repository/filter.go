@@ -17,3 +17,20 @@ type ContentWhitelistRepository interface {
 	// GetAll retrieves all whitelisted domains.
 	GetAll(ctx context.Context) ([]string, error)
 }
+
+// CosmeticFilterExceptionRepository defines operations for cosmetic filter
+// exception persistence. Listed domains load without the rules that hide page
+// elements; their requests are still filtered.
+type CosmeticFilterExceptionRepository interface {
+	// Add adds a domain to the exceptions.
+	Add(ctx context.Context, domain string) error
+
+	// Remove removes a domain from the exceptions.
+	Remove(ctx context.Context, domain string) error
+
+	// Contains checks if a domain is an exception.
+	Contains(ctx context.Context, domain string) (bool, error)
+
+	// GetAll retrieves all excepted domains.
+	GetAll(ctx context.Context) ([]string, error)
+}
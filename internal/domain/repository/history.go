@@ -68,6 +68,14 @@ type HistoryRepository interface {
 	// GetDomainStats retrieves per-domain statistics.
 	GetDomainStats(ctx context.Context, limit int) ([]*entity.DomainStat, error)
 
+	// GroupByDomain retrieves up to limit domains ordered by most recent visit,
+	// each with its perGroup most recent entries. A zero perGroup returns counts only.
+	GroupByDomain(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error)
+
+	// GroupByDay retrieves up to limit local days, newest first, each with its
+	// perGroup most recent entries. A zero perGroup returns counts only.
+	GroupByDay(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error)
+
 	// GetHourlyDistribution retrieves hourly visit distribution.
 	GetHourlyDistribution(ctx context.Context) ([]*entity.HourlyDistribution, error)
 
@@ -1226,6 +1226,154 @@ func (_c *MockHistoryRepository_GetStats_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GroupByDay provides a mock function for the type MockHistoryRepository
+func (_mock *MockHistoryRepository) GroupByDay(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error) {
+	ret := _mock.Called(ctx, limit, perGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupByDay")
+	}
+
+	var r0 []*entity.HistoryGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*entity.HistoryGroup, error)); ok {
+		return returnFunc(ctx, limit, perGroup)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*entity.HistoryGroup); ok {
+		r0 = returnFunc(ctx, limit, perGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.HistoryGroup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, limit, perGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHistoryRepository_GroupByDay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupByDay'
+type MockHistoryRepository_GroupByDay_Call struct {
+	*mock.Call
+}
+
+// GroupByDay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - perGroup int
+func (_e *MockHistoryRepository_Expecter) GroupByDay(ctx any, limit any, perGroup any) *MockHistoryRepository_GroupByDay_Call {
+	return &MockHistoryRepository_GroupByDay_Call{Call: _e.mock.On("GroupByDay", ctx, limit, perGroup)}
+}
+
+func (_c *MockHistoryRepository_GroupByDay_Call) Run(run func(ctx context.Context, limit int, perGroup int)) *MockHistoryRepository_GroupByDay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHistoryRepository_GroupByDay_Call) Return(historyGroups []*entity.HistoryGroup, err error) *MockHistoryRepository_GroupByDay_Call {
+	_c.Call.Return(historyGroups, err)
+	return _c
+}
+
+func (_c *MockHistoryRepository_GroupByDay_Call) RunAndReturn(run func(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error)) *MockHistoryRepository_GroupByDay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupByDomain provides a mock function for the type MockHistoryRepository
+func (_mock *MockHistoryRepository) GroupByDomain(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error) {
+	ret := _mock.Called(ctx, limit, perGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupByDomain")
+	}
+
+	var r0 []*entity.HistoryGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]*entity.HistoryGroup, error)); ok {
+		return returnFunc(ctx, limit, perGroup)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []*entity.HistoryGroup); ok {
+		r0 = returnFunc(ctx, limit, perGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.HistoryGroup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, limit, perGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockHistoryRepository_GroupByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupByDomain'
+type MockHistoryRepository_GroupByDomain_Call struct {
+	*mock.Call
+}
+
+// GroupByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - perGroup int
+func (_e *MockHistoryRepository_Expecter) GroupByDomain(ctx any, limit any, perGroup any) *MockHistoryRepository_GroupByDomain_Call {
+	return &MockHistoryRepository_GroupByDomain_Call{Call: _e.mock.On("GroupByDomain", ctx, limit, perGroup)}
+}
+
+func (_c *MockHistoryRepository_GroupByDomain_Call) Run(run func(ctx context.Context, limit int, perGroup int)) *MockHistoryRepository_GroupByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockHistoryRepository_GroupByDomain_Call) Return(historyGroups []*entity.HistoryGroup, err error) *MockHistoryRepository_GroupByDomain_Call {
+	_c.Call.Return(historyGroups, err)
+	return _c
+}
+
+func (_c *MockHistoryRepository_GroupByDomain_Call) RunAndReturn(run func(ctx context.Context, limit int, perGroup int) ([]*entity.HistoryGroup, error)) *MockHistoryRepository_GroupByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IncrementVisitCount provides a mock function for the type MockHistoryRepository
 func (_mock *MockHistoryRepository) IncrementVisitCount(ctx context.Context, url string) error {
 	ret := _mock.Called(ctx, url)
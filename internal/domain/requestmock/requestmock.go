@@ -0,0 +1,258 @@
+// Package requestmock answers page requests with canned responses for
+// front-end testing. A pane switched to a mock set by the omnibox ">mock"
+// command runs Script, which wraps fetch and XMLHttpRequest so requests
+// matching a mock never reach the network.
+package requestmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+const (
+	// DefaultStatus is the status of mocks that do not set one.
+	DefaultStatus = http.StatusOK
+	// DefaultContentType is the content type of mocks that do not set one.
+	DefaultContentType = "application/json"
+)
+
+// Off is the ">mock" argument that switches a pane back to the network.
+const Off = "off"
+
+// Normalize trims mock, fills in the default status and content type and
+// upper-cases the method. It fails when the URL pattern is empty or the
+// status is not one a page can receive (200-599).
+func Normalize(mock entity.RequestMock) (entity.RequestMock, error) {
+	mock.URLPattern = strings.TrimSpace(mock.URLPattern)
+	if mock.URLPattern == "" {
+		return entity.RequestMock{}, fmt.Errorf("mock has no url pattern")
+	}
+	mock.Method = strings.ToUpper(strings.TrimSpace(mock.Method))
+	if mock.Status == 0 {
+		mock.Status = DefaultStatus
+	}
+	if mock.Status < http.StatusOK || mock.Status > 599 {
+		return entity.RequestMock{}, fmt.Errorf("mock %q: status %d is not between 200 and 599", mock.URLPattern, mock.Status)
+	}
+	mock.ContentType = strings.TrimSpace(mock.ContentType)
+	if mock.ContentType == "" {
+		mock.ContentType = DefaultContentType
+	}
+	return mock, nil
+}
+
+// PatternRegexp returns the regular expression source of a URL pattern, in
+// the subset of syntax Go and JavaScript share: the whole URL must match and
+// "*" matches any run of characters.
+func PatternRegexp(pattern string) string {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return "^" + strings.Join(parts, ".*") + "$"
+}
+
+// MatchURL reports whether rawURL matches pattern. The fragment of rawURL
+// is ignored, as it is never sent.
+func MatchURL(pattern, rawURL string) bool {
+	if pattern == "" || rawURL == "" {
+		return false
+	}
+	rawURL, _, _ = strings.Cut(rawURL, "#")
+	re, err := regexp.Compile(PatternRegexp(pattern))
+	if err != nil {
+		return false
+	}
+	return re.MatchString(rawURL)
+}
+
+// Select returns the first mock answering a method request to rawURL.
+func Select(mocks []entity.RequestMock, method, rawURL string) (entity.RequestMock, bool) {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		method = http.MethodGet
+	}
+	for _, mock := range mocks {
+		if mock.Method != "" && !strings.EqualFold(mock.Method, method) {
+			continue
+		}
+		if MatchURL(mock.URLPattern, rawURL) {
+			return mock, true
+		}
+	}
+	return entity.RequestMock{}, false
+}
+
+// Find returns the set called name.
+func Find(sets []entity.RequestMockSet, name string) (entity.RequestMockSet, bool) {
+	for _, set := range sets {
+		if set.Name == name {
+			return set, true
+		}
+	}
+	return entity.RequestMockSet{}, false
+}
+
+// Names returns the names of sets, in order.
+func Names(sets []entity.RequestMockSet) []string {
+	names := make([]string, 0, len(sets))
+	for _, set := range sets {
+		names = append(names, set.Name)
+	}
+	return names
+}
+
+// scriptMock is a mock as the page script reads it.
+type scriptMock struct {
+	Pattern string            `json:"pattern"`
+	Method  string            `json:"method"`
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	Body    string            `json:"body"`
+}
+
+// Script returns the page script answering requests with mocks, the way
+// Select picks them. The first run wraps fetch and XMLHttpRequest; later runs
+// only replace the mocks, so running it with none sends every request to the
+// network again.
+func Script(mocks []entity.RequestMock) string {
+	entries := make([]scriptMock, 0, len(mocks))
+	for _, mock := range mocks {
+		headers := make(map[string]string, len(mock.Headers)+1)
+		for name, value := range mock.Headers {
+			headers[strings.ToLower(name)] = value
+		}
+		if mock.ContentType != "" {
+			headers["content-type"] = mock.ContentType
+		}
+		entries = append(entries, scriptMock{
+			Pattern: PatternRegexp(mock.URLPattern),
+			Method:  strings.ToUpper(mock.Method),
+			Status:  mock.Status,
+			Headers: headers,
+			Body:    mock.Body,
+		})
+	}
+	encoded, err := json.Marshal(entries)
+	if err != nil {
+		encoded = []byte("[]")
+	}
+	return fmt.Sprintf(mockScript, encoded)
+}
+
+// mockScript takes the JSON list of mocks.
+const mockScript = `(function() {
+  var mocks = %s.map(function(m) {
+    m.re = new RegExp(m.pattern);
+    return m;
+  });
+  if (window.__dumberRequestMocks) {
+    window.__dumberRequestMocks.mocks = mocks;
+    return;
+  }
+  var state = { mocks: mocks };
+  Object.defineProperty(window, '__dumberRequestMocks', { value: state });
+
+  var nullBodyStatus = [204, 205, 304];
+
+  function select(method, url) {
+    if (!state.mocks.length) {
+      return null;
+    }
+    var abs;
+    try {
+      abs = new URL(String(url), document.baseURI);
+    } catch (_) {
+      return null;
+    }
+    abs.hash = '';
+    var href = abs.href;
+    method = String(method || 'GET').toUpperCase();
+    for (var i = 0; i < state.mocks.length; i++) {
+      var m = state.mocks[i];
+      if ((!m.method || m.method === method) && m.re.test(href)) {
+        return { mock: m, url: href };
+      }
+    }
+    return null;
+  }
+
+  var origFetch = window.fetch;
+  if (typeof origFetch === 'function') {
+    window.fetch = function(input, init) {
+      var isRequest = typeof Request !== 'undefined' && input instanceof Request;
+      var url = isRequest ? input.url : input;
+      var method = (init && init.method) || (isRequest ? input.method : 'GET');
+      var hit = select(method, url);
+      if (!hit) {
+        return origFetch.apply(this, arguments);
+      }
+      var m = hit.mock;
+      var body = nullBodyStatus.indexOf(m.status) >= 0 ? null : m.body;
+      return Promise.resolve(new Response(body, { status: m.status, headers: m.headers }));
+    };
+  }
+
+  var XHR = window.XMLHttpRequest;
+  if (!XHR) {
+    return;
+  }
+  var origOpen = XHR.prototype.open;
+  var origSend = XHR.prototype.send;
+  XHR.prototype.open = function(method, url) {
+    this.__dumberMock = select(method, url);
+    return origOpen.apply(this, arguments);
+  };
+  XHR.prototype.send = function() {
+    var hit = this.__dumberMock;
+    if (!hit) {
+      return origSend.apply(this, arguments);
+    }
+    var xhr = this;
+    var m = hit.mock;
+    function define(name, value) {
+      Object.defineProperty(xhr, name, { value: value, configurable: true });
+    }
+    setTimeout(function() {
+      var response = m.body;
+      switch (xhr.responseType) {
+      case 'json':
+        try { response = JSON.parse(m.body); } catch (_) { response = null; }
+        break;
+      case 'arraybuffer':
+        response = new TextEncoder().encode(m.body).buffer;
+        break;
+      case 'blob':
+        response = new Blob([m.body], { type: m.headers['content-type'] || '' });
+        break;
+      case 'document':
+        response = null;
+        break;
+      default:
+        define('responseText', m.body);
+      }
+      define('readyState', 4);
+      define('status', m.status);
+      define('statusText', '');
+      define('responseURL', hit.url);
+      define('response', response);
+      define('getResponseHeader', function(name) {
+        var value = m.headers[String(name).toLowerCase()];
+        return value === undefined ? null : value;
+      });
+      define('getAllResponseHeaders', function() {
+        return Object.keys(m.headers).map(function(name) {
+          return name + ': ' + m.headers[name] + '\r\n';
+        }).join('');
+      });
+      ['readystatechange', 'load', 'loadend'].forEach(function(type) {
+        xhr.dispatchEvent(new Event(type));
+      });
+    }, 0);
+  };
+})();`
@@ -0,0 +1,122 @@
+package requestmock
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		url     string
+		want    bool
+	}{
+		{name: "exact", pattern: "https://api.example.com/users", url: "https://api.example.com/users", want: true},
+		{name: "exact rejects longer url", pattern: "https://api.example.com/users", url: "https://api.example.com/users/1", want: false},
+		{name: "trailing wildcard", pattern: "https://api.example.com/users/*", url: "https://api.example.com/users/42?expand=1", want: true},
+		{name: "wildcard in host", pattern: "https://*.example.com/*", url: "https://staging.example.com/v1/items", want: true},
+		{name: "wildcard matches empty run", pattern: "https://example.com/a*b", url: "https://example.com/ab", want: true},
+		{name: "dots are literal", pattern: "https://example.com/*", url: "https://exampleXcom/x", want: false},
+		{name: "query metacharacters are literal", pattern: "https://example.com/s?q=(a)", url: "https://example.com/s?q=(a)", want: true},
+		{name: "fragment is ignored", pattern: "https://example.com/page", url: "https://example.com/page#top", want: true},
+		{name: "empty pattern", pattern: "", url: "https://example.com/", want: false},
+		{name: "empty url", pattern: "*", url: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchURL(tt.pattern, tt.url))
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	mocks := []entity.RequestMock{
+		{URLPattern: "https://api.example.com/users/*", Method: "POST", Status: 201, Body: "created"},
+		{URLPattern: "https://api.example.com/users/*", Status: 200, Body: "user"},
+		{URLPattern: "https://api.example.com/*", Status: 404, Body: "fallback"},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		wantBody string
+		wantHit  bool
+	}{
+		{name: "method specific mock wins", method: "POST", url: "https://api.example.com/users/1", wantBody: "created", wantHit: true},
+		{name: "method is case-insensitive", method: "post", url: "https://api.example.com/users/1", wantBody: "created", wantHit: true},
+		{name: "any method mock", method: "GET", url: "https://api.example.com/users/1", wantBody: "user", wantHit: true},
+		{name: "empty method is GET", method: "", url: "https://api.example.com/users/1", wantBody: "user", wantHit: true},
+		{name: "first match wins over later ones", method: "DELETE", url: "https://api.example.com/users/1", wantBody: "user", wantHit: true},
+		{name: "falls through to broader pattern", method: "GET", url: "https://api.example.com/orders", wantBody: "fallback", wantHit: true},
+		{name: "no match", method: "GET", url: "https://other.example.com/", wantHit: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, ok := Select(mocks, tt.method, tt.url)
+			require.Equal(t, tt.wantHit, ok)
+			assert.Equal(t, tt.wantBody, mock.Body)
+		})
+	}
+
+	_, ok := Select(nil, "GET", "https://api.example.com/users/1")
+	assert.False(t, ok)
+}
+
+func TestNormalize(t *testing.T) {
+	mock, err := Normalize(entity.RequestMock{URLPattern: "  https://example.com/*  ", Method: " patch "})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/*", mock.URLPattern)
+	assert.Equal(t, "PATCH", mock.Method)
+	assert.Equal(t, DefaultStatus, mock.Status)
+	assert.Equal(t, DefaultContentType, mock.ContentType)
+
+	mock, err = Normalize(entity.RequestMock{URLPattern: "*", Status: 503, ContentType: "text/plain"})
+	require.NoError(t, err)
+	assert.Equal(t, 503, mock.Status)
+	assert.Equal(t, "text/plain", mock.ContentType)
+
+	_, err = Normalize(entity.RequestMock{URLPattern: " "})
+	assert.Error(t, err)
+	_, err = Normalize(entity.RequestMock{URLPattern: "*", Status: 101})
+	assert.Error(t, err, "informational responses cannot be mocked")
+	_, err = Normalize(entity.RequestMock{URLPattern: "*", Status: 600})
+	assert.Error(t, err)
+}
+
+func TestFind(t *testing.T) {
+	sets := []entity.RequestMockSet{{Name: "empty"}, {Name: "errors"}}
+
+	set, ok := Find(sets, "errors")
+	require.True(t, ok)
+	assert.Equal(t, "errors", set.Name)
+
+	_, ok = Find(sets, "missing")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"empty", "errors"}, Names(sets))
+}
+
+func TestScript(t *testing.T) {
+	script := Script([]entity.RequestMock{{
+		URLPattern:  "https://api.example.com/users/*",
+		Method:      "get",
+		Status:      200,
+		ContentType: "application/json",
+		Headers:     map[string]string{"X-Mock": "yes"},
+		Body:        `{"name":"</script>"}`,
+	}})
+
+	assert.Contains(t, script, `"pattern":"^https://api\\.example\\.com/users/.*$"`)
+	assert.Contains(t, script, `"method":"GET"`)
+	assert.Contains(t, script, `"content-type":"application/json"`)
+	assert.Contains(t, script, `"x-mock":"yes"`)
+	assert.NotContains(t, script, "</script>", "the body is escaped")
+	assert.Contains(t, script, "window.fetch = function")
+	assert.Contains(t, script, "XHR.prototype.send = function")
+
+	assert.Contains(t, Script(nil), "var mocks = [].map(")
+}
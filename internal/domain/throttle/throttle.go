@@ -0,0 +1,121 @@
+// Package throttle spaces out requests to configured domains with a token
+// bucket per rule, so scraping-heavy development work does not hammer a site.
+package throttle
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// Bucket is a token bucket refilled at rate tokens per second, holding at
+// most burst tokens. A request takes one token. It is not safe for
+// concurrent use; Limiter serializes access.
+type Bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewBucket returns a full bucket. A burst below 1 is raised to 1.
+func NewBucket(rate float64, burst int, now time.Time) *Bucket {
+	b := float64(max(burst, 1))
+	return &Bucket{rate: rate, burst: b, tokens: b, last: now}
+}
+
+// Reserve takes a token for a request made at now and returns how long the
+// request must wait before it is sent. The token is taken even when the
+// request has to wait, so requests arriving together leave one interval
+// apart instead of all at once.
+func (b *Bucket) Reserve(now time.Time) time.Duration {
+	if now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	ready := b.last.Add(time.Duration(-b.tokens / b.rate * float64(time.Second)))
+	return ready.Sub(now)
+}
+
+// Limiter holds one bucket per rule. Requests matching several rules use the
+// most specific one. The zero and nil Limiter throttle nothing.
+type Limiter struct {
+	mu       sync.Mutex
+	rules    []entity.RequestThrottleRule
+	patterns []string
+	buckets  map[string]*Bucket
+}
+
+// NewLimiter returns a limiter for rules, skipping rules without a domain or
+// a positive rate. It returns nil when no rule is left.
+func NewLimiter(rules []entity.RequestThrottleRule) *Limiter {
+	l := &Limiter{buckets: make(map[string]*Bucket, len(rules))}
+	for _, rule := range rules {
+		domain := strings.ToLower(strings.TrimSpace(rule.Domain))
+		if domain == "" || rule.Rate <= 0 {
+			continue
+		}
+		if _, dup := l.buckets[domain]; dup {
+			continue
+		}
+		l.rules = append(l.rules, rule)
+		l.patterns = append(l.patterns, domain)
+		l.buckets[domain] = nil
+	}
+	if len(l.rules) == 0 {
+		return nil
+	}
+	return l
+}
+
+// Rules returns the rules the limiter was built from, minus skipped ones.
+func (l *Limiter) Rules() []entity.RequestThrottleRule {
+	if l == nil {
+		return nil
+	}
+	return slices.Clone(l.rules)
+}
+
+// Reserve returns how long a request to rawURL made at now must wait. Only
+// http(s) and ws(s) URLs are throttled.
+func (l *Limiter) Reserve(rawURL string, now time.Time) time.Duration {
+	if l == nil || !throttledScheme(rawURL) {
+		return 0
+	}
+	pattern, ok := urlutil.BestDomainPatternMatch(l.patterns, rawURL)
+	if !ok {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	bucket := l.buckets[pattern]
+	if bucket == nil {
+		rule := l.rules[slices.Index(l.patterns, pattern)]
+		bucket = NewBucket(rule.Rate, rule.Burst, now)
+		l.buckets[pattern] = bucket
+	}
+	return bucket.Reserve(now)
+}
+
+func throttledScheme(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https", "ws", "wss":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,90 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+var epoch = time.Unix(1700000000, 0)
+
+func TestBucketReserve_BurstThenRate(t *testing.T) {
+	b := NewBucket(2, 3, epoch)
+
+	for i := range 3 {
+		assert.Zero(t, b.Reserve(epoch), "request %d fits in the burst", i)
+	}
+	assert.Equal(t, 500*time.Millisecond, b.Reserve(epoch))
+	assert.Equal(t, time.Second, b.Reserve(epoch), "queued requests leave one interval apart")
+	assert.Equal(t, 1500*time.Millisecond, b.Reserve(epoch))
+}
+
+func TestBucketReserve_Refills(t *testing.T) {
+	b := NewBucket(4, 1, epoch)
+
+	assert.Zero(t, b.Reserve(epoch))
+	assert.Equal(t, 250*time.Millisecond, b.Reserve(epoch))
+
+	// The queued request consumed the token refilled by +250ms.
+	assert.Equal(t, 250*time.Millisecond, b.Reserve(epoch.Add(250*time.Millisecond)))
+	assert.Zero(t, b.Reserve(epoch.Add(time.Second)))
+}
+
+func TestBucketReserve_RefillCapsAtBurst(t *testing.T) {
+	b := NewBucket(10, 2, epoch)
+	later := epoch.Add(time.Hour)
+
+	assert.Zero(t, b.Reserve(later))
+	assert.Zero(t, b.Reserve(later))
+	assert.Equal(t, 100*time.Millisecond, b.Reserve(later), "an idle hour does not bank more than burst")
+}
+
+func TestBucketReserve_ClockGoingBackwardsDoesNotRefill(t *testing.T) {
+	b := NewBucket(1, 1, epoch)
+
+	assert.Zero(t, b.Reserve(epoch))
+	assert.Equal(t, 2*time.Second, b.Reserve(epoch.Add(-time.Second)))
+}
+
+func TestNewBucket_BurstBelowOne(t *testing.T) {
+	b := NewBucket(1, 0, epoch)
+
+	assert.Zero(t, b.Reserve(epoch))
+	assert.Equal(t, time.Second, b.Reserve(epoch))
+}
+
+func TestLimiterReserve(t *testing.T) {
+	l := NewLimiter([]entity.RequestThrottleRule{
+		{Domain: "*.example.com", Rate: 1, Burst: 1},
+		{Domain: "api.example.com", Rate: 10, Burst: 1},
+	})
+
+	assert.Zero(t, l.Reserve("https://www.example.com/a", epoch))
+	assert.Equal(t, time.Second, l.Reserve("https://cdn.example.com/b", epoch), "subdomains share the wildcard bucket")
+
+	assert.Zero(t, l.Reserve("https://api.example.com/v1", epoch))
+	assert.Equal(t, 100*time.Millisecond, l.Reserve("https://api.example.com/v1", epoch), "the most specific rule wins")
+
+	assert.Zero(t, l.Reserve("https://other.org/", epoch))
+	assert.Zero(t, l.Reserve("https://other.org/", epoch))
+	assert.Zero(t, l.Reserve("dumb://example.com", epoch), "internal pages are never throttled")
+}
+
+func TestNewLimiter_SkipsUnusableRules(t *testing.T) {
+	assert.Nil(t, NewLimiter(nil))
+	assert.Nil(t, NewLimiter([]entity.RequestThrottleRule{{Domain: "", Rate: 1}, {Domain: "a.com", Rate: 0}}))
+
+	l := NewLimiter([]entity.RequestThrottleRule{
+		{Domain: "a.com", Rate: 0},
+		{Domain: "b.com", Rate: 2, Burst: 4},
+		{Domain: "B.com", Rate: 9},
+	})
+	assert.Equal(t, []entity.RequestThrottleRule{{Domain: "b.com", Rate: 2, Burst: 4}}, l.Rules())
+
+	var nilLimiter *Limiter
+	assert.Zero(t, nilLimiter.Reserve("https://b.com/", epoch))
+	assert.Nil(t, nilLimiter.Rules())
+}
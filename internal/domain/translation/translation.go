@@ -0,0 +1,202 @@
+// Package translation decides when to offer translating a page: it works out
+// the page language from its <html lang> attribute or, failing that, the
+// script its text is written in, compares it with the preferred language,
+// and builds the translation URL from translation.url_template.
+package translation
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// DefaultURLTemplate opens pages in Google Translate.
+const DefaultURLTemplate = "https://translate.google.com/translate?sl={from}&tl={to}&u={url}"
+
+// AutoLanguage stands for a page language that could not be detected in a
+// translation URL; translation services read it as "detect it yourself".
+const AutoLanguage = "auto"
+
+// minScriptLetters is how many letters a text sample needs before its script
+// says anything about its language.
+const minScriptLetters = 20
+
+// NormalizeLanguage reduces a BCP 47 tag or locale name ("en-US", "pt_BR",
+// "fr_FR.UTF-8") to its lowercase primary language subtag. Anything that is
+// not a 2 or 3 letter language ("", "C", "x-klingon"), and the codes for
+// undetermined or multiple languages, return "".
+func NormalizeLanguage(tag string) string {
+	tag = strings.TrimSpace(tag)
+	if i := strings.IndexAny(tag, "-_.@"); i >= 0 {
+		tag = tag[:i]
+	}
+	if len(tag) < 2 || len(tag) > 3 {
+		return ""
+	}
+	for _, r := range tag {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return ""
+		}
+	}
+	tag = strings.ToLower(tag)
+	if nonLanguages[tag] {
+		return ""
+	}
+	return tag
+}
+
+// nonLanguages are ISO 639 codes that name no particular language.
+var nonLanguages = map[string]bool{"und": true, "mul": true, "mis": true, "zxx": true}
+
+// LanguageFromLocale returns the language of a POSIX locale such as the value
+// of $LANG. The C and POSIX locales have none.
+func LanguageFromLocale(locale string) string {
+	lang := NormalizeLanguage(locale)
+	if lang == "c" {
+		return ""
+	}
+	return lang
+}
+
+// scriptLanguages maps scripts used by essentially one language to it.
+// Latin and most other scripts are shared, so they tell nothing.
+var scriptLanguages = []struct {
+	table *unicode.RangeTable
+	lang  string
+}{
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Hangul, "ko"},
+	{unicode.Han, "zh"},
+	{unicode.Greek, "el"},
+	{unicode.Hebrew, "he"},
+	{unicode.Thai, "th"},
+	{unicode.Georgian, "ka"},
+	{unicode.Armenian, "hy"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Devanagari, "hi"},
+}
+
+// DetectScriptLanguage guesses the language of text from the script most of
+// its letters are written in. It only answers for scripts tied to one main
+// language, and returns "" for Latin text, mixed text or short samples.
+// Japanese mixes kana with Han characters, so Han text with a sprinkling of
+// kana counts as Japanese.
+func DetectScriptLanguage(text string) string {
+	counts := make(map[string]int)
+	letters := 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		for _, script := range scriptLanguages {
+			if unicode.Is(script.table, r) {
+				counts[script.lang]++
+				break
+			}
+		}
+	}
+	if letters < minScriptLetters {
+		return ""
+	}
+	if counts["ja"]*20 >= letters && counts["ja"]+counts["zh"] > letters/2 {
+		return "ja"
+	}
+	for lang, count := range counts {
+		if count > letters/2 {
+			return lang
+		}
+	}
+	return ""
+}
+
+// PageLanguage returns the language of a page: its declared <html lang> when
+// it names one, else the language its text sample is written in, else "".
+func PageLanguage(htmlLang, sample string) string {
+	if lang := NormalizeLanguage(htmlLang); lang != "" {
+		return lang
+	}
+	return DetectScriptLanguage(sample)
+}
+
+// ShouldOffer reports whether a page in pageLang should be offered for
+// translation to preferred: both are known, they differ, and pageLang is
+// not one of the ignored languages.
+func ShouldOffer(pageLang, preferred string, ignored []string) bool {
+	pageLang = NormalizeLanguage(pageLang)
+	preferred = NormalizeLanguage(preferred)
+	if pageLang == "" || preferred == "" || pageLang == preferred {
+		return false
+	}
+	for _, lang := range ignored {
+		if NormalizeLanguage(lang) == pageLang {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateURLTemplate checks that template is an http(s) URL with a {url}
+// placeholder.
+func ValidateURLTemplate(template string) error {
+	if !strings.Contains(template, "{url}") {
+		return errors.New("must contain {url}")
+	}
+	parsed, err := url.Parse(expand(template, "", "", ""))
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("must be an http or https URL")
+	}
+	return nil
+}
+
+// BuildURL fills the translation URL template for pageURL: {url} becomes the
+// query-escaped page address, {from} the page language (AutoLanguage when
+// unknown) and {to} the target language.
+func BuildURL(template, pageURL, from, to string) (string, error) {
+	if err := ValidateURLTemplate(template); err != nil {
+		return "", fmt.Errorf("translation URL template %w", err)
+	}
+	parsed, err := url.Parse(pageURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return "", fmt.Errorf("can't translate %q: not a web page", pageURL)
+	}
+	to = NormalizeLanguage(to)
+	if to == "" {
+		return "", errors.New("no language to translate to")
+	}
+	from = NormalizeLanguage(from)
+	if from == "" {
+		from = AutoLanguage
+	}
+	return expand(template, url.QueryEscape(pageURL), from, to), nil
+}
+
+func expand(template, escapedURL, from, to string) string {
+	return strings.NewReplacer("{url}", escapedURL, "{from}", from, "{to}", to).Replace(template)
+}
+
+// languageNames names the languages offers are most often made for.
+var languageNames = map[string]string{
+	"ar": "Arabic", "cs": "Czech", "da": "Danish", "de": "German", "el": "Greek",
+	"en": "English", "es": "Spanish", "fi": "Finnish", "fr": "French", "he": "Hebrew",
+	"hi": "Hindi", "hu": "Hungarian", "hy": "Armenian", "id": "Indonesian", "it": "Italian",
+	"ja": "Japanese", "ka": "Georgian", "ko": "Korean", "nl": "Dutch", "no": "Norwegian",
+	"pl": "Polish", "pt": "Portuguese", "ro": "Romanian", "ru": "Russian", "sv": "Swedish",
+	"th": "Thai", "tr": "Turkish", "uk": "Ukrainian", "vi": "Vietnamese", "zh": "Chinese",
+}
+
+// LanguageName returns the English name of lang, or lang itself when it has
+// none on record.
+func LanguageName(lang string) string {
+	if name, ok := languageNames[NormalizeLanguage(lang)]; ok {
+		return name
+	}
+	return lang
+}
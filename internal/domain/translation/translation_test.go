@@ -0,0 +1,132 @@
+package translation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLanguage(t *testing.T) {
+	tests := map[string]string{
+		"en":          "en",
+		"en-US":       "en",
+		"PT_br":       "pt",
+		"fr_FR.UTF-8": "fr",
+		" de ":        "de",
+		"haw":         "haw",
+		"":            "",
+		"C":           "",
+		"x-klingon":   "",
+		"english":     "",
+		"e1":          "",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, NormalizeLanguage(in), "NormalizeLanguage(%q)", in)
+	}
+}
+
+func TestLanguageFromLocale(t *testing.T) {
+	assert.Equal(t, "fr", LanguageFromLocale("fr_FR.UTF-8"))
+	assert.Equal(t, "en", LanguageFromLocale("en_US"))
+	assert.Empty(t, LanguageFromLocale("C.UTF-8"))
+	assert.Empty(t, LanguageFromLocale("POSIX"))
+	assert.Empty(t, LanguageFromLocale(""))
+}
+
+func TestDetectScriptLanguage(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"russian", "Москва — столица России, крупнейший по численности населения город страны", "ru"},
+		{"greek", "Η Αθήνα είναι η πρωτεύουσα και μεγαλύτερη πόλη της Ελλάδας", "el"},
+		{"japanese", "東京都は日本の首都であり、世界有数の大都市です。人口は約千四百万人です。", "ja"},
+		{"chinese", "北京是中华人民共和国的首都，也是全国的政治中心和文化中心，历史悠久。", "zh"},
+		{"korean", "서울특별시는 대한민국의 수도이자 최대 도시이며 한강이 도시를 가로지릅니다", "ko"},
+		{"arabic", "القاهرة هي عاصمة جمهورية مصر العربية وأكبر مدنها من حيث السكان", "ar"},
+		{"latin is shared", "Paris est la capitale de la France et sa ville la plus peuplée", ""},
+		{"too short", "Привет", ""},
+		{"mixed", "Moscow Москва Berlin Берлин Paris Париж London Лондон Rome Рим", ""},
+		{"latin page quoting cyrillic", strings.Repeat("English text ", 10) + "Москва", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectScriptLanguage(tt.text))
+		})
+	}
+}
+
+func TestPageLanguage(t *testing.T) {
+	russian := "Москва — столица России, крупнейший по численности населения город страны"
+	assert.Equal(t, "de", PageLanguage("de-AT", russian), "the declared language wins")
+	assert.Equal(t, "ru", PageLanguage("", russian), "the script is the fallback")
+	assert.Equal(t, "ru", PageLanguage("und-x-none", russian))
+	assert.Empty(t, PageLanguage("", "Hello world, this page declares nothing at all"))
+}
+
+func TestShouldOffer(t *testing.T) {
+	tests := []struct {
+		name      string
+		page      string
+		preferred string
+		ignored   []string
+		want      bool
+	}{
+		{name: "different languages", page: "fr", preferred: "en", want: true},
+		{name: "same language", page: "en", preferred: "en"},
+		{name: "same language other region", page: "en-GB", preferred: "en_US.UTF-8"},
+		{name: "unknown page language", page: "", preferred: "en"},
+		{name: "unknown preferred language", page: "fr", preferred: ""},
+		{name: "ignored language", page: "fr-CA", preferred: "en", ignored: []string{"de", "FR"}},
+		{name: "other ignored language", page: "es", preferred: "en", ignored: []string{"fr"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ShouldOffer(tt.page, tt.preferred, tt.ignored))
+		})
+	}
+}
+
+func TestBuildURL(t *testing.T) {
+	got, err := BuildURL(DefaultURLTemplate, "https://example.fr/actu?id=1&p=2", "fr", "en-US")
+	require.NoError(t, err)
+	assert.Equal(t,
+		"https://translate.google.com/translate?sl=fr&tl=en&u=https%3A%2F%2Fexample.fr%2Factu%3Fid%3D1%26p%3D2", got)
+
+	got, err = BuildURL("https://translate.example/{to}?q={url}", "http://example.org/", "", "de")
+	require.NoError(t, err)
+	assert.Equal(t, "https://translate.example/de?q=http%3A%2F%2Fexample.org%2F", got)
+
+	got, err = BuildURL(DefaultURLTemplate, "https://example.org/", "", "de")
+	require.NoError(t, err)
+	assert.Contains(t, got, "sl=auto", "an unknown page language lets the service detect it")
+}
+
+func TestBuildURL_Errors(t *testing.T) {
+	_, err := BuildURL("https://translate.example/?lang={to}", "https://example.org/", "fr", "en")
+	assert.ErrorContains(t, err, "{url}")
+
+	_, err = BuildURL(DefaultURLTemplate, "dumb://home", "fr", "en")
+	assert.ErrorContains(t, err, "not a web page")
+
+	_, err = BuildURL(DefaultURLTemplate, "https://example.org/", "fr", "")
+	assert.ErrorContains(t, err, "no language")
+}
+
+func TestValidateURLTemplate(t *testing.T) {
+	require.NoError(t, ValidateURLTemplate(DefaultURLTemplate))
+	require.NoError(t, ValidateURLTemplate("http://localhost:5000/?u={url}&to={to}"))
+	assert.Error(t, ValidateURLTemplate(""))
+	assert.Error(t, ValidateURLTemplate("https://translate.example/"))
+	assert.Error(t, ValidateURLTemplate("file:///tmp/{url}"))
+	assert.Error(t, ValidateURLTemplate("{url}"))
+}
+
+func TestLanguageName(t *testing.T) {
+	assert.Equal(t, "French", LanguageName("fr"))
+	assert.Equal(t, "Portuguese", LanguageName("pt-BR"))
+	assert.Equal(t, "haw", LanguageName("haw"))
+}
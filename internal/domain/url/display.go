@@ -0,0 +1,37 @@
+package url
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DisplayLinkURL turns a hovered link target into the text shown in the
+// link status overlay. Surrounding whitespace and embedded control characters
+// are dropped, and percent-escapes are decoded when the result is printable
+// UTF-8 so "caf%C3%A9" reads as "café". An empty result means nothing should
+// be shown.
+func DisplayLinkURL(raw string) string {
+	cleaned := strings.TrimSpace(stripControlChars(raw))
+	if cleaned == "" {
+		return ""
+	}
+	decoded, err := url.PathUnescape(cleaned)
+	if err != nil || !utf8.ValidString(decoded) || strings.IndexFunc(decoded, unicode.IsControl) >= 0 {
+		return cleaned
+	}
+	return decoded
+}
+
+func stripControlChars(s string) string {
+	if strings.IndexFunc(s, unicode.IsControl) < 0 {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,31 @@
+package url
+
+import "testing"
+
+func TestDisplayLinkURL(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "empty", raw: "", want: ""},
+		{name: "whitespace only", raw: "  \n\t", want: ""},
+		{name: "plain url unchanged", raw: "https://example.com/a?b=c", want: "https://example.com/a?b=c"},
+		{name: "trims whitespace", raw: "  https://example.com/  ", want: "https://example.com/"},
+		{name: "strips embedded newlines", raw: "https://exa\nmple.com/", want: "https://example.com/"},
+		{name: "decodes utf8 escapes", raw: "https://example.com/caf%C3%A9", want: "https://example.com/café"},
+		{name: "decodes spaces", raw: "https://example.com/a%20b", want: "https://example.com/a b"},
+		{name: "keeps invalid escapes", raw: "https://example.com/100%", want: "https://example.com/100%"},
+		{name: "keeps escapes decoding to invalid utf8", raw: "https://example.com/%FF", want: "https://example.com/%FF"},
+		{name: "keeps escapes decoding to control chars", raw: "https://example.com/a%0Ab", want: "https://example.com/a%0Ab"},
+		{name: "non-http schemes pass through", raw: "mailto:someone@example.com", want: "mailto:someone@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisplayLinkURL(tt.raw); got != tt.want {
+				t.Errorf("DisplayLinkURL(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
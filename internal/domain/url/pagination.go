@@ -0,0 +1,130 @@
+package url
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// pageQueryKey is the query parameter treated as a page number.
+const pageQueryKey = "page"
+
+// StepPage moves the page number in raw by delta and returns the new URL.
+// A numeric page= query parameter wins over the path; otherwise the trailing
+// number of the last path segment is used, so "/page/3", "/chapter-12" and
+// "/12.html" all qualify. Zero-padded numbers keep their width. It returns
+// false when raw has no page number or the result would be negative.
+func StepPage(raw string, delta int) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Opaque != "" || (u.Host == "" && u.Path == "") {
+		return "", false
+	}
+
+	if query, ok := stepPageQuery(u.RawQuery, delta); ok {
+		u.RawQuery = query
+		return u.String(), true
+	}
+
+	path, ok := stepPagePath(u.EscapedPath(), delta)
+	if !ok {
+		return "", false
+	}
+	decoded, err := url.PathUnescape(path)
+	if err != nil {
+		return "", false
+	}
+	u.Path = decoded
+	u.RawPath = path
+	return u.String(), true
+}
+
+// stepPageQuery steps the first numeric page= parameter, leaving the order and
+// encoding of the other parameters untouched.
+func stepPageQuery(rawQuery string, delta int) (string, bool) {
+	if rawQuery == "" {
+		return "", false
+	}
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		key, value, found := strings.Cut(param, "=")
+		if !found || !strings.EqualFold(key, pageQueryKey) {
+			continue
+		}
+		stepped, ok := stepNumber(value, delta)
+		if !ok {
+			continue
+		}
+		params[i] = key + "=" + stepped
+		return strings.Join(params, "&"), true
+	}
+	return "", false
+}
+
+// stepPagePath steps the trailing number of the last path segment. A trailing
+// slash and a file extension after the number are kept.
+func stepPagePath(path string, delta int) (string, bool) {
+	trimmed := strings.TrimRight(path, "/")
+	segment := trimmed[strings.LastIndex(trimmed, "/")+1:]
+	stem := segment
+	if dot := strings.LastIndex(segment, "."); dot > 0 && isLetters(segment[dot+1:]) {
+		stem = segment[:dot]
+	}
+
+	start := len(stem)
+	for start > 0 && isDigit(stem[start-1]) {
+		start--
+	}
+	if start == len(stem) {
+		return "", false
+	}
+	stepped, ok := stepNumber(stem[start:], delta)
+	if !ok {
+		return "", false
+	}
+
+	numberEnd := len(trimmed) - len(segment) + len(stem)
+	numberStart := numberEnd - (len(stem) - start)
+	return path[:numberStart] + stepped + path[numberEnd:], true
+}
+
+// stepNumber adds delta to a run of ASCII digits, keeping zero padding.
+func stepNumber(digits string, delta int) (string, bool) {
+	if digits == "" {
+		return "", false
+	}
+	for i := 0; i < len(digits); i++ {
+		if !isDigit(digits[i]) {
+			return "", false
+		}
+	}
+	n, err := strconv.ParseInt(digits, 10, 63)
+	if err != nil {
+		return "", false
+	}
+	next := n + int64(delta)
+	if next < 0 {
+		return "", false
+	}
+	if len(digits) > 1 && digits[0] == '0' {
+		return fmt.Sprintf("%0*d", len(digits), next), true
+	}
+	return strconv.FormatInt(next, 10), true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetters(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i] | 0x20
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package url
+
+import "testing"
+
+func TestStepPage(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		delta  int
+		want   string
+		wantOK bool
+	}{
+		{name: "page query next", raw: "https://example.com/list?page=3", delta: 1, want: "https://example.com/list?page=4", wantOK: true},
+		{name: "page query prev", raw: "https://example.com/list?page=3", delta: -1, want: "https://example.com/list?page=2", wantOK: true},
+		{name: "page query keeps other params in order", raw: "https://example.com/s?q=a+b&page=9&sort=new", delta: 1, want: "https://example.com/s?q=a+b&page=10&sort=new", wantOK: true},
+		{name: "page query case insensitive key", raw: "https://example.com/?Page=2", delta: 1, want: "https://example.com/?Page=3", wantOK: true},
+		{name: "page query wins over path", raw: "https://example.com/topic/7?page=2", delta: 1, want: "https://example.com/topic/7?page=3", wantOK: true},
+		{name: "non numeric page query falls back to path", raw: "https://example.com/page/3?page=last", delta: 1, want: "https://example.com/page/4?page=last", wantOK: true},
+		{name: "path segment next", raw: "https://example.com/blog/page/3", delta: 1, want: "https://example.com/blog/page/4", wantOK: true},
+		{name: "path segment prev", raw: "https://example.com/blog/page/3", delta: -1, want: "https://example.com/blog/page/2", wantOK: true},
+		{name: "path keeps trailing slash", raw: "https://example.com/page/9/", delta: 1, want: "https://example.com/page/10/", wantOK: true},
+		{name: "path trailing number in segment", raw: "https://example.com/story/chapter-12", delta: 1, want: "https://example.com/story/chapter-13", wantOK: true},
+		{name: "path keeps extension", raw: "https://example.com/comic/41.html", delta: 1, want: "https://example.com/comic/42.html", wantOK: true},
+		{name: "path keeps zero padding", raw: "https://example.com/img/007", delta: 1, want: "https://example.com/img/008", wantOK: true},
+		{name: "path keeps query and fragment", raw: "https://example.com/p/5?ref=x#top", delta: -1, want: "https://example.com/p/4?ref=x#top", wantOK: true},
+		{name: "path keeps escaped characters", raw: "https://example.com/caf%C3%A9/2", delta: 1, want: "https://example.com/caf%C3%A9/3", wantOK: true},
+		{name: "prev below zero", raw: "https://example.com/page/0", delta: -1, wantOK: false},
+		{name: "no number", raw: "https://example.com/about", delta: 1, wantOK: false},
+		{name: "number not trailing", raw: "https://example.com/2024/about", delta: 1, wantOK: false},
+		{name: "host only", raw: "https://example.com", delta: 1, wantOK: false},
+		{name: "empty", raw: "", delta: 1, wantOK: false},
+		{name: "opaque url", raw: "mailto:user1@example.com", delta: 1, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := StepPage(tt.raw, tt.delta)
+			if ok != tt.wantOK {
+				t.Fatalf("StepPage(%q, %d) ok = %v, want %v (got %q)", tt.raw, tt.delta, ok, tt.wantOK, got)
+			}
+			if got != tt.want {
+				t.Errorf("StepPage(%q, %d) = %q, want %q", tt.raw, tt.delta, got, tt.want)
+			}
+		})
+	}
+}
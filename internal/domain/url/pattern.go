@@ -0,0 +1,78 @@
+package url
+
+import (
+	"path"
+	"strings"
+)
+
+// MatchDomainPattern reports whether host matches a domain glob pattern.
+//
+// Patterns are matched case-insensitively against the normalized host (see
+// DisplayDomain), so "example.com" also matches "www.example.com". Ports are
+// ignored unless the pattern names one ("localhost:3000").
+// Supported forms:
+//   - "example.com" matches that host only
+//   - "*.example.com" matches example.com and any of its subdomains
+//   - any other pattern is matched with path.Match semantics ("dev-*.local")
+//   - "*" matches every host
+func MatchDomainPattern(pattern, host string) bool {
+	pattern = strings.TrimPrefix(strings.TrimSpace(strings.ToLower(pattern)), "www.")
+	if strings.Contains(pattern, ":") {
+		host = CanonicalDomain(host)
+	} else {
+		host = DisplayDomain(host)
+	}
+	if pattern == "" || host == "" {
+		return false
+	}
+	if pattern == "*" || pattern == host {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(pattern, "*."); ok && !strings.ContainsAny(suffix, "*?[") {
+		return host == suffix || strings.HasSuffix(host, "."+suffix)
+	}
+	matched, err := path.Match(pattern, host)
+	return err == nil && matched
+}
+
+// MatchAnyDomainPattern reports whether rawURL's host matches any of patterns.
+// rawURL may be a full URL or a bare host.
+func MatchAnyDomainPattern(patterns []string, rawURL string) bool {
+	for _, pattern := range patterns {
+		if MatchDomainPattern(pattern, rawURL) {
+			return true
+		}
+	}
+	return false
+}
+
+// BestDomainPatternMatch returns the most specific pattern that matches rawURL.
+// Specificity is the pattern length without wildcards, so "*.mail.example.com"
+// wins over "*.example.com" and an exact host wins over both.
+// The boolean is false when no pattern matches.
+func BestDomainPatternMatch(patterns []string, rawURL string) (string, bool) {
+	best := ""
+	bestScore := -1
+	for _, pattern := range patterns {
+		if !MatchDomainPattern(pattern, rawURL) {
+			continue
+		}
+		score := domainPatternSpecificity(pattern)
+		if score > bestScore || (score == bestScore && pattern < best) {
+			best = pattern
+			bestScore = score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+func domainPatternSpecificity(pattern string) int {
+	literal := strings.NewReplacer("*", "", "?", "", "[", "", "]", "").Replace(pattern)
+	literal = strings.TrimPrefix(literal, ".")
+	score := len(literal) * 2
+	if !strings.ContainsAny(pattern, "*?[") {
+		// Exact hosts beat globs of the same literal length.
+		score++
+	}
+	return score
+}
@@ -0,0 +1,60 @@
+package url
+
+import "testing"
+
+func TestMatchDomainPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		host    string
+		want    bool
+	}{
+		{pattern: "example.com", host: "https://example.com/path", want: true},
+		{pattern: "example.com", host: "https://www.example.com", want: true},
+		{pattern: "Example.COM", host: "example.com", want: true},
+		{pattern: "example.com", host: "https://sub.example.com", want: false},
+		{pattern: "*.example.com", host: "https://example.com", want: true},
+		{pattern: "*.example.com", host: "https://a.b.example.com", want: true},
+		{pattern: "*.example.com", host: "https://badexample.com", want: false},
+		{pattern: "dev-*.local", host: "http://dev-api.local:8080", want: true},
+		{pattern: "localhost:3000", host: "http://localhost:3000/", want: true},
+		{pattern: "localhost:3000", host: "http://localhost:4000/", want: false},
+		{pattern: "*", host: "https://anything.test", want: true},
+		{pattern: "", host: "https://example.com", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"|"+tt.host, func(t *testing.T) {
+			if got := MatchDomainPattern(tt.pattern, tt.host); got != tt.want {
+				t.Fatalf("MatchDomainPattern(%q, %q) = %v, want %v", tt.pattern, tt.host, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBestDomainPatternMatch(t *testing.T) {
+	patterns := []string{"*", "*.example.com", "mail.example.com", "*.mail.example.com"}
+
+	tests := []struct {
+		rawURL string
+		want   string
+		wantOK bool
+	}{
+		{rawURL: "https://mail.example.com", want: "mail.example.com", wantOK: true},
+		{rawURL: "https://inbox.mail.example.com", want: "*.mail.example.com", wantOK: true},
+		{rawURL: "https://docs.example.com", want: "*.example.com", wantOK: true},
+		{rawURL: "https://other.test", want: "*", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rawURL, func(t *testing.T) {
+			got, ok := BestDomainPatternMatch(patterns, tt.rawURL)
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("BestDomainPatternMatch(%q) = (%q, %v), want (%q, %v)", tt.rawURL, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	if _, ok := BestDomainPatternMatch([]string{"example.com"}, "https://other.test"); ok {
+		t.Fatal("expected no match")
+	}
+}
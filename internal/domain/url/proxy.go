@@ -0,0 +1,68 @@
+package url
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// proxySchemes lists the proxy URL schemes the network stack understands.
+var proxySchemes = map[string]bool{
+	"http":    true,
+	"https":   true,
+	"socks":   true,
+	"socks4":  true,
+	"socks4a": true,
+	"socks5":  true,
+	"socks5h": true,
+}
+
+// ParseProxyURL parses a proxy URL such as "http://proxy.lan:3128" or
+// "socks5://127.0.0.1:1080". The URL must name a supported scheme and a host,
+// and must not carry a path, query or fragment.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, fmt.Errorf("proxy URL is empty")
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", raw, err)
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if !proxySchemes[scheme] {
+		return nil, fmt.Errorf("unsupported proxy scheme %q (use http, https, socks, socks4, socks4a, socks5 or socks5h)", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("proxy URL %q has no host", raw)
+	}
+	if port := u.Port(); port == "" && strings.HasSuffix(u.Host, ":") {
+		return nil, fmt.Errorf("proxy URL %q has an empty port", raw)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return nil, fmt.Errorf("proxy URL %q must not have a path, query or fragment", raw)
+	}
+	u.Scheme = scheme
+	u.Path = ""
+	return u, nil
+}
+
+// ValidateNoProxyEntry checks one entry of a no-proxy list. Entries are host
+// names ("localhost", "*.lan", ".example.com"), IP addresses or CIDR ranges
+// ("10.0.0.0/8"), optionally followed by a port.
+func ValidateNoProxyEntry(entry string) error {
+	entry = strings.TrimSpace(entry)
+	if entry == "" {
+		return fmt.Errorf("no-proxy entry is empty")
+	}
+	if strings.Contains(entry, "://") || strings.ContainsAny(entry, " \t") {
+		return fmt.Errorf("no-proxy entry %q must be a host, IP address or CIDR range", entry)
+	}
+	if strings.Contains(entry, "/") {
+		if _, _, err := net.ParseCIDR(entry); err != nil {
+			return fmt.Errorf("no-proxy entry %q is not a valid CIDR range", entry)
+		}
+	}
+	return nil
+}
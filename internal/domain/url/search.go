@@ -1,6 +1,9 @@
 package url
 
-import "strings"
+import (
+	"net/url"
+	"strings"
+)
 
 // ParseBangShortcut extracts a bang shortcut from input.
 // Input must start with "!" followed by shortcut key and a space.
@@ -70,3 +73,30 @@ func BuildSearchURL(input string, shortcutURLs map[string]string, defaultSearch
 
 	return input
 }
+
+// MaxSelectionSearchLen caps, in runes, how much selected text
+// SelectionSearchURL searches for.
+const MaxSelectionSearchLen = 256
+
+// SelectionSearchURL builds the URL searching selected page text with the
+// defaultSearch URL template. Runs of whitespace, such as line breaks in a
+// selection spanning paragraphs, become single spaces, and the text is cut at
+// MaxSelectionSearchLen runes before it is query-escaped. Unlike
+// BuildSearchURL, the text is always searched, even when it looks like a URL
+// or starts with a bang.
+//
+// Returns "" when the selection is blank or defaultSearch has no %s
+// placeholder.
+func SelectionSearchURL(selection, defaultSearch string) string {
+	if !strings.Contains(defaultSearch, "%s") {
+		return ""
+	}
+	query := strings.Join(strings.Fields(selection), " ")
+	if query == "" {
+		return ""
+	}
+	if runes := []rune(query); len(runes) > MaxSelectionSearchLen {
+		query = strings.TrimSpace(string(runes[:MaxSelectionSearchLen]))
+	}
+	return strings.Replace(defaultSearch, "%s", url.QueryEscape(query), 1)
+}
@@ -1,6 +1,9 @@
 package url
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 // Test shortcuts for BuildSearchURL tests
 var testShortcuts = map[string]string{
@@ -274,3 +277,70 @@ func TestParseBangShortcut(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectionSearchURL(t *testing.T) {
+	long := strings.Repeat("a", MaxSelectionSearchLen+10)
+	tests := []struct {
+		name          string
+		selection     string
+		defaultSearch string
+		want          string
+	}{
+		{
+			name:          "words are query-escaped",
+			selection:     "go generics & interfaces",
+			defaultSearch: testDefaultSearch,
+			want:          "https://duckduckgo.com/?q=go+generics+%26+interfaces",
+		},
+		{
+			name:          "whitespace runs collapse to one space",
+			selection:     "  first line\n\n\tsecond  line ",
+			defaultSearch: testDefaultSearch,
+			want:          "https://duckduckgo.com/?q=first+line+second+line",
+		},
+		{
+			name:          "url-like selection is searched, not opened",
+			selection:     "example.com",
+			defaultSearch: testDefaultSearch,
+			want:          "https://duckduckgo.com/?q=example.com",
+		},
+		{
+			name:          "bang is searched literally",
+			selection:     "!g golang",
+			defaultSearch: testDefaultSearch,
+			want:          "https://duckduckgo.com/?q=%21g+golang",
+		},
+		{
+			name:          "non-ascii text is escaped",
+			selection:     "café crème",
+			defaultSearch: "https://www.google.com/search?q=%s&hl=fr",
+			want:          "https://www.google.com/search?q=caf%C3%A9+cr%C3%A8me&hl=fr",
+		},
+		{
+			name:          "long selection is cut",
+			selection:     long,
+			defaultSearch: testDefaultSearch,
+			want:          "https://duckduckgo.com/?q=" + long[:MaxSelectionSearchLen],
+		},
+		{
+			name:          "blank selection",
+			selection:     " \n\t ",
+			defaultSearch: testDefaultSearch,
+			want:          "",
+		},
+		{
+			name:          "template without placeholder",
+			selection:     "golang",
+			defaultSearch: "https://duckduckgo.com/",
+			want:          "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SelectionSearchURL(tt.selection, tt.defaultSearch); got != tt.want {
+				t.Errorf("SelectionSearchURL(%q, %q) = %q, want %q", tt.selection, tt.defaultSearch, got, tt.want)
+			}
+		})
+	}
+}
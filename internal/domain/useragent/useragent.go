@@ -0,0 +1,37 @@
+// Package useragent holds the user agent presets behind the "request
+// desktop/mobile site" toggles.
+package useragent
+
+import "github.com/bnema/dumber/internal/domain/entity"
+
+// The presets impersonate Safari so sites serve markup meant for a WebKit
+// engine.
+const (
+	desktopUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) " +
+		"AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.0 Safari/605.1.15"
+	mobileUserAgent = "Mozilla/5.0 (iPhone; CPU iPhone OS 18_0 like Mac OS X) " +
+		"AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.0 Mobile/15E148 Safari/604.1"
+)
+
+// ForPreset returns the user agent string of preset. The default preset,
+// and any unknown one, return "" so the engine keeps its own user agent.
+func ForPreset(preset entity.UserAgentPreset) string {
+	switch preset {
+	case entity.UserAgentPresetDesktop:
+		return desktopUserAgent
+	case entity.UserAgentPresetMobile:
+		return mobileUserAgent
+	default:
+		return ""
+	}
+}
+
+// Toggle returns the preset in effect after requesting requested while
+// current applies: requesting the preset already in effect returns to the
+// default user agent.
+func Toggle(current, requested entity.UserAgentPreset) entity.UserAgentPreset {
+	if current == requested {
+		return entity.UserAgentPresetDefault
+	}
+	return requested
+}
@@ -0,0 +1,43 @@
+package useragent
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestForPreset(t *testing.T) {
+	desktop := ForPreset(entity.UserAgentPresetDesktop)
+	mobile := ForPreset(entity.UserAgentPresetMobile)
+
+	assert.NotEmpty(t, desktop)
+	assert.NotContains(t, desktop, "Mobile")
+	assert.Contains(t, mobile, "Mobile")
+	assert.NotEqual(t, desktop, mobile)
+	for _, ua := range []string{desktop, mobile} {
+		assert.True(t, strings.HasPrefix(ua, "Mozilla/5.0 ("), ua)
+		assert.Contains(t, ua, "AppleWebKit/")
+	}
+
+	assert.Empty(t, ForPreset(entity.UserAgentPresetDefault))
+	assert.Empty(t, ForPreset("tablet"))
+}
+
+func TestToggle(t *testing.T) {
+	tests := []struct {
+		current, requested, want entity.UserAgentPreset
+	}{
+		{entity.UserAgentPresetDefault, entity.UserAgentPresetMobile, entity.UserAgentPresetMobile},
+		{entity.UserAgentPresetDefault, entity.UserAgentPresetDesktop, entity.UserAgentPresetDesktop},
+		{entity.UserAgentPresetMobile, entity.UserAgentPresetMobile, entity.UserAgentPresetDefault},
+		{entity.UserAgentPresetDesktop, entity.UserAgentPresetDesktop, entity.UserAgentPresetDefault},
+		{entity.UserAgentPresetMobile, entity.UserAgentPresetDesktop, entity.UserAgentPresetDesktop},
+		{entity.UserAgentPresetDesktop, entity.UserAgentPresetMobile, entity.UserAgentPresetMobile},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Toggle(tt.current, tt.requested), "%q then %q", tt.current, tt.requested)
+	}
+}
@@ -0,0 +1,66 @@
+package userscript
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// PageEnvGlobal is the window property page_env values are exposed as.
+const PageEnvGlobal = "__dumber_env"
+
+// PageEnvFor returns the page_env values rawURL may see: the union of the
+// keys of every rule whose domain matches, limited to keys present in
+// cfg.Values. It returns nil when nothing applies, including for any page
+// that is not http or https.
+func PageEnvFor(cfg entity.RuntimePageEnvConfig, rawURL string) map[string]string {
+	if len(cfg.Values) == 0 || len(cfg.Domains) == 0 {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+	if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+		return nil
+	}
+
+	var env map[string]string
+	for _, rule := range cfg.Domains {
+		if !urlutil.MatchDomainPattern(rule.Domain, u.Hostname()) {
+			continue
+		}
+		for _, key := range rule.Keys {
+			value, ok := cfg.Values[key]
+			if !ok {
+				continue
+			}
+			if env == nil {
+				env = make(map[string]string, len(rule.Keys))
+			}
+			env[key] = value
+		}
+	}
+	return env
+}
+
+// PageEnvScript returns the JavaScript that defines window.__dumber_env as a
+// frozen object holding env. The property is neither writable nor
+// configurable, so page scripts can read the values but not replace them.
+func PageEnvScript(env map[string]string) string {
+	// json.Marshal sorts map keys and escapes <, > and &, so the literal is
+	// stable and safe to embed.
+	literal, _ := json.Marshal(env)
+	return `(function() {
+	if (Object.prototype.hasOwnProperty.call(window, '` + PageEnvGlobal + `')) return;
+	Object.defineProperty(window, '` + PageEnvGlobal + `', {
+		value: Object.freeze(` + string(literal) + `),
+		writable: false,
+		configurable: false,
+		enumerable: false
+	});
+})();`
+}
@@ -0,0 +1,75 @@
+package userscript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func pageEnvConfig() entity.RuntimePageEnvConfig {
+	return entity.RuntimePageEnvConfig{
+		Values: map[string]string{
+			"api_base": "https://api.tools.internal",
+			"team":     "infra",
+			"token":    "s3cret",
+		},
+		Domains: []entity.PageEnvRule{
+			{Domain: "tools.internal", Keys: []string{"api_base", "token"}},
+			{Domain: "*.corp.example", Keys: []string{"team"}},
+			{Domain: "wiki.corp.example", Keys: []string{"api_base", "missing"}},
+		},
+	}
+}
+
+func TestPageEnvFor_ScopesValuesToMatchingDomains(t *testing.T) {
+	cfg := pageEnvConfig()
+
+	tests := []struct {
+		url  string
+		want map[string]string
+	}{
+		{"https://tools.internal/dashboard", map[string]string{"api_base": "https://api.tools.internal", "token": "s3cret"}},
+		{"https://corp.example/", map[string]string{"team": "infra"}},
+		{"https://wiki.corp.example/page", map[string]string{"team": "infra", "api_base": "https://api.tools.internal"}},
+		{"https://example.com/", nil},
+		{"https://tools.internal.evil.test/", nil},
+		{"https://evil-tools.internal/", nil},
+		{"dumb://homepage", nil},
+		{"file:///tmp/tools.internal.html", nil},
+		{"not a url", nil},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, PageEnvFor(cfg, tt.url), tt.url)
+	}
+}
+
+func TestPageEnvFor_OnlyInjectsAllowListedKeys(t *testing.T) {
+	cfg := pageEnvConfig()
+
+	env := PageEnvFor(cfg, "https://corp.example/")
+	assert.NotContains(t, env, "token", "values not listed for the domain stay out")
+	assert.NotContains(t, env, "api_base")
+
+	env = PageEnvFor(cfg, "https://wiki.corp.example/")
+	assert.NotContains(t, env, "missing", "keys without a value are dropped")
+
+	cfg.Domains = []entity.PageEnvRule{{Domain: "tools.internal"}}
+	assert.Nil(t, PageEnvFor(cfg, "https://tools.internal/"), "a rule without keys exposes nothing")
+
+	cfg.Values = nil
+	cfg.Domains = []entity.PageEnvRule{{Domain: "*", Keys: []string{"team"}}}
+	assert.Nil(t, PageEnvFor(cfg, "https://tools.internal/"))
+}
+
+func TestPageEnvScript(t *testing.T) {
+	script := PageEnvScript(map[string]string{"team": `"infra" </script>`, "api_base": "x"})
+
+	assert.Contains(t, script, "Object.defineProperty(window, '__dumber_env'")
+	assert.Contains(t, script, "Object.freeze(")
+	assert.Contains(t, script, "writable: false")
+	assert.Contains(t, script, "configurable: false")
+	assert.Contains(t, script, `{"api_base":"x","team":"\"infra\" \u003c/script\u003e"}`,
+		"values must be an escaped JSON literal with sorted keys")
+}
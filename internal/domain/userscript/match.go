@@ -0,0 +1,162 @@
+package userscript
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+const allURLsPattern = "<all_urls>"
+
+// allURLsSchemes are the schemes covered by <all_urls> and by a "*" scheme
+// (the latter only for http and https, as in browser extensions).
+var allURLsSchemes = map[string]bool{"http": true, "https": true, "file": true, "ftp": true}
+
+// MatchPattern reports whether rawURL matches a @match or @include pattern.
+//
+// Patterns of the form "scheme://host/path" use browser extension match
+// semantics:
+//   - scheme "*" matches http and https
+//   - host "*" matches any host, "*.example.com" matches example.com and its
+//     subdomains; ports are ignored
+//   - path is a glob where "*" matches any run of characters, matched against
+//     the path plus query string
+//
+// "<all_urls>" matches any http, https, file or ftp URL. Anything else is
+// treated as a Greasemonkey @include glob over the whole URL.
+func MatchPattern(pattern, rawURL string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" || rawURL == "" {
+		return false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	if pattern == allURLsPattern {
+		return allURLsSchemes[strings.ToLower(u.Scheme)]
+	}
+	if scheme, host, path, ok := splitMatchPattern(pattern); ok {
+		return matchScheme(scheme, u.Scheme) && matchHost(host, u.Hostname()) && globMatch(path, requestPath(u))
+	}
+	return globMatch(pattern, rawURL)
+}
+
+// AppliesTo reports whether script should run on rawURL: at least one of its
+// match patterns matches and none of its exclude patterns do. Only http,
+// https, file and ftp pages are eligible, so internal dumb:// pages never
+// run user scripts.
+func AppliesTo(script entity.UserScript, rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || !allURLsSchemes[strings.ToLower(u.Scheme)] {
+		return false
+	}
+	matched := false
+	for _, pattern := range script.Matches {
+		if MatchPattern(pattern, rawURL) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+	for _, pattern := range script.Excludes {
+		if MatchPattern(pattern, rawURL) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matching returns the scripts that apply to rawURL, preserving order.
+func Matching(scripts []entity.UserScript, rawURL string) []entity.UserScript {
+	var out []entity.UserScript
+	for _, script := range scripts {
+		if AppliesTo(script, rawURL) {
+			out = append(out, script)
+		}
+	}
+	return out
+}
+
+// splitMatchPattern splits a browser-extension style match pattern. ok is
+// false when pattern does not follow that syntax, in which case callers fall
+// back to glob matching.
+func splitMatchPattern(pattern string) (scheme, host, path string, ok bool) {
+	scheme, rest, found := strings.Cut(pattern, "://")
+	if !found {
+		return "", "", "", false
+	}
+	scheme = strings.ToLower(scheme)
+	if scheme != "*" && !allURLsSchemes[scheme] {
+		return "", "", "", false
+	}
+	host, path, found = strings.Cut(rest, "/")
+	if !found {
+		return "", "", "", false
+	}
+	if host == "" && scheme != "file" {
+		return "", "", "", false
+	}
+	if strings.Contains(host, "*") && host != "*" && (!strings.HasPrefix(host, "*.") || strings.Contains(host[2:], "*")) {
+		return "", "", "", false
+	}
+	return scheme, strings.ToLower(host), "/" + path, true
+}
+
+func matchScheme(pattern, scheme string) bool {
+	scheme = strings.ToLower(scheme)
+	if pattern == "*" {
+		return scheme == "http" || scheme == "https"
+	}
+	return pattern == scheme
+}
+
+func matchHost(pattern, host string) bool {
+	host = strings.ToLower(host)
+	if pattern == "*" {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+		return host == suffix || strings.HasSuffix(host, "."+suffix)
+	}
+	if h, _, found := strings.Cut(pattern, ":"); found {
+		pattern = h
+	}
+	return pattern == host
+}
+
+func requestPath(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return path
+}
+
+// globMatch reports whether s matches pattern, where "*" matches any run of
+// characters (including none) and every other character matches itself.
+func globMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(s, part)
+		if idx < 0 {
+			return false
+		}
+		s = s[idx+len(part):]
+	}
+	return len(s) >= len(last) && strings.HasSuffix(s, last)
+}
@@ -0,0 +1,77 @@
+package userscript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		url     string
+		want    bool
+	}{
+		{"<all_urls>", "https://example.com/", true},
+		{"<all_urls>", "file:///tmp/a.html", true},
+		{"<all_urls>", "dumb://homepage", false},
+		{"*://*/*", "http://example.com/a", true},
+		{"*://*/*", "https://example.com/", true},
+		{"*://*/*", "file:///tmp/a.html", false},
+		{"https://example.com/*", "https://example.com/", true},
+		{"https://example.com/*", "http://example.com/", false},
+		{"https://example.com/*", "https://www.example.com/", false},
+		{"https://example.com/*", "https://example.com:8443/x", true},
+		{"https://*.example.com/*", "https://example.com/", true},
+		{"https://*.example.com/*", "https://a.b.example.com/x", true},
+		{"https://*.example.com/*", "https://notexample.com/", false},
+		{"https://EXAMPLE.com/*", "https://example.com/", true},
+		{"https://example.com/docs/*", "https://example.com/docs/intro", true},
+		{"https://example.com/docs/*", "https://example.com/blog", false},
+		{"https://example.com/*/edit", "https://example.com/page/42/edit", true},
+		{"https://example.com/search*", "https://example.com/search?q=go", true},
+		{"https://example.com/", "https://example.com", true},
+		{"file:///home/*", "file:///home/user/a.html", true},
+		// @include globs
+		{"http*://example.com/*", "https://example.com/a", true},
+		{"http*://example.com/*", "ftp://example.com/a", false},
+		{"*example.org*", "https://www.example.org/path", true},
+		{"*example.org*", "https://example.com/", false},
+		{"https://example.com", "https://example.com", true},
+		{"", "https://example.com/", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchPattern(tt.pattern, tt.url))
+		})
+	}
+}
+
+func TestAppliesTo(t *testing.T) {
+	script := entity.UserScript{
+		Matches:  []string{"https://*.wikipedia.org/*", "https://example.com/*"},
+		Excludes: []string{"https://*.wikipedia.org/wiki/Special:*"},
+	}
+
+	assert.True(t, AppliesTo(script, "https://en.wikipedia.org/wiki/Go"))
+	assert.True(t, AppliesTo(script, "https://example.com/"))
+	assert.False(t, AppliesTo(script, "https://en.wikipedia.org/wiki/Special:Random"))
+	assert.False(t, AppliesTo(script, "https://example.org/"))
+	assert.False(t, AppliesTo(entity.UserScript{}, "https://example.com/"))
+
+	catchAll := entity.UserScript{Matches: []string{"*"}}
+	assert.True(t, AppliesTo(catchAll, "https://example.com/"))
+	assert.False(t, AppliesTo(catchAll, "dumb://homepage"), "internal pages never run user scripts")
+}
+
+func TestMatching(t *testing.T) {
+	a := entity.UserScript{Name: "a", Matches: []string{"*://*/*"}}
+	b := entity.UserScript{Name: "b", Matches: []string{"https://example.com/*"}}
+	c := entity.UserScript{Name: "c", Matches: []string{"*://*/*"}, Excludes: []string{"*://example.com/*"}}
+
+	got := Matching([]entity.UserScript{a, b, c}, "https://example.com/x")
+	assert.Equal(t, []entity.UserScript{a, b}, got)
+	assert.Empty(t, Matching([]entity.UserScript{b}, "https://other.com/"))
+}
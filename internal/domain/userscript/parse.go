@@ -0,0 +1,117 @@
+// Package userscript parses Greasemonkey-style user script metadata and
+// decides which scripts apply to a page.
+package userscript
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+const (
+	metadataStart = "// ==UserScript=="
+	metadataEnd   = "// ==/UserScript=="
+
+	// FileSuffix is the extension user script files must use.
+	FileSuffix = ".user.js"
+)
+
+var (
+	// ErrNoMetadata is returned when a script has no ==UserScript== block.
+	ErrNoMetadata = errors.New("missing ==UserScript== metadata block")
+	// ErrNoMatch is returned when a script declares no @match or @include,
+	// so it could never run.
+	ErrNoMatch = errors.New("no @match or @include pattern")
+)
+
+// Parse reads the metadata block of a user script and returns the script
+// ready for injection. path is only used for error messages and as the
+// fallback name when @name is missing.
+//
+// Recognized keys are @name, @match, @include, @exclude and @run-at; other
+// keys are ignored. "document-idle" is accepted as an alias of document-end.
+func Parse(path, source string) (entity.UserScript, error) {
+	script := entity.UserScript{
+		Path:   path,
+		RunAt:  entity.UserScriptRunAtDocumentEnd,
+		Source: source,
+	}
+
+	inBlock, closed := false, false
+	for _, line := range strings.Split(source, "\n") {
+		line = strings.TrimSpace(line)
+		if !inBlock {
+			if line == metadataStart {
+				inBlock = true
+			}
+			continue
+		}
+		if line == metadataEnd {
+			closed = true
+			break
+		}
+		key, value, ok := parseMetadataLine(line)
+		if !ok {
+			continue
+		}
+		switch key {
+		case "name":
+			script.Name = value
+		case "match", "include":
+			script.Matches = append(script.Matches, value)
+		case "exclude":
+			script.Excludes = append(script.Excludes, value)
+		case "run-at":
+			runAt, err := parseRunAt(value)
+			if err != nil {
+				return entity.UserScript{}, fmt.Errorf("%s: %w", path, err)
+			}
+			script.RunAt = runAt
+		}
+	}
+
+	if !closed {
+		return entity.UserScript{}, fmt.Errorf("%s: %w", path, ErrNoMetadata)
+	}
+	if len(script.Matches) == 0 {
+		return entity.UserScript{}, fmt.Errorf("%s: %w", path, ErrNoMatch)
+	}
+	if script.Name == "" {
+		script.Name = strings.TrimSuffix(filepath.Base(path), FileSuffix)
+	}
+	return script, nil
+}
+
+// parseMetadataLine splits "// @key value" into its key and value.
+func parseMetadataLine(line string) (key, value string, ok bool) {
+	rest, found := strings.CutPrefix(line, "//")
+	if !found {
+		return "", "", false
+	}
+	rest = strings.TrimSpace(rest)
+	rest, found = strings.CutPrefix(rest, "@")
+	if !found {
+		return "", "", false
+	}
+	key, value, _ = strings.Cut(rest, " ")
+	key = strings.ToLower(strings.TrimSpace(key))
+	value = strings.TrimSpace(value)
+	if key == "" || value == "" {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+func parseRunAt(value string) (entity.UserScriptRunAt, error) {
+	switch strings.ToLower(value) {
+	case string(entity.UserScriptRunAtDocumentStart):
+		return entity.UserScriptRunAtDocumentStart, nil
+	case string(entity.UserScriptRunAtDocumentEnd), "document-idle":
+		return entity.UserScriptRunAtDocumentEnd, nil
+	default:
+		return "", fmt.Errorf("unsupported @run-at %q", value)
+	}
+}
@@ -0,0 +1,85 @@
+package userscript
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestParse(t *testing.T) {
+	source := `// ==UserScript==
+// @name        Dark Wiki
+// @namespace   example
+// @match       https://*.wikipedia.org/*
+// @include     http*://example.com/*
+// @exclude     https://*.wikipedia.org/wiki/Special:*
+// @run-at      document-start
+// @grant       none
+// ==/UserScript==
+document.documentElement.classList.add('dark');
+`
+	script, err := Parse("/scripts/dark-wiki.user.js", source)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Dark Wiki", script.Name)
+	assert.Equal(t, "/scripts/dark-wiki.user.js", script.Path)
+	assert.Equal(t, []string{"https://*.wikipedia.org/*", "http*://example.com/*"}, script.Matches)
+	assert.Equal(t, []string{"https://*.wikipedia.org/wiki/Special:*"}, script.Excludes)
+	assert.Equal(t, entity.UserScriptRunAtDocumentStart, script.RunAt)
+	assert.Equal(t, source, script.Source)
+}
+
+func TestParse_RunAt(t *testing.T) {
+	tests := []struct {
+		runAt string
+		want  entity.UserScriptRunAt
+	}{
+		{runAt: "", want: entity.UserScriptRunAtDocumentEnd},
+		{runAt: "// @run-at document-start", want: entity.UserScriptRunAtDocumentStart},
+		{runAt: "// @run-at document-end", want: entity.UserScriptRunAtDocumentEnd},
+		{runAt: "// @run-at document-idle", want: entity.UserScriptRunAtDocumentEnd},
+		{runAt: "// @run-at Document-Start", want: entity.UserScriptRunAtDocumentStart},
+	}
+	for _, tt := range tests {
+		t.Run(tt.runAt, func(t *testing.T) {
+			source := "// ==UserScript==\n// @match *://*/*\n" + tt.runAt + "\n// ==/UserScript==\n"
+			script, err := Parse("a.user.js", source)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, script.RunAt)
+		})
+	}
+
+	_, err := Parse("a.user.js", "// ==UserScript==\n// @match *://*/*\n// @run-at context-menu\n// ==/UserScript==\n")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "context-menu")
+}
+
+func TestParse_NameFallsBackToFileName(t *testing.T) {
+	script, err := Parse("/scripts/tidy-news.user.js", "// ==UserScript==\n// @match *://*/*\n// ==/UserScript==\n")
+	require.NoError(t, err)
+	assert.Equal(t, "tidy-news", script.Name)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   error
+	}{
+		{name: "no block", source: "console.log('hi');", want: ErrNoMetadata},
+		{name: "unterminated block", source: "// ==UserScript==\n// @match *://*/*\n", want: ErrNoMetadata},
+		{name: "no match", source: "// ==UserScript==\n// @name x\n// ==/UserScript==\n", want: ErrNoMatch},
+		{name: "empty match", source: "// ==UserScript==\n// @match\n// ==/UserScript==\n", want: ErrNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("a.user.js", tt.source)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.want), "got %v", err)
+		})
+	}
+}
@@ -97,6 +97,10 @@ func (wv *WebView) applyAdaptiveWindowlessFrameRate() {
 	wv.lastAdaptiveFrameRate = fps
 	wv.mu.Unlock()
 
+	// A frozen view keeps its low rate; thawing applies the new one.
+	if wv.renderingFrozen.Load() {
+		return
+	}
 	host.SetWindowlessFrameRate(fps)
 	if wv.ctx != nil {
 		logging.FromContext(wv.ctx).Info().
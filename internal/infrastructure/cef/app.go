@@ -299,8 +299,10 @@ func (a *dumberApp) OnBeforeCommandLineProcessing(processType string, commandLin
 	log := logging.FromContext(a.engine.ctx)
 	if commandLine != nil {
 		configureCommandLineWithRenderStack(commandLine, a.engine.renderStackPlan)
+		configureSoftwareRendering(commandLine, a.engine.gpuDisabled())
 
 		if processType == "" {
+			configureProxyCommandLine(commandLine, a.engine.proxy)
 			if cefWebAuthnUnsafeEnabled() {
 				log.Warn().
 					Str("env_var", cefEnableWebAuthnUnsafeEnvVar).
@@ -367,6 +369,7 @@ func (h *dumberBPH) OnBeforeChildProcessLaunch(commandLine purecef.CommandLine)
 	renderNodeOverride := ""
 	if commandLine != nil {
 		configureCommandLineWithRenderStack(commandLine, h.engine.renderStackPlan)
+		configureSoftwareRendering(commandLine, h.engine.gpuDisabled())
 		appendSwitchIfMissing(commandLine, "no-zygote")
 		processType = commandLine.GetSwitchValue("type")
 		commandLineString = commandLine.GetCommandLineString()
@@ -444,6 +444,46 @@ func TestDumberBPH_OnBeforeChildProcessLaunch_AppendsNoZygote(t *testing.T) {
 	}
 }
 
+func TestDumberBPH_OnBeforeChildProcessLaunch_DisablesGPUWhenEngineRunsWithoutGPU(t *testing.T) {
+	commandLine := newMutableCommandLineStub()
+	commandLine.AppendSwitchWithValue("type", "gpu-process")
+
+	(&dumberBPH{engine: &Engine{ctx: context.Background(), disableGPU: true}}).OnBeforeChildProcessLaunch(commandLine)
+
+	if !commandLine.HasSwitch("disable-gpu") {
+		t.Fatal("expected disable-gpu switch")
+	}
+	if !commandLine.HasSwitch("disable-gpu-compositing") {
+		t.Fatal("expected disable-gpu-compositing switch")
+	}
+}
+
+func TestDumberBPH_OnBeforeChildProcessLaunch_KeepsGPUByDefault(t *testing.T) {
+	commandLine := newMutableCommandLineStub()
+	commandLine.AppendSwitchWithValue("type", "gpu-process")
+
+	(&dumberBPH{engine: &Engine{ctx: context.Background()}}).OnBeforeChildProcessLaunch(commandLine)
+
+	if commandLine.HasSwitch("disable-gpu") || commandLine.HasSwitch("disable-gpu-compositing") {
+		t.Fatal("expected GPU switches to be left alone")
+	}
+}
+
+func TestConfigureSoftwareWindowInfo_DisablesSharedTexture(t *testing.T) {
+	windowInfo := purecef.NewWindowInfo()
+	windowInfo.SharedTextureEnabled = 1
+
+	configureSoftwareWindowInfo(&windowInfo, false)
+	if windowInfo.SharedTextureEnabled != 1 {
+		t.Fatal("expected shared texture to stay enabled with the GPU on")
+	}
+
+	configureSoftwareWindowInfo(&windowInfo, true)
+	if windowInfo.SharedTextureEnabled != 0 {
+		t.Fatal("expected shared texture to be disabled without the GPU")
+	}
+}
+
 func TestConfigureCommandLine_CEFRenderNodeEnvOverridesExistingRenderNode(t *testing.T) {
 	t.Setenv(cefRenderNodeEnvVar, "/dev/dri/renderD129")
 
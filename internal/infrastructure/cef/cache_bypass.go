@@ -0,0 +1,54 @@
+package cef
+
+import (
+	"slices"
+
+	purecef "github.com/bnema/purego-cef/cef"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+)
+
+// setAlwaysFreshDomains replaces the engine-wide list of domain patterns
+// whose pages skip the HTTP cache.
+func (e *Engine) setAlwaysFreshDomains(domains []string) {
+	if len(domains) == 0 {
+		e.alwaysFresh.Store(nil)
+		return
+	}
+	cloned := slices.Clone(domains)
+	e.alwaysFresh.Store(&cloned)
+}
+
+// alwaysFreshDomains returns the domain patterns whose pages skip the HTTP
+// cache, or nil when none is listed.
+func (e *Engine) alwaysFreshDomains() []string {
+	if e == nil {
+		return nil
+	}
+	if domains := e.alwaysFresh.Load(); domains != nil {
+		return *domains
+	}
+	return nil
+}
+
+func (wv *WebView) alwaysFreshDomains() []string {
+	if wv == nil || wv.engine == nil {
+		return nil
+	}
+	return wv.engine.alwaysFreshDomains()
+}
+
+// skipCacheForAlwaysFresh makes a main-frame request to an always-fresh
+// domain skip the HTTP cache. Requests get here whether the URL was typed,
+// opened from history or followed from a link.
+func skipCacheForAlwaysFresh(domains []string, request purecef.Request) bool {
+	if len(domains) == 0 || request == nil || request.IsReadOnly() ||
+		request.GetResourceType() != purecef.ResourceTypeRtMainFrame {
+		return false
+	}
+	if !usecase.ShouldBypassCache(domains, request.GetURL()) {
+		return false
+	}
+	request.SetFlags(request.GetFlags() | int32(purecef.UrlrequestFlagsUrFlagSkipCache))
+	return true
+}
@@ -0,0 +1,70 @@
+package cef
+
+import (
+	"crypto/x509"
+	"net/url"
+	"strings"
+	"unsafe"
+
+	purecef "github.com/bnema/purego-cef/cef"
+
+	"github.com/bnema/dumber/internal/infrastructure/certpin"
+)
+
+// visibleCertificateSPKISHA256 reads the certificate CEF recorded for the
+// committed main-frame navigation of browser, so it is only meaningful from
+// OnLoadStart onwards.
+func visibleCertificateSPKISHA256(browser purecef.Browser) (string, bool) {
+	if browser == nil {
+		return "", false
+	}
+	host := browser.GetHost()
+	if host == nil {
+		return "", false
+	}
+	entry := host.GetVisibleNavigationEntry()
+	if entry == nil || !entry.IsValid() {
+		return "", false
+	}
+	status := entry.GetSslstatus()
+	if status == nil {
+		return "", false
+	}
+	cert := status.GetX509Certificate()
+	if cert == nil {
+		return "", false
+	}
+	return spkiSHA256FromDER(binaryValueBytes(cert.GetDerencoded()))
+}
+
+func spkiSHA256FromDER(der []byte) (string, bool) {
+	if len(der) == 0 {
+		return "", false
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return "", false
+	}
+	return certpin.SPKISHA256(cert), true
+}
+
+func binaryValueBytes(value purecef.BinaryValue) []byte {
+	if value == nil {
+		return nil
+	}
+	size := value.GetSize()
+	if size <= 0 {
+		return nil
+	}
+	buf := make([]byte, size)
+	n := value.GetData(unsafe.Pointer(&buf[0]), size, 0)
+	if n <= 0 || n > size {
+		return nil
+	}
+	return buf[:n]
+}
+
+func isHTTPSURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	return err == nil && strings.EqualFold(parsed.Scheme, "https")
+}
@@ -0,0 +1,34 @@
+package cef
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/infrastructure/certpin"
+)
+
+func TestSPKISHA256FromDER(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+	cert := srv.Certificate()
+
+	got, ok := spkiSHA256FromDER(cert.Raw)
+	require.True(t, ok)
+	assert.Equal(t, certpin.SPKISHA256(cert), got)
+
+	_, ok = spkiSHA256FromDER([]byte("not a certificate"))
+	assert.False(t, ok)
+	_, ok = spkiSHA256FromDER(nil)
+	assert.False(t, ok)
+}
+
+func TestIsHTTPSURI(t *testing.T) {
+	assert.True(t, isHTTPSURI("https://bank.example/"))
+	assert.True(t, isHTTPSURI("HTTPS://bank.example/"))
+	assert.False(t, isHTTPSURI("http://bank.example/"))
+	assert.False(t, isHTTPSURI("dumb://home"))
+}
@@ -8,11 +8,31 @@ import (
 	"github.com/bnema/dumber/internal/infrastructure/webutil"
 
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/userscript"
 	"github.com/bnema/dumber/internal/logging"
 )
 
-// Compile-time interface check.
-var _ port.ContentInjector = (*contentInjector)(nil)
+// Compile-time interface checks.
+var (
+	_ port.ContentInjector    = (*contentInjector)(nil)
+	_ port.UserScriptInjector = (*contentInjector)(nil)
+	_ port.PageEnvInjector    = (*contentInjector)(nil)
+)
+
+// userScriptDocumentEndTemplate defers a document-end user script until the
+// DOM is parsed. CEF has no injection-time setting, so scripts are executed
+// from OnLoadStart and wait for DOMContentLoaded themselves.
+const userScriptDocumentEndTemplate = `(function(){
+  var run = function(){
+%s
+  };
+  if (document.readyState === 'loading') {
+    document.addEventListener('DOMContentLoaded', run, {once: true});
+  } else {
+    run();
+  }
+})();`
 
 // scrollbarCSS styles the scrollbar with auto-hide behavior: invisible by
 // default, fades in on scroll, widens on hover, fades out after 1s idle.
@@ -173,6 +193,8 @@ type contentInjector struct {
 	engine                  *Engine
 	colorResolver           port.ColorSchemeResolver
 	videoDiagnosticsEnabled bool
+	userScripts             []entity.UserScript
+	pageEnv                 entity.RuntimePageEnvConfig
 }
 
 // setColorResolver updates the color scheme resolver used for dark mode detection.
@@ -232,6 +254,54 @@ func (ci *contentInjector) InjectFindHighlightCSS(ctx context.Context, css strin
 	return nil
 }
 
+// SetUserScripts replaces the user scripts evaluated on each main-frame
+// navigation. Implements port.UserScriptInjector.
+func (ci *contentInjector) SetUserScripts(ctx context.Context, scripts []entity.UserScript) {
+	log := logging.FromContext(ctx).With().Str("component", "cef-content-injector").Logger()
+
+	ci.mu.Lock()
+	ci.userScripts = scripts
+	ci.mu.Unlock()
+
+	log.Debug().Int("count", len(scripts)).Msg("user scripts set for injection")
+}
+
+// SetPageEnv replaces the page_env values and domain rules evaluated on each
+// main-frame navigation. Implements port.PageEnvInjector.
+func (ci *contentInjector) SetPageEnv(ctx context.Context, cfg entity.RuntimePageEnvConfig) {
+	log := logging.FromContext(ctx).With().Str("component", "cef-content-injector").Logger()
+
+	ci.mu.Lock()
+	ci.pageEnv = cfg
+	ci.mu.Unlock()
+
+	log.Debug().Int("values", len(cfg.Values)).Int("domains", len(cfg.Domains)).Msg("page env set for injection")
+}
+
+// userScriptsFor returns the JavaScript to execute for the page env and the
+// user scripts that match uri, in load order. The page env comes first so
+// user scripts can read it. Document-end scripts are wrapped so they wait
+// for DOMContentLoaded.
+func (ci *contentInjector) userScriptsFor(uri string) []string {
+	ci.mu.RLock()
+	env := userscript.PageEnvFor(ci.pageEnv, uri)
+	matching := userscript.Matching(ci.userScripts, uri)
+	ci.mu.RUnlock()
+
+	out := make([]string, 0, len(matching)+1)
+	if len(env) > 0 {
+		out = append(out, userscript.PageEnvScript(env))
+	}
+	for _, script := range matching {
+		if script.RunAt == entity.UserScriptRunAtDocumentStart {
+			out = append(out, script.Source)
+			continue
+		}
+		out = append(out, fmt.Sprintf(userScriptDocumentEndTemplate, script.Source))
+	}
+	return out
+}
+
 // RefreshScripts re-injects all scripts into a specific webview.
 func (ci *contentInjector) RefreshScripts(ctx context.Context, wv port.WebView) error {
 	log := logging.FromContext(ctx).With().Str("component", "cef-content-injector").Logger()
@@ -278,6 +348,9 @@ func (ci *contentInjector) onLoadEnd(wv *WebView) {
 		ci.injectCSS(wv, "dumber-find-highlight", findCSS)
 	}
 
+	// Forced dark pages get their stylesheet back in case the page dropped it.
+	wv.reapplyForceDark(context.Background())
+
 	// All pages get custom scrollbar styling with auto-hide.
 	ci.injectCSS(wv, "dumber-scrollbar", scrollbarCSS)
 	wv.RunJavaScript(context.Background(), scrollbarAutoHideJS)
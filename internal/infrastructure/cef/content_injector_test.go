@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
 )
 
 func testTrustedPageFetchBridgeJS() string {
@@ -90,3 +92,39 @@ func TestPopupOpenerBridgeScript_InstallsSyntheticOpenerCallbacks(t *testing.T)
 	require.Contains(t, script, "https://example.com/app")
 	require.Contains(t, script, "bridge-nonce")
 }
+
+func TestContentInjector_UserScriptsFor(t *testing.T) {
+	ci := newContentInjector(nil, nil)
+	ci.SetUserScripts(context.Background(), []entity.UserScript{
+		{Name: "start", Matches: []string{"https://example.com/*"}, RunAt: entity.UserScriptRunAtDocumentStart, Source: "start();"},
+		{Name: "end", Matches: []string{"*://*/*"}, RunAt: entity.UserScriptRunAtDocumentEnd, Source: "end();"},
+	})
+
+	scripts := ci.userScriptsFor("https://example.com/page")
+	require.Len(t, scripts, 2)
+	require.Equal(t, "start();", scripts[0], "document-start scripts run as-is")
+	require.Contains(t, scripts[1], "end();")
+	require.Contains(t, scripts[1], "DOMContentLoaded", "document-end scripts wait for the DOM")
+
+	require.Len(t, ci.userScriptsFor("https://other.org/"), 1)
+	require.Empty(t, ci.userScriptsFor("dumb://homepage"))
+}
+
+func TestContentInjector_UserScriptsForPrependsPageEnv(t *testing.T) {
+	ci := newContentInjector(nil, nil)
+	ci.SetUserScripts(context.Background(), []entity.UserScript{
+		{Name: "start", Matches: []string{"*://*/*"}, RunAt: entity.UserScriptRunAtDocumentStart, Source: "start();"},
+	})
+	ci.SetPageEnv(context.Background(), entity.RuntimePageEnvConfig{
+		Values:  map[string]string{"team": "infra"},
+		Domains: []entity.PageEnvRule{{Domain: "tools.internal", Keys: []string{"team"}}},
+	})
+
+	scripts := ci.userScriptsFor("https://tools.internal/")
+	require.Len(t, scripts, 2)
+	require.Contains(t, scripts[0], "__dumber_env", "the page env runs before user scripts")
+	require.Contains(t, scripts[0], `"team":"infra"`)
+	require.Equal(t, "start();", scripts[1])
+
+	require.Equal(t, []string{"start();"}, ci.userScriptsFor("https://example.com/"))
+}
@@ -249,7 +249,8 @@ func shouldExecuteDirectCEFAction(action port.MenuAction) bool {
 		port.MenuActionCopyLink,
 		port.MenuActionCopyImage,
 		port.MenuActionInspectElement,
-		port.MenuActionCopySelection:
+		port.MenuActionCopySelection,
+		port.MenuActionSearchSelection:
 		return true
 	default:
 		return false
@@ -289,6 +290,19 @@ func (d *cefMenuDelegator) DelegateMenuAction(ctx context.Context, action port.M
 	case port.MenuActionCopySelection:
 		d.wv.RunJavaScript(ctx, "document.execCommand('copy');")
 		return nil
+	case port.MenuActionSearchSelection:
+		d.wv.mu.RLock()
+		cb := d.wv.callbacks
+		d.wv.mu.RUnlock()
+		if cb == nil || cb.OnSearchSelection == nil {
+			return fmt.Errorf("search selection: search handler not available")
+		}
+		if menuContext.SelectionText != "" {
+			cb.OnSearchSelection(menuContext.SelectionText)
+			return nil
+		}
+		d.wv.ReadSelectionText(ctx, cb.OnSearchSelection)
+		return nil
 	default:
 		return fmt.Errorf("cef menu delegator: unsupported action %s", action)
 	}
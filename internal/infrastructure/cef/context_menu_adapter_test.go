@@ -216,6 +216,29 @@ func TestContextMenuSelectionFallsBackToNativeCopySelectionWhenTextUnavailable(t
 	require.Zero(t, executor.executeCalls)
 }
 
+func TestContextMenuSelectionExecutesSearchSelectionDirectly(t *testing.T) {
+	callback := &stubRunContextMenuCallback{}
+	executor := &stubContextMenuExecutor{}
+	var copied []string
+	menuContext := port.MenuContext{SelectionText: "selected text", HasSelection: true}
+
+	dispatchContextMenuSelection(
+		context.Background(),
+		executor,
+		callback,
+		func(text string) { copied = append(copied, text) },
+		map[port.MenuAction]int32{},
+		port.MenuItem{Action: port.MenuActionSearchSelection, Label: "Search for Selection"},
+		menuContext,
+	)
+
+	require.Equal(t, 1, executor.executeCalls)
+	require.Equal(t, port.MenuActionSearchSelection, executor.action)
+	require.Equal(t, 1, callback.cancelCalls)
+	require.Zero(t, callback.contCalls)
+	require.Empty(t, copied, "searching is not a copy")
+}
+
 func TestContextMenuAnchorPositionScalesCEFCoordinatesForDeviceBacking(t *testing.T) {
 	x, y := contextMenuAnchorPosition(stubContextMenuParams{x: 320, y: 180}, 1.25, 1)
 
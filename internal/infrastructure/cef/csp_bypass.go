@@ -0,0 +1,73 @@
+package cef
+
+import (
+	"context"
+
+	purecef "github.com/bnema/purego-cef/cef"
+
+	"github.com/bnema/dumber/internal/domain/csp"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// setCSPStripper replaces the engine-wide CSP stripper. Any listed domain is
+// reported as a warning: those pages lose their protection against injected
+// scripts.
+func (e *Engine) setCSPStripper(ctx context.Context, stripper *csp.Stripper) {
+	e.cspStripper.Store(stripper)
+	if stripper == nil {
+		return
+	}
+	logging.FromContext(ctx).Warn().
+		Strs("domains", stripper.Domains()).
+		Msg("cef: INSECURE debug.strip_csp_domains is enabled; " +
+			"pages of these domains load without their Content Security Policy")
+}
+
+// cspStripper returns the engine-wide CSP stripper, or nil when no domain is
+// listed.
+func (wv *WebView) cspStripper() *csp.Stripper {
+	if wv == nil || wv.engine == nil {
+		return nil
+	}
+	return wv.engine.cspStripper.Load()
+}
+
+// updateCSPBypass turns DevTools CSP bypassing on for a main-frame navigation
+// to a listed domain and off again for any other. CEF cannot rewrite response
+// headers, so bypassing the policy is how its Content-Security-Policy headers
+// are dropped. Runs on the CEF UI thread from OnBeforeBrowse.
+func (wv *WebView) updateCSPBypass(browser purecef.Browser, url string) {
+	bypass := wv.cspStripper().Applies(url)
+	if bypass == wv.cspBypassed.Load() || browser == nil {
+		return
+	}
+	host := browser.GetHost()
+	if host == nil {
+		return
+	}
+	params := purecef.DictionaryValueCreate()
+	if params == nil {
+		return
+	}
+	var enabled int32
+	if bypass {
+		enabled = 1
+	}
+	params.SetBool("enabled", enabled)
+	if host.ExecuteDevToolsMethod(0, "Page.setBypassCSP", params) == 0 {
+		logging.FromContext(wv.engine.currentContext()).Error().
+			Bool("enabled", bypass).
+			Msg("cef: failed to toggle CSP bypass")
+		return
+	}
+	wv.cspBypassed.Store(bypass)
+
+	log := logging.FromContext(wv.engine.currentContext())
+	if bypass {
+		log.Warn().
+			Str("url", logging.TruncateURL(url, maxSchemeTruncatedURLLength)).
+			Msg("cef: INSECURE loading page without its Content Security Policy")
+		return
+	}
+	log.Debug().Msg("cef: CSP bypass turned off")
+}
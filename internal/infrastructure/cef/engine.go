@@ -5,6 +5,7 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"os"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,7 +16,9 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/csp"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/throttle"
 	"github.com/bnema/dumber/internal/logging"
 )
 
@@ -33,8 +36,13 @@ type Engine struct {
 	runtimeCEFDir      string
 	stateRoot          string
 	renderStackPlan    cef2gtk.RenderStackPlan
+	disableGPU         bool
+	proxy              *port.ProxyConfig
 	applicationScaleMu sync.RWMutex
 	applicationScale   float64
+	requestLimiter     atomic.Pointer[throttle.Limiter]
+	cspStripper        atomic.Pointer[csp.Stripper]
+	alwaysFresh        atomic.Pointer[[]string]
 
 	messageRouter *MessageRouter
 	schemeHandler *dumbSchemeHandler
@@ -50,6 +58,7 @@ type Engine struct {
 	clipboard                        port.Clipboard
 	clipboardTextOrchestrator        port.ClipboardTextOrchestrator
 	onClipboardCopied                func(textLen int)
+	consoleCapture                   port.ConsoleCapture
 	resolver                         port.ImageDataResolver
 	downloadMu                       sync.RWMutex
 	downloadHandler                  *downloadHandler
@@ -316,6 +325,7 @@ func (e *Engine) destroyClosedWebViewBridges(webViews []*WebView) {
 func (e *Engine) RegisterHandlers(ctx context.Context, deps port.HandlerDependencies) error {
 	e.clipboardTextOrchestrator = deps.ClipboardTextOrchestrator
 	e.onClipboardCopied = deps.OnClipboardCopied
+	e.consoleCapture = deps.ConsoleCapture
 	if e.messageRouter == nil || e.registerHandlers == nil {
 		return nil
 	}
@@ -378,6 +388,19 @@ func (e *Engine) UpdateSettings(ctx context.Context, update entity.EngineSetting
 	}
 	e.applicationScaleMu.Unlock()
 
+	// Rebuilding the limiter refills every bucket, so keep it while the
+	// rules are unchanged.
+	if !slices.Equal(e.requestLimiter.Load().Rules(), update.Settings.RequestThrottle) {
+		e.requestLimiter.Store(throttle.NewLimiter(update.Settings.RequestThrottle))
+	}
+	if !slices.Equal(e.alwaysFreshDomains(), update.Settings.AlwaysFreshDomains) {
+		e.setAlwaysFreshDomains(update.Settings.AlwaysFreshDomains)
+	}
+	stripper := csp.NewStripper(update.Settings.StripCSPDomains)
+	if !slices.Equal(e.cspStripper.Load().Domains(), stripper.Domains()) {
+		e.setCSPStripper(ctx, stripper)
+	}
+
 	if oldScale != newScale {
 		logging.FromContext(ctx).Info().
 			Float64("old_application_scale", oldScale).
@@ -436,6 +459,34 @@ func (e *Engine) handleClipboardSelectionUpdate(viewID port.WebViewID, text stri
 	}
 }
 
+// recordConsoleMessage forwards a page console message to dumb://console.
+// Messages logged by internal pages are not captured.
+func (e *Engine) recordConsoleMessage(viewID port.WebViewID, pageURL string, severity purecef.LogSeverity, message, source string, line int32) {
+	if e == nil || e.consoleCapture == nil || isConceptualInternalURL(pageURL) || isActualInternalURL(pageURL) {
+		return
+	}
+	e.consoleCapture.Record(e.currentContext(), viewID, entity.ConsoleMessage{
+		Level:   consoleLevelForSeverity(severity),
+		Message: message,
+		URL:     pageURL,
+		Source:  source,
+		Line:    int(line),
+	})
+}
+
+func consoleLevelForSeverity(severity purecef.LogSeverity) entity.ConsoleLevel {
+	switch severity {
+	case purecef.LogSeverityLogseverityVerbose:
+		return entity.ConsoleLevelDebug
+	case purecef.LogSeverityLogseverityWarning:
+		return entity.ConsoleLevelWarn
+	case purecef.LogSeverityLogseverityError, purecef.LogSeverityLogseverityFatal:
+		return entity.ConsoleLevelError
+	default:
+		return entity.ConsoleLevelInfo
+	}
+}
+
 func (e *Engine) notifyClipboardCopied(text string) {
 	if e == nil || e.onClipboardCopied == nil || text == "" {
 		return
@@ -20,6 +20,8 @@ import (
 
 	"github.com/bnema/dumber/assets"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/csp"
+	"github.com/bnema/dumber/internal/domain/throttle"
 	"github.com/bnema/dumber/internal/logging"
 )
 
@@ -74,6 +76,8 @@ func NewEngine(
 		runtimeCEFDir:          settings.CEFDir,
 		stateRoot:              stateRoot,
 		renderStackPlan:        renderStackPlan,
+		disableGPU:             cfg.DisableGPU,
+		proxy:                  opts.Proxy,
 		applicationScale:       normalizedApplicationScale(cfg.ApplicationScale),
 		registerHandlers:       deps.RegisterHandlers,
 		registerAccentHandlers: deps.RegisterAccentHandlers,
@@ -85,6 +89,9 @@ func NewEngine(
 		clipboard:              deps.Clipboard,
 		resolver:               deps.ImageDataResolver,
 	}
+	eng.requestLimiter.Store(throttle.NewLimiter(cfg.RequestThrottle))
+	eng.setCSPStripper(ctx, csp.NewStripper(cfg.StripCSPDomains))
+	eng.setAlwaysFreshDomains(cfg.AlwaysFreshDomains)
 
 	logger.Info().
 		Int32("windowless_frame_rate", windowlessFrameRate).
@@ -92,6 +99,7 @@ func NewEngine(
 		Str("render_backend", renderStackPlan.Backend.String()).
 		Str("angle_backend", renderStackPlan.ANGLEBackend).
 		Str("gsk_renderer", renderStackPlan.GSKRenderer).
+		Bool("disable_gpu", cfg.DisableGPU).
 		Bool("external_begin_frame", externalBeginFrameEnabled()).
 		Bool("trace_handlers", cfg.TraceHandlers).
 		Bool("enable_audio_handler", cfg.EnableAudioHandler).
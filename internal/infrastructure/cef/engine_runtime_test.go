@@ -7,6 +7,8 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	purecef "github.com/bnema/purego-cef/cef"
 	"github.com/stretchr/testify/require"
 )
 
@@ -89,6 +91,38 @@ func TestEngineExplicitClipboardCopy_ForwardsViewID(t *testing.T) {
 	require.Equal(t, dto.ExplicitClipboardInput{Text: "copied", SourceEngine: dto.ClipboardSourceCEF, ViewID: 77, Action: "copy", NativeHandled: false}, orchestrator.explicit)
 }
 
+func TestEngineRecordConsoleMessage_MapsSeverityAndSkipsInternalPages(t *testing.T) {
+	capture := &recordingConsoleCapture{}
+	eng := &Engine{consoleCapture: capture}
+
+	eng.recordConsoleMessage(5, "https://example.com/", purecef.LogSeverityLogseverityWarning, "careful", "https://example.com/app.js", 12)
+	eng.recordConsoleMessage(5, "dumb://history", purecef.LogSeverityLogseverityError, "internal", "", 0)
+	eng.recordConsoleMessage(5, "https://dumber.invalid/console", purecef.LogSeverityLogseverityError, "internal", "", 0)
+
+	require.Equal(t, []entity.ConsoleMessage{{
+		Level:   entity.ConsoleLevelWarn,
+		Message: "careful",
+		URL:     "https://example.com/",
+		Source:  "https://example.com/app.js",
+		Line:    12,
+	}}, capture.messages)
+	require.Equal(t, []port.WebViewID{5}, capture.viewIDs)
+}
+
+type recordingConsoleCapture struct {
+	viewIDs  []port.WebViewID
+	messages []entity.ConsoleMessage
+}
+
+func (r *recordingConsoleCapture) Record(_ context.Context, viewID port.WebViewID, msg entity.ConsoleMessage) {
+	r.viewIDs = append(r.viewIDs, viewID)
+	r.messages = append(r.messages, msg)
+}
+
+func (*recordingConsoleCapture) Logs(context.Context) []dto.ConsoleLog { return nil }
+
+func (*recordingConsoleCapture) Clear(context.Context, port.WebViewID) {}
+
 type recordingClipboardTextOrchestrator struct {
 	selection dto.SelectionClipboardInput
 	explicit  dto.ExplicitClipboardInput
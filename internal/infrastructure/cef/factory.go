@@ -93,6 +93,7 @@ func (f *WebViewFactory) Create(ctx context.Context) (port.WebView, error) {
 	if externalBeginFrameEnabled() {
 		windowInfo.ExternalBeginFrameEnabled = 1
 	}
+	configureSoftwareWindowInfo(&windowInfo, f.engine.gpuDisabled())
 
 	// Configure BrowserSettings.
 	settings := purecef.NewBrowserSettings()
@@ -383,6 +384,7 @@ func (f *WebViewFactory) CreateRelated(ctx context.Context, parentID port.WebVie
 	if externalBeginFrameEnabled() {
 		windowInfo.ExternalBeginFrameEnabled = 1
 	}
+	configureSoftwareWindowInfo(&windowInfo, f.engine.gpuDisabled())
 
 	settings := purecef.NewBrowserSettings()
 	cef2gtk.ConfigureBrowserSettings(&settings, cef2gtk.BrowserSettingsOptions{WindowlessFrameRate: f.windowlessFrameRate})
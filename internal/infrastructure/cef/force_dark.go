@@ -0,0 +1,68 @@
+package cef
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/webutil"
+)
+
+var _ port.ForceDarkCapable = (*WebView)(nil)
+
+// forceDarkStyleID is the id of the <style> element that darkens the page.
+const forceDarkStyleID = "dumber-force-dark"
+
+// ApplyForceDark implements port.ForceDarkCapable. CEF has no user
+// stylesheets, so the stylesheet is injected into the main frame as a
+// <style> element and injected again when the page finishes loading; frames
+// inside the page are not darkened. The dark color scheme is reported to
+// page scripts only for styles that ask for it.
+func (wv *WebView) ApplyForceDark(ctx context.Context, style entity.ForceDarkStyle, enabled bool) {
+	if wv.destroyed.Load() {
+		return
+	}
+	if !enabled {
+		if wv.forceDarkCSS.Swap(nil) != nil {
+			wv.RunJavaScript(ctx, removeForceDarkScript())
+		}
+		return
+	}
+	css := webutil.ForceDarkStyleSheet(style)
+	wv.forceDarkCSS.Store(&css)
+	wv.RunJavaScript(ctx, forceDarkScript(css))
+	if webutil.ForceDarkReportsDarkScheme(style) {
+		wv.RunJavaScript(ctx, webutil.DarkModeScript(true, "__dumber_force_dark"))
+	}
+}
+
+// reapplyForceDark injects the force dark stylesheet again once the page
+// has loaded, in case the page replaced the element injected at commit.
+func (wv *WebView) reapplyForceDark(ctx context.Context) {
+	if css := wv.forceDarkCSS.Load(); css != nil {
+		wv.RunJavaScript(ctx, forceDarkScript(*css))
+	}
+}
+
+// forceDarkScript adds or updates the force dark <style> element. It runs
+// as early as the commit, before <head> may exist.
+func forceDarkScript(css string) string {
+	id := webutil.EscapeForJSString(forceDarkStyleID)
+	return fmt.Sprintf(`(function(){
+  var el = document.getElementById('%s');
+  if (!el) {
+    el = document.createElement('style');
+    el.id = '%s';
+    (document.head || document.documentElement).appendChild(el);
+  }
+  el.textContent = '%s';
+})();`, id, id, webutil.EscapeForJSString(css))
+}
+
+func removeForceDarkScript() string {
+	return fmt.Sprintf(`(function(){
+  var el = document.getElementById('%s');
+  if (el) { el.remove(); }
+})();`, webutil.EscapeForJSString(forceDarkStyleID))
+}
@@ -0,0 +1,44 @@
+package cef
+
+import (
+	"context"
+	"testing"
+
+	cefmocks "github.com/bnema/purego-cef/cef/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestWebViewApplyForceDark_InjectsAndRemovesStyle(t *testing.T) {
+	browser := cefmocks.NewMockBrowser(t)
+	frame := cefmocks.NewMockFrame(t)
+	browser.EXPECT().GetMainFrame().Return(frame)
+	var scripts []string
+	frame.EXPECT().ExecuteJavaScript(mock.Anything, "", int32(0)).Run(func(script, _ string, _ int32) {
+		scripts = append(scripts, script)
+	})
+	wv := &WebView{ctx: context.Background(), browser: browser}
+
+	wv.ApplyForceDark(context.Background(), entity.ForceDarkStyleCSS, true)
+	require.Len(t, scripts, 2, "the curated style also reports a dark color scheme")
+	assert.Contains(t, scripts[0], forceDarkStyleID)
+	assert.Contains(t, scripts[0], "color-scheme: dark")
+	assert.Contains(t, scripts[1], "__dumber_force_dark")
+
+	scripts = nil
+	wv.reapplyForceDark(context.Background())
+	require.Len(t, scripts, 1, "the stylesheet is injected again when the page has loaded")
+
+	scripts = nil
+	wv.ApplyForceDark(context.Background(), entity.ForceDarkStyleInvert, false)
+	require.Len(t, scripts, 1)
+	assert.Contains(t, scripts[0], "el.remove()")
+
+	scripts = nil
+	wv.ApplyForceDark(context.Background(), entity.ForceDarkStyleInvert, false)
+	wv.reapplyForceDark(context.Background())
+	assert.Empty(t, scripts, "nothing runs on pages that were not darkened")
+}
@@ -134,7 +134,7 @@ func toConceptualInternalURL(raw string) string {
 
 func isInternalPageHost(host string) bool {
 	switch host {
-	case historyPath, favoritesPath, configPath, errorPath:
+	case historyPath, favoritesPath, configPath, homepagePath, consolePath, navTreePath, notesPath, errorPath:
 		return true
 	default:
 		return false
@@ -33,6 +33,26 @@ func TestToActualInternalURL(t *testing.T) {
 			in:   "dumb://config",
 			want: "https://dumber.invalid/config",
 		},
+		{
+			name: "homepage page root",
+			in:   "dumb://homepage",
+			want: "https://dumber.invalid/homepage",
+		},
+		{
+			name: "console page root",
+			in:   "dumb://console",
+			want: "https://dumber.invalid/console",
+		},
+		{
+			name: "navtree page root",
+			in:   "dumb://navtree",
+			want: "https://dumber.invalid/navtree",
+		},
+		{
+			name: "notes page root",
+			in:   "dumb://notes",
+			want: "https://dumber.invalid/notes",
+		},
 		{
 			name: "api path stays at origin root",
 			in:   "dumb://history/api/message",
@@ -164,6 +184,11 @@ func TestToConceptualInternalURL(t *testing.T) {
 			in:   "https://dumber.invalid/config",
 			want: "dumb://config",
 		},
+		{
+			name: "homepage page root",
+			in:   "https://dumber.invalid/homepage",
+			want: "dumb://homepage",
+		},
 		{
 			name: "page subroute",
 			in:   "https://dumber.invalid/history/crash?url=https%3A%2F%2Fexample.com",
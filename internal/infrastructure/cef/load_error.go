@@ -0,0 +1,51 @@
+package cef
+
+import (
+	purecef "github.com/bnema/purego-cef/cef"
+
+	"github.com/bnema/dumber/internal/domain/loadretry"
+)
+
+// Chromium net error codes of main-frame load failures (net/base/net_error_list.h).
+const (
+	cefErrTimedOut                purecef.Errorcode = -7
+	cefErrBlockedByClient         purecef.Errorcode = -20
+	cefErrNetworkChanged          purecef.Errorcode = -21
+	cefErrConnectionClosed        purecef.Errorcode = -100
+	cefErrConnectionReset         purecef.Errorcode = -101
+	cefErrConnectionRefused       purecef.Errorcode = -102
+	cefErrConnectionFailed        purecef.Errorcode = -104
+	cefErrNameNotResolved         purecef.Errorcode = -105
+	cefErrInternetDisconnected    purecef.Errorcode = -106
+	cefErrAddressUnreachable      purecef.Errorcode = -109
+	cefErrConnectionTimedOut      purecef.Errorcode = -118
+	cefErrProxyConnectionFailed   purecef.Errorcode = -130
+	cefErrNameResolutionFailed    purecef.Errorcode = -137
+	cefErrDisallowedURLScheme     purecef.Errorcode = -301
+	cefErrUnknownURLScheme        purecef.Errorcode = -302
+	cefErrEmptyResponse           purecef.Errorcode = -324
+	cefErrHTTPResponseCodeFailure purecef.Errorcode = -379
+)
+
+// loadFailureCause maps a Chromium net error code to its engine-neutral cause.
+func loadFailureCause(code purecef.Errorcode) loadretry.Cause {
+	switch code {
+	case cefErrAborted:
+		return loadretry.CauseCancelled
+	case cefErrTimedOut, cefErrConnectionTimedOut:
+		return loadretry.CauseTimeout
+	case cefErrNameNotResolved, cefErrNameResolutionFailed:
+		return loadretry.CauseNameResolution
+	case cefErrInternetDisconnected, cefErrAddressUnreachable, cefErrNetworkChanged:
+		return loadretry.CauseOffline
+	case cefErrConnectionClosed, cefErrConnectionReset, cefErrConnectionRefused,
+		cefErrConnectionFailed, cefErrProxyConnectionFailed, cefErrEmptyResponse:
+		return loadretry.CauseConnection
+	case cefErrHTTPResponseCodeFailure:
+		return loadretry.CauseHTTPStatus
+	case cefErrBlockedByClient, cefErrDisallowedURLScheme, cefErrUnknownURLScheme:
+		return loadretry.CausePolicy
+	default:
+		return loadretry.CauseOther
+	}
+}
@@ -0,0 +1,56 @@
+package cef
+
+import (
+	"context"
+	"testing"
+
+	purecef "github.com/bnema/purego-cef/cef"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/loadretry"
+)
+
+func TestLoadFailureCause(t *testing.T) {
+	tests := []struct {
+		code purecef.Errorcode
+		want loadretry.Cause
+	}{
+		{-105, loadretry.CauseNameResolution}, // ERR_NAME_NOT_RESOLVED
+		{-102, loadretry.CauseConnection},     // ERR_CONNECTION_REFUSED
+		{-101, loadretry.CauseConnection},     // ERR_CONNECTION_RESET
+		{-324, loadretry.CauseConnection},     // ERR_EMPTY_RESPONSE
+		{-118, loadretry.CauseTimeout},        // ERR_CONNECTION_TIMED_OUT
+		{-106, loadretry.CauseOffline},        // ERR_INTERNET_DISCONNECTED
+		{-3, loadretry.CauseCancelled},        // ERR_ABORTED
+		{-379, loadretry.CauseHTTPStatus},     // ERR_HTTP_RESPONSE_CODE_FAILURE
+		{-302, loadretry.CausePolicy},         // ERR_UNKNOWN_URL_SCHEME
+		{-200, loadretry.CauseOther},          // ERR_CERT_COMMON_NAME_INVALID
+		{-6, loadretry.CauseOther},            // ERR_FILE_NOT_FOUND
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, loadFailureCause(tt.code), "error code %d", tt.code)
+	}
+}
+
+func TestOnLoadErrorReportsLoadFailure(t *testing.T) {
+	wv := &WebView{ctx: context.Background()}
+	var failures []port.LoadFailure
+	wv.SetCallbacks(&port.WebViewCallbacks{
+		OnLoadFailed: func(failure port.LoadFailure) {
+			failures = append(failures, failure)
+		},
+	})
+
+	h := &handlerSet{wv: wv}
+	h.OnLoadError(nil, stubFrame{main: true, url: "http://localhost:9"}, -102, "CONNECTION_REFUSED", "http://localhost:9")
+	h.OnLoadError(nil, stubFrame{main: false, url: "http://localhost:9/frame"}, -105, "NAME_NOT_RESOLVED", "http://localhost:9/frame")
+
+	require.Len(t, failures, 1, "sub-frame failures are not reported")
+	assert.Equal(t, port.LoadFailure{
+		URI:     "http://localhost:9",
+		Cause:   loadretry.CauseConnection,
+		Message: "CONNECTION_REFUSED",
+	}, failures[0])
+}
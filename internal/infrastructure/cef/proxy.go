@@ -0,0 +1,96 @@
+package cef
+
+import (
+	"net"
+	"strings"
+
+	purecef "github.com/bnema/purego-cef/cef"
+
+	"github.com/bnema/dumber/internal/application/port"
+	domainurl "github.com/bnema/dumber/internal/domain/url"
+)
+
+const (
+	chromiumProxyServerSwitch     = "proxy-server"
+	chromiumProxyBypassListSwitch = "proxy-bypass-list"
+)
+
+// chromiumProxySchemes maps the configured proxy schemes to the ones
+// Chromium's --proxy-server accepts. Chromium always resolves names on the
+// SOCKS server, so the "h"/"a" variants collapse onto their base scheme.
+var chromiumProxySchemes = map[string]string{
+	"http":    "http",
+	"https":   "https",
+	"socks":   "socks5",
+	"socks4":  "socks4",
+	"socks4a": "socks4",
+	"socks5":  "socks5",
+	"socks5h": "socks5",
+}
+
+// configureProxyCommandLine routes the browser process through the configured
+// proxy. A nil or empty config leaves Chromium on the system proxy.
+func configureProxyCommandLine(commandLine purecef.CommandLine, proxy *port.ProxyConfig) {
+	if commandLine == nil || proxy == nil {
+		return
+	}
+	server, ok := chromiumProxyServer(proxy.URL)
+	if !ok {
+		return
+	}
+	commandLine.AppendSwitchWithValue(chromiumProxyServerSwitch, server)
+	if bypass := chromiumProxyBypassList(proxy.NoProxy); bypass != "" {
+		commandLine.AppendSwitchWithValue(chromiumProxyBypassListSwitch, bypass)
+	}
+}
+
+// chromiumProxyServer converts a proxy URL into a --proxy-server value.
+// Credentials are dropped: Chromium ignores them and prompts instead.
+func chromiumProxyServer(raw string) (string, bool) {
+	if strings.TrimSpace(raw) == "" {
+		return "", false
+	}
+	u, err := domainurl.ParseProxyURL(raw)
+	if err != nil {
+		return "", false
+	}
+	scheme, ok := chromiumProxySchemes[u.Scheme]
+	if !ok {
+		return "", false
+	}
+	return scheme + "://" + u.Host, true
+}
+
+// chromiumProxyBypassList converts no-proxy entries into Chromium bypass
+// rules. A domain entry bypasses the domain and its subdomains, matching the
+// WebKit ignore-hosts semantics, so it expands to "example.com;*.example.com".
+func chromiumProxyBypassList(entries []string) string {
+	rules := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") || isIPEntry(entry) {
+			rules = append(rules, entry)
+			continue
+		}
+		host, port := entry, ""
+		if h, p, err := net.SplitHostPort(entry); err == nil {
+			host, port = h, ":"+p
+		}
+		domain := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(host, "*"), "."), ".")
+		if domain == "" {
+			continue
+		}
+		rules = append(rules, domain+port, "*."+domain+port)
+	}
+	return strings.Join(rules, ";")
+}
+
+func isIPEntry(entry string) bool {
+	if h, _, err := net.SplitHostPort(entry); err == nil {
+		entry = h
+	}
+	return net.ParseIP(strings.Trim(entry, "[]")) != nil
+}
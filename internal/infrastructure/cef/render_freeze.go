@@ -0,0 +1,53 @@
+package cef
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/infrastructure/webutil"
+)
+
+// frozenWindowlessFrameRate is the frame rate of a view whose rendering is
+// frozen. CEF rejects 0, and one frame per second keeps the last frame fresh
+// enough for a pane that is still on screen.
+const frozenWindowlessFrameRate = 1
+
+var _ port.RenderingFreezeCapable = (*WebView)(nil)
+
+// SetRenderingFrozen implements port.RenderingFreezeCapable. Besides the
+// freeze script, a frozen view drops to frozenWindowlessFrameRate; thawing
+// restores the configured or adaptive rate. Calling it again with the same
+// state re-applies the script to a newly loaded page.
+func (wv *WebView) SetRenderingFrozen(ctx context.Context, frozen bool) {
+	if wv.destroyed.Load() {
+		return
+	}
+	if wv.renderingFrozen.Swap(frozen) != frozen {
+		wv.applyRenderingFrozenFrameRate(frozen)
+	}
+	wv.RunJavaScript(ctx, webutil.RenderFreezeScript(frozen))
+}
+
+// IsRenderingFrozen implements port.RenderingFreezeCapable.
+func (wv *WebView) IsRenderingFrozen() bool {
+	return wv.renderingFrozen.Load()
+}
+
+func (wv *WebView) applyRenderingFrozenFrameRate(frozen bool) {
+	wv.mu.RLock()
+	host := wv.host
+	fps := wv.lastAdaptiveFrameRate
+	wv.mu.RUnlock()
+	if host == nil {
+		return
+	}
+	if fps <= 0 {
+		fps = wv.windowlessFrameRate
+	}
+	if frozen {
+		fps = frozenWindowlessFrameRate
+	}
+	if fps > 0 {
+		host.SetWindowlessFrameRate(fps)
+	}
+}
@@ -0,0 +1,118 @@
+package cef
+
+import (
+	"time"
+
+	purecef "github.com/bnema/purego-cef/cef"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/throttle"
+)
+
+var _ port.ExtraHeadersCapable = (*WebView)(nil)
+
+// SetExtraHeaders adds headers to every request this view sends from the
+// frames selected by scope. An empty map clears them. Requests already in
+// flight keep the headers they were sent with.
+func (wv *WebView) SetExtraHeaders(headers map[string]string, scope entity.RequestHeaderScope) {
+	wv.extraHeaders.Store(entity.NewExtraRequestHeaders(headers, scope))
+}
+
+// requestLimiter returns the engine-wide request limiter, or nil when no
+// domain is throttled.
+func (wv *WebView) requestLimiter() *throttle.Limiter {
+	if wv == nil || wv.engine == nil {
+		return nil
+	}
+	return wv.engine.requestLimiter.Load()
+}
+
+// resourceLoadHandler returns the view's resource request handler, creating
+// it on first use. CEF asks for it on the IO thread.
+func (h *handlerSet) resourceLoadHandler() purecef.ResourceRequestHandler {
+	h.resourceLoadOnce.Do(func() {
+		h.resourceLoad = purecef.NewResourceRequestHandler(&resourceLoadHandler{wv: h.wv})
+	})
+	return h.resourceLoad
+}
+
+// resourceLoadHandler adds the owning view's extra headers to outgoing
+// requests, makes pages of always-fresh domains skip the HTTP cache and
+// holds requests to throttled domains. Every other resource hook keeps CEF's
+// default behavior.
+type resourceLoadHandler struct {
+	wv *WebView
+}
+
+var _ purecef.ResourceRequestHandler = (*resourceLoadHandler)(nil)
+
+func (r *resourceLoadHandler) OnBeforeResourceLoad(
+	_ purecef.Browser, frame purecef.Frame, request purecef.Request, callback purecef.Callback,
+) purecef.ReturnValue {
+	if r.wv == nil {
+		return purecef.ReturnValueRvContinue
+	}
+	applyExtraHeaders(r.wv.extraHeaders.Load(), frame, request)
+	skipCacheForAlwaysFresh(r.wv.alwaysFreshDomains(), request)
+	if request == nil || callback == nil {
+		return purecef.ReturnValueRvContinue
+	}
+	wait := r.wv.requestLimiter().Reserve(request.GetURL(), time.Now())
+	if wait <= 0 {
+		return purecef.ReturnValueRvContinue
+	}
+	time.AfterFunc(wait, callback.Cont)
+	return purecef.ReturnValueRvContinueAsync
+}
+
+// applyExtraHeaders sets headers on request, overwriting same-named headers
+// set by the page, when they apply to the requesting frame.
+func applyExtraHeaders(headers *entity.ExtraRequestHeaders, frame purecef.Frame, request purecef.Request) int {
+	if request == nil || request.IsReadOnly() {
+		return 0
+	}
+	mainFrame := frame != nil && frame.IsMain()
+	return headers.Apply(mainFrame, func(name, value string) {
+		request.SetHeaderByName(name, value, 1)
+	})
+}
+
+func (*resourceLoadHandler) GetCookieAccessFilter(
+	_ purecef.Browser, _ purecef.Frame, _ purecef.Request,
+) purecef.CookieAccessFilter {
+	return nil
+}
+
+func (*resourceLoadHandler) GetResourceHandler(
+	_ purecef.Browser, _ purecef.Frame, _ purecef.Request,
+) purecef.ResourceHandler {
+	return nil
+}
+
+func (*resourceLoadHandler) OnResourceRedirect(
+	_ purecef.Browser, _ purecef.Frame, _ purecef.Request, _ purecef.Response, _ uintptr,
+) {
+}
+
+func (*resourceLoadHandler) OnResourceResponse(
+	_ purecef.Browser, _ purecef.Frame, _ purecef.Request, _ purecef.Response,
+) int32 {
+	return 0
+}
+
+func (*resourceLoadHandler) GetResourceResponseFilter(
+	_ purecef.Browser, _ purecef.Frame, _ purecef.Request, _ purecef.Response,
+) purecef.ResponseFilter {
+	return nil
+}
+
+func (*resourceLoadHandler) OnResourceLoadComplete(
+	_ purecef.Browser, _ purecef.Frame, _ purecef.Request, _ purecef.Response, _ purecef.UrlrequestStatus, _ int64,
+) {
+}
+
+func (*resourceLoadHandler) OnProtocolExecution(
+	_ purecef.Browser, _ purecef.Frame, _ purecef.Request, _ *int32,
+) {
+}
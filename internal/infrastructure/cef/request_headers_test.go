@@ -0,0 +1,170 @@
+package cef
+
+import (
+	"testing"
+	"time"
+
+	purecef "github.com/bnema/purego-cef/cef"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/throttle"
+)
+
+type stubHeaderFrame struct {
+	purecef.Frame
+	main bool
+}
+
+func (f stubHeaderFrame) IsMain() bool { return f.main }
+
+type recordingHeaderRequest struct {
+	purecef.Request
+	url      string
+	readOnly bool
+	set      map[string]string
+}
+
+func (r *recordingHeaderRequest) IsReadOnly() bool { return r.readOnly }
+
+func (r *recordingHeaderRequest) GetURL() string { return r.url }
+
+func (r *recordingHeaderRequest) SetHeaderByName(name, value string, overwrite int32) {
+	if overwrite == 0 {
+		panic("extra headers must overwrite page headers")
+	}
+	if r.set == nil {
+		r.set = map[string]string{}
+	}
+	r.set[name] = value
+}
+
+func TestExtraHeadersHandler_OnBeforeResourceLoad(t *testing.T) {
+	headers := map[string]string{"X-Debug": "1", "X-Env": "staging"}
+
+	tests := []struct {
+		name  string
+		scope entity.RequestHeaderScope
+		main  bool
+		want  map[string]string
+	}{
+		{name: "top frame scope on main frame", scope: entity.RequestHeaderScopeTopFrame, main: true, want: headers},
+		{name: "top frame scope on iframe", scope: entity.RequestHeaderScopeTopFrame},
+		{name: "all frames scope on iframe", scope: entity.RequestHeaderScopeAllFrames, want: headers},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wv := &WebView{}
+			wv.SetExtraHeaders(headers, tt.scope)
+			handler := &resourceLoadHandler{wv: wv}
+			request := &recordingHeaderRequest{}
+
+			rv := handler.OnBeforeResourceLoad(nil, stubHeaderFrame{main: tt.main}, request, nil)
+
+			assert.Equal(t, purecef.ReturnValueRvContinue, rv)
+			assert.Equal(t, tt.want, request.set)
+		})
+	}
+}
+
+func TestApplyExtraHeaders_SkipsReadOnlyAndMissingFrame(t *testing.T) {
+	headers := entity.NewExtraRequestHeaders(map[string]string{"X-Debug": "1"}, entity.RequestHeaderScopeTopFrame)
+
+	readOnly := &recordingHeaderRequest{readOnly: true}
+	assert.Zero(t, applyExtraHeaders(headers, stubHeaderFrame{main: true}, readOnly))
+	assert.Nil(t, readOnly.set)
+
+	noFrame := &recordingHeaderRequest{}
+	assert.Zero(t, applyExtraHeaders(headers, nil, noFrame), "unknown frame is not the main frame")
+
+	assert.Zero(t, applyExtraHeaders(headers, stubHeaderFrame{main: true}, nil))
+}
+
+func TestGetResourceRequestHandler_OnlyWithExtraHeaders(t *testing.T) {
+	wv := &WebView{}
+	h := &handlerSet{wv: wv}
+
+	require.Nil(t, h.GetResourceRequestHandler(nil, nil, nil, 0, 0, "", nil))
+
+	wv.SetExtraHeaders(map[string]string{"X-Debug": "1"}, entity.RequestHeaderScopeTopFrame)
+	wv.SetExtraHeaders(map[string]string{}, entity.RequestHeaderScopeTopFrame)
+	require.Nil(t, h.GetResourceRequestHandler(nil, nil, nil, 0, 0, "", nil), "empty map clears headers")
+}
+
+type signalCallback struct {
+	purecef.Callback
+	done chan struct{}
+}
+
+func (c signalCallback) Cont() { close(c.done) }
+
+func TestResourceLoadHandler_ThrottlesMatchingDomains(t *testing.T) {
+	engine := &Engine{}
+	engine.requestLimiter.Store(throttle.NewLimiter([]entity.RequestThrottleRule{
+		{Domain: "example.com", Rate: 50, Burst: 1},
+	}))
+	wv := &WebView{engine: engine}
+	handler := &resourceLoadHandler{wv: wv}
+
+	other := signalCallback{done: make(chan struct{})}
+	rv := handler.OnBeforeResourceLoad(nil, nil, &recordingHeaderRequest{url: "https://other.org/"}, other)
+	assert.Equal(t, purecef.ReturnValueRvContinue, rv)
+
+	first := signalCallback{done: make(chan struct{})}
+	rv = handler.OnBeforeResourceLoad(nil, nil, &recordingHeaderRequest{url: "https://example.com/a"}, first)
+	assert.Equal(t, purecef.ReturnValueRvContinue, rv, "the burst goes out at once")
+
+	second := signalCallback{done: make(chan struct{})}
+	rv = handler.OnBeforeResourceLoad(nil, nil, &recordingHeaderRequest{url: "https://example.com/b"}, second)
+	require.Equal(t, purecef.ReturnValueRvContinueAsync, rv)
+	select {
+	case <-second.done:
+	case <-time.After(time.Second):
+		t.Fatal("throttled request was never continued")
+	}
+}
+
+type flagRecordingRequest struct {
+	purecef.Request
+	url          string
+	resourceType purecef.ResourceType
+	flags        int32
+}
+
+func (r *flagRecordingRequest) IsReadOnly() bool { return false }
+
+func (r *flagRecordingRequest) GetURL() string { return r.url }
+
+func (r *flagRecordingRequest) GetResourceType() purecef.ResourceType { return r.resourceType }
+
+func (r *flagRecordingRequest) GetFlags() int32 { return r.flags }
+
+func (r *flagRecordingRequest) SetFlags(flags int32) { r.flags = flags }
+
+func TestResourceLoadHandler_SkipsCacheForAlwaysFreshPages(t *testing.T) {
+	engine := &Engine{}
+	engine.setAlwaysFreshDomains([]string{"*.status.example"})
+	wv := &WebView{engine: engine}
+	handler := &resourceLoadHandler{wv: wv}
+	skipCache := int32(purecef.UrlrequestFlagsUrFlagSkipCache)
+
+	page := &flagRecordingRequest{url: "https://app.status.example/", resourceType: purecef.ResourceTypeRtMainFrame}
+	rv := handler.OnBeforeResourceLoad(nil, stubHeaderFrame{main: true}, page, nil)
+	assert.Equal(t, purecef.ReturnValueRvContinue, rv)
+	assert.Equal(t, skipCache, page.flags&skipCache, "a followed link to the page skips the cache like a typed URL")
+
+	image := &flagRecordingRequest{url: "https://app.status.example/logo.png", resourceType: purecef.ResourceTypeRtImage}
+	handler.OnBeforeResourceLoad(nil, stubHeaderFrame{main: true}, image, nil)
+	assert.Zero(t, image.flags, "subresources keep the cache")
+
+	other := &flagRecordingRequest{url: "https://example.com/", resourceType: purecef.ResourceTypeRtMainFrame}
+	handler.OnBeforeResourceLoad(nil, stubHeaderFrame{main: true}, other, nil)
+	assert.Zero(t, other.flags)
+
+	engine.setAlwaysFreshDomains(nil)
+	page.flags = 0
+	handler.OnBeforeResourceLoad(nil, stubHeaderFrame{main: true}, page, nil)
+	assert.Zero(t, page.flags, "emptying the list restores caching")
+}
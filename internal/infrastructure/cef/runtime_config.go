@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
 	domainerrors "github.com/bnema/dumber/internal/domain/errors"
 )
 
@@ -19,6 +20,7 @@ type RuntimeConfig struct {
 	LogFile                     string
 	LogSeverity                 int32
 	RenderStack                 string
+	DisableGPU                  bool
 	AdaptiveWindowlessFrameRate bool
 	WindowlessFrameRate         int32
 	WindowlessFrameRateMax      int32
@@ -26,6 +28,9 @@ type RuntimeConfig struct {
 	EnableAudioHandler          bool
 	TraceHandlers               bool
 	ApplicationScale            float64
+	RequestThrottle             []entity.RequestThrottleRule
+	StripCSPDomains             []string
+	AlwaysFreshDomains          []string
 }
 
 type RuntimeInputConfig struct {
@@ -33,6 +33,10 @@ const (
 	historyPath                 = "history"
 	favoritesPath               = "favorites"
 	configPath                  = "config"
+	homepagePath                = "homepage"
+	consolePath                 = "console"
+	navTreePath                 = "navtree"
+	notesPath                   = "notes"
 	errorPath                   = "error"
 	indexHTML                   = "index.html"
 	maxSchemeTruncatedURLLength = 240
@@ -45,6 +49,10 @@ var pageRootFiles = map[string]string{
 	historyPath:   indexHTML,
 	favoritesPath: indexHTML,
 	configPath:    indexHTML,
+	homepagePath:  indexHTML,
+	consolePath:   indexHTML,
+	navTreePath:   indexHTML,
+	notesPath:     indexHTML,
 	errorPath:     indexHTML,
 }
 
@@ -707,7 +715,7 @@ func resolveActualAssetPath(u *url.URL) (assetDir, relPath string, ok bool) {
 
 func assetDirForPageHost(host string) string {
 	switch host {
-	case historyPath, favoritesPath, configPath, errorPath:
+	case historyPath, favoritesPath, configPath, homepagePath, consolePath, navTreePath, notesPath, errorPath:
 		return systemviewsAssetDir
 	default:
 		return ""
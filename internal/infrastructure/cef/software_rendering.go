@@ -0,0 +1,28 @@
+package cef
+
+import (
+	purecef "github.com/bnema/purego-cef/cef"
+)
+
+// configureSoftwareRendering turns GPU rasterization and compositing off when
+// the engine runs without the GPU, as it does in safe mode.
+func configureSoftwareRendering(commandLine purecef.CommandLine, disableGPU bool) {
+	if commandLine == nil || !disableGPU {
+		return
+	}
+	appendSwitchIfMissing(commandLine, "disable-gpu")
+	appendSwitchIfMissing(commandLine, "disable-gpu-compositing")
+}
+
+// configureSoftwareWindowInfo makes CEF paint into CPU buffers instead of
+// shared GPU textures when the engine runs without the GPU.
+func configureSoftwareWindowInfo(windowInfo *purecef.WindowInfo, disableGPU bool) {
+	if windowInfo == nil || !disableGPU {
+		return
+	}
+	windowInfo.SharedTextureEnabled = 0
+}
+
+func (e *Engine) gpuDisabled() bool {
+	return e != nil && e.disableGPU
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/media"
+	"github.com/bnema/dumber/internal/infrastructure/webutil"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/dumber/internal/shared/syncdispatch"
 )
@@ -34,6 +36,8 @@ var (
 	_ port.PopupOpenerCapable    = (*WebView)(nil)
 	_ port.ViewportSyncCapable   = (*WebView)(nil)
 	_ port.OAuthCallbackCapable  = (*WebView)(nil)
+	_ port.AutoplayPolicyCapable = (*WebView)(nil)
+	_ port.AudioMuteCapable      = (*WebView)(nil)
 )
 
 // errDestroyed is returned when an operation is attempted on a destroyed WebView.
@@ -130,6 +134,7 @@ type WebView struct {
 	adaptiveFrameRatePoll       *glib.SourceFunc
 	adaptiveFrameRatePollID     uint
 	lastAdaptiveFrameRate       int32
+	renderingFrozen             atomic.Bool
 
 	// beginFrameTick drives CEF external BeginFrame requests while the GTK
 	// widget is visible. Access is guarded by mu.
@@ -230,8 +235,12 @@ type WebView struct {
 	fullscreen                    atomic.Bool
 	generation                    atomic.Uint64
 	audioPlaying                  atomic.Bool
+	audioMuted                    atomic.Bool
 	zoomFactor                    atomic.Value // float64, initialized to 1.0
 	lastAppliedZoomScaleRatioBits atomic.Uint64
+	extraHeaders                  atomic.Pointer[entity.ExtraRequestHeaders]
+	cspBypassed                   atomic.Bool
+	forceDarkCSS                  atomic.Pointer[string]
 
 	// Browser creation defaults copied from the factory so native popup shells
 	// can apply the same settings in OnBeforePopup.
@@ -497,6 +506,31 @@ func (wv *WebView) IsPlayingAudio() bool {
 	return wv.audioPlaying.Load()
 }
 
+// SetAudioMuted implements port.AudioMuteCapable. The state is remembered
+// so a browser that is still being created starts muted.
+func (wv *WebView) SetAudioMuted(muted bool) {
+	if wv.destroyed.Load() {
+		return
+	}
+	wv.audioMuted.Store(muted)
+	wv.mu.RLock()
+	host := wv.host
+	wv.mu.RUnlock()
+	if host == nil {
+		return
+	}
+	var flag int32
+	if muted {
+		flag = 1
+	}
+	host.SetAudioMuted(flag)
+}
+
+// IsAudioMuted implements port.AudioMuteCapable.
+func (wv *WebView) IsAudioMuted() bool {
+	return wv.audioMuted.Load()
+}
+
 // Generation returns a monotonic counter incremented on pool reuse.
 func (wv *WebView) Generation() uint64 {
 	return wv.generation.Load()
@@ -1005,6 +1039,7 @@ func (wv *WebView) prepareNativePopup(
 	}
 
 	configureNativePopupWindow(windowInfo, settings, prep.frameRate)
+	configureSoftwareWindowInfo(windowInfo, wv.engine.gpuDisabled())
 	clientSlot.Set(client)
 	return true
 }
@@ -1089,6 +1124,18 @@ func (wv *WebView) handleNativePopupAborted() {
 // JavaScript / Appearance
 // ---------------------------------------------------------------------------
 
+// ApplyAutoplayPolicy implements port.AutoplayPolicyCapable.
+// CEF has no per-view gesture setting, so only the policies needing the
+// autoplay script are enforced here; block-audio follows the global
+// autoplay switch.
+func (wv *WebView) ApplyAutoplayPolicy(ctx context.Context, policy entity.AutoplayPolicy) {
+	enforcement := media.EnforcementForAutoplayPolicy(policy)
+	if !enforcement.NeedsScript() {
+		return
+	}
+	wv.RunJavaScript(ctx, webutil.AutoplayScript(enforcement))
+}
+
 // RunJavaScript executes a script in the main world. Fire-and-forget.
 func (wv *WebView) RunJavaScript(_ context.Context, script string) {
 	if wv.destroyed.Load() {
@@ -1522,6 +1569,17 @@ func (wv *WebView) selectedTextSnapshot() string {
 	return wv.selectedText
 }
 
+var _ port.SelectionTextReader = (*WebView)(nil)
+
+// ReadSelectionText implements port.SelectionTextReader with the selection
+// last reported by the render handler.
+func (wv *WebView) ReadSelectionText(_ context.Context, fn func(text string)) {
+	if wv == nil || fn == nil || wv.destroyed.Load() {
+		return
+	}
+	fn(wv.selectedTextSnapshot())
+}
+
 func (wv *WebView) bridgeInputOptions() cef2gtk.InputOptions {
 	if wv == nil {
 		return cef2gtk.InputOptions{}
@@ -1975,6 +2033,14 @@ func (wv *WebView) updateHoverURI(uri string) {
 	wv.mu.Unlock()
 }
 
+// HoveredLinkURI implements port.HoveredLinkProvider with the URI of the last
+// link status message.
+func (wv *WebView) HoveredLinkURI() string {
+	wv.mu.RLock()
+	defer wv.mu.RUnlock()
+	return wv.lastHoverURI
+}
+
 // scheduleZoomRefresh posts two invalidation requests to the CEF UI thread:
 //   - 16ms delay: one frame at 60fps, gives the renderer time to process
 //     the zoom IPC before we request the first repaint.
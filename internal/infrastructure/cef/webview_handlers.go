@@ -30,6 +30,8 @@ type handlerSet struct {
 	fileDialogPresenter fileDialogPresenter
 	renderHandlerOnce   sync.Once
 	renderHandler       purecef.RenderHandler
+	resourceLoadOnce    sync.Once
+	resourceLoad        purecef.ResourceRequestHandler
 }
 
 // Compile-time interface checks.
@@ -244,6 +246,9 @@ func (h *handlerSet) OnConsoleMessage(
 	message, source string,
 	line int32,
 ) int32 {
+	if h.wv != nil && h.wv.engine != nil {
+		h.wv.engine.recordConsoleMessage(h.wv.id, h.wv.URI(), level, message, source, line)
+	}
 	if h.wv != nil && h.wv.ctx != nil &&
 		(strings.Contains(message, consoleMarkerVideoDiag) ||
 			strings.Contains(message, consoleMarkerAutoCopy) ||
@@ -449,7 +454,7 @@ func (h *handlerSet) emitDiscoveredFaviconCandidates(browser purecef.Browser, pa
 // OnLoadStart fires LoadCommitted for main frame navigations.
 // In CEF this callback runs after navigation commit, so it is the closest
 // equivalent to WebKit's LoadCommitted event.
-func (h *handlerSet) OnLoadStart(_ purecef.Browser, frame purecef.Frame, _ purecef.TransitionType) {
+func (h *handlerSet) OnLoadStart(browser purecef.Browser, frame purecef.Frame, _ purecef.TransitionType) {
 	if frame == nil || !frame.IsMain() {
 		return
 	}
@@ -467,6 +472,12 @@ func (h *handlerSet) OnLoadStart(_ purecef.Browser, frame purecef.Frame, _ purec
 		}
 		frame.ExecuteJavaScript(openerBridgeScript, frame.GetURL(), 0)
 	}
+	if h.wv.engine != nil && h.wv.engine.contentInj != nil {
+		frameURL := frame.GetURL()
+		for _, script := range h.wv.engine.contentInj.userScriptsFor(frameURL) {
+			frame.ExecuteJavaScript(script, frameURL, 0)
+		}
+	}
 	if h.wv != nil && h.wv.ctx != nil {
 		logging.FromContext(h.wv.ctx).Debug().
 			Str("url", logging.TruncateURL(frame.GetURL(), logging.PermissionLogURLMaxLen)).
@@ -476,11 +487,28 @@ func (h *handlerSet) OnLoadStart(_ purecef.Browser, frame purecef.Frame, _ purec
 	h.wv.mu.RLock()
 	cb := h.wv.callbacks
 	h.wv.mu.RUnlock()
-	if cb != nil && cb.OnLoadChanged != nil {
-		h.wv.runOnGTK(func() {
-			cb.OnLoadChanged(port.LoadCommitted)
-		})
+	if cb == nil {
+		return
+	}
+	// The certificate check runs before the commit is reported, so a load
+	// rejected by its pin never reaches the UI as committed.
+	checkURI := ""
+	spkiSHA256 := ""
+	if cb.OnCertificateCheck != nil && isHTTPSURI(frame.GetURL()) {
+		checkURI = frame.GetURL()
+		spkiSHA256, _ = visibleCertificateSPKISHA256(browser)
+	}
+	if checkURI == "" && cb.OnLoadChanged == nil {
+		return
 	}
+	h.wv.runOnGTK(func() {
+		if checkURI != "" && cb.OnCertificateCheck(checkURI, spkiSHA256) {
+			return
+		}
+		if cb.OnLoadChanged != nil {
+			cb.OnLoadChanged(port.LoadCommitted)
+		}
+	})
 }
 
 // OnLoadEnd resets crash count and injects content scripts for the main frame.
@@ -577,14 +605,20 @@ func (h *handlerSet) OnLoadError(_ purecef.Browser, frame purecef.Frame, errorCo
 		h.wv.updateURI(failedURL)
 	}
 
-	if cb == nil || cb.OnLoadChanged == nil {
+	if cb == nil {
 		return
 	}
-	if failedURL != "" && !pendingURIEquivalent(currentURI, failedURL) {
+	if cb.OnLoadChanged != nil && failedURL != "" && !pendingURIEquivalent(currentURI, failedURL) {
 		h.wv.runOnGTK(func() {
 			cb.OnLoadChanged(port.LoadCommitted)
 		})
 	}
+	if cb.OnLoadFailed != nil {
+		failure := port.LoadFailure{URI: failedURL, Cause: loadFailureCause(errorCode), Message: errorText}
+		h.wv.runOnGTK(func() {
+			cb.OnLoadFailed(failure)
+		})
+	}
 }
 
 func mapCEFWindowDisposition(disposition purecef.WindowOpenDisposition) dto.WindowDisposition {
@@ -783,6 +817,9 @@ func (h *handlerSet) attachAfterCreatedBrowser(
 
 	h.wv.browser = browser
 	h.wv.host = host
+	if h.wv.audioMuted.Load() {
+		host.SetAudioMuted(1)
+	}
 	h.wv.lastAppliedZoomScaleRatioBits.Store(0)
 	h.wv.pendingCreate = nil
 	bridge := h.wv.viewBridge
@@ -941,6 +978,10 @@ func (h *handlerSet) OnBeforeBrowse(browser purecef.Browser, frame purecef.Frame
 	if frame == nil || !frame.IsMain() || request == nil {
 		return false
 	}
+	h.wv.updateCSPBypass(browser, request.GetURL())
+	if h.cancelNavigation(request.GetURL()) {
+		return true
+	}
 
 	handler := h.downloadHandler()
 	if handler == nil || !strings.EqualFold(request.GetMethod(), "GET") {
@@ -965,6 +1006,31 @@ func (h *handlerSet) OnBeforeBrowse(browser purecef.Browser, frame purecef.Frame
 	return true
 }
 
+// cancelNavigation asks OnNavigationRequest on the GTK thread whether the
+// main-frame navigation to url must be cancelled before its request is sent.
+func (h *handlerSet) cancelNavigation(url string) bool {
+	h.wv.mu.RLock()
+	cb := h.wv.callbacks
+	h.wv.mu.RUnlock()
+	if cb == nil || cb.OnNavigationRequest == nil || url == "" {
+		return false
+	}
+
+	cancel := false
+	dispatchResult := h.wv.runOnGTKSyncLabel("cef.on_before_browse", func() {
+		cancel = cb.OnNavigationRequest(url)
+	})
+	if !dispatchResult.Completed() {
+		logging.FromContext(h.currentContext()).Warn().
+			Str("url", logging.TruncateURL(url, maxSchemeTruncatedURLLength)).
+			Dur("elapsed", dispatchResult.Elapsed).
+			Str("dispatch_status", string(dispatchResult.Status)).
+			Msg("cef: navigation request check did not complete")
+		return false
+	}
+	return cancel
+}
+
 func (h *handlerSet) CanDownload(browser purecef.Browser, url, requestMethod string) bool {
 	handler := h.downloadHandler()
 	if handler == nil {
@@ -1026,7 +1092,13 @@ func (h *handlerSet) GetResourceRequestHandler(
 	_ purecef.Browser, _ purecef.Frame, _ purecef.Request,
 	_, _ int32, _ string, _ *int32,
 ) purecef.ResourceRequestHandler {
-	return nil
+	if h == nil || h.wv == nil {
+		return nil
+	}
+	if h.wv.extraHeaders.Load() == nil && h.wv.requestLimiter() == nil && len(h.wv.alwaysFreshDomains()) == 0 {
+		return nil
+	}
+	return h.resourceLoadHandler()
 }
 
 func (h *handlerSet) GetAuthCredentials(
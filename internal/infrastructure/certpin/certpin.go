@@ -0,0 +1,99 @@
+// Package certpin computes certificate public key pins and captures them from
+// live TLS servers.
+package certpin
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+)
+
+const (
+	defaultPort        = "443"
+	defaultDialTimeout = 15 * time.Second
+)
+
+// SPKISHA256 returns the base64 SHA-256 of cert's SubjectPublicKeyInfo, the
+// same value HPKP pin-sha256 directives use.
+func SPKISHA256(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// SPKISHA256FromPEM parses the first certificate in pemData and returns its
+// SPKISHA256 pin.
+func SPKISHA256FromPEM(pemData string) (string, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", errors.New("no PEM certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("parse certificate: %w", err)
+	}
+	return SPKISHA256(cert), nil
+}
+
+// Probe captures pins by completing a verified TLS handshake with the host.
+type Probe struct {
+	// RootCAs overrides the system trust store when non-nil.
+	RootCAs *x509.CertPool
+	// Timeout bounds the dial and handshake. Zero uses a default.
+	Timeout time.Duration
+}
+
+// NewProbe creates a probe that trusts the system certificate store.
+func NewProbe() *Probe {
+	return &Probe{}
+}
+
+// FetchSPKISHA256 implements port.CertificateProbe. The certificate chain is
+// verified normally so a pin is never captured from an untrusted server.
+func (p *Probe) FetchSPKISHA256(ctx context.Context, hostPort string) (string, error) {
+	host, portStr, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		host, portStr = hostPort, defaultPort
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = defaultDialTimeout
+	}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: timeout},
+		Config: &tls.Config{
+			ServerName: host,
+			RootCAs:    p.RootCAs,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, portStr))
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return "", errors.New("unexpected connection type")
+	}
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", errors.New("server presented no certificate")
+	}
+	return SPKISHA256(certs[0]), nil
+}
+
+var _ port.CertificateProbe = (*Probe)(nil)
@@ -0,0 +1,51 @@
+package certpin
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPKISHA256FromPEM(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+	cert := srv.Certificate()
+
+	pemData := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	got, err := SPKISHA256FromPEM(pemData)
+	require.NoError(t, err)
+	assert.Equal(t, SPKISHA256(cert), got)
+	assert.Len(t, got, 44)
+
+	_, err = SPKISHA256FromPEM("not a certificate")
+	assert.Error(t, err)
+}
+
+func TestProbe_FetchSPKISHA256(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	addr := strings.TrimPrefix(srv.URL, "https://")
+
+	got, err := (&Probe{RootCAs: pool}).FetchSPKISHA256(context.Background(), addr)
+	require.NoError(t, err)
+	assert.Equal(t, SPKISHA256(srv.Certificate()), got)
+}
+
+func TestProbe_FetchSPKISHA256_RejectsUntrusted(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	addr := strings.TrimPrefix(srv.URL, "https://")
+	_, err := (&Probe{RootCAs: x509.NewCertPool()}).FetchSPKISHA256(context.Background(), addr)
+	assert.Error(t, err)
+}
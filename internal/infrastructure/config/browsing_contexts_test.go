@@ -9,6 +9,8 @@ import (
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
 )
 
 // --- Legacy popups → browsing_contexts transform tests ---
@@ -335,6 +337,34 @@ oauth_auto_close = false
 	assert.Equal(t, cfg.Workspace.BrowsingContexts, cfg.Workspace.Popups)
 }
 
+func TestDomainPlacementFileKeepsDottedDomains(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.toml")
+	content := `
+[[workspace.browsing_contexts.domain_placement]]
+domain = " Accounts.Google.com "
+placement = "Bottom"
+
+[[workspace.browsing_contexts.domain_placement]]
+domain = "*.okta.com"
+placement = "left"
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0o644))
+
+	m := &Manager{viper: viper.New()}
+	m.viper.SetConfigFile(configFile)
+	m.setDefaults()
+	require.NoError(t, m.viper.ReadInConfig())
+
+	cfg := &Config{}
+	require.NoError(t, m.viper.Unmarshal(cfg))
+	normalizeConfig(cfg)
+
+	assert.Equal(t, []entity.PopupPlacementRule{
+		{Domain: "accounts.google.com", Placement: "bottom"},
+		{Domain: "*.okta.com", Placement: "left"},
+	}, cfg.Workspace.BrowsingContexts.DomainPlacement)
+}
+
 func TestLegacyPopupsFilePreservesCanonicalBrowsingContextsEnvOverride(t *testing.T) {
 	configFile := filepath.Join(t.TempDir(), "config.toml")
 	content := `
@@ -4,7 +4,10 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/bnema/dumber/internal/domain/download"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/notification"
+	"github.com/bnema/dumber/internal/domain/translation"
 )
 
 // Default configuration constants
@@ -32,11 +35,28 @@ const (
 	// Workspace defaults
 	defaultNewPaneURL = "about:blank"
 
+	// Safe mode defaults
+	defaultSafeModeCrashThreshold     = 3
+	defaultSafeModeCrashWindowMinutes = 10
+
+	// Clipboard defaults
+	defaultClipboardURLHistorySize = 20 // copied URLs
+
+	// Window defaults
+	defaultWindowWidth  = 1280 // px
+	defaultWindowHeight = 800  // px
+
 	// Omnibox defaults
 	defaultOmniboxInitialBehavior   = OmniboxInitialBehaviorRecent
 	defaultOmniboxMostVisitedDays   = 30
 	defaultOmniboxAutoOpenOnNewPane = false
 
+	defaultOmniboxMaxResults     = 10
+	defaultOmniboxMinQueryLength = 1
+	maxOmniboxMaxResults         = 50
+	defaultOmniboxTriggerKey     = "ctrl+l"
+	defaultOmniboxMatchMode      = OmniboxMatchModeSubstring
+
 	// Workspace defaults
 	defaultPaneActivationShortcut    = "ctrl+p"
 	defaultPaneTimeoutMilliseconds   = 3000
@@ -46,10 +66,42 @@ const (
 	defaultResizeTimeoutMilliseconds = 3000
 	defaultResizeStepPercent         = 5.0
 	defaultResizeMinPanePercent      = 10.0
+	defaultLeaderTimeoutMilliseconds = 1500
 	defaultTabBarPosition            = "bottom"
 	defaultPopupPlacement            = "right"
 	defaultFloatingPaneWidthPct      = 0.82
 	defaultFloatingPaneHeightPct     = 0.72
+	defaultReloadOnFocusAfterSeconds = 60
+	defaultClosedTabHistoryDepth     = 10
+	defaultFocusTimerMinutes         = 25
+	defaultFocusTimerWarningSeconds  = 60
+	defaultFocusTimerNavigateURL     = "dumb://home"
+
+	// Focus-follows-mouse delay before a hovered pane takes focus
+	defaultHoverFocusDelayMs = 150
+
+	// Retries of loads that failed on a transient network error
+	defaultLoadRetryMaxAttempts    = 3
+	defaultLoadRetryInitialDelayMs = 1000
+	defaultLoadRetryMaxDelayMs     = 8000
+
+	// Wheel scroll scaling; 1.0 leaves scrolling untouched
+	defaultScrollMultiplier = 1.0
+
+	// On-disk favicon cache budget before LRU eviction
+	defaultFaviconMaxMB = 50
+
+	// Hovered-link status overlay
+	defaultLinkStatusShowDelayMs = 100
+	defaultLinkStatusMaxLength   = 80
+
+	// Homepage dashboard widget sizes
+	defaultHomepageRecentHistoryLimit = 8
+	defaultHomepageTopFavoritesLimit  = 8
+
+	// Console messages kept per pane for dumb://console. Capture wraps the
+	// page console, so it stays off until a size is set.
+	defaultConsoleBufferSize = 0
 
 	// Session defaults
 	defaultSessionActivationShortcut  = "ctrl+o"
@@ -107,6 +159,9 @@ func defaultBrowsingContextConfig() BrowsingContextConfig {
 		BlankTargetBehavior:  "stacked",
 		EnableSmartDetection: true,
 		OAuthAutoClose:       true,
+		PopupBlockDomains:    []string{},
+		PopupAllowDomains:    []string{},
+		DomainPlacement:      []entity.PopupPlacementRule{},
 	}
 }
 
@@ -164,6 +219,7 @@ func DefaultConfig() *Config {
 		},
 		SearchShortcuts:     GetDefaultSearchShortcuts(),
 		DefaultSearchEngine: "https://duckduckgo.com/?q=%s",
+		MultiSearchEngines:  []string{"ddg", "g", "w"},
 		Dmenu: DmenuConfig{
 			MaxHistoryDays:   defaultMaxHistoryDays,
 			ShowVisitCount:   true,
@@ -174,6 +230,7 @@ func DefaultConfig() *Config {
 			DateFormat:       "2006-01-02 15:04",
 			SortByVisitCount: true,
 		},
+		SearchSelectionInNewPane: true,
 		Logging: LoggingConfig{
 			Level:          "info",
 			Format:         "text", // text or json
@@ -208,7 +265,15 @@ func DefaultConfig() *Config {
 				Accent:         "#4ade80", // Green-400 - vibrant primary
 				Border:         "#3f3f46",
 			},
-			ColorScheme: "default", // default follows system theme
+			ColorScheme:      "default", // default follows system theme
+			FaviconFallback:  FaviconFallbackMonogram,
+			ForceDarkDomains: []string{},
+			ForceDarkStyle:   ForceDarkStyleInvert,
+			WorkspaceBackground: WorkspaceBackgroundConfig{
+				Pattern: WorkspaceBackgroundPatternNone,
+			},
+			ReadingProgress: false,
+			FontOverrides:   []FontOverride{},
 			ExternalTheme: entity.ExternalThemeConfig{
 				Enabled:  false,
 				Provider: defaultExternalThemeProvider,
@@ -217,7 +282,10 @@ func DefaultConfig() *Config {
 			},
 		},
 		Debug: DebugConfig{
-			EnableDevTools: true,
+			EnableDevTools:    true,
+			ConsoleBufferSize: defaultConsoleBufferSize,
+			StartupBudgets:    map[string]int{},
+			StripCSPDomains:   []string{},
 		},
 		Engine: EngineConfig{
 			Type:             EngineTypeCEF,
@@ -227,7 +295,8 @@ func DefaultConfig() *Config {
 			// With ITP enabled, WebKit ignores ACCEPT_NO_THIRD_PARTY — ITP handles
 			// third-party cookie isolation more intelligently. Using Always + ITP
 			// matches Epiphany's model and avoids a misleading setting.
-			CookiePolicy: CookiePolicyAlways,
+			CookiePolicy:          CookiePolicyAlways,
+			CookiePolicyOverrides: []CookiePolicyOverride{},
 			CEF: CEFEngineConfig{
 				RenderStack:                 CEFRenderStackVulkan,
 				AdaptiveWindowlessFrameRate: true,
@@ -279,25 +348,50 @@ func DefaultConfig() *Config {
 					"consume-or-expel-up":    {Keys: []string{"{"}, Desc: "Consume/expel pane up"},
 					"consume-or-expel-down":  {Keys: []string{"}"}, Desc: "Consume/expel pane down"},
 
+					"collapse-stacks": {Keys: []string{"c"}, Desc: "Collapse all panes into one stack"},
+					"expand-stack":    {Keys: []string{"e"}, Desc: "Expand stack into an even grid"},
+					"balance-panes":   {Keys: []string{"="}, Desc: "Give every split an even share"},
+
+					"reopen-as-related": {Keys: []string{"o"}, Desc: "Reopen pane sharing the previous pane's session"},
+
+					"toggle-zen": {Keys: []string{"z"}, Desc: "Maximize pane, hiding the others (toggle)"},
+
 					"focus-right": {Keys: []string{"shift+arrowright", "shift+l"}, Desc: "Focus pane to the right"},
 					"focus-left":  {Keys: []string{"shift+arrowleft", "shift+h"}, Desc: "Focus pane to the left"},
 					"focus-up":    {Keys: []string{"shift+arrowup", "shift+k"}, Desc: "Focus pane above"},
 					"focus-down":  {Keys: []string{"shift+arrowdown", "shift+j"}, Desc: "Focus pane below"},
-					"confirm":     {Keys: []string{"enter"}, Desc: "Confirm action"},
-					"cancel":      {Keys: []string{"escape"}, Desc: "Cancel/exit mode"},
+
+					"focus-pane-1": {Keys: []string{"1"}, Desc: "Focus pane number 1"},
+					"focus-pane-2": {Keys: []string{"2"}, Desc: "Focus pane number 2"},
+					"focus-pane-3": {Keys: []string{"3"}, Desc: "Focus pane number 3"},
+					"focus-pane-4": {Keys: []string{"4"}, Desc: "Focus pane number 4"},
+					"focus-pane-5": {Keys: []string{"5"}, Desc: "Focus pane number 5"},
+					"focus-pane-6": {Keys: []string{"6"}, Desc: "Focus pane number 6"},
+					"focus-pane-7": {Keys: []string{"7"}, Desc: "Focus pane number 7"},
+					"focus-pane-8": {Keys: []string{"8"}, Desc: "Focus pane number 8"},
+					"focus-pane-9": {Keys: []string{"9"}, Desc: "Focus pane number 9"},
+
+					"confirm": {Keys: []string{"enter"}, Desc: "Confirm action"},
+					"cancel":  {Keys: []string{"escape"}, Desc: "Cancel/exit mode"},
 				},
 			},
 			TabMode: TabModeConfig{
 				ActivationShortcut:  defaultTabActivationShortcut,
 				TimeoutMilliseconds: defaultTabTimeoutMilliseconds,
 				Actions: map[string]ActionBinding{
-					"new-tab":      {Keys: []string{"n", "c"}, Desc: "Create new tab"},
-					"close-tab":    {Keys: []string{"x"}, Desc: "Close current tab"},
-					"next-tab":     {Keys: []string{"l", "tab"}, Desc: "Switch to next tab"},
-					"previous-tab": {Keys: []string{"h", "shift+tab"}, Desc: "Switch to previous tab"},
-					"rename-tab":   {Keys: []string{"r"}, Desc: "Rename current tab"},
-					"confirm":      {Keys: []string{"enter"}, Desc: "Confirm action"},
-					"cancel":       {Keys: []string{"escape"}, Desc: "Cancel/exit mode"},
+					"new-tab":             {Keys: []string{"n", "c"}, Desc: "Create new tab"},
+					"close-tab":           {Keys: []string{"x"}, Desc: "Close current tab"},
+					"close-tabs-to-right": {Keys: []string{"X"}, Desc: "Close unpinned tabs to the right"},
+					"close-other-tabs":    {Keys: []string{"o"}, Desc: "Close unpinned tabs except the current one"},
+					"reopen-closed-tab":   {Keys: []string{"u"}, Desc: "Reopen the last closed tab"},
+					"next-tab":            {Keys: []string{"l", "tab"}, Desc: "Switch to next tab"},
+					"previous-tab":        {Keys: []string{"h", "shift+tab"}, Desc: "Switch to previous tab"},
+					"rename-tab":          {Keys: []string{"r"}, Desc: "Rename current tab"},
+					"group-tab":           {Keys: []string{"g"}, Desc: "Move current tab into a new group"},
+					"toggle-tab-group":    {Keys: []string{"z"}, Desc: "Collapse or expand the current tab's group"},
+					"ungroup-tab":         {Keys: []string{"G"}, Desc: "Remove current tab from its group"},
+					"confirm":             {Keys: []string{"enter"}, Desc: "Confirm action"},
+					"cancel":              {Keys: []string{"escape"}, Desc: "Cancel/exit mode"},
 				},
 			},
 			ResizeMode: ResizeModeConfig{
@@ -320,6 +414,19 @@ func DefaultConfig() *Config {
 					"cancel":                {Keys: []string{"escape"}, Desc: "Cancel/exit mode"},
 				},
 			},
+			Leader: LeaderConfig{
+				ActivationShortcut:  "", // Disabled until the user picks a leader key
+				TimeoutMilliseconds: defaultLeaderTimeoutMilliseconds,
+				ShowHints:           true,
+				Actions: map[string]ActionBinding{
+					"new-tab":     {Keys: []string{"t"}, Desc: "Create new tab"},
+					"split-right": {Keys: []string{"v"}, Desc: "Split pane right"},
+					"split-down":  {Keys: []string{"s"}, Desc: "Split pane down"},
+					"close-pane":  {Keys: []string{"x"}, Desc: "Close active pane"},
+					"toggle-zen":  {Keys: []string{"z"}, Desc: "Toggle zen mode"},
+					"cancel":      {Keys: []string{"escape"}, Desc: "Cancel sequence"},
+				},
+			},
 			Shortcuts: GlobalShortcutsConfig{
 				Actions: map[string]ActionBinding{
 					"toggle-floating-pane":         {Keys: []string{"alt+f"}, Desc: "Toggle floating pane"},
@@ -327,13 +434,39 @@ func DefaultConfig() *Config {
 					"toggle-favorites-systemview":  {Keys: []string{"ctrl+b"}, Desc: "Toggle Favorites sidebar"},
 					"toggle-current-page-favorite": {Keys: []string{"ctrl+d"}, Desc: "Add/remove current page favorite"},
 					"toggle-config-systemview":     {Keys: []string{}, Desc: "Toggle Config in right split"},
+					"toggle-notes-systemview":      {Keys: []string{}, Desc: "Toggle Notes in right split"},
+					"open-link-in-pane":            {Keys: []string{}, Desc: "Open hovered link in a pane picked by number"},
 					"close-pane":                   {Keys: []string{"ctrl+w"}, Desc: "Close active pane"},
 					"next-tab":                     {Keys: []string{"ctrl+tab"}, Desc: "Switch to next tab"},
 					"previous-tab":                 {Keys: []string{"ctrl+shift+tab"}, Desc: "Switch to previous tab"},
+					"reopen-closed-tab":            {Keys: []string{"ctrl+shift+t"}, Desc: "Reopen the last closed tab"},
 					"consume-or-expel-left":        {Keys: []string{"alt+["}, Desc: "Consume/expel pane left"},
 					"consume-or-expel-right":       {Keys: []string{"alt+]"}, Desc: "Consume/expel pane right"},
 					"consume-or-expel-up":          {Keys: []string{"alt+{"}, Desc: "Consume/expel pane up"},
 					"consume-or-expel-down":        {Keys: []string{"alt+}"}, Desc: "Consume/expel pane down"},
+
+					"copy-url-markdown": {Keys: []string{"ctrl+alt+c"}, Desc: "Copy page as Markdown link"},
+					"copy-url-html":     {Keys: []string{}, Desc: "Copy page as HTML link"},
+					"share-url":         {Keys: []string{}, Desc: "Share page with another app"},
+
+					"copy-tab-urls":          {Keys: []string{}, Desc: "Copy the URLs of every pane in the tab"},
+					"copy-tab-urls-markdown": {Keys: []string{}, Desc: "Copy the tab's pane URLs as a Markdown list"},
+					"copy-tab-urls-json":     {Keys: []string{}, Desc: "Copy the tab's pane URLs as JSON"},
+
+					"next-page": {Keys: []string{}, Desc: "Go to the next page of a numbered URL"},
+					"prev-page": {Keys: []string{}, Desc: "Go to the previous page of a numbered URL"},
+
+					"restore-last-view": {Keys: []string{}, Desc: "Go back and restore the page's scroll position"},
+
+					"toggle-mute":            {Keys: []string{"ctrl+m"}, Desc: "Mute/unmute active pane"},
+					"toggle-mute-background": {Keys: []string{"ctrl+shift+m"}, Desc: "Mute all panes except the active one (toggle)"},
+
+					"toggle-force-dark": {Keys: []string{}, Desc: "Force dark mode on the current site (toggle)"},
+
+					"toggle-content-filtering":  {Keys: []string{}, Desc: "Disable content filtering on the current site (toggle)"},
+					"toggle-cosmetic-filtering": {Keys: []string{}, Desc: "Stop hiding page elements on the current site (toggle)"},
+
+					"panic": {Keys: []string{}, Desc: "Hide the browser at once (panic key)"},
 				},
 			},
 			FloatingPane: FloatingPaneConfig{
@@ -341,10 +474,21 @@ func DefaultConfig() *Config {
 				HeightPct: defaultFloatingPaneHeightPct,
 				Profiles:  map[string]FloatingPaneProfile{},
 			},
-			TabBarPosition:          defaultTabBarPosition,
-			HideTabBarWhenSingleTab: true,
-			BrowsingContexts:        browsingContextDefaults,
-			Popups:                  browsingContextDefaults,
+			TabBarPosition:            defaultTabBarPosition,
+			TabBarMode:                TabBarModeBar,
+			HideTabBarWhenSingleTab:   true,
+			StackSwipe:                StackSwipeTitleBar,
+			ReloadOnFocusDomains:      []string{},
+			ReloadOnFocusAfterSeconds: defaultReloadOnFocusAfterSeconds,
+			ClosedTabHistoryDepth:     defaultClosedTabHistoryDepth,
+			BrowsingContexts:          browsingContextDefaults,
+			Popups:                    browsingContextDefaults,
+			FocusTimer: FocusTimerConfig{
+				DurationMinutes: defaultFocusTimerMinutes,
+				WarningSeconds:  defaultFocusTimerWarningSeconds,
+				Action:          FocusTimerWarn,
+				NavigateURL:     defaultFocusTimerNavigateURL,
+			},
 			Styling: WorkspaceStylingConfig{
 				BorderWidth:                 defaultBorderWidth,
 				BorderColor:                 defaultBorderColor,
@@ -360,17 +504,31 @@ func DefaultConfig() *Config {
 		ContentFiltering: ContentFilteringConfig{
 			Enabled:    true, // Ad blocking enabled by default
 			AutoUpdate: true, // Auto-update filters from GitHub releases
+			// Filtering applies everywhere by default
+			DisabledDomains: []string{},
 		},
 		Clipboard: ClipboardConfig{
 			AutoCopyOnSelection: true, // Enabled by default (zellij-style)
+			URLHistorySize:      defaultClipboardURLHistorySize,
 		},
 		Omnibox: OmniboxConfig{
 			InitialBehavior:   defaultOmniboxInitialBehavior,
 			MostVisitedDays:   defaultOmniboxMostVisitedDays,
 			AutoOpenOnNewPane: defaultOmniboxAutoOpenOnNewPane,
+
+			MaxResults:     defaultOmniboxMaxResults,
+			MinQueryLength: defaultOmniboxMinQueryLength,
+			TriggerKey:     defaultOmniboxTriggerKey,
+			MatchMode:      defaultOmniboxMatchMode,
+
+			NextResultKeys:     []string{"ctrl+j"},
+			PreviousResultKeys: []string{"ctrl+k"},
 		},
 		Session: SessionConfig{
 			AutoRestore:             false,
+			RestoreMode:             SessionRestoreAll,
+			RestoreStrategy:         SessionRestoreReplace,
+			LazyRestore:             false,
 			SnapshotIntervalMs:      defaultSnapshotIntervalMs,
 			MaxExitedSessions:       defaultMaxExitedSessions,
 			MaxExitedSessionAgeDays: defaultMaxExitedSessionAgeDays,
@@ -388,6 +546,9 @@ func DefaultConfig() *Config {
 			HardwareDecodingMode:     HardwareDecodingAuto, // auto allows sw fallback
 			PreferAV1:                false,                // Don't force codec preference, let site choose
 			ShowDiagnosticsOnStartup: false,                // Disabled - diagnostics can be noisy
+			AutoplayPolicy:           AutoplayBlockAudio,   // Muted autoplay OK, audible needs a gesture
+			AutoplayExceptions:       []AutoplayException{},
+			MuteOnFocusLoss:          false,
 			// GStreamer fields (ForceVSync, GLRenderingMode, GStreamerDebugLevel)
 			// moved to [engine.webkit] — zero values here prevent them from being
 			// written back when marshaling the Config struct.
@@ -398,7 +559,107 @@ func DefaultConfig() *Config {
 			NotifyOnNewSettings: true,  // Show toast when new config settings available
 		},
 		Downloads: DownloadsConfig{
-			Path: "", // Empty = use XDG_DOWNLOAD_DIR or ~/Downloads
+			Path:             "", // Empty = use XDG_DOWNLOAD_DIR or ~/Downloads
+			FilenameTemplate: download.DefaultFilenameTemplate,
+			Images:           ImagesInline,
+		},
+		Cache: CacheConfig{
+			AlwaysFreshDomains: []string{}, // Normal caching everywhere by default
+			FaviconMaxMB:       defaultFaviconMaxMB,
+			FaviconMaxEntries:  0, // Bounded by size only
+		},
+		Zoom: ZoomConfig{
+			StepFactor:         entity.ZoomStepFactor,
+			DomainOverrides:    []ZoomDomainOverride{},
+			Presets:            []ZoomPreset{},
+			SessionOnlyDomains: []string{},
+		},
+		Idle: IdleConfig{
+			QuietHours: QuietHours{}, // Disabled: media always keeps the screen awake
+		},
+		ReadAloud: ReadAloudConfig{
+			Command: "", // Disabled until a text-to-speech command is set
+		},
+		Translation: TranslationConfig{
+			Prompt:            false,
+			PreferredLanguage: "", // System locale
+			URLTemplate:       translation.DefaultURLTemplate,
+			IgnoredLanguages:  []string{},
+		},
+		Notifications: NotificationsConfig{
+			HistorySize: notification.DefaultCapacity,
+		},
+		Network: NetworkConfig{
+			Proxy: ProxyConfig{
+				URL:     "", // Empty = system proxy settings
+				NoProxy: []string{},
+				// No per-engine overrides
+				Profiles: map[string]ProxyProfileConfig{},
+			},
+			Retry: LoadRetryConfig{
+				MaxAttempts:    defaultLoadRetryMaxAttempts,
+				InitialDelayMs: defaultLoadRetryInitialDelayMs,
+				MaxDelayMs:     defaultLoadRetryMaxDelayMs,
+			},
+			Throttle: []RequestThrottleRule{},
+		},
+		Input: InputConfig{
+			HoverFocusEnabled:      true,
+			HoverFocusDelayMs:      defaultHoverFocusDelayMs,
+			MiddleClickClosesPane:  false,
+			ScrollMultiplier:       defaultScrollMultiplier,
+			SmoothScrolling:        true,
+			TypeToFind:             false,
+			AutofocusFirstField:    false,
+			AutofocusDomains:       []string{},
+			DisableGesturesDomains: []string{},
+			// Off by default: it injects an input listener into every page.
+			ConfirmCloseUnsavedForms: false,
+		},
+		Homepage: HomepageConfig{
+			Widgets: []HomepageWidget{
+				HomepageWidgetSearch,
+				HomepageWidgetRecentHistory,
+				HomepageWidgetTopFavorites,
+			},
+			RecentHistoryLimit: defaultHomepageRecentHistoryLimit,
+			TopFavoritesLimit:  defaultHomepageTopFavoritesLimit,
+		},
+		LinkStatus: LinkStatusConfig{
+			Enabled:     true,
+			ShowDelayMs: defaultLinkStatusShowDelayMs,
+			MaxLength:   defaultLinkStatusMaxLength,
+		},
+		UserScripts: UserScriptsConfig{
+			Enabled:   true,
+			Directory: "", // Empty = <config dir>/userscripts
+		},
+		RequestMocks: RequestMocksConfig{
+			File: "", // Empty = <config dir>/mocks.toml
+		},
+		PageEnv: PageEnvConfig{
+			Values:  map[string]string{},
+			Domains: []PageEnvRule{},
+		},
+		SafeMode: SafeModeConfig{
+			CrashThreshold:     defaultSafeModeCrashThreshold,
+			CrashWindowMinutes: defaultSafeModeCrashWindowMinutes,
+		},
+		Window: WindowConfig{
+			RememberGeometry: true,
+			DefaultWidth:     defaultWindowWidth,
+			DefaultHeight:    defaultWindowHeight,
+		},
+		ScriptDialogs: ScriptDialogsConfig{
+			AutoDismiss:        false,
+			AutoDismissDomains: []string{},
+		},
+		Privacy: PrivacyConfig{
+			ClipboardReadPolicy:     ClipboardReadPrompt,
+			ClearDataOnCloseDomains: []string{},
+			PanicNeutralURL:         "",
+			PanicPIN:                "",
+			HTTPSOnly:               HTTPSOnlyOff,
 		},
 	}
 }
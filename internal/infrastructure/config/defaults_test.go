@@ -44,6 +44,8 @@ func TestDefaultConfig_CoreDefaults(t *testing.T) {
 	requireActionBinding(t, cfg.Workspace.Shortcuts.Actions, "toggle-favorites-systemview", []string{"ctrl+b"})
 	requireActionBinding(t, cfg.Workspace.Shortcuts.Actions, "toggle-current-page-favorite", []string{"ctrl+d"})
 	requireActionBinding(t, cfg.Workspace.Shortcuts.Actions, "toggle-config-systemview", []string{})
+	requireActionBinding(t, cfg.Workspace.Shortcuts.Actions, "toggle-notes-systemview", []string{})
+	requireActionBinding(t, cfg.Workspace.Shortcuts.Actions, "open-link-in-pane", []string{})
 
 	// Old sections (Rendering, Privacy, Performance, Runtime) have been removed from Config.
 	// Their values now live under cfg.Engine / cfg.Engine.WebKit (validated above).
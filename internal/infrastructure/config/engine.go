@@ -24,6 +24,9 @@ type EngineConfig struct {
 	CookiePolicy     CookiePolicy       `mapstructure:"cookie_policy" toml:"cookie_policy" yaml:"cookie_policy"`
 	WebKit           WebKitEngineConfig `mapstructure:"webkit" toml:"webkit" yaml:"webkit"`
 	CEF              CEFEngineConfig    `mapstructure:"cef" toml:"cef" yaml:"cef"`
+	// CookiePolicyOverrides overrides CookiePolicy per domain pattern.
+	// Entries are tables: { domain = "example.com", policy = "always" }.
+	CookiePolicyOverrides []CookiePolicyOverride `mapstructure:"cookie_policy_overrides" toml:"cookie_policy_overrides" yaml:"cookie_policy_overrides"` //nolint:lll // struct tags exceed lll limit
 }
 
 // ResolveEngineType returns the effective engine type from config + env override.
@@ -100,6 +103,9 @@ type CEFEngineConfig struct {
 	LogSeverity int32 `mapstructure:"log_severity" toml:"log_severity" yaml:"log_severity"`
 	// RenderStack selects the CEF GPU render stack. Empty falls back to vulkan.
 	RenderStack CEFRenderStack `mapstructure:"render_stack" toml:"render_stack" yaml:"render_stack"`
+	// DisableGPU renders pages in software: GPU rasterization, GPU compositing
+	// and shared textures are turned off. Safe mode sets it.
+	DisableGPU bool `mapstructure:"disable_gpu" toml:"disable_gpu" yaml:"disable_gpu"`
 	// AdaptiveWindowlessFrameRate polls the active Wayland monitor refresh rate
 	// and updates CEF's OSR frame-rate while the browser moves across monitors.
 	// It is ignored when WindowlessFrameRate is explicitly set.
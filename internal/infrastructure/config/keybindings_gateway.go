@@ -9,7 +9,10 @@ import (
 	"github.com/bnema/dumber/internal/logging"
 )
 
-const modeGlobal = "global"
+const (
+	modeGlobal = "global"
+	modeLeader = "leader"
+)
 
 // KeybindingsGateway implements port.KeybindingsProvider and port.KeybindingsSaver.
 type KeybindingsGateway struct {
@@ -33,6 +36,7 @@ func (g *KeybindingsGateway) GetKeybindings(ctx context.Context) (port.Keybindin
 		g.buildPaneModeGroup(cfg, defaults),
 		g.buildTabModeGroup(cfg, defaults),
 		g.buildResizeModeGroup(cfg, defaults),
+		g.buildLeaderGroup(cfg, defaults),
 		g.buildSessionModeGroup(cfg, defaults),
 	}
 
@@ -49,6 +53,7 @@ func (g *KeybindingsGateway) GetDefaultKeybindings(ctx context.Context) (port.Ke
 		g.buildPaneModeGroup(defaults, defaults),
 		g.buildTabModeGroup(defaults, defaults),
 		g.buildResizeModeGroup(defaults, defaults),
+		g.buildLeaderGroup(defaults, defaults),
 		g.buildSessionModeGroup(defaults, defaults),
 	}
 
@@ -109,6 +114,7 @@ func (g *KeybindingsGateway) ResetAllKeybindings(ctx context.Context) error {
 	cfg.Workspace.PaneMode.Actions = defaults.Workspace.PaneMode.Actions
 	cfg.Workspace.TabMode.Actions = defaults.Workspace.TabMode.Actions
 	cfg.Workspace.ResizeMode.Actions = defaults.Workspace.ResizeMode.Actions
+	cfg.Workspace.Leader.Actions = defaults.Workspace.Leader.Actions
 	cfg.Workspace.Shortcuts = defaults.Workspace.Shortcuts
 	cfg.Session.SessionMode.Actions = defaults.Session.SessionMode.Actions
 
@@ -154,6 +160,16 @@ func (g *KeybindingsGateway) buildResizeModeGroup(cfg, defaults *Config) port.Ke
 	}
 }
 
+// buildLeaderGroup builds the leader key sequence group.
+func (g *KeybindingsGateway) buildLeaderGroup(cfg, defaults *Config) port.KeybindingGroup {
+	return port.KeybindingGroup{
+		Mode:        modeLeader,
+		DisplayName: "Leader Key",
+		Bindings:    g.buildModeBindings(cfg.Workspace.Leader.Actions, defaults.Workspace.Leader.Actions),
+		Activation:  cfg.Workspace.Leader.ActivationShortcut,
+	}
+}
+
 // buildSessionModeGroup builds the session mode group.
 func (g *KeybindingsGateway) buildSessionModeGroup(cfg, defaults *Config) port.KeybindingGroup {
 	return port.KeybindingGroup{
@@ -213,6 +229,11 @@ func (*KeybindingsGateway) updateKeybinding(cfg *Config, req port.SetKeybindingR
 			existing.Keys = req.Keys
 			cfg.Workspace.ResizeMode.Actions[req.Action] = existing
 		}
+	case modeLeader:
+		if existing, ok := cfg.Workspace.Leader.Actions[req.Action]; ok {
+			existing.Keys = req.Keys
+			cfg.Workspace.Leader.Actions[req.Action] = existing
+		}
 	case "session":
 		if existing, ok := cfg.Session.SessionMode.Actions[req.Action]; ok {
 			existing.Keys = req.Keys
@@ -240,6 +261,10 @@ func (*KeybindingsGateway) getDefaultKeys(defaults *Config, mode, action string)
 		if binding, ok := defaults.Workspace.ResizeMode.Actions[action]; ok {
 			return binding.Keys
 		}
+	case modeLeader:
+		if binding, ok := defaults.Workspace.Leader.Actions[action]; ok {
+			return binding.Keys
+		}
 	case "session":
 		if binding, ok := defaults.Session.SessionMode.Actions[action]; ok {
 			return binding.Keys
@@ -270,6 +295,7 @@ func (*KeybindingsGateway) checkConflicts(cfg *Config, targetMode, targetAction
 	addBindings("pane", cfg.Workspace.PaneMode.Actions)
 	addBindings("tab", cfg.Workspace.TabMode.Actions)
 	addBindings("resize", cfg.Workspace.ResizeMode.Actions)
+	addBindings(modeLeader, cfg.Workspace.Leader.Actions)
 	addBindings("session", cfg.Session.SessionMode.Actions)
 
 	for _, newKey := range newKeys {
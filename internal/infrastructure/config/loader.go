@@ -380,6 +380,20 @@ func ensureDatabasePath(config *Config) error {
 func normalizeConfig(config *Config) {
 	normalizeAppearance(config)
 	normalizeMedia(config)
+	normalizeCache(config)
+	normalizeDebug(config)
+	normalizeDownloads(config)
+	normalizeContentFiltering(config)
+	normalizeReloadOnFocus(config)
+	normalizeScriptDialogs(config)
+	normalizeAutofocus(config)
+	normalizeGestures(config)
+	normalizePageEnv(config)
+	normalizePrivacy(config)
+	normalizeZoom(config)
+	normalizeNetwork(config)
+	normalizeOmnibox(config)
+	normalizeHomepage(config)
 	normalizeEngineConfig(config)
 	normalizeBrowsingContexts(config)
 }
@@ -387,6 +401,18 @@ func normalizeConfig(config *Config) {
 // normalizeBrowsingContexts ensures the deprecated Popups field mirrors BrowsingContexts
 // for runtime compatibility. Existing code can keep reading config.Workspace.Popups.
 func normalizeBrowsingContexts(config *Config) {
+	contexts := &config.Workspace.BrowsingContexts
+	for i, domain := range contexts.PopupBlockDomains {
+		contexts.PopupBlockDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+	for i, domain := range contexts.PopupAllowDomains {
+		contexts.PopupAllowDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+	for i := range contexts.DomainPlacement {
+		rule := &contexts.DomainPlacement[i]
+		rule.Domain = strings.ToLower(strings.TrimSpace(rule.Domain))
+		rule.Placement = strings.ToLower(strings.TrimSpace(rule.Placement))
+	}
 	config.Workspace.Popups = config.Workspace.BrowsingContexts
 }
 
@@ -427,6 +453,11 @@ func normalizeEngineConfig(config *Config) {
 	default:
 		config.Engine.CookiePolicy = itpDefault()
 	}
+	for i := range config.Engine.CookiePolicyOverrides {
+		override := &config.Engine.CookiePolicyOverrides[i]
+		override.Domain = strings.ToLower(strings.TrimSpace(override.Domain))
+		override.Policy = CookiePolicy(strings.ToLower(strings.TrimSpace(string(override.Policy))))
+	}
 
 	// Normalize performance profile (engine)
 	switch strings.ToLower(string(config.Engine.Profile)) {
@@ -449,6 +480,24 @@ func normalizeEngineConfig(config *Config) {
 }
 
 func normalizeAppearance(config *Config) {
+	for i, domain := range config.Appearance.ForceDarkDomains {
+		config.Appearance.ForceDarkDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+	config.Appearance.ForceDarkStyle = ForceDarkStyle(strings.ToLower(strings.TrimSpace(string(config.Appearance.ForceDarkStyle))))
+	for i := range config.Appearance.FontOverrides {
+		fonts := &config.Appearance.FontOverrides[i]
+		fonts.Domain = strings.ToLower(strings.TrimSpace(fonts.Domain))
+		fonts.SansFont = strings.TrimSpace(fonts.SansFont)
+		fonts.SerifFont = strings.TrimSpace(fonts.SerifFont)
+		fonts.MonospaceFont = strings.TrimSpace(fonts.MonospaceFont)
+	}
+	bg := &config.Appearance.WorkspaceBackground
+	bg.Color = strings.TrimSpace(bg.Color)
+	bg.Image = strings.TrimSpace(bg.Image)
+	bg.Pattern = WorkspaceBackgroundPattern(strings.ToLower(strings.TrimSpace(string(bg.Pattern))))
+	if bg.Pattern == "" {
+		bg.Pattern = WorkspaceBackgroundPatternNone
+	}
 	config.Appearance.ExternalTheme.Provider = strings.ToLower(strings.TrimSpace(config.Appearance.ExternalTheme.Provider))
 	config.Appearance.ExternalTheme.Format = strings.ToLower(strings.TrimSpace(config.Appearance.ExternalTheme.Format))
 	config.Appearance.ExternalTheme.Path = strings.TrimSpace(config.Appearance.ExternalTheme.Path)
@@ -488,6 +537,159 @@ func normalizeMedia(config *Config) {
 	default:
 		config.Media.HardwareDecodingMode = HardwareDecodingAuto
 	}
+
+	config.Media.AutoplayPolicy = AutoplayPolicy(strings.ToLower(strings.TrimSpace(string(config.Media.AutoplayPolicy))))
+	if config.Media.AutoplayPolicy == "" {
+		config.Media.AutoplayPolicy = AutoplayBlockAudio
+	}
+	for i := range config.Media.AutoplayExceptions {
+		exception := &config.Media.AutoplayExceptions[i]
+		exception.Domain = strings.ToLower(strings.TrimSpace(exception.Domain))
+		exception.Policy = AutoplayPolicy(strings.ToLower(strings.TrimSpace(string(exception.Policy))))
+	}
+}
+
+func normalizeZoom(config *Config) {
+	for i := range config.Zoom.DomainOverrides {
+		override := &config.Zoom.DomainOverrides[i]
+		override.Domain = strings.ToLower(strings.TrimSpace(override.Domain))
+	}
+	for i := range config.Zoom.Presets {
+		preset := &config.Zoom.Presets[i]
+		preset.Domain = strings.ToLower(strings.TrimSpace(preset.Domain))
+	}
+	for i, domain := range config.Zoom.SessionOnlyDomains {
+		config.Zoom.SessionOnlyDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+func normalizeNetwork(config *Config) {
+	config.Network.Proxy.URL = strings.TrimSpace(config.Network.Proxy.URL)
+	normalizeNoProxy(config.Network.Proxy.NoProxy)
+	for engine, override := range config.Network.Proxy.Profiles {
+		override.URL = strings.TrimSpace(override.URL)
+		normalizeNoProxy(override.NoProxy)
+		config.Network.Proxy.Profiles[engine] = override
+	}
+	for i := range config.Network.Throttle {
+		rule := &config.Network.Throttle[i]
+		rule.Domain = strings.ToLower(strings.TrimSpace(rule.Domain))
+		if rule.Burst == 0 {
+			rule.Burst = 1
+		}
+	}
+}
+
+func normalizeNoProxy(entries []string) {
+	for i, host := range entries {
+		entries[i] = strings.ToLower(strings.TrimSpace(host))
+	}
+}
+
+// normalizeOmnibox also mirrors the omnibox trigger key into the workspace
+// config, where the shortcut tables are built from.
+func normalizeOmnibox(config *Config) {
+	config.Omnibox.TriggerKey = strings.TrimSpace(config.Omnibox.TriggerKey)
+	config.Workspace.OmniboxTriggerKey = config.Omnibox.TriggerKey
+	for i, key := range config.Omnibox.NextResultKeys {
+		config.Omnibox.NextResultKeys[i] = strings.TrimSpace(key)
+	}
+	for i, key := range config.Omnibox.PreviousResultKeys {
+		config.Omnibox.PreviousResultKeys[i] = strings.TrimSpace(key)
+	}
+}
+
+func normalizeCache(config *Config) {
+	for i, domain := range config.Cache.AlwaysFreshDomains {
+		config.Cache.AlwaysFreshDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+func normalizeDebug(config *Config) {
+	for i, domain := range config.Debug.StripCSPDomains {
+		config.Debug.StripCSPDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+func normalizeDownloads(config *Config) {
+	images := ImageDisposition(strings.ToLower(strings.TrimSpace(string(config.Downloads.Images))))
+	if images == "" {
+		images = ImagesInline
+	}
+	config.Downloads.Images = images
+}
+
+func normalizeReloadOnFocus(config *Config) {
+	for i, domain := range config.Workspace.ReloadOnFocusDomains {
+		config.Workspace.ReloadOnFocusDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+func normalizeScriptDialogs(config *Config) {
+	for i, domain := range config.ScriptDialogs.AutoDismissDomains {
+		config.ScriptDialogs.AutoDismissDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+func normalizeAutofocus(config *Config) {
+	for i, domain := range config.Input.AutofocusDomains {
+		config.Input.AutofocusDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+func normalizeGestures(config *Config) {
+	for i, domain := range config.Input.DisableGesturesDomains {
+		config.Input.DisableGesturesDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+// normalizePageEnv lowercases value names and rule keys so they match the
+// names viper loads from the file, which are always lowercased.
+func normalizePageEnv(config *Config) {
+	if len(config.PageEnv.Values) > 0 {
+		values := make(map[string]string, len(config.PageEnv.Values))
+		for name, value := range config.PageEnv.Values {
+			values[strings.ToLower(strings.TrimSpace(name))] = value
+		}
+		config.PageEnv.Values = values
+	}
+	for i := range config.PageEnv.Domains {
+		rule := &config.PageEnv.Domains[i]
+		rule.Domain = strings.ToLower(strings.TrimSpace(rule.Domain))
+		for j, key := range rule.Keys {
+			rule.Keys[j] = strings.ToLower(strings.TrimSpace(key))
+		}
+	}
+}
+
+func normalizePrivacy(config *Config) {
+	policy := ClipboardReadPolicy(strings.ToLower(strings.TrimSpace(string(config.Privacy.ClipboardReadPolicy))))
+	if policy == "" {
+		policy = ClipboardReadPrompt
+	}
+	config.Privacy.ClipboardReadPolicy = policy
+	for i, domain := range config.Privacy.ClearDataOnCloseDomains {
+		config.Privacy.ClearDataOnCloseDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+	config.Privacy.PanicNeutralURL = strings.TrimSpace(config.Privacy.PanicNeutralURL)
+	config.Privacy.PanicPIN = strings.TrimSpace(config.Privacy.PanicPIN)
+	mode := HTTPSOnlyMode(strings.ToLower(strings.TrimSpace(string(config.Privacy.HTTPSOnly))))
+	if mode == "" {
+		mode = HTTPSOnlyOff
+	}
+	config.Privacy.HTTPSOnly = mode
+}
+
+func normalizeContentFiltering(config *Config) {
+	for i, domain := range config.ContentFiltering.DisabledDomains {
+		config.ContentFiltering.DisabledDomains[i] = strings.ToLower(strings.TrimSpace(domain))
+	}
+}
+
+func normalizeHomepage(config *Config) {
+	for i, widget := range config.Homepage.Widgets {
+		config.Homepage.Widgets[i] = HomepageWidget(strings.ToLower(strings.TrimSpace(string(widget))))
+	}
 }
 
 // Get returns the current configuration (thread-safe).
@@ -628,17 +830,37 @@ func (m *Manager) setDefaults() {
 	m.setSessionDefaults(defaults)
 	m.setUpdateDefaults(defaults)
 	m.setDownloadsDefaults(defaults)
+	m.setCacheDefaults(defaults)
+	m.setZoomDefaults(defaults)
+	m.setIdleDefaults(defaults)
+	m.setReadAloudDefaults(defaults)
+	m.setTranslationDefaults(defaults)
+	m.setNotificationsDefaults(defaults)
+	m.setNetworkDefaults(defaults)
+	m.setInputDefaults(defaults)
+	m.setHomepageDefaults(defaults)
+	m.setLinkStatusDefaults(defaults)
+	m.setUserScriptsDefaults(defaults)
+	m.setRequestMocksDefaults(defaults)
+	m.setPageEnvDefaults(defaults)
+	m.setSafeModeDefaults(defaults)
+	m.setWindowDefaults(defaults)
+	m.setScriptDialogsDefaults(defaults)
+	m.setPrivacyDefaults(defaults)
 }
 
 func (m *Manager) setHistoryDefaults(defaults *Config) {
 	m.viper.SetDefault("history.max_entries", defaults.History.MaxEntries)
 	m.viper.SetDefault("history.retention_period_days", defaults.History.RetentionPeriodDays)
 	m.viper.SetDefault("history.cleanup_interval_days", defaults.History.CleanupIntervalDays)
+	m.viper.SetDefault("history.persist_navigation_tree", defaults.History.PersistNavigationTree)
 }
 
 func (m *Manager) setSearchDefaults(defaults *Config) {
 	m.viper.SetDefault("search_shortcuts", defaults.SearchShortcuts)
 	m.viper.SetDefault("default_search_engine", defaults.DefaultSearchEngine)
+	m.viper.SetDefault("multi_search_engines", defaults.MultiSearchEngines)
+	m.viper.SetDefault("search_selection_in_new_pane", defaults.SearchSelectionInNewPane)
 }
 
 func (m *Manager) setDmenuDefaults(defaults *Config) {
@@ -665,6 +887,10 @@ func (m *Manager) setLoggingDefaults(defaults *Config) {
 
 func (m *Manager) setDebugDefaults(defaults *Config) {
 	m.viper.SetDefault("debug.enable_devtools", defaults.Debug.EnableDevTools)
+	m.viper.SetDefault("debug.console_buffer_size", defaults.Debug.ConsoleBufferSize)
+	m.viper.SetDefault("debug.startup_budgets", defaults.Debug.StartupBudgets)
+	m.viper.SetDefault("debug.enable_csp_stripping", defaults.Debug.EnableCSPStripping)
+	m.viper.SetDefault("debug.strip_csp_domains", defaults.Debug.StripCSPDomains)
 }
 
 func (m *Manager) setAppearanceDefaults(defaults *Config) {
@@ -676,6 +902,14 @@ func (m *Manager) setAppearanceDefaults(defaults *Config) {
 	m.viper.SetDefault("appearance.light_palette", defaults.Appearance.LightPalette)
 	m.viper.SetDefault("appearance.dark_palette", defaults.Appearance.DarkPalette)
 	m.viper.SetDefault("appearance.color_scheme", defaults.Appearance.ColorScheme)
+	m.viper.SetDefault("appearance.favicon_fallback", string(defaults.Appearance.FaviconFallback))
+	m.viper.SetDefault("appearance.force_dark_domains", defaults.Appearance.ForceDarkDomains)
+	m.viper.SetDefault("appearance.force_dark_style", string(defaults.Appearance.ForceDarkStyle))
+	m.viper.SetDefault("appearance.workspace_background.color", defaults.Appearance.WorkspaceBackground.Color)
+	m.viper.SetDefault("appearance.workspace_background.image", defaults.Appearance.WorkspaceBackground.Image)
+	m.viper.SetDefault("appearance.workspace_background.pattern", string(defaults.Appearance.WorkspaceBackground.Pattern))
+	m.viper.SetDefault("appearance.reading_progress", defaults.Appearance.ReadingProgress)
+	m.viper.SetDefault("appearance.font_overrides", defaults.Appearance.FontOverrides)
 	m.viper.SetDefault("appearance.external_theme.enabled", defaults.Appearance.ExternalTheme.Enabled)
 	m.viper.SetDefault("appearance.external_theme.provider", defaults.Appearance.ExternalTheme.Provider)
 	m.viper.SetDefault("appearance.external_theme.format", defaults.Appearance.ExternalTheme.Format)
@@ -700,20 +934,42 @@ func (m *Manager) setWorkspaceDefaults(defaults *Config) {
 	m.viper.SetDefault("workspace.resize_mode.step_percent", defaults.Workspace.ResizeMode.StepPercent)
 	m.viper.SetDefault("workspace.resize_mode.min_pane_percent", defaults.Workspace.ResizeMode.MinPanePercent)
 	m.viper.SetDefault("workspace.resize_mode.actions", defaults.Workspace.ResizeMode.Actions)
+	m.viper.SetDefault("workspace.leader.activation_shortcut", defaults.Workspace.Leader.ActivationShortcut)
+	m.viper.SetDefault("workspace.leader.timeout_ms", defaults.Workspace.Leader.TimeoutMilliseconds)
+	m.viper.SetDefault("workspace.leader.show_hints", defaults.Workspace.Leader.ShowHints)
+	m.viper.SetDefault("workspace.leader.actions", defaults.Workspace.Leader.Actions)
 	m.viper.SetDefault("workspace.shortcuts.actions", defaults.Workspace.Shortcuts.Actions)
 	m.viper.SetDefault("workspace.floating_pane.width_pct", defaults.Workspace.FloatingPane.WidthPct)
 	m.viper.SetDefault("workspace.floating_pane.height_pct", defaults.Workspace.FloatingPane.HeightPct)
 	m.viper.SetDefault("workspace.floating_pane.profiles", defaults.Workspace.FloatingPane.Profiles)
 	m.viper.SetDefault("workspace.tab_bar_position", defaults.Workspace.TabBarPosition)
+	m.viper.SetDefault("workspace.tab_bar_mode", string(defaults.Workspace.TabBarMode))
 	m.viper.SetDefault("workspace.hide_tab_bar_when_single_tab", defaults.Workspace.HideTabBarWhenSingleTab)
 	m.viper.SetDefault("workspace.switch_to_tab_on_move", defaults.Workspace.SwitchToTabOnMove)
+	m.viper.SetDefault("workspace.split_inherits_url", defaults.Workspace.SplitInheritsURL)
+	m.viper.SetDefault("workspace.auto_balance_on_split", defaults.Workspace.AutoBalanceOnSplit)
+	m.viper.SetDefault("workspace.freeze_background_panes", defaults.Workspace.FreezeBackgroundPanes)
+	m.viper.SetDefault("workspace.reload_crashed_panes", defaults.Workspace.ReloadCrashedPanes)
+	m.viper.SetDefault("workspace.stack_swipe", string(defaults.Workspace.StackSwipe))
+	m.viper.SetDefault("workspace.reload_on_focus_domains", defaults.Workspace.ReloadOnFocusDomains)
+	m.viper.SetDefault("workspace.reload_on_focus_after_seconds", defaults.Workspace.ReloadOnFocusAfterSeconds)
+	m.viper.SetDefault("workspace.closed_tab_history_depth", defaults.Workspace.ClosedTabHistoryDepth)
+	m.viper.SetDefault("workspace.focus_timer.duration_minutes", defaults.Workspace.FocusTimer.DurationMinutes)
+	m.viper.SetDefault("workspace.focus_timer.warning_seconds", defaults.Workspace.FocusTimer.WarningSeconds)
+	m.viper.SetDefault("workspace.focus_timer.action", string(defaults.Workspace.FocusTimer.Action))
+	m.viper.SetDefault("workspace.focus_timer.navigate_url", defaults.Workspace.FocusTimer.NavigateURL)
 	m.viper.SetDefault("workspace.browsing_contexts.behavior", string(defaults.Workspace.BrowsingContexts.Behavior))
 	m.viper.SetDefault("workspace.browsing_contexts.placement", defaults.Workspace.BrowsingContexts.Placement)
 	m.viper.SetDefault("workspace.browsing_contexts.open_in_new_pane", defaults.Workspace.BrowsingContexts.OpenInNewPane)
 	m.viper.SetDefault("workspace.browsing_contexts.follow_pane_context", defaults.Workspace.BrowsingContexts.FollowPaneContext)
 	m.viper.SetDefault("workspace.browsing_contexts.blank_target_behavior", defaults.Workspace.BrowsingContexts.BlankTargetBehavior)
+	m.viper.SetDefault("workspace.browsing_contexts.default_for_links", string(defaults.Workspace.BrowsingContexts.DefaultForLinks))
 	m.viper.SetDefault("workspace.browsing_contexts.enable_smart_detection", defaults.Workspace.BrowsingContexts.EnableSmartDetection)
 	m.viper.SetDefault("workspace.browsing_contexts.oauth_auto_close", defaults.Workspace.BrowsingContexts.OAuthAutoClose)
+	m.viper.SetDefault("workspace.browsing_contexts.popup_block_domains", defaults.Workspace.BrowsingContexts.PopupBlockDomains)
+	m.viper.SetDefault("workspace.browsing_contexts.popup_allow_domains", defaults.Workspace.BrowsingContexts.PopupAllowDomains)
+	m.viper.SetDefault("workspace.browsing_contexts.notify_blocked_popups", defaults.Workspace.BrowsingContexts.NotifyBlockedPopups)
+	m.viper.SetDefault("workspace.browsing_contexts.domain_placement", defaults.Workspace.BrowsingContexts.DomainPlacement)
 	m.viper.SetDefault("workspace.styling.border_width", defaults.Workspace.Styling.BorderWidth)
 	m.viper.SetDefault("workspace.styling.border_color", defaults.Workspace.Styling.BorderColor)
 	m.viper.SetDefault("workspace.styling.mode_border_width", defaults.Workspace.Styling.ModeBorderWidth)
@@ -728,16 +984,26 @@ func (m *Manager) setWorkspaceDefaults(defaults *Config) {
 func (m *Manager) setContentFilteringDefaults(defaults *Config) {
 	m.viper.SetDefault("content_filtering.enabled", defaults.ContentFiltering.Enabled)
 	m.viper.SetDefault("content_filtering.auto_update", defaults.ContentFiltering.AutoUpdate)
+	m.viper.SetDefault("content_filtering.disabled_domains", defaults.ContentFiltering.DisabledDomains)
 }
 
 func (m *Manager) setClipboardDefaults(defaults *Config) {
 	m.viper.SetDefault("clipboard.auto_copy_on_selection", defaults.Clipboard.AutoCopyOnSelection)
+	m.viper.SetDefault("clipboard.url_history_size", defaults.Clipboard.URLHistorySize)
 }
 
 func (m *Manager) setOmniboxDefaults(defaults *Config) {
 	m.viper.SetDefault("omnibox.initial_behavior", defaults.Omnibox.InitialBehavior)
 	m.viper.SetDefault("omnibox.most_visited_days", defaults.Omnibox.MostVisitedDays)
 	m.viper.SetDefault("omnibox.auto_open_on_new_pane", defaults.Omnibox.AutoOpenOnNewPane)
+	m.viper.SetDefault("omnibox.max_results", defaults.Omnibox.MaxResults)
+	m.viper.SetDefault("omnibox.min_query_length", defaults.Omnibox.MinQueryLength)
+	m.viper.SetDefault("omnibox.open_in_new_pane", defaults.Omnibox.OpenInNewPane)
+	m.viper.SetDefault("omnibox.trigger_key", defaults.Omnibox.TriggerKey)
+	m.viper.SetDefault("omnibox.open_with_current_url", defaults.Omnibox.OpenWithCurrentURL)
+	m.viper.SetDefault("omnibox.match_mode", defaults.Omnibox.MatchMode)
+	m.viper.SetDefault("omnibox.next_result_keys", defaults.Omnibox.NextResultKeys)
+	m.viper.SetDefault("omnibox.previous_result_keys", defaults.Omnibox.PreviousResultKeys)
 }
 
 func (m *Manager) setMediaDefaults(defaults *Config) {
@@ -746,12 +1012,18 @@ func (m *Manager) setMediaDefaults(defaults *Config) {
 	m.viper.SetDefault("media.hardware_decoding", string(defaults.Media.HardwareDecodingMode))
 	m.viper.SetDefault("media.prefer_av1", defaults.Media.PreferAV1)
 	m.viper.SetDefault("media.show_diagnostics", defaults.Media.ShowDiagnosticsOnStartup)
+	m.viper.SetDefault("media.autoplay_policy", string(defaults.Media.AutoplayPolicy))
+	m.viper.SetDefault("media.autoplay_exceptions", defaults.Media.AutoplayExceptions)
+	m.viper.SetDefault("media.mute_on_focus_loss", defaults.Media.MuteOnFocusLoss)
 }
 
 // setRuntimeDefaults removed — runtime.prefix moved to [engine.webkit].
 
 func (m *Manager) setSessionDefaults(defaults *Config) {
 	m.viper.SetDefault("session.auto_restore", defaults.Session.AutoRestore)
+	m.viper.SetDefault("session.restore_mode", string(defaults.Session.RestoreMode))
+	m.viper.SetDefault("session.restore_strategy", string(defaults.Session.RestoreStrategy))
+	m.viper.SetDefault("session.lazy_restore", defaults.Session.LazyRestore)
 	m.viper.SetDefault("session.snapshot_interval_ms", defaults.Session.SnapshotIntervalMs)
 	m.viper.SetDefault("session.max_exited_sessions", defaults.Session.MaxExitedSessions)
 	m.viper.SetDefault("session.max_exited_session_age_days", defaults.Session.MaxExitedSessionAgeDays)
@@ -770,6 +1042,113 @@ func (m *Manager) setUpdateDefaults(defaults *Config) {
 
 func (m *Manager) setDownloadsDefaults(defaults *Config) {
 	m.viper.SetDefault("downloads.path", defaults.Downloads.Path)
+	m.viper.SetDefault("downloads.filename_template", defaults.Downloads.FilenameTemplate)
+	m.viper.SetDefault("downloads.images", string(defaults.Downloads.Images))
+}
+
+func (m *Manager) setCacheDefaults(defaults *Config) {
+	m.viper.SetDefault("cache.always_fresh_domains", defaults.Cache.AlwaysFreshDomains)
+	m.viper.SetDefault("cache.favicon_max_mb", defaults.Cache.FaviconMaxMB)
+	m.viper.SetDefault("cache.favicon_max_entries", defaults.Cache.FaviconMaxEntries)
+}
+
+func (m *Manager) setIdleDefaults(defaults *Config) {
+	m.viper.SetDefault("idle.quiet_hours.start", defaults.Idle.QuietHours.Start)
+	m.viper.SetDefault("idle.quiet_hours.end", defaults.Idle.QuietHours.End)
+}
+
+func (m *Manager) setReadAloudDefaults(defaults *Config) {
+	m.viper.SetDefault("read_aloud.command", defaults.ReadAloud.Command)
+}
+
+func (m *Manager) setTranslationDefaults(defaults *Config) {
+	m.viper.SetDefault("translation.prompt", defaults.Translation.Prompt)
+	m.viper.SetDefault("translation.preferred_language", defaults.Translation.PreferredLanguage)
+	m.viper.SetDefault("translation.url_template", defaults.Translation.URLTemplate)
+	m.viper.SetDefault("translation.ignored_languages", defaults.Translation.IgnoredLanguages)
+}
+
+func (m *Manager) setNotificationsDefaults(defaults *Config) {
+	m.viper.SetDefault("notifications.history_size", defaults.Notifications.HistorySize)
+}
+
+func (m *Manager) setNetworkDefaults(defaults *Config) {
+	m.viper.SetDefault("network.proxy.url", defaults.Network.Proxy.URL)
+	m.viper.SetDefault("network.proxy.no_proxy", defaults.Network.Proxy.NoProxy)
+	m.viper.SetDefault("network.retry.max_attempts", defaults.Network.Retry.MaxAttempts)
+	m.viper.SetDefault("network.retry.initial_delay_ms", defaults.Network.Retry.InitialDelayMs)
+	m.viper.SetDefault("network.retry.max_delay_ms", defaults.Network.Retry.MaxDelayMs)
+	m.viper.SetDefault("network.throttle", defaults.Network.Throttle)
+}
+
+func (m *Manager) setZoomDefaults(defaults *Config) {
+	m.viper.SetDefault("zoom.step_factor", defaults.Zoom.StepFactor)
+	m.viper.SetDefault("zoom.domain_overrides", defaults.Zoom.DomainOverrides)
+	m.viper.SetDefault("zoom.presets", defaults.Zoom.Presets)
+	m.viper.SetDefault("zoom.session_only_domains", defaults.Zoom.SessionOnlyDomains)
+}
+
+func (m *Manager) setInputDefaults(defaults *Config) {
+	m.viper.SetDefault("input.hover_focus_enabled", defaults.Input.HoverFocusEnabled)
+	m.viper.SetDefault("input.hover_focus_delay_ms", defaults.Input.HoverFocusDelayMs)
+	m.viper.SetDefault("input.middle_click_closes_pane", defaults.Input.MiddleClickClosesPane)
+	m.viper.SetDefault("input.scroll_multiplier", defaults.Input.ScrollMultiplier)
+	m.viper.SetDefault("input.smooth_scrolling", defaults.Input.SmoothScrolling)
+	m.viper.SetDefault("input.type_to_find", defaults.Input.TypeToFind)
+	m.viper.SetDefault("input.autofocus_first_field", defaults.Input.AutofocusFirstField)
+	m.viper.SetDefault("input.autofocus_domains", defaults.Input.AutofocusDomains)
+	m.viper.SetDefault("input.disable_gestures_domains", defaults.Input.DisableGesturesDomains)
+	m.viper.SetDefault("input.confirm_close_unsaved_forms", defaults.Input.ConfirmCloseUnsavedForms)
+}
+
+func (m *Manager) setHomepageDefaults(defaults *Config) {
+	m.viper.SetDefault("homepage.widgets", defaults.Homepage.Widgets)
+	m.viper.SetDefault("homepage.recent_history_limit", defaults.Homepage.RecentHistoryLimit)
+	m.viper.SetDefault("homepage.top_favorites_limit", defaults.Homepage.TopFavoritesLimit)
+}
+
+func (m *Manager) setLinkStatusDefaults(defaults *Config) {
+	m.viper.SetDefault("link_status.enabled", defaults.LinkStatus.Enabled)
+	m.viper.SetDefault("link_status.show_delay_ms", defaults.LinkStatus.ShowDelayMs)
+	m.viper.SetDefault("link_status.max_length", defaults.LinkStatus.MaxLength)
+}
+
+func (m *Manager) setUserScriptsDefaults(defaults *Config) {
+	m.viper.SetDefault("user_scripts.enabled", defaults.UserScripts.Enabled)
+	m.viper.SetDefault("user_scripts.directory", defaults.UserScripts.Directory)
+}
+
+func (m *Manager) setRequestMocksDefaults(defaults *Config) {
+	m.viper.SetDefault("request_mocks.file", defaults.RequestMocks.File)
+}
+
+func (m *Manager) setPageEnvDefaults(defaults *Config) {
+	m.viper.SetDefault("page_env.values", defaults.PageEnv.Values)
+	m.viper.SetDefault("page_env.domains", defaults.PageEnv.Domains)
+}
+
+func (m *Manager) setSafeModeDefaults(defaults *Config) {
+	m.viper.SetDefault("safe_mode.crash_threshold", defaults.SafeMode.CrashThreshold)
+	m.viper.SetDefault("safe_mode.crash_window_minutes", defaults.SafeMode.CrashWindowMinutes)
+}
+
+func (m *Manager) setWindowDefaults(defaults *Config) {
+	m.viper.SetDefault("window.remember_geometry", defaults.Window.RememberGeometry)
+	m.viper.SetDefault("window.default_width", defaults.Window.DefaultWidth)
+	m.viper.SetDefault("window.default_height", defaults.Window.DefaultHeight)
+}
+
+func (m *Manager) setScriptDialogsDefaults(defaults *Config) {
+	m.viper.SetDefault("script_dialogs.auto_dismiss", defaults.ScriptDialogs.AutoDismiss)
+	m.viper.SetDefault("script_dialogs.auto_dismiss_domains", defaults.ScriptDialogs.AutoDismissDomains)
+}
+
+func (m *Manager) setPrivacyDefaults(defaults *Config) {
+	m.viper.SetDefault("privacy.clipboard_read_policy", string(defaults.Privacy.ClipboardReadPolicy))
+	m.viper.SetDefault("privacy.clear_data_on_close_domains", defaults.Privacy.ClearDataOnCloseDomains)
+	m.viper.SetDefault("privacy.panic_neutral_url", defaults.Privacy.PanicNeutralURL)
+	m.viper.SetDefault("privacy.panic_pin", defaults.Privacy.PanicPIN)
+	m.viper.SetDefault("privacy.https_only", string(defaults.Privacy.HTTPSOnly))
 }
 
 func (m *Manager) setEngineDefaults(defaults *Config) {
@@ -779,10 +1158,12 @@ func (m *Manager) setEngineDefaults(defaults *Config) {
 	m.viper.SetDefault("engine.pool_prewarm_count", e.PoolPrewarmCount)
 	m.viper.SetDefault("engine.zoom_cache_size", e.ZoomCacheSize)
 	m.viper.SetDefault("engine.cookie_policy", string(e.CookiePolicy))
+	m.viper.SetDefault("engine.cookie_policy_overrides", e.CookiePolicyOverrides)
 
 	ce := e.CEF
 	m.viper.SetDefault("engine.cef.cef_dir", ce.CEFDir)
 	m.viper.SetDefault("engine.cef.render_stack", string(ce.CEFRenderStack()))
+	m.viper.SetDefault("engine.cef.disable_gpu", ce.DisableGPU)
 	m.viper.SetDefault("engine.cef.log_file", ce.LogFile)
 	m.viper.SetDefault("engine.cef.log_severity", ce.LogSeverity)
 	m.viper.SetDefault("engine.cef.adaptive_windowless_frame_rate", ce.AdaptiveWindowlessFrameRate)
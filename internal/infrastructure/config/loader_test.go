@@ -1,10 +1,13 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSetEngineDefaults(t *testing.T) {
@@ -47,3 +50,32 @@ func TestNormalizeConfig_EngineCookiePolicy(t *testing.T) {
 
 	assert.Equal(t, CookiePolicyAlways, cfg.Engine.CookiePolicy)
 }
+
+func TestFontOverridesFileKeepsDottedDomains(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.toml")
+	content := `
+[[appearance.font_overrides]]
+domain = " Docs.Example.com "
+mono = " JetBrains Mono "
+
+[[appearance.font_overrides]]
+domain = "*.example.com"
+sans = "Inter"
+size = 18
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0o644))
+
+	m := &Manager{viper: viper.New()}
+	m.viper.SetConfigFile(configFile)
+	m.setDefaults()
+	require.NoError(t, m.viper.ReadInConfig())
+
+	cfg := &Config{}
+	require.NoError(t, m.viper.Unmarshal(cfg))
+	normalizeConfig(cfg)
+
+	assert.Equal(t, []FontOverride{
+		{Domain: "docs.example.com", MonospaceFont: "JetBrains Mono"},
+		{Domain: "*.example.com", SansFont: "Inter", DefaultFontSize: 18},
+	}, cfg.Appearance.FontOverrides)
+}
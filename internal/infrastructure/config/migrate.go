@@ -300,6 +300,7 @@ func (m *Migrator) defaultActionMaps() []defaultActionMap {
 		{key: "workspace.pane_mode.actions", actions: m.defaultConfig.Workspace.PaneMode.Actions},
 		{key: "workspace.tab_mode.actions", actions: m.defaultConfig.Workspace.TabMode.Actions},
 		{key: "workspace.resize_mode.actions", actions: m.defaultConfig.Workspace.ResizeMode.Actions},
+		{key: "workspace.leader.actions", actions: m.defaultConfig.Workspace.Leader.Actions},
 		{key: "session.session_mode.actions", actions: m.defaultConfig.Session.SessionMode.Actions},
 	}
 }
@@ -814,7 +815,9 @@ func (*Migrator) isUserDataSection(keyPath string) bool {
 	// them as leaf keys makes detection agree with TOML writing.
 	switch keyPath {
 	case "search_shortcuts",
-		"workspace.floating_pane.profiles":
+		"workspace.floating_pane.profiles",
+		"debug.startup_budgets",
+		"page_env.values":
 		return true
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/pelletier/go-toml/v2"
@@ -95,6 +96,14 @@ var legacyEngineAliases = []legacyEngineAlias{
 	legacyEngineSameTarget("runtime", "prefix", engineWebKitTargetSection),
 }
 
+// sharedLegacySections still hold current settings next to their legacy
+// engine keys, so migration strips only the legacy keys from them.
+var sharedLegacySections = []string{"media", "privacy"}
+
+func isSharedLegacySection(section string) bool {
+	return slices.Contains(sharedLegacySections, section)
+}
+
 func legacyEngineAliasesForSection(section string) []legacyEngineAlias {
 	aliases := make([]legacyEngineAlias, 0)
 	for _, alias := range legacyEngineAliases {
@@ -109,7 +118,7 @@ func legacyEngineSectionsToRemove() []string {
 	sections := make([]string, 0)
 	seen := make(map[string]struct{})
 	for _, alias := range legacyEngineAliases {
-		if alias.legacySection == "media" {
+		if isSharedLegacySection(alias.legacySection) {
 			continue
 		}
 		if _, ok := seen[alias.legacySection]; ok {
@@ -178,19 +187,17 @@ func MigrateToEngineConfig(configFile string) (bool, error) {
 			if _, hasLegacy := raw[section]; hasLegacy {
 				return false, fmt.Errorf(
 					"mixed old/new config: [engine] coexists with legacy [%s]; "+
-						"remove [rendering], [performance], [privacy], [runtime]",
+						"remove [rendering], [performance], [runtime]",
 					section,
 				)
 			}
 		}
-		for _, alias := range legacyEngineAliasesForSection("media") {
-			if hasLegacyEngineAlias(raw, alias) {
-				return false, fmt.Errorf(
-					"mixed old/new config: [engine] coexists with deprecated "+
-						"media.%s; remove it from [media] (now in [engine.webkit])",
-					alias.legacyField,
-				)
-			}
+		if alias, found := firstSharedLegacyAlias(raw); found {
+			return false, fmt.Errorf(
+				"mixed old/new config: [engine] coexists with deprecated "+
+					"%s; remove it from [%s] (now in [%s])",
+				alias.legacyKey(), alias.legacySection, alias.targetSection,
+			)
 		}
 		return false, nil // already migrated
 	}
@@ -233,12 +240,21 @@ func hasOldEngineSections(raw map[string]any) bool {
 			return true
 		}
 	}
-	for _, alias := range legacyEngineAliasesForSection("media") {
-		if hasLegacyEngineAlias(raw, alias) {
-			return true
+	_, found := firstSharedLegacyAlias(raw)
+	return found
+}
+
+// firstSharedLegacyAlias returns the first legacy engine key set in a shared
+// section.
+func firstSharedLegacyAlias(raw map[string]any) (legacyEngineAlias, bool) {
+	for _, section := range sharedLegacySections {
+		for _, alias := range legacyEngineAliasesForSection(section) {
+			if hasLegacyEngineAlias(raw, alias) {
+				return alias, true
+			}
 		}
 	}
-	return false
+	return legacyEngineAlias{}, false
 }
 
 // applyEngineMappings builds [engine]/[engine.webkit] from old sections and cleans up.
@@ -273,24 +289,27 @@ func applyEngineMappings(raw map[string]any) {
 		delete(raw, section)
 	}
 
-	stripMigratedMediaFields(raw)
+	for _, section := range sharedLegacySections {
+		stripMigratedSectionFields(raw, section)
+	}
 }
 
-// stripMigratedMediaFields removes migrated fields from [media] while keeping the rest.
-func stripMigratedMediaFields(raw map[string]any) {
-	mediaAny, exists := raw["media"]
+// stripMigratedSectionFields removes migrated fields from a shared section
+// while keeping the rest, and drops the section once it is empty.
+func stripMigratedSectionFields(raw map[string]any, section string) {
+	sectionAny, exists := raw[section]
 	if !exists {
 		return
 	}
-	media, ok := mediaAny.(map[string]any)
+	sectionMap, ok := sectionAny.(map[string]any)
 	if !ok {
 		return
 	}
-	for _, alias := range legacyEngineAliasesForSection("media") {
-		delete(media, alias.legacyField)
+	for _, alias := range legacyEngineAliasesForSection(section) {
+		delete(sectionMap, alias.legacyField)
 	}
-	if len(media) == 0 {
-		delete(raw, "media")
+	if len(sectionMap) == 0 {
+		delete(raw, section)
 	}
 }
 
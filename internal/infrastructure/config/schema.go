@@ -9,6 +9,9 @@ import "github.com/bnema/dumber/internal/domain/entity"
 // AppearanceConfig holds UI/rendering preferences.
 type AppearanceConfig = entity.AppearanceConfig
 
+// FontOverride sets the fonts of the sites matching a domain pattern.
+type FontOverride = entity.FontOverride
+
 // ColorPalette contains semantic color tokens for light/dark themes.
 type ColorPalette = entity.ColorPalette
 
@@ -24,6 +27,9 @@ type TabModeConfig = entity.TabModeConfig
 // ResizeModeConfig defines modal behavior for resizing panes (Zellij-style).
 type ResizeModeConfig = entity.ResizeModeConfig
 
+// LeaderConfig defines the leader key and its follow-up key sequences.
+type LeaderConfig = entity.LeaderConfig
+
 // SessionModeConfig defines modal behavior for session management.
 type SessionModeConfig = entity.SessionModeConfig
 
@@ -39,6 +45,21 @@ type FloatingPaneProfile = entity.FloatingPaneProfile
 // FloatingPaneConfig defines persistent floating pane behavior.
 type FloatingPaneConfig = entity.FloatingPaneConfig
 
+// FocusTimerConfig defines the per-pane focus timer.
+type FocusTimerConfig = entity.FocusTimerConfig
+
+// FocusTimerAction defines what happens to a pane when its focus timer runs out.
+type FocusTimerAction = entity.FocusTimerAction
+
+const (
+	// FocusTimerWarn only tells that the time is up (default)
+	FocusTimerWarn = entity.FocusTimerWarn
+	// FocusTimerClose closes the pane
+	FocusTimerClose = entity.FocusTimerClose
+	// FocusTimerNavigate loads focus_timer.navigate_url in the pane
+	FocusTimerNavigate = entity.FocusTimerNavigate
+)
+
 // WorkspaceStylingConfig defines visual styling for workspace panes.
 type WorkspaceStylingConfig = entity.WorkspaceStylingConfig
 
@@ -56,6 +77,87 @@ const (
 	PopupBehaviorWindowed = entity.PopupBehaviorWindowed
 )
 
+// FaviconFallback defines what is shown for sites without a favicon.
+type FaviconFallback = entity.FaviconFallback
+
+const (
+	// FaviconFallbackMonogram shows a host-colored letter (default)
+	FaviconFallbackMonogram = entity.FaviconFallbackMonogram
+	// FaviconFallbackIcon shows the generic globe icon
+	FaviconFallbackIcon = entity.FaviconFallbackIcon
+)
+
+// ForceDarkStyle selects the stylesheet used on forced-dark pages.
+type ForceDarkStyle = entity.ForceDarkStyle
+
+const (
+	// ForceDarkStyleInvert inverts page colors, keeping media as is (default)
+	ForceDarkStyleInvert = entity.ForceDarkStyleInvert
+	// ForceDarkStyleCSS applies a curated dark stylesheet
+	ForceDarkStyleCSS = entity.ForceDarkStyleCSS
+)
+
+// WorkspaceBackgroundConfig styles the empty areas of the workspace.
+type WorkspaceBackgroundConfig = entity.WorkspaceBackgroundConfig
+
+// WorkspaceBackgroundPattern is a pattern drawn over the workspace background.
+type WorkspaceBackgroundPattern = entity.WorkspaceBackgroundPattern
+
+const (
+	// WorkspaceBackgroundPatternNone draws no pattern (default)
+	WorkspaceBackgroundPatternNone = entity.WorkspaceBackgroundPatternNone
+	// WorkspaceBackgroundPatternDots draws small dots
+	WorkspaceBackgroundPatternDots = entity.WorkspaceBackgroundPatternDots
+	// WorkspaceBackgroundPatternGrid draws thin grid lines
+	WorkspaceBackgroundPatternGrid = entity.WorkspaceBackgroundPatternGrid
+	// WorkspaceBackgroundPatternStripes draws diagonal stripes
+	WorkspaceBackgroundPatternStripes = entity.WorkspaceBackgroundPatternStripes
+)
+
+// SessionRestoreMode defines which tabs auto-restore brings back.
+type SessionRestoreMode = entity.SessionRestoreMode
+
+const (
+	// SessionRestoreAll restores every tab of the last session (default)
+	SessionRestoreAll = entity.SessionRestoreAll
+	// SessionRestorePinned restores only the pinned tabs of the last session
+	SessionRestorePinned = entity.SessionRestorePinned
+)
+
+// SessionRestoreStrategy defines where a session opened from the session manager goes.
+type SessionRestoreStrategy = entity.SessionRestoreStrategy
+
+const (
+	// SessionRestoreReplace opens the session in its own browser instance (default)
+	SessionRestoreReplace = entity.SessionRestoreReplace
+	// SessionRestoreMerge appends the session's tabs to the current window
+	SessionRestoreMerge = entity.SessionRestoreMerge
+)
+
+// StackSwipeMode defines which vertical swipes cycle the panes of a stack.
+type StackSwipeMode = entity.StackSwipeMode
+
+const (
+	// StackSwipeTitleBar cycles stacks on swipes that start over a title bar (default)
+	StackSwipeTitleBar = entity.StackSwipeTitleBar
+	// StackSwipeAlt cycles stacks on any swipe while Alt is held
+	StackSwipeAlt = entity.StackSwipeAlt
+	// StackSwipeOff disables swipe navigation in stacks
+	StackSwipeOff = entity.StackSwipeOff
+)
+
+// TabBarMode defines when the tab strip is shown.
+type TabBarMode = entity.TabBarMode
+
+const (
+	// TabBarModeBar shows the tab strip (default)
+	TabBarModeBar = entity.TabBarModeBar
+	// TabBarModeHidden never shows the tab strip
+	TabBarModeHidden = entity.TabBarModeHidden
+	// TabBarModeOverview shows the tab strip only while tab mode is active
+	TabBarModeOverview = entity.TabBarModeOverview
+)
+
 // OmniboxInitialBehavior defines how omnibox history is ordered on open.
 type OmniboxInitialBehavior = entity.OmniboxInitialBehavior
 
@@ -68,6 +170,18 @@ const (
 	OmniboxInitialBehaviorNone = entity.OmniboxInitialBehaviorNone
 )
 
+// OmniboxMatchMode defines how omnibox suggestions match the query.
+type OmniboxMatchMode = entity.OmniboxMatchMode
+
+const (
+	// OmniboxMatchModeSubstring matches entries containing the query.
+	OmniboxMatchModeSubstring = entity.OmniboxMatchModeSubstring
+	// OmniboxMatchModeFuzzy matches the query's characters in order.
+	OmniboxMatchModeFuzzy = entity.OmniboxMatchModeFuzzy
+	// OmniboxMatchModePrefix matches entries starting with the query.
+	OmniboxMatchModePrefix = entity.OmniboxMatchModePrefix
+)
+
 // BrowsingContextConfig defines handling for browsing contexts (popups, tabs, new windows).
 type BrowsingContextConfig = entity.BrowsingContextConfig
 
@@ -86,11 +200,15 @@ type Config struct {
 	History         HistoryConfig             `mapstructure:"history" yaml:"history" toml:"history"`
 	SearchShortcuts map[string]SearchShortcut `mapstructure:"search_shortcuts" yaml:"search_shortcuts" toml:"search_shortcuts"`
 	// DefaultSearchEngine is the URL template for the default search engine (must contain %s placeholder)
-	DefaultSearchEngine string           `mapstructure:"default_search_engine" yaml:"default_search_engine" toml:"default_search_engine"`
-	Dmenu               DmenuConfig      `mapstructure:"dmenu" yaml:"dmenu" toml:"dmenu"`
-	Logging             LoggingConfig    `mapstructure:"logging" yaml:"logging" toml:"logging"`
-	Appearance          AppearanceConfig `mapstructure:"appearance" yaml:"appearance" toml:"appearance"`
-	Debug               DebugConfig      `mapstructure:"debug" yaml:"debug" toml:"debug"`
+	DefaultSearchEngine string `mapstructure:"default_search_engine" yaml:"default_search_engine" toml:"default_search_engine"`
+	// SearchSelectionInNewPane opens "Search for Selection" results in a new pane instead of the active one
+	SearchSelectionInNewPane bool `mapstructure:"search_selection_in_new_pane" yaml:"search_selection_in_new_pane" toml:"search_selection_in_new_pane"` //nolint:lll // struct tags must stay on one line
+	// MultiSearchEngines are the search_shortcuts keys the ">multi" omnibox command searches with, one pane each
+	MultiSearchEngines []string         `mapstructure:"multi_search_engines" yaml:"multi_search_engines" toml:"multi_search_engines"`
+	Dmenu              DmenuConfig      `mapstructure:"dmenu" yaml:"dmenu" toml:"dmenu"`
+	Logging            LoggingConfig    `mapstructure:"logging" yaml:"logging" toml:"logging"`
+	Appearance         AppearanceConfig `mapstructure:"appearance" yaml:"appearance" toml:"appearance"`
+	Debug              DebugConfig      `mapstructure:"debug" yaml:"debug" toml:"debug"`
 	// DefaultWebpageZoom sets the default zoom level for pages without saved zoom settings (1.0 = 100%, 1.2 = 120%)
 	DefaultWebpageZoom float64 `mapstructure:"default_webpage_zoom" yaml:"default_webpage_zoom" toml:"default_webpage_zoom"`
 	// DefaultUIScale sets the default UI scale for GTK widgets (1.0 = 100%, 2.0 = 200%)
@@ -114,20 +232,57 @@ type Config struct {
 	Update UpdateConfig `mapstructure:"update" yaml:"update" toml:"update"`
 	// Downloads configures file download behavior.
 	Downloads DownloadsConfig `mapstructure:"downloads" yaml:"downloads" toml:"downloads"`
+	// Cache controls HTTP cache behavior for specific domains.
+	Cache CacheConfig `mapstructure:"cache" yaml:"cache" toml:"cache"`
+	// Zoom controls the zoom-in/out step, globally and per domain.
+	Zoom ZoomConfig `mapstructure:"zoom" yaml:"zoom" toml:"zoom"`
+	// Idle controls system idle inhibition during media playback.
+	Idle IdleConfig `mapstructure:"idle" yaml:"idle" toml:"idle"`
+	// ReadAloud configures the text-to-speech command that reads pages aloud.
+	ReadAloud ReadAloudConfig `mapstructure:"read_aloud" yaml:"read_aloud" toml:"read_aloud"`
+	// Translation controls the offer to translate pages in another language.
+	Translation TranslationConfig `mapstructure:"translation" yaml:"translation" toml:"translation"`
+	// Notifications configures the notification center that keeps recent toasts.
+	Notifications NotificationsConfig `mapstructure:"notifications" yaml:"notifications" toml:"notifications"`
+	// Network configures the network session, such as the proxy.
+	Network NetworkConfig `mapstructure:"network" yaml:"network" toml:"network"`
+	// Input controls pointer-driven behavior such as focus-follows-mouse.
+	Input InputConfig `mapstructure:"input" yaml:"input" toml:"input"`
+	// Homepage controls the widgets shown on the dumb://homepage dashboard.
+	Homepage HomepageConfig `mapstructure:"homepage" yaml:"homepage" toml:"homepage"`
+	// LinkStatus controls the hovered-link URL overlay at the bottom of panes.
+	LinkStatus LinkStatusConfig `mapstructure:"link_status" yaml:"link_status" toml:"link_status"`
+	// UserScripts controls Greasemonkey-style *.user.js injection.
+	UserScripts UserScriptsConfig `mapstructure:"user_scripts" yaml:"user_scripts" toml:"user_scripts"`
+	// RequestMocks names the file the ">mock" omnibox command reads mock responses from.
+	RequestMocks RequestMocksConfig `mapstructure:"request_mocks" yaml:"request_mocks" toml:"request_mocks"`
+	// PageEnv exposes allow-listed values to pages as window.__dumber_env.
+	PageEnv PageEnvConfig `mapstructure:"page_env" yaml:"page_env" toml:"page_env"`
+	// SafeMode controls the crash loop detector that starts dumber in safe mode.
+	SafeMode SafeModeConfig `mapstructure:"safe_mode" yaml:"safe_mode" toml:"safe_mode"`
+	// Window controls the browser window size and geometry persistence.
+	Window WindowConfig `mapstructure:"window" yaml:"window" toml:"window"`
+	// ScriptDialogs controls how JavaScript alert/confirm/prompt dialogs are handled.
+	ScriptDialogs ScriptDialogsConfig `mapstructure:"script_dialogs" yaml:"script_dialogs" toml:"script_dialogs"`
+	// Privacy holds page permission preferences.
+	Privacy PrivacyConfig `mapstructure:"privacy" yaml:"privacy" toml:"privacy"`
 	// Engine holds engine selection and unified engine options.
 	Engine EngineConfig `mapstructure:"engine" toml:"engine" yaml:"engine"`
 }
 
 // CookiePolicy controls cookie acceptance behavior.
-type CookiePolicy string
+type CookiePolicy = entity.CookiePolicy
+
+// CookiePolicyOverride overrides the cookie policy for a domain pattern.
+type CookiePolicyOverride = entity.CookiePolicyOverride
 
 const (
 	// CookiePolicyAlways accepts all cookies.
-	CookiePolicyAlways CookiePolicy = "always"
+	CookiePolicyAlways = entity.CookiePolicyAlways
 	// CookiePolicyNoThirdParty blocks third-party cookies.
-	CookiePolicyNoThirdParty CookiePolicy = "no_third_party"
+	CookiePolicyNoThirdParty = entity.CookiePolicyNoThirdParty
 	// CookiePolicyNever blocks all cookies.
-	CookiePolicyNever CookiePolicy = "never"
+	CookiePolicyNever = entity.CookiePolicyNever
 )
 
 // GSKRendererMode controls the GTK Scene Kit renderer selection.
@@ -152,6 +307,75 @@ const (
 	HardwareDecodingDisable HardwareDecodingMode = "disable"
 )
 
+// AutoplayPolicy controls whether media may start playing without a user gesture.
+type AutoplayPolicy = entity.AutoplayPolicy
+
+// AutoplayException overrides the autoplay policy for a domain pattern.
+type AutoplayException = entity.AutoplayException
+
+// ClipboardReadPolicy controls whether pages may read the clipboard.
+type ClipboardReadPolicy = entity.ClipboardReadPolicy
+
+const (
+	// ClipboardReadAllow lets pages read the clipboard.
+	ClipboardReadAllow = entity.ClipboardReadAllow
+	// ClipboardReadDeny blocks clipboard reads.
+	ClipboardReadDeny = entity.ClipboardReadDeny
+	// ClipboardReadPrompt asks before a page reads the clipboard.
+	ClipboardReadPrompt = entity.ClipboardReadPrompt
+)
+
+// ImageDisposition decides whether images opened as a page are shown or
+// downloaded.
+type ImageDisposition = entity.ImageDisposition
+
+const (
+	// ImagesInline shows images opened as a page.
+	ImagesInline = entity.ImageDispositionInline
+	// ImagesDownload downloads images opened as a page.
+	ImagesDownload = entity.ImageDispositionDownload
+)
+
+// HTTPSOnlyMode controls whether http:// pages are upgraded to https://.
+type HTTPSOnlyMode = entity.HTTPSOnlyMode
+
+const (
+	// HTTPSOnlyOff loads http:// pages as requested.
+	HTTPSOnlyOff = entity.HTTPSOnlyOff
+	// HTTPSOnlyLenient upgrades pages and falls back to http:// per host.
+	HTTPSOnlyLenient = entity.HTTPSOnlyLenient
+	// HTTPSOnlyStrict upgrades pages and never falls back.
+	HTTPSOnlyStrict = entity.HTTPSOnlyStrict
+)
+
+const (
+	// AutoplayAllow lets pages autoplay audible and muted media.
+	AutoplayAllow = entity.AutoplayPolicyAllow
+	// AutoplayBlock pauses any media started without a user gesture.
+	AutoplayBlock = entity.AutoplayPolicyBlock
+	// AutoplayBlockAudio lets muted media autoplay but blocks audible playback.
+	AutoplayBlockAudio = entity.AutoplayPolicyBlockAudio
+	// AutoplayMutedVideo lets video autoplay muted but blocks audio elements.
+	AutoplayMutedVideo = entity.AutoplayPolicyMutedVideo
+	// AutoplayBlockVideo lets audio elements autoplay but blocks video.
+	AutoplayBlockVideo = entity.AutoplayPolicyBlockVideo
+)
+
+// HomepageWidget names a dumb://homepage dashboard widget.
+type HomepageWidget = entity.HomepageWidget
+
+// HomepageConfig selects the dumb://homepage dashboard widgets.
+type HomepageConfig = entity.HomepageConfig
+
+const (
+	// HomepageWidgetSearch shows a search box using the default search engine.
+	HomepageWidgetSearch = entity.HomepageWidgetSearch
+	// HomepageWidgetRecentHistory lists recently visited pages.
+	HomepageWidgetRecentHistory = entity.HomepageWidgetRecentHistory
+	// HomepageWidgetTopFavorites lists favorites, shortcut-bound ones first.
+	HomepageWidgetTopFavorites = entity.HomepageWidgetTopFavorites
+)
+
 // GLRenderingMode controls OpenGL API selection for video rendering.
 type GLRenderingMode string
 
@@ -201,6 +425,15 @@ type MediaConfig struct {
 	PreferAV1 bool `mapstructure:"prefer_av1" yaml:"prefer_av1" toml:"prefer_av1"`
 	// ShowDiagnosticsOnStartup shows media capability warnings at startup
 	ShowDiagnosticsOnStartup bool `mapstructure:"show_diagnostics" yaml:"show_diagnostics" toml:"show_diagnostics"`
+	// AutoplayPolicy controls media autoplay without a user gesture.
+	// Values: "allow", "block", "block-audio" (default)
+	AutoplayPolicy AutoplayPolicy `mapstructure:"autoplay_policy" yaml:"autoplay_policy" toml:"autoplay_policy"`
+	// AutoplayExceptions overrides AutoplayPolicy per domain pattern.
+	// Entries are tables: { domain = "youtube.com", policy = "allow" }.
+	AutoplayExceptions []AutoplayException `mapstructure:"autoplay_exceptions" yaml:"autoplay_exceptions" toml:"autoplay_exceptions"`
+	// MuteOnFocusLoss mutes every pane while no browser window has the focus.
+	// Panes muted before the focus left stay muted when it comes back.
+	MuteOnFocusLoss bool `mapstructure:"mute_on_focus_loss" yaml:"mute_on_focus_loss" toml:"mute_on_focus_loss"`
 	// ForceVSync forces vertical sync for video playback (may help with tearing).
 	//
 	// Deprecated: moved to [engine.webkit]. Kept for read compatibility during migration.
@@ -226,6 +459,9 @@ type HistoryConfig struct {
 	MaxEntries          int `mapstructure:"max_entries" yaml:"max_entries" toml:"max_entries"`
 	RetentionPeriodDays int `mapstructure:"retention_period_days" yaml:"retention_period_days" toml:"retention_period_days"`
 	CleanupIntervalDays int `mapstructure:"cleanup_interval_days" yaml:"cleanup_interval_days" toml:"cleanup_interval_days"`
+	// PersistNavigationTree saves the dumb://navtree trees on exit and shows
+	// them again on the next start.
+	PersistNavigationTree bool `mapstructure:"persist_navigation_tree" yaml:"persist_navigation_tree" toml:"persist_navigation_tree"` //nolint:lll // struct tags must stay on one line
 }
 
 // PerformanceConfig holds internal performance tuning options.
@@ -348,8 +584,11 @@ type ContentFilteringConfig struct {
 	Enabled bool `mapstructure:"enabled" yaml:"enabled" toml:"enabled"`
 	// AutoUpdate controls whether filters are automatically updated (default: true)
 	AutoUpdate bool `mapstructure:"auto_update" yaml:"auto_update" toml:"auto_update"`
+	// DisabledDomains lists domain patterns loaded without content filtering
+	// ("example.com", "*.example.com").
+	DisabledDomains []string `mapstructure:"disabled_domains" yaml:"disabled_domains" toml:"disabled_domains"`
 	// Note: Filters are downloaded from bnema/ublock-webkit-filters GitHub releases
-	// Note: Whitelist is managed via database (content_whitelist table)
+	// Note: Sites toggled from the browser are stored in the database (content_whitelist table)
 }
 
 // ClipboardConfig holds clipboard-related behavior preferences
@@ -359,6 +598,10 @@ type ClipboardConfig struct {
 	// Does not apply to text selection in input fields or textareas.
 	// Default: true
 	AutoCopyOnSelection bool `mapstructure:"auto_copy_on_selection" yaml:"auto_copy_on_selection" toml:"auto_copy_on_selection" json:"autoCopyOnSelection"` //nolint:lll // struct tags must stay on one line
+	// URLHistorySize is how many copied URLs the clipboard history keeps
+	// (0-200). 0 keeps none.
+	// Default: 20
+	URLHistorySize int `mapstructure:"url_history_size" yaml:"url_history_size" toml:"url_history_size" json:"urlHistorySize"`
 }
 
 // OmniboxConfig holds omnibox behavior preferences
@@ -373,12 +616,308 @@ type OmniboxConfig struct {
 	// AutoOpenOnNewPane opens the omnibox automatically when a new pane is created.
 	// Default: false
 	AutoOpenOnNewPane bool `mapstructure:"auto_open_on_new_pane" yaml:"auto_open_on_new_pane" toml:"auto_open_on_new_pane"`
+	// MaxResults caps how many suggestions the omnibox fetches and lists.
+	// Default: 10
+	MaxResults int `mapstructure:"max_results" yaml:"max_results" toml:"max_results"`
+	// MinQueryLength is the number of characters to type before the omnibox
+	// searches history. Shorter queries keep the current suggestions.
+	// Default: 1
+	MinQueryLength int `mapstructure:"min_query_length" yaml:"min_query_length" toml:"min_query_length"`
+	// OpenInNewPane makes Enter open the selected result in a new pane
+	// instead of navigating the active one.
+	// Default: false
+	OpenInNewPane bool `mapstructure:"open_in_new_pane" yaml:"open_in_new_pane" toml:"open_in_new_pane"`
+	// TriggerKey is the shortcut that opens the omnibox, e.g. "ctrl+l".
+	// Default: "ctrl+l"
+	TriggerKey string `mapstructure:"trigger_key" yaml:"trigger_key" toml:"trigger_key"`
+	// OpenWithCurrentURL prefills the omnibox with the active page's URL,
+	// selected so typing replaces it. When false the omnibox opens empty.
+	// Default: false
+	OpenWithCurrentURL bool `mapstructure:"open_with_current_url" yaml:"open_with_current_url" toml:"open_with_current_url"`
+	// MatchMode controls how suggestions match the query.
+	// Values: "substring", "fuzzy" (characters in order), "prefix"
+	// Default: "substring"
+	MatchMode OmniboxMatchMode `mapstructure:"match_mode" yaml:"match_mode" toml:"match_mode"`
+	// NextResultKeys and PreviousResultKeys move the selection through the
+	// results like the arrow keys, e.g. "ctrl+j" and "ctrl+k". Each key needs
+	// Ctrl or Alt so plain typing is never captured.
+	// Default: ["ctrl+j"] and ["ctrl+k"]
+	NextResultKeys     []string `mapstructure:"next_result_keys" yaml:"next_result_keys" toml:"next_result_keys"`
+	PreviousResultKeys []string `mapstructure:"previous_result_keys" yaml:"previous_result_keys" toml:"previous_result_keys"`
 }
 
 // DebugConfig holds debug and troubleshooting options
 type DebugConfig struct {
 	// Enable browser developer tools (F12, Inspect Element in context menu)
 	EnableDevTools bool `mapstructure:"enable_devtools" yaml:"enable_devtools" toml:"enable_devtools"`
+	// ConsoleBufferSize is how many console messages are kept per pane for
+	// dumb://console. 0 (the default) disables console capture.
+	ConsoleBufferSize int `mapstructure:"console_buffer_size" yaml:"console_buffer_size" toml:"console_buffer_size"`
+	// StartupBudgets maps a startup phase, as named in the "startup timing"
+	// log line, to its budget in milliseconds. Slower phases log a warning.
+	StartupBudgets map[string]int `mapstructure:"startup_budgets" yaml:"startup_budgets" toml:"startup_budgets"`
+	// EnableCSPStripping arms StripCSPDomains. It exists so a domain list
+	// left in a config cannot silently disable CSP outside a debug session.
+	EnableCSPStripping bool `mapstructure:"enable_csp_stripping" yaml:"enable_csp_stripping" toml:"enable_csp_stripping"` //nolint:lll // struct tags must stay on one line
+	// StripCSPDomains lists domain patterns ("example.com", "*.example.com")
+	// whose pages load without their Content Security Policy. INSECURE: it
+	// removes the site's protection against injected scripts. CEF only.
+	StripCSPDomains []string `mapstructure:"strip_csp_domains" yaml:"strip_csp_domains" toml:"strip_csp_domains"`
+}
+
+// InputConfig holds pointer input preferences.
+type InputConfig struct {
+	// HoverFocusEnabled focuses a pane when the pointer rests over it.
+	HoverFocusEnabled bool `mapstructure:"hover_focus_enabled" yaml:"hover_focus_enabled" toml:"hover_focus_enabled"`
+	// HoverFocusDelayMs is how long the pointer must stay over a pane before
+	// it takes focus. Leaving the pane earlier cancels the switch.
+	HoverFocusDelayMs int `mapstructure:"hover_focus_delay_ms" yaml:"hover_focus_delay_ms" toml:"hover_focus_delay_ms"`
+	// MiddleClickClosesPane closes a pane on a middle click over its edge.
+	// Middle clicks on the page itself still open links.
+	MiddleClickClosesPane bool `mapstructure:"middle_click_closes_pane" yaml:"middle_click_closes_pane" toml:"middle_click_closes_pane"` //nolint:lll // struct tags must stay on one line
+	// ScrollMultiplier scales mouse wheel and touchpad scroll distance on web
+	// pages. 1.0 leaves scrolling to the engine.
+	ScrollMultiplier float64 `mapstructure:"scroll_multiplier" yaml:"scroll_multiplier" toml:"scroll_multiplier"`
+	// SmoothScrolling animates scrolls; false jumps straight to the target.
+	SmoothScrolling bool `mapstructure:"smooth_scrolling" yaml:"smooth_scrolling" toml:"smooth_scrolling"`
+	// TypeToFind opens the find bar when a printable key is typed on a page
+	// while no form field or editable content has focus.
+	TypeToFind bool `mapstructure:"type_to_find" yaml:"type_to_find" toml:"type_to_find"`
+	// AutofocusFirstField focuses the first visible text field of every page
+	// once it has loaded, unless the page already focused something.
+	AutofocusFirstField bool `mapstructure:"autofocus_first_field" yaml:"autofocus_first_field" toml:"autofocus_first_field"` //nolint:lll // struct tags must stay on one line
+	// AutofocusDomains autofocuses the first field only on matching domain
+	// patterns ("example.com", "*.example.com").
+	AutofocusDomains []string `mapstructure:"autofocus_domains" yaml:"autofocus_domains" toml:"autofocus_domains"`
+	// ConfirmCloseUnsavedForms asks before closing a pane whose page has
+	// form fields edited since it loaded.
+	ConfirmCloseUnsavedForms bool `mapstructure:"confirm_close_unsaved_forms" yaml:"confirm_close_unsaved_forms" toml:"confirm_close_unsaved_forms"` //nolint:lll // struct tags must stay on one line
+	// DisableGesturesDomains turns off the touchpad swipe back/forward
+	// gestures on matching domain patterns ("example.com", "*.example.com"),
+	// for web apps that use horizontal swipes themselves.
+	DisableGesturesDomains []string `mapstructure:"disable_gestures_domains" yaml:"disable_gestures_domains" toml:"disable_gestures_domains"` //nolint:lll // struct tags must stay on one line
+}
+
+// LinkStatusConfig holds hovered-link status overlay preferences.
+type LinkStatusConfig struct {
+	// Enabled shows the URL of the hovered link at the bottom of the pane.
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" toml:"enabled"`
+	// ShowDelayMs debounces rapid hover changes before the overlay first
+	// appears. Moving between links while it is visible updates it at once.
+	ShowDelayMs int `mapstructure:"show_delay_ms" yaml:"show_delay_ms" toml:"show_delay_ms"`
+	// MaxLength truncates longer URLs in the middle; 0 only limits them to
+	// the pane width.
+	MaxLength int `mapstructure:"max_length" yaml:"max_length" toml:"max_length"`
+}
+
+// UserScriptsConfig holds user script preferences.
+type UserScriptsConfig struct {
+	// Enabled loads *.user.js files from Directory and injects them into
+	// pages matching their @match/@include patterns.
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" toml:"enabled"`
+	// Directory holds the scripts. Empty means "userscripts" inside the
+	// config directory; a leading "~/" expands to the home directory.
+	Directory string `mapstructure:"directory" yaml:"directory" toml:"directory"`
+}
+
+// RequestMocksConfig holds the mock responses the ">mock" omnibox command
+// switches panes to.
+type RequestMocksConfig struct {
+	// File holds the mock sets. Empty means "mocks.toml" inside the config
+	// directory; a leading "~/" expands to the home directory.
+	File string `mapstructure:"file" yaml:"file" toml:"file"`
+}
+
+// PageEnvRule exposes the page_env values named by Keys to a domain pattern.
+type PageEnvRule = entity.PageEnvRule
+
+// PageEnvConfig holds the values exposed to pages as a frozen
+// window.__dumber_env object, and the domains allowed to see them.
+type PageEnvConfig struct {
+	// Values maps names to values. Names are lowercased when loaded.
+	Values map[string]string `mapstructure:"values" yaml:"values" toml:"values"`
+	// Domains lists which values each domain pattern receives. A value not
+	// named by a matching rule is never injected.
+	Domains []PageEnvRule `mapstructure:"domains" yaml:"domains" toml:"domains"`
+}
+
+// SafeModeConfig holds crash loop detection preferences. When the previous
+// runs crashed CrashThreshold times within CrashWindowMinutes, dumber starts
+// with content filtering, user scripts and GPU rendering disabled.
+type SafeModeConfig struct {
+	// CrashThreshold is the number of crashes that triggers safe mode.
+	// 0 disables crash loop detection.
+	CrashThreshold int `mapstructure:"crash_threshold" yaml:"crash_threshold" toml:"crash_threshold"`
+	// CrashWindowMinutes is how far back crashes are counted.
+	CrashWindowMinutes int `mapstructure:"crash_window_minutes" yaml:"crash_window_minutes" toml:"crash_window_minutes"`
+}
+
+// WindowConfig holds browser window preferences.
+type WindowConfig struct {
+	// RememberGeometry restores the last window size and maximized state on
+	// startup. GTK4 does not let applications place windows, so the position
+	// is left to the compositor.
+	RememberGeometry bool `mapstructure:"remember_geometry" yaml:"remember_geometry" toml:"remember_geometry"`
+	// DefaultWidth and DefaultHeight size new windows when no geometry is
+	// remembered.
+	DefaultWidth  int `mapstructure:"default_width" yaml:"default_width" toml:"default_width"`
+	DefaultHeight int `mapstructure:"default_height" yaml:"default_height" toml:"default_height"`
+}
+
+// ScriptDialogsConfig holds JavaScript dialog preferences.
+type ScriptDialogsConfig struct {
+	// AutoDismiss answers every alert, confirm, prompt and beforeunload
+	// dialog without showing it, for kiosk and automation setups.
+	AutoDismiss bool `mapstructure:"auto_dismiss" yaml:"auto_dismiss" toml:"auto_dismiss"`
+	// AutoDismissDomains auto-dismisses dialogs only on matching domain
+	// patterns ("example.com", "*.example.com").
+	AutoDismissDomains []string `mapstructure:"auto_dismiss_domains" yaml:"auto_dismiss_domains" toml:"auto_dismiss_domains"`
+}
+
+// PrivacyConfig holds page permission preferences. Cookie settings live
+// under [engine].
+type PrivacyConfig struct {
+	// ClipboardReadPolicy handles clipboard read requests from origins without
+	// a remembered decision: allow, deny or prompt.
+	ClipboardReadPolicy ClipboardReadPolicy `mapstructure:"clipboard_read_policy" yaml:"clipboard_read_policy" toml:"clipboard_read_policy"` //nolint:lll // struct tags must stay on one line
+	// ClearDataOnCloseDomains lists domain patterns whose cookies, storage and
+	// cache are removed when a pane showing them closes ("bank.example",
+	// "*.health.example").
+	ClearDataOnCloseDomains []string `mapstructure:"clear_data_on_close_domains" yaml:"clear_data_on_close_domains" toml:"clear_data_on_close_domains"` //nolint:lll // struct tags must stay on one line
+	// PanicNeutralURL is loaded in the active pane by the panic key before the
+	// windows are minimized. Empty only minimizes.
+	PanicNeutralURL string `mapstructure:"panic_neutral_url" yaml:"panic_neutral_url" toml:"panic_neutral_url"`
+	// PanicPIN (4-12 digits) locks the windows after the panic key until it
+	// is entered. Empty does not lock.
+	PanicPIN string `mapstructure:"panic_pin" yaml:"panic_pin" toml:"panic_pin"`
+	// HTTPSOnly upgrades http:// pages to https://: off, lenient (fall back
+	// to http:// for a host whose HTTPS load fails) or strict (never).
+	HTTPSOnly HTTPSOnlyMode `mapstructure:"https_only" yaml:"https_only" toml:"https_only"`
+}
+
+// CacheConfig holds HTTP cache preferences.
+type CacheConfig struct {
+	// AlwaysFreshDomains lists domain patterns whose pages are always loaded
+	// from the network, skipping the HTTP cache ("localhost:3000", "*.dev.local").
+	AlwaysFreshDomains []string `mapstructure:"always_fresh_domains" yaml:"always_fresh_domains" toml:"always_fresh_domains"`
+	// FaviconMaxMB caps the on-disk favicon cache; least recently used
+	// favicons are evicted at startup once it is exceeded. 0 = unlimited.
+	FaviconMaxMB int `mapstructure:"favicon_max_mb" yaml:"favicon_max_mb" toml:"favicon_max_mb"`
+	// FaviconMaxEntries caps the number of cached favicon domains. 0 = unlimited.
+	FaviconMaxEntries int `mapstructure:"favicon_max_entries" yaml:"favicon_max_entries" toml:"favicon_max_entries"`
+}
+
+// ZoomDomainOverride overrides the zoom step factor for a domain pattern.
+type ZoomDomainOverride = entity.ZoomDomainOverride
+
+// ZoomPreset sets the initial zoom level for a domain pattern.
+type ZoomPreset = entity.ZoomPreset
+
+// ZoomConfig holds zoom step preferences.
+type ZoomConfig struct {
+	// StepFactor is the factor applied by one zoom-in/out step (1.1 = 10%).
+	StepFactor float64 `mapstructure:"step_factor" yaml:"step_factor" toml:"step_factor"`
+	// DomainOverrides overrides StepFactor per domain pattern.
+	DomainOverrides []ZoomDomainOverride `mapstructure:"domain_overrides" yaml:"domain_overrides" toml:"domain_overrides"`
+	// Presets sets the zoom level of domains that have no saved zoom yet.
+	Presets []ZoomPreset `mapstructure:"presets" yaml:"presets" toml:"presets"`
+	// SessionOnlyDomains lists domain patterns whose zoom is kept until the
+	// browser quits instead of being saved.
+	SessionOnlyDomains []string `mapstructure:"session_only_domains" yaml:"session_only_domains" toml:"session_only_domains"`
+}
+
+// QuietHours is a daily "HH:MM" time range that may span midnight.
+type QuietHours = entity.QuietHours
+
+// IdleConfig holds idle inhibition preferences.
+type IdleConfig struct {
+	// QuietHours is the daily range during which media playback never keeps
+	// the screen awake. Empty start and end disable it.
+	QuietHours QuietHours `mapstructure:"quiet_hours" yaml:"quiet_hours" toml:"quiet_hours"`
+}
+
+// ReadAloudConfig holds read-aloud preferences.
+type ReadAloudConfig struct {
+	// Command is the text-to-speech program and its arguments, e.g.
+	// "espeak-ng -v en-us". It is run without a shell and reads the page
+	// text on stdin. Empty disables reading aloud.
+	Command string `mapstructure:"command" yaml:"command" toml:"command"`
+}
+
+// TranslationConfig holds the translate offer shown for foreign pages.
+type TranslationConfig struct {
+	// Prompt offers, with a toast, to translate pages whose language differs
+	// from PreferredLanguage.
+	Prompt bool `mapstructure:"prompt" yaml:"prompt" toml:"prompt"`
+	// PreferredLanguage is the language pages are translated to, e.g. "en"
+	// or "pt-BR". Empty uses the language of the system locale ($LANG).
+	PreferredLanguage string `mapstructure:"preferred_language" yaml:"preferred_language" toml:"preferred_language"`
+	// URLTemplate is the translation page: {url} is replaced by the escaped
+	// page address, {from} by the page language and {to} by the preferred one.
+	URLTemplate string `mapstructure:"url_template" yaml:"url_template" toml:"url_template"`
+	// IgnoredLanguages are page languages never offered for translation.
+	IgnoredLanguages []string `mapstructure:"ignored_languages" yaml:"ignored_languages" toml:"ignored_languages"`
+}
+
+// NotificationsConfig holds notification center preferences.
+type NotificationsConfig struct {
+	// HistorySize is how many recent toasts the notification center keeps
+	// (0-500). 0 keeps none.
+	HistorySize int `mapstructure:"history_size" yaml:"history_size" toml:"history_size"`
+}
+
+// NetworkConfig holds network session preferences.
+type NetworkConfig struct {
+	// Proxy routes page traffic through a proxy server.
+	Proxy ProxyConfig `mapstructure:"proxy" yaml:"proxy" toml:"proxy"`
+	// Retry reloads pages that failed on a transient network error.
+	Retry LoadRetryConfig `mapstructure:"retry" yaml:"retry" toml:"retry"`
+	// Throttle limits the request rate to domain patterns.
+	Throttle []RequestThrottleRule `mapstructure:"throttle" yaml:"throttle" toml:"throttle"`
+}
+
+// RequestThrottleRule limits the request rate to a domain pattern.
+type RequestThrottleRule = entity.RequestThrottleRule
+
+// LoadRetryConfig holds the automatic retry of failed loads. Only DNS,
+// connection, timeout and offline failures are retried; a server that
+// answered with an error page is not.
+type LoadRetryConfig struct {
+	// MaxAttempts caps the retries of one page. 0 disables retrying.
+	MaxAttempts int `mapstructure:"max_attempts" yaml:"max_attempts" toml:"max_attempts"`
+	// InitialDelayMs is the wait before the first retry; it doubles after each one.
+	InitialDelayMs int `mapstructure:"initial_delay_ms" yaml:"initial_delay_ms" toml:"initial_delay_ms"`
+	// MaxDelayMs caps the wait between two retries.
+	MaxDelayMs int `mapstructure:"max_delay_ms" yaml:"max_delay_ms" toml:"max_delay_ms"`
+}
+
+// ProxyConfig holds proxy preferences. The proxy is applied to the network
+// session when it is created, so changes take effect on the next start.
+type ProxyConfig struct {
+	// URL is the proxy used for all requests ("http://proxy.lan:3128",
+	// "socks5://127.0.0.1:1080"). Empty uses the system proxy settings.
+	URL string `mapstructure:"url" yaml:"url" toml:"url"`
+	// NoProxy lists hosts, IP addresses and CIDR ranges that bypass the proxy.
+	NoProxy []string `mapstructure:"no_proxy" yaml:"no_proxy" toml:"no_proxy"`
+	// Profiles overrides the proxy for one engine profile, keyed by engine
+	// ("webkit", "cef"). Each engine profile has its own network session.
+	Profiles map[string]ProxyProfileConfig `mapstructure:"profiles" yaml:"profiles" toml:"profiles"`
+}
+
+// ProxyProfileConfig replaces the proxy settings of one engine profile. An
+// empty URL makes that profile use the system proxy settings.
+type ProxyProfileConfig struct {
+	URL     string   `mapstructure:"url" yaml:"url" toml:"url"`
+	NoProxy []string `mapstructure:"no_proxy" yaml:"no_proxy" toml:"no_proxy"`
+}
+
+// ForProfile returns the proxy settings of the engine profile: its override
+// when there is one, else the global settings.
+func (p ProxyConfig) ForProfile(engine string) ProxyProfileConfig {
+	if override, ok := p.Profiles[engine]; ok {
+		return override
+	}
+	return ProxyProfileConfig{URL: p.URL, NoProxy: p.NoProxy}
 }
 
 // DownloadsConfig holds file download preferences.
@@ -386,4 +925,10 @@ type DownloadsConfig struct {
 	// Path is the directory where downloads are saved.
 	// Empty string means use XDG_DOWNLOAD_DIR or ~/Downloads.
 	Path string `mapstructure:"path" yaml:"path" toml:"path"`
+	// FilenameTemplate names downloaded files. Placeholders: {name}, {stem},
+	// {ext}, {host}, {date} and {time}.
+	FilenameTemplate string `mapstructure:"filename_template" yaml:"filename_template" toml:"filename_template"`
+	// Images decides what happens to an image opened as a page, such as a
+	// link to a .png: inline shows it, download saves it.
+	Images ImageDisposition `mapstructure:"images" yaml:"images" toml:"images"`
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/notification"
 )
 
 // Section names for grouping config keys.
@@ -27,6 +28,22 @@ const (
 	SectionDatabase         = "Database"
 	SectionSearch           = "Search"
 	SectionDownloads        = "Downloads"
+	SectionCache            = "Cache"
+	SectionInput            = "Input"
+	SectionZoom             = "Zoom"
+	SectionIdle             = "Idle"
+	SectionReadAloud        = "Read Aloud"
+	SectionTranslation      = "Translation"
+	SectionNotifications    = "Notifications"
+	SectionNetwork          = "Network"
+	SectionHomepage         = "Homepage"
+	SectionLinkStatus       = "Link Status"
+	SectionUserScripts      = "User Scripts"
+	SectionRequestMocks     = "Request Mocks"
+	SectionPageEnv          = "Page Env"
+	SectionSafeMode         = "Safe Mode"
+	SectionWindow           = "Window"
+	SectionScriptDialogs    = "Script Dialogs"
 )
 
 // SchemaProvider implements port.ConfigSchemaProvider.
@@ -100,6 +117,54 @@ func (p *SchemaProvider) GetSchema() []entity.ConfigKeyInfo {
 	// Downloads section
 	keys = append(keys, p.getDownloadsKeys(defaults)...)
 
+	// Cache section
+	keys = append(keys, p.getCacheKeys(defaults)...)
+
+	// Zoom section
+	keys = append(keys, p.getZoomKeys(defaults)...)
+
+	// Idle section
+	keys = append(keys, p.getIdleKeys(defaults)...)
+
+	// Read aloud section
+	keys = append(keys, p.getReadAloudKeys(defaults)...)
+
+	// Translation section
+	keys = append(keys, p.getTranslationKeys(defaults)...)
+
+	// Notifications section
+	keys = append(keys, p.getNotificationsKeys(defaults)...)
+
+	// Network section
+	keys = append(keys, p.getNetworkKeys(defaults)...)
+
+	// Input section
+	keys = append(keys, p.getInputKeys(defaults)...)
+
+	// Homepage section
+	keys = append(keys, p.getHomepageKeys(defaults)...)
+
+	// Link status section
+	keys = append(keys, p.getLinkStatusKeys(defaults)...)
+
+	// User scripts section
+	keys = append(keys, p.getUserScriptsKeys(defaults)...)
+
+	// Request mocks section
+	keys = append(keys, p.getRequestMocksKeys()...)
+
+	// Page env section
+	keys = append(keys, p.getPageEnvKeys()...)
+
+	// Safe mode section
+	keys = append(keys, p.getSafeModeKeys(defaults)...)
+
+	// Window section
+	keys = append(keys, p.getWindowKeys(defaults)...)
+
+	// Script dialogs section
+	keys = append(keys, p.getScriptDialogsKeys(defaults)...)
+
 	return keys
 }
 
@@ -113,6 +178,68 @@ func (*SchemaProvider) getAppearanceKeys(defaults *Config) []entity.ConfigKeyInf
 			Values:      []string{"default", "prefer-dark", "prefer-light"},
 			Section:     SectionAppearance,
 		},
+		{
+			Key:         "appearance.favicon_fallback",
+			Type:        "string",
+			Default:     string(defaults.Appearance.FaviconFallback),
+			Description: "What to show for sites without a favicon",
+			Values:      []string{"monogram", "icon"},
+			Section:     SectionAppearance,
+		},
+		{
+			Key:         "appearance.force_dark_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domain patterns that always get a dark-mode stylesheet",
+			Section:     SectionAppearance,
+		},
+		{
+			Key:         "appearance.force_dark_style",
+			Type:        "string",
+			Default:     string(defaults.Appearance.ForceDarkStyle),
+			Description: "Stylesheet used on forced-dark sites",
+			Values:      []string{string(ForceDarkStyleInvert), string(ForceDarkStyleCSS)},
+			Section:     SectionAppearance,
+		},
+		{
+			Key:         "appearance.workspace_background.color",
+			Type:        "string",
+			Default:     defaults.Appearance.WorkspaceBackground.Color,
+			Description: "Hex color of the empty workspace areas (empty = theme background)",
+			Section:     SectionAppearance,
+		},
+		{
+			Key:         "appearance.workspace_background.image",
+			Type:        "string",
+			Default:     defaults.Appearance.WorkspaceBackground.Image,
+			Description: "Image drawn behind the panes, scaled to cover the workspace",
+			Section:     SectionAppearance,
+		},
+		{
+			Key:         "appearance.workspace_background.pattern",
+			Type:        "string",
+			Default:     string(defaults.Appearance.WorkspaceBackground.Pattern),
+			Description: "Subtle pattern drawn over the workspace background",
+			Values: []string{
+				string(WorkspaceBackgroundPatternNone), string(WorkspaceBackgroundPatternDots),
+				string(WorkspaceBackgroundPatternGrid), string(WorkspaceBackgroundPatternStripes),
+			},
+			Section: SectionAppearance,
+		},
+		{
+			Key:         "appearance.reading_progress",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Appearance.ReadingProgress),
+			Description: "Show how far the page is scrolled with a thin bar at the top of each pane",
+			Section:     SectionAppearance,
+		},
+		{
+			Key:         "appearance.font_overrides",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Fonts per site as { domain, sans, serif, mono, size }; the most specific domain wins",
+			Section:     SectionAppearance,
+		},
 		{
 			Key:         "appearance.default_font_size",
 			Type:        "int",
@@ -311,6 +438,13 @@ func (*SchemaProvider) getHistoryKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Range:       ">=0",
 			Section:     SectionHistory,
 		},
+		{
+			Key:         "history.persist_navigation_tree",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.History.PersistNavigationTree),
+			Description: "Keep the dumb://navtree navigation trees across restarts",
+			Section:     SectionHistory,
+		},
 	}
 }
 
@@ -330,6 +464,20 @@ func (*SchemaProvider) getSearchKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Description: "Search shortcuts map with url and description fields",
 			Section:     SectionSearch,
 		},
+		{
+			Key:         "multi_search_engines",
+			Type:        "[]string",
+			Default:     `["ddg", "g", "w"]`,
+			Description: "Search shortcut keys the >multi omnibox command opens side by side",
+			Section:     SectionSearch,
+		},
+		{
+			Key:         "search_selection_in_new_pane",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.SearchSelectionInNewPane),
+			Description: "Open Search for Selection results in a new pane instead of the active one",
+			Section:     SectionSearch,
+		},
 	}
 }
 
@@ -412,6 +560,14 @@ func (*SchemaProvider) getWorkspaceKeys(defaults *Config) []entity.ConfigKeyInfo
 			Values:      []string{"top", "bottom"},
 			Section:     SectionWorkspace,
 		},
+		{
+			Key:         "workspace.tab_bar_mode",
+			Type:        "string",
+			Default:     string(defaults.Workspace.TabBarMode),
+			Description: "When the tab strip is shown",
+			Values:      []string{"bar", "hidden", "overview"},
+			Section:     SectionWorkspace,
+		},
 		{
 			Key:         "workspace.hide_tab_bar_when_single_tab",
 			Type:        "bool",
@@ -426,6 +582,97 @@ func (*SchemaProvider) getWorkspaceKeys(defaults *Config) []entity.ConfigKeyInfo
 			Description: "Switch focus to tab when moving pane to it",
 			Section:     SectionWorkspace,
 		},
+		{
+			Key:         "workspace.split_inherits_url",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Workspace.SplitInheritsURL),
+			Description: "Open the current page in new split panes instead of new_pane_url",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.auto_balance_on_split",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Workspace.AutoBalanceOnSplit),
+			Description: "Reset every split of the tab to an even share after each split",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.stack_swipe",
+			Type:        "string",
+			Default:     string(defaults.Workspace.StackSwipe),
+			Description: "Which vertical two-finger swipes cycle the panes of a stack",
+			Values:      []string{"title_bar", "alt", "off"},
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.reload_on_focus_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domain patterns whose panes reload when refocused after being unfocused for a while",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.reload_on_focus_after_seconds",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Workspace.ReloadOnFocusAfterSeconds),
+			Description: "Seconds a reload_on_focus_domains pane must stay unfocused before refocusing reloads it",
+			Range:       ">= 0",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.freeze_background_panes",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Workspace.FreezeBackgroundPanes),
+			Description: "Pause animations and lower the frame rate of unfocused panes to save CPU",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.reload_crashed_panes",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Workspace.ReloadCrashedPanes),
+			Description: "Reload a page once when its web process crashes instead of showing the crash page",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.closed_tab_history_depth",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Workspace.ClosedTabHistoryDepth),
+			Description: "How many closed tabs reopen_closed_tab can bring back (0 disables)",
+			Range:       "0-100",
+			Section:     SectionWorkspace,
+		},
+		// Focus timer
+		{
+			Key:         "workspace.focus_timer.duration_minutes",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Workspace.FocusTimer.DurationMinutes),
+			Description: "How long the focus timer started by toggle_focus_timer runs",
+			Range:       "1-1440",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.focus_timer.warning_seconds",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Workspace.FocusTimer.WarningSeconds),
+			Description: "Seconds before the end of a focus timer to warn (0 disables the warning)",
+			Range:       ">=0",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.focus_timer.action",
+			Type:        "string",
+			Default:     string(defaults.Workspace.FocusTimer.Action),
+			Description: "What happens to the pane when its focus timer runs out",
+			Values:      []string{"warn", "close", "navigate"},
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.focus_timer.navigate_url",
+			Type:        "string",
+			Default:     defaults.Workspace.FocusTimer.NavigateURL,
+			Description: "Page loaded in the pane when the focus timer action is navigate",
+			Section:     SectionWorkspace,
+		},
 		// Pane mode
 		{
 			Key:         "workspace.pane_mode.activation_shortcut",
@@ -509,6 +756,36 @@ func (*SchemaProvider) getWorkspaceKeys(defaults *Config) []entity.ConfigKeyInfo
 			Description: "Key bindings for resize mode actions",
 			Section:     SectionWorkspace,
 		},
+		// Leader key
+		{
+			Key:         "workspace.leader.activation_shortcut",
+			Type:        "string",
+			Default:     "(empty = disabled)",
+			Description: "Leader key that starts a key sequence",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.leader.timeout_ms",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Workspace.Leader.TimeoutMilliseconds),
+			Description: "How long to wait for the follow-up key in milliseconds (0 = no timeout)",
+			Range:       ">=0",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.leader.show_hints",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Workspace.Leader.ShowHints),
+			Description: "Show the available follow-up keys after the leader key",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.leader.actions.<action>",
+			Type:        "[]string",
+			Default:     "(see defaults)",
+			Description: "Follow-up keys for leader actions",
+			Section:     SectionWorkspace,
+		},
 		// Global shortcuts - managed via dedicated keybindings UI
 		{
 			Key:         "workspace.shortcuts.actions",
@@ -555,7 +832,7 @@ func (*SchemaProvider) getWorkspaceKeys(defaults *Config) []entity.ConfigKeyInfo
 			Type:        "string",
 			Default:     defaults.Workspace.BrowsingContexts.Placement,
 			Description: "Placement direction for split popups",
-			Values:      []string{"right", "left", "top", "bottom"},
+			Values:      []string{"right", "left", "top", "bottom", "auto"},
 			Section:     SectionWorkspace,
 		},
 		{
@@ -566,6 +843,14 @@ func (*SchemaProvider) getWorkspaceKeys(defaults *Config) []entity.ConfigKeyInfo
 			Values:      []string{"split", "stacked", "tabbed"},
 			Section:     SectionWorkspace,
 		},
+		{
+			Key:         "workspace.browsing_contexts.default_for_links",
+			Type:        "string",
+			Default:     string(defaults.Workspace.BrowsingContexts.DefaultForLinks),
+			Description: "Placement for every non-OAuth link and popup; empty keeps the per-kind settings",
+			Values:      []string{"", "split", "stacked", "tabbed"},
+			Section:     SectionWorkspace,
+		},
 		{
 			Key:         "workspace.browsing_contexts.open_in_new_pane",
 			Type:        "bool",
@@ -594,6 +879,34 @@ func (*SchemaProvider) getWorkspaceKeys(defaults *Config) []entity.ConfigKeyInfo
 			Description: "Auto-close OAuth popups after success",
 			Section:     SectionWorkspace,
 		},
+		{
+			Key:         "workspace.browsing_contexts.popup_block_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Opener domains whose script-opened windows are suppressed; supports *.example.com",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.browsing_contexts.popup_allow_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Opener domains whose windows are always allowed, overriding popup_block_domains",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.browsing_contexts.notify_blocked_popups",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Workspace.BrowsingContexts.NotifyBlockedPopups),
+			Description: "Show a toast when a popup is suppressed",
+			Section:     SectionWorkspace,
+		},
+		{
+			Key:         "workspace.browsing_contexts.domain_placement",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Split direction per popup domain as { domain, placement } (right, left, top, bottom, auto); overrides placement",
+			Section:     SectionWorkspace,
+		},
 		// Styling
 		{
 			Key:         "workspace.styling.border_width",
@@ -673,6 +986,29 @@ func (*SchemaProvider) getSessionKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Description: "Automatically restore last session on startup",
 			Section:     SectionSession,
 		},
+		{
+			Key:         "session.restore_mode",
+			Type:        "string",
+			Default:     string(defaults.Session.RestoreMode),
+			Description: "Which tabs auto-restore brings back from the last session",
+			Values:      []string{"all", "pinned"},
+			Section:     SectionSession,
+		},
+		{
+			Key:         "session.restore_strategy",
+			Type:        "string",
+			Default:     string(defaults.Session.RestoreStrategy),
+			Description: "Where a session opened from the session manager goes: its own instance or the current window",
+			Values:      []string{"replace", "merge"},
+			Section:     SectionSession,
+		},
+		{
+			Key:         "session.lazy_restore",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Session.LazyRestore),
+			Description: "Load the background tabs of a restored session only when they are first shown",
+			Section:     SectionSession,
+		},
 		{
 			Key:         "session.snapshot_interval_ms",
 			Type:        "int",
@@ -751,6 +1087,69 @@ func (*SchemaProvider) getOmniboxKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Description: "Auto-open omnibox when creating new pane",
 			Section:     SectionOmnibox,
 		},
+		{
+			Key:         "omnibox.max_results",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Omnibox.MaxResults),
+			Description: "Maximum number of suggestions shown",
+			Range:       fmt.Sprintf("1-%d", maxOmniboxMaxResults),
+			Section:     SectionOmnibox,
+		},
+		{
+			Key:         "omnibox.min_query_length",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Omnibox.MinQueryLength),
+			Description: "Characters to type before searching history",
+			Range:       ">=0",
+			Section:     SectionOmnibox,
+		},
+		{
+			Key:         "omnibox.open_in_new_pane",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Omnibox.OpenInNewPane),
+			Description: "Open the selected result in a new pane instead of the active one",
+			Section:     SectionOmnibox,
+		},
+		{
+			Key:         "omnibox.trigger_key",
+			Type:        "string",
+			Default:     defaults.Omnibox.TriggerKey,
+			Description: "Shortcut that opens the omnibox",
+			Section:     SectionOmnibox,
+		},
+		{
+			Key:         "omnibox.next_result_keys",
+			Type:        "[]string",
+			Default:     `["ctrl+j"]`,
+			Description: "Keys that select the next result, like Down (need ctrl or alt)",
+			Section:     SectionOmnibox,
+		},
+		{
+			Key:         "omnibox.previous_result_keys",
+			Type:        "[]string",
+			Default:     `["ctrl+k"]`,
+			Description: "Keys that select the previous result, like Up (need ctrl or alt)",
+			Section:     SectionOmnibox,
+		},
+		{
+			Key:         "omnibox.open_with_current_url",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Omnibox.OpenWithCurrentURL),
+			Description: "Prefill the omnibox with the current URL, selected",
+			Section:     SectionOmnibox,
+		},
+		{
+			Key:         "omnibox.match_mode",
+			Type:        "string",
+			Default:     string(defaults.Omnibox.MatchMode),
+			Description: "How suggestions match the query",
+			Values: []string{
+				string(OmniboxMatchModeSubstring),
+				string(OmniboxMatchModeFuzzy),
+				string(OmniboxMatchModePrefix),
+			},
+			Section: SectionOmnibox,
+		},
 	}
 }
 
@@ -770,6 +1169,13 @@ func (*SchemaProvider) getContentFilteringKeys(defaults *Config) []entity.Config
 			Description: "Auto-update filter lists from GitHub",
 			Section:     SectionContentFiltering,
 		},
+		{
+			Key:         "content_filtering.disabled_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domains loaded without content filtering (supports *.example.com)",
+			Section:     SectionContentFiltering,
+		},
 	}
 }
 
@@ -782,6 +1188,14 @@ func (*SchemaProvider) getClipboardKeys(defaults *Config) []entity.ConfigKeyInfo
 			Description: "Auto-copy selected text to clipboard (zellij-style)",
 			Section:     SectionClipboard,
 		},
+		{
+			Key:         "clipboard.url_history_size",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Clipboard.URLHistorySize),
+			Description: "Copied URLs kept by the clipboard history (0 keeps none)",
+			Range:       fmt.Sprintf("0-%d", maxClipboardURLHistorySize),
+			Section:     SectionClipboard,
+		},
 	}
 }
 
@@ -893,6 +1307,28 @@ func (*SchemaProvider) getMediaKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Description: "Show media capability warnings at startup",
 			Section:     SectionMedia,
 		},
+		{
+			Key:         "media.autoplay_policy",
+			Type:        "string",
+			Default:     string(defaults.Media.AutoplayPolicy),
+			Description: "Media autoplay without a user gesture",
+			Values:      []string{"allow", "block", "block-audio", "muted-video", "block-video"},
+			Section:     SectionMedia,
+		},
+		{
+			Key:         "media.autoplay_exceptions",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Per-domain autoplay overrides: [{domain, policy}] (domain supports *.example.com)",
+			Section:     SectionMedia,
+		},
+		{
+			Key:         "media.mute_on_focus_loss",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Media.MuteOnFocusLoss),
+			Description: "Mute every pane while no browser window has the focus; user-muted panes stay muted",
+			Section:     SectionMedia,
+		},
 		{
 			Key:         "engine.webkit.force_vsync",
 			Type:        "bool",
@@ -929,6 +1365,13 @@ func (*SchemaProvider) getPrivacyKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Values:      []string{"always", "no_third_party", "never"},
 			Section:     SectionPrivacy,
 		},
+		{
+			Key:         "engine.cookie_policy_overrides",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Per-domain cookie policy overrides: [{domain, policy}] (domain supports *.example.com)",
+			Section:     SectionPrivacy,
+		},
 		{
 			Key:         "engine.webkit.itp_enabled",
 			Type:        "bool",
@@ -936,6 +1379,43 @@ func (*SchemaProvider) getPrivacyKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Description: "Enable WebKit fallback Intelligent Tracking Prevention",
 			Section:     SectionPrivacy,
 		},
+		{
+			Key:         "privacy.clipboard_read_policy",
+			Type:        "string",
+			Default:     string(defaults.Privacy.ClipboardReadPolicy),
+			Description: "Clipboard reads by pages without a remembered decision",
+			Values:      []string{"allow", "deny", "prompt"},
+			Section:     SectionPrivacy,
+		},
+		{
+			Key:         "privacy.clear_data_on_close_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domains whose cookies, storage and cache are cleared when their pane closes (supports *.example.com)",
+			Section:     SectionPrivacy,
+		},
+		{
+			Key:         "privacy.panic_neutral_url",
+			Type:        "string",
+			Default:     "",
+			Description: "Page the panic key loads in the active pane before minimizing (empty only minimizes)",
+			Section:     SectionPrivacy,
+		},
+		{
+			Key:         "privacy.panic_pin",
+			Type:        "string",
+			Default:     "",
+			Description: "PIN (4-12 digits) asked to reopen the browser after the panic key (empty does not lock)",
+			Section:     SectionPrivacy,
+		},
+		{
+			Key:         "privacy.https_only",
+			Type:        "string",
+			Default:     string(defaults.Privacy.HTTPSOnly),
+			Description: "Upgrade http:// pages to https://; lenient falls back to http:// per host, strict never does",
+			Values:      []string{"off", "lenient", "strict"},
+			Section:     SectionPrivacy,
+		},
 	}
 }
 
@@ -974,6 +1454,36 @@ func (*SchemaProvider) getDebugKeys(defaults *Config) []entity.ConfigKeyInfo {
 			Description: "Enable browser developer tools (F12)",
 			Section:     SectionDebug,
 		},
+		{
+			Key:         "debug.console_buffer_size",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Debug.ConsoleBufferSize),
+			Description: "Console messages kept per pane for dumb://console (0 disables capture)",
+			Range:       "0-5000",
+			Section:     SectionDebug,
+		},
+		{
+			Key:         "debug.startup_budgets.<phase>",
+			Type:        "int",
+			Default:     "(none)",
+			Description: "Startup phase budget in milliseconds; slower phases log a warning",
+			Range:       ">0",
+			Section:     SectionDebug,
+		},
+		{
+			Key:         "debug.enable_csp_stripping",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Debug.EnableCSPStripping),
+			Description: "INSECURE: arm debug.strip_csp_domains",
+			Section:     SectionDebug,
+		},
+		{
+			Key:         "debug.strip_csp_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "INSECURE: domains whose pages load without their Content Security Policy (CEF only)",
+			Section:     SectionDebug,
+		},
 		{
 			Key:         "engine.cef.log_file",
 			Type:        "string",
@@ -1039,6 +1549,13 @@ func (*SchemaProvider) getPerformanceKeys(defaults *Config) []entity.ConfigKeyIn
 			Values:      []string{"vulkan", "egl"},
 			Section:     SectionPerformance,
 		},
+		{
+			Key:         "engine.cef.disable_gpu",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Engine.CEF.DisableGPU),
+			Description: "Render CEF pages in software, without GPU rasterization or compositing",
+			Section:     SectionPerformance,
+		},
 		{
 			Key:         "engine.cef.adaptive_windowless_frame_rate",
 			Type:        "bool",
@@ -1254,7 +1771,7 @@ func (*SchemaProvider) getDatabaseKeys() []entity.ConfigKeyInfo {
 	}
 }
 
-func (*SchemaProvider) getDownloadsKeys(_ *Config) []entity.ConfigKeyInfo {
+func (*SchemaProvider) getDownloadsKeys(defaults *Config) []entity.ConfigKeyInfo {
 	return []entity.ConfigKeyInfo{
 		{
 			Key:         "downloads.path",
@@ -1263,5 +1780,467 @@ func (*SchemaProvider) getDownloadsKeys(_ *Config) []entity.ConfigKeyInfo {
 			Description: "Directory where downloads are saved",
 			Section:     SectionDownloads,
 		},
+		{
+			Key:         "downloads.filename_template",
+			Type:        "string",
+			Default:     defaults.Downloads.FilenameTemplate,
+			Description: "Name for downloaded files; placeholders {name}, {stem}, {ext}, {host}, {date}, {time}",
+			Section:     SectionDownloads,
+		},
+		{
+			Key:         "downloads.images",
+			Type:        "string",
+			Default:     string(defaults.Downloads.Images),
+			Description: "Show images opened as a page, or download them (WebKit only)",
+			Values:      []string{"inline", "download"},
+			Section:     SectionDownloads,
+		},
+	}
+}
+
+func (*SchemaProvider) getCacheKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "cache.always_fresh_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domains always loaded from the network, skipping the HTTP cache (supports *.example.com)",
+			Section:     SectionCache,
+		},
+		{
+			Key:         "cache.favicon_max_mb",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Cache.FaviconMaxMB),
+			Description: "Maximum favicon cache size on disk in MB; least recently used favicons are evicted at startup (0 = unlimited)",
+			Range:       ">= 0",
+			Section:     SectionCache,
+		},
+		{
+			Key:         "cache.favicon_max_entries",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Cache.FaviconMaxEntries),
+			Description: "Maximum number of cached favicon domains (0 = unlimited)",
+			Range:       ">= 0",
+			Section:     SectionCache,
+		},
+	}
+}
+
+func (*SchemaProvider) getZoomKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "zoom.step_factor",
+			Type:        "float64",
+			Default:     fmt.Sprintf("%.2f", defaults.Zoom.StepFactor),
+			Description: "Factor applied by one zoom-in/out step (1.1 = 10% per step)",
+			Range:       "1.01-2.0",
+			Section:     SectionZoom,
+		},
+		{
+			Key:         "zoom.domain_overrides",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Per-domain step factors as {domain, step_factor} (supports *.example.com)",
+			Section:     SectionZoom,
+		},
+		{
+			Key:         "zoom.presets",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Initial zoom as {domain, factor} for domains without a saved zoom (supports *.example.com)",
+			Section:     SectionZoom,
+		},
+		{
+			Key:         "zoom.session_only_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domains whose zoom is not saved and resets on the next launch (supports *.example.com)",
+			Section:     SectionZoom,
+		},
+	}
+}
+
+func (*SchemaProvider) getIdleKeys(_ *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "idle.quiet_hours.start",
+			Type:        "string",
+			Default:     "(empty = disabled)",
+			Description: "Start of the daily range (HH:MM) during which media never keeps the screen awake",
+			Section:     SectionIdle,
+		},
+		{
+			Key:         "idle.quiet_hours.end",
+			Type:        "string",
+			Default:     "(empty = disabled)",
+			Description: "End of the quiet hours range (HH:MM); earlier than start spans midnight",
+			Section:     SectionIdle,
+		},
+	}
+}
+
+func (*SchemaProvider) getReadAloudKeys(_ *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "read_aloud.command",
+			Type:        "string",
+			Default:     "(empty = disabled)",
+			Description: "Text-to-speech command reading the page text on stdin, run without a shell (e.g. espeak-ng)",
+			Section:     SectionReadAloud,
+		},
+	}
+}
+
+func (*SchemaProvider) getTranslationKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "translation.prompt",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Translation.Prompt),
+			Description: "Offer to translate pages whose language differs from the preferred language",
+			Section:     SectionTranslation,
+		},
+		{
+			Key:         "translation.preferred_language",
+			Type:        "string",
+			Default:     "(empty = system locale)",
+			Description: "Language pages are translated to, e.g. en or pt-BR",
+			Section:     SectionTranslation,
+		},
+		{
+			Key:         "translation.url_template",
+			Type:        "string",
+			Default:     defaults.Translation.URLTemplate,
+			Description: "Translation page URL; {url} is the escaped page address, {from} and {to} the languages",
+			Section:     SectionTranslation,
+		},
+		{
+			Key:         "translation.ignored_languages",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Page languages never offered for translation",
+			Section:     SectionTranslation,
+		},
+	}
+}
+
+func (*SchemaProvider) getNotificationsKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "notifications.history_size",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Notifications.HistorySize),
+			Description: "Recent toasts kept by the notification center (0 keeps none)",
+			Range:       fmt.Sprintf("0-%d", notification.MaxCapacity),
+			Section:     SectionNotifications,
+		},
+	}
+}
+
+func (*SchemaProvider) getNetworkKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "network.proxy.url",
+			Type:        "string",
+			Default:     "(empty = system proxy settings)",
+			Description: "Proxy for all requests (http, https, socks, socks4, socks5); applied when the network session is created",
+			Section:     SectionNetwork,
+		},
+		{
+			Key:         "network.proxy.no_proxy",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Hosts, IP addresses and CIDR ranges that bypass the proxy (example.com also matches its subdomains)",
+			Section:     SectionNetwork,
+		},
+		{
+			Key:         "network.proxy.profiles.<engine>",
+			Type:        "object",
+			Default:     "(none)",
+			Description: "Proxy url and no_proxy replacing the global ones for the webkit or cef engine profile",
+			Section:     SectionNetwork,
+		},
+		{
+			Key:         "network.retry.max_attempts",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Network.Retry.MaxAttempts),
+			Description: "Retries of a page that failed on a DNS, connection or timeout error (0 = off)",
+			Range:       fmt.Sprintf("0-%d", maxLoadRetryAttempts),
+			Section:     SectionNetwork,
+		},
+		{
+			Key:         "network.retry.initial_delay_ms",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Network.Retry.InitialDelayMs),
+			Description: "Wait before the first retry; doubles after each retry",
+			Range:       fmt.Sprintf("%d-%d", minLoadRetryDelayMs, maxLoadRetryDelayMs),
+			Section:     SectionNetwork,
+		},
+		{
+			Key:         "network.retry.max_delay_ms",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Network.Retry.MaxDelayMs),
+			Description: "Longest wait between two retries",
+			Range:       fmt.Sprintf("%d-%d", minLoadRetryDelayMs, maxLoadRetryDelayMs),
+			Section:     SectionNetwork,
+		},
+		{
+			Key:         "network.throttle",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Per-domain request rate limits as {domain, rate, burst}; rate is requests per second",
+			Section:     SectionNetwork,
+		},
+	}
+}
+
+func (*SchemaProvider) getInputKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "input.hover_focus_enabled",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Input.HoverFocusEnabled),
+			Description: "Focus a pane when the pointer rests over it",
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.hover_focus_delay_ms",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Input.HoverFocusDelayMs),
+			Description: "Delay before a hovered pane takes focus",
+			Range:       fmt.Sprintf("0-%d", maxHoverFocusDelayMs),
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.middle_click_closes_pane",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Input.MiddleClickClosesPane),
+			Description: "Close a pane with a middle click on its edge",
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.scroll_multiplier",
+			Type:        "float",
+			Default:     fmt.Sprintf("%.1f", defaults.Input.ScrollMultiplier),
+			Description: "Scale wheel and touchpad scroll distance on web pages",
+			Range:       fmt.Sprintf("%.1f-%.1f", minScrollMultiplier, maxScrollMultiplier),
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.smooth_scrolling",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Input.SmoothScrolling),
+			Description: "Animate scrolling instead of jumping",
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.type_to_find",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Input.TypeToFind),
+			Description: "Open the find bar when typing on a page outside form fields",
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.autofocus_first_field",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Input.AutofocusFirstField),
+			Description: "Focus the first visible text field once a page has loaded",
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.autofocus_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domains whose first text field is focused on load (supports *.example.com)",
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.disable_gestures_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domains where touchpad swipes don't go back or forward (supports *.example.com)",
+			Section:     SectionInput,
+		},
+		{
+			Key:         "input.confirm_close_unsaved_forms",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Input.ConfirmCloseUnsavedForms),
+			Description: "Ask before closing a pane whose page has edited form fields",
+			Section:     SectionInput,
+		},
+	}
+}
+
+func (*SchemaProvider) getHomepageKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "homepage.widgets",
+			Type:        "[]string",
+			Default:     `["search", "recent_history", "top_favorites"]`,
+			Description: "Widgets shown on dumb://homepage, in display order",
+			Values:      []string{"search", "recent_history", "top_favorites"},
+			Section:     SectionHomepage,
+		},
+		{
+			Key:         "homepage.recent_history_limit",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Homepage.RecentHistoryLimit),
+			Description: "Number of recent pages shown by the recent_history widget",
+			Range:       fmt.Sprintf("1-%d", maxHomepageWidgetItems),
+			Section:     SectionHomepage,
+		},
+		{
+			Key:         "homepage.top_favorites_limit",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Homepage.TopFavoritesLimit),
+			Description: "Number of favorites shown by the top_favorites widget",
+			Range:       fmt.Sprintf("1-%d", maxHomepageWidgetItems),
+			Section:     SectionHomepage,
+		},
+	}
+}
+
+func (*SchemaProvider) getLinkStatusKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "link_status.enabled",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.LinkStatus.Enabled),
+			Description: "Show the hovered link URL at the bottom of the pane",
+			Section:     SectionLinkStatus,
+		},
+		{
+			Key:         "link_status.show_delay_ms",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.LinkStatus.ShowDelayMs),
+			Description: "Delay before the overlay first appears while hovering links",
+			Range:       fmt.Sprintf("0-%d", maxLinkStatusShowDelayMs),
+			Section:     SectionLinkStatus,
+		},
+		{
+			Key:         "link_status.max_length",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.LinkStatus.MaxLength),
+			Description: "Truncate longer URLs in the middle (0 = pane width only)",
+			Range:       fmt.Sprintf("0-%d", maxLinkStatusLength),
+			Section:     SectionLinkStatus,
+		},
+	}
+}
+
+func (*SchemaProvider) getUserScriptsKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "user_scripts.enabled",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.UserScripts.Enabled),
+			Description: "Inject *.user.js scripts into pages matching their @match patterns",
+			Section:     SectionUserScripts,
+		},
+		{
+			Key:         "user_scripts.directory",
+			Type:        "string",
+			Default:     "(empty = <config dir>/userscripts)",
+			Description: "Directory scanned for *.user.js files; reloaded on change",
+			Section:     SectionUserScripts,
+		},
+	}
+}
+
+func (*SchemaProvider) getRequestMocksKeys() []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "request_mocks.file",
+			Type:        "string",
+			Default:     "(empty = <config dir>/mocks.toml)",
+			Description: "TOML file of mock response sets for the >mock omnibox command (WebKit only)",
+			Section:     SectionRequestMocks,
+		},
+	}
+}
+
+func (*SchemaProvider) getPageEnvKeys() []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "page_env.values.<name>",
+			Type:        "string",
+			Default:     "(none)",
+			Description: "Value exposed as window.__dumber_env.<name> to domains that list it",
+			Section:     SectionPageEnv,
+		},
+		{
+			Key:         "page_env.domains",
+			Type:        "[]object",
+			Default:     "[]",
+			Description: "Values each domain receives: [{domain, keys}] (domain supports *.example.com)",
+			Section:     SectionPageEnv,
+		},
+	}
+}
+
+func (*SchemaProvider) getSafeModeKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "safe_mode.crash_threshold",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.SafeMode.CrashThreshold),
+			Description: "Crashes within the window that start dumber in safe mode (0 = disabled)",
+			Section:     SectionSafeMode,
+		},
+		{
+			Key:         "safe_mode.crash_window_minutes",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.SafeMode.CrashWindowMinutes),
+			Description: "How far back crashes are counted, in minutes",
+			Section:     SectionSafeMode,
+			Range:       "1+",
+		},
+	}
+}
+
+func (*SchemaProvider) getWindowKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "window.remember_geometry",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.Window.RememberGeometry),
+			Description: "Restore the last window size and maximized state on startup",
+			Section:     SectionWindow,
+		},
+		{
+			Key:         "window.default_width",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Window.DefaultWidth),
+			Description: "Width of new windows when no geometry is remembered, in pixels",
+			Section:     SectionWindow,
+			Range:       fmt.Sprintf("%d+", entity.MinWindowWidth),
+		},
+		{
+			Key:         "window.default_height",
+			Type:        "int",
+			Default:     fmt.Sprintf("%d", defaults.Window.DefaultHeight),
+			Description: "Height of new windows when no geometry is remembered, in pixels",
+			Section:     SectionWindow,
+			Range:       fmt.Sprintf("%d+", entity.MinWindowHeight),
+		},
+	}
+}
+
+func (*SchemaProvider) getScriptDialogsKeys(defaults *Config) []entity.ConfigKeyInfo {
+	return []entity.ConfigKeyInfo{
+		{
+			Key:         "script_dialogs.auto_dismiss",
+			Type:        "bool",
+			Default:     fmt.Sprintf("%t", defaults.ScriptDialogs.AutoDismiss),
+			Description: "Answer JavaScript alert/confirm/prompt/beforeunload dialogs without showing them",
+			Section:     SectionScriptDialogs,
+		},
+		{
+			Key:         "script_dialogs.auto_dismiss_domains",
+			Type:        "[]string",
+			Default:     "[]",
+			Description: "Domains whose JavaScript dialogs are auto-dismissed (supports *.example.com)",
+			Section:     SectionScriptDialogs,
+		},
 	}
 }
@@ -147,8 +147,11 @@ func TestSchemaProviderCoversViperDefaults(t *testing.T) {
 		"workspace.pane_mode.actions":      "workspace.pane_mode.actions.<action>",
 		"workspace.tab_mode.actions":       "workspace.tab_mode.actions.<action>",
 		"workspace.resize_mode.actions":    "workspace.resize_mode.actions.<action>",
+		"workspace.leader.actions":         "workspace.leader.actions.<action>",
 		"workspace.floating_pane.profiles": "workspace.floating_pane.profiles.<name>",
 		"session.session_mode.actions":     "session.session_mode.actions.<action>",
+		"debug.startup_budgets":            "debug.startup_budgets.<phase>",
+		"page_env.values":                  "page_env.values.<name>",
 	}
 
 	var missing []string
@@ -9,12 +9,65 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/bnema/dumber/internal/domain/download"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/multisearch"
+	"github.com/bnema/dumber/internal/domain/notification"
+	"github.com/bnema/dumber/internal/domain/panickey"
+	"github.com/bnema/dumber/internal/domain/readaloud"
+	"github.com/bnema/dumber/internal/domain/translation"
 	domainurl "github.com/bnema/dumber/internal/domain/url"
 	domainvalidation "github.com/bnema/dumber/internal/domain/validation"
 )
 
 const cefLogSeverityDisabled = 99
 
+// maxHoverFocusDelayMs caps input.hover_focus_delay_ms; longer delays make
+// focus-follows-mouse feel broken rather than deliberate.
+const maxHoverFocusDelayMs = 5000
+
+// network.retry bounds: past them a dead site keeps a pane busy for minutes.
+const (
+	maxLoadRetryAttempts = 10
+	minLoadRetryDelayMs  = 100
+	maxLoadRetryDelayMs  = 60000
+)
+
+// network.throttle bounds: a rule slower than one request a minute stalls
+// pages, and past 1000 requests a second a rule throttles nothing.
+const (
+	minThrottleRate  = 1.0 / 60
+	maxThrottleRate  = 1000.0
+	maxThrottleBurst = 1000
+)
+
+// input.scroll_multiplier bounds; outside them a single wheel notch either
+// barely moves the page or skips whole screens.
+const (
+	minScrollMultiplier = 0.1
+	maxScrollMultiplier = 10.0
+)
+
+// maxHomepageWidgetItems caps the homepage dashboard list widgets.
+const maxHomepageWidgetItems = 50
+
+// maxLinkStatusShowDelayMs caps link_status.show_delay_ms; the overlay is
+// useless if it appears after the pointer has already moved on.
+const maxLinkStatusShowDelayMs = 2000
+
+// maxLinkStatusLength caps link_status.max_length.
+const maxLinkStatusLength = 500
+
+// maxClipboardURLHistorySize caps clipboard.url_history_size.
+const maxClipboardURLHistorySize = 200
+
+// maxConsoleBufferSize caps debug.console_buffer_size per pane.
+const maxConsoleBufferSize = 5000
+
+// maxClosedTabHistoryDepth caps workspace.closed_tab_history_depth; each
+// entry keeps a whole pane tree in memory.
+const maxClosedTabHistoryDepth = 100
+
 // validateConfig performs comprehensive validation of configuration values
 func validateConfig(config *Config) error {
 	var validationErrors []string
@@ -23,10 +76,16 @@ func validateConfig(config *Config) error {
 	validationErrors = append(validationErrors, validateDmenu(config)...)
 	validationErrors = append(validationErrors, validateAppearance(config)...)
 	validationErrors = append(validationErrors, validateSearchEngine(config)...)
+	validationErrors = append(validationErrors, validateMultiSearchEngines(config)...)
 	validationErrors = append(validationErrors, validatePopups(config)...)
 	validationErrors = append(validationErrors, validateWorkspaceStyling(config)...)
 	validationErrors = append(validationErrors, validatePaneMode(config)...)
+	validationErrors = append(validationErrors, validateLeader(config)...)
 	validationErrors = append(validationErrors, validateTabBar(config)...)
+	validationErrors = append(validationErrors, validateStackSwipe(config)...)
+	validationErrors = append(validationErrors, validateReloadOnFocus(config)...)
+	validationErrors = append(validationErrors, validateClosedTabHistory(config)...)
+	validationErrors = append(validationErrors, validateFocusTimer(config)...)
 	validationErrors = append(validationErrors, validateTabMode(config)...)
 	validationErrors = append(validationErrors, validateFloatingPane(config)...)
 	validationErrors = append(validationErrors, validateLogging(config)...)
@@ -36,9 +95,31 @@ func validateConfig(config *Config) error {
 	validationErrors = append(validationErrors, validateRendering(config)...)
 	validationErrors = append(validationErrors, validatePrivacy(config)...)
 	validationErrors = append(validationErrors, validateColorScheme(config)...)
+	validationErrors = append(validationErrors, validateFaviconFallback(config)...)
+	validationErrors = append(validationErrors, validateForceDark(config)...)
+	validationErrors = append(validationErrors, validateWorkspaceBackground(config)...)
 	validationErrors = append(validationErrors, validateSession(config)...)
 	validationErrors = append(validationErrors, validatePerformanceProfile(config)...)
 	validationErrors = append(validationErrors, validateCEF(config)...)
+	validationErrors = append(validationErrors, validateMedia(config)...)
+	validationErrors = append(validationErrors, validateCache(config)...)
+	validationErrors = append(validationErrors, validateContentFiltering(config)...)
+	validationErrors = append(validationErrors, validateZoom(config)...)
+	validationErrors = append(validationErrors, validateDownloads(config)...)
+	validationErrors = append(validationErrors, validateIdle(config)...)
+	validationErrors = append(validationErrors, validateReadAloud(config)...)
+	validationErrors = append(validationErrors, validateTranslation(config)...)
+	validationErrors = append(validationErrors, validateClipboard(config)...)
+	validationErrors = append(validationErrors, validateNotifications(config)...)
+	validationErrors = append(validationErrors, validateNetwork(config)...)
+	validationErrors = append(validationErrors, validateInput(config)...)
+	validationErrors = append(validationErrors, validateHomepage(config)...)
+	validationErrors = append(validationErrors, validateLinkStatus(config)...)
+	validationErrors = append(validationErrors, validatePageEnv(config)...)
+	validationErrors = append(validationErrors, validateSafeMode(config)...)
+	validationErrors = append(validationErrors, validateWindow(config)...)
+	validationErrors = append(validationErrors, validateScriptDialogs(config)...)
+	validationErrors = append(validationErrors, validateDebug(config)...)
 
 	// If there are validation errors, return them
 	if len(validationErrors) > 0 {
@@ -111,6 +192,36 @@ func validateAppearance(config *Config) []string {
 		validationErrors = append(validationErrors, "sidebar_width must be between 280 and 380, or 0 for default")
 	}
 	validationErrors = append(validationErrors, validateExternalTheme(config)...)
+	validationErrors = append(validationErrors, validateFontOverrides(config.Appearance.FontOverrides)...)
+	return validationErrors
+}
+
+func validateFontOverrides(overrides []FontOverride) []string {
+	var validationErrors []string
+	for i, fonts := range overrides {
+		field := fmt.Sprintf("appearance.font_overrides[%d]", i)
+		if strings.TrimSpace(fonts.Domain) == "" {
+			validationErrors = append(validationErrors, field+".domain must not be empty")
+		}
+		if fonts.KeepsDefaults() {
+			validationErrors = append(validationErrors, field+" must set sans, serif, mono or size")
+			continue
+		}
+		if fonts.DefaultFontSize < 0 || fonts.DefaultFontSize > 72 {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"%s.size must be between 1 and 72, or 0 for the default (got: %d)", field, fonts.DefaultFontSize,
+			))
+		}
+		for _, family := range []struct{ key, value string }{
+			{"sans", fonts.SansFont},
+			{"serif", fonts.SerifFont},
+			{"mono", fonts.MonospaceFont},
+		} {
+			if family.value != "" {
+				validationErrors = append(validationErrors, domainvalidation.ValidateFontFamily(field+"."+family.key, family.value)...)
+			}
+		}
+	}
 	return validationErrors
 }
 
@@ -152,6 +263,33 @@ func validateSearchEngine(config *Config) []string {
 	return nil
 }
 
+func validateMultiSearchEngines(config *Config) []string {
+	var validationErrors []string
+	if len(config.MultiSearchEngines) > multisearch.MaxEngines {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"multi_search_engines can list at most %d engines (got: %d)",
+			multisearch.MaxEngines, len(config.MultiSearchEngines),
+		))
+	}
+	for _, key := range config.MultiSearchEngines {
+		if !hasSearchShortcut(config.SearchShortcuts, key) {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"multi_search_engines: %q is not a search_shortcuts key", key,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func hasSearchShortcut(shortcuts map[string]SearchShortcut, key string) bool {
+	for candidate := range shortcuts {
+		if strings.EqualFold(candidate, key) {
+			return true
+		}
+	}
+	return false
+}
+
 func validatePopups(config *Config) []string {
 	var validationErrors []string
 	// Validate against BrowsingContexts (canonical field).
@@ -167,10 +305,10 @@ func validatePopups(config *Config) []string {
 
 	if config.Workspace.BrowsingContexts.Behavior == PopupBehaviorSplit {
 		switch config.Workspace.BrowsingContexts.Placement {
-		case "right", "left", "top", "bottom":
+		case "right", "left", "top", "bottom", "auto":
 		default:
 			validationErrors = append(validationErrors, fmt.Sprintf(
-				"workspace.browsing_contexts.placement must be one of: right, left, top, bottom (got: %s)",
+				"workspace.browsing_contexts.placement must be one of: right, left, top, bottom, auto (got: %s)",
 				config.Workspace.BrowsingContexts.Placement,
 			))
 		}
@@ -184,6 +322,45 @@ func validatePopups(config *Config) []string {
 			config.Workspace.BrowsingContexts.BlankTargetBehavior,
 		))
 	}
+
+	switch config.Workspace.BrowsingContexts.DefaultForLinks {
+	case "", PopupBehaviorSplit, PopupBehaviorStacked, PopupBehaviorTabbed:
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"workspace.browsing_contexts.default_for_links must be empty or one of: split, stacked, tabbed (got: %s)",
+			config.Workspace.BrowsingContexts.DefaultForLinks,
+		))
+	}
+
+	for i, domain := range config.Workspace.BrowsingContexts.PopupBlockDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"workspace.browsing_contexts.popup_block_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	for i, domain := range config.Workspace.BrowsingContexts.PopupAllowDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"workspace.browsing_contexts.popup_allow_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	for i, rule := range config.Workspace.BrowsingContexts.DomainPlacement {
+		if strings.TrimSpace(rule.Domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"workspace.browsing_contexts.domain_placement[%d].domain must not be empty", i,
+			))
+		}
+		switch rule.Placement {
+		case "right", "left", "top", "bottom", "auto":
+		default:
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"workspace.browsing_contexts.domain_placement[%d].placement must be one of: right, left, top, bottom, auto (got: %s)",
+				i, rule.Placement,
+			))
+		}
+	}
 	return validationErrors
 }
 
@@ -230,13 +407,112 @@ func validatePaneMode(config *Config) []string {
 	return validationErrors
 }
 
+func validateLeader(config *Config) []string {
+	var validationErrors []string
+	if config.Workspace.Leader.TimeoutMilliseconds < 0 {
+		validationErrors = append(validationErrors, "workspace.leader.timeout_ms must be non-negative")
+	}
+
+	seenKeys := make(map[string]string)
+	for action, binding := range config.Workspace.Leader.Actions {
+		for _, key := range binding.Keys {
+			if existingAction, exists := seenKeys[key]; exists {
+				validationErrors = append(validationErrors, fmt.Sprintf(
+					"duplicate key binding '%s' found in leader actions '%s' and '%s'",
+					key,
+					existingAction,
+					action,
+				))
+			}
+			seenKeys[key] = action
+		}
+	}
+	return validationErrors
+}
+
 func validateTabBar(config *Config) []string {
+	var validationErrors []string
 	switch config.Workspace.TabBarPosition {
 	case "top", "bottom":
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"workspace.tab_bar_position must be 'top' or 'bottom' (got: %s)", config.Workspace.TabBarPosition,
+		))
+	}
+	switch config.Workspace.TabBarMode {
+	case TabBarModeBar, TabBarModeHidden, TabBarModeOverview:
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"workspace.tab_bar_mode must be 'bar', 'hidden' or 'overview' (got: %s)", config.Workspace.TabBarMode,
+		))
+	}
+	return validationErrors
+}
+
+func validateStackSwipe(config *Config) []string {
+	switch config.Workspace.StackSwipe {
+	case StackSwipeTitleBar, StackSwipeAlt, StackSwipeOff:
 		return nil
 	default:
-		return []string{fmt.Sprintf("workspace.tab_bar_position must be 'top' or 'bottom' (got: %s)", config.Workspace.TabBarPosition)}
+		return []string{fmt.Sprintf(
+			"workspace.stack_swipe must be 'title_bar', 'alt' or 'off' (got: %s)", config.Workspace.StackSwipe,
+		)}
+	}
+}
+
+func validateReloadOnFocus(config *Config) []string {
+	var validationErrors []string
+	for i, domain := range config.Workspace.ReloadOnFocusDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"workspace.reload_on_focus_domains[%d] must not be empty", i,
+			))
+		}
 	}
+	if config.Workspace.ReloadOnFocusAfterSeconds < 0 {
+		validationErrors = append(validationErrors, "workspace.reload_on_focus_after_seconds must be non-negative")
+	}
+	return validationErrors
+}
+
+func validateClosedTabHistory(config *Config) []string {
+	depth := config.Workspace.ClosedTabHistoryDepth
+	if depth < 0 || depth > maxClosedTabHistoryDepth {
+		return []string{fmt.Sprintf(
+			"workspace.closed_tab_history_depth must be between 0 and %d (got: %d)", maxClosedTabHistoryDepth, depth,
+		)}
+	}
+	return nil
+}
+
+// maxFocusTimerMinutes caps workspace.focus_timer.duration_minutes at a day.
+const maxFocusTimerMinutes = 24 * 60
+
+func validateFocusTimer(config *Config) []string {
+	timer := config.Workspace.FocusTimer
+	var validationErrors []string
+	if timer.DurationMinutes < 1 || timer.DurationMinutes > maxFocusTimerMinutes {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"workspace.focus_timer.duration_minutes must be between 1 and %d (got: %d)",
+			maxFocusTimerMinutes, timer.DurationMinutes,
+		))
+	}
+	if timer.WarningSeconds < 0 {
+		validationErrors = append(validationErrors, "workspace.focus_timer.warning_seconds must be non-negative")
+	}
+	switch timer.Action {
+	case FocusTimerWarn, FocusTimerClose:
+	case FocusTimerNavigate:
+		if strings.TrimSpace(timer.NavigateURL) == "" {
+			validationErrors = append(validationErrors,
+				"workspace.focus_timer.navigate_url must not be empty when action is 'navigate'")
+		}
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"workspace.focus_timer.action must be 'warn', 'close' or 'navigate' (got: %s)", timer.Action,
+		))
+	}
+	return validationErrors
 }
 
 func validateTabMode(config *Config) []string {
@@ -542,6 +818,25 @@ func validateOmnibox(config *Config) []string {
 	if config.Omnibox.MostVisitedDays < 0 {
 		validationErrors = append(validationErrors, "omnibox.most_visited_days must be non-negative")
 	}
+	if config.Omnibox.MaxResults < 1 || config.Omnibox.MaxResults > maxOmniboxMaxResults {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"omnibox.max_results must be between 1 and %d (got: %d)",
+			maxOmniboxMaxResults, config.Omnibox.MaxResults,
+		))
+	}
+	if config.Omnibox.MinQueryLength < 0 {
+		validationErrors = append(validationErrors, "omnibox.min_query_length must be non-negative")
+	}
+	if trigger := strings.TrimSpace(config.Omnibox.TriggerKey); trigger == "" || strings.ContainsAny(trigger, " \t") {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"omnibox.trigger_key must be a single key combination such as ctrl+l (got: %q)",
+			config.Omnibox.TriggerKey,
+		))
+	}
+	validationErrors = append(validationErrors,
+		validateOmniboxResultKeys("omnibox.next_result_keys", config.Omnibox.NextResultKeys)...)
+	validationErrors = append(validationErrors,
+		validateOmniboxResultKeys("omnibox.previous_result_keys", config.Omnibox.PreviousResultKeys)...)
 	switch config.Omnibox.InitialBehavior {
 	case OmniboxInitialBehaviorRecent, OmniboxInitialBehaviorMostVisited, OmniboxInitialBehaviorNone:
 	default:
@@ -553,9 +848,54 @@ func validateOmnibox(config *Config) []string {
 			config.Omnibox.InitialBehavior,
 		))
 	}
+	switch config.Omnibox.MatchMode {
+	case OmniboxMatchModeSubstring, OmniboxMatchModeFuzzy, OmniboxMatchModePrefix:
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"omnibox.match_mode must be one of: %s, %s, %s (got: %s)",
+			OmniboxMatchModeSubstring,
+			OmniboxMatchModeFuzzy,
+			OmniboxMatchModePrefix,
+			config.Omnibox.MatchMode,
+		))
+	}
 	return validationErrors
 }
 
+// validateOmniboxResultKeys checks that every result navigation key is a
+// single combination with Ctrl or Alt, so it never swallows typed text.
+func validateOmniboxResultKeys(field string, keys []string) []string {
+	var validationErrors []string
+	for i, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" || strings.ContainsAny(key, " \t") {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"%s[%d] must be a single key combination such as ctrl+j (got: %q)", field, i, key,
+			))
+			continue
+		}
+		if !keyUsesCtrlOrAlt(key) {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"%s[%d] must use ctrl or alt so typing is not captured (got: %q)", field, i, key,
+			))
+		}
+	}
+	return validationErrors
+}
+
+// keyUsesCtrlOrAlt reports whether a key combination like "ctrl+j" holds
+// Ctrl or Alt.
+func keyUsesCtrlOrAlt(key string) bool {
+	parts := strings.Split(strings.ToLower(key), "+")
+	for _, part := range parts[:len(parts)-1] {
+		switch strings.TrimSpace(part) {
+		case "ctrl", "control", "alt":
+			return true
+		}
+	}
+	return false
+}
+
 func validateEngine(config *Config) []string {
 	switch config.Engine.Type {
 	case EngineTypeCEF, EngineTypeWebKit:
@@ -612,15 +952,60 @@ func validateRendering(config *Config) []string {
 }
 
 func validatePrivacy(config *Config) []string {
+	var validationErrors []string
 	switch config.Engine.CookiePolicy {
 	case CookiePolicyAlways, CookiePolicyNoThirdParty, CookiePolicyNever, "":
-		return nil
 	default:
-		return []string{fmt.Sprintf(
+		validationErrors = append(validationErrors, fmt.Sprintf(
 			"engine.cookie_policy must be one of: always, no_third_party, never (got: %s)",
 			config.Engine.CookiePolicy,
-		)}
+		))
+	}
+	for i, override := range config.Engine.CookiePolicyOverrides {
+		if strings.TrimSpace(override.Domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"engine.cookie_policy_overrides[%d].domain must not be empty", i,
+			))
+		}
+		if !override.Policy.IsValid() {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"engine.cookie_policy_overrides[%d].policy must be one of: always, no_third_party, never (got: %s)",
+				i, override.Policy,
+			))
+		}
+	}
+	if !config.Privacy.ClipboardReadPolicy.IsValid() {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"privacy.clipboard_read_policy must be one of: allow, deny, prompt (got: %s)",
+			config.Privacy.ClipboardReadPolicy,
+		))
+	}
+	for i, domain := range config.Privacy.ClearDataOnCloseDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"privacy.clear_data_on_close_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	if neutral := config.Privacy.PanicNeutralURL; neutral != "" {
+		if parsed, err := url.Parse(neutral); err != nil || parsed.Scheme == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"privacy.panic_neutral_url must be an absolute URL (got: %s)", neutral,
+			))
+		}
+	}
+	if pin := config.Privacy.PanicPIN; pin != "" && !panickey.ValidPIN(pin) {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"privacy.panic_pin must be %d to %d digits", panickey.MinPINLength, panickey.MaxPINLength,
+		))
+	}
+	if !config.Privacy.HTTPSOnly.IsValid() {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"privacy.https_only must be one of: off, lenient, strict (got: %s)",
+			config.Privacy.HTTPSOnly,
+		))
 	}
+	return validationErrors
 }
 
 func validateColorScheme(config *Config) []string {
@@ -635,6 +1020,58 @@ func validateColorScheme(config *Config) []string {
 	}
 }
 
+func validateFaviconFallback(config *Config) []string {
+	switch config.Appearance.FaviconFallback {
+	case FaviconFallbackMonogram, FaviconFallbackIcon, "":
+		return nil
+	default:
+		return []string{fmt.Sprintf(
+			"appearance.favicon_fallback must be one of: monogram, icon (got: %s)",
+			config.Appearance.FaviconFallback,
+		)}
+	}
+}
+
+func validateForceDark(config *Config) []string {
+	var validationErrors []string
+	for i, domain := range config.Appearance.ForceDarkDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"appearance.force_dark_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	switch config.Appearance.ForceDarkStyle {
+	case ForceDarkStyleInvert, ForceDarkStyleCSS, "":
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"appearance.force_dark_style must be one of: invert, css (got: %s)",
+			config.Appearance.ForceDarkStyle,
+		))
+	}
+	return validationErrors
+}
+
+func validateWorkspaceBackground(config *Config) []string {
+	var validationErrors []string
+	bg := config.Appearance.WorkspaceBackground
+	if bg.Color != "" && !domainvalidation.IsHexColor(bg.Color) {
+		validationErrors = append(validationErrors, "appearance.workspace_background.color must be a hex color like #RRGGBB")
+	}
+	if bg.Image != "" && !filepath.IsAbs(bg.Image) && !strings.HasPrefix(bg.Image, "~/") {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"appearance.workspace_background.image must be an absolute path or start with ~/ (got: %s)", bg.Image,
+		))
+	}
+	if bg.Pattern != "" && !bg.Pattern.IsValid() {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"appearance.workspace_background.pattern must be one of: none, dots, grid, stripes (got: %s)",
+			bg.Pattern,
+		))
+	}
+	return validationErrors
+}
+
 func validateSession(config *Config) []string {
 	var validationErrors []string
 	if config.Session.MaxExitedSessions < 0 {
@@ -646,6 +1083,20 @@ func validateSession(config *Config) []string {
 	if config.Session.SnapshotIntervalMs < 0 {
 		validationErrors = append(validationErrors, "session.snapshot_interval_ms must be non-negative")
 	}
+	switch config.Session.RestoreMode {
+	case SessionRestoreAll, SessionRestorePinned:
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"session.restore_mode must be 'all' or 'pinned' (got: %s)", config.Session.RestoreMode,
+		))
+	}
+	switch config.Session.RestoreStrategy {
+	case SessionRestoreReplace, SessionRestoreMerge:
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"session.restore_strategy must be 'replace' or 'merge' (got: %s)", config.Session.RestoreStrategy,
+		))
+	}
 	return validationErrors
 }
 
@@ -699,3 +1150,428 @@ func validatePerformanceProfile(config *Config) []string {
 
 	return validationErrors
 }
+
+func validateMedia(config *Config) []string {
+	var validationErrors []string
+	if !config.Media.AutoplayPolicy.IsValid() {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"media.autoplay_policy must be one of: allow, block, block-audio, muted-video, block-video (got: %s)",
+			config.Media.AutoplayPolicy,
+		))
+	}
+	for i, exception := range config.Media.AutoplayExceptions {
+		if strings.TrimSpace(exception.Domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"media.autoplay_exceptions[%d].domain must not be empty", i,
+			))
+		}
+		if !exception.Policy.IsValid() {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"media.autoplay_exceptions[%d].policy must be one of: allow, block, block-audio, muted-video, block-video (got: %s)",
+				i, exception.Policy,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func validateCache(config *Config) []string {
+	var validationErrors []string
+	for i, domain := range config.Cache.AlwaysFreshDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"cache.always_fresh_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	if config.Cache.FaviconMaxMB < 0 {
+		validationErrors = append(validationErrors, "cache.favicon_max_mb must be non-negative")
+	}
+	if config.Cache.FaviconMaxEntries < 0 {
+		validationErrors = append(validationErrors, "cache.favicon_max_entries must be non-negative")
+	}
+	return validationErrors
+}
+
+func validateContentFiltering(config *Config) []string {
+	var validationErrors []string
+	for i, domain := range config.ContentFiltering.DisabledDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"content_filtering.disabled_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func validateZoom(config *Config) []string {
+	var validationErrors []string
+	if !entity.ValidZoomStepFactor(config.Zoom.StepFactor) {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"zoom.step_factor must be between %.2f and %.1f (got: %g)",
+			entity.ZoomStepFactorMin, entity.ZoomStepFactorMax, config.Zoom.StepFactor,
+		))
+	}
+	for i, override := range config.Zoom.DomainOverrides {
+		if strings.TrimSpace(override.Domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"zoom.domain_overrides[%d].domain must not be empty", i,
+			))
+		}
+		if !entity.ValidZoomStepFactor(override.StepFactor) {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"zoom.domain_overrides[%d].step_factor must be between %.2f and %.1f (got: %g)",
+				i, entity.ZoomStepFactorMin, entity.ZoomStepFactorMax, override.StepFactor,
+			))
+		}
+	}
+	for i, preset := range config.Zoom.Presets {
+		if strings.TrimSpace(preset.Domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"zoom.presets[%d].domain must not be empty", i,
+			))
+		}
+		if preset.Factor < entity.ZoomMin || preset.Factor > entity.ZoomMax {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"zoom.presets[%d].factor must be between %.2f and %.1f (got: %g)",
+				i, entity.ZoomMin, entity.ZoomMax, preset.Factor,
+			))
+		}
+	}
+	for i, domain := range config.Zoom.SessionOnlyDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"zoom.session_only_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func validateDownloads(config *Config) []string {
+	var validationErrors []string
+	if err := download.ValidateFilenameTemplate(config.Downloads.FilenameTemplate); err != nil {
+		validationErrors = append(validationErrors, fmt.Sprintf("downloads.filename_template: %v", err))
+	}
+	if !config.Downloads.Images.IsValid() {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"downloads.images must be one of: inline, download (got: %s)", config.Downloads.Images,
+		))
+	}
+	return validationErrors
+}
+
+func validateIdle(config *Config) []string {
+	if err := config.Idle.QuietHours.Validate(); err != nil {
+		return []string{fmt.Sprintf("idle.quiet_hours %v", err)}
+	}
+	return nil
+}
+
+func validateReadAloud(config *Config) []string {
+	if strings.TrimSpace(config.ReadAloud.Command) == "" {
+		return nil
+	}
+	if _, err := readaloud.ParseCommand(config.ReadAloud.Command); err != nil {
+		return []string{fmt.Sprintf("read_aloud.command: %v", err)}
+	}
+	return nil
+}
+
+func validateTranslation(config *Config) []string {
+	var validationErrors []string
+	if lang := config.Translation.PreferredLanguage; lang != "" && translation.NormalizeLanguage(lang) == "" {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"translation.preferred_language must be a language code like 'en' or 'pt-BR' (got: %s)", lang,
+		))
+	}
+	if err := translation.ValidateURLTemplate(config.Translation.URLTemplate); err != nil {
+		validationErrors = append(validationErrors, fmt.Sprintf("translation.url_template %v", err))
+	}
+	for i, lang := range config.Translation.IgnoredLanguages {
+		if translation.NormalizeLanguage(lang) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"translation.ignored_languages[%d] must be a language code (got: %q)", i, lang,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func validateClipboard(config *Config) []string {
+	if size := config.Clipboard.URLHistorySize; size < 0 || size > maxClipboardURLHistorySize {
+		return []string{fmt.Sprintf(
+			"clipboard.url_history_size must be between 0 and %d (got: %d)", maxClipboardURLHistorySize, size,
+		)}
+	}
+	return nil
+}
+
+func validateNotifications(config *Config) []string {
+	if size := config.Notifications.HistorySize; size < 0 || size > notification.MaxCapacity {
+		return []string{fmt.Sprintf(
+			"notifications.history_size must be between 0 and %d (got: %d)", notification.MaxCapacity, size,
+		)}
+	}
+	return nil
+}
+
+func validateSafeMode(config *Config) []string {
+	var validationErrors []string
+	if config.SafeMode.CrashThreshold < 0 {
+		validationErrors = append(validationErrors, "safe_mode.crash_threshold must be non-negative")
+	}
+	if config.SafeMode.CrashWindowMinutes < 1 {
+		validationErrors = append(validationErrors, "safe_mode.crash_window_minutes must be at least 1")
+	}
+	return validationErrors
+}
+
+func validateWindow(config *Config) []string {
+	var validationErrors []string
+	if config.Window.DefaultWidth < entity.MinWindowWidth {
+		validationErrors = append(validationErrors,
+			fmt.Sprintf("window.default_width must be at least %d", entity.MinWindowWidth))
+	}
+	if config.Window.DefaultHeight < entity.MinWindowHeight {
+		validationErrors = append(validationErrors,
+			fmt.Sprintf("window.default_height must be at least %d", entity.MinWindowHeight))
+	}
+	return validationErrors
+}
+
+func validateScriptDialogs(config *Config) []string {
+	var validationErrors []string
+	for i, domain := range config.ScriptDialogs.AutoDismissDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"script_dialogs.auto_dismiss_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func validateNetwork(config *Config) []string {
+	proxy := config.Network.Proxy
+	validationErrors := validateProxy("network.proxy", proxy.URL, proxy.NoProxy)
+	engines := make([]string, 0, len(proxy.Profiles))
+	for engine := range proxy.Profiles {
+		engines = append(engines, engine)
+	}
+	sort.Strings(engines)
+	for _, engine := range engines {
+		prefix := "network.proxy.profiles." + engine
+		if engine != EngineTypeWebKit && engine != EngineTypeCEF {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"%s: profile must be %s or %s", prefix, EngineTypeWebKit, EngineTypeCEF,
+			))
+			continue
+		}
+		override := proxy.Profiles[engine]
+		validationErrors = append(validationErrors, validateProxy(prefix, override.URL, override.NoProxy)...)
+	}
+	validationErrors = append(validationErrors, validateLoadRetry(config.Network.Retry)...)
+	return append(validationErrors, validateThrottle(config.Network.Throttle)...)
+}
+
+func validateThrottle(rules []RequestThrottleRule) []string {
+	var validationErrors []string
+	for i, rule := range rules {
+		if strings.TrimSpace(rule.Domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"network.throttle[%d].domain must not be empty", i,
+			))
+		}
+		if rule.Rate < minThrottleRate || rule.Rate > maxThrottleRate {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"network.throttle[%d].rate must be between %.3f and %g requests per second (got: %g)",
+				i, minThrottleRate, maxThrottleRate, rule.Rate,
+			))
+		}
+		if rule.Burst < 1 || rule.Burst > maxThrottleBurst {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"network.throttle[%d].burst must be between 1 and %d (got: %d)", i, maxThrottleBurst, rule.Burst,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func validateProxy(prefix, proxyURL string, noProxy []string) []string {
+	var validationErrors []string
+	if proxyURL != "" {
+		if _, err := domainurl.ParseProxyURL(proxyURL); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s.url: %v", prefix, err))
+		}
+	}
+	for i, entry := range noProxy {
+		if err := domainurl.ValidateNoProxyEntry(entry); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s.no_proxy[%d]: %v", prefix, i, err))
+		}
+	}
+	return validationErrors
+}
+
+func validateLoadRetry(retry LoadRetryConfig) []string {
+	var validationErrors []string
+	if retry.MaxAttempts < 0 || retry.MaxAttempts > maxLoadRetryAttempts {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"network.retry.max_attempts must be between 0 and %d (got %d)", maxLoadRetryAttempts, retry.MaxAttempts,
+		))
+	}
+	if retry.InitialDelayMs < minLoadRetryDelayMs || retry.InitialDelayMs > maxLoadRetryDelayMs {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"network.retry.initial_delay_ms must be between %d and %d (got %d)",
+			minLoadRetryDelayMs, maxLoadRetryDelayMs, retry.InitialDelayMs,
+		))
+	}
+	if retry.MaxDelayMs < retry.InitialDelayMs || retry.MaxDelayMs > maxLoadRetryDelayMs {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"network.retry.max_delay_ms must be between initial_delay_ms and %d (got %d)",
+			maxLoadRetryDelayMs, retry.MaxDelayMs,
+		))
+	}
+	return validationErrors
+}
+
+func validateInput(config *Config) []string {
+	var validationErrors []string
+	if config.Input.HoverFocusDelayMs < 0 || config.Input.HoverFocusDelayMs > maxHoverFocusDelayMs {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"input.hover_focus_delay_ms must be between 0 and %d (got: %d)",
+			maxHoverFocusDelayMs, config.Input.HoverFocusDelayMs,
+		))
+	}
+	if config.Input.ScrollMultiplier < minScrollMultiplier || config.Input.ScrollMultiplier > maxScrollMultiplier {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"input.scroll_multiplier must be between %.1f and %.1f (got: %g)",
+			minScrollMultiplier, maxScrollMultiplier, config.Input.ScrollMultiplier,
+		))
+	}
+	for i, domain := range config.Input.AutofocusDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"input.autofocus_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	for i, domain := range config.Input.DisableGesturesDomains {
+		if strings.TrimSpace(domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"input.disable_gestures_domains[%d] must not be empty", i,
+			))
+		}
+	}
+	return validationErrors
+}
+
+func validatePageEnv(config *Config) []string {
+	var validationErrors []string
+	for i, rule := range config.PageEnv.Domains {
+		if strings.TrimSpace(rule.Domain) == "" {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"page_env.domains[%d].domain must not be empty", i,
+			))
+		}
+		for _, key := range rule.Keys {
+			if _, ok := config.PageEnv.Values[key]; !ok {
+				validationErrors = append(validationErrors, fmt.Sprintf(
+					"page_env.domains[%d].keys names %q, which is not in page_env.values", i, key,
+				))
+			}
+		}
+	}
+	return validationErrors
+}
+
+func validateHomepage(config *Config) []string {
+	var validationErrors []string
+	seen := make(map[HomepageWidget]bool, len(config.Homepage.Widgets))
+	for i, widget := range config.Homepage.Widgets {
+		if !widget.IsValid() {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"homepage.widgets[%d] must be one of: search, recent_history, top_favorites (got: %s)",
+				i, widget,
+			))
+			continue
+		}
+		if seen[widget] {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"homepage.widgets[%d] duplicates %s", i, widget,
+			))
+		}
+		seen[widget] = true
+	}
+	if config.Homepage.RecentHistoryLimit < 1 || config.Homepage.RecentHistoryLimit > maxHomepageWidgetItems {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"homepage.recent_history_limit must be between 1 and %d (got: %d)",
+			maxHomepageWidgetItems, config.Homepage.RecentHistoryLimit,
+		))
+	}
+	if config.Homepage.TopFavoritesLimit < 1 || config.Homepage.TopFavoritesLimit > maxHomepageWidgetItems {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"homepage.top_favorites_limit must be between 1 and %d (got: %d)",
+			maxHomepageWidgetItems, config.Homepage.TopFavoritesLimit,
+		))
+	}
+	return validationErrors
+}
+
+func validateLinkStatus(config *Config) []string {
+	var validationErrors []string
+	if config.LinkStatus.ShowDelayMs < 0 || config.LinkStatus.ShowDelayMs > maxLinkStatusShowDelayMs {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"link_status.show_delay_ms must be between 0 and %d (got: %d)",
+			maxLinkStatusShowDelayMs, config.LinkStatus.ShowDelayMs,
+		))
+	}
+	if config.LinkStatus.MaxLength < 0 || config.LinkStatus.MaxLength > maxLinkStatusLength {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"link_status.max_length must be between 0 and %d (got: %d)",
+			maxLinkStatusLength, config.LinkStatus.MaxLength,
+		))
+	}
+	return validationErrors
+}
+
+func validateDebug(config *Config) []string {
+	var validationErrors []string
+	if config.Debug.ConsoleBufferSize < 0 || config.Debug.ConsoleBufferSize > maxConsoleBufferSize {
+		validationErrors = append(validationErrors, fmt.Sprintf(
+			"debug.console_buffer_size must be between 0 and %d (got: %d)",
+			maxConsoleBufferSize, config.Debug.ConsoleBufferSize,
+		))
+	}
+	phases := make([]string, 0, len(config.Debug.StartupBudgets))
+	for phase := range config.Debug.StartupBudgets {
+		phases = append(phases, phase)
+	}
+	sort.Strings(phases)
+	for _, phase := range phases {
+		if strings.TrimSpace(phase) == "" {
+			validationErrors = append(validationErrors, "debug.startup_budgets phase name cannot be empty")
+			continue
+		}
+		if budget := config.Debug.StartupBudgets[phase]; budget <= 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"debug.startup_budgets.%s must be a positive number of milliseconds (got: %d)",
+				phase, budget,
+			))
+		}
+	}
+	for i, domain := range config.Debug.StripCSPDomains {
+		switch strings.TrimSpace(domain) {
+		case "":
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"debug.strip_csp_domains[%d] must not be empty", i,
+			))
+		case "*":
+			validationErrors = append(validationErrors, fmt.Sprintf(
+				"debug.strip_csp_domains[%d] must name a domain; \"*\" would strip CSP from every site", i,
+			))
+		}
+	}
+	return validationErrors
+}
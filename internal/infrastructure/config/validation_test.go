@@ -8,155 +8,1143 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
 )
 
-func TestValidateConfig_EngineType(t *testing.T) {
-	t.Setenv("DUMBER_ENGINE", "")
+func TestValidateConfig_EngineType(t *testing.T) {
+	t.Setenv("DUMBER_ENGINE", "")
+
+	tests := []struct {
+		name       string
+		engineType string
+		wantErr    bool
+	}{
+		{name: "cef", engineType: EngineTypeCEF, wantErr: false},
+		{name: "webkit", engineType: EngineTypeWebKit, wantErr: false},
+		{name: "empty is invalid", engineType: "", wantErr: true},
+		{name: "invalid", engineType: "netscape", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Engine.Type = tt.engineType
+
+			err := validateConfig(cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "engine.type")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateConfig_EngineTypeIgnoresEnvOverride(t *testing.T) {
+	t.Run("invalid configured type is still rejected when env override is valid", func(t *testing.T) {
+		t.Setenv("DUMBER_ENGINE", EngineTypeWebKit)
+		cfg := DefaultConfig()
+		cfg.Engine.Type = "netscape"
+
+		err := validateConfig(cfg)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "engine.type")
+	})
+
+	t.Run("valid configured type is not rejected when env override is invalid", func(t *testing.T) {
+		t.Setenv("DUMBER_ENGINE", "netscape")
+		cfg := DefaultConfig()
+		cfg.Engine.Type = EngineTypeCEF
+
+		err := validateConfig(cfg)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestValidateConfig_EngineCookiePolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		cookiePolicy CookiePolicy
+		wantErr      bool
+	}{
+		{name: "always", cookiePolicy: CookiePolicyAlways, wantErr: false},
+		{name: "no_third_party", cookiePolicy: CookiePolicyNoThirdParty, wantErr: false},
+		{name: "never", cookiePolicy: CookiePolicyNever, wantErr: false},
+		{name: "invalid", cookiePolicy: CookiePolicy("bad_policy"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Engine.CookiePolicy = tt.cookiePolicy
+
+			err := validateConfig(cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "engine.cookie_policy")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateConfig_CookiePolicyOverrides(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Engine.CookiePolicyOverrides = []CookiePolicyOverride{
+		{Domain: "bank.example", Policy: CookiePolicyAlways},
+		{Domain: "*.tracker.test", Policy: CookiePolicyNever},
+	}
+	require.NoError(t, validateConfig(cfg))
+
+	for _, override := range []CookiePolicyOverride{
+		{Domain: "", Policy: CookiePolicyAlways},
+		{Domain: "bank.example", Policy: "no-third-party"},
+	} {
+		cfg := DefaultConfig()
+		cfg.Engine.CookiePolicyOverrides = []CookiePolicyOverride{override}
+
+		err := validateConfig(cfg)
+		require.Error(t, err, "%+v", override)
+		assert.Contains(t, err.Error(), "engine.cookie_policy_overrides[0]")
+	}
+}
+
+func TestValidateConfig_PageEnv(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.PageEnv.Values = map[string]string{"api_base": "https://api.internal", "team": "infra"}
+	cfg.PageEnv.Domains = []PageEnvRule{
+		{Domain: "tools.internal", Keys: []string{"api_base", "team"}},
+		{Domain: "*.corp.example", Keys: []string{"team"}},
+	}
+	require.NoError(t, validateConfig(cfg))
+
+	for _, rule := range []PageEnvRule{
+		{Domain: "", Keys: []string{"team"}},
+		{Domain: "tools.internal", Keys: []string{"token"}},
+	} {
+		cfg := DefaultConfig()
+		cfg.PageEnv.Values = map[string]string{"team": "infra"}
+		cfg.PageEnv.Domains = []PageEnvRule{rule}
+
+		err := validateConfig(cfg)
+		require.Error(t, err, "%+v", rule)
+		assert.Contains(t, err.Error(), "page_env.domains[0]")
+	}
+}
+
+func TestValidateConfig_WebKitMediaSettings(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(*Config)
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name: "valid gl rendering modes",
+			mutate: func(cfg *Config) {
+				cfg.Engine.WebKit.GLRenderingMode = GLRenderingModeGLES2
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid gl rendering mode",
+			mutate: func(cfg *Config) {
+				cfg.Engine.WebKit.GLRenderingMode = GLRenderingMode("metal")
+			},
+			wantErr:   true,
+			wantField: "engine.webkit.gl_rendering_mode",
+		},
+		{
+			name: "gstreamer debug level lower bound",
+			mutate: func(cfg *Config) {
+				cfg.Engine.WebKit.GStreamerDebugLevel = 0
+			},
+			wantErr: false,
+		},
+		{
+			name: "gstreamer debug level upper bound",
+			mutate: func(cfg *Config) {
+				cfg.Engine.WebKit.GStreamerDebugLevel = 5
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative gstreamer debug level",
+			mutate: func(cfg *Config) {
+				cfg.Engine.WebKit.GStreamerDebugLevel = -1
+			},
+			wantErr:   true,
+			wantField: "engine.webkit.gstreamer_debug_level",
+		},
+		{
+			name: "too high gstreamer debug level",
+			mutate: func(cfg *Config) {
+				cfg.Engine.WebKit.GStreamerDebugLevel = 6
+			},
+			wantErr:   true,
+			wantField: "engine.webkit.gstreamer_debug_level",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+
+			err := validateConfig(cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantField)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateConfig_MediaAutoplay(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(*Config)
+		wantErr   bool
+		wantField string
+	}{
+		{
+			name: "valid policy and exceptions",
+			mutate: func(cfg *Config) {
+				cfg.Media.AutoplayPolicy = AutoplayBlock
+				cfg.Media.AutoplayExceptions = []AutoplayException{
+					{Domain: "youtube.com", Policy: AutoplayAllow},
+					{Domain: "*.news.example", Policy: AutoplayBlockAudio},
+					{Domain: "reddit.com", Policy: AutoplayMutedVideo},
+					{Domain: "radio.example", Policy: AutoplayBlockVideo},
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid policy",
+			mutate: func(cfg *Config) {
+				cfg.Media.AutoplayPolicy = AutoplayPolicy("sometimes")
+			},
+			wantErr:   true,
+			wantField: "media.autoplay_policy",
+		},
+		{
+			name: "invalid exception policy",
+			mutate: func(cfg *Config) {
+				cfg.Media.AutoplayExceptions = []AutoplayException{{Domain: "example.com", Policy: "loud"}}
+			},
+			wantErr:   true,
+			wantField: "media.autoplay_exceptions[0].policy",
+		},
+		{
+			name: "empty exception domain",
+			mutate: func(cfg *Config) {
+				cfg.Media.AutoplayExceptions = []AutoplayException{{Domain: " ", Policy: AutoplayAllow}}
+			},
+			wantErr:   true,
+			wantField: "media.autoplay_exceptions[0].domain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+
+			err := validateConfig(cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantField)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateConfig_CacheAlwaysFreshDomains(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Cache.AlwaysFreshDomains = []string{"localhost:3000", "*.dev.example"}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Cache.AlwaysFreshDomains = []string{"localhost:3000", "  "}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cache.always_fresh_domains[1]")
+}
+
+func TestValidateConfig_WorkspaceReloadOnFocus(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Workspace.ReloadOnFocusDomains = []string{"grafana.example.com", "*.status.example"}
+	cfg.Workspace.ReloadOnFocusAfterSeconds = 0
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Workspace.ReloadOnFocusDomains = []string{"grafana.example.com", " "}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.reload_on_focus_domains[1]")
+
+	cfg = DefaultConfig()
+	cfg.Workspace.ReloadOnFocusAfterSeconds = -1
+	err = validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.reload_on_focus_after_seconds")
+}
+
+func TestValidateConfig_WorkspaceClosedTabHistoryDepth(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Workspace.ClosedTabHistoryDepth = 0
+	require.NoError(t, validateConfig(cfg))
+
+	for _, depth := range []int{-1, maxClosedTabHistoryDepth + 1} {
+		cfg.Workspace.ClosedTabHistoryDepth = depth
+		err := validateConfig(cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "workspace.closed_tab_history_depth")
+	}
+}
+
+func TestValidateConfig_WorkspaceFocusTimer(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Workspace.FocusTimer.Action = FocusTimerNavigate
+	require.NoError(t, validateConfig(cfg))
+
+	tests := []struct {
+		name   string
+		mutate func(*FocusTimerConfig)
+		want   string
+	}{
+		{"zero duration", func(f *FocusTimerConfig) { f.DurationMinutes = 0 }, "workspace.focus_timer.duration_minutes"},
+		{"long duration", func(f *FocusTimerConfig) { f.DurationMinutes = maxFocusTimerMinutes + 1 }, "workspace.focus_timer.duration_minutes"},
+		{"negative warning", func(f *FocusTimerConfig) { f.WarningSeconds = -1 }, "workspace.focus_timer.warning_seconds"},
+		{"unknown action", func(f *FocusTimerConfig) { f.Action = "snooze" }, "workspace.focus_timer.action"},
+		{"navigate without url", func(f *FocusTimerConfig) {
+			f.Action = FocusTimerNavigate
+			f.NavigateURL = " "
+		}, "workspace.focus_timer.navigate_url"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(&cfg.Workspace.FocusTimer)
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestValidateConfig_InputDisableGesturesDomains(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Input.DisableGesturesDomains = []string{"excalidraw.com", "*.figma.com"}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Input.DisableGesturesDomains = []string{"excalidraw.com", "  "}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "input.disable_gestures_domains[1]")
+}
+
+func TestValidateConfig_Translation(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Translation.PreferredLanguage = "pt-BR"
+	cfg.Translation.IgnoredLanguages = []string{"fr", "es_ES"}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Translation.PreferredLanguage = "portuguese"
+	cfg.Translation.URLTemplate = "https://translate.example/?tl={to}"
+	cfg.Translation.IgnoredLanguages = []string{"fr", ""}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "translation.preferred_language")
+	assert.Contains(t, err.Error(), "translation.url_template must contain {url}")
+	assert.Contains(t, err.Error(), "translation.ignored_languages[1]")
+}
+
+func TestValidateConfig_ScriptDialogs(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ScriptDialogs.AutoDismiss = true
+	cfg.ScriptDialogs.AutoDismissDomains = []string{"kiosk.example", "*.ads.example"}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.ScriptDialogs.AutoDismissDomains = []string{"kiosk.example", " "}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "script_dialogs.auto_dismiss_domains[1]")
+}
+
+func TestValidateConfig_ContentFilteringDisabledDomains(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ContentFiltering.DisabledDomains = []string{"example.com", "*.bank.example"}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.ContentFiltering.DisabledDomains = []string{"example.com", ""}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "content_filtering.disabled_domains[1]")
+}
+
+func TestValidateConfig_CacheFaviconLimits(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Cache.FaviconMaxMB = 0
+	cfg.Cache.FaviconMaxEntries = 500
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Cache.FaviconMaxMB = -1
+	cfg.Cache.FaviconMaxEntries = -1
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cache.favicon_max_mb")
+	assert.Contains(t, err.Error(), "cache.favicon_max_entries")
+}
+
+func TestValidateConfig_Leader(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Workspace.Leader.ActivationShortcut = "ctrl+space"
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Workspace.Leader.TimeoutMilliseconds = -1
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.leader.timeout_ms")
+
+	cfg = DefaultConfig()
+	cfg.Workspace.Leader.Actions = map[string]ActionBinding{
+		"new-tab":    {Keys: []string{"t"}},
+		"toggle-zen": {Keys: []string{"t"}},
+	}
+	err = validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate key binding 't' found in leader actions")
+}
+
+func TestValidateConfig_Zoom(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Zoom.StepFactor = 1.25
+	cfg.Zoom.DomainOverrides = []ZoomDomainOverride{
+		{Domain: "maps.example", StepFactor: 1.05},
+		{Domain: "*.docs.example", StepFactor: 2.0},
+	}
+	cfg.Zoom.Presets = []ZoomPreset{
+		{Domain: "*.docs.example", Factor: 1.25},
+		{Domain: "dense.example", Factor: 0.8},
+	}
+	cfg.Zoom.SessionOnlyDomains = []string{"*.slides.example"}
+	require.NoError(t, validateConfig(cfg))
+
+	tests := []struct {
+		name      string
+		mutate    func(*Config)
+		wantField string
+	}{
+		{
+			name:      "step factor of one never zooms",
+			mutate:    func(cfg *Config) { cfg.Zoom.StepFactor = 1.0 },
+			wantField: "zoom.step_factor",
+		},
+		{
+			name:      "step factor too large",
+			mutate:    func(cfg *Config) { cfg.Zoom.StepFactor = 3.0 },
+			wantField: "zoom.step_factor",
+		},
+		{
+			name: "empty override domain",
+			mutate: func(cfg *Config) {
+				cfg.Zoom.DomainOverrides = []ZoomDomainOverride{{Domain: " ", StepFactor: 1.2}}
+			},
+			wantField: "zoom.domain_overrides[0].domain",
+		},
+		{
+			name: "invalid override step factor",
+			mutate: func(cfg *Config) {
+				cfg.Zoom.DomainOverrides = []ZoomDomainOverride{
+					{Domain: "example.com", StepFactor: 1.2},
+					{Domain: "example.org", StepFactor: 0.9},
+				}
+			},
+			wantField: "zoom.domain_overrides[1].step_factor",
+		},
+		{
+			name: "empty preset domain",
+			mutate: func(cfg *Config) {
+				cfg.Zoom.Presets = []ZoomPreset{{Domain: "", Factor: 1.2}}
+			},
+			wantField: "zoom.presets[0].domain",
+		},
+		{
+			name: "preset factor out of range",
+			mutate: func(cfg *Config) {
+				cfg.Zoom.Presets = []ZoomPreset{{Domain: "example.com", Factor: 6}}
+			},
+			wantField: "zoom.presets[0].factor",
+		},
+		{
+			name: "empty session-only domain",
+			mutate: func(cfg *Config) {
+				cfg.Zoom.SessionOnlyDomains = []string{"slides.example", ""}
+			},
+			wantField: "zoom.session_only_domains[1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantField)
+		})
+	}
+}
+
+func TestValidateConfig_DownloadsFilenameTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Downloads.FilenameTemplate = "{date}-{host}-{name}"
+	require.NoError(t, validateConfig(cfg))
+
+	for _, tmpl := range []string{"", "{date}-{url}", "{name"} {
+		cfg := DefaultConfig()
+		cfg.Downloads.FilenameTemplate = tmpl
+
+		err := validateConfig(cfg)
+		require.Error(t, err, tmpl)
+		assert.Contains(t, err.Error(), "downloads.filename_template")
+	}
+}
+
+func TestValidateConfig_DownloadsImages(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, ImagesInline, cfg.Downloads.Images)
+	for _, images := range []ImageDisposition{ImagesInline, ImagesDownload} {
+		cfg.Downloads.Images = images
+		require.NoError(t, validateConfig(cfg), images)
+	}
+
+	cfg.Downloads.Images = "open"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "downloads.images")
+}
+
+func TestValidateConfig_IdleQuietHours(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Idle.QuietHours = QuietHours{Start: "22:00", End: "07:00"}
+	require.NoError(t, validateConfig(cfg))
+
+	for _, hours := range []QuietHours{
+		{Start: "22:00"},
+		{Start: "22:00", End: "7pm"},
+		{Start: "08:00", End: "08:00"},
+	} {
+		cfg := DefaultConfig()
+		cfg.Idle.QuietHours = hours
+
+		err := validateConfig(cfg)
+		require.Error(t, err, "%+v", hours)
+		assert.Contains(t, err.Error(), "idle.quiet_hours")
+	}
+}
+
+func TestValidateConfig_ReadAloudCommand(t *testing.T) {
+	for _, command := range []string{"", "espeak-ng", `piper-say --model "/voices/en US.onnx"`} {
+		cfg := DefaultConfig()
+		cfg.ReadAloud.Command = command
+		require.NoError(t, validateConfig(cfg), command)
+	}
+
+	for _, command := range []string{"piper | aplay", "espeak-ng; rm -rf ~", `espeak-ng "open`} {
+		cfg := DefaultConfig()
+		cfg.ReadAloud.Command = command
+
+		err := validateConfig(cfg)
+		require.Error(t, err, command)
+		assert.Contains(t, err.Error(), "read_aloud.command")
+	}
+}
+
+func TestValidateConfig_ClipboardURLHistorySize(t *testing.T) {
+	for _, size := range []int{0, 20, 200} {
+		cfg := DefaultConfig()
+		cfg.Clipboard.URLHistorySize = size
+		require.NoError(t, validateConfig(cfg), size)
+	}
+
+	for _, size := range []int{-1, 201} {
+		cfg := DefaultConfig()
+		cfg.Clipboard.URLHistorySize = size
+
+		err := validateConfig(cfg)
+		require.Error(t, err, size)
+		assert.Contains(t, err.Error(), "clipboard.url_history_size")
+	}
+}
+
+func TestValidateConfig_NotificationsHistorySize(t *testing.T) {
+	for _, size := range []int{0, 50, 500} {
+		cfg := DefaultConfig()
+		cfg.Notifications.HistorySize = size
+		require.NoError(t, validateConfig(cfg), size)
+	}
+
+	for _, size := range []int{-1, 501} {
+		cfg := DefaultConfig()
+		cfg.Notifications.HistorySize = size
+
+		err := validateConfig(cfg)
+		require.Error(t, err, size)
+		assert.Contains(t, err.Error(), "notifications.history_size")
+	}
+}
+
+func TestValidateConfig_NetworkProxy(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Network.Proxy.URL = "socks5://127.0.0.1:1080"
+	cfg.Network.Proxy.NoProxy = []string{"localhost", "*.lan", "10.0.0.0/8"}
+	require.NoError(t, validateConfig(cfg))
+
+	tests := []struct {
+		name      string
+		mutate    func(*Config)
+		wantField string
+	}{
+		{
+			name:      "missing scheme",
+			mutate:    func(cfg *Config) { cfg.Network.Proxy.URL = "proxy.lan:3128" },
+			wantField: "network.proxy.url",
+		},
+		{
+			name:      "unsupported scheme",
+			mutate:    func(cfg *Config) { cfg.Network.Proxy.URL = "ftp://proxy.lan" },
+			wantField: "network.proxy.url",
+		},
+		{
+			name:      "invalid no-proxy range",
+			mutate:    func(cfg *Config) { cfg.Network.Proxy.NoProxy = []string{"localhost", "10.0.0.0/40"} },
+			wantField: "network.proxy.no_proxy[1]",
+		},
+		{
+			name: "unknown profile",
+			mutate: func(cfg *Config) {
+				cfg.Network.Proxy.Profiles = map[string]ProxyProfileConfig{"firefox": {URL: "http://proxy.lan:3128"}}
+			},
+			wantField: "network.proxy.profiles.firefox",
+		},
+		{
+			name: "invalid profile url",
+			mutate: func(cfg *Config) {
+				cfg.Network.Proxy.Profiles = map[string]ProxyProfileConfig{EngineTypeCEF: {URL: "proxy.lan:3128"}}
+			},
+			wantField: "network.proxy.profiles.cef.url",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantField)
+		})
+	}
+}
+
+func TestProxyConfig_ForProfile(t *testing.T) {
+	proxy := ProxyConfig{
+		URL:     "socks5://127.0.0.1:1080",
+		NoProxy: []string{"localhost"},
+		Profiles: map[string]ProxyProfileConfig{
+			EngineTypeCEF: {URL: "http://proxy.lan:3128", NoProxy: []string{"*.lan"}},
+			"other":       {},
+		},
+	}
+
+	assert.Equal(t, ProxyProfileConfig{URL: "socks5://127.0.0.1:1080", NoProxy: []string{"localhost"}},
+		proxy.ForProfile(EngineTypeWebKit), "no override keeps the global proxy")
+	assert.Equal(t, ProxyProfileConfig{URL: "http://proxy.lan:3128", NoProxy: []string{"*.lan"}},
+		proxy.ForProfile(EngineTypeCEF))
+	assert.Empty(t, proxy.ForProfile("other").URL, "an empty override uses the system proxy")
+}
+
+func TestValidateConfig_NetworkRetry(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Network.Retry = LoadRetryConfig{MaxAttempts: 0, InitialDelayMs: 500, MaxDelayMs: 500}
+	require.NoError(t, validateConfig(cfg), "0 attempts disables retrying")
+
+	tests := []struct {
+		name      string
+		retry     LoadRetryConfig
+		wantField string
+	}{
+		{
+			name:      "negative attempts",
+			retry:     LoadRetryConfig{MaxAttempts: -1, InitialDelayMs: 1000, MaxDelayMs: 8000},
+			wantField: "network.retry.max_attempts",
+		},
+		{
+			name:      "too many attempts",
+			retry:     LoadRetryConfig{MaxAttempts: maxLoadRetryAttempts + 1, InitialDelayMs: 1000, MaxDelayMs: 8000},
+			wantField: "network.retry.max_attempts",
+		},
+		{
+			name:      "initial delay too short",
+			retry:     LoadRetryConfig{MaxAttempts: 3, InitialDelayMs: 10, MaxDelayMs: 8000},
+			wantField: "network.retry.initial_delay_ms",
+		},
+		{
+			name:      "max delay below initial delay",
+			retry:     LoadRetryConfig{MaxAttempts: 3, InitialDelayMs: 2000, MaxDelayMs: 1000},
+			wantField: "network.retry.max_delay_ms",
+		},
+		{
+			name:      "max delay too long",
+			retry:     LoadRetryConfig{MaxAttempts: 3, InitialDelayMs: 1000, MaxDelayMs: maxLoadRetryDelayMs + 1},
+			wantField: "network.retry.max_delay_ms",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Network.Retry = tt.retry
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantField)
+		})
+	}
+}
+
+func TestValidateConfig_NetworkThrottle(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Network.Throttle = []RequestThrottleRule{{Domain: "*.example.com", Rate: 0.5, Burst: 2}}
+	require.NoError(t, validateConfig(cfg))
+
+	tests := []struct {
+		name      string
+		rule      RequestThrottleRule
+		wantField string
+	}{
+		{
+			name:      "empty domain",
+			rule:      RequestThrottleRule{Domain: " ", Rate: 1, Burst: 1},
+			wantField: "network.throttle[0].domain",
+		},
+		{
+			name:      "zero rate",
+			rule:      RequestThrottleRule{Domain: "example.com", Rate: 0, Burst: 1},
+			wantField: "network.throttle[0].rate",
+		},
+		{
+			name:      "rate too high",
+			rule:      RequestThrottleRule{Domain: "example.com", Rate: maxThrottleRate + 1, Burst: 1},
+			wantField: "network.throttle[0].rate",
+		},
+		{
+			name:      "burst too large",
+			rule:      RequestThrottleRule{Domain: "example.com", Rate: 1, Burst: maxThrottleBurst + 1},
+			wantField: "network.throttle[0].burst",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Network.Throttle = []RequestThrottleRule{tt.rule}
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantField)
+		})
+	}
+}
+
+func TestValidateConfig_MultiSearchEngines(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MultiSearchEngines = []string{"GH", "so"}
+	require.NoError(t, validateConfig(cfg), "keys match search_shortcuts without regard to case")
+
+	cfg.MultiSearchEngines = nil
+	require.NoError(t, validateConfig(cfg))
+
+	cfg = DefaultConfig()
+	cfg.MultiSearchEngines = []string{"g", "nope"}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `multi_search_engines: "nope"`)
+
+	cfg = DefaultConfig()
+	cfg.MultiSearchEngines = []string{"ddg", "g", "gi", "gh", "go", "mdn", "npm"}
+	err = validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at most")
+}
+
+func TestValidateConfig_InputHoverFocusDelay(t *testing.T) {
+	for _, delay := range []int{0, 150, maxHoverFocusDelayMs} {
+		cfg := DefaultConfig()
+		cfg.Input.HoverFocusDelayMs = delay
+		require.NoError(t, validateConfig(cfg), "delay %d", delay)
+	}
+
+	for _, delay := range []int{-1, maxHoverFocusDelayMs + 1} {
+		cfg := DefaultConfig()
+		cfg.Input.HoverFocusDelayMs = delay
+		err := validateConfig(cfg)
+		require.Error(t, err, "delay %d", delay)
+		assert.Contains(t, err.Error(), "input.hover_focus_delay_ms")
+	}
+}
+
+func TestValidateConfig_DefaultForLinks(t *testing.T) {
+	for _, behavior := range []PopupBehavior{"", PopupBehaviorSplit, PopupBehaviorStacked, PopupBehaviorTabbed} {
+		cfg := DefaultConfig()
+		cfg.Workspace.BrowsingContexts.DefaultForLinks = behavior
+		require.NoError(t, validateConfig(cfg), "behavior %q", behavior)
+	}
+
+	for _, behavior := range []PopupBehavior{PopupBehaviorWindowed, "tab"} {
+		cfg := DefaultConfig()
+		cfg.Workspace.BrowsingContexts.DefaultForLinks = behavior
+		err := validateConfig(cfg)
+		require.Error(t, err, "behavior %q", behavior)
+		assert.Contains(t, err.Error(), "workspace.browsing_contexts.default_for_links")
+	}
+}
+
+func TestValidateConfig_PopupDomains(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Workspace.BrowsingContexts.PopupBlockDomains = []string{"ads.example", "*.tracker.example"}
+	cfg.Workspace.BrowsingContexts.PopupAllowDomains = []string{"accounts.example"}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Workspace.BrowsingContexts.PopupBlockDomains = []string{"ads.example", " "}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.browsing_contexts.popup_block_domains[1]")
+
+	cfg = DefaultConfig()
+	cfg.Workspace.BrowsingContexts.PopupAllowDomains = []string{""}
+	err = validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.browsing_contexts.popup_allow_domains[0]")
+}
+
+func TestValidateConfig_PopupDomainPlacement(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Workspace.BrowsingContexts.DomainPlacement = []entity.PopupPlacementRule{
+		{Domain: "accounts.google.com", Placement: "bottom"},
+		{Domain: "*.okta.com", Placement: "auto"},
+	}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Workspace.BrowsingContexts.DomainPlacement = append(cfg.Workspace.BrowsingContexts.DomainPlacement,
+		entity.PopupPlacementRule{Domain: "github.com", Placement: "middle"})
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.browsing_contexts.domain_placement[2].placement")
+
+	cfg = DefaultConfig()
+	cfg.Workspace.BrowsingContexts.DomainPlacement = []entity.PopupPlacementRule{{Domain: " ", Placement: "left"}}
+	err = validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "domain_placement[0].domain must not be empty")
+}
+
+func TestValidateConfig_InputScrollMultiplier(t *testing.T) {
+	for _, m := range []float64{minScrollMultiplier, 1, 2.5, maxScrollMultiplier} {
+		cfg := DefaultConfig()
+		cfg.Input.ScrollMultiplier = m
+		require.NoError(t, validateConfig(cfg), "multiplier %g", m)
+	}
+
+	for _, m := range []float64{0, -1, 0.05, maxScrollMultiplier + 1} {
+		cfg := DefaultConfig()
+		cfg.Input.ScrollMultiplier = m
+		err := validateConfig(cfg)
+		require.Error(t, err, "multiplier %g", m)
+		assert.Contains(t, err.Error(), "input.scroll_multiplier")
+	}
+}
+
+func TestValidateConfig_Homepage(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Homepage.Widgets = []HomepageWidget{}
+	require.NoError(t, validateConfig(cfg), "empty widget list hides the dashboard")
+
+	tests := []struct {
+		name     string
+		mutate   func(*Config)
+		wantText string
+	}{
+		{
+			name:     "unknown widget",
+			mutate:   func(c *Config) { c.Homepage.Widgets = []HomepageWidget{"weather"} },
+			wantText: "homepage.widgets[0]",
+		},
+		{
+			name: "duplicate widget",
+			mutate: func(c *Config) {
+				c.Homepage.Widgets = []HomepageWidget{HomepageWidgetSearch, HomepageWidgetSearch}
+			},
+			wantText: "homepage.widgets[1] duplicates search",
+		},
+		{
+			name:     "recent limit too small",
+			mutate:   func(c *Config) { c.Homepage.RecentHistoryLimit = 0 },
+			wantText: "homepage.recent_history_limit",
+		},
+		{
+			name:     "favorites limit too large",
+			mutate:   func(c *Config) { c.Homepage.TopFavoritesLimit = maxHomepageWidgetItems + 1 },
+			wantText: "homepage.top_favorites_limit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantText)
+		})
+	}
+}
+
+func TestValidateConfig_LinkStatus(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.LinkStatus.ShowDelayMs = 0
+	cfg.LinkStatus.MaxLength = 0
+	require.NoError(t, validateConfig(cfg), "zero delay and unlimited length are valid")
+
+	tests := []struct {
+		name     string
+		mutate   func(*Config)
+		wantText string
+	}{
+		{
+			name:     "negative delay",
+			mutate:   func(c *Config) { c.LinkStatus.ShowDelayMs = -1 },
+			wantText: "link_status.show_delay_ms",
+		},
+		{
+			name:     "delay too large",
+			mutate:   func(c *Config) { c.LinkStatus.ShowDelayMs = maxLinkStatusShowDelayMs + 1 },
+			wantText: "link_status.show_delay_ms",
+		},
+		{
+			name:     "negative max length",
+			mutate:   func(c *Config) { c.LinkStatus.MaxLength = -1 },
+			wantText: "link_status.max_length",
+		},
+		{
+			name:     "max length too large",
+			mutate:   func(c *Config) { c.LinkStatus.MaxLength = maxLinkStatusLength + 1 },
+			wantText: "link_status.max_length",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantText)
+		})
+	}
+}
+
+func TestValidateConfig_SafeMode(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SafeMode.CrashThreshold = 0
+	require.NoError(t, validateConfig(cfg), "zero threshold disables detection")
+
+	tests := []struct {
+		name     string
+		mutate   func(*Config)
+		wantText string
+	}{
+		{
+			name:     "negative threshold",
+			mutate:   func(c *Config) { c.SafeMode.CrashThreshold = -1 },
+			wantText: "safe_mode.crash_threshold",
+		},
+		{
+			name:     "zero window",
+			mutate:   func(c *Config) { c.SafeMode.CrashWindowMinutes = 0 },
+			wantText: "safe_mode.crash_window_minutes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(cfg)
+			err := validateConfig(cfg)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantText)
+		})
+	}
+}
+
+func TestValidateConfig_Window(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Window.DefaultWidth = entity.MinWindowWidth
+	cfg.Window.DefaultHeight = entity.MinWindowHeight
+	require.NoError(t, validateConfig(cfg), "minimum size is allowed")
 
 	tests := []struct {
-		name       string
-		engineType string
-		wantErr    bool
+		name     string
+		mutate   func(*Config)
+		wantText string
 	}{
-		{name: "cef", engineType: EngineTypeCEF, wantErr: false},
-		{name: "webkit", engineType: EngineTypeWebKit, wantErr: false},
-		{name: "empty is invalid", engineType: "", wantErr: true},
-		{name: "invalid", engineType: "netscape", wantErr: true},
+		{
+			name:     "width too small",
+			mutate:   func(c *Config) { c.Window.DefaultWidth = entity.MinWindowWidth - 1 },
+			wantText: "window.default_width",
+		},
+		{
+			name:     "zero height",
+			mutate:   func(c *Config) { c.Window.DefaultHeight = 0 },
+			wantText: "window.default_height",
+		},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := DefaultConfig()
-			cfg.Engine.Type = tt.engineType
-
+			tt.mutate(cfg)
 			err := validateConfig(cfg)
-			if tt.wantErr {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), "engine.type")
-				return
-			}
-			require.NoError(t, err)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantText)
 		})
 	}
 }
 
-func TestValidateConfig_EngineTypeIgnoresEnvOverride(t *testing.T) {
-	t.Run("invalid configured type is still rejected when env override is valid", func(t *testing.T) {
-		t.Setenv("DUMBER_ENGINE", EngineTypeWebKit)
-		cfg := DefaultConfig()
-		cfg.Engine.Type = "netscape"
+func TestValidateConfig_DebugConsoleBufferSize(t *testing.T) {
+	cfg := DefaultConfig()
+	for _, size := range []int{0, 200, maxConsoleBufferSize} {
+		cfg.Debug.ConsoleBufferSize = size
+		require.NoError(t, validateConfig(cfg))
+	}
 
+	for _, size := range []int{-1, maxConsoleBufferSize + 1} {
+		cfg.Debug.ConsoleBufferSize = size
 		err := validateConfig(cfg)
-
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), "engine.type")
-	})
+		assert.Contains(t, err.Error(), "debug.console_buffer_size")
+	}
+}
 
-	t.Run("valid configured type is not rejected when env override is invalid", func(t *testing.T) {
-		t.Setenv("DUMBER_ENGINE", "netscape")
-		cfg := DefaultConfig()
-		cfg.Engine.Type = EngineTypeCEF
+func TestValidateConfig_PrivacyClipboardReadPolicy(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, ClipboardReadPrompt, cfg.Privacy.ClipboardReadPolicy)
+	for _, policy := range []ClipboardReadPolicy{ClipboardReadAllow, ClipboardReadDeny, ClipboardReadPrompt} {
+		cfg.Privacy.ClipboardReadPolicy = policy
+		require.NoError(t, validateConfig(cfg))
+	}
+
+	cfg.Privacy.ClipboardReadPolicy = "ask"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "privacy.clipboard_read_policy")
+}
+
+func TestValidateConfig_PrivacyClearDataOnCloseDomains(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Empty(t, cfg.Privacy.ClearDataOnCloseDomains)
+	cfg.Privacy.ClearDataOnCloseDomains = []string{"bank.example", "*.health.example"}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Privacy.ClearDataOnCloseDomains = []string{"bank.example", "  "}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "privacy.clear_data_on_close_domains[1]")
+}
+
+func TestValidateConfig_PrivacyPanicKey(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Privacy.PanicNeutralURL = "https://news.example/"
+	cfg.Privacy.PanicPIN = "2468"
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Privacy.PanicNeutralURL = "news.example"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "privacy.panic_neutral_url")
 
+	for _, pin := range []string{"12", "12ab", "1234567890123"} {
+		cfg := DefaultConfig()
+		cfg.Privacy.PanicPIN = pin
 		err := validateConfig(cfg)
+		require.Error(t, err, pin)
+		assert.Contains(t, err.Error(), "privacy.panic_pin")
+	}
+}
 
-		require.NoError(t, err)
-	})
+func TestValidateConfig_PrivacyHTTPSOnly(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, HTTPSOnlyOff, cfg.Privacy.HTTPSOnly)
+	for _, mode := range []HTTPSOnlyMode{HTTPSOnlyOff, HTTPSOnlyLenient, HTTPSOnlyStrict} {
+		cfg.Privacy.HTTPSOnly = mode
+		require.NoError(t, validateConfig(cfg))
+	}
+
+	cfg.Privacy.HTTPSOnly = "always"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "privacy.https_only")
 }
 
-func TestValidateConfig_EngineCookiePolicy(t *testing.T) {
+func TestValidateConfig_DebugStartupBudgets(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Debug.StartupBudgets = map[string]int{"config": 50, "ui_deps": 300}
+	require.NoError(t, validateConfig(cfg))
+
 	tests := []struct {
-		name         string
-		cookiePolicy CookiePolicy
-		wantErr      bool
+		name     string
+		budgets  map[string]int
+		wantText string
 	}{
-		{name: "always", cookiePolicy: CookiePolicyAlways, wantErr: false},
-		{name: "no_third_party", cookiePolicy: CookiePolicyNoThirdParty, wantErr: false},
-		{name: "never", cookiePolicy: CookiePolicyNever, wantErr: false},
-		{name: "invalid", cookiePolicy: CookiePolicy("bad_policy"), wantErr: true},
+		{name: "zero budget", budgets: map[string]int{"session": 0}, wantText: "debug.startup_budgets.session"},
+		{name: "negative budget", budgets: map[string]int{"logger": -5}, wantText: "debug.startup_budgets.logger"},
+		{name: "empty phase", budgets: map[string]int{" ": 10}, wantText: "phase name cannot be empty"},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := DefaultConfig()
-			cfg.Engine.CookiePolicy = tt.cookiePolicy
-
+			cfg.Debug.StartupBudgets = tt.budgets
 			err := validateConfig(cfg)
-			if tt.wantErr {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), "engine.cookie_policy")
-				return
-			}
-			require.NoError(t, err)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantText)
 		})
 	}
 }
 
-func TestValidateConfig_WebKitMediaSettings(t *testing.T) {
+func TestValidateConfig_DebugStripCSPDomains(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Debug.StripCSPDomains = []string{"localhost:3000", "*.dev.example.com"}
+	require.NoError(t, validateConfig(cfg))
+
 	tests := []struct {
-		name      string
-		mutate    func(*Config)
-		wantErr   bool
-		wantField string
+		name     string
+		domains  []string
+		wantText string
 	}{
-		{
-			name: "valid gl rendering modes",
-			mutate: func(cfg *Config) {
-				cfg.Engine.WebKit.GLRenderingMode = GLRenderingModeGLES2
-			},
-			wantErr: false,
-		},
-		{
-			name: "invalid gl rendering mode",
-			mutate: func(cfg *Config) {
-				cfg.Engine.WebKit.GLRenderingMode = GLRenderingMode("metal")
-			},
-			wantErr:   true,
-			wantField: "engine.webkit.gl_rendering_mode",
-		},
-		{
-			name: "gstreamer debug level lower bound",
-			mutate: func(cfg *Config) {
-				cfg.Engine.WebKit.GStreamerDebugLevel = 0
-			},
-			wantErr: false,
-		},
-		{
-			name: "gstreamer debug level upper bound",
-			mutate: func(cfg *Config) {
-				cfg.Engine.WebKit.GStreamerDebugLevel = 5
-			},
-			wantErr: false,
-		},
-		{
-			name: "negative gstreamer debug level",
-			mutate: func(cfg *Config) {
-				cfg.Engine.WebKit.GStreamerDebugLevel = -1
-			},
-			wantErr:   true,
-			wantField: "engine.webkit.gstreamer_debug_level",
-		},
-		{
-			name: "too high gstreamer debug level",
-			mutate: func(cfg *Config) {
-				cfg.Engine.WebKit.GStreamerDebugLevel = 6
-			},
-			wantErr:   true,
-			wantField: "engine.webkit.gstreamer_debug_level",
-		},
+		{name: "empty entry", domains: []string{"example.com", " "}, wantText: "debug.strip_csp_domains[1] must not be empty"},
+		{name: "catch-all", domains: []string{"*"}, wantText: "would strip CSP from every site"},
 	}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := DefaultConfig()
-			tt.mutate(cfg)
-
+			cfg.Debug.StripCSPDomains = tt.domains
 			err := validateConfig(cfg)
-			if tt.wantErr {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tt.wantField)
-				return
-			}
-			require.NoError(t, err)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantText)
 		})
 	}
 }
@@ -393,3 +1381,204 @@ func TestValidateConfig_WebKitDefaultProfileIgnoresZeroGPUThreads(t *testing.T)
 	err := validateConfig(cfg)
 	require.NoError(t, err)
 }
+
+func TestValidateConfig_OmniboxResultLimits(t *testing.T) {
+	cfg := DefaultConfig()
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Omnibox.MaxResults = 0
+	cfg.Omnibox.MinQueryLength = -1
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "omnibox.max_results")
+	assert.Contains(t, err.Error(), "omnibox.min_query_length")
+
+	cfg.Omnibox.MaxResults = maxOmniboxMaxResults + 1
+	cfg.Omnibox.MinQueryLength = 0
+	err = validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "omnibox.max_results")
+}
+
+func TestValidateConfig_OmniboxTriggerKey(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Omnibox.TriggerKey = "alt+o"
+	require.NoError(t, validateConfig(cfg))
+
+	for _, key := range []string{"", "   ", "ctrl+l ctrl+k"} {
+		cfg.Omnibox.TriggerKey = key
+		err := validateConfig(cfg)
+		require.Error(t, err, "trigger key %q", key)
+		assert.Contains(t, err.Error(), "omnibox.trigger_key")
+	}
+}
+
+func TestValidateConfig_OmniboxResultKeys(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Omnibox.NextResultKeys = []string{"ctrl+j", "alt+n", "ctrl+shift+Down"}
+	cfg.Omnibox.PreviousResultKeys = []string{}
+	require.NoError(t, validateConfig(cfg))
+
+	tests := []struct {
+		key      string
+		wantText string
+	}{
+		{key: "", wantText: "single key combination"},
+		{key: "ctrl+j ctrl+k", wantText: "single key combination"},
+		{key: "j", wantText: "must use ctrl or alt"},
+		{key: "shift+j", wantText: "must use ctrl or alt"},
+		{key: "ctrl", wantText: "must use ctrl or alt"},
+	}
+	for _, tt := range tests {
+		cfg := DefaultConfig()
+		cfg.Omnibox.PreviousResultKeys = []string{"ctrl+k", tt.key}
+		err := validateConfig(cfg)
+		require.Error(t, err, "key %q", tt.key)
+		assert.Contains(t, err.Error(), "omnibox.previous_result_keys[1]")
+		assert.Contains(t, err.Error(), tt.wantText)
+	}
+}
+
+func TestValidateConfig_OmniboxMatchMode(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, OmniboxMatchModeSubstring, cfg.Omnibox.MatchMode)
+
+	for _, mode := range []OmniboxMatchMode{OmniboxMatchModeSubstring, OmniboxMatchModeFuzzy, OmniboxMatchModePrefix} {
+		cfg.Omnibox.MatchMode = mode
+		require.NoError(t, validateConfig(cfg), "match mode %q", mode)
+	}
+
+	cfg.Omnibox.MatchMode = "regex"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "omnibox.match_mode")
+}
+
+func TestValidateConfig_ForceDark(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, ForceDarkStyleInvert, cfg.Appearance.ForceDarkStyle)
+	cfg.Appearance.ForceDarkDomains = []string{"example.com", "*.example.org"}
+	cfg.Appearance.ForceDarkStyle = ForceDarkStyleCSS
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Appearance.ForceDarkDomains = []string{"example.com", " "}
+	cfg.Appearance.ForceDarkStyle = "sepia"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "appearance.force_dark_domains[1]")
+	assert.Contains(t, err.Error(), "appearance.force_dark_style")
+}
+
+func TestValidateConfig_WorkspaceBackground(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, WorkspaceBackgroundPatternNone, cfg.Appearance.WorkspaceBackground.Pattern)
+	cfg.Appearance.WorkspaceBackground = WorkspaceBackgroundConfig{
+		Color:   "#1e1e2e",
+		Image:   "~/Pictures/wall.png",
+		Pattern: WorkspaceBackgroundPatternDots,
+	}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Appearance.WorkspaceBackground = WorkspaceBackgroundConfig{
+		Color:   "red",
+		Image:   "Pictures/wall.png",
+		Pattern: "zigzag",
+	}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "appearance.workspace_background.color")
+	assert.Contains(t, err.Error(), "appearance.workspace_background.image")
+	assert.Contains(t, err.Error(), "appearance.workspace_background.pattern")
+}
+
+func TestValidateConfig_FontOverrides(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Empty(t, cfg.Appearance.FontOverrides)
+	cfg.Appearance.FontOverrides = []FontOverride{
+		{Domain: "*.example.com", SansFont: "Inter", DefaultFontSize: 18},
+		{Domain: "docs.example.com", MonospaceFont: "JetBrains Mono"},
+	}
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Appearance.FontOverrides = []FontOverride{
+		{Domain: " ", SerifFont: "Georgia"},
+		{Domain: "example.org"},
+		{Domain: "example.net", DefaultFontSize: 100},
+		{Domain: "example.io", SansFont: "Inter\nBold"},
+	}
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "appearance.font_overrides[0].domain must not be empty")
+	assert.Contains(t, err.Error(), "appearance.font_overrides[1] must set sans, serif, mono or size")
+	assert.Contains(t, err.Error(), "appearance.font_overrides[2].size")
+	assert.Contains(t, err.Error(), "appearance.font_overrides[3].sans")
+}
+
+func TestValidateConfig_SessionRestoreMode(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, SessionRestoreAll, cfg.Session.RestoreMode)
+
+	cfg.Session.RestoreMode = SessionRestorePinned
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Session.RestoreMode = "recent"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "session.restore_mode")
+}
+
+func TestValidateConfig_SessionRestoreStrategy(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, SessionRestoreReplace, cfg.Session.RestoreStrategy)
+
+	cfg.Session.RestoreStrategy = SessionRestoreMerge
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Session.RestoreStrategy = "append"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "session.restore_strategy")
+}
+
+func TestValidateConfig_FaviconFallback(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, FaviconFallbackMonogram, cfg.Appearance.FaviconFallback)
+
+	cfg.Appearance.FaviconFallback = FaviconFallbackIcon
+	require.NoError(t, validateConfig(cfg))
+
+	cfg.Appearance.FaviconFallback = "initials"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "appearance.favicon_fallback")
+}
+
+func TestValidateConfig_WorkspaceTabBarMode(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, TabBarModeBar, cfg.Workspace.TabBarMode)
+
+	for _, mode := range []TabBarMode{TabBarModeBar, TabBarModeHidden, TabBarModeOverview} {
+		cfg.Workspace.TabBarMode = mode
+		require.NoError(t, validateConfig(cfg))
+	}
+
+	cfg.Workspace.TabBarMode = "strip"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.tab_bar_mode")
+}
+
+func TestValidateConfig_WorkspaceStackSwipe(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, StackSwipeTitleBar, cfg.Workspace.StackSwipe)
+
+	for _, mode := range []StackSwipeMode{StackSwipeTitleBar, StackSwipeAlt, StackSwipeOff} {
+		cfg.Workspace.StackSwipe = mode
+		require.NoError(t, validateConfig(cfg))
+	}
+
+	cfg.Workspace.StackSwipe = "always"
+	err := validateConfig(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace.stack_swipe")
+}
@@ -2,8 +2,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/bnema/dumber/internal/infrastructure/runtimeprofile"
 )
@@ -138,6 +140,41 @@ func GetLogDir() (string, error) {
 	return profile.Shared.LogDir, nil
 }
 
+// ResolveUserScriptsDir returns the directory user scripts are loaded from.
+// An empty dir resolves to "userscripts" inside the config directory and a
+// leading "~/" expands to the home directory.
+func ResolveUserScriptsDir(dir string) (string, error) {
+	return resolveConfigPath(dir, "userscripts")
+}
+
+// ResolveRequestMocksFile returns the file request mocks are loaded from. An
+// empty path resolves to "mocks.toml" inside the config directory and a
+// leading "~/" expands to the home directory.
+func ResolveRequestMocksFile(path string) (string, error) {
+	return resolveConfigPath(path, "mocks.toml")
+}
+
+// resolveConfigPath resolves a user-set path, falling back to defaultName
+// inside the config directory when it is empty.
+func resolveConfigPath(path, defaultName string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		configDir, err := GetConfigDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(configDir, defaultName), nil
+	}
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolve home directory: %w", err)
+		}
+		return filepath.Join(homeDir, rest), nil
+	}
+	return filepath.Clean(path), nil
+}
+
 // GetConfigFile returns the path to the main configuration file.
 func GetConfigFile() (string, error) {
 	configDir, err := GetConfigDir()
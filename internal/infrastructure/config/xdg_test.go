@@ -47,3 +47,37 @@ func TestGetXDGDirs_DevUsesSharedSandboxLayout(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, filepath.Join(wd, ".dev", appName, "logs"), logDir)
 }
+
+func TestResolveUserScriptsDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("ENV", "")
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+
+	dir, err := ResolveUserScriptsDir("")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(home, ".config", appName, "userscripts"), dir)
+
+	dir, err = ResolveUserScriptsDir("~/scripts")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(home, "scripts"), dir)
+
+	dir, err = ResolveUserScriptsDir("/opt/scripts/")
+	require.NoError(t, err)
+	require.Equal(t, "/opt/scripts", dir)
+}
+
+func TestResolveRequestMocksFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("ENV", "")
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+
+	file, err := ResolveRequestMocksFile("")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(home, ".config", appName, "mocks.toml"), file)
+
+	file, err = ResolveRequestMocksFile("~/dev/mocks.toml")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(home, "dev", "mocks.toml"), file)
+}
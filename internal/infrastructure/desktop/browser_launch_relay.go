@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/infrastructure/runtimeprofile"
 	"github.com/bnema/dumber/internal/logging"
 )
@@ -38,15 +39,37 @@ type browserLaunchRelay struct {
 	ipc runtimeprofile.IPCPaths
 }
 
+// Commands accepted on the browser launch socket in addition to the default
+// open-fresh-window request (empty command).
+const (
+	browserLaunchCommandGetZoom  = "get_zoom"
+	browserLaunchCommandSetZoom  = "set_zoom"
+	browserLaunchCommandSavePage = "save_page"
+)
+
+// browserLaunchSavePageTimeout bounds a save_page request when the caller has
+// no deadline of its own. Saving loads the page first, so it can take far
+// longer than the other commands.
+const browserLaunchSavePageTimeout = 2 * time.Minute
+
+// ErrBrowserNotRunning reports that no browser instance is listening on the
+// browser launch socket.
+var ErrBrowserNotRunning = errors.New("no running browser instance")
+
 type browserLaunchRequest struct {
-	RequestID string `json:"request_id,omitempty"`
-	URL       string `json:"url"`
+	RequestID string   `json:"request_id,omitempty"`
+	Command   string   `json:"command,omitempty"`
+	URL       string   `json:"url"`
+	Zoom      *float64 `json:"zoom,omitempty"`
+	Path      string   `json:"path,omitempty"`
+	Format    string   `json:"format,omitempty"`
 }
 
 type browserLaunchResponse struct {
-	RequestID string `json:"request_id,omitempty"`
-	Accepted  bool   `json:"accepted,omitempty"`
-	Error     string `json:"error,omitempty"`
+	RequestID string   `json:"request_id,omitempty"`
+	Accepted  bool     `json:"accepted,omitempty"`
+	Zoom      *float64 `json:"zoom,omitempty"`
+	Error     string   `json:"error,omitempty"`
 }
 
 type browserLaunchRelayListener struct {
@@ -68,6 +91,71 @@ func NewBrowserLaunchRelay(ipc runtimeprofile.IPCPaths) port.BrowserLaunchRelay
 	return &browserLaunchRelay{ipc: ipc}
 }
 
+// NewPageSaveRequester returns a client that asks the browser listening on the
+// profile's launch socket to save pages.
+func NewPageSaveRequester(ipc runtimeprofile.IPCPaths) port.PageSaveRequester {
+	return &browserLaunchRelay{ipc: ipc}
+}
+
+// RequestSavePage asks the running browser to load url and save it to path.
+// It blocks until the browser reports completion, ctx ends, or
+// browserLaunchSavePageTimeout elapses when ctx has no deadline.
+func (r *browserLaunchRelay) RequestSavePage(
+	ctx context.Context,
+	url, path string,
+	format entity.PageSaveFormat,
+) error {
+	socketPath, err := r.socketPath()
+	if err != nil {
+		return err
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, browserLaunchSavePageTimeout)
+		defer cancel()
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+	if err != nil {
+		if isMissingRelayListener(err) {
+			return ErrBrowserNotRunning
+		}
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	requestID := newBrowserLaunchRequestID()
+	request := browserLaunchRequest{
+		RequestID: requestID,
+		Command:   browserLaunchCommandSavePage,
+		URL:       url,
+		Path:      path,
+		Format:    string(format),
+	}
+	if err := json.NewEncoder(conn).Encode(request); err != nil {
+		return err
+	}
+
+	var response browserLaunchResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		if isBrowserLaunchReadTimeout(err) {
+			return fmt.Errorf("save page: %w", context.DeadlineExceeded)
+		}
+		return err
+	}
+	if response.RequestID != "" && response.RequestID != requestID {
+		return fmt.Errorf("mismatched browser launch relay response request id: got %q, want %q", response.RequestID, requestID)
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+	return nil
+}
+
 func (r *browserLaunchRelay) DeliverOpenFreshWindow(ctx context.Context, url string) (bool, error) {
 	socketPath, err := r.socketPath()
 	if err != nil {
@@ -321,6 +409,20 @@ func (*browserLaunchRelayListener) handleConnection(ctx context.Context, conn *n
 	if requestID == "" {
 		requestID = newBrowserLaunchRequestID()
 	}
+	if request.Command != "" {
+		response := handleBrowserLaunchCommand(ctx, request, opener)
+		response.RequestID = requestID
+		if err := conn.SetDeadline(time.Now().Add(browserLaunchIOTimeout)); err != nil {
+			return
+		}
+		if err := json.NewEncoder(conn).Encode(response); err != nil {
+			log.Warn().Err(err).
+				Str("request_id", requestID).
+				Str("command", request.Command).
+				Msg("failed to encode browser launch command response")
+		}
+		return
+	}
 	log.Debug().
 		Str("request_id", requestID).
 		Str("url_host", safeURLHost(request.URL)).
@@ -370,4 +472,76 @@ func (*browserLaunchRelayListener) handleConnection(ctx context.Context, conn *n
 	}()
 }
 
-var _ port.BrowserLaunchRelay = (*browserLaunchRelay)(nil)
+// handleBrowserLaunchCommand runs a command synchronously against the opener,
+// which must implement the capability port the command needs.
+func handleBrowserLaunchCommand(
+	ctx context.Context,
+	request browserLaunchRequest,
+	opener port.BrowserWindowOpener,
+) browserLaunchResponse {
+	switch request.Command {
+	case browserLaunchCommandGetZoom, browserLaunchCommandSetZoom:
+		if controller, ok := opener.(port.ActivePaneZoomController); ok {
+			return handleBrowserLaunchZoomCommand(ctx, request, controller)
+		}
+	case browserLaunchCommandSavePage:
+		if controller, ok := opener.(port.PageSaveController); ok {
+			return handleBrowserLaunchSavePageCommand(ctx, request, controller)
+		}
+	}
+	return browserLaunchResponse{Error: fmt.Sprintf("unsupported command %q", request.Command)}
+}
+
+func handleBrowserLaunchZoomCommand(
+	ctx context.Context,
+	request browserLaunchRequest,
+	controller port.ActivePaneZoomController,
+) browserLaunchResponse {
+	var zoom float64
+	var err error
+	if request.Command == browserLaunchCommandSetZoom {
+		if request.Zoom == nil {
+			return browserLaunchResponse{Error: "set_zoom requires a zoom value"}
+		}
+		zoom, err = controller.SetActivePaneZoom(ctx, *request.Zoom)
+	} else {
+		zoom, err = controller.GetActivePaneZoom(ctx)
+	}
+	if err != nil {
+		return browserLaunchResponse{Error: err.Error()}
+	}
+	return browserLaunchResponse{Accepted: true, Zoom: &zoom}
+}
+
+func handleBrowserLaunchSavePageCommand(
+	ctx context.Context,
+	request browserLaunchRequest,
+	controller port.PageSaveController,
+) browserLaunchResponse {
+	if request.URL == "" {
+		return browserLaunchResponse{Error: "save_page requires a url"}
+	}
+	if request.Path == "" || !filepath.IsAbs(request.Path) {
+		return browserLaunchResponse{Error: "save_page requires an absolute path"}
+	}
+	format := entity.PageSaveFormatForPath(request.Path)
+	if request.Format != "" {
+		parsed, err := entity.ParsePageSaveFormat(request.Format)
+		if err != nil {
+			return browserLaunchResponse{Error: err.Error()}
+		}
+		format = parsed
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, browserLaunchSavePageTimeout)
+	defer cancel()
+	if err := controller.SavePageURL(ctx, request.URL, request.Path, format); err != nil {
+		return browserLaunchResponse{Error: err.Error()}
+	}
+	return browserLaunchResponse{Accepted: true}
+}
+
+var (
+	_ port.BrowserLaunchRelay = (*browserLaunchRelay)(nil)
+	_ port.PageSaveRequester  = (*browserLaunchRelay)(nil)
+)
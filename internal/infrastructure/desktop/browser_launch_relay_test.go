@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/infrastructure/runtimeprofile"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -593,3 +594,154 @@ func TestBrowserLaunchRelay_SilentClientDoesNotStallListener(t *testing.T) {
 		t.Fatal("expected opener to receive the URL")
 	}
 }
+
+type zoomControllerOpener struct {
+	browserWindowOpenerFunc
+	zoom float64
+}
+
+func (o *zoomControllerOpener) GetActivePaneZoom(context.Context) (float64, error) {
+	return o.zoom, nil
+}
+
+func (o *zoomControllerOpener) SetActivePaneZoom(_ context.Context, level float64) (float64, error) {
+	o.zoom = min(level, 5.0)
+	return o.zoom, nil
+}
+
+func sendBrowserLaunchCommand(t *testing.T, socketPath string, request browserLaunchRequest) browserLaunchResponse {
+	t.Helper()
+
+	conn, err := net.Dial("unix", socketPath)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(time.Second)))
+	require.NoError(t, json.NewEncoder(conn).Encode(request))
+
+	var response browserLaunchResponse
+	require.NoError(t, json.NewDecoder(conn).Decode(&response))
+	return response
+}
+
+func TestBrowserLaunchRelay_ZoomCommands(t *testing.T) {
+	ipc := testIPC(shortTempDir(t))
+	relay := NewBrowserLaunchRelay(ipc)
+	opener := &zoomControllerOpener{
+		browserWindowOpenerFunc: func(context.Context, string) error {
+			t.Fatal("zoom commands must not open a window")
+			return nil
+		},
+		zoom: 1.0,
+	}
+
+	closer, err := relay.Listen(t.Context(), opener)
+	require.NoError(t, err)
+	defer closer.Close()
+	waitForSocket(t, ipc.BrowserLaunchSocket)
+
+	got := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{RequestID: "zoom-1", Command: "get_zoom"})
+	assert.Empty(t, got.Error)
+	assert.Equal(t, "zoom-1", got.RequestID)
+	require.NotNil(t, got.Zoom)
+	assert.InDelta(t, 1.0, *got.Zoom, 1e-9)
+
+	level := 9.0
+	set := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{Command: "set_zoom", Zoom: &level})
+	assert.Empty(t, set.Error)
+	assert.True(t, set.Accepted)
+	require.NotNil(t, set.Zoom)
+	assert.InDelta(t, 5.0, *set.Zoom, 1e-9)
+
+	missing := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{Command: "set_zoom"})
+	assert.Contains(t, missing.Error, "requires a zoom value")
+
+	unknown := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{Command: "reboot"})
+	assert.Contains(t, unknown.Error, "unsupported command")
+}
+
+func TestBrowserLaunchRelay_ZoomCommandsRequireController(t *testing.T) {
+	ipc := testIPC(shortTempDir(t))
+	relay := NewBrowserLaunchRelay(ipc)
+
+	closer, err := relay.Listen(t.Context(), browserWindowOpenerFunc(func(context.Context, string) error {
+		return nil
+	}))
+	require.NoError(t, err)
+	defer closer.Close()
+	waitForSocket(t, ipc.BrowserLaunchSocket)
+
+	got := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{Command: "get_zoom"})
+	assert.Contains(t, got.Error, "unsupported command")
+	assert.Nil(t, got.Zoom)
+}
+
+type pageSaveControllerOpener struct {
+	browserWindowOpenerFunc
+	url    string
+	path   string
+	format entity.PageSaveFormat
+	err    error
+}
+
+func (o *pageSaveControllerOpener) SavePageURL(_ context.Context, url, path string, format entity.PageSaveFormat) error {
+	o.url, o.path, o.format = url, path, format
+	return o.err
+}
+
+func TestBrowserLaunchRelay_RequestSavePage_RoundTrip(t *testing.T) {
+	ipc := testIPC(shortTempDir(t))
+	opener := &pageSaveControllerOpener{
+		browserWindowOpenerFunc: func(context.Context, string) error {
+			t.Fatal("save_page must not open a window")
+			return nil
+		},
+	}
+
+	closer, err := NewBrowserLaunchRelay(ipc).Listen(t.Context(), opener)
+	require.NoError(t, err)
+	defer closer.Close()
+	waitForSocket(t, ipc.BrowserLaunchSocket)
+
+	requester := NewPageSaveRequester(ipc)
+	err = requester.RequestSavePage(t.Context(), "https://example.com/", "/tmp/page.html", entity.PageSaveFormatHTMLComplete)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/", opener.url)
+	assert.Equal(t, "/tmp/page.html", opener.path)
+	assert.Equal(t, entity.PageSaveFormatHTMLComplete, opener.format)
+
+	opener.err = errors.New("load failed")
+	err = requester.RequestSavePage(t.Context(), "https://example.com/", "/tmp/page.mhtml", entity.PageSaveFormatMHTML)
+	assert.EqualError(t, err, "load failed")
+}
+
+func TestBrowserLaunchRelay_SavePageCommandValidatesRequest(t *testing.T) {
+	ipc := testIPC(shortTempDir(t))
+	opener := &pageSaveControllerOpener{}
+
+	closer, err := NewBrowserLaunchRelay(ipc).Listen(t.Context(), opener)
+	require.NoError(t, err)
+	defer closer.Close()
+	waitForSocket(t, ipc.BrowserLaunchSocket)
+
+	relative := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{
+		Command: "save_page", URL: "https://example.com/", Path: "page.mhtml",
+	})
+	assert.Contains(t, relative.Error, "absolute path")
+
+	badFormat := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{
+		Command: "save_page", URL: "https://example.com/", Path: "/tmp/page", Format: "pdf",
+	})
+	assert.Contains(t, badFormat.Error, "unknown page save format")
+
+	inferred := sendBrowserLaunchCommand(t, ipc.BrowserLaunchSocket, browserLaunchRequest{
+		Command: "save_page", URL: "https://example.com/", Path: "/tmp/page.htm",
+	})
+	assert.Empty(t, inferred.Error)
+	assert.Equal(t, entity.PageSaveFormatHTMLComplete, opener.format)
+}
+
+func TestBrowserLaunchRelay_RequestSavePage_NoListener(t *testing.T) {
+	ipc := testIPC(shortTempDir(t))
+	err := NewPageSaveRequester(ipc).RequestSavePage(t.Context(), "https://example.com/", "/tmp/page.mhtml", entity.PageSaveFormatMHTML)
+	assert.ErrorIs(t, err, ErrBrowserNotRunning)
+}
@@ -0,0 +1,236 @@
+package desktop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	portalDest       = "org.freedesktop.portal.Desktop"
+	portalPath       = "/org/freedesktop/portal/desktop"
+	openURIInterface = "org.freedesktop.portal.OpenURI"
+	requestInterface = "org.freedesktop.portal.Request"
+
+	// Request::Response codes from the portal spec. Any other code means the
+	// request failed.
+	portalResponseSuccess   = 0
+	portalResponseCancelled = 1
+
+	// shareResponseTimeout bounds how long a share waits for the user to pick
+	// an application before falling back.
+	shareResponseTimeout = 2 * time.Minute
+)
+
+// Compile-time interface check.
+var _ port.URLSharer = (*PortalSharer)(nil)
+
+// openURIPortal sends OpenURI requests and reports the code of the portal's
+// Request::Response signal.
+type openURIPortal interface {
+	OpenURI(ctx context.Context, uri string, options map[string]dbus.Variant) (uint32, error)
+	Close() error
+}
+
+// PortalSharer shares URLs through the XDG Desktop Portal OpenURI interface.
+// The portal asks the user which application should receive the URL, which
+// works on Wayland and inside Flatpak without talking to apps directly.
+//
+// The session bus is only contacted on the first share, so startup never
+// waits on D-Bus.
+type PortalSharer struct {
+	connect func(ctx context.Context) (openURIPortal, error)
+	portal  openURIPortal
+	dialed  bool
+	timeout time.Duration
+
+	mu sync.Mutex
+}
+
+// NewPortalSharer creates a new portal-based URL sharer.
+// It always returns a functional sharer (graceful degradation): when D-Bus or
+// the portal is missing, ShareURL reports port.ErrShareUnavailable.
+func NewPortalSharer() *PortalSharer {
+	return &PortalSharer{
+		connect: connectOpenURIPortal,
+		timeout: shareResponseTimeout,
+	}
+}
+
+// ShareURL asks the portal to hand uri to an application the user picks, and
+// waits for the user's choice. OpenURI has no field for a title, so only the
+// URL is shared.
+func (s *PortalSharer) ShareURL(ctx context.Context, uri, _ string) error {
+	log := logging.FromContext(ctx)
+
+	portal := s.portalFor(ctx)
+	if portal == nil {
+		return port.ErrShareUnavailable
+	}
+
+	options := map[string]dbus.Variant{
+		// Always show the application chooser instead of the default handler,
+		// which for web pages is usually this browser.
+		"ask": dbus.MakeVariant(true),
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	response, err := portal.OpenURI(waitCtx, uri, options)
+	if err != nil {
+		return fmt.Errorf("portal open uri: %w", err)
+	}
+	switch response {
+	case portalResponseSuccess:
+		log.Debug().Str("uri", uri).Msg("share portal: application chosen")
+		return nil
+	case portalResponseCancelled:
+		return port.ErrShareCancelled
+	default:
+		return fmt.Errorf("portal open uri: request failed with response %d", response)
+	}
+}
+
+// portalFor returns the portal connection, connecting on first use. A failed
+// connection is not retried.
+func (s *PortalSharer) portalFor(ctx context.Context) openURIPortal {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dialed || s.connect == nil {
+		return s.portal
+	}
+	s.dialed = true
+
+	portal, err := s.connect(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Debug().Err(err).Msg("share portal: not available")
+		return nil
+	}
+	s.portal = portal
+	return portal
+}
+
+// Close releases the D-Bus connection. A share still waiting for the user
+// then returns an error.
+func (s *PortalSharer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dialed = true
+	if s.portal != nil {
+		err := s.portal.Close()
+		s.portal = nil
+		return err
+	}
+	return nil
+}
+
+// dbusOpenURIPortal talks to the OpenURI portal over the session bus.
+type dbusOpenURIPortal struct {
+	conn      *dbus.Conn
+	nextToken atomic.Uint64
+}
+
+func connectOpenURIPortal(ctx context.Context) (openURIPortal, error) {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("connect to session bus: %w", err)
+	}
+
+	var version uint32
+	err = conn.Object(portalDest, portalPath).CallWithContext(ctx, "org.freedesktop.DBus.Properties.Get", 0,
+		openURIInterface, "version").Store(&version)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("read portal version: %w", err)
+	}
+
+	logging.FromContext(ctx).Debug().Uint32("version", version).Msg("share portal: portal available")
+	return &dbusOpenURIPortal{conn: conn}, nil
+}
+
+// OpenURI sends the request and waits for its Response signal. The signal
+// subscription is in place before the call, on the request path the portal
+// derives from handle_token, so a portal that answers at once is not missed.
+func (p *dbusOpenURIPortal) OpenURI(ctx context.Context, uri string, options map[string]dbus.Variant) (uint32, error) {
+	token := fmt.Sprintf("dumber_share_%d", p.nextToken.Add(1))
+	options["handle_token"] = dbus.MakeVariant(token)
+
+	var expected dbus.ObjectPath
+	if names := p.conn.Names(); len(names) > 0 {
+		expected = portalRequestPath(names[0], token)
+	}
+
+	signals := make(chan *dbus.Signal, 4)
+	p.conn.Signal(signals)
+	defer p.conn.RemoveSignal(signals)
+
+	match := []dbus.MatchOption{
+		dbus.WithMatchInterface(requestInterface),
+		dbus.WithMatchMember("Response"),
+	}
+	if err := p.conn.AddMatchSignalContext(ctx, match...); err != nil {
+		return 0, fmt.Errorf("subscribe to portal response: %w", err)
+	}
+	defer func() { _ = p.conn.RemoveMatchSignal(match...) }()
+
+	// OpenURI(parent_window: s, uri: s, options: a{sv}) -> handle: o
+	var handle dbus.ObjectPath
+	err := p.conn.Object(portalDest, portalPath).CallWithContext(ctx, openURIInterface+".OpenURI", 0,
+		"", // window identifier (empty for non-sandboxed)
+		uri,
+		options,
+	).Store(&handle)
+	if err != nil {
+		return 0, err
+	}
+
+	return waitForPortalResponse(ctx, signals, expected, handle)
+}
+
+func (p *dbusOpenURIPortal) Close() error {
+	return p.conn.Close()
+}
+
+// portalRequestPath returns the Request object path the portal uses for a
+// request sent by sender with the given handle_token.
+func portalRequestPath(sender, token string) dbus.ObjectPath {
+	sender = strings.ReplaceAll(strings.TrimPrefix(sender, ":"), ".", "_")
+	return dbus.ObjectPath(portalPath + "/request/" + sender + "/" + token)
+}
+
+// waitForPortalResponse returns the response code of the first Response
+// signal emitted on one of paths.
+func waitForPortalResponse(ctx context.Context, signals <-chan *dbus.Signal, paths ...dbus.ObjectPath) (uint32, error) {
+	for {
+		select {
+		case sig, ok := <-signals:
+			if !ok || sig == nil {
+				return 0, errors.New("portal connection closed")
+			}
+			if sig.Name != requestInterface+".Response" || !slices.Contains(paths, sig.Path) {
+				continue
+			}
+			if len(sig.Body) == 0 {
+				return 0, errors.New("portal response without a code")
+			}
+			code, ok := sig.Body[0].(uint32)
+			if !ok {
+				return 0, fmt.Errorf("portal response code has type %T", sig.Body[0])
+			}
+			return code, nil
+		case <-ctx.Done():
+			return 0, fmt.Errorf("waiting for portal response: %w", ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package desktop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+)
+
+type fakeOpenURIPortal struct {
+	response uint32
+	err      error
+	block    bool
+	uris     []string
+	closed   bool
+}
+
+func (p *fakeOpenURIPortal) OpenURI(ctx context.Context, uri string, options map[string]dbus.Variant) (uint32, error) {
+	p.uris = append(p.uris, uri)
+	if ask, ok := options["ask"].Value().(bool); !ok || !ask {
+		return 0, errors.New("ask option not set")
+	}
+	if p.block {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	return p.response, p.err
+}
+
+func (p *fakeOpenURIPortal) Close() error {
+	p.closed = true
+	return nil
+}
+
+func newTestPortalSharer(portal *fakeOpenURIPortal, connects *int) *PortalSharer {
+	return &PortalSharer{
+		connect: func(context.Context) (openURIPortal, error) {
+			*connects++
+			return portal, nil
+		},
+		timeout: time.Second,
+	}
+}
+
+func TestPortalSharer_ShareURL_ReportsUnavailableWithoutPortal(t *testing.T) {
+	sharer := &PortalSharer{}
+
+	err := sharer.ShareURL(context.Background(), "https://example.com", "Example")
+
+	require.ErrorIs(t, err, port.ErrShareUnavailable)
+	assert.NoError(t, sharer.Close())
+}
+
+func TestPortalSharer_ConnectsOnFirstShare(t *testing.T) {
+	portal := &fakeOpenURIPortal{response: portalResponseSuccess}
+	connects := 0
+	sharer := newTestPortalSharer(portal, &connects)
+	assert.Zero(t, connects)
+
+	require.NoError(t, sharer.ShareURL(context.Background(), "https://example.com", ""))
+	require.NoError(t, sharer.ShareURL(context.Background(), "https://example.org", ""))
+
+	assert.Equal(t, 1, connects)
+	assert.Equal(t, []string{"https://example.com", "https://example.org"}, portal.uris)
+	require.NoError(t, sharer.Close())
+	assert.True(t, portal.closed)
+}
+
+func TestPortalSharer_DoesNotRetryAFailedConnection(t *testing.T) {
+	connects := 0
+	sharer := &PortalSharer{
+		connect: func(context.Context) (openURIPortal, error) {
+			connects++
+			return nil, errors.New("no session bus")
+		},
+		timeout: time.Second,
+	}
+
+	require.ErrorIs(t, sharer.ShareURL(context.Background(), "https://example.com", ""), port.ErrShareUnavailable)
+	require.ErrorIs(t, sharer.ShareURL(context.Background(), "https://example.com", ""), port.ErrShareUnavailable)
+	assert.Equal(t, 1, connects)
+}
+
+func TestPortalSharer_ShareURL_ReportsPortalResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		portal  *fakeOpenURIPortal
+		wantErr error
+		failed  bool
+	}{
+		{name: "application chosen", portal: &fakeOpenURIPortal{response: portalResponseSuccess}},
+		{name: "chooser cancelled", portal: &fakeOpenURIPortal{response: portalResponseCancelled}, wantErr: port.ErrShareCancelled},
+		{name: "request failed", portal: &fakeOpenURIPortal{response: 2}, failed: true},
+		{name: "call failed", portal: &fakeOpenURIPortal{err: errors.New("org.freedesktop.DBus.Error.NoReply")}, failed: true},
+		{name: "no answer", portal: &fakeOpenURIPortal{block: true}, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connects := 0
+			sharer := newTestPortalSharer(tt.portal, &connects)
+			sharer.timeout = 10 * time.Millisecond
+
+			err := sharer.ShareURL(context.Background(), "https://example.com", "")
+
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+			case tt.failed:
+				require.Error(t, err)
+				assert.NotErrorIs(t, err, port.ErrShareCancelled)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPortalRequestPath(t *testing.T) {
+	assert.Equal(t,
+		dbus.ObjectPath("/org/freedesktop/portal/desktop/request/1_42/dumber_share_1"),
+		portalRequestPath(":1.42", "dumber_share_1"))
+}
+
+func TestWaitForPortalResponse(t *testing.T) {
+	const path = dbus.ObjectPath("/org/freedesktop/portal/desktop/request/1_42/dumber_share_1")
+
+	t.Run("skips other signals", func(t *testing.T) {
+		signals := make(chan *dbus.Signal, 3)
+		signals <- &dbus.Signal{Path: "/other", Name: requestInterface + ".Response", Body: []any{uint32(0)}}
+		signals <- &dbus.Signal{Path: path, Name: "org.freedesktop.portal.Other", Body: []any{uint32(0)}}
+		signals <- &dbus.Signal{Path: path, Name: requestInterface + ".Response", Body: []any{uint32(1), map[string]dbus.Variant{}}}
+
+		code, err := waitForPortalResponse(context.Background(), signals, path)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint32(portalResponseCancelled), code)
+	})
+
+	t.Run("connection closed", func(t *testing.T) {
+		signals := make(chan *dbus.Signal)
+		close(signals)
+
+		_, err := waitForPortalResponse(context.Background(), signals, path)
+
+		require.Error(t, err)
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := waitForPortalResponse(ctx, make(chan *dbus.Signal), path)
+
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -8,12 +8,12 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"strings"
 	"sync"
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/shared/pathutil"
 )
 
 const (
@@ -64,7 +64,7 @@ func (s *FileSource) Configure(cfg entity.ExternalThemeConfig) {
 	enabled := cfg.Enabled && provider == providerName && isSupportedFormat(format)
 	path := strings.TrimSpace(cfg.Path)
 	identityPath := path
-	if expandedPath, err := ExpandPath(path); err == nil {
+	if expandedPath, err := pathutil.ExpandPath(path); err == nil {
 		identityPath = expandedPath
 	}
 
@@ -105,7 +105,7 @@ func (s *FileSource) Get(ctx context.Context) (*entity.ExternalTheme, error) {
 		return nil, err
 	}
 
-	path, err := ExpandPath(pathConfig)
+	path, err := pathutil.ExpandPath(pathConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -131,26 +131,6 @@ func (s *FileSource) Get(ctx context.Context) (*entity.ExternalTheme, error) {
 	}
 }
 
-// ExpandPath expands environment variables, leading ~, and cleans the path.
-func ExpandPath(path string) (string, error) {
-	expanded := os.ExpandEnv(strings.TrimSpace(path))
-	if expanded == "" {
-		return "", nil
-	}
-	if expanded == "~" || strings.HasPrefix(expanded, "~/") {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return "", fmt.Errorf("expand home directory: %w", err)
-		}
-		if expanded == "~" {
-			expanded = home
-		} else {
-			expanded = filepath.Join(home, expanded[2:])
-		}
-	}
-	return filepath.Clean(expanded), nil
-}
-
 // ParseColorsJSON parses Noctalia's native colors.json file.
 //
 // Noctalia colors.json represents the active palette only, so Dumber applies the
@@ -220,21 +220,6 @@ func TestParseDumberJSONRejectsTrailingTokens(t *testing.T) {
 	}
 }
 
-func TestExpandPath(t *testing.T) {
-	t.Setenv("NOCTALIA_THEME_TEST", "theme.json")
-	home := t.TempDir()
-	t.Setenv("HOME", home)
-
-	got, err := ExpandPath("~/$NOCTALIA_THEME_TEST")
-	if err != nil {
-		t.Fatalf("ExpandPath() error = %v", err)
-	}
-	want := filepath.Join(home, "theme.json")
-	if got != want {
-		t.Fatalf("ExpandPath() = %q, want %q", got, want)
-	}
-}
-
 func TestFileSourceIdentityUsesExpandedPath(t *testing.T) {
 	home := t.TempDir()
 	t.Setenv("HOME", home)
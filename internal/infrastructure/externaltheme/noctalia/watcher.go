@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/shared/pathutil"
 	"github.com/fsnotify/fsnotify"
 )
 
@@ -176,7 +177,7 @@ func watchPathFromConfig(cfg entity.ExternalThemeConfig) (string, bool, error) {
 	if !enabled {
 		return "", false, nil
 	}
-	path, err := ExpandPath(cfg.Path)
+	path, err := pathutil.ExpandPath(cfg.Path)
 	if err != nil {
 		return "", false, err
 	}
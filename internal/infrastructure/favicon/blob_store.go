@@ -133,6 +133,7 @@ func readNonEmpty(path string) ([]byte, error) {
 	if len(data) == 0 {
 		return nil, appport.ErrFaviconMiss
 	}
+	touchAccess(path)
 	return data, nil
 }
 
@@ -283,6 +283,7 @@ func (c *Cache) loadFromDisk(domain string) []byte {
 		return nil
 	}
 
+	touchAccess(path)
 	return data
 }
 
@@ -0,0 +1,226 @@
+package favicon
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// CacheLimits bounds the on-disk favicon cache. Zero values mean unlimited.
+type CacheLimits struct {
+	MaxBytes   int64
+	MaxEntries int
+}
+
+// Unlimited reports whether no limit is set.
+func (l CacheLimits) Unlimited() bool {
+	return l.MaxBytes <= 0 && l.MaxEntries <= 0
+}
+
+// CacheStats describes the on-disk favicon cache.
+type CacheStats struct {
+	Dir     string
+	Entries int
+	Files   int
+	Bytes   int64
+	// Oldest and Newest are the last access times of the least and most
+	// recently used entries. Zero when the cache is empty.
+	Oldest time.Time
+	Newest time.Time
+}
+
+// GCResult reports what an eviction pass removed.
+type GCResult struct {
+	EvictedEntries int
+	EvictedFiles   int
+	FreedBytes     int64
+	Remaining      CacheStats
+}
+
+// cacheEntry groups every file cached for one favicon key: the original,
+// its content-type sidecar, the PNG export and its sized variants.
+type cacheEntry struct {
+	name       string
+	files      []string
+	bytes      int64
+	lastAccess time.Time
+}
+
+// Stats scans the disk cache.
+func (s *Service) Stats() (CacheStats, error) {
+	entries, err := s.scanEntries()
+	if err != nil {
+		return CacheStats{}, err
+	}
+	return statsFor(s.cache.diskDir, entries), nil
+}
+
+// GC evicts the least recently accessed favicons until the disk cache fits
+// limits. Evicted favicons are fetched again the next time a page needs them.
+func (s *Service) GC(ctx context.Context, limits CacheLimits) (GCResult, error) {
+	entries, err := s.scanEntries()
+	if err != nil {
+		return GCResult{}, err
+	}
+
+	var result GCResult
+	var errs []error
+	evicted := make(map[string]bool)
+	for _, entry := range selectEvictions(entries, limits) {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		for _, path := range entry.files {
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, err)
+				continue
+			}
+			result.EvictedFiles++
+		}
+		evicted[entry.name] = true
+		result.EvictedEntries++
+		result.FreedBytes += entry.bytes
+	}
+
+	remaining := slices.DeleteFunc(entries, func(e cacheEntry) bool { return evicted[e.name] })
+	result.Remaining = statsFor(s.cache.diskDir, remaining)
+	return result, errors.Join(errs...)
+}
+
+// selectEvictions returns the least recently accessed entries that must be
+// removed for the rest to fit limits, oldest first.
+func selectEvictions(entries []cacheEntry, limits CacheLimits) []cacheEntry {
+	if limits.Unlimited() {
+		return nil
+	}
+
+	byAge := slices.Clone(entries)
+	slices.SortFunc(byAge, func(a, b cacheEntry) int {
+		if c := a.lastAccess.Compare(b.lastAccess); c != 0 {
+			return c
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	count := len(byAge)
+	var total int64
+	for _, entry := range byAge {
+		total += entry.bytes
+	}
+
+	var evict []cacheEntry
+	for _, entry := range byAge {
+		overEntries := limits.MaxEntries > 0 && count > limits.MaxEntries
+		overBytes := limits.MaxBytes > 0 && total > limits.MaxBytes
+		if !overEntries && !overBytes {
+			break
+		}
+		evict = append(evict, entry)
+		count--
+		total -= entry.bytes
+	}
+	return evict
+}
+
+// scanEntries lists the disk cache grouped by favicon key. In-flight
+// temporary files are skipped.
+func (s *Service) scanEntries() ([]cacheEntry, error) {
+	if s.cache == nil || s.cache.diskDir == "" {
+		return nil, nil
+	}
+	dirEntries, err := os.ReadDir(s.cache.diskDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*cacheEntry)
+	var order []string
+	for _, dirEntry := range dirEntries {
+		fileName := dirEntry.Name()
+		if !dirEntry.Type().IsRegular() || strings.HasPrefix(fileName, ".") {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		name := cacheEntryName(fileName)
+		entry, ok := byName[name]
+		if !ok {
+			entry = &cacheEntry{name: name}
+			byName[name] = entry
+			order = append(order, name)
+		}
+		entry.files = append(entry.files, filepath.Join(s.cache.diskDir, fileName))
+		entry.bytes += info.Size()
+		if accessed := fileAccessTime(info); accessed.After(entry.lastAccess) {
+			entry.lastAccess = accessed
+		}
+	}
+
+	entries := make([]cacheEntry, 0, len(order))
+	for _, name := range order {
+		entries = append(entries, *byName[name])
+	}
+	return entries, nil
+}
+
+// cacheEntryName strips the suffixes the cache appends to a sanitized key.
+func cacheEntryName(fileName string) string {
+	if base, ok := strings.CutSuffix(fileName, ".ico"+contentTypeSuffix); ok {
+		return base
+	}
+	if base, ok := strings.CutSuffix(fileName, ".ico"); ok {
+		return base
+	}
+	base, ok := strings.CutSuffix(fileName, ".png")
+	if !ok {
+		return fileName
+	}
+	if dot := strings.LastIndexByte(base, '.'); dot > 0 && isSizedPNGForKey(fileName, base[:dot+1]) {
+		return base[:dot]
+	}
+	return base
+}
+
+func statsFor(dir string, entries []cacheEntry) CacheStats {
+	stats := CacheStats{Dir: dir, Entries: len(entries)}
+	for _, entry := range entries {
+		stats.Files += len(entry.files)
+		stats.Bytes += entry.bytes
+		if stats.Oldest.IsZero() || entry.lastAccess.Before(stats.Oldest) {
+			stats.Oldest = entry.lastAccess
+		}
+		if entry.lastAccess.After(stats.Newest) {
+			stats.Newest = entry.lastAccess
+		}
+	}
+	return stats
+}
+
+// fileAccessTime returns the later of a file's access and modification times.
+// Reads refresh the access time explicitly (see touchAccess), so this stays
+// accurate on relatime and noatime mounts.
+func fileAccessTime(info os.FileInfo) time.Time {
+	accessed := info.ModTime()
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		if atime := time.Unix(stat.Atim.Sec, stat.Atim.Nsec); atime.After(accessed) {
+			accessed = atime
+		}
+	}
+	return accessed
+}
+
+// touchAccess records a cache read so eviction keeps recently used favicons.
+func touchAccess(path string) {
+	_ = os.Chtimes(path, time.Now(), time.Time{})
+}
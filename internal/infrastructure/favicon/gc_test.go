@@ -0,0 +1,115 @@
+package favicon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSelectEvictionsPicksLeastRecentlyAccessed(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []cacheEntry{
+		{name: "recent.com", bytes: 100, lastAccess: base.Add(3 * time.Hour)},
+		{name: "oldest.com", bytes: 100, lastAccess: base},
+		{name: "middle.com", bytes: 100, lastAccess: base.Add(2 * time.Hour)},
+		{name: "older.com", bytes: 100, lastAccess: base.Add(time.Hour)},
+	}
+
+	tests := []struct {
+		name   string
+		limits CacheLimits
+		want   []string
+	}{
+		{name: "unlimited", limits: CacheLimits{}},
+		{name: "within limits", limits: CacheLimits{MaxBytes: 400, MaxEntries: 4}},
+		{name: "max entries", limits: CacheLimits{MaxEntries: 2}, want: []string{"oldest.com", "older.com"}},
+		{name: "max bytes", limits: CacheLimits{MaxBytes: 250}, want: []string{"oldest.com", "older.com"}},
+		{name: "stricter limit wins", limits: CacheLimits{MaxBytes: 350, MaxEntries: 1}, want: []string{"oldest.com", "older.com", "middle.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, entry := range selectEvictions(entries, tt.limits) {
+				got = append(got, entry.name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("evicted %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheEntryNameGroupsKeyFiles(t *testing.T) {
+	for fileName, want := range map[string]string{
+		"example.com.ico":              "example.com",
+		"example.com.ico.content-type": "example.com",
+		"example.com.png":              "example.com",
+		"example.com.32.png":           "example.com",
+		"example.com%2Fdocs.png":       "example.com%2Fdocs",
+		"unknown.bin":                  "unknown.bin",
+	} {
+		if got := cacheEntryName(fileName); got != want {
+			t.Errorf("cacheEntryName(%q) = %q, want %q", fileName, got, want)
+		}
+	}
+}
+
+func TestServiceGCEvictsLeastRecentlyReadFavicons(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Now().Add(-time.Hour)
+	write := func(name string, age time.Duration) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("data"), diskCacheFilePerm); err != nil {
+			t.Fatal(err)
+		}
+		stamp := base.Add(age)
+		if err := os.Chtimes(path, stamp, stamp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a.com.ico", 0)
+	write("a.com.32.png", 0)
+	write("b.com.ico", time.Minute)
+	write("c.com.ico", 2*time.Minute)
+	write(".c.com.ico-123.tmp", 0)
+
+	svc := NewService(dir)
+	defer svc.Close()
+
+	// Reading a.com marks it as the most recently used entry.
+	if _, ok := svc.GetCached(context.Background(), "a.com"); !ok {
+		t.Fatal("expected a.com in cache")
+	}
+
+	result, err := svc.GC(context.Background(), CacheLimits{MaxEntries: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.EvictedEntries != 1 || result.EvictedFiles != 1 || result.FreedBytes != 4 {
+		t.Fatalf("result = %+v", result)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "b.com.ico")); !os.IsNotExist(err) {
+		t.Fatalf("b.com.ico should be evicted, stat err = %v", err)
+	}
+	for _, kept := range []string{"a.com.ico", "a.com.32.png", "c.com.ico", ".c.com.ico-123.tmp"} {
+		if _, err := os.Stat(filepath.Join(dir, kept)); err != nil {
+			t.Fatalf("%s should be kept: %v", kept, err)
+		}
+	}
+
+	stats, err := svc.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Entries != 2 || stats.Files != 3 || stats.Bytes != 12 {
+		t.Fatalf("stats = %+v", stats)
+	}
+	if stats != result.Remaining {
+		t.Fatalf("remaining = %+v, want %+v", result.Remaining, stats)
+	}
+}
@@ -0,0 +1,59 @@
+package favicon
+
+import (
+	"context"
+	"fmt"
+	"html"
+
+	domainfavicon "github.com/bnema/dumber/internal/domain/favicon"
+	domainurl "github.com/bnema/dumber/internal/domain/url"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// monogramSVGSize is the viewBox size of generated monograms. SVG scales
+// cleanly, so one size serves the omnibox and title bars.
+const monogramSVGSize = 64
+
+// MonogramSVG renders a monogram as a rounded square with a centered letter.
+func MonogramSVG(m domainfavicon.Monogram) []byte {
+	return fmt.Appendf(nil,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="%[1]d" viewBox="0 0 %[1]d %[1]d">`+
+			`<rect width="%[1]d" height="%[1]d" rx="12" fill="%[2]s"/>`+
+			`<text x="50%%" y="50%%" dy="0.35em" text-anchor="middle" fill="#ffffff" `+
+			`font-family="sans-serif" font-weight="bold" font-size="38">%[3]s</text></svg>`,
+		monogramSVGSize, m.Background, html.EscapeString(m.Letter),
+	)
+}
+
+// GetOrGenerate returns the cached favicon for the domain of pageURL, the
+// app logo for internal pages, or a generated monogram when nothing is cached.
+// Monograms are kept in memory only, so a real favicon replaces them as soon
+// as one is stored. It returns nil for URLs without a host.
+func (s *Service) GetOrGenerate(ctx context.Context, pageURL string) []byte {
+	if IsInternalURL(pageURL) {
+		return GetLogoBytes()
+	}
+	if domain := domainurl.ExtractDomain(pageURL); domain != "" {
+		if data, ok := s.cache.Get(ctx, domain); ok && len(data) > 0 {
+			return data
+		}
+	}
+
+	m, ok := domainfavicon.MonogramFor(pageURL)
+	if !ok {
+		return nil
+	}
+
+	s.monogramMu.Lock()
+	defer s.monogramMu.Unlock()
+	if data, ok := s.monograms[m.Site]; ok {
+		return data
+	}
+	data := MonogramSVG(m)
+	s.monograms[m.Site] = data
+	logging.FromContext(ctx).Debug().
+		Str("site", m.Site).
+		Str("letter", m.Letter).
+		Msg("favicon: generated monogram")
+	return data
+}
@@ -0,0 +1,45 @@
+package favicon
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+
+	domainfavicon "github.com/bnema/dumber/internal/domain/favicon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonogramSVGIsWellFormed(t *testing.T) {
+	data := MonogramSVG(domainfavicon.Monogram{Site: "example.com", Letter: "<", Background: "#2980b9"})
+
+	var doc struct {
+		XMLName xml.Name `xml:"svg"`
+		Rect    struct {
+			Fill string `xml:"fill,attr"`
+		} `xml:"rect"`
+		Text string `xml:"text"`
+	}
+	require.NoError(t, xml.Unmarshal(data, &doc))
+	assert.Equal(t, "#2980b9", doc.Rect.Fill)
+	assert.Equal(t, "<", doc.Text)
+}
+
+func TestServiceGetOrGenerate(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService("")
+	t.Cleanup(svc.Close)
+
+	generated := svc.GetOrGenerate(ctx, "https://docs.example.com/guide")
+	require.NotEmpty(t, generated)
+	m, _ := domainfavicon.MonogramFor("example.com")
+	assert.Equal(t, MonogramSVG(m), generated)
+	assert.Equal(t, generated, svc.GetOrGenerate(ctx, "https://example.com"))
+
+	// A cached favicon wins over the monogram.
+	require.NoError(t, svc.Store(ctx, "example.com", []byte("real-icon")))
+	assert.Equal(t, []byte("real-icon"), svc.GetOrGenerate(ctx, "https://example.com/page"))
+
+	assert.Equal(t, GetLogoBytes(), svc.GetOrGenerate(ctx, "dumb://history"))
+	assert.Nil(t, svc.GetOrGenerate(ctx, "about:blank"))
+}
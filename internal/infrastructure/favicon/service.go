@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/bnema/dumber/assets"
 	"github.com/bnema/dumber/internal/logging"
@@ -20,12 +21,15 @@ const InternalDomain = "dumb"
 // remote-fetch policy; new code should use the usecase plus BlobStore/Fetcher.
 type Service struct {
 	cache *Cache
+
+	monogramMu sync.Mutex
+	monograms  map[string][]byte
 }
 
 // NewService creates a new favicon service.
 // cacheDir is the directory for disk caching; empty string disables disk caching.
 func NewService(cacheDir string) *Service {
-	return &Service{cache: NewCache(cacheDir)}
+	return &Service{cache: NewCache(cacheDir), monograms: make(map[string][]byte)}
 }
 
 func (s *Service) Cache() *Cache {
@@ -0,0 +1,183 @@
+package filtering
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+// NetworkOnlyIdentifierPrefix is the prefix of the compiled filters that
+// keep the network rules of each part and drop its cosmetic rules.
+const NetworkOnlyIdentifierPrefix = "ublock-network"
+
+// networkOnlyJSONDir is the subdirectory of the JSON cache holding the parts
+// stripped of their cosmetic rules.
+const networkOnlyJSONDir = "network-only"
+
+// cosmeticActionType is the content blocker action that hides page elements.
+// Every other action acts on network requests, cookies or upgrades.
+const cosmeticActionType = "css-display-none"
+
+// StripCosmeticRules returns the Safari Content Blocker rules of data without
+// the rules that hide page elements, and how many rules were dropped.
+func StripCosmeticRules(data []byte) (stripped []byte, removed int, err error) {
+	var rules []json.RawMessage
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, 0, fmt.Errorf("parse filter rules: %w", err)
+	}
+
+	kept := make([]json.RawMessage, 0, len(rules))
+	for _, raw := range rules {
+		var rule struct {
+			Action struct {
+				Type string `json:"type"`
+			} `json:"action"`
+		}
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return nil, 0, fmt.Errorf("parse filter rule %d: %w", len(kept)+removed, err)
+		}
+		if rule.Action.Type == cosmeticActionType {
+			removed++
+			continue
+		}
+		kept = append(kept, raw)
+	}
+
+	stripped, err = json.Marshal(kept)
+	if err != nil {
+		return nil, 0, fmt.Errorf("encode filter rules: %w", err)
+	}
+	return stripped, removed, nil
+}
+
+// PrepareNetworkFilters compiles the network-only filters in the background
+// the first time it is called after the active filters changed, and calls
+// onReady from that goroutine once they can be applied. Calls made while the
+// filters are being compiled only register onReady. onReady is not called
+// when compiling fails; the pages keep their cosmetic rules then.
+func (m *Manager) PrepareNetworkFilters(ctx context.Context, onReady func()) {
+	m.filterMu.Lock()
+	if len(m.networkFilters) > 0 {
+		m.filterMu.Unlock()
+		if onReady != nil {
+			onReady()
+		}
+		return
+	}
+	if onReady != nil {
+		m.networkWaiters = append(m.networkWaiters, onReady)
+	}
+	if m.networkPreparing || len(m.filters) == 0 {
+		m.filterMu.Unlock()
+		return
+	}
+	m.networkPreparing = true
+	generation := m.filterGeneration
+	m.filterMu.Unlock()
+
+	go m.compileNetworkFilters(ctx, generation)
+}
+
+// ApplyNetworkOnlyTo adds the network-only filters to a WebView's
+// UserContentManager and reports whether they were ready. Nothing is added
+// when they were not.
+func (m *Manager) ApplyNetworkOnlyTo(ctx context.Context, ucm *webkit.UserContentManager) bool {
+	if ucm == nil {
+		return false
+	}
+	m.filterMu.RLock()
+	filters := m.networkFilters
+	m.filterMu.RUnlock()
+	if len(filters) == 0 {
+		return false
+	}
+	for _, filter := range filters {
+		ucm.AddFilter(filter)
+	}
+	logging.FromContext(ctx).Debug().Int("parts", len(filters)).Msg("network-only content filters applied to webview")
+	return true
+}
+
+func (m *Manager) compileNetworkFilters(ctx context.Context, generation uint64) {
+	log := logging.FromContext(ctx).With().
+		Str("component", "filter-manager").
+		Logger()
+
+	filters, err := m.buildNetworkFilters(ctx, m.downloader.GetCachedFilterPaths())
+
+	m.filterMu.Lock()
+	m.networkPreparing = false
+	if generation != m.filterGeneration {
+		// The active filters changed meanwhile; build from the new lists.
+		m.filterMu.Unlock()
+		m.PrepareNetworkFilters(ctx, nil)
+		return
+	}
+	waiters := m.networkWaiters
+	m.networkWaiters = nil
+	if err != nil {
+		m.filterMu.Unlock()
+		log.Warn().Err(err).Msg("failed to build network-only filters, cosmetic rules stay on")
+		return
+	}
+	m.networkFilters = filters
+	m.filterMu.Unlock()
+
+	log.Info().Int("parts", len(filters)).Msg("network-only filters ready")
+	for _, onReady := range waiters {
+		onReady()
+	}
+}
+
+// buildNetworkFilters strips the cosmetic rules of each cached part and
+// compiles the result.
+func (m *Manager) buildNetworkFilters(ctx context.Context, paths []string) ([]*webkit.UserContentFilter, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no cached filter lists")
+	}
+	dir := filepath.Join(m.jsonDir, networkOnlyJSONDir)
+	if err := os.MkdirAll(dir, jsonDirPerm); err != nil {
+		return nil, fmt.Errorf("create network-only dir: %w", err)
+	}
+
+	filters := make([]*webkit.UserContentFilter, 0, len(paths))
+	for i, path := range paths {
+		data, err := readLimitedFile(path, defaultMaxFilterFileBytes, "filter part")
+		if err != nil {
+			return nil, err
+		}
+		stripped, removed, err := StripCosmeticRules(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		outPath := filepath.Join(dir, filepath.Base(path))
+		if err := os.WriteFile(outPath, stripped, mergedFilePerm); err != nil {
+			return nil, fmt.Errorf("write network-only part: %w", err)
+		}
+
+		identifier := fmt.Sprintf("%s-%d", NetworkOnlyIdentifierPrefix, i)
+		logging.FromContext(ctx).Debug().
+			Str("id", identifier).
+			Int("cosmetic_rules", removed).
+			Msg("compiling network-only filter part")
+		filter, err := m.store.Compile(ctx, identifier, outPath)
+		if err != nil {
+			return nil, fmt.Errorf("compile %s: %w", identifier, err)
+		}
+		if filter == nil {
+			return nil, fmt.Errorf("compile %s returned nil filter", identifier)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func isCompiledFilterID(id string) bool {
+	return strings.HasPrefix(id, FilterIdentifierPrefix) || strings.HasPrefix(id, NetworkOnlyIdentifierPrefix)
+}
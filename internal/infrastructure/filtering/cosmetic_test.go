@@ -0,0 +1,90 @@
+package filtering_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/infrastructure/filtering"
+	"github.com/bnema/dumber/internal/infrastructure/filtering/mocks"
+	"github.com/bnema/puregotk/v4/webkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripCosmeticRules(t *testing.T) {
+	in := `[
+		{"trigger":{"url-filter":"ads"},"action":{"type":"block"}},
+		{"trigger":{"url-filter":".*","if-domain":["*example.com"]},"action":{"type":"css-display-none","selector":".banner"}},
+		{"trigger":{"url-filter":"track"},"action":{"type":"block-cookies"}}
+	]`
+
+	out, removed, err := filtering.StripCosmeticRules([]byte(in))
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.JSONEq(t, `[
+		{"trigger":{"url-filter":"ads"},"action":{"type":"block"}},
+		{"trigger":{"url-filter":"track"},"action":{"type":"block-cookies"}}
+	]`, string(out))
+}
+
+func TestStripCosmeticRules_RejectsInvalidJSON(t *testing.T) {
+	_, _, err := filtering.StripCosmeticRules([]byte(`{"not":"a list"}`))
+	assert.Error(t, err)
+}
+
+func TestManager_PrepareNetworkFilters_CompilesStrippedListsOnce(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonDir := filepath.Join(tmpDir, "json")
+	require.NoError(t, os.MkdirAll(jsonDir, 0o755))
+	part := filepath.Join(jsonDir, "combined-part1.json")
+	require.NoError(t, os.WriteFile(part, []byte(
+		`[{"trigger":{"url-filter":"ads"},"action":{"type":"block"}},`+
+			`{"trigger":{"url-filter":".*"},"action":{"type":"css-display-none","selector":".ad"}}]`), 0o644))
+
+	mockStore := mocks.NewMockFilterStore(t)
+	mockDownloader := mocks.NewMockFilterDownloader(t)
+	mockStore.EXPECT().FetchIdentifiers(mock.Anything).Return([]string{"ublock-combined-0"}, nil)
+	mockStore.EXPECT().Load(mock.Anything, "ublock-combined-0").Return(&webkit.UserContentFilter{}, nil)
+	mockDownloader.EXPECT().GetCachedManifest().Return(nil, nil)
+	mockDownloader.EXPECT().GetCachedFilterPaths().Return([]string{part})
+
+	strippedPath := filepath.Join(jsonDir, "network-only", "combined-part1.json")
+	mockStore.EXPECT().
+		Compile(mock.Anything, "ublock-network-0", strippedPath).
+		Return(&webkit.UserContentFilter{}, nil).
+		Once()
+
+	mgr, err := filtering.NewManager(filtering.ManagerConfig{
+		StoreDir:   filepath.Join(tmpDir, "store"),
+		JSONDir:    jsonDir,
+		Enabled:    true,
+		Store:      mockStore,
+		Downloader: mockDownloader,
+	})
+	require.NoError(t, err)
+	ctx := testContext()
+	mgr.LoadAsync(ctx)
+	require.Eventually(t, func() bool { return len(mgr.GetFilters()) == 1 }, 3*time.Second, 20*time.Millisecond)
+
+	ready := make(chan struct{}, 2)
+	mgr.PrepareNetworkFilters(ctx, func() { ready <- struct{}{} })
+	select {
+	case <-ready:
+	case <-time.After(3 * time.Second):
+		t.Fatal("network-only filters were not prepared")
+	}
+
+	stripped, err := os.ReadFile(strippedPath)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"trigger":{"url-filter":"ads"},"action":{"type":"block"}}]`, string(stripped))
+
+	mgr.PrepareNetworkFilters(ctx, func() { ready <- struct{}{} })
+	select {
+	case <-ready:
+	default:
+		t.Fatal("prepared filters must be reported ready at once")
+	}
+}
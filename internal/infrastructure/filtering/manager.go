@@ -49,6 +49,15 @@ type Manager struct {
 	enabled    bool
 	autoUpdate bool
 
+	// filterGeneration changes each time the active filters are replaced,
+	// so network-only filters built from older lists are dropped.
+	filterGeneration uint64
+	// networkFilters are the active filters without their cosmetic rules,
+	// built on demand by PrepareNetworkFilters. Guarded by filterMu.
+	networkFilters   []*webkit.UserContentFilter
+	networkPreparing bool
+	networkWaiters   []func()
+
 	// Callbacks for status updates (e.g., toast notifications)
 	onStatusChange func(FilterStatus)
 }
@@ -310,6 +319,8 @@ func (m *Manager) handleCompilationFailure() {
 func (m *Manager) setActiveFilters(filters []*webkit.UserContentFilter, message string) string {
 	m.filterMu.Lock()
 	m.filters = filters
+	m.filterGeneration++
+	m.networkFilters = nil
 	m.filterMu.Unlock()
 
 	version := m.getCachedVersion()
@@ -642,6 +653,8 @@ func (m *Manager) Clear(ctx context.Context) error {
 
 	m.filterMu.Lock()
 	m.filters = nil
+	m.filterGeneration++
+	m.networkFilters = nil
 	m.filterMu.Unlock()
 
 	// Remove all compiled filter parts
@@ -650,7 +663,7 @@ func (m *Manager) Clear(ctx context.Context) error {
 		log.Warn().Err(err).Msg("failed to fetch identifiers for cleanup")
 	}
 	for _, id := range identifiers {
-		if strings.HasPrefix(id, FilterIdentifierPrefix) {
+		if isCompiledFilterID(id) {
 			if err := m.store.Remove(ctx, id); err != nil {
 				log.Warn().Err(err).Str("id", id).Msg("failed to remove compiled filter")
 			}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// consoleClockSkew is how far a page-reported timestamp may lie in the
+// future before the receive time is used instead.
+const consoleClockSkew = time.Minute
+
+// ConsoleHandler records console output posted by the injected console
+// override. The dumb://console view reads it through the homepage handlers.
+type ConsoleHandler struct {
+	capture port.ConsoleCapture
+	now     func() time.Time
+}
+
+// NewConsoleHandler creates a new ConsoleHandler.
+func NewConsoleHandler(capture port.ConsoleCapture) *ConsoleHandler {
+	return &ConsoleHandler{capture: capture, now: time.Now}
+}
+
+// consoleMessageRequest is the payload posted by the injected console override.
+type consoleMessageRequest struct {
+	Level     string `json:"level"`
+	Message   string `json:"message"`
+	URL       string `json:"url"`
+	Timestamp int64  `json:"timestamp"` // Unix milliseconds
+}
+
+// parseConsoleMessage decodes a console_message payload. Unknown levels are
+// rejected; a missing, negative or far-future timestamp is replaced by now.
+func parseConsoleMessage(payload json.RawMessage, now time.Time) (entity.ConsoleMessage, error) {
+	var req consoleMessageRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return entity.ConsoleMessage{}, err
+	}
+	level, ok := entity.ParseConsoleLevel(req.Level)
+	if !ok {
+		return entity.ConsoleMessage{}, errors.New("unknown console level")
+	}
+	ts := now
+	if req.Timestamp > 0 {
+		if reported := time.UnixMilli(req.Timestamp); reported.Before(now.Add(consoleClockSkew)) {
+			ts = reported
+		}
+	}
+	return entity.ConsoleMessage{
+		Level:     level,
+		Message:   req.Message,
+		URL:       req.URL,
+		Timestamp: ts,
+	}, nil
+}
+
+// HandleMessage handles the console_message message posted by pages.
+func (h *ConsoleHandler) HandleMessage() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, webviewID port.WebViewID, payload json.RawMessage) (any, error) {
+		msg, err := parseConsoleMessage(payload, h.now())
+		if err != nil {
+			logging.FromContext(ctx).Debug().Err(err).Msg("failed to parse console message payload")
+			return nil, nil // Silently ignore malformed requests
+		}
+		h.capture.Record(ctx, webviewID, msg)
+		return nil, nil
+	})
+}
+
+// RegisterConsoleHandlers registers the console capture handler with the router.
+func RegisterConsoleHandlers(ctx context.Context, router port.WebUIHandlerRouter, capture port.ConsoleCapture) error {
+	handler := NewConsoleHandler(capture)
+
+	if err := router.RegisterHandler("console_message", handler.HandleMessage()); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info().Msg("registered console handlers")
+	return nil
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingConsoleCapture struct {
+	viewIDs  []port.WebViewID
+	messages []entity.ConsoleMessage
+}
+
+func (r *recordingConsoleCapture) Record(_ context.Context, viewID port.WebViewID, msg entity.ConsoleMessage) {
+	r.viewIDs = append(r.viewIDs, viewID)
+	r.messages = append(r.messages, msg)
+}
+
+func (*recordingConsoleCapture) Logs(context.Context) []dto.ConsoleLog { return nil }
+
+func (*recordingConsoleCapture) Clear(context.Context, port.WebViewID) {}
+
+func TestParseConsoleMessage(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	reported := now.Add(-5 * time.Second)
+
+	tests := []struct {
+		name    string
+		payload string
+		want    entity.ConsoleMessage
+		wantErr bool
+	}{
+		{
+			name:    "log maps to info and keeps page timestamp",
+			payload: `{"level":"log","message":"hello","url":"https://example.com/","timestamp":` + strconv.FormatInt(reported.UnixMilli(), 10) + `}`,
+			want:    entity.ConsoleMessage{Level: entity.ConsoleLevelInfo, Message: "hello", URL: "https://example.com/", Timestamp: time.UnixMilli(reported.UnixMilli())},
+		},
+		{
+			name:    "warn",
+			payload: `{"level":"warn","message":"careful"}`,
+			want:    entity.ConsoleMessage{Level: entity.ConsoleLevelWarn, Message: "careful", Timestamp: now},
+		},
+		{
+			name:    "error level is case insensitive",
+			payload: `{"level":"ERROR","message":"boom","timestamp":0}`,
+			want:    entity.ConsoleMessage{Level: entity.ConsoleLevelError, Message: "boom", Timestamp: now},
+		},
+		{
+			name:    "far future timestamp falls back to now",
+			payload: `{"level":"debug","message":"x","timestamp":` + strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10) + `}`,
+			want:    entity.ConsoleMessage{Level: entity.ConsoleLevelDebug, Message: "x", Timestamp: now},
+		},
+		{
+			name:    "unknown level",
+			payload: `{"level":"table","message":"x"}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			payload: `{"level":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseConsoleMessage(json.RawMessage(tt.payload), now)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Level, got.Level)
+			assert.Equal(t, tt.want.Message, got.Message)
+			assert.Equal(t, tt.want.URL, got.URL)
+			assert.True(t, tt.want.Timestamp.Equal(got.Timestamp), "timestamp %s, want %s", got.Timestamp, tt.want.Timestamp)
+		})
+	}
+}
+
+func TestConsoleHandler_HandleMessage_RecordsForSender(t *testing.T) {
+	capture := &recordingConsoleCapture{}
+	handler := NewConsoleHandler(capture)
+
+	_, err := handler.HandleMessage().Handle(context.Background(), 9, json.RawMessage(`{"level":"error","message":"failed"}`))
+	require.NoError(t, err)
+
+	require.Len(t, capture.messages, 1)
+	assert.Equal(t, port.WebViewID(9), capture.viewIDs[0])
+	assert.Equal(t, entity.ConsoleLevelError, capture.messages[0].Level)
+}
+
+func TestConsoleHandler_HandleMessage_IgnoresMalformedPayload(t *testing.T) {
+	capture := &recordingConsoleCapture{}
+	handler := NewConsoleHandler(capture)
+
+	resp, err := handler.HandleMessage().Handle(context.Background(), 9, json.RawMessage(`{"level":"nope"}`))
+	require.NoError(t, err)
+	assert.Nil(t, resp)
+	assert.Empty(t, capture.messages)
+}
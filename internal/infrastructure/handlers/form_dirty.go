@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// parseFormDirty decodes a form_dirty payload. The payload is untrusted page
+// input, so anything but an explicit boolean is rejected.
+func parseFormDirty(payload json.RawMessage) (dirty, ok bool) {
+	var state struct {
+		Dirty *bool `json:"dirty"`
+	}
+	if err := json.Unmarshal(payload, &state); err != nil || state.Dirty == nil {
+		return false, false
+	}
+	return *state.Dirty, true
+}
+
+// RegisterFormDirtyHandlers registers the form_dirty handler with the router.
+// onChange runs whenever a page reports that its edited form fields appeared
+// or went away.
+func RegisterFormDirtyHandlers(
+	ctx context.Context,
+	router port.WebUIHandlerRouter,
+	onChange func(ctx context.Context, webviewID port.WebViewID, dirty bool),
+) error {
+	if err := router.RegisterHandler("form_dirty", port.WebUIMessageHandlerFunc(
+		func(ctx context.Context, webviewID port.WebViewID, payload json.RawMessage) (any, error) {
+			dirty, ok := parseFormDirty(payload)
+			if !ok {
+				logging.FromContext(ctx).Debug().Msg("ignoring malformed form_dirty message")
+				return nil, nil
+			}
+			onChange(ctx, webviewID, dirty)
+			return nil, nil
+		},
+	)); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info().Msg("registered form dirty handlers")
+	return nil
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterFormDirtyHandlers_ForwardsDirtyState(t *testing.T) {
+	ctx := context.Background()
+
+	var captured port.WebUIMessageHandler
+	router := mocks.NewMockWebUIHandlerRouter(t)
+	router.EXPECT().RegisterHandler("form_dirty", mock.AnythingOfType("port.WebUIMessageHandlerFunc")).
+		Run(func(_ string, h port.WebUIMessageHandler) { captured = h }).
+		Return(nil)
+
+	type change struct {
+		id    port.WebViewID
+		dirty bool
+	}
+	var got []change
+	err := RegisterFormDirtyHandlers(ctx, router, func(_ context.Context, id port.WebViewID, dirty bool) {
+		got = append(got, change{id: id, dirty: dirty})
+	})
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+
+	for _, payload := range []string{
+		`{"dirty":true}`,
+		`{"dirty":false}`,
+		`{"dirty":"yes"}`,
+		`{}`,
+		`{"dirty":`,
+	} {
+		resp, err := captured.Handle(ctx, 7, json.RawMessage(payload))
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+	}
+
+	assert.Equal(t, []change{{id: 7, dirty: true}, {id: 7, dirty: false}}, got)
+}
@@ -0,0 +1,50 @@
+package homepage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ConsoleHandlers handles the dumb://console view messages.
+type ConsoleHandlers struct {
+	capture port.ConsoleCapture
+}
+
+// NewConsoleHandlers creates a new ConsoleHandlers instance.
+func NewConsoleHandlers(capture port.ConsoleCapture) *ConsoleHandlers {
+	return &ConsoleHandlers{capture: capture}
+}
+
+type consoleClearRequest struct {
+	RequestID string `json:"requestId"`
+	WebViewID uint64 `json:"webview_id"`
+}
+
+// HandleList handles console_list messages.
+func (h *ConsoleHandlers) HandleList() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		requestID := ParseRequestID(payload)
+		logging.FromContext(ctx).Debug().Str("request_id", requestID).Msg("handling console_list")
+		return NewSuccessResponse(requestID, h.capture.Logs(ctx)), nil
+	})
+}
+
+// HandleClear handles console_clear messages. A webview_id of 0 clears
+// every captured buffer.
+func (h *ConsoleHandlers) HandleClear() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		var req consoleClearRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return NewErrorResponse("", err), nil
+		}
+		logging.FromContext(ctx).Debug().
+			Str("request_id", req.RequestID).
+			Uint64("webview_id", req.WebViewID).
+			Msg("handling console_clear")
+		h.capture.Clear(ctx, port.WebViewID(req.WebViewID))
+		return NewSuccessResponse(req.RequestID, nil), nil
+	})
+}
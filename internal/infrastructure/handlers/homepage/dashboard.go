@@ -0,0 +1,118 @@
+package homepage
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// DashboardHandlers handles the dumb://homepage dashboard message.
+type DashboardHandlers struct {
+	historyUC   port.HomepageHistory
+	favoritesUC port.HomepageFavorites
+	settings    func() dto.HomepageDashboardSettings
+}
+
+// NewDashboardHandlers creates a new DashboardHandlers instance.
+// settings is read on every request so config reloads apply without a restart.
+func NewDashboardHandlers(
+	historyUC port.HomepageHistory,
+	favoritesUC port.HomepageFavorites,
+	settings func() dto.HomepageDashboardSettings,
+) *DashboardHandlers {
+	return &DashboardHandlers{historyUC: historyUC, favoritesUC: favoritesUC, settings: settings}
+}
+
+// HandleDashboard handles homepage_dashboard messages.
+func (h *DashboardHandlers) HandleDashboard() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		log := logging.FromContext(ctx)
+
+		requestID := ParseRequestID(payload)
+
+		log.Debug().
+			Str("request_id", requestID).
+			Msg("handling homepage_dashboard")
+
+		dashboard, err := h.Build(ctx)
+		if err != nil {
+			return NewErrorResponse(requestID, err), nil
+		}
+
+		return NewSuccessResponse(requestID, dashboard), nil
+	})
+}
+
+// Build assembles the dashboard payload for the enabled widgets.
+func (h *DashboardHandlers) Build(ctx context.Context) (dto.HomepageDashboard, error) {
+	var settings dto.HomepageDashboardSettings
+	if h.settings != nil {
+		settings = h.settings()
+	}
+
+	dashboard := dto.HomepageDashboard{
+		Widgets: make([]entity.HomepageWidget, 0, len(settings.Homepage.Widgets)),
+	}
+	for _, widget := range settings.Homepage.Widgets {
+		if !widget.IsValid() || dashboard.HasWidget(widget) {
+			continue
+		}
+		dashboard.Widgets = append(dashboard.Widgets, widget)
+	}
+
+	if dashboard.HasWidget(entity.HomepageWidgetSearch) {
+		dashboard.SearchURL = settings.SearchURL
+	}
+
+	if dashboard.HasWidget(entity.HomepageWidgetRecentHistory) && settings.Homepage.RecentHistoryLimit > 0 {
+		entries, err := h.historyUC.GetRecent(ctx, settings.Homepage.RecentHistoryLimit, 0)
+		if err != nil {
+			return dto.HomepageDashboard{}, fmt.Errorf("failed to load recent history: %w", err)
+		}
+		dashboard.RecentHistory = entries
+	}
+
+	if dashboard.HasWidget(entity.HomepageWidgetTopFavorites) && settings.Homepage.TopFavoritesLimit > 0 {
+		favorites, err := h.favoritesUC.GetAll(ctx)
+		if err != nil {
+			return dto.HomepageDashboard{}, fmt.Errorf("failed to load favorites: %w", err)
+		}
+		dashboard.TopFavorites = topFavorites(favorites, settings.Homepage.TopFavoritesLimit)
+	}
+
+	return dashboard, nil
+}
+
+// topFavorites returns up to limit favorites: shortcut-bound favorites first
+// (by shortcut key), then the rest in their saved position order.
+func topFavorites(favorites []*entity.Favorite, limit int) []*entity.Favorite {
+	out := make([]*entity.Favorite, 0, len(favorites))
+	for _, favorite := range favorites {
+		if favorite != nil {
+			out = append(out, favorite)
+		}
+	}
+	slices.SortStableFunc(out, func(a, b *entity.Favorite) int {
+		switch {
+		case a.ShortcutKey != nil && b.ShortcutKey != nil:
+			return cmp.Compare(*a.ShortcutKey, *b.ShortcutKey)
+		case a.ShortcutKey != nil:
+			return -1
+		case b.ShortcutKey != nil:
+			return 1
+		default:
+			return cmp.Compare(a.Position, b.Position)
+		}
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
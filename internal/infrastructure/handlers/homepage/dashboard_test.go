@@ -0,0 +1,130 @@
+package homepage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/application/port"
+	portmocks "github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func dashboardSettings(widgets []entity.HomepageWidget, recentLimit, favoritesLimit int) func() dto.HomepageDashboardSettings {
+	return func() dto.HomepageDashboardSettings {
+		return dto.HomepageDashboardSettings{
+			Homepage: entity.HomepageConfig{
+				Widgets:            widgets,
+				RecentHistoryLimit: recentLimit,
+				TopFavoritesLimit:  favoritesLimit,
+			},
+			SearchURL: "https://duckduckgo.com/?q=%s",
+		}
+	}
+}
+
+func favoriteIDs(favorites []*entity.Favorite) []entity.FavoriteID {
+	ids := make([]entity.FavoriteID, 0, len(favorites))
+	for _, favorite := range favorites {
+		ids = append(ids, favorite.ID)
+	}
+	return ids
+}
+
+func TestDashboardBuildAssemblesRecentAndTopFavorites(t *testing.T) {
+	t.Parallel()
+
+	shortcut1, shortcut3 := 1, 3
+	recent := []*entity.HistoryEntry{
+		{ID: 2, URL: "https://b.example"},
+		{ID: 1, URL: "https://a.example"},
+	}
+	history := portmocks.NewMockHomepageHistory(t)
+	history.EXPECT().GetRecent(mock.Anything, 5, 0).Return(recent, nil).Once()
+
+	favorites := portmocks.NewMockHomepageFavorites(t)
+	favorites.EXPECT().GetAll(mock.Anything).Return([]*entity.Favorite{
+		{ID: 10, Position: 2},
+		{ID: 11, Position: 0},
+		nil,
+		{ID: 12, Position: 5, ShortcutKey: &shortcut3},
+		{ID: 13, Position: 1},
+		{ID: 14, Position: 9, ShortcutKey: &shortcut1},
+	}, nil).Once()
+
+	widgets := []entity.HomepageWidget{
+		entity.HomepageWidgetTopFavorites,
+		entity.HomepageWidgetSearch,
+		entity.HomepageWidgetRecentHistory,
+	}
+	h := NewDashboardHandlers(history, favorites, dashboardSettings(widgets, 5, 4))
+
+	got, err := h.Build(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, widgets, got.Widgets)
+	require.Equal(t, "https://duckduckgo.com/?q=%s", got.SearchURL)
+	require.Equal(t, recent, got.RecentHistory)
+	require.Equal(t, []entity.FavoriteID{14, 12, 11, 13}, favoriteIDs(got.TopFavorites))
+}
+
+func TestDashboardBuildSkipsDisabledWidgets(t *testing.T) {
+	t.Parallel()
+
+	// Mocks fail the test if GetRecent or GetAll is called.
+	history := portmocks.NewMockHomepageHistory(t)
+	favorites := portmocks.NewMockHomepageFavorites(t)
+	h := NewDashboardHandlers(history, favorites, dashboardSettings(
+		[]entity.HomepageWidget{entity.HomepageWidgetSearch, "weather", entity.HomepageWidgetSearch}, 5, 5,
+	))
+
+	got, err := h.Build(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []entity.HomepageWidget{entity.HomepageWidgetSearch}, got.Widgets)
+	require.Nil(t, got.RecentHistory)
+	require.Nil(t, got.TopFavorites)
+}
+
+func TestDashboardBuildOmitsSearchURLWithoutSearchWidget(t *testing.T) {
+	t.Parallel()
+
+	history := portmocks.NewMockHomepageHistory(t)
+	history.EXPECT().GetRecent(mock.Anything, 3, 0).Return([]*entity.HistoryEntry{}, nil).Once()
+	h := NewDashboardHandlers(history, portmocks.NewMockHomepageFavorites(t), dashboardSettings(
+		[]entity.HomepageWidget{entity.HomepageWidgetRecentHistory}, 3, 3,
+	))
+
+	got, err := h.Build(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, got.SearchURL)
+}
+
+func TestHandleDashboardReturnsErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	history := portmocks.NewMockHomepageHistory(t)
+	history.EXPECT().GetRecent(mock.Anything, 8, 0).Return(nil, errors.New("db closed")).Once()
+	h := NewDashboardHandlers(history, portmocks.NewMockHomepageFavorites(t), dashboardSettings(
+		[]entity.HomepageWidget{entity.HomepageWidgetRecentHistory}, 8, 8,
+	))
+
+	got, err := h.HandleDashboard().Handle(context.Background(), port.WebViewID(0), json.RawMessage(`{"requestId":"req-1"}`))
+	require.NoError(t, err)
+
+	resp, ok := got.(Response)
+	require.True(t, ok)
+	require.False(t, resp.Success)
+	require.Equal(t, "req-1", resp.RequestID)
+	require.Contains(t, resp.Error, "db closed")
+}
+
+func TestTopFavoritesRespectsLimit(t *testing.T) {
+	t.Parallel()
+
+	favorites := []*entity.Favorite{{ID: 1, Position: 1}, {ID: 2, Position: 0}, {ID: 3, Position: 2}}
+	require.Equal(t, []entity.FavoriteID{2, 1}, favoriteIDs(topFavorites(favorites, 2)))
+	require.Equal(t, []entity.FavoriteID{2, 1, 3}, favoriteIDs(topFavorites(favorites, 10)))
+}
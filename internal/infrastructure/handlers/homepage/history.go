@@ -347,6 +347,51 @@ func (h *HistoryHandlers) HandleDomainStats() port.WebUIMessageHandler {
 	})
 }
 
+// historyGroupRequest is the payload for history_group_by_domain and
+// history_group_by_day messages.
+type historyGroupRequest struct {
+	RequestID string `json:"requestId"`
+	Limit     int    `json:"limit"`
+	PerGroup  int    `json:"perGroup"`
+}
+
+// HandleGroupByDomain handles history_group_by_domain messages.
+func (h *HistoryHandlers) HandleGroupByDomain() port.WebUIMessageHandler {
+	return h.handleGroup("history_group_by_domain", h.historyUC.GroupByDomain)
+}
+
+// HandleGroupByDay handles history_group_by_day messages.
+func (h *HistoryHandlers) HandleGroupByDay() port.WebUIMessageHandler {
+	return h.handleGroup("history_group_by_day", h.historyUC.GroupByDay)
+}
+
+func (*HistoryHandlers) handleGroup(
+	name string,
+	group func(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error),
+) port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		log := logging.FromContext(ctx)
+
+		var req historyGroupRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return NewErrorResponse("", err), nil
+		}
+
+		log.Debug().
+			Str("request_id", req.RequestID).
+			Int("limit", req.Limit).
+			Int("per_group", req.PerGroup).
+			Msg("handling " + name)
+
+		groups, err := group(ctx, req.Limit, req.PerGroup)
+		if err != nil {
+			return NewErrorResponse(req.RequestID, err), nil
+		}
+
+		return NewSuccessResponse(req.RequestID, groups), nil
+	})
+}
+
 // deleteDomainRequest is the payload for history_delete_domain messages.
 type deleteDomainRequest struct {
 	RequestID string `json:"requestId"`
@@ -59,3 +59,39 @@ func TestHandleTimelineWindowPassesCompleteCursor(t *testing.T) {
 	require.True(t, ok)
 	require.True(t, resp.Success)
 }
+
+func TestHandleGroupByDomainPassesLimits(t *testing.T) {
+	t.Parallel()
+
+	groups := []*entity.HistoryGroup{{Key: "example.com", EntryCount: 3}}
+	history := portmocks.NewMockHomepageHistory(t)
+	history.EXPECT().GroupByDomain(mock.Anything, 25, 5).Return(groups, nil).Once()
+
+	handler := NewHistoryHandlers(history).HandleGroupByDomain()
+	payload := json.RawMessage(`{"requestId":"req-3","limit":25,"perGroup":5}`)
+
+	got, err := handler.Handle(context.Background(), port.WebViewID(0), payload)
+	require.NoError(t, err)
+
+	resp, ok := got.(Response)
+	require.True(t, ok)
+	require.True(t, resp.Success)
+	require.Equal(t, groups, resp.Data)
+}
+
+func TestHandleGroupByDayPassesLimits(t *testing.T) {
+	t.Parallel()
+
+	history := portmocks.NewMockHomepageHistory(t)
+	history.EXPECT().GroupByDay(mock.Anything, 30, 0).Return([]*entity.HistoryGroup{}, nil).Once()
+
+	handler := NewHistoryHandlers(history).HandleGroupByDay()
+	payload := json.RawMessage(`{"requestId":"req-4","limit":30}`)
+
+	got, err := handler.Handle(context.Background(), port.WebViewID(0), payload)
+	require.NoError(t, err)
+
+	resp, ok := got.(Response)
+	require.True(t, ok)
+	require.True(t, resp.Success)
+}
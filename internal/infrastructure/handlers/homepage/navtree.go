@@ -0,0 +1,28 @@
+package homepage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// NavTreeHandlers handles the dumb://navtree view messages.
+type NavTreeHandlers struct {
+	trees port.NavigationTrees
+}
+
+// NewNavTreeHandlers creates a new NavTreeHandlers instance.
+func NewNavTreeHandlers(trees port.NavigationTrees) *NavTreeHandlers {
+	return &NavTreeHandlers{trees: trees}
+}
+
+// HandleList handles navtree_list messages.
+func (h *NavTreeHandlers) HandleList() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		requestID := ParseRequestID(payload)
+		logging.FromContext(ctx).Debug().Str("request_id", requestID).Msg("handling navtree_list")
+		return NewSuccessResponse(requestID, h.trees.Trees(ctx)), nil
+	})
+}
@@ -0,0 +1,101 @@
+package homepage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// NotesHandlers handles the dumb://notes view messages.
+type NotesHandlers struct {
+	notes port.Notes
+}
+
+// NewNotesHandlers creates a new NotesHandlers instance.
+func NewNotesHandlers(notes port.Notes) *NotesHandlers {
+	return &NotesHandlers{notes: notes}
+}
+
+type noteSaveRequest struct {
+	RequestID string `json:"requestId"`
+	ID        int64  `json:"id"`
+	Body      string `json:"body"`
+}
+
+type noteDeleteRequest struct {
+	RequestID string `json:"requestId"`
+	ID        int64  `json:"id"`
+}
+
+// HandleList handles notes_list messages.
+func (h *NotesHandlers) HandleList() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		requestID := ParseRequestID(payload)
+		logging.FromContext(ctx).Debug().Str("request_id", requestID).Msg("handling notes_list")
+		notes, err := h.notes.List(ctx)
+		if err != nil {
+			return NewErrorResponse(requestID, err), nil
+		}
+		return NewSuccessResponse(requestID, notes), nil
+	})
+}
+
+// HandleCreate handles notes_create messages.
+func (h *NotesHandlers) HandleCreate() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		requestID := ParseRequestID(payload)
+		logging.FromContext(ctx).Debug().Str("request_id", requestID).Msg("handling notes_create")
+		note, err := h.notes.Create(ctx)
+		if err != nil {
+			return NewErrorResponse(requestID, err), nil
+		}
+		return NewSuccessResponse(requestID, note), nil
+	})
+}
+
+// HandleSave handles notes_save messages sent by the page's autosave.
+func (h *NotesHandlers) HandleSave() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		var req noteSaveRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return NewErrorResponse("", err), nil
+		}
+		logging.FromContext(ctx).Debug().
+			Str("request_id", req.RequestID).
+			Int64("note_id", req.ID).
+			Int("bytes", len(req.Body)).
+			Msg("handling notes_save")
+		if req.ID <= 0 {
+			return NewErrorResponse(req.RequestID, fmt.Errorf("note id must be positive")), nil
+		}
+		note, err := h.notes.Save(ctx, req.ID, req.Body)
+		if err != nil {
+			return NewErrorResponse(req.RequestID, err), nil
+		}
+		return NewSuccessResponse(req.RequestID, note), nil
+	})
+}
+
+// HandleDelete handles notes_delete messages.
+func (h *NotesHandlers) HandleDelete() port.WebUIMessageHandler {
+	return port.WebUIMessageHandlerFunc(func(ctx context.Context, _ port.WebViewID, payload json.RawMessage) (any, error) {
+		var req noteDeleteRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return NewErrorResponse("", err), nil
+		}
+		logging.FromContext(ctx).Debug().
+			Str("request_id", req.RequestID).
+			Int64("note_id", req.ID).
+			Msg("handling notes_delete")
+		if req.ID <= 0 {
+			return NewErrorResponse(req.RequestID, fmt.Errorf("note id must be positive")), nil
+		}
+		if err := h.notes.Delete(ctx, req.ID); err != nil {
+			return NewErrorResponse(req.RequestID, err), nil
+		}
+		return NewSuccessResponse(req.RequestID, nil), nil
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/logging"
 )
@@ -12,6 +13,18 @@ import (
 type Config struct {
 	HistoryUC   port.HomepageHistory
 	FavoritesUC port.HomepageFavorites
+	// Dashboard returns the dumb://homepage widget settings. The
+	// homepage_dashboard handler is only registered when it is set.
+	Dashboard func() dto.HomepageDashboardSettings
+	// Console backs dumb://console. The console_list and console_clear
+	// handlers are only registered when it is set.
+	Console port.ConsoleCapture
+	// NavigationTrees backs dumb://navtree. The navtree_list handler is only
+	// registered when it is set.
+	NavigationTrees port.NavigationTrees
+	// Notes backs dumb://notes. The notes_* handlers are only registered
+	// when it is set.
+	Notes port.Notes
 }
 
 // RegisterHandlers registers all homepage message handlers with the router.
@@ -39,6 +52,8 @@ func RegisterHandlers(ctx context.Context, router port.WebUIHandlerRouter, cfg C
 	handlers["history_analytics"] = historyHandlers.HandleAnalytics()
 	handlers["history_domain_stats"] = historyHandlers.HandleDomainStats()
 	handlers["history_delete_domain"] = historyHandlers.HandleDeleteDomain()
+	handlers["history_group_by_domain"] = historyHandlers.HandleGroupByDomain()
+	handlers["history_group_by_day"] = historyHandlers.HandleGroupByDay()
 
 	// Favorites handlers
 	favoritesHandlers := NewFavoritesHandlers(cfg.FavoritesUC)
@@ -57,6 +72,34 @@ func RegisterHandlers(ctx context.Context, router port.WebUIHandlerRouter, cfg C
 	handlers["tag_assign"] = tagHandlers.HandleAssign()
 	handlers["tag_remove"] = tagHandlers.HandleRemove()
 
+	// Dashboard handler
+	if cfg.Dashboard != nil {
+		dashboardHandlers := NewDashboardHandlers(cfg.HistoryUC, cfg.FavoritesUC, cfg.Dashboard)
+		handlers["homepage_dashboard"] = dashboardHandlers.HandleDashboard()
+	}
+
+	// Console handlers
+	if cfg.Console != nil {
+		consoleHandlers := NewConsoleHandlers(cfg.Console)
+		handlers["console_list"] = consoleHandlers.HandleList()
+		handlers["console_clear"] = consoleHandlers.HandleClear()
+	}
+
+	// Navigation tree handlers
+	if cfg.NavigationTrees != nil {
+		navTreeHandlers := NewNavTreeHandlers(cfg.NavigationTrees)
+		handlers["navtree_list"] = navTreeHandlers.HandleList()
+	}
+
+	// Notes handlers
+	if cfg.Notes != nil {
+		notesHandlers := NewNotesHandlers(cfg.Notes)
+		handlers["notes_list"] = notesHandlers.HandleList()
+		handlers["notes_create"] = notesHandlers.HandleCreate()
+		handlers["notes_save"] = notesHandlers.HandleSave()
+		handlers["notes_delete"] = notesHandlers.HandleDelete()
+	}
+
 	// Register all handlers
 	for msgType, handler := range handlers {
 		if err := router.RegisterHandlerWithCallbacks(msgType, callback, errorCallback, worldName, handler); err != nil {
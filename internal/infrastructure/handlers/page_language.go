@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/translation"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// maxPageLanguageSample caps the page text examined for its script, in bytes.
+const maxPageLanguageSample = 4096
+
+// parsePageLanguage decodes a page_language payload into the page language,
+// from its declared lang or its text sample. The payload is untrusted page
+// input: malformed payloads and pages whose language can't be told return
+// ok false.
+func parsePageLanguage(payload json.RawMessage) (lang string, ok bool) {
+	var report struct {
+		Lang   string `json:"lang"`
+		Sample string `json:"sample"`
+	}
+	if err := json.Unmarshal(payload, &report); err != nil {
+		return "", false
+	}
+	sample := report.Sample
+	if len(sample) > maxPageLanguageSample {
+		sample = sample[:maxPageLanguageSample]
+	}
+	lang = translation.PageLanguage(report.Lang, sample)
+	return lang, lang != ""
+}
+
+// RegisterPageLanguageHandlers registers the page_language handler with the
+// router. onLanguage receives the language of each page loaded while the
+// translate offer is enabled, when it can be told.
+func RegisterPageLanguageHandlers(
+	ctx context.Context,
+	router port.WebUIHandlerRouter,
+	onLanguage func(ctx context.Context, webviewID port.WebViewID, lang string),
+) error {
+	if err := router.RegisterHandler("page_language", port.WebUIMessageHandlerFunc(
+		func(ctx context.Context, webviewID port.WebViewID, payload json.RawMessage) (any, error) {
+			lang, ok := parsePageLanguage(payload)
+			if !ok {
+				logging.FromContext(ctx).Debug().Msg("page_language message without a known language")
+				return nil, nil
+			}
+			onLanguage(ctx, webviewID, lang)
+			return nil, nil
+		},
+	)); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info().Msg("registered page language handlers")
+	return nil
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePageLanguage(t *testing.T) {
+	greek := "Η Αθήνα είναι η πρωτεύουσα και μεγαλύτερη πόλη της Ελλάδας"
+	tests := []struct {
+		name    string
+		payload string
+		want    string
+		ok      bool
+	}{
+		{name: "declared", payload: `{"lang":"fr-FR","sample":"Bonjour"}`, want: "fr", ok: true},
+		{name: "from script", payload: `{"lang":"","sample":"` + greek + `"}`, want: "el", ok: true},
+		{name: "unknown", payload: `{"lang":"","sample":"Hello there, nothing declared"}`},
+		{name: "wrong type", payload: `{"lang":42}`},
+		{name: "malformed", payload: `{"lang":`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, ok := parsePageLanguage(json.RawMessage(tt.payload))
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, lang)
+		})
+	}
+}
+
+func TestParsePageLanguage_CapsSample(t *testing.T) {
+	// Latin text fills the examined part; the Greek past it is ignored.
+	sample := strings.Repeat("a", maxPageLanguageSample) + strings.Repeat("Ελλάδα ", 2000)
+	payload, err := json.Marshal(map[string]string{"sample": sample})
+	require.NoError(t, err)
+
+	_, ok := parsePageLanguage(payload)
+	assert.False(t, ok)
+}
+
+func TestRegisterPageLanguageHandlers_ForwardsKnownLanguages(t *testing.T) {
+	ctx := context.Background()
+
+	var captured port.WebUIMessageHandler
+	router := mocks.NewMockWebUIHandlerRouter(t)
+	router.EXPECT().RegisterHandler("page_language", mock.AnythingOfType("port.WebUIMessageHandlerFunc")).
+		Run(func(_ string, h port.WebUIMessageHandler) { captured = h }).
+		Return(nil)
+
+	type report struct {
+		id   port.WebViewID
+		lang string
+	}
+	var got []report
+	err := RegisterPageLanguageHandlers(ctx, router, func(_ context.Context, id port.WebViewID, lang string) {
+		got = append(got, report{id: id, lang: lang})
+	})
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+
+	for _, payload := range []string{`{"lang":"de"}`, `{"lang":""}`, `{"lang":`} {
+		resp, err := captured.Handle(ctx, 7, json.RawMessage(payload))
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+	}
+
+	assert.Equal(t, []report{{id: 7, lang: "de"}}, got)
+}
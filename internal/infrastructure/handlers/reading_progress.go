@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// readingProgressDebounce is how long scroll reports from one page are
+// gathered before the latest is delivered.
+const readingProgressDebounce = 100 * time.Millisecond
+
+// parseReadingProgress decodes a reading_progress payload into a percentage.
+// The payload is untrusted page input, so anything but a number between 0
+// and 100 is rejected.
+func parseReadingProgress(payload json.RawMessage) (percent float64, ok bool) {
+	var progress struct {
+		Percent *float64 `json:"percent"`
+	}
+	if err := json.Unmarshal(payload, &progress); err != nil || progress.Percent == nil {
+		return 0, false
+	}
+	if *progress.Percent < 0 || *progress.Percent > 100 {
+		return 0, false
+	}
+	return *progress.Percent, true
+}
+
+// pendingReadingProgress is the latest report of a page not yet delivered.
+type pendingReadingProgress struct {
+	ctx     context.Context
+	percent float64
+}
+
+// readingProgressDebouncer limits how often a page's scroll position reaches
+// the UI. The first report of a page starts a delay; reports arriving during
+// it only replace the pending value, and the latest one is delivered when the
+// delay ends. Pages are debounced independently.
+type readingProgressDebouncer struct {
+	delay   time.Duration
+	deliver func(ctx context.Context, webviewID port.WebViewID, percent float64)
+
+	mu      sync.Mutex
+	pending map[port.WebViewID]pendingReadingProgress
+}
+
+func newReadingProgressDebouncer(
+	delay time.Duration,
+	deliver func(ctx context.Context, webviewID port.WebViewID, percent float64),
+) *readingProgressDebouncer {
+	return &readingProgressDebouncer{
+		delay:   delay,
+		deliver: deliver,
+		pending: make(map[port.WebViewID]pendingReadingProgress),
+	}
+}
+
+// push records percent as the latest report of webviewID.
+func (d *readingProgressDebouncer) push(ctx context.Context, webviewID port.WebViewID, percent float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, scheduled := d.pending[webviewID]
+	d.pending[webviewID] = pendingReadingProgress{ctx: ctx, percent: percent}
+	if scheduled {
+		return
+	}
+	time.AfterFunc(d.delay, func() { d.flush(webviewID) })
+}
+
+// flush delivers the pending report of webviewID.
+func (d *readingProgressDebouncer) flush(webviewID port.WebViewID) {
+	d.mu.Lock()
+	progress, ok := d.pending[webviewID]
+	delete(d.pending, webviewID)
+	d.mu.Unlock()
+	if ok {
+		d.deliver(progress.ctx, webviewID, progress.percent)
+	}
+}
+
+// RegisterReadingProgressHandlers registers the reading_progress handler with
+// the router. onProgress receives how far a page is scrolled, in percent,
+// debounced per page.
+func RegisterReadingProgressHandlers(
+	ctx context.Context,
+	router port.WebUIHandlerRouter,
+	onProgress func(ctx context.Context, webviewID port.WebViewID, percent float64),
+) error {
+	debouncer := newReadingProgressDebouncer(readingProgressDebounce, onProgress)
+	if err := router.RegisterHandler("reading_progress", port.WebUIMessageHandlerFunc(
+		func(ctx context.Context, webviewID port.WebViewID, payload json.RawMessage) (any, error) {
+			percent, ok := parseReadingProgress(payload)
+			if !ok {
+				logging.FromContext(ctx).Debug().Msg("ignoring malformed reading_progress message")
+				return nil, nil
+			}
+			debouncer.push(ctx, webviewID, percent)
+			return nil, nil
+		},
+	)); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info().Msg("registered reading progress handlers")
+	return nil
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type readingProgressReport struct {
+	id      port.WebViewID
+	percent float64
+}
+
+// readingProgressRecorder collects delivered reports from timer goroutines.
+type readingProgressRecorder struct {
+	mu      sync.Mutex
+	reports []readingProgressReport
+}
+
+func (r *readingProgressRecorder) record(_ context.Context, id port.WebViewID, percent float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports = append(r.reports, readingProgressReport{id: id, percent: percent})
+}
+
+func (r *readingProgressRecorder) snapshot() []readingProgressReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]readingProgressReport(nil), r.reports...)
+}
+
+func TestParseReadingProgress(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    float64
+		ok      bool
+	}{
+		{payload: `{"percent":0}`, want: 0, ok: true},
+		{payload: `{"percent":42.5}`, want: 42.5, ok: true},
+		{payload: `{"percent":100}`, want: 100, ok: true},
+		{payload: `{"percent":-1}`},
+		{payload: `{"percent":101}`},
+		{payload: `{"percent":"50"}`},
+		{payload: `{}`},
+		{payload: `{"percent":`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			percent, ok := parseReadingProgress(json.RawMessage(tt.payload))
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, percent)
+		})
+	}
+}
+
+func TestRegisterReadingProgressHandlers_ForwardsLatestPercent(t *testing.T) {
+	ctx := context.Background()
+
+	var captured port.WebUIMessageHandler
+	router := mocks.NewMockWebUIHandlerRouter(t)
+	router.EXPECT().RegisterHandler("reading_progress", mock.AnythingOfType("port.WebUIMessageHandlerFunc")).
+		Run(func(_ string, h port.WebUIMessageHandler) { captured = h }).
+		Return(nil)
+
+	recorder := &readingProgressRecorder{}
+	require.NoError(t, RegisterReadingProgressHandlers(ctx, router, recorder.record))
+	require.NotNil(t, captured)
+
+	for _, payload := range []string{
+		`{"percent":10}`,
+		`{"percent":"bogus"}`,
+		`{"percent":250}`,
+		`{"percent":35}`,
+	} {
+		resp, err := captured.Handle(ctx, 7, json.RawMessage(payload))
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+	}
+
+	want := []readingProgressReport{{id: 7, percent: 35}}
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual(want, recorder.snapshot())
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestReadingProgressDebouncer(t *testing.T) {
+	ctx := context.Background()
+	recorder := &readingProgressRecorder{}
+	debouncer := newReadingProgressDebouncer(20*time.Millisecond, recorder.record)
+
+	// A burst from each page delivers only its latest report, per page.
+	debouncer.push(ctx, 1, 10)
+	debouncer.push(ctx, 1, 20)
+	debouncer.push(ctx, 2, 50)
+	debouncer.push(ctx, 1, 30)
+	assert.Empty(t, recorder.snapshot(), "nothing is delivered before the delay")
+
+	require.Eventually(t, func() bool {
+		return len(recorder.snapshot()) == 2
+	}, time.Second, 5*time.Millisecond)
+	assert.ElementsMatch(t, []readingProgressReport{{id: 1, percent: 30}, {id: 2, percent: 50}}, recorder.snapshot())
+
+	// A report after the delay starts a new burst.
+	debouncer.push(ctx, 1, 90)
+	require.Eventually(t, func() bool {
+		return len(recorder.snapshot()) == 3
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, readingProgressReport{id: 1, percent: 90}, recorder.snapshot()[2])
+
+	time.Sleep(40 * time.Millisecond)
+	assert.Len(t, recorder.snapshot(), 3, "each burst is delivered once")
+}
@@ -23,8 +23,12 @@ func RegisterAll(ctx context.Context, router port.WebUIHandlerRouter, deps port.
 	// Homepage handlers (history, favorites, folders, tags)
 	if deps.HistoryUC != nil && deps.FavoritesUC != nil {
 		if err := homepage.RegisterHandlers(ctx, router, homepage.Config{
-			HistoryUC:   deps.HistoryUC,
-			FavoritesUC: deps.FavoritesUC,
+			HistoryUC:       deps.HistoryUC,
+			FavoritesUC:     deps.FavoritesUC,
+			Dashboard:       deps.HomepageDashboard,
+			Console:         deps.ConsoleCapture,
+			NavigationTrees: deps.NavigationTrees,
+			Notes:           deps.Notes,
 		}); err != nil {
 			return err
 		}
@@ -58,6 +62,41 @@ func RegisterAll(ctx context.Context, router port.WebUIHandlerRouter, deps port.
 		}
 	}
 
+	// Console capture (for dumb://console)
+	if deps.ConsoleCapture != nil {
+		if err := RegisterConsoleHandlers(ctx, router, deps.ConsoleCapture); err != nil {
+			return err
+		}
+	}
+
+	// Type-to-find (opens the find bar from keys typed on a page)
+	if deps.OnTypeToFind != nil {
+		if err := RegisterTypeToFindHandlers(ctx, router, deps.OnTypeToFind); err != nil {
+			return err
+		}
+	}
+
+	// Edited-form tracking (asks before closing a pane with unsaved input)
+	if deps.OnFormDirty != nil {
+		if err := RegisterFormDirtyHandlers(ctx, router, deps.OnFormDirty); err != nil {
+			return err
+		}
+	}
+
+	// Reading progress (scroll position bar at the top of each pane)
+	if deps.OnReadingProgress != nil {
+		if err := RegisterReadingProgressHandlers(ctx, router, deps.OnReadingProgress); err != nil {
+			return err
+		}
+	}
+
+	// Page language (offers to translate pages in another language)
+	if deps.OnPageLanguage != nil {
+		if err := RegisterPageLanguageHandlers(ctx, router, deps.OnPageLanguage); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// parseTypeToFind decodes a type_to_find payload and returns the text to
+// search for. The page already filters keys, but the payload is untrusted,
+// so the focus context is checked again here.
+func parseTypeToFind(payload json.RawMessage) (string, bool) {
+	var key entity.TypeToFindKey
+	if err := json.Unmarshal(payload, &key); err != nil {
+		return "", false
+	}
+	return key.Text()
+}
+
+// RegisterTypeToFindHandlers registers the type_to_find handler with the
+// router. onText runs for every key that may start or extend a search.
+func RegisterTypeToFindHandlers(
+	ctx context.Context,
+	router port.WebUIHandlerRouter,
+	onText func(ctx context.Context, webviewID port.WebViewID, text string),
+) error {
+	if err := router.RegisterHandler("type_to_find", port.WebUIMessageHandlerFunc(
+		func(ctx context.Context, webviewID port.WebViewID, payload json.RawMessage) (any, error) {
+			text, ok := parseTypeToFind(payload)
+			if !ok {
+				logging.FromContext(ctx).Debug().Msg("ignoring type_to_find key")
+				return nil, nil // Silently ignore keys meant for the page
+			}
+			onText(ctx, webviewID, text)
+			return nil, nil
+		},
+	)); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info().Msg("registered type-to-find handlers")
+	return nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterTypeToFindHandlers_ForwardsAllowedKeys(t *testing.T) {
+	ctx := context.Background()
+
+	var captured port.WebUIMessageHandler
+	router := mocks.NewMockWebUIHandlerRouter(t)
+	router.EXPECT().RegisterHandler("type_to_find", mock.AnythingOfType("port.WebUIMessageHandlerFunc")).
+		Run(func(_ string, h port.WebUIMessageHandler) { captured = h }).
+		Return(nil)
+
+	var gotIDs []port.WebViewID
+	var gotText []string
+	err := RegisterTypeToFindHandlers(ctx, router, func(_ context.Context, id port.WebViewID, text string) {
+		gotIDs = append(gotIDs, id)
+		gotText = append(gotText, text)
+	})
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+
+	for _, payload := range []string{
+		`{"key":"d","focusTag":"BODY"}`,
+		`{"key":"a","focusTag":"INPUT","focusType":"password"}`,
+		`{"key":"b","focusTag":"DIV","focusEditable":true}`,
+		`{"key":"c","ctrl":true,"focusTag":"BODY"}`,
+		`{"key":`,
+	} {
+		resp, err := captured.Handle(ctx, 4, json.RawMessage(payload))
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+	}
+
+	assert.Equal(t, []port.WebViewID{4}, gotIDs)
+	assert.Equal(t, []string{"d"}, gotText)
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/godbus/dbus/v5"
 )
@@ -29,13 +31,24 @@ var _ port.IdleInhibitor = (*PortalInhibitor)(nil)
 
 // PortalInhibitor implements idle inhibition using XDG Desktop Portal.
 // This works on Wayland with any compositor (GNOME, KDE, sway, hyprland, etc.).
+//
+// During quiet hours the portal is never asked to inhibit, even while the
+// refcount is held. The inhibition is re-evaluated when quiet hours begin or
+// end, so media that keeps playing across a boundary is handled too.
 type PortalInhibitor struct {
 	conn            *dbus.Conn
 	requestPath     dbus.ObjectPath // Active inhibit request handle
 	refcount        int
 	supported       bool
 	requestComplete bool // True if the portal sent a Response signal (request no longer exists)
-	mu              sync.Mutex
+	reason          string
+
+	quietHours    entity.QuietHours
+	quietTimer    *time.Timer
+	quietTimerCtx context.Context
+	now           func() time.Time
+
+	mu sync.Mutex
 }
 
 // NewPortalInhibitor creates a new portal-based idle inhibitor.
@@ -45,6 +58,7 @@ func NewPortalInhibitor(ctx context.Context) *PortalInhibitor {
 
 	inhibitor := &PortalInhibitor{
 		supported: false,
+		now:       time.Now,
 	}
 
 	// Connect to session bus
@@ -71,7 +85,8 @@ func NewPortalInhibitor(ctx context.Context) *PortalInhibitor {
 	return inhibitor
 }
 
-// Inhibit increments the inhibit refcount. First call activates inhibition.
+// Inhibit increments the inhibit refcount. First call activates inhibition,
+// unless quiet hours are in effect.
 func (p *PortalInhibitor) Inhibit(ctx context.Context, reason string) error {
 	log := logging.FromContext(ctx)
 
@@ -85,8 +100,26 @@ func (p *PortalInhibitor) Inhibit(ctx context.Context, reason string) error {
 	if p.refcount > 1 {
 		return nil
 	}
+	p.reason = reason
+	p.scheduleQuietHoursCheckLocked(ctx)
+
+	if p.inQuietHoursLocked() {
+		log.Debug().Msg("idle inhibitor: quiet hours, not engaging")
+		return nil
+	}
+
+	if err := p.activateLocked(ctx); err != nil {
+		p.refcount--
+		p.stopQuietHoursCheckLocked()
+		return err
+	}
+	return nil
+}
+
+// activateLocked asks the portal to inhibit idle. Caller must hold p.mu.
+func (p *PortalInhibitor) activateLocked(ctx context.Context) error {
+	log := logging.FromContext(ctx)
 
-	// First inhibit request
 	if !p.supported || p.conn == nil {
 		log.Debug().Msg("idle inhibitor: not supported, skipping")
 		return nil
@@ -97,7 +130,7 @@ func (p *PortalInhibitor) Inhibit(ctx context.Context, reason string) error {
 	obj := p.conn.Object(portalDest, portalPath)
 
 	options := map[string]dbus.Variant{
-		"reason": dbus.MakeVariant(reason),
+		"reason": dbus.MakeVariant(p.reason),
 	}
 
 	var handlePath dbus.ObjectPath
@@ -108,7 +141,6 @@ func (p *PortalInhibitor) Inhibit(ctx context.Context, reason string) error {
 	).Store(&handlePath)
 
 	if err != nil {
-		p.refcount--
 		log.Warn().Err(err).Msg("idle inhibitor: failed to inhibit")
 		return fmt.Errorf("portal inhibit: %w", err)
 	}
@@ -122,7 +154,7 @@ func (p *PortalInhibitor) Inhibit(ctx context.Context, reason string) error {
 
 	log.Info().
 		Str("handle", string(handlePath)).
-		Str("reason", reason).
+		Str("reason", p.reason).
 		Msg("idle inhibitor: activated")
 
 	return nil
@@ -203,9 +235,17 @@ func (p *PortalInhibitor) Uninhibit(ctx context.Context) error {
 		return nil
 	}
 
-	// Release the inhibition
+	p.stopQuietHoursCheckLocked()
+	p.releaseLocked(ctx)
+	return nil
+}
+
+// releaseLocked closes the active portal request, if any. Caller must hold p.mu.
+func (p *PortalInhibitor) releaseLocked(ctx context.Context) {
+	log := logging.FromContext(ctx)
+
 	if !p.supported || p.conn == nil || p.requestPath == "" {
-		return nil
+		return
 	}
 
 	// If the portal already completed the request with a Response signal,
@@ -213,7 +253,7 @@ func (p *PortalInhibitor) Uninhibit(ctx context.Context) error {
 	if p.requestComplete {
 		log.Info().Msg("idle inhibitor: deactivated (completed by portal)")
 		p.requestPath = ""
-		return nil
+		return
 	}
 
 	// Close the request to release inhibition
@@ -222,14 +262,94 @@ func (p *PortalInhibitor) Uninhibit(ctx context.Context) error {
 
 	log.Info().Msg("idle inhibitor: deactivated")
 	p.requestPath = ""
-	return nil
 }
 
-// IsInhibited returns true if currently inhibiting idle.
+// SetQuietHours sets the daily range during which idle is never inhibited.
+// An active inhibition is released or restored right away to match.
+func (p *PortalInhibitor) SetQuietHours(ctx context.Context, hours entity.QuietHours) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.quietHours = hours
+	if p.refcount <= 0 {
+		return
+	}
+	p.scheduleQuietHoursCheckLocked(ctx)
+	p.syncQuietHoursLocked(ctx)
+}
+
+// inQuietHoursLocked reports whether quiet hours are in effect. Caller must hold p.mu.
+func (p *PortalInhibitor) inQuietHoursLocked() bool {
+	return p.quietHours.Contains(p.clock())
+}
+
+func (p *PortalInhibitor) clock() time.Time {
+	if p.now != nil {
+		return p.now()
+	}
+	return time.Now()
+}
+
+// syncQuietHoursLocked engages or releases the portal inhibition to match
+// the quiet hours while the refcount is held. Caller must hold p.mu.
+func (p *PortalInhibitor) syncQuietHoursLocked(ctx context.Context) {
+	log := logging.FromContext(ctx)
+
+	if p.inQuietHoursLocked() {
+		if p.requestPath != "" {
+			log.Info().Msg("idle inhibitor: quiet hours started, releasing")
+			p.releaseLocked(ctx)
+		}
+		return
+	}
+	if p.requestPath == "" {
+		if err := p.activateLocked(ctx); err != nil {
+			log.Warn().Err(err).Msg("idle inhibitor: failed to engage after quiet hours")
+		}
+	}
+}
+
+// scheduleQuietHoursCheckLocked arms a timer for the next quiet hours
+// boundary. Caller must hold p.mu.
+func (p *PortalInhibitor) scheduleQuietHoursCheckLocked(ctx context.Context) {
+	p.stopQuietHoursCheckLocked()
+
+	current := p.clock()
+	next, ok := p.quietHours.NextBoundary(current)
+	if !ok {
+		return
+	}
+	p.quietTimerCtx = ctx
+	p.quietTimer = time.AfterFunc(next.Sub(current), p.onQuietHoursBoundary)
+}
+
+func (p *PortalInhibitor) onQuietHoursBoundary() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.refcount <= 0 || p.quietTimerCtx == nil {
+		return
+	}
+	ctx := p.quietTimerCtx
+	p.syncQuietHoursLocked(ctx)
+	p.scheduleQuietHoursCheckLocked(ctx)
+}
+
+// stopQuietHoursCheckLocked cancels the boundary timer. Caller must hold p.mu.
+func (p *PortalInhibitor) stopQuietHoursCheckLocked() {
+	if p.quietTimer != nil {
+		p.quietTimer.Stop()
+		p.quietTimer = nil
+	}
+	p.quietTimerCtx = nil
+}
+
+// IsInhibited returns true if currently inhibiting idle. It is false during
+// quiet hours even while callers hold the refcount.
 func (p *PortalInhibitor) IsInhibited() bool {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	return p.refcount > 0
+	return p.refcount > 0 && !p.inQuietHoursLocked()
 }
 
 // Close releases D-Bus resources and any active inhibition.
@@ -245,6 +365,7 @@ func (p *PortalInhibitor) Close() error {
 	p.requestPath = ""
 	p.requestComplete = false
 	p.refcount = 0
+	p.stopQuietHoursCheckLocked()
 
 	if p.conn != nil {
 		err := p.conn.Close()
@@ -8,7 +8,9 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/godbus/dbus/v5"
 )
 
@@ -102,3 +104,39 @@ func closedDoneChannel() <-chan struct{} {
 	close(done)
 	return done
 }
+
+func TestQuietHoursSuppressInhibition(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 23, 0, 0, 0, time.UTC)
+	inhibitor := &PortalInhibitor{now: func() time.Time { return now }}
+	ctx := context.Background()
+	inhibitor.SetQuietHours(ctx, entity.QuietHours{Start: "22:00", End: "07:00"})
+
+	if err := inhibitor.Inhibit(ctx, "Media playback"); err != nil {
+		t.Fatalf("Inhibit() error = %v", err)
+	}
+	if inhibitor.IsInhibited() {
+		t.Fatal("inhibitor engaged during quiet hours")
+	}
+
+	inhibitor.mu.Lock()
+	timerArmed := inhibitor.quietTimer != nil
+	inhibitor.mu.Unlock()
+	if !timerArmed {
+		t.Fatal("quiet hours boundary check was not scheduled")
+	}
+
+	now = time.Date(2024, time.March, 11, 7, 0, 0, 0, time.UTC)
+	if !inhibitor.IsInhibited() {
+		t.Fatal("inhibitor should engage once quiet hours end while media still plays")
+	}
+
+	if err := inhibitor.Uninhibit(ctx); err != nil {
+		t.Fatalf("Uninhibit() error = %v", err)
+	}
+	inhibitor.mu.Lock()
+	timerArmed = inhibitor.quietTimer != nil
+	inhibitor.mu.Unlock()
+	if timerArmed || inhibitor.IsInhibited() {
+		t.Fatal("releasing the last hold should stop the boundary check")
+	}
+}
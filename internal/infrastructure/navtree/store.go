@@ -0,0 +1,115 @@
+// Package navtree persists per-pane navigation trees in the state directory.
+package navtree
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/application/port"
+)
+
+const (
+	// FileName is the navigation tree file inside the state directory.
+	FileName = "navtree.json"
+
+	fileVersion = 1
+	dirPerm     = 0o755
+	// Trees hold browsing history, so only the user may read them.
+	filePerm = 0o600
+)
+
+var _ port.NavigationTreeStore = (*Store)(nil)
+
+// Store keeps the navigation trees in a JSON file.
+type Store struct {
+	path string
+}
+
+// NewStore returns a store writing to FileName inside stateDir.
+func NewStore(stateDir string) *Store {
+	return &Store{path: filepath.Join(stateDir, FileName)}
+}
+
+// treeFile is the on-disk format. Version lets a future format change
+// ignore files it can't read instead of restoring garbage.
+type treeFile struct {
+	Version int                  `json:"version"`
+	Trees   []dto.NavigationTree `json:"trees"`
+}
+
+// Load implements port.NavigationTreeStore.
+func (s *Store) Load(_ context.Context) ([]dto.NavigationTree, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read navigation trees: %w", err)
+	}
+	return decodeTrees(data)
+}
+
+// Save implements port.NavigationTreeStore. The file is replaced atomically
+// so a crash mid-write keeps the previous trees; saving no trees removes it.
+func (s *Store) Save(_ context.Context, trees []dto.NavigationTree) error {
+	if len(trees) == 0 {
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove navigation trees: %w", err)
+		}
+		return nil
+	}
+	data, err := encodeTrees(trees)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), dirPerm); err != nil {
+		return fmt.Errorf("create state dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), "."+FileName+"-*.tmp")
+	if err != nil {
+		return fmt.Errorf("save navigation trees: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer func() { _ = os.Remove(tmpName) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("save navigation trees: %w", err)
+	}
+	if err := tmp.Chmod(filePerm); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("save navigation trees: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("save navigation trees: %w", err)
+	}
+	if err := os.Rename(tmpName, s.path); err != nil {
+		return fmt.Errorf("save navigation trees: %w", err)
+	}
+	return nil
+}
+
+func encodeTrees(trees []dto.NavigationTree) ([]byte, error) {
+	data, err := json.Marshal(treeFile{Version: fileVersion, Trees: trees})
+	if err != nil {
+		return nil, fmt.Errorf("encode navigation trees: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// decodeTrees parses a tree file. Files from another version are treated as
+// empty; malformed files are an error.
+func decodeTrees(data []byte) ([]dto.NavigationTree, error) {
+	var file treeFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("decode navigation trees: %w", err)
+	}
+	if file.Version != fileVersion {
+		return nil, nil
+	}
+	return file.Trees, nil
+}
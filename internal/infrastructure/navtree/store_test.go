@@ -0,0 +1,67 @@
+package navtree
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "state")
+	store := NewStore(dir)
+
+	got, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, got, "missing file means no saved trees")
+
+	visited := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	want := []dto.NavigationTree{{
+		PaneID:    "pane-1",
+		Current:   2,
+		LastVisit: visited,
+		Nodes: []entity.NavigationNode{
+			{ID: 1, URL: "https://a.example/", Visits: 1, FirstVisit: visited, LastVisit: visited},
+			{ID: 2, ParentID: 1, URL: "https://b.example/", Title: "B", Visits: 2, FirstVisit: visited, LastVisit: visited},
+		},
+	}}
+	require.NoError(t, store.Save(ctx, want))
+
+	got, err = store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temporary files must not be left behind")
+	info, err := entries[0].Info()
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(filePerm), info.Mode().Perm())
+
+	require.NoError(t, store.Save(ctx, nil))
+	_, err = os.Stat(filepath.Join(dir, FileName))
+	assert.ErrorIs(t, err, os.ErrNotExist, "saving no trees removes the file")
+	require.NoError(t, store.Save(ctx, nil), "removing a missing file is not an error")
+}
+
+func TestDecodeTrees(t *testing.T) {
+	trees, err := decodeTrees([]byte(`{"version":1,"trees":[{"pane_id":"p","last_visit":"2026-03-01T12:00:00Z","nodes":[{"id":1,"url":"https://a.example/","visits":1,"first_visit":"2026-03-01T12:00:00Z","last_visit":"2026-03-01T12:00:00Z"}]}]}`))
+	require.NoError(t, err)
+	require.Len(t, trees, 1)
+	assert.Equal(t, "https://a.example/", trees[0].Nodes[0].URL)
+
+	trees, err = decodeTrees([]byte(`{"version":2,"trees":[{"pane_id":"p"}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, trees, "other versions are ignored")
+
+	_, err = decodeTrees([]byte(`{"version":1,`))
+	assert.Error(t, err)
+}
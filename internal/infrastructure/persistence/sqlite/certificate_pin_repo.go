@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite/sqlc"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+type certificatePinRepo struct {
+	queries *sqlc.Queries
+}
+
+// NewCertificatePinRepository creates a new SQLite-backed certificate pin repository.
+func NewCertificatePinRepository(db *sql.DB) port.CertificatePinRepository {
+	return &certificatePinRepo{queries: sqlc.New(db)}
+}
+
+func (r *certificatePinRepo) Get(ctx context.Context, host string) (*entity.CertificatePin, error) {
+	row, err := r.queries.GetCertificatePin(ctx, host)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return certificatePinFromRow(row), nil
+}
+
+func (r *certificatePinRepo) Set(ctx context.Context, pin *entity.CertificatePin) error {
+	if pin == nil {
+		return errors.New("cannot set nil certificate pin")
+	}
+	logging.FromContext(ctx).Debug().Str("host", pin.Host).Msg("setting certificate pin")
+
+	return r.queries.SetCertificatePin(ctx, sqlc.SetCertificatePinParams{
+		Host:       pin.Host,
+		SpkiSha256: pin.SPKISHA256,
+		CreatedAt:  pin.CreatedAt,
+	})
+}
+
+func (r *certificatePinRepo) Delete(ctx context.Context, host string) error {
+	logging.FromContext(ctx).Debug().Str("host", host).Msg("deleting certificate pin")
+	return r.queries.DeleteCertificatePin(ctx, host)
+}
+
+func (r *certificatePinRepo) List(ctx context.Context) ([]*entity.CertificatePin, error) {
+	rows, err := r.queries.ListCertificatePins(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pins := make([]*entity.CertificatePin, 0, len(rows))
+	for _, row := range rows {
+		pins = append(pins, certificatePinFromRow(row))
+	}
+	return pins, nil
+}
+
+func certificatePinFromRow(row sqlc.CertificatePin) *entity.CertificatePin {
+	return &entity.CertificatePin{
+		Host:       row.Host,
+		SPKISHA256: row.SpkiSha256,
+		CreatedAt:  row.CreatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificatePinRepository_CRUD(t *testing.T) {
+	ctx := testCtx()
+	db, err := sqlite.NewConnection(ctx, filepath.Join(t.TempDir(), "dumber.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := sqlite.NewCertificatePinRepository(db)
+
+	missing, err := repo.Get(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, repo.Set(ctx, &entity.CertificatePin{Host: "example.com", SPKISHA256: "old=", CreatedAt: 1}))
+	require.NoError(t, repo.Set(ctx, &entity.CertificatePin{Host: "example.com", SPKISHA256: "new=", CreatedAt: 2}))
+	require.NoError(t, repo.Set(ctx, &entity.CertificatePin{Host: "bank.example", SPKISHA256: "bank=", CreatedAt: 3}))
+
+	got, err := repo.Get(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.CertificatePin{Host: "example.com", SPKISHA256: "new=", CreatedAt: 2}, got)
+
+	pins, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, pins, 2)
+	assert.Equal(t, "bank.example", pins[0].Host)
+	assert.Equal(t, "example.com", pins[1].Host)
+
+	require.NoError(t, repo.Delete(ctx, "example.com"))
+	got, err = repo.Get(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bnema/dumber/internal/domain/repository"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite/sqlc"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+type cosmeticFilterExceptionRepo struct {
+	queries *sqlc.Queries
+}
+
+// NewCosmeticFilterExceptionRepository creates a new SQLite-backed cosmetic filter exception repository.
+func NewCosmeticFilterExceptionRepository(db *sql.DB) repository.CosmeticFilterExceptionRepository {
+	return &cosmeticFilterExceptionRepo{queries: sqlc.New(db)}
+}
+
+func (r *cosmeticFilterExceptionRepo) Add(ctx context.Context, domain string) error {
+	logging.FromContext(ctx).Debug().Str("domain", domain).Msg("adding cosmetic filter exception")
+	return r.queries.AddCosmeticException(ctx, domain)
+}
+
+func (r *cosmeticFilterExceptionRepo) Remove(ctx context.Context, domain string) error {
+	return r.queries.RemoveCosmeticException(ctx, domain)
+}
+
+func (r *cosmeticFilterExceptionRepo) Contains(ctx context.Context, domain string) (bool, error) {
+	count, err := r.queries.IsCosmeticException(ctx, domain)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *cosmeticFilterExceptionRepo) GetAll(ctx context.Context) ([]string, error) {
+	return r.queries.GetAllCosmeticExceptionDomains(ctx)
+}
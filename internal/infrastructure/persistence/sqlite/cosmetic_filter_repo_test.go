@@ -0,0 +1,53 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosmeticFilterExceptionRepository_PersistsAcrossConnections(t *testing.T) {
+	ctx := testCtx()
+	path := filepath.Join(t.TempDir(), "dumber.db")
+	db, err := sqlite.NewConnection(ctx, path)
+	require.NoError(t, err)
+
+	repo := sqlite.NewCosmeticFilterExceptionRepository(db)
+	require.NoError(t, repo.Add(ctx, "news.example"))
+	require.NoError(t, repo.Add(ctx, "blog.example"))
+	require.NoError(t, repo.Add(ctx, "news.example"), "adding twice is a no-op")
+	require.NoError(t, db.Close())
+
+	db, err = sqlite.NewConnection(ctx, path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	repo = sqlite.NewCosmeticFilterExceptionRepository(db)
+
+	all, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"blog.example", "news.example"}, all)
+
+	require.NoError(t, repo.Remove(ctx, "news.example"))
+	contains, err := repo.Contains(ctx, "news.example")
+	require.NoError(t, err)
+	assert.False(t, contains)
+	contains, err = repo.Contains(ctx, "blog.example")
+	require.NoError(t, err)
+	assert.True(t, contains)
+}
+
+func TestCosmeticFilterExceptionRepository_SeparateFromContentWhitelist(t *testing.T) {
+	ctx := testCtx()
+	db, err := sqlite.NewConnection(ctx, filepath.Join(t.TempDir(), "dumber.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, sqlite.NewCosmeticFilterExceptionRepository(db).Add(ctx, "news.example"))
+
+	whitelisted, err := sqlite.NewContentWhitelistRepository(db).Contains(ctx, "news.example")
+	require.NoError(t, err)
+	assert.False(t, whitelisted, "a cosmetic exception keeps network filtering on")
+}
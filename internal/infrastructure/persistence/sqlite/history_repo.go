@@ -709,6 +709,94 @@ func (r *historyRepo) GetDomainStats(ctx context.Context, limit int) ([]*entity.
 	return stats, nil
 }
 
+func (r *historyRepo) GroupByDomain(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	if limit <= 0 {
+		return []*entity.HistoryGroup{}, nil
+	}
+	rows, err := r.queries.GetHistoryDomainGroups(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*entity.HistoryGroup, len(rows))
+	for i, row := range rows {
+		groups[i] = &entity.HistoryGroup{
+			Key:         row.Domain,
+			EntryCount:  row.EntryCount,
+			VisitCount:  aggregateVisits(row.VisitCount),
+			LastVisited: aggregateTime(row.LastVisit),
+			Entries:     []*entity.HistoryEntry{},
+		}
+		if perGroup <= 0 {
+			continue
+		}
+		entries, err := r.queries.GetRecentHistoryByDomain(ctx, sqlc.GetRecentHistoryByDomainParams{
+			Domain: sql.NullString{String: row.Domain, Valid: true},
+			Limit:  int64(perGroup),
+		})
+		if err != nil {
+			return nil, err
+		}
+		groups[i].Entries = historyEntriesFromRows(entries)
+	}
+	return groups, nil
+}
+
+func (r *historyRepo) GroupByDay(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	if limit <= 0 {
+		return []*entity.HistoryGroup{}, nil
+	}
+	rows, err := r.queries.GetHistoryDayGroups(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*entity.HistoryGroup, len(rows))
+	for i, row := range rows {
+		day, _ := row.Day.(string)
+		groups[i] = &entity.HistoryGroup{
+			Key:         day,
+			EntryCount:  row.EntryCount,
+			VisitCount:  aggregateVisits(row.VisitCount),
+			LastVisited: aggregateTime(row.LastVisit),
+			Entries:     []*entity.HistoryEntry{},
+		}
+		if perGroup <= 0 || day == "" {
+			continue
+		}
+		entries, err := r.queries.GetRecentHistoryByDay(ctx, sqlc.GetRecentHistoryByDayParams{
+			Day:   day,
+			Limit: int64(perGroup),
+		})
+		if err != nil {
+			return nil, err
+		}
+		groups[i].Entries = historyEntriesFromRows(entries)
+	}
+	return groups, nil
+}
+
+// aggregateVisits converts a SUM(visit_count) column to a count.
+func aggregateVisits(v sql.NullFloat64) int64 {
+	if !v.Valid {
+		return 0
+	}
+	return int64(v.Float64)
+}
+
+// aggregateTime parses a MAX(last_visited) column, which SQLite returns as text.
+func aggregateTime(v interface{}) time.Time {
+	switch t := v.(type) {
+	case time.Time:
+		return t
+	case string:
+		parsed, _ := time.Parse("2006-01-02 15:04:05", t)
+		return parsed
+	default:
+		return time.Time{}
+	}
+}
+
 func (r *historyRepo) GetHourlyDistribution(ctx context.Context) ([]*entity.HourlyDistribution, error) {
 	rows, err := r.queries.GetHourlyDistribution(ctx)
 	if err != nil {
@@ -937,3 +937,89 @@ func historyIDs(entries []*entity.HistoryEntry) []int64 {
 	}
 	return ids
 }
+
+func TestHistoryRepository_GroupByDomainAndDay(t *testing.T) {
+	ctx := historyTestCtx()
+	dbPath := filepath.Join(t.TempDir(), "dumber.db")
+
+	db, err := sqlite.NewConnection(ctx, dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := sqlite.NewHistoryRepository(db)
+	dayOne := time.Date(2026, 6, 20, 12, 0, 0, 0, time.UTC)
+	dayTwo := dayOne.AddDate(0, 0, 2)
+	insert := func(url, domain string, visited time.Time, visits int) {
+		_, err := db.ExecContext(ctx,
+			`INSERT INTO history (url, title, domain, visit_count, last_visited, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+			url, url, domain, visits, visited, visited)
+		require.NoError(t, err)
+	}
+	insert("https://example.com/a", "example.com", dayOne, 2)
+	insert("https://example.com/b", "example.com", dayTwo.Add(-time.Hour), 1)
+	insert("https://example.com/c", "example.com", dayOne.Add(time.Minute), 4)
+	insert("https://other.test/", "other.test", dayTwo, 3)
+
+	byDomain, err := repo.GroupByDomain(ctx, 10, 2)
+	require.NoError(t, err)
+	require.Len(t, byDomain, 2)
+	assert.Equal(t, "other.test", byDomain[0].Key)
+	assert.Equal(t, int64(1), byDomain[0].EntryCount)
+	assert.Equal(t, "example.com", byDomain[1].Key)
+	assert.Equal(t, int64(3), byDomain[1].EntryCount)
+	assert.Equal(t, int64(7), byDomain[1].VisitCount)
+	assert.True(t, byDomain[1].LastVisited.Equal(dayTwo.Add(-time.Hour)))
+	require.Len(t, byDomain[1].Entries, 2)
+	assert.Equal(t, "https://example.com/b", byDomain[1].Entries[0].URL)
+	assert.Equal(t, "https://example.com/c", byDomain[1].Entries[1].URL)
+
+	byDay, err := repo.GroupByDay(ctx, 10, 10)
+	require.NoError(t, err)
+	require.Len(t, byDay, 2)
+	assert.Equal(t, dayTwo.Local().Format("2006-01-02"), byDay[0].Key)
+	assert.Equal(t, int64(2), byDay[0].EntryCount)
+	assert.Equal(t, int64(4), byDay[0].VisitCount)
+	assert.Equal(t, []string{"https://other.test/", "https://example.com/b"},
+		[]string{byDay[0].Entries[0].URL, byDay[0].Entries[1].URL})
+	assert.Equal(t, dayOne.Local().Format("2006-01-02"), byDay[1].Key)
+	assert.Equal(t, int64(2), byDay[1].EntryCount)
+
+	countsOnly, err := repo.GroupByDay(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, countsOnly, 1)
+	assert.Empty(t, countsOnly[0].Entries)
+
+	empty, err := repo.GroupByDomain(ctx, 0, 5)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestHistoryRepository_DeleteByIDUpdatesGroups(t *testing.T) {
+	ctx := historyTestCtx()
+	dbPath := filepath.Join(t.TempDir(), "dumber.db")
+
+	db, err := sqlite.NewConnection(ctx, dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := sqlite.NewHistoryRepository(db)
+	require.NoError(t, repo.Save(ctx, &entity.HistoryEntry{URL: "https://example.com/a", Title: "A"}))
+	require.NoError(t, repo.Save(ctx, &entity.HistoryEntry{URL: "https://example.com/b", Title: "B"}))
+
+	entry, err := repo.FindByURL(ctx, "https://example.com/a")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	require.NoError(t, repo.Delete(ctx, entry.ID))
+
+	groups, err := repo.GroupByDomain(ctx, 10, 10)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, int64(1), groups[0].EntryCount)
+	require.Len(t, groups[0].Entries, 1)
+	assert.Equal(t, "https://example.com/b", groups[0].Entries[0].URL)
+
+	days, err := repo.GroupByDay(ctx, 10, 10)
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, int64(1), days[0].EntryCount)
+}
@@ -97,6 +97,211 @@ func (r *LazyPermissionRepository) GetAll(ctx context.Context, origin string) ([
 	return r.repo.GetAll(ctx, origin)
 }
 
+// LazyCertificatePinRepository wraps a certificate pin repository with lazy database initialization.
+type LazyCertificatePinRepository struct {
+	provider port.DatabaseProvider
+	repo     port.CertificatePinRepository
+	once     sync.Once
+	initErr  error
+}
+
+// NewLazyCertificatePinRepository creates a lazy-loading certificate pin repository.
+func NewLazyCertificatePinRepository(provider port.DatabaseProvider) port.CertificatePinRepository {
+	return &LazyCertificatePinRepository{provider: provider}
+}
+
+func (r *LazyCertificatePinRepository) init(ctx context.Context) error {
+	r.once.Do(func() {
+		db, err := r.provider.DB(ctx)
+		if err != nil {
+			r.initErr = err
+			return
+		}
+		r.repo = NewCertificatePinRepository(db)
+	})
+	return r.initErr
+}
+
+func (r *LazyCertificatePinRepository) Get(ctx context.Context, host string) (*entity.CertificatePin, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.Get(ctx, host)
+}
+
+func (r *LazyCertificatePinRepository) Set(ctx context.Context, pin *entity.CertificatePin) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Set(ctx, pin)
+}
+
+func (r *LazyCertificatePinRepository) Delete(ctx context.Context, host string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Delete(ctx, host)
+}
+
+func (r *LazyCertificatePinRepository) List(ctx context.Context) ([]*entity.CertificatePin, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.List(ctx)
+}
+
+// LazyNoteRepository wraps a note repository with lazy database initialization.
+type LazyNoteRepository struct {
+	provider port.DatabaseProvider
+	repo     port.NoteRepository
+	once     sync.Once
+	initErr  error
+}
+
+// NewLazyNoteRepository creates a lazy-loading note repository.
+func NewLazyNoteRepository(provider port.DatabaseProvider) port.NoteRepository {
+	return &LazyNoteRepository{provider: provider}
+}
+
+func (r *LazyNoteRepository) init(ctx context.Context) error {
+	r.once.Do(func() {
+		db, err := r.provider.DB(ctx)
+		if err != nil {
+			r.initErr = err
+			return
+		}
+		r.repo = NewNoteRepository(db)
+	})
+	return r.initErr
+}
+
+func (r *LazyNoteRepository) Create(ctx context.Context, note *entity.Note) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Create(ctx, note)
+}
+
+func (r *LazyNoteRepository) Get(ctx context.Context, id int64) (*entity.Note, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.Get(ctx, id)
+}
+
+func (r *LazyNoteRepository) Update(ctx context.Context, note *entity.Note) (bool, error) {
+	if err := r.init(ctx); err != nil {
+		return false, err
+	}
+	return r.repo.Update(ctx, note)
+}
+
+func (r *LazyNoteRepository) Delete(ctx context.Context, id int64) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Delete(ctx, id)
+}
+
+func (r *LazyNoteRepository) List(ctx context.Context) ([]*entity.Note, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.List(ctx)
+}
+
+// LazySiteUserAgentRepository wraps a site user agent repository with lazy database initialization.
+type LazySiteUserAgentRepository struct {
+	provider port.DatabaseProvider
+	repo     port.SiteUserAgentRepository
+	once     sync.Once
+	initErr  error
+}
+
+// NewLazySiteUserAgentRepository creates a lazy-loading site user agent repository.
+func NewLazySiteUserAgentRepository(provider port.DatabaseProvider) port.SiteUserAgentRepository {
+	return &LazySiteUserAgentRepository{provider: provider}
+}
+
+func (r *LazySiteUserAgentRepository) init(ctx context.Context) error {
+	r.once.Do(func() {
+		db, err := r.provider.DB(ctx)
+		if err != nil {
+			r.initErr = err
+			return
+		}
+		r.repo = NewSiteUserAgentRepository(db)
+	})
+	return r.initErr
+}
+
+func (r *LazySiteUserAgentRepository) Get(ctx context.Context, domain string) (*entity.SiteUserAgent, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.Get(ctx, domain)
+}
+
+func (r *LazySiteUserAgentRepository) Set(ctx context.Context, site *entity.SiteUserAgent) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Set(ctx, site)
+}
+
+func (r *LazySiteUserAgentRepository) Delete(ctx context.Context, domain string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Delete(ctx, domain)
+}
+
+// LazySiteColorSchemeRepository wraps a site color scheme repository with lazy database initialization.
+type LazySiteColorSchemeRepository struct {
+	provider port.DatabaseProvider
+	repo     port.SiteColorSchemeRepository
+	once     sync.Once
+	initErr  error
+}
+
+// NewLazySiteColorSchemeRepository creates a lazy-loading site color scheme repository.
+func NewLazySiteColorSchemeRepository(provider port.DatabaseProvider) port.SiteColorSchemeRepository {
+	return &LazySiteColorSchemeRepository{provider: provider}
+}
+
+func (r *LazySiteColorSchemeRepository) init(ctx context.Context) error {
+	r.once.Do(func() {
+		db, err := r.provider.DB(ctx)
+		if err != nil {
+			r.initErr = err
+			return
+		}
+		r.repo = NewSiteColorSchemeRepository(db)
+	})
+	return r.initErr
+}
+
+func (r *LazySiteColorSchemeRepository) Get(ctx context.Context, domain string) (*entity.SiteColorScheme, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.Get(ctx, domain)
+}
+
+func (r *LazySiteColorSchemeRepository) Set(ctx context.Context, site *entity.SiteColorScheme) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Set(ctx, site)
+}
+
+func (r *LazySiteColorSchemeRepository) Delete(ctx context.Context, domain string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Delete(ctx, domain)
+}
+
 func (r *LazyHistoryRepository) init(ctx context.Context) error {
 	r.once.Do(func() {
 		db, err := r.provider.DB(ctx)
@@ -290,6 +495,20 @@ func (r *LazyHistoryRepository) GetDomainStats(ctx context.Context, limit int) (
 	return r.repo.GetDomainStats(ctx, limit)
 }
 
+func (r *LazyHistoryRepository) GroupByDomain(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.GroupByDomain(ctx, limit, perGroup)
+}
+
+func (r *LazyHistoryRepository) GroupByDay(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.GroupByDay(ctx, limit, perGroup)
+}
+
 func (r *LazyHistoryRepository) GetHourlyDistribution(ctx context.Context) ([]*entity.HourlyDistribution, error) {
 	if err := r.init(ctx); err != nil {
 		return nil, err
@@ -720,6 +939,59 @@ func (r *LazyContentWhitelistRepository) GetAll(ctx context.Context) ([]string,
 	return r.repo.GetAll(ctx)
 }
 
+// LazyCosmeticFilterExceptionRepository wraps a cosmetic filter exception repository with lazy database initialization.
+type LazyCosmeticFilterExceptionRepository struct {
+	provider port.DatabaseProvider
+	repo     repository.CosmeticFilterExceptionRepository
+	once     sync.Once
+	initErr  error
+}
+
+// NewLazyCosmeticFilterExceptionRepository creates a lazy-loading cosmetic filter exception repository.
+func NewLazyCosmeticFilterExceptionRepository(provider port.DatabaseProvider) repository.CosmeticFilterExceptionRepository {
+	return &LazyCosmeticFilterExceptionRepository{provider: provider}
+}
+
+func (r *LazyCosmeticFilterExceptionRepository) init(ctx context.Context) error {
+	r.once.Do(func() {
+		db, err := r.provider.DB(ctx)
+		if err != nil {
+			r.initErr = err
+			return
+		}
+		r.repo = NewCosmeticFilterExceptionRepository(db)
+	})
+	return r.initErr
+}
+
+func (r *LazyCosmeticFilterExceptionRepository) Add(ctx context.Context, domain string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Add(ctx, domain)
+}
+
+func (r *LazyCosmeticFilterExceptionRepository) Remove(ctx context.Context, domain string) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	return r.repo.Remove(ctx, domain)
+}
+
+func (r *LazyCosmeticFilterExceptionRepository) Contains(ctx context.Context, domain string) (bool, error) {
+	if err := r.init(ctx); err != nil {
+		return false, err
+	}
+	return r.repo.Contains(ctx, domain)
+}
+
+func (r *LazyCosmeticFilterExceptionRepository) GetAll(ctx context.Context) ([]string, error) {
+	if err := r.init(ctx); err != nil {
+		return nil, err
+	}
+	return r.repo.GetAll(ctx)
+}
+
 // LazyRepositories holds all lazy-loaded repositories.
 type LazyRepositories struct {
 	History      repository.HistoryRepository
@@ -729,7 +1001,11 @@ type LazyRepositories struct {
 	Session      repository.SessionRepository
 	SessionState repository.SessionStateRepository
 	Filter       repository.ContentWhitelistRepository
+	Cosmetic     repository.CosmeticFilterExceptionRepository
 	Permission   port.PermissionRepository
+	CertPin      port.CertificatePinRepository
+	SiteUA       port.SiteUserAgentRepository
+	SiteScheme   port.SiteColorSchemeRepository
 }
 
 // NewLazyRepositories creates all lazy repositories from a database provider.
@@ -742,7 +1018,11 @@ func NewLazyRepositories(provider port.DatabaseProvider) *LazyRepositories {
 		Session:      NewLazySessionRepository(provider),
 		SessionState: NewLazySessionStateRepository(provider),
 		Filter:       NewLazyContentWhitelistRepository(provider),
+		Cosmetic:     NewLazyCosmeticFilterExceptionRepository(provider),
 		Permission:   NewLazyPermissionRepository(provider),
+		CertPin:      NewLazyCertificatePinRepository(provider),
+		SiteUA:       NewLazySiteUserAgentRepository(provider),
+		SiteScheme:   NewLazySiteColorSchemeRepository(provider),
 	}
 }
 
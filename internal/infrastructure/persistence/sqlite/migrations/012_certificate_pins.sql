@@ -0,0 +1,11 @@
+-- +goose Up
+-- Per-host certificate pins (HPKP-style SPKI SHA-256 hashes)
+
+CREATE TABLE IF NOT EXISTS certificate_pins (
+    host TEXT PRIMARY KEY NOT NULL,
+    spki_sha256 TEXT NOT NULL,
+    created_at INTEGER NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS certificate_pins;
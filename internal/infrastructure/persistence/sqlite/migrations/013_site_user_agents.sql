@@ -0,0 +1,11 @@
+-- +goose Up
+-- Per-domain user agent presets ("request desktop/mobile site")
+
+CREATE TABLE IF NOT EXISTS site_user_agents (
+    domain TEXT PRIMARY KEY NOT NULL,
+    preset TEXT NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS site_user_agents;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Per-domain color scheme overrides (light/dark instead of the system scheme)
+
+CREATE TABLE IF NOT EXISTS site_color_schemes (
+    domain TEXT PRIMARY KEY NOT NULL,
+    scheme TEXT NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS site_color_schemes;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Quick notes shown on the dumb://notes scratchpad
+
+CREATE TABLE IF NOT EXISTS notes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    body TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notes_updated_at ON notes(updated_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notes_updated_at;
+DROP TABLE IF EXISTS notes;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Cosmetic filter exceptions - domains loaded with network filters only
+
+CREATE TABLE IF NOT EXISTS cosmetic_filter_exceptions (
+    domain TEXT PRIMARY KEY NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS cosmetic_filter_exceptions;
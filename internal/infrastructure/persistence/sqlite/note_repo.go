@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite/sqlc"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+type noteRepo struct {
+	queries *sqlc.Queries
+}
+
+// NewNoteRepository creates a new SQLite-backed note repository. Times are
+// stored as Unix milliseconds so autosaves a moment apart keep their order.
+func NewNoteRepository(db *sql.DB) port.NoteRepository {
+	return &noteRepo{queries: sqlc.New(db)}
+}
+
+func (r *noteRepo) Create(ctx context.Context, note *entity.Note) error {
+	if note == nil {
+		return errors.New("cannot create nil note")
+	}
+	row, err := r.queries.CreateNote(ctx, sqlc.CreateNoteParams{
+		Body:      note.Body,
+		CreatedAt: note.CreatedAt.UnixMilli(),
+		UpdatedAt: note.UpdatedAt.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+	note.ID = row.ID
+	logging.FromContext(ctx).Debug().Int64("id", note.ID).Msg("created note")
+	return nil
+}
+
+func (r *noteRepo) Get(ctx context.Context, id int64) (*entity.Note, error) {
+	row, err := r.queries.GetNote(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return noteFromRow(row), nil
+}
+
+func (r *noteRepo) Update(ctx context.Context, note *entity.Note) (bool, error) {
+	if note == nil {
+		return false, errors.New("cannot update nil note")
+	}
+	rows, err := r.queries.UpdateNote(ctx, sqlc.UpdateNoteParams{
+		Body:      note.Body,
+		UpdatedAt: note.UpdatedAt.UnixMilli(),
+		ID:        note.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *noteRepo) Delete(ctx context.Context, id int64) error {
+	logging.FromContext(ctx).Debug().Int64("id", id).Msg("deleting note")
+	return r.queries.DeleteNote(ctx, id)
+}
+
+func (r *noteRepo) List(ctx context.Context) ([]*entity.Note, error) {
+	rows, err := r.queries.ListNotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notes := make([]*entity.Note, 0, len(rows))
+	for _, row := range rows {
+		notes = append(notes, noteFromRow(row))
+	}
+	return notes, nil
+}
+
+func noteFromRow(row sqlc.Note) *entity.Note {
+	return &entity.Note{
+		ID:        row.ID,
+		Body:      row.Body,
+		CreatedAt: time.UnixMilli(row.CreatedAt),
+		UpdatedAt: time.UnixMilli(row.UpdatedAt),
+	}
+}
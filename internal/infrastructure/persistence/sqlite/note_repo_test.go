@@ -0,0 +1,63 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoteRepository_CRUD(t *testing.T) {
+	ctx := testCtx()
+	db, err := sqlite.NewConnection(ctx, filepath.Join(t.TempDir(), "dumber.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := sqlite.NewNoteRepository(db)
+	base := time.UnixMilli(1700000000000)
+
+	missing, err := repo.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	first := &entity.Note{Body: "groceries", CreatedAt: base, UpdatedAt: base}
+	require.NoError(t, repo.Create(ctx, first))
+	require.NotZero(t, first.ID)
+	second := &entity.Note{Body: "todo", CreatedAt: base.Add(time.Second), UpdatedAt: base.Add(time.Second)}
+	require.NoError(t, repo.Create(ctx, second))
+
+	got, err := repo.Get(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.Body, got.Body)
+	assert.True(t, got.CreatedAt.Equal(base))
+
+	notes, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	assert.Equal(t, second.ID, notes[0].ID, "most recently updated first")
+
+	first.Body = "groceries\n- milk"
+	first.UpdatedAt = base.Add(2 * time.Second)
+	updated, err := repo.Update(ctx, first)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	notes, err = repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	assert.Equal(t, first.ID, notes[0].ID, "an edit moves the note to the top")
+	assert.Equal(t, "groceries\n- milk", notes[0].Body)
+
+	require.NoError(t, repo.Delete(ctx, first.ID))
+	got, err = repo.Get(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	updated, err = repo.Update(ctx, first)
+	require.NoError(t, err)
+	assert.False(t, updated, "updating a deleted note reports it missing")
+}
@@ -0,0 +1,15 @@
+-- name: GetCertificatePin :one
+SELECT * FROM certificate_pins WHERE host = ? LIMIT 1;
+
+-- name: SetCertificatePin :exec
+INSERT INTO certificate_pins (host, spki_sha256, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT(host) DO UPDATE SET
+    spki_sha256 = excluded.spki_sha256,
+    created_at = excluded.created_at;
+
+-- name: DeleteCertificatePin :exec
+DELETE FROM certificate_pins WHERE host = ?;
+
+-- name: ListCertificatePins :many
+SELECT * FROM certificate_pins ORDER BY host;
@@ -0,0 +1,11 @@
+-- name: GetAllCosmeticExceptionDomains :many
+SELECT domain FROM cosmetic_filter_exceptions ORDER BY domain;
+
+-- name: AddCosmeticException :exec
+INSERT OR IGNORE INTO cosmetic_filter_exceptions (domain, created_at) VALUES (?, CURRENT_TIMESTAMP);
+
+-- name: RemoveCosmeticException :exec
+DELETE FROM cosmetic_filter_exceptions WHERE domain = ?;
+
+-- name: IsCosmeticException :one
+SELECT COUNT(*) FROM cosmetic_filter_exceptions WHERE domain = ? LIMIT 1;
@@ -87,6 +87,35 @@ GROUP BY domain
 ORDER BY total_visits DESC
 LIMIT ?;
 
+-- name: GetHistoryDomainGroups :many
+SELECT
+    COALESCE(domain, '') as domain,
+    COUNT(*) as entry_count,
+    SUM(visit_count) as visit_count,
+    MAX(last_visited) as last_visit
+FROM history
+WHERE domain IS NOT NULL AND domain != ''
+GROUP BY domain
+ORDER BY last_visit DESC, domain ASC
+LIMIT ?;
+
+-- name: GetHistoryDayGroups :many
+SELECT
+    date(last_visited, 'localtime') as day,
+    COUNT(*) as entry_count,
+    SUM(visit_count) as visit_count,
+    MAX(last_visited) as last_visit
+FROM history
+GROUP BY day
+ORDER BY day DESC
+LIMIT ?;
+
+-- name: GetRecentHistoryByDay :many
+SELECT * FROM history
+WHERE date(last_visited, 'localtime') = @day
+ORDER BY last_visited DESC, id DESC
+LIMIT @limit;
+
 -- name: GetHourlyDistribution :many
 SELECT
     CAST(strftime('%H', last_visited) AS INTEGER) as hour,
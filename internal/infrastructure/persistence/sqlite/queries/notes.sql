@@ -0,0 +1,14 @@
+-- name: CreateNote :one
+INSERT INTO notes (body, created_at, updated_at) VALUES (?, ?, ?) RETURNING *;
+
+-- name: GetNote :one
+SELECT * FROM notes WHERE id = ? LIMIT 1;
+
+-- name: UpdateNote :execrows
+UPDATE notes SET body = ?, updated_at = ? WHERE id = ?;
+
+-- name: DeleteNote :exec
+DELETE FROM notes WHERE id = ?;
+
+-- name: ListNotes :many
+SELECT * FROM notes ORDER BY updated_at DESC, id DESC;
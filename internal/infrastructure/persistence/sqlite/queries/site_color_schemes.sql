@@ -0,0 +1,12 @@
+-- name: GetSiteColorScheme :one
+SELECT * FROM site_color_schemes WHERE domain = ? LIMIT 1;
+
+-- name: SetSiteColorScheme :exec
+INSERT INTO site_color_schemes (domain, scheme, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT(domain) DO UPDATE SET
+    scheme = excluded.scheme,
+    updated_at = excluded.updated_at;
+
+-- name: DeleteSiteColorScheme :exec
+DELETE FROM site_color_schemes WHERE domain = ?;
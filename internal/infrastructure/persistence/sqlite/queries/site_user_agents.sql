@@ -0,0 +1,12 @@
+-- name: GetSiteUserAgent :one
+SELECT * FROM site_user_agents WHERE domain = ? LIMIT 1;
+
+-- name: SetSiteUserAgent :exec
+INSERT INTO site_user_agents (domain, preset, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT(domain) DO UPDATE SET
+    preset = excluded.preset,
+    updated_at = excluded.updated_at;
+
+-- name: DeleteSiteUserAgent :exec
+DELETE FROM site_user_agents WHERE domain = ?;
@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite/sqlc"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+type siteColorSchemeRepo struct {
+	queries *sqlc.Queries
+}
+
+// NewSiteColorSchemeRepository creates a new SQLite-backed site color scheme repository.
+func NewSiteColorSchemeRepository(db *sql.DB) port.SiteColorSchemeRepository {
+	return &siteColorSchemeRepo{queries: sqlc.New(db)}
+}
+
+func (r *siteColorSchemeRepo) Get(ctx context.Context, domain string) (*entity.SiteColorScheme, error) {
+	row, err := r.queries.GetSiteColorScheme(ctx, domain)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entity.SiteColorScheme{
+		Domain:    row.Domain,
+		Scheme:    entity.ColorScheme(row.Scheme),
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+func (r *siteColorSchemeRepo) Set(ctx context.Context, site *entity.SiteColorScheme) error {
+	if site == nil {
+		return errors.New("cannot set nil site color scheme")
+	}
+	logging.FromContext(ctx).Debug().
+		Str("domain", site.Domain).
+		Str("scheme", string(site.Scheme)).
+		Msg("setting site color scheme")
+
+	return r.queries.SetSiteColorScheme(ctx, sqlc.SetSiteColorSchemeParams{
+		Domain:    site.Domain,
+		Scheme:    string(site.Scheme),
+		UpdatedAt: site.UpdatedAt,
+	})
+}
+
+func (r *siteColorSchemeRepo) Delete(ctx context.Context, domain string) error {
+	logging.FromContext(ctx).Debug().Str("domain", domain).Msg("deleting site color scheme")
+	return r.queries.DeleteSiteColorScheme(ctx, domain)
+}
@@ -0,0 +1,36 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteColorSchemeRepository_CRUD(t *testing.T) {
+	ctx := testCtx()
+	db, err := sqlite.NewConnection(ctx, filepath.Join(t.TempDir(), "dumber.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	repo := sqlite.NewSiteColorSchemeRepository(db)
+
+	missing, err := repo.Get(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, repo.Set(ctx, &entity.SiteColorScheme{Domain: "example.com", Scheme: entity.ColorSchemeDark, UpdatedAt: 1}))
+	require.NoError(t, repo.Set(ctx, &entity.SiteColorScheme{Domain: "example.com", Scheme: entity.ColorSchemeLight, UpdatedAt: 2}))
+
+	got, err := repo.Get(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.SiteColorScheme{Domain: "example.com", Scheme: entity.ColorSchemeLight, UpdatedAt: 2}, got)
+
+	require.NoError(t, repo.Delete(ctx, "example.com"))
+	got, err = repo.Get(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
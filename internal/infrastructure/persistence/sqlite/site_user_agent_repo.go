@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/persistence/sqlite/sqlc"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+type siteUserAgentRepo struct {
+	queries *sqlc.Queries
+}
+
+// NewSiteUserAgentRepository creates a new SQLite-backed site user agent repository.
+func NewSiteUserAgentRepository(db *sql.DB) port.SiteUserAgentRepository {
+	return &siteUserAgentRepo{queries: sqlc.New(db)}
+}
+
+func (r *siteUserAgentRepo) Get(ctx context.Context, domain string) (*entity.SiteUserAgent, error) {
+	row, err := r.queries.GetSiteUserAgent(ctx, domain)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entity.SiteUserAgent{
+		Domain:    row.Domain,
+		Preset:    entity.UserAgentPreset(row.Preset),
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+func (r *siteUserAgentRepo) Set(ctx context.Context, site *entity.SiteUserAgent) error {
+	if site == nil {
+		return errors.New("cannot set nil site user agent")
+	}
+	logging.FromContext(ctx).Debug().
+		Str("domain", site.Domain).
+		Str("preset", string(site.Preset)).
+		Msg("setting site user agent")
+
+	return r.queries.SetSiteUserAgent(ctx, sqlc.SetSiteUserAgentParams{
+		Domain:    site.Domain,
+		Preset:    string(site.Preset),
+		UpdatedAt: site.UpdatedAt,
+	})
+}
+
+func (r *siteUserAgentRepo) Delete(ctx context.Context, domain string) error {
+	logging.FromContext(ctx).Debug().Str("domain", domain).Msg("deleting site user agent")
+	return r.queries.DeleteSiteUserAgent(ctx, domain)
+}
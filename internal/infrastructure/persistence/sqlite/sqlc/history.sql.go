@@ -290,6 +290,101 @@ func (q *Queries) GetHistoryByURL(ctx context.Context, url string) (History, err
 	return i, err
 }
 
+const GetHistoryDayGroups = `-- name: GetHistoryDayGroups :many
+SELECT
+    date(last_visited, 'localtime') as day,
+    COUNT(*) as entry_count,
+    SUM(visit_count) as visit_count,
+    MAX(last_visited) as last_visit
+FROM history
+GROUP BY day
+ORDER BY day DESC
+LIMIT ?
+`
+
+type GetHistoryDayGroupsRow struct {
+	Day        interface{}     `json:"day"`
+	EntryCount int64           `json:"entry_count"`
+	VisitCount sql.NullFloat64 `json:"visit_count"`
+	LastVisit  interface{}     `json:"last_visit"`
+}
+
+func (q *Queries) GetHistoryDayGroups(ctx context.Context, limit int64) ([]GetHistoryDayGroupsRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetHistoryDayGroups, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetHistoryDayGroupsRow{}
+	for rows.Next() {
+		var i GetHistoryDayGroupsRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.EntryCount,
+			&i.VisitCount,
+			&i.LastVisit,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetHistoryDomainGroups = `-- name: GetHistoryDomainGroups :many
+SELECT
+    COALESCE(domain, '') as domain,
+    COUNT(*) as entry_count,
+    SUM(visit_count) as visit_count,
+    MAX(last_visited) as last_visit
+FROM history
+WHERE domain IS NOT NULL AND domain != ''
+GROUP BY domain
+ORDER BY last_visit DESC, domain ASC
+LIMIT ?
+`
+
+type GetHistoryDomainGroupsRow struct {
+	Domain     string          `json:"domain"`
+	EntryCount int64           `json:"entry_count"`
+	VisitCount sql.NullFloat64 `json:"visit_count"`
+	LastVisit  interface{}     `json:"last_visit"`
+}
+
+func (q *Queries) GetHistoryDomainGroups(ctx context.Context, limit int64) ([]GetHistoryDomainGroupsRow, error) {
+	rows, err := q.db.QueryContext(ctx, GetHistoryDomainGroups, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetHistoryDomainGroupsRow{}
+	for rows.Next() {
+		var i GetHistoryDomainGroupsRow
+		if err := rows.Scan(
+			&i.Domain,
+			&i.EntryCount,
+			&i.VisitCount,
+			&i.LastVisit,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const GetHistoryStats = `-- name: GetHistoryStats :one
 SELECT
     COUNT(*) as total_entries,
@@ -429,6 +524,50 @@ func (q *Queries) GetRecentHistory(ctx context.Context, arg GetRecentHistoryPara
 	return items, nil
 }
 
+const GetRecentHistoryByDay = `-- name: GetRecentHistoryByDay :many
+SELECT id, url, title, favicon_url, visit_count, last_visited, created_at, domain FROM history
+WHERE date(last_visited, 'localtime') = ?1
+ORDER BY last_visited DESC, id DESC
+LIMIT ?2
+`
+
+type GetRecentHistoryByDayParams struct {
+	Day   interface{} `json:"day"`
+	Limit int64       `json:"limit"`
+}
+
+func (q *Queries) GetRecentHistoryByDay(ctx context.Context, arg GetRecentHistoryByDayParams) ([]History, error) {
+	rows, err := q.db.QueryContext(ctx, GetRecentHistoryByDay, arg.Day, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []History{}
+	for rows.Next() {
+		var i History
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Title,
+			&i.FaviconUrl,
+			&i.VisitCount,
+			&i.LastVisited,
+			&i.CreatedAt,
+			&i.Domain,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const GetRecentHistoryByDomain = `-- name: GetRecentHistoryByDomain :many
 SELECT id, url, title, favicon_url, visit_count, last_visited, created_at, domain FROM history
 WHERE domain = ?1
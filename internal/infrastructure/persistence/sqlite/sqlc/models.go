@@ -9,12 +9,23 @@ import (
 	"time"
 )
 
+type CertificatePin struct {
+	Host       string `json:"host"`
+	SpkiSha256 string `json:"spki_sha256"`
+	CreatedAt  int64  `json:"created_at"`
+}
+
 type ContentWhitelist struct {
 	ID        int64        `json:"id"`
 	Domain    string       `json:"domain"`
 	CreatedAt sql.NullTime `json:"created_at"`
 }
 
+type CosmeticFilterException struct {
+	Domain    string       `json:"domain"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
 type Favicon struct {
 	Key           string         `json:"key"`
 	SourceUrl     sql.NullString `json:"source_url"`
@@ -75,6 +86,13 @@ type HistoryFt struct {
 	Title string `json:"title"`
 }
 
+type Note struct {
+	ID        int64  `json:"id"`
+	Body      string `json:"body"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
 type Permission struct {
 	Origin         string       `json:"origin"`
 	PermissionType string       `json:"permission_type"`
@@ -99,6 +117,18 @@ type SessionState struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+type SiteColorScheme struct {
+	Domain    string `json:"domain"`
+	Scheme    string `json:"scheme"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+type SiteUserAgent struct {
+	Domain    string `json:"domain"`
+	Preset    string `json:"preset"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
 type ZoomLevel struct {
 	Domain     string       `json:"domain"`
 	ZoomFactor float64      `json:"zoom_factor"`
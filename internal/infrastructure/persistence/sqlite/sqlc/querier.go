@@ -10,12 +10,15 @@ import (
 )
 
 type Querier interface {
+	AddCosmeticException(ctx context.Context, domain string) error
 	AddToWhitelist(ctx context.Context, domain string) error
 	AssignTagToFavorite(ctx context.Context, arg AssignTagToFavoriteParams) error
 	CapVisitCount(ctx context.Context, arg CapVisitCountParams) error
 	CreateFavorite(ctx context.Context, arg CreateFavoriteParams) (CreateFavoriteRow, error)
+	CreateNote(ctx context.Context, arg CreateNoteParams) (Note, error)
 	CreateTag(ctx context.Context, arg CreateTagParams) (FavoriteTag, error)
 	DeleteAllHistory(ctx context.Context) error
+	DeleteCertificatePin(ctx context.Context, host string) error
 	// Deletes exited browser sessions older than the given cutoff time.
 	DeleteExitedSessionsBefore(ctx context.Context, endedAt sql.NullTime) (int64, error)
 	DeleteFavicon(ctx context.Context, key string) error
@@ -24,14 +27,18 @@ type Querier interface {
 	DeleteHistoryByID(ctx context.Context, id int64) error
 	DeleteHistoryOlderThan(ctx context.Context, lastVisited sql.NullTime) error
 	DeleteHistorySince(ctx context.Context, lastVisited sql.NullTime) error
+	DeleteNote(ctx context.Context, id int64) error
 	// Deletes exited browser sessions beyond the keep limit, keeping the most recent ones.
 	DeleteOldestExitedSessions(ctx context.Context, offset int64) (int64, error)
 	DeletePermission(ctx context.Context, arg DeletePermissionParams) error
 	DeleteSession(ctx context.Context, id string) error
 	DeleteSessionState(ctx context.Context, sessionID string) error
+	DeleteSiteColorScheme(ctx context.Context, domain string) error
+	DeleteSiteUserAgent(ctx context.Context, domain string) error
 	DeleteTag(ctx context.Context, id int64) error
 	DeleteZoomLevel(ctx context.Context, domain string) error
 	GetActiveBrowserSession(ctx context.Context) (Session, error)
+	GetAllCosmeticExceptionDomains(ctx context.Context) ([]string, error)
 	GetAllFavorites(ctx context.Context) ([]GetAllFavoritesRow, error)
 	GetAllMostVisited(ctx context.Context) ([]History, error)
 	GetAllRecentHistory(ctx context.Context) ([]History, error)
@@ -39,6 +46,7 @@ type Querier interface {
 	GetAllSessionStates(ctx context.Context) ([]SessionState, error)
 	GetAllTags(ctx context.Context) ([]FavoriteTag, error)
 	GetAllWhitelistedDomains(ctx context.Context) ([]string, error)
+	GetCertificatePin(ctx context.Context, host string) (CertificatePin, error)
 	GetDailyVisitCount(ctx context.Context, date interface{}) ([]GetDailyVisitCountRow, error)
 	GetDomainStats(ctx context.Context, limit int64) ([]GetDomainStatsRow, error)
 	GetFavicon(ctx context.Context, key string) (Favicon, error)
@@ -47,11 +55,15 @@ type Querier interface {
 	GetFavoriteByURL(ctx context.Context, url string) (GetFavoriteByURLRow, error)
 	GetFavoritesByTag(ctx context.Context, tagID int64) ([]GetFavoritesByTagRow, error)
 	GetHistoryByURL(ctx context.Context, url string) (History, error)
+	GetHistoryDayGroups(ctx context.Context, limit int64) ([]GetHistoryDayGroupsRow, error)
+	GetHistoryDomainGroups(ctx context.Context, limit int64) ([]GetHistoryDomainGroupsRow, error)
 	GetHistoryStats(ctx context.Context) (GetHistoryStatsRow, error)
 	GetHourlyDistribution(ctx context.Context) ([]GetHourlyDistributionRow, error)
 	GetMostVisited(ctx context.Context, datetime interface{}) ([]History, error)
+	GetNote(ctx context.Context, id int64) (Note, error)
 	GetPermission(ctx context.Context, arg GetPermissionParams) (Permission, error)
 	GetRecentHistory(ctx context.Context, arg GetRecentHistoryParams) ([]History, error)
+	GetRecentHistoryByDay(ctx context.Context, arg GetRecentHistoryByDayParams) ([]History, error)
 	GetRecentHistoryByDomain(ctx context.Context, arg GetRecentHistoryByDomainParams) ([]History, error)
 	GetRecentHistorySince(ctx context.Context, datetime interface{}) ([]History, error)
 	GetRecentHistoryWindow(ctx context.Context, arg GetRecentHistoryWindowParams) ([]History, error)
@@ -60,6 +72,8 @@ type Querier interface {
 	GetSessionByID(ctx context.Context, id string) (Session, error)
 	GetSessionState(ctx context.Context, sessionID string) (SessionState, error)
 	GetSessionsWithState(ctx context.Context, limit int64) ([]GetSessionsWithStateRow, error)
+	GetSiteColorScheme(ctx context.Context, domain string) (SiteColorScheme, error)
+	GetSiteUserAgent(ctx context.Context, domain string) (SiteUserAgent, error)
 	GetTagByID(ctx context.Context, id int64) (FavoriteTag, error)
 	GetTagByName(ctx context.Context, trim string) (FavoriteTag, error)
 	GetTagsForFavorite(ctx context.Context, favoriteID int64) ([]FavoriteTag, error)
@@ -69,23 +83,31 @@ type Querier interface {
 	IncrementVisitCount(ctx context.Context, url string) error
 	IncrementVisitCountByDelta(ctx context.Context, arg IncrementVisitCountByDeltaParams) error
 	InsertSession(ctx context.Context, arg InsertSessionParams) error
+	IsCosmeticException(ctx context.Context, domain string) (int64, error)
 	IsWhitelisted(ctx context.Context, domain string) (int64, error)
 	ListAllPermissions(ctx context.Context) ([]Permission, error)
+	ListCertificatePins(ctx context.Context) ([]CertificatePin, error)
+	ListNotes(ctx context.Context) ([]Note, error)
 	ListPermissionsByOrigin(ctx context.Context, origin string) ([]Permission, error)
 	ListZoomLevels(ctx context.Context) ([]ZoomLevel, error)
 	MarkSessionEnded(ctx context.Context, arg MarkSessionEndedParams) error
+	RemoveCosmeticException(ctx context.Context, domain string) error
 	RemoveFromWhitelist(ctx context.Context, domain string) error
 	RemoveTagFromFavorite(ctx context.Context, arg RemoveTagFromFavoriteParams) error
 	SearchHistory(ctx context.Context, arg SearchHistoryParams) ([]History, error)
 	SearchHistoryFTSTitle(ctx context.Context, arg SearchHistoryFTSTitleParams) ([]History, error)
 	SearchHistoryFTSUrl(ctx context.Context, arg SearchHistoryFTSUrlParams) ([]History, error)
 	SearchHistoryFTSUrlWithDomainBoost(ctx context.Context, arg SearchHistoryFTSUrlWithDomainBoostParams) ([]SearchHistoryFTSUrlWithDomainBoostRow, error)
+	SetCertificatePin(ctx context.Context, arg SetCertificatePinParams) error
 	SetFavoriteShortcut(ctx context.Context, arg SetFavoriteShortcutParams) error
 	SetPermission(ctx context.Context, arg SetPermissionParams) error
+	SetSiteColorScheme(ctx context.Context, arg SetSiteColorSchemeParams) error
+	SetSiteUserAgent(ctx context.Context, arg SetSiteUserAgentParams) error
 	SetZoomLevel(ctx context.Context, arg SetZoomLevelParams) error
 	UpdateFaviconLastChecked(ctx context.Context, arg UpdateFaviconLastCheckedParams) error
 	UpdateFavorite(ctx context.Context, arg UpdateFavoriteParams) error
 	UpdateFavoritePosition(ctx context.Context, arg UpdateFavoritePositionParams) error
+	UpdateNote(ctx context.Context, arg UpdateNoteParams) (int64, error)
 	UpdateTag(ctx context.Context, arg UpdateTagParams) error
 	UpsertFavicon(ctx context.Context, arg UpsertFaviconParams) error
 	UpsertHistory(ctx context.Context, arg UpsertHistoryParams) error
@@ -0,0 +1,116 @@
+// Package requestmock loads the mock responses of the ">mock" omnibox
+// command from a TOML file.
+package requestmock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	domainrequestmock "github.com/bnema/dumber/internal/domain/requestmock"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/pelletier/go-toml/v2"
+)
+
+var _ port.RequestMockSource = (*File)(nil)
+
+// fileMock is one mock as written in the file.
+type fileMock struct {
+	URL         string            `toml:"url"`
+	Method      string            `toml:"method"`
+	Status      int               `toml:"status"`
+	ContentType string            `toml:"content_type"`
+	Headers     map[string]string `toml:"headers"`
+	Body        string            `toml:"body"`
+	// BodyFile is read as the body; relative paths start at the mocks file.
+	BodyFile string `toml:"body_file"`
+}
+
+// File reads mock sets from a TOML file where each array of tables is a set
+// named by its key:
+//
+//	[[empty-cart]]
+//	url = "https://shop.example.com/api/cart*"
+//	body = '{"items": []}'
+type File struct {
+	path string
+}
+
+// NewFile creates a source reading the mock sets in path.
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+// Path returns the file mock sets are read from.
+func (f *File) Path() string {
+	return f.path
+}
+
+// LoadRequestMocks reads every set in the file, sorted by name. A missing
+// file yields no sets.
+func (f *File) LoadRequestMocks(ctx context.Context) ([]entity.RequestMockSet, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		logging.FromContext(ctx).Debug().Str("path", f.path).Msg("no request mocks file")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read request mocks: %w", err)
+	}
+
+	var raw map[string][]fileMock
+	if err := toml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", f.path, err)
+	}
+
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sets := make([]entity.RequestMockSet, 0, len(names))
+	for _, name := range names {
+		set := entity.RequestMockSet{Name: name, Mocks: make([]entity.RequestMock, 0, len(raw[name]))}
+		for i, fm := range raw[name] {
+			mock, mockErr := f.mock(fm)
+			if mockErr != nil {
+				return nil, fmt.Errorf("set %q, mock %d: %w", name, i+1, mockErr)
+			}
+			set.Mocks = append(set.Mocks, mock)
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+func (f *File) mock(fm fileMock) (entity.RequestMock, error) {
+	body := fm.Body
+	if fm.BodyFile != "" {
+		if body != "" {
+			return entity.RequestMock{}, errors.New("body and body_file are both set")
+		}
+		path := fm.BodyFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(f.path), path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return entity.RequestMock{}, fmt.Errorf("read body_file: %w", err)
+		}
+		body = string(data)
+	}
+	return domainrequestmock.Normalize(entity.RequestMock{
+		URLPattern:  fm.URL,
+		Method:      fm.Method,
+		Status:      fm.Status,
+		ContentType: fm.ContentType,
+		Headers:     fm.Headers,
+		Body:        body,
+	})
+}
@@ -0,0 +1,76 @@
+package requestmock
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestFile_LoadRequestMocks(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "user.json", `{"name": "Ada"}`)
+	path := writeFile(t, dir, "mocks.toml", `
+[[signed-in]]
+url = "https://api.example.com/me"
+body_file = "user.json"
+
+[[signed-in]]
+url = "https://api.example.com/*"
+method = "post"
+status = 204
+headers = { "X-Mock" = "yes" }
+
+[[errors]]
+url = "*"
+status = 503
+content_type = "text/plain"
+body = "down"
+`)
+
+	sets, err := NewFile(path).LoadRequestMocks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sets, 2)
+
+	assert.Equal(t, "errors", sets[0].Name, "sets are sorted by name")
+	require.Len(t, sets[0].Mocks, 1)
+	assert.Equal(t, 503, sets[0].Mocks[0].Status)
+	assert.Equal(t, "text/plain", sets[0].Mocks[0].ContentType)
+	assert.Equal(t, "down", sets[0].Mocks[0].Body)
+
+	signedIn := sets[1]
+	assert.Equal(t, "signed-in", signedIn.Name)
+	require.Len(t, signedIn.Mocks, 2)
+	assert.Equal(t, `{"name": "Ada"}`, signedIn.Mocks[0].Body)
+	assert.Equal(t, 200, signedIn.Mocks[0].Status)
+	assert.Equal(t, "application/json", signedIn.Mocks[0].ContentType)
+	assert.Equal(t, "POST", signedIn.Mocks[1].Method)
+	assert.Equal(t, map[string]string{"X-Mock": "yes"}, signedIn.Mocks[1].Headers)
+}
+
+func TestFile_LoadRequestMocksMissingFile(t *testing.T) {
+	sets, err := NewFile(filepath.Join(t.TempDir(), "mocks.toml")).LoadRequestMocks(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, sets)
+}
+
+func TestFile_LoadRequestMocksInvalidMock(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "mocks.toml", `
+[[broken]]
+url = "https://example.com/*"
+status = 42
+`)
+	_, err := NewFile(path).LoadRequestMocks(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `set "broken", mock 1`)
+}
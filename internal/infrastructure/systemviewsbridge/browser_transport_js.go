@@ -25,9 +25,11 @@ func NewBrowserClient() *Client {
 func callbackPlanForMessage(msgType string) (callbackPlan, bool) {
 	switch msgType {
 	case "history_timeline", "history_timeline_by_domain", "history_timeline_window", "history_search_fts", "history_delete_entry", "history_delete_range", "history_stats", "history_analytics",
-		"history_domain_stats", "history_delete_domain", "favorite_list", "favorite_create", "favorite_update", "favorite_delete", "tag_list",
+		"history_domain_stats", "history_delete_domain", "history_group_by_domain", "history_group_by_day", "favorite_list", "favorite_create", "favorite_update", "favorite_delete", "tag_list",
 		"favorite_set_shortcut",
-		"tag_create", "tag_update", "tag_delete", "tag_assign", "tag_remove":
+		"tag_create", "tag_update", "tag_delete", "tag_assign", "tag_remove",
+		"homepage_dashboard", "console_list", "console_clear", "navtree_list",
+		"notes_list", "notes_create", "notes_save", "notes_delete":
 		return callbackPlan{success: "__dumber_homepage_response", failure: "__dumber_error"}, true
 	case "save_config":
 		return callbackPlan{success: "__dumber_config_saved", failure: "__dumber_config_error"}, true
@@ -30,6 +30,10 @@ type Client struct {
 var _ port.SystemviewConfigService = (*Client)(nil)
 var _ port.SystemviewHistoryService = (*Client)(nil)
 var _ port.SystemviewFavoritesService = (*Client)(nil)
+var _ port.SystemviewHomepageService = (*Client)(nil)
+var _ port.SystemviewConsoleService = (*Client)(nil)
+var _ port.SystemviewNavigationTreeService = (*Client)(nil)
+var _ port.SystemviewNotesService = (*Client)(nil)
 
 var requestSeq atomic.Uint64
 
@@ -226,6 +230,25 @@ func (c *Client) DomainStats(ctx context.Context, limit int) ([]*entity.DomainSt
 	}{RequestID: nextRequestID(), Limit: limit})
 }
 
+func (c *Client) GroupByDomain(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	return c.historyGroups(ctx, "history_group_by_domain", limit, perGroup)
+}
+
+func (c *Client) GroupByDay(ctx context.Context, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	return c.historyGroups(ctx, "history_group_by_day", limit, perGroup)
+}
+
+func (c *Client) historyGroups(ctx context.Context, msgType string, limit, perGroup int) ([]*entity.HistoryGroup, error) {
+	if limit < 0 || perGroup < 0 {
+		return nil, fmt.Errorf("history group limits must be non-negative, got %d/%d", limit, perGroup)
+	}
+	return request[[]*entity.HistoryGroup](c, ctx, msgType, struct {
+		RequestID string `json:"requestId"`
+		Limit     int    `json:"limit"`
+		PerGroup  int    `json:"perGroup"`
+	}{RequestID: nextRequestID(), Limit: limit, PerGroup: perGroup})
+}
+
 func (c *Client) DeleteDomain(ctx context.Context, domain string) error {
 	domain = strings.TrimSpace(domain)
 	if domain == "" {
@@ -400,6 +423,62 @@ func (c *Client) RemoveTag(ctx context.Context, favoriteID, tagID int64) error {
 	return err
 }
 
+func (c *Client) Dashboard(ctx context.Context) (dto.HomepageDashboard, error) {
+	return request[dto.HomepageDashboard](c, ctx, "homepage_dashboard", struct {
+		RequestID string `json:"requestId"`
+	}{RequestID: nextRequestID()})
+}
+
+func (c *Client) ConsoleLogs(ctx context.Context) ([]dto.ConsoleLog, error) {
+	return request[[]dto.ConsoleLog](c, ctx, "console_list", struct {
+		RequestID string `json:"requestId"`
+	}{RequestID: nextRequestID()})
+}
+
+// ClearConsole drops the captured output of one WebView, or of every WebView
+// when webViewID is 0.
+func (c *Client) ClearConsole(ctx context.Context, webViewID uint64) error {
+	_, err := request[struct{}](c, ctx, "console_clear", struct {
+		RequestID string `json:"requestId"`
+		WebViewID uint64 `json:"webview_id"`
+	}{RequestID: nextRequestID(), WebViewID: webViewID})
+	return err
+}
+
+func (c *Client) NavigationTrees(ctx context.Context) ([]dto.NavigationTree, error) {
+	return request[[]dto.NavigationTree](c, ctx, "navtree_list", struct {
+		RequestID string `json:"requestId"`
+	}{RequestID: nextRequestID()})
+}
+
+func (c *Client) Notes(ctx context.Context) ([]*entity.Note, error) {
+	return request[[]*entity.Note](c, ctx, "notes_list", struct {
+		RequestID string `json:"requestId"`
+	}{RequestID: nextRequestID()})
+}
+
+func (c *Client) CreateNote(ctx context.Context) (*entity.Note, error) {
+	return request[*entity.Note](c, ctx, "notes_create", struct {
+		RequestID string `json:"requestId"`
+	}{RequestID: nextRequestID()})
+}
+
+func (c *Client) SaveNote(ctx context.Context, id int64, body string) (*entity.Note, error) {
+	return request[*entity.Note](c, ctx, "notes_save", struct {
+		RequestID string `json:"requestId"`
+		ID        int64  `json:"id"`
+		Body      string `json:"body"`
+	}{RequestID: nextRequestID(), ID: id, Body: body})
+}
+
+func (c *Client) DeleteNote(ctx context.Context, id int64) error {
+	_, err := request[struct{}](c, ctx, "notes_delete", struct {
+		RequestID string `json:"requestId"`
+		ID        int64  `json:"id"`
+	}{RequestID: nextRequestID(), ID: id})
+	return err
+}
+
 func (c *Client) transport() Transport {
 	if c == nil {
 		return nil
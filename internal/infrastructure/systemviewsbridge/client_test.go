@@ -9,6 +9,7 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
 	systemviewsbridgemocks "github.com/bnema/dumber/internal/infrastructure/systemviewsbridge/mocks"
 	"github.com/stretchr/testify/mock"
 )
@@ -242,6 +243,36 @@ func TestClientDeleteRangeSendsRange(t *testing.T) {
 	}
 }
 
+func TestClientGroupByDaySendsLimits(t *testing.T) {
+	t.Parallel()
+
+	native := newTransportRecorder(t, true, []byte(`{"requestId":"req-11","success":true,"data":[{"key":"2026-06-20","entry_count":2,"visit_count":5}]}`))
+	client := NewClient(native, nil)
+
+	groups, err := client.GroupByDay(context.Background(), 30, 50)
+	if err != nil {
+		t.Fatalf("GroupByDay() error = %v", err)
+	}
+	if len(groups) != 1 || groups[0].Key != "2026-06-20" || groups[0].EntryCount != 2 {
+		t.Fatalf("GroupByDay() = %+v, want one decoded group", groups)
+	}
+
+	var msg port.WebUIMessage
+	if err := json.Unmarshal(native.last, &msg); err != nil {
+		t.Fatalf("unmarshal sent envelope: %v", err)
+	}
+	if msg.Type != "history_group_by_day" {
+		t.Fatalf("sent type = %q, want %q", msg.Type, "history_group_by_day")
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if payload["limit"] != float64(30) || payload["perGroup"] != float64(50) {
+		t.Fatalf("payload = %s, want limit 30 and perGroup 50", msg.Payload)
+	}
+}
+
 func TestClientListDecodesFavorites(t *testing.T) {
 	t.Parallel()
 
@@ -294,6 +325,140 @@ func TestClientListTagsDecodesTags(t *testing.T) {
 	}
 }
 
+func TestClientDashboardDecodesPayload(t *testing.T) {
+	t.Parallel()
+
+	native := newTransportRecorder(t, true, []byte(`{"requestId":"req-14","success":true,"data":{"widgets":["search","top_favorites"],"search_url":"https://duckduckgo.com/?q=%s","top_favorites":[{"id":3,"url":"https://example.com","title":"Example"}]}}`))
+	client := NewClient(native, nil)
+
+	dashboard, err := client.Dashboard(context.Background())
+	if err != nil {
+		t.Fatalf("Dashboard() error = %v", err)
+	}
+	if len(dashboard.Widgets) != 2 || dashboard.Widgets[1] != entity.HomepageWidgetTopFavorites {
+		t.Fatalf("Dashboard() widgets = %v", dashboard.Widgets)
+	}
+	if dashboard.SearchURL != "https://duckduckgo.com/?q=%s" {
+		t.Fatalf("Dashboard() search URL = %q", dashboard.SearchURL)
+	}
+	if len(dashboard.TopFavorites) != 1 || dashboard.TopFavorites[0].URL != "https://example.com" {
+		t.Fatalf("Dashboard() top favorites = %+v", dashboard.TopFavorites)
+	}
+
+	var msg port.WebUIMessage
+	if err := json.Unmarshal(native.last, &msg); err != nil {
+		t.Fatalf("unmarshal sent envelope: %v", err)
+	}
+	if msg.Type != "homepage_dashboard" {
+		t.Fatalf("sent type = %q, want %q", msg.Type, "homepage_dashboard")
+	}
+}
+
+func TestClientConsoleLogsDecodesPayload(t *testing.T) {
+	t.Parallel()
+
+	native := newTransportRecorder(t, true, []byte(`{"requestId":"req-15","success":true,"data":[{"webview_id":7,"url":"https://example.com","dropped":2,"last_logged":"2026-04-24T12:00:00Z","messages":[{"level":"warn","message":"deprecated API","timestamp":"2026-04-24T12:00:00Z"}]}]}`))
+	client := NewClient(native, nil)
+
+	logs, err := client.ConsoleLogs(context.Background())
+	if err != nil {
+		t.Fatalf("ConsoleLogs() error = %v", err)
+	}
+	if len(logs) != 1 || logs[0].WebViewID != 7 || logs[0].Dropped != 2 {
+		t.Fatalf("ConsoleLogs() = %+v", logs)
+	}
+	if len(logs[0].Messages) != 1 || logs[0].Messages[0].Level != entity.ConsoleLevelWarn {
+		t.Fatalf("ConsoleLogs() messages = %+v", logs[0].Messages)
+	}
+
+	var msg port.WebUIMessage
+	if err := json.Unmarshal(native.last, &msg); err != nil {
+		t.Fatalf("unmarshal sent envelope: %v", err)
+	}
+	if msg.Type != "console_list" {
+		t.Fatalf("sent type = %q, want %q", msg.Type, "console_list")
+	}
+}
+
+func TestClientNavigationTreesDecodesPayload(t *testing.T) {
+	t.Parallel()
+
+	native := newTransportRecorder(t, true, []byte(`{"requestId":"req-17","success":true,"data":[{"pane_id":"pane-1","current":2,"last_visit":"2026-04-24T12:00:00Z","nodes":[{"id":1,"url":"https://example.com","visits":1,"first_visit":"2026-04-24T12:00:00Z","last_visit":"2026-04-24T12:00:00Z"},{"id":2,"parent_id":1,"url":"https://example.com/docs","visits":1,"first_visit":"2026-04-24T12:00:00Z","last_visit":"2026-04-24T12:00:00Z"}]}]}`))
+	client := NewClient(native, nil)
+
+	trees, err := client.NavigationTrees(context.Background())
+	if err != nil {
+		t.Fatalf("NavigationTrees() error = %v", err)
+	}
+	if len(trees) != 1 || trees[0].PaneID != "pane-1" || trees[0].Current != 2 {
+		t.Fatalf("NavigationTrees() = %+v", trees)
+	}
+	if len(trees[0].Nodes) != 2 || trees[0].Nodes[1].ParentID != 1 {
+		t.Fatalf("NavigationTrees() nodes = %+v", trees[0].Nodes)
+	}
+
+	var msg port.WebUIMessage
+	if err := json.Unmarshal(native.last, &msg); err != nil {
+		t.Fatalf("unmarshal sent envelope: %v", err)
+	}
+	if msg.Type != "navtree_list" {
+		t.Fatalf("sent type = %q, want %q", msg.Type, "navtree_list")
+	}
+}
+
+func TestClientClearConsoleSendsWebViewID(t *testing.T) {
+	t.Parallel()
+
+	native := newTransportRecorder(t, true, []byte(`{"requestId":"req-16","success":true,"data":{}}`))
+	client := NewClient(native, nil)
+
+	if err := client.ClearConsole(context.Background(), 7); err != nil {
+		t.Fatalf("ClearConsole() error = %v", err)
+	}
+
+	var msg struct {
+		Type    string `json:"type"`
+		Payload struct {
+			WebViewID uint64 `json:"webview_id"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(native.last, &msg); err != nil {
+		t.Fatalf("unmarshal sent envelope: %v", err)
+	}
+	if msg.Type != "console_clear" || msg.Payload.WebViewID != 7 {
+		t.Fatalf("sent = %+v", msg)
+	}
+}
+
+func TestClientSaveNoteSendsBodyAndDecodesNote(t *testing.T) {
+	t.Parallel()
+
+	native := newTransportRecorder(t, true, []byte(`{"requestId":"req-18","success":true,"data":{"id":3,"body":"milk\neggs","created_at":"2026-04-24T12:00:00Z","updated_at":"2026-04-24T12:05:00Z"}}`))
+	client := NewClient(native, nil)
+
+	note, err := client.SaveNote(context.Background(), 3, "milk\neggs")
+	if err != nil {
+		t.Fatalf("SaveNote() error = %v", err)
+	}
+	if note == nil || note.ID != 3 || note.Body != "milk\neggs" {
+		t.Fatalf("SaveNote() = %+v", note)
+	}
+
+	var msg struct {
+		Type    string `json:"type"`
+		Payload struct {
+			ID   int64  `json:"id"`
+			Body string `json:"body"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(native.last, &msg); err != nil {
+		t.Fatalf("unmarshal sent envelope: %v", err)
+	}
+	if msg.Type != "notes_save" || msg.Payload.ID != 3 || msg.Payload.Body != "milk\neggs" {
+		t.Fatalf("sent = %+v", msg)
+	}
+}
+
 func TestClientCurrentAndDefaultDecodeConfigPayload(t *testing.T) {
 	t.Parallel()
 
@@ -629,4 +794,10 @@ func TestClientRejectsNegativeHistoryLimits(t *testing.T) {
 	if _, err := client.Search(context.Background(), "example", -1); err == nil {
 		t.Fatal("Search() error = nil, want error")
 	}
+	if _, err := client.GroupByDomain(context.Background(), -1, 0); err == nil {
+		t.Fatal("GroupByDomain() error = nil, want error")
+	}
+	if _, err := client.GroupByDay(context.Background(), 10, -1); err == nil {
+		t.Fatal("GroupByDay() error = nil, want error")
+	}
 }
@@ -0,0 +1,195 @@
+// Package tts reads text aloud by running an external text-to-speech
+// command such as espeak-ng.
+package tts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/readaloud"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+var _ port.SpeechPlayer = (*Player)(nil)
+
+// Player implements port.SpeechPlayer. Each chunk is spoken by its own run
+// of the command, in its own process group so wrapper scripts are paused
+// and stopped with their children.
+type Player struct {
+	mu    sync.Mutex
+	cond  *sync.Cond
+	state readaloud.State
+	// current is the reading in progress; nil when idle.
+	current *reading
+}
+
+// reading is one call to Play.
+type reading struct {
+	cancel context.CancelFunc
+	// cmd is the run speaking the current chunk; nil between chunks.
+	cmd  *exec.Cmd
+	done chan struct{}
+}
+
+// NewPlayer creates an idle Player.
+func NewPlayer() *Player {
+	p := &Player{state: readaloud.StateIdle}
+	p.cond = sync.NewCond(&p.mu)
+	return p
+}
+
+// Play implements port.SpeechPlayer.
+func (p *Player) Play(ctx context.Context, argv []string, chunks []string) error {
+	if len(argv) == 0 || argv[0] == "" {
+		return readaloud.ErrNoCommand
+	}
+	if _, err := exec.LookPath(argv[0]); err != nil {
+		return fmt.Errorf("text-to-speech command %q not found", argv[0])
+	}
+	p.Stop()
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	r := &reading{cancel: cancel, done: make(chan struct{})}
+	p.mu.Lock()
+	p.current = r
+	p.state = readaloud.StatePlaying
+	p.mu.Unlock()
+
+	go p.speak(runCtx, r, argv, chunks)
+	return nil
+}
+
+// speak runs the command for each chunk until the reading ends or is
+// replaced. A paused reading waits before starting its next chunk.
+func (p *Player) speak(ctx context.Context, r *reading, argv []string, chunks []string) {
+	log := logging.FromContext(ctx)
+	defer close(r.done)
+	defer p.finish(r)
+
+	for i, chunk := range chunks {
+		p.mu.Lock()
+		for p.current == r && p.state == readaloud.StatePaused {
+			p.cond.Wait()
+		}
+		if p.current != r {
+			p.mu.Unlock()
+			return
+		}
+		cmd := command(ctx, argv, chunk)
+		if err := cmd.Start(); err != nil {
+			p.mu.Unlock()
+			log.Warn().Err(err).Str("command", argv[0]).Msg("failed to start text-to-speech command")
+			return
+		}
+		r.cmd = cmd
+		p.mu.Unlock()
+
+		err := cmd.Wait()
+
+		p.mu.Lock()
+		r.cmd = nil
+		p.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("command", argv[0]).Int("chunk", i).Msg("text-to-speech command failed")
+			return
+		}
+	}
+}
+
+// finish marks the reading over unless another one replaced it.
+func (p *Player) finish(r *reading) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.current == r {
+		p.current = nil
+		p.state = readaloud.StateIdle
+		p.cond.Broadcast()
+	}
+	r.cancel()
+}
+
+// command builds one run of argv speaking text. argv is executed directly,
+// never through a shell, and text only reaches the program on stdin, so
+// page content can't change what runs.
+func command(ctx context.Context, argv []string, text string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return signalGroup(cmd, syscall.SIGKILL)
+	}
+	return cmd
+}
+
+// signalGroup sends sig to the process group of a started cmd.
+func signalGroup(cmd *exec.Cmd, sig syscall.Signal) error {
+	if cmd == nil || cmd.Process == nil {
+		return nil
+	}
+	err := syscall.Kill(-cmd.Process.Pid, sig)
+	if errors.Is(err, syscall.ESRCH) {
+		return nil
+	}
+	return err
+}
+
+// Pause implements port.SpeechPlayer.
+func (p *Player) Pause() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.current == nil || p.state != readaloud.StatePlaying {
+		return false
+	}
+	_ = signalGroup(p.current.cmd, syscall.SIGSTOP)
+	p.state = readaloud.StatePaused
+	return true
+}
+
+// Resume implements port.SpeechPlayer.
+func (p *Player) Resume() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.current == nil || p.state != readaloud.StatePaused {
+		return false
+	}
+	_ = signalGroup(p.current.cmd, syscall.SIGCONT)
+	p.state = readaloud.StatePlaying
+	p.cond.Broadcast()
+	return true
+}
+
+// Stop implements port.SpeechPlayer. It waits for the current run to exit.
+func (p *Player) Stop() {
+	p.mu.Lock()
+	r := p.current
+	if r == nil {
+		p.mu.Unlock()
+		return
+	}
+	p.current = nil
+	p.state = readaloud.StateIdle
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	r.cancel()
+	<-r.done
+}
+
+// State implements port.SpeechPlayer.
+func (p *Player) State() readaloud.State {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.state
+}
@@ -0,0 +1,67 @@
+package tts
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/readaloud"
+)
+
+func TestCommand_RunsArgvDirectlyWithTextOnStdin(t *testing.T) {
+	text := `"; rm -rf ~; $(reboot) | tee /etc/passwd`
+	cmd := command(context.Background(), []string{"espeak-ng", "-v", "en"}, text)
+
+	assert.Equal(t, []string{"espeak-ng", "-v", "en"}, cmd.Args, "page text never becomes an argument")
+	require.NotNil(t, cmd.Stdin)
+	stdin, err := io.ReadAll(cmd.Stdin)
+	require.NoError(t, err)
+	assert.Equal(t, text, string(stdin))
+	assert.True(t, cmd.SysProcAttr.Setpgid)
+}
+
+func TestPlayer_SpeaksChunksInOrder(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "spoken")
+	p := NewPlayer()
+
+	require.NoError(t, p.Play(context.Background(), []string{"tee", "-a", out}, []string{"One. ", "Two; $(x)"}))
+	assert.Eventually(t, func() bool { return p.State() == readaloud.StateIdle }, 5*time.Second, 10*time.Millisecond)
+
+	spoken, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "One. Two; $(x)", string(spoken))
+}
+
+func TestPlayer_PauseResumeStop(t *testing.T) {
+	p := NewPlayer()
+	assert.False(t, p.Pause(), "nothing to pause")
+	assert.False(t, p.Resume(), "nothing to resume")
+
+	require.NoError(t, p.Play(context.Background(), []string{"sleep", "30"}, []string{"a", "b"}))
+	assert.Equal(t, readaloud.StatePlaying, p.State())
+
+	assert.True(t, p.Pause())
+	assert.Equal(t, readaloud.StatePaused, p.State())
+	assert.False(t, p.Pause())
+
+	assert.True(t, p.Resume())
+	assert.Equal(t, readaloud.StatePlaying, p.State())
+
+	assert.True(t, p.Pause())
+	p.Stop()
+	assert.Equal(t, readaloud.StateIdle, p.State(), "a paused reading stops too")
+	p.Stop()
+}
+
+func TestPlayer_PlayRejectsMissingCommand(t *testing.T) {
+	p := NewPlayer()
+	assert.ErrorIs(t, p.Play(context.Background(), nil, []string{"a"}), readaloud.ErrNoCommand)
+	assert.Error(t, p.Play(context.Background(), []string{"dumber-no-such-tts"}, []string{"a"}))
+	assert.Equal(t, readaloud.StateIdle, p.State())
+}
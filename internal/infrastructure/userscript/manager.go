@@ -0,0 +1,193 @@
+// Package userscript loads Greasemonkey-style user scripts from disk.
+package userscript
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	domainuserscript "github.com/bnema/dumber/internal/domain/userscript"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/fsnotify/fsnotify"
+)
+
+const defaultDebounceDelay = 150 * time.Millisecond
+
+var _ port.UserScriptSource = (*UserScriptManager)(nil)
+
+// UserScriptManager loads *.user.js files from a directory and reloads them
+// when the directory changes. Subdirectories are not scanned.
+type UserScriptManager struct {
+	dir   string
+	delay time.Duration
+
+	mu       sync.RWMutex
+	scripts  []entity.UserScript
+	watcher  *fsnotify.Watcher
+	stopFunc context.CancelFunc
+}
+
+// NewUserScriptManager creates a manager for the scripts in dir.
+func NewUserScriptManager(dir string) *UserScriptManager {
+	return &UserScriptManager{dir: dir, delay: defaultDebounceDelay}
+}
+
+// Dir returns the directory scripts are loaded from.
+func (m *UserScriptManager) Dir() string {
+	return m.dir
+}
+
+// Load reads every *.user.js file in the directory, sorted by file name so
+// injection order is predictable. A missing directory yields no scripts.
+func (m *UserScriptManager) Load(ctx context.Context) error {
+	log := logging.FromContext(ctx).With().Str("component", "userscripts").Logger()
+
+	entries, err := os.ReadDir(m.dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read user scripts directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), domainuserscript.FileSuffix) {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	scripts := make([]entity.UserScript, 0, len(names))
+	var loadErrs []error
+	for _, name := range names {
+		path := filepath.Join(m.dir, name)
+		data, readErr := os.ReadFile(path)
+		if readErr != nil {
+			loadErrs = append(loadErrs, fmt.Errorf("read %s: %w", path, readErr))
+			continue
+		}
+		script, parseErr := domainuserscript.Parse(path, string(data))
+		if parseErr != nil {
+			loadErrs = append(loadErrs, parseErr)
+			continue
+		}
+		scripts = append(scripts, script)
+	}
+
+	m.mu.Lock()
+	m.scripts = scripts
+	m.mu.Unlock()
+
+	log.Debug().Str("dir", m.dir).Int("count", len(scripts)).Int("errors", len(loadErrs)).Msg("user scripts loaded")
+	return errors.Join(loadErrs...)
+}
+
+// Scripts returns a copy of the loaded scripts.
+func (m *UserScriptManager) Scripts() []entity.UserScript {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return slices.Clone(m.scripts)
+}
+
+// Watch creates the directory if needed and reloads the scripts whenever a
+// *.user.js file in it is written, created, renamed or removed. Bursts of
+// events (editors writing via temp files) are debounced into one reload.
+// Calling Watch while already watching is a no-op.
+func (m *UserScriptManager) Watch(ctx context.Context, onChange func()) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.watcher != nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("create user scripts directory: %w", err)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("create user scripts watcher: %w", err)
+	}
+	if err := watcher.Add(m.dir); err != nil {
+		return errors.Join(fmt.Errorf("watch user scripts directory: %w", err), watcher.Close())
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	m.watcher = watcher
+	m.stopFunc = cancel
+	go m.run(runCtx, watcher, onChange)
+	return nil
+}
+
+// Stop stops watching the directory. It is safe to call repeatedly.
+func (m *UserScriptManager) Stop() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.watcher == nil {
+		return nil
+	}
+	m.stopFunc()
+	err := m.watcher.Close()
+	m.watcher = nil
+	m.stopFunc = nil
+	return err
+}
+
+func (m *UserScriptManager) run(ctx context.Context, watcher *fsnotify.Watcher, onChange func()) {
+	log := logging.FromContext(ctx).With().Str("component", "userscripts").Logger()
+
+	var timer *time.Timer
+	var timerC <-chan time.Time
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if !isRelevantScriptEvent(event) {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(m.delay)
+			} else {
+				timer.Reset(m.delay)
+			}
+			timerC = timer.C
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Warn().Err(err).Msg("user scripts watcher error")
+		case <-timerC:
+			timerC = nil
+			if err := m.Load(ctx); err != nil {
+				log.Warn().Err(err).Msg("some user scripts failed to load")
+			}
+			if onChange != nil {
+				onChange()
+			}
+		}
+	}
+}
+
+func isRelevantScriptEvent(event fsnotify.Event) bool {
+	if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+		return false
+	}
+	return strings.HasSuffix(event.Name, domainuserscript.FileSuffix)
+}
@@ -0,0 +1,62 @@
+package userscript
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScript(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+}
+
+func TestUserScriptManager_Load(t *testing.T) {
+	dir := t.TempDir()
+	writeScript(t, dir, "b.user.js", "// ==UserScript==\n// @name B\n// @match *://*/*\n// ==/UserScript==\n")
+	writeScript(t, dir, "a.user.js", "// ==UserScript==\n// @name A\n// @match *://*/*\n// ==/UserScript==\n")
+	writeScript(t, dir, "notes.js", "console.log('not a user script');")
+	writeScript(t, dir, "broken.user.js", "console.log('no metadata');")
+
+	m := NewUserScriptManager(dir)
+	err := m.Load(context.Background())
+	require.Error(t, err, "broken script is reported")
+	assert.Contains(t, err.Error(), "broken.user.js")
+
+	scripts := m.Scripts()
+	require.Len(t, scripts, 2)
+	assert.Equal(t, "A", scripts[0].Name)
+	assert.Equal(t, "B", scripts[1].Name)
+}
+
+func TestUserScriptManager_LoadMissingDirectory(t *testing.T) {
+	m := NewUserScriptManager(filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, m.Load(context.Background()))
+	assert.Empty(t, m.Scripts())
+}
+
+func TestUserScriptManager_WatchReloadsOnChange(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "scripts")
+	m := NewUserScriptManager(dir)
+	m.delay = 10 * time.Millisecond
+
+	changed := make(chan struct{}, 4)
+	require.NoError(t, m.Watch(context.Background(), func() { changed <- struct{}{} }))
+	t.Cleanup(func() { require.NoError(t, m.Stop()) })
+
+	writeScript(t, dir, "new.user.js", "// ==UserScript==\n// @name New\n// @match *://*/*\n// ==/UserScript==\n")
+
+	select {
+	case <-changed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("watcher did not report the new script")
+	}
+	scripts := m.Scripts()
+	require.Len(t, scripts, 1)
+	assert.Equal(t, "New", scripts[0].Name)
+}
@@ -0,0 +1,71 @@
+package webkit
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/infrastructure/certpin"
+	"github.com/bnema/puregotk/v4/gio"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+// certificateRejected runs OnCertificateCheck for a committed https load. It
+// runs from the load-changed handler, before the commit is reported, so a
+// rejected load never reaches the UI as committed.
+func (wv *WebView) certificateRejected(uri string) bool {
+	if wv.OnCertificateCheck == nil || !isHTTPSURI(uri) {
+		return false
+	}
+	hash, _ := wv.peerCertificateSPKISHA256()
+	return wv.OnCertificateCheck(uri, hash)
+}
+
+// connectLoadFailedWithTLSErrorsSignal runs OnCertificateCheck when a
+// main-frame load fails certificate verification, so pinned hosts get the
+// pin block page instead of the generic TLS error.
+func (wv *WebView) connectLoadFailedWithTLSErrorsSignal() {
+	tlsErrorsCb := func(_ webkit.WebView, failingURI string, certPtr uintptr, _ gio.TlsCertificateFlags) bool {
+		if wv.OnCertificateCheck == nil {
+			return false
+		}
+		hash := ""
+		if certPtr != 0 {
+			hash, _ = spkiSHA256FromTLSCertificate(gio.TlsCertificateNewFromInternalPtr(certPtr))
+		}
+		// Returning true stops WebKit from emitting load-failed.
+		return wv.OnCertificateCheck(failingURI, hash)
+	}
+	sigID := wv.inner.ConnectLoadFailedWithTlsErrors(&tlsErrorsCb)
+	wv.signalIDs = append(wv.signalIDs, uintptr(sigID))
+}
+
+// peerCertificateSPKISHA256 reads the certificate WebKit recorded for the
+// committed main resource, so it is only meaningful from load-committed
+// onwards.
+func (wv *WebView) peerCertificateSPKISHA256() (string, bool) {
+	if wv.destroyed.Load() || wv.inner == nil {
+		return "", false
+	}
+	var cert *gio.TlsCertificate
+	var flags gio.TlsCertificateFlags
+	if !wv.inner.GetTlsInfo(&cert, &flags) || cert == nil {
+		return "", false
+	}
+	return spkiSHA256FromTLSCertificate(cert)
+}
+
+func spkiSHA256FromTLSCertificate(cert *gio.TlsCertificate) (string, bool) {
+	if cert == nil {
+		return "", false
+	}
+	hash, err := certpin.SPKISHA256FromPEM(cert.GetPropertyCertificatePem())
+	if err != nil {
+		return "", false
+	}
+	return hash, true
+}
+
+func isHTTPSURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	return err == nil && strings.EqualFold(parsed.Scheme, "https")
+}
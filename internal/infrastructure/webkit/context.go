@@ -3,9 +3,11 @@ package webkit
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"path/filepath"
 	"sync"
 
+	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/puregotk/v4/webkit"
 	"github.com/rs/zerolog"
@@ -161,6 +163,7 @@ func (c *WebKitContext) initNetworkSession(opts webKitContextOptions) error {
 	cookieManager.SetPersistentStorage(cookiePath, webkit.CookiePersistentStorageSqliteValue)
 	cookiePolicy, cookiePolicyLabel := mapCookiePolicy(opts.CookiePolicy)
 	cookieManager.SetAcceptPolicy(cookiePolicy)
+	sessionCookiePolicies.swap(session.GoPointer(), cookiePolicy)
 	session.SetItpEnabled(opts.ITPEnabled)
 
 	c.logger.Info().
@@ -169,6 +172,8 @@ func (c *WebKitContext) initNetworkSession(opts webKitContextOptions) error {
 		Bool("itp_enabled", opts.ITPEnabled).
 		Msg("cookie storage configured")
 
+	applyProxySettings(session, opts.Proxy, c.logger)
+
 	// Enable persistent credential storage
 	session.SetPersistentCredentialStorageEnabled(true)
 
@@ -187,6 +192,36 @@ func (c *WebKitContext) initNetworkSession(opts webKitContextOptions) error {
 	return nil
 }
 
+// applyProxySettings sets a custom proxy on the session. Without one the
+// session keeps following the system proxy settings.
+func applyProxySettings(session *webkit.NetworkSession, proxy *port.ProxyConfig, logger zerolog.Logger) {
+	if proxy == nil || proxy.URL == "" {
+		return
+	}
+	proxyURI := proxy.URL
+	settings := webkit.NewNetworkProxySettings(&proxyURI, proxy.NoProxy)
+	if settings == nil {
+		logger.Warn().Msg("failed to create proxy settings, using system proxy")
+		return
+	}
+	defer settings.Free()
+	session.SetProxySettings(webkit.NetworkProxyModeCustomValue, settings)
+
+	logger.Info().
+		Str("proxy", redactProxyURL(proxyURI)).
+		Strs("no_proxy", proxy.NoProxy).
+		Msg("network proxy configured")
+}
+
+// redactProxyURL hides proxy credentials before logging.
+func redactProxyURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return u.Redacted()
+}
+
 func mapCookiePolicy(policy cookiePolicy) (webkit.CookieAcceptPolicy, string) {
 	switch policy {
 	case cookiePolicyAlways:
@@ -202,6 +237,28 @@ func mapCookiePolicy(policy cookiePolicy) (webkit.CookieAcceptPolicy, string) {
 	}
 }
 
+// cookiePolicyTracker remembers the cookie policy applied to each network
+// session. WebKit only reports the current policy asynchronously.
+type cookiePolicyTracker struct {
+	mu       sync.Mutex
+	policies map[uintptr]webkit.CookieAcceptPolicy
+}
+
+var sessionCookiePolicies = &cookiePolicyTracker{}
+
+// swap records policy for session and reports whether it differs from the
+// policy recorded before.
+func (t *cookiePolicyTracker) swap(session uintptr, policy webkit.CookieAcceptPolicy) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.policies == nil {
+		t.policies = make(map[uintptr]webkit.CookieAcceptPolicy)
+	}
+	current, ok := t.policies[session]
+	t.policies[session] = policy
+	return !ok || current != policy
+}
+
 // Context returns the shared WebContext.
 func (c *WebKitContext) Context() *webkit.WebContext {
 	c.mu.RLock()
@@ -332,7 +332,7 @@ func validatedImageMimeType(raw string) string {
 var _ port.ImageDataResolver = (*contextMenuResolver)(nil)
 
 // buildMenuContextFromHitTest maps a WebKit HitTestResult into a port.MenuContext.
-func buildMenuContextFromHitTest(wv *WebView, hit *webkit.HitTestResult, x, y int) port.MenuContext {
+func buildMenuContextFromHitTest(wv *WebView, hit hitTestResult, x, y int) port.MenuContext {
 	ctx := port.MenuContext{
 		X: x,
 		Y: y,
@@ -348,12 +348,8 @@ func buildMenuContextFromHitTest(wv *WebView, hit *webkit.HitTestResult, x, y in
 		return ctx
 	}
 
-	if hit.ContextIsLink() {
-		ctx.LinkURI = hit.GetLinkUri()
-	}
-	if hit.ContextIsImage() {
-		ctx.ImageURI = hit.GetImageUri()
-	}
+	ctx.LinkURI = hitLinkURI(hit)
+	ctx.ImageURI = hitImageURI(hit)
 	ctx.HasSelection = hit.ContextIsSelection()
 	ctx.IsEditable = hit.ContextIsEditable()
 
@@ -450,10 +446,7 @@ func (wv *WebView) connectContextMenuSignal(pipeline *contextMenuPipeline) {
 		if contextMenuPtr != 0 {
 			contextMenu = webkit.ContextMenuNewFromInternalPtr(contextMenuPtr)
 		}
-		var hit *webkit.HitTestResult
-		if hitTestPtr != 0 {
-			hit = webkit.HitTestResultNewFromInternalPtr(hitTestPtr)
-		}
+		hit := newHitTestResult(hitTestPtr)
 
 		x, y := contextMenuPosition(contextMenu)
 		parent := anchorParentWidget(&wv.inner.Widget)
@@ -619,6 +612,18 @@ func (d *webkitMenuDelegator) DelegateMenuAction(ctx context.Context, action por
 		}
 		d.wv.RunJavaScript(ctx, "document.execCommand('copy');")
 		return nil
+	case port.MenuActionSearchSelection:
+		onSearch := d.wv.OnSearchSelection
+		if onSearch == nil {
+			return fmt.Errorf("search selection: search handler not available")
+		}
+		// WebKit's hit test reports that there is a selection, not its text.
+		if menuContext.SelectionText != "" {
+			onSearch(menuContext.SelectionText)
+			return nil
+		}
+		d.wv.ReadSelectionText(ctx, onSearch)
+		return nil
 	default:
 		return fmt.Errorf("webkit menu delegator: unsupported action %s", action)
 	}
@@ -30,6 +30,10 @@ type webKitContextOptions struct {
 	// False means ITP remains disabled unless enabled explicitly.
 	ITPEnabled bool
 
+	// Proxy configures the network session proxy.
+	// nil means the system proxy settings are used.
+	Proxy *port.ProxyConfig
+
 	// WebProcessMemory configures memory pressure for web processes.
 	// nil means use WebKit defaults.
 	WebProcessMemory *port.MemoryPressureConfig
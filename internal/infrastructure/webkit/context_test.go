@@ -0,0 +1,38 @@
+package webkit
+
+import (
+	"testing"
+
+	"github.com/bnema/puregotk/v4/webkit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapCookiePolicy(t *testing.T) {
+	tests := []struct {
+		policy    cookiePolicy
+		want      webkit.CookieAcceptPolicy
+		wantLabel string
+	}{
+		{policy: cookiePolicyAlways, want: webkit.CookiePolicyAcceptAlwaysValue, wantLabel: "always"},
+		{policy: cookiePolicyNever, want: webkit.CookiePolicyAcceptNeverValue, wantLabel: "never"},
+		{policy: cookiePolicyNoThirdParty, want: webkit.CookiePolicyAcceptNoThirdPartyValue, wantLabel: "no_third_party"},
+		{policy: "", want: webkit.CookiePolicyAcceptNoThirdPartyValue, wantLabel: ""},
+		{policy: "bogus", want: webkit.CookiePolicyAcceptNoThirdPartyValue, wantLabel: "no_third_party"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			got, label := mapCookiePolicy(tt.policy)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantLabel, label)
+		})
+	}
+}
+
+func TestCookiePolicyTrackerSwap(t *testing.T) {
+	tracker := &cookiePolicyTracker{}
+
+	assert.True(t, tracker.swap(1, webkit.CookiePolicyAcceptAlwaysValue), "first policy for a session")
+	assert.False(t, tracker.swap(1, webkit.CookiePolicyAcceptAlwaysValue), "same policy again")
+	assert.True(t, tracker.swap(1, webkit.CookiePolicyAcceptNeverValue))
+	assert.True(t, tracker.swap(2, webkit.CookiePolicyAcceptNeverValue), "sessions are tracked separately")
+}
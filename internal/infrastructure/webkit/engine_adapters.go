@@ -8,6 +8,7 @@ import (
 	"github.com/bnema/dumber/internal/infrastructure/filtering"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/puregotk/v4/gio"
+	"github.com/bnema/puregotk/v4/glib"
 )
 
 // --- WebViewFactory adapter ---
@@ -94,6 +95,8 @@ func (a *settingsApplierAdapter) ApplyToAll(ctx context.Context, webviews []port
 	for _, wv := range webviews {
 		if wwv, ok := wv.(*WebView); ok && !wwv.IsDestroyed() {
 			a.settings.ApplyToWebView(ctx, wwv.Widget())
+			wwv.reapplyFontOverride()
+			wwv.reapplyNavigationGestures()
 		}
 	}
 }
@@ -107,9 +110,74 @@ type filterApplierAdapter struct {
 
 func (a *filterApplierAdapter) ApplyToAll(ctx context.Context, webviews []port.WebView) {
 	for _, wv := range webviews {
-		if wwv, ok := wv.(*WebView); ok && !wwv.IsDestroyed() {
-			a.manager.ApplyTo(ctx, wwv.UserContentManager())
+		wwv, ok := wv.(*WebView)
+		if !ok || wwv.IsDestroyed() {
+			continue
+		}
+		if wwv.cosmeticFilteringBypassed.Load() {
+			a.refilter(ctx, wwv)
+			continue
+		}
+		a.manager.ApplyTo(ctx, wwv.UserContentManager())
+	}
+}
+
+var (
+	_ port.FilterBypassApplier         = (*filterApplierAdapter)(nil)
+	_ port.CosmeticFilterBypassApplier = (*filterApplierAdapter)(nil)
+)
+
+// SetFilteringBypassed removes every content filter from the WebView's user
+// content manager, or applies the active filters again. Both network blocking
+// and cosmetic rules live in those filters.
+func (a *filterApplierAdapter) SetFilteringBypassed(ctx context.Context, webview port.WebView, bypassed bool) {
+	wwv, ok := webview.(*WebView)
+	if !ok || wwv.IsDestroyed() {
+		return
+	}
+	wwv.filteringBypassed.Store(bypassed)
+	a.refilter(ctx, wwv)
+}
+
+// SetCosmeticFilteringBypassed swaps the WebView's content filters for the
+// same filters without their cosmetic rules, or back. The network-only
+// filters are compiled the first time a site asks for them; the page keeps
+// its cosmetic rules until they are ready.
+func (a *filterApplierAdapter) SetCosmeticFilteringBypassed(ctx context.Context, webview port.WebView, bypassed bool) {
+	wwv, ok := webview.(*WebView)
+	if !ok || wwv.IsDestroyed() {
+		return
+	}
+	wwv.cosmeticFilteringBypassed.Store(bypassed)
+	a.refilter(ctx, wwv)
+}
+
+// refilter puts the content filters wwv asks for on its user content
+// manager.
+func (a *filterApplierAdapter) refilter(ctx context.Context, wwv *WebView) {
+	ucm := wwv.UserContentManager()
+	if ucm == nil {
+		return
+	}
+	ucm.RemoveAllFilters()
+	switch {
+	case wwv.filteringBypassed.Load():
+	case wwv.cosmeticFilteringBypassed.Load():
+		if a.manager.ApplyNetworkOnlyTo(ctx, ucm) {
+			return
 		}
+		a.manager.ApplyTo(ctx, ucm)
+		a.manager.PrepareNetworkFilters(ctx, func() {
+			cb := glib.SourceFunc(func(_ uintptr) bool {
+				if !wwv.IsDestroyed() && wwv.cosmeticFilteringBypassed.Load() {
+					a.refilter(ctx, wwv)
+				}
+				return false
+			})
+			glib.IdleAdd(&cb, 0)
+		})
+	default:
+		a.manager.ApplyTo(ctx, ucm)
 	}
 }
 
@@ -75,6 +75,9 @@ func NewEngine(
 	}
 
 	messageRouter := NewMessageRouter(ctx)
+	messageRouter.SetPageMessageGate("console_message", func() bool {
+		return settings.current().WebContent.ConsoleBufferSize > 0
+	})
 
 	// --- WebView pool ---
 	poolCfg := DefaultPoolConfig()
@@ -135,6 +138,43 @@ func engineConfigureContentInjectorRuntimeSettings(injector *ContentInjector, se
 		}
 		return settings.current().WebContent.AutoCopyOnSelection
 	})
+	injector.SetConsoleCaptureConfigGetter(func() bool {
+		if settings == nil {
+			return false
+		}
+		return settings.current().WebContent.ConsoleBufferSize > 0
+	})
+	injector.SetTypeToFindConfigGetter(func() bool {
+		if settings == nil {
+			return false
+		}
+		return settings.current().WebContent.TypeToFind
+	})
+	injector.SetFormDirtyConfigGetter(func() bool {
+		if settings == nil {
+			return false
+		}
+		return settings.current().WebContent.TrackFormDirty
+	})
+	injector.SetReadingProgressConfigGetter(func() bool {
+		if settings == nil {
+			return false
+		}
+		return settings.current().WebContent.ReadingProgress
+	})
+	injector.SetPageLanguageConfigGetter(func() bool {
+		if settings == nil {
+			return false
+		}
+		return settings.current().WebContent.PageLanguage
+	})
+	injector.SetScrollConfigGetter(func() (float64, bool) {
+		if settings == nil {
+			return 1, true
+		}
+		webContent := settings.current().WebContent
+		return webContent.ScrollMultiplier, webContent.SmoothScrolling
+	})
 }
 
 // engineSurveyHardwareAndResolveProfile surveys hardware and resolves the performance profile.
@@ -234,6 +274,7 @@ func engineBuildContextOptions(
 		CacheDir:     cacheDir,
 		CookiePolicy: cp,
 		ITPEnabled:   wkCfg.ITPEnabled,
+		Proxy:        opts.Proxy,
 	}
 
 	if opts.WebProcessMemory != nil {
@@ -37,3 +37,11 @@ func TestEngineBuildContextOptions_PrefersExplicitEngineOptionsDirs(t *testing.T
 	require.Equal(t, "/tmp/data", wkOpts.DataDir)
 	require.Equal(t, "/tmp/cache", wkOpts.CacheDir)
 }
+
+func TestEngineBuildContextOptions_PassesProxy(t *testing.T) {
+	profile := runtimeprofile.Profile{Mode: runtimeprofile.ModeDev, Engine: "webkit"}
+	proxy := &port.ProxyConfig{URL: "socks5://127.0.0.1:1080", NoProxy: []string{"localhost"}}
+
+	wkOpts := engineBuildContextOptions(port.EngineOptions{Proxy: proxy}, profile, WebKitEngineConfig{}, &config.ResolvedPerformanceSettings{})
+	require.Equal(t, proxy, wkOpts.Proxy)
+}
@@ -0,0 +1,68 @@
+package webkit
+
+import (
+	"strings"
+
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+// hitTestResult is the part of a WebKit HitTestResult read by the context
+// menu and the link hover overlay, so the URL extraction can be tested
+// without WebKit.
+type hitTestResult interface {
+	ContextIsLink() bool
+	ContextIsImage() bool
+	ContextIsMedia() bool
+	ContextIsSelection() bool
+	ContextIsEditable() bool
+	GetLinkUri() string
+	GetImageUri() string
+	GetMediaUri() string
+}
+
+var _ hitTestResult = (*webkit.HitTestResult)(nil)
+
+// newHitTestResult wraps the HitTestResult at ptr; nil when there is none.
+func newHitTestResult(ptr uintptr) hitTestResult {
+	if ptr == 0 {
+		return nil
+	}
+	if hit := webkit.HitTestResultNewFromInternalPtr(ptr); hit != nil {
+		return hit
+	}
+	return nil
+}
+
+// hitLinkURI returns the URI of the link under the pointer.
+func hitLinkURI(hit hitTestResult) string {
+	if hit == nil || !hit.ContextIsLink() {
+		return ""
+	}
+	return strings.TrimSpace(hit.GetLinkUri())
+}
+
+// hitImageURI returns the URI of the image under the pointer, used by Copy
+// Image and Save Image.
+func hitImageURI(hit hitTestResult) string {
+	if hit == nil || !hit.ContextIsImage() {
+		return ""
+	}
+	return strings.TrimSpace(hit.GetImageUri())
+}
+
+// hitHoverURI returns the URI shown by the link hover overlay: the link, else
+// the image, else the media element under the pointer.
+func hitHoverURI(hit hitTestResult) string {
+	switch {
+	case hit == nil:
+		return ""
+	case hit.ContextIsLink():
+		return hit.GetLinkUri()
+	case hit.ContextIsImage():
+		return hit.GetImageUri()
+	case hit.ContextIsMedia():
+		return hit.GetMediaUri()
+	default:
+		return ""
+	}
+}
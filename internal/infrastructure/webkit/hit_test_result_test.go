@@ -0,0 +1,99 @@
+package webkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeHitTestResult is a hitTestResult without WebKit.
+type fakeHitTestResult struct {
+	stubHitTest
+	mediaURI string
+	isMedia  bool
+}
+
+func (h fakeHitTestResult) ContextIsLink() bool      { return h.isLink }
+func (h fakeHitTestResult) ContextIsImage() bool     { return h.isImage }
+func (h fakeHitTestResult) ContextIsMedia() bool     { return h.isMedia }
+func (h fakeHitTestResult) ContextIsSelection() bool { return h.isSelection }
+func (h fakeHitTestResult) ContextIsEditable() bool  { return h.isEditable }
+func (h fakeHitTestResult) GetLinkUri() string       { return h.linkURI }
+func (h fakeHitTestResult) GetImageUri() string      { return h.imageURI }
+func (h fakeHitTestResult) GetMediaUri() string      { return h.mediaURI }
+
+func TestBuildMenuContextFromHitTest_ExtractsURLs(t *testing.T) {
+	hit := fakeHitTestResult{stubHitTest: stubHitTest{
+		linkURI:     "https://example.com/gallery",
+		imageURI:    " https://cdn.example.com/photo.jpg ",
+		isLink:      true,
+		isImage:     true,
+		isSelection: true,
+	}}
+
+	ctx := buildMenuContextFromHitTest(nil, hit, 12, 34)
+
+	assert.Equal(t, "https://example.com/gallery", ctx.LinkURI)
+	assert.Equal(t, "https://cdn.example.com/photo.jpg", ctx.ImageURI)
+	assert.True(t, ctx.HasSelection)
+	assert.False(t, ctx.IsEditable)
+	assert.Equal(t, 12, ctx.X)
+	assert.Equal(t, 34, ctx.Y)
+}
+
+func TestBuildMenuContextFromHitTest_NoHitResult(t *testing.T) {
+	ctx := buildMenuContextFromHitTest(nil, nil, 5, 6)
+
+	assert.Empty(t, ctx.LinkURI)
+	assert.Empty(t, ctx.ImageURI)
+	assert.Equal(t, 5, ctx.X)
+}
+
+func TestHitImageURI_TrimsImageURI(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/a.png":      "https://example.com/a.png",
+		" http://example.com/a.gif\n":    "http://example.com/a.gif",
+		"data:image/png;base64,iVBORw0K": "data:image/png;base64,iVBORw0K",
+		"blob:https://example.com/1234":  "blob:https://example.com/1234",
+		"":                               "",
+	}
+	for uri, want := range tests {
+		hit := fakeHitTestResult{stubHitTest: stubHitTest{imageURI: uri, isImage: true}}
+		assert.Equal(t, want, hitImageURI(hit), uri)
+	}
+
+	notImage := fakeHitTestResult{stubHitTest: stubHitTest{imageURI: "https://example.com/a.png"}}
+	assert.Empty(t, hitImageURI(notImage), "the URI only counts when the hit is an image")
+}
+
+func TestHitHoverURI_PrefersLinkThenImageThenMedia(t *testing.T) {
+	link := fakeHitTestResult{stubHitTest: stubHitTest{
+		linkURI: "https://example.com/page", imageURI: "https://example.com/a.png", isLink: true, isImage: true,
+	}}
+	assert.Equal(t, "https://example.com/page", hitHoverURI(link))
+
+	image := fakeHitTestResult{stubHitTest: stubHitTest{imageURI: "blob:https://example.com/1", isImage: true}}
+	assert.Equal(t, "blob:https://example.com/1", hitHoverURI(image), "hovering shows any image URI")
+
+	media := fakeHitTestResult{mediaURI: "https://example.com/v.webm", isMedia: true}
+	assert.Equal(t, "https://example.com/v.webm", hitHoverURI(media))
+
+	assert.Empty(t, hitHoverURI(fakeHitTestResult{}))
+	assert.Empty(t, hitHoverURI(nil))
+}
+
+func TestRecordMouseTarget_KeepsOnlyLinks(t *testing.T) {
+	wv := &WebView{}
+
+	wv.recordMouseTarget(fakeHitTestResult{stubHitTest: stubHitTest{
+		linkURI: " https://example.com/next ", imageURI: "https://example.com/a.png", isLink: true, isImage: true,
+	}})
+	assert.Equal(t, "https://example.com/next", wv.HoveredLinkURI())
+
+	wv.recordMouseTarget(fakeHitTestResult{stubHitTest: stubHitTest{imageURI: "https://example.com/a.png", isImage: true}})
+	assert.Empty(t, wv.HoveredLinkURI(), "an image alone is not a link target")
+
+	wv.recordMouseTarget(fakeHitTestResult{stubHitTest: stubHitTest{linkURI: "https://example.com/x", isLink: true}})
+	wv.recordMouseTarget(nil)
+	assert.Empty(t, wv.HoveredLinkURI(), "leaving the page forgets the link")
+}
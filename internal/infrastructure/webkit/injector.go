@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/userscript"
 	"github.com/bnema/dumber/internal/infrastructure/webutil"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/puregotk/v4/webkit"
@@ -179,6 +181,278 @@ const explicitCopyScript = `(function() {
   }
 })();`
 
+// consoleCaptureScript wraps console.debug/log/info/warn/error and forwards
+// each call to Go for dumb://console. Arguments are formatted the way DevTools
+// prints them on one line, long messages are truncated, and bursts are capped
+// so a logging loop cannot flood the bridge. The original methods still run.
+const consoleCaptureScript = `(function() {
+  'use strict';
+  if (window.__dumber_console_capture || !window.console) {
+    return;
+  }
+  window.__dumber_console_capture = true;
+
+  var MAX_LENGTH = 4000;
+  var BURST_WINDOW_MS = 1000;
+  var BURST_LIMIT = 100;
+  var burstStart = 0;
+  var burstCount = 0;
+  var posting = false;
+
+  function formatValue(value) {
+    if (typeof value === 'string') {
+      return value;
+    }
+    if (value instanceof Error) {
+      return value.stack || (value.name + ': ' + value.message);
+    }
+    if (value && typeof value === 'object') {
+      try {
+        var json = JSON.stringify(value);
+        if (json !== undefined) {
+          return json;
+        }
+      } catch (_) {}
+    }
+    try {
+      return String(value);
+    } catch (_) {
+      return Object.prototype.toString.call(value);
+    }
+  }
+
+  function post(level, args) {
+    var handlers = window.webkit && window.webkit.messageHandlers;
+    if (posting || !handlers || !handlers.dumber) {
+      return;
+    }
+    var now = Date.now();
+    if (now - burstStart > BURST_WINDOW_MS) {
+      burstStart = now;
+      burstCount = 0;
+    }
+    if (++burstCount > BURST_LIMIT) {
+      return;
+    }
+    var parts = [];
+    for (var i = 0; i < args.length; i++) {
+      parts.push(formatValue(args[i]));
+    }
+    var message = parts.join(' ');
+    if (message.length > MAX_LENGTH) {
+      message = message.slice(0, MAX_LENGTH) + '\u2026';
+    }
+    posting = true;
+    try {
+      handlers.dumber.postMessage({
+        type: 'console_message',
+        payload: { level: level, message: message, url: location.href, timestamp: now }
+      });
+    } finally {
+      posting = false;
+    }
+  }
+
+  ['debug', 'log', 'info', 'warn', 'error'].forEach(function(level) {
+    var original = console[level];
+    if (typeof original !== 'function') {
+      return;
+    }
+    console[level] = function() {
+      try {
+        post(level, arguments);
+      } catch (_) {}
+      return original.apply(this, arguments);
+    };
+  });
+})();`
+
+// typeToFindScript reports printable keys typed on a page, with the focused
+// element, so Go can open the find bar. Keys the page already handled, keys
+// with modifiers and keys typed into editable elements never leave the page;
+// Go checks the focus context again with entity.TypeToFindKey.
+const typeToFindScript = `(function() {
+  'use strict';
+  if (window.__dumber_type_to_find) {
+    return;
+  }
+  window.__dumber_type_to_find = true;
+
+  function focusedElement() {
+    var el = document.activeElement;
+    while (el && el.shadowRoot && el.shadowRoot.activeElement) {
+      el = el.shadowRoot.activeElement;
+    }
+    return el;
+  }
+
+  function isEditable(el) {
+    if (document.designMode === 'on') return true;
+    if (!el) return false;
+    if (el.isContentEditable) return true;
+    var tag = el.tagName;
+    return tag === 'INPUT' || tag === 'TEXTAREA' || tag === 'SELECT';
+  }
+
+  window.addEventListener('keydown', function(e) {
+    if (e.defaultPrevented || e.isComposing || e.repeat) return;
+    if (e.ctrlKey || e.altKey || e.metaKey) return;
+    if (!e.key || e.key.length > 2 || e.key === ' ') return;
+    var handlers = window.webkit && window.webkit.messageHandlers;
+    if (!handlers || !handlers.dumber) return;
+    var el = focusedElement();
+    var tag = el && el.tagName ? el.tagName : '';
+    if (isEditable(el) && !(tag === 'INPUT' && /^(button|checkbox|color|file|image|radio|range|reset|submit)$/i.test(el.type))) {
+      return;
+    }
+    handlers.dumber.postMessage({
+      type: 'type_to_find',
+      payload: {
+        key: e.key,
+        focusTag: tag,
+        focusType: tag === 'INPUT' ? String(el.type || '') : '',
+        focusRole: el && el.getAttribute ? (el.getAttribute('role') || '') : '',
+        focusEditable: document.designMode === 'on' || !!(el && el.isContentEditable)
+      }
+    });
+  });
+})();`
+
+// formDirtyScript reports whether the page has form fields edited since it
+// loaded, so closing its pane can ask first. Only changes of state are
+// posted: a field changed back to its original value, a form reset or a
+// submit clears it again.
+const formDirtyScript = `(function() {
+  'use strict';
+  if (window.__dumber_form_dirty) {
+    return;
+  }
+  window.__dumber_form_dirty = true;
+
+  var dirtyFields = new Set();
+  var reported = false;
+
+  function isDirty(el) {
+    if (el.isContentEditable && el.tagName !== 'INPUT' && el.tagName !== 'TEXTAREA') return true;
+    if (el.tagName === 'SELECT') {
+      return Array.prototype.some.call(el.options, function(o) { return o.selected !== o.defaultSelected; });
+    }
+    if (el.tagName === 'TEXTAREA') return el.value !== el.defaultValue;
+    if (el.tagName !== 'INPUT') return false;
+    var type = String(el.type || '').toLowerCase();
+    if (type === 'checkbox' || type === 'radio') return el.checked !== el.defaultChecked;
+    if (type === 'file') return !!(el.files && el.files.length);
+    if (/^(button|hidden|image|reset|submit)$/.test(type)) return false;
+    return el.value !== el.defaultValue;
+  }
+
+  function report() {
+    dirtyFields.forEach(function(el) {
+      if (!el.isConnected) dirtyFields.delete(el);
+    });
+    var dirty = dirtyFields.size > 0;
+    if (dirty === reported) return;
+    var handlers = window.webkit && window.webkit.messageHandlers;
+    if (!handlers || !handlers.dumber) return;
+    reported = dirty;
+    handlers.dumber.postMessage({ type: 'form_dirty', payload: { dirty: dirty } });
+  }
+
+  function track(e) {
+    var el = e.composedPath ? e.composedPath()[0] : e.target;
+    if (!el || !el.tagName) return;
+    if (el.tagName === 'INPUT' && String(el.type).toLowerCase() === 'radio' && el.name) {
+      // Checking a radio unchecks the rest of its group without an event.
+      var root = el.form || el.getRootNode();
+      root.querySelectorAll('input[type="radio" i]').forEach(function(r) {
+        if (r.name === el.name) dirtyFields.delete(r);
+      });
+    }
+    if (isDirty(el)) {
+      dirtyFields.add(el);
+    } else {
+      dirtyFields.delete(el);
+    }
+    report();
+  }
+
+  function clearForm(e) {
+    var form = e.target;
+    dirtyFields.forEach(function(el) {
+      if (el.form === form || (form.contains && form.contains(el))) dirtyFields.delete(el);
+    });
+    report();
+  }
+
+  document.addEventListener('input', track, true);
+  document.addEventListener('change', track, true);
+  document.addEventListener('reset', clearForm, true);
+  document.addEventListener('submit', clearForm, true);
+})();`
+
+// readingProgressScript reports how far the page is scrolled, as a whole
+// percentage, for the reading progress bar. Scroll and resize events are
+// coalesced to one check per animation frame, and only changes are posted.
+// Pages too short to scroll report 0.
+const readingProgressScript = `(function() {
+  'use strict';
+  if (window.__dumber_reading_progress) {
+    return;
+  }
+  window.__dumber_reading_progress = true;
+
+  var reported = -1;
+  var scheduled = false;
+
+  function percent() {
+    var root = document.scrollingElement || document.documentElement;
+    if (!root) return 0;
+    var max = root.scrollHeight - window.innerHeight;
+    if (max <= 0) return 0;
+    return Math.min(100, Math.max(0, Math.round(window.scrollY / max * 100)));
+  }
+
+  function report() {
+    scheduled = false;
+    var p = percent();
+    if (p === reported) return;
+    var handlers = window.webkit && window.webkit.messageHandlers;
+    if (!handlers || !handlers.dumber) return;
+    reported = p;
+    handlers.dumber.postMessage({ type: 'reading_progress', payload: { percent: p } });
+  }
+
+  function schedule() {
+    if (scheduled) return;
+    scheduled = true;
+    window.requestAnimationFrame(report);
+  }
+
+  window.addEventListener('scroll', schedule, { passive: true });
+  window.addEventListener('resize', schedule, { passive: true });
+  window.addEventListener('load', schedule);
+  schedule();
+})();`
+
+// pageLanguageScript reports the page's declared <html lang> and a sample
+// of its text, once the document is parsed, so the language can be worked
+// out for the translate offer.
+const pageLanguageScript = `(function() {
+  'use strict';
+  if (window.__dumber_page_language) {
+    return;
+  }
+  window.__dumber_page_language = true;
+
+  var handlers = window.webkit && window.webkit.messageHandlers;
+  if (!handlers || !handlers.dumber) return;
+  var root = document.documentElement;
+  var body = document.body;
+  var lang = (root && root.getAttribute('lang')) || '';
+  var sample = body ? (body.innerText || '').slice(0, 1000) : '';
+  handlers.dumber.postMessage({ type: 'page_language', payload: { lang: lang, sample: sample } });
+})();`
+
 // accentDetectionScript is built at init from entity.AccentMap so the JS
 // filter stays in sync with the Go-side accent table.
 var accentDetectionScript string
@@ -265,6 +539,67 @@ func buildWebRTCCompatScript() string {
 	return webRTCCompatScript
 }
 
+// wheelScrollScript scales wheel deltas by the configured multiplier and scrolls the nearest
+// scrollable ancestor itself. Events a page already handled, ctrl+wheel zoom
+// and wheels over content that cannot scroll further are left to the engine,
+// so the page never scrolls twice or loses overscroll gestures.
+const wheelScrollScript = `(function() {
+  'use strict';
+  var multiplier = %[1]s;
+  var behavior = '%[2]s';
+  var LINE_HEIGHT = 40;
+
+  function canScroll(el, dx, dy) {
+    var style = window.getComputedStyle(el);
+    var root = el === document.scrollingElement;
+    if (dy !== 0 && (root || /(auto|scroll|overlay)/.test(style.overflowY))) {
+      if (dy < 0 ? el.scrollTop > 0 : el.scrollTop + el.clientHeight < el.scrollHeight) return true;
+    }
+    if (dx !== 0 && (root || /(auto|scroll|overlay)/.test(style.overflowX))) {
+      if (dx < 0 ? el.scrollLeft > 0 : el.scrollLeft + el.clientWidth < el.scrollWidth) return true;
+    }
+    return false;
+  }
+
+  function scrollTarget(node, dx, dy) {
+    for (var el = node instanceof Element ? node : node && node.parentElement; el; el = el.parentElement) {
+      if (el === document.body || el === document.documentElement) break;
+      if (canScroll(el, dx, dy)) return el;
+    }
+    var root = document.scrollingElement;
+    return root && canScroll(root, dx, dy) ? root : null;
+  }
+
+  window.addEventListener('wheel', function(e) {
+    if (e.defaultPrevented || e.ctrlKey) return;
+    var dx = e.deltaX, dy = e.deltaY;
+    if (e.deltaMode === 1) {
+      dx *= LINE_HEIGHT;
+      dy *= LINE_HEIGHT;
+    } else if (e.deltaMode === 2) {
+      dx *= window.innerWidth;
+      dy *= window.innerHeight;
+    }
+    var target = scrollTarget(e.target, dx, dy);
+    if (!target) return;
+    e.preventDefault();
+    target.scrollBy({ left: dx * multiplier, top: dy * multiplier, behavior: behavior });
+  }, { passive: false });
+})();`
+
+// buildWheelScrollScript returns the wheel scaling script for multiplier, or
+// "" when multiplier is 1 (or unset) so the engine scrolls natively.
+func buildWheelScrollScript(multiplier float64, smooth bool) string {
+	if multiplier <= 0 || multiplier == 1 {
+		return ""
+	}
+	behavior := "instant"
+	if smooth {
+		behavior = "smooth"
+	}
+	return fmt.Sprintf(wheelScrollScript, strconv.FormatFloat(multiplier, 'g', -1, 64), behavior)
+}
+
 // ContentInjector encapsulates script injection into WebViews.
 // It injects dark mode detection scripts for internal pages (dumb://)
 // and theme CSS variables for WebUI styling.
@@ -275,8 +610,21 @@ type ContentInjector struct {
 	themeCSSVars         string      // CSS custom property declarations for WebUI
 	findCSS              string      // CSS for find-in-page highlight styling
 	autoCopyConfigGetter func() bool // Dynamic getter for auto-copy config
+	consoleCaptureGetter func() bool // Dynamic getter for console capture config
+	typeToFindGetter     func() bool // Dynamic getter for type-to-find config
+	formDirtyGetter      func() bool // Dynamic getter for the edited-form tracker config
+	readingGetter        func() bool // Dynamic getter for the reading progress config
+	pageLanguageGetter   func() bool // Dynamic getter for the translate offer config
+	scrollConfigGetter   func() (multiplier float64, smooth bool)
+	userScripts          []entity.UserScript
+	pageEnv              entity.RuntimePageEnvConfig
 }
 
+var (
+	_ port.UserScriptInjector = (*ContentInjector)(nil)
+	_ port.PageEnvInjector    = (*ContentInjector)(nil)
+)
+
 // NewContentInjector creates a new injector instance.
 // The resolver is used to dynamically determine dark mode preference.
 func NewContentInjector(resolver port.ColorSchemeResolver) *ContentInjector {
@@ -291,6 +639,45 @@ func (ci *ContentInjector) SetAutoCopyConfigGetter(getter func() bool) {
 	ci.autoCopyConfigGetter = getter
 }
 
+// SetFormDirtyConfigGetter sets the function to dynamically check if the
+// edited-form tracker should be injected into web pages.
+func (ci *ContentInjector) SetFormDirtyConfigGetter(getter func() bool) {
+	ci.formDirtyGetter = getter
+}
+
+// SetReadingProgressConfigGetter sets the function to dynamically check if
+// the scroll listener behind the reading progress bar should be injected
+// into web pages.
+func (ci *ContentInjector) SetReadingProgressConfigGetter(getter func() bool) {
+	ci.readingGetter = getter
+}
+
+// SetPageLanguageConfigGetter sets the function to dynamically check if the
+// script reporting page languages for the translate offer should be injected
+// into web pages.
+func (ci *ContentInjector) SetPageLanguageConfigGetter(getter func() bool) {
+	ci.pageLanguageGetter = getter
+}
+
+// SetConsoleCaptureConfigGetter sets the function to dynamically check if
+// console capture is enabled. It is read whenever scripts are injected.
+func (ci *ContentInjector) SetConsoleCaptureConfigGetter(getter func() bool) {
+	ci.consoleCaptureGetter = getter
+}
+
+// SetTypeToFindConfigGetter sets the function to dynamically check if
+// type-to-find is enabled. It is read whenever scripts are injected.
+func (ci *ContentInjector) SetTypeToFindConfigGetter(getter func() bool) {
+	ci.typeToFindGetter = getter
+}
+
+// SetScrollConfigGetter sets the function returning the wheel scroll
+// multiplier and smooth scrolling preference. It is read whenever scripts are
+// injected.
+func (ci *ContentInjector) SetScrollConfigGetter(getter func() (multiplier float64, smooth bool)) {
+	ci.scrollConfigGetter = getter
+}
+
 // InjectThemeCSS stores CSS variables for injection into internal pages.
 // Implements port.ContentInjector interface.
 // The CSS will be injected when InjectScripts is called on WebView creation.
@@ -309,6 +696,62 @@ func (ci *ContentInjector) InjectFindHighlightCSS(ctx context.Context, css strin
 	return nil
 }
 
+// SetUserScripts replaces the user scripts evaluated on each navigation.
+// Implements port.UserScriptInjector. Pages already loaded keep the scripts
+// they ran; the new set applies from their next navigation.
+func (ci *ContentInjector) SetUserScripts(ctx context.Context, scripts []entity.UserScript) {
+	log := logging.FromContext(ctx).With().Str("component", "content-injector").Logger()
+	ci.userScripts = scripts
+	log.Debug().Int("count", len(scripts)).Msg("user scripts set for injection")
+}
+
+// SetPageEnv replaces the page_env values and domain rules evaluated on each
+// navigation. Implements port.PageEnvInjector. Like user scripts, the new
+// values apply from each page's next navigation.
+func (ci *ContentInjector) SetPageEnv(ctx context.Context, cfg entity.RuntimePageEnvConfig) {
+	log := logging.FromContext(ctx).With().Str("component", "content-injector").Logger()
+	ci.pageEnv = cfg
+	log.Debug().Int("values", len(cfg.Values)).Int("domains", len(cfg.Domains)).Msg("page env set for injection")
+}
+
+// addUserScripts registers the page env and the user scripts matching uri
+// with ucm and returns the registered scripts so they can be removed on the
+// next navigation. The page env goes first so user scripts can read it.
+func (ci *ContentInjector) addUserScripts(ucm *webkit.UserContentManager, uri string) []*webkit.UserScript {
+	env := userscript.PageEnvFor(ci.pageEnv, uri)
+	matching := userscript.Matching(ci.userScripts, uri)
+	if len(env) == 0 && len(matching) == 0 {
+		return nil
+	}
+	added := make([]*webkit.UserScript, 0, len(matching)+1)
+	if len(env) > 0 {
+		us := webkit.NewUserScript(
+			userscript.PageEnvScript(env),
+			webkit.UserContentInjectTopFrameValue,
+			webkit.UserScriptInjectAtDocumentStartValue,
+			nil,
+			nil,
+		)
+		if us != nil {
+			ucm.AddScript(us)
+			added = append(added, us)
+		}
+	}
+	for _, script := range matching {
+		injectTime := webkit.UserScriptInjectAtDocumentEndValue
+		if script.RunAt == entity.UserScriptRunAtDocumentStart {
+			injectTime = webkit.UserScriptInjectAtDocumentStartValue
+		}
+		us := webkit.NewUserScript(script.Source, webkit.UserContentInjectTopFrameValue, injectTime, nil, nil)
+		if us == nil {
+			continue
+		}
+		ucm.AddScript(us)
+		added = append(added, us)
+	}
+	return added
+}
+
 // PrefersDark returns the current dark mode preference from the resolver.
 func (ci *ContentInjector) PrefersDark() bool {
 	return ci.colorResolver.Resolve().PrefersDark
@@ -460,7 +903,111 @@ func (ci *ContentInjector) InjectScripts(ctx context.Context, ucm *webkit.UserCo
 		"accent-key-detection",
 	)
 
-	log.Debug().Bool("prefers_dark", prefersDark).Bool("auto_copy", autoCopyEnabled).Msg("scripts injected")
+	// 9. Inject console capture for web pages (if enabled). Internal pages are
+	// excluded so dumb://console does not capture itself.
+	consoleCaptureEnabled := ci.consoleCaptureGetter != nil && ci.consoleCaptureGetter()
+	if consoleCaptureEnabled {
+		addScript(
+			webkit.NewUserScript(
+				consoleCaptureScript,
+				webkit.UserContentInjectTopFrameValue,
+				webkit.UserScriptInjectAtDocumentStartValue,
+				nil,
+				internalPageAllowList,
+			),
+			"console-capture",
+		)
+	}
+
+	// 10. Inject wheel scroll scaling for all pages (if the multiplier is not 1).
+	var scrollMultiplier float64 = 1
+	if ci.scrollConfigGetter != nil {
+		var smooth bool
+		scrollMultiplier, smooth = ci.scrollConfigGetter()
+		if script := buildWheelScrollScript(scrollMultiplier, smooth); script != "" {
+			addScript(
+				webkit.NewUserScript(
+					script,
+					webkit.UserContentInjectAllFramesValue,
+					webkit.UserScriptInjectAtDocumentStartValue,
+					nil,
+					nil,
+				),
+				"wheel-scroll-multiplier",
+			)
+		}
+	}
+
+	// 11. Inject the type-to-find key listener for web pages (if enabled).
+	typeToFindEnabled := ci.typeToFindGetter != nil && ci.typeToFindGetter()
+	if typeToFindEnabled {
+		addScript(
+			webkit.NewUserScript(
+				typeToFindScript,
+				webkit.UserContentInjectTopFrameValue,
+				webkit.UserScriptInjectAtDocumentEndValue,
+				nil,
+				internalPageAllowList,
+			),
+			"type-to-find",
+		)
+	}
+
+	// 12. Inject the edited-form tracker for web pages (if enabled).
+	formDirtyEnabled := ci.formDirtyGetter != nil && ci.formDirtyGetter()
+	if formDirtyEnabled {
+		addScript(
+			webkit.NewUserScript(
+				formDirtyScript,
+				webkit.UserContentInjectTopFrameValue,
+				webkit.UserScriptInjectAtDocumentEndValue,
+				nil,
+				internalPageAllowList,
+			),
+			"form-dirty",
+		)
+	}
+
+	// 13. Inject the reading progress scroll listener for web pages (if enabled).
+	readingProgressEnabled := ci.readingGetter != nil && ci.readingGetter()
+	if readingProgressEnabled {
+		addScript(
+			webkit.NewUserScript(
+				readingProgressScript,
+				webkit.UserContentInjectTopFrameValue,
+				webkit.UserScriptInjectAtDocumentEndValue,
+				nil,
+				internalPageAllowList,
+			),
+			"reading-progress",
+		)
+	}
+
+	// 14. Inject the page language report for the translate offer (if enabled).
+	pageLanguageEnabled := ci.pageLanguageGetter != nil && ci.pageLanguageGetter()
+	if pageLanguageEnabled {
+		addScript(
+			webkit.NewUserScript(
+				pageLanguageScript,
+				webkit.UserContentInjectTopFrameValue,
+				webkit.UserScriptInjectAtDocumentEndValue,
+				nil,
+				internalPageAllowList,
+			),
+			"page-language",
+		)
+	}
+
+	log.Debug().
+		Bool("prefers_dark", prefersDark).
+		Bool("auto_copy", autoCopyEnabled).
+		Bool("console_capture", consoleCaptureEnabled).
+		Bool("type_to_find", typeToFindEnabled).
+		Bool("form_dirty", formDirtyEnabled).
+		Bool("reading_progress", readingProgressEnabled).
+		Bool("page_language", pageLanguageEnabled).
+		Float64("scroll_multiplier", scrollMultiplier).
+		Msg("scripts injected")
 }
 
 // RefreshScripts clears and re-injects user scripts for a single WebView.
@@ -484,6 +1031,10 @@ func (ci *ContentInjector) RefreshScripts(ctx context.Context, wv port.WebView)
 	}
 	ucm.RemoveAllScripts()
 	ucm.RemoveAllStyleSheets()
+	// User scripts and the force dark stylesheet were removed with the rest;
+	// the next navigation re-adds them.
+	wkWV.userScripts = nil
+	wkWV.forceDarkSheet = nil
 	ci.InjectScripts(ctx, ucm, wv.ID())
 	return nil
 }
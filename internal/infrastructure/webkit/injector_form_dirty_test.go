@@ -0,0 +1,37 @@
+package webkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestFormDirtyScriptReportsStateChangesOnly(t *testing.T) {
+	assert.Contains(t, formDirtyScript, "type: 'form_dirty'")
+	assert.Contains(t, formDirtyScript, "if (dirty === reported) return;")
+	assert.Contains(t, formDirtyScript, "el.value !== el.defaultValue")
+	assert.Contains(t, formDirtyScript, "el.checked !== el.defaultChecked")
+	assert.Contains(t, formDirtyScript, "document.addEventListener('submit', clearForm, true)")
+	assert.Contains(t, formDirtyScript, "document.addEventListener('reset', clearForm, true)")
+	assert.True(t, pageMessageTypes["form_dirty"])
+}
+
+func TestEngineConfigureContentInjectorFormDirtyGetterReadsCurrentPayload(t *testing.T) {
+	settings := NewSettingsManager(context.Background(), entity.EngineSettingsPayload{})
+	injector := NewContentInjector(nil)
+
+	engineConfigureContentInjectorRuntimeSettings(injector, settings)
+
+	require.NotNil(t, injector.formDirtyGetter)
+	require.False(t, injector.formDirtyGetter())
+
+	settings.UpdateFromPayload(context.Background(), entity.EngineSettingsPayload{
+		WebContent: entity.EngineWebContentSettingsPayload{TrackFormDirty: true},
+	})
+
+	require.True(t, injector.formDirtyGetter())
+}
@@ -0,0 +1,34 @@
+package webkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestPageLanguageScriptReportsLangAndSample(t *testing.T) {
+	assert.Contains(t, pageLanguageScript, "type: 'page_language'")
+	assert.Contains(t, pageLanguageScript, "root.getAttribute('lang')")
+	assert.Contains(t, pageLanguageScript, ".slice(0, 1000)")
+	assert.True(t, pageMessageTypes["page_language"])
+}
+
+func TestEngineConfigureContentInjectorPageLanguageGetterReadsCurrentPayload(t *testing.T) {
+	settings := NewSettingsManager(context.Background(), entity.EngineSettingsPayload{})
+	injector := NewContentInjector(nil)
+
+	engineConfigureContentInjectorRuntimeSettings(injector, settings)
+
+	require.NotNil(t, injector.pageLanguageGetter)
+	require.False(t, injector.pageLanguageGetter())
+
+	settings.UpdateFromPayload(context.Background(), entity.EngineSettingsPayload{
+		WebContent: entity.EngineWebContentSettingsPayload{PageLanguage: true},
+	})
+
+	require.True(t, injector.pageLanguageGetter())
+}
@@ -0,0 +1,35 @@
+package webkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestReadingProgressScriptReportsPercentChangesOnly(t *testing.T) {
+	assert.Contains(t, readingProgressScript, "type: 'reading_progress'")
+	assert.Contains(t, readingProgressScript, "if (p === reported) return;")
+	assert.Contains(t, readingProgressScript, "window.requestAnimationFrame(report)")
+	assert.Contains(t, readingProgressScript, "if (max <= 0) return 0;")
+	assert.True(t, pageMessageTypes["reading_progress"])
+}
+
+func TestEngineConfigureContentInjectorReadingProgressGetterReadsCurrentPayload(t *testing.T) {
+	settings := NewSettingsManager(context.Background(), entity.EngineSettingsPayload{})
+	injector := NewContentInjector(nil)
+
+	engineConfigureContentInjectorRuntimeSettings(injector, settings)
+
+	require.NotNil(t, injector.readingGetter)
+	require.False(t, injector.readingGetter())
+
+	settings.UpdateFromPayload(context.Background(), entity.EngineSettingsPayload{
+		WebContent: entity.EngineWebContentSettingsPayload{ReadingProgress: true},
+	})
+
+	require.True(t, injector.readingGetter())
+}
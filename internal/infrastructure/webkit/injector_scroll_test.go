@@ -0,0 +1,55 @@
+package webkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestBuildWheelScrollScript_PassesThroughAtOne(t *testing.T) {
+	for _, multiplier := range []float64{1, 0, -2} {
+		assert.Empty(t, buildWheelScrollScript(multiplier, true), "multiplier %g", multiplier)
+		assert.Empty(t, buildWheelScrollScript(multiplier, false), "multiplier %g", multiplier)
+	}
+}
+
+func TestBuildWheelScrollScript_AppliesMultiplier(t *testing.T) {
+	script := buildWheelScrollScript(2.5, true)
+
+	assert.Contains(t, script, "var multiplier = 2.5;")
+	assert.Contains(t, script, "var behavior = 'smooth';")
+	assert.Contains(t, script, "left: dx * multiplier, top: dy * multiplier")
+	assert.Contains(t, script, "e.preventDefault()")
+	assert.Contains(t, script, "passive: false")
+	assert.Regexp(t, `if \(e\.defaultPrevented \|\| e\.ctrlKey\) return;`, script)
+
+	stepped := buildWheelScrollScript(0.5, false)
+	assert.Contains(t, stepped, "var multiplier = 0.5;")
+	assert.Contains(t, stepped, "var behavior = 'instant';")
+}
+
+func TestEngineConfigureContentInjectorScrollGetterReadsCurrentPayload(t *testing.T) {
+	settings := NewSettingsManager(context.Background(), entity.EngineSettingsPayload{
+		WebContent: entity.EngineWebContentSettingsPayload{ScrollMultiplier: 1, SmoothScrolling: true},
+	})
+	injector := NewContentInjector(nil)
+
+	engineConfigureContentInjectorRuntimeSettings(injector, settings)
+
+	require.NotNil(t, injector.scrollConfigGetter)
+	multiplier, smooth := injector.scrollConfigGetter()
+	assert.InDelta(t, 1.0, multiplier, 0)
+	assert.True(t, smooth)
+
+	settings.UpdateFromPayload(context.Background(), entity.EngineSettingsPayload{
+		WebContent: entity.EngineWebContentSettingsPayload{ScrollMultiplier: 3},
+	})
+
+	multiplier, smooth = injector.scrollConfigGetter()
+	assert.InDelta(t, 3.0, multiplier, 0)
+	assert.False(t, smooth)
+}
@@ -0,0 +1,41 @@
+package webkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestTypeToFindScriptLeavesEditableFocusToThePage(t *testing.T) {
+	assert.Contains(t, typeToFindScript, "type: 'type_to_find'")
+	assert.Contains(t, typeToFindScript, "if (e.defaultPrevented || e.isComposing || e.repeat) return;")
+	assert.Contains(t, typeToFindScript, "if (e.ctrlKey || e.altKey || e.metaKey) return;")
+	assert.Contains(t, typeToFindScript, "el.isContentEditable")
+	assert.Contains(t, typeToFindScript, "document.designMode === 'on'")
+	assert.Contains(t, typeToFindScript, "tag === 'INPUT' || tag === 'TEXTAREA' || tag === 'SELECT'")
+	assert.Contains(t, typeToFindScript, "el.shadowRoot.activeElement")
+}
+
+func TestTypeToFindMessageIsAcceptedFromPages(t *testing.T) {
+	assert.True(t, pageMessageTypes["type_to_find"])
+}
+
+func TestEngineConfigureContentInjectorTypeToFindGetterReadsCurrentPayload(t *testing.T) {
+	settings := NewSettingsManager(context.Background(), entity.EngineSettingsPayload{})
+	injector := NewContentInjector(nil)
+
+	engineConfigureContentInjectorRuntimeSettings(injector, settings)
+
+	require.NotNil(t, injector.typeToFindGetter)
+	require.False(t, injector.typeToFindGetter())
+
+	settings.UpdateFromPayload(context.Background(), entity.EngineSettingsPayload{
+		WebContent: entity.EngineWebContentSettingsPayload{TypeToFind: true},
+	})
+
+	require.True(t, injector.typeToFindGetter())
+}
@@ -0,0 +1,60 @@
+package webkit
+
+import (
+	"github.com/bnema/dumber/internal/domain/loadretry"
+	"github.com/bnema/puregotk/v4/gio"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+// GError domains of load failures, as returned by g_quark_to_string.
+const (
+	ioErrorDomain       = "g-io-error-quark"
+	resolverErrorDomain = "g-resolver-error-quark"
+	networkErrorDomain  = "WebKitNetworkError"
+	policyErrorDomain   = "WebKitPolicyError"
+)
+
+// loadFailureCause maps the GError of a load-failed signal to its
+// engine-neutral cause. Network failures keep the GIO error WebKit got from
+// libsoup, so both GIO and WebKit domains are checked.
+func loadFailureCause(domain string, code int32) loadretry.Cause {
+	switch domain {
+	case ioErrorDomain:
+		return ioErrorCause(gio.IOErrorEnum(code))
+	case resolverErrorDomain:
+		switch gio.ResolverError(code) {
+		case gio.GResolverErrorNotFoundValue, gio.GResolverErrorTemporaryFailureValue:
+			return loadretry.CauseNameResolution
+		}
+	case networkErrorDomain:
+		switch webkit.NetworkError(code) {
+		case webkit.NetworkErrorTransportValue:
+			return loadretry.CauseConnection
+		case webkit.NetworkErrorCancelledValue:
+			return loadretry.CauseCancelled
+		case webkit.NetworkErrorUnknownProtocolValue:
+			return loadretry.CausePolicy
+		}
+	case policyErrorDomain:
+		return loadretry.CausePolicy
+	}
+	return loadretry.CauseOther
+}
+
+func ioErrorCause(code gio.IOErrorEnum) loadretry.Cause {
+	switch code {
+	case gio.GIoErrorCancelledValue:
+		return loadretry.CauseCancelled
+	case gio.GIoErrorTimedOutValue:
+		return loadretry.CauseTimeout
+	case gio.GIoErrorHostNotFoundValue:
+		return loadretry.CauseNameResolution
+	case gio.GIoErrorHostUnreachableValue, gio.GIoErrorNetworkUnreachableValue:
+		return loadretry.CauseOffline
+	case gio.GIoErrorConnectionRefusedValue, gio.GIoErrorConnectionClosedValue,
+		gio.GIoErrorNotConnectedValue, gio.GIoErrorProxyFailedValue:
+		return loadretry.CauseConnection
+	default:
+		return loadretry.CauseOther
+	}
+}
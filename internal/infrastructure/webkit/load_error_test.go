@@ -0,0 +1,37 @@
+package webkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/loadretry"
+)
+
+func TestLoadFailureCause(t *testing.T) {
+	tests := []struct {
+		domain string
+		code   int32
+		want   loadretry.Cause
+	}{
+		{ioErrorDomain, 39, loadretry.CauseConnection},     // G_IO_ERROR_CONNECTION_REFUSED
+		{ioErrorDomain, 44, loadretry.CauseConnection},     // G_IO_ERROR_CONNECTION_CLOSED
+		{ioErrorDomain, 24, loadretry.CauseTimeout},        // G_IO_ERROR_TIMED_OUT
+		{ioErrorDomain, 28, loadretry.CauseNameResolution}, // G_IO_ERROR_HOST_NOT_FOUND
+		{ioErrorDomain, 38, loadretry.CauseOffline},        // G_IO_ERROR_NETWORK_UNREACHABLE
+		{ioErrorDomain, 19, loadretry.CauseCancelled},      // G_IO_ERROR_CANCELLED
+		{ioErrorDomain, 1, loadretry.CauseOther},           // G_IO_ERROR_NOT_FOUND
+		{resolverErrorDomain, 0, loadretry.CauseNameResolution},
+		{resolverErrorDomain, 1, loadretry.CauseNameResolution},
+		{resolverErrorDomain, 2, loadretry.CauseOther},
+		{networkErrorDomain, 300, loadretry.CauseConnection},
+		{networkErrorDomain, 302, loadretry.CauseCancelled},
+		{networkErrorDomain, 301, loadretry.CausePolicy},
+		{networkErrorDomain, 303, loadretry.CauseOther},
+		{policyErrorDomain, 102, loadretry.CausePolicy},
+		{"g-tls-error-quark", 1, loadretry.CauseOther},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, loadFailureCause(tt.domain, tt.code), "%s %d", tt.domain, tt.code)
+	}
+}
@@ -24,6 +24,17 @@ type MessageHandler = port.WebUIMessageHandler
 // Kept for backward compatibility within the webkit package.
 type MessageHandlerFunc = port.WebUIMessageHandlerFunc
 
+// pageMessageTypes may be posted by any page, not only trusted dumb:// views.
+// Their handlers must treat the payload as untrusted page input. A type with
+// a gate (see SetPageMessageGate) is only accepted while the gate is open.
+var pageMessageTypes = map[string]bool{
+	"console_message":  true,
+	"type_to_find":     true,
+	"form_dirty":       true,
+	"reading_progress": true,
+	"page_language":    true,
+}
+
 type handlerEntry struct {
 	handler       MessageHandler
 	callback      string
@@ -38,6 +49,8 @@ var _ port.WebUIHandlerRouter = (*MessageRouter)(nil)
 type MessageRouter struct {
 	handlers map[string]handlerEntry
 	baseCtx  context.Context
+	// pageGates decides whether a page message type is currently accepted.
+	pageGates map[string]func() bool
 
 	mu        sync.RWMutex
 	callbacks []any
@@ -86,6 +99,28 @@ func (r *MessageRouter) baseContext() context.Context {
 	return ctx
 }
 
+// SetPageMessageGate accepts msgType from untrusted pages only while enabled
+// returns true, so a disabled feature does not leave its bridge open.
+func (r *MessageRouter) SetPageMessageGate(msgType string, enabled func() bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.pageGates == nil {
+		r.pageGates = make(map[string]func() bool)
+	}
+	r.pageGates[msgType] = enabled
+}
+
+// acceptsPageMessage reports whether any page may currently post msgType.
+func (r *MessageRouter) acceptsPageMessage(msgType string) bool {
+	if !pageMessageTypes[msgType] {
+		return false
+	}
+	r.mu.RLock()
+	enabled := r.pageGates[msgType]
+	r.mu.RUnlock()
+	return enabled == nil || enabled()
+}
+
 // RegisterHandler registers a handler for a message type.
 func (r *MessageRouter) RegisterHandler(msgType string, handler MessageHandler) error {
 	if msgType == "" {
@@ -221,7 +256,7 @@ func (r *MessageRouter) handleScriptMessage(senderUCM webkit.UserContentManager,
 		log.Warn().Msg("rejecting script message from unknown sender")
 		return
 	}
-	if !isTrustedBridgeWebView(senderWV) {
+	if !isTrustedBridgeWebView(senderWV) && !r.acceptsPageMessage(msg.Type) {
 		log.Warn().
 			Str("type", msg.Type).
 			Uint64("sender_webview_id", uint64(senderWV.ID())).
@@ -250,7 +285,12 @@ func (r *MessageRouter) handleScriptMessage(senderUCM webkit.UserContentManager,
 		return
 	}
 
-	log.Info().
+	dispatchLog := log.Info()
+	if pageMessageTypes[msg.Type] {
+		// Page messages can arrive at console-logging rates.
+		dispatchLog = log.Debug()
+	}
+	dispatchLog.
 		Str("type", msg.Type).
 		Uint64("webview_id", msg.WebViewID).
 		Int("payload_len", len(msg.Payload)).
@@ -42,6 +42,9 @@ func TestIsTrustedBridgeURI(t *testing.T) {
 		"dumb://history",
 		"dumb://favorites",
 		"dumb://config",
+		"dumb://console",
+		"dumb://navtree",
+		"dumb://notes",
 		"dumb://error",
 		"dumb://crash",
 		"dumb://history/path?cursor=1",
@@ -69,3 +72,18 @@ func TestIsTrustedBridgeURI(t *testing.T) {
 		})
 	}
 }
+
+func TestMessageRouterPageMessageGate(t *testing.T) {
+	t.Parallel()
+
+	router := NewMessageRouter(context.Background())
+	require.True(t, router.acceptsPageMessage("console_message"), "ungated page types are accepted")
+	require.False(t, router.acceptsPageMessage("history_search"), "bridge types are never page types")
+
+	enabled := false
+	router.SetPageMessageGate("console_message", func() bool { return enabled })
+	require.False(t, router.acceptsPageMessage("console_message"))
+
+	enabled = true
+	require.True(t, router.acceptsPageMessage("console_message"))
+}
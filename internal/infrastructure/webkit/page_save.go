@@ -0,0 +1,130 @@
+package webkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/infrastructure/webutil"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/gio"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+const savedPagePerm = 0o644
+
+// saveModeForFormat returns the native WebKit save mode for format.
+// Formats WebKit cannot produce itself (HTML complete) report false.
+func saveModeForFormat(format entity.PageSaveFormat) (webkit.SaveMode, bool) {
+	switch format {
+	case entity.PageSaveFormatMHTML:
+		return webkit.SaveModeMhtmlValue, true
+	default:
+		return 0, false
+	}
+}
+
+// SavePage implements port.PageSaver. It must be called on the GTK main
+// thread; the returned channel receives the result once the file is written.
+func (wv *WebView) SavePage(ctx context.Context, path string, format entity.PageSaveFormat) <-chan error {
+	done := make(chan error, 1)
+	if wv.destroyed.Load() || wv.inner == nil {
+		done <- errors.New("webview destroyed")
+		return done
+	}
+	if path == "" {
+		done <- errors.New("save path is empty")
+		return done
+	}
+
+	logging.FromContext(ctx).Debug().
+		Uint64("webview_id", uint64(wv.id)).
+		Str("format", string(format)).
+		Str("path", path).
+		Msg("saving page")
+
+	if mode, ok := saveModeForFormat(format); ok {
+		wv.saveNative(path, mode, done)
+		return done
+	}
+	if format == entity.PageSaveFormatHTMLComplete {
+		wv.saveHTMLComplete(path, done)
+		return done
+	}
+	done <- fmt.Errorf("unsupported page save format %q", format)
+	return done
+}
+
+// saveNative writes the page with webkit_web_view_save_to_file.
+func (wv *WebView) saveNative(path string, mode webkit.SaveMode, done chan<- error) {
+	file := gio.FileNewForPath(path)
+	if file == nil {
+		done <- fmt.Errorf("open %s: invalid path", path)
+		return
+	}
+
+	cb := gio.AsyncReadyCallback(func(_ uintptr, resPtr uintptr, _ uintptr) {
+		if wv.destroyed.Load() {
+			done <- errors.New("webview destroyed while saving")
+			return
+		}
+		if resPtr == 0 {
+			done <- errors.New("save page: nil async result")
+			return
+		}
+		if _, err := wv.inner.SaveToFileFinish(&gio.AsyncResultBase{Ptr: resPtr}); err != nil {
+			done <- fmt.Errorf("save page: %w", err)
+			return
+		}
+		done <- nil
+	})
+
+	// prevent callback from being GC'd before it's called
+	wv.mu.Lock()
+	wv.asyncCallbacks = append(wv.asyncCallbacks, &cb)
+	wv.mu.Unlock()
+
+	wv.inner.SaveToFile(file, mode, nil, &cb, 0)
+}
+
+// saveHTMLComplete serializes the DOM with resources inlined by the page
+// snapshot script and writes the result to path off the main thread.
+func (wv *WebView) saveHTMLComplete(path string, done chan<- error) {
+	cb := gio.AsyncReadyCallback(func(_ uintptr, resPtr uintptr, _ uintptr) {
+		if wv.destroyed.Load() {
+			done <- errors.New("webview destroyed while saving")
+			return
+		}
+		if resPtr == 0 {
+			done <- errors.New("save page: nil async result")
+			return
+		}
+		value, err := wv.inner.CallAsyncJavascriptFunctionFinish(&gio.AsyncResultBase{Ptr: resPtr})
+		if err != nil {
+			done <- fmt.Errorf("snapshot page: %w", err)
+			return
+		}
+		if value == nil || !value.IsString() {
+			done <- errors.New("snapshot page: script returned no document")
+			return
+		}
+		html := value.ToString()
+		go func() {
+			if err := os.WriteFile(path, []byte(html), savedPagePerm); err != nil {
+				done <- fmt.Errorf("write %s: %w", path, err)
+				return
+			}
+			done <- nil
+		}()
+	})
+
+	// prevent callback from being GC'd before it's called
+	wv.mu.Lock()
+	wv.asyncCallbacks = append(wv.asyncCallbacks, &cb)
+	wv.mu.Unlock()
+
+	body := webutil.PageSnapshotFunctionBody
+	wv.inner.CallAsyncJavascriptFunction(body, -1, nil, nil, nil, nil, &cb, 0)
+}
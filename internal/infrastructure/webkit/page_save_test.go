@@ -0,0 +1,21 @@
+package webkit
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/webkit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveModeForFormat(t *testing.T) {
+	mode, ok := saveModeForFormat(entity.PageSaveFormatMHTML)
+	assert.True(t, ok)
+	assert.Equal(t, webkit.SaveModeMhtmlValue, mode)
+
+	_, ok = saveModeForFormat(entity.PageSaveFormatHTMLComplete)
+	assert.False(t, ok, "HTML complete is produced by the snapshot script, not WebKit")
+
+	_, ok = saveModeForFormat(entity.PageSaveFormat("pdf"))
+	assert.False(t, ok)
+}
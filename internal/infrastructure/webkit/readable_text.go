@@ -0,0 +1,50 @@
+package webkit
+
+import (
+	"context"
+
+	"github.com/bnema/puregotk/v4/gio"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/readaloud"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+var _ port.ReadableTextReader = (*WebView)(nil)
+
+// ReadReadableText implements port.ReadableTextReader.
+func (wv *WebView) ReadReadableText(ctx context.Context, fn func(text string)) {
+	if wv == nil || fn == nil || wv.destroyed.Load() {
+		return
+	}
+	wv.mu.RLock()
+	inner := wv.inner
+	wv.mu.RUnlock()
+	if inner == nil {
+		return
+	}
+
+	cb := gio.AsyncReadyCallback(func(_ uintptr, resPtr uintptr, _ uintptr) {
+		if wv.destroyed.Load() || resPtr == 0 {
+			return
+		}
+		value, err := inner.EvaluateJavascriptFinish(&gio.AsyncResultBase{Ptr: resPtr})
+		if err != nil {
+			logging.FromContext(ctx).Debug().Err(err).
+				Uint64("webview_id", uint64(wv.id)).
+				Msg("read readable text failed")
+			return
+		}
+		if value == nil || !value.IsString() {
+			return
+		}
+		fn(value.ToString())
+	})
+
+	// prevent callback from being GC'd before it's called
+	wv.mu.Lock()
+	wv.asyncCallbacks = append(wv.asyncCallbacks, &cb)
+	wv.mu.Unlock()
+
+	inner.EvaluateJavascript(readaloud.ExtractScript(), -1, nil, nil, nil, &cb, 0)
+}
@@ -0,0 +1,26 @@
+package webkit
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/infrastructure/webutil"
+)
+
+var _ port.RenderingFreezeCapable = (*WebView)(nil)
+
+// SetRenderingFrozen implements port.RenderingFreezeCapable. WebKitGTK has no
+// per-view frame rate control, so only the freeze script applies. Calling it
+// again with the same state re-applies the script to a newly loaded page.
+func (wv *WebView) SetRenderingFrozen(ctx context.Context, frozen bool) {
+	if wv.destroyed.Load() {
+		return
+	}
+	wv.renderingFrozen.Store(frozen)
+	wv.RunJavaScript(ctx, webutil.RenderFreezeScript(frozen))
+}
+
+// IsRenderingFrozen implements port.RenderingFreezeCapable.
+func (wv *WebView) IsRenderingFrozen() bool {
+	return wv.renderingFrozen.Load()
+}
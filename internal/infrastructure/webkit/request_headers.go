@@ -0,0 +1,32 @@
+package webkit
+
+import (
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+// SetExtraHeaders adds headers to the top-level loads this view starts
+// through LoadURI. An empty map clears them.
+//
+// WebKitGTK only exposes outgoing requests for rewriting in the web process,
+// so link clicks, redirects and subresources keep their original headers;
+// scope therefore has no effect beyond the main frame here.
+func (wv *WebView) SetExtraHeaders(headers map[string]string, scope entity.RequestHeaderScope) {
+	wv.extraHeaders = entity.NewExtraRequestHeaders(headers, scope)
+}
+
+// newExtraHeadersRequest builds a request for uri carrying the extra headers,
+// or returns nil when there are none.
+func (wv *WebView) newExtraHeadersRequest(uri string) *webkit.URIRequest {
+	if !wv.extraHeaders.AppliesTo(true) {
+		return nil
+	}
+	request := webkit.NewURIRequest(uri)
+	if request == nil {
+		return nil
+	}
+	if headers := request.GetHttpHeaders(); headers != nil {
+		wv.extraHeaders.Apply(true, headers.Replace)
+	}
+	return request
+}
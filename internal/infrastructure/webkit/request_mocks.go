@@ -0,0 +1,58 @@
+package webkit
+
+import (
+	"context"
+
+	"github.com/bnema/puregotk/v4/webkit"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/requestmock"
+)
+
+var _ port.RequestMockCapable = (*WebView)(nil)
+
+// SetRequestMocks implements port.RequestMockCapable. WebKitGTK only exposes
+// send-request to web process extensions, so the mocks are answered by a
+// script wrapping fetch and XMLHttpRequest in every frame; requests made by
+// the page's HTML, such as images and stylesheets, are not mocked.
+func (wv *WebView) SetRequestMocks(ctx context.Context, mocks []entity.RequestMock) {
+	if wv.destroyed.Load() || wv.ucm == nil {
+		return
+	}
+	hadMocks := wv.clearRequestMockScript()
+	if len(mocks) == 0 && !hadMocks {
+		return
+	}
+	source := requestmock.Script(mocks)
+	if len(mocks) > 0 {
+		script := webkit.NewUserScript(
+			source,
+			webkit.UserContentInjectAllFramesValue,
+			webkit.UserScriptInjectAtDocumentStartValue,
+			nil,
+			nil,
+		)
+		if script == nil {
+			wv.logger.Warn().Msg("failed to create request mock script")
+			return
+		}
+		wv.ucm.AddScript(script)
+		wv.requestMockScript = script
+	}
+	wv.RunJavaScript(ctx, source)
+	wv.logger.Debug().Int("mocks", len(mocks)).Msg("request mocks changed")
+}
+
+// clearRequestMockScript removes the request mock script, reporting whether
+// there was one.
+func (wv *WebView) clearRequestMockScript() bool {
+	if wv.requestMockScript == nil {
+		return false
+	}
+	if wv.ucm != nil {
+		wv.ucm.RemoveScript(wv.requestMockScript)
+	}
+	wv.requestMockScript = nil
+	return true
+}
@@ -29,6 +29,10 @@ const (
 	HistoryPath             = "history"
 	FavoritesPath           = "favorites"
 	ConfigPath              = "config"
+	HomepagePath            = "homepage"
+	ConsolePath             = "console"
+	NavTreePath             = "navtree"
+	NotesPath               = "notes"
 	ErrorPath               = "error"
 	CrashPath               = "crash"
 	IndexHTML               = "index.html"
@@ -287,7 +291,7 @@ func isTrustedSystemviewURL(raw string) bool {
 		host = host[:idx]
 	}
 	switch host {
-	case HistoryPath, FavoritesPath, ConfigPath, ErrorPath, CrashPath:
+	case HistoryPath, FavoritesPath, ConfigPath, HomepagePath, ConsolePath, NavTreePath, NotesPath, ErrorPath, CrashPath:
 		return true
 	default:
 		return false
@@ -535,6 +539,10 @@ func resolveAssetPath(u *url.URL) (assetDir, relPath string, ok bool) {
 		HistoryPath:   {assetDir: systemviewsAssetDir, file: IndexHTML},
 		FavoritesPath: {assetDir: systemviewsAssetDir, file: IndexHTML},
 		ConfigPath:    {assetDir: systemviewsAssetDir, file: IndexHTML},
+		HomepagePath:  {assetDir: systemviewsAssetDir, file: IndexHTML},
+		ConsolePath:   {assetDir: systemviewsAssetDir, file: IndexHTML},
+		NavTreePath:   {assetDir: systemviewsAssetDir, file: IndexHTML},
+		NotesPath:     {assetDir: systemviewsAssetDir, file: IndexHTML},
 		ErrorPath:     {assetDir: systemviewsAssetDir, file: IndexHTML},
 		CrashPath:     {assetDir: systemviewsAssetDir, file: IndexHTML},
 	}
@@ -548,7 +556,7 @@ func resolveAssetPath(u *url.URL) (assetDir, relPath string, ok bool) {
 	}
 
 	switch u.Opaque {
-	case HistoryPath, FavoritesPath, ConfigPath, ErrorPath, CrashPath:
+	case HistoryPath, FavoritesPath, ConfigPath, HomepagePath, ConsolePath, NavTreePath, NotesPath, ErrorPath, CrashPath:
 		return systemviewsAssetDir, IndexHTML, true
 	default:
 		return "", "", false
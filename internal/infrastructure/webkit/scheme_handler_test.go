@@ -60,6 +60,10 @@ func TestIsTrustedSystemviewURL(t *testing.T) {
 		"dumb://history",
 		"dumb://favorites",
 		"dumb:config",
+		"dumb://homepage",
+		"dumb://console",
+		"dumb://navtree",
+		"dumb://notes",
 	} {
 		require.True(t, isTrustedSystemviewURL(raw), raw)
 	}
@@ -168,6 +172,14 @@ func TestHandleAsset_SystemviewsRootsServeShell(t *testing.T) {
 		"dumb:favorites",
 		"dumb://config",
 		"dumb:config",
+		"dumb://homepage",
+		"dumb:homepage",
+		"dumb://console",
+		"dumb:console",
+		"dumb://navtree",
+		"dumb:navtree",
+		"dumb://notes",
+		"dumb:notes",
 		"dumb://error",
 		"dumb:error",
 	}
@@ -0,0 +1,90 @@
+package webkit
+
+import (
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+// scriptDialogTypeFromWebKit maps a WebKit script dialog type to its domain
+// type. Unknown types are left to WebKit's default handler.
+func scriptDialogTypeFromWebKit(t webkit.ScriptDialogType) (entity.ScriptDialogType, bool) {
+	switch t {
+	case webkit.ScriptDialogAlertValue:
+		return entity.ScriptDialogAlert, true
+	case webkit.ScriptDialogConfirmValue:
+		return entity.ScriptDialogConfirm, true
+	case webkit.ScriptDialogPromptValue:
+		return entity.ScriptDialogPrompt, true
+	case webkit.ScriptDialogBeforeUnloadConfirmValue:
+		return entity.ScriptDialogBeforeUnload, true
+	default:
+		return "", false
+	}
+}
+
+// applyScriptDialogResponse stores response on dialog. WebKit rejects
+// confirmed/text on dialog types that do not carry them, so each type only
+// sets what it supports. A prompt whose text is never set returns null.
+func applyScriptDialogResponse(dialog *webkit.ScriptDialog, t entity.ScriptDialogType, response entity.ScriptDialogResponse) {
+	switch t {
+	case entity.ScriptDialogConfirm, entity.ScriptDialogBeforeUnload:
+		dialog.ConfirmSetConfirmed(response.Confirmed)
+	case entity.ScriptDialogPrompt:
+		if response.Confirmed {
+			dialog.PromptSetText(response.Text)
+		}
+	}
+}
+
+// connectScriptDialogSignal routes alert/confirm/prompt/beforeunload dialogs
+// to OnScriptDialog. Handled dialogs are answered asynchronously: the dialog
+// is kept alive until Respond closes it.
+func (wv *WebView) connectScriptDialogSignal() {
+	scriptDialogCb := func(inner webkit.WebView, dialogPtr uintptr) bool {
+		handler := wv.OnScriptDialog
+		if handler == nil {
+			return false // Not handled, WebKit shows its own dialog
+		}
+
+		dialog := webkit.ScriptDialogNewFromInternalPtr(dialogPtr)
+		if dialog == nil {
+			return false
+		}
+		dialogType, ok := scriptDialogTypeFromWebKit(dialog.GetDialogType())
+		if !ok {
+			wv.logger.Debug().Int("type", int(dialog.GetDialogType())).Msg("unknown script dialog type")
+			return false
+		}
+
+		request := port.ScriptDialogRequest{
+			Type:    dialogType,
+			Message: dialog.GetMessage(),
+			URI:     inner.GetUri(),
+		}
+		if dialogType == entity.ScriptDialogPrompt {
+			request.DefaultText = dialog.PromptGetDefaultText()
+		}
+
+		dialog.Ref()
+		answered := false
+		request.Respond = func(response entity.ScriptDialogResponse) {
+			if answered {
+				return
+			}
+			answered = true
+			applyScriptDialogResponse(dialog, dialogType, response)
+			dialog.Close()
+			dialog.Unref()
+		}
+
+		if !handler(request) {
+			answered = true
+			dialog.Unref()
+			return false
+		}
+		return true
+	}
+	sigID := wv.inner.ConnectScriptDialog(&scriptDialogCb)
+	wv.signalIDs = append(wv.signalIDs, uintptr(sigID))
+}
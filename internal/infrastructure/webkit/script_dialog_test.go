@@ -0,0 +1,30 @@
+package webkit
+
+import (
+	"testing"
+
+	webkitlib "github.com/bnema/puregotk/v4/webkit"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestScriptDialogTypeFromWebKit(t *testing.T) {
+	tests := []struct {
+		in   webkitlib.ScriptDialogType
+		want entity.ScriptDialogType
+	}{
+		{in: webkitlib.ScriptDialogAlertValue, want: entity.ScriptDialogAlert},
+		{in: webkitlib.ScriptDialogConfirmValue, want: entity.ScriptDialogConfirm},
+		{in: webkitlib.ScriptDialogPromptValue, want: entity.ScriptDialogPrompt},
+		{in: webkitlib.ScriptDialogBeforeUnloadConfirmValue, want: entity.ScriptDialogBeforeUnload},
+	}
+	for _, tt := range tests {
+		got, ok := scriptDialogTypeFromWebKit(tt.in)
+		assert.True(t, ok)
+		assert.Equal(t, tt.want, got)
+	}
+
+	_, ok := scriptDialogTypeFromWebKit(webkitlib.ScriptDialogType(99))
+	assert.False(t, ok)
+}
@@ -0,0 +1,77 @@
+package webkit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bnema/puregotk/v4/gio"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+var _ port.ScrollPositionReader = (*WebView)(nil)
+
+// scrollPositionScript reports the document URL with its scroll offset so a
+// result that arrives after a navigation started is still keyed to the page
+// it was measured on.
+const scrollPositionScript = `JSON.stringify([location.href, window.scrollX || 0, window.scrollY || 0])`
+
+// ReadScrollPosition implements port.ScrollPositionReader.
+func (wv *WebView) ReadScrollPosition(ctx context.Context, fn func(pageURL string, pos entity.ScrollPosition)) {
+	if wv == nil || fn == nil || wv.destroyed.Load() {
+		return
+	}
+	wv.mu.RLock()
+	inner := wv.inner
+	wv.mu.RUnlock()
+	if inner == nil {
+		return
+	}
+
+	cb := gio.AsyncReadyCallback(func(_ uintptr, resPtr uintptr, _ uintptr) {
+		if wv.destroyed.Load() || resPtr == 0 {
+			return
+		}
+		value, err := inner.EvaluateJavascriptFinish(&gio.AsyncResultBase{Ptr: resPtr})
+		if err != nil {
+			logging.FromContext(ctx).Debug().Err(err).
+				Uint64("webview_id", uint64(wv.id)).
+				Msg("read scroll position failed")
+			return
+		}
+		if value == nil || !value.IsString() {
+			return
+		}
+		if pageURL, pos, ok := parseScrollPosition(value.ToString()); ok {
+			fn(pageURL, pos)
+		}
+	})
+
+	// prevent callback from being GC'd before it's called
+	wv.mu.Lock()
+	wv.asyncCallbacks = append(wv.asyncCallbacks, &cb)
+	wv.mu.Unlock()
+
+	inner.EvaluateJavascript(scrollPositionScript, -1, nil, nil, nil, &cb, 0)
+}
+
+// parseScrollPosition decodes the [url, x, y] result of scrollPositionScript.
+func parseScrollPosition(raw string) (string, entity.ScrollPosition, bool) {
+	var fields []json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil || len(fields) != 3 {
+		return "", entity.ScrollPosition{}, false
+	}
+	var (
+		pageURL string
+		pos     entity.ScrollPosition
+	)
+	if json.Unmarshal(fields[0], &pageURL) != nil ||
+		json.Unmarshal(fields[1], &pos.X) != nil ||
+		json.Unmarshal(fields[2], &pos.Y) != nil ||
+		pageURL == "" {
+		return "", entity.ScrollPosition{}, false
+	}
+	return pageURL, pos, true
+}
@@ -0,0 +1,21 @@
+package webkit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestParseScrollPosition(t *testing.T) {
+	pageURL, pos, ok := parseScrollPosition(`["https://example.com/article",0,1234.5]`)
+	assert.True(t, ok)
+	assert.Equal(t, "https://example.com/article", pageURL)
+	assert.Equal(t, entity.ScrollPosition{Y: 1234.5}, pos)
+
+	for _, raw := range []string{``, `null`, `["https://example.com/",1]`, `["",0,0]`, `[1,2,3]`} {
+		_, _, ok := parseScrollPosition(raw)
+		assert.False(t, ok, "raw %q", raw)
+	}
+}
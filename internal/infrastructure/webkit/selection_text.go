@@ -0,0 +1,61 @@
+package webkit
+
+import (
+	"context"
+
+	"github.com/bnema/puregotk/v4/gio"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+var _ port.SelectionTextReader = (*WebView)(nil)
+
+// selectionTextScript returns the text selected in the main frame, including
+// a selection inside a text field.
+const selectionTextScript = `(function() {
+  var el = document.activeElement;
+  if (el && (el.tagName === 'TEXTAREA' || el.tagName === 'INPUT') && el.type !== 'password' &&
+      typeof el.selectionStart === 'number' && el.selectionEnd > el.selectionStart) {
+    return el.value.slice(el.selectionStart, el.selectionEnd);
+  }
+  var sel = window.getSelection ? window.getSelection() : null;
+  return sel ? sel.toString() : '';
+})()`
+
+// ReadSelectionText implements port.SelectionTextReader.
+func (wv *WebView) ReadSelectionText(ctx context.Context, fn func(text string)) {
+	if wv == nil || fn == nil || wv.destroyed.Load() {
+		return
+	}
+	wv.mu.RLock()
+	inner := wv.inner
+	wv.mu.RUnlock()
+	if inner == nil {
+		return
+	}
+
+	cb := gio.AsyncReadyCallback(func(_ uintptr, resPtr uintptr, _ uintptr) {
+		if wv.destroyed.Load() || resPtr == 0 {
+			return
+		}
+		value, err := inner.EvaluateJavascriptFinish(&gio.AsyncResultBase{Ptr: resPtr})
+		if err != nil {
+			logging.FromContext(ctx).Debug().Err(err).
+				Uint64("webview_id", uint64(wv.id)).
+				Msg("read selection text failed")
+			return
+		}
+		if value == nil || !value.IsString() {
+			return
+		}
+		fn(value.ToString())
+	})
+
+	// prevent callback from being GC'd before it's called
+	wv.mu.Lock()
+	wv.asyncCallbacks = append(wv.asyncCallbacks, &cb)
+	wv.mu.Unlock()
+
+	inner.EvaluateJavascript(selectionTextScript, -1, nil, nil, nil, &cb, 0)
+}
@@ -2,9 +2,12 @@ package webkit
 
 import (
 	"context"
+	"slices"
 	"sync"
 
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/media"
+	"github.com/bnema/dumber/internal/domain/throttle"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/puregotk/v4/webkit"
 	"github.com/rs/zerolog"
@@ -25,9 +28,19 @@ type mediaSettings interface {
 	SetMediaContentTypesRequiringHardwareSupport(*string)
 }
 
+// fontSettings is the font part of webkit.Settings.
+type fontSettings interface {
+	SetDefaultFontFamily(string)
+	SetSansSerifFontFamily(string)
+	SetSerifFontFamily(string)
+	SetMonospaceFontFamily(string)
+	SetDefaultFontSize(uint32)
+}
+
 // SettingsManager creates and manages WebKit Settings instances from payloads.
 type SettingsManager struct {
 	settings entity.EngineSettingsPayload
+	limiter  *throttle.Limiter
 	mu       sync.RWMutex
 }
 
@@ -35,7 +48,9 @@ type SettingsManager struct {
 func NewSettingsManager(ctx context.Context, settings entity.EngineSettingsPayload) *SettingsManager {
 	log := logging.FromContext(ctx)
 	log.Debug().Msg("creating settings manager")
-	return &SettingsManager{settings: settings}
+	warnCSPStrippingUnsupported(ctx, settings.StripCSPDomains)
+	warnAlwaysFreshLimited(ctx, settings.AlwaysFreshDomains)
+	return &SettingsManager{settings: settings, limiter: throttle.NewLimiter(settings.RequestThrottle)}
 }
 
 func (sm *SettingsManager) current() entity.EngineSettingsPayload {
@@ -44,6 +59,36 @@ func (sm *SettingsManager) current() entity.EngineSettingsPayload {
 	return sm.settings
 }
 
+// warnCSPStrippingUnsupported reports that debug.strip_csp_domains has no
+// effect: WebKitGTK gives the UI process no way to rewrite response headers.
+func warnCSPStrippingUnsupported(ctx context.Context, domains []string) {
+	if len(domains) == 0 {
+		return
+	}
+	logging.FromContext(ctx).Warn().
+		Strs("domains", domains).
+		Msg("webkit: debug.strip_csp_domains is not supported by the WebKit engine; CSP is kept")
+}
+
+// warnAlwaysFreshLimited reports that WebKit cannot change the cache policy
+// of navigations the page starts itself.
+func warnAlwaysFreshLimited(ctx context.Context, domains []string) {
+	if len(domains) == 0 {
+		return
+	}
+	logging.FromContext(ctx).Warn().
+		Strs("domains", domains).
+		Msg("webkit: cache.always_fresh_domains only applies to URLs opened by dumber; links followed on a page may be served from cache")
+}
+
+// requestLimiter returns the limiter shared by every view, or nil when no
+// domain is throttled.
+func (sm *SettingsManager) requestLimiter() *throttle.Limiter {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.limiter
+}
+
 // CreateSettings creates a new webkit.Settings instance configured from the current payload.
 func (sm *SettingsManager) CreateSettings(ctx context.Context) *webkit.Settings {
 	log := logging.FromContext(ctx)
@@ -68,8 +113,8 @@ func (sm *SettingsManager) applySettings(ctx context.Context, settings *webkit.S
 	applyJavaScriptSettings(settings)
 	applyFontSettings(settings, payload.WebContent)
 	applyDebugSettings(settings, payload.WebContent)
-	applyBrowsingSettings(settings)
-	applyMediaSettings(settings, payload.WebContent.HardwareDecoding, log)
+	applyBrowsingSettings(settings, payload.WebContent)
+	applyMediaSettings(settings, payload.WebContent.HardwareDecoding, payload.WebContent.AutoplayPolicy, log)
 	applyStorageSettings(settings)
 	applyUISettings(settings)
 	applyCanvasSettings(settings)
@@ -91,7 +136,7 @@ func applyJavaScriptSettings(settings *webkit.Settings) {
 	settings.SetEnableJavascriptMarkup(true)
 }
 
-func applyFontSettings(settings *webkit.Settings, payload entity.EngineWebContentSettingsPayload) {
+func applyFontSettings(settings fontSettings, payload entity.EngineWebContentSettingsPayload) {
 	if payload.SansFont != "" {
 		settings.SetDefaultFontFamily(payload.SansFont)
 		settings.SetSansSerifFontFamily(payload.SansFont)
@@ -107,26 +152,52 @@ func applyFontSettings(settings *webkit.Settings, payload entity.EngineWebConten
 	}
 }
 
+// fontSettingsWithOverride returns payload with the fonts fonts sets in place
+// of the configured ones.
+func fontSettingsWithOverride(
+	payload entity.EngineWebContentSettingsPayload,
+	fonts entity.FontOverride,
+) entity.EngineWebContentSettingsPayload {
+	if fonts.SansFont != "" {
+		payload.SansFont = fonts.SansFont
+	}
+	if fonts.SerifFont != "" {
+		payload.SerifFont = fonts.SerifFont
+	}
+	if fonts.MonospaceFont != "" {
+		payload.MonospaceFont = fonts.MonospaceFont
+	}
+	if fonts.DefaultFontSize > 0 {
+		payload.DefaultFontSize = fonts.DefaultFontSize
+	}
+	return payload
+}
+
 func applyDebugSettings(settings *webkit.Settings, payload entity.EngineWebContentSettingsPayload) {
 	settings.SetEnableDeveloperExtras(payload.EnableDevTools)
 	settings.SetEnableWriteConsoleMessagesToStdout(payload.CaptureConsole)
 	settings.SetDrawCompositingIndicators(payload.DrawCompositingIndicators)
 }
 
-func applyBrowsingSettings(settings *webkit.Settings) {
-	settings.SetEnableSmoothScrolling(true)
+func applyBrowsingSettings(settings *webkit.Settings, payload entity.EngineWebContentSettingsPayload) {
+	settings.SetEnableSmoothScrolling(payload.SmoothScrolling)
 	settings.SetEnablePageCache(true)
 	settings.SetEnableSiteSpecificQuirks(true)
 }
 
-func applyMediaSettings(settings mediaSettings, mode entity.EngineHardwareDecodingMode, log *zerolog.Logger) {
+func applyMediaSettings(
+	settings mediaSettings,
+	mode entity.EngineHardwareDecodingMode,
+	autoplay entity.AutoplayPolicy,
+	log *zerolog.Logger,
+) {
 	settings.SetEnableWebaudio(true)
 	settings.SetEnableWebgl(true)
 	settings.SetEnableMedia(true)
 	settings.SetEnableMediasource(true)
 	settings.SetEnableMediaCapabilities(true)
 	settings.SetEnableEncryptedMedia(true)
-	settings.SetMediaPlaybackRequiresUserGesture(true)
+	settings.SetMediaPlaybackRequiresUserGesture(media.EnforcementForAutoplayPolicy(autoplay).RequireUserGesture)
 	settings.SetMediaPlaybackAllowsInline(true)
 
 	switch mode {
@@ -178,6 +249,17 @@ func (sm *SettingsManager) UpdateFromPayload(ctx context.Context, settings entit
 	log := logging.FromContext(ctx)
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
+	// Rebuilding the limiter refills every bucket, so keep it while the
+	// rules are unchanged.
+	if !slices.Equal(sm.settings.RequestThrottle, settings.RequestThrottle) {
+		sm.limiter = throttle.NewLimiter(settings.RequestThrottle)
+	}
+	if !slices.Equal(sm.settings.StripCSPDomains, settings.StripCSPDomains) {
+		warnCSPStrippingUnsupported(ctx, settings.StripCSPDomains)
+	}
+	if !slices.Equal(sm.settings.AlwaysFreshDomains, settings.AlwaysFreshDomains) {
+		warnAlwaysFreshLimited(ctx, settings.AlwaysFreshDomains)
+	}
 	sm.settings = settings
 	log.Debug().Msg("settings payload updated")
 }
@@ -70,8 +70,8 @@ func TestApplyMediaSettingsUpdatesHardwareDecodingKnobsOnSameSettingsObject(t *t
 		t.Run(tt.name, func(t *testing.T) {
 			settings := &recordingMediaSettings{}
 
-			applyMediaSettings(settings, tt.first, &logger)
-			applyMediaSettings(settings, tt.next, &logger)
+			applyMediaSettings(settings, tt.first, entity.AutoplayPolicyBlockAudio, &logger)
+			applyMediaSettings(settings, tt.next, entity.AutoplayPolicyBlockAudio, &logger)
 
 			if got := settings.hardwareAccelerationPolicy; got != tt.wantPolicy {
 				t.Fatalf("HardwareAccelerationPolicy=%v, want %v", got, tt.wantPolicy)
@@ -90,9 +90,106 @@ func TestApplyMediaSettingsUpdatesHardwareDecodingKnobsOnSameSettingsObject(t *t
 	}
 }
 
+func TestApplyMediaSettingsMapsAutoplayPolicyToUserGesture(t *testing.T) {
+	tests := []struct {
+		policy      entity.AutoplayPolicy
+		wantGesture bool
+	}{
+		{policy: entity.AutoplayPolicyAllow, wantGesture: false},
+		{policy: entity.AutoplayPolicyBlock, wantGesture: true},
+		{policy: entity.AutoplayPolicyBlockAudio, wantGesture: true},
+		{policy: "", wantGesture: true},
+	}
+
+	logger := zerolog.Nop()
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			settings := &recordingMediaSettings{requiresUserGesture: !tt.wantGesture}
+
+			applyMediaSettings(settings, entity.EngineHardwareDecodingAuto, tt.policy, &logger)
+
+			if settings.requiresUserGesture != tt.wantGesture {
+				t.Fatalf("MediaPlaybackRequiresUserGesture=%v, want %v", settings.requiresUserGesture, tt.wantGesture)
+			}
+		})
+	}
+}
+
+func TestApplyFontSettingsWithOverride(t *testing.T) {
+	base := entity.EngineWebContentSettingsPayload{
+		SansFont:        "Fira Sans",
+		SerifFont:       "Literata",
+		MonospaceFont:   "Fira Code",
+		DefaultFontSize: 16,
+	}
+	tests := []struct {
+		name  string
+		fonts entity.FontOverride
+		want  recordingFontSettings
+	}{
+		{
+			name: "no override keeps the configured fonts",
+			want: recordingFontSettings{
+				defaultFamily: "Fira Sans", sans: "Fira Sans", serif: "Literata", mono: "Fira Code", size: 16,
+			},
+		},
+		{
+			name:  "override replaces only the fonts it sets",
+			fonts: entity.FontOverride{Domain: "example.com", SansFont: "Inter", DefaultFontSize: 20},
+			want: recordingFontSettings{
+				defaultFamily: "Inter", sans: "Inter", serif: "Literata", mono: "Fira Code", size: 20,
+			},
+		},
+		{
+			name:  "override replaces every font",
+			fonts: entity.FontOverride{SansFont: "Inter", SerifFont: "Georgia", MonospaceFont: "Iosevka", DefaultFontSize: 14},
+			want: recordingFontSettings{
+				defaultFamily: "Inter", sans: "Inter", serif: "Georgia", mono: "Iosevka", size: 14,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &recordingFontSettings{}
+			applyFontSettings(settings, fontSettingsWithOverride(base, tt.fonts))
+			if *settings != tt.want {
+				t.Fatalf("font settings=%+v, want %+v", *settings, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyFontSettingsSkipsUnsetFonts(t *testing.T) {
+	settings := &recordingFontSettings{sans: "kept", size: 12}
+	applyFontSettings(settings, entity.EngineWebContentSettingsPayload{SerifFont: "Literata"})
+	want := recordingFontSettings{sans: "kept", serif: "Literata", size: 12}
+	if *settings != want {
+		t.Fatalf("font settings=%+v, want %+v", *settings, want)
+	}
+}
+
+type recordingFontSettings struct {
+	defaultFamily string
+	sans          string
+	serif         string
+	mono          string
+	size          uint32
+}
+
+func (s *recordingFontSettings) SetDefaultFontFamily(family string) { s.defaultFamily = family }
+
+func (s *recordingFontSettings) SetSansSerifFontFamily(family string) { s.sans = family }
+
+func (s *recordingFontSettings) SetSerifFontFamily(family string) { s.serif = family }
+
+func (s *recordingFontSettings) SetMonospaceFontFamily(family string) { s.mono = family }
+
+func (s *recordingFontSettings) SetDefaultFontSize(size uint32) { s.size = size }
+
 type recordingMediaSettings struct {
 	hardwareAccelerationPolicy                webkit.HardwareAccelerationPolicy
 	mediaContentTypesRequiringHardwareSupport string
+	requiresUserGesture                       bool
 }
 
 func (*recordingMediaSettings) SetEnableWebaudio(bool) {}
@@ -107,7 +204,9 @@ func (*recordingMediaSettings) SetEnableMediaCapabilities(bool) {}
 
 func (*recordingMediaSettings) SetEnableEncryptedMedia(bool) {}
 
-func (*recordingMediaSettings) SetMediaPlaybackRequiresUserGesture(bool) {}
+func (s *recordingMediaSettings) SetMediaPlaybackRequiresUserGesture(required bool) {
+	s.requiresUserGesture = required
+}
 
 func (*recordingMediaSettings) SetMediaPlaybackAllowsInline(bool) {}
 
@@ -0,0 +1,141 @@
+package webkit
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/privacy"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/gio"
+	"github.com/bnema/puregotk/v4/glib"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+var _ port.SiteDataClearCapable = (*WebView)(nil)
+
+// clearedSiteDataTypes is the data removed for a site: cookies, caches,
+// storage and service workers. HSTS, ITP and device ID salts stay, since
+// dropping them weakens protections rather than removing what the site kept.
+const clearedSiteDataTypes = webkit.WebsiteDataCookiesValue |
+	webkit.WebsiteDataMemoryCacheValue |
+	webkit.WebsiteDataDiskCacheValue |
+	webkit.WebsiteDataOfflineApplicationCacheValue |
+	webkit.WebsiteDataSessionStorageValue |
+	webkit.WebsiteDataLocalStorageValue |
+	webkit.WebsiteDataIndexeddbDatabasesValue |
+	webkit.WebsiteDataServiceWorkerRegistrationsValue |
+	webkit.WebsiteDataDomCacheValue
+
+// siteDataCallbacks keeps async callbacks alive until they run. Clearing
+// outlives the WebView that started it, so they cannot live on the WebView.
+var siteDataCallbacks = &callbackKeeper{}
+
+type callbackKeeper struct {
+	mu   sync.Mutex
+	next uint64
+	cbs  map[uint64]*gio.AsyncReadyCallback
+}
+
+func (k *callbackKeeper) keep(cb *gio.AsyncReadyCallback) uint64 {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.cbs == nil {
+		k.cbs = make(map[uint64]*gio.AsyncReadyCallback)
+	}
+	k.next++
+	k.cbs[k.next] = cb
+	return k.next
+}
+
+func (k *callbackKeeper) release(id uint64) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	delete(k.cbs, id)
+}
+
+// ClearSiteData implements port.SiteDataClearCapable. WebKit keeps website
+// data per site, so every record covering origin's host is removed.
+func (wv *WebView) ClearSiteData(ctx context.Context, origin string) {
+	if wv.destroyed.Load() {
+		return
+	}
+	session := wv.inner.GetNetworkSession()
+	if session == nil {
+		return
+	}
+	manager := session.GetWebsiteDataManager()
+	if manager == nil {
+		return
+	}
+	log := logging.FromContext(ctx).With().Str("origin", origin).Logger()
+
+	var fetchID uint64
+	fetchCb := gio.AsyncReadyCallback(func(_ uintptr, resPtr uintptr, _ uintptr) {
+		defer siteDataCallbacks.release(fetchID)
+		if resPtr == 0 {
+			return
+		}
+		records, err := manager.FetchFinish(&gio.AsyncResultBase{Ptr: resPtr})
+		if err != nil {
+			log.Warn().Err(err).Msg("failed to list website data")
+			return
+		}
+		defer freeWebsiteDataList(records)
+
+		var sites []string
+		var matching []glib.List
+		for node := records; node != nil; node = node.Next {
+			data := webkit.WebsiteDataNewFromInternalPtr(node.Data)
+			if data == nil || !privacy.SiteDataCoversOrigin(data.GetName(), origin) {
+				continue
+			}
+			sites = append(sites, data.GetName())
+			matching = append(matching, glib.List{Data: node.Data})
+		}
+		if len(matching) == 0 {
+			log.Debug().Msg("no website data to clear")
+			return
+		}
+		// Link the matching records into a list of their own; WebKit copies
+		// them before Remove returns.
+		for i := range matching {
+			if i > 0 {
+				matching[i].Prev = &matching[i-1]
+			}
+			if i+1 < len(matching) {
+				matching[i].Next = &matching[i+1]
+			}
+		}
+
+		var removeID uint64
+		removeCb := gio.AsyncReadyCallback(func(_ uintptr, resPtr uintptr, _ uintptr) {
+			defer siteDataCallbacks.release(removeID)
+			if resPtr == 0 {
+				return
+			}
+			if _, err := manager.RemoveFinish(&gio.AsyncResultBase{Ptr: resPtr}); err != nil {
+				log.Warn().Err(err).Strs("sites", sites).Msg("failed to clear website data")
+				return
+			}
+			log.Debug().Strs("sites", sites).Msg("website data cleared")
+		})
+		removeID = siteDataCallbacks.keep(&removeCb)
+		manager.Remove(clearedSiteDataTypes, &matching[0], nil, &removeCb, 0)
+		runtime.KeepAlive(matching)
+	})
+	fetchID = siteDataCallbacks.keep(&fetchCb)
+	manager.Fetch(clearedSiteDataTypes, nil, &fetchCb, 0)
+}
+
+// freeWebsiteDataList releases a list returned by FetchFinish, which owns
+// both the list and a reference on each record.
+func freeWebsiteDataList(records *glib.List) {
+	for node := records; node != nil; node = node.Next {
+		if data := webkit.WebsiteDataNewFromInternalPtr(node.Data); data != nil {
+			data.Unref()
+		}
+	}
+	glib.ClearList(&records, nil)
+}
@@ -0,0 +1,45 @@
+package webkit
+
+import (
+	"time"
+
+	"github.com/bnema/puregotk/v4/glib"
+	"github.com/bnema/puregotk/v4/webkit"
+)
+
+// deferThrottledNavigation holds a navigation to a throttled domain until
+// its bucket has a token, then lets it go. It returns false when the
+// navigation may start now. Subresources are not throttled: WebKit only
+// exposes them to a web process extension.
+func (wv *WebView) deferThrottledNavigation(decisionPtr uintptr, uri string) bool {
+	if wv.settings == nil {
+		return false
+	}
+	wait := wv.settings.requestLimiter().Reserve(uri, time.Now())
+	if wait <= 0 {
+		return false
+	}
+	decision := webkit.PolicyDecisionNewFromInternalPtr(decisionPtr)
+	if decision == nil {
+		return false
+	}
+
+	wv.logger.Debug().
+		Str("uri", uri).
+		Dur("wait", wait).
+		Msg("throttling navigation")
+
+	// WebKit waits for a decision the handler returned true for until Use
+	// is called; the ref keeps it alive past this signal emission.
+	decision.Ref()
+	cb := glib.SourceFunc(func(_ uintptr) bool {
+		decision.Use()
+		decision.Unref()
+		return false // Don't repeat
+	})
+	wv.mu.Lock()
+	wv.asyncCallbacks = append(wv.asyncCallbacks, &cb)
+	wv.mu.Unlock()
+	glib.TimeoutAdd(uint(wait.Round(time.Millisecond).Milliseconds()), &cb, 0)
+	return true
+}
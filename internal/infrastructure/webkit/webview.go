@@ -14,8 +14,10 @@ import (
 	"github.com/bnema/dumber/internal/application/port"
 	downloadutil "github.com/bnema/dumber/internal/domain/download"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/media"
 	urlutil "github.com/bnema/dumber/internal/domain/url"
 	"github.com/bnema/dumber/internal/infrastructure/desktop"
+	"github.com/bnema/dumber/internal/infrastructure/webutil"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/puregotk/v4/gdk"
 	"github.com/bnema/puregotk/v4/gio"
@@ -31,8 +33,16 @@ import (
 var _ port.WebView = (*WebView)(nil)
 var _ port.DevToolsOpener = (*WebView)(nil)
 var _ port.Printer = (*WebView)(nil)
+var _ port.AutoplayPolicyCapable = (*WebView)(nil)
+var _ port.AudioMuteCapable = (*WebView)(nil)
+var _ port.ForceDarkCapable = (*WebView)(nil)
+var _ port.CookiePolicyCapable = (*WebView)(nil)
+var _ port.ExtraHeadersCapable = (*WebView)(nil)
+var _ port.PageSaver = (*WebView)(nil)
+var _ port.CacheBypassLoader = (*WebView)(nil)
 var _ port.PopupLifecycleCapable = (*WebView)(nil)
 var _ port.OAuthCallbackCapable = (*WebView)(nil)
+var _ port.HoveredLinkProvider = (*WebView)(nil)
 
 // WebViewID is an alias to port.WebViewID for clean architecture compliance.
 // Infrastructure layer uses the type defined in the application port.
@@ -125,6 +135,8 @@ type WebView struct {
 	id    WebViewID
 	inner *webkit.WebView
 	ucm   *webkit.UserContentManager
+	// settings is the live engine settings; nil in tests.
+	settings *SettingsManager
 
 	// State (protected by mutex)
 	destroyed atomic.Bool
@@ -140,6 +152,15 @@ type WebView struct {
 	isFullscreen   atomic.Bool
 	isPlayingAudio atomic.Bool
 
+	// renderingFrozen is set while the freeze script holds the page's
+	// rendering work back.
+	renderingFrozen atomic.Bool
+
+	// filteringBypassed and cosmeticFilteringBypassed select the content
+	// filters on the user content manager: none, network rules only, or all.
+	filteringBypassed         atomic.Bool
+	cosmeticFilteringBypassed atomic.Bool
+
 	// Progress throttling (~60fps)
 	lastProgressUpdate atomic.Int64 // Unix nanoseconds
 
@@ -157,11 +178,15 @@ type WebView struct {
 	OnCreate                   func(PopupRequest) *WebView // Return new WebView or nil to block popup
 	OnReadyToShow              func()                      // Called when popup is ready to display
 	OnLinkMiddleClick          func(uri string) bool       // Return true if handled (blocks navigation)
+	OnSearchSelection          func(text string)           // Called with the selection to search from the context menu
 	OnEnterFullscreen          func() bool                 // Return true to prevent fullscreen
 	OnLeaveFullscreen          func() bool                 // Return true to prevent leaving fullscreen
 	OnAudioStateChanged        func(playing bool)          // Called when audio playback starts/stops
 	OnLinkHover                func(uri string)            // Called when hovering over a link/image/media (empty string when leaving)
 	OnWebProcessTerminated     func(reason webkit.WebProcessTerminationReason, reasonLabel string, uri string)
+	OnLoadFailed               func(failure port.LoadFailure)
+	OnCertificateCheck         func(uri, spkiSHA256 string) bool // Return true to block the load
+	OnNavigationRequest        func(uri string) bool             // Return true to cancel the navigation
 	browsingContextDecision    dto.HostDecision
 	hasBrowsingContextDecision bool
 	nativePopupHostAbort       func()
@@ -173,12 +198,52 @@ type WebView struct {
 	// entity.PermissionMetadataKeyRequestingDomain and entity.PermissionMetadataKeyCurrentDomain are populated.
 	OnPermissionRequest func(origin string, permTypes []string, metadata map[string]string, allow, deny func()) bool
 
+	// OnScriptDialog is called when the page opens an alert, confirm, prompt
+	// or beforeunload dialog. Return false to let WebKit show its own dialog.
+	OnScriptDialog func(request port.ScriptDialogRequest) bool
+
 	logger zerolog.Logger
 	mu     sync.RWMutex
 
 	frontendAttached atomic.Bool
 	navigationActive atomic.Bool
 
+	// injector is set by AttachFrontend; userScripts holds the user scripts
+	// registered for the current navigation and forceDarkSheet the forced
+	// dark-mode stylesheet, if any. All are main-thread only.
+	injector       *ContentInjector
+	userScripts    []*webkit.UserScript
+	forceDarkSheet *webkit.UserStyleSheet
+
+	// extraHeaders are added to loads started through LoadURI. Main-thread only.
+	extraHeaders *entity.ExtraRequestHeaders
+
+	// userAgent is the user agent override set by ApplyUserAgent, empty for
+	// the engine default. Main-thread only.
+	userAgent string
+
+	// fontOverride is the fonts set by ApplyFontOverride, zero for the
+	// configured ones. Main-thread only.
+	fontOverride entity.FontOverride
+
+	// gesturesDisabled is set by SetNavigationGesturesEnabled(false) on
+	// sites where swiping must not go back or forward. Main-thread only.
+	gesturesDisabled bool
+
+	// colorScheme is the scheme set by ApplyColorScheme, empty for the system
+	// one, and colorSchemeScript the document-start script reporting it.
+	// Main-thread only.
+	colorScheme       entity.ColorScheme
+	colorSchemeScript *webkit.UserScript
+
+	// requestMockScript is the document-start script set by SetRequestMocks,
+	// nil when the pane sends its requests to the network. Main-thread only.
+	requestMockScript *webkit.UserScript
+
+	// hoveredLink is the link under the pointer from the last mouse-target
+	// hit test. Main-thread only.
+	hoveredLink string
+
 	// asyncCallbacks keeps references to async JS callbacks to prevent GC
 	asyncCallbacks []any
 
@@ -345,6 +410,7 @@ func NewWebView(ctx context.Context, wkCtx *WebKitContext, settings *SettingsMan
 	wv := &WebView{
 		inner:           inner,
 		ucm:             inner.GetUserContentManager(),
+		settings:        settings,
 		logger:          log.With().Str("component", "webview").Logger(),
 		signalIDs:       make([]uintptr, 0, 4),
 		runJSErrorStats: make(map[string]runJSErrorStat),
@@ -396,15 +462,23 @@ func NewWebViewWithRelated(ctx context.Context, parent *WebView, settings *Setti
 		inner:           inner,
 		isRelated:       true, // Shares web process with parent - must not terminate process on destroy
 		ucm:             inner.GetUserContentManager(),
+		settings:        settings,
 		logger:          log.With().Str("component", "webview-popup").Logger(),
 		signalIDs:       make([]uintptr, 0, 6),
 		runJSErrorStats: make(map[string]runJSErrorStat),
+		// Related views share their parent's settings, user agent and fonts
+		// included.
+		userAgent:        parent.userAgent,
+		fontOverride:     parent.fontOverride,
+		gesturesDisabled: parent.gesturesDisabled,
 	}
 
 	wv.id = globalRegistry.register(wv)
 
 	if settings != nil {
 		settings.ApplyToWebView(ctx, inner)
+		wv.reapplyFontOverride()
+		wv.reapplyNavigationGestures()
 	}
 
 	wv.connectSignals()
@@ -428,6 +502,7 @@ func (wv *WebView) connectSignals() {
 	wv.connectFaviconSignal()
 	wv.connectProgressSignal()
 	wv.connectLoadFailedSignal()
+	wv.connectLoadFailedWithTLSErrorsSignal()
 	wv.connectWebProcessResponsiveSignal()
 	wv.connectDecidePolicySignal()
 	wv.connectEnterFullscreenSignal()
@@ -438,6 +513,7 @@ func (wv *WebView) connectSignals() {
 	wv.connectBackForwardListChangedSignal()
 	wv.connectWebProcessTerminatedSignal()
 	wv.connectPermissionRequestSignal()
+	wv.connectScriptDialogSignal()
 	wv.connectContextMenuSignal(wv.contextMenu)
 }
 
@@ -468,6 +544,14 @@ func (wv *WebView) connectLoadChangedSignal() {
 		}
 		wv.mu.Unlock()
 
+		if event == webkit.LoadStartedValue || event == webkit.LoadRedirectedValue {
+			wv.syncUserScripts(uri)
+		}
+
+		if event == webkit.LoadCommittedValue && wv.certificateRejected(uri) {
+			return
+		}
+
 		if wv.OnLoadChanged != nil {
 			wv.OnLoadChanged(LoadEvent(event))
 		}
@@ -476,6 +560,23 @@ func (wv *WebView) connectLoadChangedSignal() {
 	wv.signalIDs = append(wv.signalIDs, uintptr(sigID))
 }
 
+// syncUserScripts swaps the registered page env and user scripts for those
+// matching uri.
+// It runs while the navigation is still provisional so document-start
+// scripts are in place before the new document is created.
+func (wv *WebView) syncUserScripts(uri string) {
+	if wv.injector == nil || wv.ucm == nil {
+		return
+	}
+	for _, script := range wv.userScripts {
+		wv.ucm.RemoveScript(script)
+	}
+	wv.userScripts = wv.injector.addUserScripts(wv.ucm, uri)
+	if len(wv.userScripts) > 0 {
+		wv.logger.Debug().Str("uri", uri).Int("count", len(wv.userScripts)).Msg("page env and user scripts registered")
+	}
+}
+
 func (wv *WebView) connectLoadFailedSignal() {
 	loadFailedCb := func(_ webkit.WebView, event webkit.LoadEvent, failingURI string, gerr *glib.Error) bool {
 		wv.logger.Warn().
@@ -484,6 +585,13 @@ func (wv *WebView) connectLoadFailedSignal() {
 			Int("load_event", int(event)).
 			Str("error", gerr.MessageGo()).
 			Msg("load failed")
+		if wv.OnLoadFailed != nil && gerr != nil {
+			wv.OnLoadFailed(port.LoadFailure{
+				URI:     failingURI,
+				Cause:   loadFailureCause(glib.QuarkToString(gerr.Domain), gerr.Code),
+				Message: gerr.MessageGo(),
+			})
+		}
 		return false
 	}
 	sigID := wv.inner.ConnectLoadFailed(&loadFailedCb)
@@ -681,7 +789,7 @@ func (wv *WebView) connectDecidePolicySignal() {
 			return wv.handleResponsePolicyDecision(decisionPtr)
 		case webkit.PolicyDecisionTypeNavigationActionValue, webkit.PolicyDecisionTypeNewWindowActionValue:
 			// Both navigation and new window actions use NavigationPolicyDecision
-			return wv.handleNavigationPolicyDecision(decisionPtr)
+			return wv.handleNavigationPolicyDecision(decisionPtr, decisionType)
 		default:
 			return false
 		}
@@ -701,7 +809,7 @@ func (wv *WebView) handleResponsePolicyDecision(decisionPtr uintptr) bool {
 		return false
 	}
 
-	if !shouldForceDownload(responseDecision) {
+	if !shouldForceDownload(responseDecision, wv.imageDisposition()) {
 		return false
 	}
 
@@ -751,7 +859,7 @@ func (wv *WebView) handleResponsePolicyDecision(decisionPtr uintptr) bool {
 }
 
 // handleNavigationPolicyDecision handles navigation policy decisions (e.g., middle-click, external schemes).
-func (wv *WebView) handleNavigationPolicyDecision(decisionPtr uintptr) bool {
+func (wv *WebView) handleNavigationPolicyDecision(decisionPtr uintptr, decisionType webkit.PolicyDecisionType) bool {
 	navDecision := webkit.NavigationPolicyDecisionNewFromInternalPtr(decisionPtr)
 	if navDecision == nil {
 		return false
@@ -818,9 +926,15 @@ func (wv *WebView) handleNavigationPolicyDecision(decisionPtr uintptr) bool {
 		return true
 	}
 
+	if decisionType == webkit.PolicyDecisionTypeNavigationActionValue && wv.OnNavigationRequest != nil &&
+		mayTargetMainFrame(navAction) && wv.OnNavigationRequest(linkURI) {
+		navDecision.Ignore()
+		return true
+	}
+
 	// Only handle link clicks for middle-click/ctrl-click (open in new tab)
 	if navAction.GetNavigationType() != webkit.NavigationTypeLinkClickedValue {
-		return false
+		return wv.deferThrottledNavigation(decisionPtr, linkURI)
 	}
 
 	mouseButton := navAction.GetMouseButton()
@@ -829,7 +943,7 @@ func (wv *WebView) handleNavigationPolicyDecision(decisionPtr uintptr) bool {
 	isCtrlClick := mouseButton == 1 && (gdk.ModifierType(modifiers)&gdk.ControlMaskValue) != 0
 
 	if !isMiddleClick && !isCtrlClick {
-		return false
+		return wv.deferThrottledNavigation(decisionPtr, linkURI)
 	}
 
 	wv.logger.Debug().
@@ -845,10 +959,17 @@ func (wv *WebView) handleNavigationPolicyDecision(decisionPtr uintptr) bool {
 		}
 	}
 
-	return false
+	return wv.deferThrottledNavigation(decisionPtr, linkURI)
 }
 
-func shouldForceDownload(responseDecision *webkit.ResponsePolicyDecision) bool {
+// mayTargetMainFrame reports whether action may navigate the main frame.
+// WebKit does not say which frame a navigation targets; the initial load of
+// a subframe is of type Other, without user gesture and not a redirect.
+func mayTargetMainFrame(action *webkit.NavigationAction) bool {
+	return action.GetNavigationType() != webkit.NavigationTypeOtherValue || action.IsUserGesture() || action.IsRedirect()
+}
+
+func shouldForceDownload(responseDecision *webkit.ResponsePolicyDecision, images entity.ImageDisposition) bool {
 	if responseDecision == nil {
 		return false
 	}
@@ -858,7 +979,18 @@ func shouldForceDownload(responseDecision *webkit.ResponsePolicyDecision) bool {
 		return false
 	}
 
-	return downloadutil.ShouldForceDownload(response.GetUri(), response.GetMimeType())
+	mimeType := response.GetMimeType()
+	return downloadutil.ShouldForceDownload(response.GetUri(), mimeType) ||
+		downloadutil.ShouldDownloadImage(mimeType, images)
+}
+
+// imageDisposition returns whether images opened as a page are shown or
+// downloaded, read from the live settings so config reloads apply.
+func (wv *WebView) imageDisposition() entity.ImageDisposition {
+	if wv.settings == nil {
+		return entity.ImageDispositionInline
+	}
+	return wv.settings.current().WebContent.ImageDisposition
 }
 
 func (wv *WebView) connectEnterFullscreenSignal() {
@@ -940,32 +1072,29 @@ func mediaCaptureStateString(state webkit.MediaCaptureState) string {
 
 func (wv *WebView) connectMouseTargetChangedSignal() {
 	mouseTargetCb := func(_ webkit.WebView, hitTestPtr uintptr, _ uint) {
+		hit := newHitTestResult(hitTestPtr)
+		wv.recordMouseTarget(hit)
 		if wv.OnLinkHover == nil {
 			return
 		}
 
-		hitResult := webkit.HitTestResultNewFromInternalPtr(hitTestPtr)
-		if hitResult == nil {
-			wv.OnLinkHover("")
-			return
-		}
-
-		var uri string
-		switch {
-		case hitResult.ContextIsLink():
-			uri = hitResult.GetLinkUri()
-		case hitResult.ContextIsImage():
-			uri = hitResult.GetImageUri()
-		case hitResult.ContextIsMedia():
-			uri = hitResult.GetMediaUri()
-		}
-
-		wv.OnLinkHover(uri)
+		wv.OnLinkHover(hitHoverURI(hit))
 	}
 	sigID := wv.inner.ConnectMouseTargetChanged(&mouseTargetCb)
 	wv.signalIDs = append(wv.signalIDs, uintptr(sigID))
 }
 
+// recordMouseTarget remembers the link of the hit test under the pointer for
+// HoveredLinkURI.
+func (wv *WebView) recordMouseTarget(hit hitTestResult) {
+	wv.hoveredLink = hitLinkURI(hit)
+}
+
+// HoveredLinkURI implements port.HoveredLinkProvider. Main-thread only.
+func (wv *WebView) HoveredLinkURI() string {
+	return wv.hoveredLink
+}
+
 func (wv *WebView) connectBackForwardListChangedSignal() {
 	backForwardList := wv.inner.GetBackForwardList()
 	if backForwardList == nil {
@@ -1161,6 +1290,8 @@ func (wv *WebView) determinePermissionTypes(ctx context.Context, requestPtr uint
 			entity.PermissionMetadataKeyCurrentDomain:    currentDomain,
 		}
 		return classifyPermissionRequestTypes(ctx, requestKind, false, false, false), meta
+	case permissionRequestKindClipboard:
+		return classifyPermissionRequestTypes(ctx, requestKind, false, false, false), nil
 	default:
 		if requestPtr != 0 {
 			typeName := permissionRequestTypeName(ctx, requestPtr)
@@ -1170,7 +1301,7 @@ func (wv *WebView) determinePermissionTypes(ctx context.Context, requestPtr uint
 				wv.logger.Warn().Msg("unknown permission request type")
 			}
 		}
-		// Unknown permission type - could be clipboard, notifications, geolocation, etc.
+		// Unknown permission type - could be notifications, geolocation, etc.
 		// For now, return empty to trigger denial. Future phases will add these types.
 		return nil, nil
 	}
@@ -1183,6 +1314,7 @@ const (
 	permissionRequestKindUserMedia
 	permissionRequestKindDeviceInfo
 	permissionRequestKindWebsiteDataAccess
+	permissionRequestKindClipboard
 )
 
 func detectPermissionRequestKind(ctx context.Context, requestPtr uintptr) permissionRequestKind {
@@ -1201,6 +1333,11 @@ func detectPermissionRequestKind(ctx context.Context, requestPtr uintptr) permis
 			return permissionRequestKindWebsiteDataAccess
 		}
 	}
+	if gtype, ok := safeGLibType(ctx, webkit.ClipboardPermissionRequestGLibType); ok {
+		if isPermissionRequestType(ctx, requestPtr, gtype) {
+			return permissionRequestKindClipboard
+		}
+	}
 	return permissionRequestKindUnknown
 }
 
@@ -1237,6 +1374,8 @@ func classifyPermissionRequestTypes(
 		return []string{"device_info"}
 	case permissionRequestKindWebsiteDataAccess:
 		return []string{"website_data_access"}
+	case permissionRequestKindClipboard:
+		return []string{string(entity.PermissionTypeClipboard)}
 	default:
 		return nil
 	}
@@ -1352,6 +1491,22 @@ func (wv *WebView) IsPlayingAudio() bool {
 	return wv.isPlayingAudio.Load()
 }
 
+// SetAudioMuted implements port.AudioMuteCapable.
+func (wv *WebView) SetAudioMuted(muted bool) {
+	if wv.destroyed.Load() {
+		return
+	}
+	wv.inner.SetIsMuted(muted)
+}
+
+// IsAudioMuted implements port.AudioMuteCapable.
+func (wv *WebView) IsAudioMuted() bool {
+	if wv.destroyed.Load() {
+		return false
+	}
+	return wv.inner.GetIsMuted()
+}
+
 // GetFindController returns the WebKit FindController wrapped in the port interface.
 // The adapter is cached to prevent the Go wrapper from being garbage collected.
 func (wv *WebView) GetFindController() port.FindController {
@@ -1375,11 +1530,38 @@ func (wv *WebView) LoadURI(ctx context.Context, uri string) error {
 		return fmt.Errorf("webview %d is destroyed", wv.id)
 	}
 	wv.navigationActive.Store(true)
-	wv.inner.LoadUri(uri)
+	if request := wv.newExtraHeadersRequest(uri); request != nil {
+		defer request.Unref()
+		wv.inner.LoadRequest(request)
+	} else {
+		wv.inner.LoadUri(uri)
+	}
 	logging.FromContext(ctx).Debug().Str("uri", uri).Msg("loading URI")
 	return nil
 }
 
+// LoadURIBypassCache loads uri with no-cache request headers so the main
+// document is revalidated against the network instead of served from cache.
+func (wv *WebView) LoadURIBypassCache(ctx context.Context, uri string) error {
+	if wv.destroyed.Load() {
+		return fmt.Errorf("webview %d is destroyed", wv.id)
+	}
+	request := webkit.NewURIRequest(uri)
+	if request == nil {
+		return wv.LoadURI(ctx, uri)
+	}
+	defer request.Unref()
+	if headers := request.GetHttpHeaders(); headers != nil {
+		wv.extraHeaders.Apply(true, headers.Replace)
+		headers.Replace("Cache-Control", "no-cache")
+		headers.Replace("Pragma", "no-cache")
+	}
+	wv.navigationActive.Store(true)
+	wv.inner.LoadRequest(request)
+	logging.FromContext(ctx).Debug().Str("uri", uri).Msg("loading URI bypassing cache")
+	return nil
+}
+
 // LoadHTML loads HTML content with an optional base URI.
 func (wv *WebView) LoadHTML(ctx context.Context, content, baseURI string) error {
 	if wv.destroyed.Load() {
@@ -1671,8 +1853,13 @@ func (wv *WebView) SetCallbacks(callbacks *port.WebViewCallbacks) {
 		wv.OnCreate = nil
 		wv.OnLinkHover = nil
 		wv.OnWebProcessTerminated = nil
+		wv.OnLoadFailed = nil
+		wv.OnCertificateCheck = nil
+		wv.OnNavigationRequest = nil
 		wv.OnPermissionRequest = nil
+		wv.OnScriptDialog = nil
 		wv.OnLinkMiddleClick = nil
+		wv.OnSearchSelection = nil
 		wv.OnEnterFullscreen = nil
 		wv.OnLeaveFullscreen = nil
 		wv.OnAudioStateChanged = nil
@@ -1722,8 +1909,13 @@ func (wv *WebView) SetCallbacks(callbacks *port.WebViewCallbacks) {
 	} else {
 		wv.OnWebProcessTerminated = nil
 	}
+	wv.OnLoadFailed = callbacks.OnLoadFailed
+	wv.OnCertificateCheck = callbacks.OnCertificateCheck
+	wv.OnNavigationRequest = callbacks.OnNavigationRequest
 	wv.OnPermissionRequest = callbacks.OnPermissionRequest
+	wv.OnScriptDialog = callbacks.OnScriptDialog
 	wv.OnLinkMiddleClick = callbacks.OnLinkMiddleClick
+	wv.OnSearchSelection = callbacks.OnSearchSelection
 	wv.OnEnterFullscreen = callbacks.OnEnterFullscreen
 	wv.OnLeaveFullscreen = callbacks.OnLeaveFullscreen
 	wv.OnAudioStateChanged = callbacks.OnAudioStateChanged
@@ -1772,6 +1964,209 @@ func (wv *WebView) PrintPage() {
 	}
 }
 
+// ApplyAutoplayPolicy implements port.AutoplayPolicyCapable.
+// The gesture setting covers audible autoplay; the autoplay script also
+// stops muted media under the block policy and tells video from audio
+// under the split policies.
+func (wv *WebView) ApplyAutoplayPolicy(ctx context.Context, policy entity.AutoplayPolicy) {
+	if wv.destroyed.Load() {
+		return
+	}
+	enforcement := media.EnforcementForAutoplayPolicy(policy)
+	if settings := wv.inner.GetSettings(); settings != nil {
+		settings.SetMediaPlaybackRequiresUserGesture(enforcement.RequireUserGesture)
+	}
+	if enforcement.NeedsScript() {
+		wv.RunJavaScript(ctx, webutil.AutoplayScript(enforcement))
+	}
+}
+
+// ApplyCookiePolicy implements port.CookiePolicyCapable. The accept policy
+// belongs to the network session, so every WebView sharing it follows.
+func (wv *WebView) ApplyCookiePolicy(_ context.Context, policy entity.CookiePolicy) bool {
+	if wv.destroyed.Load() {
+		return false
+	}
+	session := wv.inner.GetNetworkSession()
+	if session == nil {
+		return false
+	}
+	cookieManager := session.GetCookieManager()
+	if cookieManager == nil {
+		return false
+	}
+	accept, label := mapCookiePolicy(cookiePolicy(policy))
+	if !sessionCookiePolicies.swap(session.GoPointer(), accept) {
+		return false
+	}
+	cookieManager.SetAcceptPolicy(accept)
+	wv.logger.Debug().Str("cookie_policy", label).Msg("session cookie policy changed")
+	return true
+}
+
+// ApplyUserAgent implements port.UserAgentCapable. The override lives in
+// this WebView's settings, so other panes keep their own user agent; popups
+// opened from it share the settings and so the override.
+func (wv *WebView) ApplyUserAgent(_ context.Context, userAgent string) bool {
+	if wv.destroyed.Load() || userAgent == wv.userAgent {
+		return false
+	}
+	settings := wv.inner.GetSettings()
+	if settings == nil {
+		return false
+	}
+	if userAgent == "" {
+		settings.SetUserAgent(nil)
+	} else {
+		settings.SetUserAgent(&userAgent)
+	}
+	wv.userAgent = userAgent
+	wv.logger.Debug().Str("user_agent", userAgent).Msg("user agent changed")
+	return true
+}
+
+// ApplyFontOverride implements port.FontOverrideCapable. WebKit lays the
+// loaded page out again with the new fonts, so no reload is needed.
+func (wv *WebView) ApplyFontOverride(_ context.Context, fonts entity.FontOverride) bool {
+	fonts.Domain = ""
+	if wv.destroyed.Load() || fonts == wv.fontOverride {
+		return false
+	}
+	if !wv.applyFonts(fonts) {
+		return false
+	}
+	wv.fontOverride = fonts
+	wv.logger.Debug().
+		Str("sans", fonts.SansFont).
+		Str("serif", fonts.SerifFont).
+		Str("mono", fonts.MonospaceFont).
+		Int("size", fonts.DefaultFontSize).
+		Msg("font override changed")
+	return true
+}
+
+// reapplyFontOverride sets the font override again after the settings were
+// reset to the configured fonts.
+func (wv *WebView) reapplyFontOverride() {
+	if wv.destroyed.Load() || wv.fontOverride.KeepsDefaults() {
+		return
+	}
+	wv.applyFonts(wv.fontOverride)
+}
+
+// SetNavigationGesturesEnabled implements port.NavigationGesturesCapable.
+func (wv *WebView) SetNavigationGesturesEnabled(_ context.Context, enabled bool) bool {
+	if wv.destroyed.Load() || enabled == !wv.gesturesDisabled {
+		return false
+	}
+	settings := wv.inner.GetSettings()
+	if settings == nil {
+		return false
+	}
+	settings.SetEnableBackForwardNavigationGestures(enabled)
+	wv.gesturesDisabled = !enabled
+	wv.logger.Debug().Bool("enabled", enabled).Msg("navigation gestures changed")
+	return true
+}
+
+// reapplyNavigationGestures turns the gestures off again after the settings
+// were reset, which turns them on.
+func (wv *WebView) reapplyNavigationGestures() {
+	if wv.destroyed.Load() || !wv.gesturesDisabled {
+		return
+	}
+	if settings := wv.inner.GetSettings(); settings != nil {
+		settings.SetEnableBackForwardNavigationGestures(false)
+	}
+}
+
+// applyFonts sets the configured fonts, overridden by fonts, on the WebView's
+// settings.
+func (wv *WebView) applyFonts(fonts entity.FontOverride) bool {
+	if wv.settings == nil {
+		return false
+	}
+	settings := wv.inner.GetSettings()
+	if settings == nil {
+		return false
+	}
+	applyFontSettings(settings, fontSettingsWithOverride(wv.settings.current().WebContent, fonts))
+	return true
+}
+
+// ApplyColorScheme implements port.ColorSchemeCapable. The loaded page
+// switches right away; a document-start script keeps the scheme for the
+// pages loaded after it until the scheme changes again.
+func (wv *WebView) ApplyColorScheme(ctx context.Context, scheme entity.ColorScheme) {
+	if wv.destroyed.Load() || wv.ucm == nil {
+		return
+	}
+	if !scheme.IsValid() {
+		scheme = entity.ColorSchemeSystem
+	}
+	current := wv.colorScheme
+	if current == "" {
+		current = entity.ColorSchemeSystem
+	}
+	if scheme == current {
+		return
+	}
+	if wv.colorSchemeScript != nil {
+		wv.ucm.RemoveScript(wv.colorSchemeScript)
+		wv.colorSchemeScript = nil
+	}
+	if scheme != entity.ColorSchemeSystem {
+		script := webkit.NewUserScript(
+			webutil.ColorSchemeScript(scheme),
+			webkit.UserContentInjectAllFramesValue,
+			webkit.UserScriptInjectAtDocumentStartValue,
+			nil,
+			nil,
+		)
+		if script == nil {
+			wv.logger.Warn().Msg("failed to create color scheme script")
+		} else {
+			wv.ucm.AddScript(script)
+			wv.colorSchemeScript = script
+		}
+	}
+	wv.colorScheme = scheme
+	wv.RunJavaScript(ctx, webutil.ColorSchemeScript(scheme))
+	wv.logger.Debug().Str("color_scheme", string(scheme)).Msg("color scheme changed")
+}
+
+// ApplyForceDark implements port.ForceDarkCapable. The stylesheet applies to
+// the loaded page right away; the dark color scheme is reported to page
+// scripts only for styles that ask for it.
+func (wv *WebView) ApplyForceDark(ctx context.Context, style entity.ForceDarkStyle, enabled bool) {
+	if wv.destroyed.Load() || wv.ucm == nil {
+		return
+	}
+	if wv.forceDarkSheet != nil {
+		wv.ucm.RemoveStyleSheet(wv.forceDarkSheet)
+		wv.forceDarkSheet = nil
+	}
+	if !enabled {
+		return
+	}
+	sheet := webkit.NewUserStyleSheet(
+		webutil.ForceDarkStyleSheet(style),
+		webkit.UserContentInjectAllFramesValue,
+		webkit.UserStyleLevelUserValue,
+		nil,
+		nil,
+	)
+	if sheet == nil {
+		wv.logger.Warn().Msg("failed to create force dark stylesheet")
+		return
+	}
+	wv.ucm.AddStyleSheet(sheet)
+	wv.forceDarkSheet = sheet
+	if webutil.ForceDarkReportsDarkScheme(style) {
+		wv.RunJavaScript(ctx, webutil.DarkModeScript(true, "__dumber_force_dark"))
+	}
+}
+
 // IsDestroyed returns true if the WebView has been destroyed.
 func (wv *WebView) IsDestroyed() bool {
 	return wv.destroyed.Load()
@@ -1918,12 +2313,17 @@ func (wv *WebView) DestroyWithPolicy(policy string) {
 	wv.OnCreate = nil
 	wv.OnReadyToShow = nil
 	wv.OnLinkMiddleClick = nil
+	wv.OnSearchSelection = nil
 	wv.OnEnterFullscreen = nil
 	wv.OnLeaveFullscreen = nil
 	wv.OnAudioStateChanged = nil
 	wv.OnLinkHover = nil
 	wv.OnWebProcessTerminated = nil
+	wv.OnLoadFailed = nil
+	wv.OnCertificateCheck = nil
+	wv.OnNavigationRequest = nil
 	wv.OnPermissionRequest = nil
+	wv.OnScriptDialog = nil
 
 	// 3. Clear async callback references and popup-hosting state
 	wv.mu.Lock()
@@ -1982,12 +2382,17 @@ func (wv *WebView) ResetForPoolReuse() {
 	wv.OnCreate = nil
 	wv.OnReadyToShow = nil
 	wv.OnLinkMiddleClick = nil
+	wv.OnSearchSelection = nil
 	wv.OnEnterFullscreen = nil
 	wv.OnLeaveFullscreen = nil
 	wv.OnAudioStateChanged = nil
 	wv.OnLinkHover = nil
 	wv.OnWebProcessTerminated = nil
+	wv.OnLoadFailed = nil
+	wv.OnCertificateCheck = nil
+	wv.OnNavigationRequest = nil
 	wv.OnPermissionRequest = nil
+	wv.OnScriptDialog = nil
 
 	wv.mu.Lock()
 	wv.uri = ""
@@ -2007,6 +2412,7 @@ func (wv *WebView) ResetForPoolReuse() {
 	wv.isFullscreen.Store(false)
 	wv.isPlayingAudio.Store(false)
 	wv.navigationActive.Store(false)
+	wv.clearRequestMockScript()
 
 	if wv.inner != nil {
 		wv.inner.StopLoading()
@@ -2291,6 +2697,7 @@ func (wv *WebView) AttachFrontend(ctx context.Context, injector *ContentInjector
 	if injector != nil {
 		log.Debug().Msg("AttachFrontend: injecting scripts")
 		injector.InjectScripts(ctx, wv.ucm, wv.id)
+		wv.injector = injector
 	}
 
 	log.Debug().Msg("frontend assets attached to webview")
@@ -92,6 +92,11 @@ func TestClassifyPermissionRequestTypes(t *testing.T) {
 			kind:     permissionRequestKindWebsiteDataAccess,
 			expected: []string{"website_data_access"},
 		},
+		{
+			name:     "clipboard read request",
+			kind:     permissionRequestKindClipboard,
+			expected: []string{"clipboard"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,93 @@
+package webutil
+
+import (
+	"fmt"
+
+	"github.com/bnema/dumber/internal/domain/media"
+)
+
+// autoplayScriptTemplate applies a per-element rule to media that starts
+// playing without a recent user gesture: "pause" strips autoplay and pauses
+// it, "mute" lets it play muted, "allow" leaves it alone. Re-running the
+// script only replaces the rules, so navigation-time re-injection stays
+// idempotent and picks up the policy of the new page.
+const autoplayScriptTemplate = `(function(rules) {
+  var existing = window.__dumber_autoplay_blocker;
+  if (existing) { existing.rules = rules; return; }
+  var state = { lastGesture: 0, rules: rules };
+  window.__dumber_autoplay_blocker = state;
+  var GESTURE_WINDOW_MS = 1000;
+
+  function markGesture(e) {
+    if (e && e.isTrusted === false) return;
+    state.lastGesture = Date.now();
+  }
+  ['pointerdown', 'keydown', 'touchstart'].forEach(function(type) {
+    window.addEventListener(type, markGesture, true);
+  });
+
+  function hasRecentGesture() {
+    try {
+      if (navigator.userActivation && navigator.userActivation.isActive) return true;
+    } catch (_) {}
+    return Date.now() - state.lastGesture < GESTURE_WINDOW_MS;
+  }
+
+  function ruleFor(el) {
+    return state.rules[el.tagName] || 'allow';
+  }
+
+  function enforce(el) {
+    if (hasRecentGesture()) return;
+    var rule = ruleFor(el);
+    if (rule === 'mute') {
+      el.muted = true;
+    } else if (rule === 'pause') {
+      try { el.pause(); } catch (_) {}
+    }
+  }
+
+  function strip(el) {
+    if (!el || el.__dumberAutoplayChecked) return;
+    el.__dumberAutoplayChecked = true;
+    if (hasRecentGesture()) return;
+    var rule = ruleFor(el);
+    if (el.hasAttribute && el.hasAttribute('autoplay')) {
+      if (rule === 'pause') {
+        el.removeAttribute('autoplay');
+        el.autoplay = false;
+      } else if (rule === 'mute') {
+        el.muted = true;
+      }
+    }
+    if (!el.paused) enforce(el);
+  }
+
+  function scan(root) {
+    if (!root || !root.querySelectorAll) return;
+    root.querySelectorAll('video, audio').forEach(strip);
+  }
+
+  document.addEventListener('play', function(e) {
+    var el = e.target;
+    if (!el || (el.tagName !== 'VIDEO' && el.tagName !== 'AUDIO')) return;
+    enforce(el);
+  }, true);
+
+  new MutationObserver(function(muts) {
+    muts.forEach(function(m) {
+      m.addedNodes.forEach(function(n) {
+        if (n.nodeName === 'VIDEO' || n.nodeName === 'AUDIO') strip(n);
+        scan(n);
+      });
+    });
+  }).observe(document.documentElement || document, { childList: true, subtree: true });
+
+  scan(document);
+})({VIDEO: %q, AUDIO: %q});`
+
+// AutoplayScript returns JS that enforces the video and audio rules of e on
+// media started without a user gesture.
+func AutoplayScript(e media.AutoplayEnforcement) string {
+	return fmt.Sprintf(autoplayScriptTemplate, string(e.Video), string(e.Audio))
+}
@@ -0,0 +1,40 @@
+package webutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/media"
+)
+
+func TestAutoplayScript_StripsAutoplay(t *testing.T) {
+	t.Parallel()
+
+	got := AutoplayScript(media.EnforcementForAutoplayPolicy(entity.AutoplayPolicyBlock))
+	if !strings.Contains(got, "removeAttribute('autoplay')") {
+		t.Fatal("script should strip autoplay attributes")
+	}
+	if !strings.Contains(got, "if (existing) { existing.rules = rules; return; }") {
+		t.Fatal("script should be idempotent")
+	}
+}
+
+func TestAutoplayScript_EmbedsElementRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		policy entity.AutoplayPolicy
+		want   string
+	}{
+		{policy: entity.AutoplayPolicyBlock, want: `({VIDEO: "pause", AUDIO: "pause"});`},
+		{policy: entity.AutoplayPolicyMutedVideo, want: `({VIDEO: "mute", AUDIO: "pause"});`},
+		{policy: entity.AutoplayPolicyBlockVideo, want: `({VIDEO: "pause", AUDIO: "allow"});`},
+	}
+	for _, tt := range tests {
+		got := AutoplayScript(media.EnforcementForAutoplayPolicy(tt.policy))
+		if !strings.HasSuffix(got, tt.want) {
+			t.Fatalf("%s: script should end with %s", tt.policy, tt.want)
+		}
+	}
+}
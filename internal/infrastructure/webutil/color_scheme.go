@@ -0,0 +1,143 @@
+package webutil
+
+import (
+	"fmt"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// setThemeDefinition defines window.__dumber_setTheme(scheme) once per
+// document. A "light" or "dark" scheme is reported to the page through
+// matchMedia, the root color-scheme and the prefers-color-scheme rules of
+// same-origin stylesheets; "system" restores all three. Listeners of
+// prefers-color-scheme queries are told when their answer changes.
+const setThemeDefinition = `(function() {
+  if (typeof window.__dumber_setTheme === 'function') return;
+  var originalMatchMedia = window.matchMedia.bind(window);
+  var current = 'system';
+  var lists = [];
+  var rewritten = [];
+  var featureRe = /\(\s*prefers-color-scheme\s*:\s*(dark|light)\s*\)/gi;
+
+  function queryScheme(query) {
+    if (typeof query !== 'string') return '';
+    var normalized = query.replace(/\s+/g, '').toLowerCase();
+    if (normalized.indexOf('prefers-color-scheme:dark') !== -1) return 'dark';
+    if (normalized.indexOf('prefers-color-scheme:light') !== -1) return 'light';
+    return '';
+  }
+
+  function createList(query, scheme) {
+    var listeners = [];
+    var onchangeHandler = null;
+    var list = {
+      media: query,
+      get matches() {
+        return current === 'system' ? originalMatchMedia(query).matches : current === scheme;
+      },
+      get onchange() { return onchangeHandler; },
+      set onchange(fn) { onchangeHandler = fn; },
+      addListener: function(cb) { if (typeof cb === 'function') listeners.push(cb); },
+      removeListener: function(cb) { var idx = listeners.indexOf(cb); if (idx !== -1) listeners.splice(idx, 1); },
+      addEventListener: function(type, cb) { if (type === 'change' && typeof cb === 'function') listeners.push(cb); },
+      removeEventListener: function(type, cb) {
+        if (type === 'change') { var idx = listeners.indexOf(cb); if (idx !== -1) listeners.splice(idx, 1); }
+      },
+      dispatchEvent: function(event) {
+        for (var i = 0; i < listeners.length; i++) { try { listeners[i].call(list, event); } catch (e) {} }
+        if (onchangeHandler) { try { onchangeHandler.call(list, event); } catch (e) {} }
+        return true;
+      }
+    };
+    lists.push(list);
+    return list;
+  }
+
+  function patchedMatchMedia(query) {
+    var scheme = queryScheme(query);
+    if (scheme) return createList(query, scheme);
+    return originalMatchMedia(query);
+  }
+
+  function rewriteMedia(media) {
+    if (!media || typeof media.mediaText !== 'string' || !queryScheme(media.mediaText)) return;
+    var original = media.mediaText;
+    media.mediaText = original.replace(featureRe, function(_, scheme) {
+      return scheme.toLowerCase() === current ? '(min-width: 0px)' : '(max-width: -1px)';
+    });
+    rewritten.push({ media: media, text: original });
+  }
+
+  function rewriteRules(rules) {
+    if (!rules) return;
+    for (var i = 0; i < rules.length; i++) {
+      var rule = rules[i];
+      if (rule.styleSheet) rewriteSheet(rule.styleSheet);
+      if (rule.media) rewriteMedia(rule.media);
+      if (rule.cssRules) rewriteRules(rule.cssRules);
+    }
+  }
+
+  function rewriteSheet(sheet) {
+    rewriteMedia(sheet.media);
+    var rules;
+    try { rules = sheet.cssRules; } catch (e) { return; }
+    rewriteRules(rules);
+  }
+
+  function restoreRules() {
+    for (var i = rewritten.length - 1; i >= 0; i--) {
+      try { rewritten[i].media.mediaText = rewritten[i].text; } catch (e) {}
+    }
+    rewritten = [];
+  }
+
+  function applyToDocument() {
+    restoreRules();
+    var root = document.documentElement;
+    if (root) root.style.colorScheme = current === 'system' ? '' : current;
+    if (current === 'system') return;
+    var sheets = document.styleSheets || [];
+    for (var i = 0; i < sheets.length; i++) rewriteSheet(sheets[i]);
+  }
+
+  window.__dumber_setTheme = function(scheme) {
+    if (scheme !== 'light' && scheme !== 'dark') scheme = 'system';
+    if (scheme === current) return;
+    var before = lists.map(function(list) { return list.matches; });
+    current = scheme;
+    window.__dumber_color_scheme = scheme;
+    window.matchMedia = scheme === 'system' ? originalMatchMedia : patchedMatchMedia;
+    applyToDocument();
+    lists.forEach(function(list, i) {
+      if (list.matches === before[i]) return;
+      var event;
+      try {
+        event = new MediaQueryListEvent('change', { matches: list.matches, media: list.media });
+      } catch (e) {
+        event = { type: 'change', matches: list.matches, media: list.media };
+      }
+      list.dispatchEvent(event);
+    });
+  };
+
+  // Stylesheets of the document load after document start.
+  document.addEventListener('DOMContentLoaded', function() { if (current !== 'system') applyToDocument(); });
+  window.addEventListener('load', function() { if (current !== 'system') applyToDocument(); });
+})();
+`
+
+// SetThemeCall returns the JS call that switches the page to scheme. Unknown
+// schemes follow the system.
+func SetThemeCall(scheme entity.ColorScheme) string {
+	if !scheme.IsValid() {
+		scheme = entity.ColorSchemeSystem
+	}
+	return fmt.Sprintf("window.__dumber_setTheme(%q);", string(scheme))
+}
+
+// ColorSchemeScript returns JS that defines window.__dumber_setTheme, unless
+// the page already has it, and switches the page to scheme.
+func ColorSchemeScript(scheme entity.ColorScheme) string {
+	return setThemeDefinition + SetThemeCall(scheme)
+}
@@ -0,0 +1,36 @@
+package webutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestSetThemeCall(t *testing.T) {
+	tests := map[entity.ColorScheme]string{
+		entity.ColorSchemeDark:   `window.__dumber_setTheme("dark");`,
+		entity.ColorSchemeLight:  `window.__dumber_setTheme("light");`,
+		entity.ColorSchemeSystem: `window.__dumber_setTheme("system");`,
+		"":                       `window.__dumber_setTheme("system");`,
+		"sepia');alert(1);//":    `window.__dumber_setTheme("system");`,
+	}
+	for scheme, want := range tests {
+		if got := SetThemeCall(scheme); got != want {
+			t.Errorf("SetThemeCall(%q) = %s, want %s", scheme, got, want)
+		}
+	}
+}
+
+func TestColorSchemeScript(t *testing.T) {
+	script := ColorSchemeScript(entity.ColorSchemeDark)
+	if !strings.HasSuffix(script, `window.__dumber_setTheme("dark");`) {
+		t.Error("script must end by applying the requested scheme")
+	}
+	if !strings.Contains(script, "typeof window.__dumber_setTheme === 'function') return") {
+		t.Error("script must not redefine __dumber_setTheme on a page that has it")
+	}
+	if !strings.Contains(script, "root.style.colorScheme") {
+		t.Error("script must set the root color scheme for form controls and scrollbars")
+	}
+}
@@ -0,0 +1,59 @@
+package webutil
+
+import "github.com/bnema/dumber/internal/domain/entity"
+
+// forceDarkInvertCSS inverts the whole page and inverts media back, so
+// photos, video and embedded frames keep their colors. The white root
+// background keeps transparent pages from inverting to black-on-black.
+const forceDarkInvertCSS = `html {
+  filter: invert(1) hue-rotate(180deg) !important;
+  background-color: #fff !important;
+}
+img, picture, video, canvas, iframe, embed, object, svg image,
+[style*="background-image"] {
+  filter: invert(1) hue-rotate(180deg) !important;
+}`
+
+// forceDarkCuratedCSS recolors common elements to a dark palette and reports
+// a dark color scheme, so form controls and scrollbars follow.
+const forceDarkCuratedCSS = `:root {
+  color-scheme: dark !important;
+}
+html, body {
+  background-color: #18181b !important;
+  color: #e4e4e7 !important;
+}
+body *:not(img):not(video):not(canvas):not(svg):not(svg *) {
+  background-color: transparent !important;
+  border-color: #3f3f46 !important;
+  color: inherit !important;
+}
+input, textarea, select, button {
+  background-color: #27272a !important;
+  color: #e4e4e7 !important;
+}
+a, a * {
+  color: #8ab4f8 !important;
+}
+a:visited, a:visited * {
+  color: #c58af9 !important;
+}
+pre, code, kbd, samp {
+  background-color: #27272a !important;
+}`
+
+// ForceDarkStyleSheet returns the user stylesheet that darkens a page for
+// style. Unknown and empty styles fall back to the invert stylesheet.
+func ForceDarkStyleSheet(style entity.ForceDarkStyle) string {
+	if style == entity.ForceDarkStyleCSS {
+		return forceDarkCuratedCSS
+	}
+	return forceDarkInvertCSS
+}
+
+// ForceDarkReportsDarkScheme reports whether style should also tell page
+// scripts that dark mode is preferred. The invert style must not: a page
+// that switches itself to dark would be inverted back to light.
+func ForceDarkReportsDarkScheme(style entity.ForceDarkStyle) bool {
+	return style == entity.ForceDarkStyleCSS
+}
@@ -0,0 +1,41 @@
+package webutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestForceDarkStyleSheet(t *testing.T) {
+	tests := []struct {
+		name        string
+		style       entity.ForceDarkStyle
+		want        string
+		reportsDark bool
+	}{
+		{name: "invert", style: entity.ForceDarkStyleInvert, want: forceDarkInvertCSS},
+		{name: "curated css", style: entity.ForceDarkStyleCSS, want: forceDarkCuratedCSS, reportsDark: true},
+		{name: "empty falls back to invert", style: "", want: forceDarkInvertCSS},
+		{name: "unknown falls back to invert", style: "sepia", want: forceDarkInvertCSS},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ForceDarkStyleSheet(tt.style); got != tt.want {
+				t.Errorf("ForceDarkStyleSheet(%q) returned the wrong stylesheet", tt.style)
+			}
+			if got := ForceDarkReportsDarkScheme(tt.style); got != tt.reportsDark {
+				t.Errorf("ForceDarkReportsDarkScheme(%q) = %v, want %v", tt.style, got, tt.reportsDark)
+			}
+		})
+	}
+}
+
+func TestForceDarkStyleSheet_Content(t *testing.T) {
+	if invert := ForceDarkStyleSheet(entity.ForceDarkStyleInvert); strings.Contains(invert, "color-scheme") {
+		t.Error("invert stylesheet must not set a dark color scheme; it would be inverted back to light")
+	}
+	if css := ForceDarkStyleSheet(entity.ForceDarkStyleCSS); !strings.Contains(css, "color-scheme: dark") {
+		t.Error("curated stylesheet must set a dark color scheme")
+	}
+}
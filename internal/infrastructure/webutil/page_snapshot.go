@@ -0,0 +1,89 @@
+package webutil
+
+// PageSnapshotFunctionBody is the body of an async JS function that returns
+// the current document serialized as a single self-contained HTML string.
+// Same-origin and CORS-enabled stylesheets are inlined as <style> elements,
+// images are inlined as data URIs, and every remaining link is made absolute
+// so the saved file still resolves what could not be embedded. Scripts are
+// dropped because they would re-run against the frozen snapshot.
+const PageSnapshotFunctionBody = `
+const MAX_INLINE_BYTES = 8 * 1024 * 1024;
+const doc = document.documentElement.cloneNode(true);
+const base = document.baseURI;
+
+function abs(value) {
+  try { return new URL(value, base).href; } catch (_) { return value; }
+}
+
+function toDataURL(blob) {
+  return new Promise(function(resolve, reject) {
+    const reader = new FileReader();
+    reader.onload = function() { resolve(reader.result); };
+    reader.onerror = function() { reject(reader.error); };
+    reader.readAsDataURL(blob);
+  });
+}
+
+async function fetchBlob(url) {
+  const resp = await fetch(url, { credentials: 'same-origin' });
+  if (!resp.ok) throw new Error('HTTP ' + resp.status);
+  const blob = await resp.blob();
+  if (blob.size > MAX_INLINE_BYTES) throw new Error('too large');
+  return blob;
+}
+
+doc.querySelectorAll('script, noscript, base').forEach(function(el) { el.remove(); });
+
+const live = Array.from(document.styleSheets);
+const links = Array.from(doc.querySelectorAll('link[rel~="stylesheet"][href]'));
+await Promise.all(links.map(async function(link) {
+  const href = abs(link.getAttribute('href'));
+  let css = null;
+  const sheet = live.find(function(s) { return s.href === href; });
+  try {
+    if (sheet) css = Array.from(sheet.cssRules).map(function(r) { return r.cssText; }).join('\n');
+  } catch (_) {}
+  if (css === null) {
+    try { css = await (await fetchBlob(href)).text(); } catch (_) {}
+  }
+  if (css === null) { link.setAttribute('href', href); return; }
+  const style = document.createElement('style');
+  if (link.media) style.media = link.media;
+  style.textContent = '/* ' + href.replace(/\*\//g, '') + ' */\n' + css;
+  link.replaceWith(style);
+}));
+
+await Promise.all(Array.from(doc.querySelectorAll('img[src]')).map(async function(img) {
+  const src = abs(img.getAttribute('src'));
+  img.removeAttribute('srcset');
+  img.removeAttribute('loading');
+  if (src.startsWith('data:')) return;
+  try {
+    img.setAttribute('src', await toDataURL(await fetchBlob(src)));
+  } catch (_) {
+    img.setAttribute('src', src);
+  }
+}));
+
+['href', 'src', 'action', 'poster'].forEach(function(attr) {
+  doc.querySelectorAll('[' + attr + ']').forEach(function(el) {
+    const value = el.getAttribute(attr);
+    if (!value || value.startsWith('data:') || value.startsWith('#') || value.startsWith('javascript:')) return;
+    el.setAttribute(attr, abs(value));
+  });
+});
+
+let head = doc.querySelector('head');
+if (!head) { head = document.createElement('head'); doc.insertBefore(head, doc.firstChild); }
+const baseEl = document.createElement('base');
+baseEl.href = base;
+head.insertBefore(baseEl, head.firstChild);
+if (!head.querySelector('meta[charset]')) {
+  const meta = document.createElement('meta');
+  meta.setAttribute('charset', 'utf-8');
+  head.insertBefore(meta, head.firstChild);
+}
+
+const doctype = document.doctype ? new XMLSerializer().serializeToString(document.doctype) : '<!DOCTYPE html>';
+return doctype + '\n' + doc.outerHTML;
+`
@@ -0,0 +1,64 @@
+package webutil
+
+import "fmt"
+
+// renderFreezeScriptTemplate freezes or thaws the page's rendering work.
+// While frozen, requestAnimationFrame callbacks are held back instead of
+// scheduled (so animation loops stop after their current frame) and CSS
+// animations are paused; thawing schedules every held callback for the next
+// frame. Held callbacks get negative IDs, which native IDs never use, so
+// cancelAnimationFrame keeps working for both. Re-running the script only
+// switches the state, so it is safe to inject again after a navigation.
+const renderFreezeScriptTemplate = `(function(frozen) {
+  var state = window.__dumber_render_freeze;
+  if (!state) {
+    state = {
+      frozen: false,
+      held: [],
+      nextID: 1,
+      style: null,
+      request: window.requestAnimationFrame.bind(window),
+      cancel: window.cancelAnimationFrame.bind(window)
+    };
+    window.__dumber_render_freeze = state;
+    window.requestAnimationFrame = function(cb) {
+      if (!state.frozen) return state.request(cb);
+      for (var i = 0; i < state.held.length; i++) {
+        if (state.held[i].cb === cb) return state.held[i].id;
+      }
+      var id = -(state.nextID++);
+      state.held.push({ id: id, cb: cb });
+      return id;
+    };
+    window.cancelAnimationFrame = function(id) {
+      if (id < 0) {
+        state.held = state.held.filter(function(h) { return h.id !== id; });
+        return;
+      }
+      state.cancel(id);
+    };
+  }
+  if (state.frozen === frozen) return;
+  state.frozen = frozen;
+
+  if (frozen) {
+    var style = document.createElement('style');
+    style.textContent = '*, *::before, *::after { animation-play-state: paused !important; }';
+    (document.head || document.documentElement).appendChild(style);
+    state.style = style;
+    return;
+  }
+  if (state.style) {
+    state.style.remove();
+    state.style = null;
+  }
+  var held = state.held;
+  state.held = [];
+  held.forEach(function(h) { state.request(h.cb); });
+})(%t);`
+
+// RenderFreezeScript returns JS that freezes the page's rendering work when
+// frozen is true and thaws it otherwise.
+func RenderFreezeScript(frozen bool) string {
+	return fmt.Sprintf(renderFreezeScriptTemplate, frozen)
+}
@@ -0,0 +1,29 @@
+package webutil
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderFreezeScript_EmbedsState(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderFreezeScript(true); !strings.HasSuffix(got, "})(true);") {
+		t.Fatal("freeze script should pass frozen=true")
+	}
+	if got := RenderFreezeScript(false); !strings.HasSuffix(got, "})(false);") {
+		t.Fatal("thaw script should pass frozen=false")
+	}
+}
+
+func TestRenderFreezeScript_IsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	got := RenderFreezeScript(true)
+	if !strings.Contains(got, "if (state.frozen === frozen) return;") {
+		t.Fatal("re-running the script in the same state should do nothing")
+	}
+	if !strings.Contains(got, "if (!state) {") {
+		t.Fatal("requestAnimationFrame should be wrapped only once per document")
+	}
+}
@@ -0,0 +1,119 @@
+// Package windowstate persists browser window geometry in the state directory.
+package windowstate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+const (
+	// FileName is the geometry file inside the state directory.
+	FileName = "window.json"
+
+	fileVersion = 1
+	dirPerm     = 0o755
+	filePerm    = 0o644
+)
+
+var _ port.WindowGeometryStore = (*Store)(nil)
+
+// Store keeps the window geometry in a small JSON file.
+type Store struct {
+	path string
+}
+
+// NewStore returns a store writing to FileName inside stateDir.
+func NewStore(stateDir string) *Store {
+	return &Store{path: filepath.Join(stateDir, FileName)}
+}
+
+// geometryFile is the on-disk format. Version lets a future format change
+// ignore files it can't read instead of restoring garbage.
+type geometryFile struct {
+	Version   int  `json:"version"`
+	Width     int  `json:"width"`
+	Height    int  `json:"height"`
+	Maximized bool `json:"maximized"`
+}
+
+// Load implements port.WindowGeometryStore.
+func (s *Store) Load(_ context.Context) (entity.WindowGeometry, bool, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return entity.WindowGeometry{}, false, nil
+	}
+	if err != nil {
+		return entity.WindowGeometry{}, false, fmt.Errorf("read window geometry: %w", err)
+	}
+	return decodeGeometry(data)
+}
+
+// Save implements port.WindowGeometryStore. The file is replaced atomically
+// so a crash mid-write keeps the previous geometry.
+func (s *Store) Save(_ context.Context, geometry entity.WindowGeometry) error {
+	data, err := encodeGeometry(geometry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), dirPerm); err != nil {
+		return fmt.Errorf("create state dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), "."+FileName+"-*.tmp")
+	if err != nil {
+		return fmt.Errorf("save window geometry: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer func() { _ = os.Remove(tmpName) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("save window geometry: %w", err)
+	}
+	if err := tmp.Chmod(filePerm); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("save window geometry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("save window geometry: %w", err)
+	}
+	if err := os.Rename(tmpName, s.path); err != nil {
+		return fmt.Errorf("save window geometry: %w", err)
+	}
+	return nil
+}
+
+func encodeGeometry(geometry entity.WindowGeometry) ([]byte, error) {
+	data, err := json.MarshalIndent(geometryFile{
+		Version:   fileVersion,
+		Width:     geometry.Width,
+		Height:    geometry.Height,
+		Maximized: geometry.Maximized,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode window geometry: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// decodeGeometry parses a geometry file. Files from another version are
+// treated as absent; malformed or undersized geometry is an error.
+func decodeGeometry(data []byte) (entity.WindowGeometry, bool, error) {
+	var file geometryFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return entity.WindowGeometry{}, false, fmt.Errorf("decode window geometry: %w", err)
+	}
+	if file.Version != fileVersion {
+		return entity.WindowGeometry{}, false, nil
+	}
+	geometry := entity.WindowGeometry{Width: file.Width, Height: file.Height, Maximized: file.Maximized}
+	if !geometry.Valid() {
+		return entity.WindowGeometry{}, false, fmt.Errorf("invalid window geometry %dx%d", file.Width, file.Height)
+	}
+	return geometry, true, nil
+}
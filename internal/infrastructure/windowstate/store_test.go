@@ -0,0 +1,73 @@
+package windowstate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func TestStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "state")
+	store := NewStore(dir)
+
+	_, ok, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.False(t, ok, "missing file means no saved geometry")
+
+	want := entity.WindowGeometry{Width: 1440, Height: 900, Maximized: true}
+	require.NoError(t, store.Save(ctx, want))
+
+	got, ok, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, want, got)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files must not be left behind")
+}
+
+func TestEncodeGeometry(t *testing.T) {
+	data, err := encodeGeometry(entity.WindowGeometry{Width: 1280, Height: 800})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"version":1,"width":1280,"height":800,"maximized":false}`, string(data))
+}
+
+func TestDecodeGeometry(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    entity.WindowGeometry
+		ok      bool
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: `{"version":1,"width":1600,"height":1000,"maximized":true}`,
+			want: entity.WindowGeometry{Width: 1600, Height: 1000, Maximized: true},
+			ok:   true,
+		},
+		{name: "other version", data: `{"version":2,"width":1600,"height":1000}`},
+		{name: "malformed", data: `{"version":1,`, wantErr: true},
+		{name: "too small", data: `{"version":1,"width":10,"height":10}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := decodeGeometry([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Package pathutil holds path helpers shared by the UI and infrastructure
+// layers.
+package pathutil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExpandPath expands environment variables, leading ~, and cleans the path.
+// It fails when the path starts with ~ and the home directory is unknown,
+// rather than returning the literal ~ path.
+func ExpandPath(path string) (string, error) {
+	expanded := os.ExpandEnv(strings.TrimSpace(path))
+	if expanded == "" {
+		return "", nil
+	}
+	if expanded == "~" || strings.HasPrefix(expanded, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("expand home directory: %w", err)
+		}
+		if expanded == "~" {
+			expanded = home
+		} else {
+			expanded = filepath.Join(home, expanded[2:])
+		}
+	}
+	return filepath.Clean(expanded), nil
+}
@@ -0,0 +1,37 @@
+package pathutil
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandPath(t *testing.T) {
+	t.Setenv("PATHUTIL_EXPAND_TEST", "theme.json")
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	tests := map[string]string{
+		"~/$PATHUTIL_EXPAND_TEST":        filepath.Join(home, "theme.json"),
+		"~":                              home,
+		" /srv/walls/../img.png ":        "/srv/img.png",
+		"":                               "",
+		"relative/$PATHUTIL_EXPAND_TEST": "relative/theme.json",
+	}
+	for in, want := range tests {
+		got, err := ExpandPath(in)
+		if err != nil {
+			t.Fatalf("ExpandPath(%q) error = %v", in, err)
+		}
+		if got != want {
+			t.Fatalf("ExpandPath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestExpandPathFailsWithoutHome(t *testing.T) {
+	t.Setenv("HOME", "")
+
+	if got, err := ExpandPath("~/wall.png"); err == nil {
+		t.Fatalf("ExpandPath() = %q, want an error when the home directory is unknown", got)
+	}
+}
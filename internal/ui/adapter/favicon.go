@@ -32,11 +32,15 @@ type FaviconAdapter struct {
 	internalDomain     string
 	normalizedIconSize int
 	getLogoBytes       func() []byte
+	monogramFallback   func() bool
 	disabled           bool
 }
 
 const disableFaviconsEnv = "DUMBER_DISABLE_FAVICONS"
 
+// monogramTextureKeyPrefix keeps monogram textures out of the favicon key space.
+const monogramTextureKeyPrefix = "monogram:"
+
 type warningLogFunc func(log *zerolog.Logger, err error)
 
 type textureResult struct {
@@ -54,6 +58,9 @@ type FaviconAdapterConfig struct {
 	NormalizedIconSize int
 	// GetLogoBytes returns the raw bytes of the app logo (nil = skip logo favicon).
 	GetLogoBytes func() []byte
+	// MonogramFallback reports whether sites without a favicon get a generated
+	// monogram (nil = never).
+	MonogramFallback func() bool
 }
 
 // NewFaviconAdapter creates a new FaviconAdapter.
@@ -79,6 +86,7 @@ func NewFaviconAdapterWithResolver(
 		internalDomain:     cfg.InternalDomain,
 		normalizedIconSize: cfg.NormalizedIconSize,
 		getLogoBytes:       cfg.GetLogoBytes,
+		monogramFallback:   cfg.MonogramFallback,
 		disabled:           disabled,
 	}
 }
@@ -211,6 +219,42 @@ func (a *FaviconAdapter) GetOrFetch(ctx context.Context, pageURL string, callbac
 	a.fetchViaService(ctx, domain, callback)
 }
 
+// GetOrFetchWithFallback behaves like GetOrFetch but substitutes a generated
+// monogram when no favicon is found and the monogram fallback is enabled.
+func (a *FaviconAdapter) GetOrFetchWithFallback(ctx context.Context, pageURL string, callback func(*gdk.Texture)) {
+	if callback == nil {
+		return
+	}
+	a.GetOrFetch(ctx, pageURL, func(texture *gdk.Texture) {
+		if texture == nil {
+			texture = a.FallbackTexture(ctx, pageURL)
+		}
+		callback(texture)
+	})
+}
+
+// FallbackTexture returns the monogram texture for pageURL, or nil when the
+// monogram fallback is disabled or the URL has no host. Monogram textures are
+// cached apart from real favicons so they never shadow one.
+func (a *FaviconAdapter) FallbackTexture(ctx context.Context, pageURL string) *gdk.Texture {
+	if a.disabled || a.service == nil || a.monogramFallback == nil || !a.monogramFallback() {
+		return nil
+	}
+	domain := domainurl.ExtractDomain(pageURL)
+	if domain == "" {
+		return nil
+	}
+	key := monogramTextureKeyPrefix + domain
+	if texture := a.GetTexture(key); texture != nil {
+		return texture
+	}
+	texture := a.textureFromBytesOnGTK(ctx, a.service.GetOrGenerate(ctx, pageURL))
+	if texture != nil {
+		a.setTexture(key, texture)
+	}
+	return texture
+}
+
 func (a *FaviconAdapter) invokeCallbackOnGTK(ctx context.Context, domain string, callback func(*gdk.Texture), texture *gdk.Texture) {
 	if callback == nil {
 		return
@@ -13,9 +13,12 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/application/usecase"
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/httpsupgrade"
+	"github.com/bnema/dumber/internal/domain/panickey"
 	urlutil "github.com/bnema/dumber/internal/domain/url"
 	"github.com/bnema/dumber/internal/shared/syncdispatch"
 
@@ -132,6 +135,7 @@ type App struct {
 	faviconAdapter *adapter.FaviconAdapter
 
 	snapshotService port.SnapshotService
+	windowGeometry  *windowGeometryKeeper
 
 	// Update management
 	updateCoord *coordinator.UpdateCoordinator
@@ -145,14 +149,27 @@ type App struct {
 
 	movePaneToTabUC        *usecase.MovePaneToTabUseCase
 	extractPaneToTabListUC *usecase.ExtractPaneToTabListUseCase
+	reloadOnFocusUC        *usecase.ReloadOnFocusUseCase
+	renderFreezeUC         *usecase.RenderFreezeUseCase
+	closedTabsUC           *usecase.ClosedTabsUseCase
+	notificationsUC        *usecase.NotificationsUseCase
+	navTreeUC              *usecase.NavigationTreeUseCase
 
 	// Accent picker for dead keys support
 	accentFocusProvider port.FocusedInputProvider
 
+	// Panic key PIN lock shared by every browser window
+	panicGate panickey.Gate
+
 	// Deferred initialization - runs after first load_started to avoid blocking initial navigation
 	deferredInitOnce sync.Once
 	deferredInitFn   func()
 
+	// focusMuteTimer mutes every pane once no browser window is left with the
+	// focus; see media.mute_on_focus_loss.
+	focusMuteTimer uint
+	focusMuteCb    glib.SourceFunc
+
 	// lifecycle
 	cancel                   context.CancelCauseFunc
 	browserLaunchRelayOnce   sync.Once
@@ -200,6 +217,7 @@ func New(deps *Dependencies) (*App, error) {
 		windowForTab:     make(map[entity.TabID]*browserWindow),
 		floatingSessions: make(map[floatingSessionKey]*floatingWorkspaceSession),
 		browserWindows:   make(map[string]*browserWindow),
+		windowGeometry:   newWindowGeometryKeeper(deps.WindowGeometryStore),
 		dispatchOnMainThread: func(label string, fn func()) syncdispatch.SyncDispatchResult {
 			if fn != nil {
 				fn()
@@ -226,6 +244,14 @@ func New(deps *Dependencies) (*App, error) {
 			glib.IdleAdd(&cb, 0)
 		})
 	}
+	// Navigation trees for dumb://navtree; saved trees are read once here so
+	// they are listed as soon as the page opens.
+	app.navTreeUC = usecase.NewNavigationTreeUseCase(deps.NavigationTreeStore, func() bool {
+		return app.runtimeConfigSnapshot().UI.History.PersistNavigationTree
+	})
+	if err := app.navTreeUC.Load(ctx); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msg("failed to restore navigation trees")
+	}
 	historyChangeSink := newHistoryChangeAdapter(app)
 	if deps.HistoryRecorderUC != nil {
 		deps.HistoryRecorderUC.SetHistoryChangeSink(historyChangeSink)
@@ -261,6 +287,53 @@ func New(deps *Dependencies) (*App, error) {
 			})
 			glib.IdleAdd(&cb, 0)
 		},
+		HomepageDashboard: func() dto.HomepageDashboardSettings {
+			ui := app.runtimeConfigSnapshot().UI
+			return dto.HomepageDashboardSettings{Homepage: ui.Homepage, SearchURL: ui.DefaultSearchEngine}
+		},
+		ConsoleCapture: usecase.NewCaptureConsoleUseCase(func() int {
+			return app.runtimeConfigSnapshot().EngineSettings.WebContent.ConsoleBufferSize
+		}),
+		NavigationTrees: app.navTreeUC,
+		Notes:           notesService(deps.NotesUC),
+		OnTypeToFind: func(ctx context.Context, webViewID port.WebViewID, text string) {
+			cb := glib.SourceFunc(func(_ uintptr) bool {
+				app.typeToFind(ctx, webViewID, text)
+				return false
+			})
+			glib.IdleAdd(&cb, 0)
+		},
+		OnFormDirty: func(_ context.Context, webViewID port.WebViewID, dirty bool) {
+			cb := glib.SourceFunc(func(_ uintptr) bool {
+				if app.contentCoord != nil {
+					app.contentCoord.SetFormDirty(webViewID, dirty)
+				}
+				return false
+			})
+			glib.IdleAdd(&cb, 0)
+		},
+		OnReadingProgress: func(_ context.Context, webViewID port.WebViewID, percent float64) {
+			cb := glib.SourceFunc(func(_ uintptr) bool {
+				if !app.runtimeConfigSnapshot().UI.Appearance.ReadingProgress {
+					// Pages loaded before the option was turned off still report.
+					percent = 0
+				}
+				if app.contentCoord != nil {
+					app.contentCoord.SetReadingProgress(webViewID, percent)
+				}
+				return false
+			})
+			glib.IdleAdd(&cb, 0)
+		},
+		OnPageLanguage: func(ctx context.Context, webViewID port.WebViewID, lang string) {
+			cb := glib.SourceFunc(func(_ uintptr) bool {
+				if app.contentCoord != nil {
+					app.contentCoord.SetPageLanguage(ctx, webViewID, lang)
+				}
+				return false
+			})
+			glib.IdleAdd(&cb, 0)
+		},
 		HandlerDeps: deps.HandlerDeps,
 	}); err != nil {
 		cancel(err)
@@ -449,12 +522,13 @@ func (a *App) prewarmWebViewPoolAsync(ctx context.Context) {
 }
 
 func (a *App) initBrowserWindowOverlays(mainWindow *window.MainWindow, browserWindow *browserWindow, runtimeCfg entity.RuntimeUIConfig) {
+	uiScale := runtimeCfg.DefaultUIScale
+	if uiScale == 0 {
+		uiScale = 1.0
+	}
+
 	// Create permission popup and dialog presenter
 	if a.deps != nil && a.deps.PermissionUC != nil {
-		uiScale := runtimeCfg.DefaultUIScale
-		if uiScale == 0 {
-			uiScale = 1.0
-		}
 		permPopup := component.NewPermissionPopup(nil, uiScale)
 		if permPopup != nil {
 			// Add popup to the main window's content overlay
@@ -465,6 +539,14 @@ func (a *App) initBrowserWindowOverlays(mainWindow *window.MainWindow, browserWi
 		}
 	}
 
+	// Create the JavaScript dialog popup and presenter
+	if scriptPopup := component.NewScriptDialogPopup(nil, uiScale); scriptPopup != nil {
+		if w := scriptPopup.Widget(); w != nil {
+			mainWindow.AddOverlay(w)
+		}
+		browserWindow.scriptDialog = dialog.NewScriptDialog(scriptPopup)
+	}
+
 	// Create top-right WebRTC permission activity indicator.
 	indicator := component.NewWebRTCPermissionIndicator()
 	if indicator != nil {
@@ -589,6 +671,7 @@ func (a *App) showToastOnBrowserWindow(
 		return
 	}
 	bw.appToaster.Show(ctx, message, level, opts...)
+	a.recordToast("", message, level)
 }
 
 func (a *App) showToastOnLastFocusedBrowserWindow(
@@ -655,12 +738,11 @@ func (a *App) updateBrowserWindowTabBarVisibility(bw *browserWindow) {
 	if bw == nil || bw.mainWindow == nil || bw.mainWindow.TabBar() == nil {
 		return
 	}
-	if !a.runtimeConfigSnapshot().UI.Workspace.HideTabBarWhenSingleTab {
-		bw.mainWindow.TabBar().SetAutoHidden(false)
-		bw.mainWindow.SetTabBarContentInsetVisible(true)
-		return
-	}
-	shouldShow := bw.mainWindow.TabBar().Count() > 1
+	workspace := a.runtimeConfigSnapshot().UI.Workspace
+	tabModeActive := a.tabCoord != nil && a.tabCoord.TabModeActive()
+	shouldShow := component.TabBarShown(
+		workspace.TabBarMode, workspace.HideTabBarWhenSingleTab, bw.mainWindow.TabBar().Count(), tabModeActive,
+	)
 	bw.mainWindow.TabBar().SetAutoHidden(!shouldShow)
 	bw.mainWindow.SetTabBarContentInsetVisible(shouldShow)
 }
@@ -701,10 +783,14 @@ func (a *App) activateBrowserWindow(bw *browserWindow) {
 }
 
 func (a *App) handleBrowserWindowActivationChanged(bw *browserWindow, active bool) {
+	a.handleFocusMute(active)
 	if !active || bw == nil {
 		return
 	}
 	a.activateBrowserWindow(bw)
+	if bw.lockScreen != nil && bw.lockScreen.IsVisible() {
+		bw.lockScreen.Focus()
+	}
 }
 
 func (a *App) wireBrowserWindowActivationTracking(bw *browserWindow) {
@@ -861,6 +947,9 @@ func (a *App) initDownloadHandler(ctx context.Context) {
 
 	// Create use case for preparing download destinations with file deduplication.
 	prepareDownloadUC := usecase.NewPrepareDownloadUseCase(a.deps.FileSystem)
+	prepareDownloadUC.SetFilenameTemplateProvider(func() string {
+		return a.runtimeConfigSnapshot().UI.Downloads.FilenameTemplate
+	})
 
 	if err := a.engine.ConfigureDownloads(ctx, downloadPath, eventAdapter, prepareDownloadUC); err != nil {
 		log.Error().Err(err).Msg("failed to configure downloads")
@@ -962,7 +1051,7 @@ func (d *downloadEventAdapter) toastSpecForEvent(event port.DownloadEvent) downl
 	case port.DownloadEventFinished:
 		delete(d.active, key)
 		return downloadToastSpec{
-			message:  "Download complete: " + state.filename,
+			message:  "Download complete: " + downloadDisplayPath(event.Destination, state.filename),
 			level:    component.ToastSuccess,
 			duration: downloadToastTerminalDuration,
 			show:     true,
@@ -980,6 +1069,21 @@ func (d *downloadEventAdapter) toastSpecForEvent(event port.DownloadEvent) downl
 	}
 }
 
+// downloadDisplayPath returns the resolved destination with the home directory
+// shortened to "~", or fallback when the destination is unknown.
+func downloadDisplayPath(destination, fallback string) string {
+	destination = strings.TrimPrefix(destination, "file://")
+	if destination == "" {
+		return fallback
+	}
+	if home, err := os.UserHomeDir(); err == nil && home != "" {
+		if rel, ok := strings.CutPrefix(destination, home+string(filepath.Separator)); ok {
+			return filepath.Join("~", rel)
+		}
+	}
+	return destination
+}
+
 func downloadToastKey(event port.DownloadEvent) string {
 	if event.Destination != "" {
 		return event.Destination
@@ -1065,6 +1169,12 @@ func (a *App) initBrowserWindowInput(ctx context.Context, bw *browserWindow) {
 		if bw.tabPicker != nil && bw.tabPicker.IsVisible() {
 			return input.RoutePassToWidget
 		}
+		if bw.notificationCenter != nil && bw.notificationCenter.IsVisible() {
+			return input.RoutePassToWidget
+		}
+		if bw.clipboardHistory != nil && bw.clipboardHistory.IsVisible() {
+			return input.RoutePassToWidget
+		}
 
 		if a.accentFocusProvider != nil {
 			if _, ok := a.accentFocusProvider.GetFocusedInput().(port.EntryInputTarget); ok {
@@ -1117,6 +1227,47 @@ func (a *App) wireBrowserWindowTabBar(ctx context.Context, bw *browserWindow) {
 		}
 		// Active tab state is managed by TabCoordinator.Switch → TabList.SetActive.
 	})
+	bw.mainWindow.TabBar().SetOnReorder(func(tabID entity.TabID, position int) {
+		a.activateBrowserWindow(bw)
+		if err := a.tabCoord.Move(ctx, a.tabTargetForBrowserWindow(bw), tabID, position); err != nil {
+			logging.FromContext(ctx).Error().Err(err).Str("tab_id", string(tabID)).Str("window_id", bw.id).Msg("tab reorder failed")
+		}
+	})
+	bw.mainWindow.TabBar().SetOnCloseToTheRight(func(tabID entity.TabID) {
+		a.activateBrowserWindow(bw)
+		if err := a.tabCoord.CloseToTheRight(ctx, a.tabTargetForBrowserWindow(bw), tabID); err != nil {
+			logging.FromContext(ctx).Error().Err(err).Str("tab_id", string(tabID)).Str("window_id", bw.id).Msg("close tabs to the right failed")
+		}
+	})
+	bw.mainWindow.TabBar().SetOnCloseOthers(func(tabID entity.TabID) {
+		a.activateBrowserWindow(bw)
+		if err := a.tabCoord.CloseOthers(ctx, a.tabTargetForBrowserWindow(bw), tabID); err != nil {
+			logging.FromContext(ctx).Error().Err(err).Str("tab_id", string(tabID)).Str("window_id", bw.id).Msg("close other tabs failed")
+		}
+	})
+	bw.mainWindow.TabBar().SetGroupMenu(func(tabID entity.TabID) []component.TabMenuEntry {
+		return a.tabCoord.TabGroupMenu(ctx, a.tabTargetForBrowserWindow(bw), tabID)
+	})
+}
+
+// refreshTabStrip updates the tab bar buttons of bw with the page title and
+// favicon of each tab.
+func (a *App) refreshTabStrip(ctx context.Context, bw *browserWindow) {
+	if bw == nil || bw.mainWindow == nil || bw.mainWindow.TabBar() == nil {
+		return
+	}
+	tabBar := bw.mainWindow.TabBar()
+	for _, item := range component.TabStripItems(a.tabListForBrowserWindow(bw)) {
+		tabBar.SetItem(item)
+		tabID := item.ID
+		if a.faviconAdapter == nil || item.PageURL == "" {
+			tabBar.SetFavicon(tabID, nil)
+			continue
+		}
+		a.faviconAdapter.GetOrFetch(ctx, item.PageURL, func(texture *gdk.Texture) {
+			tabBar.SetFavicon(tabID, texture)
+		})
+	}
 }
 
 // handleAccentKeyPress handles accent key press events for GTK entry widgets
@@ -1182,6 +1333,7 @@ func (a *App) Cancel(ctx context.Context) {
 
 type omniboxCallbacks struct {
 	OnNavigate             func(ctx context.Context, url string) error
+	OnCommand              func(ctx context.Context, command string) error
 	NormalizeNavigationURL func(ctx context.Context, input string) string
 	OnToast                func(ctx context.Context, message string, level component.ToastLevel)
 	OnFocusIn              func(entry *gtk.SearchEntry)
@@ -1218,9 +1370,16 @@ func buildOmniboxConfig(
 		NormalizeNavigationURL: callbacks.NormalizeNavigationURL,
 		InitialBehavior:        runtimeCfg.Omnibox.InitialBehavior,
 		MostVisitedDays:        runtimeCfg.Omnibox.MostVisitedDays,
+		MaxResults:             runtimeCfg.Omnibox.MaxResults,
+		MinQueryLength:         runtimeCfg.Omnibox.MinQueryLength,
+		OpenInNewPane:          runtimeCfg.Omnibox.OpenInNewPane,
+		MatchMode:              runtimeCfg.Omnibox.MatchMode,
+		NextResultKeys:         runtimeCfg.Omnibox.NextResultKeys,
+		PreviousResultKeys:     runtimeCfg.Omnibox.PreviousResultKeys,
 		SaveInitialBehavior:    deps.HandlerDeps.SaveOmniboxInitialBehavior,
 		UIScale:                runtimeCfg.DefaultUIScale,
 		OnNavigate:             callbacks.OnNavigate,
+		OnCommand:              callbacks.OnCommand,
 		OnToast:                callbacks.OnToast,
 		OnFocusIn:              callbacks.OnFocusIn,
 		OnFocusOut:             callbacks.OnFocusOut,
@@ -1308,6 +1467,7 @@ func (a *App) initOmniboxConfig(ctx context.Context) {
 		OnNavigate: func(navCtx context.Context, url string) error {
 			return a.navigateFromOmnibox(navCtx, url)
 		},
+		OnCommand: a.runOmniboxCommand,
 		NormalizeNavigationURL: func(navCtx context.Context, input string) string {
 			if a.panesUC != nil {
 				return a.panesUC.NormalizeNavigationURL(navCtx, input)
@@ -2135,6 +2295,24 @@ func (a *App) navigateFromBrowserWindow(ctx context.Context, bw *browserWindow,
 	return a.navCoord.NavigateWebView(ctx, rawURL, paneID, wv)
 }
 
+// openOmniboxURLInNewPane opens rawURL in a new pane split from the active pane
+// of bw. A blank active pane (for example one just created with the omnibox
+// auto-opened) is navigated in place instead of being split again.
+func (a *App) openOmniboxURLInNewPane(ctx context.Context, bw *browserWindow, rawURL string) error {
+	if _, wv := a.activeWebViewForBrowserWindow(bw); wv == nil || isBlankPaneURI(wv.URI()) {
+		return a.navigateFromBrowserWindow(ctx, bw, rawURL)
+	}
+	if a.wsCoord == nil || !a.hasBrowserWindow(bw) {
+		return fmt.Errorf("workspace coordinator not initialized")
+	}
+	a.activateBrowserWindow(bw)
+	return a.wsCoord.SplitWithURL(ctx, usecase.SplitRight, rawURL)
+}
+
+func isBlankPaneURI(uri string) bool {
+	return uri == "" || uri == "about:blank"
+}
+
 // withBrowserWindowWebView resolves the active WebView for bw, checks that navCoord
 // is initialized and wv is not nil, then runs fn with the WebView. Avoids nil bw
 // panic in error formatting by falling back to empty window id.
@@ -2222,7 +2400,7 @@ func (a *App) zoomBrowserWindow(ctx context.Context, bw *browserWindow, action s
 	case "reset":
 		err = a.deps.ZoomUC.ResetZoom(ctx, zoomKey)
 		if err == nil {
-			newZoom = entity.NewZoomLevel(zoomKey, a.deps.ZoomUC.DefaultZoom())
+			newZoom = entity.NewZoomLevel(zoomKey, a.deps.ZoomUC.DefaultZoomFor(zoomKey))
 		}
 	default:
 		return fmt.Errorf("unknown zoom action %q", action)
@@ -2247,6 +2425,57 @@ func (a *App) zoomBrowserWindow(ctx context.Context, bw *browserWindow, action s
 	return nil
 }
 
+// GetActivePaneZoom returns the zoom factor of the active pane.
+// It is safe to call from any goroutine.
+func (a *App) GetActivePaneZoom(ctx context.Context) (float64, error) {
+	var zoom float64
+	var zoomErr error
+	if err := a.runOnMainThreadChecked("ui.get_active_pane_zoom", func() {
+		if a.wsCoord == nil {
+			zoomErr = errors.New("workspace coordinator not available")
+			return
+		}
+		zoom, zoomErr = a.wsCoord.GetActivePaneZoom(ctx)
+	}); err != nil {
+		return 0, err
+	}
+	return zoom, zoomErr
+}
+
+// SetActivePaneZoom applies and persists a zoom factor on the active pane,
+// returning the clamped factor. It is safe to call from any goroutine.
+func (a *App) SetActivePaneZoom(ctx context.Context, level float64) (float64, error) {
+	var zoom float64
+	var zoomErr error
+	if err := a.runOnMainThreadChecked("ui.set_active_pane_zoom", func() {
+		if a.wsCoord == nil {
+			zoomErr = errors.New("workspace coordinator not available")
+			return
+		}
+		zoom, zoomErr = a.wsCoord.SetActivePaneZoom(ctx, level)
+		if zoomErr == nil && a.navCoord != nil {
+			a.navCoord.NotifyZoomChanged(ctx, zoom)
+		}
+	}); err != nil {
+		return 0, err
+	}
+	return zoom, zoomErr
+}
+
+func (a *App) runOnMainThreadChecked(label string, fn func()) error {
+	if a.dispatchOnMainThread == nil {
+		fn()
+		return nil
+	}
+	result := a.dispatchOnMainThread(label, fn)
+	if !result.Completed() {
+		return fmt.Errorf("main thread dispatch did not complete: %s", result.Status)
+	}
+	return nil
+}
+
+var _ port.ActivePaneZoomController = (*App)(nil)
+
 const (
 	tabSwitchIndex0 = iota // 0
 	tabSwitchIndex1        // 1
@@ -2293,6 +2522,9 @@ func switchTabIndexActionIndex(action input.Action) (int, bool) {
 // webview/tab. Browser-window-owning callbacks (keyboardHandler, globalShortcutHandler)
 // must call this instead of activating the window then dispatching globally.
 func (a *App) dispatchBrowserWindowAction(ctx context.Context, bw *browserWindow, action input.Action) error {
+	if a.panicBlocksAction(action) {
+		return nil
+	}
 	switch action {
 	case input.ActionReload:
 		return a.reloadBrowserWindow(ctx, bw, false)
@@ -2569,6 +2801,37 @@ func (a *App) restoreSession(ctx context.Context, sessionID entity.SessionID) er
 	return nil
 }
 
+// mergeSession appends the tabs of a saved session to bw, as the
+// "merge" session.restore_strategy asks, instead of opening the session in
+// its own instance.
+func (a *App) mergeSession(ctx context.Context, bw *browserWindow, sessionID entity.SessionID) error {
+	if a.deps == nil || a.deps.SessionStateRepo == nil {
+		return fmt.Errorf("session state repo not available")
+	}
+	if a.tabCoord == nil {
+		return fmt.Errorf("tab coordinator not available")
+	}
+
+	restoreUC := usecase.NewRestoreSessionUseCase(a.deps.SessionStateRepo, a.deps.SessionRepo)
+	output, err := restoreUC.Execute(ctx, usecase.RestoreInput{SessionID: sessionID})
+	if err != nil {
+		return err
+	}
+
+	merged, err := a.tabCoord.MergeSession(ctx, a.ensureTabTargetForBrowserWindow(bw), output.State)
+	if err != nil {
+		return err
+	}
+	if a.wsCoord != nil {
+		for _, tab := range merged {
+			if wsView := a.workspaceViews[tab.ID]; wsView != nil {
+				a.wsCoord.SetupStackedPaneCallbacks(ctx, tab.Workspace, wsView)
+			}
+		}
+	}
+	return nil
+}
+
 func (a *App) loadRestoredWindowStates(
 	ctx context.Context,
 	sessionID entity.SessionID,
@@ -2584,6 +2847,9 @@ func (a *App) loadRestoredWindowStates(
 	if state == nil {
 		return nil, nil, fmt.Errorf("session state not found")
 	}
+	if a.deps.RestorePinnedOnly {
+		state = state.PinnedOnly()
+	}
 
 	restoredWindows := entity.WindowTabListsFromSnapshot(state, a.generateID)
 	return state, restoredWindows, nil
@@ -2756,6 +3022,7 @@ func (a *App) showRestoredRuntimeWindows(runtimeWindows []*browserWindow) {
 }
 
 func (a *App) buildRestoredWindowUI(ctx context.Context, runtimeWindows []*browserWindow) {
+	lazy := a.runtimeConfigSnapshot().UI.Session.LazyRestore
 	for _, bw := range runtimeWindows {
 		perWinTabs := a.tabListForBrowserWindow(bw)
 		if perWinTabs == nil || perWinTabs.Count() == 0 {
@@ -2766,6 +3033,10 @@ func (a *App) buildRestoredWindowUI(ctx context.Context, runtimeWindows []*brows
 		tabBar := bw.mainWindow.TabBar()
 		activeTab := perWinTabs.ActiveTab()
 		for _, tab := range perWinTabs.Tabs {
+			// Background tabs load when first shown, see switchWorkspaceView.
+			if lazy && tab != nil && tab != activeTab && a.contentCoord != nil {
+				a.contentCoord.DeferWorkspace(tab.Workspace)
+			}
 			a.buildRestoredTabUI(ctx, bw, tabBar, tab)
 		}
 
@@ -2775,6 +3046,7 @@ func (a *App) buildRestoredWindowUI(ctx context.Context, runtimeWindows []*brows
 				tabBar.SetActive(activeTab.ID)
 			}
 		}
+		a.refreshTabStrip(ctx, bw)
 		a.updateBrowserWindowTabBarVisibility(bw)
 	}
 }
@@ -2838,6 +3110,9 @@ func (a *App) finalizeActivation(ctx context.Context) {
 	if a.deps != nil && len(a.deps.StartupCrashReports) > 0 {
 		a.showCrashReportToast(ctx, a.deps.StartupCrashReports)
 	}
+	if a.deps != nil && a.deps.SafeMode {
+		a.showSafeModeToast(ctx)
+	}
 
 	// Defer non-critical initialization until after first navigation starts.
 	// This keeps pool prewarm, config watcher, and filter loading from
@@ -2868,6 +3143,20 @@ func (a *App) showCrashReportToast(ctx context.Context, paths []string) {
 	glib.IdleAdd(&cb, 0)
 }
 
+// showSafeModeToast tells the user that this run started in safe mode.
+func (a *App) showSafeModeToast(ctx context.Context) {
+	cb := glib.SourceFunc(func(_ uintptr) bool {
+		a.showToastOnLastFocusedBrowserWindow(ctx,
+			"Safe mode: ad blocking, user scripts and GPU rendering are off after repeated crashes",
+			component.ToastWarning,
+			component.WithDuration(crashReportToastDurationMs),
+			component.WithPosition(component.ToastPositionBottomRight),
+		)
+		return false
+	})
+	glib.IdleAdd(&cb, 0)
+}
+
 // runAfterFirstLoadStarted schedules work to run after the first navigation starts.
 // This keeps the GTK main loop free to process the initial load_uri() quickly,
 // reducing the gap between webview_attached and load_started.
@@ -2906,6 +3195,10 @@ func (a *App) onShutdown(ctx context.Context) {
 			log.Warn().Err(err).Msg("failed to save final session state")
 		}
 	}
+	a.windowGeometry.flush(ctx)
+	if err := a.navTreeUC.Save(ctx); err != nil {
+		log.Warn().Err(err).Msg("failed to save navigation trees")
+	}
 
 	// Apply staged update if available (before cleanup)
 	if a.updateCoord != nil {
@@ -2921,6 +3214,8 @@ func (a *App) onShutdown(ctx context.Context) {
 			log.Warn().Err(err).Msg("failed to stop external theme watcher")
 		}
 	}
+	a.stopUserScripts(ctx)
+	a.cancelFocusMute()
 
 	// Cancel context to signal all goroutines
 	a.cancel(errors.New("application shutdown"))
@@ -2955,6 +3250,10 @@ func (a *App) onShutdown(ctx context.Context) {
 			log.Warn().Err(err).Msg("failed to close engine")
 		}
 	}
+	// Stop reading aloud so no text-to-speech process outlives the browser
+	if a.deps.SpeechPlayer != nil {
+		a.deps.SpeechPlayer.Stop()
+	}
 	// Close idle inhibitor to release D-Bus connection
 	if a.deps.IdleInhibitor != nil {
 		if err := a.deps.IdleInhibitor.Close(); err != nil {
@@ -3009,6 +3308,66 @@ func (a *App) initContentCoordinator(
 	a.contentCoord.SetOnTouchpadNavigationGesture(func(paneID entity.PaneID, gesture entity.TouchpadNavigationGesture) {
 		a.handleTouchpadNavigationGesture(paneID, gesture)
 	})
+	a.contentCoord.SetOnSearchSelection(func(paneID entity.PaneID, text string) {
+		a.searchSelection(ctx, paneID, text)
+	})
+
+	// Per-domain autoplay policy reads the live config on every navigation.
+	a.contentCoord.SetMediaConfigProvider(func() entity.RuntimeMediaConfig {
+		return a.runtimeConfigSnapshot().UI.Media
+	})
+	// Per-domain forced dark mode reads the live config on every navigation.
+	a.contentCoord.SetAppearanceConfigProvider(func() entity.AppearanceConfig {
+		return a.runtimeConfigSnapshot().UI.Appearance
+	})
+	// Per-domain cookie policy reads the live config on every navigation.
+	a.contentCoord.SetPrivacyConfigProvider(func() entity.RuntimePrivacyConfig {
+		return a.runtimeConfigSnapshot().UI.Privacy
+	})
+	if a.deps != nil && a.deps.PermissionUC != nil {
+		a.deps.PermissionUC.SetClipboardReadPolicyProvider(func() entity.ClipboardReadPolicy {
+			return a.runtimeConfigSnapshot().UI.Privacy.ClipboardReadPolicy
+		})
+	}
+	// First-field autofocus and the unsaved-form close prompt read the live
+	// input config each time they run.
+	a.contentCoord.SetInputConfigProvider(func() entity.RuntimeInputConfig {
+		return a.runtimeConfigSnapshot().UI.Input
+	})
+	// JavaScript dialogs are auto-dismissed per the live config, otherwise
+	// shown in the pane's own window.
+	a.contentCoord.SetScriptDialogsConfigProvider(func() entity.RuntimeScriptDialogsConfig {
+		return a.runtimeConfigSnapshot().UI.ScriptDialogs
+	})
+	a.contentCoord.SetOnScriptDialog(func(ctx context.Context, paneID entity.PaneID, request port.ScriptDialogRequest) bool {
+		bw := a.browserWindowForPane(paneID)
+		if bw == nil || bw.scriptDialog == nil {
+			return false
+		}
+		bw.scriptDialog.Show(ctx, request)
+		return true
+	})
+
+	// Hard-fail loads whose certificate breaks a stored host pin.
+	if a.deps.FilterExceptionsUC != nil {
+		a.deps.FilterExceptionsUC.SetDisabledDomainsProvider(func() []string {
+			return a.runtimeConfigSnapshot().UI.ContentFiltering.DisabledDomains
+		})
+		a.contentCoord.SetFilterExceptionsUC(a.deps.FilterExceptionsUC)
+	}
+	if a.deps.CosmeticExceptionsUC != nil {
+		a.contentCoord.SetCosmeticExceptionsUC(a.deps.CosmeticExceptionsUC)
+	}
+	if a.deps.CertificatePinUC != nil {
+		a.contentCoord.SetCertificatePinUC(a.deps.CertificatePinUC)
+		a.deps.CertificatePinUC.RefreshInBackground(ctx)
+	}
+	if a.deps.SiteUserAgentUC != nil {
+		a.contentCoord.SetSiteUserAgentUC(a.deps.SiteUserAgentUC)
+	}
+	if a.deps.SiteColorSchemeUC != nil {
+		a.contentCoord.SetSiteColorSchemeUC(a.deps.SiteColorSchemeUC)
+	}
 
 	// Wire deferred init trigger - runs after first navigation starts
 	a.contentCoord.SetOnFirstLoadStarted(func() {
@@ -3023,6 +3382,7 @@ func (a *App) initTabCoordinator(ctx context.Context) {
 		TabsUC:                  a.tabsUC,
 		MainWindow:              a.mainWindow,
 		HideTabBarWhenSingleTab: runtimeCfg.Workspace.HideTabBarWhenSingleTab,
+		TabBarMode:              runtimeCfg.Workspace.TabBarMode,
 	})
 	a.tabCoord.SetOnTabCreated(func(ctx context.Context, target coordinator.TabTarget, tab *entity.Tab) {
 		// Assign ownership from the callback target, not focus/global state.
@@ -3056,8 +3416,16 @@ func (a *App) initTabCoordinator(ctx context.Context) {
 			a.activateBrowserWindow(bw)
 		}
 		a.switchWorkspaceView(ctx, tab.ID)
+		if a.wsCoord != nil {
+			a.wsCoord.FocusPaneByID(ctx, tab.PaneToFocus())
+		}
+		if tab.Workspace != nil {
+			a.notifyPaneFocused(ctx, tab.Workspace.ActivePaneID)
+		}
 	})
 	a.tabCoord.SetOnTabClosed(func(ctx context.Context, _ coordinator.TabTarget, tab *entity.Tab) {
+		// Snapshot the pane tree before the workspace is released.
+		a.closedTabsUC.Remember(ctx, tab)
 		a.releaseTabWorkspace(ctx, tab)
 	})
 	a.tabCoord.SetOnStateChanged(a.MarkDirty)
@@ -3117,6 +3485,8 @@ func (a *App) initCoordinators(ctx context.Context) {
 
 	// 1. Content Coordinator (no dependencies on other coordinators)
 	a.initContentCoordinator(ctx, getActiveWS)
+	a.initUserScripts(ctx)
+	a.syncPageEnv(ctx)
 
 	// 2. Tab Coordinator
 	a.initTabCoordinator(ctx)
@@ -3134,17 +3504,29 @@ func (a *App) initCoordinators(ctx context.Context) {
 		StackedPaneMgr:       a.stackedPaneMgr,
 		WidgetFactory:        a.widgetFactory,
 		ContentCoord:         a.contentCoord,
+		ZoomUC:               a.deps.ZoomUC,
+		CopyURLUC:            a.deps.CopyURLUC,
+		OnCreatePopupTab:     a.createPopupTab,
 		GetActiveWS:          getActiveWS,
 		GenerateID:           a.generateID,
 		NewPaneURL:           runtimeCfg.Workspace.NewPaneURL,
+		SplitInheritsURL:     runtimeCfg.Workspace.SplitInheritsURL,
+		AutoBalanceOnSplit:   runtimeCfg.Workspace.AutoBalanceOnSplit,
+		StackSwipe:           runtimeCfg.Workspace.StackSwipe,
 		ResizeStepPercent:    runtimeCfg.Workspace.ResizeMode.StepPercent,
 		ResizeMinPanePercent: runtimeCfg.Workspace.ResizeMode.MinPanePercent,
+		PopupDomainPlacement: func() []entity.PopupPlacementRule {
+			return a.runtimeConfigSnapshot().UI.Workspace.BrowsingContexts.DomainPlacement
+		},
 	})
 	a.wsCoord.SetOnCloseLastPane(func(ctx context.Context) error {
 		bw := a.lastFocusedBrowserWindow()
 		return a.tabCoord.Close(ctx, a.ensureTabTargetForBrowserWindow(bw))
 	})
 	a.wsCoord.SetOnStateChanged(a.MarkDirty)
+	a.wsCoord.SetOnNavigatePane(func(ctx context.Context, paneID entity.PaneID, url string) error {
+		return a.navCoord.NavigateWebView(ctx, url, paneID, a.contentCoord.GetWebView(paneID))
+	})
 
 	// Wire popup handling
 	// Set theme background color on the engine's popup factory to eliminate white flash.
@@ -3177,13 +3559,145 @@ func (a *App) initCoordinators(ctx context.Context) {
 		return a.wsCoord.ClosePaneByID(ctx, paneID)
 	})
 	a.contentCoord.SetOnOpenNativePopup(a.openNativePopupWindow)
-	// Wire tabbed popup behavior to create new tabs in the originating window.
-	a.wsCoord.SetOnCreatePopupTab(a.createPopupTab)
+	a.contentCoord.SetOnPopupSuppressed(func(ctx context.Context, paneID entity.PaneID, targetURI string) {
+		message := "Popup blocked"
+		if host := urlutil.ExtractDomain(targetURI); host != "" {
+			message = "Popup blocked: " + host
+		}
+		a.showToastOnBrowserWindow(ctx, a.browserWindowForPane(paneID), message, component.ToastInfo)
+	})
+	// Loads that failed on a transient network error are retried per the
+	// live config, with their progress shown as toasts.
+	a.contentCoord.SetLoadRetryConfigProvider(func() entity.RuntimeLoadRetryConfig {
+		return a.runtimeConfigSnapshot().UI.LoadRetry
+	})
+	// Pages whose web process crashed are reloaded once when the live config
+	// asks for it; repeat crashes fall back to the crash page.
+	a.contentCoord.SetReloadCrashedPanesProvider(func() bool {
+		return a.runtimeConfigSnapshot().UI.Workspace.ReloadCrashedPanes
+	})
+	a.contentCoord.SetOnCrashReload(func(ctx context.Context, paneID entity.PaneID, uri string) {
+		site := urlutil.ExtractDomain(uri)
+		if site == "" {
+			site = "page"
+		}
+		a.showToastOnBrowserWindow(ctx, a.browserWindowForPane(paneID), site+" crashed, reloading", component.ToastWarning)
+	})
+	a.contentCoord.SetOnLoadRetry(func(ctx context.Context, paneID entity.PaneID, event content.LoadRetryEvent) {
+		site := urlutil.ExtractDomain(event.URI)
+		if site == "" {
+			site = "page"
+		}
+		bw := a.browserWindowForPane(paneID)
+		if event.GaveUp {
+			message := fmt.Sprintf("Could not load %s after %d retries", site, event.MaxAttempts)
+			a.showToastOnBrowserWindow(ctx, bw, message, component.ToastWarning)
+			return
+		}
+		message := fmt.Sprintf("Could not load %s, retrying in %s (%d/%d)",
+			site, event.Delay.Round(100*time.Millisecond), event.Attempt, event.MaxAttempts)
+		a.showToastOnBrowserWindow(ctx, bw, message, component.ToastInfo)
+	})
+	// Focus timers read their duration and action live when started, and
+	// close panes through the same path as popups.
+	a.contentCoord.SetFocusTimerConfigProvider(func() entity.FocusTimerConfig {
+		return a.runtimeConfigSnapshot().UI.Workspace.FocusTimer
+	})
+	a.contentCoord.SetOnFocusTimer(func(ctx context.Context, paneID entity.PaneID, event content.FocusTimerEvent) {
+		a.showToastOnBrowserWindow(ctx, a.browserWindowForPane(paneID), event.Message(), component.ToastWarning)
+	})
+	// Pages in another language offer a translation; the prompt, preferred
+	// language and URL template are read live.
+	a.contentCoord.SetTranslationConfigProvider(func() entity.RuntimeTranslationConfig {
+		return a.runtimeConfigSnapshot().UI.Translation
+	})
+	a.contentCoord.SetOnTranslateOffer(func(ctx context.Context, paneID entity.PaneID, offer content.TranslateOffer) {
+		a.showToastOnBrowserWindow(ctx, a.browserWindowForPane(paneID), offer.Message(), component.ToastInfo)
+	})
+
+	// HTTPS-only mode upgrades typed loads through the navigate use case and
+	// followed links as their load starts; the mode is read live.
+	httpsUpgradeUC := usecase.NewHTTPSUpgradeUseCase(func() entity.HTTPSOnlyMode {
+		return a.runtimeConfigSnapshot().UI.Privacy.HTTPSOnly
+	})
+	a.contentCoord.SetHTTPSUpgradeUC(httpsUpgradeUC)
+	if a.deps.NavigateUC != nil {
+		a.deps.NavigateUC.SetURLRewriter(httpsUpgradeUC.Rewrite)
+	}
+	a.contentCoord.SetOnHTTPSUpgrade(func(ctx context.Context, paneID entity.PaneID, event content.HTTPSUpgradeEvent) {
+		bw := a.browserWindowForPane(paneID)
+		switch event.Action {
+		case httpsupgrade.ActionFallback:
+			message := fmt.Sprintf("HTTPS failed on %s, loading over HTTP", event.Host)
+			a.showToastOnBrowserWindow(ctx, bw, message, component.ToastWarning)
+		case httpsupgrade.ActionAllowInsecure:
+			message := fmt.Sprintf("%s is loaded over HTTP, not encrypted", event.Host)
+			a.showToastOnBrowserWindow(ctx, bw, message, component.ToastWarning)
+		case httpsupgrade.ActionBlock:
+			message := fmt.Sprintf("%s is not available over HTTPS", event.Host)
+			a.showToastOnBrowserWindow(ctx, bw, message, component.ToastError)
+		}
+	})
 
 	// Move pane use cases (cross-tab/cross-window)
 	a.movePaneToTabUC = usecase.NewMovePaneToTabUseCase(a.generateID)
 	a.extractPaneToTabListUC = usecase.NewExtractPaneToTabListUseCase(a.generateID)
 
+	// Closed tabs are remembered so reopen_closed_tab can bring them back.
+	a.closedTabsUC = usecase.NewClosedTabsUseCase(a.generateID, func() int {
+		return a.runtimeConfigSnapshot().UI.Workspace.ClosedTabHistoryDepth
+	})
+
+	// Recent toasts are kept for the notification center.
+	a.notificationsUC = usecase.NewNotificationsUseCase(func() int {
+		return a.runtimeConfigSnapshot().UI.Notifications.HistorySize
+	})
+	a.wsCoord.SetOnToast(a.recordToast)
+
+	// Copied URLs are kept for the clipboard history panel.
+	if a.deps.CopyURLUC != nil {
+		a.deps.CopyURLUC.SetHistorySize(func() int {
+			return a.runtimeConfigSnapshot().UI.Clipboard.URLHistorySize
+		})
+	}
+
+	// Reload-on-focus domains are read from the live config on every focus change.
+	a.reloadOnFocusUC = usecase.NewReloadOnFocusUseCase(
+		func() entity.WorkspaceConfig {
+			return a.runtimeConfigSnapshot().UI.Workspace
+		},
+		func(ctx context.Context, paneID entity.PaneID) {
+			cb := glib.SourceFunc(func(_ uintptr) bool {
+				a.reloadPaneOnFocus(ctx, paneID)
+				return false
+			})
+			glib.IdleAdd(&cb, 0)
+		},
+	)
+
+	// Background pane freezing is read from the live config on every focus change.
+	a.renderFreezeUC = usecase.NewRenderFreezeUseCase(
+		func() entity.WorkspaceConfig {
+			return a.runtimeConfigSnapshot().UI.Workspace
+		},
+		a.setPaneRenderingFrozen,
+	)
+
+	// Always-fresh domains are read from the live config on every navigation.
+	if a.deps.NavigateUC != nil {
+		a.deps.NavigateUC.SetAlwaysFreshDomainsProvider(func() []string {
+			return a.runtimeConfigSnapshot().UI.Cache.AlwaysFreshDomains
+		})
+	}
+
+	// Zoom steps are read from the live config so per-domain overrides apply
+	// after a reload.
+	if a.deps.ZoomUC != nil {
+		a.deps.ZoomUC.SetStepConfigProvider(func() entity.RuntimeZoomConfig {
+			return a.runtimeConfigSnapshot().UI.Zoom
+		})
+	}
+
 	// 4. Navigation Coordinator
 	a.navCoord = coordinator.NewNavigationCoordinatorWithHistoryRecorder(
 		ctx,
@@ -3193,16 +3707,27 @@ func (a *App) initCoordinators(ctx context.Context) {
 	)
 	a.wsCoord.SetOnPaneClosed(func(paneID entity.PaneID) {
 		a.navCoord.ClearPaneHistory(paneID)
+		a.reloadOnFocusUC.PaneClosed(paneID)
+		a.renderFreezeUC.PaneClosed(paneID)
+		a.navTreeUC.PaneClosed(paneID)
+		for _, bw := range a.browserWindows {
+			if bw != nil {
+				a.tabsUC.ForgetPane(bw.tabs, paneID)
+			}
+		}
 	})
 
 	// Wire title updates to history persistence
 	a.contentCoord.SetOnTitleUpdated(func(ctx context.Context, paneID entity.PaneID, url, title string) {
 		a.navCoord.UpdateHistoryTitle(ctx, paneID, url, title)
+		a.navTreeUC.UpdateTitle(ctx, paneID, url, title)
+		a.refreshTabStrip(ctx, a.browserWindowForPane(paneID))
 	})
 
 	// Wire history recording on LoadCommitted (URI is guaranteed correct at this point)
 	a.contentCoord.SetOnHistoryRecord(func(ctx context.Context, paneID entity.PaneID, url string) {
 		a.navCoord.RecordHistory(ctx, paneID, url)
+		a.navTreeUC.Record(ctx, paneID, url)
 	})
 
 	// Wire window title updates when active pane's title changes
@@ -3259,6 +3784,7 @@ func (a *App) initCoordinators(ctx context.Context) {
 		a.keyboardActions(),
 		a.contentCoord.ActivePaneID,
 	)
+	a.kbDispatcher.SetShareURLUseCase(a.deps.ShareURLUC)
 	a.wireKeyboardActions()
 	for _, bw := range a.browserWindows {
 		a.initBrowserWindowInput(ctx, bw)
@@ -3285,6 +3811,22 @@ func (a *App) keyboardActions() dispatcher.KeyboardActions {
 				return a.tabCoord.Close(ctx, target)
 			})
 		},
+		CloseTabsToRight: func(ctx context.Context) error {
+			return a.withFocusedTabTarget(ctx, "close tabs to the right", false, func(target coordinator.TabTarget) error {
+				if target.Tabs == nil {
+					return nil
+				}
+				return a.tabCoord.CloseToTheRight(ctx, target, target.Tabs.ActiveTabID)
+			})
+		},
+		CloseOtherTabs: func(ctx context.Context) error {
+			return a.withFocusedTabTarget(ctx, "close other tabs", false, func(target coordinator.TabTarget) error {
+				if target.Tabs == nil {
+					return nil
+				}
+				return a.tabCoord.CloseOthers(ctx, target, target.Tabs.ActiveTabID)
+			})
+		},
 		NextTab: func(ctx context.Context) error {
 			return a.withFocusedTabTarget(ctx, "next tab", false, func(target coordinator.TabTarget) error {
 				return a.tabCoord.SwitchNext(ctx, target)
@@ -3303,6 +3845,35 @@ func (a *App) keyboardActions() dispatcher.KeyboardActions {
 		SwitchTabIndex: func(ctx context.Context, index int) error {
 			return a.switchBrowserWindowTabIndex(ctx, a.lastFocusedBrowserWindow(), index)
 		},
+		ReopenClosedTab: func(ctx context.Context) error {
+			return a.withFocusedTabTarget(ctx, "reopen closed tab", true, func(target coordinator.TabTarget) error {
+				return a.reopenClosedTab(ctx, target)
+			})
+		},
+		GroupTab: func(ctx context.Context) error {
+			return a.withFocusedTabTarget(ctx, "group tab", false, func(target coordinator.TabTarget) error {
+				if target.Tabs == nil {
+					return nil
+				}
+				return a.tabCoord.GroupTab(ctx, target, target.Tabs.ActiveTabID)
+			})
+		},
+		ToggleTabGroup: func(ctx context.Context) error {
+			return a.withFocusedTabTarget(ctx, "toggle tab group", false, func(target coordinator.TabTarget) error {
+				if target.Tabs == nil {
+					return nil
+				}
+				return a.tabCoord.ToggleTabGroupCollapsed(ctx, target, target.Tabs.ActiveTabID)
+			})
+		},
+		UngroupTab: func(ctx context.Context) error {
+			return a.withFocusedTabTarget(ctx, "ungroup tab", false, func(target coordinator.TabTarget) error {
+				if target.Tabs == nil || target.Tabs.GroupOf(target.Tabs.ActiveTabID) == nil {
+					return nil
+				}
+				return a.tabCoord.AssignTabGroup(ctx, target, target.Tabs.ActiveTabID, "")
+			})
+		},
 		ActiveWebView: func(context.Context) port.WebView {
 			_, wv := a.activeWebViewForBrowserWindow(a.lastFocusedBrowserWindow())
 			return wv
@@ -3310,6 +3881,34 @@ func (a *App) keyboardActions() dispatcher.KeyboardActions {
 	}
 }
 
+// reopenClosedTab brings the most recently closed tab back into target,
+// with its pane layout.
+func (a *App) reopenClosedTab(ctx context.Context, target coordinator.TabTarget) error {
+	tab, err := a.closedTabsUC.ReopenLastClosedTab(ctx)
+	if errors.Is(err, usecase.ErrNoClosedTabs) {
+		a.showToastOnLastFocusedBrowserWindow(ctx, "No closed tabs to reopen", component.ToastInfo)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return a.addRestoredTab(ctx, target, tab)
+}
+
+// addRestoredTab adds a tab built with its pane layout to target and switches
+// to it.
+func (a *App) addRestoredTab(ctx context.Context, target coordinator.TabTarget, tab *entity.Tab) error {
+	if err := a.tabCoord.AddRestored(ctx, target, tab); err != nil {
+		return err
+	}
+	if a.wsCoord != nil {
+		if wsView := a.workspaceViews[tab.ID]; wsView != nil {
+			a.wsCoord.SetupStackedPaneCallbacks(ctx, tab.Workspace, wsView)
+		}
+	}
+	return nil
+}
+
 func (a *App) withFocusedTabTarget(ctx context.Context, action string, ensure bool, fn func(coordinator.TabTarget) error) error {
 	if a.tabCoord == nil {
 		logging.FromContext(ctx).Warn().Str("action", action).Msg("tab action ignored: tab coordinator is nil")
@@ -3366,6 +3965,14 @@ func (a *App) wireKeyboardActions() {
 	a.kbDispatcher.SetOnToggleHistorySidebar(a.toggleHistorySidebarAction)
 	a.kbDispatcher.SetOnToggleFavoritesSidebar(a.toggleFavoritesSidebarAction)
 	a.kbDispatcher.SetOnToggleCurrentPageFavorite(a.toggleCurrentPageFavoriteAction)
+	a.kbDispatcher.SetOnRestoreLastView(a.contentCoord.RestoreLastView)
+	a.kbDispatcher.SetOnPanic(a.Panic)
+	a.kbDispatcher.SetOnReadAloud(a.ReadAloud)
+	a.kbDispatcher.SetOnStopReadAloud(a.StopReadAloud)
+	a.kbDispatcher.SetOnSearchSelection(a.SearchSelection)
+	a.kbDispatcher.SetOnToggleNotifications(a.ToggleNotifications)
+	a.kbDispatcher.SetOnToggleClipboardHistory(a.ToggleClipboardHistory)
+	a.kbDispatcher.SetOnOpenLinkInPane(a.OpenLinkInPane)
 	a.kbDispatcher.SetOnToggleFloatingPane(func(ctx context.Context) error {
 		return a.ToggleFloatingPane(ctx)
 	})
@@ -3388,6 +3995,14 @@ func (a *App) wireWebRTCPermissionIndicator() {
 		ctx = a.deps.Ctx
 	}
 
+	// Permission prompts show in the window of the requesting pane.
+	a.contentCoord.SetOnPermissionRequest(func(paneID entity.PaneID) {
+		bw := a.browserWindowForPane(paneID)
+		if bw == nil || bw.permissionDialog == nil || a.deps == nil || a.deps.PermissionUC == nil {
+			return
+		}
+		a.deps.PermissionUC.SetDialogPresenter(bw.permissionDialog)
+	})
 	a.contentCoord.SetOnPermissionActivity(func(
 		paneID entity.PaneID,
 		origin string,
@@ -3398,9 +4013,6 @@ func (a *App) wireWebRTCPermissionIndicator() {
 		if bw == nil || bw.webrtcIndicator == nil {
 			return
 		}
-		if state == content.PermissionActivityRequesting && a.deps != nil && a.deps.PermissionUC != nil && bw.permissionDialog != nil {
-			a.deps.PermissionUC.SetDialogPresenter(bw.permissionDialog)
-		}
 		bw.webrtcIndicator.SetOrigin(origin)
 
 		switch state {
@@ -3634,7 +4246,7 @@ func (a *App) updateWindowTitle(pageTitle string, target *browserWindow) {
 	}
 
 	title := appTitle
-	if pageTitle != "" {
+	if pageTitle != "" && !a.panicLocked() {
 		title = pageTitle + " - " + appTitle
 	}
 	target.mainWindow.SetTitle(title)
@@ -3672,6 +4284,20 @@ func (a *App) handleModeChange(ctx context.Context, from, to input.Mode) {
 		// Resize mode targets the last-focused browser window's active workspace.
 		a.applyResizeModeBorder(ctx, a.activeWorkspace())
 	}
+	if a.tabCoord != nil && (to == input.ModeTab || from == input.ModeTab) {
+		// The overview tab bar mode shows the tab bar only during tab mode.
+		a.tabCoord.SetTabModeActive(ctx, a.tabTargetForBrowserWindow(a.lastFocusedBrowserWindow()), to == input.ModeTab)
+	}
+	if a.wsCoord != nil {
+		// Pane mode labels visible panes so 1-9 can jump straight to them.
+		if to == input.ModePane {
+			a.wsCoord.ShowPaneNumbers(ctx)
+		} else if from == input.ModePane {
+			a.wsCoord.HidePaneNumbers(ctx)
+			// A link captured by open-link-in-pane dies with the numbers.
+			a.wsCoord.CancelOpenLinkInPane()
+		}
+	}
 
 	// Update global border overlay visibility based on mode.
 	// Note: resize mode border is handled per-pane (stack container), not via global overlay.
@@ -3690,8 +4316,20 @@ func (a *App) updateModeIndicatorToaster(ctx context.Context, mode input.Mode) {
 		return
 	}
 
+	workspace := a.runtimeConfigSnapshot().UI.Workspace
+
+	// Leader hints are the only cue that a sequence is pending, so they show
+	// even when the mode indicator toaster is disabled.
+	if mode == input.ModeLeader && workspace.Leader.ShowHints && bw.keyboardHandler != nil {
+		bw.modeToaster.Show(ctx, formatLeaderHints(bw.keyboardHandler.LeaderHints()), component.ToastInfo,
+			component.WithDuration(0), // Persistent until the sequence ends.
+			component.WithPosition(component.ToastPositionBottomLeft),
+		)
+		return
+	}
+
 	// Check if mode indicator toaster is enabled in config.
-	if !a.runtimeConfigSnapshot().UI.Workspace.Styling.ModeIndicatorToasterEnabled {
+	if !workspace.Styling.ModeIndicatorToasterEnabled {
 		bw.modeToaster.Hide()
 		return
 	}
@@ -3711,6 +4349,18 @@ func (a *App) updateModeIndicatorToaster(ctx context.Context, mode input.Mode) {
 	)
 }
 
+// formatLeaderHints renders the leader follow-up keys as "LEADER  t new tab · v split".
+func formatLeaderHints(hints []input.LeaderHint) string {
+	if len(hints) == 0 {
+		return input.ModeLeader.DisplayName()
+	}
+	parts := make([]string, 0, len(hints))
+	for _, hint := range hints {
+		parts = append(parts, hint.Key+" "+hint.Label)
+	}
+	return input.ModeLeader.DisplayName() + "  " + strings.Join(parts, " · ")
+}
+
 // getModeToastClass returns the CSS class for the given mode's toast styling.
 func getModeToastClass(mode input.Mode) string {
 	switch mode {
@@ -3815,6 +4465,20 @@ func (a *App) createWorkspaceViewWithoutAttach(ctx context.Context, tab *entity.
 		return false
 	}
 	a.installFloatingOverlayPositioning(tab.ID, wsView.WorkspaceOverlayWidget())
+	// Hover focus must be configured before SetWorkspace creates PaneViews.
+	inputCfg := a.runtimeConfigSnapshot().UI.Input
+	wsView.SetHoverFocus(inputCfg.HoverFocusEnabled, time.Duration(inputCfg.HoverFocusDelayMs)*time.Millisecond)
+	wsView.SetMiddleClickClosesPane(inputCfg.MiddleClickClosesPane)
+	wsView.SetOnPaneCloseRequest(func(paneID entity.PaneID) {
+		if a.wsCoord == nil {
+			return
+		}
+		if err := a.wsCoord.ClosePaneByID(ctx, paneID); err != nil {
+			log.Error().Err(err).Str("pane_id", string(paneID)).Msg("failed to close pane from its chrome")
+		}
+	})
+	wsView.SetLinkStatusConfig(linkStatusConfigFromRuntime(a.runtimeConfigSnapshot().UI.LinkStatus))
+	wsView.SetOnToast(a.recordToast)
 	if a.contentCoord != nil {
 		syncCtx := context.Background()
 		if a.deps != nil && a.deps.Ctx != nil {
@@ -3825,6 +4489,7 @@ func (a *App) createWorkspaceViewWithoutAttach(ctx context.Context, tab *entity.
 		})
 		wsView.SetOnActivePaneChanged(func(paneID entity.PaneID) {
 			a.contentCoord.SyncWebViewViewport(syncCtx, paneID, "workspace-pane-activated")
+			a.notifyPaneFocused(syncCtx, paneID)
 		})
 	}
 
@@ -3849,6 +4514,7 @@ func (a *App) createWorkspaceViewWithoutAttach(ctx context.Context, tab *entity.
 	if owner := a.browserWindowForTab(tab.ID); owner != nil {
 		cfg := a.omniboxCfg
 		cfg.OnNavigate = omniboxNavigateForBrowserWindow(ctx, owner, a.navigateFromBrowserWindow)
+		cfg.OnOpenInNewPane = omniboxNavigateForBrowserWindow(ctx, owner, a.openOmniboxURLInNewPane)
 		wsView.SetOmniboxConfig(cfg)
 	} else {
 		wsView.SetOmniboxConfig(a.omniboxCfg)
@@ -4004,6 +4670,8 @@ func (a *App) switchWorkspaceView(ctx context.Context, tabID entity.TabID) {
 		return
 	}
 
+	a.resumeDeferredTab(ctx, tabID, wsView)
+
 	// Swap content (MainWindow.SetContent now properly removes old content).
 	// Active tab state is managed by TabList.SetActive; no per-window field needed.
 	if target := a.browserWindowForTab(tabID); target != nil && target.mainWindow != nil {
@@ -4019,6 +4687,21 @@ func (a *App) switchWorkspaceView(ctx context.Context, tabID entity.TabID) {
 	log.Debug().Str("tab_id", string(tabID)).Msg("workspace view switched")
 }
 
+// resumeDeferredTab loads the panes of a lazily restored tab the first time
+// it is shown.
+func (a *App) resumeDeferredTab(ctx context.Context, tabID entity.TabID, wsView *component.WorkspaceView) {
+	if a.contentCoord == nil {
+		return
+	}
+	bw := a.browserWindowForTab(tabID)
+	if bw == nil || bw.tabs == nil {
+		return
+	}
+	if tab := bw.tabs.Find(tabID); tab != nil {
+		a.contentCoord.ResumeWorkspace(ctx, tab.Workspace, wsView)
+	}
+}
+
 func normalizeFloatingSessionID(sessionID string) string {
 	sessionID = strings.TrimSpace(sessionID)
 	if sessionID == "" {
@@ -4457,6 +5140,7 @@ func (a *App) showFloatingOmnibox(ctx context.Context, session *floatingWorkspac
 			}
 			return session.pane.Navigate(navCtx, url)
 		}
+		cfg.OnOpenInNewPane = nil
 		cfg.OnToast = func(toastCtx context.Context, message string, level component.ToastLevel) {
 			a.showToastOnLastFocusedBrowserWindow(toastCtx, message, level)
 		}
@@ -4481,7 +5165,7 @@ func (a *App) showFloatingOmnibox(ctx context.Context, session *floatingWorkspac
 	}
 
 	session.pane.SetOmniboxVisible(true)
-	session.omnibox.Show(ctx, "")
+	session.omnibox.Show(ctx, a.omniboxPrefill(session.webView))
 }
 
 func (a *App) hideFloatingOmnibox(ctx context.Context, session *floatingWorkspaceSession) {
@@ -4807,8 +5491,27 @@ func (a *App) ToggleOmnibox(ctx context.Context) {
 	if wsView.IsOmniboxVisible() {
 		wsView.HideOmnibox()
 	} else {
-		wsView.ShowOmnibox(ctx, "")
+		_, wv := a.activeWebViewForBrowserWindow(a.lastFocusedBrowserWindow())
+		wsView.ShowOmnibox(ctx, a.omniboxPrefill(wv))
+	}
+}
+
+// omniboxPrefill returns the text the omnibox opens with for the given pane.
+func (a *App) omniboxPrefill(wv port.WebView) string {
+	if wv == nil {
+		return ""
+	}
+	return omniboxPrefillText(a.runtimeConfigSnapshot().UI.Omnibox.OpenWithCurrentURL, wv.URI())
+}
+
+// omniboxPrefillText returns the current URL when omnibox.open_with_current_url
+// is set. Blank pages have nothing worth editing and open empty.
+func omniboxPrefillText(openWithCurrentURL bool, currentURL string) string {
+	currentURL = strings.TrimSpace(currentURL)
+	if !openWithCurrentURL || currentURL == "" || currentURL == "about:blank" {
+		return ""
 	}
+	return currentURL
 }
 
 // ToggleFindBar shows or hides the find bar in the active workspace view.
@@ -4854,6 +5557,23 @@ func (a *App) FindPrevious(ctx context.Context) {
 	wsView.FindPrevious()
 }
 
+// typeToFind searches for text typed on the page of webViewID. Only the
+// focused pane of the focused window reacts, since that is where the user is
+// typing.
+func (a *App) typeToFind(ctx context.Context, webViewID port.WebViewID, text string) {
+	if !a.runtimeConfigSnapshot().EngineSettings.WebContent.TypeToFind {
+		return
+	}
+	bw := a.lastFocusedBrowserWindow()
+	_, wv := a.activeWebViewForBrowserWindow(bw)
+	if wv == nil || wv.ID() != webViewID {
+		return
+	}
+	if wsView := a.activeWorkspaceViewForBrowserWindow(bw); wsView != nil {
+		wsView.TypeIntoFindBar(ctx, text)
+	}
+}
+
 // CloseFindBar hides the find bar if visible.
 func (a *App) CloseFindBar(ctx context.Context) {
 	wsView := a.activeWorkspaceView()
@@ -4928,6 +5648,16 @@ func (a *App) applyRuntimeConfigChange(ctx context.Context, snapshot entity.Runt
 	sessionCfg := runtimeCfg.Session
 	a.syncExternalThemeWatcher(ctx)
 	a.applyAppearanceConfig(ctx)
+	a.syncPageEnv(ctx)
+	linkStatusCfg := linkStatusConfigFromRuntime(runtimeCfg.LinkStatus)
+	for _, wsView := range a.workspaceViews {
+		if wsView != nil {
+			wsView.SetLinkStatusConfig(linkStatusCfg)
+			if !runtimeCfg.Appearance.ReadingProgress {
+				wsView.HideReadingProgress()
+			}
+		}
+	}
 	for _, bw := range a.browserWindows {
 		if bw == nil {
 			continue
@@ -4944,6 +5674,16 @@ func (a *App) applyRuntimeConfigChange(ctx context.Context, snapshot entity.Runt
 	}
 }
 
+// linkStatusConfigFromRuntime converts the runtime link status settings into
+// the overlay component configuration.
+func linkStatusConfigFromRuntime(cfg entity.RuntimeLinkStatusConfig) component.LinkStatusConfig {
+	return component.LinkStatusConfig{
+		Enabled:   cfg.Enabled,
+		ShowDelay: time.Duration(cfg.ShowDelayMs) * time.Millisecond,
+		MaxChars:  cfg.MaxLength,
+	}
+}
+
 func (a *App) syncExternalThemeWatcher(ctx context.Context) {
 	if a == nil || a.deps == nil || a.deps.ExternalThemeWatcher == nil {
 		return
@@ -5103,3 +5843,60 @@ func (a *App) showFilterStatus(ctx context.Context, status port.FilterStatus) {
 		a.showToastOnLastFocusedBrowserWindow(ctx, "Filter load failed: "+status.Message, component.ToastError)
 	}
 }
+
+// notifyPaneFocused tells the reload-on-focus and render freeze use cases
+// that paneID now has focus. Panes of background tabs count as unfocused.
+func (a *App) notifyPaneFocused(ctx context.Context, paneID entity.PaneID) {
+	if a.contentCoord == nil || paneID == "" {
+		return
+	}
+	a.renderFreezeUC.PaneFocused(ctx, paneID)
+	if a.reloadOnFocusUC == nil {
+		return
+	}
+	uri := ""
+	if wv := a.contentCoord.GetWebView(paneID); wv != nil {
+		uri = wv.URI()
+	}
+	a.reloadOnFocusUC.PaneFocused(ctx, paneID, uri)
+}
+
+// setPaneRenderingFrozen freezes or thaws the rendering of paneID's WebView
+// for workspace.freeze_background_panes.
+func (a *App) setPaneRenderingFrozen(ctx context.Context, paneID entity.PaneID, frozen bool) {
+	if a.contentCoord == nil {
+		return
+	}
+	wv := a.contentCoord.GetWebView(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return
+	}
+	if capable, ok := wv.(port.RenderingFreezeCapable); ok {
+		capable.SetRenderingFrozen(ctx, frozen)
+	}
+}
+
+// reloadPaneOnFocus reloads paneID for workspace.reload_on_focus_domains.
+func (a *App) reloadPaneOnFocus(ctx context.Context, paneID entity.PaneID) {
+	if a.contentCoord == nil || a.navCoord == nil {
+		return
+	}
+	wv := a.contentCoord.GetWebView(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return
+	}
+	if err := a.navCoord.ReloadWebView(ctx, wv, false); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("reload on focus failed")
+		return
+	}
+	logging.FromContext(ctx).Debug().Str("pane_id", string(paneID)).Msg("reloaded pane on focus")
+}
+
+// notesService keeps a missing notes use case a nil interface, so the notes
+// handlers are not registered instead of dereferencing a nil pointer.
+func notesService(uc *usecase.ManageNotesUseCase) port.Notes {
+	if uc == nil {
+		return nil
+	}
+	return uc
+}
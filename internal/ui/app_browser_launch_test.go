@@ -2599,6 +2599,7 @@ func TestApp_AttachPopupToTabReleasesRegistrationWhenWrapFails(t *testing.T) {
 	container.EXPECT().SetHexpand(true).Once()
 	container.EXPECT().SetVexpand(true).Once()
 	container.EXPECT().SetVisible(true).Once()
+	container.EXPECT().AddCssClass("workspace-container").Once()
 	factory.EXPECT().NewOverlay().Return(overlay).Once()
 	overlay.EXPECT().SetHexpand(true).Once()
 	overlay.EXPECT().SetVexpand(true).Once()
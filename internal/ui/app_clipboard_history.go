@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/puregotk/v4/glib"
+)
+
+// ToggleClipboardHistory shows or hides the copied URL history of the
+// focused browser window.
+func (a *App) ToggleClipboardHistory(ctx context.Context) error {
+	bw := a.lastFocusedBrowserWindow()
+	if bw == nil || bw.clipboardHistory == nil {
+		return errors.New("clipboard history not available")
+	}
+	if bw.clipboardHistory.IsVisible() {
+		bw.clipboardHistory.Hide()
+		return nil
+	}
+	logging.FromContext(ctx).Debug().Msg("showing clipboard history")
+	bw.clipboardHistory.Show(a.clipboardHistoryItems())
+	return nil
+}
+
+// runClipboardCommand runs the ">clipboard" omnibox command: no argument
+// opens the copied URL history, "clear" forgets it.
+func (a *App) runClipboardCommand(args string) error {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+		bw := a.lastFocusedBrowserWindow()
+		if bw == nil || bw.clipboardHistory == nil {
+			return errors.New("clipboard history not available")
+		}
+		// Shown once the omnibox closed, so the panel keeps the focus.
+		cb := glib.SourceFunc(func(_ uintptr) bool {
+			bw.clipboardHistory.Show(a.clipboardHistoryItems())
+			return false
+		})
+		glib.IdleAdd(&cb, 0)
+		return nil
+	case "clear":
+		if a.deps != nil {
+			a.deps.CopyURLUC.ClearHistory()
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown clipboard argument %q (want clear)", args)
+	}
+}
+
+func (a *App) clipboardHistoryItems() []component.ClipboardHistoryItem {
+	if a.deps == nil {
+		return nil
+	}
+	history := a.deps.CopyURLUC.History()
+	items := make([]component.ClipboardHistoryItem, len(history))
+	for i, entry := range history {
+		items[i] = component.ClipboardHistoryItem{URL: entry.URL, At: entry.At}
+	}
+	return items
+}
+
+// initClipboardHistory adds the copied URL history panel to a new browser
+// window.
+func (a *App) initClipboardHistory(ctx context.Context, bw *browserWindow) {
+	if bw == nil || bw.mainWindow == nil {
+		return
+	}
+	panel := component.NewClipboardHistory(component.ClipboardHistoryConfig{
+		OnCopy: func(url string) {
+			if a.deps == nil || a.deps.CopyURLUC == nil {
+				return
+			}
+			go func() {
+				if err := a.deps.CopyURLUC.Copy(ctx, url); err != nil {
+					logging.FromContext(ctx).Error().Err(err).Str("url", url).Msg("copy URL failed")
+					return
+				}
+				cb := glib.SourceFunc(func(_ uintptr) bool {
+					a.showToastOnBrowserWindow(ctx, bw, "URL copied", component.ToastSuccess)
+					return false
+				})
+				glib.IdleAdd(&cb, 0)
+			}()
+		},
+		OnOpen: func(url string) {
+			if err := a.navigateFromBrowserWindow(ctx, bw, url); err != nil {
+				logging.FromContext(ctx).Warn().Err(err).Str("url", url).Msg("failed to open copied URL")
+			}
+		},
+		OnClose: func() {
+			if wsView := a.activeWorkspaceViewForBrowserWindow(bw); wsView != nil {
+				if ws := a.activeWorkspaceForBrowserWindow(bw); ws != nil {
+					wsView.FocusPane(ws.ActivePaneID)
+				}
+			}
+		},
+	})
+	if panel == nil {
+		return
+	}
+	if w := panel.Widget(); w != nil {
+		bw.mainWindow.AddOverlay(w)
+	}
+	bw.clipboardHistory = panel
+}
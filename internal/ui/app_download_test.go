@@ -45,7 +45,7 @@ func TestDownloadEventAdapterToastSpecLifecycle(t *testing.T) {
 	require.True(t, finished.show)
 	require.Equal(t, component.ToastSuccess, finished.level)
 	require.Equal(t, downloadToastTerminalDuration, finished.duration)
-	require.Equal(t, "Download complete: archlinux.iso", finished.message)
+	require.Equal(t, "Download complete: /tmp/archlinux.iso", finished.message)
 }
 
 func TestDownloadEventAdapterToastSpecSuppressesDuplicateProgress(t *testing.T) {
@@ -84,3 +84,13 @@ func TestDownloadProgressPercentRoundsReasonably(t *testing.T) {
 	require.Equal(t, 37, downloadProgressPercent(0.37))
 	require.Equal(t, 100, downloadProgressPercent(1.2))
 }
+
+func TestDownloadDisplayPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	require.Equal(t, "~/Downloads/2024-03-09-example.com-report.pdf",
+		downloadDisplayPath(home+"/Downloads/2024-03-09-example.com-report.pdf", "report.pdf"))
+	require.Equal(t, "/tmp/report.pdf", downloadDisplayPath("file:///tmp/report.pdf", "report.pdf"))
+	require.Equal(t, "report.pdf", downloadDisplayPath("", "report.pdf"))
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"context"
+
+	"github.com/bnema/puregotk/v4/glib"
+)
+
+// focusMuteDelayMs lets the focus move between browser windows without
+// muting: the window losing it reports so before the next one gains it.
+const focusMuteDelayMs = 150
+
+// handleFocusMute mutes every pane shortly after the last browser window
+// loses the focus, when media.mute_on_focus_loss is set, and unmutes them
+// once a browser window gets it back.
+func (a *App) handleFocusMute(active bool) {
+	if a.wsCoord == nil {
+		return
+	}
+	ctx := context.Background()
+	if a.deps != nil && a.deps.Ctx != nil {
+		ctx = a.deps.Ctx
+	}
+
+	if active {
+		a.cancelFocusMute()
+		a.wsCoord.RestoreFocusMute(ctx)
+		return
+	}
+	if !a.runtimeConfigSnapshot().UI.Media.MuteOnFocusLoss || a.focusMuteTimer != 0 {
+		return
+	}
+	a.focusMuteCb = func(_ uintptr) bool {
+		a.focusMuteTimer = 0
+		if !a.anyBrowserWindowActive() {
+			a.wsCoord.MuteAllForFocusLoss(ctx)
+		}
+		return false
+	}
+	a.focusMuteTimer = glib.TimeoutAdd(focusMuteDelayMs, &a.focusMuteCb, 0)
+}
+
+func (a *App) cancelFocusMute() {
+	if a.focusMuteTimer != 0 {
+		glib.SourceRemove(a.focusMuteTimer)
+		a.focusMuteTimer = 0
+	}
+}
+
+// anyBrowserWindowActive reports whether a browser window has the focus.
+func (a *App) anyBrowserWindowActive() bool {
+	for _, bw := range a.browserWindows {
+		if bw != nil && bw.mainWindow.IsActive() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package ui
+
+import (
+	"context"
+	"errors"
+)
+
+// OpenLinkInPane captures the link hovered in the active pane and enters pane
+// mode, whose numbers then pick the pane the link loads in.
+func (a *App) OpenLinkInPane(ctx context.Context) error {
+	bw := a.lastFocusedBrowserWindow()
+	if a.wsCoord == nil || bw == nil || bw.keyboardHandler == nil {
+		return errors.New("open link in pane not available")
+	}
+	if !a.wsCoord.BeginOpenLinkInPane(ctx) {
+		return nil
+	}
+	bw.keyboardHandler.EnterPaneMode()
+	return nil
+}
@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/notification"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/puregotk/v4/glib"
+)
+
+// ToggleNotifications shows or hides the notification center of the focused
+// browser window.
+func (a *App) ToggleNotifications(ctx context.Context) error {
+	bw := a.lastFocusedBrowserWindow()
+	if bw == nil || bw.notificationCenter == nil {
+		return errors.New("notification center not available")
+	}
+	if bw.notificationCenter.IsVisible() {
+		bw.notificationCenter.Hide()
+		return nil
+	}
+	logging.FromContext(ctx).Debug().Msg("showing notification center")
+	bw.notificationCenter.Show(a.notificationItems())
+	return nil
+}
+
+// runNotificationsCommand runs the ">notifications" omnibox command: no
+// argument opens the notification center, "clear" dismisses every
+// notification.
+func (a *App) runNotificationsCommand(args string) error {
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+		bw := a.lastFocusedBrowserWindow()
+		if bw == nil || bw.notificationCenter == nil {
+			return errors.New("notification center not available")
+		}
+		// Shown once the omnibox closed, so the panel keeps the focus.
+		cb := glib.SourceFunc(func(_ uintptr) bool {
+			bw.notificationCenter.Show(a.notificationItems())
+			return false
+		})
+		glib.IdleAdd(&cb, 0)
+		return nil
+	case "clear":
+		a.dismissAllNotifications()
+		return nil
+	default:
+		return fmt.Errorf("unknown notifications argument %q (want clear)", args)
+	}
+}
+
+// recordToast keeps a shown toast for the notification center and refreshes
+// the open centers. paneID is empty for toasts shown over a whole window.
+func (a *App) recordToast(paneID entity.PaneID, message string, level component.ToastLevel) {
+	if a.notificationsUC == nil {
+		return
+	}
+	a.notificationsUC.Record(message, notificationLevel(level), paneID)
+	a.refreshNotificationCenters()
+}
+
+// dismissAllNotifications clears the notification history and every open
+// center.
+func (a *App) dismissAllNotifications() {
+	a.notificationsUC.DismissAll()
+	a.refreshNotificationCenters()
+}
+
+func (a *App) refreshNotificationCenters() {
+	var items []component.NotificationItem
+	for _, bw := range a.browserWindows {
+		if bw == nil || bw.notificationCenter == nil || !bw.notificationCenter.IsVisible() {
+			continue
+		}
+		if items == nil {
+			items = a.notificationItems()
+		}
+		bw.notificationCenter.SetItems(items)
+	}
+}
+
+func (a *App) notificationItems() []component.NotificationItem {
+	recent := a.notificationsUC.Recent()
+	items := make([]component.NotificationItem, len(recent))
+	for i, entry := range recent {
+		items[i] = component.NotificationItem{
+			Message: entry.Message,
+			Level:   toastLevel(entry.Level),
+			At:      entry.At,
+		}
+	}
+	return items
+}
+
+// initNotificationCenter adds the notification center panel to a new
+// browser window.
+func (a *App) initNotificationCenter(bw *browserWindow) {
+	if bw == nil || bw.mainWindow == nil {
+		return
+	}
+	center := component.NewNotificationCenter(component.NotificationCenterConfig{
+		OnDismissAll: a.dismissAllNotifications,
+		OnClose: func() {
+			if wsView := a.activeWorkspaceViewForBrowserWindow(bw); wsView != nil {
+				if ws := a.activeWorkspaceForBrowserWindow(bw); ws != nil {
+					wsView.FocusPane(ws.ActivePaneID)
+				}
+			}
+		},
+	})
+	if center == nil {
+		return
+	}
+	if w := center.Widget(); w != nil {
+		bw.mainWindow.AddOverlay(w)
+	}
+	bw.notificationCenter = center
+}
+
+func notificationLevel(level component.ToastLevel) notification.Level {
+	switch level {
+	case component.ToastSuccess:
+		return notification.LevelSuccess
+	case component.ToastWarning:
+		return notification.LevelWarning
+	case component.ToastError:
+		return notification.LevelError
+	default:
+		return notification.LevelInfo
+	}
+}
+
+func toastLevel(level notification.Level) component.ToastLevel {
+	switch level {
+	case notification.LevelSuccess:
+		return component.ToastSuccess
+	case notification.LevelWarning:
+		return component.ToastWarning
+	case notification.LevelError:
+		return component.ToastError
+	default:
+		return component.ToastInfo
+	}
+}
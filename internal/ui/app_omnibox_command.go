@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/formfill"
+	"github.com/bnema/dumber/internal/domain/measure"
+	"github.com/bnema/dumber/internal/domain/multisearch"
+	"github.com/bnema/dumber/internal/domain/readaloud"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/coordinator"
+)
+
+const (
+	// omniboxCommandFill fills the visible text fields of the active page.
+	omniboxCommandFill = "fill"
+	// omniboxCommandMeasure toggles the measure overlay on the active page.
+	omniboxCommandMeasure = "measure"
+	// omniboxCommandMulti searches several engines at once, one pane each.
+	omniboxCommandMulti = "multi"
+	// omniboxCommandRead reads the active page aloud, or pauses, resumes or
+	// stops the reading.
+	omniboxCommandRead = "read"
+	// omniboxCommandNotifications opens the notification center, or clears it.
+	omniboxCommandNotifications = "notifications"
+	// omniboxCommandClipboard opens the copied URL history, or clears it.
+	omniboxCommandClipboard = "clipboard"
+	// omniboxCommandMock answers the active page's requests with a set of
+	// mock responses, or stops.
+	omniboxCommandMock = "mock"
+)
+
+// runOmniboxCommand runs a command submitted as ">name args" in the omnibox.
+func (a *App) runOmniboxCommand(ctx context.Context, command string) error {
+	name, args, _ := strings.Cut(command, " ")
+	switch name {
+	case omniboxCommandFill:
+		opts, err := formfill.ParseArgs(args)
+		if err != nil {
+			return err
+		}
+		wv := a.omniboxCommandTarget(ctx)
+		if wv == nil || wv.IsDestroyed() {
+			return errors.New("no active page to fill")
+		}
+		logging.FromContext(ctx).Debug().
+			Bool("include_passwords", opts.IncludePasswords).
+			Msg("filling form fields from omnibox")
+		wv.RunJavaScript(ctx, formfill.Script(opts))
+		return nil
+	case omniboxCommandMeasure:
+		wv := a.omniboxCommandTarget(ctx)
+		if wv == nil || wv.IsDestroyed() {
+			return errors.New("no active page to measure")
+		}
+		logging.FromContext(ctx).Debug().Msg("toggling measure overlay from omnibox")
+		wv.RunJavaScript(ctx, measure.ToggleScript())
+		return nil
+	case omniboxCommandMulti:
+		return a.openMultiSearch(ctx, args)
+	case omniboxCommandRead:
+		control, err := readaloud.ParseControl(args)
+		if err != nil {
+			return err
+		}
+		return a.controlReadAloud(ctx, control, a.omniboxCommandTarget(ctx))
+	case omniboxCommandNotifications:
+		return a.runNotificationsCommand(args)
+	case omniboxCommandClipboard:
+		return a.runClipboardCommand(args)
+	case omniboxCommandMock:
+		return a.runMockCommand(ctx, args)
+	default:
+		return fmt.Errorf("unknown command %q", name)
+	}
+}
+
+// openMultiSearch opens a tab searching the query in args on several
+// engines, one pane each in a grid: the engines named with leading "!key"
+// words, or multi_search_engines.
+func (a *App) openMultiSearch(ctx context.Context, args string) error {
+	ui := a.runtimeConfigSnapshot().UI
+	keys, query := multisearch.ParseArgs(args)
+	if len(keys) == 0 {
+		keys = ui.MultiSearchEngines
+	}
+	templates := make(map[string]string, len(ui.SearchShortcuts))
+	for key, shortcut := range ui.SearchShortcuts {
+		templates[key] = shortcut.URL
+	}
+	urls, err := multisearch.URLs(keys, templates, query)
+	if err != nil {
+		return err
+	}
+	snap, ok := multisearch.Tab(urls)
+	if !ok {
+		return errors.New("no search engines to search with")
+	}
+	tab := entity.TabFromSnapshot(snap, a.generateID)
+	if tab == nil {
+		return errors.New("failed to build the search tab")
+	}
+	logging.FromContext(ctx).Debug().Int("engines", len(urls)).Msg("opening multi-search tab")
+	return a.withFocusedTabTarget(ctx, "multi-search", true, func(target coordinator.TabTarget) error {
+		return a.addRestoredTab(ctx, target, tab)
+	})
+}
+
+// omniboxCommandTarget returns the page the omnibox was opened over: the
+// floating pane when its omnibox is showing, the active pane otherwise.
+func (a *App) omniboxCommandTarget(ctx context.Context) port.WebView {
+	session, _ := a.activeFloatingSession()
+	if session != nil && session.pane != nil && session.pane.IsVisible() && session.pane.IsOmniboxVisible() {
+		return session.webView
+	}
+	if a.contentCoord == nil {
+		return nil
+	}
+	return a.contentCoord.ActiveWebView(ctx)
+}
@@ -21,6 +21,10 @@ func TestBuildOmniboxConfigUsesRuntimeUIConfig(t *testing.T) {
 		Omnibox: entity.RuntimeOmniboxConfig{
 			InitialBehavior: entity.OmniboxInitialBehaviorMostVisited,
 			MostVisitedDays: 7,
+			MatchMode:       entity.OmniboxMatchModeFuzzy,
+
+			NextResultKeys:     []string{"ctrl+n"},
+			PreviousResultKeys: []string{"ctrl+p"},
 		},
 	}
 
@@ -38,6 +42,15 @@ func TestBuildOmniboxConfigUsesRuntimeUIConfig(t *testing.T) {
 	if got.InitialBehavior != entity.OmniboxInitialBehaviorMostVisited {
 		t.Fatalf("InitialBehavior = %q, want most_visited", got.InitialBehavior)
 	}
+	if got.MatchMode != entity.OmniboxMatchModeFuzzy {
+		t.Fatalf("MatchMode = %q, want fuzzy", got.MatchMode)
+	}
+	if len(got.NextResultKeys) != 1 || got.NextResultKeys[0] != "ctrl+n" {
+		t.Fatalf("NextResultKeys = %q, want [ctrl+n]", got.NextResultKeys)
+	}
+	if len(got.PreviousResultKeys) != 1 || got.PreviousResultKeys[0] != "ctrl+p" {
+		t.Fatalf("PreviousResultKeys = %q, want [ctrl+p]", got.PreviousResultKeys)
+	}
 	if got.UIScale != 1.35 {
 		t.Fatalf("UIScale = %v, want 1.35", got.UIScale)
 	}
@@ -51,3 +64,24 @@ func TestBuildOmniboxConfigUsesRuntimeUIConfig(t *testing.T) {
 		t.Fatalf("NormalizeNavigationURL propagated callback = %q", normalized)
 	}
 }
+
+func TestOmniboxPrefillText(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		url     string
+		want    string
+	}{
+		{name: "disabled opens empty", enabled: false, url: "https://example.com/a", want: ""},
+		{name: "enabled uses current url", enabled: true, url: "https://example.com/a", want: "https://example.com/a"},
+		{name: "blank page opens empty", enabled: true, url: "about:blank", want: ""},
+		{name: "no url opens empty", enabled: true, url: "  ", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := omniboxPrefillText(tt.enabled, tt.url); got != tt.want {
+				t.Errorf("omniboxPrefillText(%v, %q) = %q, want %q", tt.enabled, tt.url, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/panickey"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/dumber/internal/ui/input"
+)
+
+// Panic runs the panic key: it locks every window behind the PIN prompt when
+// privacy.panic_pin is set, loads privacy.panic_neutral_url in the active pane
+// when set, and minimizes every window.
+func (a *App) Panic(ctx context.Context) error {
+	cfg := a.runtimeConfigSnapshot().UI.Privacy
+	for _, step := range panickey.Steps(cfg.PanicNeutralURL, cfg.PanicPIN) {
+		switch step {
+		case panickey.StepLock:
+			a.lockBrowserWindows(ctx, cfg.PanicPIN)
+		case panickey.StepNeutralPage:
+			a.loadPanicNeutralPage(ctx, cfg.PanicNeutralURL)
+		case panickey.StepMinimize:
+			a.minimizeBrowserWindows()
+		}
+	}
+	logging.FromContext(ctx).Info().Bool("locked", a.panicGate.Locked()).Msg("panic key: browser hidden")
+	return nil
+}
+
+// panicLocked reports whether the panic key locked the browser and the PIN
+// has not been entered yet.
+func (a *App) panicLocked() bool {
+	return a.panicGate.Locked()
+}
+
+// panicBlocksAction reports whether action is ignored because the browser is
+// locked. The panic key itself still works, to hide the windows again.
+func (a *App) panicBlocksAction(action input.Action) bool {
+	return action != input.ActionPanic && a.panicLocked()
+}
+
+func (a *App) lockBrowserWindows(ctx context.Context, pin string) {
+	if !a.panicGate.Lock(pin) {
+		return
+	}
+	for _, bw := range a.browserWindows {
+		a.showLockScreen(ctx, bw)
+	}
+}
+
+// showLockScreen covers bw with the PIN prompt and hides its page title.
+func (a *App) showLockScreen(ctx context.Context, bw *browserWindow) {
+	if bw == nil || bw.lockScreen == nil {
+		return
+	}
+	bw.lockScreen.Show(func(pin string) bool {
+		return a.unlockPanic(ctx, pin)
+	})
+	if bw.mainWindow != nil {
+		bw.mainWindow.SetTitle(appTitle)
+	}
+}
+
+// unlockPanic uncovers every window when pin matches and reports whether it
+// did.
+func (a *App) unlockPanic(ctx context.Context, pin string) bool {
+	if !a.panicGate.Unlock(pin) {
+		logging.FromContext(ctx).Info().Msg("panic lock: wrong PIN")
+		return false
+	}
+	for _, bw := range a.browserWindows {
+		if bw == nil {
+			continue
+		}
+		if bw.lockScreen != nil {
+			bw.lockScreen.Hide()
+		}
+		if bw.tabs != nil {
+			a.updateWindowTitleFromActivePane(bw.tabs.ActiveTabID)
+		}
+	}
+	logging.FromContext(ctx).Info().Msg("panic lock: unlocked")
+	return true
+}
+
+func (a *App) loadPanicNeutralPage(ctx context.Context, neutralURL string) {
+	_, wv := a.activeWebViewForBrowserWindow(a.lastFocusedBrowserWindow())
+	if wv == nil || wv.IsDestroyed() {
+		return
+	}
+	if err := wv.LoadURI(ctx, neutralURL); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msg("panic key: failed to load neutral page")
+	}
+}
+
+func (a *App) minimizeBrowserWindows() {
+	for _, bw := range a.browserWindows {
+		if bw == nil || bw.mainWindow == nil || bw.mainWindow.Window() == nil {
+			continue
+		}
+		bw.mainWindow.Window().Minimize()
+	}
+}
+
+// initLockScreen adds the panic lock screen to a new browser window, already
+// shown when the browser is locked. It must run after initChrome so the lock
+// screen stacks above the toasters and sidebars.
+func (a *App) initLockScreen(ctx context.Context, bw *browserWindow) {
+	lockScreen := component.NewLockScreen()
+	if lockScreen == nil || bw == nil || bw.mainWindow == nil {
+		return
+	}
+	if w := lockScreen.Widget(); w != nil {
+		bw.mainWindow.AddOverlay(w)
+	}
+	bw.lockScreen = lockScreen
+	if a.panicLocked() {
+		a.showLockScreen(ctx, bw)
+	}
+}
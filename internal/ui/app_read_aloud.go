@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/readaloud"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// ReadAloud runs the read-aloud key: it starts reading the active page
+// aloud, or pauses or resumes the current reading. Failures are shown as a
+// toast.
+func (a *App) ReadAloud(ctx context.Context) error {
+	var wv port.WebView
+	if a.contentCoord != nil {
+		wv = a.contentCoord.ActiveWebView(ctx)
+	}
+	a.toastReadAloudError(ctx, a.controlReadAloud(ctx, "", wv))
+	return nil
+}
+
+// StopReadAloud ends the current reading, showing failures as a toast.
+func (a *App) StopReadAloud(ctx context.Context) error {
+	a.toastReadAloudError(ctx, a.controlReadAloud(ctx, readaloud.ControlStop, nil))
+	return nil
+}
+
+// controlReadAloud applies control to the reading; starting reads wv. An
+// empty control follows from the state of the reading (see readaloud.Toggle).
+func (a *App) controlReadAloud(ctx context.Context, control readaloud.Control, wv port.WebView) error {
+	player := a.speechPlayer()
+	if player == nil {
+		return errors.New("reading aloud is not available")
+	}
+	if control == "" {
+		control = readaloud.Toggle(player.State())
+	}
+	switch control {
+	case readaloud.ControlPause:
+		if !player.Pause() {
+			return errors.New("nothing is being read aloud")
+		}
+		a.showReadAloudToast(ctx, "Reading paused", component.ToastInfo)
+	case readaloud.ControlResume:
+		if !player.Resume() {
+			return errors.New("no paused reading to resume")
+		}
+		a.showReadAloudToast(ctx, "Reading resumed", component.ToastInfo)
+	case readaloud.ControlStop:
+		if player.State() == readaloud.StateIdle {
+			return errors.New("nothing is being read aloud")
+		}
+		player.Stop()
+		a.showReadAloudToast(ctx, "Reading stopped", component.ToastInfo)
+	default:
+		return a.startReadAloud(ctx, player, wv)
+	}
+	return nil
+}
+
+// startReadAloud extracts the readable text of wv and speaks it through
+// read_aloud.command, replacing the current reading.
+func (a *App) startReadAloud(ctx context.Context, player port.SpeechPlayer, wv port.WebView) error {
+	argv, err := readaloud.ParseCommand(a.runtimeConfigSnapshot().UI.ReadAloud.Command)
+	if errors.Is(err, readaloud.ErrNoCommand) {
+		return errors.New("set read_aloud.command to read pages aloud")
+	}
+	if err != nil {
+		return fmt.Errorf("read_aloud.command: %w", err)
+	}
+	if wv == nil || wv.IsDestroyed() {
+		return errors.New("no active page to read")
+	}
+	reader, ok := wv.(port.ReadableTextReader)
+	if !ok {
+		return errors.New("reading aloud is not supported by this engine")
+	}
+
+	reader.ReadReadableText(ctx, func(text string) {
+		chunks := readaloud.Chunks(text, readaloud.MaxChunkLen)
+		if len(chunks) == 0 {
+			a.showReadAloudToast(ctx, "No readable text on this page", component.ToastWarning)
+			return
+		}
+		if err := player.Play(ctx, argv, chunks); err != nil {
+			a.toastReadAloudError(ctx, err)
+			return
+		}
+		logging.FromContext(ctx).Debug().Int("chunks", len(chunks)).Msg("reading page aloud")
+		a.showReadAloudToast(ctx, "Reading aloud", component.ToastInfo)
+	})
+	return nil
+}
+
+func (a *App) speechPlayer() port.SpeechPlayer {
+	if a.deps == nil {
+		return nil
+	}
+	return a.deps.SpeechPlayer
+}
+
+func (a *App) showReadAloudToast(ctx context.Context, message string, level component.ToastLevel) {
+	if a.wsCoord != nil {
+		a.wsCoord.ShowToastOnActivePane(ctx, message, level)
+	}
+}
+
+func (a *App) toastReadAloudError(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	logging.FromContext(ctx).Debug().Err(err).Msg("read aloud failed")
+	a.showReadAloudToast(ctx, err.Error(), component.ToastError)
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/requestmock"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// runMockCommand runs ">mock <set>", answering the requests of the target
+// page with the mocks of set, or ">mock off", sending them to the network
+// again. The mocks file is read afresh each time.
+func (a *App) runMockCommand(ctx context.Context, args string) error {
+	name := strings.TrimSpace(args)
+	wv := a.omniboxCommandTarget(ctx)
+	if wv == nil || wv.IsDestroyed() {
+		return errors.New("no active page to mock")
+	}
+	capable, ok := wv.(port.RequestMockCapable)
+	if !ok {
+		return errors.New("request mocks are not supported by this engine")
+	}
+
+	if name == requestmock.Off {
+		capable.SetRequestMocks(ctx, nil)
+		a.showRequestMockToast(ctx, "Request mocks off")
+		return nil
+	}
+
+	if a.deps == nil || a.deps.RequestMocks == nil {
+		return errors.New("request mocks not available")
+	}
+	sets, err := a.deps.RequestMocks.LoadRequestMocks(ctx)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		if len(sets) == 0 {
+			return errors.New("no request mock sets defined")
+		}
+		return fmt.Errorf("usage: >mock <set>|off (sets: %s)", strings.Join(requestmock.Names(sets), ", "))
+	}
+	set, ok := requestmock.Find(sets, name)
+	if !ok {
+		return fmt.Errorf("unknown request mock set %q", name)
+	}
+
+	logging.FromContext(ctx).Debug().Str("set", set.Name).Int("mocks", len(set.Mocks)).Msg("mocking requests from omnibox")
+	capable.SetRequestMocks(ctx, set.Mocks)
+	a.showRequestMockToast(ctx, fmt.Sprintf("Mocking requests with %q", set.Name))
+	return nil
+}
+
+func (a *App) showRequestMockToast(ctx context.Context, message string) {
+	if a.wsCoord != nil {
+		a.wsCoord.ShowToastOnActivePane(ctx, message, component.ToastInfo)
+	}
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// savePageLoadTimeout bounds how long SavePageURL waits for the page to load
+// before giving up.
+const savePageLoadTimeout = 60 * time.Second
+
+// SavePageURL loads url in a hidden WebView and saves it to path.
+// It is safe to call from any goroutine and blocks until the file is written.
+func (a *App) SavePageURL(ctx context.Context, url, path string, format entity.PageSaveFormat) error {
+	log := logging.FromContext(ctx)
+	if a.engine == nil {
+		return errors.New("browser engine not available")
+	}
+
+	loaded := make(chan struct{})
+	terminated := make(chan string, 1)
+	var wv port.WebView
+	var createErr error
+	if err := a.runOnMainThreadChecked("ui.save_page_create", func() {
+		wv, createErr = a.engine.Factory().Create(ctx)
+		if createErr != nil {
+			return
+		}
+		if _, ok := wv.(port.PageSaver); !ok {
+			createErr = errors.New("saving pages is not supported by this engine")
+			wv.Destroy()
+			return
+		}
+		var finished bool
+		wv.SetCallbacks(&port.WebViewCallbacks{
+			OnLoadChanged: func(event port.LoadEvent) {
+				if event == port.LoadFinished && !finished {
+					finished = true
+					close(loaded)
+				}
+			},
+			OnWebProcessTerminated: func(_ port.WebProcessTerminationReason, reasonLabel string, _ string) {
+				select {
+				case terminated <- reasonLabel:
+				default:
+				}
+			},
+		})
+		createErr = wv.LoadURI(ctx, url)
+		if createErr != nil {
+			wv.Destroy()
+		}
+	}); err != nil {
+		return err
+	}
+	if createErr != nil {
+		return fmt.Errorf("save page: %w", createErr)
+	}
+	defer func() {
+		_ = a.runOnMainThreadChecked("ui.save_page_destroy", wv.Destroy)
+	}()
+
+	loadTimer := time.NewTimer(savePageLoadTimeout)
+	defer loadTimer.Stop()
+	select {
+	case <-loaded:
+	case reason := <-terminated:
+		return fmt.Errorf("save page: web process terminated (%s)", reason)
+	case <-loadTimer.C:
+		return fmt.Errorf("save page: %s did not finish loading within %s", url, savePageLoadTimeout)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	var done <-chan error
+	if err := a.runOnMainThreadChecked("ui.save_page_write", func() {
+		done = wv.(port.PageSaver).SavePage(ctx, path, format)
+	}); err != nil {
+		return err
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	log.Info().
+		Str("url_host", logging.SafeURLHost(url)).
+		Str("format", string(format)).
+		Str("path", path).
+		Msg("page saved")
+	return nil
+}
+
+var _ port.PageSaveController = (*App)(nil)
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// SearchSelection runs the search_selection key: it searches the text
+// selected in the active pane with default_search_engine.
+func (a *App) SearchSelection(ctx context.Context) error {
+	if a.contentCoord == nil {
+		return errors.New("search selection not available")
+	}
+	paneID := a.contentCoord.ActivePaneID(ctx)
+	wv := a.contentCoord.GetWebView(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return errors.New("no active page to search from")
+	}
+	reader, ok := wv.(port.SelectionTextReader)
+	if !ok {
+		return errors.New("searching the selection is not supported by this engine")
+	}
+	reader.ReadSelectionText(ctx, func(text string) {
+		a.searchSelection(ctx, paneID, text)
+	})
+	return nil
+}
+
+// searchSelection opens the default_search_engine results for text, the
+// selection of paneID. search_selection_in_new_pane decides whether they load
+// in a new pane split from the active one or replace the page of paneID.
+func (a *App) searchSelection(ctx context.Context, paneID entity.PaneID, text string) {
+	ui := a.runtimeConfigSnapshot().UI
+	searchURL := urlutil.SelectionSearchURL(text, ui.DefaultSearchEngine)
+	if searchURL == "" {
+		a.showSearchSelectionToast(ctx, "No text selected", component.ToastWarning)
+		return
+	}
+
+	var err error
+	if ui.SearchSelectionInNewPane {
+		err = a.openSearchSelectionInNewPane(ctx, searchURL)
+	} else {
+		err = a.navigateSearchSelection(ctx, paneID, searchURL)
+	}
+	if err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("search selection failed")
+		a.showSearchSelectionToast(ctx, "Could not search the selection", component.ToastError)
+	}
+}
+
+func (a *App) openSearchSelectionInNewPane(ctx context.Context, searchURL string) error {
+	if a.wsCoord == nil {
+		return fmt.Errorf("workspace coordinator not initialized")
+	}
+	return a.wsCoord.SplitWithURL(ctx, usecase.SplitRight, searchURL)
+}
+
+func (a *App) navigateSearchSelection(ctx context.Context, paneID entity.PaneID, searchURL string) error {
+	if a.navCoord == nil || a.contentCoord == nil {
+		return fmt.Errorf("navigation coordinator not initialized")
+	}
+	return a.navCoord.NavigateWebView(ctx, searchURL, paneID, a.contentCoord.GetWebView(paneID))
+}
+
+func (a *App) showSearchSelectionToast(ctx context.Context, message string, level component.ToastLevel) {
+	if a.wsCoord != nil {
+		a.wsCoord.ShowToastOnActivePane(ctx, message, level)
+	}
+}
@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/window"
+)
+
+// windowGeometrySaveDelay coalesces the notify storm of an interactive resize
+// into a single write.
+const windowGeometrySaveDelay = 500 * time.Millisecond
+
+// windowGeometryKeeper remembers the last browser window geometry and writes
+// it to the store shortly after it stops changing. All methods are nil-safe.
+type windowGeometryKeeper struct {
+	store port.WindowGeometryStore
+
+	mu      sync.Mutex
+	last    entity.WindowGeometry
+	known   bool
+	pending bool
+	timer   *time.Timer
+}
+
+func newWindowGeometryKeeper(store port.WindowGeometryStore) *windowGeometryKeeper {
+	if store == nil {
+		return nil
+	}
+	return &windowGeometryKeeper{store: store}
+}
+
+// lastGeometry returns the most recent geometry, loading the saved one on
+// first use.
+func (k *windowGeometryKeeper) lastGeometry(ctx context.Context) (entity.WindowGeometry, bool) {
+	if k == nil {
+		return entity.WindowGeometry{}, false
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.known {
+		return k.last, true
+	}
+	geometry, ok, err := k.store.Load(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msg("ignoring saved window geometry")
+		return entity.WindowGeometry{}, false
+	}
+	if ok {
+		k.last, k.known = geometry, true
+	}
+	return geometry, ok
+}
+
+// schedule records geometry and saves it once it has been stable for
+// windowGeometrySaveDelay.
+func (k *windowGeometryKeeper) schedule(ctx context.Context, geometry entity.WindowGeometry) {
+	if k == nil || !geometry.Valid() {
+		return
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.known && k.last == geometry {
+		return
+	}
+	k.last, k.known, k.pending = geometry, true, true
+	if k.timer != nil {
+		k.timer.Stop()
+	}
+	k.timer = time.AfterFunc(windowGeometrySaveDelay, func() { k.flush(ctx) })
+}
+
+// flush writes the pending geometry, if any, right away.
+func (k *windowGeometryKeeper) flush(ctx context.Context) {
+	if k == nil {
+		return
+	}
+	k.mu.Lock()
+	if k.timer != nil {
+		k.timer.Stop()
+		k.timer = nil
+	}
+	geometry, pending := k.last, k.pending
+	k.pending = false
+	k.mu.Unlock()
+
+	if !pending {
+		return
+	}
+	// Shutdown cancels the app context; the final write must still happen.
+	if err := k.store.Save(context.WithoutCancel(ctx), geometry); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msg("failed to save window geometry")
+	}
+}
+
+// initWindowGeometry sizes a new browser window and starts tracking its
+// geometry. A remembered size is shrunk to fit the largest monitor so a
+// window saved on a bigger screen still opens fully visible.
+func (a *App) initWindowGeometry(ctx context.Context, mainWindow *window.MainWindow, cfg entity.RuntimeWindowConfig) {
+	geometry := entity.WindowGeometry{Width: cfg.DefaultWidth, Height: cfg.DefaultHeight}
+	if cfg.RememberGeometry {
+		if saved, ok := a.windowGeometry.lastGeometry(ctx); ok && saved.Valid() {
+			geometry = saved
+		}
+	}
+	if width, height, ok := mainWindow.MonitorBounds(); ok {
+		geometry = geometry.ClampTo(width, height)
+	}
+	mainWindow.ApplyGeometry(geometry)
+
+	if a.windowGeometry == nil {
+		return
+	}
+	mainWindow.ConnectGeometryChanged(func() {
+		a.trackWindowGeometry(ctx, mainWindow)
+	})
+}
+
+// trackWindowGeometry schedules a save of the window's current geometry.
+func (a *App) trackWindowGeometry(ctx context.Context, mainWindow *window.MainWindow) {
+	if !a.runtimeConfigSnapshot().UI.Window.RememberGeometry {
+		return
+	}
+	a.windowGeometry.schedule(ctx, mainWindow.Geometry())
+}
+
+// saveWindowGeometry records the window's final geometry and writes it
+// immediately. Called when a window closes.
+func (a *App) saveWindowGeometry(ctx context.Context, mainWindow *window.MainWindow) {
+	if a.windowGeometry == nil {
+		return
+	}
+	a.trackWindowGeometry(ctx, mainWindow)
+	a.windowGeometry.flush(ctx)
+}
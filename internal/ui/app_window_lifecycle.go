@@ -52,8 +52,11 @@ func (a *App) createBrowserWindow(ctx context.Context, initialURL string) (*brow
 		a.mainWindow = mainWindow
 	}
 
+	a.initWindowGeometry(ctx, mainWindow, runtimeCfg.Window)
+
 	closeRequestCb := func(_ gtk.Window) bool {
 		log.Info().Msg("browser window close requested")
+		a.saveWindowGeometry(ctx, mainWindow)
 		a.removeBrowserWindow(browserWindow.id)
 		return false
 	}
@@ -68,6 +71,7 @@ func (a *App) createBrowserWindow(ctx context.Context, initialURL string) (*brow
 		a.wireBrowserWindowTabBar(ctx, browserWindow)
 	}
 	browserWindow.initChrome(ctx, a)
+	a.initLockScreen(ctx, browserWindow)
 
 	// Apply GTK CSS styling from theme manager.
 	if a.deps == nil || a.deps.Theme == nil {
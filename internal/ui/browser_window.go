@@ -13,6 +13,7 @@ import (
 	"github.com/bnema/dumber/internal/shared/syncdispatch"
 	"github.com/bnema/dumber/internal/ui/component"
 	"github.com/bnema/dumber/internal/ui/coordinator"
+	"github.com/bnema/dumber/internal/ui/dialog"
 	"github.com/bnema/dumber/internal/ui/focus"
 	"github.com/bnema/dumber/internal/ui/input"
 	"github.com/bnema/dumber/internal/ui/layout"
@@ -46,12 +47,16 @@ type browserWindow struct {
 	keyboardHandler        *input.KeyboardHandler
 	globalShortcutHandler  *input.GlobalShortcutHandler
 	permissionDialog       port.PermissionDialogPresenter
+	scriptDialog           *dialog.ScriptDialog
 	webrtcIndicator        *component.WebRTCPermissionIndicator
 	historySidebar         *component.HistorySidebar
 	favoritesSidebar       *component.FavoritesSidebar
 	historySidebarReloader historySidebarReloader
 	sidebarVisible         bool
 	activeSidebarKind      nativeSidebarKind
+	lockScreen             *component.LockScreen
+	notificationCenter     *component.NotificationCenter
+	clipboardHistory       *component.ClipboardHistory
 }
 
 func (bw *browserWindow) detachInputForDestroy() {
@@ -98,11 +103,14 @@ func (bw *browserWindow) clearShellState() {
 	bw.tabPicker = nil
 	bw.tabPickerWidget = nil
 	bw.tabPickerPaneID = ""
+	bw.notificationCenter = nil
+	bw.clipboardHistory = nil
 	bw.insertAccentUC = nil
 	bw.accentPicker = nil
 	bw.keyboardHandler = nil
 	bw.globalShortcutHandler = nil
 	bw.permissionDialog = nil
+	bw.scriptDialog = nil
 	bw.webrtcIndicator = nil
 	bw.historySidebar = nil
 	bw.favoritesSidebar = nil
@@ -121,6 +129,8 @@ func (bw *browserWindow) initChrome(ctx context.Context, a *App) {
 	bw.initAccentPicker(ctx, a)
 	bw.initSessionManager(ctx, a)
 	bw.initTabPicker(ctx, a)
+	a.initNotificationCenter(bw)
+	a.initClipboardHistory(ctx, bw)
 	bw.initHistorySidebar(ctx, a)
 	bw.initFavoritesSidebar(ctx, a)
 }
@@ -243,6 +253,15 @@ func (bw *browserWindow) initSessionManager(ctx context.Context, a *App) {
 		},
 		OnOpen: func(sessionID entity.SessionID) {
 			log.Info().Str("session_id", string(sessionID)).Msg("session restoration requested")
+			if a.runtimeConfigSnapshot().UI.Session.RestoreStrategy == entity.SessionRestoreMerge {
+				if err := a.mergeSession(ctx, bw, sessionID); err != nil {
+					log.Error().Err(err).Str("session_id", string(sessionID)).Msg("failed to merge session")
+					a.showToastOnBrowserWindow(ctx, bw, "Session merge failed", component.ToastWarning)
+					return
+				}
+				a.showToastOnBrowserWindow(ctx, bw, "Session merged", component.ToastSuccess)
+				return
+			}
 			if spawner := a.deps.SessionSpawner; spawner == nil {
 				log.Warn().Str("session_id", string(sessionID)).Msg("session spawner not available")
 				return
@@ -251,9 +270,7 @@ func (bw *browserWindow) initSessionManager(ctx context.Context, a *App) {
 			}
 		},
 		OnToast: func(ctx context.Context, message string, level component.ToastLevel) {
-			if bw.appToaster != nil {
-				bw.appToaster.Show(ctx, message, level)
-			}
+			a.showToastOnBrowserWindow(ctx, bw, message, level)
 		},
 	})
 
@@ -14,6 +14,7 @@ import (
 	"github.com/bnema/dumber/internal/shared/syncdispatch"
 	"github.com/bnema/dumber/internal/ui/component"
 	contentcoord "github.com/bnema/dumber/internal/ui/coordinator/content"
+	"github.com/bnema/dumber/internal/ui/dialog"
 	"github.com/bnema/dumber/internal/ui/focus"
 	"github.com/bnema/dumber/internal/ui/input"
 	"github.com/bnema/dumber/internal/ui/layout"
@@ -45,6 +46,7 @@ func TestBrowserWindow_RemoveBrowserWindowClearsShellState(t *testing.T) {
 	setShellField(t, removed, "keyboardHandler", &input.KeyboardHandler{})
 	setShellField(t, removed, "globalShortcutHandler", &input.GlobalShortcutHandler{})
 	setShellField(t, removed, "permissionDialog", (*testPermissionDialogPresenter)(nil))
+	setShellField(t, removed, "scriptDialog", &dialog.ScriptDialog{})
 	setShellField(t, removed, "webrtcIndicator", &component.WebRTCPermissionIndicator{})
 	setShellField(t, removed, "historySidebar", &component.HistorySidebar{})
 
@@ -63,6 +65,7 @@ func TestBrowserWindow_RemoveBrowserWindowClearsShellState(t *testing.T) {
 		"keyboardHandler",
 		"globalShortcutHandler",
 		"permissionDialog",
+		"scriptDialog",
 		"webrtcIndicator",
 		"historySidebar",
 	} {
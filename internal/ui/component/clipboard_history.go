@@ -0,0 +1,321 @@
+package component
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bnema/puregotk/v4/gdk"
+	"github.com/bnema/puregotk/v4/gtk"
+	"github.com/bnema/puregotk/v4/pango"
+)
+
+// ClipboardHistoryItem is one copied URL listed by the clipboard history.
+type ClipboardHistoryItem struct {
+	URL string
+	At  time.Time
+}
+
+// ClipboardHistoryConfig holds the clipboard history callbacks.
+type ClipboardHistoryConfig struct {
+	// OnCopy copies the chosen URL to the clipboard again.
+	OnCopy func(url string)
+	// OnOpen navigates the active pane to the chosen URL.
+	OnOpen func(url string)
+	// OnClose runs after the panel was hidden.
+	OnClose func()
+}
+
+// ClipboardHistory is a panel at the top of a browser window listing the
+// recently copied URLs, newest first. The selected URL is opened or copied
+// again.
+type ClipboardHistory struct {
+	outerBox   *gtk.Box
+	listBox    *gtk.ListBox
+	emptyLabel *gtk.Label
+
+	mu            sync.Mutex
+	visible       bool
+	items         []ClipboardHistoryItem
+	selectedIndex int
+	onCopy        func(url string)
+	onOpen        func(url string)
+	onClose       func()
+
+	retainedCallbacks []any
+}
+
+// NewClipboardHistory creates a hidden clipboard history panel.
+func NewClipboardHistory(cfg ClipboardHistoryConfig) *ClipboardHistory {
+	ch := &ClipboardHistory{
+		onCopy:  cfg.OnCopy,
+		onOpen:  cfg.OnOpen,
+		onClose: cfg.OnClose,
+	}
+	if err := ch.createWidgets(); err != nil {
+		return nil
+	}
+	ch.attachKeyController()
+	return ch
+}
+
+// Widget returns the outer GTK widget for overlay registration.
+func (ch *ClipboardHistory) Widget() *gtk.Widget {
+	if ch.outerBox == nil {
+		return nil
+	}
+	return &ch.outerBox.Widget
+}
+
+// IsVisible returns whether the panel is shown.
+func (ch *ClipboardHistory) IsVisible() bool {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	return ch.visible
+}
+
+// Show lists items and shows the panel with keyboard focus on the newest
+// URL.
+func (ch *ClipboardHistory) Show(items []ClipboardHistoryItem) {
+	ch.mu.Lock()
+	ch.visible = true
+	ch.items = append([]ClipboardHistoryItem(nil), items...)
+	ch.selectedIndex = 0
+	ch.mu.Unlock()
+
+	ch.populateList(items)
+	if ch.outerBox != nil {
+		ch.outerBox.SetVisible(true)
+	}
+	if ch.listBox != nil {
+		ch.listBox.GrabFocus()
+	}
+}
+
+// Hide hides the panel and runs OnClose.
+func (ch *ClipboardHistory) Hide() {
+	ch.mu.Lock()
+	if !ch.visible {
+		ch.mu.Unlock()
+		return
+	}
+	ch.visible = false
+	ch.items = nil
+	ch.mu.Unlock()
+
+	if ch.outerBox != nil {
+		ch.outerBox.SetVisible(false)
+	}
+	if ch.listBox != nil {
+		ch.listBox.RemoveAll()
+	}
+	if ch.onClose != nil {
+		ch.onClose()
+	}
+}
+
+func (ch *ClipboardHistory) populateList(items []ClipboardHistoryItem) {
+	if ch.listBox == nil {
+		return
+	}
+	ch.listBox.RemoveAll()
+	for _, item := range items {
+		if row := newClipboardHistoryRow(item); row != nil {
+			ch.listBox.Append(&row.Widget)
+		}
+	}
+	if row := ch.listBox.GetRowAtIndex(0); row != nil {
+		ch.listBox.SelectRow(row)
+	}
+	if ch.emptyLabel != nil {
+		ch.emptyLabel.SetVisible(len(items) == 0)
+	}
+}
+
+func newClipboardHistoryRow(item ClipboardHistoryItem) *gtk.ListBoxRow {
+	row := gtk.NewListBoxRow()
+	if row == nil {
+		return nil
+	}
+	row.AddCssClass("clipboard-history-row")
+
+	const rowSpacing = 8
+	hbox := gtk.NewBox(gtk.OrientationHorizontalValue, rowSpacing)
+	if hbox == nil {
+		return nil
+	}
+
+	timeText := item.At.Format("15:04:05")
+	timeLabel := gtk.NewLabel(&timeText)
+	if timeLabel != nil {
+		timeLabel.AddCssClass("clipboard-history-time")
+		hbox.Append(&timeLabel.Widget)
+	}
+
+	url := item.URL
+	urlLabel := gtk.NewLabel(&url)
+	if urlLabel != nil {
+		urlLabel.AddCssClass("clipboard-history-url")
+		urlLabel.SetHalign(gtk.AlignStartValue)
+		urlLabel.SetHexpand(true)
+		urlLabel.SetXalign(0)
+		urlLabel.SetEllipsize(pango.EllipsizeEndValue)
+		hbox.Append(&urlLabel.Widget)
+	}
+
+	row.SetChild(&hbox.Widget)
+	return row
+}
+
+func (ch *ClipboardHistory) attachKeyController() {
+	controller := gtk.NewEventControllerKey()
+	if controller == nil || ch.outerBox == nil {
+		return
+	}
+	controller.SetPropagationPhase(gtk.PhaseCaptureValue)
+
+	keyPressedCb := func(_ gtk.EventControllerKey, keyval uint, _ uint, _ gdk.ModifierType) bool {
+		switch keyval {
+		case uint(gdk.KEY_Escape):
+			ch.Hide()
+			return true
+		case uint(gdk.KEY_Up), uint(gdk.KEY_k):
+			ch.selectRelative(-1)
+			return true
+		case uint(gdk.KEY_Down), uint(gdk.KEY_j):
+			ch.selectRelative(1)
+			return true
+		case uint(gdk.KEY_Return), uint(gdk.KEY_KP_Enter):
+			ch.choose(ch.onOpen)
+			return true
+		case uint(gdk.KEY_c), uint(gdk.KEY_y):
+			ch.choose(ch.onCopy)
+			return true
+		default:
+			return false
+		}
+	}
+	ch.retainedCallbacks = append(ch.retainedCallbacks, keyPressedCb)
+	controller.ConnectKeyPressed(&keyPressedCb)
+	ch.outerBox.AddController(&controller.EventController)
+}
+
+func (ch *ClipboardHistory) selectRelative(delta int) {
+	ch.mu.Lock()
+	count := len(ch.items)
+	if count == 0 {
+		ch.mu.Unlock()
+		return
+	}
+	i := (ch.selectedIndex + delta + count) % count
+	ch.selectedIndex = i
+	ch.mu.Unlock()
+
+	if ch.listBox != nil {
+		if row := ch.listBox.GetRowAtIndex(i); row != nil {
+			ch.listBox.SelectRow(row)
+			row.GrabFocus()
+		}
+	}
+}
+
+// choose hides the panel and passes the selected URL to action.
+func (ch *ClipboardHistory) choose(action func(url string)) {
+	ch.mu.Lock()
+	idx := ch.selectedIndex
+	var url string
+	if idx >= 0 && idx < len(ch.items) {
+		url = ch.items[idx].URL
+	}
+	ch.mu.Unlock()
+
+	if url == "" {
+		return
+	}
+	ch.Hide()
+	if action != nil {
+		action(url)
+	}
+}
+
+func (ch *ClipboardHistory) createWidgets() error {
+	ch.outerBox = gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	if ch.outerBox == nil {
+		return errNilWidget("clipboardHistoryOuterBox")
+	}
+	ch.outerBox.AddCssClass("clipboard-history")
+	ch.outerBox.SetHalign(gtk.AlignCenterValue)
+	ch.outerBox.SetValign(gtk.AlignStartValue)
+	ch.outerBox.SetVisible(false)
+
+	title := "Copied URLs"
+	titleLabel := gtk.NewLabel(&title)
+	if titleLabel == nil {
+		return errNilWidget("clipboardHistoryTitleLabel")
+	}
+	titleLabel.AddCssClass("clipboard-history-title")
+	titleLabel.SetHalign(gtk.AlignStartValue)
+
+	scrolled := gtk.NewScrolledWindow()
+	if scrolled == nil {
+		return errNilWidget("clipboardHistoryScrolledWindow")
+	}
+	scrolled.AddCssClass("clipboard-history-scrolled")
+	scrolled.SetPolicy(gtk.PolicyNeverValue, gtk.PolicyAutomaticValue)
+	scrolled.SetPropagateNaturalHeight(true)
+	const maxListHeight = 400
+	scrolled.SetMaxContentHeight(maxListHeight)
+
+	ch.listBox = gtk.NewListBox()
+	if ch.listBox == nil {
+		return errNilWidget("clipboardHistoryListBox")
+	}
+	ch.listBox.AddCssClass("clipboard-history-list")
+	ch.listBox.SetSelectionMode(gtk.SelectionSingleValue)
+	ch.listBox.SetActivateOnSingleClick(true)
+
+	rowSelectedCb := func(_ gtk.ListBox, rowPtr uintptr) {
+		row := gtk.ListBoxRowNewFromInternalPtr(rowPtr)
+		if row == nil {
+			return
+		}
+		ch.mu.Lock()
+		ch.selectedIndex = row.GetIndex()
+		ch.mu.Unlock()
+	}
+	ch.retainedCallbacks = append(ch.retainedCallbacks, rowSelectedCb)
+	ch.listBox.ConnectRowSelected(&rowSelectedCb)
+
+	rowActivatedCb := func(_ gtk.ListBox, rowPtr uintptr) {
+		row := gtk.ListBoxRowNewFromInternalPtr(rowPtr)
+		if row == nil {
+			return
+		}
+		ch.mu.Lock()
+		ch.selectedIndex = row.GetIndex()
+		ch.mu.Unlock()
+		ch.choose(ch.onOpen)
+	}
+	ch.retainedCallbacks = append(ch.retainedCallbacks, rowActivatedCb)
+	ch.listBox.ConnectRowActivated(&rowActivatedCb)
+	scrolled.SetChild(&ch.listBox.Widget)
+
+	empty := "No copied URLs"
+	ch.emptyLabel = gtk.NewLabel(&empty)
+	if ch.emptyLabel == nil {
+		return errNilWidget("clipboardHistoryEmptyLabel")
+	}
+	ch.emptyLabel.AddCssClass("clipboard-history-empty")
+
+	footerText := "↑↓/jk navigate  Enter open  c copy  Esc close"
+	footerLabel := gtk.NewLabel(&footerText)
+	if footerLabel == nil {
+		return errNilWidget("clipboardHistoryFooterLabel")
+	}
+	footerLabel.AddCssClass("clipboard-history-footer")
+
+	ch.outerBox.Append(&titleLabel.Widget)
+	ch.outerBox.Append(&scrolled.Widget)
+	ch.outerBox.Append(&ch.emptyLabel.Widget)
+	ch.outerBox.Append(&footerLabel.Widget)
+	return nil
+}
@@ -119,6 +119,19 @@ func (fb *FindBar) Show() {
 	}
 }
 
+// AppendQuery adds text to the end of the search query and leaves the cursor
+// after it, so further typing continues the query.
+func (fb *FindBar) AppendQuery(text string) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+
+	if fb.entry == nil || text == "" {
+		return
+	}
+	fb.entry.SetText(fb.entry.GetText() + text)
+	fb.entry.SetPosition(-1)
+}
+
 // Hide hides the find bar and clears highlights.
 func (fb *FindBar) Hide() {
 	fb.mu.Lock()
@@ -3,7 +3,9 @@ package component
 
 import (
 	"sync"
+	"time"
 
+	urlutil "github.com/bnema/dumber/internal/domain/url"
 	"github.com/bnema/dumber/internal/ui/mainloop"
 	"github.com/bnema/puregotk/v4/glib"
 	"github.com/bnema/puregotk/v4/gtk"
@@ -12,14 +14,75 @@ import (
 )
 
 const (
-	// linkStatusShowDelayMs is the delay before showing the overlay to avoid flicker.
+	// linkStatusShowDelayMs is the default delay before showing the overlay to avoid flicker.
 	linkStatusShowDelayMs = 100
 	// linkStatusAutoHideMs is the delay before auto-hiding the overlay (e.g., during fullscreen video).
 	linkStatusAutoHideMs = 10000
-	// linkStatusMaxChars is the maximum characters before truncation.
+	// linkStatusMaxChars is the default maximum characters before truncation.
 	linkStatusMaxChars = 80
+	// linkStatusExpandMs is how long a truncated URL stays on screen before
+	// the overlay expands to show it in full.
+	linkStatusExpandMs = 1000
+	// linkStatusEllipsis replaces the middle of truncated URLs.
+	linkStatusEllipsis = "…"
 )
 
+// LinkStatusConfig controls the link hover status overlay.
+type LinkStatusConfig struct {
+	// Enabled shows the overlay while hovering links.
+	Enabled bool
+	// ShowDelay debounces rapid hover changes before the overlay appears.
+	ShowDelay time.Duration
+	// MaxChars truncates longer URLs in the middle; <= 0 disables truncation.
+	MaxChars int
+}
+
+// DefaultLinkStatusConfig returns the built-in overlay settings.
+func DefaultLinkStatusConfig() LinkStatusConfig {
+	return LinkStatusConfig{
+		Enabled:   true,
+		ShowDelay: linkStatusShowDelayMs * time.Millisecond,
+		MaxChars:  linkStatusMaxChars,
+	}
+}
+
+// truncateLinkStatus shortens uri to at most maxChars runes by replacing its
+// middle with an ellipsis, keeping both the host and the final path segment
+// readable. maxChars <= 0 returns uri unchanged.
+func truncateLinkStatus(uri string, maxChars int) string {
+	runes := []rune(uri)
+	if maxChars <= 0 || len(runes) <= maxChars {
+		return uri
+	}
+	ellipsis := []rune(linkStatusEllipsis)
+	if maxChars <= len(ellipsis) {
+		return string(ellipsis[:maxChars])
+	}
+	keep := maxChars - len(ellipsis)
+	head := (keep + 1) / 2
+	tail := keep - head
+	return string(runes[:head]) + linkStatusEllipsis + string(runes[len(runes)-tail:])
+}
+
+// linkStatusWidthChars maps MaxChars to a GTK max-width-chars value, where -1
+// means the label is only bounded by the pane width.
+func linkStatusWidthChars(maxChars int) int {
+	if maxChars <= 0 {
+		return -1
+	}
+	return maxChars
+}
+
+// linkStatusShowDelay returns how long to wait before showing a hovered URL.
+// Once the overlay is visible, moving between links updates it immediately;
+// only the first appearance is debounced.
+func linkStatusShowDelay(visible bool, delay time.Duration) time.Duration {
+	if visible || delay < 0 {
+		return 0
+	}
+	return delay
+}
+
 // LinkStatusOverlay displays the destination URL when hovering over links.
 // It appears in the bottom-left corner with a fade-in/fade-out transition.
 type LinkStatusOverlay struct {
@@ -27,12 +90,15 @@ type LinkStatusOverlay struct {
 	container layout.BoxWidget
 	label     layout.LabelWidget
 
+	cfg           LinkStatusConfig
 	pendingURI    string // URI waiting to be shown after delay
 	showTimer     uint   // GLib timer for delayed show
 	autoHideTimer uint   // GLib timer for auto-hide after timeout
+	expandTimer   uint   // GLib timer for revealing a truncated URL in full
 	visible       bool   // Whether overlay is currently visible (has .visible class)
 	showTimerCb   glib.SourceFunc
 	autoHideCb    glib.SourceFunc
+	expandCb      glib.SourceFunc
 	coalescer     *mainloop.Coalescer
 	mu            sync.Mutex
 }
@@ -68,6 +134,7 @@ func NewLinkStatusOverlay(factory layout.WidgetFactory) *LinkStatusOverlay {
 		factory:   factory,
 		container: container,
 		label:     label,
+		cfg:       DefaultLinkStatusConfig(),
 		visible:   false,
 	}
 	overlay.coalescer = mainloop.NewCoalescer(func(fn func()) {
@@ -81,15 +148,34 @@ func NewLinkStatusOverlay(factory layout.WidgetFactory) *LinkStatusOverlay {
 		overlay.mu.Lock()
 		defer overlay.mu.Unlock()
 
+		overlay.showTimer = 0
 		if overlay.pendingURI != "" {
-			overlay.label.SetText(overlay.pendingURI)
+			overlay.cancelExpandTimerLocked()
+			text := truncateLinkStatus(overlay.pendingURI, overlay.cfg.MaxChars)
+			overlay.label.SetMaxWidthChars(linkStatusWidthChars(overlay.cfg.MaxChars))
+			overlay.label.SetText(text)
 			if !overlay.visible {
 				overlay.visible = true
 				overlay.container.AddCssClass("visible")
 			}
+			if text != overlay.pendingURI {
+				overlay.expandTimer = glib.TimeoutAdd(linkStatusExpandMs, &overlay.expandCb, 0)
+			}
 			overlay.resetAutoHideTimerLocked()
 		}
-		overlay.showTimer = 0
+		return false
+	}
+	overlay.expandCb = func(_ uintptr) bool {
+		overlay.mu.Lock()
+		defer overlay.mu.Unlock()
+
+		overlay.expandTimer = 0
+		if overlay.visible && overlay.pendingURI != "" {
+			// Lift the width cap so the full URL uses the available pane width;
+			// GTK still ellipsizes if the pane is narrower than the URL.
+			overlay.label.SetMaxWidthChars(-1)
+			overlay.label.SetText(overlay.pendingURI)
+		}
 		return false
 	}
 	overlay.autoHideCb = func(_ uintptr) bool {
@@ -104,25 +190,39 @@ func NewLinkStatusOverlay(factory layout.WidgetFactory) *LinkStatusOverlay {
 	return overlay
 }
 
+// SetConfig updates the truncation and debounce settings used by later Show calls.
+func (l *LinkStatusOverlay) SetConfig(cfg LinkStatusConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cfg = cfg
+}
+
 // Show displays the link status overlay with the given URI.
 // If uri is empty, hides the overlay instead.
 // Uses a small delay to avoid flicker during rapid mouse movement.
+// Long URLs are truncated in the middle and expand to full length when the
+// pointer lingers on the same link.
 func (l *LinkStatusOverlay) Show(uri string) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	uri = urlutil.DisplayLinkURL(uri)
+	if uri == "" || !l.cfg.Enabled {
+		l.hide()
+		return
+	}
+	if uri == l.pendingURI && l.visible {
+		return
+	}
+
 	// Cancel any pending show timer
 	if l.showTimer != 0 {
 		glib.SourceRemove(l.showTimer)
 		l.showTimer = 0
 	}
 
-	if uri == "" {
-		l.hide()
-		return
-	}
-
 	l.pendingURI = uri
+	delay := linkStatusShowDelay(l.visible, l.cfg.ShowDelay)
 
 	// Coalesce rapid hover events before scheduling delay timer.
 	l.coalescer.Post("link-status-show-delay", func() {
@@ -135,7 +235,7 @@ func (l *LinkStatusOverlay) Show(uri string) {
 		if l.showTimer != 0 {
 			glib.SourceRemove(l.showTimer)
 		}
-		l.showTimer = glib.TimeoutAdd(linkStatusShowDelayMs, &l.showTimerCb, 0)
+		l.showTimer = glib.TimeoutAdd(uint(delay.Milliseconds()), &l.showTimerCb, 0)
 	})
 }
 
@@ -158,6 +258,7 @@ func (l *LinkStatusOverlay) hide() {
 		glib.SourceRemove(l.autoHideTimer)
 		l.autoHideTimer = 0
 	}
+	l.cancelExpandTimerLocked()
 	l.pendingURI = ""
 
 	if l.visible {
@@ -166,6 +267,14 @@ func (l *LinkStatusOverlay) hide() {
 	}
 }
 
+// cancelExpandTimerLocked stops a pending full-URL expansion (must be called with lock held).
+func (l *LinkStatusOverlay) cancelExpandTimerLocked() {
+	if l.expandTimer != 0 {
+		glib.SourceRemove(l.expandTimer)
+		l.expandTimer = 0
+	}
+}
+
 // resetAutoHideTimerLocked starts or resets the auto-hide timer (must be called with lock held).
 func (l *LinkStatusOverlay) resetAutoHideTimerLocked() {
 	// Cancel existing auto-hide timer
@@ -217,6 +326,7 @@ func (l *LinkStatusOverlay) Cleanup() {
 		glib.SourceRemove(l.autoHideTimer)
 		l.autoHideTimer = 0
 	}
+	l.cancelExpandTimerLocked()
 	if l.coalescer != nil {
 		l.coalescer.Destroy()
 	}
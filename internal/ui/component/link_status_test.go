@@ -0,0 +1,51 @@
+package component
+
+import (
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateLinkStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		uri      string
+		maxChars int
+		want     string
+	}{
+		{name: "short uri unchanged", uri: "https://example.com/", maxChars: 80, want: "https://example.com/"},
+		{name: "exact length unchanged", uri: "abcdefghij", maxChars: 10, want: "abcdefghij"},
+		{name: "disabled truncation", uri: "abcdefghij", maxChars: 0, want: "abcdefghij"},
+		{name: "keeps head and tail", uri: "abcdefghijkl", maxChars: 7, want: "abc…jkl"},
+		{name: "odd budget favors head", uri: "abcdefghijkl", maxChars: 6, want: "abc…kl"},
+		{name: "tiny budget is ellipsis only", uri: "abcdefghijkl", maxChars: 1, want: "…"},
+		{name: "counts runes not bytes", uri: "https://example.com/café/été", maxChars: 9, want: "http…/été"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateLinkStatus(tt.uri, tt.maxChars)
+			assert.Equal(t, tt.want, got)
+			if tt.maxChars > 0 {
+				assert.LessOrEqual(t, utf8.RuneCountInString(got), tt.maxChars)
+			}
+		})
+	}
+}
+
+func TestLinkStatusShowDelay(t *testing.T) {
+	delay := 100 * time.Millisecond
+
+	assert.Equal(t, delay, linkStatusShowDelay(false, delay), "first appearance is debounced")
+	assert.Zero(t, linkStatusShowDelay(true, delay), "visible overlay updates immediately")
+	assert.Zero(t, linkStatusShowDelay(false, 0))
+	assert.Zero(t, linkStatusShowDelay(false, -time.Second))
+}
+
+func TestLinkStatusWidthChars(t *testing.T) {
+	assert.Equal(t, 80, linkStatusWidthChars(80))
+	assert.Equal(t, -1, linkStatusWidthChars(0))
+	assert.Equal(t, -1, linkStatusWidthChars(-5))
+}
@@ -0,0 +1,167 @@
+package component
+
+import (
+	"sync"
+
+	"github.com/bnema/puregotk/v4/gtk"
+)
+
+// LockScreen covers a browser window with an opaque PIN prompt after the
+// panic key locked it. It sits above the content overlay, tab bar included,
+// and takes pointer input so nothing below it can be reached.
+type LockScreen struct {
+	outerBox   *gtk.Box
+	entry      *gtk.Entry
+	errorLabel *gtk.Label
+
+	mu       sync.Mutex
+	visible  bool
+	onSubmit func(pin string) bool
+
+	retainedCallbacks []any
+}
+
+// NewLockScreen creates a hidden lock screen.
+func NewLockScreen() *LockScreen {
+	ls := &LockScreen{}
+	if err := ls.createWidgets(); err != nil {
+		return nil
+	}
+	return ls
+}
+
+// Widget returns the outer GTK widget for overlay registration.
+func (ls *LockScreen) Widget() *gtk.Widget {
+	if ls.outerBox == nil {
+		return nil
+	}
+	return &ls.outerBox.Widget
+}
+
+// Show covers the window and focuses the PIN entry. onSubmit receives each
+// entered PIN and returns whether it unlocked; the screen hides itself when it
+// did and asks again when it did not.
+func (ls *LockScreen) Show(onSubmit func(pin string) bool) {
+	ls.mu.Lock()
+	ls.visible = true
+	ls.onSubmit = onSubmit
+	ls.mu.Unlock()
+
+	if ls.entry != nil {
+		ls.entry.SetText("")
+	}
+	if ls.errorLabel != nil {
+		ls.errorLabel.SetVisible(false)
+	}
+	if ls.outerBox != nil {
+		ls.outerBox.SetVisible(true)
+	}
+	ls.Focus()
+}
+
+// Hide uncovers the window without calling onSubmit.
+func (ls *LockScreen) Hide() {
+	ls.mu.Lock()
+	ls.visible = false
+	ls.onSubmit = nil
+	ls.mu.Unlock()
+
+	if ls.entry != nil {
+		ls.entry.SetText("")
+	}
+	if ls.outerBox != nil {
+		ls.outerBox.SetVisible(false)
+	}
+}
+
+// IsVisible returns whether the lock screen currently covers the window.
+func (ls *LockScreen) IsVisible() bool {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.visible
+}
+
+// Focus moves keyboard focus to the PIN entry, so keys typed while locked
+// never reach the page below.
+func (ls *LockScreen) Focus() {
+	if ls.IsVisible() && ls.entry != nil {
+		ls.entry.GrabFocus()
+	}
+}
+
+func (ls *LockScreen) submit() {
+	ls.mu.Lock()
+	cb := ls.onSubmit
+	ls.mu.Unlock()
+	if cb == nil || ls.entry == nil {
+		return
+	}
+
+	pin := ls.entry.GetText()
+	if cb(pin) {
+		ls.Hide()
+		return
+	}
+	ls.entry.SetText("")
+	if ls.errorLabel != nil {
+		ls.errorLabel.SetVisible(true)
+	}
+	ls.entry.GrabFocus()
+}
+
+func (ls *LockScreen) createWidgets() error {
+	ls.outerBox = gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	if ls.outerBox == nil {
+		return errNilWidget("lockScreenOuterBox")
+	}
+	ls.outerBox.AddCssClass("lock-screen")
+	ls.outerBox.SetHalign(gtk.AlignFillValue)
+	ls.outerBox.SetValign(gtk.AlignFillValue)
+	ls.outerBox.SetHexpand(true)
+	ls.outerBox.SetVexpand(true)
+	ls.outerBox.SetVisible(false)
+
+	card := gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	if card == nil {
+		return errNilWidget("lockScreenCard")
+	}
+	card.AddCssClass("lock-screen-card")
+	card.SetHalign(gtk.AlignCenterValue)
+	card.SetValign(gtk.AlignCenterValue)
+	card.SetVexpand(true)
+
+	heading := "Locked"
+	headingLabel := gtk.NewLabel(&heading)
+	if headingLabel == nil {
+		return errNilWidget("lockScreenHeadingLabel")
+	}
+	headingLabel.AddCssClass("lock-screen-heading")
+
+	ls.entry = gtk.NewEntry()
+	if ls.entry == nil {
+		return errNilWidget("lockScreenEntry")
+	}
+	ls.entry.AddCssClass("lock-screen-entry")
+	ls.entry.SetVisibility(false)
+	ls.entry.SetInputPurpose(gtk.InputPurposePinValue)
+	placeholder := "PIN"
+	ls.entry.SetPlaceholderText(&placeholder)
+	activateCb := func(_ gtk.Entry) { ls.submit() }
+	ls.retainedCallbacks = append(ls.retainedCallbacks, activateCb)
+	ls.entry.ConnectActivate(&activateCb)
+
+	wrongPIN := "Wrong PIN"
+	ls.errorLabel = gtk.NewLabel(&wrongPIN)
+	if ls.errorLabel == nil {
+		return errNilWidget("lockScreenErrorLabel")
+	}
+	ls.errorLabel.AddCssClass("lock-screen-error")
+	ls.errorLabel.SetVisible(false)
+
+	card.Append(&headingLabel.Widget)
+	card.Append(&ls.entry.Widget)
+	card.Append(&ls.errorLabel.Widget)
+	ls.outerBox.Append(&card.Widget)
+
+	return nil
+}
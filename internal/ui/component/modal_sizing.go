@@ -55,6 +55,19 @@ var OmniboxListDefaults = ListDisplayDefaults{
 	SmallMaxVisibleRows: 5,
 }
 
+// omniboxListDefaults returns OmniboxListDefaults resized to maxResults rows.
+// Non-positive values keep the package defaults.
+func omniboxListDefaults(maxResults int) ListDisplayDefaults {
+	if maxResults <= 0 {
+		return OmniboxListDefaults
+	}
+	return ListDisplayDefaults{
+		MaxVisibleRows:      maxResults,
+		MaxResults:          maxResults,
+		SmallMaxVisibleRows: min(maxResults, OmniboxListDefaults.SmallMaxVisibleRows),
+	}
+}
+
 // SessionManagerSizeDefaults provides default sizing for session manager modal.
 var SessionManagerSizeDefaults = ModalSizeConfig{
 	WidthPct:       0.6,
@@ -0,0 +1,282 @@
+package component
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bnema/puregotk/v4/gdk"
+	"github.com/bnema/puregotk/v4/gtk"
+)
+
+// NotificationItem is one past toast listed by the notification center.
+type NotificationItem struct {
+	Message string
+	Level   ToastLevel
+	At      time.Time
+}
+
+// NotificationCenterConfig holds the notification center callbacks.
+type NotificationCenterConfig struct {
+	// OnDismissAll clears the notification history.
+	OnDismissAll func()
+	// OnClose runs after the panel was hidden.
+	OnClose func()
+}
+
+// NotificationCenter is a panel in the top-right corner of a browser window
+// listing the recent toasts, newest first, with a button to dismiss them all.
+type NotificationCenter struct {
+	outerBox      *gtk.Box
+	listBox       *gtk.ListBox
+	emptyLabel    *gtk.Label
+	dismissButton *gtk.Button
+
+	mu           sync.Mutex
+	visible      bool
+	onDismissAll func()
+	onClose      func()
+
+	retainedCallbacks []any
+}
+
+// NewNotificationCenter creates a hidden notification center.
+func NewNotificationCenter(cfg NotificationCenterConfig) *NotificationCenter {
+	nc := &NotificationCenter{
+		onDismissAll: cfg.OnDismissAll,
+		onClose:      cfg.OnClose,
+	}
+	if err := nc.createWidgets(); err != nil {
+		return nil
+	}
+	nc.attachKeyController()
+	return nc
+}
+
+// Widget returns the outer GTK widget for overlay registration.
+func (nc *NotificationCenter) Widget() *gtk.Widget {
+	if nc.outerBox == nil {
+		return nil
+	}
+	return &nc.outerBox.Widget
+}
+
+// IsVisible returns whether the panel is shown.
+func (nc *NotificationCenter) IsVisible() bool {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	return nc.visible
+}
+
+// Show lists items and shows the panel with keyboard focus.
+func (nc *NotificationCenter) Show(items []NotificationItem) {
+	nc.mu.Lock()
+	nc.visible = true
+	nc.mu.Unlock()
+
+	nc.SetItems(items)
+	if nc.outerBox != nil {
+		nc.outerBox.SetVisible(true)
+	}
+	if nc.dismissButton != nil {
+		nc.dismissButton.GrabFocus()
+	}
+}
+
+// Hide hides the panel and runs OnClose.
+func (nc *NotificationCenter) Hide() {
+	nc.mu.Lock()
+	if !nc.visible {
+		nc.mu.Unlock()
+		return
+	}
+	nc.visible = false
+	nc.mu.Unlock()
+
+	if nc.outerBox != nil {
+		nc.outerBox.SetVisible(false)
+	}
+	if nc.listBox != nil {
+		nc.listBox.RemoveAll()
+	}
+	if nc.onClose != nil {
+		nc.onClose()
+	}
+}
+
+// SetItems replaces the listed notifications.
+func (nc *NotificationCenter) SetItems(items []NotificationItem) {
+	if nc.listBox == nil {
+		return
+	}
+	nc.listBox.RemoveAll()
+	for _, item := range items {
+		if row := newNotificationRow(item); row != nil {
+			nc.listBox.Append(&row.Widget)
+		}
+	}
+	if nc.emptyLabel != nil {
+		nc.emptyLabel.SetVisible(len(items) == 0)
+	}
+	if nc.dismissButton != nil {
+		nc.dismissButton.SetSensitive(len(items) > 0)
+	}
+}
+
+func (nc *NotificationCenter) dismissAll() {
+	if nc.onDismissAll != nil {
+		nc.onDismissAll()
+	}
+	nc.SetItems(nil)
+}
+
+func newNotificationRow(item NotificationItem) *gtk.ListBoxRow {
+	row := gtk.NewListBoxRow()
+	if row == nil {
+		return nil
+	}
+	row.AddCssClass("notification-center-row")
+	row.AddCssClass(notificationLevelClass(item.Level))
+	row.SetActivatable(false)
+
+	const rowSpacing = 8
+	hbox := gtk.NewBox(gtk.OrientationHorizontalValue, rowSpacing)
+	if hbox == nil {
+		return nil
+	}
+
+	timeText := item.At.Format("15:04:05")
+	timeLabel := gtk.NewLabel(&timeText)
+	if timeLabel != nil {
+		timeLabel.AddCssClass("notification-center-time")
+		timeLabel.SetValign(gtk.AlignStartValue)
+		hbox.Append(&timeLabel.Widget)
+	}
+
+	message := item.Message
+	messageLabel := gtk.NewLabel(&message)
+	if messageLabel != nil {
+		messageLabel.AddCssClass("notification-center-message")
+		messageLabel.SetHalign(gtk.AlignStartValue)
+		messageLabel.SetHexpand(true)
+		messageLabel.SetXalign(0)
+		messageLabel.SetWrap(true)
+		hbox.Append(&messageLabel.Widget)
+	}
+
+	row.SetChild(&hbox.Widget)
+	return row
+}
+
+// notificationLevelClass returns the CSS class marking a row's level; the
+// toast classes would paint the whole row.
+func notificationLevelClass(level ToastLevel) string {
+	switch level {
+	case ToastSuccess:
+		return "notification-success"
+	case ToastWarning:
+		return "notification-warning"
+	case ToastError:
+		return "notification-error"
+	default:
+		return "notification-info"
+	}
+}
+
+func (nc *NotificationCenter) attachKeyController() {
+	controller := gtk.NewEventControllerKey()
+	if controller == nil || nc.outerBox == nil {
+		return
+	}
+	controller.SetPropagationPhase(gtk.PhaseCaptureValue)
+
+	keyPressedCb := func(_ gtk.EventControllerKey, keyval uint, _ uint, _ gdk.ModifierType) bool {
+		switch keyval {
+		case uint(gdk.KEY_Escape):
+			nc.Hide()
+			return true
+		case uint(gdk.KEY_d), uint(gdk.KEY_Delete):
+			nc.dismissAll()
+			return true
+		default:
+			return false
+		}
+	}
+	nc.retainedCallbacks = append(nc.retainedCallbacks, keyPressedCb)
+	controller.ConnectKeyPressed(&keyPressedCb)
+	nc.outerBox.AddController(&controller.EventController)
+}
+
+func (nc *NotificationCenter) createWidgets() error {
+	nc.outerBox = gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	if nc.outerBox == nil {
+		return errNilWidget("notificationCenterOuterBox")
+	}
+	nc.outerBox.AddCssClass("notification-center")
+	nc.outerBox.SetHalign(gtk.AlignEndValue)
+	nc.outerBox.SetValign(gtk.AlignStartValue)
+	nc.outerBox.SetVisible(false)
+
+	header := gtk.NewBox(gtk.OrientationHorizontalValue, 0)
+	if header == nil {
+		return errNilWidget("notificationCenterHeader")
+	}
+	header.AddCssClass("notification-center-header")
+
+	title := "Notifications"
+	titleLabel := gtk.NewLabel(&title)
+	if titleLabel == nil {
+		return errNilWidget("notificationCenterTitleLabel")
+	}
+	titleLabel.AddCssClass("notification-center-title")
+	titleLabel.SetHalign(gtk.AlignStartValue)
+	titleLabel.SetHexpand(true)
+	header.Append(&titleLabel.Widget)
+
+	nc.dismissButton = gtk.NewButtonWithLabel("Dismiss all")
+	if nc.dismissButton == nil {
+		return errNilWidget("notificationCenterDismissButton")
+	}
+	nc.dismissButton.AddCssClass("notification-center-dismiss")
+	dismissCb := func(_ gtk.Button) { nc.dismissAll() }
+	nc.retainedCallbacks = append(nc.retainedCallbacks, dismissCb)
+	nc.dismissButton.ConnectClicked(&dismissCb)
+	header.Append(&nc.dismissButton.Widget)
+
+	scrolled := gtk.NewScrolledWindow()
+	if scrolled == nil {
+		return errNilWidget("notificationCenterScrolledWindow")
+	}
+	scrolled.AddCssClass("notification-center-scrolled")
+	scrolled.SetPolicy(gtk.PolicyNeverValue, gtk.PolicyAutomaticValue)
+	scrolled.SetPropagateNaturalHeight(true)
+	const maxListHeight = 400
+	scrolled.SetMaxContentHeight(maxListHeight)
+
+	nc.listBox = gtk.NewListBox()
+	if nc.listBox == nil {
+		return errNilWidget("notificationCenterListBox")
+	}
+	nc.listBox.AddCssClass("notification-center-list")
+	nc.listBox.SetSelectionMode(gtk.SelectionNoneValue)
+	scrolled.SetChild(&nc.listBox.Widget)
+
+	empty := "No notifications"
+	nc.emptyLabel = gtk.NewLabel(&empty)
+	if nc.emptyLabel == nil {
+		return errNilWidget("notificationCenterEmptyLabel")
+	}
+	nc.emptyLabel.AddCssClass("notification-center-empty")
+
+	footerText := "d dismiss all  Esc close"
+	footerLabel := gtk.NewLabel(&footerText)
+	if footerLabel == nil {
+		return errNilWidget("notificationCenterFooterLabel")
+	}
+	footerLabel.AddCssClass("notification-center-footer")
+
+	nc.outerBox.Append(&header.Widget)
+	nc.outerBox.Append(&scrolled.Widget)
+	nc.outerBox.Append(&nc.emptyLabel.Widget)
+	nc.outerBox.Append(&footerLabel.Widget)
+	return nil
+}
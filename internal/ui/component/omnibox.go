@@ -30,6 +30,7 @@ const (
 	debounceDelayMs             = 50
 	endBoxSpacing               = 6
 	defaultOmniboxPlaceholder   = "Search history or enter URL… (! lists bangs)"
+	omniboxCommandPrefix        = ">"
 	minGhostInputLength         = 1
 	initialBehaviorBadgeTooltip = "Toggle default history order (Ctrl+R)"
 )
@@ -52,14 +53,41 @@ type favoriteToggleResultUpdate struct {
 	IsFavorite bool
 }
 
-// ViewMode distinguishes history search from favorites display.
+// ViewMode distinguishes unified, history-only, and favorites-only display.
 type ViewMode string
 
 const (
+	// ViewModeAll interleaves history and favorites in one ranked list.
+	ViewModeAll       ViewMode = "all"
 	ViewModeHistory   ViewMode = "history"
 	ViewModeFavorites ViewMode = "favorites"
 )
 
+// listsSuggestions reports whether the mode renders o.suggestions rows
+// (history and unified modes) rather than o.favorites rows.
+func (m ViewMode) listsSuggestions() bool { return m != ViewModeFavorites }
+
+// nextViewMode returns the mode Tab cycles to: all → history → favorites → all.
+func nextViewMode(current ViewMode) ViewMode {
+	switch current {
+	case ViewModeAll:
+		return ViewModeHistory
+	case ViewModeHistory:
+		return ViewModeFavorites
+	default:
+		return ViewModeAll
+	}
+}
+
+// explicitViewModeTarget returns the mode selected by a header button click.
+// Clicking the already-active explicit mode returns to the unified list.
+func explicitViewModeTarget(current, clicked ViewMode) ViewMode {
+	if current == clicked {
+		return ViewModeAll
+	}
+	return clicked
+}
+
 // Suggestion represents a search result from history.
 type Suggestion struct {
 	URL        string
@@ -133,8 +161,19 @@ type Omnibox struct {
 	saveInitialBehaviorFn  func(context.Context, entity.OmniboxInitialBehavior) error
 	ctx                    context.Context
 
+	listDefaults   ListDisplayDefaults
+	minQueryLength int
+	openInNewPane  bool
+	matchMode      entity.OmniboxMatchMode
+	// nextResultKeys and previousResultKeys are the configured alternatives
+	// to Down and Up.
+	nextResultKeys     input.KeySet
+	previousResultKeys input.KeySet
+
 	// Callbacks
 	onNavigate         func(ctx context.Context, url string) error
+	onOpenInNewPane    func(ctx context.Context, url string) error
+	onCommand          func(ctx context.Context, command string) error
 	onClose            func()
 	onToast            func(ctx context.Context, message string, level ToastLevel)
 	onAccentKeyPress   func(keyval uint, state gdk.ModifierType) bool
@@ -182,8 +221,26 @@ type OmniboxConfig struct {
 	MostVisitedDays        int
 	SaveInitialBehavior    func(ctx context.Context, behavior entity.OmniboxInitialBehavior) error
 	UIScale                float64 // UI scale for favicon sizing
+	// MaxResults caps fetched and listed suggestions (0 uses OmniboxListDefaults).
+	MaxResults int
+	// MinQueryLength is the query length, in characters, below which history
+	// is not searched.
+	MinQueryLength int
+	// OpenInNewPane routes submissions through OnOpenInNewPane when it is set.
+	OpenInNewPane bool
+	// MatchMode controls how history and favorites match the query.
+	MatchMode entity.OmniboxMatchMode
+	// NextResultKeys and PreviousResultKeys ("ctrl+j") move the selection
+	// like Down and Up.
+	NextResultKeys     []string
+	PreviousResultKeys []string
+	// OnOpenInNewPane opens a submitted URL in a new pane.
+	OnOpenInNewPane func(ctx context.Context, url string) error
 	// OnNavigate is called when the user submits a URL; returning nil closes the omnibox.
-	OnNavigate         func(ctx context.Context, url string) error
+	OnNavigate func(ctx context.Context, url string) error
+	// OnCommand runs the text submitted after ">" (e.g. "fill test-{n}");
+	// returning nil closes the omnibox. Without it, ">" input is navigated.
+	OnCommand          func(ctx context.Context, command string) error
 	OnToast            func(ctx context.Context, message string, level ToastLevel) // Callback to show toast notification
 	OnFocusIn          func(entry *gtk.SearchEntry)                                // Callback when entry gains focus (for accent picker)
 	OnFocusOut         func()                                                      // Callback when entry loses focus
@@ -205,7 +262,7 @@ func NewOmnibox(ctx context.Context, cfg OmniboxConfig) *Omnibox {
 	sizeCfg := ResolveModalSizeConfig(cfg.SizeConfig, OmniboxSizeDefaults)
 
 	o := &Omnibox{
-		viewMode:               ViewModeHistory,
+		viewMode:               ViewModeAll,
 		selectedIndex:          -1,
 		historyUC:              cfg.HistoryUC,
 		favoritesUC:            cfg.FavoritesUC,
@@ -223,6 +280,14 @@ func NewOmnibox(ctx context.Context, cfg OmniboxConfig) *Omnibox {
 		ctx:                    ctx,
 		uiScale:                uiScale,
 		sizeCfg:                sizeCfg,
+		listDefaults:           omniboxListDefaults(cfg.MaxResults),
+		minQueryLength:         cfg.MinQueryLength,
+		openInNewPane:          cfg.OpenInNewPane,
+		matchMode:              cfg.MatchMode,
+		nextResultKeys:         parseOmniboxResultKeys(ctx, "next", cfg.NextResultKeys),
+		previousResultKeys:     parseOmniboxResultKeys(ctx, "previous", cfg.PreviousResultKeys),
+		onOpenInNewPane:        cfg.OnOpenInNewPane,
+		onCommand:              cfg.OnCommand,
 	}
 	o.idleCoalescer = mainloop.NewCoalescer(func(fn func()) {
 		var cb glib.SourceFunc = func(uintptr) bool {
@@ -396,7 +461,7 @@ var loadInitialHistoryFn = func(o *Omnibox, token uint64) {
 }
 
 func shouldRefreshInitialHistoryAfterToggle(viewMode ViewMode, query string, initialBehavior entity.OmniboxInitialBehavior) bool {
-	if viewMode != ViewModeHistory {
+	if !viewMode.listsSuggestions() {
 		return false
 	}
 	if strings.TrimSpace(query) != "" {
@@ -489,9 +554,9 @@ func (o *Omnibox) setResultsContainerState(rowCount int) {
 // Must be called on the GTK main thread.
 func (o *Omnibox) effectiveMaxRows() int {
 	if o.parentOverlay == nil {
-		return OmniboxListDefaults.MaxVisibleRows
+		return o.listDefaults.MaxVisibleRows
 	}
-	return EffectiveMaxRows(o.parentOverlay.GetAllocatedHeight(), o.estimateRowHeight(), o.sizeCfg, OmniboxListDefaults)
+	return EffectiveMaxRows(o.parentOverlay.GetAllocatedHeight(), o.estimateRowHeight(), o.sizeCfg, o.listDefaults)
 }
 
 // resizeAndCenter adjusts the omnibox size based on content and centers it.
@@ -596,6 +661,19 @@ func (o *Omnibox) createWidgets() error {
 }
 
 // setupKeyboardHandling adds keyboard event handling.
+// parseOmniboxResultKeys parses the keys that move the result selection in
+// direction, logging the ones that cannot be parsed.
+func parseOmniboxResultKeys(ctx context.Context, direction string, keys []string) input.KeySet {
+	set, invalid := input.ParseKeySet(keys)
+	if len(invalid) > 0 {
+		logging.FromContext(ctx).Warn().
+			Str("direction", direction).
+			Strs("keys", invalid).
+			Msg("ignoring unknown omnibox result navigation keys")
+	}
+	return set
+}
+
 func (o *Omnibox) setupKeyboardHandling() {
 	log := logging.FromContext(o.ctx)
 	controller := gtk.NewEventControllerKey()
@@ -673,6 +751,7 @@ func (o *Omnibox) initHeader() error {
 		return errNilWidget("favoritesBtn")
 	}
 	o.favoritesBtn.AddCssClass("omnibox-header-btn")
+	o.favoritesBtn.AddCssClass("omnibox-header-active") // unified mode is the default
 	o.favoritesBtn.SetCanFocus(false)
 
 	o.initialBehaviorBadge = gtk.NewButtonWithLabel("")
@@ -691,13 +770,13 @@ func (o *Omnibox) initHeader() error {
 	o.refreshInitialBehaviorBadge()
 
 	historyClickCb := func(_ gtk.Button) {
-		o.setViewMode(ViewModeHistory)
+		o.selectExplicitViewMode(ViewModeHistory)
 	}
 	o.retainedCallbacks = append(o.retainedCallbacks, historyClickCb)
 	o.historyBtn.ConnectClicked(&historyClickCb)
 
 	favoritesClickCb := func(_ gtk.Button) {
-		o.setViewMode(ViewModeFavorites)
+		o.selectExplicitViewMode(ViewModeFavorites)
 	}
 	o.retainedCallbacks = append(o.retainedCallbacks, favoritesClickCb)
 	o.favoritesBtn.ConnectClicked(&favoritesClickCb)
@@ -928,6 +1007,13 @@ func (o *Omnibox) handleKeyPress(keyval, keycode uint, state gdk.ModifierType) b
 
 	ctrl := state&gdk.ControlMaskValue != 0
 
+	// Configured result keys come first so they can override the built-in
+	// Ctrl shortcuts below.
+	if step := o.resultKeyStep(keyval, state); step != 0 {
+		o.moveSelection(step)
+		return true
+	}
+
 	switch keyval {
 	case uint(gdk.KEY_r), uint(gdk.KEY_R):
 		if ctrl {
@@ -984,11 +1070,19 @@ func (o *Omnibox) handleKeyPress(keyval, keycode uint, state gdk.ModifierType) b
 	case uint(gdk.KEY_y):
 		// 'y' yanks (copies) the selected URL to clipboard when navigating
 		if o.hasUserNavigated() {
-			o.yankSelectedURL()
+			o.yankSelectedURL(false)
 			return true
 		}
 		return false // Let entry handle 'y' for typing
 
+	case uint(gdk.KEY_Y):
+		// 'Y' yanks the selected entry as a Markdown link when navigating
+		if o.hasUserNavigated() {
+			o.yankSelectedURL(true)
+			return true
+		}
+		return false
+
 	default:
 		return o.handleCtrlNumberShortcut(keyval, keycode, ctrl)
 	}
@@ -1005,6 +1099,27 @@ func isDeletionKey(keyval uint) bool {
 	}
 }
 
+// inlineCompletionAllowed reports whether an edit from previous to current
+// input may be completed inline. Only appending at the end qualifies: deletion
+// keys, edits that shrink or keep the text (ctrl+w, cut, undo) and edits away
+// from the end of the entry leave the input alone so completion never fights
+// the user.
+func inlineCompletionAllowed(previous, current string, insertKey, cursorAtEnd bool) bool {
+	if !insertKey || !cursorAtEnd || current == "" {
+		return false
+	}
+	if len(current) <= len(previous) && strings.HasPrefix(previous, current) {
+		return false
+	}
+	return true
+}
+
+// entryCursorAtEnd reports whether position sits after the last character of
+// bufferText, trailing whitespace included.
+func entryCursorAtEnd(position int, bufferText string) bool {
+	return position == utf8.RuneCountInString(bufferText)
+}
+
 // updateInsertCompletionFromKey sets whether the next text change should trigger
 // ghost completion. Character insertion enables it; deletion disables it.
 func (o *Omnibox) updateInsertCompletionFromKey(keyval uint) {
@@ -1096,14 +1211,20 @@ func (o *Omnibox) onEntryChanged() {
 		entryText = trimmed
 	}
 
+	// Positions are character offsets into the buffer as GTK holds it, so
+	// compare against the buffer text rather than the text we act on.
+	cursorAtEnd := entryCursorAtEnd(o.entry.GetPosition(), o.entry.GetText())
+
 	// Reset ghost and selection state (the buffer is already correct — GTK handled it).
+	// The gating result is stored so async result updates honor it as well.
 	o.mu.Lock()
 	oldGhost := o.ghostSuffix
+	shouldComplete := inlineCompletionAllowed(o.realInput, entryText, o.insertCompletion, cursorAtEnd)
 	o.hasNavigated = false
 	o.selectedIndex = -1
 	o.ghostSuffix = ""
 	o.realInput = entryText
-	shouldComplete := o.insertCompletion
+	o.insertCompletion = shouldComplete
 	o.mu.Unlock()
 
 	log.Debug().
@@ -1503,7 +1624,7 @@ func visibleGhostSuggestion(
 }
 
 func visibleURLsForMode(mode ViewMode, maxVisible int, suggestions []Suggestion, favorites []Favorite) []string {
-	if mode == ViewModeHistory {
+	if mode.listsSuggestions() {
 		visibleCount := visibleResultCount(len(suggestions), maxVisible)
 		urls := make([]string, 0, visibleCount)
 		for _, s := range suggestions[:visibleCount] {
@@ -1591,16 +1712,67 @@ func (o *Omnibox) performSearch() {
 		return
 	}
 
-	// History search
+	// History (or unified history+favorites) search
 	if query == "" {
 		o.loadInitialHistory(token)
 		return
 	}
+	if !queryMeetsMinLength(query, o.minQueryLength) {
+		return
+	}
 
 	// Perform fuzzy history search in background
 	o.searchHistory(query, o.effectiveMaxRows(), token)
 }
 
+// mergeFavoritesIfUnified interleaves matching favorites into history
+// suggestions when the omnibox is in unified mode. Runs off the GTK thread.
+func (o *Omnibox) mergeFavoritesIfUnified(
+	ctx context.Context,
+	mode ViewMode,
+	query string,
+	suggestions []Suggestion,
+	limit int,
+) []Suggestion {
+	if mode != ViewModeAll || o.favoritesUC == nil {
+		return suggestions
+	}
+	favorites, err := o.favoritesUC.FilterForOmnibox(ctx, query, o.matchMode)
+	if err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msg("failed to load favorites for unified omnibox")
+		return suggestions
+	}
+
+	history := make([]usecase.OmniboxResult, len(suggestions))
+	for i, s := range suggestions {
+		history[i] = usecase.OmniboxResult{URL: s.URL, Title: s.Title, IsFavorite: s.IsFavorite}
+	}
+	merged := usecase.MergeOmniboxResults(history, favorites, limit)
+	out := make([]Suggestion, len(merged))
+	for i, r := range merged {
+		out[i] = Suggestion{URL: r.URL, Title: r.Title, IsFavorite: r.IsFavorite}
+	}
+	return out
+}
+
+// queryMeetsMinLength reports whether query is long enough to search history.
+func queryMeetsMinLength(query string, minLength int) bool {
+	return utf8.RuneCountInString(strings.TrimSpace(query)) >= minLength
+}
+
+// selectOmniboxNavigate picks the handler used when a suggestion is submitted.
+// New-pane routing falls back to the active pane when no new-pane handler is
+// wired (standalone and floating omniboxes).
+func selectOmniboxNavigate(
+	openInNewPane bool,
+	active, newPane func(context.Context, string) error,
+) func(context.Context, string) error {
+	if openInNewPane && newPane != nil {
+		return newPane
+	}
+	return active
+}
+
 func effectiveSearchQuery(entryText, realInput string, hasGhost bool) string {
 	if hasGhost && realInput != "" {
 		return realInput
@@ -1611,6 +1783,10 @@ func effectiveSearchQuery(entryText, realInput string, hasGhost bool) string {
 // searchHistory runs a fuzzy history search in a background goroutine.
 // query is the search text; limit caps the number of results.
 func (o *Omnibox) searchHistory(query string, limit int, token uint64) {
+	o.mu.RLock()
+	mode := o.viewMode
+	o.mu.RUnlock()
+
 	go func() {
 		ctx := o.ctx
 		log := logging.FromContext(ctx)
@@ -1628,8 +1804,9 @@ func (o *Omnibox) searchHistory(query string, limit int, token uint64) {
 
 		go func() {
 			searchInput := usecase.SearchInput{
-				Query: query,
-				Limit: limit,
+				Query:     query,
+				Limit:     limit,
+				MatchMode: o.matchMode,
 			}
 			output, err := o.historyUC.Search(ctx, searchInput)
 			searchCh <- searchResult{output, err}
@@ -1660,6 +1837,7 @@ func (o *Omnibox) searchHistory(query string, limit int, token uint64) {
 				IsFavorite: isFav,
 			})
 		}
+		suggestions = o.mergeFavoritesIfUnified(ctx, mode, query, suggestions, limit)
 
 		// Marshal back to GTK main thread, passing query for stale-result guarding.
 		o.idleAddUpdateSuggestions(suggestions, query, token)
@@ -1676,6 +1854,9 @@ func (o *Omnibox) loadInitialHistory(token uint64) {
 	initialLimit := o.effectiveMaxRows()
 	initialBehavior := o.initialBehavior
 	mostVisitedDays := o.mostVisitedDays
+	o.mu.RLock()
+	mode := o.viewMode
+	o.mu.RUnlock()
 
 	go func() {
 		ctx := o.ctx
@@ -1741,6 +1922,7 @@ func (o *Omnibox) loadInitialHistory(token uint64) {
 					IsFavorite: isFav,
 				})
 			}
+			suggestions = o.mergeFavoritesIfUnified(ctx, mode, "", suggestions, initialLimit)
 		}
 
 		o.idleAddUpdateSuggestions(suggestions, "", token)
@@ -1756,7 +1938,7 @@ func (o *Omnibox) loadFavorites(query string, token uint64) {
 	go func() {
 		ctx := o.ctx
 		log := logging.FromContext(ctx)
-		results, err := o.favoritesUC.FilterForOmnibox(ctx, query)
+		results, err := o.favoritesUC.FilterForOmnibox(ctx, query, o.matchMode)
 		if err != nil {
 			log.Error().Err(err).Msg("failed to load favorites")
 			return
@@ -1954,7 +2136,7 @@ func (o *Omnibox) rebuildList() {
 				o.listBox.Append(&row.Widget)
 			}
 		}
-	} else if mode == ViewModeHistory {
+	} else if mode.listsSuggestions() {
 		for i, s := range suggestions {
 			row := o.createSuggestionRow(s, i)
 			if row != nil {
@@ -1987,7 +2169,7 @@ func (o *Omnibox) rebuildList() {
 				var count int
 				if o.bangMode {
 					count = len(o.bangSuggestions)
-				} else if o.viewMode == ViewModeHistory {
+				} else if o.viewMode.listsSuggestions() {
 					count = len(o.suggestions)
 				} else {
 					count = len(o.favorites)
@@ -2011,9 +2193,9 @@ func (o *Omnibox) createFaviconImage(rawURL, fallbackIcon string) *gtk.Image {
 	favicon.SetPixelSize(int(16 * o.uiScale))
 	favicon.AddCssClass("omnibox-favicon")
 
-	// Async load favicon from cache
+	// Async load favicon from cache, falling back to a generated monogram
 	if o.faviconAdapter != nil && rawURL != "" {
-		o.faviconAdapter.GetOrFetch(o.ctx, rawURL, func(texture *gdk.Texture) {
+		o.faviconAdapter.GetOrFetchWithFallback(o.ctx, rawURL, func(texture *gdk.Texture) {
 			if texture != nil {
 				var cb glib.SourceFunc = func(data uintptr) bool {
 					favicon.SetFromPaintable(texture)
@@ -2238,60 +2420,70 @@ func (o *Omnibox) selectIndex(index int) {
 
 // selectNext moves selection down.
 func (o *Omnibox) selectNext() {
-	o.mu.Lock()
-	current := o.selectedIndex
-	mode := o.viewMode
-	bangMode := o.bangMode
-	maxVisible := o.effectiveMaxRows()
-	var maxIndex int
-	if bangMode {
-		maxIndex = visibleResultCount(len(o.bangSuggestions), maxVisible) - 1
-	} else if mode == ViewModeHistory {
-		maxIndex = visibleResultCount(len(o.suggestions), maxVisible) - 1
-	} else {
-		maxIndex = visibleResultCount(len(o.favorites), maxVisible) - 1
-	}
-	o.hasNavigated = true // User is navigating with arrow keys
-	o.mu.Unlock()
-
-	if maxIndex < 0 {
-		return
-	}
-
-	newIndex := current + 1
-	if newIndex > maxIndex {
-		newIndex = 0 // Wrap around
-	}
-	o.selectIndex(newIndex)
+	o.moveSelection(1)
 }
 
 // selectPrevious moves selection up.
 func (o *Omnibox) selectPrevious() {
+	o.moveSelection(-1)
+}
+
+// moveSelection moves the selection step rows through the visible results,
+// wrapping around at either end.
+func (o *Omnibox) moveSelection(step int) {
 	o.mu.Lock()
 	current := o.selectedIndex
 	mode := o.viewMode
 	bangMode := o.bangMode
 	maxVisible := o.effectiveMaxRows()
-	var maxIndex int
+	var count int
 	if bangMode {
-		maxIndex = visibleResultCount(len(o.bangSuggestions), maxVisible) - 1
-	} else if mode == ViewModeHistory {
-		maxIndex = visibleResultCount(len(o.suggestions), maxVisible) - 1
+		count = visibleResultCount(len(o.bangSuggestions), maxVisible)
+	} else if mode.listsSuggestions() {
+		count = visibleResultCount(len(o.suggestions), maxVisible)
 	} else {
-		maxIndex = visibleResultCount(len(o.favorites), maxVisible) - 1
+		count = visibleResultCount(len(o.favorites), maxVisible)
 	}
 	o.hasNavigated = true // User is navigating with arrow keys
 	o.mu.Unlock()
 
-	if maxIndex < 0 {
-		return
+	if newIndex, ok := wrappedResultIndex(current, step, count); ok {
+		o.selectIndex(newIndex)
+	}
+}
+
+// wrappedResultIndex returns the index step rows away from current in a list
+// of count results, wrapping past either end. With no selection (-1), moving
+// down starts at the first result and moving up at the last. It reports false
+// when there is nothing to select.
+func wrappedResultIndex(current, step, count int) (int, bool) {
+	if count <= 0 || step == 0 {
+		return 0, false
+	}
+	if current >= count {
+		current = -1 // The list shrank under the selection.
+	}
+	if current < 0 && step < 0 {
+		current = count
+	}
+	index := (current + step) % count
+	if index < 0 {
+		index += count
 	}
+	return index, true
+}
 
-	newIndex := current - 1
-	if newIndex < 0 {
-		newIndex = maxIndex // Wrap around
+// resultKeyStep returns 1 for a configured next-result key, -1 for a
+// previous-result key and 0 for any other key.
+func (o *Omnibox) resultKeyStep(keyval uint, state gdk.ModifierType) int {
+	switch {
+	case o.nextResultKeys.Contains(keyval, state):
+		return 1
+	case o.previousResultKeys.Contains(keyval, state):
+		return -1
+	default:
+		return 0
 	}
-	o.selectIndex(newIndex)
 }
 
 // selectAndNavigate selects an index and navigates to it.
@@ -2323,11 +2515,12 @@ func (o *Omnibox) submitNavigation(targetURL string) {
 	if targetURL == "" {
 		return
 	}
-	if o.onNavigate == nil {
+	navigate := selectOmniboxNavigate(o.openInNewPane, o.onNavigate, o.onOpenInNewPane)
+	if navigate == nil {
 		logging.FromContext(o.ctx).Warn().Msg("omnibox navigate handler is nil")
 		return
 	}
-	if err := o.onNavigate(o.ctx, targetURL); err != nil {
+	if err := navigate(o.ctx, targetURL); err != nil {
 		logging.FromContext(o.ctx).Error().Err(err).Msg("omnibox navigation failed")
 		if o.onToast != nil {
 			o.onToast(o.ctx, "Unable to open URL", ToastError)
@@ -2337,6 +2530,29 @@ func (o *Omnibox) submitNavigation(targetURL string) {
 	o.Hide(o.ctx)
 }
 
+// omniboxCommandText returns the command typed after the ">" prefix.
+func omniboxCommandText(entryText string) (string, bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(entryText), omniboxCommandPrefix)
+	if !ok {
+		return "", false
+	}
+	command := strings.TrimSpace(rest)
+	return command, command != ""
+}
+
+// submitCommand runs command and closes the omnibox, or keeps it open with
+// the error shown as a toast so the command can be corrected.
+func (o *Omnibox) submitCommand(command string) {
+	if err := o.onCommand(o.ctx, command); err != nil {
+		logging.FromContext(o.ctx).Debug().Err(err).Str("command", command).Msg("omnibox command failed")
+		if o.onToast != nil {
+			o.onToast(o.ctx, err.Error(), ToastError)
+		}
+		return
+	}
+	o.Hide(o.ctx)
+}
+
 // navigateToSelected navigates to the currently selected item or typed URL.
 // If the user typed a URL-like string, prioritize navigating to that directly.
 func (o *Omnibox) navigateToSelected() {
@@ -2351,6 +2567,11 @@ func (o *Omnibox) navigateToSelected() {
 
 	entryText := o.entry.GetText()
 
+	if command, ok := omniboxCommandText(entryText); ok && o.onCommand != nil {
+		o.submitCommand(command)
+		return
+	}
+
 	if bangMode {
 		// If user typed a full bang query, navigate using the bang URL.
 		if o.shortcutsUC != nil {
@@ -2390,7 +2611,7 @@ func (o *Omnibox) navigateToSelected() {
 }
 
 func resolveTargetURLForSelection(mode ViewMode, idx, maxVisible int, suggestions []Suggestion, favorites []Favorite) string {
-	if mode == ViewModeHistory {
+	if mode.listsSuggestions() {
 		visibleCount := visibleResultCount(len(suggestions), maxVisible)
 		if idx >= 0 && idx < visibleCount {
 			return suggestions[idx].URL
@@ -2418,7 +2639,7 @@ func resolveFavoriteToggleTarget(
 	suggestions []Suggestion,
 	favorites []Favorite,
 ) (favoriteToggleTarget, bool) {
-	if mode == ViewModeHistory {
+	if mode.listsSuggestions() {
 		if idx < 0 || idx >= len(suggestions) {
 			return favoriteToggleTarget{}, false
 		}
@@ -2487,7 +2708,7 @@ func (o *Omnibox) toggleFavorite() {
 		return
 	}
 
-	if mode == ViewModeHistory {
+	if mode.listsSuggestions() {
 		// Toggle favorite in history mode
 		if idx < 0 || idx >= len(suggestions) {
 			log.Debug().Int("index", idx).Msg("toggle favorite: invalid selection")
@@ -2641,7 +2862,7 @@ func resolveFavoriteRowIndicatorUpdate(
 	suggestions []Suggestion,
 	isFavorite bool,
 ) favoriteRowIndicatorUpdate {
-	if mode != ViewModeHistory {
+	if !mode.listsSuggestions() {
 		return favoriteRowIndicatorUpdate{}
 	}
 	if index < 0 || index >= len(suggestions) {
@@ -2653,8 +2874,9 @@ func resolveFavoriteRowIndicatorUpdate(
 	return favoriteRowIndicatorUpdate{Apply: true, ShowStarSlot: isFavorite}
 }
 
-// yankSelectedURL copies the URL of the selected item to clipboard.
-func (o *Omnibox) yankSelectedURL() {
+// yankSelectedURL copies the URL of the selected item to clipboard, or a
+// "[title](url)" Markdown link when asMarkdown is set.
+func (o *Omnibox) yankSelectedURL(asMarkdown bool) {
 	log := logging.FromContext(o.ctx)
 
 	if o.copyURLUC == nil {
@@ -2669,19 +2891,21 @@ func (o *Omnibox) yankSelectedURL() {
 	favorites := o.favorites
 	o.mu.RUnlock()
 
-	var selectedURL string
-	if mode == ViewModeHistory {
+	var selectedURL, selectedTitle string
+	if mode.listsSuggestions() {
 		if idx < 0 || idx >= len(suggestions) {
 			log.Debug().Int("index", idx).Msg("yank URL: invalid selection")
 			return
 		}
 		selectedURL = suggestions[idx].URL
+		selectedTitle = suggestions[idx].Title
 	} else {
 		if idx < 0 || idx >= len(favorites) {
 			log.Debug().Int("index", idx).Msg("yank URL: invalid selection")
 			return
 		}
 		selectedURL = favorites[idx].URL
+		selectedTitle = favorites[idx].Title
 	}
 
 	if selectedURL == "" {
@@ -2691,14 +2915,21 @@ func (o *Omnibox) yankSelectedURL() {
 
 	go func() {
 		ctx := o.ctx
-		if err := o.copyURLUC.Copy(ctx, selectedURL); err != nil {
+		copyFn, toast := o.copyURLUC.Copy, "URL copied"
+		if asMarkdown {
+			copyFn = func(ctx context.Context, url string) error {
+				return o.copyURLUC.CopyAsMarkdown(ctx, url, selectedTitle)
+			}
+			toast = "Markdown link copied"
+		}
+		if err := copyFn(ctx, selectedURL); err != nil {
 			return // Use case already logs the error
 		}
 
 		// Show toast notification on success (must run on GTK main thread)
 		if o.onToast != nil {
 			cb := glib.SourceFunc(func(_ uintptr) bool {
-				o.onToast(ctx, "URL copied", ToastSuccess)
+				o.onToast(ctx, toast, ToastSuccess)
 				return false // Don't repeat
 			})
 			glib.IdleAdd(&cb, 0)
@@ -2715,17 +2946,22 @@ func (o *Omnibox) buildURL(text string) string {
 	return usecase.BuildNavigationURL(o.ctx, text, o.normalizeNavigationURL, shortcutURLs, o.defaultSearch)
 }
 
-// toggleViewMode switches between history and favorites.
+// toggleViewMode cycles between unified, history, and favorites.
 func (o *Omnibox) toggleViewMode() {
 	o.mu.RLock()
 	current := o.viewMode
 	o.mu.RUnlock()
 
-	if current == ViewModeHistory {
-		o.setViewMode(ViewModeFavorites)
-	} else {
-		o.setViewMode(ViewModeHistory)
-	}
+	o.setViewMode(nextViewMode(current))
+}
+
+// selectExplicitViewMode handles header button clicks.
+func (o *Omnibox) selectExplicitViewMode(mode ViewMode) {
+	o.mu.RLock()
+	current := o.viewMode
+	o.mu.RUnlock()
+
+	o.setViewMode(explicitViewModeTarget(current, mode))
 }
 
 // setViewMode changes the view mode and updates UI.
@@ -2734,16 +2970,22 @@ func (o *Omnibox) setViewMode(mode ViewMode) {
 	o.viewMode = mode
 	o.mu.Unlock()
 
-	// Update header button styling
-	if mode == ViewModeHistory {
+	// Update header button styling. Unified mode highlights both sources.
+	if mode != ViewModeFavorites {
 		o.historyBtn.AddCssClass("omnibox-header-active")
-		o.favoritesBtn.RemoveCssClass("omnibox-header-active")
 	} else {
 		o.historyBtn.RemoveCssClass("omnibox-header-active")
+	}
+	if mode != ViewModeHistory {
 		o.favoritesBtn.AddCssClass("omnibox-header-active")
+	} else {
+		o.favoritesBtn.RemoveCssClass("omnibox-header-active")
 	}
 
-	// Reload data
+	// Reload data; the query is unchanged, so bypass duplicate suppression.
+	o.debounceMu.Lock()
+	o.lastQuery = ""
+	o.debounceMu.Unlock()
 	o.performSearch()
 }
 
@@ -2765,9 +3007,13 @@ func (o *Omnibox) Show(ctx context.Context, query string) {
 	o.insertCompletion = false
 	o.mu.Unlock()
 
-	// Set initial query
+	// Set initial query. A prefilled query is selected so typing replaces it.
 	o.entry.SetText(query)
-	o.entry.SelectRegion(-1, -1)
+	if query != "" {
+		o.entry.SelectRegion(0, -1)
+	} else {
+		o.entry.SelectRegion(-1, -1)
+	}
 
 	// Keep the results container hidden until real rows arrive.
 	// Pre-showing an empty, expanding scroller renders a dead area in standalone mode.
@@ -81,6 +81,13 @@ func TestShouldRefreshInitialHistoryAfterToggle(t *testing.T) {
 			behavior:    entity.OmniboxInitialBehaviorRecent,
 			wantRefresh: false,
 		},
+		{
+			name:        "empty unified mode",
+			viewMode:    ViewModeAll,
+			query:       "",
+			behavior:    entity.OmniboxInitialBehaviorMostVisited,
+			wantRefresh: true,
+		},
 		{
 			name:        "favorites mode",
 			viewMode:    ViewModeFavorites,
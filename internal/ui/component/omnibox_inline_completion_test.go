@@ -0,0 +1,72 @@
+package component
+
+import "testing"
+
+func TestInlineCompletionAllowed(t *testing.T) {
+	tests := []struct {
+		name        string
+		previous    string
+		current     string
+		insertKey   bool
+		cursorAtEnd bool
+		want        bool
+	}{
+		{name: "append character", previous: "git", current: "gith", insertKey: true, cursorAtEnd: true, want: true},
+		{name: "first character", previous: "", current: "g", insertKey: true, cursorAtEnd: true, want: true},
+		{name: "paste replaces input", previous: "foo", current: "github", insertKey: true, cursorAtEnd: true, want: true},
+		{name: "deletion key", previous: "gith", current: "git", insertKey: false, cursorAtEnd: true, want: false},
+		{name: "word delete shrinks text", previous: "github.com/bnema", current: "github.com/", insertKey: true, cursorAtEnd: true, want: false},
+		{name: "unchanged text", previous: "git", current: "git", insertKey: true, cursorAtEnd: true, want: false},
+		{name: "cleared entry", previous: "git", current: "", insertKey: true, cursorAtEnd: true, want: false},
+		{name: "insert in the middle", previous: "gthub", current: "github", insertKey: true, cursorAtEnd: false, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := inlineCompletionAllowed(tt.previous, tt.current, tt.insertKey, tt.cursorAtEnd)
+			if got != tt.want {
+				t.Fatalf("inlineCompletionAllowed(%q, %q, %v, %v) = %v, want %v",
+					tt.previous, tt.current, tt.insertKey, tt.cursorAtEnd, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntryCursorAtEnd(t *testing.T) {
+	tests := []struct {
+		name     string
+		position int
+		buffer   string
+		want     bool
+	}{
+		{name: "end of text", position: 4, buffer: "gith", want: true},
+		{name: "middle of text", position: 2, buffer: "gith", want: false},
+		{name: "after trailing space", position: 5, buffer: "gith ", want: true},
+		{name: "before trailing space", position: 4, buffer: "gith ", want: false},
+		{name: "after trailing spaces", position: 7, buffer: "gith   ", want: true},
+		{name: "multibyte characters", position: 5, buffer: "café ", want: true},
+		{name: "empty entry", position: 0, buffer: "", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entryCursorAtEnd(tt.position, tt.buffer); got != tt.want {
+				t.Fatalf("entryCursorAtEnd(%d, %q) = %v, want %v", tt.position, tt.buffer, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisibleGhostSuggestionPrefersTopRankedPrefixMatch(t *testing.T) {
+	suggestions := []Suggestion{
+		{URL: "https://news.ycombinator.com"},
+		{URL: "https://github.com/bnema/dumber"},
+		{URL: "https://github.com"},
+	}
+
+	gotFull, gotSuffix, gotOK := visibleGhostSuggestion("gith", "", false, ViewModeHistory, 10, suggestions, nil)
+	if !gotOK || gotFull != "github.com" || gotSuffix != "ub.com" {
+		t.Fatalf("visibleGhostSuggestion(%q) = (%q, %q, %v), want (%q, %q, true)",
+			"gith", gotFull, gotSuffix, gotOK, "github.com", "ub.com")
+	}
+}
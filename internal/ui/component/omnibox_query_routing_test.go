@@ -0,0 +1,85 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryMeetsMinLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		minLength int
+		want      bool
+	}{
+		{name: "disabled gate", query: "a", minLength: 0, want: true},
+		{name: "default gate allows single char", query: "a", minLength: 1, want: true},
+		{name: "single char below gate", query: "a", minLength: 2, want: false},
+		{name: "meets gate", query: "ab", minLength: 2, want: true},
+		{name: "counts runes not bytes", query: "éé", minLength: 3, want: false},
+		{name: "ignores surrounding spaces", query: " a ", minLength: 2, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, queryMeetsMinLength(tt.query, tt.minLength))
+		})
+	}
+}
+
+func TestSelectOmniboxNavigate(t *testing.T) {
+	var got string
+	active := func(_ context.Context, url string) error { got = "active:" + url; return nil }
+	newPane := func(_ context.Context, url string) error { got = "new:" + url; return nil }
+
+	navigate := selectOmniboxNavigate(false, active, newPane)
+	require.NotNil(t, navigate)
+	require.NoError(t, navigate(context.Background(), "https://a.example"))
+	assert.Equal(t, "active:https://a.example", got)
+
+	navigate = selectOmniboxNavigate(true, active, newPane)
+	require.NoError(t, navigate(context.Background(), "https://b.example"))
+	assert.Equal(t, "new:https://b.example", got)
+
+	// Without a new-pane handler the active pane is used.
+	navigate = selectOmniboxNavigate(true, active, nil)
+	require.NoError(t, navigate(context.Background(), "https://c.example"))
+	assert.Equal(t, "active:https://c.example", got)
+
+	assert.Nil(t, selectOmniboxNavigate(false, nil, newPane))
+}
+
+func TestOmniboxListDefaults(t *testing.T) {
+	assert.Equal(t, OmniboxListDefaults, omniboxListDefaults(0))
+
+	custom := omniboxListDefaults(20)
+	assert.Equal(t, 20, custom.MaxVisibleRows)
+	assert.Equal(t, 20, custom.MaxResults)
+	assert.Equal(t, OmniboxListDefaults.SmallMaxVisibleRows, custom.SmallMaxVisibleRows)
+
+	small := omniboxListDefaults(3)
+	assert.Equal(t, 3, small.MaxVisibleRows)
+	assert.Equal(t, 3, small.SmallMaxVisibleRows)
+}
+
+func TestOmniboxCommandText(t *testing.T) {
+	tests := []struct {
+		entry   string
+		want    string
+		wantCmd bool
+	}{
+		{entry: ">fill test-{n}", want: "fill test-{n}", wantCmd: true},
+		{entry: "  > fill --passwords x ", want: "fill --passwords x", wantCmd: true},
+		{entry: ">", wantCmd: false},
+		{entry: ">   ", wantCmd: false},
+		{entry: "example.com", wantCmd: false},
+		{entry: "!g >fill", wantCmd: false},
+	}
+	for _, tt := range tests {
+		got, ok := omniboxCommandText(tt.entry)
+		assert.Equal(t, tt.wantCmd, ok, "entry %q", tt.entry)
+		assert.Equal(t, tt.want, got, "entry %q", tt.entry)
+	}
+}
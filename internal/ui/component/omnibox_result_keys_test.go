@@ -0,0 +1,76 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bnema/puregotk/v4/gdk"
+)
+
+func TestOmniboxResultKeyStep(t *testing.T) {
+	ctx := context.Background()
+	o := &Omnibox{
+		nextResultKeys:     parseOmniboxResultKeys(ctx, "next", []string{"ctrl+j", "ctrl+n"}),
+		previousResultKeys: parseOmniboxResultKeys(ctx, "previous", []string{"ctrl+k", "nosuchkey"}),
+	}
+	ctrl := gdk.ControlMaskValue
+
+	tests := []struct {
+		name   string
+		keyval uint
+		state  gdk.ModifierType
+		want   int
+	}{
+		{name: "ctrl+j moves down", keyval: uint(gdk.KEY_j), state: ctrl, want: 1},
+		{name: "second next key", keyval: uint(gdk.KEY_n), state: ctrl, want: 1},
+		{name: "ctrl+k moves up", keyval: uint(gdk.KEY_k), state: ctrl, want: -1},
+		{name: "plain j is typed", keyval: uint(gdk.KEY_j), state: 0, want: 0},
+		{name: "shift+k is typed", keyval: uint(gdk.KEY_K), state: gdk.ShiftMaskValue, want: 0},
+		{name: "unconfigured ctrl key", keyval: uint(gdk.KEY_p), state: ctrl, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := o.resultKeyStep(tt.keyval, tt.state); got != tt.want {
+				t.Fatalf("resultKeyStep() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOmniboxResultKeyStep_NoKeysConfigured(t *testing.T) {
+	o := &Omnibox{}
+	if got := o.resultKeyStep(uint(gdk.KEY_j), gdk.ControlMaskValue); got != 0 {
+		t.Fatalf("resultKeyStep() = %d, want 0 without configured keys", got)
+	}
+}
+
+func TestWrappedResultIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int
+		step    int
+		count   int
+		want    int
+		wantOK  bool
+	}{
+		{name: "down", current: 0, step: 1, count: 3, want: 1, wantOK: true},
+		{name: "up", current: 2, step: -1, count: 3, want: 1, wantOK: true},
+		{name: "down wraps to first", current: 2, step: 1, count: 3, want: 0, wantOK: true},
+		{name: "up wraps to last", current: 0, step: -1, count: 3, want: 2, wantOK: true},
+		{name: "no selection down", current: -1, step: 1, count: 3, want: 0, wantOK: true},
+		{name: "no selection up", current: -1, step: -1, count: 3, want: 2, wantOK: true},
+		{name: "selection past shrunk list down", current: 5, step: 1, count: 3, want: 0, wantOK: true},
+		{name: "selection past shrunk list up", current: 5, step: -1, count: 3, want: 2, wantOK: true},
+		{name: "single result", current: 0, step: 1, count: 1, want: 0, wantOK: true},
+		{name: "empty list", current: -1, step: 1, count: 0, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := wrappedResultIndex(tt.current, tt.step, tt.count)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Fatalf("wrappedResultIndex(%d, %d, %d) = %d, %v; want %d, %v",
+					tt.current, tt.step, tt.count, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
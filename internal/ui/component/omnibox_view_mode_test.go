@@ -0,0 +1,49 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextViewMode_CyclesUnifiedHistoryFavorites(t *testing.T) {
+	assert.Equal(t, ViewModeHistory, nextViewMode(ViewModeAll))
+	assert.Equal(t, ViewModeFavorites, nextViewMode(ViewModeHistory))
+	assert.Equal(t, ViewModeAll, nextViewMode(ViewModeFavorites))
+}
+
+func TestExplicitViewModeTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		current ViewMode
+		clicked ViewMode
+		want    ViewMode
+	}{
+		{name: "unified to history", current: ViewModeAll, clicked: ViewModeHistory, want: ViewModeHistory},
+		{name: "unified to favorites", current: ViewModeAll, clicked: ViewModeFavorites, want: ViewModeFavorites},
+		{name: "history to favorites", current: ViewModeHistory, clicked: ViewModeFavorites, want: ViewModeFavorites},
+		{name: "active history returns to unified", current: ViewModeHistory, clicked: ViewModeHistory, want: ViewModeAll},
+		{name: "active favorites returns to unified", current: ViewModeFavorites, clicked: ViewModeFavorites, want: ViewModeAll},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, explicitViewModeTarget(tt.current, tt.clicked))
+		})
+	}
+}
+
+func TestViewModeListsSuggestions(t *testing.T) {
+	assert.True(t, ViewModeAll.listsSuggestions())
+	assert.True(t, ViewModeHistory.listsSuggestions())
+	assert.False(t, ViewModeFavorites.listsSuggestions())
+}
+
+func TestVisibleURLsForMode_UnifiedUsesSuggestions(t *testing.T) {
+	suggestions := []Suggestion{{URL: "https://a.test"}, {URL: "https://b.test", IsFavorite: true}}
+	favorites := []Favorite{{URL: "https://fav.test"}}
+
+	got := visibleURLsForMode(ViewModeAll, 10, suggestions, favorites)
+
+	assert.Equal(t, []string{"https://a.test", "https://b.test"}, got)
+}
@@ -0,0 +1,53 @@
+// Package component provides UI components for the browser.
+package component
+
+import (
+	"strconv"
+
+	"github.com/bnema/puregotk/v4/gtk"
+
+	"github.com/bnema/dumber/internal/ui/layout"
+)
+
+// PaneNumberBadge displays the jump-to-pane number over a pane while pane
+// mode is active. It is centered and never intercepts pointer events.
+type PaneNumberBadge struct {
+	container layout.BoxWidget
+	label     layout.LabelWidget
+}
+
+// NewPaneNumberBadge creates a hidden pane number badge.
+func NewPaneNumberBadge(factory layout.WidgetFactory) *PaneNumberBadge {
+	container := factory.NewBox(layout.OrientationHorizontal, 0)
+	container.AddCssClass("pane-number")
+	container.SetHalign(gtk.AlignCenterValue)
+	container.SetValign(gtk.AlignCenterValue)
+	container.SetHexpand(false)
+	container.SetVexpand(false)
+	container.SetCanTarget(false)
+	container.SetCanFocus(false)
+	container.SetVisible(false)
+
+	label := factory.NewLabel("")
+	label.SetCanTarget(false)
+	label.SetCanFocus(false)
+	container.Append(label)
+
+	return &PaneNumberBadge{container: container, label: label}
+}
+
+// Show displays n on the badge.
+func (b *PaneNumberBadge) Show(n int) {
+	b.label.SetText(strconv.Itoa(n))
+	b.container.SetVisible(true)
+}
+
+// Hide hides the badge.
+func (b *PaneNumberBadge) Hide() {
+	b.container.SetVisible(false)
+}
+
+// Widget returns the badge container for embedding in an overlay.
+func (b *PaneNumberBadge) Widget() layout.Widget {
+	return b.container
+}
@@ -4,6 +4,7 @@ package component
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/logging"
@@ -25,8 +26,10 @@ type PaneView struct {
 	webViewWidget layout.Widget      // The actual WebView widget
 	borderBox     layout.BoxWidget   // Border overlay for active indication
 	progressBar   *ProgressBar       // Loading progress indicator
+	reading       *ReadingProgress   // Scroll position indicator
 	toaster       *Toaster           // Toast notification overlay
 	linkStatus    *LinkStatusOverlay // Link hover URL overlay
+	paneNumber    *PaneNumberBadge   // Jump-to-pane number shown in pane mode
 	loading       *LoadingSkeleton   // Placeholder shown until WebView paints
 	paneID        entity.PaneID
 	isActive      bool
@@ -35,8 +38,10 @@ type PaneView struct {
 	onFocusOut    func(paneID entity.PaneID)
 	onHover       func(paneID entity.PaneID)
 	onMouseMotion func()
+	onChromeClose func(paneID entity.PaneID)
 
-	hoverHandler *input.HoverHandler
+	hoverHandler       *input.HoverHandler
+	middleClickHandler *input.MiddleClickHandler
 
 	mu sync.RWMutex
 }
@@ -263,12 +268,14 @@ func (pv *PaneView) SetOnMouseMotion(fn func()) {
 }
 
 // AttachHoverHandler creates and attaches a hover handler for focus-follows-mouse behavior.
-func (pv *PaneView) AttachHoverHandler(ctx context.Context) {
+// The pane is focused once the pointer has stayed over it for delay.
+func (pv *PaneView) AttachHoverHandler(ctx context.Context, delay time.Duration) {
 	pv.mu.Lock()
 	defer pv.mu.Unlock()
 
 	// Create hover handler
 	pv.hoverHandler = input.NewHoverHandler(ctx, pv.paneID)
+	pv.hoverHandler.SetDelay(delay)
 
 	// Wire up hover enter callback
 	pv.hoverHandler.SetOnEnter(func(paneID entity.PaneID) {
@@ -299,6 +306,40 @@ func (pv *PaneView) AttachHoverHandler(ctx context.Context) {
 	}
 }
 
+// SetOnChromeClose sets the callback for when the pane is closed from its
+// chrome, e.g. by a middle click on the pane edge.
+func (pv *PaneView) SetOnChromeClose(fn func(paneID entity.PaneID)) {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+
+	pv.onChromeClose = fn
+}
+
+// AttachMiddleClickClose makes a middle click on the pane edge close the pane.
+// Middle clicks on the page itself are left to the WebView.
+func (pv *PaneView) AttachMiddleClickClose(ctx context.Context) {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+
+	pv.middleClickHandler = input.NewMiddleClickHandler(ctx)
+	pv.middleClickHandler.SetOnClose(pv.requestChromeClose)
+
+	if gtkWidget := pv.overlay.GtkWidget(); gtkWidget != nil {
+		pv.middleClickHandler.AttachTo(gtkWidget)
+	}
+}
+
+func (pv *PaneView) requestChromeClose() {
+	pv.mu.RLock()
+	callback := pv.onChromeClose
+	paneID := pv.paneID
+	pv.mu.RUnlock()
+
+	if callback != nil {
+		callback(paneID)
+	}
+}
+
 // CancelPendingHover cancels any pending hover focus timer.
 // Called when keyboard navigation occurs to prevent hover from overriding keyboard focus.
 func (pv *PaneView) CancelPendingHover() {
@@ -449,6 +490,35 @@ func (pv *PaneView) HideLoadingSkeleton() {
 	}
 }
 
+// ensureReadingProgress creates the reading progress bar lazily on first use.
+// Must be called with write lock held.
+func (pv *PaneView) ensureReadingProgress() *ReadingProgress {
+	if pv.reading != nil {
+		return pv.reading
+	}
+
+	rp := NewReadingProgress(pv.factory)
+	pv.overlay.AddOverlay(rp.Widget())
+	pv.overlay.SetClipOverlay(rp.Widget(), false)
+	pv.overlay.SetMeasureOverlay(rp.Widget(), false)
+	pv.reading = rp
+	return rp
+}
+
+// SetReadingProgress shows how far the page is scrolled, from 0.0 to 1.0,
+// in the bar at the top of the pane. 0 hides the bar.
+func (pv *PaneView) SetReadingProgress(fraction float64) {
+	pv.mu.Lock()
+	if pv.reading == nil && fraction <= 0 {
+		pv.mu.Unlock()
+		return
+	}
+	rp := pv.ensureReadingProgress()
+	pv.mu.Unlock()
+
+	rp.SetFraction(fraction)
+}
+
 // ensureToaster creates the toaster lazily on first use.
 // Must be called with write lock held.
 func (pv *PaneView) ensureToaster() *Toaster {
@@ -499,13 +569,14 @@ func (pv *PaneView) ensureLinkStatus() *LinkStatusOverlay {
 	return ls
 }
 
-// ShowLinkStatus displays the link status overlay with the given URI.
-// If uri is empty, hides the overlay instead.
-func (pv *PaneView) ShowLinkStatus(uri string) {
+// ShowLinkStatus displays the link status overlay with the given URI using
+// cfg for truncation and debouncing. If uri is empty, hides the overlay instead.
+func (pv *PaneView) ShowLinkStatus(uri string, cfg LinkStatusConfig) {
 	pv.mu.Lock()
 	ls := pv.ensureLinkStatus()
 	pv.mu.Unlock()
 
+	ls.SetConfig(cfg)
 	ls.Show(uri)
 }
 
@@ -520,6 +591,41 @@ func (pv *PaneView) HideLinkStatus() {
 	}
 }
 
+// ensurePaneNumber creates the pane number badge lazily on first use.
+// Must be called with write lock held.
+func (pv *PaneView) ensurePaneNumber() *PaneNumberBadge {
+	if pv.paneNumber != nil {
+		return pv.paneNumber
+	}
+
+	badge := NewPaneNumberBadge(pv.factory)
+	pv.overlay.AddOverlay(badge.Widget())
+	pv.overlay.SetClipOverlay(badge.Widget(), false)
+	pv.overlay.SetMeasureOverlay(badge.Widget(), false)
+	pv.paneNumber = badge
+	return badge
+}
+
+// ShowPaneNumber overlays the jump-to-pane number n on this pane.
+func (pv *PaneView) ShowPaneNumber(n int) {
+	pv.mu.Lock()
+	badge := pv.ensurePaneNumber()
+	pv.mu.Unlock()
+
+	badge.Show(n)
+}
+
+// HidePaneNumber hides the jump-to-pane number, if shown.
+func (pv *PaneView) HidePaneNumber() {
+	pv.mu.Lock()
+	badge := pv.paneNumber
+	pv.mu.Unlock()
+
+	if badge != nil {
+		badge.Hide()
+	}
+}
+
 // Cleanup removes the WebView widget from the overlay and clears references.
 // This must be called before destroying the WebView to ensure proper GTK cleanup.
 // After calling Cleanup, the PaneView should not be reused.
@@ -532,12 +638,17 @@ func (pv *PaneView) Cleanup() {
 	pv.onFocusOut = nil
 	pv.onHover = nil
 	pv.onMouseMotion = nil
+	pv.onChromeClose = nil
 
 	// Detach hover handler if present
 	if pv.hoverHandler != nil {
 		pv.hoverHandler.Detach()
 		pv.hoverHandler = nil
 	}
+	if pv.middleClickHandler != nil {
+		pv.middleClickHandler.Detach()
+		pv.middleClickHandler = nil
+	}
 
 	// Remove WebView from overlay (unparents it from GTK hierarchy)
 	if pv.webViewWidget != nil {
@@ -554,6 +665,10 @@ func (pv *PaneView) Cleanup() {
 		pv.overlay.RemoveOverlay(pv.progressBar.Widget())
 		pv.progressBar = nil
 	}
+	if pv.reading != nil {
+		pv.overlay.RemoveOverlay(pv.reading.Widget())
+		pv.reading = nil
+	}
 	if pv.toaster != nil {
 		pv.overlay.RemoveOverlay(pv.toaster.Widget())
 		pv.toaster = nil
@@ -563,4 +678,8 @@ func (pv *PaneView) Cleanup() {
 		pv.overlay.RemoveOverlay(pv.linkStatus.Widget())
 		pv.linkStatus = nil
 	}
+	if pv.paneNumber != nil {
+		pv.overlay.RemoveOverlay(pv.paneNumber.Widget())
+		pv.paneNumber = nil
+	}
 }
@@ -1,11 +1,13 @@
 package component
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/ui/layout"
 	"github.com/bnema/dumber/internal/ui/layout/mocks"
 )
@@ -115,3 +117,35 @@ func TestPaneView_AttachWebViewWidget_RevealedThenUnrevealedReplacementRestartsS
 
 	assert.Same(t, unrevealedWidget, pv.WebViewWidget())
 }
+
+func TestWorkspaceView_MiddleClickCloseRoutesPaneID(t *testing.T) {
+	mockOverlay := mocks.NewMockOverlayWidget(t)
+	mockOverlay.EXPECT().GtkWidget().Return(nil).Once()
+
+	wv := &WorkspaceView{}
+	wv.SetMiddleClickClosesPane(true)
+	var closed []entity.PaneID
+	wv.SetOnPaneCloseRequest(func(paneID entity.PaneID) {
+		closed = append(closed, paneID)
+	})
+
+	pv := &PaneView{paneID: "pane-1", overlay: mockOverlay}
+	wv.SetupPaneMiddleClickClose(context.Background(), pv)
+	assert.NotNil(t, pv.middleClickHandler)
+
+	pv.requestChromeClose()
+	assert.Equal(t, []entity.PaneID{"pane-1"}, closed)
+}
+
+func TestWorkspaceView_MiddleClickCloseDisabledAttachesNothing(t *testing.T) {
+	wv := &WorkspaceView{}
+	called := false
+	wv.SetOnPaneCloseRequest(func(entity.PaneID) { called = true })
+
+	pv := &PaneView{paneID: "pane-1"}
+	wv.SetupPaneMiddleClickClose(context.Background(), pv)
+	assert.Nil(t, pv.middleClickHandler)
+
+	pv.requestChromeClose()
+	assert.False(t, called)
+}
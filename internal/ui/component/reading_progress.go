@@ -0,0 +1,84 @@
+package component
+
+import (
+	"sync"
+
+	"github.com/bnema/puregotk/v4/gtk"
+
+	"github.com/bnema/dumber/internal/ui/layout"
+)
+
+// ReadingProgress is a thin bar at the top of a pane showing how far its
+// page is scrolled. It stays hidden while the page is at the top or too
+// short to scroll.
+type ReadingProgress struct {
+	progressBar layout.ProgressBarWidget
+
+	visible  bool
+	fraction float64
+
+	mu sync.Mutex
+}
+
+// NewReadingProgress creates a hidden reading progress bar.
+func NewReadingProgress(factory layout.WidgetFactory) *ReadingProgress {
+	progressBar := factory.NewProgressBar()
+	progressBar.AddCssClass("reading-progress")
+
+	// Position at top, full width
+	progressBar.SetValign(gtk.AlignStartValue)
+	progressBar.SetHalign(gtk.AlignFillValue)
+	progressBar.SetHexpand(true)
+	// Same as ProgressBar: a 0 width keeps GTK from measuring the internal
+	// gizmo negative before realization.
+	progressBar.SetSizeRequest(0, 3)
+
+	progressBar.SetCanTarget(false)
+	progressBar.SetCanFocus(false)
+	progressBar.SetFraction(0)
+	progressBar.SetVisible(false)
+
+	return &ReadingProgress{progressBar: progressBar}
+}
+
+// SetFraction shows how far the page is scrolled, from 0.0 (top) to 1.0
+// (bottom). A fraction of 0 hides the bar.
+func (rp *ReadingProgress) SetFraction(fraction float64) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	if fraction < 0 {
+		fraction = 0
+	} else if fraction > 1 {
+		fraction = 1
+	}
+	if fraction != rp.fraction {
+		rp.fraction = fraction
+		rp.progressBar.SetFraction(fraction)
+	}
+
+	visible := fraction > 0
+	if visible != rp.visible {
+		rp.visible = visible
+		rp.progressBar.SetVisible(visible)
+	}
+}
+
+// Fraction returns the fraction currently shown.
+func (rp *ReadingProgress) Fraction() float64 {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	return rp.fraction
+}
+
+// IsVisible returns whether the bar is currently visible.
+func (rp *ReadingProgress) IsVisible() bool {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	return rp.visible
+}
+
+// Widget returns the underlying widget for embedding in overlays.
+func (rp *ReadingProgress) Widget() layout.Widget {
+	return rp.progressBar
+}
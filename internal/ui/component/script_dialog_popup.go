@@ -0,0 +1,295 @@
+package component
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/layout"
+	"github.com/bnema/puregotk/v4/gdk"
+	"github.com/bnema/puregotk/v4/gtk"
+)
+
+// ScriptDialogContent describes what a ScriptDialogPopup shows for one
+// JavaScript dialog.
+type ScriptDialogContent struct {
+	Heading string
+	Body    string
+	// ConfirmLabel is the label of the accept button.
+	ConfirmLabel string
+	// CancelLabel is the label of the cancel button. Empty hides the button,
+	// as for alerts.
+	CancelLabel string
+	// ShowEntry adds a text entry prefilled with DefaultText, as for prompts.
+	ShowEntry   bool
+	DefaultText string
+}
+
+// ScriptDialogPopup is a custom overlay component for JavaScript
+// alert/confirm/prompt/beforeunload dialogs. It shares the permission popup
+// styling so page dialogs look like the rest of the browser chrome.
+type ScriptDialogPopup struct {
+	outerBox *gtk.Box
+	mainBox  *gtk.Box
+
+	headingLabel *gtk.Label
+	bodyLabel    *gtk.Label
+	entry        *gtk.Entry
+
+	btnCancel  *gtk.Button
+	btnConfirm *gtk.Button
+
+	parentOverlay layout.OverlayWidget
+	uiScale       float64
+
+	mu        sync.Mutex
+	visible   bool
+	showEntry bool
+	callback  func(confirmed bool, text string)
+
+	retainedCallbacks []any
+}
+
+// NewScriptDialogPopup creates a new script dialog popup component.
+func NewScriptDialogPopup(parentOverlay layout.OverlayWidget, uiScale float64) *ScriptDialogPopup {
+	if uiScale <= 0 {
+		uiScale = 1.0
+	}
+
+	sp := &ScriptDialogPopup{
+		parentOverlay: parentOverlay,
+		uiScale:       uiScale,
+	}
+
+	if err := sp.createWidgets(); err != nil {
+		return nil
+	}
+	sp.attachKeyController()
+	return sp
+}
+
+// Widget returns the outer GTK widget for overlay registration.
+func (sp *ScriptDialogPopup) Widget() *gtk.Widget {
+	if sp.outerBox == nil {
+		return nil
+	}
+	return &sp.outerBox.Widget
+}
+
+// Show displays the popup with the given content.
+// The callback receives (confirmed, text) when the user answers; text is the
+// entry content and is only meaningful when content.ShowEntry is set.
+func (sp *ScriptDialogPopup) Show(ctx context.Context, content ScriptDialogContent, callback func(confirmed bool, text string)) {
+	log := logging.FromContext(ctx)
+
+	sp.mu.Lock()
+	if sp.visible {
+		sp.mu.Unlock()
+		log.Warn().Msg("script dialog popup already visible, ignoring Show")
+		return
+	}
+	sp.visible = true
+	sp.showEntry = content.ShowEntry
+	sp.callback = callback
+	sp.mu.Unlock()
+
+	if sp.headingLabel != nil {
+		sp.headingLabel.SetText(content.Heading)
+	}
+	if sp.bodyLabel != nil {
+		sp.bodyLabel.SetText(content.Body)
+		sp.bodyLabel.SetVisible(content.Body != "")
+	}
+	if sp.entry != nil {
+		sp.entry.SetText(content.DefaultText)
+		sp.entry.SetVisible(content.ShowEntry)
+	}
+	if sp.btnConfirm != nil {
+		sp.btnConfirm.SetLabel(content.ConfirmLabel)
+	}
+	if sp.btnCancel != nil {
+		sp.btnCancel.SetLabel(content.CancelLabel)
+		sp.btnCancel.SetVisible(content.CancelLabel != "")
+	}
+
+	sp.resizeAndCenter()
+	if sp.outerBox != nil {
+		sp.outerBox.SetVisible(true)
+	}
+	switch {
+	case content.ShowEntry && sp.entry != nil:
+		sp.entry.GrabFocus()
+	case sp.btnConfirm != nil:
+		sp.btnConfirm.GrabFocus()
+	}
+}
+
+// Hide hides the popup without invoking the callback.
+func (sp *ScriptDialogPopup) Hide() {
+	sp.mu.Lock()
+	if !sp.visible {
+		sp.mu.Unlock()
+		return
+	}
+	sp.visible = false
+	sp.callback = nil
+	sp.mu.Unlock()
+
+	if sp.outerBox != nil {
+		sp.outerBox.SetVisible(false)
+	}
+}
+
+// IsVisible returns whether the popup is currently displayed.
+func (sp *ScriptDialogPopup) IsVisible() bool {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	return sp.visible
+}
+
+func (sp *ScriptDialogPopup) dismiss(confirmed bool) {
+	sp.mu.Lock()
+	if !sp.visible {
+		sp.mu.Unlock()
+		return
+	}
+	sp.visible = false
+	showEntry := sp.showEntry
+	cb := sp.callback
+	sp.callback = nil
+	sp.mu.Unlock()
+
+	text := ""
+	if confirmed && showEntry && sp.entry != nil {
+		text = sp.entry.GetText()
+	}
+
+	if sp.outerBox != nil {
+		sp.outerBox.SetVisible(false)
+	}
+	if cb != nil {
+		cb(confirmed, text)
+	}
+}
+
+func (sp *ScriptDialogPopup) createWidgets() error {
+	sp.outerBox = gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	if sp.outerBox == nil {
+		return errNilWidget("scriptDialogPopupOuterBox")
+	}
+	sp.outerBox.AddCssClass("permission-popup-outer")
+	sp.outerBox.SetHalign(gtk.AlignCenterValue)
+	sp.outerBox.SetValign(gtk.AlignStartValue)
+	sp.outerBox.SetVisible(false)
+
+	sp.mainBox = gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	if sp.mainBox == nil {
+		return errNilWidget("scriptDialogPopupMainBox")
+	}
+	sp.mainBox.AddCssClass("permission-popup-container")
+
+	emptyText := ""
+	sp.headingLabel = gtk.NewLabel(&emptyText)
+	if sp.headingLabel == nil {
+		return errNilWidget("scriptDialogPopupHeadingLabel")
+	}
+	sp.headingLabel.AddCssClass("permission-popup-heading")
+	sp.headingLabel.SetHalign(gtk.AlignStartValue)
+
+	sp.bodyLabel = gtk.NewLabel(&emptyText)
+	if sp.bodyLabel == nil {
+		return errNilWidget("scriptDialogPopupBodyLabel")
+	}
+	sp.bodyLabel.AddCssClass("permission-popup-body")
+	sp.bodyLabel.SetHalign(gtk.AlignStartValue)
+	sp.bodyLabel.SetWrap(true)
+	// Pages can put anything in a dialog; let the user copy it.
+	sp.bodyLabel.SetSelectable(true)
+
+	sp.entry = gtk.NewEntry()
+	if sp.entry == nil {
+		return errNilWidget("scriptDialogPopupEntry")
+	}
+	sp.entry.AddCssClass("script-dialog-entry")
+	sp.entry.SetVisible(false)
+	// Enter in the prompt entry accepts, like the OK button.
+	activateCb := func(_ gtk.Entry) { sp.dismiss(true) }
+	sp.retainedCallbacks = append(sp.retainedCallbacks, activateCb)
+	sp.entry.ConnectActivate(&activateCb)
+
+	btnRow := gtk.NewBox(gtk.OrientationHorizontalValue, buttonSpacing)
+	if btnRow == nil {
+		return errNilWidget("scriptDialogPopupBtnRow")
+	}
+	btnRow.AddCssClass("permission-popup-btn-row")
+	btnRow.SetHalign(gtk.AlignEndValue)
+
+	sp.btnCancel = gtk.NewButtonWithLabel("Cancel")
+	if sp.btnCancel == nil {
+		return errNilWidget("scriptDialogPopupBtnCancel")
+	}
+	sp.btnCancel.AddCssClass("permission-popup-btn")
+	sp.btnCancel.AddCssClass("permission-popup-btn-deny")
+
+	sp.btnConfirm = gtk.NewButtonWithLabel("OK")
+	if sp.btnConfirm == nil {
+		return errNilWidget("scriptDialogPopupBtnConfirm")
+	}
+	sp.btnConfirm.AddCssClass("permission-popup-btn")
+	sp.btnConfirm.AddCssClass("permission-popup-btn-allow")
+
+	sp.wireButton(sp.btnCancel, false)
+	sp.wireButton(sp.btnConfirm, true)
+
+	btnRow.Append(&sp.btnCancel.Widget)
+	btnRow.Append(&sp.btnConfirm.Widget)
+
+	sp.mainBox.Append(&sp.headingLabel.Widget)
+	sp.mainBox.Append(&sp.bodyLabel.Widget)
+	sp.mainBox.Append(&sp.entry.Widget)
+	sp.mainBox.Append(&btnRow.Widget)
+
+	sp.outerBox.Append(&sp.mainBox.Widget)
+
+	return nil
+}
+
+// wireButton connects a button click to the dismiss callback.
+func (sp *ScriptDialogPopup) wireButton(btn *gtk.Button, confirmed bool) {
+	cb := func(_ gtk.Button) { sp.dismiss(confirmed) }
+	sp.retainedCallbacks = append(sp.retainedCallbacks, cb)
+	btn.ConnectClicked(&cb)
+}
+
+func (sp *ScriptDialogPopup) attachKeyController() {
+	if sp.outerBox == nil {
+		return
+	}
+	controller := gtk.NewEventControllerKey()
+	if controller == nil {
+		return
+	}
+	controller.SetPropagationPhase(gtk.PhaseCaptureValue)
+
+	keyPressedCb := func(_ gtk.EventControllerKey, keyval uint, _ uint, _ gdk.ModifierType) bool {
+		if keyval == uint(gdk.KEY_Escape) {
+			// Escape = cancel; an alert has nothing else to answer.
+			sp.dismiss(false)
+			return true
+		}
+		return false
+	}
+	sp.retainedCallbacks = append(sp.retainedCallbacks, keyPressedCb)
+	controller.ConnectKeyPressed(&keyPressedCb)
+	sp.outerBox.AddController(&controller.EventController)
+}
+
+func (sp *ScriptDialogPopup) resizeAndCenter() {
+	if sp.outerBox == nil || sp.mainBox == nil {
+		return
+	}
+
+	width, marginTop := CalculateModalDimensions(sp.parentOverlay, PermissionPopupSizeDefaults)
+	sp.mainBox.SetSizeRequest(width, -1)
+	sp.outerBox.SetMarginTop(marginTop)
+}
@@ -1,9 +1,11 @@
 package component
 
 import (
+	"slices"
 	"sync"
 
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/gdk"
 	"github.com/bnema/puregotk/v4/gtk"
 )
 
@@ -11,6 +13,8 @@ import (
 type TabBar struct {
 	box     *gtk.Box
 	buttons map[entity.TabID]*TabButton
+	// order lists the tab IDs in the order their buttons appear.
+	order []entity.TabID
 
 	explicitlyVisible bool
 	autoHidden        bool
@@ -19,9 +23,13 @@ type TabBar struct {
 	activeTabID entity.TabID
 
 	// Callbacks
-	onSwitch func(tabID entity.TabID)
-	onClose  func(tabID entity.TabID)
-	onCreate func()
+	onSwitch          func(tabID entity.TabID)
+	onClose           func(tabID entity.TabID)
+	onCreate          func()
+	onCloseToTheRight func(tabID entity.TabID)
+	onCloseOthers     func(tabID entity.TabID)
+	onReorder         func(tabID entity.TabID, position int)
+	groupMenu         func(tabID entity.TabID) []TabMenuEntry
 
 	mu sync.RWMutex
 }
@@ -81,9 +89,16 @@ func (tb *TabBar) AddTab(tab *entity.Tab) {
 		}
 	})
 
+	button.SetOnSecondaryClick(func(tabID entity.TabID) {
+		tb.showContextMenu(button.Widget(), tabID)
+	})
+
+	button.SetOnDragEnd(tb.handleDragEnd)
+
 	// Add to container
 	tb.box.Append(button.Widget())
 	tb.buttons[tab.ID] = button
+	tb.order = append(tb.order, tab.ID)
 }
 
 // RemoveTab removes a tab button from the bar.
@@ -100,6 +115,53 @@ func (tb *TabBar) RemoveTab(tabID entity.TabID) {
 	tb.box.Remove(button.Widget())
 	button.Destroy()
 	delete(tb.buttons, tabID)
+	tb.order = slices.DeleteFunc(tb.order, func(id entity.TabID) bool { return id == tabID })
+}
+
+// MoveTab moves the button of tabID to position, keeping the bar in step
+// with a reordered tab list.
+func (tb *TabBar) MoveTab(tabID entity.TabID, position int) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	order := moveTabID(tb.order, tabID, position)
+	if order == nil {
+		return
+	}
+	tb.order = order
+
+	var previous *gtk.Widget
+	if position > 0 {
+		previous = tb.buttons[order[position-1]].Widget()
+	}
+	tb.box.ReorderChildAfter(tb.buttons[tabID].Widget(), previous)
+}
+
+// handleDragEnd turns a tab drag into a reorder request for the tab under
+// the drop point.
+func (tb *TabBar) handleDragEnd(tabID entity.TabID, startX, offsetX float64) {
+	tb.mu.RLock()
+	widths := make([]int, len(tb.order))
+	left := 0
+	from := -1
+	for i, id := range tb.order {
+		widths[i] = tb.buttons[id].Widget().GetWidth()
+		if id == tabID {
+			from = i
+		}
+		if from < 0 {
+			left += widths[i]
+		}
+	}
+	onReorder := tb.onReorder
+	tb.mu.RUnlock()
+
+	if from < 0 || onReorder == nil {
+		return
+	}
+	if to := tabDropIndex(widths, float64(left)+startX+offsetX); to >= 0 && to != from {
+		onReorder(tabID, to)
+	}
 }
 
 // SetActive updates which tab is shown as active.
@@ -132,6 +194,48 @@ func (tb *TabBar) UpdateTitle(tabID entity.TabID, title string) {
 	}
 }
 
+// SetItem updates the title, page title and group marks of a tab button, and
+// hides it when its collapsed group folds it away. The favicon of
+// item.PageURL is set separately through SetFavicon once it has loaded.
+func (tb *TabBar) SetItem(item TabStripItem) {
+	tb.mu.RLock()
+	defer tb.mu.RUnlock()
+
+	if button, exists := tb.buttons[item.ID]; exists {
+		button.SetTitle(item.Title)
+		button.SetPageTitle(item.PageTitle)
+		tb.setGroup(button, item)
+	}
+}
+
+// SetGroups updates the group marks and visibility of every tab button from
+// items, leaving titles alone.
+func (tb *TabBar) SetGroups(items []TabStripItem) {
+	tb.mu.RLock()
+	defer tb.mu.RUnlock()
+
+	for _, item := range items {
+		if button, exists := tb.buttons[item.ID]; exists {
+			tb.setGroup(button, item)
+		}
+	}
+}
+
+func (*TabBar) setGroup(button *TabButton, item TabStripItem) {
+	button.SetGroup(item.GroupColor, item.GroupLabel)
+	button.Widget().SetVisible(!item.Hidden)
+}
+
+// SetFavicon sets the favicon of a tab button; nil hides it.
+func (tb *TabBar) SetFavicon(tabID entity.TabID, texture *gdk.Texture) {
+	tb.mu.RLock()
+	defer tb.mu.RUnlock()
+
+	if button, exists := tb.buttons[tabID]; exists {
+		button.SetFavicon(texture)
+	}
+}
+
 // SetOnSwitch sets the callback for tab switch events.
 func (tb *TabBar) SetOnSwitch(fn func(tabID entity.TabID)) {
 	tb.onSwitch = fn
@@ -142,6 +246,46 @@ func (tb *TabBar) SetOnClose(fn func(tabID entity.TabID)) {
 	tb.onClose = fn
 }
 
+// SetOnReorder sets the callback for a tab dragged to a new position.
+func (tb *TabBar) SetOnReorder(fn func(tabID entity.TabID, position int)) {
+	tb.onReorder = fn
+}
+
+// SetOnCloseToTheRight sets the callback of the "Close tabs to the right"
+// context menu entry.
+func (tb *TabBar) SetOnCloseToTheRight(fn func(tabID entity.TabID)) {
+	tb.onCloseToTheRight = fn
+}
+
+// SetOnCloseOthers sets the callback of the "Close other tabs" context menu
+// entry.
+func (tb *TabBar) SetOnCloseOthers(fn func(tabID entity.TabID)) {
+	tb.onCloseOthers = fn
+}
+
+// SetGroupMenu sets the provider of the tab group entries of a tab's context
+// menu, listed after the close entries.
+func (tb *TabBar) SetGroupMenu(fn func(tabID entity.TabID) []TabMenuEntry) {
+	tb.groupMenu = fn
+}
+
+// showContextMenu opens the context menu of the tab button anchor.
+func (tb *TabBar) showContextMenu(anchor *gtk.Widget, tabID entity.TabID) {
+	var items []tabMenuItem
+	if fn := tb.onCloseToTheRight; fn != nil {
+		items = append(items, tabMenuItem{label: "Close Tabs to the Right", activate: func() { fn(tabID) }})
+	}
+	if fn := tb.onCloseOthers; fn != nil {
+		items = append(items, tabMenuItem{label: "Close Other Tabs", activate: func() { fn(tabID) }})
+	}
+	if fn := tb.groupMenu; fn != nil {
+		for _, entry := range fn(tabID) {
+			items = append(items, tabMenuItem{label: entry.Label, activate: entry.Activate})
+		}
+	}
+	showTabContextMenu(anchor, items)
+}
+
 // SetOnCreate sets the callback for new tab creation.
 func (tb *TabBar) SetOnCreate(fn func()) {
 	tb.onCreate = fn
@@ -208,6 +352,7 @@ func (tb *TabBar) Destroy() {
 		button.Destroy()
 	}
 	tb.buttons = nil
+	tb.order = nil
 
 	if tb.box != nil {
 		tb.box.Unref()
@@ -2,7 +2,10 @@
 package component
 
 import (
+	"math"
+
 	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/gdk"
 	"github.com/bnema/puregotk/v4/gtk"
 	"github.com/bnema/puregotk/v4/pango"
 )
@@ -10,14 +13,35 @@ import (
 // TabButton represents a single tab button in the tab bar.
 type TabButton struct {
 	button   *gtk.Button
+	content  *gtk.Box
+	icon     *gtk.Image
 	label    *gtk.Label
 	tabID    entity.TabID
 	isActive bool
 
+	// groupLabel names a collapsed group on the tab it still shows.
+	groupLabel *gtk.Label
+	groupColor entity.TabGroupColor
+
 	// Callback for click events
 	onClick func(tabID entity.TabID)
+
+	// Secondary (right) click opens the tab context menu.
+	onSecondaryClick func(tabID entity.TabID)
+	secondaryClickCb func(gtk.GestureClick, int, float64, float64)
+
+	// Dragging the button sideways reorders the tab.
+	onDragEnd    func(tabID entity.TabID, startX, offsetX float64)
+	dragUpdateCb func(gtk.GestureDrag, float64, float64)
+	dragEndCb    func(gtk.GestureDrag, float64, float64)
 }
 
+// mouseButtonSecondary is GDK_BUTTON_SECONDARY.
+const mouseButtonSecondary = 3
+
+// tabFaviconSize is the favicon size in pixels.
+const tabFaviconSize = 16
+
 // NewTabButton creates a new tab button for the given tab.
 func NewTabButton(tab *entity.Tab) *TabButton {
 	tb := &TabButton{
@@ -51,8 +75,24 @@ func NewTabButton(tab *entity.Tab) *TabButton {
 	tb.label.SetMaxWidthChars(maxTabTitleChars)
 	tb.label.AddCssClass("tab-title")
 
-	// Set label as button child
-	tb.button.SetChild(&tb.label.Widget)
+	// The favicon stays hidden until SetFavicon provides one.
+	tb.icon = gtk.NewImage()
+	tb.content = gtk.NewBox(gtk.OrientationHorizontalValue, 6)
+	if tb.icon == nil || tb.content == nil {
+		tb.button.SetChild(&tb.label.Widget)
+		return tb
+	}
+	tb.icon.SetPixelSize(tabFaviconSize)
+	tb.icon.SetVisible(false)
+	tb.icon.AddCssClass("tab-favicon")
+	if tb.groupLabel = gtk.NewLabel(nil); tb.groupLabel != nil {
+		tb.groupLabel.SetVisible(false)
+		tb.groupLabel.AddCssClass("tab-group-label")
+		tb.content.Append(&tb.groupLabel.Widget)
+	}
+	tb.content.Append(&tb.icon.Widget)
+	tb.content.Append(&tb.label.Widget)
+	tb.button.SetChild(&tb.content.Widget)
 
 	return tb
 }
@@ -79,6 +119,55 @@ func (tb *TabButton) SetTitle(title string) {
 	}
 }
 
+// SetPageTitle shows the title of the tab's page as the button tooltip.
+func (tb *TabButton) SetPageTitle(title string) {
+	if tb.button != nil {
+		tb.button.SetTooltipText(&title)
+	}
+}
+
+// SetFavicon shows texture before the title, or hides the favicon when
+// texture is nil.
+func (tb *TabButton) SetFavicon(texture *gdk.Texture) {
+	if tb.icon == nil {
+		return
+	}
+	if texture == nil {
+		tb.icon.Clear()
+		tb.icon.SetVisible(false)
+		return
+	}
+	tb.icon.SetFromPaintable(texture)
+	tb.icon.SetVisible(true)
+}
+
+// SetGroup marks the button with the color of its tab group and shows label
+// before the favicon. An empty color clears the mark, an empty label hides it.
+func (tb *TabButton) SetGroup(color entity.TabGroupColor, label string) {
+	if tb.button == nil {
+		return
+	}
+	if color != tb.groupColor {
+		if tb.groupColor != "" {
+			tb.button.RemoveCssClass(tabGroupCSSClass(tb.groupColor))
+		}
+		if color != "" {
+			tb.button.AddCssClass(tabGroupCSSClass(color))
+		}
+		tb.groupColor = color
+	}
+	if tb.groupLabel != nil {
+		tb.groupLabel.SetText(label)
+		tb.groupLabel.SetVisible(label != "")
+	}
+}
+
+// tabGroupCSSClass returns the CSS class marking tabs of a group colored
+// color.
+func tabGroupCSSClass(color entity.TabGroupColor) string {
+	return "tab-group-" + string(color)
+}
+
 // SetActive updates the active state styling.
 func (tb *TabButton) SetActive(active bool) {
 	if tb.isActive == active {
@@ -116,12 +205,74 @@ func (tb *TabButton) SetOnClick(fn func(tabID entity.TabID)) {
 	}
 }
 
+// SetOnSecondaryClick sets the callback for right clicks on the tab.
+func (tb *TabButton) SetOnSecondaryClick(fn func(tabID entity.TabID)) {
+	firstSet := tb.onSecondaryClick == nil
+	tb.onSecondaryClick = fn
+	if fn == nil || !firstSet || tb.button == nil {
+		return
+	}
+
+	gesture := gtk.NewGestureClick()
+	if gesture == nil {
+		return
+	}
+	gesture.SetButton(mouseButtonSecondary)
+	tabID := tb.tabID // Capture for closure
+	tb.secondaryClickCb = func(_ gtk.GestureClick, _ int, _ float64, _ float64) {
+		if tb.onSecondaryClick != nil {
+			tb.onSecondaryClick(tabID)
+		}
+	}
+	gesture.ConnectPressed(&tb.secondaryClickCb)
+	tb.button.AddController(&gesture.EventController)
+}
+
+// SetOnDragEnd sets the callback for a sideways drag of the button past
+// tabDragThreshold. startX is where the drag began within the button and
+// offsetX how far it moved.
+func (tb *TabButton) SetOnDragEnd(fn func(tabID entity.TabID, startX, offsetX float64)) {
+	firstSet := tb.onDragEnd == nil
+	tb.onDragEnd = fn
+	if fn == nil || !firstSet || tb.button == nil {
+		return
+	}
+
+	gesture := gtk.NewGestureDrag()
+	if gesture == nil {
+		return
+	}
+	// Once the pointer moves past the threshold the gesture claims the
+	// sequence, so releasing the button does not also switch to the tab.
+	tb.dragUpdateCb = func(g gtk.GestureDrag, offsetX, _ float64) {
+		if math.Abs(offsetX) >= tabDragThreshold {
+			g.SetState(gtk.EventSequenceClaimedValue)
+		}
+	}
+	tabID := tb.tabID // Capture for closure
+	tb.dragEndCb = func(g gtk.GestureDrag, offsetX, _ float64) {
+		if math.Abs(offsetX) < tabDragThreshold || tb.onDragEnd == nil {
+			return
+		}
+		var startX, startY float64
+		if !g.GetStartPoint(&startX, &startY) {
+			return
+		}
+		tb.onDragEnd(tabID, startX, offsetX)
+	}
+	gesture.ConnectDragUpdate(&tb.dragUpdateCb)
+	gesture.ConnectDragEnd(&tb.dragEndCb)
+	tb.button.AddController(&gesture.EventController)
+}
+
 // Destroy cleans up the button resources.
 func (tb *TabButton) Destroy() {
 	if tb.label != nil {
 		tb.label.Unref()
 		tb.label = nil
 	}
+	tb.icon = nil
+	tb.content = nil
 	if tb.button != nil {
 		tb.button.Unref()
 		tb.button = nil
@@ -0,0 +1,77 @@
+package component
+
+import (
+	"github.com/bnema/puregotk/v4/gtk"
+)
+
+// Tab context menu styling reuses the page context menu classes.
+const (
+	tabMenuPopoverClass = "context-menu-popover"
+	tabMenuBoxClass     = "context-menu"
+	tabMenuItemClass    = "context-menu-item"
+)
+
+// TabMenuEntry is an entry added to the tab context menu by its owner.
+type TabMenuEntry struct {
+	Label    string
+	Activate func()
+}
+
+// tabMenuItem is one entry of the tab context menu.
+type tabMenuItem struct {
+	label    string
+	activate func()
+}
+
+// showTabContextMenu pops up items below anchor. The popover is detached
+// from anchor again once it closes, so anchor can be destroyed later.
+func showTabContextMenu(anchor *gtk.Widget, items []tabMenuItem) {
+	if anchor == nil || len(items) == 0 {
+		return
+	}
+
+	box := gtk.NewBox(gtk.OrientationVerticalValue, 0)
+	if box == nil {
+		return
+	}
+	box.AddCssClass(tabMenuBoxClass)
+
+	popover := gtk.NewPopover()
+	if popover == nil {
+		return
+	}
+
+	for _, item := range items {
+		btn := gtk.NewButtonWithLabel(item.label)
+		if btn == nil {
+			continue
+		}
+		btn.AddCssClass("flat")
+		btn.AddCssClass(tabMenuItemClass)
+
+		activate := item.activate
+		clickCb := func(_ gtk.Button) {
+			// Close first: the action may rebuild the tab bar around anchor.
+			popover.Popdown()
+			if activate != nil {
+				activate()
+			}
+		}
+		btn.ConnectClicked(&clickCb)
+		box.Append(&btn.Widget)
+	}
+
+	popover.AddCssClass(tabMenuPopoverClass)
+	popover.SetChild(&box.Widget)
+	popover.SetParent(anchor)
+	popover.SetHasArrow(false)
+	popover.SetAutohide(true)
+	popover.SetPosition(gtk.PosBottomValue)
+
+	closedCb := func(_ gtk.Popover) {
+		popover.Unparent()
+	}
+	popover.ConnectClosed(&closedCb)
+
+	popover.Popup()
+}
@@ -0,0 +1,129 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// tabDragThreshold is how far, in pixels, a tab must be dragged before the
+// release counts as a reorder rather than a click.
+const tabDragThreshold = 8.0
+
+// TabStripItem is what the tab strip shows for one tab.
+type TabStripItem struct {
+	ID entity.TabID
+	// Title is the tab's name, or its positional "Tab N" title.
+	Title string
+	// PageTitle is the title of the page shown in the tab's focused pane,
+	// falling back to its URL.
+	PageTitle string
+	// PageURL is the page whose favicon the tab shows. Empty for blank tabs.
+	PageURL string
+	Active  bool
+
+	// GroupColor marks tabs that belong to a group. Empty for ungrouped tabs.
+	GroupColor entity.TabGroupColor
+	// GroupLabel names a collapsed group and its size on the one tab the
+	// group still shows. Empty on every other tab.
+	GroupLabel string
+	// Hidden is set on the tabs a collapsed group folds away.
+	Hidden bool
+}
+
+// TabStripItems builds the strip items of tabs, in tab order, including how
+// their groups mark them.
+func TabStripItems(tabs *entity.TabList) []TabStripItem {
+	if tabs == nil {
+		return nil
+	}
+	items := make([]TabStripItem, 0, len(tabs.Tabs))
+	for _, tab := range tabs.Tabs {
+		if tab == nil {
+			continue
+		}
+		item := TabStripItemFor(tab, tab.ID == tabs.ActiveTabID)
+		if group := tabs.GroupOf(tab.ID); group != nil {
+			item.GroupColor = group.Color
+			item.Hidden = tabs.IsTabHidden(tab.ID)
+			if group.Collapsed && !item.Hidden {
+				item.GroupLabel = fmt.Sprintf("%s (%d)", group.Name, len(group.TabIDs))
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// TabStripItemFor builds the strip item of one tab. The page shown is the
+// one in the pane the tab would focus.
+func TabStripItemFor(tab *entity.Tab, active bool) TabStripItem {
+	item := TabStripItem{ID: tab.ID, Title: tab.Title(), Active: active}
+
+	if tab.Workspace == nil {
+		return item
+	}
+	node := tab.Workspace.FindPane(tab.PaneToFocus())
+	if node == nil || node.Pane == nil {
+		return item
+	}
+	item.PageURL = node.Pane.URI
+	item.PageTitle = node.Pane.Title
+	if item.PageTitle == "" {
+		item.PageTitle = node.Pane.URI
+	}
+	return item
+}
+
+// TabBarShown reports whether the tab strip should be shown for the given
+// mode, tab count and tab mode state.
+func TabBarShown(mode entity.TabBarMode, hideWhenSingleTab bool, tabCount int, tabModeActive bool) bool {
+	switch mode {
+	case entity.TabBarModeHidden:
+		return false
+	case entity.TabBarModeOverview:
+		return tabModeActive
+	default:
+		return !hideWhenSingleTab || tabCount > 1
+	}
+}
+
+// tabDropIndex returns the index of the tab under x, where x is measured from
+// the left edge of the strip and widths holds the width of each tab in order.
+// Points before the first tab or past the last clamp to the ends.
+func tabDropIndex(widths []int, x float64) int {
+	if len(widths) == 0 {
+		return -1
+	}
+	left := 0.0
+	for i, width := range widths {
+		left += float64(width)
+		if x < left {
+			return i
+		}
+	}
+	return len(widths) - 1
+}
+
+// moveTabID returns order with id moved to index, or nil when id is not in
+// order or index is out of range.
+func moveTabID(order []entity.TabID, id entity.TabID, index int) []entity.TabID {
+	if index < 0 || index >= len(order) {
+		return nil
+	}
+	from := -1
+	for i, existing := range order {
+		if existing == id {
+			from = i
+			break
+		}
+	}
+	if from < 0 {
+		return nil
+	}
+	moved := make([]entity.TabID, 0, len(order))
+	moved = append(moved, order[:from]...)
+	moved = append(moved, order[from+1:]...)
+	moved = append(moved[:index], append([]entity.TabID{id}, moved[index:]...)...)
+	return moved
+}
@@ -0,0 +1,134 @@
+package component
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTabStripItems(t *testing.T) {
+	assert.Nil(t, TabStripItems(nil))
+
+	tabs := entity.NewTabList()
+	docs := entity.NewPane("pane-docs")
+	docs.URI = "https://go.dev/doc/"
+	docs.Title = "Documentation - The Go Programming Language"
+	tabs.Add(entity.NewTab("tab-docs", "ws-docs", docs))
+
+	untitled := entity.NewPane("pane-untitled")
+	untitled.URI = "https://example.com/"
+	named := entity.NewTab("tab-named", "ws-named", untitled)
+	named.Name = "Work"
+	tabs.Add(named)
+
+	tabs.Add(entity.NewTab("tab-blank", "ws-blank", entity.NewPane("pane-blank")))
+	tabs.SetActive("tab-named")
+
+	assert.Equal(t, []TabStripItem{
+		{
+			ID:        "tab-docs",
+			Title:     "Tab 1",
+			PageTitle: "Documentation - The Go Programming Language",
+			PageURL:   "https://go.dev/doc/",
+		},
+		{
+			ID:        "tab-named",
+			Title:     "Work",
+			PageTitle: "https://example.com/",
+			PageURL:   "https://example.com/",
+			Active:    true,
+		},
+		{ID: "tab-blank", Title: "Tab 3"},
+	}, TabStripItems(tabs))
+}
+
+func TestTabStripItemFor_UsesPaneToFocus(t *testing.T) {
+	first := entity.NewPane("pane-1")
+	first.URI = "https://one.example/"
+	tab := entity.NewTab("tab", "ws", first)
+
+	second := entity.NewPane("pane-2")
+	second.URI = "https://two.example/"
+	second.Title = "Two"
+	root := tab.Workspace.Root
+	split := &entity.PaneNode{ID: "split", SplitDir: entity.SplitHorizontal, SplitRatio: 0.5}
+	root.Parent = split
+	secondNode := &entity.PaneNode{ID: string(second.ID), Pane: second, Parent: split}
+	split.Children = []*entity.PaneNode{root, secondNode}
+	tab.Workspace.Root = split
+	tab.LastActivePaneID = "pane-2"
+
+	item := TabStripItemFor(tab, false)
+	assert.Equal(t, "Two", item.PageTitle)
+	assert.Equal(t, "https://two.example/", item.PageURL)
+}
+
+func TestTabBarShown(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          entity.TabBarMode
+		hideWhenOne   bool
+		tabCount      int
+		tabModeActive bool
+		want          bool
+	}{
+		{name: "bar always", mode: entity.TabBarModeBar, tabCount: 1, want: true},
+		{name: "bar hides single tab", mode: entity.TabBarModeBar, hideWhenOne: true, tabCount: 1, want: false},
+		{name: "bar shows several tabs", mode: entity.TabBarModeBar, hideWhenOne: true, tabCount: 2, want: true},
+		{name: "unset mode acts as bar", tabCount: 2, hideWhenOne: true, want: true},
+		{name: "hidden", mode: entity.TabBarModeHidden, tabCount: 3, tabModeActive: true, want: false},
+		{name: "overview outside tab mode", mode: entity.TabBarModeOverview, tabCount: 3, want: false},
+		{name: "overview in tab mode", mode: entity.TabBarModeOverview, hideWhenOne: true, tabCount: 1, tabModeActive: true, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TabBarShown(tt.mode, tt.hideWhenOne, tt.tabCount, tt.tabModeActive))
+		})
+	}
+}
+
+func TestTabDropIndex(t *testing.T) {
+	widths := []int{100, 80, 120}
+	assert.Equal(t, -1, tabDropIndex(nil, 10))
+	assert.Equal(t, 0, tabDropIndex(widths, -40))
+	assert.Equal(t, 0, tabDropIndex(widths, 99.5))
+	assert.Equal(t, 1, tabDropIndex(widths, 100))
+	assert.Equal(t, 2, tabDropIndex(widths, 250))
+	assert.Equal(t, 2, tabDropIndex(widths, 1000))
+}
+
+func TestMoveTabID(t *testing.T) {
+	order := []entity.TabID{"a", "b", "c", "d"}
+	assert.Equal(t, []entity.TabID{"b", "c", "a", "d"}, moveTabID(order, "a", 2))
+	assert.Equal(t, []entity.TabID{"d", "a", "b", "c"}, moveTabID(order, "d", 0))
+	assert.Equal(t, order, moveTabID(order, "b", 1))
+	assert.Equal(t, []entity.TabID{"a", "b", "c", "d"}, order, "input must not be modified")
+	assert.Nil(t, moveTabID(order, "a", 4))
+	assert.Nil(t, moveTabID(order, "a", -1))
+	assert.Nil(t, moveTabID(order, "z", 0))
+}
+
+func TestTabStripItems_MarksTabGroups(t *testing.T) {
+	tabs := entity.NewTabList()
+	for _, id := range []entity.TabID{"a", "b", "c", "d"} {
+		tabs.Add(entity.NewTab(id, entity.WorkspaceID("ws-"+id), entity.NewPane(entity.PaneID("pane-"+id))))
+	}
+	tabs.AddGroup(&entity.TabGroup{ID: "g", Name: "Work", Color: entity.TabGroupColorGreen, TabIDs: []entity.TabID{"b", "c"}})
+	tabs.SetActive("a")
+
+	items := TabStripItems(tabs)
+	assert.Empty(t, items[0].GroupColor)
+	assert.Equal(t, entity.TabGroupColorGreen, items[1].GroupColor)
+	assert.Equal(t, entity.TabGroupColorGreen, items[2].GroupColor)
+	assert.Empty(t, items[1].GroupLabel, "expanded groups are not labelled")
+	assert.False(t, items[2].Hidden)
+
+	tabs.SetGroupCollapsed("g", true)
+	items = TabStripItems(tabs)
+	assert.Equal(t, "Work (2)", items[1].GroupLabel)
+	assert.False(t, items[1].Hidden)
+	assert.Empty(t, items[2].GroupLabel)
+	assert.True(t, items[2].Hidden)
+	assert.False(t, items[3].Hidden)
+}
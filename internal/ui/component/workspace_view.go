@@ -10,7 +10,9 @@ import (
 
 	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/input"
 	"github.com/bnema/dumber/internal/ui/layout"
+	"github.com/bnema/dumber/internal/ui/theme"
 	"github.com/bnema/puregotk/v4/glib"
 	"github.com/rs/zerolog"
 )
@@ -49,10 +51,15 @@ type WorkspaceView struct {
 	workspace *entity.Workspace
 	paneViews map[entity.PaneID]*PaneView
 
+	// previousActivePaneID is the pane that was active before the current one.
+	previousActivePaneID entity.PaneID
+
 	onPaneFocused       func(paneID entity.PaneID)
 	onActivePaneChanged func(paneID entity.PaneID)
 	onWebViewAttached   func(paneID entity.PaneID)
 	onSplitRatioDragged func(nodeID string, ratio float64)
+	onPaneCloseRequest  func(paneID entity.PaneID)
+	onToast             func(paneID entity.PaneID, message string, level ToastLevel)
 
 	// Hover suppression for keyboard navigation (Issue #89)
 	// Prevents hover focus from overriding keyboard-initiated focus changes
@@ -70,6 +77,20 @@ type WorkspaceView struct {
 	// Auto-open omnibox on new pane creation
 	autoOpenOnNewPane bool
 
+	// Focus-follows-mouse settings applied to PaneViews as they are created.
+	hoverFocusEnabled bool
+	hoverFocusDelay   time.Duration
+
+	// middleClickCloses makes a middle click on the pane edge close the pane.
+	middleClickCloses bool
+
+	// Link hover status overlay settings used by all panes.
+	linkStatusCfg LinkStatusConfig
+
+	// Zen mode: the maximized pane and the sibling widgets hidden for it.
+	zenPaneID entity.PaneID
+	zenHidden []layout.Widget
+
 	mu sync.RWMutex
 }
 
@@ -139,8 +160,14 @@ func (a *paneViewFactoryAdapter) CreatePaneView(node *entity.PaneNode) layout.Wi
 		}
 	})
 
-	// Attach hover handler with debouncing
-	pv.AttachHoverHandler(a.ctx)
+	// Attach hover handler with debouncing (skipped when hover focus is off).
+	// Caller (SetWorkspace) already holds the lock, so read fields directly.
+	if a.wv.hoverFocusEnabled {
+		pv.AttachHoverHandler(a.ctx, a.wv.hoverFocusDelay)
+	}
+	if a.wv.middleClickCloses {
+		a.wv.attachMiddleClickClose(a.ctx, pv)
+	}
 
 	return pv.Widget()
 }
@@ -153,6 +180,7 @@ func NewWorkspaceView(ctx context.Context, factory layout.WidgetFactory) *Worksp
 	container.SetHexpand(true)
 	container.SetVexpand(true)
 	container.SetVisible(true)
+	container.AddCssClass(theme.WorkspaceContainerClass)
 
 	// Wrap container in overlay for mode borders
 	overlay := factory.NewOverlay()
@@ -167,6 +195,10 @@ func NewWorkspaceView(ctx context.Context, factory layout.WidgetFactory) *Worksp
 		overlay:   overlay,
 		logger:    log.With().Str("component", "workspace-view").Logger(),
 		paneViews: make(map[entity.PaneID]*PaneView),
+
+		hoverFocusEnabled: true,
+		hoverFocusDelay:   input.HoverFocusDelay,
+		linkStatusCfg:     DefaultLinkStatusConfig(),
 	}
 
 	// Create tree renderer with our adapter as the pane view factory
@@ -203,6 +235,8 @@ func (wv *WorkspaceView) SetWorkspace(ctx context.Context, ws *entity.Workspace)
 	// Clear previous state
 	wv.workspace = ws
 	wv.paneViews = make(map[entity.PaneID]*PaneView)
+	wv.zenPaneID = ""
+	wv.zenHidden = nil
 
 	// Remove old root widget from container before building new tree
 	if wv.rootWidget != nil {
@@ -332,6 +366,9 @@ func (wv *WorkspaceView) setActivePaneIDInternal(paneID entity.PaneID) error {
 	}
 
 	newPV.SetActive(true)
+	if currentActiveID != "" && currentActiveID != paneID {
+		wv.previousActivePaneID = currentActiveID
+	}
 
 	// Update domain model - single source of truth
 	if wv.workspace != nil {
@@ -350,6 +387,16 @@ func (wv *WorkspaceView) GetActivePaneID() entity.PaneID {
 	return wv.getActivePaneIDInternal()
 }
 
+// PreviousActivePaneID returns the pane that was active before the current
+// one, or "" when the active pane has not changed yet. The pane may have been
+// closed since; callers should check it still exists.
+func (wv *WorkspaceView) PreviousActivePaneID() entity.PaneID {
+	wv.mu.RLock()
+	defer wv.mu.RUnlock()
+
+	return wv.previousActivePaneID
+}
+
 // getActivePaneIDInternal returns the active pane ID without locking.
 // Must be called with at least a read lock held.
 func (wv *WorkspaceView) getActivePaneIDInternal() entity.PaneID {
@@ -419,6 +466,24 @@ func (wv *WorkspaceView) SetOnPaneFocused(fn func(paneID entity.PaneID)) {
 	wv.onPaneFocused = fn
 }
 
+// SetOnPaneCloseRequest sets the callback for when a pane asks to be closed
+// from its chrome (middle click on the pane edge).
+func (wv *WorkspaceView) SetOnPaneCloseRequest(fn func(paneID entity.PaneID)) {
+	wv.mu.Lock()
+	defer wv.mu.Unlock()
+
+	wv.onPaneCloseRequest = fn
+}
+
+// SetOnToast sets the callback invoked for each toast the omnibox shows over
+// a pane, feeding the notification center.
+func (wv *WorkspaceView) SetOnToast(fn func(paneID entity.PaneID, message string, level ToastLevel)) {
+	wv.mu.Lock()
+	defer wv.mu.Unlock()
+
+	wv.onToast = fn
+}
+
 // SetOnActivePaneChanged sets the callback invoked after the active pane changes.
 func (wv *WorkspaceView) SetOnActivePaneChanged(fn func(paneID entity.PaneID)) {
 	wv.mu.Lock()
@@ -456,6 +521,91 @@ func (wv *WorkspaceView) Rebuild(ctx context.Context) error {
 	return wv.SetWorkspace(ctx, ws)
 }
 
+// EnterZen maximizes paneID by hiding the sibling subtree at every split on
+// its path to the root. Widgets are only hidden, so hidden panes keep their
+// state; ExitZen shows them again.
+func (wv *WorkspaceView) EnterZen(paneID entity.PaneID) error {
+	wv.mu.Lock()
+	defer wv.mu.Unlock()
+
+	if wv.workspace == nil {
+		return ErrNilWorkspace
+	}
+	node := wv.workspace.FindPane(paneID)
+	if node == nil {
+		return ErrPaneNotFound
+	}
+
+	wv.exitZenInternal()
+	for child := node; child.Parent != nil; child = child.Parent {
+		parent := child.Parent
+		if !parent.IsSplit() {
+			continue
+		}
+		sibling := parent.Left()
+		if sibling == child {
+			sibling = parent.Right()
+		}
+		widget := wv.subtreeWidgetInternal(sibling)
+		if widget == nil {
+			continue
+		}
+		widget.SetVisible(false)
+		wv.zenHidden = append(wv.zenHidden, widget)
+	}
+	wv.zenPaneID = paneID
+	return nil
+}
+
+// ExitZen shows the panes hidden by EnterZen.
+func (wv *WorkspaceView) ExitZen() {
+	wv.mu.Lock()
+	defer wv.mu.Unlock()
+
+	wv.exitZenInternal()
+}
+
+// ZenPaneID returns the maximized pane, or "" when zen mode is off.
+func (wv *WorkspaceView) ZenPaneID() entity.PaneID {
+	wv.mu.RLock()
+	defer wv.mu.RUnlock()
+
+	return wv.zenPaneID
+}
+
+func (wv *WorkspaceView) exitZenInternal() {
+	for _, widget := range wv.zenHidden {
+		widget.SetVisible(true)
+	}
+	wv.zenHidden = nil
+	wv.zenPaneID = ""
+}
+
+// subtreeWidgetInternal returns the widget rendering node inside its parent
+// split. Incrementally inserted leaves are only tracked by their stack.
+func (wv *WorkspaceView) subtreeWidgetInternal(node *entity.PaneNode) layout.Widget {
+	if node == nil || wv.treeRenderer == nil {
+		return nil
+	}
+	if widget := wv.treeRenderer.Lookup(node.ID); widget != nil {
+		return widget
+	}
+	var widget layout.Widget
+	node.Walk(func(n *entity.PaneNode) bool {
+		if widget != nil {
+			return false
+		}
+		if n.Pane == nil {
+			return true
+		}
+		if sv := wv.treeRenderer.GetStackedViewForPane(string(n.Pane.ID)); sv != nil {
+			widget = sv.Widget()
+		}
+		return false
+	})
+	return widget
+}
+
 // FocusPane attempts to give focus to a specific pane.
 // Returns true if focus was successfully grabbed.
 func (wv *WorkspaceView) FocusPane(paneID entity.PaneID) bool {
@@ -729,6 +879,82 @@ func (wv *WorkspaceView) SetFindBarConfig(cfg FindBarConfig) {
 	wv.findBarCfg = cfg
 }
 
+// SetHoverFocus configures focus-follows-mouse for panes created after this
+// call. When disabled, no hover handler is attached to new panes.
+func (wv *WorkspaceView) SetHoverFocus(enabled bool, delay time.Duration) {
+	wv.mu.Lock()
+	defer wv.mu.Unlock()
+	wv.hoverFocusEnabled = enabled
+	wv.hoverFocusDelay = delay
+}
+
+// HoverFocus returns the focus-follows-mouse settings for new panes.
+func (wv *WorkspaceView) HoverFocus() (enabled bool, delay time.Duration) {
+	wv.mu.RLock()
+	defer wv.mu.RUnlock()
+	return wv.hoverFocusEnabled, wv.hoverFocusDelay
+}
+
+// SetMiddleClickClosesPane configures middle-click closing for panes created
+// after this call.
+func (wv *WorkspaceView) SetMiddleClickClosesPane(enabled bool) {
+	wv.mu.Lock()
+	defer wv.mu.Unlock()
+	wv.middleClickCloses = enabled
+}
+
+// SetupPaneMiddleClickClose enables middle-click closing on a PaneView created
+// outside SetWorkspace, when the workspace view has it enabled.
+func (wv *WorkspaceView) SetupPaneMiddleClickClose(ctx context.Context, pv *PaneView) {
+	wv.mu.RLock()
+	enabled := wv.middleClickCloses
+	wv.mu.RUnlock()
+	if enabled {
+		wv.attachMiddleClickClose(ctx, pv)
+	}
+}
+
+func (wv *WorkspaceView) attachMiddleClickClose(ctx context.Context, pv *PaneView) {
+	if pv == nil {
+		return
+	}
+	pv.SetOnChromeClose(wv.requestPaneClose)
+	pv.AttachMiddleClickClose(ctx)
+}
+
+func (wv *WorkspaceView) requestPaneClose(paneID entity.PaneID) {
+	wv.mu.RLock()
+	callback := wv.onPaneCloseRequest
+	wv.mu.RUnlock()
+
+	if callback != nil {
+		callback(paneID)
+	}
+}
+
+// SetLinkStatusConfig configures the link hover status overlay for all panes.
+func (wv *WorkspaceView) SetLinkStatusConfig(cfg LinkStatusConfig) {
+	wv.mu.Lock()
+	defer wv.mu.Unlock()
+	wv.linkStatusCfg = cfg
+}
+
+// LinkStatusConfig returns the link hover status overlay settings.
+func (wv *WorkspaceView) LinkStatusConfig() LinkStatusConfig {
+	wv.mu.RLock()
+	defer wv.mu.RUnlock()
+	return wv.linkStatusCfg
+}
+
+// HideReadingProgress hides the reading progress bar of every pane.
+func (wv *WorkspaceView) HideReadingProgress() {
+	for _, paneID := range wv.GetPaneIDs() {
+		if pv := wv.GetPaneView(paneID); pv != nil {
+			pv.SetReadingProgress(0)
+		}
+	}
+}
+
 // SetAutoOpenOnNewPane configures whether to show omnibox when a new pane is created.
 func (wv *WorkspaceView) SetAutoOpenOnNewPane(enabled bool) {
 	wv.mu.Lock()
@@ -792,8 +1018,12 @@ func (wv *WorkspaceView) ShowOmnibox(ctx context.Context, query string) {
 
 	// Create omnibox with pane-specific toast callback
 	cfg := wv.omniboxCfg
+	onToast := wv.onToast
 	cfg.OnToast = func(toastCtx context.Context, message string, level ToastLevel) {
 		pv.ShowToast(toastCtx, message, level)
+		if onToast != nil {
+			onToast(pv.PaneID(), message, level)
+		}
 	}
 	omnibox := NewOmnibox(ctx, cfg)
 	if omnibox == nil {
@@ -911,6 +1141,21 @@ func (wv *WorkspaceView) ShowFindBar(ctx context.Context) {
 	wv.logger.Debug().Str("paneID", string(activePaneID)).Msg("find bar shown")
 }
 
+// TypeIntoFindBar shows the find bar and appends text to its query. Used by
+// type-to-find, where the first key opens the bar and keys that reach the
+// page before the entry takes focus extend the query.
+func (wv *WorkspaceView) TypeIntoFindBar(ctx context.Context, text string) {
+	wv.ShowFindBar(ctx)
+
+	wv.mu.RLock()
+	findBar := wv.findBar
+	wv.mu.RUnlock()
+
+	if findBar != nil {
+		findBar.AppendQuery(text)
+	}
+}
+
 // HideFindBar hides and destroys the current find bar.
 func (wv *WorkspaceView) HideFindBar() {
 	wv.mu.Lock()
@@ -90,6 +90,7 @@ func setupWorkspaceViewBase(t *testing.T, mockFactory *mocks.MockWidgetFactory)
 	mockContainer.EXPECT().SetHexpand(true).Once()
 	mockContainer.EXPECT().SetVexpand(true).Once()
 	mockContainer.EXPECT().SetVisible(true).Once()
+	mockContainer.EXPECT().AddCssClass("workspace-container").Once()
 
 	mockFactory.EXPECT().NewOverlay().Return(mockOverlay).Once()
 	mockOverlay.EXPECT().SetHexpand(true).Once()
@@ -369,6 +370,7 @@ func TestAddWorkspaceOverlayWidget_DoesNotReaddWhenAlreadyAttached(t *testing.T)
 	mockContainer.EXPECT().SetHexpand(true).Once()
 	mockContainer.EXPECT().SetVexpand(true).Once()
 	mockContainer.EXPECT().SetVisible(true).Once()
+	mockContainer.EXPECT().AddCssClass("workspace-container").Once()
 	mockFactory.EXPECT().NewOverlay().Return(mockOverlay).Once()
 	mockOverlay.EXPECT().SetHexpand(true).Once()
 	mockOverlay.EXPECT().SetVexpand(true).Once()
@@ -393,6 +395,7 @@ func TestAddWorkspaceOverlayWidget_UnparentsBeforeAdding(t *testing.T) {
 	mockContainer.EXPECT().SetHexpand(true).Once()
 	mockContainer.EXPECT().SetVexpand(true).Once()
 	mockContainer.EXPECT().SetVisible(true).Once()
+	mockContainer.EXPECT().AddCssClass("workspace-container").Once()
 	mockFactory.EXPECT().NewOverlay().Return(mockOverlay).Once()
 	mockOverlay.EXPECT().SetHexpand(true).Once()
 	mockOverlay.EXPECT().SetVexpand(true).Once()
@@ -424,6 +427,7 @@ func TestAddWorkspaceOverlayWidget_DoesNotReaddWhenParentGTKMatchesOverlay(t *te
 	mockContainer.EXPECT().SetHexpand(true).Once()
 	mockContainer.EXPECT().SetVexpand(true).Once()
 	mockContainer.EXPECT().SetVisible(true).Once()
+	mockContainer.EXPECT().AddCssClass("workspace-container").Once()
 	mockFactory.EXPECT().NewOverlay().Return(mockOverlay).Once()
 	mockOverlay.EXPECT().SetHexpand(true).Once()
 	mockOverlay.EXPECT().SetVexpand(true).Once()
@@ -0,0 +1,39 @@
+package content
+
+import (
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// AudioMuteStates returns the mute state of every live WebView that supports
+// muting, keyed by pane.
+func (c *Coordinator) AudioMuteStates() map[entity.PaneID]bool {
+	c.webViewsMu.RLock()
+	defer c.webViewsMu.RUnlock()
+
+	states := make(map[entity.PaneID]bool, len(c.webViews))
+	for paneID, wv := range c.webViews {
+		if wv == nil || wv.IsDestroyed() {
+			continue
+		}
+		if muter, ok := wv.(port.AudioMuteCapable); ok {
+			states[paneID] = muter.IsAudioMuted()
+		}
+	}
+	return states
+}
+
+// SetPaneAudioMuted mutes or unmutes the WebView of paneID. It reports false
+// when the pane has no live WebView that supports muting.
+func (c *Coordinator) SetPaneAudioMuted(paneID entity.PaneID, muted bool) bool {
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return false
+	}
+	muter, ok := wv.(port.AudioMuteCapable)
+	if !ok {
+		return false
+	}
+	muter.SetAudioMuted(muted)
+	return true
+}
@@ -0,0 +1,30 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/formfill"
+)
+
+// SetInputConfigProvider sets the source of the live input config used to
+// decide which pages get their first text field focused on load, and where
+// swipe gestures stay off.
+func (c *Coordinator) SetInputConfigProvider(fn func() entity.RuntimeInputConfig) {
+	c.inputConfigProvider = fn
+}
+
+// autofocusFirstField focuses the first visible text field of the loaded
+// page when the config asks for it. The script itself backs off when the
+// page already focused something.
+func (c *Coordinator) autofocusFirstField(ctx context.Context, wv port.WebView) {
+	if c.inputConfigProvider == nil || wv == nil || wv.IsDestroyed() {
+		return
+	}
+	if !usecase.ShouldAutofocusFirstField(c.inputConfigProvider(), wv.URI()) {
+		return
+	}
+	wv.RunJavaScript(ctx, formfill.AutofocusScript())
+}
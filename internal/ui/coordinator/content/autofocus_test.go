@@ -0,0 +1,32 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/formfill"
+)
+
+func TestAutofocusFirstField_RunsOnlyOnConfiguredDomains(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &Coordinator{}
+	c.SetInputConfigProvider(func() entity.RuntimeInputConfig {
+		return entity.RuntimeInputConfig{AutofocusDomains: []string{"search.example"}}
+	})
+
+	matching := mocks.NewMockWebView(t)
+	matching.EXPECT().IsDestroyed().Return(false)
+	matching.EXPECT().URI().Return("https://search.example/")
+	matching.EXPECT().RunJavaScript(ctx, formfill.AutofocusScript()).Once()
+	c.autofocusFirstField(ctx, matching)
+
+	// No RunJavaScript expectation: the mock fails the test if it is called.
+	other := mocks.NewMockWebView(t)
+	other.EXPECT().IsDestroyed().Return(false)
+	other.EXPECT().URI().Return("https://news.example/")
+	c.autofocusFirstField(ctx, other)
+}
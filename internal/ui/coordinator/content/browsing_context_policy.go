@@ -5,10 +5,50 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
 )
 
 type browsingContextPolicy struct{}
 
+// DecideForConfig applies the per-domain popup rules of cfg before Decide.
+// A request whose opener matches popup_block_domains, and no
+// popup_allow_domains pattern, is denied unless it is an OAuth flow or a
+// link the user opened in a new page.
+func (p browsingContextPolicy) DecideForConfig(
+	req dto.NewBrowsingContextRequest,
+	namedContextExists bool,
+	cfg *entity.BrowsingContextConfig,
+) dto.HostDecision {
+	decision := p.Decide(req, namedContextExists)
+	if decision.Kind == dto.HostDecisionDeny || !popupSuppressedForOpener(req, cfg) {
+		return decision
+	}
+	return dto.HostDecision{
+		Kind:                      dto.HostDecisionDeny,
+		RequestContextDisposition: decision.RequestContextDisposition,
+		Suppressed:                true,
+		Reason:                    "popup suppressed for opener domain",
+	}
+}
+
+func popupSuppressedForOpener(req dto.NewBrowsingContextRequest, cfg *entity.BrowsingContextConfig) bool {
+	if cfg == nil || len(cfg.PopupBlockDomains) == 0 {
+		return false
+	}
+	if req.AuthIntent || (req.TriggerKind == dto.TriggerLinkNewPage && req.IsUserGesture) {
+		return false
+	}
+	opener := req.OpenerURI
+	if opener == "" {
+		opener = req.SourceFrameURL
+	}
+	if opener == "" || urlutil.MatchAnyDomainPattern(cfg.PopupAllowDomains, opener) {
+		return false
+	}
+	return urlutil.MatchAnyDomainPattern(cfg.PopupBlockDomains, opener)
+}
+
 func (browsingContextPolicy) Decide(req dto.NewBrowsingContextRequest, namedContextExists bool) dto.HostDecision {
 	decision := dto.HostDecision{
 		RequestContextDisposition: req.RequestContextDisposition,
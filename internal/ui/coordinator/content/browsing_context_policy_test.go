@@ -5,6 +5,7 @@ import (
 
 	"github.com/bnema/dumber/internal/application/dto"
 	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -172,3 +173,119 @@ func TestInferPopupWindowDisposition_NormalizesBlankTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestBrowsingContextPolicyDecideForConfig_PopupDomains(t *testing.T) {
+	t.Parallel()
+
+	cfg := &entity.BrowsingContextConfig{
+		PopupBlockDomains: []string{"*.ads.example", "news.example"},
+		PopupAllowDomains: []string{"partner.ads.example"},
+	}
+	scriptPopup := func(opener string) dto.NewBrowsingContextRequest {
+		return dto.NewBrowsingContextRequest{
+			OpenerURI:         opener,
+			TargetURI:         "https://promo.example/offer",
+			TriggerKind:       dto.TriggerScriptWindowOpen,
+			TargetDisposition: dto.WindowDispositionNewPopup,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		request        dto.NewBrowsingContextRequest
+		cfg            *entity.BrowsingContextConfig
+		wantKind       dto.HostDecisionKind
+		wantSuppressed bool
+	}{
+		{
+			name:           "blocked opener suppresses script popup",
+			request:        scriptPopup("https://news.example/article"),
+			cfg:            cfg,
+			wantKind:       dto.HostDecisionDeny,
+			wantSuppressed: true,
+		},
+		{
+			name:           "wildcard block matches subdomain",
+			request:        scriptPopup("https://www.ads.example/"),
+			cfg:            cfg,
+			wantKind:       dto.HostDecisionDeny,
+			wantSuppressed: true,
+		},
+		{
+			name:     "allow list wins over block list",
+			request:  scriptPopup("https://partner.ads.example/"),
+			cfg:      cfg,
+			wantKind: dto.HostDecisionCreatePane,
+		},
+		{
+			name:     "unlisted opener is allowed",
+			request:  scriptPopup("https://docs.example/"),
+			cfg:      cfg,
+			wantKind: dto.HostDecisionCreatePane,
+		},
+		{
+			name:     "nil config keeps default decision",
+			request:  scriptPopup("https://news.example/article"),
+			wantKind: dto.HostDecisionCreatePane,
+		},
+		{
+			name: "user-opened link on blocked opener is allowed",
+			request: dto.NewBrowsingContextRequest{
+				OpenerURI:         "https://news.example/article",
+				TargetURI:         "https://docs.example/",
+				TargetFrameName:   "_blank",
+				TriggerKind:       dto.TriggerLinkNewPage,
+				TargetDisposition: dto.WindowDispositionNewTab,
+				IsUserGesture:     true,
+			},
+			cfg:      cfg,
+			wantKind: dto.HostDecisionCreatePane,
+		},
+		{
+			name: "oauth popup on blocked opener is allowed",
+			request: dto.NewBrowsingContextRequest{
+				OpenerURI:   "https://news.example/login",
+				TargetURI:   "https://accounts.google.com/o/oauth2/auth",
+				TriggerKind: dto.TriggerAuthPopupRequest,
+				AuthIntent:  true,
+			},
+			cfg:      cfg,
+			wantKind: dto.HostDecisionCreateNativeWin,
+		},
+		{
+			name: "source frame is used without opener URI",
+			request: dto.NewBrowsingContextRequest{
+				SourceFrameURL:    "https://news.example/frame",
+				TargetURI:         "https://promo.example/offer",
+				TriggerKind:       dto.TriggerScriptWindowOpen,
+				TargetDisposition: dto.WindowDispositionNewPopup,
+			},
+			cfg:            cfg,
+			wantKind:       dto.HostDecisionDeny,
+			wantSuppressed: true,
+		},
+	}
+
+	policy := browsingContextPolicy{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			decision := policy.DecideForConfig(tt.request, false, tt.cfg)
+			assert.Equal(t, tt.wantKind, decision.Kind)
+			assert.Equal(t, tt.wantSuppressed, decision.Suppressed)
+		})
+	}
+}
+
+func TestBrowsingContextPolicyDecideForConfig_EmptyTargetIsNotSuppressed(t *testing.T) {
+	t.Parallel()
+
+	cfg := &entity.BrowsingContextConfig{PopupBlockDomains: []string{"news.example"}}
+	decision := browsingContextPolicy{}.DecideForConfig(dto.NewBrowsingContextRequest{
+		OpenerURI:   "https://news.example/",
+		TriggerKind: dto.TriggerScriptWindowOpen,
+	}, false, cfg)
+
+	assert.Equal(t, dto.HostDecisionDeny, decision.Kind)
+	assert.False(t, decision.Suppressed)
+}
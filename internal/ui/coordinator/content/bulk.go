@@ -21,7 +21,10 @@ func (c *Coordinator) ApplyFiltersToAll(ctx context.Context) {
 	c.webViewsMu.RLock()
 	snapshot := make([]port.WebView, 0, len(c.webViews))
 	for _, wv := range c.webViews {
-		snapshot = append(snapshot, wv)
+		// Sites with a filter exception stay unfiltered.
+		if !c.isFilterBypassed(wv.ID()) {
+			snapshot = append(snapshot, wv)
+		}
 	}
 	c.webViewsMu.RUnlock()
 
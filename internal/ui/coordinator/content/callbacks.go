@@ -76,13 +76,31 @@ func (c *Coordinator) setupWebViewCallbacks(ctx context.Context, paneID entity.P
 		OnLoadChanged: func(event port.LoadEvent) {
 			switch event {
 			case port.LoadStarted:
+				c.captureScrollPosition(ctx, paneID, wv)
+				c.refreshCertificatePins(ctx)
+				c.syncFilterBypass(ctx, wv, wv.URI())
+				c.noteLoadRetryStarted(paneID)
 				c.onLoadStarted(paneID)
 			case port.LoadCommitted:
+				c.settleHTTPSUpgrade(wv)
 				c.onLoadCommitted(ctx, paneID, wv, identity)
 			case port.LoadFinished:
+				c.settleLoadRetry(paneID)
 				c.onLoadFinished(ctx, paneID, wv, identity)
 			}
 		},
+		OnLoadFailed: func(failure port.LoadFailure) {
+			if c.onHTTPSLoadFailed(ctx, paneID, wv, failure) {
+				return
+			}
+			c.onLoadFailed(ctx, paneID, wv, failure)
+		},
+		OnNavigationRequest: func(uri string) bool {
+			return c.onNavigationRequest(ctx, paneID, wv, uri)
+		},
+		OnCertificateCheck: func(uri, spkiSHA256 string) bool {
+			return c.enforceCertificatePin(ctx, paneID, wv, uri, spkiSHA256)
+		},
 		OnProgressChanged: func(progress float64) {
 			c.onProgressChanged(paneID, wv, identity, progress)
 		},
@@ -93,42 +111,24 @@ func (c *Coordinator) setupWebViewCallbacks(ctx context.Context, paneID entity.P
 			c.onLinkHover(paneID, uri)
 		},
 		OnTouchpadNavigationGesture: func(gesture entity.TouchpadNavigationGesture) {
-			if c.onTouchpadNavigationGesture != nil {
+			if c.onTouchpadNavigationGesture != nil && c.navigationGestureAllowed(wv) {
 				c.onTouchpadNavigationGesture(paneID, gesture)
 			}
 		},
-		OnWebProcessTerminated: func(reason port.WebProcessTerminationReason, reasonLabel string, uri string) {
-			originalURI := extractOriginalURIFromCrashPage(uri)
-			if !shouldRenderCrashPage(reason) {
-				log.Info().
-					Str("pane_id", string(paneID)).
-					Str("reason", reasonLabel).
-					Str("uri", uri).
-					Msg("web process termination handled without crash page")
-				return
-			}
-
-			crashURI := buildCrashPageURI(originalURI)
-			log.Warn().
-				Str("pane_id", string(paneID)).
-				Str("reason", reasonLabel).
-				Str("uri", uri).
-				Str("crash_uri", crashURI).
-				Msg("web process terminated, redirecting to crash page")
-
-			if err := wv.LoadURI(ctx, crashURI); err != nil {
-				log.Error().
-					Err(err).
-					Str("pane_id", string(paneID)).
-					Str("reason", reasonLabel).
-					Str("uri", uri).
-					Str("crash_uri", crashURI).
-					Msg("failed to load crash page after web process termination")
+		OnSearchSelection: func(text string) {
+			if c.onSearchSelection != nil {
+				c.onSearchSelection(paneID, text)
 			}
 		},
+		OnWebProcessTerminated: func(reason port.WebProcessTerminationReason, reasonLabel string, uri string) {
+			c.onWebProcessTerminated(ctx, paneID, wv, reason, reasonLabel, uri)
+		},
 		OnPermissionRequest: func(origin string, permTypes []string, metadata map[string]string, allow, deny func()) bool {
 			return c.handlePermissionRequest(ctx, paneID, origin, permTypes, metadata, allow, deny)
 		},
+		OnScriptDialog: func(request port.ScriptDialogRequest) bool {
+			return c.handleScriptDialog(ctx, paneID, request)
+		},
 	}
 
 	c.setupFaviconCallbacks(ctx, paneID, wv, callbacks)
@@ -211,6 +211,8 @@ func (c *Coordinator) handlePermissionRequest(
 			entityTypes = append(entityTypes, entity.PermissionTypeDeviceInfo)
 		case "website_data_access":
 			entityTypes = append(entityTypes, entity.PermissionTypeWebsiteDataAccess)
+		case "clipboard":
+			entityTypes = append(entityTypes, entity.PermissionTypeClipboard)
 		default:
 			log.Warn().Str("type", pt).Msg("unknown permission type, skipping")
 		}
@@ -222,6 +224,10 @@ func (c *Coordinator) handlePermissionRequest(
 		return true
 	}
 
+	if c.onPermissionRequest != nil {
+		c.onPermissionRequest(paneID)
+	}
+
 	trackedTypes := filterWebRTCPermissionTypes(entityTypes)
 	notifyActivity := func(state PermissionActivityState) {
 		if c.onPermissionActivity == nil || len(trackedTypes) == 0 {
@@ -0,0 +1,74 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"html"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetCertificatePinUC enables certificate pin enforcement for main-frame loads.
+func (c *Coordinator) SetCertificatePinUC(uc *usecase.ManageCertificatePinsUseCase) {
+	c.certPinUC = uc
+}
+
+// refreshCertificatePins reloads the pins off the main thread so pins added
+// with the CLI while the browser runs apply from the next load.
+func (c *Coordinator) refreshCertificatePins(ctx context.Context) {
+	if c.certPinUC != nil {
+		c.certPinUC.RefreshInBackground(ctx)
+	}
+}
+
+// enforceCertificatePin checks the certificate a main-frame load presented
+// against the pin of its host. It runs from the engine's certificate check,
+// before the load is reported as committed or as failed. On mismatch the load
+// is stopped and replaced by a block page without offering to proceed, and
+// true is returned so the engine drops the commit or failure.
+func (c *Coordinator) enforceCertificatePin(
+	ctx context.Context,
+	paneID entity.PaneID,
+	wv port.WebView,
+	uri, presented string,
+) bool {
+	if c.certPinUC == nil {
+		return false
+	}
+
+	decision, pin := c.certPinUC.Check(ctx, uri, presented)
+	if decision != entity.CertificatePinMismatch {
+		return false
+	}
+
+	_ = wv.Stop(ctx)
+	if err := wv.LoadHTML(ctx, certificatePinBlockPage(pin.Host, pin.SPKISHA256, presented), ""); err != nil {
+		logging.FromContext(ctx).Error().Err(err).Str("pane_id", string(paneID)).Msg("failed to show certificate pin block page")
+	}
+	return true
+}
+
+// certificatePinBlockPage renders the page shown in place of a load whose
+// certificate does not match its pin.
+func certificatePinBlockPage(host, expected, presented string) string {
+	if presented == "" {
+		presented = "(none)"
+	}
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Certificate pin mismatch</title>
+<style>body{font-family:sans-serif;max-width:42em;margin:4em auto;padding:0 1em}code{word-break:break-all}</style>
+</head><body>
+<h1>Certificate pin mismatch</h1>
+<p>The certificate presented by <strong>%s</strong> does not match the key pinned for this host. The connection was blocked.</p>
+<p>Expected: <code>%s</code><br>Presented: <code>%s</code></p>
+<p>If the site legitimately rotated its key, run <code>dumber certs pin %s</code> to update the pin.</p>
+</body></html>`,
+		html.EscapeString(host),
+		html.EscapeString(expected),
+		html.EscapeString(presented),
+		html.EscapeString(host),
+	)
+}
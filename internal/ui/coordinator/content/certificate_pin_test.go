@@ -0,0 +1,108 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type staticPinRepo struct {
+	pins map[string]*entity.CertificatePin
+}
+
+func (r *staticPinRepo) Get(_ context.Context, host string) (*entity.CertificatePin, error) {
+	return r.pins[host], nil
+}
+
+func (*staticPinRepo) Set(context.Context, *entity.CertificatePin) error { return nil }
+
+func (*staticPinRepo) Delete(context.Context, string) error { return nil }
+
+func (r *staticPinRepo) List(context.Context) ([]*entity.CertificatePin, error) {
+	out := make([]*entity.CertificatePin, 0, len(r.pins))
+	for _, pin := range r.pins {
+		out = append(out, pin)
+	}
+	return out, nil
+}
+
+func newPinnedCoordinator(t *testing.T) *Coordinator {
+	t.Helper()
+	uc := usecase.NewManageCertificatePinsUseCase(&staticPinRepo{
+		pins: map[string]*entity.CertificatePin{
+			"bank.example": {Host: "bank.example", SPKISHA256: "pinned="},
+		},
+	}, nil)
+	require.NoError(t, uc.Refresh(context.Background()))
+	c := &Coordinator{}
+	c.SetCertificatePinUC(uc)
+	return c
+}
+
+func TestEnforceCertificatePin_MismatchHardFails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().Stop(ctx).Return(nil).Once()
+	wv.EXPECT().LoadHTML(ctx, mock.MatchedBy(func(page string) bool {
+		return assert.Contains(t, page, "bank.example") &&
+			assert.Contains(t, page, "pinned=") &&
+			assert.Contains(t, page, "attacker=")
+	}), "").Return(nil).Once()
+
+	blocked := newPinnedCoordinator(t).enforceCertificatePin(ctx, "pane-1", wv, "https://bank.example/login", "attacker=")
+
+	assert.True(t, blocked)
+}
+
+func TestEnforceCertificatePin_MatchContinues(t *testing.T) {
+	t.Parallel()
+
+	blocked := newPinnedCoordinator(t).enforceCertificatePin(
+		context.Background(), "pane-1", mocks.NewMockWebView(t), "https://bank.example/", "pinned=",
+	)
+
+	assert.False(t, blocked)
+}
+
+func TestEnforceCertificatePin_UnpinnedHostContinues(t *testing.T) {
+	t.Parallel()
+
+	blocked := newPinnedCoordinator(t).enforceCertificatePin(
+		context.Background(), "pane-1", mocks.NewMockWebView(t), "https://example.com/", "other=",
+	)
+
+	assert.False(t, blocked)
+}
+
+func TestEnforceCertificatePin_MissingCertificateHardFails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().Stop(ctx).Return(nil).Once()
+	wv.EXPECT().LoadHTML(ctx, mock.MatchedBy(func(page string) bool {
+		return assert.Contains(t, page, "(none)")
+	}), "").Return(nil).Once()
+
+	blocked := newPinnedCoordinator(t).enforceCertificatePin(ctx, "pane-1", wv, "https://bank.example/", "")
+
+	assert.True(t, blocked)
+}
+
+func TestEnforceCertificatePin_WithoutUseCaseIsSkipped(t *testing.T) {
+	t.Parallel()
+
+	c := &Coordinator{}
+	blocked := c.enforceCertificatePin(context.Background(), "pane-1", mocks.NewMockWebView(t), "https://bank.example/", "")
+
+	assert.False(t, blocked)
+}
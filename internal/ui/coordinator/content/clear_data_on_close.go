@@ -0,0 +1,54 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/privacy"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// clearDataOnClose removes the stored data of the page wv shows when its
+// domain is listed in privacy.clear_data_on_close_domains. wv must already be
+// unregistered from its pane. Data is kept while another pane still shows the
+// same origin.
+func (c *Coordinator) clearDataOnClose(ctx context.Context, wv port.WebView) {
+	if c.privacyConfigProvider == nil || wv == nil {
+		return
+	}
+	domains := c.privacyConfigProvider().ClearDataOnCloseDomains
+	if len(domains) == 0 || wv.IsDestroyed() {
+		return
+	}
+	capable, ok := wv.(port.SiteDataClearCapable)
+	if !ok {
+		return
+	}
+	origin, ok := privacy.ClearDataOnCloseOrigin(domains, wv.URI())
+	if !ok {
+		return
+	}
+	if c.originShownElsewhere(origin, wv) {
+		logging.FromContext(ctx).Debug().Str("origin", origin).Msg("origin still open, keeping its data")
+		return
+	}
+	logging.FromContext(ctx).Debug().Str("origin", origin).Msg("clearing site data on close")
+	capable.ClearSiteData(ctx, origin)
+}
+
+// originShownElsewhere reports whether a pane other than the one of closing
+// shows a page of origin.
+func (c *Coordinator) originShownElsewhere(origin string, closing port.WebView) bool {
+	c.webViewsMu.RLock()
+	defer c.webViewsMu.RUnlock()
+	for _, wv := range c.webViews {
+		if wv == nil || wv == closing || wv.IsDestroyed() {
+			continue
+		}
+		if other, err := urlutil.ExtractOrigin(wv.URI()); err == nil && other == origin {
+			return true
+		}
+	}
+	return false
+}
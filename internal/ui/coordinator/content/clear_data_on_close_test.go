@@ -0,0 +1,92 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type siteDataWebView struct {
+	*mocks.MockWebView
+	cleared []string
+}
+
+func (w *siteDataWebView) ClearSiteData(_ context.Context, origin string) {
+	w.cleared = append(w.cleared, origin)
+}
+
+func newSiteDataWebView(t *testing.T, uri string) *siteDataWebView {
+	wv := &siteDataWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().URI().Return(uri).Maybe()
+	return wv
+}
+
+func newClearDataCoordinator(domains ...string) *Coordinator {
+	c := &Coordinator{webViews: make(map[entity.PaneID]port.WebView)}
+	c.SetPrivacyConfigProvider(func() entity.RuntimePrivacyConfig {
+		return entity.RuntimePrivacyConfig{ClearDataOnCloseDomains: domains}
+	})
+	return c
+}
+
+func TestClearDataOnClose_ClearsMatchingOrigin(t *testing.T) {
+	t.Parallel()
+
+	c := newClearDataCoordinator("bank.example", "*.health.example")
+	ctx := context.Background()
+
+	bank := newSiteDataWebView(t, "https://bank.example:443/account")
+	c.clearDataOnClose(ctx, bank)
+	assert.Equal(t, []string{"https://bank.example"}, bank.cleared)
+
+	portal := newSiteDataWebView(t, "https://portal.health.example/")
+	c.clearDataOnClose(ctx, portal)
+	assert.Equal(t, []string{"https://portal.health.example"}, portal.cleared)
+}
+
+func TestClearDataOnClose_SkipsUnlistedAndInternalPages(t *testing.T) {
+	t.Parallel()
+
+	c := newClearDataCoordinator("bank.example")
+	ctx := context.Background()
+
+	for _, uri := range []string{"https://news.example/", "dumb://home", ""} {
+		wv := newSiteDataWebView(t, uri)
+		c.clearDataOnClose(ctx, wv)
+		assert.Empty(t, wv.cleared, uri)
+	}
+}
+
+func TestClearDataOnClose_KeepsDataWhileOriginOpenElsewhere(t *testing.T) {
+	t.Parallel()
+
+	c := newClearDataCoordinator("bank.example")
+	ctx := context.Background()
+
+	other := newSiteDataWebView(t, "https://bank.example/statements")
+	c.webViews[entity.PaneID("pane-2")] = other
+
+	closing := newSiteDataWebView(t, "https://bank.example/account")
+	c.clearDataOnClose(ctx, closing)
+	assert.Empty(t, closing.cleared, "another pane still shows the origin")
+
+	delete(c.webViews, entity.PaneID("pane-2"))
+	c.webViews[entity.PaneID("pane-3")] = newSiteDataWebView(t, "http://bank.example/")
+	c.clearDataOnClose(ctx, closing)
+	assert.Equal(t, []string{"https://bank.example"}, closing.cleared, "a different scheme is a different origin")
+}
+
+func TestClearDataOnClose_DisabledWithoutDomains(t *testing.T) {
+	t.Parallel()
+
+	c := newClearDataCoordinator()
+	wv := newSiteDataWebView(t, "https://bank.example/")
+	c.clearDataOnClose(context.Background(), wv)
+	assert.Empty(t, wv.cleared)
+}
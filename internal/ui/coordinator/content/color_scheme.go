@@ -0,0 +1,98 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/colorscheme"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetSiteColorSchemeUC enables color schemes remembered per site.
+func (c *Coordinator) SetSiteColorSchemeUC(uc *usecase.ManageSiteColorSchemesUseCase) {
+	c.siteColorSchemeUC = uc
+}
+
+// colorSchemeFor resolves the color scheme of paneID on uri: the pane
+// override, then the one stored for the site, then the system one. Pages
+// that are not web pages always follow the system.
+func (c *Coordinator) colorSchemeFor(ctx context.Context, paneID entity.PaneID, uri string) entity.ColorScheme {
+	if usecase.SiteColorSchemeDomain(uri) == "" {
+		return entity.ColorSchemeSystem
+	}
+	c.appearanceMu.Lock()
+	pane := c.colorSchemeOverrides[paneID]
+	c.appearanceMu.Unlock()
+
+	site := entity.ColorSchemeSystem
+	if c.siteColorSchemeUC != nil {
+		var err error
+		site, err = c.siteColorSchemeUC.Resolve(ctx, uri)
+		if err != nil {
+			logging.FromContext(ctx).Warn().Err(err).Str("uri", uri).Msg("site color scheme lookup failed")
+		}
+	}
+	return colorscheme.Resolve(pane, site)
+}
+
+// applyColorScheme reports the resolved color scheme to the page of paneID
+// after a navigation.
+func (c *Coordinator) applyColorScheme(ctx context.Context, paneID entity.PaneID, wv port.WebView, uri string) {
+	if wv == nil || wv.IsDestroyed() {
+		return
+	}
+	capable, ok := wv.(port.ColorSchemeCapable)
+	if !ok {
+		return
+	}
+	capable.ApplyColorScheme(ctx, c.colorSchemeFor(ctx, paneID, uri))
+}
+
+// CyclePaneColorScheme moves the pane to the next color scheme, from system
+// to dark to light and back, for the site loaded in paneID. The pane keeps
+// the scheme on other sites and the site keeps it in other panes. It returns
+// the new scheme and the site; ok is false when the page is not a web page.
+func (c *Coordinator) CyclePaneColorScheme(
+	ctx context.Context,
+	paneID entity.PaneID,
+) (scheme entity.ColorScheme, domain string, ok bool) {
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return entity.ColorSchemeSystem, "", false
+	}
+	capable, capableOK := wv.(port.ColorSchemeCapable)
+	uri := wv.URI()
+	domain = usecase.SiteColorSchemeDomain(uri)
+	if !capableOK || domain == "" {
+		return entity.ColorSchemeSystem, "", false
+	}
+
+	scheme = colorscheme.Next(c.colorSchemeFor(ctx, paneID, uri))
+	c.appearanceMu.Lock()
+	if scheme == entity.ColorSchemeSystem {
+		delete(c.colorSchemeOverrides, paneID)
+	} else {
+		if c.colorSchemeOverrides == nil {
+			c.colorSchemeOverrides = make(map[entity.PaneID]entity.ColorScheme)
+		}
+		c.colorSchemeOverrides[paneID] = scheme
+	}
+	c.appearanceMu.Unlock()
+
+	if c.siteColorSchemeUC != nil {
+		if _, err := c.siteColorSchemeUC.Set(ctx, uri, scheme); err != nil {
+			logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to save site color scheme")
+		}
+	}
+	capable.ApplyColorScheme(ctx, scheme)
+	return scheme, domain, true
+}
+
+// forgetColorScheme drops the color scheme override of a released pane.
+func (c *Coordinator) forgetColorScheme(paneID entity.PaneID) {
+	c.appearanceMu.Lock()
+	delete(c.colorSchemeOverrides, paneID)
+	c.appearanceMu.Unlock()
+}
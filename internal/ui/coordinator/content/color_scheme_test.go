@@ -0,0 +1,134 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// colorSchemeWebView records the schemes sent to the page.
+type colorSchemeWebView struct {
+	*mocks.MockWebView
+	applied []entity.ColorScheme
+}
+
+func (w *colorSchemeWebView) ApplyColorScheme(_ context.Context, scheme entity.ColorScheme) {
+	w.applied = append(w.applied, scheme)
+}
+
+type memorySiteColorSchemeRepo struct {
+	sites map[string]*entity.SiteColorScheme
+}
+
+func (r *memorySiteColorSchemeRepo) Get(_ context.Context, domain string) (*entity.SiteColorScheme, error) {
+	return r.sites[domain], nil
+}
+
+func (r *memorySiteColorSchemeRepo) Set(_ context.Context, site *entity.SiteColorScheme) error {
+	r.sites[site.Domain] = site
+	return nil
+}
+
+func (r *memorySiteColorSchemeRepo) Delete(_ context.Context, domain string) error {
+	delete(r.sites, domain)
+	return nil
+}
+
+func newColorSchemeCoordinator(sites map[string]*entity.SiteColorScheme) *Coordinator {
+	c := &Coordinator{}
+	c.SetSiteColorSchemeUC(usecase.NewManageSiteColorSchemesUseCase(&memorySiteColorSchemeRepo{sites: sites}))
+	return c
+}
+
+func TestColorSchemeFor_PaneThenSiteThenSystem(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newColorSchemeCoordinator(map[string]*entity.SiteColorScheme{
+		"dark.example": {Domain: "dark.example", Scheme: entity.ColorSchemeDark},
+	})
+
+	assert.Equal(t, entity.ColorSchemeSystem, c.colorSchemeFor(ctx, "pane-1", "https://plain.example/"))
+	assert.Equal(t, entity.ColorSchemeDark, c.colorSchemeFor(ctx, "pane-1", "https://dark.example/"))
+
+	c.colorSchemeOverrides = map[entity.PaneID]entity.ColorScheme{"pane-1": entity.ColorSchemeLight}
+	assert.Equal(t, entity.ColorSchemeLight, c.colorSchemeFor(ctx, "pane-1", "https://dark.example/"))
+	assert.Equal(t, entity.ColorSchemeLight, c.colorSchemeFor(ctx, "pane-1", "https://plain.example/"))
+	assert.Equal(t, entity.ColorSchemeDark, c.colorSchemeFor(ctx, "pane-2", "https://dark.example/"))
+	assert.Equal(t, entity.ColorSchemeSystem, c.colorSchemeFor(ctx, "pane-1", "dumb://home"),
+		"internal pages follow the system")
+}
+
+func TestApplyColorScheme_SendsResolvedScheme(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &colorSchemeWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+
+	c := newColorSchemeCoordinator(map[string]*entity.SiteColorScheme{
+		"dark.example": {Domain: "dark.example", Scheme: entity.ColorSchemeDark},
+	})
+
+	c.applyColorScheme(ctx, "pane-1", wv, "https://dark.example/")
+	c.applyColorScheme(ctx, "pane-1", wv, "https://plain.example/")
+	assert.Equal(t, []entity.ColorScheme{entity.ColorSchemeDark, entity.ColorSchemeSystem}, wv.applied)
+}
+
+func TestCyclePaneColorScheme_PersistsAndApplies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &colorSchemeWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+	wv.EXPECT().URI().Return("https://www.example.com/page")
+
+	sites := map[string]*entity.SiteColorScheme{}
+	c := newColorSchemeCoordinator(sites)
+	c.webViews = map[entity.PaneID]port.WebView{"pane-1": wv}
+
+	var cycle []entity.ColorScheme
+	for range 3 {
+		scheme, domain, ok := c.CyclePaneColorScheme(ctx, "pane-1")
+		require.True(t, ok)
+		assert.Equal(t, "example.com", domain)
+		cycle = append(cycle, scheme)
+		if scheme == entity.ColorSchemeSystem {
+			assert.NotContains(t, sites, "example.com")
+			assert.NotContains(t, c.colorSchemeOverrides, entity.PaneID("pane-1"))
+		} else {
+			require.Contains(t, sites, "example.com")
+			assert.Equal(t, scheme, sites["example.com"].Scheme)
+			assert.Equal(t, scheme, c.colorSchemeOverrides["pane-1"])
+		}
+	}
+	want := []entity.ColorScheme{entity.ColorSchemeDark, entity.ColorSchemeLight, entity.ColorSchemeSystem}
+	assert.Equal(t, want, cycle)
+	assert.Equal(t, want, wv.applied)
+}
+
+func TestCyclePaneColorScheme_SkipsInternalPagesAndForgetsReleasedPanes(t *testing.T) {
+	t.Parallel()
+
+	wv := &colorSchemeWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+	wv.EXPECT().URI().Return("dumb://home")
+
+	c := newColorSchemeCoordinator(map[string]*entity.SiteColorScheme{})
+	c.webViews = map[entity.PaneID]port.WebView{"pane-1": wv}
+
+	_, _, ok := c.CyclePaneColorScheme(context.Background(), "pane-1")
+	assert.False(t, ok)
+	assert.Empty(t, wv.applied)
+
+	c.colorSchemeOverrides = map[entity.PaneID]entity.ColorScheme{"pane-1": entity.ColorSchemeDark}
+	c.forgetColorScheme("pane-1")
+	assert.Empty(t, c.colorSchemeOverrides)
+}
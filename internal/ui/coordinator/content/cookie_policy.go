@@ -0,0 +1,49 @@
+package content
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/privacy"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetPrivacyConfigProvider sets the source of the live privacy config used to
+// resolve per-domain cookie policy on navigation.
+func (c *Coordinator) SetPrivacyConfigProvider(fn func() entity.RuntimePrivacyConfig) {
+	c.privacyConfigProvider = fn
+}
+
+// applyCookiePolicy switches the session cookie policy to the one resolved
+// for uri. The committed page was requested under the previous policy, so a
+// change reloads it once.
+func (c *Coordinator) applyCookiePolicy(ctx context.Context, wv port.WebView, uri string) {
+	if c.privacyConfigProvider == nil || wv == nil || wv.IsDestroyed() {
+		return
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Host == "" {
+		return
+	}
+	if scheme := strings.ToLower(parsed.Scheme); scheme != "http" && scheme != "https" {
+		return
+	}
+	capable, ok := wv.(port.CookiePolicyCapable)
+	if !ok {
+		return
+	}
+
+	cfg := c.privacyConfigProvider()
+	policy := privacy.ResolveCookiePolicy(cfg.CookiePolicy, cfg.CookiePolicyOverrides, uri)
+	if !capable.ApplyCookiePolicy(ctx, policy) {
+		return
+	}
+	log := logging.FromContext(ctx)
+	log.Debug().Str("uri", uri).Str("cookie_policy", string(policy)).Msg("cookie policy changed, reloading")
+	if err := wv.Reload(ctx); err != nil {
+		log.Warn().Err(err).Str("uri", uri).Msg("failed to reload after cookie policy change")
+	}
+}
@@ -0,0 +1,56 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type cookiePolicyWebView struct {
+	*mocks.MockWebView
+	current entity.CookiePolicy
+	applied []entity.CookiePolicy
+}
+
+func (w *cookiePolicyWebView) ApplyCookiePolicy(_ context.Context, policy entity.CookiePolicy) bool {
+	w.applied = append(w.applied, policy)
+	changed := policy != w.current
+	w.current = policy
+	return changed
+}
+
+func TestApplyCookiePolicy_ReloadsOnlyWhenPolicyChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &cookiePolicyWebView{MockWebView: mocks.NewMockWebView(t), current: entity.CookiePolicyNoThirdParty}
+	wv.EXPECT().IsDestroyed().Return(false)
+	wv.EXPECT().Reload(ctx).Return(nil).Times(2)
+
+	c := &Coordinator{}
+	c.SetPrivacyConfigProvider(func() entity.RuntimePrivacyConfig {
+		return entity.RuntimePrivacyConfig{
+			CookiePolicy: entity.CookiePolicyNoThirdParty,
+			CookiePolicyOverrides: []entity.CookiePolicyOverride{
+				{Domain: "bank.example", Policy: entity.CookiePolicyAlways},
+			},
+		}
+	})
+
+	c.applyCookiePolicy(ctx, wv, "https://news.test/")           // default, unchanged
+	c.applyCookiePolicy(ctx, wv, "https://bank.example/login")   // override, reload
+	c.applyCookiePolicy(ctx, wv, "https://bank.example/account") // same policy
+	c.applyCookiePolicy(ctx, wv, "dumb://home")                  // internal page, skipped
+	c.applyCookiePolicy(ctx, wv, "https://news.test/next")       // back to default, reload
+
+	assert.Equal(t, []entity.CookiePolicy{
+		entity.CookiePolicyNoThirdParty,
+		entity.CookiePolicyAlways,
+		entity.CookiePolicyAlways,
+		entity.CookiePolicyNoThirdParty,
+	}, wv.applied)
+}
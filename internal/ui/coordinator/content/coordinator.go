@@ -6,6 +6,7 @@ import (
 
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/crashloop"
 	"github.com/bnema/dumber/internal/domain/entity"
 
 	"github.com/bnema/dumber/internal/logging"
@@ -28,6 +29,17 @@ type Coordinator struct {
 	settingsApplier port.SettingsApplier // optional: nil if engine doesn't support
 	filterApplier   port.FilterApplier   // optional: nil if engine doesn't support
 
+	// Per-site filter exceptions, and the WebViews currently loaded without
+	// content filters because of one.
+	filterExceptionsUC *usecase.ManageFilterExceptionsUseCase
+	filterBypassed     map[port.WebViewID]bool
+	filterBypassMu     sync.Mutex
+
+	// Per-site cosmetic filter exceptions, and the WebViews currently loaded
+	// without cosmetic rules because of one. Guarded by filterBypassMu.
+	cosmeticExceptionsUC *usecase.ManageFilterExceptionsUseCase
+	cosmeticBypassed     map[port.WebViewID]bool
+
 	webViews       map[entity.PaneID]port.WebView
 	webViewPaneIDs map[port.WebViewID]entity.PaneID
 	webViewsMu     sync.RWMutex
@@ -61,12 +73,18 @@ type Coordinator struct {
 	// Callback when media permission activity changes (requesting/allowed/blocked).
 	onPermissionActivity func(paneID entity.PaneID, origin string, permTypes []entity.PermissionType, state PermissionActivityState)
 
+	// Callback before any permission request of a pane is decided.
+	onPermissionRequest func(paneID entity.PaneID)
+
 	// Callback when the active pane commits a navigation (new page loading).
 	onActiveNavigationCommitted func(paneID entity.PaneID, uri string)
 
 	// Callback when a CEF touchpad history navigation gesture progresses.
 	onTouchpadNavigationGesture func(paneID entity.PaneID, gesture entity.TouchpadNavigationGesture)
 
+	// Callback when the context menu asks to search the selected text.
+	onSearchSelection func(paneID entity.PaneID, text string)
+
 	// Callback when the WebView becomes visible (first real commit)
 	onWebViewShown func(paneID entity.PaneID)
 
@@ -91,6 +109,55 @@ type Coordinator struct {
 	hasPendingThemeUpdate bool
 	currentTheme          pendingThemeUpdate
 	hasCurrentTheme       bool
+	forceDarkOverrides    map[entity.PaneID]forceDarkOverride
+	colorSchemeOverrides  map[entity.PaneID]entity.ColorScheme
+
+	// Scroll offsets of recently left pages, and panes waiting to restore one
+	// once a RestoreLastView navigation finishes loading.
+	scrollMu             sync.Mutex
+	scrollMemories       map[entity.PaneID]*entity.ScrollMemory
+	pendingScrollRestore map[entity.PaneID]bool
+
+	// Panes whose page reported form fields edited since it loaded.
+	formDirtyMu sync.Mutex
+	dirtyForms  map[entity.PaneID]bool
+
+	// Panes of lazily restored tabs that get a WebView only once their tab
+	// is shown.
+	deferredMu    sync.Mutex
+	deferredPanes map[entity.PaneID]bool
+
+	// Retries of pages that failed on a transient network error.
+	loadRetryMu             sync.Mutex
+	loadRetries             map[entity.PaneID]*loadRetryState
+	loadRetryConfigProvider func() entity.RuntimeLoadRetryConfig
+	onLoadRetry             func(ctx context.Context, paneID entity.PaneID, event LoadRetryEvent)
+
+	// Focus timers running on panes.
+	focusTimerMu             sync.Mutex
+	focusTimers              map[entity.PaneID]*focusTimerState
+	focusTimerConfigProvider func() entity.FocusTimerConfig
+	onFocusTimer             func(ctx context.Context, paneID entity.PaneID, event FocusTimerEvent)
+	onClosePane              func(ctx context.Context, paneID entity.PaneID) error
+
+	// Page languages reported for the translate offer.
+	pageLanguageMu            sync.Mutex
+	pageLanguages             map[entity.PaneID]string
+	translationConfigProvider func() entity.RuntimeTranslationConfig
+	onTranslateOffer          func(ctx context.Context, paneID entity.PaneID, offer TranslateOffer)
+
+	// Recent web process crashes of each page, so a crashed pane is reloaded
+	// automatically at most once.
+	crashes            crashloop.Tracker
+	reloadCrashedPanes func() bool
+	onCrashReload      func(ctx context.Context, paneID entity.PaneID, uri string)
+
+	// HTTPS-only mode, and the host each pane was last warned about for
+	// loading over http://.
+	httpsUpgradeUC *usecase.HTTPSUpgradeUseCase
+	onHTTPSUpgrade func(ctx context.Context, paneID entity.PaneID, event HTTPSUpgradeEvent)
+	httpsWarnMu    sync.Mutex
+	httpsWarned    map[entity.PaneID]string
 
 	// Gesture action handler for mouse button navigation
 	gestureActionHandler input.ActionHandler
@@ -116,6 +183,33 @@ type Coordinator struct {
 	// Callback to open a URL with the system's default handler (e.g. xdg-open).
 	// Used for external URL schemes like vscode://, spotify://, etc.
 	onLaunchExternalURL func(uri string)
+
+	// Provides the live media config for per-domain autoplay policy.
+	mediaConfigProvider func() entity.RuntimeMediaConfig
+
+	// Provides the live appearance config for per-domain forced dark mode.
+	appearanceConfigProvider func() entity.AppearanceConfig
+
+	// Provides the live privacy config for per-domain cookie policy.
+	privacyConfigProvider func() entity.RuntimePrivacyConfig
+
+	// Provides the live script dialog config for auto-dismissal.
+	scriptDialogsConfigProvider func() entity.RuntimeScriptDialogsConfig
+
+	// Provides the live input config for first-field autofocus.
+	inputConfigProvider func() entity.RuntimeInputConfig
+
+	// Presents JavaScript dialogs in the pane's browser window.
+	onScriptDialog func(ctx context.Context, paneID entity.PaneID, request port.ScriptDialogRequest) bool
+
+	// Optional: blocks committed loads whose certificate breaks a host pin.
+	certPinUC *usecase.ManageCertificatePinsUseCase
+
+	// Optional: requests sites with a stored desktop or mobile user agent.
+	siteUserAgentUC *usecase.ManageSiteUserAgentsUseCase
+
+	// Optional: shows sites with a stored light or dark color scheme.
+	siteColorSchemeUC *usecase.ManageSiteColorSchemesUseCase
 }
 
 type pendingThemeUpdate struct {
@@ -197,6 +291,12 @@ func (c *Coordinator) SetOnPermissionActivity(
 	c.onPermissionActivity = fn
 }
 
+// SetOnPermissionRequest sets a callback run before any permission request
+// is decided, so a prompt can be shown in the pane's own window.
+func (c *Coordinator) SetOnPermissionRequest(fn func(paneID entity.PaneID)) {
+	c.onPermissionRequest = fn
+}
+
 // SetOnActiveNavigationCommitted sets a callback fired when the active pane commits a navigation.
 func (c *Coordinator) SetOnActiveNavigationCommitted(fn func(paneID entity.PaneID, uri string)) {
 	c.onActiveNavigationCommitted = fn
@@ -213,6 +313,12 @@ func (c *Coordinator) SetOnTouchpadNavigationGesture(fn func(paneID entity.PaneI
 	c.onTouchpadNavigationGesture = fn
 }
 
+// SetOnSearchSelection sets the callback for the "Search for Selection"
+// context menu item.
+func (c *Coordinator) SetOnSearchSelection(fn func(paneID entity.PaneID, text string)) {
+	c.onSearchSelection = fn
+}
+
 // SetOnWindowTitleChanged sets the callback for active pane title changes (for window title updates).
 func (c *Coordinator) SetOnWindowTitleChanged(fn func(paneID entity.PaneID, title string)) {
 	c.onWindowTitleChanged = fn
@@ -0,0 +1,109 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetCosmeticExceptionsUC enables per-site cosmetic filter exceptions.
+func (c *Coordinator) SetCosmeticExceptionsUC(uc *usecase.ManageFilterExceptionsUseCase) {
+	c.cosmeticExceptionsUC = uc
+}
+
+func (c *Coordinator) cosmeticBypassApplier() (port.CosmeticFilterBypassApplier, bool) {
+	if c.cosmeticExceptionsUC == nil || c.filterApplier == nil {
+		return nil, false
+	}
+	applier, ok := c.filterApplier.(port.CosmeticFilterBypassApplier)
+	return applier, ok
+}
+
+// syncCosmeticBypass drops or restores the cosmetic rules of wv for uri,
+// keeping its network filters. It runs with syncFilterBypass, so the rules
+// already match the site when the page starts rendering.
+func (c *Coordinator) syncCosmeticBypass(ctx context.Context, wv port.WebView, uri string) {
+	applier, ok := c.cosmeticBypassApplier()
+	if !ok || wv == nil || wv.IsDestroyed() || uri == "" {
+		return
+	}
+	bypassed := c.cosmeticExceptionsUC.IsBypassed(ctx, uri)
+	if !c.setCosmeticBypassed(wv.ID(), bypassed) {
+		return
+	}
+	logging.FromContext(ctx).Debug().
+		Uint64("webview_id", uint64(wv.ID())).
+		Bool("bypassed", bypassed).
+		Msg("cosmetic filtering toggled for site")
+	applier.SetCosmeticFilteringBypassed(ctx, wv, bypassed)
+}
+
+// setCosmeticBypassed records the cosmetic filter state of a WebView and
+// reports whether it changed.
+func (c *Coordinator) setCosmeticBypassed(id port.WebViewID, bypassed bool) bool {
+	c.filterBypassMu.Lock()
+	defer c.filterBypassMu.Unlock()
+	if c.cosmeticBypassed[id] == bypassed {
+		return false
+	}
+	if !bypassed {
+		delete(c.cosmeticBypassed, id)
+		return true
+	}
+	if c.cosmeticBypassed == nil {
+		c.cosmeticBypassed = make(map[port.WebViewID]bool)
+	}
+	c.cosmeticBypassed[id] = true
+	return true
+}
+
+// TogglePaneCosmeticFiltering stops hiding page elements on the site loaded
+// in paneID, or starts again, and remembers the choice for that site. Its
+// requests stay filtered. Every pane on the site follows the new state, and
+// paneID reloads so the page renders with or without the hidden elements.
+func (c *Coordinator) TogglePaneCosmeticFiltering(
+	ctx context.Context,
+	paneID entity.PaneID,
+) (bypassed bool, domain string, err error) {
+	if _, ok := c.cosmeticBypassApplier(); !ok {
+		return false, "", ErrFilteringUnsupported
+	}
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return false, "", usecase.ErrFilterExceptionUnsupportedURL
+	}
+
+	bypassed, domain, err = c.cosmeticExceptionsUC.Toggle(ctx, wv.URI())
+	if err != nil {
+		return bypassed, domain, err
+	}
+
+	c.webViewsMu.RLock()
+	snapshot := make([]port.WebView, 0, len(c.webViews))
+	for _, other := range c.webViews {
+		snapshot = append(snapshot, other)
+	}
+	c.webViewsMu.RUnlock()
+	for _, other := range snapshot {
+		c.syncCosmeticBypass(ctx, other, other.URI())
+	}
+
+	if err := wv.Reload(ctx); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("reload after cosmetic filter toggle failed")
+	}
+	return bypassed, domain, nil
+}
+
+// forgetCosmeticBypass restores the cosmetic rules of a WebView leaving its
+// pane, so a pooled instance is handed out fully filtered again.
+func (c *Coordinator) forgetCosmeticBypass(ctx context.Context, wv port.WebView) {
+	if wv == nil || !c.setCosmeticBypassed(wv.ID(), false) {
+		return
+	}
+	if applier, ok := c.cosmeticBypassApplier(); ok && !wv.IsDestroyed() {
+		applier.SetCosmeticFilteringBypassed(ctx, wv, false)
+	}
+}
@@ -0,0 +1,109 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	repomocks "github.com/bnema/dumber/internal/domain/repository/mocks"
+)
+
+// recordingCosmeticApplier records cosmetic filter changes per WebView on
+// top of the full filter changes.
+type recordingCosmeticApplier struct {
+	recordingFilterApplier
+	cosmetic map[port.WebViewID][]bool
+}
+
+func (r *recordingCosmeticApplier) SetCosmeticFilteringBypassed(_ context.Context, wv port.WebView, bypassed bool) {
+	if r.cosmetic == nil {
+		r.cosmetic = map[port.WebViewID][]bool{}
+	}
+	r.cosmetic[wv.ID()] = append(r.cosmetic[wv.ID()], bypassed)
+}
+
+func newCosmeticFilterCoordinator(
+	t *testing.T,
+	webViews map[entity.PaneID]port.WebView,
+	saved []string,
+) (*Coordinator, *recordingCosmeticApplier, *repomocks.MockContentWhitelistRepository) {
+	filterRepo := repomocks.NewMockContentWhitelistRepository(t)
+	filterRepo.EXPECT().GetAll(mock.Anything).Return(nil, nil).Maybe()
+	cosmeticRepo := repomocks.NewMockContentWhitelistRepository(t)
+	cosmeticRepo.EXPECT().GetAll(mock.Anything).Return(saved, nil).Maybe()
+
+	applier := &recordingCosmeticApplier{}
+	c := &Coordinator{webViews: webViews}
+	c.SetFilterApplier(applier)
+	c.SetFilterExceptionsUC(usecase.NewManageFilterExceptionsUseCase(filterRepo))
+	c.SetCosmeticExceptionsUC(usecase.NewManageFilterExceptionsUseCase(cosmeticRepo))
+	return c, applier, cosmeticRepo
+}
+
+func TestSyncCosmeticBypass_FollowsSavedDomains(t *testing.T) {
+	ctx := context.Background()
+	uri := "https://news.example/"
+	wv := newFilterWebView(t, 5, &uri)
+	c, applier, _ := newCosmeticFilterCoordinator(t, map[entity.PaneID]port.WebView{"pane-1": wv}, []string{"news.example"})
+
+	c.syncFilterBypass(ctx, wv, "https://news.example/today")
+	c.syncFilterBypass(ctx, wv, "https://news.example/tomorrow")
+	c.syncFilterBypass(ctx, wv, "https://cdn.news.example/")
+	c.syncFilterBypass(ctx, wv, "dumb://homepage")
+
+	assert.Equal(t, []bool{true, false}, applier.cosmetic[5], "only the saved site drops its cosmetic rules")
+	assert.Empty(t, applier.bypassed[5], "network filtering is left alone")
+}
+
+func TestTogglePaneCosmeticFiltering_PersistsSiteAndReloads(t *testing.T) {
+	ctx := context.Background()
+	uri := "https://blog.example/post"
+	otherURI := "https://blog.example/"
+	wv := newFilterWebView(t, 8, &uri)
+	wv.EXPECT().Reload(mock.Anything).Return(nil).Twice()
+	other := newFilterWebView(t, 9, &otherURI)
+	c, applier, repo := newCosmeticFilterCoordinator(t,
+		map[entity.PaneID]port.WebView{"pane-1": wv, "pane-2": other}, nil)
+	repo.EXPECT().Add(mock.Anything, "blog.example").Return(nil).Once()
+	repo.EXPECT().Remove(mock.Anything, "blog.example").Return(nil).Once()
+
+	bypassed, domain, err := c.TogglePaneCosmeticFiltering(ctx, "pane-1")
+	require.NoError(t, err)
+	assert.True(t, bypassed)
+	assert.Equal(t, "blog.example", domain)
+	assert.Equal(t, []bool{true}, applier.cosmetic[8])
+	assert.Equal(t, []bool{true}, applier.cosmetic[9], "other panes on the site follow")
+
+	bypassed, _, err = c.TogglePaneCosmeticFiltering(ctx, "pane-1")
+	require.NoError(t, err)
+	assert.False(t, bypassed)
+	assert.Equal(t, []bool{true, false}, applier.cosmetic[8])
+}
+
+func TestForgetFilterBypass_RestoresCosmeticRules(t *testing.T) {
+	ctx := context.Background()
+	uri := "https://news.example/"
+	wv := newFilterWebView(t, 4, &uri)
+	c, applier, _ := newCosmeticFilterCoordinator(t, map[entity.PaneID]port.WebView{"pane-1": wv}, []string{"news.example"})
+
+	c.syncFilterBypass(ctx, wv, uri)
+	c.forgetFilterBypass(ctx, wv)
+	c.forgetFilterBypass(ctx, wv)
+
+	assert.Equal(t, []bool{true, false}, applier.cosmetic[4], "released WebViews get their cosmetic rules back once")
+}
+
+func TestTogglePaneCosmeticFiltering_UnsupportedEngine(t *testing.T) {
+	c := &Coordinator{}
+	c.SetFilterApplier(&recordingFilterApplier{})
+	c.SetCosmeticExceptionsUC(usecase.NewManageFilterExceptionsUseCase(nil))
+
+	_, _, err := c.TogglePaneCosmeticFiltering(context.Background(), "pane-1")
+	assert.ErrorIs(t, err, ErrFilteringUnsupported)
+}
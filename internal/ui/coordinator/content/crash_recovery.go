@@ -0,0 +1,87 @@
+package content
+
+import (
+	"context"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/crashloop"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetReloadCrashedPanesProvider sets the source of the live
+// workspace.reload_crashed_panes setting.
+func (c *Coordinator) SetReloadCrashedPanesProvider(fn func() bool) {
+	c.reloadCrashedPanes = fn
+}
+
+// SetOnCrashReload sets the callback run when a pane whose web process
+// crashed is reloaded automatically.
+func (c *Coordinator) SetOnCrashReload(fn func(ctx context.Context, paneID entity.PaneID, uri string)) {
+	c.onCrashReload = fn
+}
+
+// onWebProcessTerminated replaces a pane whose web process crashed with the
+// crash page, which offers to reload it. When workspace.reload_crashed_panes
+// is set, the first crash of a page reloads it instead; a page that crashes
+// again soon after gets the crash page, so it is never reloaded in a loop.
+func (c *Coordinator) onWebProcessTerminated(
+	ctx context.Context,
+	paneID entity.PaneID,
+	wv port.WebView,
+	reason port.WebProcessTerminationReason,
+	reasonLabel string,
+	uri string,
+) {
+	log := logging.FromContext(ctx)
+	originalURI := extractOriginalURIFromCrashPage(uri)
+	if !shouldRenderCrashPage(reason) {
+		log.Info().
+			Str("pane_id", string(paneID)).
+			Str("reason", reasonLabel).
+			Str("uri", uri).
+			Msg("web process termination handled without crash page")
+		return
+	}
+
+	autoReload := c.reloadCrashedPanes != nil && c.reloadCrashedPanes()
+	if c.crashes.Crashed(originalURI, time.Now(), autoReload) == crashloop.ActionReload {
+		log.Warn().
+			Str("pane_id", string(paneID)).
+			Str("reason", reasonLabel).
+			Str("uri", originalURI).
+			Msg("web process terminated, reloading page once")
+		err := wv.LoadURI(ctx, originalURI)
+		if err == nil {
+			if c.onCrashReload != nil {
+				c.onCrashReload(ctx, paneID, originalURI)
+			}
+			return
+		}
+		log.Error().
+			Err(err).
+			Str("pane_id", string(paneID)).
+			Str("uri", originalURI).
+			Msg("failed to reload page after web process termination")
+	}
+
+	crashURI := buildCrashPageURI(originalURI)
+	log.Warn().
+		Str("pane_id", string(paneID)).
+		Str("reason", reasonLabel).
+		Str("uri", uri).
+		Str("crash_uri", crashURI).
+		Int("recent_crashes", c.crashes.Count(originalURI, time.Now())).
+		Msg("web process terminated, redirecting to crash page")
+
+	if err := wv.LoadURI(ctx, crashURI); err != nil {
+		log.Error().
+			Err(err).
+			Str("pane_id", string(paneID)).
+			Str("reason", reasonLabel).
+			Str("uri", uri).
+			Str("crash_uri", crashURI).
+			Msg("failed to load crash page after web process termination")
+	}
+}
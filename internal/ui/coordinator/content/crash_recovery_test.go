@@ -0,0 +1,90 @@
+package content
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func newCrashRecoveryCoordinator(reload bool) (*Coordinator, *[]string) {
+	c := &Coordinator{}
+	c.SetReloadCrashedPanesProvider(func() bool { return reload })
+	var reloaded []string
+	c.SetOnCrashReload(func(_ context.Context, _ entity.PaneID, uri string) {
+		reloaded = append(reloaded, uri)
+	})
+	return c, &reloaded
+}
+
+func TestCrashRecovery_ShowsPlaceholderWhenAutoReloadDisabled(t *testing.T) {
+	ctx := context.Background()
+	const uri = "https://heavy.example/app"
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().LoadURI(ctx, buildCrashPageURI(uri)).Return(nil).Once()
+
+	c, reloaded := newCrashRecoveryCoordinator(false)
+	c.onWebProcessTerminated(ctx, "pane-1", wv, port.WebProcessTerminationCrashed, "crashed", uri)
+
+	assert.Empty(t, *reloaded)
+}
+
+func TestCrashRecovery_ReloadsOnceThenShowsPlaceholder(t *testing.T) {
+	ctx := context.Background()
+	const uri = "https://heavy.example/app"
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().LoadURI(ctx, uri).Return(nil).Once()
+	wv.EXPECT().LoadURI(ctx, buildCrashPageURI(uri)).Return(nil).Twice()
+
+	c, reloaded := newCrashRecoveryCoordinator(true)
+	c.onWebProcessTerminated(ctx, "pane-1", wv, port.WebProcessTerminationCrashed, "crashed", uri)
+	c.onWebProcessTerminated(ctx, "pane-1", wv, port.WebProcessTerminationExceededMemory, "memory", uri)
+	// The crash page reloading the page, which crashes again.
+	c.onWebProcessTerminated(ctx, "pane-1", wv, port.WebProcessTerminationCrashed, "crashed", uri)
+
+	assert.Equal(t, []string{uri}, *reloaded, "a page is auto-reloaded only once")
+}
+
+func TestCrashRecovery_TracksCrashesPerURL(t *testing.T) {
+	ctx := context.Background()
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().LoadURI(ctx, "https://a.example/").Return(nil).Once()
+	wv.EXPECT().LoadURI(ctx, "https://b.example/").Return(nil).Once()
+
+	c, reloaded := newCrashRecoveryCoordinator(true)
+	c.onWebProcessTerminated(ctx, "pane-1", wv, port.WebProcessTerminationCrashed, "crashed", "https://a.example/")
+	c.onWebProcessTerminated(ctx, "pane-2", wv, port.WebProcessTerminationCrashed, "crashed", "https://b.example/")
+
+	assert.Equal(t, []string{"https://a.example/", "https://b.example/"}, *reloaded)
+}
+
+func TestCrashRecovery_CrashPageKeepsOriginalURL(t *testing.T) {
+	ctx := context.Background()
+	const uri = "https://heavy.example/app"
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().LoadURI(ctx, uri).Return(nil).Once()
+	wv.EXPECT().LoadURI(ctx, buildCrashPageURI(uri)).Return(nil).Once()
+
+	c, _ := newCrashRecoveryCoordinator(true)
+	c.onWebProcessTerminated(ctx, "pane-1", wv, port.WebProcessTerminationCrashed, "crashed", uri)
+	c.onWebProcessTerminated(ctx, "pane-1", wv, port.WebProcessTerminationCrashed, "crashed", buildCrashPageURI(uri))
+}
+
+func TestCrashRecovery_IgnoresTerminationByAPI(t *testing.T) {
+	wv := mocks.NewMockWebView(t)
+
+	c, reloaded := newCrashRecoveryCoordinator(true)
+	c.onWebProcessTerminated(context.Background(), "pane-1", wv, port.WebProcessTerminationByAPI, "api", "https://a.example/")
+
+	assert.Empty(t, *reloaded)
+	assert.Equal(t, 0, c.crashes.Count("https://a.example/", time.Now()))
+}
@@ -0,0 +1,119 @@
+package content
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// ErrFilteringUnsupported is returned when the engine can't turn content
+// filtering off for a single pane.
+var ErrFilteringUnsupported = errors.New("content filtering can't be toggled with this engine")
+
+// SetFilterExceptionsUC enables per-site content filter exceptions.
+func (c *Coordinator) SetFilterExceptionsUC(uc *usecase.ManageFilterExceptionsUseCase) {
+	c.filterExceptionsUC = uc
+}
+
+func (c *Coordinator) filterBypassApplier() (port.FilterBypassApplier, bool) {
+	if c.filterExceptionsUC == nil || c.filterApplier == nil {
+		return nil, false
+	}
+	applier, ok := c.filterApplier.(port.FilterBypassApplier)
+	return applier, ok
+}
+
+// syncFilterBypass removes or restores the content filters of wv for uri.
+// It runs when a navigation starts and on redirects, so the filters already
+// match the site while its subresources load.
+func (c *Coordinator) syncFilterBypass(ctx context.Context, wv port.WebView, uri string) {
+	c.syncCosmeticBypass(ctx, wv, uri)
+	applier, ok := c.filterBypassApplier()
+	if !ok || wv == nil || wv.IsDestroyed() || uri == "" {
+		return
+	}
+	bypassed := c.filterExceptionsUC.IsBypassed(ctx, uri)
+	if !c.setFilterBypassed(wv.ID(), bypassed) {
+		return
+	}
+	logging.FromContext(ctx).Debug().
+		Uint64("webview_id", uint64(wv.ID())).
+		Bool("bypassed", bypassed).
+		Msg("content filtering toggled for site")
+	applier.SetFilteringBypassed(ctx, wv, bypassed)
+}
+
+// setFilterBypassed records the filter state of a WebView and reports
+// whether it changed.
+func (c *Coordinator) setFilterBypassed(id port.WebViewID, bypassed bool) bool {
+	c.filterBypassMu.Lock()
+	defer c.filterBypassMu.Unlock()
+	if c.filterBypassed[id] == bypassed {
+		return false
+	}
+	if !bypassed {
+		delete(c.filterBypassed, id)
+		return true
+	}
+	if c.filterBypassed == nil {
+		c.filterBypassed = make(map[port.WebViewID]bool)
+	}
+	c.filterBypassed[id] = true
+	return true
+}
+
+func (c *Coordinator) isFilterBypassed(id port.WebViewID) bool {
+	c.filterBypassMu.Lock()
+	defer c.filterBypassMu.Unlock()
+	return c.filterBypassed[id]
+}
+
+// TogglePaneFiltering turns content filtering off for the site loaded in
+// paneID, or back on, and remembers the choice for that site. Every pane on
+// the site follows the new state, and paneID reloads so the page is fetched
+// with or without its blocked resources.
+func (c *Coordinator) TogglePaneFiltering(ctx context.Context, paneID entity.PaneID) (bypassed bool, domain string, err error) {
+	if _, ok := c.filterBypassApplier(); !ok {
+		return false, "", ErrFilteringUnsupported
+	}
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return false, "", usecase.ErrFilterExceptionUnsupportedURL
+	}
+
+	bypassed, domain, err = c.filterExceptionsUC.Toggle(ctx, wv.URI())
+	if err != nil {
+		return bypassed, domain, err
+	}
+
+	c.webViewsMu.RLock()
+	snapshot := make([]port.WebView, 0, len(c.webViews))
+	for _, other := range c.webViews {
+		snapshot = append(snapshot, other)
+	}
+	c.webViewsMu.RUnlock()
+	for _, other := range snapshot {
+		c.syncFilterBypass(ctx, other, other.URI())
+	}
+
+	if err := wv.Reload(ctx); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("reload after filter toggle failed")
+	}
+	return bypassed, domain, nil
+}
+
+// forgetFilterBypass restores the filters of a WebView leaving its pane, so a
+// pooled instance is handed out filtered again.
+func (c *Coordinator) forgetFilterBypass(ctx context.Context, wv port.WebView) {
+	c.forgetCosmeticBypass(ctx, wv)
+	if wv == nil || !c.setFilterBypassed(wv.ID(), false) {
+		return
+	}
+	if applier, ok := c.filterBypassApplier(); ok && !wv.IsDestroyed() {
+		applier.SetFilteringBypassed(ctx, wv, false)
+	}
+}
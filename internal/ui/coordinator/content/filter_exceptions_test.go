@@ -0,0 +1,102 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	repomocks "github.com/bnema/dumber/internal/domain/repository/mocks"
+)
+
+// recordingFilterApplier records filter changes per WebView.
+type recordingFilterApplier struct {
+	applied  []port.WebViewID
+	bypassed map[port.WebViewID][]bool
+}
+
+func (r *recordingFilterApplier) ApplyToAll(_ context.Context, webviews []port.WebView) {
+	for _, wv := range webviews {
+		r.applied = append(r.applied, wv.ID())
+	}
+}
+
+func (r *recordingFilterApplier) SetFilteringBypassed(_ context.Context, wv port.WebView, bypassed bool) {
+	if r.bypassed == nil {
+		r.bypassed = map[port.WebViewID][]bool{}
+	}
+	r.bypassed[wv.ID()] = append(r.bypassed[wv.ID()], bypassed)
+}
+
+func newFilterWebView(t *testing.T, id port.WebViewID, uri *string) *mocks.MockWebView {
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().ID().Return(id).Maybe()
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().URI().RunAndReturn(func() string { return *uri }).Maybe()
+	return wv
+}
+
+func newFilterCoordinator(t *testing.T, webViews map[entity.PaneID]port.WebView) (*Coordinator, *recordingFilterApplier) {
+	repo := repomocks.NewMockContentWhitelistRepository(t)
+	repo.EXPECT().GetAll(mock.Anything).Return(nil, nil).Maybe()
+	repo.EXPECT().Add(mock.Anything, "shop.example").Return(nil).Maybe()
+
+	uc := usecase.NewManageFilterExceptionsUseCase(repo)
+	uc.SetDisabledDomainsProvider(func() []string { return []string{"*.bank.example"} })
+
+	applier := &recordingFilterApplier{}
+	c := &Coordinator{webViews: webViews}
+	c.SetFilterApplier(applier)
+	c.SetFilterExceptionsUC(uc)
+	return c, applier
+}
+
+func TestSyncFilterBypass_OnlyTogglesOnSiteChange(t *testing.T) {
+	ctx := context.Background()
+	uri := "https://news.example/"
+	wv := newFilterWebView(t, 7, &uri)
+	c, applier := newFilterCoordinator(t, map[entity.PaneID]port.WebView{"pane-1": wv})
+
+	c.syncFilterBypass(ctx, wv, "https://news.example/")
+	c.syncFilterBypass(ctx, wv, "https://login.bank.example/")
+	c.syncFilterBypass(ctx, wv, "https://www.bank.example/account")
+	c.syncFilterBypass(ctx, wv, "https://news.example/")
+
+	assert.Equal(t, []bool{true, false}, applier.bypassed[7])
+}
+
+func TestApplyFiltersToAll_SkipsBypassedWebViews(t *testing.T) {
+	ctx := context.Background()
+	bankURI, newsURI := "https://bank.example/", "https://news.example/"
+	bank := newFilterWebView(t, 1, &bankURI)
+	news := newFilterWebView(t, 2, &newsURI)
+	c, applier := newFilterCoordinator(t, map[entity.PaneID]port.WebView{"pane-1": bank, "pane-2": news})
+
+	c.syncFilterBypass(ctx, bank, bankURI)
+	c.ApplyFiltersToAll(ctx)
+
+	assert.Equal(t, []port.WebViewID{2}, applier.applied)
+}
+
+func TestTogglePaneFiltering_BypassesSiteAndReloads(t *testing.T) {
+	ctx := context.Background()
+	uri := "https://shop.example/cart"
+	wv := newFilterWebView(t, 3, &uri)
+	wv.EXPECT().Reload(mock.Anything).Return(nil).Once()
+	c, applier := newFilterCoordinator(t, map[entity.PaneID]port.WebView{"pane-1": wv})
+
+	bypassed, domain, err := c.TogglePaneFiltering(ctx, "pane-1")
+	require.NoError(t, err)
+	assert.True(t, bypassed)
+	assert.Equal(t, "shop.example", domain)
+	assert.Equal(t, []bool{true}, applier.bypassed[3])
+
+	c.forgetFilterBypass(ctx, wv)
+	assert.Equal(t, []bool{true, false}, applier.bypassed[3], "released WebViews are filtered again")
+}
@@ -0,0 +1,249 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/glib"
+)
+
+// FocusTimerPhase is the step a pane's focus timer reached.
+type FocusTimerPhase string
+
+const (
+	// FocusTimerStarted is reported when the timer starts.
+	FocusTimerStarted FocusTimerPhase = "started"
+	// FocusTimerWarning is reported warning_seconds before the end.
+	FocusTimerWarning FocusTimerPhase = "warning"
+	// FocusTimerExpired is reported when the time is up, before the action runs.
+	FocusTimerExpired FocusTimerPhase = "expired"
+	// FocusTimerStopped is reported when the timer is turned off early.
+	FocusTimerStopped FocusTimerPhase = "stopped"
+)
+
+// FocusTimerEvent reports a step of a pane's focus timer.
+type FocusTimerEvent struct {
+	Phase FocusTimerPhase
+	// Remaining is the time left on the timer when it starts, warns or is
+	// stopped; zero once it ran out.
+	Remaining time.Duration
+	// Action is what happens to the pane when the timer runs out.
+	Action entity.FocusTimerAction
+}
+
+// Message describes the event for a toast.
+func (e FocusTimerEvent) Message() string {
+	switch e.Phase {
+	case FocusTimerStarted:
+		return "Focus timer started: " + formatFocusTimerDuration(e.Remaining)
+	case FocusTimerWarning:
+		return formatFocusTimerDuration(e.Remaining) + " left on the focus timer"
+	case FocusTimerStopped:
+		return fmt.Sprintf("Focus timer stopped with %s left", formatFocusTimerDuration(e.Remaining))
+	case FocusTimerExpired:
+		switch e.Action {
+		case entity.FocusTimerClose:
+			return "Focus time is up, closing the pane"
+		case entity.FocusTimerNavigate:
+			return "Focus time is up, leaving the page"
+		default:
+			return "Focus time is up"
+		}
+	default:
+		return ""
+	}
+}
+
+// formatFocusTimerDuration rounds d to whole minutes, or to seconds under a
+// minute.
+func formatFocusTimerDuration(d time.Duration) string {
+	if d >= time.Minute {
+		return fmt.Sprintf("%d min", int(d.Round(time.Minute)/time.Minute))
+	}
+	return fmt.Sprintf("%d s", int(d.Round(time.Second)/time.Second))
+}
+
+// focusTimerState follows the running focus timer of a pane.
+type focusTimerState struct {
+	deadline    time.Time
+	warning     time.Duration
+	action      entity.FocusTimerAction
+	navigateURL string
+	// cancel stops the pending step; seq tells a stale step from the current
+	// one.
+	cancel func()
+	seq    uint64
+}
+
+// scheduleFocusTimer runs fn on the GTK main thread after delay and returns a
+// function that stops it. Tests replace it to run the steps on demand.
+var scheduleFocusTimer = func(delay time.Duration, fn func()) (cancel func()) {
+	timer := time.AfterFunc(delay, func() {
+		cb := glib.SourceFunc(func(_ uintptr) bool {
+			fn()
+			return false
+		})
+		glib.IdleAdd(&cb, 0)
+	})
+	return func() { timer.Stop() }
+}
+
+// SetFocusTimerConfigProvider sets the source of the live focus timer
+// settings, read when a timer starts.
+func (c *Coordinator) SetFocusTimerConfigProvider(fn func() entity.FocusTimerConfig) {
+	c.focusTimerConfigProvider = fn
+}
+
+// SetOnFocusTimer sets the callback run when a focus timer warns or runs
+// out, to show it.
+func (c *Coordinator) SetOnFocusTimer(fn func(ctx context.Context, paneID entity.PaneID, event FocusTimerEvent)) {
+	c.onFocusTimer = fn
+}
+
+// ToggleFocusTimer starts a focus timer on paneID, or stops the one running.
+// The returned event tells which; ok is false when the pane has no page.
+func (c *Coordinator) ToggleFocusTimer(ctx context.Context, paneID entity.PaneID) (FocusTimerEvent, bool) {
+	if wv := c.getWebViewLocked(paneID); wv == nil || wv.IsDestroyed() || c.focusTimerConfigProvider == nil {
+		return FocusTimerEvent{}, false
+	}
+
+	c.focusTimerMu.Lock()
+	defer c.focusTimerMu.Unlock()
+	if state := c.focusTimers[paneID]; state != nil {
+		event := FocusTimerEvent{Phase: FocusTimerStopped, Remaining: time.Until(state.deadline), Action: state.action}
+		c.forgetFocusTimerLocked(paneID)
+		return event, true
+	}
+
+	cfg := c.focusTimerConfigProvider()
+	duration := time.Duration(cfg.DurationMinutes) * time.Minute
+	if duration <= 0 {
+		return FocusTimerEvent{}, false
+	}
+	state := &focusTimerState{
+		deadline:    time.Now().Add(duration),
+		action:      cfg.Action,
+		navigateURL: cfg.NavigateURL,
+	}
+	if warning := time.Duration(cfg.WarningSeconds) * time.Second; warning > 0 && warning < duration {
+		state.warning = warning
+	}
+	if c.focusTimers == nil {
+		c.focusTimers = make(map[entity.PaneID]*focusTimerState)
+	}
+	c.focusTimers[paneID] = state
+	if state.warning > 0 {
+		c.scheduleFocusTimerStepLocked(ctx, paneID, state, duration-state.warning, FocusTimerWarning)
+	} else {
+		c.scheduleFocusTimerStepLocked(ctx, paneID, state, duration, FocusTimerExpired)
+	}
+
+	logging.FromContext(ctx).Debug().
+		Str("pane_id", string(paneID)).
+		Dur("duration", duration).
+		Str("action", string(state.action)).
+		Msg("focus timer started")
+	return FocusTimerEvent{Phase: FocusTimerStarted, Remaining: duration, Action: state.action}, true
+}
+
+// HasFocusTimer reports whether a focus timer runs on paneID.
+func (c *Coordinator) HasFocusTimer(paneID entity.PaneID) bool {
+	c.focusTimerMu.Lock()
+	defer c.focusTimerMu.Unlock()
+	return c.focusTimers[paneID] != nil
+}
+
+func (c *Coordinator) scheduleFocusTimerStepLocked(
+	ctx context.Context,
+	paneID entity.PaneID,
+	state *focusTimerState,
+	delay time.Duration,
+	phase FocusTimerPhase,
+) {
+	state.seq++
+	seq := state.seq
+	state.cancel = scheduleFocusTimer(delay, func() {
+		c.runFocusTimerStep(ctx, paneID, state, seq, phase)
+	})
+}
+
+// runFocusTimerStep warns that the time is nearly up, or ends the timer and
+// runs its action, unless the timer was stopped meanwhile.
+func (c *Coordinator) runFocusTimerStep(
+	ctx context.Context,
+	paneID entity.PaneID,
+	state *focusTimerState,
+	seq uint64,
+	phase FocusTimerPhase,
+) {
+	c.focusTimerMu.Lock()
+	if c.focusTimers[paneID] != state || state.seq != seq {
+		c.focusTimerMu.Unlock()
+		return
+	}
+	event := FocusTimerEvent{Phase: phase, Action: state.action}
+	if phase == FocusTimerWarning {
+		event.Remaining = state.warning
+		c.scheduleFocusTimerStepLocked(ctx, paneID, state, state.warning, FocusTimerExpired)
+	} else {
+		state.cancel = nil
+		delete(c.focusTimers, paneID)
+	}
+	c.focusTimerMu.Unlock()
+
+	logging.FromContext(ctx).Debug().
+		Str("pane_id", string(paneID)).
+		Str("phase", string(phase)).
+		Msg("focus timer step")
+	if c.onFocusTimer != nil {
+		c.onFocusTimer(ctx, paneID, event)
+	}
+	if phase == FocusTimerExpired {
+		c.runFocusTimerAction(ctx, paneID, state)
+	}
+}
+
+// runFocusTimerAction closes the pane or leaves its page when its timer ran
+// out, as configured when the timer started.
+func (c *Coordinator) runFocusTimerAction(ctx context.Context, paneID entity.PaneID, state *focusTimerState) {
+	log := logging.FromContext(ctx)
+	switch state.action {
+	case entity.FocusTimerClose:
+		if c.onClosePane == nil {
+			return
+		}
+		if err := c.onClosePane(ctx, paneID); err != nil {
+			log.Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to close pane after focus timer")
+		}
+	case entity.FocusTimerNavigate:
+		wv := c.getWebViewLocked(paneID)
+		if wv == nil || wv.IsDestroyed() || state.navigateURL == "" {
+			return
+		}
+		if err := wv.LoadURI(ctx, state.navigateURL); err != nil {
+			log.Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to leave page after focus timer")
+		}
+	}
+}
+
+// forgetFocusTimer stops the focus timer of a released pane.
+func (c *Coordinator) forgetFocusTimer(paneID entity.PaneID) {
+	c.focusTimerMu.Lock()
+	defer c.focusTimerMu.Unlock()
+	c.forgetFocusTimerLocked(paneID)
+}
+
+func (c *Coordinator) forgetFocusTimerLocked(paneID entity.PaneID) {
+	state := c.focusTimers[paneID]
+	if state == nil {
+		return
+	}
+	if state.cancel != nil {
+		state.cancel()
+		state.cancel = nil
+	}
+	delete(c.focusTimers, paneID)
+}
@@ -0,0 +1,175 @@
+package content
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// stubFocusTimerScheduler records scheduled focus timer steps instead of
+// waiting.
+func stubFocusTimerScheduler(t *testing.T) *[]*fakeRetryTimer {
+	t.Helper()
+	var timers []*fakeRetryTimer
+	original := scheduleFocusTimer
+	scheduleFocusTimer = func(delay time.Duration, fn func()) func() {
+		timer := &fakeRetryTimer{delay: delay, fn: fn}
+		timers = append(timers, timer)
+		return func() { timer.stopped = true }
+	}
+	t.Cleanup(func() { scheduleFocusTimer = original })
+	return &timers
+}
+
+func newFocusTimerCoordinator(
+	t *testing.T,
+	paneID entity.PaneID,
+	cfg entity.FocusTimerConfig,
+) (*Coordinator, *mocks.MockWebView, *[]FocusTimerEvent) {
+	t.Helper()
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	c := &Coordinator{webViews: map[entity.PaneID]port.WebView{paneID: wv}}
+	c.SetFocusTimerConfigProvider(func() entity.FocusTimerConfig { return cfg })
+	var events []FocusTimerEvent
+	c.SetOnFocusTimer(func(_ context.Context, _ entity.PaneID, event FocusTimerEvent) {
+		events = append(events, event)
+	})
+	return c, wv, &events
+}
+
+func TestFocusTimer_WarnsThenExpires(t *testing.T) {
+	timers := stubFocusTimerScheduler(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	c, _, events := newFocusTimerCoordinator(t, paneID, entity.FocusTimerConfig{
+		DurationMinutes: 25, WarningSeconds: 60, Action: entity.FocusTimerWarn,
+	})
+
+	started, ok := c.ToggleFocusTimer(ctx, paneID)
+	require.True(t, ok)
+	assert.Equal(t, FocusTimerEvent{Phase: FocusTimerStarted, Remaining: 25 * time.Minute, Action: entity.FocusTimerWarn}, started)
+	assert.Equal(t, "Focus timer started: 25 min", started.Message())
+	require.Len(t, *timers, 1)
+	assert.Equal(t, 24*time.Minute, (*timers)[0].delay, "the warning comes warning_seconds before the end")
+
+	(*timers)[0].fn()
+	require.Len(t, *timers, 2)
+	assert.Equal(t, time.Minute, (*timers)[1].delay)
+	require.Len(t, *events, 1)
+	assert.Equal(t, FocusTimerEvent{Phase: FocusTimerWarning, Remaining: time.Minute, Action: entity.FocusTimerWarn}, (*events)[0])
+	assert.True(t, c.HasFocusTimer(paneID))
+
+	(*timers)[1].fn()
+	require.Len(t, *events, 2)
+	assert.Equal(t, FocusTimerExpired, (*events)[1].Phase)
+	assert.Equal(t, "Focus time is up", (*events)[1].Message())
+	assert.False(t, c.HasFocusTimer(paneID), "an expired timer is forgotten")
+}
+
+func TestFocusTimer_SkipsWarningNotShorterThanDuration(t *testing.T) {
+	timers := stubFocusTimerScheduler(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	c, _, _ := newFocusTimerCoordinator(t, paneID, entity.FocusTimerConfig{
+		DurationMinutes: 1, WarningSeconds: 60, Action: entity.FocusTimerWarn,
+	})
+
+	_, ok := c.ToggleFocusTimer(ctx, paneID)
+	require.True(t, ok)
+	require.Len(t, *timers, 1)
+	assert.Equal(t, time.Minute, (*timers)[0].delay, "only the expiry is scheduled")
+}
+
+func TestFocusTimer_ToggleAndReleaseStopTimer(t *testing.T) {
+	timers := stubFocusTimerScheduler(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	c, _, events := newFocusTimerCoordinator(t, paneID, entity.FocusTimerConfig{
+		DurationMinutes: 25, WarningSeconds: 60, Action: entity.FocusTimerClose,
+	})
+	closed := 0
+	c.onClosePane = func(context.Context, entity.PaneID) error {
+		closed++
+		return nil
+	}
+
+	_, ok := c.ToggleFocusTimer(ctx, paneID)
+	require.True(t, ok)
+	stopped, ok := c.ToggleFocusTimer(ctx, paneID)
+	require.True(t, ok)
+	assert.Equal(t, FocusTimerStopped, stopped.Phase)
+	assert.True(t, (*timers)[0].stopped, "toggling again stops the pending step")
+	assert.False(t, c.HasFocusTimer(paneID))
+
+	(*timers)[0].fn()
+	assert.Empty(t, *events, "a stale step does nothing")
+	assert.Zero(t, closed)
+
+	_, ok = c.ToggleFocusTimer(ctx, paneID)
+	require.True(t, ok)
+	c.forgetFocusTimer(paneID)
+	assert.True(t, (*timers)[1].stopped, "releasing the pane stops its timer")
+	assert.Empty(t, c.focusTimers)
+}
+
+func TestFocusTimer_NoPage(t *testing.T) {
+	stubFocusTimerScheduler(t)
+	c, _, _ := newFocusTimerCoordinator(t, "pane-1", entity.FocusTimerConfig{DurationMinutes: 25})
+
+	_, ok := c.ToggleFocusTimer(context.Background(), "pane-2")
+	assert.False(t, ok)
+	assert.Empty(t, c.focusTimers)
+}
+
+func TestFocusTimer_ExpiryDispatchesAction(t *testing.T) {
+	const homeURL = "dumb://home"
+	tests := []struct {
+		action    entity.FocusTimerAction
+		wantClose bool
+		wantLoad  bool
+		message   string
+	}{
+		{action: entity.FocusTimerWarn, message: "Focus time is up"},
+		{action: entity.FocusTimerClose, wantClose: true, message: "Focus time is up, closing the pane"},
+		{action: entity.FocusTimerNavigate, wantLoad: true, message: "Focus time is up, leaving the page"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			timers := stubFocusTimerScheduler(t)
+			ctx := context.Background()
+			paneID := entity.PaneID("pane-1")
+			c, wv, events := newFocusTimerCoordinator(t, paneID, entity.FocusTimerConfig{
+				DurationMinutes: 5, Action: tt.action, NavigateURL: homeURL,
+			})
+			var closedPanes []entity.PaneID
+			c.SetOnClosePane(func(_ context.Context, id entity.PaneID) error {
+				closedPanes = append(closedPanes, id)
+				return nil
+			})
+			if tt.wantLoad {
+				wv.EXPECT().LoadURI(ctx, homeURL).Return(nil).Once()
+			}
+
+			_, ok := c.ToggleFocusTimer(ctx, paneID)
+			require.True(t, ok)
+			require.Len(t, *timers, 1)
+			(*timers)[0].fn()
+
+			require.Len(t, *events, 1)
+			assert.Equal(t, tt.message, (*events)[0].Message())
+			if tt.wantClose {
+				assert.Equal(t, []entity.PaneID{paneID}, closedPanes)
+			} else {
+				assert.Empty(t, closedPanes)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// resolveFontOverride returns the fonts configured for rawURL, zero when no
+// pattern matches. The most specific pattern wins.
+func resolveFontOverride(overrides []entity.FontOverride, rawURL string) entity.FontOverride {
+	domain := forceDarkDomain(rawURL)
+	if domain == "" || len(overrides) == 0 {
+		return entity.FontOverride{}
+	}
+	patterns := make([]string, 0, len(overrides))
+	for _, fonts := range overrides {
+		patterns = append(patterns, fonts.Domain)
+	}
+	pattern, ok := urlutil.BestDomainPatternMatch(patterns, domain)
+	if !ok {
+		return entity.FontOverride{}
+	}
+	for _, fonts := range overrides {
+		if fonts.Domain == pattern {
+			return fonts
+		}
+	}
+	return entity.FontOverride{}
+}
+
+// applyFontOverride switches the pane's fonts to the ones configured for uri,
+// or back to the appearance fonts. The engine lays the page out again, so the
+// committed page picks them up without a reload.
+func (c *Coordinator) applyFontOverride(ctx context.Context, wv port.WebView, uri string) {
+	if wv == nil || wv.IsDestroyed() {
+		return
+	}
+	capable, ok := wv.(port.FontOverrideCapable)
+	if !ok {
+		return
+	}
+	var cfg entity.AppearanceConfig
+	if c.appearanceConfigProvider != nil {
+		cfg = c.appearanceConfigProvider()
+	}
+	fonts := resolveFontOverride(cfg.FontOverrides, uri)
+	if capable.ApplyFontOverride(ctx, fonts) {
+		logging.FromContext(ctx).Debug().
+			Str("uri", uri).
+			Str("pattern", fonts.Domain).
+			Msg("font override applied")
+	}
+}
@@ -0,0 +1,89 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type fontOverrideWebView struct {
+	*mocks.MockWebView
+	current entity.FontOverride
+	applied []entity.FontOverride
+}
+
+func (w *fontOverrideWebView) ApplyFontOverride(_ context.Context, fonts entity.FontOverride) bool {
+	if fonts == w.current {
+		return false
+	}
+	w.current = fonts
+	w.applied = append(w.applied, fonts)
+	return true
+}
+
+func TestResolveFontOverride(t *testing.T) {
+	t.Parallel()
+
+	wide := entity.FontOverride{Domain: "*.example.com", SansFont: "Inter", DefaultFontSize: 18}
+	docs := entity.FontOverride{Domain: "docs.example.com", MonospaceFont: "JetBrains Mono"}
+	overrides := []entity.FontOverride{wide, docs}
+
+	tests := []struct {
+		name string
+		uri  string
+		want entity.FontOverride
+	}{
+		{name: "wildcard apex", uri: "https://example.com/", want: wide},
+		{name: "wildcard subdomain", uri: "https://blog.example.com/post", want: wide},
+		{name: "most specific pattern wins", uri: "https://docs.example.com/api", want: docs},
+		{name: "www prefix", uri: "http://www.example.com/", want: wide},
+		{name: "unlisted site", uri: "https://other.test/"},
+		{name: "internal page", uri: "dumb://home"},
+		{name: "blank page", uri: "about:blank"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, resolveFontOverride(overrides, tt.uri))
+		})
+	}
+	assert.Equal(t, entity.FontOverride{}, resolveFontOverride(nil, "https://example.com/"))
+}
+
+func TestApplyFontOverride_FollowsNavigation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &fontOverrideWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+
+	sans := entity.FontOverride{Domain: "example.com", SansFont: "Inter"}
+	c := &Coordinator{}
+	c.SetAppearanceConfigProvider(func() entity.AppearanceConfig {
+		return entity.AppearanceConfig{FontOverrides: []entity.FontOverride{sans}}
+	})
+
+	c.applyFontOverride(ctx, wv, "https://example.com/a")
+	c.applyFontOverride(ctx, wv, "https://example.com/b")
+	c.applyFontOverride(ctx, wv, "https://other.test/")
+
+	assert.Equal(t, []entity.FontOverride{sans, {}}, wv.applied,
+		"the override is applied once per site and dropped when leaving it")
+}
+
+func TestApplyFontOverride_IgnoresIncapableWebView(t *testing.T) {
+	t.Parallel()
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false)
+
+	c := &Coordinator{}
+	c.SetAppearanceConfigProvider(func() entity.AppearanceConfig {
+		return entity.AppearanceConfig{FontOverrides: []entity.FontOverride{{Domain: "example.com", SansFont: "Inter"}}}
+	})
+	assert.NotPanics(t, func() { c.applyFontOverride(context.Background(), wv, "https://example.com/") })
+}
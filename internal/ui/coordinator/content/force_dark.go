@@ -0,0 +1,122 @@
+package content
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	urlutil "github.com/bnema/dumber/internal/domain/url"
+)
+
+// forceDarkOverride is a per-pane force dark choice for one site. It lapses
+// once the pane navigates to another site.
+type forceDarkOverride struct {
+	domain  string
+	enabled bool
+}
+
+// SetAppearanceConfigProvider sets the source of the live appearance config
+// used to resolve forced dark mode and font overrides on navigation.
+func (c *Coordinator) SetAppearanceConfigProvider(fn func() entity.AppearanceConfig) {
+	c.appearanceConfigProvider = fn
+}
+
+// forceDarkDomain returns the site key of rawURL for forced dark mode, or ""
+// when the page can't be darkened (internal pages, files, blank pages).
+func forceDarkDomain(rawURL string) string {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		return urlutil.DisplayDomain(parsed.Host)
+	default:
+		return ""
+	}
+}
+
+// resolveForceDark reports whether rawURL should be darkened. A pane override
+// for the same site wins over the configured domain patterns.
+func resolveForceDark(domains []string, rawURL string, override *forceDarkOverride) bool {
+	domain := forceDarkDomain(rawURL)
+	if domain == "" {
+		return false
+	}
+	if override != nil && override.domain == domain {
+		return override.enabled
+	}
+	return urlutil.MatchAnyDomainPattern(domains, domain)
+}
+
+// forceDarkState resolves forced dark mode for paneID on uri, dropping an
+// override left over from another site.
+func (c *Coordinator) forceDarkState(paneID entity.PaneID, uri string) (entity.ForceDarkStyle, bool) {
+	var cfg entity.AppearanceConfig
+	if c.appearanceConfigProvider != nil {
+		cfg = c.appearanceConfigProvider()
+	}
+
+	c.appearanceMu.Lock()
+	defer c.appearanceMu.Unlock()
+	override, ok := c.forceDarkOverrides[paneID]
+	if ok && override.domain != forceDarkDomain(uri) {
+		delete(c.forceDarkOverrides, paneID)
+		ok = false
+	}
+	var current *forceDarkOverride
+	if ok {
+		current = &override
+	}
+	return cfg.ForceDarkStyle, resolveForceDark(cfg.ForceDarkDomains, uri, current)
+}
+
+// applyForceDark darkens or restores the page of paneID after a navigation.
+func (c *Coordinator) applyForceDark(ctx context.Context, paneID entity.PaneID, wv port.WebView, uri string) {
+	if wv == nil || wv.IsDestroyed() {
+		return
+	}
+	capable, ok := wv.(port.ForceDarkCapable)
+	if !ok {
+		return
+	}
+	style, enabled := c.forceDarkState(paneID, uri)
+	capable.ApplyForceDark(ctx, style, enabled)
+}
+
+// TogglePaneForceDark flips forced dark mode for the site loaded in paneID.
+// It returns the new state and the site it applies to; ok is false when the
+// pane's page can't be darkened.
+func (c *Coordinator) TogglePaneForceDark(ctx context.Context, paneID entity.PaneID) (enabled bool, domain string, ok bool) {
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return false, "", false
+	}
+	capable, capableOK := wv.(port.ForceDarkCapable)
+	uri := wv.URI()
+	domain = forceDarkDomain(uri)
+	if !capableOK || domain == "" {
+		return false, "", false
+	}
+
+	style, current := c.forceDarkState(paneID, uri)
+	enabled = !current
+	c.appearanceMu.Lock()
+	if c.forceDarkOverrides == nil {
+		c.forceDarkOverrides = make(map[entity.PaneID]forceDarkOverride)
+	}
+	c.forceDarkOverrides[paneID] = forceDarkOverride{domain: domain, enabled: enabled}
+	c.appearanceMu.Unlock()
+
+	capable.ApplyForceDark(ctx, style, enabled)
+	return enabled, domain, true
+}
+
+// forgetForceDark drops the force dark override of a released pane.
+func (c *Coordinator) forgetForceDark(paneID entity.PaneID) {
+	c.appearanceMu.Lock()
+	delete(c.forceDarkOverrides, paneID)
+	c.appearanceMu.Unlock()
+}
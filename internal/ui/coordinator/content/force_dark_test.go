@@ -0,0 +1,91 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type forceDarkWebView struct {
+	*mocks.MockWebView
+	applied []bool
+}
+
+func (w *forceDarkWebView) ApplyForceDark(_ context.Context, _ entity.ForceDarkStyle, enabled bool) {
+	w.applied = append(w.applied, enabled)
+}
+
+func TestResolveForceDark(t *testing.T) {
+	t.Parallel()
+
+	domains := []string{"example.com", "*.docs.example.org"}
+	tests := []struct {
+		name     string
+		uri      string
+		override *forceDarkOverride
+		want     bool
+	}{
+		{name: "exact domain", uri: "https://example.com/page", want: true},
+		{name: "www prefix", uri: "https://www.example.com/", want: true},
+		{name: "exact pattern skips subdomains", uri: "https://mail.example.com/", want: false},
+		{name: "wildcard subdomain", uri: "https://api.docs.example.org/x", want: true},
+		{name: "wildcard apex", uri: "http://docs.example.org/", want: true},
+		{name: "unlisted site", uri: "https://other.test/", want: false},
+		{name: "internal page", uri: "dumb://home", want: false},
+		{name: "blank page", uri: "about:blank", want: false},
+		{
+			name:     "override enables unlisted site",
+			uri:      "https://other.test/",
+			override: &forceDarkOverride{domain: "other.test", enabled: true},
+			want:     true,
+		},
+		{
+			name:     "override disables listed site",
+			uri:      "https://example.com/",
+			override: &forceDarkOverride{domain: "example.com", enabled: false},
+			want:     false,
+		},
+		{
+			name:     "override for another site is ignored",
+			uri:      "https://example.com/",
+			override: &forceDarkOverride{domain: "other.test", enabled: false},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, resolveForceDark(domains, tt.uri, tt.override))
+		})
+	}
+}
+
+func TestTogglePaneForceDark_OverrideLastsUntilSiteChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &forceDarkWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+	wv.EXPECT().URI().Return("https://other.test/article")
+
+	c := &Coordinator{webViews: map[entity.PaneID]port.WebView{"pane-1": wv}}
+	c.SetAppearanceConfigProvider(func() entity.AppearanceConfig {
+		return entity.AppearanceConfig{ForceDarkDomains: []string{"example.com"}}
+	})
+
+	enabled, domain, ok := c.TogglePaneForceDark(ctx, "pane-1")
+	assert.True(t, ok)
+	assert.True(t, enabled)
+	assert.Equal(t, "other.test", domain)
+
+	c.applyForceDark(ctx, "pane-1", wv, "https://other.test/next")
+	c.applyForceDark(ctx, "pane-1", wv, "https://elsewhere.test/")
+	c.applyForceDark(ctx, "pane-1", wv, "https://other.test/again")
+
+	assert.Equal(t, []bool{true, true, false, false}, wv.applied)
+}
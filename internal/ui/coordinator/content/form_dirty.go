@@ -0,0 +1,73 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetFormDirty records whether the page of webViewID has form fields edited
+// since it loaded, as reported by the page's form tracker.
+func (c *Coordinator) SetFormDirty(webViewID port.WebViewID, dirty bool) {
+	paneID, ok := c.findPaneByWebViewID(webViewID)
+	if !ok {
+		return
+	}
+	c.formDirtyMu.Lock()
+	defer c.formDirtyMu.Unlock()
+	if !dirty {
+		delete(c.dirtyForms, paneID)
+		return
+	}
+	if c.dirtyForms == nil {
+		c.dirtyForms = make(map[entity.PaneID]bool)
+	}
+	c.dirtyForms[paneID] = true
+}
+
+// PaneHasUnsavedForm reports whether the page of paneID has edited form
+// fields.
+func (c *Coordinator) PaneHasUnsavedForm(paneID entity.PaneID) bool {
+	c.formDirtyMu.Lock()
+	defer c.formDirtyMu.Unlock()
+	return c.dirtyForms[paneID]
+}
+
+func (c *Coordinator) forgetFormDirty(paneID entity.PaneID) {
+	c.formDirtyMu.Lock()
+	defer c.formDirtyMu.Unlock()
+	delete(c.dirtyForms, paneID)
+}
+
+// ConfirmPaneClose asks whether to close paneID when the config enables it
+// and the pane's page has edited form fields. It reports true when it took
+// over the close: closePane then runs only if the user chooses to leave.
+// The question is asked like a beforeunload dialog, without blocking.
+func (c *Coordinator) ConfirmPaneClose(ctx context.Context, paneID entity.PaneID, closePane func()) bool {
+	if c.inputConfigProvider == nil || !c.inputConfigProvider().ConfirmCloseUnsavedForms {
+		return false
+	}
+	if !c.PaneHasUnsavedForm(paneID) || c.onScriptDialog == nil {
+		return false
+	}
+
+	uri := ""
+	if wv := c.getWebViewLocked(paneID); wv != nil && !wv.IsDestroyed() {
+		uri = wv.URI()
+	}
+	request := port.ScriptDialogRequest{
+		Type: entity.ScriptDialogBeforeUnload,
+		URI:  uri,
+		Respond: func(response entity.ScriptDialogResponse) {
+			if !response.Confirmed {
+				logging.FromContext(ctx).Debug().Str("pane_id", string(paneID)).Msg("pane close canceled: unsaved form")
+				return
+			}
+			c.forgetFormDirty(paneID)
+			closePane()
+		},
+	}
+	return c.onScriptDialog(ctx, paneID, request)
+}
@@ -0,0 +1,100 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+func newFormDirtyCoordinator(t *testing.T, confirm bool) (*Coordinator, *[]port.ScriptDialogRequest) {
+	t.Helper()
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().URI().Return("https://forms.example/new").Maybe()
+	wv.EXPECT().ID().Return(11).Maybe()
+
+	c := &Coordinator{
+		webViews:       map[entity.PaneID]port.WebView{"pane-1": wv},
+		webViewPaneIDs: map[port.WebViewID]entity.PaneID{11: "pane-1"},
+	}
+	c.SetInputConfigProvider(func() entity.RuntimeInputConfig {
+		return entity.RuntimeInputConfig{ConfirmCloseUnsavedForms: confirm}
+	})
+	var dialogs []port.ScriptDialogRequest
+	c.SetOnScriptDialog(func(_ context.Context, _ entity.PaneID, request port.ScriptDialogRequest) bool {
+		dialogs = append(dialogs, request)
+		return true
+	})
+	return c, &dialogs
+}
+
+func TestSetFormDirty_TracksPageReports(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newFormDirtyCoordinator(t, true)
+	assert.False(t, c.PaneHasUnsavedForm("pane-1"))
+
+	c.SetFormDirty(11, true)
+	assert.True(t, c.PaneHasUnsavedForm("pane-1"))
+
+	c.SetFormDirty(11, false)
+	assert.False(t, c.PaneHasUnsavedForm("pane-1"), "a page reporting clean fields clears the state")
+
+	c.SetFormDirty(11, true)
+	c.forgetFormDirty("pane-1")
+	assert.False(t, c.PaneHasUnsavedForm("pane-1"), "a new document clears the state")
+
+	c.SetFormDirty(99, true)
+	assert.False(t, c.PaneHasUnsavedForm("pane-1"), "reports from unknown webviews are ignored")
+}
+
+func TestConfirmPaneClose_PromptsOnlyForDirtyFormsWhenEnabled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	closed := 0
+	closePane := func() { closed++ }
+
+	disabled, dialogs := newFormDirtyCoordinator(t, false)
+	disabled.SetFormDirty(11, true)
+	assert.False(t, disabled.ConfirmPaneClose(ctx, "pane-1", closePane), "disabled config closes at once")
+	assert.Empty(t, *dialogs)
+
+	clean, dialogs := newFormDirtyCoordinator(t, true)
+	assert.False(t, clean.ConfirmPaneClose(ctx, "pane-1", closePane), "clean panes close at once")
+	assert.Empty(t, *dialogs)
+
+	dirty, dialogs := newFormDirtyCoordinator(t, true)
+	dirty.SetFormDirty(11, true)
+	require.True(t, dirty.ConfirmPaneClose(ctx, "pane-1", closePane))
+	require.Len(t, *dialogs, 1)
+	request := (*dialogs)[0]
+	assert.Equal(t, entity.ScriptDialogBeforeUnload, request.Type)
+	assert.Equal(t, "https://forms.example/new", request.URI)
+	assert.Zero(t, closed, "the pane stays open until the user answers")
+
+	request.Respond(entity.ScriptDialogResponse{Confirmed: false})
+	assert.Zero(t, closed, "choosing Stay keeps the pane")
+	assert.True(t, dirty.PaneHasUnsavedForm("pane-1"))
+
+	require.True(t, dirty.ConfirmPaneClose(ctx, "pane-1", closePane))
+	(*dialogs)[1].Respond(entity.ScriptDialogResponse{Confirmed: true})
+	assert.Equal(t, 1, closed, "choosing Leave closes the pane")
+	assert.False(t, dirty.PaneHasUnsavedForm("pane-1"))
+}
+
+func TestConfirmPaneClose_ClosesWhenNoDialogCanBeShown(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newFormDirtyCoordinator(t, true)
+	c.SetFormDirty(11, true)
+	c.SetOnScriptDialog(func(context.Context, entity.PaneID, port.ScriptDialogRequest) bool { return false })
+
+	assert.False(t, c.ConfirmPaneClose(context.Background(), "pane-1", func() {}))
+}
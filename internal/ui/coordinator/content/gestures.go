@@ -0,0 +1,38 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// applyNavigationGestures turns the swipe back/forward gestures off on sites
+// listed in input.disable_gestures_domains, and back on elsewhere.
+func (c *Coordinator) applyNavigationGestures(ctx context.Context, wv port.WebView, uri string) {
+	if c.inputConfigProvider == nil || wv == nil || wv.IsDestroyed() {
+		return
+	}
+	capable, ok := wv.(port.NavigationGesturesCapable)
+	if !ok {
+		return
+	}
+	enabled := usecase.NavigationGesturesEnabled(c.inputConfigProvider(), uri)
+	if capable.SetNavigationGesturesEnabled(ctx, enabled) {
+		logging.FromContext(ctx).Debug().
+			Str("uri", uri).
+			Bool("enabled", enabled).
+			Msg("navigation gestures toggled")
+	}
+}
+
+// navigationGestureAllowed reports whether a swipe gesture reported by wv may
+// go back or forward. Engines that leave swipe navigation to dumber, such as
+// CEF, report gestures whatever the site, so they are filtered here.
+func (c *Coordinator) navigationGestureAllowed(wv port.WebView) bool {
+	if c.inputConfigProvider == nil {
+		return true
+	}
+	return usecase.NavigationGesturesEnabled(c.inputConfigProvider(), wv.URI())
+}
@@ -0,0 +1,97 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type gesturesWebView struct {
+	*mocks.MockWebView
+	enabled bool
+	changes []bool
+}
+
+func (w *gesturesWebView) SetNavigationGesturesEnabled(_ context.Context, enabled bool) bool {
+	if enabled == w.enabled {
+		return false
+	}
+	w.enabled = enabled
+	w.changes = append(w.changes, enabled)
+	return true
+}
+
+func TestApplyNavigationGestures_FollowsNavigation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &gesturesWebView{MockWebView: mocks.NewMockWebView(t), enabled: true}
+	wv.EXPECT().IsDestroyed().Return(false)
+
+	c := &Coordinator{}
+	c.SetInputConfigProvider(func() entity.RuntimeInputConfig {
+		return entity.RuntimeInputConfig{DisableGesturesDomains: []string{"*.draw.example"}}
+	})
+
+	c.applyNavigationGestures(ctx, wv, "https://example.com/")
+	c.applyNavigationGestures(ctx, wv, "https://app.draw.example/board/1")
+	c.applyNavigationGestures(ctx, wv, "https://draw.example/board/2")
+	c.applyNavigationGestures(ctx, wv, "https://example.com/")
+
+	assert.Equal(t, []bool{false, true}, wv.changes,
+		"gestures go off on a listed site and come back when leaving it")
+}
+
+func TestApplyNavigationGestures_IgnoresIncapableWebView(t *testing.T) {
+	t.Parallel()
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false)
+
+	c := &Coordinator{}
+	c.SetInputConfigProvider(func() entity.RuntimeInputConfig {
+		return entity.RuntimeInputConfig{DisableGesturesDomains: []string{"draw.example"}}
+	})
+	assert.NotPanics(t, func() { c.applyNavigationGestures(context.Background(), wv, "https://draw.example/") })
+}
+
+func TestTouchpadNavigationGesture_FilteredOnListedSites(t *testing.T) {
+	t.Parallel()
+
+	paneID := entity.PaneID("pane-1")
+	uri := "https://app.draw.example/board/1"
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().ID().Return(port.WebViewID(101)).Maybe()
+	wv.EXPECT().Generation().Return(uint64(1)).Maybe()
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().URI().RunAndReturn(func() string { return uri })
+	var callbacks *port.WebViewCallbacks
+	wv.EXPECT().SetCallbacks(mock.Anything).RunAndReturn(func(value *port.WebViewCallbacks) {
+		callbacks = value
+	}).Once()
+
+	c := &Coordinator{}
+	c.SetInputConfigProvider(func() entity.RuntimeInputConfig {
+		return entity.RuntimeInputConfig{DisableGesturesDomains: []string{"*.draw.example"}}
+	})
+	var forwarded []entity.TouchpadNavigationGesture
+	c.SetOnTouchpadNavigationGesture(func(_ entity.PaneID, gesture entity.TouchpadNavigationGesture) {
+		forwarded = append(forwarded, gesture)
+	})
+	c.setupWebViewCallbacks(context.Background(), paneID, wv)
+
+	// CEF reports swipes to dumber instead of navigating itself.
+	gesture := entity.TouchpadNavigationGesture{Action: entity.TouchpadNavigationBack, Progress: 1, ThresholdReached: true}
+	callbacks.OnTouchpadNavigationGesture(gesture)
+	assert.Empty(t, forwarded, "swipes on a listed site do not navigate")
+
+	uri = "https://example.com/"
+	callbacks.OnTouchpadNavigationGesture(gesture)
+	assert.Equal(t, []entity.TouchpadNavigationGesture{gesture}, forwarded)
+}
@@ -0,0 +1,142 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/httpsupgrade"
+	"github.com/bnema/dumber/internal/domain/loadretry"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/glib"
+)
+
+// HTTPSUpgradeEvent reports a page of Host loaded over http:// or blocked by
+// HTTPS-only mode.
+type HTTPSUpgradeEvent struct {
+	Host string
+	// Action is httpsupgrade.ActionFallback when HTTPS just failed and the
+	// page falls back to http://, ActionAllowInsecure when the host was
+	// allowed over http:// earlier, and ActionBlock when strict mode
+	// refused the page.
+	Action httpsupgrade.Action
+}
+
+// SetHTTPSUpgradeUC enables privacy.https_only on main-frame loads.
+func (c *Coordinator) SetHTTPSUpgradeUC(uc *usecase.HTTPSUpgradeUseCase) {
+	c.httpsUpgradeUC = uc
+}
+
+// SetOnHTTPSUpgrade sets the callback run when a page is loaded over
+// http:// or blocked by HTTPS-only mode, to warn about it.
+func (c *Coordinator) SetOnHTTPSUpgrade(fn func(ctx context.Context, paneID entity.PaneID, event HTTPSUpgradeEvent)) {
+	c.onHTTPSUpgrade = fn
+}
+
+// scheduleUpgradedLoad runs fn on the GTK main loop, once the engine is done
+// with the navigation it was told to cancel.
+var scheduleUpgradedLoad = func(fn func()) {
+	cb := glib.SourceFunc(func(_ uintptr) bool {
+		fn()
+		return false
+	})
+	glib.IdleAdd(&cb, 0)
+}
+
+// onNavigationRequest applies HTTPS-only mode to the main-frame navigation
+// of paneID to uri, before its request is sent. Navigations that did not go
+// through the navigation URL rewriter, such as followed links and redirects,
+// are cancelled and replaced by their https:// version here. It reports
+// whether the navigation is cancelled.
+func (c *Coordinator) onNavigationRequest(ctx context.Context, paneID entity.PaneID, wv port.WebView, uri string) bool {
+	if c.httpsUpgradeUC == nil {
+		return false
+	}
+	decision := c.httpsUpgradeUC.Navigate(uri)
+	switch decision.Action {
+	case httpsupgrade.ActionUpgrade:
+		logging.FromContext(ctx).Debug().
+			Str("pane_id", string(paneID)).
+			Str("host", decision.Host).
+			Msg("upgrading navigation to https")
+		upgraded := decision.URL
+		scheduleUpgradedLoad(func() {
+			if wv.IsDestroyed() {
+				return
+			}
+			if err := wv.LoadURI(ctx, upgraded); err != nil {
+				logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to load upgraded URL")
+			}
+		})
+		return true
+	case httpsupgrade.ActionBlock:
+		c.notifyHTTPSUpgrade(ctx, paneID, decision)
+		return true
+	case httpsupgrade.ActionAllowInsecure:
+		c.notifyHTTPSUpgrade(ctx, paneID, decision)
+	}
+	return false
+}
+
+// onHTTPSLoadFailed handles the failure of an upgraded load. It reports
+// true when the page falls back to http://, so the failure is not retried.
+func (c *Coordinator) onHTTPSLoadFailed(ctx context.Context, paneID entity.PaneID, wv port.WebView, failure port.LoadFailure) bool {
+	if c.httpsUpgradeUC == nil {
+		return false
+	}
+	if failure.Cause == loadretry.CauseCancelled {
+		c.httpsUpgradeUC.Settled(failure.URI)
+		return false
+	}
+	decision := c.httpsUpgradeUC.Failed(failure.URI)
+	switch decision.Action {
+	case httpsupgrade.ActionFallback:
+		logging.FromContext(ctx).Info().
+			Str("pane_id", string(paneID)).
+			Str("host", decision.Host).
+			Str("cause", string(failure.Cause)).
+			Msg("https failed, falling back to http")
+		c.notifyHTTPSUpgrade(ctx, paneID, decision)
+		if err := wv.LoadURI(ctx, decision.URL); err != nil {
+			logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to load http fallback")
+		}
+		return true
+	case httpsupgrade.ActionBlock:
+		c.notifyHTTPSUpgrade(ctx, paneID, decision)
+	}
+	return false
+}
+
+// settleHTTPSUpgrade records that the load of wv committed.
+func (c *Coordinator) settleHTTPSUpgrade(wv port.WebView) {
+	if c.httpsUpgradeUC != nil {
+		c.httpsUpgradeUC.Settled(wv.URI())
+	}
+}
+
+// notifyHTTPSUpgrade reports decision, warning about a host allowed over
+// http:// only once in a row per pane.
+func (c *Coordinator) notifyHTTPSUpgrade(ctx context.Context, paneID entity.PaneID, decision httpsupgrade.Decision) {
+	c.httpsWarnMu.Lock()
+	if decision.Action == httpsupgrade.ActionAllowInsecure && c.httpsWarned[paneID] == decision.Host {
+		c.httpsWarnMu.Unlock()
+		return
+	}
+	if c.httpsWarned == nil {
+		c.httpsWarned = make(map[entity.PaneID]string)
+	}
+	c.httpsWarned[paneID] = decision.Host
+	c.httpsWarnMu.Unlock()
+
+	if c.onHTTPSUpgrade != nil {
+		c.onHTTPSUpgrade(ctx, paneID, HTTPSUpgradeEvent{Host: decision.Host, Action: decision.Action})
+	}
+}
+
+// forgetHTTPSWarning drops the warning state of a released pane.
+func (c *Coordinator) forgetHTTPSWarning(paneID entity.PaneID) {
+	c.httpsWarnMu.Lock()
+	defer c.httpsWarnMu.Unlock()
+	delete(c.httpsWarned, paneID)
+}
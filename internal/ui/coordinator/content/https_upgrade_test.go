@@ -0,0 +1,126 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/httpsupgrade"
+	"github.com/bnema/dumber/internal/domain/loadretry"
+)
+
+func newHTTPSUpgradeCoordinator(mode entity.HTTPSOnlyMode) (*Coordinator, *[]HTTPSUpgradeEvent) {
+	c := &Coordinator{}
+	c.SetHTTPSUpgradeUC(usecase.NewHTTPSUpgradeUseCase(func() entity.HTTPSOnlyMode { return mode }))
+	var events []HTTPSUpgradeEvent
+	c.SetOnHTTPSUpgrade(func(_ context.Context, _ entity.PaneID, event HTTPSUpgradeEvent) {
+		events = append(events, event)
+	})
+	return c, &events
+}
+
+// laggingWebView reports the page being left as its URI while the next
+// navigation is requested, as CEF does until that navigation commits.
+type laggingWebView struct {
+	*mocks.MockWebView
+	current string
+	loaded  []string
+}
+
+func (w *laggingWebView) URI() string { return w.current }
+
+func (w *laggingWebView) IsDestroyed() bool { return false }
+
+func (w *laggingWebView) LoadURI(_ context.Context, uri string) error {
+	w.loaded = append(w.loaded, uri)
+	return nil
+}
+
+func runUpgradedLoadsNow(t *testing.T) {
+	t.Helper()
+	orig := scheduleUpgradedLoad
+	scheduleUpgradedLoad = func(fn func()) { fn() }
+	t.Cleanup(func() { scheduleUpgradedLoad = orig })
+}
+
+func TestHTTPSUpgrade_DecidesOnRequestURLNotCurrentPage(t *testing.T) {
+	runUpgradedLoadsNow(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	c, events := newHTTPSUpgradeCoordinator(entity.HTTPSOnlyStrict)
+	wv := &laggingWebView{MockWebView: mocks.NewMockWebView(t), current: "https://news.example/"}
+
+	assert.True(t, c.onNavigationRequest(ctx, paneID, wv, "http://blog.example/post"),
+		"the followed http link is cancelled before its request is sent")
+	assert.Equal(t, []string{"https://blog.example/post"}, wv.loaded,
+		"the https version of the link is loaded, not the page being left")
+
+	assert.False(t, c.onNavigationRequest(ctx, paneID, wv, "https://blog.example/post"))
+	assert.True(t, c.onNavigationRequest(ctx, paneID, wv, "http://blog.example/post"),
+		"strict mode cancels the server sending the upgraded load back to http")
+	assert.Equal(t, []string{"https://blog.example/post"}, wv.loaded)
+	assert.Equal(t, []HTTPSUpgradeEvent{{Host: "blog.example", Action: httpsupgrade.ActionBlock}}, *events)
+}
+
+func TestHTTPSUpgrade_LenientUpgradesThenFallsBack(t *testing.T) {
+	runUpgradedLoadsNow(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	c, events := newHTTPSUpgradeCoordinator(entity.HTTPSOnlyLenient)
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Once()
+	wv.EXPECT().LoadURI(ctx, "https://old.example/page").Return(nil).Once()
+	assert.True(t, c.onNavigationRequest(ctx, paneID, wv, "http://old.example/page"))
+
+	c.onHTTPSLoadFailed(ctx, paneID, wv, port.LoadFailure{URI: "http://old.example/page", Cause: loadretry.CauseCancelled})
+	wv.EXPECT().LoadURI(ctx, "http://old.example/page").Return(nil).Once()
+	handled := c.onHTTPSLoadFailed(ctx, paneID, wv,
+		port.LoadFailure{URI: "https://old.example/page", Cause: loadretry.CauseConnection})
+	assert.True(t, handled, "the fallback replaces the load retry")
+	require.Equal(t, []HTTPSUpgradeEvent{{Host: "old.example", Action: httpsupgrade.ActionFallback}}, *events)
+
+	assert.False(t, c.onNavigationRequest(ctx, paneID, wv, "http://old.example/page"))
+	assert.False(t, c.onNavigationRequest(ctx, paneID, wv, "http://old.example/page"))
+	assert.Len(t, *events, 1, "the fallback load is not warned about twice")
+
+	c.forgetHTTPSWarning(paneID)
+	assert.False(t, c.onNavigationRequest(ctx, paneID, wv, "http://old.example/other"))
+	require.Len(t, *events, 2)
+	assert.Equal(t, HTTPSUpgradeEvent{Host: "old.example", Action: httpsupgrade.ActionAllowInsecure}, (*events)[1])
+}
+
+func TestHTTPSUpgrade_CommittedUpgradeIsNotRetriedOverHTTP(t *testing.T) {
+	runUpgradedLoadsNow(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	c, events := newHTTPSUpgradeCoordinator(entity.HTTPSOnlyLenient)
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Once()
+	wv.EXPECT().LoadURI(ctx, "https://good.example/").Return(nil).Once()
+	assert.True(t, c.onNavigationRequest(ctx, paneID, wv, "http://good.example/"))
+
+	assert.False(t, c.onNavigationRequest(ctx, paneID, wv, "https://good.example/"))
+	wv.EXPECT().URI().Return("https://good.example/").Once()
+	c.settleHTTPSUpgrade(wv)
+
+	handled := c.onHTTPSLoadFailed(ctx, paneID, wv,
+		port.LoadFailure{URI: "https://good.example/", Cause: loadretry.CauseConnection})
+	assert.False(t, handled, "later failures go to the load retry")
+	assert.Empty(t, *events)
+}
+
+func TestHTTPSUpgrade_DisabledWithoutUseCase(t *testing.T) {
+	c := &Coordinator{}
+	wv := mocks.NewMockWebView(t)
+	assert.False(t, c.onNavigationRequest(context.Background(), "pane-1", wv, "http://example.com/"))
+	assert.False(t, c.onHTTPSLoadFailed(context.Background(), "pane-1", wv,
+		port.LoadFailure{URI: "https://example.com/", Cause: loadretry.CauseConnection}))
+}
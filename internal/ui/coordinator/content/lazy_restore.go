@@ -0,0 +1,70 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// DeferWorkspace keeps the panes of ws without a WebView until
+// ResumeWorkspace is called for it. AttachToWorkspace skips deferred panes,
+// so a lazily restored background tab gets its pane views but loads nothing.
+func (c *Coordinator) DeferWorkspace(ws *entity.Workspace) {
+	if ws == nil {
+		return
+	}
+	c.deferredMu.Lock()
+	defer c.deferredMu.Unlock()
+	for _, pane := range ws.AllPanes() {
+		if pane == nil {
+			continue
+		}
+		if c.deferredPanes == nil {
+			c.deferredPanes = make(map[entity.PaneID]bool)
+		}
+		c.deferredPanes[pane.ID] = true
+	}
+}
+
+// IsPaneDeferred reports whether paneID waits for its tab to be shown before
+// getting a WebView.
+func (c *Coordinator) IsPaneDeferred(paneID entity.PaneID) bool {
+	c.deferredMu.Lock()
+	defer c.deferredMu.Unlock()
+	return c.deferredPanes[paneID]
+}
+
+// ResumeWorkspace gives the deferred panes of ws their WebView, loading the
+// URI each pane was restored with, and attaches them to wsView. It does
+// nothing for a workspace without deferred panes.
+func (c *Coordinator) ResumeWorkspace(ctx context.Context, ws *entity.Workspace, wsView *component.WorkspaceView) {
+	if ws == nil {
+		return
+	}
+	resumed := 0
+	c.deferredMu.Lock()
+	for _, pane := range ws.AllPanes() {
+		if pane != nil && c.deferredPanes[pane.ID] {
+			delete(c.deferredPanes, pane.ID)
+			resumed++
+		}
+	}
+	c.deferredMu.Unlock()
+	if resumed == 0 {
+		return
+	}
+
+	logging.FromContext(ctx).Debug().
+		Str("workspace_id", string(ws.ID)).
+		Int("panes", resumed).
+		Msg("loading lazily restored panes")
+	c.AttachToWorkspace(ctx, ws, wsView)
+}
+
+func (c *Coordinator) forgetDeferred(paneID entity.PaneID) {
+	c.deferredMu.Lock()
+	defer c.deferredMu.Unlock()
+	delete(c.deferredPanes, paneID)
+}
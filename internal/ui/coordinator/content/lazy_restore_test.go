@@ -0,0 +1,130 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+	layoutmocks "github.com/bnema/dumber/internal/ui/layout/mocks"
+)
+
+// newLazyRestoreWorkspace returns a workspace of two panes split side by side,
+// each restored with its own URI.
+func newLazyRestoreWorkspace() *entity.Workspace {
+	left := entity.NewPane("pane-left")
+	left.URI = "https://example.com/left"
+	right := entity.NewPane("pane-right")
+	right.URI = "https://example.com/right"
+	return &entity.Workspace{
+		ID: "workspace-1",
+		Root: &entity.PaneNode{
+			ID:       "split-1",
+			Children: []*entity.PaneNode{{ID: "node-left", Pane: left}, {ID: "node-right", Pane: right}},
+		},
+		ActivePaneID: left.ID,
+	}
+}
+
+func newLazyRestoreWebView(t *testing.T, id port.WebViewID, uri string) *mocks.MockWebView {
+	t.Helper()
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().SetCallbacks(mock.Anything).Maybe()
+	wv.EXPECT().Generation().Return(uint64(0)).Maybe()
+	wv.EXPECT().ID().Return(id).Maybe()
+	wv.EXPECT().URI().Return("").Maybe()
+	wv.EXPECT().LoadURI(mock.Anything, uri).Return(nil).Once()
+	return wv
+}
+
+func TestLazyRestore_DeferredPanesHaveNoWebViewUntilResumed(t *testing.T) {
+	t.Parallel()
+
+	workspace := newLazyRestoreWorkspace()
+	pool := mocks.NewMockWebViewPool(t)
+	widgetFactory := layoutmocks.NewMockWidgetFactory(t)
+	ctx, wsView := newAttachWorkspaceView(t, widgetFactory)
+
+	c := newMinimalCoordinator()
+	c.pool = pool
+	c.widgetFactory = widgetFactory
+
+	// A deferred background tab is built without acquiring any WebView.
+	c.DeferWorkspace(workspace)
+	c.AttachToWorkspace(ctx, workspace, wsView)
+
+	for _, pane := range workspace.AllPanes() {
+		assert.True(t, c.IsPaneDeferred(pane.ID))
+		assert.Nil(t, c.GetWebView(pane.ID), "pane %s must not have a live WebView", pane.ID)
+	}
+
+	// Showing the tab loads each pane's restored URI.
+	left := newLazyRestoreWebView(t, 301, "https://example.com/left")
+	right := newLazyRestoreWebView(t, 302, "https://example.com/right")
+	pool.EXPECT().Acquire(mock.Anything).Return(left, nil).Once()
+	pool.EXPECT().Acquire(mock.Anything).Return(right, nil).Once()
+
+	c.ResumeWorkspace(ctx, workspace, wsView)
+
+	assert.Same(t, left, c.GetWebView("pane-left"))
+	assert.Same(t, right, c.GetWebView("pane-right"))
+	assert.False(t, c.IsPaneDeferred("pane-left"))
+	assert.False(t, c.IsPaneDeferred("pane-right"))
+}
+
+func TestLazyRestore_ResumeWithoutDeferredPanesDoesNothing(t *testing.T) {
+	t.Parallel()
+
+	workspace := newLazyRestoreWorkspace()
+	widgetFactory := layoutmocks.NewMockWidgetFactory(t)
+	ctx, wsView := newAttachWorkspaceView(t, widgetFactory)
+
+	c := newMinimalCoordinator()
+	c.pool = mocks.NewMockWebViewPool(t) // Acquire must NOT be called
+	c.widgetFactory = widgetFactory
+
+	c.ResumeWorkspace(ctx, workspace, wsView)
+
+	assert.Nil(t, c.GetWebView("pane-left"))
+	assert.Nil(t, c.GetWebView("pane-right"))
+}
+
+func TestLazyRestore_EnsureWebViewEndsDeferral(t *testing.T) {
+	t.Parallel()
+
+	workspace := newLazyRestoreWorkspace()
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().SetCallbacks(mock.Anything).Maybe()
+	wv.EXPECT().Generation().Return(uint64(0)).Maybe()
+	wv.EXPECT().ID().Return(port.WebViewID(303)).Maybe()
+
+	pool := mocks.NewMockWebViewPool(t)
+	pool.EXPECT().Acquire(mock.Anything).Return(wv, nil).Once()
+
+	c := newMinimalCoordinator()
+	c.pool = pool
+	c.DeferWorkspace(workspace)
+
+	got, err := c.EnsureWebView(context.Background(), "pane-left")
+	require.NoError(t, err)
+	assert.Same(t, wv, got)
+	assert.False(t, c.IsPaneDeferred("pane-left"))
+	assert.True(t, c.IsPaneDeferred("pane-right"))
+}
+
+func TestLazyRestore_ReleaseForgetsDeferredPane(t *testing.T) {
+	t.Parallel()
+
+	c := newMinimalCoordinator()
+	c.DeferWorkspace(newLazyRestoreWorkspace())
+
+	c.ReleaseWebView(context.Background(), "pane-right")
+
+	assert.False(t, c.IsPaneDeferred("pane-right"))
+	assert.True(t, c.IsPaneDeferred("pane-left"))
+}
@@ -32,6 +32,8 @@ func (c *Coordinator) EnsureWebView(ctx context.Context, paneID entity.PaneID) (
 	if err != nil {
 		return nil, err
 	}
+	// A deferred pane that gets its WebView early no longer waits for its tab.
+	c.forgetDeferred(paneID)
 
 	// setWebViewLocked atomically resets presentation state for the acquired
 	// WebView, including a pooled instance previously revealed in another pane.
@@ -49,6 +51,8 @@ func (c *Coordinator) EnsureWebView(ctx context.Context, paneID entity.PaneID) (
 func (c *Coordinator) ReleaseWebView(ctx context.Context, paneID entity.PaneID) {
 	log := logging.FromContext(ctx)
 
+	c.forgetDeferred(paneID)
+
 	// deleteWebViewLocked clears reveal state before returning, even when a
 	// previous cleanup already removed this pane's mapping.
 	wv := c.deleteWebViewLocked(paneID)
@@ -58,6 +62,16 @@ func (c *Coordinator) ReleaseWebView(ctx context.Context, paneID entity.PaneID)
 	c.ensurePopupManager().clearReusableNamedPopupByPaneID(paneID)
 	c.ensurePopupManager().clearReusableNamedPopupByWebViewID(wv.ID())
 	c.clearPendingAppearance(paneID)
+	c.forgetForceDark(paneID)
+	c.forgetColorScheme(paneID)
+	c.forgetScrollPositions(paneID)
+	c.forgetFormDirty(paneID)
+	c.forgetLoadRetry(paneID)
+	c.forgetFocusTimer(paneID)
+	c.forgetPageLanguage(paneID)
+	c.forgetHTTPSWarning(paneID)
+	c.forgetFilterBypass(ctx, wv)
+	c.clearDataOnClose(ctx, wv)
 
 	// CRITICAL: If this webview was inhibiting idle (fullscreen or audio playing),
 	// we must release the inhibition before destroying the webview.
@@ -97,6 +111,7 @@ func (c *Coordinator) ReleaseWebView(ctx context.Context, paneID entity.PaneID)
 }
 
 // AttachToWorkspace ensures each pane in the workspace has a WebView widget attached.
+// Panes deferred by DeferWorkspace are left without one.
 func (c *Coordinator) AttachToWorkspace(ctx context.Context, ws *entity.Workspace, wsView *component.WorkspaceView) {
 	log := logging.FromContext(ctx)
 
@@ -105,7 +120,7 @@ func (c *Coordinator) AttachToWorkspace(ctx context.Context, ws *entity.Workspac
 	}
 
 	for _, pane := range ws.AllPanes() {
-		if pane == nil {
+		if pane == nil || c.IsPaneDeferred(pane.ID) {
 			continue
 		}
 
@@ -227,6 +242,36 @@ func (c *Coordinator) RegisterPopupWebView(paneID entity.PaneID, wv port.WebView
 	}
 }
 
+// CreateRelatedWebView creates a WebView for paneID that shares session and
+// web process with parentPaneID's WebView, wires pane callbacks and loads uri.
+// The factory configured through SetPopupConfig is used.
+func (c *Coordinator) CreateRelatedWebView(
+	ctx context.Context,
+	paneID, parentPaneID entity.PaneID,
+	uri string,
+) (port.WebView, error) {
+	parentWV := c.getWebViewLocked(parentPaneID)
+	if parentWV == nil || parentWV.IsDestroyed() {
+		return nil, fmt.Errorf("no webview for related parent pane %s", parentPaneID)
+	}
+
+	wv, err := c.ensurePopupManager().createPopupWebView(ctx, parentWV.ID(), uri, true)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setWebViewLocked(paneID, wv)
+	c.setupWebViewCallbacks(ctx, paneID, wv)
+	if uri != "" {
+		if err := wv.LoadURI(ctx, uri); err != nil {
+			c.deleteWebViewLocked(paneID)
+			wv.Destroy()
+			return nil, fmt.Errorf("load %s in related webview: %w", logging.TruncateURL(uri, logURLMaxLen), err)
+		}
+	}
+	return wv, nil
+}
+
 // SyncWebViewViewport requests an explicit viewport resync when the engine
 // supports it. This is useful after widget attachment, reparenting, visibility
 // changes, or layout promotion paths that may not emit a size change.
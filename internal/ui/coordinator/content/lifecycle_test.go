@@ -164,6 +164,7 @@ func newAttachWorkspaceView(t *testing.T, factory *layoutmocks.MockWidgetFactory
 	container.EXPECT().SetHexpand(true).Once()
 	container.EXPECT().SetVexpand(true).Once()
 	container.EXPECT().SetVisible(true).Once()
+	container.EXPECT().AddCssClass("workspace-container").Once()
 	factory.EXPECT().NewOverlay().Return(overlay).Once()
 	overlay.EXPECT().SetHexpand(true).Once()
 	overlay.EXPECT().SetVexpand(true).Once()
@@ -531,6 +532,7 @@ func newLifecycleRebuildWorkspaceView(
 	workspaceContainer.EXPECT().SetHexpand(true).Once()
 	workspaceContainer.EXPECT().SetVexpand(true).Once()
 	workspaceContainer.EXPECT().SetVisible(true).Once()
+	workspaceContainer.EXPECT().AddCssClass("workspace-container").Once()
 	factory.EXPECT().NewOverlay().Return(workspaceOverlay).Once()
 	workspaceOverlay.EXPECT().SetHexpand(true).Once()
 	workspaceOverlay.EXPECT().SetVexpand(true).Once()
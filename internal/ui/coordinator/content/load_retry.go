@@ -0,0 +1,205 @@
+package content
+
+import (
+	"context"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/loadretry"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/glib"
+)
+
+// LoadRetryEvent reports an automatic retry of a failed load.
+type LoadRetryEvent struct {
+	// URI is the page being retried.
+	URI string
+	// Attempt is the number of the scheduled retry, from 1 to MaxAttempts.
+	Attempt     int
+	MaxAttempts int
+	// Delay is the wait before the retry.
+	Delay time.Duration
+	// GaveUp is set when the page failed again after its last retry.
+	GaveUp bool
+}
+
+// loadRetryState follows the retries of the page a pane failed to load.
+type loadRetryState struct {
+	uri      string
+	attempts int
+	// failed is set when the current load of the pane failed.
+	failed bool
+	// cancel stops the pending retry; nil when none is pending. seq tells a
+	// stale timer from the current one.
+	cancel func()
+	seq    uint64
+}
+
+// scheduleLoadRetry runs fn on the GTK main thread after delay and returns a
+// function that stops it. Tests replace it to run retries on demand.
+var scheduleLoadRetry = func(delay time.Duration, fn func()) (cancel func()) {
+	timer := time.AfterFunc(delay, func() {
+		cb := glib.SourceFunc(func(_ uintptr) bool {
+			fn()
+			return false
+		})
+		glib.IdleAdd(&cb, 0)
+	})
+	return func() { timer.Stop() }
+}
+
+// SetLoadRetryConfigProvider sets the source of the live retry schedule of
+// failed loads.
+func (c *Coordinator) SetLoadRetryConfigProvider(fn func() entity.RuntimeLoadRetryConfig) {
+	c.loadRetryConfigProvider = fn
+}
+
+// SetOnLoadRetry sets the callback run when a failed load is retried or
+// given up on, to show the progress.
+func (c *Coordinator) SetOnLoadRetry(fn func(ctx context.Context, paneID entity.PaneID, event LoadRetryEvent)) {
+	c.onLoadRetry = fn
+}
+
+func (c *Coordinator) loadRetryPolicy() loadretry.Policy {
+	if c.loadRetryConfigProvider == nil {
+		return loadretry.Policy{}
+	}
+	cfg := c.loadRetryConfigProvider()
+	return loadretry.Policy{
+		MaxAttempts:  cfg.MaxAttempts,
+		InitialDelay: time.Duration(cfg.InitialDelayMs) * time.Millisecond,
+		MaxDelay:     time.Duration(cfg.MaxDelayMs) * time.Millisecond,
+	}
+}
+
+// onLoadFailed schedules a retry of a load that failed on a transient
+// network error, waiting longer after each failure of the same page. Other
+// failures end the retries of the pane. Cancelled loads are left alone: the
+// load that replaced them decides.
+func (c *Coordinator) onLoadFailed(ctx context.Context, paneID entity.PaneID, wv port.WebView, failure port.LoadFailure) {
+	log := logging.FromContext(ctx)
+	if failure.Cause == loadretry.CauseCancelled {
+		return
+	}
+	policy := c.loadRetryPolicy()
+
+	c.loadRetryMu.Lock()
+	if !failure.Cause.Transient() || policy.MaxAttempts == 0 || failure.URI == "" {
+		c.forgetLoadRetryLocked(paneID)
+		c.loadRetryMu.Unlock()
+		log.Debug().
+			Str("pane_id", string(paneID)).
+			Str("cause", string(failure.Cause)).
+			Str("error", failure.Message).
+			Msg("load failed, not retrying")
+		return
+	}
+	state := c.loadRetries[paneID]
+	if state == nil || state.uri != failure.URI {
+		c.forgetLoadRetryLocked(paneID)
+		state = &loadRetryState{uri: failure.URI}
+		if c.loadRetries == nil {
+			c.loadRetries = make(map[entity.PaneID]*loadRetryState)
+		}
+		c.loadRetries[paneID] = state
+	}
+	state.failed = true
+	state.attempts++
+	delay, ok := policy.Delay(state.attempts)
+	event := LoadRetryEvent{
+		URI:         failure.URI,
+		Attempt:     state.attempts,
+		MaxAttempts: policy.MaxAttempts,
+		Delay:       delay,
+		GaveUp:      !ok,
+	}
+	if !ok {
+		event.Attempt = policy.MaxAttempts
+	}
+	if ok {
+		state.seq++
+		seq := state.seq
+		state.cancel = scheduleLoadRetry(delay, func() {
+			c.runLoadRetry(ctx, paneID, wv, state, seq)
+		})
+	} else {
+		c.forgetLoadRetryLocked(paneID)
+	}
+	c.loadRetryMu.Unlock()
+
+	log.Info().
+		Str("pane_id", string(paneID)).
+		Str("uri", logging.TruncateURL(failure.URI, logURLMaxLen)).
+		Str("cause", string(failure.Cause)).
+		Int("attempt", event.Attempt).
+		Dur("delay", delay).
+		Bool("gave_up", event.GaveUp).
+		Msg("load failed on a transient error")
+	if c.onLoadRetry != nil {
+		c.onLoadRetry(ctx, paneID, event)
+	}
+}
+
+// runLoadRetry reloads the failed page unless the pane moved on while the
+// retry waited: it shows another page, is loading one, or was released.
+func (c *Coordinator) runLoadRetry(ctx context.Context, paneID entity.PaneID, wv port.WebView, state *loadRetryState, seq uint64) {
+	current := c.getWebViewLocked(paneID)
+
+	c.loadRetryMu.Lock()
+	if c.loadRetries[paneID] != state || state.seq != seq || state.cancel == nil {
+		c.loadRetryMu.Unlock()
+		return
+	}
+	state.cancel = nil
+	uri := state.uri
+	if current != wv || wv.IsDestroyed() || wv.IsLoading() || wv.URI() != uri {
+		delete(c.loadRetries, paneID)
+		c.loadRetryMu.Unlock()
+		return
+	}
+	c.loadRetryMu.Unlock()
+
+	if err := wv.LoadURI(ctx, uri); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to retry load")
+	}
+}
+
+// noteLoadRetryStarted clears the failure mark when the pane starts a load,
+// so the end of that load tells whether it succeeded.
+func (c *Coordinator) noteLoadRetryStarted(paneID entity.PaneID) {
+	c.loadRetryMu.Lock()
+	defer c.loadRetryMu.Unlock()
+	if state := c.loadRetries[paneID]; state != nil {
+		state.failed = false
+	}
+}
+
+// settleLoadRetry ends the retries of the pane once a load finished without
+// failing and no retry is pending.
+func (c *Coordinator) settleLoadRetry(paneID entity.PaneID) {
+	c.loadRetryMu.Lock()
+	defer c.loadRetryMu.Unlock()
+	if state := c.loadRetries[paneID]; state != nil && !state.failed && state.cancel == nil {
+		delete(c.loadRetries, paneID)
+	}
+}
+
+// forgetLoadRetry stops the pending retry of a released pane.
+func (c *Coordinator) forgetLoadRetry(paneID entity.PaneID) {
+	c.loadRetryMu.Lock()
+	defer c.loadRetryMu.Unlock()
+	c.forgetLoadRetryLocked(paneID)
+}
+
+func (c *Coordinator) forgetLoadRetryLocked(paneID entity.PaneID) {
+	state := c.loadRetries[paneID]
+	if state == nil {
+		return
+	}
+	if state.cancel != nil {
+		state.cancel()
+		state.cancel = nil
+	}
+	delete(c.loadRetries, paneID)
+}
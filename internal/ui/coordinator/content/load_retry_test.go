@@ -0,0 +1,155 @@
+package content
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/loadretry"
+)
+
+type fakeRetryTimer struct {
+	delay   time.Duration
+	fn      func()
+	stopped bool
+}
+
+// stubLoadRetryScheduler records scheduled retries instead of waiting.
+func stubLoadRetryScheduler(t *testing.T) *[]*fakeRetryTimer {
+	t.Helper()
+	var timers []*fakeRetryTimer
+	original := scheduleLoadRetry
+	scheduleLoadRetry = func(delay time.Duration, fn func()) func() {
+		timer := &fakeRetryTimer{delay: delay, fn: fn}
+		timers = append(timers, timer)
+		return func() { timer.stopped = true }
+	}
+	t.Cleanup(func() { scheduleLoadRetry = original })
+	return &timers
+}
+
+func newLoadRetryCoordinator(paneID entity.PaneID, wv port.WebView, maxAttempts int) (*Coordinator, *[]LoadRetryEvent) {
+	c := &Coordinator{webViews: map[entity.PaneID]port.WebView{paneID: wv}}
+	c.SetLoadRetryConfigProvider(func() entity.RuntimeLoadRetryConfig {
+		return entity.RuntimeLoadRetryConfig{MaxAttempts: maxAttempts, InitialDelayMs: 1000, MaxDelayMs: 3000}
+	})
+	var events []LoadRetryEvent
+	c.SetOnLoadRetry(func(_ context.Context, _ entity.PaneID, event LoadRetryEvent) {
+		events = append(events, event)
+	})
+	return c, &events
+}
+
+func connectionFailure(uri string) port.LoadFailure {
+	return port.LoadFailure{URI: uri, Cause: loadretry.CauseConnection}
+}
+
+func TestLoadRetry_BacksOffThenGivesUp(t *testing.T) {
+	timers := stubLoadRetryScheduler(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	const uri = "https://flaky.example/"
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().IsLoading().Return(false).Maybe()
+	wv.EXPECT().URI().Return(uri).Maybe()
+	wv.EXPECT().LoadURI(ctx, uri).Return(nil).Times(3)
+
+	c, events := newLoadRetryCoordinator(paneID, wv, 3)
+	for range 3 {
+		c.noteLoadRetryStarted(paneID)
+		c.onLoadFailed(ctx, paneID, wv, connectionFailure(uri))
+		c.settleLoadRetry(paneID)
+		last := (*timers)[len(*timers)-1]
+		last.fn()
+	}
+	c.noteLoadRetryStarted(paneID)
+	c.onLoadFailed(ctx, paneID, wv, connectionFailure(uri))
+
+	require.Len(t, *timers, 3)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second},
+		[]time.Duration{(*timers)[0].delay, (*timers)[1].delay, (*timers)[2].delay})
+	require.Len(t, *events, 4)
+	assert.Equal(t, LoadRetryEvent{URI: uri, Attempt: 1, MaxAttempts: 3, Delay: time.Second}, (*events)[0])
+	assert.Equal(t, LoadRetryEvent{URI: uri, Attempt: 3, MaxAttempts: 3, GaveUp: true}, (*events)[3])
+	assert.Empty(t, c.loadRetries, "giving up forgets the pane")
+}
+
+func TestLoadRetry_SkipsPermanentAndCancelledFailures(t *testing.T) {
+	timers := stubLoadRetryScheduler(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	wv := mocks.NewMockWebView(t)
+
+	c, events := newLoadRetryCoordinator(paneID, wv, 3)
+	c.onLoadFailed(ctx, paneID, wv, port.LoadFailure{URI: "https://gone.example/", Cause: loadretry.CauseHTTPStatus})
+	c.onLoadFailed(ctx, paneID, wv, port.LoadFailure{URI: "https://gone.example/", Cause: loadretry.CausePolicy})
+	c.onLoadFailed(ctx, paneID, wv, port.LoadFailure{URI: "https://gone.example/", Cause: loadretry.CauseCancelled})
+
+	assert.Empty(t, *timers)
+	assert.Empty(t, *events)
+}
+
+func TestLoadRetry_DisabledWithZeroAttempts(t *testing.T) {
+	timers := stubLoadRetryScheduler(t)
+	paneID := entity.PaneID("pane-1")
+	wv := mocks.NewMockWebView(t)
+
+	c, events := newLoadRetryCoordinator(paneID, wv, 0)
+	c.onLoadFailed(context.Background(), paneID, wv, connectionFailure("https://flaky.example/"))
+
+	assert.Empty(t, *timers)
+	assert.Empty(t, *events)
+}
+
+func TestLoadRetry_DroppedWhenPaneMovedOn(t *testing.T) {
+	timers := stubLoadRetryScheduler(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().IsLoading().Return(false).Maybe()
+	wv.EXPECT().URI().Return("https://elsewhere.example/").Maybe()
+
+	c, _ := newLoadRetryCoordinator(paneID, wv, 3)
+	c.onLoadFailed(ctx, paneID, wv, connectionFailure("https://flaky.example/"))
+	require.Len(t, *timers, 1)
+
+	(*timers)[0].fn()
+	assert.Empty(t, c.loadRetries, "no LoadURI call: the pane shows another page")
+}
+
+func TestLoadRetry_SuccessAndReleaseResetAttempts(t *testing.T) {
+	timers := stubLoadRetryScheduler(t)
+	ctx := context.Background()
+	paneID := entity.PaneID("pane-1")
+	const uri = "https://flaky.example/"
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().IsLoading().Return(false).Maybe()
+	wv.EXPECT().URI().Return(uri).Maybe()
+	wv.EXPECT().LoadURI(ctx, uri).Return(nil).Once()
+
+	c, events := newLoadRetryCoordinator(paneID, wv, 3)
+	c.onLoadFailed(ctx, paneID, wv, connectionFailure(uri))
+	(*timers)[0].fn()
+	c.noteLoadRetryStarted(paneID)
+	c.settleLoadRetry(paneID)
+	assert.Empty(t, c.loadRetries, "a load that finished without failing ends the retries")
+
+	c.onLoadFailed(ctx, paneID, wv, connectionFailure(uri))
+	assert.Equal(t, 1, (*events)[1].Attempt, "attempts start over after a success")
+
+	c.forgetLoadRetry(paneID)
+	assert.True(t, (*timers)[1].stopped, "releasing the pane stops its pending retry")
+	assert.Empty(t, c.loadRetries)
+}
@@ -0,0 +1,34 @@
+package content
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/media"
+)
+
+// SetMediaConfigProvider sets the source of the live media config used to
+// resolve per-domain autoplay policy on navigation.
+func (c *Coordinator) SetMediaConfigProvider(fn func() entity.RuntimeMediaConfig) {
+	c.mediaConfigProvider = fn
+}
+
+// applyAutoplayPolicy resolves the autoplay policy for uri and hands it to
+// engines that can enforce it per page.
+func (c *Coordinator) applyAutoplayPolicy(ctx context.Context, wv port.WebView, uri string) {
+	if c.mediaConfigProvider == nil || wv == nil || wv.IsDestroyed() {
+		return
+	}
+	if strings.HasPrefix(uri, "dumb://") || strings.HasPrefix(uri, "about:") {
+		return
+	}
+	capable, ok := wv.(port.AutoplayPolicyCapable)
+	if !ok {
+		return
+	}
+
+	cfg := c.mediaConfigProvider()
+	capable.ApplyAutoplayPolicy(ctx, media.ResolveAutoplayPolicy(cfg.AutoplayPolicy, cfg.AutoplayExceptions, uri))
+}
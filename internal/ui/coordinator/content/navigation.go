@@ -20,6 +20,13 @@ import (
 func (c *Coordinator) onLoadCommitted(ctx context.Context, paneID entity.PaneID, wv port.WebView, identity webViewIdentity) {
 	log := logging.FromContext(ctx)
 
+	// The committed document replaces the one whose fields were edited.
+	c.forgetFormDirty(paneID)
+	// Its scroll position is reported once its reading progress script runs.
+	c.setPaneReadingProgress(paneID, 0)
+	// Its language is reported once its page_language script runs.
+	c.forgetPageLanguage(paneID)
+
 	uri := wv.URI()
 	if uri == "" {
 		return
@@ -100,6 +107,15 @@ func (c *Coordinator) onLoadCommitted(ctx context.Context, paneID entity.PaneID,
 	// Notify active pane navigation for permission indicator reset.
 	c.notifyActiveNavigation(paneID, uri)
 
+	c.applyAutoplayPolicy(ctx, wv, uri)
+	c.applyForceDark(ctx, paneID, wv, uri)
+	c.applyFontOverride(ctx, wv, uri)
+	c.applyNavigationGestures(ctx, wv, uri)
+	c.applyColorScheme(ctx, paneID, wv, uri)
+	c.applyCookiePolicy(ctx, wv, uri)
+	c.applySiteUserAgent(ctx, wv, uri)
+	c.refreezeRendering(ctx, wv)
+
 	// Apply zoom
 	if c.zoomUC == nil {
 		return
@@ -198,8 +214,13 @@ func (c *Coordinator) onLoadStarted(paneID entity.PaneID) {
 	}
 }
 
-// onLoadFinished hides the progress bar when page loading completes.
+// onLoadFinished hides the progress bar when page loading completes,
+// restores the scroll offset of a RestoreLastView navigation and autofocuses
+// the first text field where configured.
 func (c *Coordinator) onLoadFinished(ctx context.Context, paneID entity.PaneID, wv port.WebView, identity webViewIdentity) {
+	c.restorePendingScroll(ctx, paneID, wv)
+	c.autofocusFirstField(ctx, wv)
+
 	_, wsView := c.getActiveWS()
 	var paneView *component.PaneView
 	if wsView != nil {
@@ -380,8 +401,9 @@ func (c *Coordinator) onLinkHover(paneID entity.PaneID, uri string) {
 		return
 	}
 
-	if uri != "" {
-		paneView.ShowLinkStatus(uri)
+	cfg := wsView.LinkStatusConfig()
+	if uri != "" && cfg.Enabled {
+		paneView.ShowLinkStatus(uri, cfg)
 	} else {
 		paneView.HideLinkStatus()
 	}
@@ -419,6 +441,7 @@ func (c *Coordinator) handleURIChanged(ctx context.Context, paneID entity.PaneID
 		return
 	}
 
+	c.syncFilterBypass(ctx, wv, uri)
 	if !wv.IsLoading() {
 		c.onSPANavigation(ctx, paneID, uri)
 	}
@@ -142,6 +142,16 @@ func GetBehavior(popupType PopupType, cfg *entity.BrowsingContextConfig) entity.
 	}
 }
 
+// ResolveBehavior returns the placement for a browsing context opened at
+// targetURI. cfg.DefaultForLinks wins when set, except for OAuth flows, which
+// keep the per-kind placement from GetBehavior.
+func ResolveBehavior(popupType PopupType, targetURI string, cfg *entity.BrowsingContextConfig) entity.PopupBehavior {
+	if cfg != nil && cfg.DefaultForLinks != "" && !IsOAuthURL(targetURI) {
+		return cfg.DefaultForLinks
+	}
+	return GetBehavior(popupType, cfg)
+}
+
 // SetPopupConfig configures popup handling.
 func (c *Coordinator) SetPopupConfig(
 	factory port.WebViewFactory,
@@ -168,11 +178,19 @@ func (c *Coordinator) SetOnInsertPopup(fn func(ctx context.Context, input Insert
 	c.ensurePopupManager().setOnInsertPopup(fn)
 }
 
-// SetOnClosePane sets the callback to close a pane when its popup closes.
+// SetOnClosePane sets the callback to close a pane when its popup closes or
+// its focus timer runs out.
 func (c *Coordinator) SetOnClosePane(fn func(ctx context.Context, paneID entity.PaneID) error) {
+	c.onClosePane = fn
 	c.ensurePopupManager().setOnClosePane(fn)
 }
 
+// SetOnPopupSuppressed sets the callback run when a popup is suppressed by
+// the opener's domain rules and notify_blocked_popups is on.
+func (c *Coordinator) SetOnPopupSuppressed(fn func(ctx context.Context, paneID entity.PaneID, targetURI string)) {
+	c.ensurePopupManager().setOnPopupSuppressed(fn)
+}
+
 func (c *Coordinator) SetOnOpenNativePopup(fn func(ctx context.Context, input NativePopupInput) error) {
 	c.ensurePopupManager().setOnOpenNativePopup(fn)
 }
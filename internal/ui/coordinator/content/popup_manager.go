@@ -23,6 +23,7 @@ type popupManager struct {
 	onInsertPopup     func(ctx context.Context, input InsertPopupInput) error
 	onOpenNativePopup func(ctx context.Context, input NativePopupInput) error
 	onClosePane       func(ctx context.Context, paneID entity.PaneID) error
+	onPopupSuppressed func(ctx context.Context, paneID entity.PaneID, targetURI string)
 	generatePaneID    func() string
 	windowIDForPane   func(entity.PaneID) (string, bool)
 	policy            browsingContextPolicy
@@ -143,8 +144,8 @@ func (pm *popupManager) setWindowIDResolver(fn func(entity.PaneID) (string, bool
 	pm.windowIDForPane = fn
 }
 
-func popupTabInsertionConfig(cfg *entity.BrowsingContextConfig) (entity.PopupBehavior, string) {
-	behavior := GetBehavior(PopupTypeTab, cfg)
+func popupTabInsertionConfig(cfg *entity.BrowsingContextConfig, uri string) (entity.PopupBehavior, string) {
+	behavior := ResolveBehavior(PopupTypeTab, uri, cfg)
 	placement := "right"
 	if cfg != nil {
 		placement = cfg.Placement
@@ -179,6 +180,13 @@ func (pm *popupManager) setOnOpenNativePopup(fn func(ctx context.Context, input
 	pm.onOpenNativePopup = fn
 }
 
+func (pm *popupManager) setOnPopupSuppressed(fn func(ctx context.Context, paneID entity.PaneID, targetURI string)) {
+	if pm == nil {
+		return
+	}
+	pm.onPopupSuppressed = fn
+}
+
 func (pm *popupManager) createPopupPane(
 	popupID port.WebViewID,
 	parentPaneID entity.PaneID,
@@ -521,11 +529,14 @@ func (pm *popupManager) handlePopupCreate(
 	parentURIAtOpen := pm.popupParentURIAtOpen(parentPaneID, parentWV, hooks, req.TargetURI)
 
 	request := buildPopupBrowsingContextRequest(req)
+	if cfg != nil && len(cfg.PopupBlockDomains) > 0 {
+		request.OpenerURI = parentWV.URI()
+	}
 	namedContextExists := false
 	if !req.NoJavaScriptAccess {
 		_, namedContextExists = pm.lookupReusableNamedPopup(parentPaneID, req.FrameName, hooks)
 	}
-	decision := pm.policy.Decide(request, namedContextExists)
+	decision := pm.policy.DecideForConfig(request, namedContextExists, cfg)
 	log.Debug().
 		Str("decision", string(decision.Kind)).
 		Str("reason", decision.Reason).
@@ -534,6 +545,9 @@ func (pm *popupManager) handlePopupCreate(
 
 	switch decision.Kind {
 	case dto.HostDecisionDeny:
+		if decision.Suppressed && cfg != nil && cfg.NotifyBlockedPopups && pm.onPopupSuppressed != nil {
+			pm.onPopupSuppressed(ctx, parentPaneID, req.TargetURI)
+		}
 		return nil
 	case dto.HostDecisionReuseNamedPane:
 		if req.NoJavaScriptAccess {
@@ -566,7 +580,7 @@ func (pm *popupManager) handlePopupCreate(
 
 	popupType := DetectPopupType(req.FrameName)
 	popupID := popupWV.ID()
-	behavior := GetBehavior(popupType, cfg)
+	behavior := ResolveBehavior(popupType, req.TargetURI, cfg)
 	placement := "right"
 	if cfg != nil {
 		placement = cfg.Placement
@@ -879,7 +893,7 @@ func (pm *popupManager) handleLinkMiddleClick(
 		hooks.setupWebViewCallbacks(ctx, paneID, newWV)
 	}
 
-	behavior, placement := popupTabInsertionConfig(pm.currentPopupConfig())
+	behavior, placement := popupTabInsertionConfig(pm.currentPopupConfig(), uri)
 
 	if pm.onInsertPopup != nil {
 		popupInput := InsertPopupInput{
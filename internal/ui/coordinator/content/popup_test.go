@@ -128,6 +128,60 @@ func TestGetBehavior_TabPopup_BlocksWhenOpenInNewPaneFalse(t *testing.T) {
 	assert.Equal(t, entity.PopupBehaviorSplit, GetBehavior(PopupTypeTab, cfg))
 }
 
+func TestResolveBehavior(t *testing.T) {
+	t.Parallel()
+
+	const oauthURL = "https://accounts.google.com/o/oauth2/auth?client_id=x&redirect_uri=y"
+	const pageURL = "https://example.com/article"
+
+	tests := []struct {
+		name      string
+		popupType PopupType
+		uri       string
+		cfg       *entity.BrowsingContextConfig
+		want      entity.PopupBehavior
+	}{
+		{name: "nil config", popupType: PopupTypePopup, uri: pageURL, want: entity.PopupBehaviorSplit},
+		{
+			name: "unset keeps blank target behavior", popupType: PopupTypeTab, uri: pageURL,
+			cfg:  &entity.BrowsingContextConfig{Behavior: entity.PopupBehaviorSplit, BlankTargetBehavior: "stacked"},
+			want: entity.PopupBehaviorStacked,
+		},
+		{
+			name: "unset keeps popup behavior", popupType: PopupTypePopup, uri: pageURL,
+			cfg:  &entity.BrowsingContextConfig{Behavior: entity.PopupBehaviorTabbed, BlankTargetBehavior: "stacked"},
+			want: entity.PopupBehaviorTabbed,
+		},
+		{
+			name: "overrides blank target", popupType: PopupTypeTab, uri: pageURL,
+			cfg:  &entity.BrowsingContextConfig{BlankTargetBehavior: "stacked", DefaultForLinks: entity.PopupBehaviorTabbed},
+			want: entity.PopupBehaviorTabbed,
+		},
+		{
+			name: "overrides script popup", popupType: PopupTypePopup, uri: pageURL,
+			cfg:  &entity.BrowsingContextConfig{Behavior: entity.PopupBehaviorSplit, DefaultForLinks: entity.PopupBehaviorStacked},
+			want: entity.PopupBehaviorStacked,
+		},
+		{
+			name: "oauth popup keeps behavior", popupType: PopupTypePopup, uri: oauthURL,
+			cfg:  &entity.BrowsingContextConfig{Behavior: entity.PopupBehaviorSplit, DefaultForLinks: entity.PopupBehaviorTabbed},
+			want: entity.PopupBehaviorSplit,
+		},
+		{
+			name: "oauth _blank keeps blank target behavior", popupType: PopupTypeTab, uri: oauthURL,
+			cfg:  &entity.BrowsingContextConfig{BlankTargetBehavior: "split", DefaultForLinks: entity.PopupBehaviorTabbed},
+			want: entity.PopupBehaviorSplit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ResolveBehavior(tt.popupType, tt.uri, tt.cfg))
+		})
+	}
+}
+
 func newPopupCreateCoordinatorForTest(t *testing.T, popupID port.WebViewID) (context.Context, entity.PaneID, *mocks.MockWebView, *mocks.MockWebView, *Coordinator) {
 	t.Helper()
 
@@ -298,6 +352,40 @@ func TestHandlePopupCreate_DeniesWhenRelatedCreateIsUnsupported(t *testing.T) {
 	assert.Equal(t, 0, insertCalls)
 }
 
+func TestHandlePopupCreate_SuppressesPopupForBlockedOpener(t *testing.T) {
+	ctx := context.Background()
+	parentPaneID := entity.PaneID("parent-pane")
+	parentWV := mocks.NewMockWebView(t)
+	parentWV.EXPECT().ID().Return(port.WebViewID(101)).Once()
+	parentWV.EXPECT().URI().Return("https://news.example/article").Once()
+
+	factory := mocks.NewMockWebViewFactory(t)
+
+	var suppressedPane entity.PaneID
+	var suppressedURI string
+	c := &Coordinator{
+		webViews: make(map[entity.PaneID]port.WebView),
+		popups:   newPopupManager(),
+	}
+	c.SetPopupConfig(factory, &entity.BrowsingContextConfig{
+		OpenInNewPane:       true,
+		PopupBlockDomains:   []string{"news.example"},
+		NotifyBlockedPopups: true,
+	}, nil)
+	c.SetOnPopupSuppressed(func(_ context.Context, paneID entity.PaneID, targetURI string) {
+		suppressedPane = paneID
+		suppressedURI = targetURI
+	})
+
+	created := c.handlePopupCreate(ctx, parentPaneID, parentWV, port.PopupRequest{
+		TargetURI: "https://promo.example/offer",
+	})
+
+	require.Nil(t, created)
+	assert.Equal(t, parentPaneID, suppressedPane)
+	assert.Equal(t, "https://promo.example/offer", suppressedURI)
+}
+
 func TestHandlePopupCreate_DeniesWhenRelatedCreateFailsUnexpectedly(t *testing.T) {
 	ctx := context.Background()
 	parentPaneID := entity.PaneID("parent-pane")
@@ -0,0 +1,26 @@
+package content
+
+import (
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// SetReadingProgress fills the reading progress bar of the pane showing
+// webViewID with how far its page is scrolled, in percent. 0 hides the bar.
+func (c *Coordinator) SetReadingProgress(webViewID port.WebViewID, percent float64) {
+	paneID, ok := c.findPaneByWebViewID(webViewID)
+	if !ok {
+		return
+	}
+	c.setPaneReadingProgress(paneID, percent/100)
+}
+
+func (c *Coordinator) setPaneReadingProgress(paneID entity.PaneID, fraction float64) {
+	_, wsView := c.getActiveWS()
+	if wsView == nil {
+		return
+	}
+	if paneView := wsView.GetPaneView(paneID); paneView != nil {
+		paneView.SetReadingProgress(fraction)
+	}
+}
@@ -0,0 +1,17 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+)
+
+// refreezeRendering re-applies the rendering freeze of a frozen pane to the
+// page it just committed; the freeze script does not survive a navigation.
+func (*Coordinator) refreezeRendering(ctx context.Context, wv port.WebView) {
+	capable, ok := wv.(port.RenderingFreezeCapable)
+	if !ok || !capable.IsRenderingFrozen() {
+		return
+	}
+	capable.SetRenderingFrozen(ctx, true)
+}
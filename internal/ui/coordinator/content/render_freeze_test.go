@@ -0,0 +1,40 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/application/port/mocks"
+)
+
+type renderFreezeWebView struct {
+	*mocks.MockWebView
+	frozen  bool
+	applied []bool
+}
+
+func (w *renderFreezeWebView) SetRenderingFrozen(_ context.Context, frozen bool) {
+	w.frozen = frozen
+	w.applied = append(w.applied, frozen)
+}
+
+func (w *renderFreezeWebView) IsRenderingFrozen() bool {
+	return w.frozen
+}
+
+func TestRefreezeRendering_OnlyReappliesToFrozenPanes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &Coordinator{}
+
+	wv := &renderFreezeWebView{MockWebView: mocks.NewMockWebView(t)}
+	c.refreezeRendering(ctx, wv)
+	assert.Empty(t, wv.applied, "a pane that is not frozen is left alone")
+
+	wv.frozen = true
+	c.refreezeRendering(ctx, wv)
+	assert.Equal(t, []bool{true}, wv.applied)
+}
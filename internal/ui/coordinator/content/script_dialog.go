@@ -0,0 +1,45 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetScriptDialogsConfigProvider sets the source of the live script dialog
+// config used to decide which JavaScript dialogs are auto-dismissed.
+func (c *Coordinator) SetScriptDialogsConfigProvider(fn func() entity.RuntimeScriptDialogsConfig) {
+	c.scriptDialogsConfigProvider = fn
+}
+
+// SetOnScriptDialog sets the callback that presents a JavaScript dialog for
+// a pane. It returns false when the dialog cannot be shown, leaving it to
+// the engine's default handling.
+func (c *Coordinator) SetOnScriptDialog(fn func(ctx context.Context, paneID entity.PaneID, request port.ScriptDialogRequest) bool) {
+	c.onScriptDialog = fn
+}
+
+// handleScriptDialog auto-dismisses the dialog when the config says so and
+// otherwise hands it to the presenter.
+func (c *Coordinator) handleScriptDialog(ctx context.Context, paneID entity.PaneID, request port.ScriptDialogRequest) bool {
+	log := logging.FromContext(ctx)
+
+	if c.scriptDialogsConfigProvider != nil &&
+		usecase.ShouldAutoDismissScriptDialog(c.scriptDialogsConfigProvider(), request.URI) {
+		log.Debug().
+			Str("pane_id", string(paneID)).
+			Str("type", string(request.Type)).
+			Str("uri", request.URI).
+			Msg("auto-dismissing script dialog")
+		request.Respond(request.Type.DismissResponse())
+		return true
+	}
+
+	if c.onScriptDialog == nil {
+		return false
+	}
+	return c.onScriptDialog(ctx, paneID, request)
+}
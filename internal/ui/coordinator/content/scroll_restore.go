@@ -0,0 +1,114 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// scrollMemoryPerPane bounds how many left pages each pane remembers the
+// scroll offset of.
+const scrollMemoryPerPane = 32
+
+// captureScrollPosition remembers where the page being left was scrolled to.
+// It runs when a navigation starts, while the old document is still live.
+func (c *Coordinator) captureScrollPosition(ctx context.Context, paneID entity.PaneID, wv port.WebView) {
+	if wv == nil || wv.IsDestroyed() {
+		return
+	}
+	reader, ok := wv.(port.ScrollPositionReader)
+	if !ok {
+		return
+	}
+	reader.ReadScrollPosition(ctx, func(pageURL string, pos entity.ScrollPosition) {
+		c.scrollMu.Lock()
+		defer c.scrollMu.Unlock()
+		if c.scrollMemories == nil {
+			c.scrollMemories = make(map[entity.PaneID]*entity.ScrollMemory)
+		}
+		memory := c.scrollMemories[paneID]
+		if memory == nil {
+			memory = entity.NewScrollMemory(scrollMemoryPerPane)
+			c.scrollMemories[paneID] = memory
+		}
+		memory.Remember(pageURL, pos)
+	})
+}
+
+// RestoreLastView goes back one history entry in the active pane and, once
+// that page has finished loading, scrolls it to where it was when the pane
+// left it. Unlike a plain GoBack, the reading position comes back even when
+// the engine reloads the page instead of restoring it from its cache.
+func (c *Coordinator) RestoreLastView(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+
+	paneID := c.ActivePaneID(ctx)
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		log.Debug().Msg("restore last view: no active webview")
+		return nil
+	}
+	if !wv.CanGoBack() {
+		log.Debug().Str("pane_id", string(paneID)).Msg("restore last view: no previous page")
+		return nil
+	}
+
+	c.setPendingScrollRestore(paneID, true)
+	if err := wv.GoBack(ctx); err != nil {
+		c.setPendingScrollRestore(paneID, false)
+		return fmt.Errorf("restore last view: %w", err)
+	}
+	return nil
+}
+
+// restorePendingScroll scrolls a page reached through RestoreLastView back to
+// its remembered position. Any finished load settles the pending restore.
+func (c *Coordinator) restorePendingScroll(ctx context.Context, paneID entity.PaneID, wv port.WebView) {
+	c.scrollMu.Lock()
+	pending := c.pendingScrollRestore[paneID]
+	delete(c.pendingScrollRestore, paneID)
+	memory := c.scrollMemories[paneID]
+	c.scrollMu.Unlock()
+
+	if !pending || memory == nil || wv == nil || wv.IsDestroyed() {
+		return
+	}
+	uri := wv.URI()
+	c.scrollMu.Lock()
+	pos, ok := memory.Recall(uri)
+	c.scrollMu.Unlock()
+	if ok {
+		wv.RunJavaScript(ctx, scrollToScript(pos))
+	}
+}
+
+func (c *Coordinator) setPendingScrollRestore(paneID entity.PaneID, pending bool) {
+	c.scrollMu.Lock()
+	defer c.scrollMu.Unlock()
+	if !pending {
+		delete(c.pendingScrollRestore, paneID)
+		return
+	}
+	if c.pendingScrollRestore == nil {
+		c.pendingScrollRestore = make(map[entity.PaneID]bool)
+	}
+	c.pendingScrollRestore[paneID] = true
+}
+
+// forgetScrollPositions drops the scroll memory of a released pane.
+func (c *Coordinator) forgetScrollPositions(paneID entity.PaneID) {
+	c.scrollMu.Lock()
+	delete(c.scrollMemories, paneID)
+	delete(c.pendingScrollRestore, paneID)
+	c.scrollMu.Unlock()
+}
+
+// scrollToScript jumps to pos without smooth scrolling.
+func scrollToScript(pos entity.ScrollPosition) string {
+	return "window.scrollTo({left: " + strconv.FormatFloat(pos.X, 'f', -1, 64) +
+		", top: " + strconv.FormatFloat(pos.Y, 'f', -1, 64) + ", behavior: 'instant'});"
+}
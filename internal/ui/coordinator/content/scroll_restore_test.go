@@ -0,0 +1,110 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+const articleURL = "https://example.com/article"
+
+// scrollWebView reports a fixed scroll offset for the page it is leaving and
+// records navigation and scroll calls in order.
+type scrollWebView struct {
+	*mocks.MockWebView
+	pageURL string
+	pos     entity.ScrollPosition
+	calls   []string
+}
+
+func (w *scrollWebView) ReadScrollPosition(_ context.Context, fn func(string, entity.ScrollPosition)) {
+	fn(w.pageURL, w.pos)
+}
+
+func newScrollWebView(t *testing.T) *scrollWebView {
+	wv := &scrollWebView{MockWebView: mocks.NewMockWebView(t), pageURL: articleURL, pos: entity.ScrollPosition{Y: 840}}
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().URI().Return(articleURL).Maybe()
+	wv.EXPECT().RunJavaScript(mock.Anything, mock.Anything).Run(func(_ context.Context, script string) {
+		wv.calls = append(wv.calls, script)
+	}).Maybe()
+	return wv
+}
+
+func newScrollCoordinator(wv port.WebView) *Coordinator {
+	c := &Coordinator{
+		webViews:    map[entity.PaneID]port.WebView{"pane-1": wv},
+		getActiveWS: func() (*entity.Workspace, *component.WorkspaceView) { return nil, nil },
+	}
+	c.SetActivePaneOverride("pane-1")
+	return c
+}
+
+func TestRestoreLastView_RestoresScrollAfterLoadFinished(t *testing.T) {
+	ctx := context.Background()
+	wv := newScrollWebView(t)
+	wv.EXPECT().CanGoBack().Return(true)
+	wv.EXPECT().GoBack(mock.Anything).RunAndReturn(func(context.Context) error {
+		wv.calls = append(wv.calls, "go_back")
+		return nil
+	})
+	c := newScrollCoordinator(wv)
+
+	// Leaving the article records its scroll offset.
+	c.captureScrollPosition(ctx, "pane-1", wv)
+
+	require.NoError(t, c.RestoreLastView(ctx))
+	assert.Equal(t, []string{"go_back"}, wv.calls, "scroll must wait for the page to load")
+
+	c.onLoadFinished(ctx, "pane-1", wv, webViewIdentity{})
+	assert.Equal(t, []string{
+		"go_back",
+		"window.scrollTo({left: 0, top: 840, behavior: 'instant'});",
+	}, wv.calls)
+
+	c.onLoadFinished(ctx, "pane-1", wv, webViewIdentity{})
+	assert.Len(t, wv.calls, 2, "a later load must not scroll again")
+}
+
+func TestRestoreLastView_PlainLoadDoesNotRestoreScroll(t *testing.T) {
+	ctx := context.Background()
+	wv := newScrollWebView(t)
+	c := newScrollCoordinator(wv)
+
+	c.captureScrollPosition(ctx, "pane-1", wv)
+	c.onLoadFinished(ctx, "pane-1", wv, webViewIdentity{})
+
+	assert.Empty(t, wv.calls)
+}
+
+func TestRestoreLastView_GoBackFailureClearsPendingRestore(t *testing.T) {
+	ctx := context.Background()
+	wv := newScrollWebView(t)
+	wv.EXPECT().CanGoBack().Return(true)
+	wv.EXPECT().GoBack(mock.Anything).Return(errors.New("boom"))
+	c := newScrollCoordinator(wv)
+	c.captureScrollPosition(ctx, "pane-1", wv)
+
+	require.Error(t, c.RestoreLastView(ctx))
+	c.onLoadFinished(ctx, "pane-1", wv, webViewIdentity{})
+
+	assert.Empty(t, wv.calls)
+}
+
+func TestRestoreLastView_NoPreviousPage(t *testing.T) {
+	wv := newScrollWebView(t)
+	wv.EXPECT().CanGoBack().Return(false)
+	c := newScrollCoordinator(wv)
+
+	require.NoError(t, c.RestoreLastView(context.Background()))
+	assert.Empty(t, c.pendingScrollRestore)
+}
@@ -0,0 +1,82 @@
+package content
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/useragent"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// SetSiteUserAgentUC enables per-site desktop/mobile user agent presets.
+func (c *Coordinator) SetSiteUserAgentUC(uc *usecase.ManageSiteUserAgentsUseCase) {
+	c.siteUserAgentUC = uc
+}
+
+// applySiteUserAgent switches the pane's user agent to the preset stored for
+// uri. The committed page was requested with the previous user agent, so a
+// change reloads it once.
+func (c *Coordinator) applySiteUserAgent(ctx context.Context, wv port.WebView, uri string) {
+	if c.siteUserAgentUC == nil || wv == nil || wv.IsDestroyed() {
+		return
+	}
+	if usecase.SiteUserAgentDomain(uri) == "" {
+		return
+	}
+	capable, ok := wv.(port.UserAgentCapable)
+	if !ok {
+		return
+	}
+
+	log := logging.FromContext(ctx)
+	preset, err := c.siteUserAgentUC.Resolve(ctx, uri)
+	if err != nil {
+		log.Warn().Err(err).Str("uri", uri).Msg("site user agent lookup failed")
+		return
+	}
+	if !capable.ApplyUserAgent(ctx, useragent.ForPreset(preset)) {
+		return
+	}
+	log.Debug().Str("uri", uri).Str("preset", string(preset)).Msg("user agent changed, reloading")
+	if err := wv.Reload(ctx); err != nil {
+		log.Warn().Err(err).Str("uri", uri).Msg("failed to reload after user agent change")
+	}
+}
+
+// ToggleSiteUserAgent requests the site loaded in paneID with requested, or
+// returns it to the default user agent when requested is already in effect,
+// and reloads the page. It returns the new preset and the site it applies
+// to; ok is false when the pane's page has no site user agent.
+func (c *Coordinator) ToggleSiteUserAgent(
+	ctx context.Context,
+	paneID entity.PaneID,
+	requested entity.UserAgentPreset,
+) (preset entity.UserAgentPreset, domain string, ok bool) {
+	if c.siteUserAgentUC == nil {
+		return entity.UserAgentPresetDefault, "", false
+	}
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return entity.UserAgentPresetDefault, "", false
+	}
+	capable, capableOK := wv.(port.UserAgentCapable)
+	uri := wv.URI()
+	if !capableOK || usecase.SiteUserAgentDomain(uri) == "" {
+		return entity.UserAgentPresetDefault, "", false
+	}
+
+	log := logging.FromContext(ctx)
+	preset, domain, err := c.siteUserAgentUC.Toggle(ctx, uri, requested)
+	if err != nil {
+		log.Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to toggle site user agent")
+		return preset, domain, false
+	}
+	if capable.ApplyUserAgent(ctx, useragent.ForPreset(preset)) {
+		if err := wv.Reload(ctx); err != nil {
+			log.Warn().Err(err).Str("pane_id", string(paneID)).Msg("failed to reload after user agent change")
+		}
+	}
+	return preset, domain, true
+}
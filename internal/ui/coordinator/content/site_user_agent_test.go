@@ -0,0 +1,113 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/useragent"
+)
+
+type userAgentWebView struct {
+	*mocks.MockWebView
+	current string
+}
+
+func (w *userAgentWebView) ApplyUserAgent(_ context.Context, userAgent string) bool {
+	changed := userAgent != w.current
+	w.current = userAgent
+	return changed
+}
+
+type memorySiteUserAgentRepo struct {
+	sites map[string]*entity.SiteUserAgent
+}
+
+func (r *memorySiteUserAgentRepo) Get(_ context.Context, domain string) (*entity.SiteUserAgent, error) {
+	return r.sites[domain], nil
+}
+
+func (r *memorySiteUserAgentRepo) Set(_ context.Context, site *entity.SiteUserAgent) error {
+	r.sites[site.Domain] = site
+	return nil
+}
+
+func (r *memorySiteUserAgentRepo) Delete(_ context.Context, domain string) error {
+	delete(r.sites, domain)
+	return nil
+}
+
+func newSiteUserAgentCoordinator(sites map[string]*entity.SiteUserAgent) *Coordinator {
+	c := &Coordinator{}
+	c.SetSiteUserAgentUC(usecase.NewManageSiteUserAgentsUseCase(&memorySiteUserAgentRepo{sites: sites}))
+	return c
+}
+
+func TestApplySiteUserAgent_ReloadsOnlyWhenUserAgentChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &userAgentWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+	wv.EXPECT().Reload(ctx).Return(nil).Times(2)
+
+	c := newSiteUserAgentCoordinator(map[string]*entity.SiteUserAgent{
+		"m.example": {Domain: "m.example", Preset: entity.UserAgentPresetMobile},
+	})
+
+	c.applySiteUserAgent(ctx, wv, "https://news.test/")      // default, unchanged
+	c.applySiteUserAgent(ctx, wv, "https://m.example/")      // mobile, reload
+	c.applySiteUserAgent(ctx, wv, "https://m.example/about") // same preset
+	c.applySiteUserAgent(ctx, wv, "dumb://home")             // internal page, skipped
+	assert.Equal(t, useragent.ForPreset(entity.UserAgentPresetMobile), wv.current)
+	c.applySiteUserAgent(ctx, wv, "https://news.test/next") // back to default, reload
+
+	assert.Empty(t, wv.current)
+}
+
+func TestToggleSiteUserAgent_PersistsAndReloads(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wv := &userAgentWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+	wv.EXPECT().URI().Return("https://www.example.com/page")
+	wv.EXPECT().Reload(ctx).Return(nil).Times(2)
+
+	sites := map[string]*entity.SiteUserAgent{}
+	c := newSiteUserAgentCoordinator(sites)
+	c.webViews = map[entity.PaneID]port.WebView{"pane-1": wv}
+
+	preset, domain, ok := c.ToggleSiteUserAgent(ctx, "pane-1", entity.UserAgentPresetDesktop)
+	require.True(t, ok)
+	assert.Equal(t, entity.UserAgentPresetDesktop, preset)
+	assert.Equal(t, "example.com", domain)
+	assert.Equal(t, useragent.ForPreset(entity.UserAgentPresetDesktop), wv.current)
+	require.Contains(t, sites, "example.com")
+
+	preset, _, ok = c.ToggleSiteUserAgent(ctx, "pane-1", entity.UserAgentPresetDesktop)
+	require.True(t, ok)
+	assert.Equal(t, entity.UserAgentPresetDefault, preset)
+	assert.Empty(t, wv.current)
+	assert.NotContains(t, sites, "example.com")
+}
+
+func TestToggleSiteUserAgent_SkipsInternalPages(t *testing.T) {
+	t.Parallel()
+
+	wv := &userAgentWebView{MockWebView: mocks.NewMockWebView(t)}
+	wv.EXPECT().IsDestroyed().Return(false)
+	wv.EXPECT().URI().Return("dumb://home")
+
+	c := newSiteUserAgentCoordinator(map[string]*entity.SiteUserAgent{})
+	c.webViews = map[entity.PaneID]port.WebView{"pane-1": wv}
+
+	_, _, ok := c.ToggleSiteUserAgent(context.Background(), "pane-1", entity.UserAgentPresetMobile)
+	assert.False(t, ok)
+}
@@ -230,11 +230,16 @@ func (c *Coordinator) resolveCommittedFavicon(ctx context.Context, paneID entity
 	gen := wv.Generation()
 	capturedURI := uri
 	c.faviconAdapter.GetOrFetch(ctx, uri, func(texture *gdk.Texture) {
-		// Skip nil results — a nil means "couldn't resolve", not "no favicon".
-		// Without this guard, a late nil callback can overwrite a good favicon
-		// that was already set by an earlier onFaviconChanged signal.
+		// A nil means "couldn't resolve", not "no favicon". Only fall back to
+		// a monogram when nothing is cached yet; otherwise a late nil callback
+		// could overwrite a good favicon set by an earlier onFaviconChanged signal.
 		if texture == nil {
-			return
+			if c.faviconAdapter.GetTextureByURL(capturedURI) != nil {
+				return
+			}
+			if texture = c.faviconAdapter.FallbackTexture(ctx, capturedURI); texture == nil {
+				return
+			}
 		}
 		// Verify WebView is still bound to pane and hasn't been reused
 		if wv.Generation() != gen {
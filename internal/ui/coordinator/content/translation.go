@@ -0,0 +1,133 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/translation"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// TranslateOffer is the offer to translate a page made when it loads in a
+// language other than the preferred one.
+type TranslateOffer struct {
+	// From is the page language and To the language it would be translated to.
+	From string
+	To   string
+}
+
+// Message describes the offer for a toast.
+func (o TranslateOffer) Message() string {
+	return fmt.Sprintf("This page is in %s: translate_page shows it in %s",
+		translation.LanguageName(o.From), translation.LanguageName(o.To))
+}
+
+// systemLanguage returns the language of the system locale, used when no
+// preferred language is configured. Tests replace it.
+var systemLanguage = func() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if lang := translation.LanguageFromLocale(os.Getenv(name)); lang != "" {
+			return lang
+		}
+	}
+	return ""
+}
+
+// SetTranslationConfigProvider sets the source of the live translation
+// settings.
+func (c *Coordinator) SetTranslationConfigProvider(fn func() entity.RuntimeTranslationConfig) {
+	c.translationConfigProvider = fn
+}
+
+// SetOnTranslateOffer sets the callback run when a page loads in a language
+// other than the preferred one, to offer translating it.
+func (c *Coordinator) SetOnTranslateOffer(fn func(ctx context.Context, paneID entity.PaneID, offer TranslateOffer)) {
+	c.onTranslateOffer = fn
+}
+
+// preferredLanguage returns the language pages are translated to.
+func preferredLanguage(cfg entity.RuntimeTranslationConfig) string {
+	if lang := translation.NormalizeLanguage(cfg.PreferredLanguage); lang != "" {
+		return lang
+	}
+	return systemLanguage()
+}
+
+// SetPageLanguage records the language of the page in webViewID, as its
+// page_language script reported it, and offers to translate the page when
+// the prompt is on and the language is neither preferred nor ignored.
+func (c *Coordinator) SetPageLanguage(ctx context.Context, webViewID port.WebViewID, lang string) {
+	paneID, ok := c.findPaneByWebViewID(webViewID)
+	if !ok {
+		return
+	}
+	c.pageLanguageMu.Lock()
+	if c.pageLanguages == nil {
+		c.pageLanguages = make(map[entity.PaneID]string)
+	}
+	c.pageLanguages[paneID] = lang
+	c.pageLanguageMu.Unlock()
+
+	if c.translationConfigProvider == nil || c.onTranslateOffer == nil {
+		return
+	}
+	cfg := c.translationConfigProvider()
+	// Pages loaded before the prompt was turned off still report.
+	if !cfg.Prompt {
+		return
+	}
+	to := preferredLanguage(cfg)
+	if !translation.ShouldOffer(lang, to, cfg.IgnoredLanguages) {
+		return
+	}
+	logging.FromContext(ctx).Debug().
+		Str("pane_id", string(paneID)).
+		Str("from", lang).
+		Str("to", to).
+		Msg("offering page translation")
+	c.onTranslateOffer(ctx, paneID, TranslateOffer{From: lang, To: to})
+}
+
+// PaneLanguage returns the language the page of paneID reported, or "" when
+// it is unknown.
+func (c *Coordinator) PaneLanguage(paneID entity.PaneID) string {
+	c.pageLanguageMu.Lock()
+	defer c.pageLanguageMu.Unlock()
+	return c.pageLanguages[paneID]
+}
+
+func (c *Coordinator) forgetPageLanguage(paneID entity.PaneID) {
+	c.pageLanguageMu.Lock()
+	defer c.pageLanguageMu.Unlock()
+	delete(c.pageLanguages, paneID)
+}
+
+// TranslatePane loads the translation of the page of paneID, built from
+// translation.url_template, and returns the language it is translated to.
+// A page whose language is unknown leaves detecting it to the service.
+func (c *Coordinator) TranslatePane(ctx context.Context, paneID entity.PaneID) (string, error) {
+	wv := c.getWebViewLocked(paneID)
+	if wv == nil || wv.IsDestroyed() {
+		return "", errors.New("no page in this pane")
+	}
+	if c.translationConfigProvider == nil {
+		return "", errors.New("translation is not set up")
+	}
+	cfg := c.translationConfigProvider()
+	to := preferredLanguage(cfg)
+	if to == "" {
+		return "", errors.New("set translation.preferred_language")
+	}
+	target, err := translation.BuildURL(cfg.URLTemplate, wv.URI(), c.PaneLanguage(paneID), to)
+	if err != nil {
+		return "", err
+	}
+	if err := wv.LoadURI(ctx, target); err != nil {
+		return "", err
+	}
+	return to, nil
+}
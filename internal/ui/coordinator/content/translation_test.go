@@ -0,0 +1,125 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/domain/translation"
+)
+
+// stubSystemLanguage makes lang the language of the system locale.
+func stubSystemLanguage(t *testing.T, lang string) {
+	t.Helper()
+	original := systemLanguage
+	systemLanguage = func() string { return lang }
+	t.Cleanup(func() { systemLanguage = original })
+}
+
+func newTranslationCoordinator(
+	t *testing.T,
+	cfg entity.RuntimeTranslationConfig,
+) (*Coordinator, *mocks.MockWebView, *[]TranslateOffer) {
+	t.Helper()
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().IsDestroyed().Return(false).Maybe()
+	wv.EXPECT().URI().Return("https://journal.example/article").Maybe()
+	wv.EXPECT().ID().Return(11).Maybe()
+
+	c := &Coordinator{
+		webViews:       map[entity.PaneID]port.WebView{"pane-1": wv},
+		webViewPaneIDs: map[port.WebViewID]entity.PaneID{11: "pane-1"},
+	}
+	c.SetTranslationConfigProvider(func() entity.RuntimeTranslationConfig { return cfg })
+	var offers []TranslateOffer
+	c.SetOnTranslateOffer(func(_ context.Context, _ entity.PaneID, offer TranslateOffer) {
+		offers = append(offers, offer)
+	})
+	return c, wv, &offers
+}
+
+func TestSetPageLanguage_OffersForeignPages(t *testing.T) {
+	stubSystemLanguage(t, "en")
+	ctx := context.Background()
+	c, _, offers := newTranslationCoordinator(t, entity.RuntimeTranslationConfig{
+		Prompt:           true,
+		URLTemplate:      translation.DefaultURLTemplate,
+		IgnoredLanguages: []string{"es"},
+	})
+
+	c.SetPageLanguage(ctx, 11, "en")
+	c.SetPageLanguage(ctx, 11, "es")
+	assert.Empty(t, *offers, "preferred and ignored languages are not offered")
+
+	c.SetPageLanguage(ctx, 11, "fr")
+	require.Equal(t, []TranslateOffer{{From: "fr", To: "en"}}, *offers)
+	assert.Equal(t, "This page is in French: translate_page shows it in English", (*offers)[0].Message())
+	assert.Equal(t, "fr", c.PaneLanguage("pane-1"))
+
+	c.SetPageLanguage(ctx, 99, "de")
+	assert.Len(t, *offers, 1, "unknown web views are ignored")
+
+	c.forgetPageLanguage("pane-1")
+	assert.Empty(t, c.PaneLanguage("pane-1"))
+}
+
+func TestSetPageLanguage_PromptOffRecordsOnly(t *testing.T) {
+	stubSystemLanguage(t, "en")
+	c, _, offers := newTranslationCoordinator(t, entity.RuntimeTranslationConfig{
+		URLTemplate: translation.DefaultURLTemplate,
+	})
+
+	c.SetPageLanguage(context.Background(), 11, "ja")
+	assert.Empty(t, *offers)
+	assert.Equal(t, "ja", c.PaneLanguage("pane-1"))
+}
+
+func TestSetPageLanguage_ConfiguredLanguageOverridesLocale(t *testing.T) {
+	stubSystemLanguage(t, "fr")
+	c, _, offers := newTranslationCoordinator(t, entity.RuntimeTranslationConfig{
+		Prompt:            true,
+		PreferredLanguage: "de-CH",
+		URLTemplate:       translation.DefaultURLTemplate,
+	})
+
+	c.SetPageLanguage(context.Background(), 11, "fr")
+	assert.Equal(t, []TranslateOffer{{From: "fr", To: "de"}}, *offers)
+}
+
+func TestTranslatePane_LoadsTranslationURL(t *testing.T) {
+	stubSystemLanguage(t, "en")
+	ctx := context.Background()
+	c, wv, _ := newTranslationCoordinator(t, entity.RuntimeTranslationConfig{
+		URLTemplate: "https://translate.example/?sl={from}&tl={to}&u={url}",
+	})
+
+	wv.EXPECT().LoadURI(ctx, "https://translate.example/?sl=auto&tl=en&u=https%3A%2F%2Fjournal.example%2Farticle").
+		Return(nil).Once()
+	to, err := c.TranslatePane(ctx, "pane-1")
+	require.NoError(t, err)
+	assert.Equal(t, "en", to)
+
+	c.SetPageLanguage(ctx, 11, "it")
+	wv.EXPECT().LoadURI(ctx, "https://translate.example/?sl=it&tl=en&u=https%3A%2F%2Fjournal.example%2Farticle").
+		Return(nil).Once()
+	_, err = c.TranslatePane(ctx, "pane-1")
+	require.NoError(t, err)
+}
+
+func TestTranslatePane_NeedsTargetLanguage(t *testing.T) {
+	stubSystemLanguage(t, "")
+	c, _, _ := newTranslationCoordinator(t, entity.RuntimeTranslationConfig{
+		URLTemplate: translation.DefaultURLTemplate,
+	})
+
+	_, err := c.TranslatePane(context.Background(), "pane-1")
+	assert.ErrorContains(t, err, "translation.preferred_language")
+
+	_, err = c.TranslatePane(context.Background(), "pane-2")
+	assert.ErrorContains(t, err, "no page")
+}
@@ -28,6 +28,8 @@ type TabCoordinator struct {
 	currentTarget           TabTarget
 	mainWindow              *window.MainWindow // retained for GetTabBar and SetMainWindow
 	hideTabBarWhenSingleTab bool
+	tabBarMode              entity.TabBarMode
+	tabModeActive           bool
 
 	// Callbacks to avoid circular dependencies
 	onTabCreated         func(ctx context.Context, target TabTarget, tab *entity.Tab)
@@ -45,6 +47,7 @@ type TabCoordinatorConfig struct {
 	Tabs                    *entity.TabList // Initial tab list for the default target
 	MainWindow              *window.MainWindow
 	HideTabBarWhenSingleTab bool
+	TabBarMode              entity.TabBarMode
 }
 
 // NewTabCoordinator creates a new TabCoordinator.
@@ -60,6 +63,7 @@ func NewTabCoordinator(ctx context.Context, cfg TabCoordinatorConfig) *TabCoordi
 		},
 		mainWindow:              cfg.MainWindow,
 		hideTabBarWhenSingleTab: cfg.HideTabBarWhenSingleTab,
+		tabBarMode:              cfg.TabBarMode,
 	}
 }
 
@@ -298,6 +302,69 @@ func (c *TabCoordinator) Close(ctx context.Context, target TabTarget) error {
 	return c.recoverCloseWithoutSibling(ctx, target, activeID)
 }
 
+// CloseToTheRight closes the unpinned tabs after tabID in the given target.
+func (c *TabCoordinator) CloseToTheRight(ctx context.Context, target TabTarget, tabID entity.TabID) error {
+	return c.closeMany(ctx, target, tabID, c.tabsUC.CloseToTheRight)
+}
+
+// CloseOthers closes the unpinned tabs other than tabID in the given target.
+func (c *TabCoordinator) CloseOthers(ctx context.Context, target TabTarget, tabID entity.TabID) error {
+	return c.closeMany(ctx, target, tabID, c.tabsUC.CloseOthers)
+}
+
+func (c *TabCoordinator) closeMany(
+	ctx context.Context,
+	target TabTarget,
+	tabID entity.TabID,
+	closeTabs func(context.Context, *entity.TabList, entity.TabID) ([]*entity.Tab, error),
+) error {
+	log := logging.FromContext(ctx)
+
+	if target.Tabs == nil {
+		return fmt.Errorf("target.Tabs is nil")
+	}
+
+	activeID := target.Tabs.ActiveTabID
+	closed, err := closeTabs(ctx, target.Tabs, tabID)
+	if err != nil {
+		log.Error().Err(err).Str("tab_id", string(tabID)).Msg("failed to close tabs")
+		return err
+	}
+	if len(closed) == 0 {
+		return nil
+	}
+
+	var tabBar *component.TabBar
+	if target.MainWindow != nil {
+		tabBar = target.MainWindow.TabBar()
+	}
+	for _, tab := range closed {
+		if c.onTabClosed != nil {
+			c.onTabClosed(ctx, target, tab)
+		}
+		if tabBar != nil {
+			tabBar.RemoveTab(tab.ID)
+		}
+	}
+
+	if newActiveID := target.Tabs.ActiveTabID; newActiveID != activeID {
+		if tabBar != nil {
+			tabBar.SetActive(newActiveID)
+		}
+		if c.onTabSwitched != nil {
+			if tab := target.Tabs.Find(newActiveID); tab != nil {
+				c.onTabSwitched(ctx, target, tab)
+			}
+		}
+	}
+
+	c.UpdateBarVisibility(ctx, target)
+	c.notifyStateChanged()
+
+	log.Debug().Str("tab_id", string(tabID)).Int("closed", len(closed)).Msg("tabs closed")
+	return nil
+}
+
 func (c *TabCoordinator) recoverCloseWithoutSibling(ctx context.Context, target TabTarget, closedID entity.TabID) error {
 	log := logging.FromContext(ctx)
 	// Defensive corrupted-state guard: only treat the target as empty if it is
@@ -353,6 +420,7 @@ func (c *TabCoordinator) Switch(ctx context.Context, target TabTarget, tabID ent
 	if target.MainWindow != nil && target.MainWindow.TabBar() != nil {
 		target.MainWindow.TabBar().SetActive(tabID)
 	}
+	c.syncTabGroups(target)
 
 	// Invoke callback for workspace view switching
 	if c.onTabSwitched != nil {
@@ -366,6 +434,7 @@ func (c *TabCoordinator) Switch(ctx context.Context, target TabTarget, tabID ent
 
 // switchRelative switches to the tab delta positions away within the target.
 // delta of +1 switches next, -1 switches previous, wrapping within the list.
+// Tabs folded away by a collapsed group are skipped.
 func (c *TabCoordinator) switchRelative(ctx context.Context, target TabTarget, delta int) error {
 	if target.Tabs == nil {
 		return fmt.Errorf("target.Tabs is nil")
@@ -377,12 +446,17 @@ func (c *TabCoordinator) switchRelative(ctx context.Context, target TabTarget, d
 
 	current := indexOfTab(tabs, target.Tabs.ActiveTabID)
 	if current < 0 {
-		current = 0
-	} else {
-		current = (current + delta + len(tabs)) % len(tabs)
+		return c.Switch(ctx, target, tabs[0].ID)
+	}
+	next := current
+	for range len(tabs) - 1 {
+		next = (next + delta + len(tabs)) % len(tabs)
+		if !target.Tabs.IsTabHidden(tabs[next].ID) {
+			break
+		}
 	}
 
-	return c.Switch(ctx, target, tabs[current].ID)
+	return c.Switch(ctx, target, tabs[next].ID)
 }
 
 // SwitchNext switches to the next tab within the given target.
@@ -464,7 +538,9 @@ func (c *TabCoordinator) SwitchToLastActive(ctx context.Context, target TabTarge
 	return c.Switch(ctx, target, prevID)
 }
 
-// UpdateBarVisibility shows or hides the tab bar based on tab count in the target.
+// UpdateBarVisibility shows or hides the tab bar based on the tab bar mode
+// and the tab count in the target, and the tabs folded away by collapsed
+// groups.
 func (c *TabCoordinator) UpdateBarVisibility(ctx context.Context, target TabTarget) {
 	log := logging.FromContext(ctx)
 
@@ -472,23 +548,51 @@ func (c *TabCoordinator) UpdateBarVisibility(ctx context.Context, target TabTarg
 		log.Debug().Msg("mainWindow or tabBar is nil, skipping visibility update")
 		return
 	}
-
-	// Check if feature is enabled
-	if !c.hideTabBarWhenSingleTab {
-		log.Debug().Msg("tab bar auto-hide disabled by config")
-		target.MainWindow.TabBar().SetAutoHidden(false)
-		target.MainWindow.SetTabBarContentInsetVisible(true)
-		return
-	}
+	c.syncTabGroups(target)
 
 	tabCount := target.MainWindow.TabBar().Count()
-	shouldShow := tabCount > 1
+	shouldShow := component.TabBarShown(c.tabBarMode, c.hideTabBarWhenSingleTab, tabCount, c.tabModeActive)
 
-	log.Debug().Int("tab_count", tabCount).Bool("should_show", shouldShow).Msg("setting tab bar visibility")
+	log.Debug().
+		Str("mode", string(c.tabBarMode)).
+		Int("tab_count", tabCount).
+		Bool("should_show", shouldShow).
+		Msg("setting tab bar visibility")
 	target.MainWindow.TabBar().SetAutoHidden(!shouldShow)
 	target.MainWindow.SetTabBarContentInsetVisible(shouldShow)
 }
 
+// SetTabModeActive records whether tab mode is active, which is when the
+// overview tab bar mode shows the tab bar, and refreshes the target's bar.
+func (c *TabCoordinator) SetTabModeActive(ctx context.Context, target TabTarget, active bool) {
+	if c.tabModeActive == active {
+		return
+	}
+	c.tabModeActive = active
+	c.UpdateBarVisibility(ctx, target)
+}
+
+// TabModeActive reports whether tab mode is active.
+func (c *TabCoordinator) TabModeActive() bool {
+	return c.tabModeActive
+}
+
+// Move repositions a tab within the given target and moves its tab bar button
+// to match.
+func (c *TabCoordinator) Move(ctx context.Context, target TabTarget, tabID entity.TabID, position int) error {
+	if target.Tabs == nil {
+		return fmt.Errorf("target.Tabs is nil")
+	}
+	if err := c.tabsUC.Move(ctx, target.Tabs, tabID, position); err != nil {
+		return err
+	}
+	if target.MainWindow != nil && target.MainWindow.TabBar() != nil {
+		target.MainWindow.TabBar().MoveTab(tabID, position)
+	}
+	c.notifyStateChanged()
+	return nil
+}
+
 // GetTabBar returns the tab bar component from the current main window.
 func (c *TabCoordinator) GetTabBar() *component.TabBar {
 	if c.mainWindow != nil {
@@ -497,6 +601,89 @@ func (c *TabCoordinator) GetTabBar() *component.TabBar {
 	return nil
 }
 
+// AddRestored appends a tab rebuilt from a snapshot, such as a reopened
+// closed tab, to the given target and switches to it. Its panes load when the
+// workspace view is created.
+func (c *TabCoordinator) AddRestored(ctx context.Context, target TabTarget, tab *entity.Tab) error {
+	log := logging.FromContext(ctx)
+
+	if target.Tabs == nil {
+		return fmt.Errorf("target.Tabs is nil")
+	}
+	if tab == nil || tab.Workspace == nil {
+		return fmt.Errorf("tab has no workspace")
+	}
+
+	target.Tabs.Add(tab)
+	target.Tabs.SetActive(tab.ID)
+
+	// Notify app before adding the tab to the visible tab bar.
+	if c.onTabCreated != nil {
+		c.onTabCreated(ctx, target, tab)
+	}
+
+	if target.MainWindow != nil && target.MainWindow.TabBar() != nil {
+		target.MainWindow.TabBar().AddTab(tab)
+		target.MainWindow.TabBar().SetActive(tab.ID)
+	}
+
+	c.UpdateBarVisibility(ctx, target)
+
+	if c.onTabSwitched != nil {
+		c.onTabSwitched(ctx, target, tab)
+	}
+
+	// Notify state change for session snapshots
+	c.notifyStateChanged()
+
+	log.Debug().
+		Str("tab_id", string(tab.ID)).
+		Int("panes", tab.PaneCount()).
+		Msg("restored tab added")
+	return nil
+}
+
+// MergeSession appends the tabs of a saved session to the target's tab list,
+// with fresh IDs, adds them to the tab bar and switches to the first of them.
+func (c *TabCoordinator) MergeSession(
+	ctx context.Context, target TabTarget, state *entity.SessionState,
+) ([]*entity.Tab, error) {
+	if target.Tabs == nil {
+		return nil, fmt.Errorf("target.Tabs is nil")
+	}
+
+	merged, err := c.tabsUC.Merge(ctx, target.Tabs, state)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tab := range merged {
+		// Notify app before adding the tab to the visible tab bar.
+		if c.onTabCreated != nil {
+			c.onTabCreated(ctx, target, tab)
+		}
+		if target.MainWindow != nil && target.MainWindow.TabBar() != nil {
+			target.MainWindow.TabBar().AddTab(tab)
+		}
+	}
+
+	if len(merged) > 0 {
+		first := merged[0]
+		target.Tabs.SetActive(first.ID)
+		if target.MainWindow != nil && target.MainWindow.TabBar() != nil {
+			target.MainWindow.TabBar().SetActive(first.ID)
+		}
+		c.UpdateBarVisibility(ctx, target)
+		if c.onTabSwitched != nil {
+			c.onTabSwitched(ctx, target, first)
+		}
+	}
+
+	// Notify state change for session snapshots
+	c.notifyStateChanged()
+	return merged, nil
+}
+
 // CreateWithPane creates a new tab with a pre-created pane and WebView in the given target.
 // This is used for tabbed popup behavior where the popup pane already exists.
 func (c *TabCoordinator) CreateWithPane(
@@ -0,0 +1,124 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// GroupTab moves tabID into a new tab group of its own in the given target.
+func (c *TabCoordinator) GroupTab(ctx context.Context, target TabTarget, tabID entity.TabID) error {
+	if target.Tabs == nil {
+		return fmt.Errorf("target.Tabs is nil")
+	}
+	if _, err := c.tabsUC.CreateGroup(ctx, usecase.CreateTabGroupInput{
+		TabList: target.Tabs,
+		TabIDs:  []entity.TabID{tabID},
+	}); err != nil {
+		return err
+	}
+	c.tabGroupsChanged(target)
+	return nil
+}
+
+// AssignTabGroup moves tabID into the group groupID in the given target. An
+// empty groupID takes the tab out of its group.
+func (c *TabCoordinator) AssignTabGroup(
+	ctx context.Context, target TabTarget, tabID entity.TabID, groupID entity.TabGroupID,
+) error {
+	if target.Tabs == nil {
+		return fmt.Errorf("target.Tabs is nil")
+	}
+	if err := c.tabsUC.AssignToGroup(ctx, target.Tabs, tabID, groupID); err != nil {
+		return err
+	}
+	c.tabGroupsChanged(target)
+	return nil
+}
+
+// ToggleTabGroupCollapsed collapses the group of tabID, or expands it when it
+// is collapsed. Ungrouped tabs are left alone.
+func (c *TabCoordinator) ToggleTabGroupCollapsed(ctx context.Context, target TabTarget, tabID entity.TabID) error {
+	if target.Tabs == nil {
+		return fmt.Errorf("target.Tabs is nil")
+	}
+	group := target.Tabs.GroupOf(tabID)
+	if group == nil {
+		logging.FromContext(ctx).Debug().Str("tab_id", string(tabID)).Msg("tab is not grouped, nothing to collapse")
+		return nil
+	}
+	if err := c.tabsUC.SetGroupCollapsed(ctx, target.Tabs, group.ID, !group.Collapsed); err != nil {
+		return err
+	}
+	c.tabGroupsChanged(target)
+	return nil
+}
+
+// TabGroupMenu returns the tab group entries of the context menu of tabID.
+func (c *TabCoordinator) TabGroupMenu(ctx context.Context, target TabTarget, tabID entity.TabID) []component.TabMenuEntry {
+	if target.Tabs == nil {
+		return nil
+	}
+	log := logging.FromContext(ctx)
+	logErr := func(op string) func(error) {
+		return func(err error) {
+			if err != nil {
+				log.Error().Err(err).Str("tab_id", string(tabID)).Msg(op + " failed")
+			}
+		}
+	}
+
+	entries := []component.TabMenuEntry{{
+		Label:    "Add to New Group",
+		Activate: func() { logErr("group tab")(c.GroupTab(ctx, target, tabID)) },
+	}}
+	current := target.Tabs.GroupOf(tabID)
+	for _, group := range target.Tabs.Groups {
+		if group == current {
+			continue
+		}
+		groupID := group.ID
+		entries = append(entries, component.TabMenuEntry{
+			Label:    fmt.Sprintf("Add to Group %q", group.Name),
+			Activate: func() { logErr("assign tab group")(c.AssignTabGroup(ctx, target, tabID, groupID)) },
+		})
+	}
+	if current == nil {
+		return entries
+	}
+
+	collapseLabel := "Collapse Group"
+	if current.Collapsed {
+		collapseLabel = "Expand Group"
+	}
+	return append(entries,
+		component.TabMenuEntry{
+			Label:    "Remove from Group",
+			Activate: func() { logErr("ungroup tab")(c.AssignTabGroup(ctx, target, tabID, "")) },
+		},
+		component.TabMenuEntry{
+			Label:    collapseLabel,
+			Activate: func() { logErr("toggle tab group")(c.ToggleTabGroupCollapsed(ctx, target, tabID)) },
+		},
+	)
+}
+
+// tabGroupsChanged refreshes the group marks of the target's tab bar and
+// records the change for session snapshots.
+func (c *TabCoordinator) tabGroupsChanged(target TabTarget) {
+	c.syncTabGroups(target)
+	c.notifyStateChanged()
+}
+
+// syncTabGroups updates the group marks of the target's tab bar and hides
+// the tabs collapsed groups fold away.
+func (*TabCoordinator) syncTabGroups(target TabTarget) {
+	if target.Tabs == nil || target.MainWindow == nil || target.MainWindow.TabBar() == nil {
+		return
+	}
+	target.MainWindow.TabBar().SetGroups(component.TabStripItems(target.Tabs))
+}
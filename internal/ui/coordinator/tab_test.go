@@ -271,3 +271,145 @@ func TestTabCoordinator_SwitchByIndexCreatingMissingURLError(t *testing.T) {
 	// Tab count should remain 1.
 	assert.Equal(t, 1, targetTabs.Count(), "tab count should remain 1 after error")
 }
+
+// TestTabCoordinator_CloseOthersReleasesClosedTabsAndSwitches verifies that a
+// bulk close fires onTabClosed for each closed tab and switches to the anchor
+// tab when the active tab was among them.
+func TestTabCoordinator_CloseOthersReleasesClosedTabsAndSwitches(t *testing.T) {
+	ctx := context.Background()
+
+	tabs := entity.NewTabList()
+	for _, id := range []string{"a", "b", "c"} {
+		tabs.Add(entity.NewTab(entity.TabID(id), entity.WorkspaceID("ws-"+id), entity.NewPane(entity.PaneID("pane-"+id))))
+	}
+	tabs.Find("a").IsPinned = true
+	tabs.SetActive("c")
+
+	coord := NewTabCoordinator(ctx, TabCoordinatorConfig{TabsUC: usecase.NewManageTabsUseCase(counterIDGen(), nil)})
+	var closedIDs, switchedIDs []entity.TabID
+	coord.SetOnTabClosed(func(_ context.Context, _ TabTarget, tab *entity.Tab) {
+		closedIDs = append(closedIDs, tab.ID)
+	})
+	coord.SetOnTabSwitched(func(_ context.Context, _ TabTarget, tab *entity.Tab) {
+		switchedIDs = append(switchedIDs, tab.ID)
+	})
+
+	require.NoError(t, coord.CloseOthers(ctx, TabTarget{Tabs: tabs}, "b"))
+	assert.Equal(t, []entity.TabID{"c"}, closedIDs)
+	assert.Equal(t, []entity.TabID{"b"}, switchedIDs)
+	assert.Equal(t, entity.TabID("b"), tabs.ActiveTabID)
+	assert.Equal(t, 2, tabs.Count())
+
+	closedIDs, switchedIDs = nil, nil
+	require.NoError(t, coord.CloseToTheRight(ctx, TabTarget{Tabs: tabs}, "a"))
+	assert.Equal(t, []entity.TabID{"b"}, closedIDs)
+	assert.Equal(t, []entity.TabID{"a"}, switchedIDs)
+	assert.Equal(t, 1, tabs.Count())
+
+	closedIDs, switchedIDs = nil, nil
+	require.NoError(t, coord.CloseOthers(ctx, TabTarget{Tabs: tabs}, "a"))
+	assert.Empty(t, closedIDs)
+	assert.Empty(t, switchedIDs)
+}
+
+func TestTabCoordinator_MoveReordersTargetAndNotifies(t *testing.T) {
+	ctx := context.Background()
+
+	tabs := entity.NewTabList()
+	for _, id := range []string{"a", "b", "c"} {
+		tabs.Add(entity.NewTab(entity.TabID(id), entity.WorkspaceID("ws-"+id), entity.NewPane(entity.PaneID("pane-"+id))))
+	}
+
+	coord := NewTabCoordinator(ctx, TabCoordinatorConfig{TabsUC: usecase.NewManageTabsUseCase(counterIDGen(), nil)})
+	stateChanges := 0
+	coord.SetOnStateChanged(func() { stateChanges++ })
+
+	require.NoError(t, coord.Move(ctx, TabTarget{Tabs: tabs}, "a", 2))
+	var order []entity.TabID
+	for i, tab := range tabs.Tabs {
+		order = append(order, tab.ID)
+		assert.Equal(t, i, tab.Position)
+	}
+	assert.Equal(t, []entity.TabID{"b", "c", "a"}, order)
+	assert.Equal(t, 1, stateChanges)
+
+	require.Error(t, coord.Move(ctx, TabTarget{Tabs: tabs}, "a", 3))
+	require.Error(t, coord.Move(ctx, TabTarget{Tabs: tabs}, "missing", 0))
+	require.Error(t, coord.Move(ctx, TabTarget{}, "a", 0))
+	assert.Equal(t, 1, stateChanges)
+}
+
+func TestTabCoordinator_TabGroupsCollapseAndSkipHiddenTabs(t *testing.T) {
+	ctx := context.Background()
+
+	tabs := entity.NewTabList()
+	for _, id := range []string{"a", "b", "c", "d"} {
+		tabs.Add(entity.NewTab(entity.TabID(id), entity.WorkspaceID("ws-"+id), entity.NewPane(entity.PaneID("pane-"+id))))
+	}
+	target := TabTarget{Tabs: tabs}
+
+	coord := NewTabCoordinator(ctx, TabCoordinatorConfig{TabsUC: usecase.NewManageTabsUseCase(counterIDGen(), nil)})
+	stateChanges := 0
+	coord.SetOnStateChanged(func() { stateChanges++ })
+
+	require.NoError(t, coord.GroupTab(ctx, target, "b"))
+	group := tabs.GroupOf("b")
+	require.NotNil(t, group)
+	require.NoError(t, coord.AssignTabGroup(ctx, target, "c", group.ID))
+	require.NoError(t, coord.ToggleTabGroupCollapsed(ctx, target, "c"))
+	assert.True(t, group.Collapsed)
+	assert.Equal(t, 3, stateChanges)
+
+	// From a, next shows b for the collapsed group and skips c.
+	require.NoError(t, coord.SwitchNext(ctx, target))
+	assert.Equal(t, entity.TabID("b"), tabs.ActiveTabID)
+	require.NoError(t, coord.SwitchNext(ctx, target))
+	assert.Equal(t, entity.TabID("d"), tabs.ActiveTabID)
+	require.NoError(t, coord.SwitchPrev(ctx, target))
+	assert.Equal(t, entity.TabID("b"), tabs.ActiveTabID)
+
+	require.NoError(t, coord.ToggleTabGroupCollapsed(ctx, target, "b"))
+	assert.False(t, group.Collapsed)
+	require.NoError(t, coord.SwitchNext(ctx, target))
+	assert.Equal(t, entity.TabID("c"), tabs.ActiveTabID, "an expanded group hides nothing")
+
+	changes := stateChanges
+	require.NoError(t, coord.ToggleTabGroupCollapsed(ctx, target, "a"), "ungrouped tabs are left alone")
+	assert.Equal(t, changes, stateChanges)
+	require.Error(t, coord.AssignTabGroup(ctx, target, "a", "missing"))
+}
+
+func TestTabCoordinator_TabGroupMenu(t *testing.T) {
+	ctx := context.Background()
+
+	tabs := entity.NewTabList()
+	for _, id := range []string{"a", "b"} {
+		tabs.Add(entity.NewTab(entity.TabID(id), entity.WorkspaceID("ws-"+id), entity.NewPane(entity.PaneID("pane-"+id))))
+	}
+	target := TabTarget{Tabs: tabs}
+	coord := NewTabCoordinator(ctx, TabCoordinatorConfig{TabsUC: usecase.NewManageTabsUseCase(counterIDGen(), nil)})
+
+	labels := func(tabID entity.TabID) []string {
+		var out []string
+		for _, entry := range coord.TabGroupMenu(ctx, target, tabID) {
+			out = append(out, entry.Label)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"Add to New Group"}, labels("a"))
+	coord.TabGroupMenu(ctx, target, "a")[0].Activate()
+	require.NotNil(t, tabs.GroupOf("a"))
+
+	assert.Equal(t, []string{"Add to New Group", `Add to Group "Group 1"`}, labels("b"))
+	coord.TabGroupMenu(ctx, target, "b")[1].Activate()
+	assert.Same(t, tabs.GroupOf("a"), tabs.GroupOf("b"))
+
+	assert.Equal(t, []string{"Add to New Group", "Remove from Group", "Collapse Group"}, labels("b"))
+	coord.TabGroupMenu(ctx, target, "b")[2].Activate()
+	assert.Equal(t, []string{"Add to New Group", "Remove from Group", "Expand Group"}, labels("b"))
+	coord.TabGroupMenu(ctx, target, "b")[1].Activate()
+	assert.Nil(t, tabs.GroupOf("b"))
+
+	assert.Nil(t, coord.TabGroupMenu(ctx, TabTarget{}, "a"))
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/application/usecase"
 	"github.com/bnema/dumber/internal/domain/entity"
 	domainurl "github.com/bnema/dumber/internal/domain/url"
@@ -14,6 +15,7 @@ import (
 	"github.com/bnema/dumber/internal/ui/component"
 	"github.com/bnema/dumber/internal/ui/coordinator/content"
 	"github.com/bnema/dumber/internal/ui/focus"
+	"github.com/bnema/dumber/internal/ui/input"
 	"github.com/bnema/dumber/internal/ui/layout"
 	"github.com/rs/zerolog"
 )
@@ -30,33 +32,65 @@ type WorkspaceCoordinator struct {
 	stackedPaneMgr *component.StackedPaneManager
 	widgetFactory  layout.WidgetFactory
 	contentCoord   *content.Coordinator
+	zoomUC         *usecase.ManageZoomUseCase
+	copyURLUC      *usecase.CopyURLUseCase
 
 	// Config-derived values (injected to avoid direct config dependency)
 	newPaneURL           string
+	splitInheritsURL     bool
+	autoBalanceOnSplit   bool
 	resizeStepPercent    float64
 	resizeMinPanePercent float64
 
+	// stackSwipe maps vertical swipes over stacks to NavigateStack.
+	stackSwipe *input.StackSwipe
+
+	// zen holds per-workspace zen mode state; see ToggleZen.
+	zen map[entity.WorkspaceID]*zenState
+
+	// backgroundMuted tracks panes muted by MuteAllExceptActive.
+	backgroundMuted backgroundMuteSet
+	// focusMuted tracks panes muted by MuteAllForFocusLoss.
+	focusMuted backgroundMuteSet
+
+	// pendingLink is the link waiting for a pane number; see
+	// BeginOpenLinkInPane.
+	pendingLink *pendingPaneLink
+
 	// Callbacks to avoid circular dependencies
 	getActiveWS      func() (*entity.Workspace, *component.WorkspaceView)
 	generateID       func() string
 	onCloseLastPane  func(ctx context.Context) error
-	onCreatePopupTab func(ctx context.Context, input content.InsertPopupInput) error // For tabbed popup behavior
-	onStateChanged   func()                                                          // For session snapshots
-	onPaneClosed     func(paneID entity.PaneID)                                      // For pane-specific cleanup hooks
+	onCreatePopupTab func(ctx context.Context, input content.InsertPopupInput) error        // For tabbed popup behavior
+	popupPlacements  func() []entity.PopupPlacementRule                                     // Per-domain popup placement
+	onStateChanged   func()                                                                 // For session snapshots
+	onPaneClosed     func(paneID entity.PaneID)                                             // For pane-specific cleanup hooks
+	onToast          func(paneID entity.PaneID, message string, level component.ToastLevel) // For the notification center
+	onNavigatePane   func(ctx context.Context, paneID entity.PaneID, url string) error      // For open-link-in-pane
 }
 
 // WorkspaceCoordinatorConfig holds configuration for WorkspaceCoordinator.
 type WorkspaceCoordinatorConfig struct {
-	PanesUC              *usecase.ManagePanesUseCase
-	FocusMgr             *focus.Manager
-	StackedPaneMgr       *component.StackedPaneManager
-	WidgetFactory        layout.WidgetFactory
-	ContentCoord         *content.Coordinator
+	PanesUC        *usecase.ManagePanesUseCase
+	FocusMgr       *focus.Manager
+	StackedPaneMgr *component.StackedPaneManager
+	WidgetFactory  layout.WidgetFactory
+	ContentCoord   *content.Coordinator
+	ZoomUC         *usecase.ManageZoomUseCase
+	CopyURLUC      *usecase.CopyURLUseCase
+	// OnCreatePopupTab opens a popup as a new tab for the "tabbed" behavior.
+	OnCreatePopupTab     func(ctx context.Context, input content.InsertPopupInput) error
 	GetActiveWS          func() (*entity.Workspace, *component.WorkspaceView)
 	GenerateID           func() string
 	NewPaneURL           string
+	SplitInheritsURL     bool
+	AutoBalanceOnSplit   bool
+	StackSwipe           entity.StackSwipeMode
 	ResizeStepPercent    float64
 	ResizeMinPanePercent float64
+	// PopupDomainPlacement returns the per-domain popup placements, read for
+	// each popup so config reloads apply.
+	PopupDomainPlacement func() []entity.PopupPlacementRule
 }
 
 type splitContext struct {
@@ -114,9 +148,16 @@ func NewWorkspaceCoordinator(ctx context.Context, cfg WorkspaceCoordinatorConfig
 		stackedPaneMgr:       cfg.StackedPaneMgr,
 		widgetFactory:        cfg.WidgetFactory,
 		contentCoord:         cfg.ContentCoord,
+		zoomUC:               cfg.ZoomUC,
+		copyURLUC:            cfg.CopyURLUC,
+		onCreatePopupTab:     cfg.OnCreatePopupTab,
+		popupPlacements:      cfg.PopupDomainPlacement,
 		getActiveWS:          cfg.GetActiveWS,
 		generateID:           cfg.GenerateID,
 		newPaneURL:           cfg.NewPaneURL,
+		splitInheritsURL:     cfg.SplitInheritsURL,
+		autoBalanceOnSplit:   cfg.AutoBalanceOnSplit,
+		stackSwipe:           input.NewStackSwipe(cfg.StackSwipe),
 		resizeStepPercent:    clampResizeStep(cfg.ResizeStepPercent),
 		resizeMinPanePercent: clampResizeMin(cfg.ResizeMinPanePercent),
 	}
@@ -137,6 +178,18 @@ func (c *WorkspaceCoordinator) SetOnPaneClosed(fn func(paneID entity.PaneID)) {
 	c.onPaneClosed = fn
 }
 
+// SetOnToast sets a callback invoked for each toast shown on the active pane,
+// feeding the notification center.
+func (c *WorkspaceCoordinator) SetOnToast(fn func(paneID entity.PaneID, message string, level component.ToastLevel)) {
+	c.onToast = fn
+}
+
+// SetOnNavigatePane sets the callback that loads a URL in an existing pane,
+// used to open a captured link in the pane picked by number.
+func (c *WorkspaceCoordinator) SetOnNavigatePane(fn func(ctx context.Context, paneID entity.PaneID, url string) error) {
+	c.onNavigatePane = fn
+}
+
 // notifyStateChanged triggers the state changed callback if set.
 func (c *WorkspaceCoordinator) notifyStateChanged() {
 	if c.onStateChanged != nil {
@@ -144,8 +197,29 @@ func (c *WorkspaceCoordinator) notifyStateChanged() {
 	}
 }
 
+// targetPaneID returns the pane that pane-level actions apply to: the floating
+// pane while it is shown, otherwise the active pane of the active workspace.
+func (c *WorkspaceCoordinator) targetPaneID() (entity.PaneID, bool) {
+	if c.contentCoord != nil {
+		if paneID, ok := c.contentCoord.ActivePaneOverrideID(); ok {
+			return paneID, true
+		}
+	}
+	ws, _ := c.getActiveWS()
+	if ws == nil || ws.ActivePaneID == "" {
+		return "", false
+	}
+	return ws.ActivePaneID, true
+}
+
 // setupPaneViewHover configures hover-to-focus behavior on a PaneView.
+// Nothing is attached when the workspace view has hover focus disabled.
 func setupPaneViewHover(ctx context.Context, pv *component.PaneView, wsView *component.WorkspaceView) {
+	enabled, delay := wsView.HoverFocus()
+	if !enabled {
+		return
+	}
+
 	pv.SetOnHover(func(paneID entity.PaneID) {
 		// Skip if this pane is already active
 		if wsView.GetActivePaneID() == paneID {
@@ -158,20 +232,29 @@ func setupPaneViewHover(ctx context.Context, pv *component.PaneView, wsView *com
 		}
 	})
 
-	pv.AttachHoverHandler(ctx)
+	pv.AttachHoverHandler(ctx, delay)
 }
 
 // Split splits the active pane in the given direction.
+// When split_inherits_url is enabled the new pane opens the active pane's
+// current URL in a WebView related to it, so both share one session.
 func (c *WorkspaceCoordinator) Split(ctx context.Context, direction usecase.SplitDirection) error {
-	return c.splitWithInitialURL(ctx, direction, c.newPaneURL)
+	return c.splitPane(ctx, direction, "", true)
 }
 
 // SplitWithURL splits the active pane in the given direction and loads initialURL.
 func (c *WorkspaceCoordinator) SplitWithURL(ctx context.Context, direction usecase.SplitDirection, initialURL string) error {
-	return c.splitWithInitialURL(ctx, direction, initialURL)
+	return c.splitPane(ctx, direction, initialURL, false)
 }
 
-func (c *WorkspaceCoordinator) splitWithInitialURL(ctx context.Context, direction usecase.SplitDirection, initialURL string) error {
+// splitPane splits the active pane and loads initialURL in the new pane.
+// With useDefaultURL the URL is chosen by defaultSplitURL instead.
+func (c *WorkspaceCoordinator) splitPane(
+	ctx context.Context,
+	direction usecase.SplitDirection,
+	initialURL string,
+	useDefaultURL bool,
+) error {
 	log := logging.FromContext(ctx)
 
 	splitCtx, ok := c.prepareSplit(ctx, direction)
@@ -179,6 +262,11 @@ func (c *WorkspaceCoordinator) splitWithInitialURL(ctx context.Context, directio
 		return nil
 	}
 
+	inherited := false
+	if useDefaultURL {
+		initialURL, inherited = c.defaultSplitURL(splitCtx.activePane)
+	}
+
 	output, err := c.panesUC.Split(ctx, usecase.SplitPaneInput{
 		Workspace:  splitCtx.ws,
 		TargetPane: splitCtx.activePane,
@@ -193,6 +281,10 @@ func (c *WorkspaceCoordinator) splitWithInitialURL(ctx context.Context, directio
 	// Remember old active pane before changing
 	oldActivePaneID := splitCtx.activePane.Pane.ID
 
+	if inherited && splitCtx.wsView != nil {
+		c.createInheritedWebView(ctx, output.NewPaneNode.Pane.ID, oldActivePaneID)
+	}
+
 	// Set the new pane as active
 	splitCtx.ws.ActivePaneID = output.NewPaneNode.Pane.ID
 
@@ -201,6 +293,10 @@ func (c *WorkspaceCoordinator) splitWithInitialURL(ctx context.Context, directio
 		c.applySplitToView(ctx, splitCtx.wsView, splitCtx.ws, output, direction, splitCtx.existingWidget, splitCtx.isStackSplit, oldActivePaneID)
 	}
 
+	if c.autoBalanceOnSplit {
+		c.balanceSplits(ctx, splitCtx.ws, splitCtx.wsView)
+	}
+
 	if splitCtx.wsView != nil {
 		splitCtx.wsView.NotifyNewPaneCreated(ctx)
 	}
@@ -344,6 +440,7 @@ func (c *WorkspaceCoordinator) prepareSplit(
 		log.Warn().Msg("no active workspace")
 		return nil, false
 	}
+	c.leaveZen(ctx, ws, wsView)
 
 	activePane := ws.ActivePane()
 	if activePane == nil {
@@ -484,6 +581,7 @@ func (c *WorkspaceCoordinator) doIncrementalStackSplit(
 	// 3. Create new PaneView for the new pane (without WebView - will attach later)
 	newPaneView := component.NewPaneView(ctx, factory, output.NewPaneNode.Pane.ID, nil)
 	setupPaneViewHover(ctx, newPaneView, wsView)
+	wsView.SetupPaneMiddleClickClose(ctx, newPaneView)
 
 	// 4. Wrap the new PaneView in a StackedView
 	newStackedView := layout.NewStackedView(factory)
@@ -668,6 +766,7 @@ func (c *WorkspaceCoordinator) doIncrementalSplit(
 	// 4. Create new PaneView for the new pane
 	newPaneView := component.NewPaneView(ctx, factory, output.NewPaneNode.Pane.ID, nil)
 	setupPaneViewHover(ctx, newPaneView, wsView)
+	wsView.SetupPaneMiddleClickClose(ctx, newPaneView)
 
 	// 5. Wrap the new PaneView in a StackedView
 	newStackedView := layout.NewStackedView(factory)
@@ -889,7 +988,8 @@ func orientationString(orientation layout.Orientation) string {
 	return "vertical"
 }
 
-// ClosePane closes the active pane.
+// ClosePane closes the active pane. When its page has unsaved form input and
+// the config asks for it, the pane is only closed once the user confirms.
 func (c *WorkspaceCoordinator) ClosePane(ctx context.Context) error {
 	log := logging.FromContext(ctx)
 
@@ -903,6 +1003,7 @@ func (c *WorkspaceCoordinator) ClosePane(ctx context.Context) error {
 		log.Warn().Msg("no active workspace")
 		return nil
 	}
+	c.leaveZen(ctx, ws, wsView)
 
 	activePane := ws.ActivePane()
 	if activePane == nil {
@@ -911,6 +1012,15 @@ func (c *WorkspaceCoordinator) ClosePane(ctx context.Context) error {
 	}
 	closingPaneID := activePane.Pane.ID
 
+	if c.contentCoord != nil && c.contentCoord.ConfirmPaneClose(ctx, closingPaneID, func() {
+		if err := c.ClosePaneByID(ctx, closingPaneID); err != nil {
+			log.Error().Err(err).Str("pane_id", string(closingPaneID)).Msg("failed to close confirmed pane")
+		}
+	}) {
+		log.Debug().Str("pane_id", string(closingPaneID)).Msg("pane has unsaved form input; asking before close")
+		return nil
+	}
+
 	log.Debug().Str("pane_id", string(closingPaneID)).Msg("closing pane")
 
 	// Don't close the last pane - close the tab instead
@@ -964,6 +1074,7 @@ func (c *WorkspaceCoordinator) ClosePaneByID(ctx context.Context, paneID entity.
 		log.Warn().Msg("no active workspace")
 		return nil
 	}
+	c.leaveZen(ctx, ws, wsView)
 
 	// Find the pane node
 	paneNode := ws.FindPane(paneID)
@@ -1363,6 +1474,7 @@ func (c *WorkspaceCoordinator) FocusPane(ctx context.Context, direction usecase.
 		log.Warn().Msg("no active workspace view")
 		return nil
 	}
+	c.leaveZen(ctx, ws, wsView)
 
 	// Save old active pane ID before navigation updates the domain model
 	oldActivePaneID := ws.ActivePaneID
@@ -1522,6 +1634,7 @@ func (c *WorkspaceCoordinator) StackPane(ctx context.Context) error {
 	// Create PaneView for the new pane
 	newPaneView := component.NewPaneView(ctx, c.widgetFactory, newPaneID, nil)
 	setupPaneViewHover(ctx, newPaneView, stackCtx.wsView)
+	stackCtx.wsView.SetupPaneMiddleClickClose(ctx, newPaneView)
 	stackCtx.wsView.RegisterPaneView(newPaneID, newPaneView)
 
 	// Add to the UI StackedView
@@ -1571,6 +1684,9 @@ func (c *WorkspaceCoordinator) StackPane(ctx context.Context) error {
 			stackedView.SetOnClosePane(func(paneID string) {
 				c.onStackedPaneClose(ctx, entity.PaneID(paneID))
 			})
+			stackedView.SetOnScroll(func(event layout.StackScrollEvent) bool {
+				return c.onStackScroll(ctx, stackedView, event)
+			})
 		}
 	}
 
@@ -1611,6 +1727,7 @@ func (c *WorkspaceCoordinator) prepareStackPane(
 		log.Warn().Msg("no workspace view")
 		return nil, false
 	}
+	c.leaveZen(ctx, ws, wsView)
 
 	activePaneID := activeNode.Pane.ID
 	originalTitle := c.contentCoord.GetTitle(activePaneID)
@@ -1828,6 +1945,9 @@ func (c *WorkspaceCoordinator) SetupStackedPaneCallbacks(ctx context.Context, ws
 		stackedView.SetOnClosePane(func(paneID string) {
 			c.onStackedPaneClose(ctx, entity.PaneID(paneID))
 		})
+		stackedView.SetOnScroll(func(event layout.StackScrollEvent) bool {
+			return c.onStackScroll(ctx, stackedView, event)
+		})
 
 		// Populate stacked title bar favicons from cache.
 		// When panes are stacked (split → stack conversion) or restored from session,
@@ -1877,10 +1997,15 @@ func (c *WorkspaceCoordinator) SetOnCreatePopupTab(fn func(ctx context.Context,
 }
 
 // InsertPopup inserts a popup pane into the workspace based on the specified behavior.
-// Supports split, stacked, and tabbed behaviors.
+// Supports split, stacked, and tabbed behaviors. A domain placement matching
+// the popup's URL replaces the global placement.
 func (c *WorkspaceCoordinator) InsertPopup(ctx context.Context, input content.InsertPopupInput) error {
 	log := logging.FromContext(ctx)
 
+	if c.popupPlacements != nil {
+		input.Placement = resolvePopupPlacement(c.popupPlacements(), input.TargetURI, input.Placement)
+	}
+
 	log.Debug().
 		Str("parent_pane", string(input.ParentPaneID)).
 		Str("popup_pane", string(input.PopupPane.ID)).
@@ -1917,7 +2042,7 @@ func (c *WorkspaceCoordinator) insertPopupSplit(ctx context.Context, input conte
 		return fmt.Errorf("parent pane not found: %s", input.ParentPaneID)
 	}
 
-	direction := resolvePopupSplitDirection(input.Placement)
+	direction := c.popupSplitDirection(wsView, input)
 
 	// Check if parent is in a stack - if so, split around the stack
 	isStackSplit := (direction == usecase.SplitLeft || direction == usecase.SplitRight) &&
@@ -1956,6 +2081,65 @@ func (c *WorkspaceCoordinator) insertPopupSplit(ctx context.Context, input conte
 	return nil
 }
 
+// popupPlacementAuto picks the split direction from the parent pane's shape.
+const popupPlacementAuto = "auto"
+
+// popupSplitDirection resolves the split direction for a popup. With "auto"
+// placement the parent pane's allocation decides; without usable geometry
+// (pane not yet realized) the default placement applies.
+func (c *WorkspaceCoordinator) popupSplitDirection(
+	wsView *component.WorkspaceView,
+	input content.InsertPopupInput,
+) usecase.SplitDirection {
+	if input.Placement != popupPlacementAuto {
+		return resolvePopupSplitDirection(input.Placement)
+	}
+	if wsView != nil {
+		if pv := wsView.GetPaneView(input.ParentPaneID); pv != nil {
+			if direction, ok := autoPopupSplitDirection(pv.GetContentDimensions()); ok {
+				return direction
+			}
+		}
+	}
+	return resolvePopupSplitDirection(input.Placement)
+}
+
+// autoPopupSplitDirection picks the split that keeps both halves closest to
+// square: wide panes are split side by side, tall panes top and bottom.
+// Square panes split to the right, since pages read better wide than short.
+// It returns false when the dimensions are unknown.
+func autoPopupSplitDirection(width, height int) (usecase.SplitDirection, bool) {
+	if width <= 0 || height <= 0 {
+		return "", false
+	}
+	if width >= height {
+		return usecase.SplitRight, true
+	}
+	return usecase.SplitDown, true
+}
+
+// resolvePopupPlacement returns the placement of the most specific rule
+// matching targetURI, or placement when none does.
+func resolvePopupPlacement(rules []entity.PopupPlacementRule, targetURI, placement string) string {
+	if len(rules) == 0 || targetURI == "" {
+		return placement
+	}
+	patterns := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		patterns = append(patterns, rule.Domain)
+	}
+	pattern, ok := domainurl.BestDomainPatternMatch(patterns, targetURI)
+	if !ok {
+		return placement
+	}
+	for _, rule := range rules {
+		if rule.Domain == pattern {
+			return rule.Placement
+		}
+	}
+	return placement
+}
+
 func resolvePopupSplitDirection(placement string) usecase.SplitDirection {
 	switch placement {
 	case string(usecase.SplitLeft):
@@ -2168,6 +2352,7 @@ func (c *WorkspaceCoordinator) attachPopupPaneView(
 	}
 	newPaneView := component.NewPaneView(ctx, c.widgetFactory, input.PopupPane.ID, nil)
 	setupPaneViewHover(ctx, newPaneView, wsView)
+	wsView.SetupPaneMiddleClickClose(ctx, newPaneView)
 	wsView.RegisterPaneView(input.PopupPane.ID, newPaneView)
 
 	if err := c.stackedPaneMgr.AddPaneToStack(ctx, wsView, input.ParentPaneID, newPaneView, input.PopupPane.Title); err != nil {
@@ -2200,6 +2385,9 @@ func (c *WorkspaceCoordinator) attachPopupPaneView(
 			stackedView.SetOnClosePane(func(paneID string) {
 				c.onStackedPaneClose(ctx, entity.PaneID(paneID))
 			})
+			stackedView.SetOnScroll(func(event layout.StackScrollEvent) bool {
+				return c.onStackScroll(ctx, stackedView, event)
+			})
 		}
 	}
 	return nil
@@ -2240,6 +2428,8 @@ func (c *WorkspaceCoordinator) ConsumeOrExpelPane(ctx context.Context, direction
 		return nil
 	}
 
+	c.leaveZen(ctx, ws, wsView)
+
 	activeNode := ws.ActivePane()
 	if activeNode == nil {
 		log.Warn().Msg("no active pane")
@@ -2271,6 +2461,214 @@ func (c *WorkspaceCoordinator) ConsumeOrExpelPane(ctx context.Context, direction
 	return nil
 }
 
+// CollapseStacks collapses every pane of the active workspace into one stack,
+// keeping the active pane visible.
+func (c *WorkspaceCoordinator) CollapseStacks(ctx context.Context) error {
+	return c.restructureTree(ctx, "collapse stacks", c.panesUC.CollapseToStack, usecase.ErrNothingToCollapse, "Nothing to collapse")
+}
+
+// ExpandStack re-splits the stack holding the active pane into an even grid.
+func (c *WorkspaceCoordinator) ExpandStack(ctx context.Context) error {
+	return c.restructureTree(ctx, "expand stack", c.panesUC.ExpandStack, usecase.ErrNothingToExpand, "No stack to expand")
+}
+
+// restructureTree applies a whole-tree transformation and rebuilds the view
+// so PaneView tracking and stacked callbacks follow the new tree.
+func (c *WorkspaceCoordinator) restructureTree(
+	ctx context.Context,
+	label string,
+	transform func(context.Context, *entity.Workspace) (*entity.PaneNode, error),
+	nothingToDo error,
+	nothingToDoMessage string,
+) error {
+	log := logging.FromContext(ctx)
+
+	if c.panesUC == nil {
+		log.Warn().Msg("panes use case not available")
+		return nil
+	}
+
+	ws, wsView := c.getActiveWS()
+	if ws == nil {
+		log.Warn().Msg("no active workspace")
+		return nil
+	}
+	c.leaveZen(ctx, ws, wsView)
+
+	if _, err := transform(ctx, ws); err != nil {
+		if errors.Is(err, nothingToDo) {
+			c.ShowToastOnActivePane(ctx, nothingToDoMessage, component.ToastInfo)
+			return nil
+		}
+		return fmt.Errorf("%s: %w", label, err)
+	}
+
+	c.rebuildWorkspaceView(ctx, ws, wsView)
+	c.notifyStateChanged()
+	return nil
+}
+
+// rebuildWorkspaceView recreates the widget tree after a structural change so
+// PaneView tracking, WebView widgets and stacked callbacks match the tree.
+func (c *WorkspaceCoordinator) rebuildWorkspaceView(
+	ctx context.Context,
+	ws *entity.Workspace,
+	wsView *component.WorkspaceView,
+) {
+	if wsView == nil {
+		return
+	}
+	log := logging.FromContext(ctx)
+
+	if err := wsView.Rebuild(ctx); err != nil {
+		log.Warn().Err(err).Msg("failed to rebuild workspace view")
+	}
+	c.contentCoord.AttachToWorkspace(ctx, ws, wsView)
+	c.SetupStackedPaneCallbacks(ctx, ws, wsView)
+	if err := wsView.SetActivePaneID(ws.ActivePaneID); err != nil {
+		log.Warn().Err(err).Msg("failed to set active pane in workspace view")
+	}
+	wsView.FocusPane(ws.ActivePaneID)
+}
+
+// ReopenAsRelated recreates a pane's current page in a WebView related to the
+// previously active pane, so it shares that pane's session. This rescues
+// OAuth flows that broke because they opened in an isolated pane.
+// The new pane replaces the old one in place. An empty paneID targets the
+// active pane.
+func (c *WorkspaceCoordinator) ReopenAsRelated(ctx context.Context, paneID entity.PaneID) error {
+	log := logging.FromContext(ctx)
+
+	if c.panesUC == nil || c.contentCoord == nil {
+		log.Warn().Msg("panes use case or content coordinator not available")
+		return nil
+	}
+
+	ws, wsView := c.getActiveWS()
+	if ws == nil {
+		log.Warn().Msg("no active workspace")
+		return nil
+	}
+	if paneID == "" {
+		paneID = ws.ActivePaneID
+	}
+	node := ws.FindPane(paneID)
+	if node == nil || node.Pane == nil {
+		return fmt.Errorf("pane %s not found", paneID)
+	}
+
+	var previousActive entity.PaneID
+	if wsView != nil {
+		previousActive = wsView.PreviousActivePaneID()
+	}
+	parentID, ok := relatedParentPaneID(ws, node, previousActive)
+	if !ok {
+		c.ShowToastOnActivePane(ctx, "No other pane to share a session with", component.ToastWarning)
+		return nil
+	}
+
+	uri := node.Pane.URI
+	if wv := c.contentCoord.GetWebView(paneID); wv != nil && wv.URI() != "" {
+		uri = wv.URI()
+	}
+	node.Pane.URI = uri
+
+	newPaneID := entity.PaneID(c.generateID())
+	if _, err := c.contentCoord.CreateRelatedWebView(ctx, newPaneID, parentID, uri); err != nil {
+		return fmt.Errorf("reopen as related: %w", err)
+	}
+	newNode, err := c.panesUC.ReplaceWithRelatedPane(ctx, usecase.ReplaceWithRelatedPaneInput{
+		Workspace:    ws,
+		PaneID:       paneID,
+		ParentPaneID: parentID,
+		NewPaneID:    newPaneID,
+	})
+	if err != nil {
+		c.contentCoord.ReleaseWebView(ctx, newPaneID)
+		return fmt.Errorf("reopen as related: %w", err)
+	}
+
+	c.contentCoord.ReleaseWebView(ctx, paneID)
+	if c.onPaneClosed != nil {
+		c.onPaneClosed(paneID)
+	}
+
+	c.rebuildWorkspaceView(ctx, ws, wsView)
+	c.notifyStateChanged()
+
+	log.Info().
+		Str("old_pane", string(paneID)).
+		Str("new_pane", string(newNode.Pane.ID)).
+		Str("parent_pane", string(parentID)).
+		Msg("pane reopened in related context")
+	return nil
+}
+
+// relatedParentPaneID picks the pane whose session a reopened pane should
+// share: the previously active pane, falling back to the pane's recorded
+// popup parent.
+func relatedParentPaneID(
+	ws *entity.Workspace,
+	node *entity.PaneNode,
+	previousActive entity.PaneID,
+) (entity.PaneID, bool) {
+	candidates := []entity.PaneID{previousActive}
+	if node.Pane.ParentPaneID != nil {
+		candidates = append(candidates, *node.Pane.ParentPaneID)
+	}
+	for _, id := range candidates {
+		if id != "" && id != node.Pane.ID && ws.FindPane(id) != nil {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// BalancePanes resets every split of the active tab to an even ratio.
+func (c *WorkspaceCoordinator) BalancePanes(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+
+	if c.panesUC == nil {
+		log.Warn().Msg("panes use case not available")
+		return nil
+	}
+
+	ws, wsView := c.getActiveWS()
+	if ws == nil {
+		log.Warn().Msg("no active workspace")
+		return nil
+	}
+
+	c.leaveZen(ctx, ws, wsView)
+
+	if _, err := c.panesUC.BalanceSplits(ctx, ws); err != nil {
+		if errors.Is(err, usecase.ErrNothingToBalance) {
+			c.ShowToastOnActivePane(ctx, "Nothing to balance", component.ToastInfo)
+			return nil
+		}
+		return fmt.Errorf("balance panes: %w", err)
+	}
+
+	if wsView != nil {
+		c.updateSplitPositions(wsView, ws)
+	}
+
+	c.notifyStateChanged()
+	return nil
+}
+
+// balanceSplits evens out the splits of ws after a split when
+// workspace.auto_balance_on_split is enabled.
+func (c *WorkspaceCoordinator) balanceSplits(ctx context.Context, ws *entity.Workspace, wsView *component.WorkspaceView) {
+	changed, err := c.panesUC.BalanceSplits(ctx, ws)
+	if err != nil || changed == 0 {
+		return
+	}
+	if wsView != nil {
+		c.updateSplitPositions(wsView, ws)
+	}
+}
+
 // ShowZoomToast displays a zoom level toast on the active pane.
 func (c *WorkspaceCoordinator) ShowZoomToast(ctx context.Context, zoomPercent int) {
 	_, wsView := c.getActiveWS()
@@ -2284,6 +2682,56 @@ func (c *WorkspaceCoordinator) ShowZoomToast(ctx context.Context, zoomPercent in
 	}
 }
 
+// GetActivePaneZoom returns the zoom factor of the active pane's WebView.
+func (c *WorkspaceCoordinator) GetActivePaneZoom(ctx context.Context) (float64, error) {
+	wv := c.activePaneWebView(ctx)
+	if wv == nil {
+		return 0, errNoActivePaneWebView
+	}
+	return wv.GetZoomLevel(), nil
+}
+
+// SetActivePaneZoom clamps level to the valid zoom range, applies it to the
+// active pane's WebView and persists it for the page's zoom key.
+// It returns the applied zoom factor.
+func (c *WorkspaceCoordinator) SetActivePaneZoom(ctx context.Context, level float64) (float64, error) {
+	log := logging.FromContext(ctx)
+
+	wv := c.activePaneWebView(ctx)
+	if wv == nil {
+		return 0, errNoActivePaneWebView
+	}
+
+	zoomKey, keyErr := usecase.ExtractZoomKey(wv.URI())
+	zoom := entity.NewZoomLevel(zoomKey, level)
+	if err := wv.SetZoomLevel(ctx, zoom.ZoomFactor); err != nil {
+		return 0, fmt.Errorf("apply zoom: %w", err)
+	}
+
+	switch {
+	case c.zoomUC == nil:
+		log.Debug().Msg("zoom use case not available, zoom not persisted")
+	case keyErr != nil:
+		log.Debug().Str("uri", wv.URI()).Msg("cannot extract zoom key, zoom not persisted")
+	default:
+		if err := c.zoomUC.SetZoom(ctx, zoomKey, zoom.ZoomFactor); err != nil {
+			return zoom.ZoomFactor, err
+		}
+	}
+
+	c.ShowZoomToast(ctx, zoom.Percentage())
+	return zoom.ZoomFactor, nil
+}
+
+var errNoActivePaneWebView = errors.New("no active pane webview")
+
+func (c *WorkspaceCoordinator) activePaneWebView(ctx context.Context) port.WebView {
+	if c.contentCoord == nil {
+		return nil
+	}
+	return c.contentCoord.ActiveWebView(ctx)
+}
+
 // ShowToastOnActivePane displays a toast notification on the active pane.
 func (c *WorkspaceCoordinator) ShowToastOnActivePane(ctx context.Context, message string, level component.ToastLevel) {
 	_, wsView := c.getActiveWS()
@@ -2294,6 +2742,80 @@ func (c *WorkspaceCoordinator) ShowToastOnActivePane(ctx context.Context, messag
 	paneView := wsView.GetActivePaneView()
 	if paneView != nil {
 		paneView.ShowToast(ctx, message, level)
+		if c.onToast != nil {
+			c.onToast(paneView.PaneID(), message, level)
+		}
+	}
+}
+
+// FocusPaneByNumber focuses the visible pane labeled n (1-9) in the active
+// workspace. Numbers follow entity.Workspace.NumberedPanes; unknown numbers
+// are ignored. While a link captured by BeginOpenLinkInPane is pending, the
+// link is loaded in pane n instead.
+func (c *WorkspaceCoordinator) FocusPaneByNumber(ctx context.Context, n int) error {
+	log := logging.FromContext(ctx)
+
+	ws, wsView := c.getActiveWS()
+	if ws == nil {
+		log.Warn().Msg("no active workspace")
+		return nil
+	}
+
+	if c.pendingLink != nil {
+		return c.openPendingLinkInPane(ctx, ws, n)
+	}
+
+	paneNode := ws.PaneByNumber(n)
+	if paneNode == nil || paneNode.Pane == nil {
+		log.Debug().Int("number", n).Msg("no pane with that number")
+		return nil
+	}
+
+	c.focusExistingPane(ctx, ws, wsView, paneNode.Pane.ID)
+	log.Debug().Int("number", n).Str("pane_id", string(paneNode.Pane.ID)).Msg("focused pane by number")
+	return nil
+}
+
+// FocusPaneByID focuses the given pane of the active workspace. Unknown panes
+// are ignored.
+func (c *WorkspaceCoordinator) FocusPaneByID(ctx context.Context, paneID entity.PaneID) {
+	ws, wsView := c.getActiveWS()
+	if ws == nil || paneID == "" || ws.FindPane(paneID) == nil {
+		return
+	}
+	c.focusExistingPane(ctx, ws, wsView, paneID)
+}
+
+// ShowPaneNumbers overlays jump-to-pane numbers on the visible panes of the
+// active workspace. Panes beyond entity.MaxPaneNumber stay unlabeled.
+func (c *WorkspaceCoordinator) ShowPaneNumbers(ctx context.Context) {
+	ws, wsView := c.getActiveWS()
+	if ws == nil || wsView == nil {
+		return
+	}
+
+	c.HidePaneNumbers(ctx)
+	for i, paneNode := range ws.NumberedPanes() {
+		if paneNode.Pane == nil {
+			continue
+		}
+		if paneView := wsView.GetPaneView(paneNode.Pane.ID); paneView != nil {
+			paneView.ShowPaneNumber(i + 1)
+		}
+	}
+}
+
+// HidePaneNumbers removes jump-to-pane numbers from every pane of the active workspace.
+func (c *WorkspaceCoordinator) HidePaneNumbers(_ context.Context) {
+	ws, wsView := c.getActiveWS()
+	if ws == nil || wsView == nil {
+		return
+	}
+
+	for _, pane := range ws.AllPanes() {
+		if paneView := wsView.GetPaneView(pane.ID); paneView != nil {
+			paneView.HidePaneNumber()
+		}
 	}
 }
 
@@ -2312,6 +2834,8 @@ func (c *WorkspaceCoordinator) Resize(ctx context.Context, dir usecase.ResizeDir
 		return nil
 	}
 
+	c.leaveZen(ctx, ws, wsView)
+
 	target := ws.ActivePane()
 	if target == nil {
 		return nil
@@ -2347,6 +2871,10 @@ func (c *WorkspaceCoordinator) SetSplitRatio(ctx context.Context, splitNodeID st
 		log.Warn().Msg("no active workspace")
 		return nil
 	}
+	if _, ok := c.zen[ws.ID]; ok {
+		// Divider moves while siblings are hidden are layout artifacts.
+		return nil
+	}
 
 	err := c.panesUC.SetSplitRatio(ctx, usecase.SetSplitRatioInput{
 		Workspace:      ws,
@@ -2379,3 +2907,38 @@ func (c *WorkspaceCoordinator) updateSplitPositions(wsView *component.WorkspaceV
 		return true
 	})
 }
+
+// defaultSplitURL returns the URL for a pane split from source and whether it
+// was inherited from source. Only a source showing a real page is inherited;
+// blank and about: panes fall back to new_pane_url.
+func (c *WorkspaceCoordinator) defaultSplitURL(source *entity.PaneNode) (string, bool) {
+	if !c.splitInheritsURL || source == nil || source.Pane == nil {
+		return c.newPaneURL, false
+	}
+	uri := source.Pane.URI
+	if c.contentCoord != nil {
+		if wv := c.contentCoord.GetWebView(source.Pane.ID); wv != nil && wv.URI() != "" {
+			uri = wv.URI()
+		}
+	}
+	if uri == "" || strings.HasPrefix(strings.ToLower(uri), "about:") {
+		return c.newPaneURL, false
+	}
+	return uri, true
+}
+
+// createInheritedWebView pre-creates the new pane's WebView related to the
+// source pane so the inherited page loads with the same session. The view
+// path then reuses it through EnsureWebView. On failure the pane falls back
+// to an isolated pooled WebView.
+func (c *WorkspaceCoordinator) createInheritedWebView(ctx context.Context, paneID, sourcePaneID entity.PaneID) {
+	if c.contentCoord == nil {
+		return
+	}
+	if _, err := c.contentCoord.CreateRelatedWebView(ctx, paneID, sourcePaneID, ""); err != nil {
+		logging.FromContext(ctx).Debug().Err(err).
+			Str("pane_id", string(paneID)).
+			Str("source_pane_id", string(sourcePaneID)).
+			Msg("related webview unavailable for inherited split; using pool")
+	}
+}
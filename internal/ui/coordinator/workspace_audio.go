@@ -0,0 +1,172 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// backgroundMuteSet remembers which panes MuteAllExceptActive or
+// MuteAllForFocusLoss silenced, so they restore only those and leave panes
+// the user muted alone.
+type backgroundMuteSet struct {
+	panes map[entity.PaneID]struct{}
+}
+
+// muteAllExcept plans a background mute from the current mute states.
+// Unmuted panes other than active are recorded and returned in mute. The
+// active pane is returned in unmute when an earlier background mute silenced
+// it; a pane the user muted is never touched.
+func (s *backgroundMuteSet) muteAllExcept(
+	active entity.PaneID,
+	states map[entity.PaneID]bool,
+) (mute, unmute []entity.PaneID) {
+	if s.panes == nil {
+		s.panes = make(map[entity.PaneID]struct{})
+	}
+	for paneID, muted := range states {
+		if paneID == active {
+			continue
+		}
+		if !muted {
+			s.panes[paneID] = struct{}{}
+			mute = append(mute, paneID)
+		}
+	}
+	if _, ok := s.panes[active]; ok {
+		delete(s.panes, active)
+		if states[active] {
+			unmute = append(unmute, active)
+		}
+	}
+	slices.Sort(mute)
+	return mute, unmute
+}
+
+// release forgets every background-muted pane and returns them sorted.
+func (s *backgroundMuteSet) release() []entity.PaneID {
+	panes := make([]entity.PaneID, 0, len(s.panes))
+	for paneID := range s.panes {
+		panes = append(panes, paneID)
+	}
+	s.panes = nil
+	slices.Sort(panes)
+	return panes
+}
+
+// forget drops paneID, typically because the user toggled its mute state and
+// now owns it.
+func (s *backgroundMuteSet) forget(paneID entity.PaneID) {
+	delete(s.panes, paneID)
+}
+
+// empty reports whether no pane is background-muted.
+func (s *backgroundMuteSet) empty() bool {
+	return len(s.panes) == 0
+}
+
+// ToggleMuteActivePane mutes or unmutes the active pane. The pane then counts
+// as user-muted, so UnmuteAll leaves it alone.
+func (c *WorkspaceCoordinator) ToggleMuteActivePane(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	muted, ok := c.contentCoord.AudioMuteStates()[paneID]
+	if !ok {
+		c.ShowToastOnActivePane(ctx, "Muting not supported", component.ToastInfo)
+		return nil
+	}
+	if !c.contentCoord.SetPaneAudioMuted(paneID, !muted) {
+		return nil
+	}
+	c.backgroundMuted.forget(paneID)
+	c.focusMuted.forget(paneID)
+
+	if muted {
+		c.ShowToastOnActivePane(ctx, "Pane unmuted", component.ToastInfo)
+	} else {
+		c.ShowToastOnActivePane(ctx, "Pane muted", component.ToastInfo)
+	}
+	return nil
+}
+
+// MuteAllExceptActive mutes every pane in every tab except the active one.
+// Panes that were already muted are not recorded, so UnmuteAll keeps them
+// muted.
+func (c *WorkspaceCoordinator) MuteAllExceptActive(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	mute, unmute := c.backgroundMuted.muteAllExcept(paneID, c.contentCoord.AudioMuteStates())
+	for _, id := range mute {
+		c.contentCoord.SetPaneAudioMuted(id, true)
+	}
+	for _, id := range unmute {
+		c.contentCoord.SetPaneAudioMuted(id, false)
+	}
+
+	logging.FromContext(ctx).Debug().Int("muted", len(mute)).Msg("muted background panes")
+	c.ShowToastOnActivePane(ctx, fmt.Sprintf("Muted %d background pane(s)", len(mute)), component.ToastInfo)
+	return nil
+}
+
+// UnmuteAll unmutes the panes silenced by MuteAllExceptActive.
+func (c *WorkspaceCoordinator) UnmuteAll(ctx context.Context) error {
+	if c.contentCoord == nil {
+		return nil
+	}
+
+	panes := c.backgroundMuted.release()
+	for _, id := range panes {
+		c.contentCoord.SetPaneAudioMuted(id, false)
+	}
+
+	logging.FromContext(ctx).Debug().Int("unmuted", len(panes)).Msg("unmuted background panes")
+	c.ShowToastOnActivePane(ctx, fmt.Sprintf("Unmuted %d pane(s)", len(panes)), component.ToastInfo)
+	return nil
+}
+
+// ToggleMuteBackground unmutes background panes when a background mute is in
+// effect, otherwise mutes every pane except the active one.
+func (c *WorkspaceCoordinator) ToggleMuteBackground(ctx context.Context) error {
+	if c.backgroundMuted.empty() {
+		return c.MuteAllExceptActive(ctx)
+	}
+	return c.UnmuteAll(ctx)
+}
+
+// MuteAllForFocusLoss mutes every pane, the active one included, when no
+// browser window has the focus. Panes that were already muted are not
+// recorded, so RestoreFocusMute keeps them muted.
+func (c *WorkspaceCoordinator) MuteAllForFocusLoss(ctx context.Context) {
+	if c.contentCoord == nil {
+		return
+	}
+
+	mute, _ := c.focusMuted.muteAllExcept("", c.contentCoord.AudioMuteStates())
+	for _, id := range mute {
+		c.contentCoord.SetPaneAudioMuted(id, true)
+	}
+	logging.FromContext(ctx).Debug().Int("muted", len(mute)).Msg("muted panes on focus loss")
+}
+
+// RestoreFocusMute unmutes the panes silenced by MuteAllForFocusLoss.
+func (c *WorkspaceCoordinator) RestoreFocusMute(ctx context.Context) {
+	if c.contentCoord == nil || c.focusMuted.empty() {
+		return
+	}
+
+	panes := c.focusMuted.release()
+	for _, id := range panes {
+		c.contentCoord.SetPaneAudioMuted(id, false)
+	}
+	logging.FromContext(ctx).Debug().Int("unmuted", len(panes)).Msg("unmuted panes on focus gain")
+}
@@ -0,0 +1,181 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/dumber/internal/ui/coordinator/content"
+)
+
+// mutableWebView is a WebView whose audio can be muted.
+type mutableWebView struct {
+	*mocks.MockWebView
+	muted bool
+}
+
+func (wv *mutableWebView) SetAudioMuted(muted bool) { wv.muted = muted }
+func (wv *mutableWebView) IsAudioMuted() bool       { return wv.muted }
+
+var _ port.AudioMuteCapable = (*mutableWebView)(nil)
+
+func newAudioTestCoordinator(t *testing.T, panes map[entity.PaneID]*mutableWebView) *WorkspaceCoordinator {
+	t.Helper()
+	ctx := context.Background()
+	ws := entity.NewWorkspace("ws-1", entity.NewPane("pane-1"))
+	getActiveWS := func() (*entity.Workspace, *component.WorkspaceView) { return ws, nil }
+
+	contentCoord := content.NewCoordinator(ctx, nil, nil, nil, nil, getActiveWS, nil, nil)
+	for paneID, wv := range panes {
+		wv.MockWebView = mocks.NewMockWebView(t)
+		wv.EXPECT().ID().Return(port.WebViewID(1)).Maybe()
+		wv.EXPECT().IsDestroyed().Return(false).Maybe()
+		contentCoord.RegisterPopupWebView(paneID, wv)
+	}
+	return NewWorkspaceCoordinator(ctx, WorkspaceCoordinatorConfig{
+		ContentCoord: contentCoord,
+		GetActiveWS:  getActiveWS,
+	})
+}
+
+func TestBackgroundMuteSet_MuteAllExceptSkipsActiveAndUserMuted(t *testing.T) {
+	var set backgroundMuteSet
+
+	mute, unmute := set.muteAllExcept("pane-1", map[entity.PaneID]bool{
+		"pane-1": false,
+		"pane-2": false,
+		"pane-3": true, // muted by the user
+		"pane-4": false,
+	})
+
+	assert.Equal(t, []entity.PaneID{"pane-2", "pane-4"}, mute)
+	assert.Empty(t, unmute)
+	assert.Equal(t, []entity.PaneID{"pane-2", "pane-4"}, set.release())
+	assert.True(t, set.empty())
+}
+
+func TestBackgroundMuteSet_ReleaseLeavesUserMutedPanes(t *testing.T) {
+	var set backgroundMuteSet
+	set.muteAllExcept("pane-1", map[entity.PaneID]bool{
+		"pane-1": false,
+		"pane-2": true,
+		"pane-3": false,
+	})
+
+	assert.Equal(t, []entity.PaneID{"pane-3"}, set.release())
+	assert.Empty(t, set.release())
+}
+
+func TestBackgroundMuteSet_RepeatMuteUnmutesNewActivePane(t *testing.T) {
+	var set backgroundMuteSet
+	set.muteAllExcept("pane-1", map[entity.PaneID]bool{
+		"pane-1": false,
+		"pane-2": false,
+	})
+
+	// Focus moved to pane-2 (background-muted) and the user mutes again.
+	mute, unmute := set.muteAllExcept("pane-2", map[entity.PaneID]bool{
+		"pane-1": false,
+		"pane-2": true,
+	})
+
+	assert.Equal(t, []entity.PaneID{"pane-1"}, mute)
+	assert.Equal(t, []entity.PaneID{"pane-2"}, unmute)
+	assert.Equal(t, []entity.PaneID{"pane-1"}, set.release())
+}
+
+func TestBackgroundMuteSet_RepeatMuteKeepsUserMutedActivePane(t *testing.T) {
+	var set backgroundMuteSet
+	set.muteAllExcept("pane-1", map[entity.PaneID]bool{
+		"pane-1": true,
+		"pane-2": false,
+	})
+
+	_, unmute := set.muteAllExcept("pane-1", map[entity.PaneID]bool{
+		"pane-1": true,
+		"pane-2": true,
+	})
+
+	assert.Empty(t, unmute)
+	assert.Equal(t, []entity.PaneID{"pane-2"}, set.release())
+}
+
+func TestBackgroundMuteSet_ForgetHandsPaneToUser(t *testing.T) {
+	var set backgroundMuteSet
+	set.muteAllExcept("pane-1", map[entity.PaneID]bool{
+		"pane-1": false,
+		"pane-2": false,
+		"pane-3": false,
+	})
+
+	// The user toggled pane-2 themselves; unmute-all must not touch it.
+	set.forget("pane-2")
+
+	assert.Equal(t, []entity.PaneID{"pane-3"}, set.release())
+}
+
+func TestWorkspaceCoordinator_FocusLossMutesAllAndRestoresOnlyThosePanes(t *testing.T) {
+	ctx := context.Background()
+	active := &mutableWebView{}
+	playing := &mutableWebView{}
+	userMuted := &mutableWebView{muted: true}
+	coord := newAudioTestCoordinator(t, map[entity.PaneID]*mutableWebView{
+		"pane-1": active,
+		"pane-2": playing,
+		"pane-3": userMuted,
+	})
+
+	coord.MuteAllForFocusLoss(ctx)
+	assert.True(t, active.muted, "the active pane is muted too")
+	assert.True(t, playing.muted)
+	assert.True(t, userMuted.muted)
+
+	coord.RestoreFocusMute(ctx)
+	assert.False(t, active.muted)
+	assert.False(t, playing.muted)
+	assert.True(t, userMuted.muted, "a pane the user muted stays muted")
+
+	coord.RestoreFocusMute(ctx)
+	assert.True(t, userMuted.muted, "restoring twice changes nothing")
+}
+
+func TestWorkspaceCoordinator_FocusLossKeepsBackgroundMute(t *testing.T) {
+	ctx := context.Background()
+	active := &mutableWebView{}
+	background := &mutableWebView{}
+	coord := newAudioTestCoordinator(t, map[entity.PaneID]*mutableWebView{
+		"pane-1": active,
+		"pane-2": background,
+	})
+
+	assert.NoError(t, coord.MuteAllExceptActive(ctx))
+	assert.True(t, background.muted)
+
+	coord.MuteAllForFocusLoss(ctx)
+	coord.RestoreFocusMute(ctx)
+	assert.False(t, active.muted)
+	assert.True(t, background.muted, "the background mute outlives the focus mute")
+
+	assert.NoError(t, coord.UnmuteAll(ctx))
+	assert.False(t, background.muted)
+}
+
+func TestWorkspaceCoordinator_ToggleMuteHandsFocusMutedPaneToUser(t *testing.T) {
+	ctx := context.Background()
+	active := &mutableWebView{}
+	coord := newAudioTestCoordinator(t, map[entity.PaneID]*mutableWebView{"pane-1": active})
+
+	coord.MuteAllForFocusLoss(ctx)
+	// The user unmutes and mutes the pane again, e.g. through a global shortcut.
+	assert.NoError(t, coord.ToggleMuteActivePane(ctx))
+	assert.NoError(t, coord.ToggleMuteActivePane(ctx))
+	assert.True(t, active.muted)
+
+	coord.RestoreFocusMute(ctx)
+	assert.True(t, active.muted, "the pane now belongs to the user")
+}
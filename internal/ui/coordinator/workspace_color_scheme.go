@@ -0,0 +1,33 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// CycleColorSchemeActivePane moves the active pane to the next color scheme:
+// dark, light, then the system one. The choice is remembered for the site.
+func (c *WorkspaceCoordinator) CycleColorSchemeActivePane(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	scheme, domain, ok := c.contentCoord.CyclePaneColorScheme(ctx, paneID)
+	if !ok {
+		c.ShowToastOnActivePane(ctx, "Color scheme can't be changed on this page", component.ToastInfo)
+		return nil
+	}
+	switch scheme {
+	case entity.ColorSchemeDark:
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Dark color scheme on %s", domain), component.ToastInfo)
+	case entity.ColorSchemeLight:
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Light color scheme on %s", domain), component.ToastInfo)
+	default:
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("System color scheme on %s", domain), component.ToastInfo)
+	}
+	return nil
+}
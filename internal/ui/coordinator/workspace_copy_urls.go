@@ -0,0 +1,49 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/puregotk/v4/glib"
+)
+
+// CopyTabURLs copies the URLs of every pane in the current tab, in layout
+// order, rendered in format. Blank and internal pages are left out.
+func (c *WorkspaceCoordinator) CopyTabURLs(ctx context.Context, format usecase.URLListFormat) error {
+	log := logging.FromContext(ctx)
+	if c.copyURLUC == nil {
+		log.Warn().Msg("copy URL use case not available")
+		return nil
+	}
+	ws, _ := c.getActiveWS()
+	if ws == nil {
+		return nil
+	}
+
+	urls := ws.ShareableURLs()
+	if len(urls) == 0 {
+		c.ShowToastOnActivePane(ctx, "No page URLs to copy in this tab", component.ToastInfo)
+		return nil
+	}
+
+	// Clipboard tools may block; write off the GTK main thread.
+	go func() {
+		if err := c.copyURLUC.CopyURLList(ctx, urls, format); err != nil {
+			log.Error().Err(err).Str("format", string(format)).Msg("copy tab URLs failed")
+			return
+		}
+		msg := fmt.Sprintf("Copied %d URLs", len(urls))
+		if len(urls) == 1 {
+			msg = "Copied 1 URL"
+		}
+		cb := glib.SourceFunc(func(_ uintptr) bool {
+			c.ShowToastOnActivePane(ctx, msg, component.ToastSuccess)
+			return false
+		})
+		glib.IdleAdd(&cb, 0)
+	}()
+	return nil
+}
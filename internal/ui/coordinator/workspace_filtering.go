@@ -0,0 +1,72 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/dumber/internal/ui/coordinator/content"
+)
+
+// ToggleContentFilteringActivePane turns content filtering off for the site of
+// the active pane, or back on. The choice is remembered for the site.
+func (c *WorkspaceCoordinator) ToggleContentFilteringActivePane(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	bypassed, domain, err := c.contentCoord.TogglePaneFiltering(ctx, paneID)
+	switch {
+	case errors.Is(err, content.ErrFilteringUnsupported):
+		c.ShowToastOnActivePane(ctx, "Content filtering is not available with this engine", component.ToastInfo)
+		return nil
+	case errors.Is(err, usecase.ErrFilterExceptionUnsupportedURL):
+		c.ShowToastOnActivePane(ctx, "Content filtering does not apply to this page", component.ToastInfo)
+		return nil
+	case errors.Is(err, usecase.ErrFilteringDisabledByConfig):
+		c.ShowToastOnActivePane(ctx,
+			fmt.Sprintf("Filtering on %s is disabled by content_filtering.disabled_domains", domain), component.ToastInfo)
+		return nil
+	case err != nil:
+		c.ShowToastOnActivePane(ctx, "Failed to toggle content filtering", component.ToastError)
+		return err
+	}
+	if bypassed {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Content filtering off on %s", domain), component.ToastInfo)
+	} else {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Content filtering on for %s", domain), component.ToastInfo)
+	}
+	return nil
+}
+
+// ToggleCosmeticFilteringActivePane stops hiding page elements on the site of
+// the active pane, or starts again, while its requests stay filtered. The
+// choice is remembered for the site.
+func (c *WorkspaceCoordinator) ToggleCosmeticFilteringActivePane(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	bypassed, domain, err := c.contentCoord.TogglePaneCosmeticFiltering(ctx, paneID)
+	switch {
+	case errors.Is(err, content.ErrFilteringUnsupported):
+		c.ShowToastOnActivePane(ctx, "Content filtering is not available with this engine", component.ToastInfo)
+		return nil
+	case errors.Is(err, usecase.ErrFilterExceptionUnsupportedURL):
+		c.ShowToastOnActivePane(ctx, "Content filtering does not apply to this page", component.ToastInfo)
+		return nil
+	case err != nil:
+		c.ShowToastOnActivePane(ctx, "Failed to toggle element hiding", component.ToastError)
+		return err
+	}
+	if bypassed {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Element hiding off on %s", domain), component.ToastInfo)
+	} else {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Element hiding on for %s", domain), component.ToastInfo)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// ToggleFocusTimerActivePane starts a focus timer on the active pane, or
+// stops the one running.
+func (c *WorkspaceCoordinator) ToggleFocusTimerActivePane(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	event, ok := c.contentCoord.ToggleFocusTimer(ctx, paneID)
+	if !ok {
+		c.ShowToastOnActivePane(ctx, "No page to time in this pane", component.ToastInfo)
+		return nil
+	}
+	c.ShowToastOnActivePane(ctx, event.Message(), component.ToastInfo)
+	return nil
+}
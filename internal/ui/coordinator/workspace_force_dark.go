@@ -0,0 +1,29 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// ToggleForceDarkActivePane forces dark mode on the site of the active pane,
+// or turns it off. The choice lasts until the pane leaves the site.
+func (c *WorkspaceCoordinator) ToggleForceDarkActivePane(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	enabled, domain, ok := c.contentCoord.TogglePaneForceDark(ctx, paneID)
+	if !ok {
+		c.ShowToastOnActivePane(ctx, "Dark mode can't be forced on this page", component.ToastInfo)
+		return nil
+	}
+	if enabled {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Dark mode forced on %s", domain), component.ToastInfo)
+	} else {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Forced dark mode off on %s", domain), component.ToastInfo)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// pendingPaneLink is a link captured from sourcePaneID, waiting for the user
+// to pick the pane it opens in.
+type pendingPaneLink struct {
+	url          string
+	sourcePaneID entity.PaneID
+}
+
+// BeginOpenLinkInPane captures the link under the pointer in the active pane
+// so the next pane number loads it in that pane. It reports whether a link
+// was captured; the caller then enters pane mode, which shows the numbers.
+func (c *WorkspaceCoordinator) BeginOpenLinkInPane(ctx context.Context) bool {
+	c.pendingLink = nil
+
+	ws, _ := c.getActiveWS()
+	if ws == nil {
+		return false
+	}
+	active := ws.ActivePane()
+	if active == nil || active.Pane == nil {
+		return false
+	}
+	sourceID := active.Pane.ID
+
+	url := c.hoveredLinkURI(sourceID)
+	if url == "" {
+		c.ShowToastOnActivePane(ctx, "Hover a link first", component.ToastInfo)
+		return false
+	}
+	if len(ws.NumberedPanes()) < 2 {
+		c.ShowToastOnActivePane(ctx, "No other pane to open the link in", component.ToastInfo)
+		return false
+	}
+
+	c.pendingLink = &pendingPaneLink{url: url, sourcePaneID: sourceID}
+	logging.FromContext(ctx).Debug().
+		Str("url", url).
+		Str("source_pane_id", string(sourceID)).
+		Msg("link captured, waiting for target pane")
+	return true
+}
+
+// CancelOpenLinkInPane drops the link captured by BeginOpenLinkInPane, for
+// when pane mode ends without a pane number.
+func (c *WorkspaceCoordinator) CancelOpenLinkInPane() {
+	c.pendingLink = nil
+}
+
+// hoveredLinkURI returns the link under the pointer in paneID's WebView.
+func (c *WorkspaceCoordinator) hoveredLinkURI(paneID entity.PaneID) string {
+	if c.contentCoord == nil {
+		return ""
+	}
+	provider, ok := c.contentCoord.GetWebView(paneID).(port.HoveredLinkProvider)
+	if !ok {
+		return ""
+	}
+	return provider.HoveredLinkURI()
+}
+
+// openPendingLinkInPane loads the pending link in the pane labeled n. Focus
+// stays on the pane the link came from.
+func (c *WorkspaceCoordinator) openPendingLinkInPane(ctx context.Context, ws *entity.Workspace, n int) error {
+	link := c.pendingLink
+	c.pendingLink = nil
+
+	target := ws.PaneByNumber(n)
+	if target == nil || target.Pane == nil {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("No pane %d", n), component.ToastWarning)
+		return nil
+	}
+	if c.onNavigatePane == nil {
+		logging.FromContext(ctx).Warn().Msg("pane navigation not available")
+		return nil
+	}
+	if err := c.onNavigatePane(ctx, target.Pane.ID, link.url); err != nil {
+		return fmt.Errorf("open link in pane %d: %w", n, err)
+	}
+	logging.FromContext(ctx).Debug().
+		Int("number", n).
+		Str("pane_id", string(target.Pane.ID)).
+		Str("source_pane_id", string(link.sourcePaneID)).
+		Msg("opened link in pane")
+	return nil
+}
@@ -0,0 +1,98 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type paneNavigation struct {
+	paneID entity.PaneID
+	url    string
+}
+
+// newLinkToPaneTestCoordinator returns a coordinator over a workspace split
+// into pane-1 (number 1) and the active pane-2 (number 2).
+func newLinkToPaneTestCoordinator(t *testing.T) (*WorkspaceCoordinator, *entity.Workspace, *[]paneNavigation) {
+	t.Helper()
+	ws := entity.NewWorkspace("ws-1", entity.NewPane("pane-1"))
+	coord := newInheritTestCoordinator(ws, false)
+	require.NoError(t, coord.Split(context.Background(), usecase.SplitRight))
+	require.Equal(t, entity.PaneID("pane-2"), ws.ActivePaneID)
+
+	navigations := &[]paneNavigation{}
+	coord.SetOnNavigatePane(func(_ context.Context, paneID entity.PaneID, url string) error {
+		*navigations = append(*navigations, paneNavigation{paneID: paneID, url: url})
+		return nil
+	})
+	return coord, ws, navigations
+}
+
+func TestWorkspaceCoordinator_FocusPaneByNumber_RoutesPendingLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		number int
+		want   []paneNavigation
+	}{
+		{name: "other pane", number: 1, want: []paneNavigation{{paneID: "pane-1", url: "https://example.com/next"}}},
+		{name: "source pane", number: 2, want: []paneNavigation{{paneID: "pane-2", url: "https://example.com/next"}}},
+		{name: "unknown number", number: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coord, ws, navigations := newLinkToPaneTestCoordinator(t)
+			coord.pendingLink = &pendingPaneLink{url: "https://example.com/next", sourcePaneID: "pane-2"}
+
+			require.NoError(t, coord.FocusPaneByNumber(context.Background(), tt.number))
+
+			assert.Equal(t, tt.want, nilIfEmpty(*navigations))
+			assert.Nil(t, coord.pendingLink, "the link is used once")
+			assert.Equal(t, entity.PaneID("pane-2"), ws.ActivePaneID, "focus stays on the source pane")
+		})
+	}
+}
+
+func TestWorkspaceCoordinator_FocusPaneByNumber_WrapsNavigationError(t *testing.T) {
+	coord, _, _ := newLinkToPaneTestCoordinator(t)
+	loadErr := errors.New("webview gone")
+	coord.SetOnNavigatePane(func(context.Context, entity.PaneID, string) error { return loadErr })
+	coord.pendingLink = &pendingPaneLink{url: "https://example.com/", sourcePaneID: "pane-2"}
+
+	err := coord.FocusPaneByNumber(context.Background(), 1)
+
+	assert.ErrorIs(t, err, loadErr)
+	assert.Nil(t, coord.pendingLink)
+}
+
+func TestWorkspaceCoordinator_BeginOpenLinkInPane_NeedsHoveredLink(t *testing.T) {
+	coord, _, navigations := newLinkToPaneTestCoordinator(t)
+	coord.pendingLink = &pendingPaneLink{url: "https://stale.example/", sourcePaneID: "pane-1"}
+
+	assert.False(t, coord.BeginOpenLinkInPane(context.Background()), "no WebView reports a hovered link")
+	assert.Nil(t, coord.pendingLink, "a failed capture drops the previous link")
+	assert.Empty(t, *navigations)
+}
+
+func TestWorkspaceCoordinator_CancelOpenLinkInPane(t *testing.T) {
+	coord, ws, navigations := newLinkToPaneTestCoordinator(t)
+	coord.pendingLink = &pendingPaneLink{url: "https://example.com/", sourcePaneID: "pane-2"}
+
+	coord.CancelOpenLinkInPane()
+
+	assert.Nil(t, coord.pendingLink)
+	assert.Empty(t, *navigations)
+	assert.Equal(t, entity.PaneID("pane-2"), ws.ActivePaneID)
+}
+
+func nilIfEmpty(navigations []paneNavigation) []paneNavigation {
+	if len(navigations) == 0 {
+		return nil
+	}
+	return navigations
+}
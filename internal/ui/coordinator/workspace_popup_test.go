@@ -0,0 +1,154 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/dumber/internal/ui/coordinator/content"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoPopupSplitDirection(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		want          usecase.SplitDirection
+		wantOK        bool
+	}{
+		{name: "wide pane splits side by side", width: 1600, height: 900, want: usecase.SplitRight, wantOK: true},
+		{name: "tall pane splits top and bottom", width: 700, height: 1200, want: usecase.SplitDown, wantOK: true},
+		{name: "square pane splits side by side", width: 800, height: 800, want: usecase.SplitRight, wantOK: true},
+		{name: "half of a wide window stays tall", width: 800, height: 1000, want: usecase.SplitDown, wantOK: true},
+		{name: "unrealized pane has no geometry", width: 0, height: 0, wantOK: false},
+		{name: "missing height", width: 1200, height: 0, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := autoPopupSplitDirection(tt.width, tt.height)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPopupSplitDirection_FallsBackWithoutGeometry(t *testing.T) {
+	c := &WorkspaceCoordinator{}
+
+	assert.Equal(t, usecase.SplitRight, c.popupSplitDirection(nil, content.InsertPopupInput{Placement: popupPlacementAuto}))
+	assert.Equal(t, usecase.SplitDown, c.popupSplitDirection(nil, content.InsertPopupInput{Placement: "bottom"}))
+	assert.Equal(t, usecase.SplitLeft, c.popupSplitDirection(nil, content.InsertPopupInput{Placement: "left"}))
+}
+
+func TestInsertPopup_RoutesByBehavior(t *testing.T) {
+	tests := []struct {
+		behavior entity.PopupBehavior
+		wantTab  bool
+	}{
+		{behavior: entity.PopupBehaviorTabbed, wantTab: true},
+		{behavior: entity.PopupBehaviorSplit},
+		{behavior: entity.PopupBehaviorStacked},
+		{behavior: entity.PopupBehaviorWindowed},
+		{behavior: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.behavior), func(t *testing.T) {
+			var tabInputs []content.InsertPopupInput
+			c := NewWorkspaceCoordinator(context.Background(), WorkspaceCoordinatorConfig{
+				GetActiveWS: func() (*entity.Workspace, *component.WorkspaceView) { return nil, nil },
+				OnCreatePopupTab: func(_ context.Context, input content.InsertPopupInput) error {
+					tabInputs = append(tabInputs, input)
+					return nil
+				},
+			})
+
+			input := content.InsertPopupInput{
+				ParentPaneID: "parent",
+				PopupPane:    entity.NewPane("popup"),
+				Behavior:     tt.behavior,
+			}
+			err := c.InsertPopup(context.Background(), input)
+
+			if tt.wantTab {
+				require.NoError(t, err)
+				require.Len(t, tabInputs, 1)
+				assert.Equal(t, input.PopupPane, tabInputs[0].PopupPane)
+				return
+			}
+			// Pane behaviors go through the workspace, which is absent here.
+			require.ErrorContains(t, err, "no active workspace")
+			assert.Empty(t, tabInputs)
+		})
+	}
+}
+
+func TestInsertPopup_TabbedWithoutHandlerFallsBackToSplit(t *testing.T) {
+	c := NewWorkspaceCoordinator(context.Background(), WorkspaceCoordinatorConfig{
+		GetActiveWS: func() (*entity.Workspace, *component.WorkspaceView) { return nil, nil },
+	})
+
+	err := c.InsertPopup(context.Background(), content.InsertPopupInput{
+		ParentPaneID: "parent",
+		PopupPane:    entity.NewPane("popup"),
+		Behavior:     entity.PopupBehaviorTabbed,
+	})
+	require.ErrorContains(t, err, "no active workspace")
+}
+
+func TestResolvePopupPlacement(t *testing.T) {
+	placements := []entity.PopupPlacementRule{
+		{Domain: "*.google.com", Placement: "bottom"},
+		{Domain: "accounts.google.com", Placement: "left"},
+		{Domain: "github.com", Placement: "auto"},
+	}
+	tests := []struct {
+		name string
+		uri  string
+		want string
+	}{
+		{name: "exact domain", uri: "https://github.com/login/oauth/authorize", want: "auto"},
+		{name: "most specific pattern wins", uri: "https://accounts.google.com/o/oauth2/auth", want: "left"},
+		{name: "subdomain glob", uri: "https://mail.google.com/", want: "bottom"},
+		{name: "unlisted domain falls back to global", uri: "https://example.com/", want: "right"},
+		{name: "blank popup falls back to global", uri: "", want: "right"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resolvePopupPlacement(placements, tt.uri, "right"))
+		})
+	}
+
+	assert.Equal(t, "top", resolvePopupPlacement(nil, "https://github.com/", "top"), "no domain placements")
+}
+
+func TestInsertPopup_AppliesDomainPlacement(t *testing.T) {
+	var got []string
+	c := NewWorkspaceCoordinator(context.Background(), WorkspaceCoordinatorConfig{
+		GetActiveWS: func() (*entity.Workspace, *component.WorkspaceView) { return nil, nil },
+		OnCreatePopupTab: func(_ context.Context, input content.InsertPopupInput) error {
+			got = append(got, input.Placement)
+			return nil
+		},
+		PopupDomainPlacement: func() []entity.PopupPlacementRule {
+			return []entity.PopupPlacementRule{{Domain: "*.okta.com", Placement: "bottom"}}
+		},
+	})
+
+	for _, uri := range []string{"https://acme.okta.com/oauth2/v1/authorize", "https://example.com/"} {
+		require.NoError(t, c.InsertPopup(context.Background(), content.InsertPopupInput{
+			ParentPaneID: "parent",
+			PopupPane:    entity.NewPane("popup"),
+			Behavior:     entity.PopupBehaviorTabbed,
+			Placement:    "right",
+			TargetURI:    uri,
+		}))
+	}
+	assert.Equal(t, []string{"bottom", "right"}, got)
+}
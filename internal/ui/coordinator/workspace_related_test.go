@@ -0,0 +1,109 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/dumber/internal/ui/coordinator/content"
+)
+
+func TestWorkspaceCoordinator_ReopenAsRelated_ReplacesPaneWithRelatedWebView(t *testing.T) {
+	ctx := context.Background()
+
+	parent := testLeafNode("parent")
+	stuck := testLeafNode("stuck")
+	parentID := entity.PaneID("parent")
+	stuck.Pane.ParentPaneID = &parentID
+	root := testSplitNode("split-1", parent, stuck)
+	ws := &entity.Workspace{ID: "ws-1", Root: root, ActivePaneID: "stuck"}
+	getActiveWS := func() (*entity.Workspace, *component.WorkspaceView) { return ws, nil }
+
+	parentWV := mocks.NewMockWebView(t)
+	parentWV.EXPECT().ID().Return(port.WebViewID(1)).Maybe()
+	parentWV.EXPECT().IsDestroyed().Return(false)
+
+	stuckWV := mocks.NewMockWebView(t)
+	stuckWV.EXPECT().ID().Return(port.WebViewID(2)).Maybe()
+	stuckWV.EXPECT().URI().Return("https://accounts.example.com/oauth?step=2")
+	stuckWV.EXPECT().Destroy().Once()
+
+	relatedWV := mocks.NewMockWebView(t)
+	relatedWV.EXPECT().ID().Return(port.WebViewID(3)).Maybe()
+	relatedWV.EXPECT().Generation().Return(uint64(0)).Maybe()
+	relatedWV.EXPECT().SetCallbacks(mock.Anything).Maybe()
+	relatedWV.EXPECT().LoadURI(mock.Anything, "https://accounts.example.com/oauth?step=2").Return(nil).Once()
+
+	factory := mocks.NewMockWebViewFactory(t)
+	factory.EXPECT().CreateRelated(mock.Anything, port.WebViewID(1)).Return(relatedWV, nil).Once()
+
+	contentCoord := content.NewCoordinator(ctx, nil, nil, nil, nil, getActiveWS, nil, nil)
+	contentCoord.SetPopupConfig(factory, nil, nil)
+	contentCoord.RegisterPopupWebView("parent", parentWV)
+	contentCoord.RegisterPopupWebView("stuck", stuckWV)
+
+	var closed []entity.PaneID
+	coord := NewWorkspaceCoordinator(ctx, WorkspaceCoordinatorConfig{
+		PanesUC:      usecase.NewManagePanesUseCase(func() string { return "unused" }, nil),
+		ContentCoord: contentCoord,
+		GetActiveWS:  getActiveWS,
+		GenerateID:   func() string { return "related" },
+	})
+	coord.SetOnPaneClosed(func(paneID entity.PaneID) { closed = append(closed, paneID) })
+
+	require.NoError(t, coord.ReopenAsRelated(ctx, ""))
+
+	newNode := root.Right()
+	require.NotNil(t, newNode)
+	require.NotNil(t, newNode.Pane)
+	assert.Equal(t, entity.PaneID("related"), newNode.Pane.ID)
+	assert.True(t, newNode.Pane.IsRelated)
+	require.NotNil(t, newNode.Pane.ParentPaneID)
+	assert.Equal(t, parentID, *newNode.Pane.ParentPaneID)
+	assert.Equal(t, "https://accounts.example.com/oauth?step=2", newNode.Pane.URI)
+	assert.Same(t, root, newNode.Parent)
+	assert.Nil(t, ws.FindPane("stuck"))
+	assert.Equal(t, entity.PaneID("related"), ws.ActivePaneID)
+
+	assert.Equal(t, relatedWV, contentCoord.GetWebView("related"))
+	assert.Nil(t, contentCoord.GetWebView("stuck"))
+	assert.Equal(t, []entity.PaneID{"stuck"}, closed)
+}
+
+func TestRelatedParentPaneID(t *testing.T) {
+	left := testLeafNode("left")
+	right := testLeafNode("right")
+	ws := &entity.Workspace{ID: "ws-1", Root: testSplitNode("split-1", left, right), ActivePaneID: "right"}
+	popupParent := entity.PaneID("left")
+
+	tests := []struct {
+		name           string
+		recordedParent *entity.PaneID
+		previousActive entity.PaneID
+		want           entity.PaneID
+		wantOK         bool
+	}{
+		{name: "previous active pane", previousActive: "left", want: "left", wantOK: true},
+		{name: "falls back to popup parent", recordedParent: &popupParent, want: "left", wantOK: true},
+		{name: "ignores closed previous pane", previousActive: "gone", recordedParent: &popupParent, want: "left", wantOK: true},
+		{name: "ignores itself", previousActive: "right"},
+		{name: "no candidate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			right.Pane.ParentPaneID = tt.recordedParent
+			got, ok := relatedParentPaneID(ws, right, tt.previousActive)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// RequestSiteUserAgentActivePane reloads the site of the active pane with the
+// requested user agent preset, or with the default one when that preset is
+// already in effect. The choice is remembered for the site.
+func (c *WorkspaceCoordinator) RequestSiteUserAgentActivePane(ctx context.Context, requested entity.UserAgentPreset) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	preset, domain, ok := c.contentCoord.ToggleSiteUserAgent(ctx, paneID, requested)
+	if !ok {
+		c.ShowToastOnActivePane(ctx, "User agent can't be changed on this page", component.ToastInfo)
+		return nil
+	}
+	switch preset {
+	case entity.UserAgentPresetDesktop:
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Requesting desktop site for %s", domain), component.ToastInfo)
+	case entity.UserAgentPresetMobile:
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Requesting mobile site for %s", domain), component.ToastInfo)
+	default:
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Default user agent on %s", domain), component.ToastInfo)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+func newInheritTestCoordinator(ws *entity.Workspace, inherit bool) *WorkspaceCoordinator {
+	ids := []string{"pane-2", "split-1"}
+	idx := 0
+	return NewWorkspaceCoordinator(context.Background(), WorkspaceCoordinatorConfig{
+		PanesUC: usecase.NewManagePanesUseCase(func() string {
+			id := ids[idx]
+			idx++
+			return id
+		}, nil),
+		GetActiveWS: func() (*entity.Workspace, *component.WorkspaceView) {
+			return ws, nil
+		},
+		NewPaneURL:       "dumb://home",
+		SplitInheritsURL: inherit,
+	})
+}
+
+func TestWorkspaceCoordinator_DefaultSplitURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		inherit       bool
+		sourceURI     string
+		wantURL       string
+		wantInherited bool
+	}{
+		{name: "disabled uses new pane url", inherit: false, sourceURI: "https://example.com/a", wantURL: "dumb://home"},
+		{name: "enabled inherits page", inherit: true, sourceURI: "https://example.com/a?b=1", wantURL: "https://example.com/a?b=1", wantInherited: true},
+		{name: "enabled inherits internal page", inherit: true, sourceURI: "dumb://history", wantURL: "dumb://history", wantInherited: true},
+		{name: "blank source uses new pane url", inherit: true, sourceURI: "about:blank", wantURL: "dumb://home"},
+		{name: "empty source uses new pane url", inherit: true, sourceURI: "", wantURL: "dumb://home"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := testLeafNode("pane-1")
+			source.Pane.URI = tt.sourceURI
+			coord := newInheritTestCoordinator(nil, tt.inherit)
+
+			gotURL, gotInherited := coord.defaultSplitURL(source)
+
+			assert.Equal(t, tt.wantURL, gotURL)
+			assert.Equal(t, tt.wantInherited, gotInherited)
+		})
+	}
+}
+
+func TestWorkspaceCoordinator_Split_PropagatesSourceURL(t *testing.T) {
+	source := entity.NewPane("pane-1")
+	source.URI = "https://example.com/state-a"
+	ws := entity.NewWorkspace("ws-1", source)
+	coord := newInheritTestCoordinator(ws, true)
+
+	require.NoError(t, coord.Split(context.Background(), usecase.SplitRight))
+
+	newPane := ws.FindPane("pane-2")
+	require.NotNil(t, newPane)
+	assert.Equal(t, "https://example.com/state-a", newPane.Pane.URI)
+	assert.Equal(t, entity.PaneID("pane-2"), ws.ActivePaneID)
+}
+
+func TestWorkspaceCoordinator_SplitWithURL_IgnoresInheritance(t *testing.T) {
+	source := entity.NewPane("pane-1")
+	source.URI = "https://example.com/state-a"
+	ws := entity.NewWorkspace("ws-1", source)
+	coord := newInheritTestCoordinator(ws, true)
+
+	require.NoError(t, coord.SplitWithURL(context.Background(), usecase.SplitRight, "https://other.example/"))
+
+	newPane := ws.FindPane("pane-2")
+	require.NotNil(t, newPane)
+	assert.Equal(t, "https://other.example/", newPane.Pane.URI)
+}
@@ -0,0 +1,55 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/input"
+	"github.com/bnema/dumber/internal/ui/layout"
+)
+
+// onStackScroll cycles the stack under the pointer when a vertical swipe
+// passes the configured guard (title bar origin or Alt). It only acts when the
+// active pane lives in that stack and reports whether the event was consumed.
+func (c *WorkspaceCoordinator) onStackScroll(ctx context.Context, sv *layout.StackedView, event layout.StackScrollEvent) bool {
+	if event.End {
+		c.stackSwipe.End()
+		return false
+	}
+
+	scroll := input.StackScroll{
+		DeltaY:     event.DeltaY,
+		Precise:    event.Precise,
+		OnTitleBar: event.OnTitleBar,
+		AltHeld:    event.AltHeld,
+	}
+	if !c.stackSwipe.Accepts(scroll) || !c.activePaneInStack(sv) {
+		return false
+	}
+
+	direction, handled := c.stackSwipe.Scroll(scroll)
+	if direction == "" {
+		return handled
+	}
+	if err := c.NavigateStack(ctx, direction); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Str("direction", direction).Msg("stack swipe navigation failed")
+	}
+	return true
+}
+
+// activePaneInStack reports whether the active pane of the active workspace
+// is one of the panes of sv.
+func (c *WorkspaceCoordinator) activePaneInStack(sv *layout.StackedView) bool {
+	if sv == nil || sv.Count() <= 1 || c.getActiveWS == nil {
+		return false
+	}
+	ws, _ := c.getActiveWS()
+	if ws == nil {
+		return false
+	}
+	active := ws.ActivePane()
+	if active == nil || active.Pane == nil {
+		return false
+	}
+	return sv.FindPaneIndex(string(active.Pane.ID)) >= 0
+}
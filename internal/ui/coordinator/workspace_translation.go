@@ -0,0 +1,25 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnema/dumber/internal/domain/translation"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// TranslateActivePane loads the translation of the active pane's page.
+func (c *WorkspaceCoordinator) TranslateActivePane(ctx context.Context) error {
+	paneID, ok := c.targetPaneID()
+	if !ok || c.contentCoord == nil {
+		return nil
+	}
+
+	to, err := c.contentCoord.TranslatePane(ctx, paneID)
+	if err != nil {
+		c.ShowToastOnActivePane(ctx, fmt.Sprintf("Can't translate: %v", err), component.ToastWarning)
+		return nil
+	}
+	c.ShowToastOnActivePane(ctx, "Translating to "+translation.LanguageName(to), component.ToastInfo)
+	return nil
+}
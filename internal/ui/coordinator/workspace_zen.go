@@ -0,0 +1,124 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+// zenState records what ToggleZen changed so toggling off restores it.
+type zenState struct {
+	paneID entity.PaneID
+	// ratios snapshots every split ratio at zen entry. Hiding siblings can make
+	// GTK report new divider positions, so the snapshot is the source of truth.
+	ratios map[string]float64
+}
+
+// ToggleZen maximizes the active pane inside its workspace by hiding every
+// other pane, or restores the previous layout when zen is already active.
+// The pane tree is never modified: hidden panes keep running and the split
+// ratios are restored exactly on toggle-off.
+func (c *WorkspaceCoordinator) ToggleZen(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+
+	ws, wsView := c.getActiveWS()
+	if ws == nil {
+		log.Warn().Msg("no active workspace")
+		return nil
+	}
+
+	if c.leaveZen(ctx, ws, wsView) {
+		return nil
+	}
+
+	active := ws.ActivePane()
+	if active == nil || active.Pane == nil {
+		log.Warn().Msg("no active pane for zen mode")
+		return nil
+	}
+	if ws.PaneCount() <= 1 {
+		c.ShowToastOnActivePane(ctx, "Only one pane", component.ToastInfo)
+		return nil
+	}
+
+	if c.zen == nil {
+		c.zen = make(map[entity.WorkspaceID]*zenState)
+	}
+	c.zen[ws.ID] = &zenState{
+		paneID: active.Pane.ID,
+		ratios: snapshotSplitRatios(ws.Root),
+	}
+
+	if wsView != nil {
+		if err := wsView.EnterZen(active.Pane.ID); err != nil {
+			delete(c.zen, ws.ID)
+			return err
+		}
+	}
+
+	log.Debug().Str("pane_id", string(active.Pane.ID)).Msg("zen mode enabled")
+	return nil
+}
+
+// IsZen reports whether the active workspace is in zen mode.
+func (c *WorkspaceCoordinator) IsZen() bool {
+	ws, _ := c.getActiveWS()
+	if ws == nil {
+		return false
+	}
+	_, ok := c.zen[ws.ID]
+	return ok
+}
+
+// leaveZen restores the layout of ws if it is in zen mode and reports whether
+// it was. Structural pane operations call it first so they act on the real
+// layout instead of the maximized one.
+func (c *WorkspaceCoordinator) leaveZen(ctx context.Context, ws *entity.Workspace, wsView *component.WorkspaceView) bool {
+	if ws == nil {
+		return false
+	}
+	state, ok := c.zen[ws.ID]
+	if !ok {
+		return false
+	}
+	delete(c.zen, ws.ID)
+
+	restoreSplitRatios(ws.Root, state.ratios)
+	if wsView != nil {
+		wsView.ExitZen()
+		c.updateSplitPositions(wsView, ws)
+	}
+
+	logging.FromContext(ctx).Debug().Str("pane_id", string(state.paneID)).Msg("zen mode disabled")
+	return true
+}
+
+// snapshotSplitRatios returns the ratio of every split node keyed by node ID.
+func snapshotSplitRatios(root *entity.PaneNode) map[string]float64 {
+	ratios := make(map[string]float64)
+	if root == nil {
+		return ratios
+	}
+	root.Walk(func(node *entity.PaneNode) bool {
+		if node.IsSplit() {
+			ratios[node.ID] = node.SplitRatio
+		}
+		return true
+	})
+	return ratios
+}
+
+// restoreSplitRatios writes snapshotted ratios back onto matching split nodes.
+func restoreSplitRatios(root *entity.PaneNode, ratios map[string]float64) {
+	if root == nil {
+		return
+	}
+	root.Walk(func(node *entity.PaneNode) bool {
+		if ratio, ok := ratios[node.ID]; ok && node.IsSplit() {
+			node.SplitRatio = ratio
+		}
+		return true
+	})
+}
@@ -0,0 +1,100 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// describeTree renders the structure and split ratios of a pane tree so
+// tests can compare layouts exactly.
+func describeTree(node *entity.PaneNode) string {
+	if node == nil {
+		return nilString
+	}
+	if node.Pane != nil {
+		return node.ID
+	}
+	children := make([]string, 0, len(node.Children))
+	for _, child := range node.Children {
+		children = append(children, describeTree(child))
+	}
+	return fmt.Sprintf("%s(%d,%v)[%s]", node.ID, node.SplitDir, node.SplitRatio, strings.Join(children, ","))
+}
+
+func newZenTestWorkspace() *entity.Workspace {
+	inner := testSplitNode("split-2", testLeafNode("pane-2"), testLeafNode("pane-3"))
+	inner.SplitDir = entity.SplitVertical
+	inner.SplitRatio = 0.7
+	root := testSplitNode("split-1", testLeafNode("pane-1"), inner)
+	root.SplitRatio = 0.3
+	return &entity.Workspace{ID: "ws-1", Root: root, ActivePaneID: "pane-3"}
+}
+
+func newZenTestCoordinator(ws *entity.Workspace, ids ...string) *WorkspaceCoordinator {
+	idx := 0
+	return NewWorkspaceCoordinator(context.Background(), WorkspaceCoordinatorConfig{
+		PanesUC: usecase.NewManagePanesUseCase(func() string {
+			id := ids[idx]
+			idx++
+			return id
+		}, nil),
+		GetActiveWS: func() (*entity.Workspace, *component.WorkspaceView) {
+			return ws, nil
+		},
+	})
+}
+
+func TestWorkspaceCoordinator_ToggleZen_PreservesAndRestoresTree(t *testing.T) {
+	ctx := context.Background()
+	ws := newZenTestWorkspace()
+	root := ws.Root
+	before := describeTree(ws.Root)
+	coord := newZenTestCoordinator(ws)
+
+	require.NoError(t, coord.ToggleZen(ctx))
+	assert.True(t, coord.IsZen())
+	assert.Same(t, root, ws.Root)
+	assert.Equal(t, before, describeTree(ws.Root))
+	assert.Equal(t, 3, ws.PaneCount())
+	assert.Equal(t, entity.PaneID("pane-3"), ws.ActivePaneID)
+
+	// GTK may report new divider positions while siblings are hidden.
+	require.NoError(t, coord.SetSplitRatio(ctx, "split-1", 0.95))
+	ws.Root.Children[1].SplitRatio = 0.01
+
+	require.NoError(t, coord.ToggleZen(ctx))
+	assert.False(t, coord.IsZen())
+	assert.Same(t, root, ws.Root)
+	assert.Equal(t, before, describeTree(ws.Root))
+	assert.Equal(t, entity.PaneID("pane-3"), ws.ActivePaneID)
+}
+
+func TestWorkspaceCoordinator_ToggleZen_SinglePaneIsNoop(t *testing.T) {
+	ws := entity.NewWorkspace("ws-1", entity.NewPane("pane-1"))
+	coord := newZenTestCoordinator(ws)
+
+	require.NoError(t, coord.ToggleZen(context.Background()))
+	assert.False(t, coord.IsZen())
+}
+
+func TestWorkspaceCoordinator_ToggleZen_SplitLeavesZenFirst(t *testing.T) {
+	ctx := context.Background()
+	ws := newZenTestWorkspace()
+	coord := newZenTestCoordinator(ws, "pane-4", "split-3")
+
+	require.NoError(t, coord.ToggleZen(ctx))
+	ws.Root.SplitRatio = 0.95
+
+	require.NoError(t, coord.Split(ctx, usecase.SplitRight))
+	assert.False(t, coord.IsZen())
+	assert.Equal(t, 0.3, ws.Root.SplitRatio)
+	assert.Equal(t, 4, ws.PaneCount())
+}
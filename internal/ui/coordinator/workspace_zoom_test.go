@@ -0,0 +1,106 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/application/port/mocks"
+	"github.com/bnema/dumber/internal/application/usecase"
+	"github.com/bnema/dumber/internal/domain/entity"
+	repomocks "github.com/bnema/dumber/internal/domain/repository/mocks"
+	"github.com/bnema/dumber/internal/ui/component"
+	"github.com/bnema/dumber/internal/ui/coordinator/content"
+)
+
+func newZoomTestCoordinator(
+	t *testing.T,
+	wv *mocks.MockWebView,
+	zoomUC *usecase.ManageZoomUseCase,
+) *WorkspaceCoordinator {
+	t.Helper()
+	ctx := context.Background()
+	ws := entity.NewWorkspace("ws-1", entity.NewPane("pane-1"))
+	getActiveWS := func() (*entity.Workspace, *component.WorkspaceView) { return ws, nil }
+
+	contentCoord := content.NewCoordinator(ctx, nil, nil, nil, nil, getActiveWS, zoomUC, nil)
+	if wv != nil {
+		contentCoord.RegisterPopupWebView("pane-1", wv)
+	}
+
+	return NewWorkspaceCoordinator(ctx, WorkspaceCoordinatorConfig{
+		ContentCoord: contentCoord,
+		ZoomUC:       zoomUC,
+		GetActiveWS:  getActiveWS,
+	})
+}
+
+func TestWorkspaceCoordinator_GetActivePaneZoom(t *testing.T) {
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().ID().Return(port.WebViewID(1)).Maybe()
+	wv.EXPECT().GetZoomLevel().Return(1.25).Once()
+
+	coord := newZoomTestCoordinator(t, wv, nil)
+
+	zoom, err := coord.GetActivePaneZoom(context.Background())
+	require.NoError(t, err)
+	assert.InDelta(t, 1.25, zoom, 1e-9)
+}
+
+func TestWorkspaceCoordinator_GetActivePaneZoom_NoWebView(t *testing.T) {
+	coord := newZoomTestCoordinator(t, nil, nil)
+
+	_, err := coord.GetActivePaneZoom(context.Background())
+	require.ErrorIs(t, err, errNoActivePaneWebView)
+}
+
+func TestWorkspaceCoordinator_SetActivePaneZoom_AppliesAndPersists(t *testing.T) {
+	ctx := context.Background()
+	repo := repomocks.NewMockZoomRepository(t)
+	repo.EXPECT().Set(mock.Anything, mock.MatchedBy(func(z *entity.ZoomLevel) bool {
+		return z.Domain == "example.com" && z.ZoomFactor == 1.5
+	})).Return(nil).Once()
+	zoomUC := usecase.NewManageZoomUseCase(repo, 1.0, nil)
+
+	wv := mocks.NewMockWebView(t)
+	wv.EXPECT().ID().Return(port.WebViewID(1)).Maybe()
+	wv.EXPECT().URI().Return("https://example.com/page")
+	wv.EXPECT().SetZoomLevel(mock.Anything, 1.5).Return(nil).Once()
+
+	coord := newZoomTestCoordinator(t, wv, zoomUC)
+
+	zoom, err := coord.SetActivePaneZoom(ctx, 1.5)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.5, zoom, 1e-9)
+}
+
+func TestWorkspaceCoordinator_SetActivePaneZoom_Clamps(t *testing.T) {
+	tests := []struct {
+		name  string
+		level float64
+		want  float64
+	}{
+		{name: "below minimum", level: 0.01, want: entity.ZoomMin},
+		{name: "above maximum", level: 42, want: entity.ZoomMax},
+		{name: "in range", level: 0.8, want: 0.8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wv := mocks.NewMockWebView(t)
+			wv.EXPECT().ID().Return(port.WebViewID(1)).Maybe()
+			wv.EXPECT().URI().Return("dumb://home")
+			wv.EXPECT().SetZoomLevel(mock.Anything, tt.want).Return(nil).Once()
+
+			coord := newZoomTestCoordinator(t, wv, nil)
+
+			zoom, err := coord.SetActivePaneZoom(context.Background(), tt.level)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, zoom, 1e-9)
+		})
+	}
+}
@@ -21,7 +21,9 @@ type Dependencies struct {
 	RuntimeConfig          port.RuntimeConfigProvider
 	InitialURL             string // URL to open on startup (optional)
 	RestoreSessionID       string // Session ID to restore on startup (optional)
+	RestorePinnedOnly      bool   // Restore only the pinned tabs of RestoreSessionID
 	StartupCrashReports    []string
+	SafeMode               bool // Started in safe mode after repeated crashes
 	OnFirstWebViewShown    func(context.Context)
 	OnSessionPersisted     func() // Called by main after session is persisted to DB
 	OnCrashReportsDetected func([]string)
@@ -34,6 +36,19 @@ type Dependencies struct {
 	ColorResolver        port.ColorSchemeResolver
 	AdwaitaDetector      port.ToolkitAvailabilityNotifier
 
+	// UserScripts loads *.user.js files; nil when user scripts are disabled.
+	UserScripts port.UserScriptSource
+
+	// RequestMocks reads the mock responses of the ">mock" command (optional).
+	RequestMocks port.RequestMockSource
+
+	// WindowGeometryStore remembers the window size across restarts (optional).
+	WindowGeometryStore port.WindowGeometryStore
+
+	// NavigationTreeStore keeps dumb://navtree trees across restarts when
+	// history.persist_navigation_tree is enabled (optional).
+	NavigationTreeStore port.NavigationTreeStore
+
 	// XDG paths
 	XDG port.XDGPaths
 
@@ -57,9 +72,22 @@ type Dependencies struct {
 	HistoryRecorderUC *usecase.HistoryRecorderUseCase
 	ZoomUC            *usecase.ManageZoomUseCase
 	PermissionUC      *usecase.HandlePermissionUseCase
-	FavoritesUC       *usecase.ManageFavoritesUseCase
-	HistoryUC         *usecase.SearchHistoryUseCase
-	CopyURLUC         *usecase.CopyURLUseCase
+	CertificatePinUC  *usecase.ManageCertificatePinsUseCase
+	// SiteUserAgentUC remembers per-site desktop/mobile user agent requests.
+	SiteUserAgentUC *usecase.ManageSiteUserAgentsUseCase
+	// SiteColorSchemeUC remembers per-site light/dark color scheme overrides.
+	SiteColorSchemeUC *usecase.ManageSiteColorSchemesUseCase
+	// FilterExceptionsUC decides which sites load without content filtering.
+	FilterExceptionsUC *usecase.ManageFilterExceptionsUseCase
+	// CosmeticExceptionsUC decides which sites load without cosmetic rules.
+	CosmeticExceptionsUC *usecase.ManageFilterExceptionsUseCase
+	FavoritesUC          *usecase.ManageFavoritesUseCase
+	// NotesUC backs the dumb://notes scratchpad.
+	NotesUC   *usecase.ManageNotesUseCase
+	HistoryUC *usecase.SearchHistoryUseCase
+	CopyURLUC *usecase.CopyURLUseCase
+	// ShareURLUC hands pages to other apps, falling back to the clipboard.
+	ShareURLUC *usecase.ShareURLUseCase
 
 	// Infrastructure Adapters
 	Clipboard                 port.Clipboard
@@ -69,6 +97,8 @@ type Dependencies struct {
 	FaviconAdapterConfig      adapter.FaviconAdapterConfig
 	FilterManager             port.FilterManager
 	IdleInhibitor             port.IdleInhibitor
+	// SpeechPlayer reads pages aloud through read_aloud.command (optional).
+	SpeechPlayer port.SpeechPlayer
 
 	// Accent picker for dead keys support
 	InsertAccentUC      *usecase.InsertAccentUseCase
@@ -0,0 +1,91 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/ui/component"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockScriptDialogPopup creates a new instance of MockScriptDialogPopup. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockScriptDialogPopup(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockScriptDialogPopup {
+	mock := &MockScriptDialogPopup{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockScriptDialogPopup is an autogenerated mock type for the scriptDialogPopup type
+type MockScriptDialogPopup struct {
+	mock.Mock
+}
+
+type MockScriptDialogPopup_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockScriptDialogPopup) EXPECT() *MockScriptDialogPopup_Expecter {
+	return &MockScriptDialogPopup_Expecter{mock: &_m.Mock}
+}
+
+// Show provides a mock function for the type MockScriptDialogPopup
+func (_mock *MockScriptDialogPopup) Show(ctx context.Context, content component.ScriptDialogContent, callback func(confirmed bool, text string)) {
+	_mock.Called(ctx, content, callback)
+	return
+}
+
+// MockScriptDialogPopup_Show_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Show'
+type MockScriptDialogPopup_Show_Call struct {
+	*mock.Call
+}
+
+// Show is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content component.ScriptDialogContent
+//   - callback func(confirmed bool, text string)
+func (_e *MockScriptDialogPopup_Expecter) Show(ctx any, content any, callback any) *MockScriptDialogPopup_Show_Call {
+	return &MockScriptDialogPopup_Show_Call{Call: _e.mock.On("Show", ctx, content, callback)}
+}
+
+func (_c *MockScriptDialogPopup_Show_Call) Run(run func(ctx context.Context, content component.ScriptDialogContent, callback func(confirmed bool, text string))) *MockScriptDialogPopup_Show_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 component.ScriptDialogContent
+		if args[1] != nil {
+			arg1 = args[1].(component.ScriptDialogContent)
+		}
+		var arg2 func(confirmed bool, text string)
+		if args[2] != nil {
+			arg2 = args[2].(func(confirmed bool, text string))
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockScriptDialogPopup_Show_Call) Return() *MockScriptDialogPopup_Show_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockScriptDialogPopup_Show_Call) RunAndReturn(run func(ctx context.Context, content component.ScriptDialogContent, callback func(confirmed bool, text string))) *MockScriptDialogPopup_Show_Call {
+	_c.Run(run)
+	return _c
+}
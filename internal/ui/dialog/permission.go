@@ -150,7 +150,7 @@ func (d *PermissionDialog) showNextQueuedRequest() {
 
 // permFlags holds parsed permission type flags.
 type permFlags struct {
-	mic, cam, display, dataAccess bool
+	mic, cam, display, dataAccess, clipboard bool
 }
 
 // parsePermFlags extracts boolean flags from permission types.
@@ -166,6 +166,8 @@ func parsePermFlags(permTypes []entity.PermissionType) permFlags {
 			f.display = true
 		case entity.PermissionTypeWebsiteDataAccess:
 			f.dataAccess = true
+		case entity.PermissionTypeClipboard:
+			f.clipboard = true
 		}
 	}
 	return f
@@ -204,6 +206,9 @@ func (d *PermissionDialog) buildHeading(
 	if f.dataAccess {
 		labels = append(labels, "Data Access")
 	}
+	if f.clipboard {
+		labels = append(labels, "Clipboard")
+	}
 	switch {
 	case len(labels) == 0:
 		return "Allow Permission?"
@@ -234,6 +239,9 @@ func (d *PermissionDialog) buildBody(
 	if f.display {
 		parts = append(parts, "share your screen")
 	}
+	if f.clipboard {
+		parts = append(parts, "read your clipboard")
+	}
 	if f.dataAccess {
 		reqDomain := metadata[entity.PermissionMetadataKeyRequestingDomain]
 		curDomain := metadata[entity.PermissionMetadataKeyCurrentDomain]
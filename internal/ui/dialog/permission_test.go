@@ -148,6 +148,12 @@ func TestPermissionDialog_BuildHeadingAndBody_DisplayCombinations(t *testing.T)
 			expectHeading:  "Allow Third-Party Data Access?",
 			expectedAction: "access its stored data while you browse this site",
 		},
+		{
+			name:           "clipboard read",
+			permTypes:      []entity.PermissionType{entity.PermissionTypeClipboard},
+			expectHeading:  "Allow Clipboard Access?",
+			expectedAction: "read your clipboard",
+		},
 	}
 
 	for _, tt := range tests {
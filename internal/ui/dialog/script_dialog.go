@@ -0,0 +1,156 @@
+package dialog
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/ui/component"
+)
+
+type scriptDialogPopup interface {
+	Show(ctx context.Context, content component.ScriptDialogContent, callback func(confirmed bool, text string))
+}
+
+type scriptDialogRequest struct {
+	ctx     context.Context
+	request port.ScriptDialogRequest
+}
+
+// ScriptDialog presents JavaScript alert/confirm/prompt/beforeunload dialogs
+// in a ScriptDialogPopup. Dialogs from several panes are shown one at a time.
+type ScriptDialog struct {
+	popup scriptDialogPopup
+
+	mu     sync.Mutex
+	active bool
+	queue  []scriptDialogRequest
+}
+
+// NewScriptDialog creates a new script dialog presenter.
+// The popup is created once and reused for each dialog.
+func NewScriptDialog(popup *component.ScriptDialogPopup) *ScriptDialog {
+	return &ScriptDialog{
+		popup: popup,
+	}
+}
+
+// Show displays the dialog, or queues it behind the one currently shown.
+// request.Respond is called exactly once with the user's answer.
+func (d *ScriptDialog) Show(ctx context.Context, request port.ScriptDialogRequest) {
+	req := scriptDialogRequest{ctx: ctx, request: request}
+
+	d.mu.Lock()
+	if d.active {
+		d.queue = append(d.queue, req)
+		d.mu.Unlock()
+		return
+	}
+	d.active = true
+	d.mu.Unlock()
+
+	d.showRequest(req)
+}
+
+func (d *ScriptDialog) showRequest(req scriptDialogRequest) {
+	log := logging.FromContext(req.ctx)
+	request := req.request
+
+	respond := func(response entity.ScriptDialogResponse) {
+		if request.Respond != nil {
+			request.Respond(response)
+		}
+		d.showNextQueuedRequest()
+	}
+
+	if d.popup == nil {
+		log.Error().Msg("script dialog popup not available")
+		respond(request.Type.DismissResponse())
+		return
+	}
+
+	d.popup.Show(req.ctx, ScriptDialogContentFor(request), func(confirmed bool, text string) {
+		log.Debug().
+			Str("type", string(request.Type)).
+			Bool("confirmed", confirmed).
+			Msg("script dialog response")
+		respond(entity.ScriptDialogResponse{Confirmed: confirmed, Text: text})
+	})
+
+	log.Debug().
+		Str("type", string(request.Type)).
+		Str("uri", logging.TruncateURL(request.URI, logging.PermissionLogURLMaxLen)).
+		Msg("showing script dialog popup")
+}
+
+func (d *ScriptDialog) showNextQueuedRequest() {
+	d.mu.Lock()
+	if len(d.queue) == 0 {
+		d.active = false
+		d.mu.Unlock()
+		return
+	}
+
+	next := d.queue[0]
+	d.queue = d.queue[1:]
+	d.mu.Unlock()
+
+	d.showRequest(next)
+}
+
+// ScriptDialogContentFor maps a JavaScript dialog to what the popup shows:
+// alerts only get OK, confirms and prompts get OK/Cancel (prompts with an
+// entry), and beforeunload asks whether to leave the page.
+func ScriptDialogContentFor(request port.ScriptDialogRequest) component.ScriptDialogContent {
+	heading := "This page says"
+	if host := scriptDialogHost(request.URI); host != "" {
+		heading = host + " says"
+	}
+
+	switch request.Type {
+	case entity.ScriptDialogAlert:
+		return component.ScriptDialogContent{
+			Heading:      heading,
+			Body:         request.Message,
+			ConfirmLabel: "OK",
+		}
+	case entity.ScriptDialogPrompt:
+		return component.ScriptDialogContent{
+			Heading:      heading,
+			Body:         request.Message,
+			ConfirmLabel: "OK",
+			CancelLabel:  "Cancel",
+			ShowEntry:    true,
+			DefaultText:  request.DefaultText,
+		}
+	case entity.ScriptDialogBeforeUnload:
+		// Browsers ignore the page's own text here so it cannot be used to
+		// trick users into staying.
+		return component.ScriptDialogContent{
+			Heading:      "Leave page?",
+			Body:         "Changes you made may not be saved.",
+			ConfirmLabel: "Leave",
+			CancelLabel:  "Stay",
+		}
+	default: // entity.ScriptDialogConfirm
+		return component.ScriptDialogContent{
+			Heading:      heading,
+			Body:         request.Message,
+			ConfirmLabel: "OK",
+			CancelLabel:  "Cancel",
+		}
+	}
+}
+
+// scriptDialogHost returns the host shown in the dialog heading, or "" for
+// pages without one (internal pages, file:// URLs).
+func scriptDialogHost(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	return parsed.Hostname()
+}
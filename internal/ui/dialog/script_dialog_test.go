@@ -0,0 +1,121 @@
+package dialog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/dumber/internal/ui/component"
+	dialogmocks "github.com/bnema/dumber/internal/ui/dialog/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScriptDialogContentFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		request port.ScriptDialogRequest
+		want    component.ScriptDialogContent
+	}{
+		{
+			name: "alert has only OK",
+			request: port.ScriptDialogRequest{
+				Type: entity.ScriptDialogAlert, Message: "Saved", URI: "https://example.com/page",
+			},
+			want: component.ScriptDialogContent{
+				Heading: "example.com says", Body: "Saved", ConfirmLabel: "OK",
+			},
+		},
+		{
+			name: "confirm has OK and Cancel",
+			request: port.ScriptDialogRequest{
+				Type: entity.ScriptDialogConfirm, Message: "Delete?", URI: "https://example.com:8443/",
+			},
+			want: component.ScriptDialogContent{
+				Heading: "example.com says", Body: "Delete?", ConfirmLabel: "OK", CancelLabel: "Cancel",
+			},
+		},
+		{
+			name: "prompt shows an entry with the default text",
+			request: port.ScriptDialogRequest{
+				Type: entity.ScriptDialogPrompt, Message: "Name?", DefaultText: "anon", URI: "https://example.com/",
+			},
+			want: component.ScriptDialogContent{
+				Heading: "example.com says", Body: "Name?", ConfirmLabel: "OK", CancelLabel: "Cancel",
+				ShowEntry: true, DefaultText: "anon",
+			},
+		},
+		{
+			name: "beforeunload ignores the page message",
+			request: port.ScriptDialogRequest{
+				Type: entity.ScriptDialogBeforeUnload, Message: "Click Stay to win", URI: "https://example.com/",
+			},
+			want: component.ScriptDialogContent{
+				Heading: "Leave page?", Body: "Changes you made may not be saved.",
+				ConfirmLabel: "Leave", CancelLabel: "Stay",
+			},
+		},
+		{
+			name: "page without host",
+			request: port.ScriptDialogRequest{
+				Type: entity.ScriptDialogAlert, Message: "hi", URI: "file:///tmp/page.html",
+			},
+			want: component.ScriptDialogContent{
+				Heading: "This page says", Body: "hi", ConfirmLabel: "OK",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ScriptDialogContentFor(tt.request))
+		})
+	}
+}
+
+func TestScriptDialog_QueuesDialogsAndForwardsAnswers(t *testing.T) {
+	popup := dialogmocks.NewMockScriptDialogPopup(t)
+	var shown []component.ScriptDialogContent
+	var callback func(confirmed bool, text string)
+	popup.EXPECT().
+		Show(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, content component.ScriptDialogContent, cb func(confirmed bool, text string)) {
+			shown = append(shown, content)
+			callback = cb
+		}).
+		Twice()
+
+	d := &ScriptDialog{popup: popup}
+
+	var promptAnswer, confirmAnswer *entity.ScriptDialogResponse
+	d.Show(context.Background(), port.ScriptDialogRequest{
+		Type: entity.ScriptDialogPrompt, Message: "Name?", URI: "https://a.example/",
+		Respond: func(r entity.ScriptDialogResponse) { promptAnswer = &r },
+	})
+	d.Show(context.Background(), port.ScriptDialogRequest{
+		Type: entity.ScriptDialogConfirm, Message: "Sure?", URI: "https://b.example/",
+		Respond: func(r entity.ScriptDialogResponse) { confirmAnswer = &r },
+	})
+
+	// The second dialog waits until the first is answered.
+	assert.Len(t, shown, 1)
+	callback(true, "Ada")
+	assert.Equal(t, &entity.ScriptDialogResponse{Confirmed: true, Text: "Ada"}, promptAnswer)
+
+	assert.Len(t, shown, 2)
+	assert.Equal(t, "b.example says", shown[1].Heading)
+	callback(false, "")
+	assert.Equal(t, &entity.ScriptDialogResponse{}, confirmAnswer)
+}
+
+func TestScriptDialog_WithoutPopupDismisses(t *testing.T) {
+	d := &ScriptDialog{}
+
+	var answer *entity.ScriptDialogResponse
+	d.Show(context.Background(), port.ScriptDialogRequest{
+		Type:    entity.ScriptDialogBeforeUnload,
+		Respond: func(r entity.ScriptDialogResponse) { answer = &r },
+	})
+
+	assert.Equal(t, &entity.ScriptDialogResponse{Confirmed: true}, answer)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/bnema/dumber/internal/application/port"
 	"github.com/bnema/dumber/internal/application/usecase"
 	"github.com/bnema/dumber/internal/domain/entity"
+	domainurl "github.com/bnema/dumber/internal/domain/url"
 	"github.com/bnema/dumber/internal/logging"
 	"github.com/bnema/dumber/internal/ui/component"
 	"github.com/bnema/dumber/internal/ui/coordinator"
@@ -22,16 +23,28 @@ const (
 	historySystemViewURL   = "dumb://history"
 	favoritesSystemViewURL = "dumb://favorites"
 	configSystemViewURL    = "dumb://config"
+	notesSystemViewURL     = "dumb://notes"
 )
 
 type KeyboardActions struct {
-	NewTab         func(context.Context) error
-	CloseTab       func(context.Context) error
-	NextTab        func(context.Context) error
-	PreviousTab    func(context.Context) error
-	SwitchLastTab  func(context.Context) error
-	SwitchTabIndex func(context.Context, int) error
-	ActiveWebView  func(context.Context) port.WebView
+	NewTab   func(context.Context) error
+	CloseTab func(context.Context) error
+	// CloseTabsToRight and CloseOtherTabs close unpinned tabs around the
+	// active tab.
+	CloseTabsToRight func(context.Context) error
+	CloseOtherTabs   func(context.Context) error
+	NextTab          func(context.Context) error
+	PreviousTab      func(context.Context) error
+	SwitchLastTab    func(context.Context) error
+	SwitchTabIndex   func(context.Context, int) error
+	ReopenClosedTab  func(context.Context) error
+	ActiveWebView    func(context.Context) port.WebView
+
+	// GroupTab, ToggleTabGroup and UngroupTab manage the tab group of the
+	// active tab.
+	GroupTab       func(context.Context) error
+	ToggleTabGroup func(context.Context) error
+	UngroupTab     func(context.Context) error
 }
 
 // KeyboardDispatcher routes keyboard actions to appropriate coordinators.
@@ -41,6 +54,7 @@ type KeyboardDispatcher struct {
 	navCoord                 *coordinator.NavigationCoordinator
 	zoomUC                   *usecase.ManageZoomUseCase
 	copyURLUC                *usecase.CopyURLUseCase
+	shareURLUC               *usecase.ShareURLUseCase
 	actionHandlers           map[input.Action]func(ctx context.Context) error
 	onQuit                   func()
 	onFindOpen               func(ctx context.Context) error
@@ -55,6 +69,14 @@ type KeyboardDispatcher struct {
 	onToggleHistorySidebar   func(ctx context.Context) error
 	onToggleFavoritesSidebar func(ctx context.Context) error
 	onToggleCurrentFavorite  func(ctx context.Context) error
+	onRestoreLastView        func(ctx context.Context) error
+	onPanic                  func(ctx context.Context) error
+	onReadAloud              func(ctx context.Context) error
+	onStopReadAloud          func(ctx context.Context) error
+	onSearchSelection        func(ctx context.Context) error
+	onToggleNotifications    func(ctx context.Context) error
+	onToggleClipboardHistory func(ctx context.Context) error
+	onOpenLinkInPane         func(ctx context.Context) error
 	onToggleFloating         func(ctx context.Context) error
 	onOpenFloating           func(ctx context.Context, target input.FloatingProfileTarget) error
 }
@@ -89,6 +111,11 @@ func (d *KeyboardDispatcher) SetOnQuit(fn func()) {
 	d.onQuit = fn
 }
 
+// SetShareURLUseCase sets the use case behind the share_url action.
+func (d *KeyboardDispatcher) SetShareURLUseCase(uc *usecase.ShareURLUseCase) {
+	d.shareURLUC = uc
+}
+
 // SetOnFindOpen sets the callback for opening the find bar.
 func (d *KeyboardDispatcher) SetOnFindOpen(fn func(ctx context.Context) error) {
 	d.onFindOpen = fn
@@ -138,6 +165,38 @@ func (d *KeyboardDispatcher) SetOnToggleCurrentPageFavorite(fn func(ctx context.
 	d.onToggleCurrentFavorite = fn
 }
 
+func (d *KeyboardDispatcher) SetOnRestoreLastView(fn func(ctx context.Context) error) {
+	d.onRestoreLastView = fn
+}
+
+func (d *KeyboardDispatcher) SetOnPanic(fn func(ctx context.Context) error) {
+	d.onPanic = fn
+}
+
+func (d *KeyboardDispatcher) SetOnReadAloud(fn func(ctx context.Context) error) {
+	d.onReadAloud = fn
+}
+
+func (d *KeyboardDispatcher) SetOnStopReadAloud(fn func(ctx context.Context) error) {
+	d.onStopReadAloud = fn
+}
+
+func (d *KeyboardDispatcher) SetOnSearchSelection(fn func(ctx context.Context) error) {
+	d.onSearchSelection = fn
+}
+
+func (d *KeyboardDispatcher) SetOnToggleNotifications(fn func(ctx context.Context) error) {
+	d.onToggleNotifications = fn
+}
+
+func (d *KeyboardDispatcher) SetOnToggleClipboardHistory(fn func(ctx context.Context) error) {
+	d.onToggleClipboardHistory = fn
+}
+
+func (d *KeyboardDispatcher) SetOnOpenLinkInPane(fn func(ctx context.Context) error) {
+	d.onOpenLinkInPane = fn
+}
+
 func (d *KeyboardDispatcher) SetOnToggleFloatingPane(fn func(ctx context.Context) error) {
 	d.onToggleFloating = fn
 }
@@ -174,12 +233,21 @@ func (d *KeyboardDispatcher) initActionHandlers() {
 		input.ActionNewTab:   func(ctx context.Context) error { return d.handleKeyboardAction(ctx, "new tab", d.actions.NewTab) },
 		input.ActionCloseTab: func(ctx context.Context) error { return d.handleKeyboardAction(ctx, "close tab", d.actions.CloseTab) },
 		input.ActionNextTab:  func(ctx context.Context) error { return d.handleKeyboardAction(ctx, "next tab", d.actions.NextTab) },
+		input.ActionCloseTabsToRight: func(ctx context.Context) error {
+			return d.handleKeyboardAction(ctx, "close tabs to the right", d.actions.CloseTabsToRight)
+		},
+		input.ActionCloseOtherTabs: func(ctx context.Context) error {
+			return d.handleKeyboardAction(ctx, "close other tabs", d.actions.CloseOtherTabs)
+		},
 		input.ActionPreviousTab: func(ctx context.Context) error {
 			return d.handleKeyboardAction(ctx, "previous tab", d.actions.PreviousTab)
 		},
 		input.ActionSwitchLastTab: func(ctx context.Context) error {
 			return d.handleKeyboardAction(ctx, "switch last tab", d.actions.SwitchLastTab)
 		},
+		input.ActionReopenClosedTab: func(ctx context.Context) error {
+			return d.handleKeyboardAction(ctx, "reopen closed tab", d.actions.ReopenClosedTab)
+		},
 		input.ActionSwitchTabIndex1:  func(ctx context.Context) error { return d.handleSwitchTabIndex(ctx, firstTabIndex) },
 		input.ActionSwitchTabIndex2:  func(ctx context.Context) error { return d.handleSwitchTabIndex(ctx, secondTabIndex) },
 		input.ActionSwitchTabIndex3:  func(ctx context.Context) error { return d.handleSwitchTabIndex(ctx, thirdTabIndex) },
@@ -193,6 +261,15 @@ func (d *KeyboardDispatcher) initActionHandlers() {
 		input.ActionRenameTab: func(ctx context.Context) error {
 			return d.logNoop(ctx, "rename tab action (not yet implemented)")
 		},
+		input.ActionGroupTab: func(ctx context.Context) error {
+			return d.handleKeyboardAction(ctx, "group tab", d.actions.GroupTab)
+		},
+		input.ActionToggleTabGroup: func(ctx context.Context) error {
+			return d.handleKeyboardAction(ctx, "toggle tab group", d.actions.ToggleTabGroup)
+		},
+		input.ActionUngroupTab: func(ctx context.Context) error {
+			return d.handleKeyboardAction(ctx, "ungroup tab", d.actions.UngroupTab)
+		},
 		// Pane actions
 		input.ActionSplitRight: func(ctx context.Context) error { return d.wsCoord.Split(ctx, usecase.SplitRight) },
 		input.ActionSplitLeft:  func(ctx context.Context) error { return d.wsCoord.Split(ctx, usecase.SplitLeft) },
@@ -221,10 +298,34 @@ func (d *KeyboardDispatcher) initActionHandlers() {
 		input.ActionConsumeOrExpelDown: func(ctx context.Context) error {
 			return d.wsCoord.ConsumeOrExpelPane(ctx, usecase.ConsumeOrExpelDown)
 		},
+		input.ActionCollapseStacks: func(ctx context.Context) error {
+			return d.wsCoord.CollapseStacks(ctx)
+		},
+		input.ActionExpandStack: func(ctx context.Context) error {
+			return d.wsCoord.ExpandStack(ctx)
+		},
+		input.ActionBalancePanes: func(ctx context.Context) error {
+			return d.wsCoord.BalancePanes(ctx)
+		},
+		input.ActionReopenAsRelated: func(ctx context.Context) error {
+			return d.wsCoord.ReopenAsRelated(ctx, "")
+		},
+		input.ActionToggleZen: func(ctx context.Context) error {
+			return d.wsCoord.ToggleZen(ctx)
+		},
 		input.ActionFocusRight: func(ctx context.Context) error { return d.wsCoord.FocusPane(ctx, usecase.NavRight) },
 		input.ActionFocusLeft:  func(ctx context.Context) error { return d.wsCoord.FocusPane(ctx, usecase.NavLeft) },
 		input.ActionFocusUp:    func(ctx context.Context) error { return d.wsCoord.FocusPane(ctx, usecase.NavUp) },
 		input.ActionFocusDown:  func(ctx context.Context) error { return d.wsCoord.FocusPane(ctx, usecase.NavDown) },
+		input.ActionFocusPane1: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 1) },
+		input.ActionFocusPane2: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 2) },
+		input.ActionFocusPane3: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 3) },
+		input.ActionFocusPane4: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 4) },
+		input.ActionFocusPane5: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 5) },
+		input.ActionFocusPane6: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 6) },
+		input.ActionFocusPane7: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 7) },
+		input.ActionFocusPane8: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 8) },
+		input.ActionFocusPane9: func(ctx context.Context) error { return d.wsCoord.FocusPaneByNumber(ctx, 9) },
 		// Resize actions
 		input.ActionResizeIncreaseLeft:  func(ctx context.Context) error { return d.wsCoord.Resize(ctx, usecase.ResizeIncreaseLeft) },
 		input.ActionResizeIncreaseRight: func(ctx context.Context) error { return d.wsCoord.Resize(ctx, usecase.ResizeIncreaseRight) },
@@ -244,7 +345,19 @@ func (d *KeyboardDispatcher) initActionHandlers() {
 		input.ActionGoForward:  d.handleGoForward,
 		input.ActionReload:     d.handleReload,
 		input.ActionHardReload: d.handleHardReload,
-		input.ActionPrintPage:  d.handlePrintPage,
+		input.ActionNextPage: func(ctx context.Context) error {
+			return d.handleStepPage(ctx, 1)
+		},
+		input.ActionPrevPage: func(ctx context.Context) error {
+			return d.handleStepPage(ctx, -1)
+		},
+		input.ActionRestoreLastView: func(ctx context.Context) error {
+			if d.onRestoreLastView == nil {
+				return d.logNoop(ctx, "restore last view action (no handler)")
+			}
+			return d.onRestoreLastView(ctx)
+		},
+		input.ActionPrintPage: d.handlePrintPage,
 		// Zoom actions
 		input.ActionZoomIn:    func(ctx context.Context) error { return d.handleZoom(ctx, "in") },
 		input.ActionZoomOut:   func(ctx context.Context) error { return d.handleZoom(ctx, "out") },
@@ -286,11 +399,102 @@ func (d *KeyboardDispatcher) initActionHandlers() {
 		input.ActionToggleConfigSystemView: func(ctx context.Context) error {
 			return d.wsCoord.ToggleSystemViewRight(ctx, configSystemViewURL)
 		},
+		input.ActionToggleNotesSystemView: func(ctx context.Context) error {
+			return d.wsCoord.ToggleSystemViewRight(ctx, notesSystemViewURL)
+		},
+		input.ActionOpenLinkInPane: func(ctx context.Context) error {
+			if d.onOpenLinkInPane == nil {
+				return d.logNoop(ctx, "open link in pane action (no handler)")
+			}
+			return d.onOpenLinkInPane(ctx)
+		},
 		input.ActionToggleFullscreen: func(ctx context.Context) error {
 			return d.logNoop(ctx, "toggle fullscreen action (not yet implemented)")
 		},
 		// Clipboard
 		input.ActionCopyURL: d.handleCopyURL,
+		input.ActionCopyURLMarkdown: func(ctx context.Context) error {
+			return d.copyActivePage(ctx, "Markdown link copied", d.copyURLUC.CopyAsMarkdown)
+		},
+		input.ActionCopyURLHTML: func(ctx context.Context) error {
+			return d.copyActivePage(ctx, "HTML link copied", d.copyURLUC.CopyAsHTML)
+		},
+		input.ActionShareURL: d.handleShareURL,
+		input.ActionCopyTabURLs: func(ctx context.Context) error {
+			return d.wsCoord.CopyTabURLs(ctx, usecase.URLListPlain)
+		},
+		input.ActionCopyTabURLsMarkdown: func(ctx context.Context) error {
+			return d.wsCoord.CopyTabURLs(ctx, usecase.URLListMarkdown)
+		},
+		input.ActionCopyTabURLsJSON: func(ctx context.Context) error {
+			return d.wsCoord.CopyTabURLs(ctx, usecase.URLListJSON)
+		},
+		input.ActionToggleMute: func(ctx context.Context) error {
+			return d.wsCoord.ToggleMuteActivePane(ctx)
+		},
+		input.ActionToggleMuteBackground: func(ctx context.Context) error {
+			return d.wsCoord.ToggleMuteBackground(ctx)
+		},
+		input.ActionToggleForceDark: func(ctx context.Context) error {
+			return d.wsCoord.ToggleForceDarkActivePane(ctx)
+		},
+		input.ActionCycleColorScheme: func(ctx context.Context) error {
+			return d.wsCoord.CycleColorSchemeActivePane(ctx)
+		},
+		input.ActionToggleFocusTimer: func(ctx context.Context) error {
+			return d.wsCoord.ToggleFocusTimerActivePane(ctx)
+		},
+		input.ActionRequestDesktopSite: func(ctx context.Context) error {
+			return d.wsCoord.RequestSiteUserAgentActivePane(ctx, entity.UserAgentPresetDesktop)
+		},
+		input.ActionRequestMobileSite: func(ctx context.Context) error {
+			return d.wsCoord.RequestSiteUserAgentActivePane(ctx, entity.UserAgentPresetMobile)
+		},
+		input.ActionToggleContentFiltering: func(ctx context.Context) error {
+			return d.wsCoord.ToggleContentFilteringActivePane(ctx)
+		},
+		input.ActionToggleCosmeticFiltering: func(ctx context.Context) error {
+			return d.wsCoord.ToggleCosmeticFilteringActivePane(ctx)
+		},
+		input.ActionPanic: func(ctx context.Context) error {
+			if d.onPanic == nil {
+				return d.logNoop(ctx, "panic action (no handler)")
+			}
+			return d.onPanic(ctx)
+		},
+		input.ActionReadAloud: func(ctx context.Context) error {
+			if d.onReadAloud == nil {
+				return d.logNoop(ctx, "read aloud action (no handler)")
+			}
+			return d.onReadAloud(ctx)
+		},
+		input.ActionStopReadAloud: func(ctx context.Context) error {
+			if d.onStopReadAloud == nil {
+				return d.logNoop(ctx, "stop read aloud action (no handler)")
+			}
+			return d.onStopReadAloud(ctx)
+		},
+		input.ActionTranslatePage: func(ctx context.Context) error {
+			return d.wsCoord.TranslateActivePane(ctx)
+		},
+		input.ActionSearchSelection: func(ctx context.Context) error {
+			if d.onSearchSelection == nil {
+				return d.logNoop(ctx, "search selection action (no handler)")
+			}
+			return d.onSearchSelection(ctx)
+		},
+		input.ActionToggleNotifications: func(ctx context.Context) error {
+			if d.onToggleNotifications == nil {
+				return d.logNoop(ctx, "toggle notifications action (no handler)")
+			}
+			return d.onToggleNotifications(ctx)
+		},
+		input.ActionToggleClipboardHistory: func(ctx context.Context) error {
+			if d.onToggleClipboardHistory == nil {
+				return d.logNoop(ctx, "toggle clipboard history action (no handler)")
+			}
+			return d.onToggleClipboardHistory(ctx)
+		},
 		// Session management
 		input.ActionOpenSessionManager: d.handleSessionOpen,
 		// Application
@@ -458,6 +662,23 @@ func (d *KeyboardDispatcher) handleGoForward(ctx context.Context) error {
 	})
 }
 
+// handleStepPage navigates the active pane to its URL with the page number
+// moved by delta. URLs without a page number only get a toast.
+func (d *KeyboardDispatcher) handleStepPage(ctx context.Context, delta int) error {
+	return d.withActiveWebView(ctx, "step page", func(wv port.WebView) error {
+		target, ok := domainurl.StepPage(wv.URI(), delta)
+		if !ok {
+			d.wsCoord.ShowToastOnActivePane(ctx, "No page number in URL", component.ToastInfo)
+			return nil
+		}
+		var paneID entity.PaneID
+		if d.activePaneID != nil {
+			paneID = d.activePaneID(ctx)
+		}
+		return d.navCoord.NavigateWebView(ctx, target, paneID, wv)
+	})
+}
+
 func (d *KeyboardDispatcher) handlePrintPage(ctx context.Context) error {
 	return d.withActiveWebView(ctx, "print page", func(wv port.WebView) error {
 		return d.navCoord.PrintWebView(ctx, wv)
@@ -502,7 +723,7 @@ func (d *KeyboardDispatcher) handleZoom(ctx context.Context, action string) erro
 	case "reset":
 		err = d.zoomUC.ResetZoom(ctx, zoomKey)
 		if err == nil {
-			newZoom = entity.NewZoomLevel(zoomKey, d.zoomUC.DefaultZoom())
+			newZoom = entity.NewZoomLevel(zoomKey, d.zoomUC.DefaultZoomFor(zoomKey))
 		}
 	}
 
@@ -536,6 +757,63 @@ func (d *KeyboardDispatcher) handleZoom(ctx context.Context, action string) erro
 
 // handleCopyURL copies the active pane's URL to clipboard.
 func (d *KeyboardDispatcher) handleCopyURL(ctx context.Context) error {
+	return d.copyActivePage(ctx, "URL copied", func(ctx context.Context, uri, _ string) error {
+		return d.copyURLUC.Copy(ctx, uri)
+	})
+}
+
+// handleShareURL hands the active pane's URL to another app, or copies it
+// when the share portal is unavailable.
+func (d *KeyboardDispatcher) handleShareURL(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+
+	if d.shareURLUC == nil {
+		log.Warn().Msg("share URL use case not available")
+		return nil
+	}
+
+	wv := d.activeWebView(ctx)
+	if wv == nil {
+		log.Debug().Msg("no active webview for share URL")
+		return nil
+	}
+
+	uri := wv.URI()
+	if uri == "" {
+		log.Debug().Msg("active webview has empty URI")
+		return nil
+	}
+	title := wv.Title()
+
+	// The portal call blocks on D-Bus, so share in a background goroutine
+	go func() {
+		method, err := d.shareURLUC.Share(ctx, uri, title)
+		if err != nil {
+			log.Error().Err(err).Str("uri", uri).Msg("share URL failed")
+			return
+		}
+		if method != usecase.ShareMethodClipboard {
+			return // The portal shows its own app chooser
+		}
+
+		// Show toast on GTK main thread
+		cb := glib.SourceFunc(func(_ uintptr) bool {
+			d.wsCoord.ShowToastOnActivePane(ctx, "Sharing unavailable, URL copied", component.ToastInfo)
+			return false
+		})
+		glib.IdleAdd(&cb, 0)
+	}()
+
+	return nil
+}
+
+// copyActivePage copies the active pane's URL and title using copyFn, then
+// shows toast on success.
+func (d *KeyboardDispatcher) copyActivePage(
+	ctx context.Context,
+	toast string,
+	copyFn func(ctx context.Context, uri, title string) error,
+) error {
 	log := logging.FromContext(ctx)
 
 	if d.copyURLUC == nil {
@@ -554,17 +832,18 @@ func (d *KeyboardDispatcher) handleCopyURL(ctx context.Context) error {
 		log.Debug().Msg("active webview has empty URI")
 		return nil
 	}
+	title := wv.Title()
 
 	// Copy URL in background goroutine
 	go func() {
-		if err := d.copyURLUC.Copy(ctx, uri); err != nil {
+		if err := copyFn(ctx, uri, title); err != nil {
 			log.Error().Err(err).Str("uri", uri).Msg("copy URL failed")
 			return
 		}
 
 		// Show toast on GTK main thread
 		cb := glib.SourceFunc(func(_ uintptr) bool {
-			d.wsCoord.ShowToastOnActivePane(ctx, "URL copied", component.ToastSuccess)
+			d.wsCoord.ShowToastOnActivePane(ctx, toast, component.ToastSuccess)
 			return false
 		})
 		glib.IdleAdd(&cb, 0)
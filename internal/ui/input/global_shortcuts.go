@@ -501,6 +501,10 @@ func globalShortcutActionMap() map[string]Action {
 		"toggle-current-page-favorite": ActionToggleCurrentPageFavorite,
 		"toggle_config_systemview":     ActionToggleConfigSystemView,
 		"toggle-config-systemview":     ActionToggleConfigSystemView,
+		"toggle_notes_systemview":      ActionToggleNotesSystemView,
+		"toggle-notes-systemview":      ActionToggleNotesSystemView,
+		"open_link_in_pane":            ActionOpenLinkInPane,
+		"open-link-in-pane":            ActionOpenLinkInPane,
 	}
 }
 
@@ -712,6 +716,8 @@ func isRepeatedGlobalShortcutSuppressed(action Action) bool {
 		ActionToggleFavoritesSystemView,
 		ActionToggleCurrentPageFavorite,
 		ActionToggleConfigSystemView,
+		ActionToggleNotesSystemView,
+		ActionOpenLinkInPane,
 		ActionCopyURL,
 		ActionConsumeOrExpelLeft,
 		ActionConsumeOrExpelRight,
@@ -731,7 +737,8 @@ func isRepeatedGlobalShortcutSuppressed(action Action) bool {
 		ActionSwitchTabIndex8,
 		ActionSwitchTabIndex9,
 		ActionSwitchTabIndex10,
-		ActionSwitchLastTab:
+		ActionSwitchLastTab,
+		ActionPanic:
 		return true
 	default:
 		return false
@@ -233,6 +233,8 @@ func TestGlobalShortcutHandlerSuppressesRepeatedAdditionalOneShotUIActions(t *te
 		ActionToggleFavoritesSystemView,
 		ActionToggleCurrentPageFavorite,
 		ActionToggleConfigSystemView,
+		ActionToggleNotesSystemView,
+		ActionOpenLinkInPane,
 		ActionCopyURL,
 		ActionConsumeOrExpelLeft,
 		ActionConsumeOrExpelRight,
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	// HoverFocusDelay is the delay before switching focus on hover.
+	// HoverFocusDelay is the default delay before switching focus on hover.
 	HoverFocusDelay = 150 * time.Millisecond
 )
 
@@ -32,6 +32,7 @@ type HoverHandler struct {
 	leaveSignalID  uint
 	motionSignalID uint
 	paneID         entity.PaneID
+	delay          time.Duration
 	onEnter        HoverCallback
 	onMotion       MotionCallback
 
@@ -60,6 +61,7 @@ func NewHoverHandler(ctx context.Context, paneID entity.PaneID) *HoverHandler {
 	return &HoverHandler{
 		ctx:              ctx,
 		paneID:           paneID,
+		delay:            HoverFocusDelay,
 		schedule:         scheduleHoverSource,
 		removeSource:     glib.SourceRemove,
 		disconnectSignal: disconnectHoverSignal,
@@ -67,6 +69,17 @@ func NewHoverHandler(ctx context.Context, paneID entity.PaneID) *HoverHandler {
 	}
 }
 
+// SetDelay sets how long the pointer must stay over the pane before it is
+// focused. Negative values are treated as zero.
+func (h *HoverHandler) SetDelay(delay time.Duration) {
+	if delay < 0 {
+		delay = 0
+	}
+	h.timerMu.Lock()
+	h.delay = delay
+	h.timerMu.Unlock()
+}
+
 // SetOnEnter sets the callback for when the pane should receive focus.
 func (h *HoverHandler) SetOnEnter(fn HoverCallback) {
 	h.onEnter = fn
@@ -149,7 +162,7 @@ func (h *HoverHandler) handleEnter() {
 	h.generation++
 	generation := h.generation
 	schedule := h.schedule
-	h.sourceID = schedule(uint(HoverFocusDelay.Milliseconds()), func(_ uintptr) bool {
+	h.sourceID = schedule(uint(h.delay.Milliseconds()), func(_ uintptr) bool {
 		h.timerMu.Lock()
 		if h.detached || h.generation != generation {
 			h.timerMu.Unlock()
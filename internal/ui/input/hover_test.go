@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/puregotk/v4/gtk"
@@ -88,3 +89,98 @@ func TestHoverHandlerDetachInvalidatesScheduledCallbackAndRemovesSource(t *testi
 		t.Fatal("detached callback must be rejected and one-shot")
 	}
 }
+
+// fakeHoverTimer records scheduled hover sources so tests can fire or remove
+// them deterministically instead of waiting on the GLib main loop.
+type fakeHoverTimer struct {
+	nextID  uint
+	delays  []uint
+	pending map[uint]func(uintptr) bool
+	removed []uint
+}
+
+func newFakeHoverTimer(h *HoverHandler) *fakeHoverTimer {
+	ft := &fakeHoverTimer{pending: make(map[uint]func(uintptr) bool)}
+	h.schedule = func(delay uint, fn func(uintptr) bool) uint {
+		ft.nextID++
+		ft.delays = append(ft.delays, delay)
+		ft.pending[ft.nextID] = fn
+		return ft.nextID
+	}
+	h.removeSource = func(id uint) bool {
+		ft.removed = append(ft.removed, id)
+		delete(ft.pending, id)
+		return true
+	}
+	return ft
+}
+
+// fireAll runs every source still pending, as the main loop would once the delay elapses.
+func (ft *fakeHoverTimer) fireAll() {
+	for id, fn := range ft.pending {
+		delete(ft.pending, id)
+		fn(0)
+	}
+}
+
+func TestHoverHandlerActivatesAfterConfiguredDelay(t *testing.T) {
+	h := NewHoverHandler(context.Background(), entity.PaneID("pane-1"))
+	ft := newFakeHoverTimer(h)
+	h.SetDelay(400 * time.Millisecond)
+
+	var focused []entity.PaneID
+	h.SetOnEnter(func(paneID entity.PaneID) { focused = append(focused, paneID) })
+
+	h.handleEnter()
+	if len(focused) != 0 {
+		t.Fatal("pane must not be focused before the delay elapses")
+	}
+	if got, want := ft.delays, []uint{400}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("scheduled delays = %v, want %v", got, want)
+	}
+
+	ft.fireAll()
+	if got, want := focused, []entity.PaneID{"pane-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("focused panes = %v, want %v", got, want)
+	}
+}
+
+func TestHoverHandlerDefaultsAndClampsDelay(t *testing.T) {
+	h := NewHoverHandler(context.Background(), entity.PaneID("pane-1"))
+	ft := newFakeHoverTimer(h)
+
+	h.handleEnter()
+	h.SetDelay(-time.Second)
+	h.handleEnter()
+
+	want := []uint{uint(HoverFocusDelay.Milliseconds()), 0}
+	if !reflect.DeepEqual(ft.delays, want) {
+		t.Fatalf("scheduled delays = %v, want %v", ft.delays, want)
+	}
+}
+
+func TestHoverHandlerLeaveBeforeDelayCancelsActivation(t *testing.T) {
+	h := NewHoverHandler(context.Background(), entity.PaneID("pane-1"))
+	ft := newFakeHoverTimer(h)
+	h.SetDelay(300 * time.Millisecond)
+
+	var calls int
+	h.SetOnEnter(func(entity.PaneID) { calls++ })
+
+	h.handleEnter()
+	h.handleLeave()
+	if got, want := ft.removed, []uint{1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("removed sources = %v, want %v", got, want)
+	}
+	ft.fireAll()
+	if calls != 0 {
+		t.Fatalf("hover callback ran %d times after early leave, want 0", calls)
+	}
+
+	// Re-entering after the early leave starts a fresh timer that still fires.
+	h.handleEnter()
+	ft.fireAll()
+	if calls != 1 {
+		t.Fatalf("hover callback ran %d times after re-enter, want 1", calls)
+	}
+}
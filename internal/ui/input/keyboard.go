@@ -341,7 +341,14 @@ func (h *KeyboardHandler) handleKeyPress(keyval, keycode uint, state gdk.Modifie
 	mode := h.modal.Mode()
 	action, found := h.lookupAction(log, binding, mode, modifiers, keycode)
 
+	if mode == ModeLeader && isModifierKeyval(keyval) {
+		return true // Wait for the follow-up key itself
+	}
 	if !found {
+		if mode == ModeLeader {
+			// An unbound follow-up key ends the sequence.
+			h.modal.ExitMode(h.ctx)
+		}
 		return mode != ModeNormal // Consume unrecognized keys in modal mode
 	}
 	if h.suppressHeldAction(action, keyval) {
@@ -387,6 +394,11 @@ func (h *KeyboardHandler) dispatchAction(action Action, mode Mode) bool {
 	if h.handleModeAction(action) {
 		return true
 	}
+	// A leader sequence ends with its follow-up key, so the action runs with
+	// the key controller back in normal mode.
+	if mode == ModeLeader {
+		h.modal.ExitMode(h.ctx)
+	}
 
 	h.mu.RLock()
 	handler := h.onAction
@@ -436,6 +448,7 @@ func isRepeatedKeyboardActionSuppressed(action Action) bool {
 		ActionEnterPaneMode,
 		ActionEnterSessionMode,
 		ActionEnterResizeMode,
+		ActionEnterLeaderMode,
 		ActionNewTab,
 		ActionRenameTab,
 		ActionSplitRight,
@@ -551,6 +564,13 @@ func (h *KeyboardHandler) handleModeAction(action Action) bool {
 		}
 		h.modal.EnterResizeMode(h.ctx, time.Duration(ms)*time.Millisecond)
 		return true
+	case ActionEnterLeaderMode:
+		var ms int
+		if workspace != nil {
+			ms = workspace.Leader.TimeoutMilliseconds
+		}
+		h.modal.EnterLeaderMode(h.ctx, time.Duration(ms)*time.Millisecond)
+		return true
 	case ActionExitMode:
 		h.modal.ExitMode(h.ctx)
 		return true
@@ -598,6 +618,17 @@ func (h *KeyboardHandler) EnterSessionMode() {
 	h.modal.EnterSessionMode(h.ctx, time.Duration(ms)*time.Millisecond)
 }
 
+// LeaderHints returns the follow-up keys of the configured leader sequence.
+func (h *KeyboardHandler) LeaderHints() []LeaderHint {
+	h.mu.RLock()
+	workspace := h.workspace
+	h.mu.RUnlock()
+	if workspace == nil {
+		return nil
+	}
+	return LeaderHints(&workspace.Leader)
+}
+
 // ExitMode programmatically exits modal mode.
 // Useful for testing or programmatic mode changes.
 func (h *KeyboardHandler) ExitMode() {
@@ -0,0 +1,65 @@
+package input
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/gdk"
+)
+
+// LeaderHint describes one follow-up key of a leader sequence.
+type LeaderHint struct {
+	// Key is the follow-up key as written in config ("t", "shift+v").
+	Key string
+	// Action is the action the key triggers.
+	Action Action
+	// Label is the action description, or its config name when none is set.
+	Label string
+}
+
+// LeaderHints lists the follow-up keys of cfg, sorted by key. Keys that do
+// not parse, unknown actions and the cancel binding are left out, so the
+// list matches what the leader sequence actually dispatches.
+func LeaderHints(cfg *entity.LeaderConfig) []LeaderHint {
+	if cfg == nil {
+		return nil
+	}
+	hints := make([]LeaderHint, 0, len(cfg.Actions))
+	for name, binding := range cfg.Actions {
+		action := mapConfigAction(name)
+		if action == "" || action == ActionExitMode {
+			continue
+		}
+		label := binding.Desc
+		if label == "" {
+			label = name
+		}
+		for _, key := range binding.Keys {
+			if _, ok := ParseKeyString(key); !ok {
+				continue
+			}
+			hints = append(hints, LeaderHint{Key: key, Action: action, Label: label})
+		}
+	}
+	slices.SortFunc(hints, func(a, b LeaderHint) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return hints
+}
+
+// isModifierKeyval reports whether keyval is a bare modifier key. Pressing
+// Shift on its way to "shift+v" must not end a leader sequence.
+func isModifierKeyval(keyval uint) bool {
+	switch int(keyval) {
+	case gdk.KEY_Shift_L, gdk.KEY_Shift_R,
+		gdk.KEY_Control_L, gdk.KEY_Control_R,
+		gdk.KEY_Alt_L, gdk.KEY_Alt_R,
+		gdk.KEY_Meta_L, gdk.KEY_Meta_R,
+		gdk.KEY_Super_L, gdk.KEY_Super_R,
+		gdk.KEY_ISO_Level3_Shift:
+		return true
+	default:
+		return false
+	}
+}
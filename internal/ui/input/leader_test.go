@@ -0,0 +1,144 @@
+package input
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/gdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLeaderTestHandler(t *testing.T, timeoutMs int) (*KeyboardHandler, func() []Action) {
+	t.Helper()
+	workspace := newTestWorkspace()
+	workspace.Leader = entity.LeaderConfig{
+		ActivationShortcut:  "ctrl+space",
+		TimeoutMilliseconds: timeoutMs,
+		Actions: map[string]entity.ActionBinding{
+			"new-tab":     {Keys: []string{"t"}, Desc: "New tab"},
+			"split-right": {Keys: []string{"V"}, Desc: "Split right"},
+			"cancel":      {Keys: []string{"escape"}},
+		},
+	}
+	h := NewKeyboardHandler(context.Background(), workspace, newTestSession())
+
+	var mu sync.Mutex
+	var dispatched []Action
+	h.SetOnAction(func(_ context.Context, action Action) error {
+		mu.Lock()
+		defer mu.Unlock()
+		dispatched = append(dispatched, action)
+		return nil
+	})
+	return h, func() []Action {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]Action(nil), dispatched...)
+	}
+}
+
+func pressLeader(t *testing.T, h *KeyboardHandler) {
+	t.Helper()
+	require.True(t, h.handleKeyPress(uint(gdk.KEY_space), 0, gdk.ControlMaskValue))
+	h.handleKeyRelease(uint(gdk.KEY_space))
+}
+
+func TestLeader_FollowUpKeyDispatchesAndEnds(t *testing.T) {
+	h, dispatched := newLeaderTestHandler(t, 0)
+
+	pressLeader(t, h)
+	assert.Equal(t, ModeLeader, h.Mode())
+
+	assert.True(t, h.handleKeyPress(uint('t'), 0, 0))
+	assert.Equal(t, ModeNormal, h.Mode())
+	assert.Equal(t, []Action{ActionNewTab}, dispatched())
+}
+
+func TestLeader_ShiftedFollowUpIgnoresBareModifier(t *testing.T) {
+	h, dispatched := newLeaderTestHandler(t, 0)
+
+	pressLeader(t, h)
+	assert.True(t, h.handleKeyPress(uint(gdk.KEY_Shift_L), 0, 0), "bare modifier is swallowed")
+	assert.Equal(t, ModeLeader, h.Mode(), "bare modifier keeps the sequence pending")
+
+	assert.True(t, h.handleKeyPress(uint('V'), 0, gdk.ShiftMaskValue))
+	assert.Equal(t, ModeNormal, h.Mode())
+	assert.Equal(t, []Action{ActionSplitRight}, dispatched())
+}
+
+func TestLeader_UnboundKeyCancels(t *testing.T) {
+	h, dispatched := newLeaderTestHandler(t, 0)
+
+	pressLeader(t, h)
+	assert.True(t, h.handleKeyPress(uint('q'), 0, 0), "unbound follow-up is consumed")
+	assert.Equal(t, ModeNormal, h.Mode())
+	assert.Empty(t, dispatched())
+
+	assert.False(t, h.handleKeyPress(uint('q'), 0, 0), "plain keys pass through once the sequence ended")
+}
+
+func TestLeader_EscapeCancels(t *testing.T) {
+	h, dispatched := newLeaderTestHandler(t, 0)
+
+	pressLeader(t, h)
+	assert.True(t, h.handleKeyPress(uint(gdk.KEY_Escape), 0, 0))
+	assert.Equal(t, ModeNormal, h.Mode())
+	assert.Empty(t, dispatched())
+}
+
+func TestLeader_TimeoutResetsSequence(t *testing.T) {
+	h, dispatched := newLeaderTestHandler(t, 50)
+
+	pressLeader(t, h)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, ModeNormal, h.Mode(), "sequence expires after the timeout")
+
+	assert.False(t, h.handleKeyPress(uint('t'), 0, 0), "late follow-up key is plain input")
+	assert.Empty(t, dispatched())
+}
+
+func TestLeader_RepeatedLeaderRestartsTimeout(t *testing.T) {
+	h, _ := newLeaderTestHandler(t, 100)
+
+	pressLeader(t, h)
+	time.Sleep(60 * time.Millisecond)
+	pressLeader(t, h)
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, ModeLeader, h.Mode(), "second leader press restarted the timeout")
+
+	time.Sleep(80 * time.Millisecond)
+	assert.Equal(t, ModeNormal, h.Mode())
+}
+
+func TestLeader_DisabledWithoutActivationShortcut(t *testing.T) {
+	workspace := newTestWorkspace()
+	workspace.Leader.Actions = map[string]entity.ActionBinding{"new-tab": {Keys: []string{"t"}}}
+	set := NewShortcutSet(context.Background(), workspace, nil)
+
+	assert.Empty(t, set.Leader)
+	_, found := set.Lookup(KeyBinding{Keyval: uint(gdk.KEY_space), Modifiers: ModCtrl}, ModeNormal)
+	assert.False(t, found)
+}
+
+func TestLeaderHints(t *testing.T) {
+	cfg := &entity.LeaderConfig{
+		Actions: map[string]entity.ActionBinding{
+			"split-right": {Keys: []string{"v"}, Desc: "Split right"},
+			"new-tab":     {Keys: []string{"t", "n"}},
+			"cancel":      {Keys: []string{"escape"}},
+			"not-real":    {Keys: []string{"q"}},
+			"toggle-zen":  {Keys: []string{"not a key"}},
+		},
+	}
+
+	assert.Equal(t, []LeaderHint{
+		{Key: "n", Action: ActionNewTab, Label: "new-tab"},
+		{Key: "t", Action: ActionNewTab, Label: "new-tab"},
+		{Key: "v", Action: ActionSplitRight, Label: "Split right"},
+	}, LeaderHints(cfg))
+	assert.Nil(t, LeaderHints(nil))
+}
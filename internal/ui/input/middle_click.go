@@ -0,0 +1,164 @@
+package input
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/glib"
+	"github.com/bnema/puregotk/v4/gtk"
+)
+
+const (
+	mouseButtonMiddle = 2
+
+	// paneChromeBand is the width in pixels of the pane edge that counts as
+	// pane chrome. Presses further inside belong to the page, so middle-click
+	// link opening and autoscroll keep working.
+	paneChromeBand = 6.0
+)
+
+// gtkEventSequenceDenied matches GTK_EVENT_SEQUENCE_DENIED and hands a press
+// we don't want back to the widgets underneath.
+const gtkEventSequenceDenied = gtk.EventSequenceDeniedValue
+
+// MiddleClickPress describes a button press on a pane, in pane coordinates.
+type MiddleClickPress struct {
+	Button uint
+	NPress int
+	X, Y   float64
+	Width  float64
+	Height float64
+}
+
+// OnPaneChrome reports whether the press lies within the edge band of the pane.
+func (p MiddleClickPress) OnPaneChrome() bool {
+	if p.Width <= 0 || p.Height <= 0 {
+		return false
+	}
+	if p.X < 0 || p.Y < 0 || p.X > p.Width || p.Y > p.Height {
+		return false
+	}
+	return p.X < paneChromeBand || p.Y < paneChromeBand ||
+		p.X > p.Width-paneChromeBand || p.Y > p.Height-paneChromeBand
+}
+
+// ClosesPane reports whether the press is a single middle click on the pane
+// chrome, which closes the pane when middle-click closing is enabled.
+func (p MiddleClickPress) ClosesPane() bool {
+	return p.Button == mouseButtonMiddle && p.NPress == 1 && p.OnPaneChrome()
+}
+
+// MiddleClickHandler closes a pane on a middle click over its edge. It runs in
+// the capture phase so the press is seen before the WebView, and denies every
+// press that does not close the pane.
+type MiddleClickHandler struct {
+	clickGesture     *gtk.GestureClick
+	widget           *gtk.Widget
+	pressedHandlerID uint
+
+	// Callback retention: must stay reachable by Go GC.
+	pressedCb func(gtk.GestureClick, int, float64, float64)
+
+	onClose func()
+
+	ctx context.Context
+	mu  sync.RWMutex
+}
+
+// NewMiddleClickHandler creates a new middle-click close handler.
+func NewMiddleClickHandler(ctx context.Context) *MiddleClickHandler {
+	return &MiddleClickHandler{ctx: ctx}
+}
+
+// SetOnClose sets the callback invoked when the pane should close.
+func (h *MiddleClickHandler) SetOnClose(fn func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onClose = fn
+}
+
+// AttachTo attaches the handler to the pane widget.
+func (h *MiddleClickHandler) AttachTo(widget *gtk.Widget) {
+	log := logging.FromContext(h.ctx)
+
+	if widget == nil {
+		log.Error().Msg("cannot attach middle-click handler to nil widget")
+		return
+	}
+	h.Detach()
+
+	gesture := gtk.NewGestureClick()
+	if gesture == nil {
+		log.Error().Msg("failed to create middle-click gesture")
+		return
+	}
+	gesture.SetButton(mouseButtonMiddle)
+	gesture.SetPropagationPhase(gtk.PhaseCaptureValue)
+
+	h.mu.Lock()
+	h.clickGesture = gesture
+	h.widget = widget
+	h.pressedCb = func(_ gtk.GestureClick, nPress int, x float64, y float64) {
+		h.handlePressed(gesture, widget, nPress, x, y)
+	}
+	h.pressedHandlerID = gesture.ConnectPressed(&h.pressedCb)
+	h.mu.Unlock()
+
+	widget.AddController(&gesture.EventController)
+}
+
+func (h *MiddleClickHandler) handlePressed(gesture *gtk.GestureClick, widget *gtk.Widget, nPress int, x, y float64) {
+	press := MiddleClickPress{
+		Button: gesture.GetCurrentButton(),
+		NPress: nPress,
+		X:      x,
+		Y:      y,
+		Width:  float64(widget.GetAllocatedWidth()),
+		Height: float64(widget.GetAllocatedHeight()),
+	}
+	if !press.ClosesPane() {
+		gesture.SetState(gtkEventSequenceDenied)
+		return
+	}
+
+	h.mu.RLock()
+	onClose := h.onClose
+	h.mu.RUnlock()
+	if onClose == nil {
+		gesture.SetState(gtkEventSequenceDenied)
+		return
+	}
+
+	gesture.SetState(gtkEventSequenceClaimed)
+	logging.FromContext(h.ctx).Debug().Msg("middle click on pane chrome, closing pane")
+	// Close once the press has been dispatched: closing tears down the widget
+	// that owns this gesture.
+	cb := glib.SourceOnceFunc(func(uintptr) {
+		onClose()
+	})
+	glib.IdleAddOnce(&cb, 0)
+}
+
+// Detach removes the gesture from its widget and disconnects its signal.
+func (h *MiddleClickHandler) Detach() {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	widget := h.widget
+	gesture := h.clickGesture
+	pressedHandlerID := h.pressedHandlerID
+	h.widget = nil
+	h.clickGesture = nil
+	h.pressedHandlerID = 0
+	h.pressedCb = nil
+	h.mu.Unlock()
+
+	if gesture != nil && pressedHandlerID != 0 {
+		gesture.DisconnectSignal(pressedHandlerID)
+	}
+	if widget != nil && gesture != nil {
+		widget.RemoveController(&gesture.EventController)
+	}
+}
@@ -0,0 +1,50 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleClickPress_OnPaneChrome(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y float64
+		want bool
+	}{
+		{name: "left edge", x: 2, y: 300, want: true},
+		{name: "top edge", x: 400, y: 0, want: true},
+		{name: "right edge", x: 797, y: 300, want: true},
+		{name: "bottom edge", x: 400, y: 599, want: true},
+		{name: "page content", x: 400, y: 300, want: false},
+		{name: "just inside the band", x: 6, y: 6, want: false},
+		{name: "outside the pane", x: -1, y: 300, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			press := MiddleClickPress{X: tt.x, Y: tt.y, Width: 800, Height: 600}
+			assert.Equal(t, tt.want, press.OnPaneChrome())
+		})
+	}
+}
+
+func TestMiddleClickPress_OnPaneChromeIgnoresUnallocatedPane(t *testing.T) {
+	assert.False(t, MiddleClickPress{X: 0, Y: 0}.OnPaneChrome())
+}
+
+func TestMiddleClickPress_ClosesPane(t *testing.T) {
+	edge := MiddleClickPress{Button: mouseButtonMiddle, NPress: 1, X: 1, Y: 300, Width: 800, Height: 600}
+	assert.True(t, edge.ClosesPane())
+
+	content := edge
+	content.X = 400
+	assert.False(t, content.ClosesPane(), "middle clicks on the page are left to WebKit")
+
+	primary := edge
+	primary.Button = 1
+	assert.False(t, primary.ClosesPane())
+
+	double := edge
+	double.NPress = 2
+	assert.False(t, double.ClosesPane())
+}
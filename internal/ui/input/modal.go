@@ -22,6 +22,8 @@ const (
 	ModeSession
 	// ModeResize is the modal pane resizing mode.
 	ModeResize
+	// ModeLeader waits for the key that follows the leader key.
+	ModeLeader
 )
 
 // String returns a human-readable mode name.
@@ -37,6 +39,8 @@ func (m Mode) String() string {
 		return "session"
 	case ModeResize:
 		return "resize"
+	case ModeLeader:
+		return "leader"
 	default:
 		return "unknown"
 	}
@@ -53,6 +57,8 @@ func (m Mode) DisplayName() string {
 		return "SESSION MODE"
 	case ModeResize:
 		return "RESIZE MODE"
+	case ModeLeader:
+		return "LEADER"
 	default:
 		return ""
 	}
@@ -127,6 +133,12 @@ func (m *ModalState) EnterResizeMode(ctx context.Context, timeout time.Duration)
 	m.enterMode(ctx, ModeResize, timeout)
 }
 
+// EnterLeaderMode starts a leader sequence with an optional timeout.
+// Entering it again while pending restarts the timeout.
+func (m *ModalState) EnterLeaderMode(ctx context.Context, timeout time.Duration) {
+	m.enterMode(ctx, ModeLeader, timeout)
+}
+
 // enterMode is the shared implementation for all mode-enter methods.
 // If already in the target mode, it resets the timeout instead.
 func (m *ModalState) enterMode(ctx context.Context, target Mode, timeout time.Duration) {
@@ -108,14 +108,21 @@ const (
 	ActionEnterPaneMode    Action = "enter_pane_mode"
 	ActionEnterSessionMode Action = "enter_session_mode"
 	ActionEnterResizeMode  Action = "enter_resize_mode"
+	ActionEnterLeaderMode  Action = "enter_leader_mode"
 	ActionExitMode         Action = "exit_mode"
 
 	// Tab actions (global and modal)
 	ActionNewTab           Action = "new_tab"
 	ActionCloseTab         Action = "close_tab"
+	ActionCloseTabsToRight Action = "close_tabs_to_right"
+	ActionCloseOtherTabs   Action = "close_other_tabs"
+	ActionReopenClosedTab  Action = "reopen_closed_tab"
 	ActionNextTab          Action = "next_tab"
 	ActionPreviousTab      Action = "previous_tab"
 	ActionRenameTab        Action = "rename_tab"
+	ActionGroupTab         Action = "group_tab"
+	ActionToggleTabGroup   Action = "toggle_tab_group"
+	ActionUngroupTab       Action = "ungroup_tab"
 	ActionSwitchLastTab    Action = "switch_last_tab" // Alt+Tab style switching
 	ActionSwitchTabIndex1  Action = "switch_tab_1"
 	ActionSwitchTabIndex2  Action = "switch_tab_2"
@@ -145,12 +152,34 @@ const (
 	ActionConsumeOrExpelUp    Action = "consume_or_expel_up"
 	ActionConsumeOrExpelDown  Action = "consume_or_expel_down"
 
+	// Whole-tree restructuring (modal)
+	ActionCollapseStacks Action = "collapse_stacks"
+	ActionExpandStack    Action = "expand_stack"
+	ActionBalancePanes   Action = "balance_panes"
+
+	// Reopen the active pane in a WebView related to the previous pane (modal)
+	ActionReopenAsRelated Action = "reopen_as_related"
+
+	// Maximize the active pane, hiding the others until toggled off (modal)
+	ActionToggleZen Action = "toggle_zen"
+
 	// Pane focus navigation
 	ActionFocusRight Action = "focus_right"
 	ActionFocusLeft  Action = "focus_left"
 	ActionFocusUp    Action = "focus_up"
 	ActionFocusDown  Action = "focus_down"
 
+	// Pane numbering (modal): focus the pane labeled 1-9
+	ActionFocusPane1 Action = "focus_pane_1"
+	ActionFocusPane2 Action = "focus_pane_2"
+	ActionFocusPane3 Action = "focus_pane_3"
+	ActionFocusPane4 Action = "focus_pane_4"
+	ActionFocusPane5 Action = "focus_pane_5"
+	ActionFocusPane6 Action = "focus_pane_6"
+	ActionFocusPane7 Action = "focus_pane_7"
+	ActionFocusPane8 Action = "focus_pane_8"
+	ActionFocusPane9 Action = "focus_pane_9"
+
 	// Resize actions (modal)
 	ActionResizeIncreaseLeft  Action = "resize_increase_left"
 	ActionResizeIncreaseRight Action = "resize_increase_right"
@@ -174,6 +203,10 @@ const (
 	ActionHardReload Action = "hard_reload"
 	ActionStop       Action = "stop"
 	ActionPrintPage  Action = "print_page"
+	ActionNextPage   Action = "next_page" // Step the page number in the URL up
+	ActionPrevPage   Action = "prev_page" // Step the page number in the URL down
+	// Go back one page and restore its scroll offset
+	ActionRestoreLastView Action = "restore_last_view"
 
 	// Zoom
 	ActionZoomIn    Action = "zoom_in"
@@ -193,9 +226,55 @@ const (
 	ActionToggleFavoritesSystemView Action = "toggle_favorites_systemview"
 	ActionToggleCurrentPageFavorite Action = "toggle_current_page_favorite"
 	ActionToggleConfigSystemView    Action = "toggle_config_systemview"
+	ActionToggleNotesSystemView     Action = "toggle_notes_systemview"
+	ActionOpenLinkInPane            Action = "open_link_in_pane"
 
 	// Clipboard
-	ActionCopyURL Action = "copy_url"
+	ActionCopyURL             Action = "copy_url"
+	ActionCopyURLMarkdown     Action = "copy_url_markdown"
+	ActionCopyURLHTML         Action = "copy_url_html"
+	ActionShareURL            Action = "share_url"
+	ActionCopyTabURLs         Action = "copy_tab_urls"
+	ActionCopyTabURLsMarkdown Action = "copy_tab_urls_markdown"
+	ActionCopyTabURLsJSON     Action = "copy_tab_urls_json"
+
+	// Audio
+	ActionToggleMute           Action = "toggle_mute"
+	ActionToggleMuteBackground Action = "toggle_mute_background"
+
+	// Appearance
+	ActionToggleForceDark  Action = "toggle_force_dark"
+	ActionCycleColorScheme Action = "cycle_color_scheme"
+
+	// Focus timer
+	ActionToggleFocusTimer Action = "toggle_focus_timer"
+
+	// Site user agent
+	ActionRequestDesktopSite Action = "request_desktop_site"
+	ActionRequestMobileSite  Action = "request_mobile_site"
+
+	// Content filtering
+	ActionToggleContentFiltering  Action = "toggle_content_filtering"
+	ActionToggleCosmeticFiltering Action = "toggle_cosmetic_filtering"
+
+	// Panic key: hide the browser at once
+	ActionPanic Action = "panic"
+
+	// Read aloud
+	ActionReadAloud     Action = "read_aloud"
+	ActionStopReadAloud Action = "stop_read_aloud"
+
+	// Translation
+	ActionTranslatePage Action = "translate_page"
+
+	// Search the selected text
+	ActionSearchSelection Action = "search_selection"
+
+	// Notification center
+	ActionToggleNotifications Action = "toggle_notifications"
+
+	// Clipboard history
+	ActionToggleClipboardHistory Action = "toggle_clipboard_history"
 
 	// Session management
 	ActionOpenSessionManager Action = "open_session_manager"
@@ -222,6 +301,8 @@ type ShortcutSet struct {
 	SessionMode ShortcutTable
 	// ResizeMode shortcuts are only active in resize mode.
 	ResizeMode ShortcutTable
+	// Leader shortcuts are the follow-up keys of a leader sequence.
+	Leader ShortcutTable
 }
 
 // NewShortcutSet creates a ShortcutSet from workspace and session configuration.
@@ -235,6 +316,7 @@ func NewShortcutSet(ctx context.Context, workspace *entity.WorkspaceConfig, sess
 		PaneMode:    make(ShortcutTable),
 		SessionMode: make(ShortcutTable),
 		ResizeMode:  make(ShortcutTable),
+		Leader:      make(ShortcutTable),
 	}
 
 	set.buildGlobalShortcutsFromParts(ctx, workspace, session)
@@ -242,6 +324,7 @@ func NewShortcutSet(ctx context.Context, workspace *entity.WorkspaceConfig, sess
 		set.buildTabModeShortcuts(ctx, workspace)
 		set.buildPaneModeShortcuts(ctx, workspace)
 		set.buildResizeModeShortcuts(ctx, workspace)
+		set.buildLeaderShortcuts(ctx, workspace)
 	}
 	if session != nil {
 		set.buildSessionModeShortcuts(ctx, session)
@@ -252,6 +335,7 @@ func NewShortcutSet(ctx context.Context, workspace *entity.WorkspaceConfig, sess
 		Int("tab", len(set.TabMode)).
 		Int("pane", len(set.PaneMode)).
 		Int("resize", len(set.ResizeMode)).
+		Int("leader", len(set.Leader)).
 		Int("session", len(set.SessionMode)).
 		Msg("shortcuts registered")
 
@@ -262,7 +346,7 @@ func NewShortcutSet(ctx context.Context, workspace *entity.WorkspaceConfig, sess
 func (s *ShortcutSet) buildGlobalShortcutsFromParts(ctx context.Context, workspace *entity.WorkspaceConfig, session *entity.SessionConfig) {
 	s.registerActivationShortcutsFromParts(ctx, workspace, session)
 	s.registerConfiguredShortcuts(workspace)
-	s.registerStandardShortcuts()
+	s.registerStandardShortcuts(workspace)
 	s.registerPaneNavigationShortcuts()
 	s.registerTabSwitchShortcuts()
 	s.registerFloatingProfileShortcutsFromWorkspace(ctx, workspace)
@@ -288,6 +372,15 @@ func (s *ShortcutSet) buildResizeModeShortcuts(ctx context.Context, cfg *entity.
 	s.buildModeShortcuts(ctx, cfg.ResizeMode.GetKeyBindings(), s.ResizeMode, "resize")
 }
 
+// buildLeaderShortcuts populates leader follow-up keys from config.
+// Nothing is registered while the leader key is disabled.
+func (s *ShortcutSet) buildLeaderShortcuts(ctx context.Context, cfg *entity.WorkspaceConfig) {
+	if strings.TrimSpace(cfg.Leader.ActivationShortcut) == "" {
+		return
+	}
+	s.buildModeShortcuts(ctx, cfg.Leader.GetKeyBindings(), s.Leader, "leader")
+}
+
 func (s *ShortcutSet) registerActivationShortcutsFromParts(
 	ctx context.Context, workspace *entity.WorkspaceConfig, session *entity.SessionConfig,
 ) {
@@ -350,6 +443,18 @@ func (s *ShortcutSet) registerActivationShortcutsFromParts(
 	} else {
 		log.Warn().Str("shortcut", workspace.ResizeMode.ActivationShortcut).Msg("failed to parse resize mode activation shortcut")
 	}
+	if leader := strings.TrimSpace(workspace.Leader.ActivationShortcut); leader != "" {
+		if binding, ok := ParseKeyString(leader); ok {
+			s.Global[binding] = ActionEnterLeaderMode
+			log.Trace().
+				Str("shortcut", leader).
+				Uint("keyval", binding.Keyval).
+				Uint("mod", uint(binding.Modifiers)).
+				Msg("leader key registered")
+		} else {
+			log.Warn().Str("shortcut", leader).Msg("failed to parse leader key")
+		}
+	}
 }
 
 func (s *ShortcutSet) registerConfiguredShortcuts(cfg *entity.WorkspaceConfig) {
@@ -485,8 +590,12 @@ func reserveGlobalOnlyShortcutBindings(occupied map[KeyBinding]Action) {
 	}
 }
 
-func (s *ShortcutSet) registerStandardShortcuts() {
-	s.Global[KeyBinding{uint(gdk.KEY_l), ModCtrl}] = ActionOpenOmnibox
+func (s *ShortcutSet) registerStandardShortcuts(workspace *entity.WorkspaceConfig) {
+	triggerKey := ""
+	if workspace != nil {
+		triggerKey = workspace.OmniboxTriggerKey
+	}
+	s.Global[omniboxTriggerBinding(triggerKey)] = ActionOpenOmnibox
 	s.Global[KeyBinding{uint(gdk.KEY_f), ModCtrl}] = ActionOpenFind
 	s.Global[KeyBinding{uint(gdk.KEY_F3), ModNone}] = ActionFindNext
 	s.Global[KeyBinding{uint(gdk.KEY_F3), ModShift}] = ActionFindPrev
@@ -511,6 +620,15 @@ func (s *ShortcutSet) registerStandardShortcuts() {
 	s.Global[KeyBinding{uint(gdk.KEY_s), ModCtrl | ModShift}] = ActionOpenSessionManager
 }
 
+// omniboxTriggerBinding returns the binding that opens the omnibox. An empty or
+// unparsable key falls back to Ctrl+L.
+func omniboxTriggerBinding(key string) KeyBinding {
+	if binding, ok := ParseKeyString(key); ok {
+		return binding
+	}
+	return KeyBinding{uint(gdk.KEY_l), ModCtrl}
+}
+
 func (s *ShortcutSet) registerPaneNavigationShortcuts() {
 	s.Global[KeyBinding{uint(gdk.KEY_h), ModAlt}] = ActionFocusLeft
 	s.Global[KeyBinding{uint(gdk.KEY_l), ModAlt}] = ActionFocusRight
@@ -574,18 +692,110 @@ var configActionToAction = map[string]Action{
 	"toggle-current-page-favorite": ActionToggleCurrentPageFavorite,
 	"toggle_config_systemview":     ActionToggleConfigSystemView,
 	"toggle-config-systemview":     ActionToggleConfigSystemView,
+	"toggle_notes_systemview":      ActionToggleNotesSystemView,
+	"toggle-notes-systemview":      ActionToggleNotesSystemView,
+	"open_link_in_pane":            ActionOpenLinkInPane,
+	"open-link-in-pane":            ActionOpenLinkInPane,
+
+	// Clipboard
+	"copy_url_markdown":      ActionCopyURLMarkdown,
+	"copy-url-markdown":      ActionCopyURLMarkdown,
+	"copy_url_html":          ActionCopyURLHTML,
+	"copy-url-html":          ActionCopyURLHTML,
+	"share_url":              ActionShareURL,
+	"share-url":              ActionShareURL,
+	"copy_tab_urls":          ActionCopyTabURLs,
+	"copy-tab-urls":          ActionCopyTabURLs,
+	"copy_tab_urls_markdown": ActionCopyTabURLsMarkdown,
+	"copy-tab-urls-markdown": ActionCopyTabURLsMarkdown,
+	"copy_tab_urls_json":     ActionCopyTabURLsJSON,
+	"copy-tab-urls-json":     ActionCopyTabURLsJSON,
+
+	// Pagination
+	"next_page": ActionNextPage,
+	"next-page": ActionNextPage,
+	"prev_page": ActionPrevPage,
+	"prev-page": ActionPrevPage,
+
+	// History
+	"restore_last_view": ActionRestoreLastView,
+	"restore-last-view": ActionRestoreLastView,
+
+	// Audio
+	"toggle_mute":            ActionToggleMute,
+	"toggle-mute":            ActionToggleMute,
+	"toggle_mute_background": ActionToggleMuteBackground,
+	"toggle-mute-background": ActionToggleMuteBackground,
+
+	// Appearance
+	"toggle_force_dark":  ActionToggleForceDark,
+	"toggle-force-dark":  ActionToggleForceDark,
+	"cycle_color_scheme": ActionCycleColorScheme,
+	"cycle-color-scheme": ActionCycleColorScheme,
+
+	// Focus timer
+	"toggle_focus_timer": ActionToggleFocusTimer,
+	"toggle-focus-timer": ActionToggleFocusTimer,
+
+	// Site user agent
+	"request_desktop_site": ActionRequestDesktopSite,
+	"request-desktop-site": ActionRequestDesktopSite,
+	"request_mobile_site":  ActionRequestMobileSite,
+	"request-mobile-site":  ActionRequestMobileSite,
+
+	// Content filtering
+	"toggle_content_filtering":  ActionToggleContentFiltering,
+	"toggle-content-filtering":  ActionToggleContentFiltering,
+	"toggle_cosmetic_filtering": ActionToggleCosmeticFiltering,
+	"toggle-cosmetic-filtering": ActionToggleCosmeticFiltering,
+
+	// Panic key
+	"panic": ActionPanic,
+
+	// Read aloud
+	"read_aloud":      ActionReadAloud,
+	"read-aloud":      ActionReadAloud,
+	"stop_read_aloud": ActionStopReadAloud,
+	"stop-read-aloud": ActionStopReadAloud,
+
+	// Translation
+	"translate_page": ActionTranslatePage,
+	"translate-page": ActionTranslatePage,
+
+	// Search the selected text
+	"search_selection": ActionSearchSelection,
+	"search-selection": ActionSearchSelection,
+
+	// Notification center
+	"toggle_notifications": ActionToggleNotifications,
+	"toggle-notifications": ActionToggleNotifications,
+
+	"toggle_clipboard_history": ActionToggleClipboardHistory,
+	"toggle-clipboard-history": ActionToggleClipboardHistory,
 
 	// Tab actions
-	"new_tab":      ActionNewTab,
-	"new-tab":      ActionNewTab,
-	"close_tab":    ActionCloseTab,
-	"close-tab":    ActionCloseTab,
-	"next_tab":     ActionNextTab,
-	"next-tab":     ActionNextTab,
-	"previous_tab": ActionPreviousTab,
-	"previous-tab": ActionPreviousTab,
-	"rename_tab":   ActionRenameTab,
-	"rename-tab":   ActionRenameTab,
+	"new_tab":             ActionNewTab,
+	"new-tab":             ActionNewTab,
+	"close_tab":           ActionCloseTab,
+	"close-tab":           ActionCloseTab,
+	"close_tabs_to_right": ActionCloseTabsToRight,
+	"close-tabs-to-right": ActionCloseTabsToRight,
+	"close_other_tabs":    ActionCloseOtherTabs,
+	"close-other-tabs":    ActionCloseOtherTabs,
+	"reopen_closed_tab":   ActionReopenClosedTab,
+	"reopen-closed-tab":   ActionReopenClosedTab,
+	"next_tab":            ActionNextTab,
+	"next-tab":            ActionNextTab,
+	"previous_tab":        ActionPreviousTab,
+	"previous-tab":        ActionPreviousTab,
+	"rename_tab":          ActionRenameTab,
+	"rename-tab":          ActionRenameTab,
+	"group_tab":           ActionGroupTab,
+	"group-tab":           ActionGroupTab,
+	"toggle_tab_group":    ActionToggleTabGroup,
+	"toggle-tab-group":    ActionToggleTabGroup,
+	"ungroup_tab":         ActionUngroupTab,
+	"ungroup-tab":         ActionUngroupTab,
 
 	// Pane actions
 	"split_right":           ActionSplitRight,
@@ -616,12 +826,37 @@ var configActionToAction = map[string]Action{
 	"consume_or_expel_down":  ActionConsumeOrExpelDown,
 	"consume-or-expel-down":  ActionConsumeOrExpelDown,
 
+	// Whole-tree restructuring
+	"collapse_stacks": ActionCollapseStacks,
+	"collapse-stacks": ActionCollapseStacks,
+	"expand_stack":    ActionExpandStack,
+	"expand-stack":    ActionExpandStack,
+	"balance_panes":   ActionBalancePanes,
+	"balance-panes":   ActionBalancePanes,
+
+	"reopen_as_related": ActionReopenAsRelated,
+	"reopen-as-related": ActionReopenAsRelated,
+
+	"toggle_zen": ActionToggleZen,
+	"toggle-zen": ActionToggleZen,
+
 	// Focus navigation
 	"focus-right": ActionFocusRight,
 	"focus-left":  ActionFocusLeft,
 	"focus-up":    ActionFocusUp,
 	"focus-down":  ActionFocusDown,
 
+	// Pane numbering
+	"focus-pane-1": ActionFocusPane1,
+	"focus-pane-2": ActionFocusPane2,
+	"focus-pane-3": ActionFocusPane3,
+	"focus-pane-4": ActionFocusPane4,
+	"focus-pane-5": ActionFocusPane5,
+	"focus-pane-6": ActionFocusPane6,
+	"focus-pane-7": ActionFocusPane7,
+	"focus-pane-8": ActionFocusPane8,
+	"focus-pane-9": ActionFocusPane9,
+
 	// Stack navigation
 	"stack-nav-up":   ActionStackNavUp,
 	"stack-up":       ActionStackNavUp,
@@ -771,6 +1006,35 @@ func ParseKeyString(s string) (KeyBinding, bool) {
 	}, true
 }
 
+// KeySet is a set of key bindings parsed from config key strings, for widgets
+// that react to a configurable list of keys.
+type KeySet map[KeyBinding]struct{}
+
+// ParseKeySet parses keys like "ctrl+j" into a KeySet. Keys that cannot be
+// parsed are skipped and returned in invalid.
+func ParseKeySet(keys []string) (set KeySet, invalid []string) {
+	set = make(KeySet, len(keys))
+	for _, key := range keys {
+		binding, ok := ParseKeyString(key)
+		if !ok {
+			invalid = append(invalid, key)
+			continue
+		}
+		set[binding] = struct{}{}
+	}
+	return set, invalid
+}
+
+// Contains reports whether a key press with keyval and state is in the set.
+// Uppercase letters match their lowercase binding, as in shortcut lookup.
+func (s KeySet) Contains(keyval uint, state gdk.ModifierType) bool {
+	if len(s) == 0 {
+		return false
+	}
+	_, ok := s[KeyBinding{Keyval: normalizeKeyval(keyval), Modifiers: Modifier(state) & modifierMask}]
+	return ok
+}
+
 // stringToKeyval converts a key name to its GDK keyval.
 func stringToKeyval(s string) (uint, bool) {
 	if s == "" {
@@ -807,6 +1071,8 @@ func (s *ShortcutSet) Lookup(binding KeyBinding, mode Mode) (Action, bool) {
 		modeTable = s.ResizeMode
 	case ModeSession:
 		modeTable = s.SessionMode
+	case ModeLeader:
+		modeTable = s.Leader
 	}
 
 	if modeTable != nil {
@@ -826,11 +1092,15 @@ func (s *ShortcutSet) Lookup(binding KeyBinding, mode Mode) (Action, bool) {
 // ShouldAutoExitMode returns true if the action should cause modal mode to exit.
 func ShouldAutoExitMode(action Action) bool {
 	switch action {
-	case ActionNewTab, ActionCloseTab, ActionRenameTab,
+	case ActionNewTab, ActionCloseTab, ActionCloseTabsToRight, ActionCloseOtherTabs, ActionReopenClosedTab, ActionRenameTab,
+		ActionGroupTab, ActionToggleTabGroup, ActionUngroupTab,
 		ActionSplitRight, ActionSplitLeft, ActionSplitUp, ActionSplitDown,
 		ActionClosePane, ActionStackPane,
 		ActionMovePaneToTab, ActionMovePaneToNextTab, ActionEjectPaneToWindow,
 		ActionConsumeOrExpelLeft, ActionConsumeOrExpelRight, ActionConsumeOrExpelUp, ActionConsumeOrExpelDown,
+		ActionCollapseStacks, ActionExpandStack, ActionBalancePanes, ActionReopenAsRelated, ActionToggleZen,
+		ActionFocusPane1, ActionFocusPane2, ActionFocusPane3, ActionFocusPane4, ActionFocusPane5,
+		ActionFocusPane6, ActionFocusPane7, ActionFocusPane8, ActionFocusPane9,
 		ActionOpenSessionManager:
 		return true
 	default:
@@ -1,8 +1,10 @@
 package input
 
 import (
+	"context"
 	"testing"
 
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/puregotk/v4/gdk"
 )
 
@@ -352,6 +354,8 @@ func TestShouldAutoExitMode(t *testing.T) {
 		ActionMovePaneToTab,
 		ActionMovePaneToNextTab,
 		ActionEjectPaneToWindow,
+		ActionFocusPane1,
+		ActionFocusPane9,
 	}
 
 	stayActions := []Action{
@@ -388,6 +392,259 @@ func TestMapConfigAction_EjectPaneToWindowAliases(t *testing.T) {
 	}
 }
 
+func TestMapConfigAction_FocusPaneNumbers(t *testing.T) {
+	tests := []struct {
+		name string
+		want Action
+	}{
+		{name: "focus-pane-1", want: ActionFocusPane1},
+		{name: "focus-pane-5", want: ActionFocusPane5},
+		{name: "focus-pane-9", want: ActionFocusPane9},
+		{name: "focus-pane-10", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapConfigAction(tt.name); got != tt.want {
+				t.Fatalf("mapConfigAction(%s) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapConfigAction_CollapseExpandStacks(t *testing.T) {
+	tests := []struct {
+		name string
+		want Action
+	}{
+		{name: "collapse-stacks", want: ActionCollapseStacks},
+		{name: "collapse_stacks", want: ActionCollapseStacks},
+		{name: "expand-stack", want: ActionExpandStack},
+		{name: "expand_stack", want: ActionExpandStack},
+		{name: "balance-panes", want: ActionBalancePanes},
+		{name: "balance_panes", want: ActionBalancePanes},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapConfigAction(tt.name); got != tt.want {
+				t.Fatalf("mapConfigAction(%s) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapConfigAction_ReopenAsRelated(t *testing.T) {
+	for _, name := range []string{"reopen-as-related", "reopen_as_related"} {
+		if got := mapConfigAction(name); got != ActionReopenAsRelated {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionReopenAsRelated)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleZen(t *testing.T) {
+	for _, name := range []string{"toggle-zen", "toggle_zen"} {
+		if got := mapConfigAction(name); got != ActionToggleZen {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionToggleZen)
+		}
+	}
+}
+
+func TestMapConfigAction_CopyURLAsLink(t *testing.T) {
+	tests := []struct {
+		name string
+		want Action
+	}{
+		{name: "copy-url-markdown", want: ActionCopyURLMarkdown},
+		{name: "copy_url_markdown", want: ActionCopyURLMarkdown},
+		{name: "copy-url-html", want: ActionCopyURLHTML},
+		{name: "copy_url_html", want: ActionCopyURLHTML},
+		{name: "share-url", want: ActionShareURL},
+		{name: "share_url", want: ActionShareURL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapConfigAction(tt.name); got != tt.want {
+				t.Fatalf("mapConfigAction(%s) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapConfigAction_Pagination(t *testing.T) {
+	tests := []struct {
+		name string
+		want Action
+	}{
+		{name: "next-page", want: ActionNextPage},
+		{name: "next_page", want: ActionNextPage},
+		{name: "prev-page", want: ActionPrevPage},
+		{name: "prev_page", want: ActionPrevPage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapConfigAction(tt.name); got != tt.want {
+				t.Fatalf("mapConfigAction(%s) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapConfigAction_RestoreLastView(t *testing.T) {
+	for _, name := range []string{"restore-last-view", "restore_last_view"} {
+		if got := mapConfigAction(name); got != ActionRestoreLastView {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionRestoreLastView)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleContentFiltering(t *testing.T) {
+	for _, name := range []string{"toggle-content-filtering", "toggle_content_filtering"} {
+		if got := mapConfigAction(name); got != ActionToggleContentFiltering {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionToggleContentFiltering)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleCosmeticFiltering(t *testing.T) {
+	for _, name := range []string{"toggle-cosmetic-filtering", "toggle_cosmetic_filtering"} {
+		if got := mapConfigAction(name); got != ActionToggleCosmeticFiltering {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionToggleCosmeticFiltering)
+		}
+	}
+}
+
+func TestMapConfigAction_Panic(t *testing.T) {
+	if got := mapConfigAction("panic"); got != ActionPanic {
+		t.Fatalf("mapConfigAction(panic) = %q, want %q", got, ActionPanic)
+	}
+}
+
+func TestMapConfigAction_CopyTabURLs(t *testing.T) {
+	tests := map[string]Action{
+		"copy-tab-urls":          ActionCopyTabURLs,
+		"copy_tab_urls":          ActionCopyTabURLs,
+		"copy-tab-urls-markdown": ActionCopyTabURLsMarkdown,
+		"copy_tab_urls_markdown": ActionCopyTabURLsMarkdown,
+		"copy-tab-urls-json":     ActionCopyTabURLsJSON,
+		"copy_tab_urls_json":     ActionCopyTabURLsJSON,
+	}
+	for name, want := range tests {
+		if got := mapConfigAction(name); got != want {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleForceDark(t *testing.T) {
+	for _, name := range []string{"toggle-force-dark", "toggle_force_dark"} {
+		if got := mapConfigAction(name); got != ActionToggleForceDark {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionToggleForceDark)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleFocusTimer(t *testing.T) {
+	for _, name := range []string{"toggle-focus-timer", "toggle_focus_timer"} {
+		if got := mapConfigAction(name); got != ActionToggleFocusTimer {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionToggleFocusTimer)
+		}
+	}
+}
+
+func TestMapConfigAction_CycleColorScheme(t *testing.T) {
+	for _, name := range []string{"cycle-color-scheme", "cycle_color_scheme"} {
+		if got := mapConfigAction(name); got != ActionCycleColorScheme {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionCycleColorScheme)
+		}
+	}
+}
+
+func TestMapConfigAction_TranslatePage(t *testing.T) {
+	for _, name := range []string{"translate-page", "translate_page"} {
+		if got := mapConfigAction(name); got != ActionTranslatePage {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionTranslatePage)
+		}
+	}
+}
+
+func TestMapConfigAction_ReadAloud(t *testing.T) {
+	tests := map[string]Action{
+		"read-aloud":      ActionReadAloud,
+		"read_aloud":      ActionReadAloud,
+		"stop-read-aloud": ActionStopReadAloud,
+		"stop_read_aloud": ActionStopReadAloud,
+	}
+	for name, want := range tests {
+		if got := mapConfigAction(name); got != want {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestMapConfigAction_SearchSelection(t *testing.T) {
+	for _, name := range []string{"search-selection", "search_selection"} {
+		if got := mapConfigAction(name); got != ActionSearchSelection {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionSearchSelection)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleNotifications(t *testing.T) {
+	for _, name := range []string{"toggle-notifications", "toggle_notifications"} {
+		if got := mapConfigAction(name); got != ActionToggleNotifications {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionToggleNotifications)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleClipboardHistory(t *testing.T) {
+	for _, name := range []string{"toggle-clipboard-history", "toggle_clipboard_history"} {
+		if got := mapConfigAction(name); got != ActionToggleClipboardHistory {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionToggleClipboardHistory)
+		}
+	}
+}
+
+func TestMapConfigAction_RequestSite(t *testing.T) {
+	tests := map[string]Action{
+		"request-desktop-site": ActionRequestDesktopSite,
+		"request_desktop_site": ActionRequestDesktopSite,
+		"request-mobile-site":  ActionRequestMobileSite,
+		"request_mobile_site":  ActionRequestMobileSite,
+	}
+	for name, want := range tests {
+		if got := mapConfigAction(name); got != want {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestMapConfigAction_OpenLinkInPane(t *testing.T) {
+	for _, name := range []string{"open-link-in-pane", "open_link_in_pane"} {
+		if got := mapConfigAction(name); got != ActionOpenLinkInPane {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, ActionOpenLinkInPane)
+		}
+	}
+}
+
+func TestMapConfigAction_ToggleMute(t *testing.T) {
+	tests := []struct {
+		name string
+		want Action
+	}{
+		{name: "toggle-mute", want: ActionToggleMute},
+		{name: "toggle_mute", want: ActionToggleMute},
+		{name: "toggle-mute-background", want: ActionToggleMuteBackground},
+		{name: "toggle_mute_background", want: ActionToggleMuteBackground},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapConfigAction(tt.name); got != tt.want {
+				t.Fatalf("mapConfigAction(%s) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMapConfigAction_ToggleFloatingPane(t *testing.T) {
 	action := mapConfigAction("toggle_floating_pane")
 	if action != ActionToggleFloatingPane {
@@ -404,6 +661,7 @@ func TestMapConfigAction_ToggleSystemViews(t *testing.T) {
 		{name: "toggle_favorites_systemview", want: ActionToggleFavoritesSystemView},
 		{name: "toggle_current_page_favorite", want: ActionToggleCurrentPageFavorite},
 		{name: "toggle_config_systemview", want: ActionToggleConfigSystemView},
+		{name: "toggle_notes_systemview", want: ActionToggleNotesSystemView},
 	}
 
 	for _, tt := range tests {
@@ -424,6 +682,7 @@ func TestMapConfigAction_ToggleSystemViewsHyphenAlias(t *testing.T) {
 		{name: "toggle-favorites-systemview", want: ActionToggleFavoritesSystemView},
 		{name: "toggle-current-page-favorite", want: ActionToggleCurrentPageFavorite},
 		{name: "toggle-config-systemview", want: ActionToggleConfigSystemView},
+		{name: "toggle-notes-systemview", want: ActionToggleNotesSystemView},
 	}
 
 	for _, tt := range tests {
@@ -462,3 +721,116 @@ func TestGlobalShortcutActionMap_ConsumeOrExpelHyphenAliases(t *testing.T) {
 		})
 	}
 }
+
+func TestOmniboxTriggerBinding(t *testing.T) {
+	ctrlL := KeyBinding{Keyval: uint(gdk.KEY_l), Modifiers: ModCtrl}
+	tests := []struct {
+		name string
+		key  string
+		want KeyBinding
+	}{
+		{name: "default when empty", key: "", want: ctrlL},
+		{name: "custom combination", key: "alt+o", want: KeyBinding{Keyval: uint(gdk.KEY_o), Modifiers: ModAlt}},
+		{name: "function key", key: "f6", want: KeyBinding{Keyval: uint(gdk.KEY_F6), Modifiers: ModNone}},
+		{name: "unparsable falls back", key: "ctrl+nope", want: ctrlL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := omniboxTriggerBinding(tt.key); got != tt.want {
+				t.Errorf("omniboxTriggerBinding(%q) = %+v, want %+v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewShortcutSet_OmniboxTriggerKeyReplacesCtrlL(t *testing.T) {
+	workspace := &entity.WorkspaceConfig{OmniboxTriggerKey: "alt+o"}
+	set := NewShortcutSet(context.Background(), workspace, nil)
+
+	if got := set.Global[KeyBinding{Keyval: uint(gdk.KEY_o), Modifiers: ModAlt}]; got != ActionOpenOmnibox {
+		t.Errorf("alt+o = %q, want %q", got, ActionOpenOmnibox)
+	}
+	if got, ok := set.Global[KeyBinding{Keyval: uint(gdk.KEY_l), Modifiers: ModCtrl}]; ok {
+		t.Errorf("ctrl+l still bound to %q", got)
+	}
+}
+
+func TestMapConfigAction_BulkCloseTabs(t *testing.T) {
+	tests := map[string]Action{
+		"close-tabs-to-right": ActionCloseTabsToRight,
+		"close_tabs_to_right": ActionCloseTabsToRight,
+		"close-other-tabs":    ActionCloseOtherTabs,
+		"close_other_tabs":    ActionCloseOtherTabs,
+		"reopen-closed-tab":   ActionReopenClosedTab,
+		"reopen_closed_tab":   ActionReopenClosedTab,
+	}
+	for name, want := range tests {
+		if got := mapConfigAction(name); got != want {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, want)
+		}
+		if !ShouldAutoExitMode(want) {
+			t.Fatalf("ShouldAutoExitMode(%q) = false, want true", want)
+		}
+	}
+}
+
+func TestParseKeySet_SkipsInvalidKeys(t *testing.T) {
+	set, invalid := ParseKeySet([]string{"ctrl+j", "alt+n", "ctrl+nosuchkey", ""})
+
+	if len(set) != 2 {
+		t.Fatalf("expected 2 parsed keys, got %d", len(set))
+	}
+	if len(invalid) != 2 || invalid[0] != "ctrl+nosuchkey" || invalid[1] != "" {
+		t.Fatalf("unexpected invalid keys: %q", invalid)
+	}
+}
+
+func TestKeySet_Contains(t *testing.T) {
+	set, _ := ParseKeySet([]string{"ctrl+j", "ctrl+shift+k"})
+	ctrl := gdk.ControlMaskValue
+	shift := gdk.ShiftMaskValue
+
+	tests := []struct {
+		name   string
+		keyval uint
+		state  gdk.ModifierType
+		want   bool
+	}{
+		{name: "configured key", keyval: uint(gdk.KEY_j), state: ctrl, want: true},
+		{name: "lock modifiers ignored", keyval: uint(gdk.KEY_j), state: ctrl | gdk.LockMaskValue, want: true},
+		{name: "shifted letter", keyval: uint(gdk.KEY_K), state: ctrl | shift, want: true},
+		{name: "plain letter left for typing", keyval: uint(gdk.KEY_j), state: 0, want: false},
+		{name: "missing modifier", keyval: uint(gdk.KEY_k), state: ctrl, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := set.Contains(tt.keyval, tt.state); got != tt.want {
+				t.Fatalf("Contains(%d, %d) = %v, want %v", tt.keyval, tt.state, got, tt.want)
+			}
+		})
+	}
+
+	var empty KeySet
+	if empty.Contains(uint(gdk.KEY_j), ctrl) {
+		t.Fatal("an empty set should contain nothing")
+	}
+}
+
+func TestMapConfigAction_TabGroups(t *testing.T) {
+	tests := map[string]Action{
+		"group-tab":        ActionGroupTab,
+		"group_tab":        ActionGroupTab,
+		"toggle-tab-group": ActionToggleTabGroup,
+		"toggle_tab_group": ActionToggleTabGroup,
+		"ungroup-tab":      ActionUngroupTab,
+		"ungroup_tab":      ActionUngroupTab,
+	}
+	for name, want := range tests {
+		if got := mapConfigAction(name); got != want {
+			t.Fatalf("mapConfigAction(%s) = %q, want %q", name, got, want)
+		}
+		if !ShouldAutoExitMode(want) {
+			t.Fatalf("ShouldAutoExitMode(%q) = false, want true", want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package input
+
+import "github.com/bnema/dumber/internal/domain/entity"
+
+const (
+	// stackSwipeThreshold is the accumulated touchpad delta (surface pixels)
+	// a swipe needs before it cycles the stack.
+	stackSwipeThreshold = 48.0
+
+	stackDirectionUp   = "up"
+	stackDirectionDown = "down"
+)
+
+// StackScroll describes one vertical scroll event over a stacked container.
+type StackScroll struct {
+	DeltaY float64
+	// Precise is true for touchpad deltas and false for mouse wheel notches.
+	Precise    bool
+	OnTitleBar bool
+	AltHeld    bool
+}
+
+// StackSwipe maps vertical scrolling over a stack to NavigateStack directions.
+// A swipe cycles the stack at most once; End must be called when the scroll
+// gesture finishes to arm the next one.
+type StackSwipe struct {
+	mode        entity.StackSwipeMode
+	accumulated float64
+	fired       bool
+}
+
+// NewStackSwipe creates a swipe tracker for the given mode. Unknown modes
+// behave like entity.StackSwipeTitleBar.
+func NewStackSwipe(mode entity.StackSwipeMode) *StackSwipe {
+	switch mode {
+	case entity.StackSwipeAlt, entity.StackSwipeOff:
+	default:
+		mode = entity.StackSwipeTitleBar
+	}
+	return &StackSwipe{mode: mode}
+}
+
+// Accepts reports whether a scroll with the given origin may drive stack
+// navigation instead of scrolling the page.
+func (s *StackSwipe) Accepts(event StackScroll) bool {
+	if s == nil {
+		return false
+	}
+	switch s.mode {
+	case entity.StackSwipeTitleBar:
+		return event.OnTitleBar
+	case entity.StackSwipeAlt:
+		return event.AltHeld
+	default:
+		return false
+	}
+}
+
+// Scroll feeds a scroll event into the tracker. It returns the direction to
+// pass to NavigateStack once the swipe crosses the threshold, and whether the
+// event was consumed. Rejected events return handled=false so the page keeps
+// scrolling.
+func (s *StackSwipe) Scroll(event StackScroll) (direction string, handled bool) {
+	if !s.Accepts(event) {
+		return "", false
+	}
+	if event.DeltaY == 0 {
+		return "", true
+	}
+	if !event.Precise {
+		// Each wheel notch is a deliberate step.
+		return stackDirection(event.DeltaY), true
+	}
+	if s.fired {
+		return "", true
+	}
+	if (s.accumulated > 0) != (event.DeltaY > 0) {
+		s.accumulated = 0
+	}
+	s.accumulated += event.DeltaY
+	if s.accumulated > -stackSwipeThreshold && s.accumulated < stackSwipeThreshold {
+		return "", true
+	}
+	s.fired = true
+	direction = stackDirection(s.accumulated)
+	s.accumulated = 0
+	return direction, true
+}
+
+// End resets the tracker when the scroll gesture finishes.
+func (s *StackSwipe) End() {
+	if s == nil {
+		return
+	}
+	s.accumulated = 0
+	s.fired = false
+}
+
+func stackDirection(deltaY float64) string {
+	if deltaY < 0 {
+		return stackDirectionUp
+	}
+	return stackDirectionDown
+}
@@ -0,0 +1,84 @@
+package input
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackSwipe_AcceptsByMode(t *testing.T) {
+	tests := []struct {
+		name  string
+		mode  entity.StackSwipeMode
+		event StackScroll
+		want  bool
+	}{
+		{name: "title bar mode on title bar", mode: entity.StackSwipeTitleBar, event: StackScroll{OnTitleBar: true}, want: true},
+		{name: "title bar mode over page", mode: entity.StackSwipeTitleBar, event: StackScroll{AltHeld: true}, want: false},
+		{name: "alt mode with alt", mode: entity.StackSwipeAlt, event: StackScroll{AltHeld: true}, want: true},
+		{name: "alt mode without alt", mode: entity.StackSwipeAlt, event: StackScroll{OnTitleBar: true}, want: false},
+		{name: "off", mode: entity.StackSwipeOff, event: StackScroll{OnTitleBar: true, AltHeld: true}, want: false},
+		{name: "unknown mode falls back to title bar", mode: "sideways", event: StackScroll{OnTitleBar: true}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewStackSwipe(tt.mode).Accepts(tt.event))
+		})
+	}
+}
+
+func TestStackSwipe_TouchpadFiresOncePerGesture(t *testing.T) {
+	s := NewStackSwipe(entity.StackSwipeTitleBar)
+	step := StackScroll{DeltaY: 20, Precise: true, OnTitleBar: true}
+
+	direction, handled := s.Scroll(step)
+	assert.Empty(t, direction)
+	assert.True(t, handled)
+	direction, _ = s.Scroll(step)
+	assert.Empty(t, direction)
+	direction, _ = s.Scroll(step)
+	assert.Equal(t, "down", direction)
+
+	// Further movement in the same gesture is consumed without cycling again.
+	direction, handled = s.Scroll(StackScroll{DeltaY: 100, Precise: true, OnTitleBar: true})
+	assert.Empty(t, direction)
+	assert.True(t, handled)
+
+	s.End()
+	direction, _ = s.Scroll(StackScroll{DeltaY: -60, Precise: true, OnTitleBar: true})
+	assert.Equal(t, "up", direction)
+}
+
+func TestStackSwipe_DirectionReversalRestartsAccumulation(t *testing.T) {
+	s := NewStackSwipe(entity.StackSwipeAlt)
+
+	direction, _ := s.Scroll(StackScroll{DeltaY: 40, Precise: true, AltHeld: true})
+	assert.Empty(t, direction)
+	direction, _ = s.Scroll(StackScroll{DeltaY: -40, Precise: true, AltHeld: true})
+	assert.Empty(t, direction)
+	direction, _ = s.Scroll(StackScroll{DeltaY: -10, Precise: true, AltHeld: true})
+	assert.Equal(t, "up", direction)
+}
+
+func TestStackSwipe_WheelNotchesStepImmediately(t *testing.T) {
+	s := NewStackSwipe(entity.StackSwipeTitleBar)
+
+	direction, handled := s.Scroll(StackScroll{DeltaY: 1, OnTitleBar: true})
+	assert.Equal(t, "down", direction)
+	assert.True(t, handled)
+	direction, _ = s.Scroll(StackScroll{DeltaY: -1, OnTitleBar: true})
+	assert.Equal(t, "up", direction)
+}
+
+func TestStackSwipe_RejectedScrollIsNotConsumed(t *testing.T) {
+	s := NewStackSwipe(entity.StackSwipeTitleBar)
+
+	direction, handled := s.Scroll(StackScroll{DeltaY: 200, Precise: true})
+	assert.Empty(t, direction)
+	assert.False(t, handled)
+
+	// Rejected deltas must not count towards the next accepted swipe.
+	direction, _ = s.Scroll(StackScroll{DeltaY: 10, Precise: true, OnTitleBar: true})
+	assert.Empty(t, direction)
+}
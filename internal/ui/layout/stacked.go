@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/puregotk/v4/gdk"
 	"github.com/bnema/puregotk/v4/gobject"
 	"github.com/bnema/puregotk/v4/gtk"
 )
@@ -40,6 +41,26 @@ type stackedPane struct {
 
 	// Retained callback for GestureClick to prevent GC
 	titleClickCallback any
+	// Retained callbacks for the title bar scroll controller
+	titleScrollCallbacks *stackScrollCallbacks
+}
+
+// StackScrollEvent describes a vertical scroll over a stacked container.
+type StackScrollEvent struct {
+	DeltaY float64
+	// Precise is true for touchpad deltas and false for mouse wheel notches.
+	Precise bool
+	// OnTitleBar is true when the scroll started over one of the title bars.
+	OnTitleBar bool
+	AltHeld    bool
+	// End marks the end of a touchpad scroll gesture; DeltaY is zero.
+	End bool
+}
+
+// stackScrollCallbacks retains scroll controller callbacks to prevent GC.
+type stackScrollCallbacks struct {
+	scroll    func(gtk.EventControllerScroll, float64, float64) bool
+	scrollEnd func(gtk.EventControllerScroll)
 }
 
 // StackedView manages a stack of panes where only one is visible at a time.
@@ -52,6 +73,11 @@ type StackedView struct {
 
 	onActivate  func(index int)     // called when a pane is activated via title bar click
 	onClosePane func(paneID string) // called when a pane's close button is clicked
+	// onScroll receives vertical scrolling over the stack and reports whether
+	// it consumed the event.
+	onScroll func(event StackScrollEvent) bool
+
+	boxScrollCallbacks *stackScrollCallbacks
 
 	mu sync.RWMutex
 }
@@ -143,16 +169,17 @@ func (sv *StackedView) AddPane(ctx context.Context, paneID, title, faviconIconNa
 	titleClickCb, closeSignalID := sv.connectTitleBarHandlers(tb, paneID)
 
 	pane := &stackedPane{
-		paneID:             paneID,
-		titleBar:           tb.titleBar,
-		container:          container,
-		title:              title,
-		favicon:            tb.favicon,
-		label:              tb.label,
-		isActive:           false,
-		closeClickSignalID: closeSignalID,
-		closeButton:        tb.closeBtn,
-		titleClickCallback: titleClickCb,
+		paneID:               paneID,
+		titleBar:             tb.titleBar,
+		container:            container,
+		title:                title,
+		favicon:              tb.favicon,
+		label:                tb.label,
+		isActive:             false,
+		closeClickSignalID:   closeSignalID,
+		closeButton:          tb.closeBtn,
+		titleClickCallback:   titleClickCb,
+		titleScrollCallbacks: sv.connectScroll(tb.titleBar, true, gtk.PhaseBubbleValue),
 	}
 
 	index := len(sv.panes)
@@ -252,6 +279,7 @@ func (sv *StackedView) disconnectPaneSignals(pane *stackedPane) {
 	// Clear retained callback reference to allow GC
 	// The GestureClick controller is owned by the widget and will be cleaned up when the widget is destroyed
 	pane.titleClickCallback = nil
+	pane.titleScrollCallbacks = nil
 
 	// Disconnect close button click signal
 	disconnectButtonSignal(pane.closeButton, pane.closeClickSignalID)
@@ -312,16 +340,17 @@ func (sv *StackedView) InsertPaneAfter(
 	titleClickCb, closeSignalID := sv.connectTitleBarHandlers(tb, paneID)
 
 	pane := &stackedPane{
-		paneID:             paneID,
-		titleBar:           tb.titleBar,
-		container:          container,
-		title:              title,
-		favicon:            tb.favicon,
-		label:              tb.label,
-		isActive:           false,
-		closeClickSignalID: closeSignalID,
-		closeButton:        tb.closeBtn,
-		titleClickCallback: titleClickCb,
+		paneID:               paneID,
+		titleBar:             tb.titleBar,
+		container:            container,
+		title:                title,
+		favicon:              tb.favicon,
+		label:                tb.label,
+		isActive:             false,
+		closeClickSignalID:   closeSignalID,
+		closeButton:          tb.closeBtn,
+		titleClickCallback:   titleClickCb,
+		titleScrollCallbacks: sv.connectScroll(tb.titleBar, true, gtk.PhaseBubbleValue),
 	}
 
 	// Insert into slice at correct position
@@ -552,6 +581,64 @@ func (sv *StackedView) SetOnActivate(fn func(index int)) {
 	sv.onActivate = fn
 }
 
+// SetOnScroll sets the callback for vertical scrolling over the stack. Title
+// bars always report scrolling; the first call also installs a capture-phase
+// controller on the whole stack so modifier swipes over page content are seen
+// before the page scrolls.
+func (sv *StackedView) SetOnScroll(fn func(event StackScrollEvent) bool) {
+	sv.mu.Lock()
+	sv.onScroll = fn
+	attach := fn != nil && sv.boxScrollCallbacks == nil
+	sv.mu.Unlock()
+
+	if attach {
+		callbacks := sv.connectScroll(sv.box, false, gtk.PhaseCaptureValue)
+		sv.mu.Lock()
+		sv.boxScrollCallbacks = callbacks
+		sv.mu.Unlock()
+	}
+}
+
+// connectScroll attaches a vertical scroll controller to widget that forwards
+// events to onScroll.
+func (sv *StackedView) connectScroll(
+	widget Widget, onTitleBar bool, phase gtk.PropagationPhase,
+) *stackScrollCallbacks {
+	ctrl := gtk.NewEventControllerScroll(gtk.EventControllerScrollVerticalValue)
+	if ctrl == nil {
+		return nil
+	}
+	ctrl.SetPropagationPhase(phase)
+
+	callbacks := &stackScrollCallbacks{}
+	callbacks.scroll = func(c gtk.EventControllerScroll, _ float64, dy float64) bool {
+		sv.mu.RLock()
+		onScroll := sv.onScroll
+		sv.mu.RUnlock()
+		if onScroll == nil {
+			return false
+		}
+		return onScroll(StackScrollEvent{
+			DeltaY:     dy,
+			Precise:    c.GetUnit() == gdk.ScrollUnitSurfaceValue,
+			OnTitleBar: onTitleBar,
+			AltHeld:    c.GetCurrentEventState()&gdk.AltMaskValue != 0,
+		})
+	}
+	callbacks.scrollEnd = func(gtk.EventControllerScroll) {
+		sv.mu.RLock()
+		onScroll := sv.onScroll
+		sv.mu.RUnlock()
+		if onScroll != nil {
+			onScroll(StackScrollEvent{OnTitleBar: onTitleBar, End: true})
+		}
+	}
+	ctrl.ConnectScroll(&callbacks.scroll)
+	ctrl.ConnectScrollEnd(&callbacks.scrollEnd)
+	widget.AddController(&ctrl.EventController)
+	return callbacks
+}
+
 // SetOnClosePane sets the callback for when a pane's close button is clicked.
 func (sv *StackedView) SetOnClosePane(fn func(paneID string)) {
 	sv.mu.Lock()
@@ -18,6 +18,10 @@ type Dependencies struct {
 	Favorites   port.SystemviewFavoritesService
 	History     port.SystemviewHistoryService
 	Config      port.SystemviewConfigService
+	Homepage    port.SystemviewHomepageService
+	Console     port.SystemviewConsoleService
+	NavTree     port.SystemviewNavigationTreeService
+	Notes       port.SystemviewNotesService
 	LocationURI string
 }
 
@@ -35,6 +39,8 @@ type App struct {
 	historyDomainStats     []*entity.DomainStat
 	historyQuery           string
 	historyDomainFilter    string
+	historyGroupBy         string
+	historyGroups          []*entity.HistoryGroup
 	historyOffset          int
 	historyWindowBefore    time.Time
 	historyWindowAfter     time.Time
@@ -52,6 +58,15 @@ type App struct {
 	keybindings            port.KeybindingsConfig
 	configNotice           string
 	configError            string
+	consoleLogs            []dto.ConsoleLog
+	consoleLevel           string
+	consoleNotice          string
+	consoleError           string
+	notes                  []*entity.Note
+	notesFocusID           int64
+	notesNotice            string
+	notesError             string
+	notesAutosave          *noteAutosaver
 	renderedHTML           string
 	renderGeneration       uint64
 	closed                 bool
@@ -66,6 +81,11 @@ type App struct {
 const (
 	historyTimelineLimit = 0
 	historySearchLimit   = 100
+
+	historyDayGroupLimit      = 30
+	historyDayGroupEntries    = 50
+	historyDomainGroupLimit   = 50
+	historyDomainGroupEntries = 5
 )
 
 func (a *App) lockAction() {
@@ -87,7 +107,10 @@ func (a *App) unlockState() {
 }
 
 func NewApp(deps Dependencies) *App {
-	return &App{deps: deps, currentRoute: RouteUnknown}
+	a := &App{deps: deps, currentRoute: RouteUnknown}
+	a.notesAutosave = newNoteAutosaver(noteAutosaveDelay, a.saveNote)
+	a.notesAutosave.onError = a.enqueueActionError
+	return a
 }
 
 func (a *App) Run() error {
@@ -213,6 +236,9 @@ func (a *App) bindDOMActions(ctx context.Context, binder DOMActionBinder) error
 	a.unlockAction()
 
 	if err := binder.BindActions(func(action DOMAction) {
+		if a.handleNoteTyping(action) {
+			return
+		}
 		if !a.enqueueDOMAction(action) && a.actionWorkerActive() {
 			a.enqueueActionError(fmt.Errorf("systemview is busy; dropped action %q", action.Action))
 		}
@@ -458,6 +484,14 @@ func (a *App) loadCurrentRoute(ctx context.Context) error {
 		return a.loadFavoritesRoute(ctx)
 	case RouteConfig:
 		return a.loadConfigRoute(ctx)
+	case RouteHomepage:
+		return a.loadHomepageRoute(ctx)
+	case RouteConsole:
+		return a.loadConsoleRoute(ctx)
+	case RouteNavTree:
+		return a.loadNavTreeRoute(ctx)
+	case RouteNotes:
+		return a.loadNotesRoute(ctx)
 	default:
 		a.resetRouteState()
 		a.renderedHTML = renderAppFrame(renderedPage{
@@ -492,6 +526,14 @@ func routeDocumentTitle(route Route) string {
 		return "Favorites"
 	case RouteConfig:
 		return "Config"
+	case RouteHomepage:
+		return "Home"
+	case RouteConsole:
+		return "Console"
+	case RouteNavTree:
+		return "Navigation tree"
+	case RouteNotes:
+		return "Notes"
 	default:
 		return "Dumber System View"
 	}
@@ -505,6 +547,14 @@ func routeSubtitle(route Route) string {
 		return "Saved bookmarks"
 	case RouteConfig:
 		return "Browser settings"
+	case RouteHomepage:
+		return "Home"
+	case RouteConsole:
+		return "Page console output"
+	case RouteNavTree:
+		return "Where each pane has been"
+	case RouteNotes:
+		return "Quick notes"
 	default:
 		return string(route)
 	}
@@ -517,11 +567,13 @@ func (a *App) loadHistoryLoadingRoute() {
 	a.historyStats = nil
 	a.historyAnalytics = nil
 	a.historyDomainStats = nil
+	a.historyGroups = nil
 	a.historyNotice = ""
 	a.historyError = ""
 	data := historyRenderData{
 		Query:        a.historyQuery,
 		DomainFilter: a.historyDomainFilter,
+		GroupBy:      a.historyGroupBy,
 		Offset:       a.historyOffset,
 		Limit:        historyTimelineLimit,
 		WindowBefore: a.historyWindowBefore,
@@ -567,6 +619,7 @@ type historyRouteSnapshot struct {
 	shellTheme   shellTheme
 	query        string
 	domainFilter string
+	groupBy      string
 	offset       int
 	windowBefore time.Time
 	windowAfter  time.Time
@@ -579,6 +632,7 @@ type historyRouteResult struct {
 	entries      []*entity.HistoryEntry
 	stats        *entity.HistoryStats
 	domains      []*entity.DomainStat
+	groups       []*entity.HistoryGroup
 	windowBefore time.Time
 	windowAfter  time.Time
 	cursorID     int64
@@ -593,6 +647,7 @@ func (a *App) currentHistoryRouteSnapshotLocked() historyRouteSnapshot {
 		shellTheme:   a.shellTheme,
 		query:        a.historyQuery,
 		domainFilter: a.historyDomainFilter,
+		groupBy:      a.historyGroupBy,
 		offset:       a.historyOffset,
 		windowBefore: a.historyWindowBefore,
 		windowAfter:  a.historyWindowAfter,
@@ -614,7 +669,17 @@ func (a *App) renderHistoryRouteSnapshot(ctx context.Context, snapshot historyRo
 		}, nil
 	}
 
-	window, entries, err := loadHistoryWindowSnapshot(ctx, snapshot)
+	var (
+		window  *entity.HistoryWindow
+		entries []*entity.HistoryEntry
+		groups  []*entity.HistoryGroup
+		err     error
+	)
+	if normalizeHistoryGroupMode(snapshot.groupBy) == historyGroupTimeline {
+		window, entries, err = loadHistoryWindowSnapshot(ctx, snapshot)
+	} else {
+		groups, err = loadHistoryGroupsSnapshot(ctx, snapshot)
+	}
 	if err != nil {
 		return historyRouteResult{}, err
 	}
@@ -630,6 +695,7 @@ func (a *App) renderHistoryRouteSnapshot(ctx context.Context, snapshot historyRo
 		entries:      entries,
 		stats:        stats,
 		domains:      domains,
+		groups:       groups,
 		windowBefore: snapshot.windowBefore,
 		windowAfter:  snapshot.windowAfter,
 	}
@@ -644,6 +710,8 @@ func (a *App) renderHistoryRouteSnapshot(ctx context.Context, snapshot historyRo
 		Domains:      domains,
 		Query:        snapshot.query,
 		DomainFilter: snapshot.domainFilter,
+		GroupBy:      snapshot.groupBy,
+		Groups:       groups,
 		Offset:       snapshot.offset,
 		Limit:        historyTimelineLimit,
 		WindowBefore: result.windowBefore,
@@ -694,6 +762,13 @@ func loadHistoryWindowSnapshot(ctx context.Context, snapshot historyRouteSnapsho
 	return window, window.Entries, nil
 }
 
+func loadHistoryGroupsSnapshot(ctx context.Context, snapshot historyRouteSnapshot) ([]*entity.HistoryGroup, error) {
+	if snapshot.groupBy == historyGroupDomain {
+		return snapshot.history.GroupByDomain(ctx, historyDomainGroupLimit, historyDomainGroupEntries)
+	}
+	return snapshot.history.GroupByDay(ctx, historyDayGroupLimit, historyDayGroupEntries)
+}
+
 func (a *App) commitHistoryRouteResultLocked(result historyRouteResult) {
 	a.favorites = nil
 	a.tags = nil
@@ -701,6 +776,7 @@ func (a *App) commitHistoryRouteResultLocked(result historyRouteResult) {
 	a.historyStats = result.stats
 	a.historyAnalytics = nil
 	a.historyDomainStats = result.domains
+	a.historyGroups = result.groups
 	a.historyWindowBefore = result.windowBefore
 	a.historyWindowAfter = result.windowAfter
 	a.historyCursorID = result.cursorID
@@ -790,6 +866,136 @@ func (a *App) loadConfigRoute(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) loadHomepageRoute(ctx context.Context) error {
+	if a.deps.Homepage == nil {
+		a.resetRouteState()
+		a.renderedHTML = renderAppFrame(renderedPage{
+			route:    a.currentRoute,
+			title:    routeDocumentTitle(RouteHomepage),
+			subtitle: routeSubtitle(RouteHomepage),
+			body:     placeholderHTML(a.currentRoute),
+		}, a.shellTheme)
+		return nil
+	}
+
+	dashboard, err := a.deps.Homepage.Dashboard(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.historyEntries = nil
+	a.favorites = nil
+	a.tags = nil
+	a.renderedHTML = renderAppFrame(renderedPage{
+		route:    RouteHomepage,
+		title:    "Home — Dumber",
+		subtitle: routeSubtitle(RouteHomepage),
+		body:     homepageHTML(homepageRenderData{Dashboard: dashboard}),
+	}, a.shellTheme)
+	return nil
+}
+
+func (a *App) loadConsoleRoute(ctx context.Context) error {
+	if a.deps.Console == nil {
+		a.resetRouteState()
+		a.renderedHTML = renderAppFrame(renderedPage{
+			route:    a.currentRoute,
+			title:    routeDocumentTitle(RouteConsole),
+			subtitle: routeSubtitle(RouteConsole),
+			body:     placeholderHTML(a.currentRoute),
+		}, a.shellTheme)
+		return nil
+	}
+
+	logs, err := a.deps.Console.ConsoleLogs(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.historyEntries = nil
+	a.favorites = nil
+	a.tags = nil
+	a.consoleLogs = filterConsoleLogs(logs, a.consoleLevel)
+	a.renderedHTML = renderAppFrame(renderedPage{
+		route:    RouteConsole,
+		title:    "Console — Dumber",
+		subtitle: routeSubtitle(RouteConsole),
+		body: consoleHTML(consoleRenderData{
+			Logs:   a.consoleLogs,
+			Level:  a.consoleLevel,
+			Notice: a.consoleNotice,
+			Error:  a.consoleError,
+		}),
+	}, a.shellTheme)
+	return nil
+}
+
+func (a *App) loadNavTreeRoute(ctx context.Context) error {
+	if a.deps.NavTree == nil {
+		a.resetRouteState()
+		a.renderedHTML = renderAppFrame(renderedPage{
+			route:    a.currentRoute,
+			title:    routeDocumentTitle(RouteNavTree),
+			subtitle: routeSubtitle(RouteNavTree),
+			body:     placeholderHTML(a.currentRoute),
+		}, a.shellTheme)
+		return nil
+	}
+
+	trees, err := a.deps.NavTree.NavigationTrees(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.historyEntries = nil
+	a.favorites = nil
+	a.tags = nil
+	a.renderedHTML = renderAppFrame(renderedPage{
+		route:    RouteNavTree,
+		title:    "Navigation tree — Dumber",
+		subtitle: routeSubtitle(RouteNavTree),
+		body:     navTreeHTML(buildNavTreeRenderData(trees)),
+	}, a.shellTheme)
+	return nil
+}
+
+func (a *App) loadNotesRoute(ctx context.Context) error {
+	if a.deps.Notes == nil {
+		a.resetRouteState()
+		a.renderedHTML = renderAppFrame(renderedPage{
+			route:    a.currentRoute,
+			title:    routeDocumentTitle(RouteNotes),
+			subtitle: routeSubtitle(RouteNotes),
+			body:     placeholderHTML(a.currentRoute),
+		}, a.shellTheme)
+		return nil
+	}
+
+	notes, err := a.deps.Notes.Notes(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.historyEntries = nil
+	a.favorites = nil
+	a.tags = nil
+	a.notes = notes
+	a.renderedHTML = renderAppFrame(renderedPage{
+		route:    RouteNotes,
+		title:    "Notes — Dumber",
+		subtitle: routeSubtitle(RouteNotes),
+		body: notesHTML(notesRenderData{
+			Notes:   a.notes,
+			FocusID: a.notesFocusID,
+			Notice:  a.notesNotice,
+			Error:   a.notesError,
+		}),
+	}, a.shellTheme)
+	// Focus the new note once, not on every later remount.
+	a.notesFocusID = 0
+	return nil
+}
+
 func (a *App) loadShellTheme(ctx context.Context) {
 	if a == nil {
 		return
@@ -817,6 +1023,8 @@ func (a *App) resetRouteState() {
 	a.historyDomainStats = nil
 	a.historyQuery = ""
 	a.historyDomainFilter = ""
+	a.historyGroupBy = ""
+	a.historyGroups = nil
 	a.historyOffset = 0
 	a.historyWindowBefore = time.Time{}
 	a.historyWindowAfter = time.Time{}
@@ -834,6 +1042,14 @@ func (a *App) resetRouteState() {
 	a.keybindings = port.KeybindingsConfig{}
 	a.configNotice = ""
 	a.configError = ""
+	a.consoleLogs = nil
+	a.consoleLevel = ""
+	a.consoleNotice = ""
+	a.consoleError = ""
+	a.notes = nil
+	a.notesFocusID = 0
+	a.notesNotice = ""
+	a.notesError = ""
 }
 
 func (a *App) CurrentRoute() Route {
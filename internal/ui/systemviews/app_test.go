@@ -3,6 +3,8 @@ package systemviews
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -33,6 +35,11 @@ func TestParseRoute(t *testing.T) {
 		{name: "favorites opaque", uri: "dumb:favorites", want: RouteFavorites},
 		{name: "config host", uri: "dumb://config", want: RouteConfig},
 		{name: "config opaque", uri: "dumb:config", want: RouteConfig},
+		{name: "homepage host", uri: "dumb://homepage", want: RouteHomepage},
+		{name: "homepage opaque", uri: "dumb:homepage", want: RouteHomepage},
+		{name: "console host", uri: "dumb://console", want: RouteConsole},
+		{name: "navtree host", uri: "dumb://navtree", want: RouteNavTree},
+		{name: "notes host", uri: "dumb://notes", want: RouteNotes},
 	}
 
 	for _, tt := range tests {
@@ -624,6 +631,89 @@ func TestAppHandleFavoriteActionsRefreshesDOM(t *testing.T) {
 	assert.Contains(t, dom.HTML(), "Deleted favorite")
 }
 
+func TestAppHandleConsoleActionsRefreshesDOM(t *testing.T) {
+	dom := &recordingDOM{}
+	console := &recordingConsoleService{logs: []dto.ConsoleLog{{
+		WebViewID: 3,
+		URL:       "https://example.com",
+		Messages: []entity.ConsoleMessage{
+			{Level: entity.ConsoleLevelInfo, Message: "booted"},
+			{Level: entity.ConsoleLevelError, Message: "request failed"},
+		},
+	}}}
+	app := NewApp(Dependencies{DOM: dom, Console: console, LocationURI: "dumb://console"})
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{
+		Action: consoleActionLevel,
+		Data:   map[string]string{"level": "error"},
+	}))
+	assert.Contains(t, dom.HTML(), "request failed")
+	assert.NotContains(t, dom.HTML(), "booted")
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{
+		Action: consoleActionClear,
+		Data:   map[string]string{"webviewId": "3"},
+	}))
+	assert.Equal(t, []uint64{3}, console.cleared)
+	assert.Contains(t, dom.HTML(), "Console output of pane 3 cleared")
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{
+		Action: consoleActionClear,
+		Data:   map[string]string{"webviewId": "pane"},
+	}))
+	assert.Equal(t, []uint64{3}, console.cleared)
+	assert.Contains(t, dom.HTML(), "invalid webview id")
+}
+
+func TestAppNavTreeRefreshReloadsTrees(t *testing.T) {
+	dom := &recordingDOM{}
+	navTree := &recordingNavTreeService{}
+	app := NewApp(Dependencies{DOM: dom, NavTree: navTree, LocationURI: "dumb://navtree"})
+
+	require.NoError(t, app.LoadInitial(context.Background()))
+	assert.Contains(t, app.renderedHTML, "No navigation recorded yet")
+
+	navTree.trees = []dto.NavigationTree{{
+		PaneID:  "pane-1",
+		Current: 1,
+		Nodes:   []entity.NavigationNode{{ID: 1, URL: "https://example.com/", Title: "Example", Visits: 1}},
+	}}
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{Action: "navtree.refresh"}))
+	assert.Contains(t, dom.HTML(), "Example")
+	assert.Equal(t, 2, navTree.calls)
+}
+
+func TestAppNotesActionsSavePendingEditsBeforeRemount(t *testing.T) {
+	dom := &recordingDOM{}
+	notes := &recordingNotesService{notes: []*entity.Note{{ID: 1, Body: "groceries\nmilk"}}}
+	app := NewApp(Dependencies{DOM: dom, Notes: notes, LocationURI: "dumb://notes"})
+	app.notesAutosave.afterFunc = func(time.Duration, func()) func() bool { return func() bool { return true } }
+
+	require.NoError(t, app.LoadInitial(context.Background()))
+	assert.Contains(t, app.renderedHTML, "groceries")
+
+	assert.True(t, app.handleNoteTyping(DOMAction{Action: notesActionEdit, Data: map[string]string{"id": "1", "body": "groceries\neggs"}}))
+	assert.Empty(t, notes.saved, "typing only schedules a save")
+	assert.Empty(t, dom.HTML(), "typing does not remount")
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{Action: notesActionCreate}))
+	assert.Equal(t, []string{"groceries\neggs"}, notes.saved)
+	assert.Contains(t, dom.HTML(), "eggs")
+	assert.Contains(t, dom.HTML(), `data-id="2" data-sv-autofocus`)
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{Action: notesActionRefresh}))
+	assert.NotContains(t, dom.HTML(), "data-sv-autofocus", "only the first mount focuses the new note")
+
+	app.handleNoteTyping(DOMAction{Action: notesActionEdit, Data: map[string]string{"id": "2", "body": "lost"}})
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{Action: notesActionDelete, Data: map[string]string{"id": "2"}}))
+	assert.Equal(t, []int64{2}, notes.deleted)
+	assert.Equal(t, []string{"groceries\neggs"}, notes.saved, "a deleted note's pending edit is dropped")
+	assert.Contains(t, dom.HTML(), "Note deleted")
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{Action: notesActionDelete, Data: map[string]string{"id": "x"}}))
+	assert.Contains(t, dom.HTML(), "invalid note id")
+}
+
 func TestAppLoadInitialConfigRouteRendersData(t *testing.T) {
 	t.Parallel()
 
@@ -1079,6 +1169,9 @@ type recordingHistoryService struct {
 	deletedRangeID  string
 	deletedDomain   string
 	domainStats     []*entity.DomainStat
+	domainGroups    []*entity.HistoryGroup
+	dayGroups       []*entity.HistoryGroup
+	groupCalls      []string
 	stats           *entity.HistoryStats
 	statsCalled     bool
 	window          *entity.HistoryWindow
@@ -1167,6 +1260,16 @@ func (s *recordingHistoryService) DomainStats(context.Context, int) ([]*entity.D
 	return s.domainStats, nil
 }
 
+func (s *recordingHistoryService) GroupByDomain(context.Context, int, int) ([]*entity.HistoryGroup, error) {
+	s.groupCalls = append(s.groupCalls, "domain")
+	return s.domainGroups, s.err
+}
+
+func (s *recordingHistoryService) GroupByDay(context.Context, int, int) ([]*entity.HistoryGroup, error) {
+	s.groupCalls = append(s.groupCalls, "day")
+	return s.dayGroups, s.err
+}
+
 func (s *recordingHistoryService) DeleteDomain(_ context.Context, domain string) error {
 	s.deletedDomain = domain
 	return nil
@@ -1416,6 +1519,70 @@ func TestHandleHistorySearchClearsDomainFilter(t *testing.T) {
 	assert.False(t, history.domainCalled)
 }
 
+func TestHandleHistoryGroupByRendersGroupsAndRefreshesAfterDelete(t *testing.T) {
+	dom := &recordingDOM{}
+	history := &recordingHistoryService{
+		domainGroups: []*entity.HistoryGroup{{
+			Key:        "example.com",
+			EntryCount: 3,
+			VisitCount: 7,
+			Entries:    []*entity.HistoryEntry{{ID: 42, URL: "https://example.com/a", Title: "Example A"}},
+		}},
+	}
+	app := NewApp(Dependencies{DOM: dom, History: history, LocationURI: "dumb://history"})
+	app.historyQuery = "stale"
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{
+		Action: historyActionGroupBy,
+		Data:   map[string]string{"group": historyGroupDomain},
+	}))
+
+	assert.Equal(t, historyGroupDomain, app.historyGroupBy)
+	assert.Empty(t, app.historyQuery)
+	assert.Equal(t, []string{"domain"}, history.groupCalls)
+	assert.False(t, history.called)
+	assert.Contains(t, dom.HTML(), `data-sv-history-group="example.com"`)
+	assert.Contains(t, dom.HTML(), "3 pages · 7 visits")
+	assert.Contains(t, dom.HTML(), "2 more not shown")
+	assert.Contains(t, dom.HTML(), "Example A")
+
+	history.domainGroups[0].EntryCount = 2
+	history.domainGroups[0].Entries = nil
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{
+		Action: historyActionDeleteEntry,
+		Data:   map[string]string{"id": "42"},
+	}))
+
+	assert.Equal(t, int64(42), history.deletedEntryID)
+	assert.Equal(t, []string{"domain", "domain"}, history.groupCalls)
+	assert.Contains(t, dom.HTML(), "2 pages · 7 visits")
+	assert.NotContains(t, dom.HTML(), "Example A")
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{
+		Action: historyActionSearch,
+		Data:   map[string]string{"query": "example"},
+	}))
+
+	assert.Empty(t, app.historyGroupBy)
+	assert.True(t, history.searchCalled)
+	assert.Len(t, history.groupCalls, 2)
+}
+
+func TestHandleHistoryGroupByRejectsUnknownMode(t *testing.T) {
+	dom := &recordingDOM{}
+	history := &recordingHistoryService{}
+	app := NewApp(Dependencies{DOM: dom, History: history, LocationURI: "dumb://history"})
+
+	require.NoError(t, app.HandleDOMAction(context.Background(), DOMAction{
+		Action: historyActionGroupBy,
+		Data:   map[string]string{"group": "week"},
+	}))
+
+	assert.Empty(t, app.historyGroupBy)
+	assert.Empty(t, history.groupCalls)
+	assert.Contains(t, dom.HTML(), "invalid history grouping")
+}
+
 func TestAppRunWithContextRejectsNilContext(t *testing.T) {
 	app := NewApp(Dependencies{DOM: &recordingDOM{}, LocationURI: "dumb://history"})
 
@@ -1462,3 +1629,60 @@ func TestSavePerformanceConfigRejectsOutOfRangeValues(t *testing.T) {
 	require.ErrorContains(t, err, "Skia CPU threads must be between 0 and 8")
 	assert.False(t, service.calledSave)
 }
+
+type recordingConsoleService struct {
+	logs    []dto.ConsoleLog
+	cleared []uint64
+}
+
+func (s *recordingConsoleService) ConsoleLogs(context.Context) ([]dto.ConsoleLog, error) {
+	return s.logs, nil
+}
+
+func (s *recordingConsoleService) ClearConsole(_ context.Context, webViewID uint64) error {
+	s.cleared = append(s.cleared, webViewID)
+	return nil
+}
+
+type recordingNavTreeService struct {
+	trees []dto.NavigationTree
+	calls int
+}
+
+func (s *recordingNavTreeService) NavigationTrees(context.Context) ([]dto.NavigationTree, error) {
+	s.calls++
+	return s.trees, nil
+}
+
+type recordingNotesService struct {
+	notes   []*entity.Note
+	saved   []string
+	deleted []int64
+}
+
+func (s *recordingNotesService) Notes(context.Context) ([]*entity.Note, error) {
+	return s.notes, nil
+}
+
+func (s *recordingNotesService) CreateNote(context.Context) (*entity.Note, error) {
+	note := &entity.Note{ID: int64(len(s.notes) + 1)}
+	s.notes = append([]*entity.Note{note}, s.notes...)
+	return note, nil
+}
+
+func (s *recordingNotesService) SaveNote(_ context.Context, id int64, body string) (*entity.Note, error) {
+	for _, note := range s.notes {
+		if note.ID == id {
+			note.Body = body
+			s.saved = append(s.saved, body)
+			return note, nil
+		}
+	}
+	return nil, fmt.Errorf("note %d not found", id)
+}
+
+func (s *recordingNotesService) DeleteNote(_ context.Context, id int64) error {
+	s.deleted = append(s.deleted, id)
+	s.notes = slices.DeleteFunc(s.notes, func(note *entity.Note) bool { return note.ID == id })
+	return nil
+}
@@ -0,0 +1,48 @@
+package systemviews
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	consoleActionRefresh = "console.refresh"
+	consoleActionClear   = "console.clear"
+	consoleActionLevel   = "console.level"
+)
+
+func (a *App) handleConsoleAction(ctx context.Context, event DOMAction) error {
+	if a.deps.Console == nil {
+		return fmt.Errorf("console service not configured")
+	}
+	data := event.Data
+	switch event.Action {
+	case consoleActionRefresh:
+		a.consoleNotice = ""
+	case consoleActionLevel:
+		a.consoleLevel = normalizeConsoleLevelFilter(data["level"])
+		a.consoleNotice = ""
+	case consoleActionClear:
+		var id uint64
+		if raw := strings.TrimSpace(firstActionValue(data, "webviewId", "webview_id")); raw != "" {
+			parsed, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid webview id %q", raw)
+			}
+			id = parsed
+		}
+		if err := a.deps.Console.ClearConsole(ctx, id); err != nil {
+			return err
+		}
+		if id == 0 {
+			a.consoleNotice = "Console output cleared"
+		} else {
+			a.consoleNotice = fmt.Sprintf("Console output of pane %d cleared", id)
+		}
+	default:
+		return fmt.Errorf("unknown console action: %q", event.Action)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package systemviews
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// consoleLevelAll is the level filter value that shows every message.
+const consoleLevelAll = "all"
+
+type consoleRenderData struct {
+	Logs   []dto.ConsoleLog
+	Level  string
+	Notice string
+	Error  string
+}
+
+type consoleLevelOption struct {
+	Level string
+	Label string
+}
+
+var consoleLevelOptions = []consoleLevelOption{
+	{Level: consoleLevelAll, Label: "All"},
+	{Level: string(entity.ConsoleLevelError), Label: "Errors"},
+	{Level: string(entity.ConsoleLevelWarn), Label: "Warnings"},
+	{Level: string(entity.ConsoleLevelInfo), Label: "Info"},
+	{Level: string(entity.ConsoleLevelDebug), Label: "Debug"},
+}
+
+func consoleHTML(data consoleRenderData) string {
+	return mustRenderComponent(ConsoleView(data))
+}
+
+// normalizeConsoleLevelFilter maps a filter value from the DOM to a known
+// level, falling back to showing everything.
+func normalizeConsoleLevelFilter(raw string) string {
+	if level, ok := entity.ParseConsoleLevel(raw); ok {
+		return string(level)
+	}
+	return consoleLevelAll
+}
+
+// filterConsoleLogs keeps the messages matching level and drops panes left
+// without any.
+func filterConsoleLogs(logs []dto.ConsoleLog, level string) []dto.ConsoleLog {
+	if level == "" || level == consoleLevelAll {
+		return logs
+	}
+	filtered := make([]dto.ConsoleLog, 0, len(logs))
+	for _, log := range logs {
+		messages := make([]entity.ConsoleMessage, 0, len(log.Messages))
+		for _, msg := range log.Messages {
+			if string(msg.Level) == level {
+				messages = append(messages, msg)
+			}
+		}
+		if len(messages) == 0 {
+			continue
+		}
+		log.Messages = messages
+		filtered = append(filtered, log)
+	}
+	return filtered
+}
+
+func consoleSummary(data consoleRenderData) string {
+	messages := 0
+	for _, log := range data.Logs {
+		messages += len(log.Messages)
+	}
+	return fmt.Sprintf("%d messages · %d panes", messages, len(data.Logs))
+}
+
+func consoleLevelButtonClass(data consoleRenderData, level string) string {
+	if normalizeConsoleLevelFilter(data.Level) == level {
+		return "sv-button"
+	}
+	return "sv-button sv-button-secondary"
+}
+
+func consoleLevelPressed(data consoleRenderData, level string) string {
+	if normalizeConsoleLevelFilter(data.Level) == level {
+		return "true"
+	}
+	return "false"
+}
+
+func consoleLogTitle(log dto.ConsoleLog) string {
+	if log.URL != "" {
+		return log.URL
+	}
+	return fmt.Sprintf("Pane %d", log.WebViewID)
+}
+
+func consoleLogMeta(log dto.ConsoleLog) string {
+	meta := fmt.Sprintf("Pane %d · %d messages", log.WebViewID, len(log.Messages))
+	if log.Dropped > 0 {
+		meta += fmt.Sprintf(" · %d older dropped", log.Dropped)
+	}
+	return meta
+}
+
+func consoleMessageClass(msg entity.ConsoleMessage) string {
+	return "sv-console-message sv-console-" + string(msg.Level)
+}
+
+func consoleMessageTime(msg entity.ConsoleMessage) string {
+	if msg.Timestamp.IsZero() {
+		return ""
+	}
+	return msg.Timestamp.Local().Format(time.TimeOnly)
+}
+
+func consoleMessageSource(msg entity.ConsoleMessage) string {
+	if msg.Source == "" {
+		return ""
+	}
+	if msg.Line > 0 {
+		return fmt.Sprintf("%s:%d", msg.Source, msg.Line)
+	}
+	return msg.Source
+}
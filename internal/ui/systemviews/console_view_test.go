@@ -0,0 +1,57 @@
+package systemviews
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeConsoleLevelFilter(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "error", normalizeConsoleLevelFilter("error"))
+	assert.Equal(t, "warn", normalizeConsoleLevelFilter("warning"))
+	assert.Equal(t, consoleLevelAll, normalizeConsoleLevelFilter("all"))
+	assert.Equal(t, consoleLevelAll, normalizeConsoleLevelFilter(""))
+}
+
+func TestFilterConsoleLogsDropsPanesWithoutMatches(t *testing.T) {
+	t.Parallel()
+
+	logs := []dto.ConsoleLog{
+		{WebViewID: 1, Messages: []entity.ConsoleMessage{
+			{Level: entity.ConsoleLevelInfo, Message: "a"},
+			{Level: entity.ConsoleLevelWarn, Message: "b"},
+		}},
+		{WebViewID: 2, Messages: []entity.ConsoleMessage{{Level: entity.ConsoleLevelInfo, Message: "c"}}},
+	}
+
+	filtered := filterConsoleLogs(logs, "warn")
+
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, uint64(1), filtered[0].WebViewID)
+	assert.Equal(t, []entity.ConsoleMessage{{Level: entity.ConsoleLevelWarn, Message: "b"}}, filtered[0].Messages)
+	assert.Len(t, logs[0].Messages, 2, "filtering must not modify the input")
+	assert.Equal(t, logs, filterConsoleLogs(logs, consoleLevelAll))
+}
+
+func TestConsoleHTMLRendersLevelAndTime(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 4, 24, 9, 30, 15, 0, time.Local)
+	html := consoleHTML(consoleRenderData{Logs: []dto.ConsoleLog{{
+		WebViewID: 4,
+		URL:       "https://example.com",
+		Dropped:   2,
+		Messages:  []entity.ConsoleMessage{{Level: entity.ConsoleLevelError, Message: "<boom>", Timestamp: ts, Source: "app.js", Line: 12}},
+	}}})
+
+	assert.Contains(t, html, "sv-console-error")
+	assert.Contains(t, html, "09:30:15")
+	assert.Contains(t, html, "&lt;boom&gt;")
+	assert.Contains(t, html, "app.js:12")
+	assert.Contains(t, html, "2 older dropped")
+}
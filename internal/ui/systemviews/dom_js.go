@@ -242,6 +242,11 @@ func (d *browserDOM) BindActions(handler DOMActionHandler) error {
 			return nil
 		}
 		target := event.Get("target")
+		if isNoteBody(target) {
+			// A note lost focus after being edited: save it without waiting.
+			handler(DOMAction{Action: notesActionFlush})
+			return nil
+		}
 		if !target.Truthy() || !target.Get("matches").Truthy() || !target.Call("matches", "[data-sv-performance-profile]").Bool() {
 			return nil
 		}
@@ -253,6 +258,23 @@ func (d *browserDOM) BindActions(handler DOMActionHandler) error {
 	})
 	d.addEventBinding(d.target, "change", changeHandler)
 
+	inputHandler := js.FuncOf(func(_ js.Value, args []js.Value) any {
+		event := firstJSArg(args)
+		if !event.Truthy() {
+			return nil
+		}
+		target := event.Get("target")
+		if !isNoteBody(target) {
+			return nil
+		}
+		handler(DOMAction{Action: notesActionEdit, Data: map[string]string{
+			"id":   target.Call("getAttribute", "data-id").String(),
+			"body": target.Get("value").String(),
+		}})
+		return nil
+	})
+	d.addEventBinding(d.target, "input", inputHandler)
+
 	keydownHandler := js.FuncOf(func(_ js.Value, args []js.Value) any {
 		event := firstJSArg(args)
 		if !d.eventTargetInsideMount(event) {
@@ -266,6 +288,10 @@ func (d *browserDOM) BindActions(handler DOMActionHandler) error {
 	return nil
 }
 
+func isNoteBody(target js.Value) bool {
+	return target.Truthy() && target.Get("matches").Truthy() && target.Call("matches", "[data-sv-note-body]").Bool()
+}
+
 func (d *browserDOM) eventTargetInsideMount(event js.Value) bool {
 	if d == nil || !d.target.Truthy() || !event.Truthy() {
 		return false
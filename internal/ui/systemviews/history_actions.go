@@ -21,6 +21,7 @@ const (
 	historyActionDeleteEntry  = "history.deleteEntry"
 	historyActionDeleteRange  = "history.deleteRange"
 	historyActionDeleteDomain = "history.deleteDomain"
+	historyActionGroupBy      = "history.groupBy"
 )
 
 // HandleDOMAction applies an action delegated from the browser DOM and refreshes
@@ -84,6 +85,38 @@ func (a *App) HandleDOMAction(ctx context.Context, event DOMAction) error {
 			return err
 		}
 		return a.mountRenderedHTML()
+	case RouteConsole:
+		a.consoleError = ""
+		if err := a.handleConsoleAction(ctx, event); err != nil {
+			a.consoleNotice = ""
+			a.consoleError = err.Error()
+		}
+		if err := a.loadConsoleRoute(ctx); err != nil {
+			a.renderRouteError(err)
+			_ = a.mountRenderedHTML()
+			return err
+		}
+		return a.mountRenderedHTML()
+	case RouteNotes:
+		a.notesError = ""
+		if err := a.handleNotesAction(ctx, event); err != nil {
+			a.notesNotice = ""
+			a.notesError = err.Error()
+		}
+		if err := a.loadNotesRoute(ctx); err != nil {
+			a.renderRouteError(err)
+			_ = a.mountRenderedHTML()
+			return err
+		}
+		return a.mountRenderedHTML()
+	case RouteNavTree:
+		// The only action is navtree.refresh.
+		if err := a.loadNavTreeRoute(ctx); err != nil {
+			a.renderRouteError(err)
+			_ = a.mountRenderedHTML()
+			return err
+		}
+		return a.mountRenderedHTML()
 	default:
 		return nil
 	}
@@ -100,11 +133,13 @@ func (a *App) handleHistoryAction(ctx context.Context, event DOMAction) error {
 	case historyActionSearch:
 		a.historyQuery = strings.TrimSpace(data["query"])
 		a.historyDomainFilter = ""
+		a.historyGroupBy = ""
 		a.resetHistoryWindowState()
 		a.historyNotice = ""
 	case historyActionClear:
 		a.historyQuery = ""
 		a.historyDomainFilter = ""
+		a.historyGroupBy = ""
 		a.resetHistoryWindowState()
 		a.historyNotice = ""
 	case historyActionFilterDomain:
@@ -114,12 +149,26 @@ func (a *App) handleHistoryAction(ctx context.Context, event DOMAction) error {
 		}
 		a.historyDomainFilter = domain
 		a.historyQuery = ""
+		a.historyGroupBy = ""
 		a.resetHistoryWindowState()
 		a.historyNotice = ""
 	case historyActionClearDomain:
 		a.historyDomainFilter = ""
 		a.resetHistoryWindowState()
 		a.historyNotice = ""
+	case historyActionGroupBy:
+		mode := strings.TrimSpace(data["group"])
+		if !isKnownHistoryGroupMode(mode) {
+			return fmt.Errorf("invalid history grouping")
+		}
+		if mode == historyGroupTimeline {
+			mode = ""
+		}
+		a.historyGroupBy = mode
+		a.historyQuery = ""
+		a.historyDomainFilter = ""
+		a.resetHistoryWindowState()
+		a.historyNotice = ""
 	case historyActionPage:
 		offset, err := strconv.Atoi(strings.TrimSpace(data["offset"]))
 		if err != nil || offset < 0 {
@@ -12,6 +12,14 @@ import (
 
 const historyURLDisplayMaxRunes = 180
 
+// History grouping modes selectable on the history page. The timeline is the
+// default windowed view; day and domain modes render server-side groups.
+const (
+	historyGroupTimeline = "timeline"
+	historyGroupDay      = "day"
+	historyGroupDomain   = "domain"
+)
+
 type historyRenderData struct {
 	Entries             []*entity.HistoryEntry
 	Stats               *entity.HistoryStats
@@ -28,6 +36,8 @@ type historyRenderData struct {
 	Notice              string
 	Error               string
 	Loading             bool
+	GroupBy             string
+	Groups              []*entity.HistoryGroup
 }
 
 type historyTimelineGroup struct {
@@ -36,6 +46,11 @@ type historyTimelineGroup struct {
 	Entries []*entity.HistoryEntry
 }
 
+type historyGroupOption struct {
+	Mode  string
+	Label string
+}
+
 type historyCleanupItem struct {
 	Label   string
 	RangeID string
@@ -88,6 +103,106 @@ func historyCleanupItems() []historyCleanupItem {
 	return historyCleanupRanges
 }
 
+var historyGroupOptions = []historyGroupOption{
+	{Mode: historyGroupTimeline, Label: "Timeline"},
+	{Mode: historyGroupDay, Label: "Day"},
+	{Mode: historyGroupDomain, Label: "Domain"},
+}
+
+func historyGroupModes() []historyGroupOption {
+	return historyGroupOptions
+}
+
+func isKnownHistoryGroupMode(mode string) bool {
+	for _, option := range historyGroupOptions {
+		if option.Mode == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func historyGroupMode(data historyRenderData) string {
+	return normalizeHistoryGroupMode(data.GroupBy)
+}
+
+func normalizeHistoryGroupMode(mode string) string {
+	if mode == "" {
+		return historyGroupTimeline
+	}
+	return mode
+}
+
+func isHistoryGrouped(data historyRenderData) bool {
+	return historyGroupMode(data) != historyGroupTimeline
+}
+
+func historyGroupPressed(data historyRenderData, mode string) string {
+	if historyGroupMode(data) == mode {
+		return "true"
+	}
+	return "false"
+}
+
+func historyGroupButtonClass(data historyRenderData, mode string) string {
+	if historyGroupMode(data) == mode {
+		return "sv-button sv-history-group-option"
+	}
+	return "sv-button sv-button-secondary sv-history-group-option"
+}
+
+func historyGroupsTitle(data historyRenderData) string {
+	if historyGroupMode(data) == historyGroupDomain {
+		return "Visits by domain"
+	}
+	return "Visits by day"
+}
+
+func historyGroupLabel(mode string, group *entity.HistoryGroup) string {
+	if group == nil {
+		return ""
+	}
+	if mode == historyGroupDomain {
+		if label := displayHistoryDomain(group.Key); label != "" {
+			return label
+		}
+		return group.Key
+	}
+	day, err := time.ParseInLocation("2006-01-02", group.Key, time.Local)
+	if err != nil {
+		return group.Key
+	}
+	_, label := historyDateKeyAndLabel(day, time.Now().Local())
+	return label
+}
+
+func historyGroupDomainKey(group *entity.HistoryGroup) string {
+	if group == nil {
+		return ""
+	}
+	return browserurl.CanonicalDomain(group.Key)
+}
+
+func historyGroupMeta(group *entity.HistoryGroup) string {
+	if group == nil {
+		return ""
+	}
+	return historyCountLabel(group.EntryCount, "page", "pages") + " · " +
+		historyCountLabel(group.VisitCount, "visit", "visits")
+}
+
+// historyGroupRemaining reports how many entries of group are not listed.
+func historyGroupRemaining(group *entity.HistoryGroup) int64 {
+	if group == nil {
+		return 0
+	}
+	remaining := group.EntryCount - int64(countHistoryEntries(group.Entries))
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
 func historyLimit(data historyRenderData) int {
 	if data.Limit <= 0 {
 		return 0
@@ -111,6 +226,13 @@ func historyShowingLabel(data historyRenderData) string {
 	if data.Loading {
 		return "Loading history…"
 	}
+	if isHistoryGrouped(data) {
+		count := len(data.Groups)
+		if historyGroupMode(data) == historyGroupDomain {
+			return fmt.Sprintf("Showing %d domain%s", count, pluralSuffix(count))
+		}
+		return fmt.Sprintf("Showing %d day%s", count, pluralSuffix(count))
+	}
 	count := countHistoryEntries(data.Entries)
 	if strings.TrimSpace(data.Query) != "" {
 		return fmt.Sprintf("Showing %d matching item%s", count, pluralSuffix(count))
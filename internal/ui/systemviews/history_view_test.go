@@ -113,3 +113,39 @@ func TestHistoryItemFaviconURLIgnoresStoredRemoteFaviconURL(t *testing.T) {
 
 	assert.Equal(t, "/api/favicon?domain=example.com&size=32", historyItemFaviconURL(entry))
 }
+
+func TestHistoryGroupLabelAndMeta(t *testing.T) {
+	t.Parallel()
+
+	today := time.Now().Local().Format("2006-01-02")
+	day := &entity.HistoryGroup{Key: today, EntryCount: 1, VisitCount: 4}
+	domain := &entity.HistoryGroup{Key: "www.example.com", EntryCount: 2, VisitCount: 1}
+
+	assert.Equal(t, "Today", historyGroupLabel(historyGroupDay, day))
+	assert.Equal(t, "2026-13-40", historyGroupLabel(historyGroupDay, &entity.HistoryGroup{Key: "2026-13-40"}))
+	assert.Equal(t, "example.com", historyGroupLabel(historyGroupDomain, domain))
+	assert.Equal(t, "1 page · 4 visits", historyGroupMeta(day))
+	assert.Equal(t, "2 pages · 1 visit", historyGroupMeta(domain))
+}
+
+func TestHistoryGroupsRenderPressedModeAndDayHeadings(t *testing.T) {
+	t.Parallel()
+
+	html := historyHTML(historyRenderData{
+		GroupBy: historyGroupDay,
+		Groups: []*entity.HistoryGroup{{
+			Key:        "2026-04-20",
+			EntryCount: 1,
+			VisitCount: 1,
+			Entries:    []*entity.HistoryEntry{{ID: 3, URL: "https://example.com/", Title: "Example"}},
+		}},
+	})
+
+	assert.Contains(t, html, `data-group="day" aria-pressed="true"`)
+	assert.Contains(t, html, `data-group="timeline" aria-pressed="false"`)
+	assert.Contains(t, html, "Visits by day")
+	assert.Contains(t, html, `data-sv-history-group="2026-04-20"`)
+	assert.Contains(t, html, "Mon Apr 20")
+	assert.NotContains(t, html, "data-sv-history-timeline")
+	assert.Equal(t, "Showing 1 day", historyShowingLabel(historyRenderData{GroupBy: historyGroupDay, Groups: []*entity.HistoryGroup{{}}}))
+}
@@ -0,0 +1,77 @@
+package systemviews
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/bnema/dumber/internal/application/dto"
+)
+
+// homepageSearchPlaceholder stands in for %s while parsing the search URL template.
+const homepageSearchPlaceholder = "__dumber_homepage_query__"
+
+type homepageRenderData struct {
+	Dashboard dto.HomepageDashboard
+}
+
+// homepageSearchForm describes a plain GET form equivalent to a search URL
+// template, so the search box works without a round-trip through the bridge.
+type homepageSearchForm struct {
+	Action string
+	Param  string
+	Hidden []kvPair
+	OK     bool
+}
+
+func homepageHTML(data homepageRenderData) string {
+	return mustRenderComponent(HomepageView(data))
+}
+
+// homepageSearchFormFor maps a "https://host/path?q=%s" template to a form.
+// Templates that put %s anywhere other than a query value cannot be expressed
+// as a GET form and yield a form with OK unset.
+func homepageSearchFormFor(searchURL string) homepageSearchForm {
+	template := strings.TrimSpace(searchURL)
+	if strings.Count(template, "%s") != 1 {
+		return homepageSearchForm{}
+	}
+	parsed, err := url.Parse(strings.Replace(template, "%s", homepageSearchPlaceholder, 1))
+	if err != nil || parsed.Host == "" {
+		return homepageSearchForm{}
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case httpScheme, httpsScheme:
+	default:
+		return homepageSearchForm{}
+	}
+
+	form := homepageSearchForm{}
+	query := parsed.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range query[key] {
+			if value == homepageSearchPlaceholder {
+				if form.Param != "" {
+					return homepageSearchForm{}
+				}
+				form.Param = key
+				continue
+			}
+			form.Hidden = append(form.Hidden, kvPair{Label: key, Value: value})
+		}
+	}
+	if form.Param == "" {
+		return homepageSearchForm{}
+	}
+
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	form.Action = sanitizeHref(parsed.String())
+	form.OK = form.Action != "#"
+	return form
+}
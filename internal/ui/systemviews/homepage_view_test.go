@@ -0,0 +1,62 @@
+package systemviews
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHomepageSearchFormForQueryTemplate(t *testing.T) {
+	t.Parallel()
+
+	form := homepageSearchFormFor("https://search.example/find?lang=en&q=%s&safe=off")
+
+	assert.True(t, form.OK)
+	assert.Equal(t, "https://search.example/find", form.Action)
+	assert.Equal(t, "q", form.Param)
+	assert.Equal(t, []kvPair{{Label: "lang", Value: "en"}, {Label: "safe", Value: "off"}}, form.Hidden)
+}
+
+func TestHomepageSearchFormForRejectsUnusableTemplates(t *testing.T) {
+	t.Parallel()
+
+	for _, raw := range []string{
+		"",
+		"https://search.example/find?q=",
+		"https://search.example/search/%s",
+		"javascript:alert(%s)",
+		"https://search.example/?a=%s&b=%s",
+	} {
+		assert.False(t, homepageSearchFormFor(raw).OK, raw)
+	}
+}
+
+func TestHomepageHTMLRendersWidgetsInConfiguredOrder(t *testing.T) {
+	t.Parallel()
+
+	html := homepageHTML(homepageRenderData{Dashboard: dto.HomepageDashboard{
+		Widgets: []entity.HomepageWidget{
+			entity.HomepageWidgetTopFavorites,
+			entity.HomepageWidgetSearch,
+		},
+		SearchURL:    "https://duckduckgo.com/?q=%s",
+		TopFavorites: []*entity.Favorite{{ID: 7, Title: "Docs", URL: "https://docs.example"}},
+	}})
+
+	favorites := strings.Index(html, "sv-homepage-favorites")
+	search := strings.Index(html, "sv-homepage-search")
+	assert.True(t, favorites >= 0 && search > favorites, html)
+	assert.Contains(t, html, `action="https://duckduckgo.com/"`)
+	assert.Contains(t, html, "Docs")
+	assert.NotContains(t, html, "sv-homepage-recent")
+}
+
+func TestHomepageHTMLWithoutWidgets(t *testing.T) {
+	t.Parallel()
+
+	html := homepageHTML(homepageRenderData{})
+	assert.Contains(t, html, "No homepage widgets enabled")
+}
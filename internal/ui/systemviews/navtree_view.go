@@ -0,0 +1,159 @@
+package systemviews
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type navTreeRenderData struct {
+	Summary string
+	Panes   []navTreePane
+}
+
+type navTreePane struct {
+	Title string
+	Meta  string
+	// Roots holds one run per root; a pane normally has a single root.
+	Roots [][]navTreeItem
+}
+
+// navTreeItem is one page of a run. A run is a straight line of pages; a page
+// followed by several pages starts one nested run per branch, so only
+// branch points add indentation.
+type navTreeItem struct {
+	Node     entity.NavigationNode
+	Current  bool
+	OnPath   bool
+	Branches [][]navTreeItem
+}
+
+func navTreeHTML(data navTreeRenderData) string {
+	return mustRenderComponent(NavTreeView(data))
+}
+
+func buildNavTreeRenderData(trees []dto.NavigationTree) navTreeRenderData {
+	data := navTreeRenderData{Panes: make([]navTreePane, 0, len(trees))}
+	pages := 0
+	for _, tree := range trees {
+		if len(tree.Nodes) == 0 {
+			continue
+		}
+		pages += len(tree.Nodes)
+		data.Panes = append(data.Panes, buildNavTreePane(tree))
+	}
+	data.Summary = fmt.Sprintf("%d pages · %d panes", pages, len(data.Panes))
+	return data
+}
+
+func buildNavTreePane(tree dto.NavigationTree) navTreePane {
+	byID := make(map[int]entity.NavigationNode, len(tree.Nodes))
+	for _, n := range tree.Nodes {
+		byID[n.ID] = n
+	}
+	children := make(map[int][]entity.NavigationNode)
+	var roots []entity.NavigationNode
+	for _, n := range tree.Nodes {
+		if _, ok := byID[n.ParentID]; n.ParentID == 0 || !ok {
+			roots = append(roots, n)
+			continue
+		}
+		children[n.ParentID] = append(children[n.ParentID], n)
+	}
+	onPath := make(map[int]bool)
+	for n, ok := byID[tree.Current]; ok && !onPath[n.ID]; n, ok = byID[n.ParentID] {
+		onPath[n.ID] = true
+	}
+
+	var buildRun func(start entity.NavigationNode) []navTreeItem
+	buildRun = func(start entity.NavigationNode) []navTreeItem {
+		var run []navTreeItem
+		for node := start; ; {
+			item := navTreeItem{Node: node, Current: node.ID == tree.Current, OnPath: onPath[node.ID]}
+			next := children[node.ID]
+			if len(next) > 1 {
+				for _, child := range next {
+					item.Branches = append(item.Branches, buildRun(child))
+				}
+			}
+			run = append(run, item)
+			if len(next) != 1 {
+				return run
+			}
+			node = next[0]
+		}
+	}
+
+	pane := navTreePane{
+		Title: navTreePaneTitle(tree, byID),
+		Meta:  navTreePaneMeta(tree, children),
+	}
+	for _, root := range roots {
+		pane.Roots = append(pane.Roots, buildRun(root))
+	}
+	return pane
+}
+
+func navTreePaneTitle(tree dto.NavigationTree, byID map[int]entity.NavigationNode) string {
+	if current, ok := byID[tree.Current]; ok {
+		if current.Title != "" {
+			return current.Title
+		}
+		return current.URL
+	}
+	return "Pane " + tree.PaneID
+}
+
+func navTreePaneMeta(tree dto.NavigationTree, children map[int][]entity.NavigationNode) string {
+	branches := 0
+	for _, next := range children {
+		if len(next) > 1 {
+			branches += len(next) - 1
+		}
+	}
+	meta := fmt.Sprintf("%d pages · %d branches", len(tree.Nodes), branches)
+	if !tree.LastVisit.IsZero() {
+		meta += " · last visit " + tree.LastVisit.Local().Format(time.TimeOnly)
+	}
+	switch {
+	case tree.Restored:
+		meta += " · previous session"
+	case tree.Closed:
+		meta += " · closed"
+	}
+	return meta
+}
+
+func navTreeItemClass(item navTreeItem) string {
+	class := "sv-navtree-node"
+	if item.OnPath {
+		class += " sv-navtree-path"
+	}
+	if item.Current {
+		class += " sv-navtree-current"
+	}
+	return class
+}
+
+func navTreeItemLabel(item navTreeItem) string {
+	if item.Node.Title != "" {
+		return item.Node.Title
+	}
+	return item.Node.URL
+}
+
+func navTreeItemMeta(item navTreeItem) string {
+	meta := item.Node.LastVisit.Local().Format(time.TimeOnly)
+	if item.Node.LastVisit.IsZero() {
+		meta = ""
+	}
+	if item.Node.Visits > 1 {
+		if meta != "" {
+			meta += " · "
+		}
+		meta += fmt.Sprintf("%d visits", item.Node.Visits)
+	}
+	return meta
+}
@@ -0,0 +1,86 @@
+package systemviews
+
+import (
+	"testing"
+
+	"github.com/bnema/dumber/internal/application/dto"
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runURLs(run []navTreeItem) []string {
+	urls := make([]string, len(run))
+	for i, item := range run {
+		urls[i] = item.Node.URL
+	}
+	return urls
+}
+
+func TestBuildNavTreePaneIndentsOnlyAtBranches(t *testing.T) {
+	t.Parallel()
+
+	// a → b → c, then back to b and on to d → e.
+	tree := dto.NavigationTree{
+		PaneID:  "pane-1",
+		Current: 5,
+		Nodes: []entity.NavigationNode{
+			{ID: 1, URL: "a"},
+			{ID: 2, ParentID: 1, URL: "b"},
+			{ID: 3, ParentID: 2, URL: "c"},
+			{ID: 4, ParentID: 2, URL: "d"},
+			{ID: 5, ParentID: 4, URL: "e", Title: "Eee"},
+		},
+	}
+
+	pane := buildNavTreePane(tree)
+
+	require.Len(t, pane.Roots, 1)
+	run := pane.Roots[0]
+	assert.Equal(t, []string{"a", "b"}, runURLs(run))
+	require.Len(t, run[1].Branches, 2)
+	assert.Equal(t, []string{"c"}, runURLs(run[1].Branches[0]))
+	assert.Equal(t, []string{"d", "e"}, runURLs(run[1].Branches[1]))
+
+	assert.True(t, run[0].OnPath)
+	assert.False(t, run[1].Branches[0][0].OnPath)
+	assert.True(t, run[1].Branches[1][1].Current)
+	assert.Equal(t, "Eee", pane.Title)
+	assert.Contains(t, pane.Meta, "5 pages · 1 branches")
+}
+
+func TestBuildNavTreePaneTreatsOrphansAsRoots(t *testing.T) {
+	t.Parallel()
+
+	pane := buildNavTreePane(dto.NavigationTree{
+		PaneID:   "old",
+		Restored: true,
+		Nodes: []entity.NavigationNode{
+			{ID: 7, ParentID: 3, URL: "x"},
+			{ID: 8, ParentID: 7, URL: "y"},
+		},
+	})
+
+	require.Len(t, pane.Roots, 1)
+	assert.Equal(t, []string{"x", "y"}, runURLs(pane.Roots[0]))
+	assert.Equal(t, "Pane old", pane.Title)
+	assert.Contains(t, pane.Meta, "previous session")
+}
+
+func TestNavTreeHTMLMarksCurrentPage(t *testing.T) {
+	t.Parallel()
+
+	html := navTreeHTML(buildNavTreeRenderData([]dto.NavigationTree{{
+		PaneID:  "pane-1",
+		Current: 2,
+		Nodes: []entity.NavigationNode{
+			{ID: 1, URL: "https://example.com/", Visits: 3},
+			{ID: 2, ParentID: 1, URL: "https://example.com/<docs>", Title: "Docs"},
+		},
+	}}))
+
+	assert.Contains(t, html, "sv-navtree-current")
+	assert.Contains(t, html, "3 visits")
+	assert.Contains(t, html, "2 pages · 1 panes")
+	assert.NotContains(t, html, "<docs>")
+}
@@ -0,0 +1,93 @@
+package systemviews
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	notesActionCreate  = "notes.create"
+	notesActionDelete  = "notes.delete"
+	notesActionRefresh = "notes.refresh"
+	// notesActionEdit and notesActionFlush come from typing in a note. They
+	// feed the autosaver directly instead of queueing a remount.
+	notesActionEdit  = "notes.edit"
+	notesActionFlush = "notes.flush"
+)
+
+func (a *App) handleNotesAction(ctx context.Context, event DOMAction) error {
+	if a.deps.Notes == nil {
+		return fmt.Errorf("notes service not configured")
+	}
+	data := event.Data
+	if event.Action == notesActionDelete {
+		if id, err := parsePositiveInt64(data["id"], "note id"); err == nil {
+			a.notesAutosave.Cancel(id)
+		}
+	}
+	// Remounting replaces the textareas, so unsaved text is saved first.
+	if err := a.notesAutosave.Flush(); err != nil {
+		return err
+	}
+	switch event.Action {
+	case notesActionRefresh:
+		a.notesNotice = ""
+	case notesActionCreate:
+		note, err := a.deps.Notes.CreateNote(ctx)
+		if err != nil {
+			return err
+		}
+		a.notesFocusID = note.ID
+		a.notesNotice = ""
+	case notesActionDelete:
+		id, err := parsePositiveInt64(data["id"], "note id")
+		if err != nil {
+			return err
+		}
+		if err := a.deps.Notes.DeleteNote(ctx, id); err != nil {
+			return err
+		}
+		a.notesNotice = "Note deleted"
+	default:
+		return fmt.Errorf("unknown notes action: %q", event.Action)
+	}
+	return nil
+}
+
+// handleNoteTyping feeds note edits to the autosaver without going through
+// the action queue, so a burst of keystrokes can neither fill the queue nor
+// remount the textarea being typed in. It reports whether action was one.
+func (a *App) handleNoteTyping(action DOMAction) bool {
+	switch action.Action {
+	case notesActionEdit:
+		id, err := parsePositiveInt64(action.Data["id"], "note id")
+		if err == nil {
+			a.notesAutosave.Edit(id, action.Data["body"])
+		}
+		return true
+	case notesActionFlush:
+		// Saving calls the bridge, which must not block the JS event callback.
+		go func() {
+			if err := a.notesAutosave.Flush(); err != nil {
+				a.enqueueActionError(err)
+			}
+		}()
+		return true
+	default:
+		return false
+	}
+}
+
+func (a *App) saveNote(id int64, body string) error {
+	if a.deps.Notes == nil {
+		return fmt.Errorf("notes service not configured")
+	}
+	ctx := context.Background()
+	a.lockAction()
+	if a.actionCtx != nil {
+		ctx = a.actionCtx
+	}
+	a.unlockAction()
+	_, err := a.deps.Notes.SaveNote(ctx, id, body)
+	return err
+}
@@ -0,0 +1,126 @@
+package systemviews
+
+import (
+	"sync"
+	"time"
+)
+
+// noteAutosaveDelay is how long typing must pause before a note is saved.
+const noteAutosaveDelay = 500 * time.Millisecond
+
+// noteAutosaver debounces note edits: each edit restarts the note's timer and
+// only the latest body is saved once the timer fires. Saves are serialized so
+// an older body can never overwrite a newer one.
+type noteAutosaver struct {
+	// saveMu is taken before mu and held while a save runs.
+	saveMu    sync.Mutex
+	mu        sync.Mutex
+	delay     time.Duration
+	afterFunc func(time.Duration, func()) func() bool
+	save      func(id int64, body string) error
+	// onError receives errors of timer-driven saves; Flush returns its own.
+	onError func(error)
+	pending map[int64]*pendingNote
+	seq     uint64
+}
+
+type pendingNote struct {
+	body string
+	seq  uint64
+	stop func() bool
+}
+
+func newNoteAutosaver(delay time.Duration, save func(id int64, body string) error) *noteAutosaver {
+	return &noteAutosaver{
+		delay: delay,
+		afterFunc: func(d time.Duration, fn func()) func() bool {
+			return time.AfterFunc(d, fn).Stop
+		},
+		save:    save,
+		pending: make(map[int64]*pendingNote),
+	}
+}
+
+// Edit records body as the latest text of note id and restarts its timer.
+func (s *noteAutosaver) Edit(id int64, body string) {
+	if s == nil || id <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p := s.pending[id]; p != nil {
+		p.stop()
+	}
+	s.seq++
+	seq := s.seq
+	s.pending[id] = &pendingNote{
+		body: body,
+		seq:  seq,
+		stop: s.afterFunc(s.delay, func() { s.fire(id, seq) }),
+	}
+}
+
+// Pending reports whether note id has an unsaved edit.
+func (s *noteAutosaver) Pending(id int64) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pending[id] != nil
+}
+
+// Flush saves every pending edit now and returns the first save error.
+func (s *noteAutosaver) Flush() error {
+	if s == nil {
+		return nil
+	}
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[int64]*pendingNote)
+	s.mu.Unlock()
+
+	var firstErr error
+	for id, p := range pending {
+		p.stop()
+		if err := s.save(id, p.body); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Cancel drops the pending edit of note id, for notes about to be deleted.
+func (s *noteAutosaver) Cancel(id int64) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p := s.pending[id]; p != nil {
+		p.stop()
+		delete(s.pending, id)
+	}
+}
+
+func (s *noteAutosaver) fire(id int64, seq uint64) {
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+
+	s.mu.Lock()
+	p := s.pending[id]
+	// A newer edit, a flush or a cancel got there first.
+	if p == nil || p.seq != seq {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.pending, id)
+	s.mu.Unlock()
+
+	if err := s.save(id, p.body); err != nil && s.onError != nil {
+		s.onError(err)
+	}
+}
@@ -0,0 +1,153 @@
+package systemviews
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAutosaveTimer struct {
+	fn      func()
+	stopped bool
+}
+
+type fakeAutosaveClock struct {
+	timers []*fakeAutosaveTimer
+}
+
+func (c *fakeAutosaveClock) afterFunc(_ time.Duration, fn func()) func() bool {
+	timer := &fakeAutosaveTimer{fn: fn}
+	c.timers = append(c.timers, timer)
+	return func() bool {
+		wasActive := !timer.stopped
+		timer.stopped = true
+		return wasActive
+	}
+}
+
+// fireAll runs every timer that was not stopped, like the runtime would
+// once the delay elapsed.
+func (c *fakeAutosaveClock) fireAll() {
+	timers := c.timers
+	c.timers = nil
+	for _, timer := range timers {
+		if !timer.stopped {
+			timer.fn()
+		}
+	}
+}
+
+type noteSave struct {
+	id   int64
+	body string
+}
+
+func newTestNoteAutosaver(saveErr error) (*noteAutosaver, *fakeAutosaveClock, *[]noteSave) {
+	clock := &fakeAutosaveClock{}
+	saves := &[]noteSave{}
+	s := newNoteAutosaver(noteAutosaveDelay, func(id int64, body string) error {
+		*saves = append(*saves, noteSave{id: id, body: body})
+		return saveErr
+	})
+	s.afterFunc = clock.afterFunc
+	return s, clock, saves
+}
+
+func TestNoteAutosaver_SavesOnlyLatestBodyAfterPause(t *testing.T) {
+	s, clock, saves := newTestNoteAutosaver(nil)
+
+	s.Edit(1, "h")
+	s.Edit(1, "he")
+	s.Edit(1, "hey")
+	assert.Empty(t, *saves, "nothing is saved while typing")
+	assert.True(t, s.Pending(1))
+
+	clock.fireAll()
+	assert.Equal(t, []noteSave{{id: 1, body: "hey"}}, *saves)
+	assert.False(t, s.Pending(1))
+
+	clock.fireAll()
+	assert.Len(t, *saves, 1, "a fired edit is not saved twice")
+}
+
+func TestNoteAutosaver_DebouncesEachNoteSeparately(t *testing.T) {
+	s, clock, saves := newTestNoteAutosaver(nil)
+
+	s.Edit(1, "one")
+	s.Edit(2, "two")
+	clock.fireAll()
+
+	assert.ElementsMatch(t, []noteSave{{id: 1, body: "one"}, {id: 2, body: "two"}}, *saves)
+}
+
+func TestNoteAutosaver_FlushSavesPendingNow(t *testing.T) {
+	s, clock, saves := newTestNoteAutosaver(nil)
+
+	s.Edit(3, "draft")
+	require.NoError(t, s.Flush())
+	assert.Equal(t, []noteSave{{id: 3, body: "draft"}}, *saves)
+
+	clock.fireAll()
+	assert.Len(t, *saves, 1, "the stopped timer does not save again")
+	require.NoError(t, s.Flush())
+	assert.Len(t, *saves, 1, "flushing with nothing pending saves nothing")
+}
+
+func TestNoteAutosaver_StaleTimerDoesNotSave(t *testing.T) {
+	s, _, saves := newTestNoteAutosaver(nil)
+	var first func()
+	s.afterFunc = func(_ time.Duration, fn func()) func() bool {
+		if first == nil {
+			first = fn
+		}
+		// Stopping reports failure, as when the timer already fired.
+		return func() bool { return false }
+	}
+
+	s.Edit(1, "old")
+	s.Edit(1, "new")
+	first()
+
+	assert.Empty(t, *saves, "the timer of a superseded edit must not save the old body")
+	assert.True(t, s.Pending(1))
+}
+
+func TestNoteAutosaver_CancelDropsPendingEdit(t *testing.T) {
+	s, clock, saves := newTestNoteAutosaver(nil)
+
+	s.Edit(4, "doomed")
+	s.Cancel(4)
+	clock.fireAll()
+	require.NoError(t, s.Flush())
+
+	assert.Empty(t, *saves)
+}
+
+func TestNoteAutosaver_ReportsSaveErrors(t *testing.T) {
+	saveErr := errors.New("bridge down")
+	s, clock, _ := newTestNoteAutosaver(saveErr)
+	var reported []error
+	s.onError = func(err error) { reported = append(reported, err) }
+
+	s.Edit(1, "a")
+	clock.fireAll()
+	assert.Equal(t, []error{saveErr}, reported)
+
+	s.Edit(1, "b")
+	assert.ErrorIs(t, s.Flush(), saveErr)
+	assert.Len(t, reported, 1, "flush returns its errors instead of reporting them")
+}
+
+func TestNoteAutosaver_IgnoresInvalidIDs(t *testing.T) {
+	s, clock, saves := newTestNoteAutosaver(nil)
+
+	s.Edit(0, "x")
+	s.Edit(-1, "y")
+	clock.fireAll()
+	require.NoError(t, s.Flush())
+
+	assert.Empty(t, *saves)
+}
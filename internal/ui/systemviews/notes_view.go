@@ -0,0 +1,45 @@
+package systemviews
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+type notesRenderData struct {
+	Notes   []*entity.Note
+	FocusID int64
+	Notice  string
+	Error   string
+}
+
+func notesHTML(data notesRenderData) string {
+	return mustRenderComponent(NotesView(data))
+}
+
+func notesSummary(data notesRenderData) string {
+	if len(data.Notes) == 1 {
+		return "1 note · saved as you type"
+	}
+	return fmt.Sprintf("%d notes · saved as you type", len(data.Notes))
+}
+
+func noteTitle(note *entity.Note) string {
+	if title := note.Title(); title != "" {
+		return title
+	}
+	return "Untitled note"
+}
+
+func noteMeta(note *entity.Note) string {
+	if note.UpdatedAt.IsZero() {
+		return ""
+	}
+	return "Edited " + note.UpdatedAt.Local().Format(time.DateTime)
+}
+
+func noteID(note *entity.Note) string {
+	return strconv.FormatInt(note.ID, 10)
+}
@@ -12,6 +12,10 @@ const (
 	RouteHistory   Route = "history"
 	RouteFavorites Route = "favorites"
 	RouteConfig    Route = "config"
+	RouteHomepage  Route = "homepage"
+	RouteConsole   Route = "console"
+	RouteNavTree   Route = "navtree"
+	RouteNotes     Route = "notes"
 )
 
 func ParseRoute(uri string) Route {
@@ -54,6 +58,14 @@ func routeFromPageHost(host, opaque string) Route {
 		return RouteFavorites
 	case string(RouteConfig):
 		return RouteConfig
+	case string(RouteHomepage):
+		return RouteHomepage
+	case string(RouteConsole):
+		return RouteConsole
+	case string(RouteNavTree):
+		return RouteNavTree
+	case string(RouteNotes):
+		return RouteNotes
 	default:
 		return RouteUnknown
 	}
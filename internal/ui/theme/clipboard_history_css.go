@@ -0,0 +1,65 @@
+package theme
+
+// generateClipboardHistoryCSS creates styles for the panel listing recently
+// copied URLs.
+func generateClipboardHistoryCSS() string {
+	return `/* ===== Clipboard History Styling ===== */
+
+.clipboard-history {
+	background-color: var(--surface-variant);
+	border: 0.0625em solid var(--border);
+	border-radius: 0.1875em;
+	margin-top: 4em;
+	min-width: 32em;
+}
+
+.clipboard-history-title {
+	background-color: shade(var(--surface-variant), 1.1);
+	border-bottom: 0.0625em solid var(--border);
+	padding: 0.5em 0.75em;
+	font-size: 0.9375em;
+	font-weight: 600;
+	color: var(--text);
+}
+
+.clipboard-history-scrolled,
+.clipboard-history-list {
+	background-color: transparent;
+}
+
+.clipboard-history-row {
+	padding: 0.375em 0.75em;
+	border-bottom: 0.0625em solid alpha(var(--border), 0.5);
+}
+
+.clipboard-history-row:selected {
+	background-color: alpha(var(--accent), 0.2);
+}
+
+.clipboard-history-time {
+	font-size: 0.75em;
+	font-family: var(--font-mono);
+	color: var(--muted);
+}
+
+.clipboard-history-url {
+	font-size: 0.8125em;
+	color: var(--text);
+}
+
+.clipboard-history-empty {
+	font-size: 0.8125em;
+	color: var(--muted);
+	padding: 1em 0.75em;
+}
+
+.clipboard-history-footer {
+	background-color: shade(var(--surface-variant), 0.9);
+	border-top: 0.0625em solid var(--border);
+	padding: 0.375em 0.75em;
+	font-size: 0.6875em;
+	color: var(--muted);
+	font-family: var(--font-mono);
+}
+`
+}
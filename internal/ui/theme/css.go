@@ -86,6 +86,10 @@ func GenerateCSSFull(p Palette, _ float64, fonts FontConfig, modeColors ModeColo
 	sb.WriteString(generateTabBarCSS(p))
 	sb.WriteString("\n")
 
+	// Tab group styling
+	sb.WriteString(generateTabGroupCSS())
+	sb.WriteString("\n")
+
 	// Omnibox styling
 	sb.WriteString(generateOmniboxCSS(p))
 	sb.WriteString("\n")
@@ -118,6 +122,10 @@ func GenerateCSSFull(p Palette, _ float64, fonts FontConfig, modeColors ModeColo
 	sb.WriteString(generateLinkStatusCSS(p))
 	sb.WriteString("\n")
 
+	// Pane number badge styling
+	sb.WriteString(generatePaneNumberCSS(p))
+	sb.WriteString("\n")
+
 	// Session manager styling
 	sb.WriteString(generateSessionManagerCSS(p))
 	sb.WriteString("\n")
@@ -134,6 +142,22 @@ func GenerateCSSFull(p Palette, _ float64, fonts FontConfig, modeColors ModeColo
 	sb.WriteString(generatePermissionPopupCSS(p))
 	sb.WriteString("\n")
 
+	// Script dialog popup styling (extends the permission popup)
+	sb.WriteString(generateScriptDialogCSS())
+	sb.WriteString("\n")
+
+	// Panic key lock screen styling
+	sb.WriteString(generateLockScreenCSS())
+	sb.WriteString("\n")
+
+	// Notification center styling
+	sb.WriteString(generateNotificationCenterCSS())
+	sb.WriteString("\n")
+
+	// Clipboard history styling
+	sb.WriteString(generateClipboardHistoryCSS())
+	sb.WriteString("\n")
+
 	// Floating pane styling
 	sb.WriteString(generateFloatingPaneCSS(p))
 	sb.WriteString("\n")
@@ -762,6 +786,26 @@ progressbar.osd progress {
 	padding: 0;
 	background-color: var(--accent);
 }
+
+/* Reading progress: how far the page is scrolled, at the top of the pane */
+progressbar.reading-progress {
+	min-height: 3px;
+}
+
+progressbar.reading-progress trough {
+	min-height: 3px;
+	margin: 0;
+	padding: 0;
+	background-color: transparent;
+}
+
+progressbar.reading-progress progress {
+	min-height: 3px;
+	margin: 0;
+	padding: 0;
+	border-radius: 0;
+	background-color: var(--accent);
+}
 `
 }
 
@@ -1179,6 +1223,26 @@ func generateLinkStatusCSS(p Palette) string {
 `
 }
 
+// generatePaneNumberCSS creates the jump-to-pane number badge styles.
+// The badge uses the pane mode color so it reads as part of pane mode.
+func generatePaneNumberCSS(p Palette) string {
+	_ = p
+	return `/* ===== Pane Number Badge Styling ===== */
+.pane-number {
+	background-color: alpha(var(--pane-mode-color), 0.9);
+	border-radius: 0.5em;
+	padding: 0.25em 0.9em;
+	box-shadow: 0 0.25em 1em alpha(black, 0.3);
+}
+
+.pane-number label {
+	color: #ffffff;
+	font-size: 2.5em;
+	font-weight: 700;
+}
+`
+}
+
 func generateFloatingPaneCSS(p Palette) string {
 	_ = p
 	return `/* ===== Floating Pane Styling ===== */
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -259,3 +260,12 @@ func TestGenerateCSS_DoesNotEmitUnsupportedGTKProperties(t *testing.T) {
 	assert.NotContains(t, css, "pointer-events:")
 	assert.NotContains(t, css, "text-align:")
 }
+
+func TestGenerateCSS_MarksEveryTabGroupColor(t *testing.T) {
+	css := GenerateCSS(DefaultDarkPalette())
+
+	for _, color := range entity.TabGroupColors {
+		assert.Contains(t, css, "button.tab-button.tab-group-"+string(color)+" {", color)
+		assert.NotEmpty(t, tabGroupColorValues[color], color)
+	}
+}
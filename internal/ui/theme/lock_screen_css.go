@@ -0,0 +1,46 @@
+package theme
+
+// generateLockScreenCSS creates styles for the PIN prompt shown after the
+// panic key locks the browser. The background is opaque so nothing of the
+// pages shows through.
+func generateLockScreenCSS() string {
+	return `/* ===== Lock Screen Styling ===== */
+
+.lock-screen {
+	background-color: var(--bg);
+}
+
+.lock-screen-card {
+	background-color: var(--surface-variant);
+	border: 0.0625em solid var(--border);
+	border-radius: 0.1875em;
+	padding: 1em;
+	min-width: 16em;
+}
+
+.lock-screen-heading {
+	font-size: 0.9375em;
+	font-weight: 600;
+	color: var(--text);
+	margin-bottom: 0.75em;
+}
+
+.lock-screen-entry {
+	font-size: 0.8125em;
+	background-color: var(--surface);
+	color: var(--text);
+	border: 0.0625em solid var(--border);
+	border-radius: 0.1875em;
+}
+
+.lock-screen-entry:focus-within {
+	border-color: var(--accent);
+}
+
+.lock-screen-error {
+	font-size: 0.8125em;
+	color: var(--destructive);
+	margin-top: 0.5em;
+}
+`
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/bnema/dumber/internal/domain/entity"
 	"github.com/bnema/dumber/internal/logging"
+	"github.com/bnema/dumber/internal/shared/pathutil"
 	"github.com/bnema/puregotk/v4/gdk"
 	"github.com/bnema/puregotk/v4/gtk"
 )
@@ -22,8 +23,11 @@ type Manager struct {
 	fonts        FontConfig
 	gtkFont      string
 	modeColors   ModeColors // Modal mode indicator colors
-	cssProvider  *gtk.CssProvider
-	appliedFont  string
+	// workspaceBackground styles the empty workspace areas; its image path
+	// is absolute.
+	workspaceBackground entity.WorkspaceBackgroundConfig
+	cssProvider         *gtk.CssProvider
+	appliedFont         string
 }
 
 // NewManager creates a new theme manager from an already-resolved theme.
@@ -31,7 +35,7 @@ func NewManager(ctx context.Context, resolved entity.ResolvedTheme) *Manager {
 	log := logging.FromContext(ctx)
 
 	m := &Manager{}
-	m.applyResolvedTheme(resolved)
+	m.applyResolvedTheme(ctx, resolved)
 
 	log.Debug().
 		Bool("prefers_dark", m.prefersDark).
@@ -44,7 +48,7 @@ func NewManager(ctx context.Context, resolved entity.ResolvedTheme) *Manager {
 	return m
 }
 
-func (m *Manager) applyResolvedTheme(resolved entity.ResolvedTheme) {
+func (m *Manager) applyResolvedTheme(ctx context.Context, resolved entity.ResolvedTheme) {
 	m.prefersDark = resolved.PrefersDark
 	m.lightPalette = PaletteFromEntity(resolved.LightPalette, false)
 	m.darkPalette = PaletteFromEntity(resolved.DarkPalette, true)
@@ -58,6 +62,15 @@ func (m *Manager) applyResolvedTheme(resolved entity.ResolvedTheme) {
 	}
 	m.gtkFont = m.fonts.GtkFont
 	m.modeColors = ModeColorsFromEntity(resolved.ModeColors)
+	m.workspaceBackground = resolved.WorkspaceBackground
+	image, err := pathutil.ExpandPath(m.workspaceBackground.Image)
+	if err != nil {
+		logging.FromContext(ctx).Warn().
+			Err(err).
+			Str("image", m.workspaceBackground.Image).
+			Msg("workspace background image dropped")
+	}
+	m.workspaceBackground.Image = image
 }
 
 // PrefersDark returns true if dark mode is active.
@@ -138,6 +151,9 @@ func (m *Manager) ApplyToDisplay(ctx context.Context, display *gdk.Display) {
 	// Generate CSS with current palette, UI scale, fonts, and mode colors
 	palette := m.GetCurrentPalette()
 	css := GenerateCSSFull(palette, m.uiScale, m.fonts, m.modeColors)
+	if bgCSS := GenerateWorkspaceBackgroundCSS(m.workspaceBackground); bgCSS != "" {
+		css += "\n" + bgCSS
+	}
 	fontName := formatGTKFontName(m.gtkFont, m.uiScale)
 
 	settings := gtk.SettingsGetForDisplay(display)
@@ -178,7 +194,7 @@ func (m *Manager) ApplyToDisplay(ctx context.Context, display *gdk.Display) {
 func (m *Manager) UpdateFromResolved(ctx context.Context, resolved entity.ResolvedTheme, display *gdk.Display) {
 	log := logging.FromContext(ctx)
 
-	m.applyResolvedTheme(resolved)
+	m.applyResolvedTheme(ctx, resolved)
 
 	log.Info().
 		Bool("prefers_dark", m.prefersDark).
@@ -105,6 +105,24 @@ func TestManager_UpdateFromResolvedReplacesResolvedValues(t *testing.T) {
 	assert.Equal(t, "#654321", manager.GetModeColors().PaneMode)
 }
 
+func TestManager_UpdateFromResolvedReappliesWorkspaceBackground(t *testing.T) {
+	t.Setenv("HOME", "/home/tester")
+	ctx := context.Background()
+	manager := NewManager(ctx, resolvedThemeFixture(true))
+	assert.Empty(t, GenerateWorkspaceBackgroundCSS(manager.workspaceBackground))
+
+	updated := resolvedThemeFixture(true)
+	updated.WorkspaceBackground = entity.WorkspaceBackgroundConfig{
+		Color:   "#1e1e2e",
+		Image:   "~/Pictures/wall.png",
+		Pattern: entity.WorkspaceBackgroundPatternDots,
+	}
+	manager.UpdateFromResolved(ctx, updated, nil)
+
+	assert.Equal(t, "/home/tester/Pictures/wall.png", manager.workspaceBackground.Image)
+	assert.Contains(t, GenerateWorkspaceBackgroundCSS(manager.workspaceBackground), "background-color: #1e1e2e;")
+}
+
 func TestManager_GetWebUIThemeCSS(t *testing.T) {
 	ctx := context.Background()
 	manager := NewManager(ctx, resolvedThemeFixture(true))
@@ -0,0 +1,83 @@
+package theme
+
+// generateNotificationCenterCSS creates styles for the notification center
+// panel listing recent toasts. Rows carry the level as a left border.
+func generateNotificationCenterCSS() string {
+	return `/* ===== Notification Center Styling ===== */
+
+.notification-center {
+	background-color: var(--surface-variant);
+	border: 0.0625em solid var(--border);
+	border-radius: 0.1875em;
+	margin: 0.75em;
+	min-width: 24em;
+}
+
+.notification-center-header {
+	background-color: shade(var(--surface-variant), 1.1);
+	border-bottom: 0.0625em solid var(--border);
+	padding: 0.5em 0.75em;
+}
+
+.notification-center-title {
+	font-size: 0.9375em;
+	font-weight: 600;
+	color: var(--text);
+}
+
+.notification-center-dismiss {
+	font-size: 0.75em;
+	padding: 0.125em 0.5em;
+	min-height: 0;
+}
+
+.notification-center-scrolled,
+.notification-center-list {
+	background-color: transparent;
+}
+
+.notification-center-row {
+	padding: 0.375em 0.75em;
+	border-left: 0.1875em solid var(--accent);
+	border-bottom: 0.0625em solid alpha(var(--border), 0.5);
+}
+
+.notification-center-row.notification-success {
+	border-left-color: var(--success);
+}
+
+.notification-center-row.notification-warning {
+	border-left-color: var(--warning);
+}
+
+.notification-center-row.notification-error {
+	border-left-color: var(--destructive);
+}
+
+.notification-center-time {
+	font-size: 0.75em;
+	font-family: var(--font-mono);
+	color: var(--muted);
+}
+
+.notification-center-message {
+	font-size: 0.8125em;
+	color: var(--text);
+}
+
+.notification-center-empty {
+	font-size: 0.8125em;
+	color: var(--muted);
+	padding: 1em 0.75em;
+}
+
+.notification-center-footer {
+	background-color: shade(var(--surface-variant), 0.9);
+	border-top: 0.0625em solid var(--border);
+	padding: 0.375em 0.75em;
+	font-size: 0.6875em;
+	color: var(--muted);
+	font-family: var(--font-mono);
+}
+`
+}
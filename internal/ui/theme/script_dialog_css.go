@@ -0,0 +1,21 @@
+package theme
+
+// generateScriptDialogCSS creates styles for the JavaScript dialog popup.
+// The popup reuses the permission popup classes; only the prompt entry is new.
+func generateScriptDialogCSS() string {
+	return `/* ===== Script Dialog Popup Styling ===== */
+
+.script-dialog-entry {
+	margin: 0 1em 0.75em 1em;
+	font-size: 0.8125em;
+	background-color: var(--surface);
+	color: var(--text);
+	border: 0.0625em solid var(--border);
+	border-radius: 0.1875em;
+}
+
+.script-dialog-entry:focus-within {
+	border-color: var(--accent);
+}
+`
+}
@@ -0,0 +1,51 @@
+package theme
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// tabGroupColorValues maps each tab group color to the shade its tabs are
+// marked with. The shades read on both light and dark palettes.
+var tabGroupColorValues = map[entity.TabGroupColor]string{
+	entity.TabGroupColorBlue:   "#3584e4",
+	entity.TabGroupColorRed:    "#e01b24",
+	entity.TabGroupColorYellow: "#f6d32d",
+	entity.TabGroupColorGreen:  "#33d17a",
+	entity.TabGroupColorPink:   "#dc8add",
+	entity.TabGroupColorPurple: "#9141ac",
+	entity.TabGroupColorCyan:   "#1fb2c8",
+	entity.TabGroupColorOrange: "#ff7800",
+	entity.TabGroupColorGrey:   "#9a9996",
+}
+
+// generateTabGroupCSS creates the tab group marks: a colored stripe along the
+// top of grouped tabs and the name chip of collapsed groups.
+func generateTabGroupCSS() string {
+	var sb strings.Builder
+	sb.WriteString(`/* ===== Tab Group Styling ===== */
+
+.tab-group-label {
+	font-size: 0.6875em;
+	font-weight: 600;
+	color: var(--text);
+	border-radius: 0.1875em;
+	padding: 0 0.375em;
+}
+`)
+	for _, color := range entity.TabGroupColors {
+		value := tabGroupColorValues[color]
+		fmt.Fprintf(&sb, `
+button.tab-button.tab-group-%[1]s {
+	box-shadow: inset 0 0.1875em 0 %[2]s;
+}
+
+button.tab-button.tab-group-%[1]s .tab-group-label {
+	background-color: alpha(%[2]s, 0.35);
+}
+`, color, value)
+	}
+	return sb.String()
+}
@@ -0,0 +1,83 @@
+package theme
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+)
+
+// WorkspaceContainerClass marks the box holding a workspace's pane tree
+// (see component.WorkspaceView).
+const WorkspaceContainerClass = "workspace-container"
+
+// GenerateWorkspaceBackgroundCSS creates the styles for the empty areas of
+// the workspace container from appearance.workspace_background. The color
+// and pattern must already be validated and the image path absolute. An
+// unstyled background yields no CSS so the theme background shows.
+func GenerateWorkspaceBackgroundCSS(bg entity.WorkspaceBackgroundConfig) string {
+	if bg.IsZero() {
+		return ""
+	}
+
+	// Layers are listed top first: the pattern is drawn over the image.
+	var images, sizes, repeats []string
+	layers, tile := workspacePatternLayers(bg.Pattern)
+	for _, layer := range layers {
+		images = append(images, layer)
+		sizes = append(sizes, tile)
+		repeats = append(repeats, "repeat")
+	}
+	if bg.Image != "" {
+		fileURL := url.URL{Scheme: "file", Path: bg.Image}
+		images = append(images, fmt.Sprintf("url(%q)", fileURL.String()))
+		sizes = append(sizes, "cover")
+		repeats = append(repeats, "no-repeat")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("/* ===== Workspace Background ===== */\n\n")
+	fmt.Fprintf(&sb, ".%s {\n", WorkspaceContainerClass)
+	if bg.Color != "" {
+		fmt.Fprintf(&sb, "\tbackground-color: %s;\n", bg.Color)
+	}
+	if len(images) > 0 {
+		fmt.Fprintf(&sb, "\tbackground-image: %s;\n", strings.Join(images, ", "))
+		fmt.Fprintf(&sb, "\tbackground-size: %s;\n", strings.Join(sizes, ", "))
+		fmt.Fprintf(&sb, "\tbackground-repeat: %s;\n", strings.Join(repeats, ", "))
+		sb.WriteString("\tbackground-position: center;\n")
+	}
+	sb.WriteString("}\n\n")
+
+	// Let the background show through the split gutters.
+	fmt.Fprintf(&sb, ".%s paned > separator {\n", WorkspaceContainerClass)
+	sb.WriteString("\tbackground-color: transparent;\n")
+	sb.WriteString("\tbackground-image: none;\n")
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// workspacePatternLayers returns the background-image layers drawing
+// pattern and their tile size, or no layers for no pattern. Patterns use the
+// muted and border colors at low opacity to stay in the background.
+func workspacePatternLayers(pattern entity.WorkspaceBackgroundPattern) (layers []string, tile string) {
+	switch pattern {
+	case entity.WorkspaceBackgroundPatternDots:
+		return []string{
+			"radial-gradient(circle, alpha(var(--muted), 0.25) 1px, transparent 1.5px)",
+		}, "16px 16px"
+	case entity.WorkspaceBackgroundPatternGrid:
+		return []string{
+			"linear-gradient(to right, alpha(var(--border), 0.35) 1px, transparent 1px)",
+			"linear-gradient(to bottom, alpha(var(--border), 0.35) 1px, transparent 1px)",
+		}, "24px 24px"
+	case entity.WorkspaceBackgroundPatternStripes:
+		return []string{
+			"repeating-linear-gradient(45deg, alpha(var(--muted), 0.08) 0px, alpha(var(--muted), 0.08) 6px, " +
+				"transparent 6px, transparent 12px)",
+		}, "auto"
+	default:
+		return nil, ""
+	}
+}
@@ -0,0 +1,64 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateWorkspaceBackgroundCSS_EmptyConfigEmitsNothing(t *testing.T) {
+	assert.Empty(t, GenerateWorkspaceBackgroundCSS(entity.WorkspaceBackgroundConfig{}))
+	assert.Empty(t, GenerateWorkspaceBackgroundCSS(entity.WorkspaceBackgroundConfig{
+		Pattern: entity.WorkspaceBackgroundPatternNone,
+	}))
+}
+
+func TestGenerateWorkspaceBackgroundCSS_ColorOnly(t *testing.T) {
+	css := GenerateWorkspaceBackgroundCSS(entity.WorkspaceBackgroundConfig{
+		Color:   "#1e1e2e",
+		Pattern: entity.WorkspaceBackgroundPatternNone,
+	})
+
+	assert.Contains(t, css, ".workspace-container {")
+	assert.Contains(t, css, "background-color: #1e1e2e;")
+	assert.NotContains(t, css, "background-image: radial-gradient")
+	assert.NotContains(t, css, "url(")
+	assert.Contains(t, css, ".workspace-container paned > separator {")
+}
+
+func TestGenerateWorkspaceBackgroundCSS_Patterns(t *testing.T) {
+	tests := []struct {
+		pattern entity.WorkspaceBackgroundPattern
+		image   string
+		size    string
+	}{
+		{entity.WorkspaceBackgroundPatternDots, "radial-gradient(", "background-size: 16px 16px;"},
+		{entity.WorkspaceBackgroundPatternGrid, "linear-gradient(to right", "background-size: 24px 24px, 24px 24px;"},
+		{entity.WorkspaceBackgroundPatternStripes, "repeating-linear-gradient(45deg", "background-size: auto;"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.pattern), func(t *testing.T) {
+			css := GenerateWorkspaceBackgroundCSS(entity.WorkspaceBackgroundConfig{Pattern: tt.pattern})
+
+			assert.Contains(t, css, "background-image: "+tt.image)
+			assert.Contains(t, css, tt.size)
+			assert.NotContains(t, css, "background-color: #")
+		})
+	}
+}
+
+func TestGenerateWorkspaceBackgroundCSS_ImageUnderPattern(t *testing.T) {
+	css := GenerateWorkspaceBackgroundCSS(entity.WorkspaceBackgroundConfig{
+		Image:   "/home/tester/My Pictures/wall \"1\".png",
+		Pattern: entity.WorkspaceBackgroundPatternDots,
+	})
+
+	wantURL := `url("file:///home/tester/My%20Pictures/wall%20%221%22.png")`
+	assert.Contains(t, css, "background-image: radial-gradient(")
+	assert.Contains(t, css, wantURL)
+	assert.Less(t, strings.Index(css, "radial-gradient("), strings.Index(css, wantURL), "pattern must be drawn over the image")
+	assert.Contains(t, css, "background-size: 16px 16px, cover;")
+	assert.Contains(t, css, "background-repeat: repeat, no-repeat;")
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"context"
+
+	"github.com/bnema/dumber/internal/application/port"
+	"github.com/bnema/dumber/internal/logging"
+)
+
+// initUserScripts loads the user scripts, hands them to the engine and
+// reloads them whenever the scripts directory changes. It runs before the
+// first navigation so the startup page already gets matching scripts.
+func (a *App) initUserScripts(ctx context.Context) {
+	if a.deps == nil || a.deps.UserScripts == nil || a.engine == nil {
+		return
+	}
+	log := logging.FromContext(ctx)
+
+	injector, ok := a.engine.ContentInjector().(port.UserScriptInjector)
+	if !ok {
+		log.Debug().Msg("engine content injector does not support user scripts")
+		return
+	}
+
+	source := a.deps.UserScripts
+	if err := source.Load(ctx); err != nil {
+		log.Warn().Err(err).Msg("some user scripts failed to load")
+	}
+	injector.SetUserScripts(ctx, source.Scripts())
+
+	if err := source.Watch(ctx, func() {
+		a.dispatchOnMainThread("ui.user_scripts_reload", func() {
+			scripts := source.Scripts()
+			injector.SetUserScripts(ctx, scripts)
+			log.Info().Int("count", len(scripts)).Msg("user scripts reloaded")
+		})
+	}); err != nil {
+		log.Warn().Err(err).Msg("failed to watch user scripts directory")
+	}
+}
+
+// syncPageEnv hands the page_env config to the engine. It runs at startup
+// and on every config reload; pages pick the new values up on their next
+// navigation.
+func (a *App) syncPageEnv(ctx context.Context) {
+	if a.engine == nil {
+		return
+	}
+	injector, ok := a.engine.ContentInjector().(port.PageEnvInjector)
+	if !ok {
+		logging.FromContext(ctx).Debug().Msg("engine content injector does not support page env")
+		return
+	}
+	injector.SetPageEnv(ctx, a.runtimeConfigSnapshot().UI.PageEnv)
+}
+
+// stopUserScripts stops watching the user scripts directory.
+func (a *App) stopUserScripts(ctx context.Context) {
+	if a.deps == nil || a.deps.UserScripts == nil {
+		return
+	}
+	if err := a.deps.UserScripts.Stop(); err != nil {
+		logging.FromContext(ctx).Warn().Err(err).Msg("failed to stop user scripts watcher")
+	}
+}
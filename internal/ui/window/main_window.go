@@ -252,6 +252,11 @@ func (mw *MainWindow) ConnectActiveNotify(callback func(active bool)) uint {
 	return mw.window.ConnectNotifyWithDetail("is-active", &cb)
 }
 
+// IsActive reports whether the window has the focus.
+func (mw *MainWindow) IsActive() bool {
+	return mw != nil && mw.window != nil && mw.window.IsActive()
+}
+
 // SetTitle sets the window title.
 func (mw *MainWindow) SetTitle(title string) {
 	if mw.window == nil {
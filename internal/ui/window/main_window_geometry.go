@@ -0,0 +1,89 @@
+package window
+
+import (
+	"unsafe"
+
+	"github.com/bnema/dumber/internal/domain/entity"
+	"github.com/bnema/puregotk/v4/gdk"
+	"github.com/bnema/puregotk/v4/gobject"
+)
+
+// gdkRectangleLayout mirrors the C GdkRectangle: four 32-bit integers
+// {x, y, width, height}. puregotk declares the fields as Go int, so GTK must
+// not write into a gdk.Rectangle directly.
+type gdkRectangleLayout [4]int32
+
+// ApplyGeometry sets the window size and maximized state. Call it before the
+// window is first presented so GTK maps it at that size.
+func (mw *MainWindow) ApplyGeometry(geometry entity.WindowGeometry) {
+	if mw == nil || mw.window == nil || !geometry.Valid() {
+		return
+	}
+	mw.window.SetDefaultSize(geometry.Width, geometry.Height)
+	if geometry.Maximized {
+		mw.window.Maximize()
+	}
+}
+
+// Geometry returns the current window geometry. The size is the unmaximized
+// size, so a maximized window still restores to its previous size.
+func (mw *MainWindow) Geometry() entity.WindowGeometry {
+	if mw == nil || mw.window == nil {
+		return entity.WindowGeometry{}
+	}
+	var width, height int
+	mw.window.GetDefaultSize(&width, &height)
+	return entity.WindowGeometry{
+		// GTK writes C ints into the Go int pointers.
+		Width:     int(int32(width)),  //nolint:gosec // value is a C int
+		Height:    int(int32(height)), //nolint:gosec // value is a C int
+		Maximized: mw.window.IsMaximized(),
+	}
+}
+
+// MonitorBounds returns the size of the largest connected monitor, or
+// ok=false when the display has none.
+func (mw *MainWindow) MonitorBounds() (width, height int, ok bool) {
+	if mw == nil || mw.window == nil {
+		return 0, 0, false
+	}
+	display := mw.window.GetDisplay()
+	if display == nil {
+		return 0, 0, false
+	}
+	monitors := display.GetMonitors()
+	if monitors == nil {
+		return 0, 0, false
+	}
+	for i := uint(0); i < monitors.GetNItems(); i++ {
+		obj := monitors.GetObject(i)
+		if obj == nil {
+			continue
+		}
+		var rect gdkRectangleLayout
+		monitor := gdk.MonitorNewFromInternalPtr(obj.GoPointer())
+		//nolint:gosec // GTK writes a C GdkRectangle into the int32 buffer.
+		monitor.GetGeometry((*gdk.Rectangle)(unsafe.Pointer(&rect)))
+		obj.Unref()
+
+		w, h := int(rect[2]), int(rect[3])
+		if w*h > width*height {
+			width, height = w, h
+		}
+	}
+	return width, height, width > 0 && height > 0
+}
+
+// ConnectGeometryChanged calls callback whenever the window is resized,
+// maximized or unmaximized.
+func (mw *MainWindow) ConnectGeometryChanged(callback func()) {
+	if mw == nil || mw.window == nil || callback == nil {
+		return
+	}
+	for _, property := range []string{"default-width", "default-height", "maximized"} {
+		cb := func(_ gobject.Object, _ *gobject.ParamSpec) {
+			callback()
+		}
+		mw.window.ConnectNotifyWithDetail(property, &cb)
+	}
+}